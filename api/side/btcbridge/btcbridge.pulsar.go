@@ -2,7 +2,7 @@
 package btcbridge
 
 import (
-	_ "cosmossdk.io/api/cosmos/base/v1beta1"
+	v1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
@@ -2093,31 +2093,31 @@ func (x *fastReflection_SigningRequest) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_WithdrawRequest          protoreflect.MessageDescriptor
-	fd_WithdrawRequest_address  protoreflect.FieldDescriptor
-	fd_WithdrawRequest_amount   protoreflect.FieldDescriptor
-	fd_WithdrawRequest_sequence protoreflect.FieldDescriptor
-	fd_WithdrawRequest_txid     protoreflect.FieldDescriptor
+	md_SigningRequestHistoryEntry            protoreflect.MessageDescriptor
+	fd_SigningRequestHistoryEntry_transition protoreflect.FieldDescriptor
+	fd_SigningRequestHistoryEntry_actor      protoreflect.FieldDescriptor
+	fd_SigningRequestHistoryEntry_height     protoreflect.FieldDescriptor
+	fd_SigningRequestHistoryEntry_time       protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_side_btcbridge_btcbridge_proto_init()
-	md_WithdrawRequest = File_side_btcbridge_btcbridge_proto.Messages().ByName("WithdrawRequest")
-	fd_WithdrawRequest_address = md_WithdrawRequest.Fields().ByName("address")
-	fd_WithdrawRequest_amount = md_WithdrawRequest.Fields().ByName("amount")
-	fd_WithdrawRequest_sequence = md_WithdrawRequest.Fields().ByName("sequence")
-	fd_WithdrawRequest_txid = md_WithdrawRequest.Fields().ByName("txid")
+	md_SigningRequestHistoryEntry = File_side_btcbridge_btcbridge_proto.Messages().ByName("SigningRequestHistoryEntry")
+	fd_SigningRequestHistoryEntry_transition = md_SigningRequestHistoryEntry.Fields().ByName("transition")
+	fd_SigningRequestHistoryEntry_actor = md_SigningRequestHistoryEntry.Fields().ByName("actor")
+	fd_SigningRequestHistoryEntry_height = md_SigningRequestHistoryEntry.Fields().ByName("height")
+	fd_SigningRequestHistoryEntry_time = md_SigningRequestHistoryEntry.Fields().ByName("time")
 }
 
-var _ protoreflect.Message = (*fastReflection_WithdrawRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_SigningRequestHistoryEntry)(nil)
 
-type fastReflection_WithdrawRequest WithdrawRequest
+type fastReflection_SigningRequestHistoryEntry SigningRequestHistoryEntry
 
-func (x *WithdrawRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_WithdrawRequest)(x)
+func (x *SigningRequestHistoryEntry) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SigningRequestHistoryEntry)(x)
 }
 
-func (x *WithdrawRequest) slowProtoReflect() protoreflect.Message {
+func (x *SigningRequestHistoryEntry) slowProtoReflect() protoreflect.Message {
 	mi := &file_side_btcbridge_btcbridge_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2129,43 +2129,43 @@ func (x *WithdrawRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_WithdrawRequest_messageType fastReflection_WithdrawRequest_messageType
-var _ protoreflect.MessageType = fastReflection_WithdrawRequest_messageType{}
+var _fastReflection_SigningRequestHistoryEntry_messageType fastReflection_SigningRequestHistoryEntry_messageType
+var _ protoreflect.MessageType = fastReflection_SigningRequestHistoryEntry_messageType{}
 
-type fastReflection_WithdrawRequest_messageType struct{}
+type fastReflection_SigningRequestHistoryEntry_messageType struct{}
 
-func (x fastReflection_WithdrawRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_WithdrawRequest)(nil)
+func (x fastReflection_SigningRequestHistoryEntry_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SigningRequestHistoryEntry)(nil)
 }
-func (x fastReflection_WithdrawRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_WithdrawRequest)
+func (x fastReflection_SigningRequestHistoryEntry_messageType) New() protoreflect.Message {
+	return new(fastReflection_SigningRequestHistoryEntry)
 }
-func (x fastReflection_WithdrawRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_WithdrawRequest
+func (x fastReflection_SigningRequestHistoryEntry_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SigningRequestHistoryEntry
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_WithdrawRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_WithdrawRequest
+func (x *fastReflection_SigningRequestHistoryEntry) Descriptor() protoreflect.MessageDescriptor {
+	return md_SigningRequestHistoryEntry
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_WithdrawRequest) Type() protoreflect.MessageType {
-	return _fastReflection_WithdrawRequest_messageType
+func (x *fastReflection_SigningRequestHistoryEntry) Type() protoreflect.MessageType {
+	return _fastReflection_SigningRequestHistoryEntry_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_WithdrawRequest) New() protoreflect.Message {
-	return new(fastReflection_WithdrawRequest)
+func (x *fastReflection_SigningRequestHistoryEntry) New() protoreflect.Message {
+	return new(fastReflection_SigningRequestHistoryEntry)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_WithdrawRequest) Interface() protoreflect.ProtoMessage {
-	return (*WithdrawRequest)(x)
+func (x *fastReflection_SigningRequestHistoryEntry) Interface() protoreflect.ProtoMessage {
+	return (*SigningRequestHistoryEntry)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2173,28 +2173,28 @@ func (x *fastReflection_WithdrawRequest) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_WithdrawRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Address != "" {
-		value := protoreflect.ValueOfString(x.Address)
-		if !f(fd_WithdrawRequest_address, value) {
+func (x *fastReflection_SigningRequestHistoryEntry) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Transition != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Transition))
+		if !f(fd_SigningRequestHistoryEntry_transition, value) {
 			return
 		}
 	}
-	if x.Amount != "" {
-		value := protoreflect.ValueOfString(x.Amount)
-		if !f(fd_WithdrawRequest_amount, value) {
+	if x.Actor != "" {
+		value := protoreflect.ValueOfString(x.Actor)
+		if !f(fd_SigningRequestHistoryEntry_actor, value) {
 			return
 		}
 	}
-	if x.Sequence != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.Sequence)
-		if !f(fd_WithdrawRequest_sequence, value) {
+	if x.Height != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Height)
+		if !f(fd_SigningRequestHistoryEntry_height, value) {
 			return
 		}
 	}
-	if x.Txid != "" {
-		value := protoreflect.ValueOfString(x.Txid)
-		if !f(fd_WithdrawRequest_txid, value) {
+	if x.Time != nil {
+		value := protoreflect.ValueOfMessage(x.Time.ProtoReflect())
+		if !f(fd_SigningRequestHistoryEntry_time, value) {
 			return
 		}
 	}
@@ -2211,21 +2211,21 @@ func (x *fastReflection_WithdrawRequest) Range(f func(protoreflect.FieldDescript
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_WithdrawRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_SigningRequestHistoryEntry) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "side.btcbridge.WithdrawRequest.address":
-		return x.Address != ""
-	case "side.btcbridge.WithdrawRequest.amount":
-		return x.Amount != ""
-	case "side.btcbridge.WithdrawRequest.sequence":
-		return x.Sequence != uint64(0)
-	case "side.btcbridge.WithdrawRequest.txid":
-		return x.Txid != ""
+	case "side.btcbridge.SigningRequestHistoryEntry.transition":
+		return x.Transition != 0
+	case "side.btcbridge.SigningRequestHistoryEntry.actor":
+		return x.Actor != ""
+	case "side.btcbridge.SigningRequestHistoryEntry.height":
+		return x.Height != int64(0)
+	case "side.btcbridge.SigningRequestHistoryEntry.time":
+		return x.Time != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.WithdrawRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.SigningRequestHistoryEntry"))
 		}
-		panic(fmt.Errorf("message side.btcbridge.WithdrawRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message side.btcbridge.SigningRequestHistoryEntry does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2235,21 +2235,21 @@ func (x *fastReflection_WithdrawRequest) Has(fd protoreflect.FieldDescriptor) bo
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_WithdrawRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_SigningRequestHistoryEntry) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "side.btcbridge.WithdrawRequest.address":
-		x.Address = ""
-	case "side.btcbridge.WithdrawRequest.amount":
-		x.Amount = ""
-	case "side.btcbridge.WithdrawRequest.sequence":
-		x.Sequence = uint64(0)
-	case "side.btcbridge.WithdrawRequest.txid":
-		x.Txid = ""
+	case "side.btcbridge.SigningRequestHistoryEntry.transition":
+		x.Transition = 0
+	case "side.btcbridge.SigningRequestHistoryEntry.actor":
+		x.Actor = ""
+	case "side.btcbridge.SigningRequestHistoryEntry.height":
+		x.Height = int64(0)
+	case "side.btcbridge.SigningRequestHistoryEntry.time":
+		x.Time = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.WithdrawRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.SigningRequestHistoryEntry"))
 		}
-		panic(fmt.Errorf("message side.btcbridge.WithdrawRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message side.btcbridge.SigningRequestHistoryEntry does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2259,25 +2259,25 @@ func (x *fastReflection_WithdrawRequest) Clear(fd protoreflect.FieldDescriptor)
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_WithdrawRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_SigningRequestHistoryEntry) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "side.btcbridge.WithdrawRequest.address":
-		value := x.Address
-		return protoreflect.ValueOfString(value)
-	case "side.btcbridge.WithdrawRequest.amount":
-		value := x.Amount
-		return protoreflect.ValueOfString(value)
-	case "side.btcbridge.WithdrawRequest.sequence":
-		value := x.Sequence
-		return protoreflect.ValueOfUint64(value)
-	case "side.btcbridge.WithdrawRequest.txid":
-		value := x.Txid
+	case "side.btcbridge.SigningRequestHistoryEntry.transition":
+		value := x.Transition
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "side.btcbridge.SigningRequestHistoryEntry.actor":
+		value := x.Actor
 		return protoreflect.ValueOfString(value)
+	case "side.btcbridge.SigningRequestHistoryEntry.height":
+		value := x.Height
+		return protoreflect.ValueOfInt64(value)
+	case "side.btcbridge.SigningRequestHistoryEntry.time":
+		value := x.Time
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.WithdrawRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.SigningRequestHistoryEntry"))
 		}
-		panic(fmt.Errorf("message side.btcbridge.WithdrawRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message side.btcbridge.SigningRequestHistoryEntry does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2291,21 +2291,21 @@ func (x *fastReflection_WithdrawRequest) Get(descriptor protoreflect.FieldDescri
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_WithdrawRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_SigningRequestHistoryEntry) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "side.btcbridge.WithdrawRequest.address":
-		x.Address = value.Interface().(string)
-	case "side.btcbridge.WithdrawRequest.amount":
-		x.Amount = value.Interface().(string)
-	case "side.btcbridge.WithdrawRequest.sequence":
-		x.Sequence = value.Uint()
-	case "side.btcbridge.WithdrawRequest.txid":
-		x.Txid = value.Interface().(string)
+	case "side.btcbridge.SigningRequestHistoryEntry.transition":
+		x.Transition = (SigningTransition)(value.Enum())
+	case "side.btcbridge.SigningRequestHistoryEntry.actor":
+		x.Actor = value.Interface().(string)
+	case "side.btcbridge.SigningRequestHistoryEntry.height":
+		x.Height = value.Int()
+	case "side.btcbridge.SigningRequestHistoryEntry.time":
+		x.Time = value.Message().Interface().(*timestamppb.Timestamp)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.WithdrawRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.SigningRequestHistoryEntry"))
 		}
-		panic(fmt.Errorf("message side.btcbridge.WithdrawRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message side.btcbridge.SigningRequestHistoryEntry does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2319,52 +2319,56 @@ func (x *fastReflection_WithdrawRequest) Set(fd protoreflect.FieldDescriptor, va
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_WithdrawRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_SigningRequestHistoryEntry) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "side.btcbridge.WithdrawRequest.address":
-		panic(fmt.Errorf("field address of message side.btcbridge.WithdrawRequest is not mutable"))
-	case "side.btcbridge.WithdrawRequest.amount":
-		panic(fmt.Errorf("field amount of message side.btcbridge.WithdrawRequest is not mutable"))
-	case "side.btcbridge.WithdrawRequest.sequence":
-		panic(fmt.Errorf("field sequence of message side.btcbridge.WithdrawRequest is not mutable"))
-	case "side.btcbridge.WithdrawRequest.txid":
-		panic(fmt.Errorf("field txid of message side.btcbridge.WithdrawRequest is not mutable"))
+	case "side.btcbridge.SigningRequestHistoryEntry.time":
+		if x.Time == nil {
+			x.Time = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.Time.ProtoReflect())
+	case "side.btcbridge.SigningRequestHistoryEntry.transition":
+		panic(fmt.Errorf("field transition of message side.btcbridge.SigningRequestHistoryEntry is not mutable"))
+	case "side.btcbridge.SigningRequestHistoryEntry.actor":
+		panic(fmt.Errorf("field actor of message side.btcbridge.SigningRequestHistoryEntry is not mutable"))
+	case "side.btcbridge.SigningRequestHistoryEntry.height":
+		panic(fmt.Errorf("field height of message side.btcbridge.SigningRequestHistoryEntry is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.WithdrawRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.SigningRequestHistoryEntry"))
 		}
-		panic(fmt.Errorf("message side.btcbridge.WithdrawRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message side.btcbridge.SigningRequestHistoryEntry does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_WithdrawRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_SigningRequestHistoryEntry) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "side.btcbridge.WithdrawRequest.address":
-		return protoreflect.ValueOfString("")
-	case "side.btcbridge.WithdrawRequest.amount":
-		return protoreflect.ValueOfString("")
-	case "side.btcbridge.WithdrawRequest.sequence":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "side.btcbridge.WithdrawRequest.txid":
+	case "side.btcbridge.SigningRequestHistoryEntry.transition":
+		return protoreflect.ValueOfEnum(0)
+	case "side.btcbridge.SigningRequestHistoryEntry.actor":
 		return protoreflect.ValueOfString("")
+	case "side.btcbridge.SigningRequestHistoryEntry.height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "side.btcbridge.SigningRequestHistoryEntry.time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.WithdrawRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.SigningRequestHistoryEntry"))
 		}
-		panic(fmt.Errorf("message side.btcbridge.WithdrawRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message side.btcbridge.SigningRequestHistoryEntry does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_WithdrawRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_SigningRequestHistoryEntry) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in side.btcbridge.WithdrawRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in side.btcbridge.SigningRequestHistoryEntry", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -2372,7 +2376,7 @@ func (x *fastReflection_WithdrawRequest) WhichOneof(d protoreflect.OneofDescript
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_WithdrawRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_SigningRequestHistoryEntry) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -2383,7 +2387,7 @@ func (x *fastReflection_WithdrawRequest) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_WithdrawRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_SigningRequestHistoryEntry) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -2395,7 +2399,7 @@ func (x *fastReflection_WithdrawRequest) SetUnknown(fields protoreflect.RawField
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_WithdrawRequest) IsValid() bool {
+func (x *fastReflection_SigningRequestHistoryEntry) IsValid() bool {
 	return x != nil
 }
 
@@ -2405,9 +2409,9 @@ func (x *fastReflection_WithdrawRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_WithdrawRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_SigningRequestHistoryEntry) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*WithdrawRequest)
+		x := input.Message.Interface().(*SigningRequestHistoryEntry)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2419,19 +2423,18 @@ func (x *fastReflection_WithdrawRequest) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.Address)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.Transition != 0 {
+			n += 1 + runtime.Sov(uint64(x.Transition))
 		}
-		l = len(x.Amount)
+		l = len(x.Actor)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.Sequence != 0 {
-			n += 1 + runtime.Sov(uint64(x.Sequence))
+		if x.Height != 0 {
+			n += 1 + runtime.Sov(uint64(x.Height))
 		}
-		l = len(x.Txid)
-		if l > 0 {
+		if x.Time != nil {
+			l = options.Size(x.Time)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -2444,7 +2447,7 @@ func (x *fastReflection_WithdrawRequest) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*WithdrawRequest)
+		x := input.Message.Interface().(*SigningRequestHistoryEntry)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2463,31 +2466,36 @@ func (x *fastReflection_WithdrawRequest) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Txid) > 0 {
-			i -= len(x.Txid)
-			copy(dAtA[i:], x.Txid)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Txid)))
+		if x.Time != nil {
+			encoded, err := options.Marshal(x.Time)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
 			dAtA[i] = 0x22
 		}
-		if x.Sequence != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Sequence))
+		if x.Height != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
 			i--
 			dAtA[i] = 0x18
 		}
-		if len(x.Amount) > 0 {
-			i -= len(x.Amount)
-			copy(dAtA[i:], x.Amount)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Amount)))
+		if len(x.Actor) > 0 {
+			i -= len(x.Actor)
+			copy(dAtA[i:], x.Actor)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Actor)))
 			i--
 			dAtA[i] = 0x12
 		}
-		if len(x.Address) > 0 {
-			i -= len(x.Address)
-			copy(dAtA[i:], x.Address)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+		if x.Transition != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Transition))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -2500,7 +2508,7 @@ func (x *fastReflection_WithdrawRequest) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*WithdrawRequest)
+		x := input.Message.Interface().(*SigningRequestHistoryEntry)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2532,17 +2540,17 @@ func (x *fastReflection_WithdrawRequest) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: WithdrawRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SigningRequestHistoryEntry: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: WithdrawRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SigningRequestHistoryEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Transition", wireType)
 				}
-				var stringLen uint64
+				x.Transition = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -2552,27 +2560,14 @@ func (x *fastReflection_WithdrawRequest) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					x.Transition |= SigningTransition(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Address = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Actor", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -2600,13 +2595,13 @@ func (x *fastReflection_WithdrawRequest) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Amount = string(dAtA[iNdEx:postIndex])
+				x.Actor = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 3:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 				}
-				x.Sequence = 0
+				x.Height = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -2616,16 +2611,16 @@ func (x *fastReflection_WithdrawRequest) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.Sequence |= uint64(b&0x7F) << shift
+					x.Height |= int64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
 			case 4:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Txid", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -2635,23 +2630,27 @@ func (x *fastReflection_WithdrawRequest) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Txid = string(dAtA[iNdEx:postIndex])
+				if x.Time == nil {
+					x.Time = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Time); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -2688,91 +2687,79 @@ func (x *fastReflection_WithdrawRequest) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_UTXO_8_list)(nil)
+var _ protoreflect.List = (*_SigningRequestHistory_2_list)(nil)
 
-type _UTXO_8_list struct {
-	list *[]*RuneBalance
+type _SigningRequestHistory_2_list struct {
+	list *[]*SigningRequestHistoryEntry
 }
 
-func (x *_UTXO_8_list) Len() int {
+func (x *_SigningRequestHistory_2_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_UTXO_8_list) Get(i int) protoreflect.Value {
+func (x *_SigningRequestHistory_2_list) Get(i int) protoreflect.Value {
 	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *_UTXO_8_list) Set(i int, value protoreflect.Value) {
+func (x *_SigningRequestHistory_2_list) Set(i int, value protoreflect.Value) {
 	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*RuneBalance)
+	concreteValue := valueUnwrapped.Interface().(*SigningRequestHistoryEntry)
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_UTXO_8_list) Append(value protoreflect.Value) {
+func (x *_SigningRequestHistory_2_list) Append(value protoreflect.Value) {
 	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*RuneBalance)
+	concreteValue := valueUnwrapped.Interface().(*SigningRequestHistoryEntry)
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_UTXO_8_list) AppendMutable() protoreflect.Value {
-	v := new(RuneBalance)
+func (x *_SigningRequestHistory_2_list) AppendMutable() protoreflect.Value {
+	v := new(SigningRequestHistoryEntry)
 	*x.list = append(*x.list, v)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_UTXO_8_list) Truncate(n int) {
+func (x *_SigningRequestHistory_2_list) Truncate(n int) {
 	for i := n; i < len(*x.list); i++ {
 		(*x.list)[i] = nil
 	}
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_UTXO_8_list) NewElement() protoreflect.Value {
-	v := new(RuneBalance)
+func (x *_SigningRequestHistory_2_list) NewElement() protoreflect.Value {
+	v := new(SigningRequestHistoryEntry)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_UTXO_8_list) IsValid() bool {
+func (x *_SigningRequestHistory_2_list) IsValid() bool {
 	return x.list != nil
 }
 
 var (
-	md_UTXO                protoreflect.MessageDescriptor
-	fd_UTXO_txid           protoreflect.FieldDescriptor
-	fd_UTXO_vout           protoreflect.FieldDescriptor
-	fd_UTXO_address        protoreflect.FieldDescriptor
-	fd_UTXO_amount         protoreflect.FieldDescriptor
-	fd_UTXO_height         protoreflect.FieldDescriptor
-	fd_UTXO_pub_key_script protoreflect.FieldDescriptor
-	fd_UTXO_is_locked      protoreflect.FieldDescriptor
-	fd_UTXO_runes          protoreflect.FieldDescriptor
+	md_SigningRequestHistory          protoreflect.MessageDescriptor
+	fd_SigningRequestHistory_sequence protoreflect.FieldDescriptor
+	fd_SigningRequestHistory_entries  protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_side_btcbridge_btcbridge_proto_init()
-	md_UTXO = File_side_btcbridge_btcbridge_proto.Messages().ByName("UTXO")
-	fd_UTXO_txid = md_UTXO.Fields().ByName("txid")
-	fd_UTXO_vout = md_UTXO.Fields().ByName("vout")
-	fd_UTXO_address = md_UTXO.Fields().ByName("address")
-	fd_UTXO_amount = md_UTXO.Fields().ByName("amount")
-	fd_UTXO_height = md_UTXO.Fields().ByName("height")
-	fd_UTXO_pub_key_script = md_UTXO.Fields().ByName("pub_key_script")
-	fd_UTXO_is_locked = md_UTXO.Fields().ByName("is_locked")
-	fd_UTXO_runes = md_UTXO.Fields().ByName("runes")
+	md_SigningRequestHistory = File_side_btcbridge_btcbridge_proto.Messages().ByName("SigningRequestHistory")
+	fd_SigningRequestHistory_sequence = md_SigningRequestHistory.Fields().ByName("sequence")
+	fd_SigningRequestHistory_entries = md_SigningRequestHistory.Fields().ByName("entries")
 }
 
-var _ protoreflect.Message = (*fastReflection_UTXO)(nil)
+var _ protoreflect.Message = (*fastReflection_SigningRequestHistory)(nil)
 
-type fastReflection_UTXO UTXO
+type fastReflection_SigningRequestHistory SigningRequestHistory
 
-func (x *UTXO) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_UTXO)(x)
+func (x *SigningRequestHistory) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SigningRequestHistory)(x)
 }
 
-func (x *UTXO) slowProtoReflect() protoreflect.Message {
+func (x *SigningRequestHistory) slowProtoReflect() protoreflect.Message {
 	mi := &file_side_btcbridge_btcbridge_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2784,43 +2771,43 @@ func (x *UTXO) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_UTXO_messageType fastReflection_UTXO_messageType
-var _ protoreflect.MessageType = fastReflection_UTXO_messageType{}
+var _fastReflection_SigningRequestHistory_messageType fastReflection_SigningRequestHistory_messageType
+var _ protoreflect.MessageType = fastReflection_SigningRequestHistory_messageType{}
 
-type fastReflection_UTXO_messageType struct{}
+type fastReflection_SigningRequestHistory_messageType struct{}
 
-func (x fastReflection_UTXO_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_UTXO)(nil)
+func (x fastReflection_SigningRequestHistory_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SigningRequestHistory)(nil)
 }
-func (x fastReflection_UTXO_messageType) New() protoreflect.Message {
-	return new(fastReflection_UTXO)
+func (x fastReflection_SigningRequestHistory_messageType) New() protoreflect.Message {
+	return new(fastReflection_SigningRequestHistory)
 }
-func (x fastReflection_UTXO_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_UTXO
+func (x fastReflection_SigningRequestHistory_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SigningRequestHistory
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_UTXO) Descriptor() protoreflect.MessageDescriptor {
-	return md_UTXO
+func (x *fastReflection_SigningRequestHistory) Descriptor() protoreflect.MessageDescriptor {
+	return md_SigningRequestHistory
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_UTXO) Type() protoreflect.MessageType {
-	return _fastReflection_UTXO_messageType
+func (x *fastReflection_SigningRequestHistory) Type() protoreflect.MessageType {
+	return _fastReflection_SigningRequestHistory_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_UTXO) New() protoreflect.Message {
-	return new(fastReflection_UTXO)
+func (x *fastReflection_SigningRequestHistory) New() protoreflect.Message {
+	return new(fastReflection_SigningRequestHistory)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_UTXO) Interface() protoreflect.ProtoMessage {
-	return (*UTXO)(x)
+func (x *fastReflection_SigningRequestHistory) Interface() protoreflect.ProtoMessage {
+	return (*SigningRequestHistory)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2828,52 +2815,16 @@ func (x *fastReflection_UTXO) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_UTXO) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Txid != "" {
-		value := protoreflect.ValueOfString(x.Txid)
-		if !f(fd_UTXO_txid, value) {
-			return
-		}
-	}
-	if x.Vout != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.Vout)
-		if !f(fd_UTXO_vout, value) {
-			return
-		}
-	}
-	if x.Address != "" {
-		value := protoreflect.ValueOfString(x.Address)
-		if !f(fd_UTXO_address, value) {
-			return
-		}
-	}
-	if x.Amount != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.Amount)
-		if !f(fd_UTXO_amount, value) {
-			return
-		}
-	}
-	if x.Height != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.Height)
-		if !f(fd_UTXO_height, value) {
-			return
-		}
-	}
-	if len(x.PubKeyScript) != 0 {
-		value := protoreflect.ValueOfBytes(x.PubKeyScript)
-		if !f(fd_UTXO_pub_key_script, value) {
-			return
-		}
-	}
-	if x.IsLocked != false {
-		value := protoreflect.ValueOfBool(x.IsLocked)
-		if !f(fd_UTXO_is_locked, value) {
+func (x *fastReflection_SigningRequestHistory) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Sequence != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Sequence)
+		if !f(fd_SigningRequestHistory_sequence, value) {
 			return
 		}
 	}
-	if len(x.Runes) != 0 {
-		value := protoreflect.ValueOfList(&_UTXO_8_list{list: &x.Runes})
-		if !f(fd_UTXO_runes, value) {
+	if len(x.Entries) != 0 {
+		value := protoreflect.ValueOfList(&_SigningRequestHistory_2_list{list: &x.Entries})
+		if !f(fd_SigningRequestHistory_entries, value) {
 			return
 		}
 	}
@@ -2890,29 +2841,17 @@ func (x *fastReflection_UTXO) Range(f func(protoreflect.FieldDescriptor, protore
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_UTXO) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_SigningRequestHistory) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "side.btcbridge.UTXO.txid":
-		return x.Txid != ""
-	case "side.btcbridge.UTXO.vout":
-		return x.Vout != uint64(0)
-	case "side.btcbridge.UTXO.address":
-		return x.Address != ""
-	case "side.btcbridge.UTXO.amount":
-		return x.Amount != uint64(0)
-	case "side.btcbridge.UTXO.height":
-		return x.Height != uint64(0)
-	case "side.btcbridge.UTXO.pub_key_script":
-		return len(x.PubKeyScript) != 0
-	case "side.btcbridge.UTXO.is_locked":
-		return x.IsLocked != false
-	case "side.btcbridge.UTXO.runes":
-		return len(x.Runes) != 0
+	case "side.btcbridge.SigningRequestHistory.sequence":
+		return x.Sequence != uint64(0)
+	case "side.btcbridge.SigningRequestHistory.entries":
+		return len(x.Entries) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.UTXO"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.SigningRequestHistory"))
 		}
-		panic(fmt.Errorf("message side.btcbridge.UTXO does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message side.btcbridge.SigningRequestHistory does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2922,29 +2861,17 @@ func (x *fastReflection_UTXO) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_UTXO) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_SigningRequestHistory) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "side.btcbridge.UTXO.txid":
-		x.Txid = ""
-	case "side.btcbridge.UTXO.vout":
-		x.Vout = uint64(0)
-	case "side.btcbridge.UTXO.address":
-		x.Address = ""
-	case "side.btcbridge.UTXO.amount":
-		x.Amount = uint64(0)
-	case "side.btcbridge.UTXO.height":
-		x.Height = uint64(0)
-	case "side.btcbridge.UTXO.pub_key_script":
-		x.PubKeyScript = nil
-	case "side.btcbridge.UTXO.is_locked":
-		x.IsLocked = false
-	case "side.btcbridge.UTXO.runes":
-		x.Runes = nil
+	case "side.btcbridge.SigningRequestHistory.sequence":
+		x.Sequence = uint64(0)
+	case "side.btcbridge.SigningRequestHistory.entries":
+		x.Entries = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.UTXO"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.SigningRequestHistory"))
 		}
-		panic(fmt.Errorf("message side.btcbridge.UTXO does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message side.btcbridge.SigningRequestHistory does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2954,40 +2881,22 @@ func (x *fastReflection_UTXO) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_UTXO) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_SigningRequestHistory) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "side.btcbridge.UTXO.txid":
-		value := x.Txid
-		return protoreflect.ValueOfString(value)
-	case "side.btcbridge.UTXO.vout":
-		value := x.Vout
-		return protoreflect.ValueOfUint64(value)
-	case "side.btcbridge.UTXO.address":
-		value := x.Address
-		return protoreflect.ValueOfString(value)
-	case "side.btcbridge.UTXO.amount":
-		value := x.Amount
-		return protoreflect.ValueOfUint64(value)
-	case "side.btcbridge.UTXO.height":
-		value := x.Height
+	case "side.btcbridge.SigningRequestHistory.sequence":
+		value := x.Sequence
 		return protoreflect.ValueOfUint64(value)
-	case "side.btcbridge.UTXO.pub_key_script":
-		value := x.PubKeyScript
-		return protoreflect.ValueOfBytes(value)
-	case "side.btcbridge.UTXO.is_locked":
-		value := x.IsLocked
-		return protoreflect.ValueOfBool(value)
-	case "side.btcbridge.UTXO.runes":
-		if len(x.Runes) == 0 {
-			return protoreflect.ValueOfList(&_UTXO_8_list{})
+	case "side.btcbridge.SigningRequestHistory.entries":
+		if len(x.Entries) == 0 {
+			return protoreflect.ValueOfList(&_SigningRequestHistory_2_list{})
 		}
-		listValue := &_UTXO_8_list{list: &x.Runes}
+		listValue := &_SigningRequestHistory_2_list{list: &x.Entries}
 		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.UTXO"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.SigningRequestHistory"))
 		}
-		panic(fmt.Errorf("message side.btcbridge.UTXO does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message side.btcbridge.SigningRequestHistory does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -3001,31 +2910,19 @@ func (x *fastReflection_UTXO) Get(descriptor protoreflect.FieldDescriptor) proto
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_UTXO) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_SigningRequestHistory) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "side.btcbridge.UTXO.txid":
-		x.Txid = value.Interface().(string)
-	case "side.btcbridge.UTXO.vout":
-		x.Vout = value.Uint()
-	case "side.btcbridge.UTXO.address":
-		x.Address = value.Interface().(string)
-	case "side.btcbridge.UTXO.amount":
-		x.Amount = value.Uint()
-	case "side.btcbridge.UTXO.height":
-		x.Height = value.Uint()
-	case "side.btcbridge.UTXO.pub_key_script":
-		x.PubKeyScript = value.Bytes()
-	case "side.btcbridge.UTXO.is_locked":
-		x.IsLocked = value.Bool()
-	case "side.btcbridge.UTXO.runes":
+	case "side.btcbridge.SigningRequestHistory.sequence":
+		x.Sequence = value.Uint()
+	case "side.btcbridge.SigningRequestHistory.entries":
 		lv := value.List()
-		clv := lv.(*_UTXO_8_list)
-		x.Runes = *clv.list
+		clv := lv.(*_SigningRequestHistory_2_list)
+		x.Entries = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.UTXO"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.SigningRequestHistory"))
 		}
-		panic(fmt.Errorf("message side.btcbridge.UTXO does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message side.btcbridge.SigningRequestHistory does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3039,73 +2936,49 @@ func (x *fastReflection_UTXO) Set(fd protoreflect.FieldDescriptor, value protore
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_UTXO) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_SigningRequestHistory) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "side.btcbridge.UTXO.runes":
-		if x.Runes == nil {
-			x.Runes = []*RuneBalance{}
+	case "side.btcbridge.SigningRequestHistory.entries":
+		if x.Entries == nil {
+			x.Entries = []*SigningRequestHistoryEntry{}
 		}
-		value := &_UTXO_8_list{list: &x.Runes}
+		value := &_SigningRequestHistory_2_list{list: &x.Entries}
 		return protoreflect.ValueOfList(value)
-	case "side.btcbridge.UTXO.txid":
-		panic(fmt.Errorf("field txid of message side.btcbridge.UTXO is not mutable"))
-	case "side.btcbridge.UTXO.vout":
-		panic(fmt.Errorf("field vout of message side.btcbridge.UTXO is not mutable"))
-	case "side.btcbridge.UTXO.address":
-		panic(fmt.Errorf("field address of message side.btcbridge.UTXO is not mutable"))
-	case "side.btcbridge.UTXO.amount":
-		panic(fmt.Errorf("field amount of message side.btcbridge.UTXO is not mutable"))
-	case "side.btcbridge.UTXO.height":
-		panic(fmt.Errorf("field height of message side.btcbridge.UTXO is not mutable"))
-	case "side.btcbridge.UTXO.pub_key_script":
-		panic(fmt.Errorf("field pub_key_script of message side.btcbridge.UTXO is not mutable"))
-	case "side.btcbridge.UTXO.is_locked":
-		panic(fmt.Errorf("field is_locked of message side.btcbridge.UTXO is not mutable"))
+	case "side.btcbridge.SigningRequestHistory.sequence":
+		panic(fmt.Errorf("field sequence of message side.btcbridge.SigningRequestHistory is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.UTXO"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.SigningRequestHistory"))
 		}
-		panic(fmt.Errorf("message side.btcbridge.UTXO does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message side.btcbridge.SigningRequestHistory does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_UTXO) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_SigningRequestHistory) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "side.btcbridge.UTXO.txid":
-		return protoreflect.ValueOfString("")
-	case "side.btcbridge.UTXO.vout":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "side.btcbridge.UTXO.address":
-		return protoreflect.ValueOfString("")
-	case "side.btcbridge.UTXO.amount":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "side.btcbridge.UTXO.height":
+	case "side.btcbridge.SigningRequestHistory.sequence":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "side.btcbridge.UTXO.pub_key_script":
-		return protoreflect.ValueOfBytes(nil)
-	case "side.btcbridge.UTXO.is_locked":
-		return protoreflect.ValueOfBool(false)
-	case "side.btcbridge.UTXO.runes":
-		list := []*RuneBalance{}
-		return protoreflect.ValueOfList(&_UTXO_8_list{list: &list})
+	case "side.btcbridge.SigningRequestHistory.entries":
+		list := []*SigningRequestHistoryEntry{}
+		return protoreflect.ValueOfList(&_SigningRequestHistory_2_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.UTXO"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.SigningRequestHistory"))
 		}
-		panic(fmt.Errorf("message side.btcbridge.UTXO does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message side.btcbridge.SigningRequestHistory does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_UTXO) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_SigningRequestHistory) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in side.btcbridge.UTXO", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in side.btcbridge.SigningRequestHistory", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3113,7 +2986,7 @@ func (x *fastReflection_UTXO) WhichOneof(d protoreflect.OneofDescriptor) protore
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_UTXO) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_SigningRequestHistory) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3124,7 +2997,7 @@ func (x *fastReflection_UTXO) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_UTXO) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_SigningRequestHistory) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3136,7 +3009,7 @@ func (x *fastReflection_UTXO) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_UTXO) IsValid() bool {
+func (x *fastReflection_SigningRequestHistory) IsValid() bool {
 	return x != nil
 }
 
@@ -3146,9 +3019,9 @@ func (x *fastReflection_UTXO) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_UTXO) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_SigningRequestHistory) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*UTXO)
+		x := input.Message.Interface().(*SigningRequestHistory)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3160,32 +3033,11 @@ func (x *fastReflection_UTXO) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.Txid)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.Vout != 0 {
-			n += 1 + runtime.Sov(uint64(x.Vout))
-		}
-		l = len(x.Address)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.Amount != 0 {
-			n += 1 + runtime.Sov(uint64(x.Amount))
-		}
-		if x.Height != 0 {
-			n += 1 + runtime.Sov(uint64(x.Height))
-		}
-		l = len(x.PubKeyScript)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.IsLocked {
-			n += 2
+		if x.Sequence != 0 {
+			n += 1 + runtime.Sov(uint64(x.Sequence))
 		}
-		if len(x.Runes) > 0 {
-			for _, e := range x.Runes {
+		if len(x.Entries) > 0 {
+			for _, e := range x.Entries {
 				l = options.Size(e)
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
@@ -3200,7 +3052,7 @@ func (x *fastReflection_UTXO) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*UTXO)
+		x := input.Message.Interface().(*SigningRequestHistory)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3219,9 +3071,9 @@ func (x *fastReflection_UTXO) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Runes) > 0 {
-			for iNdEx := len(x.Runes) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Runes[iNdEx])
+		if len(x.Entries) > 0 {
+			for iNdEx := len(x.Entries) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Entries[iNdEx])
 				if err != nil {
 					return protoiface.MarshalOutput{
 						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3232,59 +3084,18 @@ func (x *fastReflection_UTXO) ProtoMethods() *protoiface.Methods {
 				copy(dAtA[i:], encoded)
 				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 				i--
-				dAtA[i] = 0x42
-			}
-		}
-		if x.IsLocked {
-			i--
-			if x.IsLocked {
-				dAtA[i] = 1
-			} else {
-				dAtA[i] = 0
+				dAtA[i] = 0x12
 			}
-			i--
-			dAtA[i] = 0x38
 		}
-		if len(x.PubKeyScript) > 0 {
-			i -= len(x.PubKeyScript)
-			copy(dAtA[i:], x.PubKeyScript)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PubKeyScript)))
+		if x.Sequence != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Sequence))
 			i--
-			dAtA[i] = 0x32
+			dAtA[i] = 0x8
 		}
-		if x.Height != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
-			i--
-			dAtA[i] = 0x28
-		}
-		if x.Amount != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Amount))
-			i--
-			dAtA[i] = 0x20
-		}
-		if len(x.Address) > 0 {
-			i -= len(x.Address)
-			copy(dAtA[i:], x.Address)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
-			i--
-			dAtA[i] = 0x1a
-		}
-		if x.Vout != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Vout))
-			i--
-			dAtA[i] = 0x10
-		}
-		if len(x.Txid) > 0 {
-			i -= len(x.Txid)
-			copy(dAtA[i:], x.Txid)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Txid)))
-			i--
-			dAtA[i] = 0xa
-		}
-		if input.Buf != nil {
-			input.Buf = append(input.Buf, dAtA...)
-		} else {
-			input.Buf = dAtA
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
 		}
 		return protoiface.MarshalOutput{
 			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3292,7 +3103,7 @@ func (x *fastReflection_UTXO) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*UTXO)
+		x := input.Message.Interface().(*SigningRequestHistory)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3324,172 +3135,17 @@ func (x *fastReflection_UTXO) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: UTXO: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SigningRequestHistory: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: UTXO: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SigningRequestHistory: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Txid", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Txid = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 2:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Vout", wireType)
-				}
-				x.Vout = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.Vout |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Address = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 4:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
-				}
-				x.Amount = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.Amount |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 5:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
-				}
-				x.Height = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.Height |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 6:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PubKeyScript", wireType)
-				}
-				var byteLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					byteLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if byteLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + byteLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.PubKeyScript = append(x.PubKeyScript[:0], dAtA[iNdEx:postIndex]...)
-				if x.PubKeyScript == nil {
-					x.PubKeyScript = []byte{}
-				}
-				iNdEx = postIndex
-			case 7:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IsLocked", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
 				}
-				var v int
+				x.Sequence = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -3499,15 +3155,14 @@ func (x *fastReflection_UTXO) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					v |= int(b&0x7F) << shift
+					x.Sequence |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				x.IsLocked = bool(v != 0)
-			case 8:
+			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Runes", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -3534,8 +3189,8 @@ func (x *fastReflection_UTXO) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Runes = append(x.Runes, &RuneBalance{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Runes[len(x.Runes)-1]); err != nil {
+				x.Entries = append(x.Entries, &SigningRequestHistoryEntry{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Entries[len(x.Entries)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -3575,27 +3230,31 @@ func (x *fastReflection_UTXO) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_RuneBalance        protoreflect.MessageDescriptor
-	fd_RuneBalance_id     protoreflect.FieldDescriptor
-	fd_RuneBalance_amount protoreflect.FieldDescriptor
+	md_WithdrawRequest          protoreflect.MessageDescriptor
+	fd_WithdrawRequest_address  protoreflect.FieldDescriptor
+	fd_WithdrawRequest_amount   protoreflect.FieldDescriptor
+	fd_WithdrawRequest_sequence protoreflect.FieldDescriptor
+	fd_WithdrawRequest_txid     protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_side_btcbridge_btcbridge_proto_init()
-	md_RuneBalance = File_side_btcbridge_btcbridge_proto.Messages().ByName("RuneBalance")
-	fd_RuneBalance_id = md_RuneBalance.Fields().ByName("id")
-	fd_RuneBalance_amount = md_RuneBalance.Fields().ByName("amount")
+	md_WithdrawRequest = File_side_btcbridge_btcbridge_proto.Messages().ByName("WithdrawRequest")
+	fd_WithdrawRequest_address = md_WithdrawRequest.Fields().ByName("address")
+	fd_WithdrawRequest_amount = md_WithdrawRequest.Fields().ByName("amount")
+	fd_WithdrawRequest_sequence = md_WithdrawRequest.Fields().ByName("sequence")
+	fd_WithdrawRequest_txid = md_WithdrawRequest.Fields().ByName("txid")
 }
 
-var _ protoreflect.Message = (*fastReflection_RuneBalance)(nil)
+var _ protoreflect.Message = (*fastReflection_WithdrawRequest)(nil)
 
-type fastReflection_RuneBalance RuneBalance
+type fastReflection_WithdrawRequest WithdrawRequest
 
-func (x *RuneBalance) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_RuneBalance)(x)
+func (x *WithdrawRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_WithdrawRequest)(x)
 }
 
-func (x *RuneBalance) slowProtoReflect() protoreflect.Message {
+func (x *WithdrawRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_side_btcbridge_btcbridge_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3607,43 +3266,43 @@ func (x *RuneBalance) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_RuneBalance_messageType fastReflection_RuneBalance_messageType
-var _ protoreflect.MessageType = fastReflection_RuneBalance_messageType{}
+var _fastReflection_WithdrawRequest_messageType fastReflection_WithdrawRequest_messageType
+var _ protoreflect.MessageType = fastReflection_WithdrawRequest_messageType{}
 
-type fastReflection_RuneBalance_messageType struct{}
+type fastReflection_WithdrawRequest_messageType struct{}
 
-func (x fastReflection_RuneBalance_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_RuneBalance)(nil)
+func (x fastReflection_WithdrawRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_WithdrawRequest)(nil)
 }
-func (x fastReflection_RuneBalance_messageType) New() protoreflect.Message {
-	return new(fastReflection_RuneBalance)
+func (x fastReflection_WithdrawRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_WithdrawRequest)
 }
-func (x fastReflection_RuneBalance_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_RuneBalance
+func (x fastReflection_WithdrawRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_WithdrawRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_RuneBalance) Descriptor() protoreflect.MessageDescriptor {
-	return md_RuneBalance
+func (x *fastReflection_WithdrawRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_WithdrawRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_RuneBalance) Type() protoreflect.MessageType {
-	return _fastReflection_RuneBalance_messageType
+func (x *fastReflection_WithdrawRequest) Type() protoreflect.MessageType {
+	return _fastReflection_WithdrawRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_RuneBalance) New() protoreflect.Message {
-	return new(fastReflection_RuneBalance)
+func (x *fastReflection_WithdrawRequest) New() protoreflect.Message {
+	return new(fastReflection_WithdrawRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_RuneBalance) Interface() protoreflect.ProtoMessage {
-	return (*RuneBalance)(x)
+func (x *fastReflection_WithdrawRequest) Interface() protoreflect.ProtoMessage {
+	return (*WithdrawRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3651,16 +3310,28 @@ func (x *fastReflection_RuneBalance) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_RuneBalance) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Id != "" {
-		value := protoreflect.ValueOfString(x.Id)
-		if !f(fd_RuneBalance_id, value) {
+func (x *fastReflection_WithdrawRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_WithdrawRequest_address, value) {
 			return
 		}
 	}
 	if x.Amount != "" {
 		value := protoreflect.ValueOfString(x.Amount)
-		if !f(fd_RuneBalance_amount, value) {
+		if !f(fd_WithdrawRequest_amount, value) {
+			return
+		}
+	}
+	if x.Sequence != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Sequence)
+		if !f(fd_WithdrawRequest_sequence, value) {
+			return
+		}
+	}
+	if x.Txid != "" {
+		value := protoreflect.ValueOfString(x.Txid)
+		if !f(fd_WithdrawRequest_txid, value) {
 			return
 		}
 	}
@@ -3677,17 +3348,21 @@ func (x *fastReflection_RuneBalance) Range(f func(protoreflect.FieldDescriptor,
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_RuneBalance) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_WithdrawRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "side.btcbridge.RuneBalance.id":
-		return x.Id != ""
-	case "side.btcbridge.RuneBalance.amount":
+	case "side.btcbridge.WithdrawRequest.address":
+		return x.Address != ""
+	case "side.btcbridge.WithdrawRequest.amount":
 		return x.Amount != ""
+	case "side.btcbridge.WithdrawRequest.sequence":
+		return x.Sequence != uint64(0)
+	case "side.btcbridge.WithdrawRequest.txid":
+		return x.Txid != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.RuneBalance"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.WithdrawRequest"))
 		}
-		panic(fmt.Errorf("message side.btcbridge.RuneBalance does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message side.btcbridge.WithdrawRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3697,17 +3372,21 @@ func (x *fastReflection_RuneBalance) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RuneBalance) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_WithdrawRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "side.btcbridge.RuneBalance.id":
-		x.Id = ""
-	case "side.btcbridge.RuneBalance.amount":
+	case "side.btcbridge.WithdrawRequest.address":
+		x.Address = ""
+	case "side.btcbridge.WithdrawRequest.amount":
 		x.Amount = ""
+	case "side.btcbridge.WithdrawRequest.sequence":
+		x.Sequence = uint64(0)
+	case "side.btcbridge.WithdrawRequest.txid":
+		x.Txid = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.RuneBalance"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.WithdrawRequest"))
 		}
-		panic(fmt.Errorf("message side.btcbridge.RuneBalance does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message side.btcbridge.WithdrawRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3717,19 +3396,25 @@ func (x *fastReflection_RuneBalance) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_RuneBalance) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_WithdrawRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "side.btcbridge.RuneBalance.id":
-		value := x.Id
+	case "side.btcbridge.WithdrawRequest.address":
+		value := x.Address
 		return protoreflect.ValueOfString(value)
-	case "side.btcbridge.RuneBalance.amount":
+	case "side.btcbridge.WithdrawRequest.amount":
 		value := x.Amount
 		return protoreflect.ValueOfString(value)
+	case "side.btcbridge.WithdrawRequest.sequence":
+		value := x.Sequence
+		return protoreflect.ValueOfUint64(value)
+	case "side.btcbridge.WithdrawRequest.txid":
+		value := x.Txid
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.RuneBalance"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.WithdrawRequest"))
 		}
-		panic(fmt.Errorf("message side.btcbridge.RuneBalance does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message side.btcbridge.WithdrawRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -3743,17 +3428,21 @@ func (x *fastReflection_RuneBalance) Get(descriptor protoreflect.FieldDescriptor
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RuneBalance) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_WithdrawRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "side.btcbridge.RuneBalance.id":
-		x.Id = value.Interface().(string)
-	case "side.btcbridge.RuneBalance.amount":
+	case "side.btcbridge.WithdrawRequest.address":
+		x.Address = value.Interface().(string)
+	case "side.btcbridge.WithdrawRequest.amount":
 		x.Amount = value.Interface().(string)
+	case "side.btcbridge.WithdrawRequest.sequence":
+		x.Sequence = value.Uint()
+	case "side.btcbridge.WithdrawRequest.txid":
+		x.Txid = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.RuneBalance"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.WithdrawRequest"))
 		}
-		panic(fmt.Errorf("message side.btcbridge.RuneBalance does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message side.btcbridge.WithdrawRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3767,44 +3456,52 @@ func (x *fastReflection_RuneBalance) Set(fd protoreflect.FieldDescriptor, value
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RuneBalance) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_WithdrawRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "side.btcbridge.RuneBalance.id":
-		panic(fmt.Errorf("field id of message side.btcbridge.RuneBalance is not mutable"))
-	case "side.btcbridge.RuneBalance.amount":
-		panic(fmt.Errorf("field amount of message side.btcbridge.RuneBalance is not mutable"))
+	case "side.btcbridge.WithdrawRequest.address":
+		panic(fmt.Errorf("field address of message side.btcbridge.WithdrawRequest is not mutable"))
+	case "side.btcbridge.WithdrawRequest.amount":
+		panic(fmt.Errorf("field amount of message side.btcbridge.WithdrawRequest is not mutable"))
+	case "side.btcbridge.WithdrawRequest.sequence":
+		panic(fmt.Errorf("field sequence of message side.btcbridge.WithdrawRequest is not mutable"))
+	case "side.btcbridge.WithdrawRequest.txid":
+		panic(fmt.Errorf("field txid of message side.btcbridge.WithdrawRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.RuneBalance"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.WithdrawRequest"))
 		}
-		panic(fmt.Errorf("message side.btcbridge.RuneBalance does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message side.btcbridge.WithdrawRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_RuneBalance) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_WithdrawRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "side.btcbridge.RuneBalance.id":
+	case "side.btcbridge.WithdrawRequest.address":
 		return protoreflect.ValueOfString("")
-	case "side.btcbridge.RuneBalance.amount":
+	case "side.btcbridge.WithdrawRequest.amount":
+		return protoreflect.ValueOfString("")
+	case "side.btcbridge.WithdrawRequest.sequence":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "side.btcbridge.WithdrawRequest.txid":
 		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.RuneBalance"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.WithdrawRequest"))
 		}
-		panic(fmt.Errorf("message side.btcbridge.RuneBalance does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message side.btcbridge.WithdrawRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_RuneBalance) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_WithdrawRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in side.btcbridge.RuneBalance", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in side.btcbridge.WithdrawRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3812,7 +3509,7 @@ func (x *fastReflection_RuneBalance) WhichOneof(d protoreflect.OneofDescriptor)
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_RuneBalance) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_WithdrawRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3823,7 +3520,7 @@ func (x *fastReflection_RuneBalance) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RuneBalance) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_WithdrawRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3835,7 +3532,7 @@ func (x *fastReflection_RuneBalance) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_RuneBalance) IsValid() bool {
+func (x *fastReflection_WithdrawRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -3845,9 +3542,9 @@ func (x *fastReflection_RuneBalance) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_RuneBalance) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_WithdrawRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*RuneBalance)
+		x := input.Message.Interface().(*WithdrawRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3859,7 +3556,7 @@ func (x *fastReflection_RuneBalance) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.Id)
+		l = len(x.Address)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
@@ -3867,6 +3564,13 @@ func (x *fastReflection_RuneBalance) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.Sequence != 0 {
+			n += 1 + runtime.Sov(uint64(x.Sequence))
+		}
+		l = len(x.Txid)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -3877,7 +3581,7 @@ func (x *fastReflection_RuneBalance) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*RuneBalance)
+		x := input.Message.Interface().(*WithdrawRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3896,6 +3600,18 @@ func (x *fastReflection_RuneBalance) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.Txid) > 0 {
+			i -= len(x.Txid)
+			copy(dAtA[i:], x.Txid)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Txid)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.Sequence != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Sequence))
+			i--
+			dAtA[i] = 0x18
+		}
 		if len(x.Amount) > 0 {
 			i -= len(x.Amount)
 			copy(dAtA[i:], x.Amount)
@@ -3903,10 +3619,10 @@ func (x *fastReflection_RuneBalance) ProtoMethods() *protoiface.Methods {
 			i--
 			dAtA[i] = 0x12
 		}
-		if len(x.Id) > 0 {
-			i -= len(x.Id)
-			copy(dAtA[i:], x.Id)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Id)))
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -3921,7 +3637,7 @@ func (x *fastReflection_RuneBalance) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*RuneBalance)
+		x := input.Message.Interface().(*WithdrawRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3953,15 +3669,15 @@ func (x *fastReflection_RuneBalance) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RuneBalance: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: WithdrawRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RuneBalance: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: WithdrawRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -3989,7 +3705,7 @@ func (x *fastReflection_RuneBalance) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Id = string(dAtA[iNdEx:postIndex])
+				x.Address = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
@@ -4023,6 +3739,57 @@ func (x *fastReflection_RuneBalance) ProtoMethods() *protoiface.Methods {
 				}
 				x.Amount = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+				}
+				x.Sequence = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Sequence |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Txid", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Txid = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -4058,29 +3825,94 @@ func (x *fastReflection_RuneBalance) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var (
-	md_RuneId       protoreflect.MessageDescriptor
-	fd_RuneId_block protoreflect.FieldDescriptor
-	fd_RuneId_tx    protoreflect.FieldDescriptor
-)
+var _ protoreflect.List = (*_UTXO_8_list)(nil)
 
-func init() {
-	file_side_btcbridge_btcbridge_proto_init()
-	md_RuneId = File_side_btcbridge_btcbridge_proto.Messages().ByName("RuneId")
-	fd_RuneId_block = md_RuneId.Fields().ByName("block")
-	fd_RuneId_tx = md_RuneId.Fields().ByName("tx")
+type _UTXO_8_list struct {
+	list *[]*RuneBalance
 }
 
-var _ protoreflect.Message = (*fastReflection_RuneId)(nil)
-
-type fastReflection_RuneId RuneId
+func (x *_UTXO_8_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
 
-func (x *RuneId) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_RuneId)(x)
+func (x *_UTXO_8_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *RuneId) slowProtoReflect() protoreflect.Message {
-	mi := &file_side_btcbridge_btcbridge_proto_msgTypes[6]
+func (x *_UTXO_8_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*RuneBalance)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_UTXO_8_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*RuneBalance)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_UTXO_8_list) AppendMutable() protoreflect.Value {
+	v := new(RuneBalance)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_UTXO_8_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_UTXO_8_list) NewElement() protoreflect.Value {
+	v := new(RuneBalance)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_UTXO_8_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_UTXO                protoreflect.MessageDescriptor
+	fd_UTXO_txid           protoreflect.FieldDescriptor
+	fd_UTXO_vout           protoreflect.FieldDescriptor
+	fd_UTXO_address        protoreflect.FieldDescriptor
+	fd_UTXO_amount         protoreflect.FieldDescriptor
+	fd_UTXO_height         protoreflect.FieldDescriptor
+	fd_UTXO_pub_key_script protoreflect.FieldDescriptor
+	fd_UTXO_is_locked      protoreflect.FieldDescriptor
+	fd_UTXO_runes          protoreflect.FieldDescriptor
+	fd_UTXO_witness_script protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_side_btcbridge_btcbridge_proto_init()
+	md_UTXO = File_side_btcbridge_btcbridge_proto.Messages().ByName("UTXO")
+	fd_UTXO_txid = md_UTXO.Fields().ByName("txid")
+	fd_UTXO_vout = md_UTXO.Fields().ByName("vout")
+	fd_UTXO_address = md_UTXO.Fields().ByName("address")
+	fd_UTXO_amount = md_UTXO.Fields().ByName("amount")
+	fd_UTXO_height = md_UTXO.Fields().ByName("height")
+	fd_UTXO_pub_key_script = md_UTXO.Fields().ByName("pub_key_script")
+	fd_UTXO_is_locked = md_UTXO.Fields().ByName("is_locked")
+	fd_UTXO_runes = md_UTXO.Fields().ByName("runes")
+	fd_UTXO_witness_script = md_UTXO.Fields().ByName("witness_script")
+}
+
+var _ protoreflect.Message = (*fastReflection_UTXO)(nil)
+
+type fastReflection_UTXO UTXO
+
+func (x *UTXO) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_UTXO)(x)
+}
+
+func (x *UTXO) slowProtoReflect() protoreflect.Message {
+	mi := &file_side_btcbridge_btcbridge_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4091,43 +3923,43 @@ func (x *RuneId) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_RuneId_messageType fastReflection_RuneId_messageType
-var _ protoreflect.MessageType = fastReflection_RuneId_messageType{}
+var _fastReflection_UTXO_messageType fastReflection_UTXO_messageType
+var _ protoreflect.MessageType = fastReflection_UTXO_messageType{}
 
-type fastReflection_RuneId_messageType struct{}
+type fastReflection_UTXO_messageType struct{}
 
-func (x fastReflection_RuneId_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_RuneId)(nil)
+func (x fastReflection_UTXO_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_UTXO)(nil)
 }
-func (x fastReflection_RuneId_messageType) New() protoreflect.Message {
-	return new(fastReflection_RuneId)
+func (x fastReflection_UTXO_messageType) New() protoreflect.Message {
+	return new(fastReflection_UTXO)
 }
-func (x fastReflection_RuneId_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_RuneId
+func (x fastReflection_UTXO_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_UTXO
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_RuneId) Descriptor() protoreflect.MessageDescriptor {
-	return md_RuneId
+func (x *fastReflection_UTXO) Descriptor() protoreflect.MessageDescriptor {
+	return md_UTXO
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_RuneId) Type() protoreflect.MessageType {
-	return _fastReflection_RuneId_messageType
+func (x *fastReflection_UTXO) Type() protoreflect.MessageType {
+	return _fastReflection_UTXO_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_RuneId) New() protoreflect.Message {
-	return new(fastReflection_RuneId)
+func (x *fastReflection_UTXO) New() protoreflect.Message {
+	return new(fastReflection_UTXO)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_RuneId) Interface() protoreflect.ProtoMessage {
-	return (*RuneId)(x)
+func (x *fastReflection_UTXO) Interface() protoreflect.ProtoMessage {
+	return (*UTXO)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -4135,16 +3967,58 @@ func (x *fastReflection_RuneId) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_RuneId) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Block != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.Block)
-		if !f(fd_RuneId_block, value) {
+func (x *fastReflection_UTXO) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Txid != "" {
+		value := protoreflect.ValueOfString(x.Txid)
+		if !f(fd_UTXO_txid, value) {
 			return
 		}
 	}
-	if x.Tx != uint32(0) {
-		value := protoreflect.ValueOfUint32(x.Tx)
-		if !f(fd_RuneId_tx, value) {
+	if x.Vout != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Vout)
+		if !f(fd_UTXO_vout, value) {
+			return
+		}
+	}
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_UTXO_address, value) {
+			return
+		}
+	}
+	if x.Amount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Amount)
+		if !f(fd_UTXO_amount, value) {
+			return
+		}
+	}
+	if x.Height != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Height)
+		if !f(fd_UTXO_height, value) {
+			return
+		}
+	}
+	if len(x.PubKeyScript) != 0 {
+		value := protoreflect.ValueOfBytes(x.PubKeyScript)
+		if !f(fd_UTXO_pub_key_script, value) {
+			return
+		}
+	}
+	if x.IsLocked != false {
+		value := protoreflect.ValueOfBool(x.IsLocked)
+		if !f(fd_UTXO_is_locked, value) {
+			return
+		}
+	}
+	if len(x.Runes) != 0 {
+		value := protoreflect.ValueOfList(&_UTXO_8_list{list: &x.Runes})
+		if !f(fd_UTXO_runes, value) {
+			return
+		}
+	}
+	if len(x.WitnessScript) != 0 {
+		value := protoreflect.ValueOfBytes(x.WitnessScript)
+		if !f(fd_UTXO_witness_script, value) {
 			return
 		}
 	}
@@ -4161,17 +4035,31 @@ func (x *fastReflection_RuneId) Range(f func(protoreflect.FieldDescriptor, proto
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_RuneId) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_UTXO) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "side.btcbridge.RuneId.block":
-		return x.Block != uint64(0)
-	case "side.btcbridge.RuneId.tx":
-		return x.Tx != uint32(0)
+	case "side.btcbridge.UTXO.txid":
+		return x.Txid != ""
+	case "side.btcbridge.UTXO.vout":
+		return x.Vout != uint64(0)
+	case "side.btcbridge.UTXO.address":
+		return x.Address != ""
+	case "side.btcbridge.UTXO.amount":
+		return x.Amount != uint64(0)
+	case "side.btcbridge.UTXO.height":
+		return x.Height != uint64(0)
+	case "side.btcbridge.UTXO.pub_key_script":
+		return len(x.PubKeyScript) != 0
+	case "side.btcbridge.UTXO.is_locked":
+		return x.IsLocked != false
+	case "side.btcbridge.UTXO.runes":
+		return len(x.Runes) != 0
+	case "side.btcbridge.UTXO.witness_script":
+		return len(x.WitnessScript) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.RuneId"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.UTXO"))
 		}
-		panic(fmt.Errorf("message side.btcbridge.RuneId does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message side.btcbridge.UTXO does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4181,17 +4069,31 @@ func (x *fastReflection_RuneId) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RuneId) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_UTXO) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "side.btcbridge.RuneId.block":
-		x.Block = uint64(0)
-	case "side.btcbridge.RuneId.tx":
-		x.Tx = uint32(0)
+	case "side.btcbridge.UTXO.txid":
+		x.Txid = ""
+	case "side.btcbridge.UTXO.vout":
+		x.Vout = uint64(0)
+	case "side.btcbridge.UTXO.address":
+		x.Address = ""
+	case "side.btcbridge.UTXO.amount":
+		x.Amount = uint64(0)
+	case "side.btcbridge.UTXO.height":
+		x.Height = uint64(0)
+	case "side.btcbridge.UTXO.pub_key_script":
+		x.PubKeyScript = nil
+	case "side.btcbridge.UTXO.is_locked":
+		x.IsLocked = false
+	case "side.btcbridge.UTXO.runes":
+		x.Runes = nil
+	case "side.btcbridge.UTXO.witness_script":
+		x.WitnessScript = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.RuneId"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.UTXO"))
 		}
-		panic(fmt.Errorf("message side.btcbridge.RuneId does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message side.btcbridge.UTXO does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4201,19 +4103,43 @@ func (x *fastReflection_RuneId) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_RuneId) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_UTXO) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "side.btcbridge.RuneId.block":
-		value := x.Block
+	case "side.btcbridge.UTXO.txid":
+		value := x.Txid
+		return protoreflect.ValueOfString(value)
+	case "side.btcbridge.UTXO.vout":
+		value := x.Vout
 		return protoreflect.ValueOfUint64(value)
-	case "side.btcbridge.RuneId.tx":
-		value := x.Tx
-		return protoreflect.ValueOfUint32(value)
+	case "side.btcbridge.UTXO.address":
+		value := x.Address
+		return protoreflect.ValueOfString(value)
+	case "side.btcbridge.UTXO.amount":
+		value := x.Amount
+		return protoreflect.ValueOfUint64(value)
+	case "side.btcbridge.UTXO.height":
+		value := x.Height
+		return protoreflect.ValueOfUint64(value)
+	case "side.btcbridge.UTXO.pub_key_script":
+		value := x.PubKeyScript
+		return protoreflect.ValueOfBytes(value)
+	case "side.btcbridge.UTXO.is_locked":
+		value := x.IsLocked
+		return protoreflect.ValueOfBool(value)
+	case "side.btcbridge.UTXO.runes":
+		if len(x.Runes) == 0 {
+			return protoreflect.ValueOfList(&_UTXO_8_list{})
+		}
+		listValue := &_UTXO_8_list{list: &x.Runes}
+		return protoreflect.ValueOfList(listValue)
+	case "side.btcbridge.UTXO.witness_script":
+		value := x.WitnessScript
+		return protoreflect.ValueOfBytes(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.RuneId"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.UTXO"))
 		}
-		panic(fmt.Errorf("message side.btcbridge.RuneId does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message side.btcbridge.UTXO does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4227,17 +4153,33 @@ func (x *fastReflection_RuneId) Get(descriptor protoreflect.FieldDescriptor) pro
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RuneId) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_UTXO) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "side.btcbridge.RuneId.block":
-		x.Block = value.Uint()
-	case "side.btcbridge.RuneId.tx":
-		x.Tx = uint32(value.Uint())
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.RuneId"))
-		}
-		panic(fmt.Errorf("message side.btcbridge.RuneId does not contain field %s", fd.FullName()))
+	case "side.btcbridge.UTXO.txid":
+		x.Txid = value.Interface().(string)
+	case "side.btcbridge.UTXO.vout":
+		x.Vout = value.Uint()
+	case "side.btcbridge.UTXO.address":
+		x.Address = value.Interface().(string)
+	case "side.btcbridge.UTXO.amount":
+		x.Amount = value.Uint()
+	case "side.btcbridge.UTXO.height":
+		x.Height = value.Uint()
+	case "side.btcbridge.UTXO.pub_key_script":
+		x.PubKeyScript = value.Bytes()
+	case "side.btcbridge.UTXO.is_locked":
+		x.IsLocked = value.Bool()
+	case "side.btcbridge.UTXO.runes":
+		lv := value.List()
+		clv := lv.(*_UTXO_8_list)
+		x.Runes = *clv.list
+	case "side.btcbridge.UTXO.witness_script":
+		x.WitnessScript = value.Bytes()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.UTXO"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.UTXO does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4251,44 +4193,77 @@ func (x *fastReflection_RuneId) Set(fd protoreflect.FieldDescriptor, value proto
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RuneId) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_UTXO) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "side.btcbridge.RuneId.block":
-		panic(fmt.Errorf("field block of message side.btcbridge.RuneId is not mutable"))
-	case "side.btcbridge.RuneId.tx":
-		panic(fmt.Errorf("field tx of message side.btcbridge.RuneId is not mutable"))
+	case "side.btcbridge.UTXO.runes":
+		if x.Runes == nil {
+			x.Runes = []*RuneBalance{}
+		}
+		value := &_UTXO_8_list{list: &x.Runes}
+		return protoreflect.ValueOfList(value)
+	case "side.btcbridge.UTXO.txid":
+		panic(fmt.Errorf("field txid of message side.btcbridge.UTXO is not mutable"))
+	case "side.btcbridge.UTXO.vout":
+		panic(fmt.Errorf("field vout of message side.btcbridge.UTXO is not mutable"))
+	case "side.btcbridge.UTXO.address":
+		panic(fmt.Errorf("field address of message side.btcbridge.UTXO is not mutable"))
+	case "side.btcbridge.UTXO.amount":
+		panic(fmt.Errorf("field amount of message side.btcbridge.UTXO is not mutable"))
+	case "side.btcbridge.UTXO.height":
+		panic(fmt.Errorf("field height of message side.btcbridge.UTXO is not mutable"))
+	case "side.btcbridge.UTXO.pub_key_script":
+		panic(fmt.Errorf("field pub_key_script of message side.btcbridge.UTXO is not mutable"))
+	case "side.btcbridge.UTXO.is_locked":
+		panic(fmt.Errorf("field is_locked of message side.btcbridge.UTXO is not mutable"))
+	case "side.btcbridge.UTXO.witness_script":
+		panic(fmt.Errorf("field witness_script of message side.btcbridge.UTXO is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.RuneId"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.UTXO"))
 		}
-		panic(fmt.Errorf("message side.btcbridge.RuneId does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message side.btcbridge.UTXO does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_RuneId) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_UTXO) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "side.btcbridge.RuneId.block":
+	case "side.btcbridge.UTXO.txid":
+		return protoreflect.ValueOfString("")
+	case "side.btcbridge.UTXO.vout":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "side.btcbridge.RuneId.tx":
-		return protoreflect.ValueOfUint32(uint32(0))
+	case "side.btcbridge.UTXO.address":
+		return protoreflect.ValueOfString("")
+	case "side.btcbridge.UTXO.amount":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "side.btcbridge.UTXO.height":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "side.btcbridge.UTXO.pub_key_script":
+		return protoreflect.ValueOfBytes(nil)
+	case "side.btcbridge.UTXO.is_locked":
+		return protoreflect.ValueOfBool(false)
+	case "side.btcbridge.UTXO.runes":
+		list := []*RuneBalance{}
+		return protoreflect.ValueOfList(&_UTXO_8_list{list: &list})
+	case "side.btcbridge.UTXO.witness_script":
+		return protoreflect.ValueOfBytes(nil)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.RuneId"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.UTXO"))
 		}
-		panic(fmt.Errorf("message side.btcbridge.RuneId does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message side.btcbridge.UTXO does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_RuneId) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_UTXO) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in side.btcbridge.RuneId", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in side.btcbridge.UTXO", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4296,7 +4271,7 @@ func (x *fastReflection_RuneId) WhichOneof(d protoreflect.OneofDescriptor) proto
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_RuneId) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_UTXO) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4307,7 +4282,7 @@ func (x *fastReflection_RuneId) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RuneId) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_UTXO) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -4319,7 +4294,7 @@ func (x *fastReflection_RuneId) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_RuneId) IsValid() bool {
+func (x *fastReflection_UTXO) IsValid() bool {
 	return x != nil
 }
 
@@ -4329,9 +4304,9 @@ func (x *fastReflection_RuneId) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_RuneId) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_UTXO) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*RuneId)
+		x := input.Message.Interface().(*UTXO)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4343,11 +4318,39 @@ func (x *fastReflection_RuneId) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.Block != 0 {
-			n += 1 + runtime.Sov(uint64(x.Block))
+		l = len(x.Txid)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.Tx != 0 {
-			n += 1 + runtime.Sov(uint64(x.Tx))
+		if x.Vout != 0 {
+			n += 1 + runtime.Sov(uint64(x.Vout))
+		}
+		l = len(x.Address)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Amount != 0 {
+			n += 1 + runtime.Sov(uint64(x.Amount))
+		}
+		if x.Height != 0 {
+			n += 1 + runtime.Sov(uint64(x.Height))
+		}
+		l = len(x.PubKeyScript)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.IsLocked {
+			n += 2
+		}
+		if len(x.Runes) > 0 {
+			for _, e := range x.Runes {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.WitnessScript)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -4359,7 +4362,7 @@ func (x *fastReflection_RuneId) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*RuneId)
+		x := input.Message.Interface().(*UTXO)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4378,15 +4381,74 @@ func (x *fastReflection_RuneId) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Tx != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Tx))
+		if len(x.WitnessScript) > 0 {
+			i -= len(x.WitnessScript)
+			copy(dAtA[i:], x.WitnessScript)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.WitnessScript)))
+			i--
+			dAtA[i] = 0x4a
+		}
+		if len(x.Runes) > 0 {
+			for iNdEx := len(x.Runes) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Runes[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x42
+			}
+		}
+		if x.IsLocked {
+			i--
+			if x.IsLocked {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x38
+		}
+		if len(x.PubKeyScript) > 0 {
+			i -= len(x.PubKeyScript)
+			copy(dAtA[i:], x.PubKeyScript)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PubKeyScript)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.Height != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.Amount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Amount))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.Vout != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Vout))
 			i--
 			dAtA[i] = 0x10
 		}
-		if x.Block != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Block))
+		if len(x.Txid) > 0 {
+			i -= len(x.Txid)
+			copy(dAtA[i:], x.Txid)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Txid)))
 			i--
-			dAtA[i] = 0x8
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -4399,7 +4461,7 @@ func (x *fastReflection_RuneId) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*RuneId)
+		x := input.Message.Interface().(*UTXO)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4431,17 +4493,17 @@ func (x *fastReflection_RuneId) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RuneId: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: UTXO: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RuneId: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: UTXO: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Txid", wireType)
 				}
-				x.Block = 0
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -4451,16 +4513,29 @@ func (x *fastReflection_RuneId) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.Block |= uint64(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Txid = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			case 2:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Tx", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Vout", wireType)
 				}
-				x.Tx = 0
+				x.Vout = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -4470,22 +4545,214 @@ func (x *fastReflection_RuneId) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.Tx |= uint32(b&0x7F) << shift
+					x.Vout |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if (iNdEx + skippy) > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Address = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				}
+				x.Amount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Amount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				}
+				x.Height = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Height |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PubKeyScript", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PubKeyScript = append(x.PubKeyScript[:0], dAtA[iNdEx:postIndex]...)
+				if x.PubKeyScript == nil {
+					x.PubKeyScript = []byte{}
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IsLocked", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.IsLocked = bool(v != 0)
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Runes", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Runes = append(x.Runes, &RuneBalance{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Runes[len(x.Runes)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WitnessScript", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.WitnessScript = append(x.WitnessScript[:0], dAtA[iNdEx:postIndex]...)
+				if x.WitnessScript == nil {
+					x.WitnessScript = []byte{}
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
 				if !options.DiscardUnknown {
 					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
@@ -4511,29 +4778,27 @@ func (x *fastReflection_RuneId) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_Edict        protoreflect.MessageDescriptor
-	fd_Edict_id     protoreflect.FieldDescriptor
-	fd_Edict_amount protoreflect.FieldDescriptor
-	fd_Edict_output protoreflect.FieldDescriptor
+	md_RuneBalance        protoreflect.MessageDescriptor
+	fd_RuneBalance_id     protoreflect.FieldDescriptor
+	fd_RuneBalance_amount protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_side_btcbridge_btcbridge_proto_init()
-	md_Edict = File_side_btcbridge_btcbridge_proto.Messages().ByName("Edict")
-	fd_Edict_id = md_Edict.Fields().ByName("id")
-	fd_Edict_amount = md_Edict.Fields().ByName("amount")
-	fd_Edict_output = md_Edict.Fields().ByName("output")
+	md_RuneBalance = File_side_btcbridge_btcbridge_proto.Messages().ByName("RuneBalance")
+	fd_RuneBalance_id = md_RuneBalance.Fields().ByName("id")
+	fd_RuneBalance_amount = md_RuneBalance.Fields().ByName("amount")
 }
 
-var _ protoreflect.Message = (*fastReflection_Edict)(nil)
+var _ protoreflect.Message = (*fastReflection_RuneBalance)(nil)
 
-type fastReflection_Edict Edict
+type fastReflection_RuneBalance RuneBalance
 
-func (x *Edict) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_Edict)(x)
+func (x *RuneBalance) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_RuneBalance)(x)
 }
 
-func (x *Edict) slowProtoReflect() protoreflect.Message {
+func (x *RuneBalance) slowProtoReflect() protoreflect.Message {
 	mi := &file_side_btcbridge_btcbridge_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4545,43 +4810,43 @@ func (x *Edict) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_Edict_messageType fastReflection_Edict_messageType
-var _ protoreflect.MessageType = fastReflection_Edict_messageType{}
+var _fastReflection_RuneBalance_messageType fastReflection_RuneBalance_messageType
+var _ protoreflect.MessageType = fastReflection_RuneBalance_messageType{}
 
-type fastReflection_Edict_messageType struct{}
+type fastReflection_RuneBalance_messageType struct{}
 
-func (x fastReflection_Edict_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_Edict)(nil)
+func (x fastReflection_RuneBalance_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_RuneBalance)(nil)
 }
-func (x fastReflection_Edict_messageType) New() protoreflect.Message {
-	return new(fastReflection_Edict)
+func (x fastReflection_RuneBalance_messageType) New() protoreflect.Message {
+	return new(fastReflection_RuneBalance)
 }
-func (x fastReflection_Edict_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_Edict
+func (x fastReflection_RuneBalance_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_RuneBalance
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_Edict) Descriptor() protoreflect.MessageDescriptor {
-	return md_Edict
+func (x *fastReflection_RuneBalance) Descriptor() protoreflect.MessageDescriptor {
+	return md_RuneBalance
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_Edict) Type() protoreflect.MessageType {
-	return _fastReflection_Edict_messageType
+func (x *fastReflection_RuneBalance) Type() protoreflect.MessageType {
+	return _fastReflection_RuneBalance_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_Edict) New() protoreflect.Message {
-	return new(fastReflection_Edict)
+func (x *fastReflection_RuneBalance) New() protoreflect.Message {
+	return new(fastReflection_RuneBalance)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_Edict) Interface() protoreflect.ProtoMessage {
-	return (*Edict)(x)
+func (x *fastReflection_RuneBalance) Interface() protoreflect.ProtoMessage {
+	return (*RuneBalance)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -4589,22 +4854,16 @@ func (x *fastReflection_Edict) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_Edict) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Id != nil {
-		value := protoreflect.ValueOfMessage(x.Id.ProtoReflect())
-		if !f(fd_Edict_id, value) {
+func (x *fastReflection_RuneBalance) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != "" {
+		value := protoreflect.ValueOfString(x.Id)
+		if !f(fd_RuneBalance_id, value) {
 			return
 		}
 	}
 	if x.Amount != "" {
 		value := protoreflect.ValueOfString(x.Amount)
-		if !f(fd_Edict_amount, value) {
-			return
-		}
-	}
-	if x.Output != uint32(0) {
-		value := protoreflect.ValueOfUint32(x.Output)
-		if !f(fd_Edict_output, value) {
+		if !f(fd_RuneBalance_amount, value) {
 			return
 		}
 	}
@@ -4621,19 +4880,17 @@ func (x *fastReflection_Edict) Range(f func(protoreflect.FieldDescriptor, protor
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_Edict) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_RuneBalance) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "side.btcbridge.Edict.id":
-		return x.Id != nil
-	case "side.btcbridge.Edict.amount":
-		return x.Amount != ""
-	case "side.btcbridge.Edict.output":
-		return x.Output != uint32(0)
+	case "side.btcbridge.RuneBalance.id":
+		return x.Id != ""
+	case "side.btcbridge.RuneBalance.amount":
+		return x.Amount != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.Edict"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.RuneBalance"))
 		}
-		panic(fmt.Errorf("message side.btcbridge.Edict does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message side.btcbridge.RuneBalance does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4643,19 +4900,17 @@ func (x *fastReflection_Edict) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Edict) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_RuneBalance) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "side.btcbridge.Edict.id":
-		x.Id = nil
-	case "side.btcbridge.Edict.amount":
+	case "side.btcbridge.RuneBalance.id":
+		x.Id = ""
+	case "side.btcbridge.RuneBalance.amount":
 		x.Amount = ""
-	case "side.btcbridge.Edict.output":
-		x.Output = uint32(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.Edict"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.RuneBalance"))
 		}
-		panic(fmt.Errorf("message side.btcbridge.Edict does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message side.btcbridge.RuneBalance does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4665,22 +4920,19 @@ func (x *fastReflection_Edict) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_Edict) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_RuneBalance) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "side.btcbridge.Edict.id":
+	case "side.btcbridge.RuneBalance.id":
 		value := x.Id
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "side.btcbridge.Edict.amount":
+		return protoreflect.ValueOfString(value)
+	case "side.btcbridge.RuneBalance.amount":
 		value := x.Amount
 		return protoreflect.ValueOfString(value)
-	case "side.btcbridge.Edict.output":
-		value := x.Output
-		return protoreflect.ValueOfUint32(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.Edict"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.RuneBalance"))
 		}
-		panic(fmt.Errorf("message side.btcbridge.Edict does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message side.btcbridge.RuneBalance does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4694,19 +4946,17 @@ func (x *fastReflection_Edict) Get(descriptor protoreflect.FieldDescriptor) prot
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Edict) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_RuneBalance) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "side.btcbridge.Edict.id":
-		x.Id = value.Message().Interface().(*RuneId)
-	case "side.btcbridge.Edict.amount":
+	case "side.btcbridge.RuneBalance.id":
+		x.Id = value.Interface().(string)
+	case "side.btcbridge.RuneBalance.amount":
 		x.Amount = value.Interface().(string)
-	case "side.btcbridge.Edict.output":
-		x.Output = uint32(value.Uint())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.Edict"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.RuneBalance"))
 		}
-		panic(fmt.Errorf("message side.btcbridge.Edict does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message side.btcbridge.RuneBalance does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4720,52 +4970,44 @@ func (x *fastReflection_Edict) Set(fd protoreflect.FieldDescriptor, value protor
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Edict) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_RuneBalance) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "side.btcbridge.Edict.id":
-		if x.Id == nil {
-			x.Id = new(RuneId)
-		}
-		return protoreflect.ValueOfMessage(x.Id.ProtoReflect())
-	case "side.btcbridge.Edict.amount":
-		panic(fmt.Errorf("field amount of message side.btcbridge.Edict is not mutable"))
-	case "side.btcbridge.Edict.output":
-		panic(fmt.Errorf("field output of message side.btcbridge.Edict is not mutable"))
+	case "side.btcbridge.RuneBalance.id":
+		panic(fmt.Errorf("field id of message side.btcbridge.RuneBalance is not mutable"))
+	case "side.btcbridge.RuneBalance.amount":
+		panic(fmt.Errorf("field amount of message side.btcbridge.RuneBalance is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.Edict"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.RuneBalance"))
 		}
-		panic(fmt.Errorf("message side.btcbridge.Edict does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message side.btcbridge.RuneBalance does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_Edict) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_RuneBalance) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "side.btcbridge.Edict.id":
-		m := new(RuneId)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "side.btcbridge.Edict.amount":
+	case "side.btcbridge.RuneBalance.id":
+		return protoreflect.ValueOfString("")
+	case "side.btcbridge.RuneBalance.amount":
 		return protoreflect.ValueOfString("")
-	case "side.btcbridge.Edict.output":
-		return protoreflect.ValueOfUint32(uint32(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.Edict"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.RuneBalance"))
 		}
-		panic(fmt.Errorf("message side.btcbridge.Edict does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message side.btcbridge.RuneBalance does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_Edict) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_RuneBalance) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in side.btcbridge.Edict", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in side.btcbridge.RuneBalance", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4773,7 +5015,7 @@ func (x *fastReflection_Edict) WhichOneof(d protoreflect.OneofDescriptor) protor
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_Edict) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_RuneBalance) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4784,7 +5026,7 @@ func (x *fastReflection_Edict) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Edict) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_RuneBalance) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -4796,7 +5038,7 @@ func (x *fastReflection_Edict) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_Edict) IsValid() bool {
+func (x *fastReflection_RuneBalance) IsValid() bool {
 	return x != nil
 }
 
@@ -4806,9 +5048,9 @@ func (x *fastReflection_Edict) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_Edict) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_RuneBalance) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*Edict)
+		x := input.Message.Interface().(*RuneBalance)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4820,17 +5062,14 @@ func (x *fastReflection_Edict) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.Id != nil {
-			l = options.Size(x.Id)
+		l = len(x.Id)
+		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		l = len(x.Amount)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.Output != 0 {
-			n += 1 + runtime.Sov(uint64(x.Output))
-		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -4841,7 +5080,7 @@ func (x *fastReflection_Edict) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*Edict)
+		x := input.Message.Interface().(*RuneBalance)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4860,11 +5099,6 @@ func (x *fastReflection_Edict) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Output != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Output))
-			i--
-			dAtA[i] = 0x18
-		}
 		if len(x.Amount) > 0 {
 			i -= len(x.Amount)
 			copy(dAtA[i:], x.Amount)
@@ -4872,17 +5106,10 @@ func (x *fastReflection_Edict) ProtoMethods() *protoiface.Methods {
 			i--
 			dAtA[i] = 0x12
 		}
-		if x.Id != nil {
-			encoded, err := options.Marshal(x.Id)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+		if len(x.Id) > 0 {
+			i -= len(x.Id)
+			copy(dAtA[i:], x.Id)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Id)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -4897,7 +5124,7 @@ func (x *fastReflection_Edict) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*Edict)
+		x := input.Message.Interface().(*RuneBalance)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4929,17 +5156,17 @@ func (x *fastReflection_Edict) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Edict: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RuneBalance: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Edict: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RuneBalance: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -4949,27 +5176,23 @@ func (x *fastReflection_Edict) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Id == nil {
-					x.Id = &RuneId{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Id); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
+				x.Id = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
@@ -5003,25 +5226,6 @@ func (x *fastReflection_Edict) ProtoMethods() *protoiface.Methods {
 				}
 				x.Amount = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 3:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Output", wireType)
-				}
-				x.Output = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.Output |= uint32(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -5058,27 +5262,27 @@ func (x *fastReflection_Edict) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_BtcConsolidation                  protoreflect.MessageDescriptor
-	fd_BtcConsolidation_target_threshold protoreflect.FieldDescriptor
-	fd_BtcConsolidation_max_num          protoreflect.FieldDescriptor
+	md_RuneId       protoreflect.MessageDescriptor
+	fd_RuneId_block protoreflect.FieldDescriptor
+	fd_RuneId_tx    protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_side_btcbridge_btcbridge_proto_init()
-	md_BtcConsolidation = File_side_btcbridge_btcbridge_proto.Messages().ByName("BtcConsolidation")
-	fd_BtcConsolidation_target_threshold = md_BtcConsolidation.Fields().ByName("target_threshold")
-	fd_BtcConsolidation_max_num = md_BtcConsolidation.Fields().ByName("max_num")
+	md_RuneId = File_side_btcbridge_btcbridge_proto.Messages().ByName("RuneId")
+	fd_RuneId_block = md_RuneId.Fields().ByName("block")
+	fd_RuneId_tx = md_RuneId.Fields().ByName("tx")
 }
 
-var _ protoreflect.Message = (*fastReflection_BtcConsolidation)(nil)
+var _ protoreflect.Message = (*fastReflection_RuneId)(nil)
 
-type fastReflection_BtcConsolidation BtcConsolidation
+type fastReflection_RuneId RuneId
 
-func (x *BtcConsolidation) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_BtcConsolidation)(x)
+func (x *RuneId) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_RuneId)(x)
 }
 
-func (x *BtcConsolidation) slowProtoReflect() protoreflect.Message {
+func (x *RuneId) slowProtoReflect() protoreflect.Message {
 	mi := &file_side_btcbridge_btcbridge_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5090,43 +5294,43 @@ func (x *BtcConsolidation) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_BtcConsolidation_messageType fastReflection_BtcConsolidation_messageType
-var _ protoreflect.MessageType = fastReflection_BtcConsolidation_messageType{}
+var _fastReflection_RuneId_messageType fastReflection_RuneId_messageType
+var _ protoreflect.MessageType = fastReflection_RuneId_messageType{}
 
-type fastReflection_BtcConsolidation_messageType struct{}
+type fastReflection_RuneId_messageType struct{}
 
-func (x fastReflection_BtcConsolidation_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_BtcConsolidation)(nil)
+func (x fastReflection_RuneId_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_RuneId)(nil)
 }
-func (x fastReflection_BtcConsolidation_messageType) New() protoreflect.Message {
-	return new(fastReflection_BtcConsolidation)
+func (x fastReflection_RuneId_messageType) New() protoreflect.Message {
+	return new(fastReflection_RuneId)
 }
-func (x fastReflection_BtcConsolidation_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_BtcConsolidation
+func (x fastReflection_RuneId_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_RuneId
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_BtcConsolidation) Descriptor() protoreflect.MessageDescriptor {
-	return md_BtcConsolidation
+func (x *fastReflection_RuneId) Descriptor() protoreflect.MessageDescriptor {
+	return md_RuneId
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_BtcConsolidation) Type() protoreflect.MessageType {
-	return _fastReflection_BtcConsolidation_messageType
+func (x *fastReflection_RuneId) Type() protoreflect.MessageType {
+	return _fastReflection_RuneId_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_BtcConsolidation) New() protoreflect.Message {
-	return new(fastReflection_BtcConsolidation)
+func (x *fastReflection_RuneId) New() protoreflect.Message {
+	return new(fastReflection_RuneId)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_BtcConsolidation) Interface() protoreflect.ProtoMessage {
-	return (*BtcConsolidation)(x)
+func (x *fastReflection_RuneId) Interface() protoreflect.ProtoMessage {
+	return (*RuneId)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -5134,16 +5338,16 @@ func (x *fastReflection_BtcConsolidation) Interface() protoreflect.ProtoMessage
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_BtcConsolidation) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.TargetThreshold != int64(0) {
-		value := protoreflect.ValueOfInt64(x.TargetThreshold)
-		if !f(fd_BtcConsolidation_target_threshold, value) {
+func (x *fastReflection_RuneId) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Block != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Block)
+		if !f(fd_RuneId_block, value) {
 			return
 		}
 	}
-	if x.MaxNum != uint32(0) {
-		value := protoreflect.ValueOfUint32(x.MaxNum)
-		if !f(fd_BtcConsolidation_max_num, value) {
+	if x.Tx != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Tx)
+		if !f(fd_RuneId_tx, value) {
 			return
 		}
 	}
@@ -5160,17 +5364,17 @@ func (x *fastReflection_BtcConsolidation) Range(f func(protoreflect.FieldDescrip
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_BtcConsolidation) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_RuneId) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "side.btcbridge.BtcConsolidation.target_threshold":
-		return x.TargetThreshold != int64(0)
-	case "side.btcbridge.BtcConsolidation.max_num":
-		return x.MaxNum != uint32(0)
+	case "side.btcbridge.RuneId.block":
+		return x.Block != uint64(0)
+	case "side.btcbridge.RuneId.tx":
+		return x.Tx != uint32(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.BtcConsolidation"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.RuneId"))
 		}
-		panic(fmt.Errorf("message side.btcbridge.BtcConsolidation does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message side.btcbridge.RuneId does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5180,17 +5384,17 @@ func (x *fastReflection_BtcConsolidation) Has(fd protoreflect.FieldDescriptor) b
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_BtcConsolidation) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_RuneId) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "side.btcbridge.BtcConsolidation.target_threshold":
-		x.TargetThreshold = int64(0)
-	case "side.btcbridge.BtcConsolidation.max_num":
-		x.MaxNum = uint32(0)
+	case "side.btcbridge.RuneId.block":
+		x.Block = uint64(0)
+	case "side.btcbridge.RuneId.tx":
+		x.Tx = uint32(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.BtcConsolidation"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.RuneId"))
 		}
-		panic(fmt.Errorf("message side.btcbridge.BtcConsolidation does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message side.btcbridge.RuneId does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5200,19 +5404,19 @@ func (x *fastReflection_BtcConsolidation) Clear(fd protoreflect.FieldDescriptor)
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_BtcConsolidation) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_RuneId) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "side.btcbridge.BtcConsolidation.target_threshold":
-		value := x.TargetThreshold
-		return protoreflect.ValueOfInt64(value)
-	case "side.btcbridge.BtcConsolidation.max_num":
-		value := x.MaxNum
+	case "side.btcbridge.RuneId.block":
+		value := x.Block
+		return protoreflect.ValueOfUint64(value)
+	case "side.btcbridge.RuneId.tx":
+		value := x.Tx
 		return protoreflect.ValueOfUint32(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.BtcConsolidation"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.RuneId"))
 		}
-		panic(fmt.Errorf("message side.btcbridge.BtcConsolidation does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message side.btcbridge.RuneId does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -5226,17 +5430,17 @@ func (x *fastReflection_BtcConsolidation) Get(descriptor protoreflect.FieldDescr
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_BtcConsolidation) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_RuneId) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "side.btcbridge.BtcConsolidation.target_threshold":
-		x.TargetThreshold = value.Int()
-	case "side.btcbridge.BtcConsolidation.max_num":
-		x.MaxNum = uint32(value.Uint())
+	case "side.btcbridge.RuneId.block":
+		x.Block = value.Uint()
+	case "side.btcbridge.RuneId.tx":
+		x.Tx = uint32(value.Uint())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.BtcConsolidation"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.RuneId"))
 		}
-		panic(fmt.Errorf("message side.btcbridge.BtcConsolidation does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message side.btcbridge.RuneId does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5250,44 +5454,44 @@ func (x *fastReflection_BtcConsolidation) Set(fd protoreflect.FieldDescriptor, v
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_BtcConsolidation) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_RuneId) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "side.btcbridge.BtcConsolidation.target_threshold":
-		panic(fmt.Errorf("field target_threshold of message side.btcbridge.BtcConsolidation is not mutable"))
-	case "side.btcbridge.BtcConsolidation.max_num":
-		panic(fmt.Errorf("field max_num of message side.btcbridge.BtcConsolidation is not mutable"))
+	case "side.btcbridge.RuneId.block":
+		panic(fmt.Errorf("field block of message side.btcbridge.RuneId is not mutable"))
+	case "side.btcbridge.RuneId.tx":
+		panic(fmt.Errorf("field tx of message side.btcbridge.RuneId is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.BtcConsolidation"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.RuneId"))
 		}
-		panic(fmt.Errorf("message side.btcbridge.BtcConsolidation does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message side.btcbridge.RuneId does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_BtcConsolidation) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_RuneId) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "side.btcbridge.BtcConsolidation.target_threshold":
-		return protoreflect.ValueOfInt64(int64(0))
-	case "side.btcbridge.BtcConsolidation.max_num":
+	case "side.btcbridge.RuneId.block":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "side.btcbridge.RuneId.tx":
 		return protoreflect.ValueOfUint32(uint32(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.BtcConsolidation"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.RuneId"))
 		}
-		panic(fmt.Errorf("message side.btcbridge.BtcConsolidation does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message side.btcbridge.RuneId does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_BtcConsolidation) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_RuneId) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in side.btcbridge.BtcConsolidation", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in side.btcbridge.RuneId", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -5295,7 +5499,7 @@ func (x *fastReflection_BtcConsolidation) WhichOneof(d protoreflect.OneofDescrip
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_BtcConsolidation) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_RuneId) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -5306,7 +5510,7 @@ func (x *fastReflection_BtcConsolidation) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_BtcConsolidation) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_RuneId) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -5318,7 +5522,7 @@ func (x *fastReflection_BtcConsolidation) SetUnknown(fields protoreflect.RawFiel
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_BtcConsolidation) IsValid() bool {
+func (x *fastReflection_RuneId) IsValid() bool {
 	return x != nil
 }
 
@@ -5328,9 +5532,9 @@ func (x *fastReflection_BtcConsolidation) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_BtcConsolidation) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_RuneId) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*BtcConsolidation)
+		x := input.Message.Interface().(*RuneId)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5342,11 +5546,11 @@ func (x *fastReflection_BtcConsolidation) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.TargetThreshold != 0 {
-			n += 1 + runtime.Sov(uint64(x.TargetThreshold))
+		if x.Block != 0 {
+			n += 1 + runtime.Sov(uint64(x.Block))
 		}
-		if x.MaxNum != 0 {
-			n += 1 + runtime.Sov(uint64(x.MaxNum))
+		if x.Tx != 0 {
+			n += 1 + runtime.Sov(uint64(x.Tx))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -5358,7 +5562,7 @@ func (x *fastReflection_BtcConsolidation) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*BtcConsolidation)
+		x := input.Message.Interface().(*RuneId)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5377,13 +5581,13 @@ func (x *fastReflection_BtcConsolidation) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.MaxNum != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxNum))
+		if x.Tx != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Tx))
 			i--
 			dAtA[i] = 0x10
 		}
-		if x.TargetThreshold != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.TargetThreshold))
+		if x.Block != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Block))
 			i--
 			dAtA[i] = 0x8
 		}
@@ -5398,7 +5602,7 @@ func (x *fastReflection_BtcConsolidation) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*BtcConsolidation)
+		x := input.Message.Interface().(*RuneId)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5430,17 +5634,17 @@ func (x *fastReflection_BtcConsolidation) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BtcConsolidation: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RuneId: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BtcConsolidation: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RuneId: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TargetThreshold", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
 				}
-				x.TargetThreshold = 0
+				x.Block = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -5450,16 +5654,16 @@ func (x *fastReflection_BtcConsolidation) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.TargetThreshold |= int64(b&0x7F) << shift
+					x.Block |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
 			case 2:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxNum", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Tx", wireType)
 				}
-				x.MaxNum = 0
+				x.Tx = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -5469,7 +5673,7 @@ func (x *fastReflection_BtcConsolidation) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.MaxNum |= uint32(b&0x7F) << shift
+					x.Tx |= uint32(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
@@ -5510,29 +5714,29 @@ func (x *fastReflection_BtcConsolidation) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_RunesConsolidation                  protoreflect.MessageDescriptor
-	fd_RunesConsolidation_rune_id          protoreflect.FieldDescriptor
-	fd_RunesConsolidation_target_threshold protoreflect.FieldDescriptor
-	fd_RunesConsolidation_max_num          protoreflect.FieldDescriptor
+	md_Edict        protoreflect.MessageDescriptor
+	fd_Edict_id     protoreflect.FieldDescriptor
+	fd_Edict_amount protoreflect.FieldDescriptor
+	fd_Edict_output protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_side_btcbridge_btcbridge_proto_init()
-	md_RunesConsolidation = File_side_btcbridge_btcbridge_proto.Messages().ByName("RunesConsolidation")
-	fd_RunesConsolidation_rune_id = md_RunesConsolidation.Fields().ByName("rune_id")
-	fd_RunesConsolidation_target_threshold = md_RunesConsolidation.Fields().ByName("target_threshold")
-	fd_RunesConsolidation_max_num = md_RunesConsolidation.Fields().ByName("max_num")
+	md_Edict = File_side_btcbridge_btcbridge_proto.Messages().ByName("Edict")
+	fd_Edict_id = md_Edict.Fields().ByName("id")
+	fd_Edict_amount = md_Edict.Fields().ByName("amount")
+	fd_Edict_output = md_Edict.Fields().ByName("output")
 }
 
-var _ protoreflect.Message = (*fastReflection_RunesConsolidation)(nil)
+var _ protoreflect.Message = (*fastReflection_Edict)(nil)
 
-type fastReflection_RunesConsolidation RunesConsolidation
+type fastReflection_Edict Edict
 
-func (x *RunesConsolidation) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_RunesConsolidation)(x)
+func (x *Edict) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Edict)(x)
 }
 
-func (x *RunesConsolidation) slowProtoReflect() protoreflect.Message {
+func (x *Edict) slowProtoReflect() protoreflect.Message {
 	mi := &file_side_btcbridge_btcbridge_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5544,43 +5748,43 @@ func (x *RunesConsolidation) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_RunesConsolidation_messageType fastReflection_RunesConsolidation_messageType
-var _ protoreflect.MessageType = fastReflection_RunesConsolidation_messageType{}
+var _fastReflection_Edict_messageType fastReflection_Edict_messageType
+var _ protoreflect.MessageType = fastReflection_Edict_messageType{}
 
-type fastReflection_RunesConsolidation_messageType struct{}
+type fastReflection_Edict_messageType struct{}
 
-func (x fastReflection_RunesConsolidation_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_RunesConsolidation)(nil)
+func (x fastReflection_Edict_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Edict)(nil)
 }
-func (x fastReflection_RunesConsolidation_messageType) New() protoreflect.Message {
-	return new(fastReflection_RunesConsolidation)
+func (x fastReflection_Edict_messageType) New() protoreflect.Message {
+	return new(fastReflection_Edict)
 }
-func (x fastReflection_RunesConsolidation_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_RunesConsolidation
+func (x fastReflection_Edict_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Edict
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_RunesConsolidation) Descriptor() protoreflect.MessageDescriptor {
-	return md_RunesConsolidation
+func (x *fastReflection_Edict) Descriptor() protoreflect.MessageDescriptor {
+	return md_Edict
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_RunesConsolidation) Type() protoreflect.MessageType {
-	return _fastReflection_RunesConsolidation_messageType
+func (x *fastReflection_Edict) Type() protoreflect.MessageType {
+	return _fastReflection_Edict_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_RunesConsolidation) New() protoreflect.Message {
-	return new(fastReflection_RunesConsolidation)
+func (x *fastReflection_Edict) New() protoreflect.Message {
+	return new(fastReflection_Edict)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_RunesConsolidation) Interface() protoreflect.ProtoMessage {
-	return (*RunesConsolidation)(x)
+func (x *fastReflection_Edict) Interface() protoreflect.ProtoMessage {
+	return (*Edict)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -5588,22 +5792,22 @@ func (x *fastReflection_RunesConsolidation) Interface() protoreflect.ProtoMessag
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_RunesConsolidation) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.RuneId != "" {
-		value := protoreflect.ValueOfString(x.RuneId)
-		if !f(fd_RunesConsolidation_rune_id, value) {
+func (x *fastReflection_Edict) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != nil {
+		value := protoreflect.ValueOfMessage(x.Id.ProtoReflect())
+		if !f(fd_Edict_id, value) {
 			return
 		}
 	}
-	if x.TargetThreshold != "" {
-		value := protoreflect.ValueOfString(x.TargetThreshold)
-		if !f(fd_RunesConsolidation_target_threshold, value) {
+	if x.Amount != "" {
+		value := protoreflect.ValueOfString(x.Amount)
+		if !f(fd_Edict_amount, value) {
 			return
 		}
 	}
-	if x.MaxNum != uint32(0) {
-		value := protoreflect.ValueOfUint32(x.MaxNum)
-		if !f(fd_RunesConsolidation_max_num, value) {
+	if x.Output != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Output)
+		if !f(fd_Edict_output, value) {
 			return
 		}
 	}
@@ -5620,19 +5824,19 @@ func (x *fastReflection_RunesConsolidation) Range(f func(protoreflect.FieldDescr
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_RunesConsolidation) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_Edict) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "side.btcbridge.RunesConsolidation.rune_id":
-		return x.RuneId != ""
-	case "side.btcbridge.RunesConsolidation.target_threshold":
-		return x.TargetThreshold != ""
-	case "side.btcbridge.RunesConsolidation.max_num":
-		return x.MaxNum != uint32(0)
+	case "side.btcbridge.Edict.id":
+		return x.Id != nil
+	case "side.btcbridge.Edict.amount":
+		return x.Amount != ""
+	case "side.btcbridge.Edict.output":
+		return x.Output != uint32(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.RunesConsolidation"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.Edict"))
 		}
-		panic(fmt.Errorf("message side.btcbridge.RunesConsolidation does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message side.btcbridge.Edict does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5642,19 +5846,19 @@ func (x *fastReflection_RunesConsolidation) Has(fd protoreflect.FieldDescriptor)
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RunesConsolidation) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_Edict) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "side.btcbridge.RunesConsolidation.rune_id":
-		x.RuneId = ""
-	case "side.btcbridge.RunesConsolidation.target_threshold":
-		x.TargetThreshold = ""
-	case "side.btcbridge.RunesConsolidation.max_num":
-		x.MaxNum = uint32(0)
+	case "side.btcbridge.Edict.id":
+		x.Id = nil
+	case "side.btcbridge.Edict.amount":
+		x.Amount = ""
+	case "side.btcbridge.Edict.output":
+		x.Output = uint32(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.RunesConsolidation"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.Edict"))
 		}
-		panic(fmt.Errorf("message side.btcbridge.RunesConsolidation does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message side.btcbridge.Edict does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5664,22 +5868,22 @@ func (x *fastReflection_RunesConsolidation) Clear(fd protoreflect.FieldDescripto
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_RunesConsolidation) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Edict) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "side.btcbridge.RunesConsolidation.rune_id":
-		value := x.RuneId
-		return protoreflect.ValueOfString(value)
-	case "side.btcbridge.RunesConsolidation.target_threshold":
-		value := x.TargetThreshold
+	case "side.btcbridge.Edict.id":
+		value := x.Id
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "side.btcbridge.Edict.amount":
+		value := x.Amount
 		return protoreflect.ValueOfString(value)
-	case "side.btcbridge.RunesConsolidation.max_num":
-		value := x.MaxNum
+	case "side.btcbridge.Edict.output":
+		value := x.Output
 		return protoreflect.ValueOfUint32(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.RunesConsolidation"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.Edict"))
 		}
-		panic(fmt.Errorf("message side.btcbridge.RunesConsolidation does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message side.btcbridge.Edict does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -5693,19 +5897,19 @@ func (x *fastReflection_RunesConsolidation) Get(descriptor protoreflect.FieldDes
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RunesConsolidation) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_Edict) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "side.btcbridge.RunesConsolidation.rune_id":
-		x.RuneId = value.Interface().(string)
-	case "side.btcbridge.RunesConsolidation.target_threshold":
-		x.TargetThreshold = value.Interface().(string)
-	case "side.btcbridge.RunesConsolidation.max_num":
-		x.MaxNum = uint32(value.Uint())
+	case "side.btcbridge.Edict.id":
+		x.Id = value.Message().Interface().(*RuneId)
+	case "side.btcbridge.Edict.amount":
+		x.Amount = value.Interface().(string)
+	case "side.btcbridge.Edict.output":
+		x.Output = uint32(value.Uint())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.RunesConsolidation"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.Edict"))
 		}
-		panic(fmt.Errorf("message side.btcbridge.RunesConsolidation does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message side.btcbridge.Edict does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5719,48 +5923,52 @@ func (x *fastReflection_RunesConsolidation) Set(fd protoreflect.FieldDescriptor,
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RunesConsolidation) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Edict) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "side.btcbridge.RunesConsolidation.rune_id":
-		panic(fmt.Errorf("field rune_id of message side.btcbridge.RunesConsolidation is not mutable"))
-	case "side.btcbridge.RunesConsolidation.target_threshold":
-		panic(fmt.Errorf("field target_threshold of message side.btcbridge.RunesConsolidation is not mutable"))
-	case "side.btcbridge.RunesConsolidation.max_num":
-		panic(fmt.Errorf("field max_num of message side.btcbridge.RunesConsolidation is not mutable"))
+	case "side.btcbridge.Edict.id":
+		if x.Id == nil {
+			x.Id = new(RuneId)
+		}
+		return protoreflect.ValueOfMessage(x.Id.ProtoReflect())
+	case "side.btcbridge.Edict.amount":
+		panic(fmt.Errorf("field amount of message side.btcbridge.Edict is not mutable"))
+	case "side.btcbridge.Edict.output":
+		panic(fmt.Errorf("field output of message side.btcbridge.Edict is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.RunesConsolidation"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.Edict"))
 		}
-		panic(fmt.Errorf("message side.btcbridge.RunesConsolidation does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message side.btcbridge.Edict does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_RunesConsolidation) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Edict) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "side.btcbridge.RunesConsolidation.rune_id":
-		return protoreflect.ValueOfString("")
-	case "side.btcbridge.RunesConsolidation.target_threshold":
+	case "side.btcbridge.Edict.id":
+		m := new(RuneId)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "side.btcbridge.Edict.amount":
 		return protoreflect.ValueOfString("")
-	case "side.btcbridge.RunesConsolidation.max_num":
+	case "side.btcbridge.Edict.output":
 		return protoreflect.ValueOfUint32(uint32(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.RunesConsolidation"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.Edict"))
 		}
-		panic(fmt.Errorf("message side.btcbridge.RunesConsolidation does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message side.btcbridge.Edict does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_RunesConsolidation) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_Edict) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in side.btcbridge.RunesConsolidation", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in side.btcbridge.Edict", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -5768,7 +5976,7 @@ func (x *fastReflection_RunesConsolidation) WhichOneof(d protoreflect.OneofDescr
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_RunesConsolidation) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_Edict) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -5779,7 +5987,7 @@ func (x *fastReflection_RunesConsolidation) GetUnknown() protoreflect.RawFields
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RunesConsolidation) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_Edict) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -5791,7 +5999,7 @@ func (x *fastReflection_RunesConsolidation) SetUnknown(fields protoreflect.RawFi
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_RunesConsolidation) IsValid() bool {
+func (x *fastReflection_Edict) IsValid() bool {
 	return x != nil
 }
 
@@ -5801,9 +6009,9 @@ func (x *fastReflection_RunesConsolidation) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_RunesConsolidation) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_Edict) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*RunesConsolidation)
+		x := input.Message.Interface().(*Edict)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5815,16 +6023,16 @@ func (x *fastReflection_RunesConsolidation) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.RuneId)
-		if l > 0 {
+		if x.Id != nil {
+			l = options.Size(x.Id)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.TargetThreshold)
+		l = len(x.Amount)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.MaxNum != 0 {
-			n += 1 + runtime.Sov(uint64(x.MaxNum))
+		if x.Output != 0 {
+			n += 1 + runtime.Sov(uint64(x.Output))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -5836,7 +6044,7 @@ func (x *fastReflection_RunesConsolidation) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*RunesConsolidation)
+		x := input.Message.Interface().(*Edict)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5855,22 +6063,29 @@ func (x *fastReflection_RunesConsolidation) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.MaxNum != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxNum))
+		if x.Output != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Output))
 			i--
 			dAtA[i] = 0x18
 		}
-		if len(x.TargetThreshold) > 0 {
-			i -= len(x.TargetThreshold)
-			copy(dAtA[i:], x.TargetThreshold)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TargetThreshold)))
+		if len(x.Amount) > 0 {
+			i -= len(x.Amount)
+			copy(dAtA[i:], x.Amount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Amount)))
 			i--
 			dAtA[i] = 0x12
 		}
-		if len(x.RuneId) > 0 {
-			i -= len(x.RuneId)
-			copy(dAtA[i:], x.RuneId)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RuneId)))
+		if x.Id != nil {
+			encoded, err := options.Marshal(x.Id)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -5885,7 +6100,7 @@ func (x *fastReflection_RunesConsolidation) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*RunesConsolidation)
+		x := input.Message.Interface().(*Edict)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5917,17 +6132,17 @@ func (x *fastReflection_RunesConsolidation) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RunesConsolidation: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Edict: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RunesConsolidation: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Edict: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RuneId", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -5937,27 +6152,31 @@ func (x *fastReflection_RunesConsolidation) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.RuneId = string(dAtA[iNdEx:postIndex])
+				if x.Id == nil {
+					x.Id = &RuneId{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Id); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TargetThreshold", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -5985,13 +6204,13 @@ func (x *fastReflection_RunesConsolidation) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.TargetThreshold = string(dAtA[iNdEx:postIndex])
+				x.Amount = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 3:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxNum", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Output", wireType)
 				}
-				x.MaxNum = 0
+				x.Output = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -6001,7 +6220,7 @@ func (x *fastReflection_RunesConsolidation) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.MaxNum |= uint32(b&0x7F) << shift
+					x.Output |= uint32(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
@@ -6042,29 +6261,33 @@ func (x *fastReflection_RunesConsolidation) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_DKGParticipant                  protoreflect.MessageDescriptor
-	fd_DKGParticipant_moniker          protoreflect.FieldDescriptor
-	fd_DKGParticipant_operator_address protoreflect.FieldDescriptor
-	fd_DKGParticipant_consensus_pubkey protoreflect.FieldDescriptor
+	md_RuneMetadata              protoreflect.MessageDescriptor
+	fd_RuneMetadata_id           protoreflect.FieldDescriptor
+	fd_RuneMetadata_name         protoreflect.FieldDescriptor
+	fd_RuneMetadata_symbol       protoreflect.FieldDescriptor
+	fd_RuneMetadata_divisibility protoreflect.FieldDescriptor
+	fd_RuneMetadata_etching_tx   protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_side_btcbridge_btcbridge_proto_init()
-	md_DKGParticipant = File_side_btcbridge_btcbridge_proto.Messages().ByName("DKGParticipant")
-	fd_DKGParticipant_moniker = md_DKGParticipant.Fields().ByName("moniker")
-	fd_DKGParticipant_operator_address = md_DKGParticipant.Fields().ByName("operator_address")
-	fd_DKGParticipant_consensus_pubkey = md_DKGParticipant.Fields().ByName("consensus_pubkey")
+	md_RuneMetadata = File_side_btcbridge_btcbridge_proto.Messages().ByName("RuneMetadata")
+	fd_RuneMetadata_id = md_RuneMetadata.Fields().ByName("id")
+	fd_RuneMetadata_name = md_RuneMetadata.Fields().ByName("name")
+	fd_RuneMetadata_symbol = md_RuneMetadata.Fields().ByName("symbol")
+	fd_RuneMetadata_divisibility = md_RuneMetadata.Fields().ByName("divisibility")
+	fd_RuneMetadata_etching_tx = md_RuneMetadata.Fields().ByName("etching_tx")
 }
 
-var _ protoreflect.Message = (*fastReflection_DKGParticipant)(nil)
+var _ protoreflect.Message = (*fastReflection_RuneMetadata)(nil)
 
-type fastReflection_DKGParticipant DKGParticipant
+type fastReflection_RuneMetadata RuneMetadata
 
-func (x *DKGParticipant) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_DKGParticipant)(x)
+func (x *RuneMetadata) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_RuneMetadata)(x)
 }
 
-func (x *DKGParticipant) slowProtoReflect() protoreflect.Message {
+func (x *RuneMetadata) slowProtoReflect() protoreflect.Message {
 	mi := &file_side_btcbridge_btcbridge_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6076,43 +6299,43 @@ func (x *DKGParticipant) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_DKGParticipant_messageType fastReflection_DKGParticipant_messageType
-var _ protoreflect.MessageType = fastReflection_DKGParticipant_messageType{}
+var _fastReflection_RuneMetadata_messageType fastReflection_RuneMetadata_messageType
+var _ protoreflect.MessageType = fastReflection_RuneMetadata_messageType{}
 
-type fastReflection_DKGParticipant_messageType struct{}
+type fastReflection_RuneMetadata_messageType struct{}
 
-func (x fastReflection_DKGParticipant_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_DKGParticipant)(nil)
+func (x fastReflection_RuneMetadata_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_RuneMetadata)(nil)
 }
-func (x fastReflection_DKGParticipant_messageType) New() protoreflect.Message {
-	return new(fastReflection_DKGParticipant)
+func (x fastReflection_RuneMetadata_messageType) New() protoreflect.Message {
+	return new(fastReflection_RuneMetadata)
 }
-func (x fastReflection_DKGParticipant_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_DKGParticipant
+func (x fastReflection_RuneMetadata_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_RuneMetadata
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_DKGParticipant) Descriptor() protoreflect.MessageDescriptor {
-	return md_DKGParticipant
+func (x *fastReflection_RuneMetadata) Descriptor() protoreflect.MessageDescriptor {
+	return md_RuneMetadata
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_DKGParticipant) Type() protoreflect.MessageType {
-	return _fastReflection_DKGParticipant_messageType
+func (x *fastReflection_RuneMetadata) Type() protoreflect.MessageType {
+	return _fastReflection_RuneMetadata_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_DKGParticipant) New() protoreflect.Message {
-	return new(fastReflection_DKGParticipant)
+func (x *fastReflection_RuneMetadata) New() protoreflect.Message {
+	return new(fastReflection_RuneMetadata)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_DKGParticipant) Interface() protoreflect.ProtoMessage {
-	return (*DKGParticipant)(x)
+func (x *fastReflection_RuneMetadata) Interface() protoreflect.ProtoMessage {
+	return (*RuneMetadata)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -6120,22 +6343,34 @@ func (x *fastReflection_DKGParticipant) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_DKGParticipant) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Moniker != "" {
-		value := protoreflect.ValueOfString(x.Moniker)
-		if !f(fd_DKGParticipant_moniker, value) {
+func (x *fastReflection_RuneMetadata) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != "" {
+		value := protoreflect.ValueOfString(x.Id)
+		if !f(fd_RuneMetadata_id, value) {
 			return
 		}
 	}
-	if x.OperatorAddress != "" {
-		value := protoreflect.ValueOfString(x.OperatorAddress)
-		if !f(fd_DKGParticipant_operator_address, value) {
+	if x.Name != "" {
+		value := protoreflect.ValueOfString(x.Name)
+		if !f(fd_RuneMetadata_name, value) {
 			return
 		}
 	}
-	if x.ConsensusPubkey != "" {
-		value := protoreflect.ValueOfString(x.ConsensusPubkey)
-		if !f(fd_DKGParticipant_consensus_pubkey, value) {
+	if x.Symbol != "" {
+		value := protoreflect.ValueOfString(x.Symbol)
+		if !f(fd_RuneMetadata_symbol, value) {
+			return
+		}
+	}
+	if x.Divisibility != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Divisibility)
+		if !f(fd_RuneMetadata_divisibility, value) {
+			return
+		}
+	}
+	if x.EtchingTx != "" {
+		value := protoreflect.ValueOfString(x.EtchingTx)
+		if !f(fd_RuneMetadata_etching_tx, value) {
 			return
 		}
 	}
@@ -6152,19 +6387,23 @@ func (x *fastReflection_DKGParticipant) Range(f func(protoreflect.FieldDescripto
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_DKGParticipant) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_RuneMetadata) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "side.btcbridge.DKGParticipant.moniker":
-		return x.Moniker != ""
-	case "side.btcbridge.DKGParticipant.operator_address":
-		return x.OperatorAddress != ""
-	case "side.btcbridge.DKGParticipant.consensus_pubkey":
-		return x.ConsensusPubkey != ""
+	case "side.btcbridge.RuneMetadata.id":
+		return x.Id != ""
+	case "side.btcbridge.RuneMetadata.name":
+		return x.Name != ""
+	case "side.btcbridge.RuneMetadata.symbol":
+		return x.Symbol != ""
+	case "side.btcbridge.RuneMetadata.divisibility":
+		return x.Divisibility != uint32(0)
+	case "side.btcbridge.RuneMetadata.etching_tx":
+		return x.EtchingTx != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.DKGParticipant"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.RuneMetadata"))
 		}
-		panic(fmt.Errorf("message side.btcbridge.DKGParticipant does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message side.btcbridge.RuneMetadata does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6174,19 +6413,23 @@ func (x *fastReflection_DKGParticipant) Has(fd protoreflect.FieldDescriptor) boo
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_DKGParticipant) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_RuneMetadata) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "side.btcbridge.DKGParticipant.moniker":
-		x.Moniker = ""
-	case "side.btcbridge.DKGParticipant.operator_address":
-		x.OperatorAddress = ""
-	case "side.btcbridge.DKGParticipant.consensus_pubkey":
-		x.ConsensusPubkey = ""
+	case "side.btcbridge.RuneMetadata.id":
+		x.Id = ""
+	case "side.btcbridge.RuneMetadata.name":
+		x.Name = ""
+	case "side.btcbridge.RuneMetadata.symbol":
+		x.Symbol = ""
+	case "side.btcbridge.RuneMetadata.divisibility":
+		x.Divisibility = uint32(0)
+	case "side.btcbridge.RuneMetadata.etching_tx":
+		x.EtchingTx = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.DKGParticipant"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.RuneMetadata"))
 		}
-		panic(fmt.Errorf("message side.btcbridge.DKGParticipant does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message side.btcbridge.RuneMetadata does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6196,22 +6439,28 @@ func (x *fastReflection_DKGParticipant) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_DKGParticipant) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_RuneMetadata) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "side.btcbridge.DKGParticipant.moniker":
-		value := x.Moniker
+	case "side.btcbridge.RuneMetadata.id":
+		value := x.Id
 		return protoreflect.ValueOfString(value)
-	case "side.btcbridge.DKGParticipant.operator_address":
-		value := x.OperatorAddress
+	case "side.btcbridge.RuneMetadata.name":
+		value := x.Name
 		return protoreflect.ValueOfString(value)
-	case "side.btcbridge.DKGParticipant.consensus_pubkey":
-		value := x.ConsensusPubkey
+	case "side.btcbridge.RuneMetadata.symbol":
+		value := x.Symbol
+		return protoreflect.ValueOfString(value)
+	case "side.btcbridge.RuneMetadata.divisibility":
+		value := x.Divisibility
+		return protoreflect.ValueOfUint32(value)
+	case "side.btcbridge.RuneMetadata.etching_tx":
+		value := x.EtchingTx
 		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.DKGParticipant"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.RuneMetadata"))
 		}
-		panic(fmt.Errorf("message side.btcbridge.DKGParticipant does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message side.btcbridge.RuneMetadata does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -6225,19 +6474,23 @@ func (x *fastReflection_DKGParticipant) Get(descriptor protoreflect.FieldDescrip
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_DKGParticipant) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_RuneMetadata) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "side.btcbridge.DKGParticipant.moniker":
-		x.Moniker = value.Interface().(string)
-	case "side.btcbridge.DKGParticipant.operator_address":
-		x.OperatorAddress = value.Interface().(string)
-	case "side.btcbridge.DKGParticipant.consensus_pubkey":
-		x.ConsensusPubkey = value.Interface().(string)
+	case "side.btcbridge.RuneMetadata.id":
+		x.Id = value.Interface().(string)
+	case "side.btcbridge.RuneMetadata.name":
+		x.Name = value.Interface().(string)
+	case "side.btcbridge.RuneMetadata.symbol":
+		x.Symbol = value.Interface().(string)
+	case "side.btcbridge.RuneMetadata.divisibility":
+		x.Divisibility = uint32(value.Uint())
+	case "side.btcbridge.RuneMetadata.etching_tx":
+		x.EtchingTx = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.DKGParticipant"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.RuneMetadata"))
 		}
-		panic(fmt.Errorf("message side.btcbridge.DKGParticipant does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message side.btcbridge.RuneMetadata does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6251,48 +6504,56 @@ func (x *fastReflection_DKGParticipant) Set(fd protoreflect.FieldDescriptor, val
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_DKGParticipant) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_RuneMetadata) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "side.btcbridge.DKGParticipant.moniker":
-		panic(fmt.Errorf("field moniker of message side.btcbridge.DKGParticipant is not mutable"))
-	case "side.btcbridge.DKGParticipant.operator_address":
-		panic(fmt.Errorf("field operator_address of message side.btcbridge.DKGParticipant is not mutable"))
-	case "side.btcbridge.DKGParticipant.consensus_pubkey":
-		panic(fmt.Errorf("field consensus_pubkey of message side.btcbridge.DKGParticipant is not mutable"))
+	case "side.btcbridge.RuneMetadata.id":
+		panic(fmt.Errorf("field id of message side.btcbridge.RuneMetadata is not mutable"))
+	case "side.btcbridge.RuneMetadata.name":
+		panic(fmt.Errorf("field name of message side.btcbridge.RuneMetadata is not mutable"))
+	case "side.btcbridge.RuneMetadata.symbol":
+		panic(fmt.Errorf("field symbol of message side.btcbridge.RuneMetadata is not mutable"))
+	case "side.btcbridge.RuneMetadata.divisibility":
+		panic(fmt.Errorf("field divisibility of message side.btcbridge.RuneMetadata is not mutable"))
+	case "side.btcbridge.RuneMetadata.etching_tx":
+		panic(fmt.Errorf("field etching_tx of message side.btcbridge.RuneMetadata is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.DKGParticipant"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.RuneMetadata"))
 		}
-		panic(fmt.Errorf("message side.btcbridge.DKGParticipant does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message side.btcbridge.RuneMetadata does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_DKGParticipant) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_RuneMetadata) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "side.btcbridge.DKGParticipant.moniker":
+	case "side.btcbridge.RuneMetadata.id":
 		return protoreflect.ValueOfString("")
-	case "side.btcbridge.DKGParticipant.operator_address":
+	case "side.btcbridge.RuneMetadata.name":
 		return protoreflect.ValueOfString("")
-	case "side.btcbridge.DKGParticipant.consensus_pubkey":
+	case "side.btcbridge.RuneMetadata.symbol":
+		return protoreflect.ValueOfString("")
+	case "side.btcbridge.RuneMetadata.divisibility":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "side.btcbridge.RuneMetadata.etching_tx":
 		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.DKGParticipant"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.RuneMetadata"))
 		}
-		panic(fmt.Errorf("message side.btcbridge.DKGParticipant does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message side.btcbridge.RuneMetadata does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_DKGParticipant) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_RuneMetadata) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in side.btcbridge.DKGParticipant", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in side.btcbridge.RuneMetadata", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -6300,7 +6561,7 @@ func (x *fastReflection_DKGParticipant) WhichOneof(d protoreflect.OneofDescripto
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_DKGParticipant) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_RuneMetadata) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -6311,7 +6572,7 @@ func (x *fastReflection_DKGParticipant) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_DKGParticipant) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_RuneMetadata) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -6323,7 +6584,7 @@ func (x *fastReflection_DKGParticipant) SetUnknown(fields protoreflect.RawFields
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_DKGParticipant) IsValid() bool {
+func (x *fastReflection_RuneMetadata) IsValid() bool {
 	return x != nil
 }
 
@@ -6333,9 +6594,9 @@ func (x *fastReflection_DKGParticipant) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_DKGParticipant) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_RuneMetadata) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*DKGParticipant)
+		x := input.Message.Interface().(*RuneMetadata)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6347,15 +6608,22 @@ func (x *fastReflection_DKGParticipant) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.Moniker)
+		l = len(x.Id)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.OperatorAddress)
+		l = len(x.Name)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.ConsensusPubkey)
+		l = len(x.Symbol)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Divisibility != 0 {
+			n += 1 + runtime.Sov(uint64(x.Divisibility))
+		}
+		l = len(x.EtchingTx)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
@@ -6369,7 +6637,7 @@ func (x *fastReflection_DKGParticipant) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*DKGParticipant)
+		x := input.Message.Interface().(*RuneMetadata)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6388,24 +6656,36 @@ func (x *fastReflection_DKGParticipant) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.ConsensusPubkey) > 0 {
-			i -= len(x.ConsensusPubkey)
-			copy(dAtA[i:], x.ConsensusPubkey)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ConsensusPubkey)))
+		if len(x.EtchingTx) > 0 {
+			i -= len(x.EtchingTx)
+			copy(dAtA[i:], x.EtchingTx)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.EtchingTx)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.Divisibility != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Divisibility))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.Symbol) > 0 {
+			i -= len(x.Symbol)
+			copy(dAtA[i:], x.Symbol)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Symbol)))
 			i--
 			dAtA[i] = 0x1a
 		}
-		if len(x.OperatorAddress) > 0 {
-			i -= len(x.OperatorAddress)
-			copy(dAtA[i:], x.OperatorAddress)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.OperatorAddress)))
+		if len(x.Name) > 0 {
+			i -= len(x.Name)
+			copy(dAtA[i:], x.Name)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Name)))
 			i--
 			dAtA[i] = 0x12
 		}
-		if len(x.Moniker) > 0 {
-			i -= len(x.Moniker)
-			copy(dAtA[i:], x.Moniker)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Moniker)))
+		if len(x.Id) > 0 {
+			i -= len(x.Id)
+			copy(dAtA[i:], x.Id)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Id)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -6420,7 +6700,7 @@ func (x *fastReflection_DKGParticipant) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*DKGParticipant)
+		x := input.Message.Interface().(*RuneMetadata)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6452,15 +6732,15 @@ func (x *fastReflection_DKGParticipant) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DKGParticipant: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RuneMetadata: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DKGParticipant: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RuneMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Moniker", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -6488,11 +6768,11 @@ func (x *fastReflection_DKGParticipant) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Moniker = string(dAtA[iNdEx:postIndex])
+				x.Id = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -6520,11 +6800,11 @@ func (x *fastReflection_DKGParticipant) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.OperatorAddress = string(dAtA[iNdEx:postIndex])
+				x.Name = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ConsensusPubkey", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -6552,7 +6832,58 @@ func (x *fastReflection_DKGParticipant) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.ConsensusPubkey = string(dAtA[iNdEx:postIndex])
+				x.Symbol = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Divisibility", wireType)
+				}
+				x.Divisibility = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Divisibility |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EtchingTx", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.EtchingTx = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -6589,137 +6920,28 @@ func (x *fastReflection_DKGParticipant) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_DKGRequest_2_list)(nil)
-
-type _DKGRequest_2_list struct {
-	list *[]*DKGParticipant
-}
-
-func (x *_DKGRequest_2_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_DKGRequest_2_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
-
-func (x *_DKGRequest_2_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*DKGParticipant)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_DKGRequest_2_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*DKGParticipant)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_DKGRequest_2_list) AppendMutable() protoreflect.Value {
-	v := new(DKGParticipant)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_DKGRequest_2_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_DKGRequest_2_list) NewElement() protoreflect.Value {
-	v := new(DKGParticipant)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_DKGRequest_2_list) IsValid() bool {
-	return x.list != nil
-}
-
-var _ protoreflect.List = (*_DKGRequest_4_list)(nil)
-
-type _DKGRequest_4_list struct {
-	list *[]AssetType
-}
-
-func (x *_DKGRequest_4_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_DKGRequest_4_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfEnum((protoreflect.EnumNumber)((*x.list)[i]))
-}
-
-func (x *_DKGRequest_4_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Enum()
-	concreteValue := (AssetType)(valueUnwrapped)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_DKGRequest_4_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Enum()
-	concreteValue := (AssetType)(valueUnwrapped)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_DKGRequest_4_list) AppendMutable() protoreflect.Value {
-	panic(fmt.Errorf("AppendMutable can not be called on message DKGRequest at list field VaultTypes as it is not of Message kind"))
-}
-
-func (x *_DKGRequest_4_list) Truncate(n int) {
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_DKGRequest_4_list) NewElement() protoreflect.Value {
-	v := 0
-	return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(v))
-}
-
-func (x *_DKGRequest_4_list) IsValid() bool {
-	return x.list != nil
-}
-
 var (
-	md_DKGRequest                 protoreflect.MessageDescriptor
-	fd_DKGRequest_id              protoreflect.FieldDescriptor
-	fd_DKGRequest_participants    protoreflect.FieldDescriptor
-	fd_DKGRequest_threshold       protoreflect.FieldDescriptor
-	fd_DKGRequest_vault_types     protoreflect.FieldDescriptor
-	fd_DKGRequest_enable_transfer protoreflect.FieldDescriptor
-	fd_DKGRequest_target_utxo_num protoreflect.FieldDescriptor
-	fd_DKGRequest_expiration      protoreflect.FieldDescriptor
-	fd_DKGRequest_status          protoreflect.FieldDescriptor
+	md_Brc20Metadata          protoreflect.MessageDescriptor
+	fd_Brc20Metadata_tick     protoreflect.FieldDescriptor
+	fd_Brc20Metadata_decimals protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_side_btcbridge_btcbridge_proto_init()
-	md_DKGRequest = File_side_btcbridge_btcbridge_proto.Messages().ByName("DKGRequest")
-	fd_DKGRequest_id = md_DKGRequest.Fields().ByName("id")
-	fd_DKGRequest_participants = md_DKGRequest.Fields().ByName("participants")
-	fd_DKGRequest_threshold = md_DKGRequest.Fields().ByName("threshold")
-	fd_DKGRequest_vault_types = md_DKGRequest.Fields().ByName("vault_types")
-	fd_DKGRequest_enable_transfer = md_DKGRequest.Fields().ByName("enable_transfer")
-	fd_DKGRequest_target_utxo_num = md_DKGRequest.Fields().ByName("target_utxo_num")
-	fd_DKGRequest_expiration = md_DKGRequest.Fields().ByName("expiration")
-	fd_DKGRequest_status = md_DKGRequest.Fields().ByName("status")
+	md_Brc20Metadata = File_side_btcbridge_btcbridge_proto.Messages().ByName("Brc20Metadata")
+	fd_Brc20Metadata_tick = md_Brc20Metadata.Fields().ByName("tick")
+	fd_Brc20Metadata_decimals = md_Brc20Metadata.Fields().ByName("decimals")
 }
 
-var _ protoreflect.Message = (*fastReflection_DKGRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_Brc20Metadata)(nil)
 
-type fastReflection_DKGRequest DKGRequest
+type fastReflection_Brc20Metadata Brc20Metadata
 
-func (x *DKGRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_DKGRequest)(x)
+func (x *Brc20Metadata) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Brc20Metadata)(x)
 }
 
-func (x *DKGRequest) slowProtoReflect() protoreflect.Message {
+func (x *Brc20Metadata) slowProtoReflect() protoreflect.Message {
 	mi := &file_side_btcbridge_btcbridge_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6731,43 +6953,43 @@ func (x *DKGRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_DKGRequest_messageType fastReflection_DKGRequest_messageType
-var _ protoreflect.MessageType = fastReflection_DKGRequest_messageType{}
+var _fastReflection_Brc20Metadata_messageType fastReflection_Brc20Metadata_messageType
+var _ protoreflect.MessageType = fastReflection_Brc20Metadata_messageType{}
 
-type fastReflection_DKGRequest_messageType struct{}
+type fastReflection_Brc20Metadata_messageType struct{}
 
-func (x fastReflection_DKGRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_DKGRequest)(nil)
+func (x fastReflection_Brc20Metadata_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Brc20Metadata)(nil)
 }
-func (x fastReflection_DKGRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_DKGRequest)
+func (x fastReflection_Brc20Metadata_messageType) New() protoreflect.Message {
+	return new(fastReflection_Brc20Metadata)
 }
-func (x fastReflection_DKGRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_DKGRequest
+func (x fastReflection_Brc20Metadata_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Brc20Metadata
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_DKGRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_DKGRequest
+func (x *fastReflection_Brc20Metadata) Descriptor() protoreflect.MessageDescriptor {
+	return md_Brc20Metadata
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_DKGRequest) Type() protoreflect.MessageType {
-	return _fastReflection_DKGRequest_messageType
+func (x *fastReflection_Brc20Metadata) Type() protoreflect.MessageType {
+	return _fastReflection_Brc20Metadata_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_DKGRequest) New() protoreflect.Message {
-	return new(fastReflection_DKGRequest)
+func (x *fastReflection_Brc20Metadata) New() protoreflect.Message {
+	return new(fastReflection_Brc20Metadata)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_DKGRequest) Interface() protoreflect.ProtoMessage {
-	return (*DKGRequest)(x)
+func (x *fastReflection_Brc20Metadata) Interface() protoreflect.ProtoMessage {
+	return (*Brc20Metadata)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -6775,91 +6997,43 @@ func (x *fastReflection_DKGRequest) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_DKGRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Id != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.Id)
-		if !f(fd_DKGRequest_id, value) {
-			return
-		}
-	}
-	if len(x.Participants) != 0 {
-		value := protoreflect.ValueOfList(&_DKGRequest_2_list{list: &x.Participants})
-		if !f(fd_DKGRequest_participants, value) {
+func (x *fastReflection_Brc20Metadata) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Tick != "" {
+		value := protoreflect.ValueOfString(x.Tick)
+		if !f(fd_Brc20Metadata_tick, value) {
 			return
 		}
 	}
-	if x.Threshold != uint32(0) {
-		value := protoreflect.ValueOfUint32(x.Threshold)
-		if !f(fd_DKGRequest_threshold, value) {
+	if x.Decimals != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Decimals)
+		if !f(fd_Brc20Metadata_decimals, value) {
 			return
 		}
 	}
-	if len(x.VaultTypes) != 0 {
-		value := protoreflect.ValueOfList(&_DKGRequest_4_list{list: &x.VaultTypes})
-		if !f(fd_DKGRequest_vault_types, value) {
-			return
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Brc20Metadata) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "side.btcbridge.Brc20Metadata.tick":
+		return x.Tick != ""
+	case "side.btcbridge.Brc20Metadata.decimals":
+		return x.Decimals != uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.Brc20Metadata"))
 		}
-	}
-	if x.EnableTransfer != false {
-		value := protoreflect.ValueOfBool(x.EnableTransfer)
-		if !f(fd_DKGRequest_enable_transfer, value) {
-			return
-		}
-	}
-	if x.TargetUtxoNum != uint32(0) {
-		value := protoreflect.ValueOfUint32(x.TargetUtxoNum)
-		if !f(fd_DKGRequest_target_utxo_num, value) {
-			return
-		}
-	}
-	if x.Expiration != nil {
-		value := protoreflect.ValueOfMessage(x.Expiration.ProtoReflect())
-		if !f(fd_DKGRequest_expiration, value) {
-			return
-		}
-	}
-	if x.Status != 0 {
-		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Status))
-		if !f(fd_DKGRequest_status, value) {
-			return
-		}
-	}
-}
-
-// Has reports whether a field is populated.
-//
-// Some fields have the property of nullability where it is possible to
-// distinguish between the default value of a field and whether the field
-// was explicitly populated with the default value. Singular message fields,
-// member fields of a oneof, and proto2 scalar fields are nullable. Such
-// fields are populated only if explicitly set.
-//
-// In other cases (aside from the nullable cases above),
-// a proto3 scalar field is populated if it contains a non-zero value, and
-// a repeated field is populated if it is non-empty.
-func (x *fastReflection_DKGRequest) Has(fd protoreflect.FieldDescriptor) bool {
-	switch fd.FullName() {
-	case "side.btcbridge.DKGRequest.id":
-		return x.Id != uint64(0)
-	case "side.btcbridge.DKGRequest.participants":
-		return len(x.Participants) != 0
-	case "side.btcbridge.DKGRequest.threshold":
-		return x.Threshold != uint32(0)
-	case "side.btcbridge.DKGRequest.vault_types":
-		return len(x.VaultTypes) != 0
-	case "side.btcbridge.DKGRequest.enable_transfer":
-		return x.EnableTransfer != false
-	case "side.btcbridge.DKGRequest.target_utxo_num":
-		return x.TargetUtxoNum != uint32(0)
-	case "side.btcbridge.DKGRequest.expiration":
-		return x.Expiration != nil
-	case "side.btcbridge.DKGRequest.status":
-		return x.Status != 0
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.DKGRequest"))
-		}
-		panic(fmt.Errorf("message side.btcbridge.DKGRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message side.btcbridge.Brc20Metadata does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6869,29 +7043,17 @@ func (x *fastReflection_DKGRequest) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_DKGRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_Brc20Metadata) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "side.btcbridge.DKGRequest.id":
-		x.Id = uint64(0)
-	case "side.btcbridge.DKGRequest.participants":
-		x.Participants = nil
-	case "side.btcbridge.DKGRequest.threshold":
-		x.Threshold = uint32(0)
-	case "side.btcbridge.DKGRequest.vault_types":
-		x.VaultTypes = nil
-	case "side.btcbridge.DKGRequest.enable_transfer":
-		x.EnableTransfer = false
-	case "side.btcbridge.DKGRequest.target_utxo_num":
-		x.TargetUtxoNum = uint32(0)
-	case "side.btcbridge.DKGRequest.expiration":
-		x.Expiration = nil
-	case "side.btcbridge.DKGRequest.status":
-		x.Status = 0
+	case "side.btcbridge.Brc20Metadata.tick":
+		x.Tick = ""
+	case "side.btcbridge.Brc20Metadata.decimals":
+		x.Decimals = uint32(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.DKGRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.Brc20Metadata"))
 		}
-		panic(fmt.Errorf("message side.btcbridge.DKGRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message side.btcbridge.Brc20Metadata does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6901,43 +7063,19 @@ func (x *fastReflection_DKGRequest) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_DKGRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Brc20Metadata) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "side.btcbridge.DKGRequest.id":
-		value := x.Id
-		return protoreflect.ValueOfUint64(value)
-	case "side.btcbridge.DKGRequest.participants":
-		if len(x.Participants) == 0 {
-			return protoreflect.ValueOfList(&_DKGRequest_2_list{})
-		}
-		listValue := &_DKGRequest_2_list{list: &x.Participants}
-		return protoreflect.ValueOfList(listValue)
-	case "side.btcbridge.DKGRequest.threshold":
-		value := x.Threshold
-		return protoreflect.ValueOfUint32(value)
-	case "side.btcbridge.DKGRequest.vault_types":
-		if len(x.VaultTypes) == 0 {
-			return protoreflect.ValueOfList(&_DKGRequest_4_list{})
-		}
-		listValue := &_DKGRequest_4_list{list: &x.VaultTypes}
-		return protoreflect.ValueOfList(listValue)
-	case "side.btcbridge.DKGRequest.enable_transfer":
-		value := x.EnableTransfer
-		return protoreflect.ValueOfBool(value)
-	case "side.btcbridge.DKGRequest.target_utxo_num":
-		value := x.TargetUtxoNum
+	case "side.btcbridge.Brc20Metadata.tick":
+		value := x.Tick
+		return protoreflect.ValueOfString(value)
+	case "side.btcbridge.Brc20Metadata.decimals":
+		value := x.Decimals
 		return protoreflect.ValueOfUint32(value)
-	case "side.btcbridge.DKGRequest.expiration":
-		value := x.Expiration
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "side.btcbridge.DKGRequest.status":
-		value := x.Status
-		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.DKGRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.Brc20Metadata"))
 		}
-		panic(fmt.Errorf("message side.btcbridge.DKGRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message side.btcbridge.Brc20Metadata does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -6951,33 +7089,17 @@ func (x *fastReflection_DKGRequest) Get(descriptor protoreflect.FieldDescriptor)
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_DKGRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_Brc20Metadata) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "side.btcbridge.DKGRequest.id":
-		x.Id = value.Uint()
-	case "side.btcbridge.DKGRequest.participants":
-		lv := value.List()
-		clv := lv.(*_DKGRequest_2_list)
-		x.Participants = *clv.list
-	case "side.btcbridge.DKGRequest.threshold":
-		x.Threshold = uint32(value.Uint())
-	case "side.btcbridge.DKGRequest.vault_types":
-		lv := value.List()
-		clv := lv.(*_DKGRequest_4_list)
-		x.VaultTypes = *clv.list
-	case "side.btcbridge.DKGRequest.enable_transfer":
-		x.EnableTransfer = value.Bool()
-	case "side.btcbridge.DKGRequest.target_utxo_num":
-		x.TargetUtxoNum = uint32(value.Uint())
-	case "side.btcbridge.DKGRequest.expiration":
-		x.Expiration = value.Message().Interface().(*timestamppb.Timestamp)
-	case "side.btcbridge.DKGRequest.status":
-		x.Status = (DKGRequestStatus)(value.Enum())
+	case "side.btcbridge.Brc20Metadata.tick":
+		x.Tick = value.Interface().(string)
+	case "side.btcbridge.Brc20Metadata.decimals":
+		x.Decimals = uint32(value.Uint())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.DKGRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.Brc20Metadata"))
 		}
-		panic(fmt.Errorf("message side.btcbridge.DKGRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message side.btcbridge.Brc20Metadata does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6991,82 +7113,44 @@ func (x *fastReflection_DKGRequest) Set(fd protoreflect.FieldDescriptor, value p
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_DKGRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Brc20Metadata) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "side.btcbridge.DKGRequest.participants":
-		if x.Participants == nil {
-			x.Participants = []*DKGParticipant{}
-		}
-		value := &_DKGRequest_2_list{list: &x.Participants}
-		return protoreflect.ValueOfList(value)
-	case "side.btcbridge.DKGRequest.vault_types":
-		if x.VaultTypes == nil {
-			x.VaultTypes = []AssetType{}
-		}
-		value := &_DKGRequest_4_list{list: &x.VaultTypes}
-		return protoreflect.ValueOfList(value)
-	case "side.btcbridge.DKGRequest.expiration":
-		if x.Expiration == nil {
-			x.Expiration = new(timestamppb.Timestamp)
-		}
-		return protoreflect.ValueOfMessage(x.Expiration.ProtoReflect())
-	case "side.btcbridge.DKGRequest.id":
-		panic(fmt.Errorf("field id of message side.btcbridge.DKGRequest is not mutable"))
-	case "side.btcbridge.DKGRequest.threshold":
-		panic(fmt.Errorf("field threshold of message side.btcbridge.DKGRequest is not mutable"))
-	case "side.btcbridge.DKGRequest.enable_transfer":
-		panic(fmt.Errorf("field enable_transfer of message side.btcbridge.DKGRequest is not mutable"))
-	case "side.btcbridge.DKGRequest.target_utxo_num":
-		panic(fmt.Errorf("field target_utxo_num of message side.btcbridge.DKGRequest is not mutable"))
-	case "side.btcbridge.DKGRequest.status":
-		panic(fmt.Errorf("field status of message side.btcbridge.DKGRequest is not mutable"))
+	case "side.btcbridge.Brc20Metadata.tick":
+		panic(fmt.Errorf("field tick of message side.btcbridge.Brc20Metadata is not mutable"))
+	case "side.btcbridge.Brc20Metadata.decimals":
+		panic(fmt.Errorf("field decimals of message side.btcbridge.Brc20Metadata is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.DKGRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.Brc20Metadata"))
 		}
-		panic(fmt.Errorf("message side.btcbridge.DKGRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message side.btcbridge.Brc20Metadata does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_DKGRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Brc20Metadata) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "side.btcbridge.DKGRequest.id":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "side.btcbridge.DKGRequest.participants":
-		list := []*DKGParticipant{}
-		return protoreflect.ValueOfList(&_DKGRequest_2_list{list: &list})
-	case "side.btcbridge.DKGRequest.threshold":
-		return protoreflect.ValueOfUint32(uint32(0))
-	case "side.btcbridge.DKGRequest.vault_types":
-		list := []AssetType{}
-		return protoreflect.ValueOfList(&_DKGRequest_4_list{list: &list})
-	case "side.btcbridge.DKGRequest.enable_transfer":
-		return protoreflect.ValueOfBool(false)
-	case "side.btcbridge.DKGRequest.target_utxo_num":
+	case "side.btcbridge.Brc20Metadata.tick":
+		return protoreflect.ValueOfString("")
+	case "side.btcbridge.Brc20Metadata.decimals":
 		return protoreflect.ValueOfUint32(uint32(0))
-	case "side.btcbridge.DKGRequest.expiration":
-		m := new(timestamppb.Timestamp)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "side.btcbridge.DKGRequest.status":
-		return protoreflect.ValueOfEnum(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.DKGRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.Brc20Metadata"))
 		}
-		panic(fmt.Errorf("message side.btcbridge.DKGRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message side.btcbridge.Brc20Metadata does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_DKGRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_Brc20Metadata) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in side.btcbridge.DKGRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in side.btcbridge.Brc20Metadata", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -7074,7 +7158,7 @@ func (x *fastReflection_DKGRequest) WhichOneof(d protoreflect.OneofDescriptor) p
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_DKGRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_Brc20Metadata) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -7085,7 +7169,7 @@ func (x *fastReflection_DKGRequest) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_DKGRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_Brc20Metadata) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -7097,7 +7181,7 @@ func (x *fastReflection_DKGRequest) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_DKGRequest) IsValid() bool {
+func (x *fastReflection_Brc20Metadata) IsValid() bool {
 	return x != nil
 }
 
@@ -7107,9 +7191,9 @@ func (x *fastReflection_DKGRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_DKGRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_Brc20Metadata) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*DKGRequest)
+		x := input.Message.Interface().(*Brc20Metadata)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7121,37 +7205,12 @@ func (x *fastReflection_DKGRequest) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.Id != 0 {
-			n += 1 + runtime.Sov(uint64(x.Id))
-		}
-		if len(x.Participants) > 0 {
-			for _, e := range x.Participants {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
-		if x.Threshold != 0 {
-			n += 1 + runtime.Sov(uint64(x.Threshold))
-		}
-		if len(x.VaultTypes) > 0 {
-			l = 0
-			for _, e := range x.VaultTypes {
-				l += runtime.Sov(uint64(e))
-			}
-			n += 1 + runtime.Sov(uint64(l)) + l
-		}
-		if x.EnableTransfer {
-			n += 2
-		}
-		if x.TargetUtxoNum != 0 {
-			n += 1 + runtime.Sov(uint64(x.TargetUtxoNum))
-		}
-		if x.Expiration != nil {
-			l = options.Size(x.Expiration)
+		l = len(x.Tick)
+		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.Status != 0 {
-			n += 1 + runtime.Sov(uint64(x.Status))
+		if x.Decimals != 0 {
+			n += 1 + runtime.Sov(uint64(x.Decimals))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -7163,7 +7222,7 @@ func (x *fastReflection_DKGRequest) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*DKGRequest)
+		x := input.Message.Interface().(*Brc20Metadata)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7182,91 +7241,22 @@ func (x *fastReflection_DKGRequest) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Status != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Status))
+		if x.Decimals != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Decimals))
 			i--
-			dAtA[i] = 0x40
+			dAtA[i] = 0x10
 		}
-		if x.Expiration != nil {
-			encoded, err := options.Marshal(x.Expiration)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+		if len(x.Tick) > 0 {
+			i -= len(x.Tick)
+			copy(dAtA[i:], x.Tick)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Tick)))
 			i--
-			dAtA[i] = 0x3a
+			dAtA[i] = 0xa
 		}
-		if x.TargetUtxoNum != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.TargetUtxoNum))
-			i--
-			dAtA[i] = 0x30
-		}
-		if x.EnableTransfer {
-			i--
-			if x.EnableTransfer {
-				dAtA[i] = 1
-			} else {
-				dAtA[i] = 0
-			}
-			i--
-			dAtA[i] = 0x28
-		}
-		if len(x.VaultTypes) > 0 {
-			var pksize2 int
-			for _, num := range x.VaultTypes {
-				pksize2 += runtime.Sov(uint64(num))
-			}
-			i -= pksize2
-			j1 := i
-			for _, num1 := range x.VaultTypes {
-				num := uint64(num1)
-				for num >= 1<<7 {
-					dAtA[j1] = uint8(uint64(num)&0x7f | 0x80)
-					num >>= 7
-					j1++
-				}
-				dAtA[j1] = uint8(num)
-				j1++
-			}
-			i = runtime.EncodeVarint(dAtA, i, uint64(pksize2))
-			i--
-			dAtA[i] = 0x22
-		}
-		if x.Threshold != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Threshold))
-			i--
-			dAtA[i] = 0x18
-		}
-		if len(x.Participants) > 0 {
-			for iNdEx := len(x.Participants) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Participants[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x12
-			}
-		}
-		if x.Id != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
-			i--
-			dAtA[i] = 0x8
-		}
-		if input.Buf != nil {
-			input.Buf = append(input.Buf, dAtA...)
-		} else {
-			input.Buf = dAtA
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
 		}
 		return protoiface.MarshalOutput{
 			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7274,7 +7264,7 @@ func (x *fastReflection_DKGRequest) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*DKGRequest)
+		x := input.Message.Interface().(*Brc20Metadata)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7306,36 +7296,17 @@ func (x *fastReflection_DKGRequest) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DKGRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Brc20Metadata: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DKGRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Brc20Metadata: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-				}
-				x.Id = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.Id |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Participants", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Tick", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -7345,158 +7316,29 @@ func (x *fastReflection_DKGRequest) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Participants = append(x.Participants, &DKGParticipant{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Participants[len(x.Participants)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
+				x.Tick = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 3:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
-				}
-				x.Threshold = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.Threshold |= uint32(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 4:
-				if wireType == 0 {
-					var v AssetType
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-						}
-						if iNdEx >= l {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= AssetType(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					x.VaultTypes = append(x.VaultTypes, v)
-				} else if wireType == 2 {
-					var packedLen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-						}
-						if iNdEx >= l {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						packedLen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if packedLen < 0 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-					}
-					postIndex := iNdEx + packedLen
-					if postIndex < 0 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-					}
-					if postIndex > l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					var elementCount int
-					if elementCount != 0 && len(x.VaultTypes) == 0 {
-						x.VaultTypes = make([]AssetType, 0, elementCount)
-					}
-					for iNdEx < postIndex {
-						var v AssetType
-						for shift := uint(0); ; shift += 7 {
-							if shift >= 64 {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-							}
-							if iNdEx >= l {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-							}
-							b := dAtA[iNdEx]
-							iNdEx++
-							v |= AssetType(b&0x7F) << shift
-							if b < 0x80 {
-								break
-							}
-						}
-						x.VaultTypes = append(x.VaultTypes, v)
-					}
-				} else {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VaultTypes", wireType)
-				}
-			case 5:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EnableTransfer", wireType)
-				}
-				var v int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				x.EnableTransfer = bool(v != 0)
-			case 6:
+			case 2:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TargetUtxoNum", wireType)
-				}
-				x.TargetUtxoNum = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.TargetUtxoNum |= uint32(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 7:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
 				}
-				var msglen int
+				x.Decimals = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -7506,61 +7348,25 @@ func (x *fastReflection_DKGRequest) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					x.Decimals |= uint32(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if postIndex > l {
+				if (iNdEx + skippy) > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Expiration == nil {
-					x.Expiration = &timestamppb.Timestamp{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Expiration); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			case 8:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-				}
-				x.Status = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.Status |= DKGRequestStatus(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if (iNdEx + skippy) > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
 				}
 				iNdEx += skippy
 			}
@@ -7582,80 +7388,28 @@ func (x *fastReflection_DKGRequest) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_DKGCompletionRequest_3_list)(nil)
-
-type _DKGCompletionRequest_3_list struct {
-	list *[]string
-}
-
-func (x *_DKGCompletionRequest_3_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_DKGCompletionRequest_3_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfString((*x.list)[i])
-}
-
-func (x *_DKGCompletionRequest_3_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_DKGCompletionRequest_3_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_DKGCompletionRequest_3_list) AppendMutable() protoreflect.Value {
-	panic(fmt.Errorf("AppendMutable can not be called on message DKGCompletionRequest at list field Vaults as it is not of Message kind"))
-}
-
-func (x *_DKGCompletionRequest_3_list) Truncate(n int) {
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_DKGCompletionRequest_3_list) NewElement() protoreflect.Value {
-	v := ""
-	return protoreflect.ValueOfString(v)
-}
-
-func (x *_DKGCompletionRequest_3_list) IsValid() bool {
-	return x.list != nil
-}
-
 var (
-	md_DKGCompletionRequest                   protoreflect.MessageDescriptor
-	fd_DKGCompletionRequest_id                protoreflect.FieldDescriptor
-	fd_DKGCompletionRequest_sender            protoreflect.FieldDescriptor
-	fd_DKGCompletionRequest_vaults            protoreflect.FieldDescriptor
-	fd_DKGCompletionRequest_consensus_address protoreflect.FieldDescriptor
-	fd_DKGCompletionRequest_signature         protoreflect.FieldDescriptor
+	md_BtcConsolidation                  protoreflect.MessageDescriptor
+	fd_BtcConsolidation_target_threshold protoreflect.FieldDescriptor
+	fd_BtcConsolidation_max_num          protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_side_btcbridge_btcbridge_proto_init()
-	md_DKGCompletionRequest = File_side_btcbridge_btcbridge_proto.Messages().ByName("DKGCompletionRequest")
-	fd_DKGCompletionRequest_id = md_DKGCompletionRequest.Fields().ByName("id")
-	fd_DKGCompletionRequest_sender = md_DKGCompletionRequest.Fields().ByName("sender")
-	fd_DKGCompletionRequest_vaults = md_DKGCompletionRequest.Fields().ByName("vaults")
-	fd_DKGCompletionRequest_consensus_address = md_DKGCompletionRequest.Fields().ByName("consensus_address")
-	fd_DKGCompletionRequest_signature = md_DKGCompletionRequest.Fields().ByName("signature")
+	md_BtcConsolidation = File_side_btcbridge_btcbridge_proto.Messages().ByName("BtcConsolidation")
+	fd_BtcConsolidation_target_threshold = md_BtcConsolidation.Fields().ByName("target_threshold")
+	fd_BtcConsolidation_max_num = md_BtcConsolidation.Fields().ByName("max_num")
 }
 
-var _ protoreflect.Message = (*fastReflection_DKGCompletionRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_BtcConsolidation)(nil)
 
-type fastReflection_DKGCompletionRequest DKGCompletionRequest
+type fastReflection_BtcConsolidation BtcConsolidation
 
-func (x *DKGCompletionRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_DKGCompletionRequest)(x)
+func (x *BtcConsolidation) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_BtcConsolidation)(x)
 }
 
-func (x *DKGCompletionRequest) slowProtoReflect() protoreflect.Message {
+func (x *BtcConsolidation) slowProtoReflect() protoreflect.Message {
 	mi := &file_side_btcbridge_btcbridge_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7667,43 +7421,43 @@ func (x *DKGCompletionRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_DKGCompletionRequest_messageType fastReflection_DKGCompletionRequest_messageType
-var _ protoreflect.MessageType = fastReflection_DKGCompletionRequest_messageType{}
+var _fastReflection_BtcConsolidation_messageType fastReflection_BtcConsolidation_messageType
+var _ protoreflect.MessageType = fastReflection_BtcConsolidation_messageType{}
 
-type fastReflection_DKGCompletionRequest_messageType struct{}
+type fastReflection_BtcConsolidation_messageType struct{}
 
-func (x fastReflection_DKGCompletionRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_DKGCompletionRequest)(nil)
+func (x fastReflection_BtcConsolidation_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_BtcConsolidation)(nil)
 }
-func (x fastReflection_DKGCompletionRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_DKGCompletionRequest)
+func (x fastReflection_BtcConsolidation_messageType) New() protoreflect.Message {
+	return new(fastReflection_BtcConsolidation)
 }
-func (x fastReflection_DKGCompletionRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_DKGCompletionRequest
+func (x fastReflection_BtcConsolidation_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_BtcConsolidation
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_DKGCompletionRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_DKGCompletionRequest
+func (x *fastReflection_BtcConsolidation) Descriptor() protoreflect.MessageDescriptor {
+	return md_BtcConsolidation
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_DKGCompletionRequest) Type() protoreflect.MessageType {
-	return _fastReflection_DKGCompletionRequest_messageType
+func (x *fastReflection_BtcConsolidation) Type() protoreflect.MessageType {
+	return _fastReflection_BtcConsolidation_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_DKGCompletionRequest) New() protoreflect.Message {
-	return new(fastReflection_DKGCompletionRequest)
+func (x *fastReflection_BtcConsolidation) New() protoreflect.Message {
+	return new(fastReflection_BtcConsolidation)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_DKGCompletionRequest) Interface() protoreflect.ProtoMessage {
-	return (*DKGCompletionRequest)(x)
+func (x *fastReflection_BtcConsolidation) Interface() protoreflect.ProtoMessage {
+	return (*BtcConsolidation)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -7711,34 +7465,16 @@ func (x *fastReflection_DKGCompletionRequest) Interface() protoreflect.ProtoMess
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_DKGCompletionRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Id != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.Id)
-		if !f(fd_DKGCompletionRequest_id, value) {
-			return
-		}
-	}
-	if x.Sender != "" {
-		value := protoreflect.ValueOfString(x.Sender)
-		if !f(fd_DKGCompletionRequest_sender, value) {
-			return
-		}
-	}
-	if len(x.Vaults) != 0 {
-		value := protoreflect.ValueOfList(&_DKGCompletionRequest_3_list{list: &x.Vaults})
-		if !f(fd_DKGCompletionRequest_vaults, value) {
-			return
-		}
-	}
-	if x.ConsensusAddress != "" {
-		value := protoreflect.ValueOfString(x.ConsensusAddress)
-		if !f(fd_DKGCompletionRequest_consensus_address, value) {
+func (x *fastReflection_BtcConsolidation) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.TargetThreshold != int64(0) {
+		value := protoreflect.ValueOfInt64(x.TargetThreshold)
+		if !f(fd_BtcConsolidation_target_threshold, value) {
 			return
 		}
 	}
-	if x.Signature != "" {
-		value := protoreflect.ValueOfString(x.Signature)
-		if !f(fd_DKGCompletionRequest_signature, value) {
+	if x.MaxNum != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.MaxNum)
+		if !f(fd_BtcConsolidation_max_num, value) {
 			return
 		}
 	}
@@ -7755,23 +7491,17 @@ func (x *fastReflection_DKGCompletionRequest) Range(f func(protoreflect.FieldDes
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_DKGCompletionRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_BtcConsolidation) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "side.btcbridge.DKGCompletionRequest.id":
-		return x.Id != uint64(0)
-	case "side.btcbridge.DKGCompletionRequest.sender":
-		return x.Sender != ""
-	case "side.btcbridge.DKGCompletionRequest.vaults":
-		return len(x.Vaults) != 0
-	case "side.btcbridge.DKGCompletionRequest.consensus_address":
-		return x.ConsensusAddress != ""
-	case "side.btcbridge.DKGCompletionRequest.signature":
-		return x.Signature != ""
+	case "side.btcbridge.BtcConsolidation.target_threshold":
+		return x.TargetThreshold != int64(0)
+	case "side.btcbridge.BtcConsolidation.max_num":
+		return x.MaxNum != uint32(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.DKGCompletionRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.BtcConsolidation"))
 		}
-		panic(fmt.Errorf("message side.btcbridge.DKGCompletionRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message side.btcbridge.BtcConsolidation does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7781,23 +7511,17 @@ func (x *fastReflection_DKGCompletionRequest) Has(fd protoreflect.FieldDescripto
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_DKGCompletionRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_BtcConsolidation) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "side.btcbridge.DKGCompletionRequest.id":
-		x.Id = uint64(0)
-	case "side.btcbridge.DKGCompletionRequest.sender":
-		x.Sender = ""
-	case "side.btcbridge.DKGCompletionRequest.vaults":
-		x.Vaults = nil
-	case "side.btcbridge.DKGCompletionRequest.consensus_address":
-		x.ConsensusAddress = ""
-	case "side.btcbridge.DKGCompletionRequest.signature":
-		x.Signature = ""
+	case "side.btcbridge.BtcConsolidation.target_threshold":
+		x.TargetThreshold = int64(0)
+	case "side.btcbridge.BtcConsolidation.max_num":
+		x.MaxNum = uint32(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.DKGCompletionRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.BtcConsolidation"))
 		}
-		panic(fmt.Errorf("message side.btcbridge.DKGCompletionRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message side.btcbridge.BtcConsolidation does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7807,31 +7531,19 @@ func (x *fastReflection_DKGCompletionRequest) Clear(fd protoreflect.FieldDescrip
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_DKGCompletionRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_BtcConsolidation) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "side.btcbridge.DKGCompletionRequest.id":
-		value := x.Id
-		return protoreflect.ValueOfUint64(value)
-	case "side.btcbridge.DKGCompletionRequest.sender":
-		value := x.Sender
-		return protoreflect.ValueOfString(value)
-	case "side.btcbridge.DKGCompletionRequest.vaults":
-		if len(x.Vaults) == 0 {
-			return protoreflect.ValueOfList(&_DKGCompletionRequest_3_list{})
-		}
-		listValue := &_DKGCompletionRequest_3_list{list: &x.Vaults}
-		return protoreflect.ValueOfList(listValue)
-	case "side.btcbridge.DKGCompletionRequest.consensus_address":
-		value := x.ConsensusAddress
-		return protoreflect.ValueOfString(value)
-	case "side.btcbridge.DKGCompletionRequest.signature":
-		value := x.Signature
-		return protoreflect.ValueOfString(value)
+	case "side.btcbridge.BtcConsolidation.target_threshold":
+		value := x.TargetThreshold
+		return protoreflect.ValueOfInt64(value)
+	case "side.btcbridge.BtcConsolidation.max_num":
+		value := x.MaxNum
+		return protoreflect.ValueOfUint32(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.DKGCompletionRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.BtcConsolidation"))
 		}
-		panic(fmt.Errorf("message side.btcbridge.DKGCompletionRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message side.btcbridge.BtcConsolidation does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -7845,25 +7557,17 @@ func (x *fastReflection_DKGCompletionRequest) Get(descriptor protoreflect.FieldD
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_DKGCompletionRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_BtcConsolidation) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "side.btcbridge.DKGCompletionRequest.id":
-		x.Id = value.Uint()
-	case "side.btcbridge.DKGCompletionRequest.sender":
-		x.Sender = value.Interface().(string)
-	case "side.btcbridge.DKGCompletionRequest.vaults":
-		lv := value.List()
-		clv := lv.(*_DKGCompletionRequest_3_list)
-		x.Vaults = *clv.list
-	case "side.btcbridge.DKGCompletionRequest.consensus_address":
-		x.ConsensusAddress = value.Interface().(string)
-	case "side.btcbridge.DKGCompletionRequest.signature":
-		x.Signature = value.Interface().(string)
+	case "side.btcbridge.BtcConsolidation.target_threshold":
+		x.TargetThreshold = value.Int()
+	case "side.btcbridge.BtcConsolidation.max_num":
+		x.MaxNum = uint32(value.Uint())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.DKGCompletionRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.BtcConsolidation"))
 		}
-		panic(fmt.Errorf("message side.btcbridge.DKGCompletionRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message side.btcbridge.BtcConsolidation does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7877,61 +7581,44 @@ func (x *fastReflection_DKGCompletionRequest) Set(fd protoreflect.FieldDescripto
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_DKGCompletionRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_BtcConsolidation) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "side.btcbridge.DKGCompletionRequest.vaults":
-		if x.Vaults == nil {
-			x.Vaults = []string{}
-		}
-		value := &_DKGCompletionRequest_3_list{list: &x.Vaults}
-		return protoreflect.ValueOfList(value)
-	case "side.btcbridge.DKGCompletionRequest.id":
-		panic(fmt.Errorf("field id of message side.btcbridge.DKGCompletionRequest is not mutable"))
-	case "side.btcbridge.DKGCompletionRequest.sender":
-		panic(fmt.Errorf("field sender of message side.btcbridge.DKGCompletionRequest is not mutable"))
-	case "side.btcbridge.DKGCompletionRequest.consensus_address":
-		panic(fmt.Errorf("field consensus_address of message side.btcbridge.DKGCompletionRequest is not mutable"))
-	case "side.btcbridge.DKGCompletionRequest.signature":
-		panic(fmt.Errorf("field signature of message side.btcbridge.DKGCompletionRequest is not mutable"))
+	case "side.btcbridge.BtcConsolidation.target_threshold":
+		panic(fmt.Errorf("field target_threshold of message side.btcbridge.BtcConsolidation is not mutable"))
+	case "side.btcbridge.BtcConsolidation.max_num":
+		panic(fmt.Errorf("field max_num of message side.btcbridge.BtcConsolidation is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.DKGCompletionRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.BtcConsolidation"))
 		}
-		panic(fmt.Errorf("message side.btcbridge.DKGCompletionRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message side.btcbridge.BtcConsolidation does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_DKGCompletionRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_BtcConsolidation) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "side.btcbridge.DKGCompletionRequest.id":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "side.btcbridge.DKGCompletionRequest.sender":
-		return protoreflect.ValueOfString("")
-	case "side.btcbridge.DKGCompletionRequest.vaults":
-		list := []string{}
-		return protoreflect.ValueOfList(&_DKGCompletionRequest_3_list{list: &list})
-	case "side.btcbridge.DKGCompletionRequest.consensus_address":
-		return protoreflect.ValueOfString("")
-	case "side.btcbridge.DKGCompletionRequest.signature":
-		return protoreflect.ValueOfString("")
+	case "side.btcbridge.BtcConsolidation.target_threshold":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "side.btcbridge.BtcConsolidation.max_num":
+		return protoreflect.ValueOfUint32(uint32(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.DKGCompletionRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.BtcConsolidation"))
 		}
-		panic(fmt.Errorf("message side.btcbridge.DKGCompletionRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message side.btcbridge.BtcConsolidation does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_DKGCompletionRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_BtcConsolidation) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in side.btcbridge.DKGCompletionRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in side.btcbridge.BtcConsolidation", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -7939,7 +7626,7 @@ func (x *fastReflection_DKGCompletionRequest) WhichOneof(d protoreflect.OneofDes
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_DKGCompletionRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_BtcConsolidation) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -7950,7 +7637,7 @@ func (x *fastReflection_DKGCompletionRequest) GetUnknown() protoreflect.RawField
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_DKGCompletionRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_BtcConsolidation) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -7962,7 +7649,7 @@ func (x *fastReflection_DKGCompletionRequest) SetUnknown(fields protoreflect.Raw
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_DKGCompletionRequest) IsValid() bool {
+func (x *fastReflection_BtcConsolidation) IsValid() bool {
 	return x != nil
 }
 
@@ -7972,9 +7659,9 @@ func (x *fastReflection_DKGCompletionRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_DKGCompletionRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_BtcConsolidation) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*DKGCompletionRequest)
+		x := input.Message.Interface().(*BtcConsolidation)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7986,26 +7673,11 @@ func (x *fastReflection_DKGCompletionRequest) ProtoMethods() *protoiface.Methods
 		var n int
 		var l int
 		_ = l
-		if x.Id != 0 {
-			n += 1 + runtime.Sov(uint64(x.Id))
-		}
-		l = len(x.Sender)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if len(x.Vaults) > 0 {
-			for _, s := range x.Vaults {
-				l = len(s)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
-		l = len(x.ConsensusAddress)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.TargetThreshold != 0 {
+			n += 1 + runtime.Sov(uint64(x.TargetThreshold))
 		}
-		l = len(x.Signature)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.MaxNum != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxNum))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -8017,7 +7689,7 @@ func (x *fastReflection_DKGCompletionRequest) ProtoMethods() *protoiface.Methods
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*DKGCompletionRequest)
+		x := input.Message.Interface().(*BtcConsolidation)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8036,38 +7708,13 @@ func (x *fastReflection_DKGCompletionRequest) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Signature) > 0 {
-			i -= len(x.Signature)
-			copy(dAtA[i:], x.Signature)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Signature)))
-			i--
-			dAtA[i] = 0x2a
-		}
-		if len(x.ConsensusAddress) > 0 {
-			i -= len(x.ConsensusAddress)
-			copy(dAtA[i:], x.ConsensusAddress)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ConsensusAddress)))
-			i--
-			dAtA[i] = 0x22
-		}
-		if len(x.Vaults) > 0 {
-			for iNdEx := len(x.Vaults) - 1; iNdEx >= 0; iNdEx-- {
-				i -= len(x.Vaults[iNdEx])
-				copy(dAtA[i:], x.Vaults[iNdEx])
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Vaults[iNdEx])))
-				i--
-				dAtA[i] = 0x1a
-			}
-		}
-		if len(x.Sender) > 0 {
-			i -= len(x.Sender)
-			copy(dAtA[i:], x.Sender)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Sender)))
+		if x.MaxNum != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxNum))
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x10
 		}
-		if x.Id != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+		if x.TargetThreshold != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TargetThreshold))
 			i--
 			dAtA[i] = 0x8
 		}
@@ -8082,7 +7729,7 @@ func (x *fastReflection_DKGCompletionRequest) ProtoMethods() *protoiface.Methods
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*DKGCompletionRequest)
+		x := input.Message.Interface().(*BtcConsolidation)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8114,17 +7761,17 @@ func (x *fastReflection_DKGCompletionRequest) ProtoMethods() *protoiface.Methods
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DKGCompletionRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BtcConsolidation: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DKGCompletionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BtcConsolidation: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TargetThreshold", wireType)
 				}
-				x.Id = 0
+				x.TargetThreshold = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -8134,112 +7781,16 @@ func (x *fastReflection_DKGCompletionRequest) ProtoMethods() *protoiface.Methods
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.Id |= uint64(b&0x7F) << shift
+					x.TargetThreshold |= int64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
 			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Sender = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Vaults", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Vaults = append(x.Vaults, string(dAtA[iNdEx:postIndex]))
-				iNdEx = postIndex
-			case 4:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ConsensusAddress", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.ConsensusAddress = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 5:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxNum", wireType)
 				}
-				var stringLen uint64
+				x.MaxNum = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -8249,24 +7800,11 @@ func (x *fastReflection_DKGCompletionRequest) ProtoMethods() *protoiface.Methods
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					x.MaxNum |= uint32(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Signature = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -8302,994 +7840,14901 @@ func (x *fastReflection_DKGCompletionRequest) ProtoMethods() *protoiface.Methods
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: side/btcbridge/btcbridge.proto
+var (
+	md_RunesConsolidation                  protoreflect.MessageDescriptor
+	fd_RunesConsolidation_rune_id          protoreflect.FieldDescriptor
+	fd_RunesConsolidation_target_threshold protoreflect.FieldDescriptor
+	fd_RunesConsolidation_max_num          protoreflect.FieldDescriptor
+)
 
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
+func init() {
+	file_side_btcbridge_btcbridge_proto_init()
+	md_RunesConsolidation = File_side_btcbridge_btcbridge_proto.Messages().ByName("RunesConsolidation")
+	fd_RunesConsolidation_rune_id = md_RunesConsolidation.Fields().ByName("rune_id")
+	fd_RunesConsolidation_target_threshold = md_RunesConsolidation.Fields().ByName("target_threshold")
+	fd_RunesConsolidation_max_num = md_RunesConsolidation.Fields().ByName("max_num")
+}
+
+var _ protoreflect.Message = (*fastReflection_RunesConsolidation)(nil)
+
+type fastReflection_RunesConsolidation RunesConsolidation
+
+func (x *RunesConsolidation) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_RunesConsolidation)(x)
+}
+
+func (x *RunesConsolidation) slowProtoReflect() protoreflect.Message {
+	mi := &file_side_btcbridge_btcbridge_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_RunesConsolidation_messageType fastReflection_RunesConsolidation_messageType
+var _ protoreflect.MessageType = fastReflection_RunesConsolidation_messageType{}
+
+type fastReflection_RunesConsolidation_messageType struct{}
+
+func (x fastReflection_RunesConsolidation_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_RunesConsolidation)(nil)
+}
+func (x fastReflection_RunesConsolidation_messageType) New() protoreflect.Message {
+	return new(fastReflection_RunesConsolidation)
+}
+func (x fastReflection_RunesConsolidation_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_RunesConsolidation
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_RunesConsolidation) Descriptor() protoreflect.MessageDescriptor {
+	return md_RunesConsolidation
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_RunesConsolidation) Type() protoreflect.MessageType {
+	return _fastReflection_RunesConsolidation_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_RunesConsolidation) New() protoreflect.Message {
+	return new(fastReflection_RunesConsolidation)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_RunesConsolidation) Interface() protoreflect.ProtoMessage {
+	return (*RunesConsolidation)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_RunesConsolidation) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.RuneId != "" {
+		value := protoreflect.ValueOfString(x.RuneId)
+		if !f(fd_RunesConsolidation_rune_id, value) {
+			return
+		}
+	}
+	if x.TargetThreshold != "" {
+		value := protoreflect.ValueOfString(x.TargetThreshold)
+		if !f(fd_RunesConsolidation_target_threshold, value) {
+			return
+		}
+	}
+	if x.MaxNum != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.MaxNum)
+		if !f(fd_RunesConsolidation_max_num, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_RunesConsolidation) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "side.btcbridge.RunesConsolidation.rune_id":
+		return x.RuneId != ""
+	case "side.btcbridge.RunesConsolidation.target_threshold":
+		return x.TargetThreshold != ""
+	case "side.btcbridge.RunesConsolidation.max_num":
+		return x.MaxNum != uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.RunesConsolidation"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.RunesConsolidation does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RunesConsolidation) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "side.btcbridge.RunesConsolidation.rune_id":
+		x.RuneId = ""
+	case "side.btcbridge.RunesConsolidation.target_threshold":
+		x.TargetThreshold = ""
+	case "side.btcbridge.RunesConsolidation.max_num":
+		x.MaxNum = uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.RunesConsolidation"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.RunesConsolidation does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_RunesConsolidation) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "side.btcbridge.RunesConsolidation.rune_id":
+		value := x.RuneId
+		return protoreflect.ValueOfString(value)
+	case "side.btcbridge.RunesConsolidation.target_threshold":
+		value := x.TargetThreshold
+		return protoreflect.ValueOfString(value)
+	case "side.btcbridge.RunesConsolidation.max_num":
+		value := x.MaxNum
+		return protoreflect.ValueOfUint32(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.RunesConsolidation"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.RunesConsolidation does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RunesConsolidation) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "side.btcbridge.RunesConsolidation.rune_id":
+		x.RuneId = value.Interface().(string)
+	case "side.btcbridge.RunesConsolidation.target_threshold":
+		x.TargetThreshold = value.Interface().(string)
+	case "side.btcbridge.RunesConsolidation.max_num":
+		x.MaxNum = uint32(value.Uint())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.RunesConsolidation"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.RunesConsolidation does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RunesConsolidation) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "side.btcbridge.RunesConsolidation.rune_id":
+		panic(fmt.Errorf("field rune_id of message side.btcbridge.RunesConsolidation is not mutable"))
+	case "side.btcbridge.RunesConsolidation.target_threshold":
+		panic(fmt.Errorf("field target_threshold of message side.btcbridge.RunesConsolidation is not mutable"))
+	case "side.btcbridge.RunesConsolidation.max_num":
+		panic(fmt.Errorf("field max_num of message side.btcbridge.RunesConsolidation is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.RunesConsolidation"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.RunesConsolidation does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_RunesConsolidation) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "side.btcbridge.RunesConsolidation.rune_id":
+		return protoreflect.ValueOfString("")
+	case "side.btcbridge.RunesConsolidation.target_threshold":
+		return protoreflect.ValueOfString("")
+	case "side.btcbridge.RunesConsolidation.max_num":
+		return protoreflect.ValueOfUint32(uint32(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.RunesConsolidation"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.RunesConsolidation does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_RunesConsolidation) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in side.btcbridge.RunesConsolidation", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_RunesConsolidation) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RunesConsolidation) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_RunesConsolidation) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_RunesConsolidation) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*RunesConsolidation)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.RuneId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.TargetThreshold)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.MaxNum != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxNum))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*RunesConsolidation)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.MaxNum != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxNum))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.TargetThreshold) > 0 {
+			i -= len(x.TargetThreshold)
+			copy(dAtA[i:], x.TargetThreshold)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TargetThreshold)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.RuneId) > 0 {
+			i -= len(x.RuneId)
+			copy(dAtA[i:], x.RuneId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RuneId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*RunesConsolidation)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RunesConsolidation: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RunesConsolidation: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RuneId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RuneId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TargetThreshold", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TargetThreshold = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxNum", wireType)
+				}
+				x.MaxNum = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxNum |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_DKGParticipant                  protoreflect.MessageDescriptor
+	fd_DKGParticipant_moniker          protoreflect.FieldDescriptor
+	fd_DKGParticipant_operator_address protoreflect.FieldDescriptor
+	fd_DKGParticipant_consensus_pubkey protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_side_btcbridge_btcbridge_proto_init()
+	md_DKGParticipant = File_side_btcbridge_btcbridge_proto.Messages().ByName("DKGParticipant")
+	fd_DKGParticipant_moniker = md_DKGParticipant.Fields().ByName("moniker")
+	fd_DKGParticipant_operator_address = md_DKGParticipant.Fields().ByName("operator_address")
+	fd_DKGParticipant_consensus_pubkey = md_DKGParticipant.Fields().ByName("consensus_pubkey")
+}
+
+var _ protoreflect.Message = (*fastReflection_DKGParticipant)(nil)
+
+type fastReflection_DKGParticipant DKGParticipant
+
+func (x *DKGParticipant) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_DKGParticipant)(x)
+}
+
+func (x *DKGParticipant) slowProtoReflect() protoreflect.Message {
+	mi := &file_side_btcbridge_btcbridge_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_DKGParticipant_messageType fastReflection_DKGParticipant_messageType
+var _ protoreflect.MessageType = fastReflection_DKGParticipant_messageType{}
+
+type fastReflection_DKGParticipant_messageType struct{}
+
+func (x fastReflection_DKGParticipant_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_DKGParticipant)(nil)
+}
+func (x fastReflection_DKGParticipant_messageType) New() protoreflect.Message {
+	return new(fastReflection_DKGParticipant)
+}
+func (x fastReflection_DKGParticipant_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_DKGParticipant
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_DKGParticipant) Descriptor() protoreflect.MessageDescriptor {
+	return md_DKGParticipant
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_DKGParticipant) Type() protoreflect.MessageType {
+	return _fastReflection_DKGParticipant_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_DKGParticipant) New() protoreflect.Message {
+	return new(fastReflection_DKGParticipant)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_DKGParticipant) Interface() protoreflect.ProtoMessage {
+	return (*DKGParticipant)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_DKGParticipant) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Moniker != "" {
+		value := protoreflect.ValueOfString(x.Moniker)
+		if !f(fd_DKGParticipant_moniker, value) {
+			return
+		}
+	}
+	if x.OperatorAddress != "" {
+		value := protoreflect.ValueOfString(x.OperatorAddress)
+		if !f(fd_DKGParticipant_operator_address, value) {
+			return
+		}
+	}
+	if x.ConsensusPubkey != "" {
+		value := protoreflect.ValueOfString(x.ConsensusPubkey)
+		if !f(fd_DKGParticipant_consensus_pubkey, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_DKGParticipant) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "side.btcbridge.DKGParticipant.moniker":
+		return x.Moniker != ""
+	case "side.btcbridge.DKGParticipant.operator_address":
+		return x.OperatorAddress != ""
+	case "side.btcbridge.DKGParticipant.consensus_pubkey":
+		return x.ConsensusPubkey != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.DKGParticipant"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.DKGParticipant does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DKGParticipant) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "side.btcbridge.DKGParticipant.moniker":
+		x.Moniker = ""
+	case "side.btcbridge.DKGParticipant.operator_address":
+		x.OperatorAddress = ""
+	case "side.btcbridge.DKGParticipant.consensus_pubkey":
+		x.ConsensusPubkey = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.DKGParticipant"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.DKGParticipant does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_DKGParticipant) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "side.btcbridge.DKGParticipant.moniker":
+		value := x.Moniker
+		return protoreflect.ValueOfString(value)
+	case "side.btcbridge.DKGParticipant.operator_address":
+		value := x.OperatorAddress
+		return protoreflect.ValueOfString(value)
+	case "side.btcbridge.DKGParticipant.consensus_pubkey":
+		value := x.ConsensusPubkey
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.DKGParticipant"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.DKGParticipant does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DKGParticipant) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "side.btcbridge.DKGParticipant.moniker":
+		x.Moniker = value.Interface().(string)
+	case "side.btcbridge.DKGParticipant.operator_address":
+		x.OperatorAddress = value.Interface().(string)
+	case "side.btcbridge.DKGParticipant.consensus_pubkey":
+		x.ConsensusPubkey = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.DKGParticipant"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.DKGParticipant does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DKGParticipant) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "side.btcbridge.DKGParticipant.moniker":
+		panic(fmt.Errorf("field moniker of message side.btcbridge.DKGParticipant is not mutable"))
+	case "side.btcbridge.DKGParticipant.operator_address":
+		panic(fmt.Errorf("field operator_address of message side.btcbridge.DKGParticipant is not mutable"))
+	case "side.btcbridge.DKGParticipant.consensus_pubkey":
+		panic(fmt.Errorf("field consensus_pubkey of message side.btcbridge.DKGParticipant is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.DKGParticipant"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.DKGParticipant does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_DKGParticipant) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "side.btcbridge.DKGParticipant.moniker":
+		return protoreflect.ValueOfString("")
+	case "side.btcbridge.DKGParticipant.operator_address":
+		return protoreflect.ValueOfString("")
+	case "side.btcbridge.DKGParticipant.consensus_pubkey":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.DKGParticipant"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.DKGParticipant does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_DKGParticipant) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in side.btcbridge.DKGParticipant", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_DKGParticipant) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DKGParticipant) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_DKGParticipant) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_DKGParticipant) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*DKGParticipant)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Moniker)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.OperatorAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ConsensusPubkey)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*DKGParticipant)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.ConsensusPubkey) > 0 {
+			i -= len(x.ConsensusPubkey)
+			copy(dAtA[i:], x.ConsensusPubkey)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ConsensusPubkey)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.OperatorAddress) > 0 {
+			i -= len(x.OperatorAddress)
+			copy(dAtA[i:], x.OperatorAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.OperatorAddress)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Moniker) > 0 {
+			i -= len(x.Moniker)
+			copy(dAtA[i:], x.Moniker)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Moniker)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*DKGParticipant)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DKGParticipant: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DKGParticipant: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Moniker", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Moniker = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.OperatorAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ConsensusPubkey", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ConsensusPubkey = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_DKGRequest_2_list)(nil)
+
+type _DKGRequest_2_list struct {
+	list *[]*DKGParticipant
+}
+
+func (x *_DKGRequest_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_DKGRequest_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_DKGRequest_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DKGParticipant)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_DKGRequest_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DKGParticipant)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_DKGRequest_2_list) AppendMutable() protoreflect.Value {
+	v := new(DKGParticipant)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_DKGRequest_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_DKGRequest_2_list) NewElement() protoreflect.Value {
+	v := new(DKGParticipant)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_DKGRequest_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_DKGRequest_4_list)(nil)
+
+type _DKGRequest_4_list struct {
+	list *[]AssetType
+}
+
+func (x *_DKGRequest_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_DKGRequest_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfEnum((protoreflect.EnumNumber)((*x.list)[i]))
+}
+
+func (x *_DKGRequest_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Enum()
+	concreteValue := (AssetType)(valueUnwrapped)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_DKGRequest_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Enum()
+	concreteValue := (AssetType)(valueUnwrapped)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_DKGRequest_4_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message DKGRequest at list field VaultTypes as it is not of Message kind"))
+}
+
+func (x *_DKGRequest_4_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_DKGRequest_4_list) NewElement() protoreflect.Value {
+	v := 0
+	return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(v))
+}
+
+func (x *_DKGRequest_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_DKGRequest_10_list)(nil)
+
+type _DKGRequest_10_list struct {
+	list *[]*DKGParticipant
+}
+
+func (x *_DKGRequest_10_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_DKGRequest_10_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_DKGRequest_10_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DKGParticipant)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_DKGRequest_10_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DKGParticipant)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_DKGRequest_10_list) AppendMutable() protoreflect.Value {
+	v := new(DKGParticipant)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_DKGRequest_10_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_DKGRequest_10_list) NewElement() protoreflect.Value {
+	v := new(DKGParticipant)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_DKGRequest_10_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_DKGRequest                 protoreflect.MessageDescriptor
+	fd_DKGRequest_id              protoreflect.FieldDescriptor
+	fd_DKGRequest_participants    protoreflect.FieldDescriptor
+	fd_DKGRequest_threshold       protoreflect.FieldDescriptor
+	fd_DKGRequest_vault_types     protoreflect.FieldDescriptor
+	fd_DKGRequest_enable_transfer protoreflect.FieldDescriptor
+	fd_DKGRequest_target_utxo_num protoreflect.FieldDescriptor
+	fd_DKGRequest_expiration      protoreflect.FieldDescriptor
+	fd_DKGRequest_status          protoreflect.FieldDescriptor
+	fd_DKGRequest_taproot_tree    protoreflect.FieldDescriptor
+	fd_DKGRequest_non_responders  protoreflect.FieldDescriptor
+	fd_DKGRequest_retry_of        protoreflect.FieldDescriptor
+	fd_DKGRequest_retry_count     protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_side_btcbridge_btcbridge_proto_init()
+	md_DKGRequest = File_side_btcbridge_btcbridge_proto.Messages().ByName("DKGRequest")
+	fd_DKGRequest_id = md_DKGRequest.Fields().ByName("id")
+	fd_DKGRequest_participants = md_DKGRequest.Fields().ByName("participants")
+	fd_DKGRequest_threshold = md_DKGRequest.Fields().ByName("threshold")
+	fd_DKGRequest_vault_types = md_DKGRequest.Fields().ByName("vault_types")
+	fd_DKGRequest_enable_transfer = md_DKGRequest.Fields().ByName("enable_transfer")
+	fd_DKGRequest_target_utxo_num = md_DKGRequest.Fields().ByName("target_utxo_num")
+	fd_DKGRequest_expiration = md_DKGRequest.Fields().ByName("expiration")
+	fd_DKGRequest_status = md_DKGRequest.Fields().ByName("status")
+	fd_DKGRequest_taproot_tree = md_DKGRequest.Fields().ByName("taproot_tree")
+	fd_DKGRequest_non_responders = md_DKGRequest.Fields().ByName("non_responders")
+	fd_DKGRequest_retry_of = md_DKGRequest.Fields().ByName("retry_of")
+	fd_DKGRequest_retry_count = md_DKGRequest.Fields().ByName("retry_count")
+}
+
+var _ protoreflect.Message = (*fastReflection_DKGRequest)(nil)
+
+type fastReflection_DKGRequest DKGRequest
+
+func (x *DKGRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_DKGRequest)(x)
+}
+
+func (x *DKGRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_side_btcbridge_btcbridge_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_DKGRequest_messageType fastReflection_DKGRequest_messageType
+var _ protoreflect.MessageType = fastReflection_DKGRequest_messageType{}
+
+type fastReflection_DKGRequest_messageType struct{}
+
+func (x fastReflection_DKGRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_DKGRequest)(nil)
+}
+func (x fastReflection_DKGRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_DKGRequest)
+}
+func (x fastReflection_DKGRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_DKGRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_DKGRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_DKGRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_DKGRequest) Type() protoreflect.MessageType {
+	return _fastReflection_DKGRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_DKGRequest) New() protoreflect.Message {
+	return new(fastReflection_DKGRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_DKGRequest) Interface() protoreflect.ProtoMessage {
+	return (*DKGRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_DKGRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_DKGRequest_id, value) {
+			return
+		}
+	}
+	if len(x.Participants) != 0 {
+		value := protoreflect.ValueOfList(&_DKGRequest_2_list{list: &x.Participants})
+		if !f(fd_DKGRequest_participants, value) {
+			return
+		}
+	}
+	if x.Threshold != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Threshold)
+		if !f(fd_DKGRequest_threshold, value) {
+			return
+		}
+	}
+	if len(x.VaultTypes) != 0 {
+		value := protoreflect.ValueOfList(&_DKGRequest_4_list{list: &x.VaultTypes})
+		if !f(fd_DKGRequest_vault_types, value) {
+			return
+		}
+	}
+	if x.EnableTransfer != false {
+		value := protoreflect.ValueOfBool(x.EnableTransfer)
+		if !f(fd_DKGRequest_enable_transfer, value) {
+			return
+		}
+	}
+	if x.TargetUtxoNum != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.TargetUtxoNum)
+		if !f(fd_DKGRequest_target_utxo_num, value) {
+			return
+		}
+	}
+	if x.Expiration != nil {
+		value := protoreflect.ValueOfMessage(x.Expiration.ProtoReflect())
+		if !f(fd_DKGRequest_expiration, value) {
+			return
+		}
+	}
+	if x.Status != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Status))
+		if !f(fd_DKGRequest_status, value) {
+			return
+		}
+	}
+	if x.TaprootTree != nil {
+		value := protoreflect.ValueOfMessage(x.TaprootTree.ProtoReflect())
+		if !f(fd_DKGRequest_taproot_tree, value) {
+			return
+		}
+	}
+	if len(x.NonResponders) != 0 {
+		value := protoreflect.ValueOfList(&_DKGRequest_10_list{list: &x.NonResponders})
+		if !f(fd_DKGRequest_non_responders, value) {
+			return
+		}
+	}
+	if x.RetryOf != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.RetryOf)
+		if !f(fd_DKGRequest_retry_of, value) {
+			return
+		}
+	}
+	if x.RetryCount != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.RetryCount)
+		if !f(fd_DKGRequest_retry_count, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_DKGRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "side.btcbridge.DKGRequest.id":
+		return x.Id != uint64(0)
+	case "side.btcbridge.DKGRequest.participants":
+		return len(x.Participants) != 0
+	case "side.btcbridge.DKGRequest.threshold":
+		return x.Threshold != uint32(0)
+	case "side.btcbridge.DKGRequest.vault_types":
+		return len(x.VaultTypes) != 0
+	case "side.btcbridge.DKGRequest.enable_transfer":
+		return x.EnableTransfer != false
+	case "side.btcbridge.DKGRequest.target_utxo_num":
+		return x.TargetUtxoNum != uint32(0)
+	case "side.btcbridge.DKGRequest.expiration":
+		return x.Expiration != nil
+	case "side.btcbridge.DKGRequest.status":
+		return x.Status != 0
+	case "side.btcbridge.DKGRequest.taproot_tree":
+		return x.TaprootTree != nil
+	case "side.btcbridge.DKGRequest.non_responders":
+		return len(x.NonResponders) != 0
+	case "side.btcbridge.DKGRequest.retry_of":
+		return x.RetryOf != uint64(0)
+	case "side.btcbridge.DKGRequest.retry_count":
+		return x.RetryCount != uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.DKGRequest"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.DKGRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DKGRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "side.btcbridge.DKGRequest.id":
+		x.Id = uint64(0)
+	case "side.btcbridge.DKGRequest.participants":
+		x.Participants = nil
+	case "side.btcbridge.DKGRequest.threshold":
+		x.Threshold = uint32(0)
+	case "side.btcbridge.DKGRequest.vault_types":
+		x.VaultTypes = nil
+	case "side.btcbridge.DKGRequest.enable_transfer":
+		x.EnableTransfer = false
+	case "side.btcbridge.DKGRequest.target_utxo_num":
+		x.TargetUtxoNum = uint32(0)
+	case "side.btcbridge.DKGRequest.expiration":
+		x.Expiration = nil
+	case "side.btcbridge.DKGRequest.status":
+		x.Status = 0
+	case "side.btcbridge.DKGRequest.taproot_tree":
+		x.TaprootTree = nil
+	case "side.btcbridge.DKGRequest.non_responders":
+		x.NonResponders = nil
+	case "side.btcbridge.DKGRequest.retry_of":
+		x.RetryOf = uint64(0)
+	case "side.btcbridge.DKGRequest.retry_count":
+		x.RetryCount = uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.DKGRequest"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.DKGRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_DKGRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "side.btcbridge.DKGRequest.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "side.btcbridge.DKGRequest.participants":
+		if len(x.Participants) == 0 {
+			return protoreflect.ValueOfList(&_DKGRequest_2_list{})
+		}
+		listValue := &_DKGRequest_2_list{list: &x.Participants}
+		return protoreflect.ValueOfList(listValue)
+	case "side.btcbridge.DKGRequest.threshold":
+		value := x.Threshold
+		return protoreflect.ValueOfUint32(value)
+	case "side.btcbridge.DKGRequest.vault_types":
+		if len(x.VaultTypes) == 0 {
+			return protoreflect.ValueOfList(&_DKGRequest_4_list{})
+		}
+		listValue := &_DKGRequest_4_list{list: &x.VaultTypes}
+		return protoreflect.ValueOfList(listValue)
+	case "side.btcbridge.DKGRequest.enable_transfer":
+		value := x.EnableTransfer
+		return protoreflect.ValueOfBool(value)
+	case "side.btcbridge.DKGRequest.target_utxo_num":
+		value := x.TargetUtxoNum
+		return protoreflect.ValueOfUint32(value)
+	case "side.btcbridge.DKGRequest.expiration":
+		value := x.Expiration
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "side.btcbridge.DKGRequest.status":
+		value := x.Status
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "side.btcbridge.DKGRequest.taproot_tree":
+		value := x.TaprootTree
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "side.btcbridge.DKGRequest.non_responders":
+		if len(x.NonResponders) == 0 {
+			return protoreflect.ValueOfList(&_DKGRequest_10_list{})
+		}
+		listValue := &_DKGRequest_10_list{list: &x.NonResponders}
+		return protoreflect.ValueOfList(listValue)
+	case "side.btcbridge.DKGRequest.retry_of":
+		value := x.RetryOf
+		return protoreflect.ValueOfUint64(value)
+	case "side.btcbridge.DKGRequest.retry_count":
+		value := x.RetryCount
+		return protoreflect.ValueOfUint32(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.DKGRequest"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.DKGRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DKGRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "side.btcbridge.DKGRequest.id":
+		x.Id = value.Uint()
+	case "side.btcbridge.DKGRequest.participants":
+		lv := value.List()
+		clv := lv.(*_DKGRequest_2_list)
+		x.Participants = *clv.list
+	case "side.btcbridge.DKGRequest.threshold":
+		x.Threshold = uint32(value.Uint())
+	case "side.btcbridge.DKGRequest.vault_types":
+		lv := value.List()
+		clv := lv.(*_DKGRequest_4_list)
+		x.VaultTypes = *clv.list
+	case "side.btcbridge.DKGRequest.enable_transfer":
+		x.EnableTransfer = value.Bool()
+	case "side.btcbridge.DKGRequest.target_utxo_num":
+		x.TargetUtxoNum = uint32(value.Uint())
+	case "side.btcbridge.DKGRequest.expiration":
+		x.Expiration = value.Message().Interface().(*timestamppb.Timestamp)
+	case "side.btcbridge.DKGRequest.status":
+		x.Status = (DKGRequestStatus)(value.Enum())
+	case "side.btcbridge.DKGRequest.taproot_tree":
+		x.TaprootTree = value.Message().Interface().(*VaultTaprootTree)
+	case "side.btcbridge.DKGRequest.non_responders":
+		lv := value.List()
+		clv := lv.(*_DKGRequest_10_list)
+		x.NonResponders = *clv.list
+	case "side.btcbridge.DKGRequest.retry_of":
+		x.RetryOf = value.Uint()
+	case "side.btcbridge.DKGRequest.retry_count":
+		x.RetryCount = uint32(value.Uint())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.DKGRequest"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.DKGRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DKGRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "side.btcbridge.DKGRequest.participants":
+		if x.Participants == nil {
+			x.Participants = []*DKGParticipant{}
+		}
+		value := &_DKGRequest_2_list{list: &x.Participants}
+		return protoreflect.ValueOfList(value)
+	case "side.btcbridge.DKGRequest.vault_types":
+		if x.VaultTypes == nil {
+			x.VaultTypes = []AssetType{}
+		}
+		value := &_DKGRequest_4_list{list: &x.VaultTypes}
+		return protoreflect.ValueOfList(value)
+	case "side.btcbridge.DKGRequest.expiration":
+		if x.Expiration == nil {
+			x.Expiration = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.Expiration.ProtoReflect())
+	case "side.btcbridge.DKGRequest.taproot_tree":
+		if x.TaprootTree == nil {
+			x.TaprootTree = new(VaultTaprootTree)
+		}
+		return protoreflect.ValueOfMessage(x.TaprootTree.ProtoReflect())
+	case "side.btcbridge.DKGRequest.non_responders":
+		if x.NonResponders == nil {
+			x.NonResponders = []*DKGParticipant{}
+		}
+		value := &_DKGRequest_10_list{list: &x.NonResponders}
+		return protoreflect.ValueOfList(value)
+	case "side.btcbridge.DKGRequest.id":
+		panic(fmt.Errorf("field id of message side.btcbridge.DKGRequest is not mutable"))
+	case "side.btcbridge.DKGRequest.threshold":
+		panic(fmt.Errorf("field threshold of message side.btcbridge.DKGRequest is not mutable"))
+	case "side.btcbridge.DKGRequest.enable_transfer":
+		panic(fmt.Errorf("field enable_transfer of message side.btcbridge.DKGRequest is not mutable"))
+	case "side.btcbridge.DKGRequest.target_utxo_num":
+		panic(fmt.Errorf("field target_utxo_num of message side.btcbridge.DKGRequest is not mutable"))
+	case "side.btcbridge.DKGRequest.status":
+		panic(fmt.Errorf("field status of message side.btcbridge.DKGRequest is not mutable"))
+	case "side.btcbridge.DKGRequest.retry_of":
+		panic(fmt.Errorf("field retry_of of message side.btcbridge.DKGRequest is not mutable"))
+	case "side.btcbridge.DKGRequest.retry_count":
+		panic(fmt.Errorf("field retry_count of message side.btcbridge.DKGRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.DKGRequest"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.DKGRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_DKGRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "side.btcbridge.DKGRequest.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "side.btcbridge.DKGRequest.participants":
+		list := []*DKGParticipant{}
+		return protoreflect.ValueOfList(&_DKGRequest_2_list{list: &list})
+	case "side.btcbridge.DKGRequest.threshold":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "side.btcbridge.DKGRequest.vault_types":
+		list := []AssetType{}
+		return protoreflect.ValueOfList(&_DKGRequest_4_list{list: &list})
+	case "side.btcbridge.DKGRequest.enable_transfer":
+		return protoreflect.ValueOfBool(false)
+	case "side.btcbridge.DKGRequest.target_utxo_num":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "side.btcbridge.DKGRequest.expiration":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "side.btcbridge.DKGRequest.status":
+		return protoreflect.ValueOfEnum(0)
+	case "side.btcbridge.DKGRequest.taproot_tree":
+		m := new(VaultTaprootTree)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "side.btcbridge.DKGRequest.non_responders":
+		list := []*DKGParticipant{}
+		return protoreflect.ValueOfList(&_DKGRequest_10_list{list: &list})
+	case "side.btcbridge.DKGRequest.retry_of":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "side.btcbridge.DKGRequest.retry_count":
+		return protoreflect.ValueOfUint32(uint32(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.DKGRequest"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.DKGRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_DKGRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in side.btcbridge.DKGRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_DKGRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DKGRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_DKGRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_DKGRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*DKGRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		if len(x.Participants) > 0 {
+			for _, e := range x.Participants {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Threshold != 0 {
+			n += 1 + runtime.Sov(uint64(x.Threshold))
+		}
+		if len(x.VaultTypes) > 0 {
+			l = 0
+			for _, e := range x.VaultTypes {
+				l += runtime.Sov(uint64(e))
+			}
+			n += 1 + runtime.Sov(uint64(l)) + l
+		}
+		if x.EnableTransfer {
+			n += 2
+		}
+		if x.TargetUtxoNum != 0 {
+			n += 1 + runtime.Sov(uint64(x.TargetUtxoNum))
+		}
+		if x.Expiration != nil {
+			l = options.Size(x.Expiration)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Status != 0 {
+			n += 1 + runtime.Sov(uint64(x.Status))
+		}
+		if x.TaprootTree != nil {
+			l = options.Size(x.TaprootTree)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.NonResponders) > 0 {
+			for _, e := range x.NonResponders {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.RetryOf != 0 {
+			n += 1 + runtime.Sov(uint64(x.RetryOf))
+		}
+		if x.RetryCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.RetryCount))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*DKGRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.RetryCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RetryCount))
+			i--
+			dAtA[i] = 0x60
+		}
+		if x.RetryOf != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RetryOf))
+			i--
+			dAtA[i] = 0x58
+		}
+		if len(x.NonResponders) > 0 {
+			for iNdEx := len(x.NonResponders) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.NonResponders[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x52
+			}
+		}
+		if x.TaprootTree != nil {
+			encoded, err := options.Marshal(x.TaprootTree)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x4a
+		}
+		if x.Status != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Status))
+			i--
+			dAtA[i] = 0x40
+		}
+		if x.Expiration != nil {
+			encoded, err := options.Marshal(x.Expiration)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if x.TargetUtxoNum != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TargetUtxoNum))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.EnableTransfer {
+			i--
+			if x.EnableTransfer {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.VaultTypes) > 0 {
+			var pksize2 int
+			for _, num := range x.VaultTypes {
+				pksize2 += runtime.Sov(uint64(num))
+			}
+			i -= pksize2
+			j1 := i
+			for _, num1 := range x.VaultTypes {
+				num := uint64(num1)
+				for num >= 1<<7 {
+					dAtA[j1] = uint8(uint64(num)&0x7f | 0x80)
+					num >>= 7
+					j1++
+				}
+				dAtA[j1] = uint8(num)
+				j1++
+			}
+			i = runtime.EncodeVarint(dAtA, i, uint64(pksize2))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.Threshold != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Threshold))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Participants) > 0 {
+			for iNdEx := len(x.Participants) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Participants[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*DKGRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DKGRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DKGRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Participants", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Participants = append(x.Participants, &DKGParticipant{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Participants[len(x.Participants)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+				}
+				x.Threshold = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Threshold |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType == 0 {
+					var v AssetType
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= AssetType(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					x.VaultTypes = append(x.VaultTypes, v)
+				} else if wireType == 2 {
+					var packedLen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						packedLen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if packedLen < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					postIndex := iNdEx + packedLen
+					if postIndex < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if postIndex > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					var elementCount int
+					if elementCount != 0 && len(x.VaultTypes) == 0 {
+						x.VaultTypes = make([]AssetType, 0, elementCount)
+					}
+					for iNdEx < postIndex {
+						var v AssetType
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							v |= AssetType(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						x.VaultTypes = append(x.VaultTypes, v)
+					}
+				} else {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VaultTypes", wireType)
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EnableTransfer", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.EnableTransfer = bool(v != 0)
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TargetUtxoNum", wireType)
+				}
+				x.TargetUtxoNum = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TargetUtxoNum |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Expiration == nil {
+					x.Expiration = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Expiration); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				}
+				x.Status = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Status |= DKGRequestStatus(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TaprootTree", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.TaprootTree == nil {
+					x.TaprootTree = &VaultTaprootTree{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TaprootTree); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NonResponders", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.NonResponders = append(x.NonResponders, &DKGParticipant{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.NonResponders[len(x.NonResponders)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 11:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RetryOf", wireType)
+				}
+				x.RetryOf = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RetryOf |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 12:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RetryCount", wireType)
+				}
+				x.RetryCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RetryCount |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_DKGCompletionRequest_3_list)(nil)
+
+type _DKGCompletionRequest_3_list struct {
+	list *[]string
+}
+
+func (x *_DKGCompletionRequest_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_DKGCompletionRequest_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_DKGCompletionRequest_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_DKGCompletionRequest_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_DKGCompletionRequest_3_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message DKGCompletionRequest at list field Vaults as it is not of Message kind"))
+}
+
+func (x *_DKGCompletionRequest_3_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_DKGCompletionRequest_3_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_DKGCompletionRequest_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_DKGCompletionRequest_6_list)(nil)
+
+type _DKGCompletionRequest_6_list struct {
+	list *[]string
+}
+
+func (x *_DKGCompletionRequest_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_DKGCompletionRequest_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_DKGCompletionRequest_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_DKGCompletionRequest_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_DKGCompletionRequest_6_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message DKGCompletionRequest at list field PubKeys as it is not of Message kind"))
+}
+
+func (x *_DKGCompletionRequest_6_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_DKGCompletionRequest_6_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_DKGCompletionRequest_6_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_DKGCompletionRequest_7_list)(nil)
+
+type _DKGCompletionRequest_7_list struct {
+	list *[]string
+}
+
+func (x *_DKGCompletionRequest_7_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_DKGCompletionRequest_7_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_DKGCompletionRequest_7_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_DKGCompletionRequest_7_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_DKGCompletionRequest_7_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message DKGCompletionRequest at list field Proofs as it is not of Message kind"))
+}
+
+func (x *_DKGCompletionRequest_7_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_DKGCompletionRequest_7_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_DKGCompletionRequest_7_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_DKGCompletionRequest_8_list)(nil)
+
+type _DKGCompletionRequest_8_list struct {
+	list *[]*VerificationShares
+}
+
+func (x *_DKGCompletionRequest_8_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_DKGCompletionRequest_8_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_DKGCompletionRequest_8_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*VerificationShares)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_DKGCompletionRequest_8_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*VerificationShares)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_DKGCompletionRequest_8_list) AppendMutable() protoreflect.Value {
+	v := new(VerificationShares)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_DKGCompletionRequest_8_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_DKGCompletionRequest_8_list) NewElement() protoreflect.Value {
+	v := new(VerificationShares)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_DKGCompletionRequest_8_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_DKGCompletionRequest                     protoreflect.MessageDescriptor
+	fd_DKGCompletionRequest_id                  protoreflect.FieldDescriptor
+	fd_DKGCompletionRequest_sender              protoreflect.FieldDescriptor
+	fd_DKGCompletionRequest_vaults              protoreflect.FieldDescriptor
+	fd_DKGCompletionRequest_consensus_address   protoreflect.FieldDescriptor
+	fd_DKGCompletionRequest_signature           protoreflect.FieldDescriptor
+	fd_DKGCompletionRequest_pub_keys            protoreflect.FieldDescriptor
+	fd_DKGCompletionRequest_proofs              protoreflect.FieldDescriptor
+	fd_DKGCompletionRequest_verification_shares protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_side_btcbridge_btcbridge_proto_init()
+	md_DKGCompletionRequest = File_side_btcbridge_btcbridge_proto.Messages().ByName("DKGCompletionRequest")
+	fd_DKGCompletionRequest_id = md_DKGCompletionRequest.Fields().ByName("id")
+	fd_DKGCompletionRequest_sender = md_DKGCompletionRequest.Fields().ByName("sender")
+	fd_DKGCompletionRequest_vaults = md_DKGCompletionRequest.Fields().ByName("vaults")
+	fd_DKGCompletionRequest_consensus_address = md_DKGCompletionRequest.Fields().ByName("consensus_address")
+	fd_DKGCompletionRequest_signature = md_DKGCompletionRequest.Fields().ByName("signature")
+	fd_DKGCompletionRequest_pub_keys = md_DKGCompletionRequest.Fields().ByName("pub_keys")
+	fd_DKGCompletionRequest_proofs = md_DKGCompletionRequest.Fields().ByName("proofs")
+	fd_DKGCompletionRequest_verification_shares = md_DKGCompletionRequest.Fields().ByName("verification_shares")
+}
+
+var _ protoreflect.Message = (*fastReflection_DKGCompletionRequest)(nil)
+
+type fastReflection_DKGCompletionRequest DKGCompletionRequest
+
+func (x *DKGCompletionRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_DKGCompletionRequest)(x)
+}
+
+func (x *DKGCompletionRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_side_btcbridge_btcbridge_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_DKGCompletionRequest_messageType fastReflection_DKGCompletionRequest_messageType
+var _ protoreflect.MessageType = fastReflection_DKGCompletionRequest_messageType{}
+
+type fastReflection_DKGCompletionRequest_messageType struct{}
+
+func (x fastReflection_DKGCompletionRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_DKGCompletionRequest)(nil)
+}
+func (x fastReflection_DKGCompletionRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_DKGCompletionRequest)
+}
+func (x fastReflection_DKGCompletionRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_DKGCompletionRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_DKGCompletionRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_DKGCompletionRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_DKGCompletionRequest) Type() protoreflect.MessageType {
+	return _fastReflection_DKGCompletionRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_DKGCompletionRequest) New() protoreflect.Message {
+	return new(fastReflection_DKGCompletionRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_DKGCompletionRequest) Interface() protoreflect.ProtoMessage {
+	return (*DKGCompletionRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_DKGCompletionRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_DKGCompletionRequest_id, value) {
+			return
+		}
+	}
+	if x.Sender != "" {
+		value := protoreflect.ValueOfString(x.Sender)
+		if !f(fd_DKGCompletionRequest_sender, value) {
+			return
+		}
+	}
+	if len(x.Vaults) != 0 {
+		value := protoreflect.ValueOfList(&_DKGCompletionRequest_3_list{list: &x.Vaults})
+		if !f(fd_DKGCompletionRequest_vaults, value) {
+			return
+		}
+	}
+	if x.ConsensusAddress != "" {
+		value := protoreflect.ValueOfString(x.ConsensusAddress)
+		if !f(fd_DKGCompletionRequest_consensus_address, value) {
+			return
+		}
+	}
+	if x.Signature != "" {
+		value := protoreflect.ValueOfString(x.Signature)
+		if !f(fd_DKGCompletionRequest_signature, value) {
+			return
+		}
+	}
+	if len(x.PubKeys) != 0 {
+		value := protoreflect.ValueOfList(&_DKGCompletionRequest_6_list{list: &x.PubKeys})
+		if !f(fd_DKGCompletionRequest_pub_keys, value) {
+			return
+		}
+	}
+	if len(x.Proofs) != 0 {
+		value := protoreflect.ValueOfList(&_DKGCompletionRequest_7_list{list: &x.Proofs})
+		if !f(fd_DKGCompletionRequest_proofs, value) {
+			return
+		}
+	}
+	if len(x.VerificationShares) != 0 {
+		value := protoreflect.ValueOfList(&_DKGCompletionRequest_8_list{list: &x.VerificationShares})
+		if !f(fd_DKGCompletionRequest_verification_shares, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_DKGCompletionRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "side.btcbridge.DKGCompletionRequest.id":
+		return x.Id != uint64(0)
+	case "side.btcbridge.DKGCompletionRequest.sender":
+		return x.Sender != ""
+	case "side.btcbridge.DKGCompletionRequest.vaults":
+		return len(x.Vaults) != 0
+	case "side.btcbridge.DKGCompletionRequest.consensus_address":
+		return x.ConsensusAddress != ""
+	case "side.btcbridge.DKGCompletionRequest.signature":
+		return x.Signature != ""
+	case "side.btcbridge.DKGCompletionRequest.pub_keys":
+		return len(x.PubKeys) != 0
+	case "side.btcbridge.DKGCompletionRequest.proofs":
+		return len(x.Proofs) != 0
+	case "side.btcbridge.DKGCompletionRequest.verification_shares":
+		return len(x.VerificationShares) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.DKGCompletionRequest"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.DKGCompletionRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DKGCompletionRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "side.btcbridge.DKGCompletionRequest.id":
+		x.Id = uint64(0)
+	case "side.btcbridge.DKGCompletionRequest.sender":
+		x.Sender = ""
+	case "side.btcbridge.DKGCompletionRequest.vaults":
+		x.Vaults = nil
+	case "side.btcbridge.DKGCompletionRequest.consensus_address":
+		x.ConsensusAddress = ""
+	case "side.btcbridge.DKGCompletionRequest.signature":
+		x.Signature = ""
+	case "side.btcbridge.DKGCompletionRequest.pub_keys":
+		x.PubKeys = nil
+	case "side.btcbridge.DKGCompletionRequest.proofs":
+		x.Proofs = nil
+	case "side.btcbridge.DKGCompletionRequest.verification_shares":
+		x.VerificationShares = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.DKGCompletionRequest"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.DKGCompletionRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_DKGCompletionRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "side.btcbridge.DKGCompletionRequest.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "side.btcbridge.DKGCompletionRequest.sender":
+		value := x.Sender
+		return protoreflect.ValueOfString(value)
+	case "side.btcbridge.DKGCompletionRequest.vaults":
+		if len(x.Vaults) == 0 {
+			return protoreflect.ValueOfList(&_DKGCompletionRequest_3_list{})
+		}
+		listValue := &_DKGCompletionRequest_3_list{list: &x.Vaults}
+		return protoreflect.ValueOfList(listValue)
+	case "side.btcbridge.DKGCompletionRequest.consensus_address":
+		value := x.ConsensusAddress
+		return protoreflect.ValueOfString(value)
+	case "side.btcbridge.DKGCompletionRequest.signature":
+		value := x.Signature
+		return protoreflect.ValueOfString(value)
+	case "side.btcbridge.DKGCompletionRequest.pub_keys":
+		if len(x.PubKeys) == 0 {
+			return protoreflect.ValueOfList(&_DKGCompletionRequest_6_list{})
+		}
+		listValue := &_DKGCompletionRequest_6_list{list: &x.PubKeys}
+		return protoreflect.ValueOfList(listValue)
+	case "side.btcbridge.DKGCompletionRequest.proofs":
+		if len(x.Proofs) == 0 {
+			return protoreflect.ValueOfList(&_DKGCompletionRequest_7_list{})
+		}
+		listValue := &_DKGCompletionRequest_7_list{list: &x.Proofs}
+		return protoreflect.ValueOfList(listValue)
+	case "side.btcbridge.DKGCompletionRequest.verification_shares":
+		if len(x.VerificationShares) == 0 {
+			return protoreflect.ValueOfList(&_DKGCompletionRequest_8_list{})
+		}
+		listValue := &_DKGCompletionRequest_8_list{list: &x.VerificationShares}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.DKGCompletionRequest"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.DKGCompletionRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DKGCompletionRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "side.btcbridge.DKGCompletionRequest.id":
+		x.Id = value.Uint()
+	case "side.btcbridge.DKGCompletionRequest.sender":
+		x.Sender = value.Interface().(string)
+	case "side.btcbridge.DKGCompletionRequest.vaults":
+		lv := value.List()
+		clv := lv.(*_DKGCompletionRequest_3_list)
+		x.Vaults = *clv.list
+	case "side.btcbridge.DKGCompletionRequest.consensus_address":
+		x.ConsensusAddress = value.Interface().(string)
+	case "side.btcbridge.DKGCompletionRequest.signature":
+		x.Signature = value.Interface().(string)
+	case "side.btcbridge.DKGCompletionRequest.pub_keys":
+		lv := value.List()
+		clv := lv.(*_DKGCompletionRequest_6_list)
+		x.PubKeys = *clv.list
+	case "side.btcbridge.DKGCompletionRequest.proofs":
+		lv := value.List()
+		clv := lv.(*_DKGCompletionRequest_7_list)
+		x.Proofs = *clv.list
+	case "side.btcbridge.DKGCompletionRequest.verification_shares":
+		lv := value.List()
+		clv := lv.(*_DKGCompletionRequest_8_list)
+		x.VerificationShares = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.DKGCompletionRequest"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.DKGCompletionRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DKGCompletionRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "side.btcbridge.DKGCompletionRequest.vaults":
+		if x.Vaults == nil {
+			x.Vaults = []string{}
+		}
+		value := &_DKGCompletionRequest_3_list{list: &x.Vaults}
+		return protoreflect.ValueOfList(value)
+	case "side.btcbridge.DKGCompletionRequest.pub_keys":
+		if x.PubKeys == nil {
+			x.PubKeys = []string{}
+		}
+		value := &_DKGCompletionRequest_6_list{list: &x.PubKeys}
+		return protoreflect.ValueOfList(value)
+	case "side.btcbridge.DKGCompletionRequest.proofs":
+		if x.Proofs == nil {
+			x.Proofs = []string{}
+		}
+		value := &_DKGCompletionRequest_7_list{list: &x.Proofs}
+		return protoreflect.ValueOfList(value)
+	case "side.btcbridge.DKGCompletionRequest.verification_shares":
+		if x.VerificationShares == nil {
+			x.VerificationShares = []*VerificationShares{}
+		}
+		value := &_DKGCompletionRequest_8_list{list: &x.VerificationShares}
+		return protoreflect.ValueOfList(value)
+	case "side.btcbridge.DKGCompletionRequest.id":
+		panic(fmt.Errorf("field id of message side.btcbridge.DKGCompletionRequest is not mutable"))
+	case "side.btcbridge.DKGCompletionRequest.sender":
+		panic(fmt.Errorf("field sender of message side.btcbridge.DKGCompletionRequest is not mutable"))
+	case "side.btcbridge.DKGCompletionRequest.consensus_address":
+		panic(fmt.Errorf("field consensus_address of message side.btcbridge.DKGCompletionRequest is not mutable"))
+	case "side.btcbridge.DKGCompletionRequest.signature":
+		panic(fmt.Errorf("field signature of message side.btcbridge.DKGCompletionRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.DKGCompletionRequest"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.DKGCompletionRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_DKGCompletionRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "side.btcbridge.DKGCompletionRequest.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "side.btcbridge.DKGCompletionRequest.sender":
+		return protoreflect.ValueOfString("")
+	case "side.btcbridge.DKGCompletionRequest.vaults":
+		list := []string{}
+		return protoreflect.ValueOfList(&_DKGCompletionRequest_3_list{list: &list})
+	case "side.btcbridge.DKGCompletionRequest.consensus_address":
+		return protoreflect.ValueOfString("")
+	case "side.btcbridge.DKGCompletionRequest.signature":
+		return protoreflect.ValueOfString("")
+	case "side.btcbridge.DKGCompletionRequest.pub_keys":
+		list := []string{}
+		return protoreflect.ValueOfList(&_DKGCompletionRequest_6_list{list: &list})
+	case "side.btcbridge.DKGCompletionRequest.proofs":
+		list := []string{}
+		return protoreflect.ValueOfList(&_DKGCompletionRequest_7_list{list: &list})
+	case "side.btcbridge.DKGCompletionRequest.verification_shares":
+		list := []*VerificationShares{}
+		return protoreflect.ValueOfList(&_DKGCompletionRequest_8_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.DKGCompletionRequest"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.DKGCompletionRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_DKGCompletionRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in side.btcbridge.DKGCompletionRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_DKGCompletionRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DKGCompletionRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_DKGCompletionRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_DKGCompletionRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*DKGCompletionRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		l = len(x.Sender)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Vaults) > 0 {
+			for _, s := range x.Vaults {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.ConsensusAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Signature)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.PubKeys) > 0 {
+			for _, s := range x.PubKeys {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.Proofs) > 0 {
+			for _, s := range x.Proofs {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.VerificationShares) > 0 {
+			for _, e := range x.VerificationShares {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*DKGCompletionRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.VerificationShares) > 0 {
+			for iNdEx := len(x.VerificationShares) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.VerificationShares[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x42
+			}
+		}
+		if len(x.Proofs) > 0 {
+			for iNdEx := len(x.Proofs) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Proofs[iNdEx])
+				copy(dAtA[i:], x.Proofs[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Proofs[iNdEx])))
+				i--
+				dAtA[i] = 0x3a
+			}
+		}
+		if len(x.PubKeys) > 0 {
+			for iNdEx := len(x.PubKeys) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.PubKeys[iNdEx])
+				copy(dAtA[i:], x.PubKeys[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PubKeys[iNdEx])))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
+		if len(x.Signature) > 0 {
+			i -= len(x.Signature)
+			copy(dAtA[i:], x.Signature)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Signature)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.ConsensusAddress) > 0 {
+			i -= len(x.ConsensusAddress)
+			copy(dAtA[i:], x.ConsensusAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ConsensusAddress)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Vaults) > 0 {
+			for iNdEx := len(x.Vaults) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Vaults[iNdEx])
+				copy(dAtA[i:], x.Vaults[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Vaults[iNdEx])))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if len(x.Sender) > 0 {
+			i -= len(x.Sender)
+			copy(dAtA[i:], x.Sender)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Sender)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*DKGCompletionRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DKGCompletionRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DKGCompletionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Sender = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Vaults", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Vaults = append(x.Vaults, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ConsensusAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ConsensusAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Signature = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PubKeys", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PubKeys = append(x.PubKeys, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Proofs", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Proofs = append(x.Proofs, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VerificationShares", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.VerificationShares = append(x.VerificationShares, &VerificationShares{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.VerificationShares[len(x.VerificationShares)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_VerificationShares_1_list)(nil)
+
+type _VerificationShares_1_list struct {
+	list *[]string
+}
+
+func (x *_VerificationShares_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_VerificationShares_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_VerificationShares_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_VerificationShares_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_VerificationShares_1_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message VerificationShares at list field Shares as it is not of Message kind"))
+}
+
+func (x *_VerificationShares_1_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_VerificationShares_1_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_VerificationShares_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_VerificationShares        protoreflect.MessageDescriptor
+	fd_VerificationShares_shares protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_side_btcbridge_btcbridge_proto_init()
+	md_VerificationShares = File_side_btcbridge_btcbridge_proto.Messages().ByName("VerificationShares")
+	fd_VerificationShares_shares = md_VerificationShares.Fields().ByName("shares")
+}
+
+var _ protoreflect.Message = (*fastReflection_VerificationShares)(nil)
+
+type fastReflection_VerificationShares VerificationShares
+
+func (x *VerificationShares) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_VerificationShares)(x)
+}
+
+func (x *VerificationShares) slowProtoReflect() protoreflect.Message {
+	mi := &file_side_btcbridge_btcbridge_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_VerificationShares_messageType fastReflection_VerificationShares_messageType
+var _ protoreflect.MessageType = fastReflection_VerificationShares_messageType{}
+
+type fastReflection_VerificationShares_messageType struct{}
+
+func (x fastReflection_VerificationShares_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_VerificationShares)(nil)
+}
+func (x fastReflection_VerificationShares_messageType) New() protoreflect.Message {
+	return new(fastReflection_VerificationShares)
+}
+func (x fastReflection_VerificationShares_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_VerificationShares
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_VerificationShares) Descriptor() protoreflect.MessageDescriptor {
+	return md_VerificationShares
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_VerificationShares) Type() protoreflect.MessageType {
+	return _fastReflection_VerificationShares_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_VerificationShares) New() protoreflect.Message {
+	return new(fastReflection_VerificationShares)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_VerificationShares) Interface() protoreflect.ProtoMessage {
+	return (*VerificationShares)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_VerificationShares) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Shares) != 0 {
+		value := protoreflect.ValueOfList(&_VerificationShares_1_list{list: &x.Shares})
+		if !f(fd_VerificationShares_shares, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_VerificationShares) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "side.btcbridge.VerificationShares.shares":
+		return len(x.Shares) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.VerificationShares"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.VerificationShares does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VerificationShares) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "side.btcbridge.VerificationShares.shares":
+		x.Shares = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.VerificationShares"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.VerificationShares does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_VerificationShares) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "side.btcbridge.VerificationShares.shares":
+		if len(x.Shares) == 0 {
+			return protoreflect.ValueOfList(&_VerificationShares_1_list{})
+		}
+		listValue := &_VerificationShares_1_list{list: &x.Shares}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.VerificationShares"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.VerificationShares does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VerificationShares) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "side.btcbridge.VerificationShares.shares":
+		lv := value.List()
+		clv := lv.(*_VerificationShares_1_list)
+		x.Shares = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.VerificationShares"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.VerificationShares does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VerificationShares) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "side.btcbridge.VerificationShares.shares":
+		if x.Shares == nil {
+			x.Shares = []string{}
+		}
+		value := &_VerificationShares_1_list{list: &x.Shares}
+		return protoreflect.ValueOfList(value)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.VerificationShares"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.VerificationShares does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_VerificationShares) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "side.btcbridge.VerificationShares.shares":
+		list := []string{}
+		return protoreflect.ValueOfList(&_VerificationShares_1_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.VerificationShares"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.VerificationShares does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_VerificationShares) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in side.btcbridge.VerificationShares", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_VerificationShares) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VerificationShares) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_VerificationShares) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_VerificationShares) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*VerificationShares)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Shares) > 0 {
+			for _, s := range x.Shares {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*VerificationShares)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Shares) > 0 {
+			for iNdEx := len(x.Shares) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Shares[iNdEx])
+				copy(dAtA[i:], x.Shares[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Shares[iNdEx])))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*VerificationShares)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: VerificationShares: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: VerificationShares: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Shares = append(x.Shares, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_FrostSigner                    protoreflect.MessageDescriptor
+	fd_FrostSigner_operator_address   protoreflect.FieldDescriptor
+	fd_FrostSigner_index              protoreflect.FieldDescriptor
+	fd_FrostSigner_verification_share protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_side_btcbridge_btcbridge_proto_init()
+	md_FrostSigner = File_side_btcbridge_btcbridge_proto.Messages().ByName("FrostSigner")
+	fd_FrostSigner_operator_address = md_FrostSigner.Fields().ByName("operator_address")
+	fd_FrostSigner_index = md_FrostSigner.Fields().ByName("index")
+	fd_FrostSigner_verification_share = md_FrostSigner.Fields().ByName("verification_share")
+}
+
+var _ protoreflect.Message = (*fastReflection_FrostSigner)(nil)
+
+type fastReflection_FrostSigner FrostSigner
+
+func (x *FrostSigner) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_FrostSigner)(x)
+}
+
+func (x *FrostSigner) slowProtoReflect() protoreflect.Message {
+	mi := &file_side_btcbridge_btcbridge_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_FrostSigner_messageType fastReflection_FrostSigner_messageType
+var _ protoreflect.MessageType = fastReflection_FrostSigner_messageType{}
+
+type fastReflection_FrostSigner_messageType struct{}
+
+func (x fastReflection_FrostSigner_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_FrostSigner)(nil)
+}
+func (x fastReflection_FrostSigner_messageType) New() protoreflect.Message {
+	return new(fastReflection_FrostSigner)
+}
+func (x fastReflection_FrostSigner_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_FrostSigner
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_FrostSigner) Descriptor() protoreflect.MessageDescriptor {
+	return md_FrostSigner
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_FrostSigner) Type() protoreflect.MessageType {
+	return _fastReflection_FrostSigner_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_FrostSigner) New() protoreflect.Message {
+	return new(fastReflection_FrostSigner)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_FrostSigner) Interface() protoreflect.ProtoMessage {
+	return (*FrostSigner)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_FrostSigner) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.OperatorAddress != "" {
+		value := protoreflect.ValueOfString(x.OperatorAddress)
+		if !f(fd_FrostSigner_operator_address, value) {
+			return
+		}
+	}
+	if x.Index != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Index)
+		if !f(fd_FrostSigner_index, value) {
+			return
+		}
+	}
+	if x.VerificationShare != "" {
+		value := protoreflect.ValueOfString(x.VerificationShare)
+		if !f(fd_FrostSigner_verification_share, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_FrostSigner) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "side.btcbridge.FrostSigner.operator_address":
+		return x.OperatorAddress != ""
+	case "side.btcbridge.FrostSigner.index":
+		return x.Index != uint32(0)
+	case "side.btcbridge.FrostSigner.verification_share":
+		return x.VerificationShare != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.FrostSigner"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.FrostSigner does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FrostSigner) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "side.btcbridge.FrostSigner.operator_address":
+		x.OperatorAddress = ""
+	case "side.btcbridge.FrostSigner.index":
+		x.Index = uint32(0)
+	case "side.btcbridge.FrostSigner.verification_share":
+		x.VerificationShare = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.FrostSigner"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.FrostSigner does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_FrostSigner) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "side.btcbridge.FrostSigner.operator_address":
+		value := x.OperatorAddress
+		return protoreflect.ValueOfString(value)
+	case "side.btcbridge.FrostSigner.index":
+		value := x.Index
+		return protoreflect.ValueOfUint32(value)
+	case "side.btcbridge.FrostSigner.verification_share":
+		value := x.VerificationShare
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.FrostSigner"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.FrostSigner does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FrostSigner) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "side.btcbridge.FrostSigner.operator_address":
+		x.OperatorAddress = value.Interface().(string)
+	case "side.btcbridge.FrostSigner.index":
+		x.Index = uint32(value.Uint())
+	case "side.btcbridge.FrostSigner.verification_share":
+		x.VerificationShare = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.FrostSigner"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.FrostSigner does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FrostSigner) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "side.btcbridge.FrostSigner.operator_address":
+		panic(fmt.Errorf("field operator_address of message side.btcbridge.FrostSigner is not mutable"))
+	case "side.btcbridge.FrostSigner.index":
+		panic(fmt.Errorf("field index of message side.btcbridge.FrostSigner is not mutable"))
+	case "side.btcbridge.FrostSigner.verification_share":
+		panic(fmt.Errorf("field verification_share of message side.btcbridge.FrostSigner is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.FrostSigner"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.FrostSigner does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_FrostSigner) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "side.btcbridge.FrostSigner.operator_address":
+		return protoreflect.ValueOfString("")
+	case "side.btcbridge.FrostSigner.index":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "side.btcbridge.FrostSigner.verification_share":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.FrostSigner"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.FrostSigner does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_FrostSigner) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in side.btcbridge.FrostSigner", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_FrostSigner) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FrostSigner) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_FrostSigner) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_FrostSigner) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*FrostSigner)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.OperatorAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Index != 0 {
+			n += 1 + runtime.Sov(uint64(x.Index))
+		}
+		l = len(x.VerificationShare)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*FrostSigner)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.VerificationShare) > 0 {
+			i -= len(x.VerificationShare)
+			copy(dAtA[i:], x.VerificationShare)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.VerificationShare)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.Index != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Index))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.OperatorAddress) > 0 {
+			i -= len(x.OperatorAddress)
+			copy(dAtA[i:], x.OperatorAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.OperatorAddress)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*FrostSigner)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FrostSigner: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FrostSigner: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.OperatorAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				}
+				x.Index = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Index |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VerificationShare", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.VerificationShare = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_FrostVaultKey_4_list)(nil)
+
+type _FrostVaultKey_4_list struct {
+	list *[]*FrostSigner
+}
+
+func (x *_FrostVaultKey_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_FrostVaultKey_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_FrostVaultKey_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*FrostSigner)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_FrostVaultKey_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*FrostSigner)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_FrostVaultKey_4_list) AppendMutable() protoreflect.Value {
+	v := new(FrostSigner)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_FrostVaultKey_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_FrostVaultKey_4_list) NewElement() protoreflect.Value {
+	v := new(FrostSigner)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_FrostVaultKey_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_FrostVaultKey           protoreflect.MessageDescriptor
+	fd_FrostVaultKey_vault     protoreflect.FieldDescriptor
+	fd_FrostVaultKey_pub_key   protoreflect.FieldDescriptor
+	fd_FrostVaultKey_threshold protoreflect.FieldDescriptor
+	fd_FrostVaultKey_signers   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_side_btcbridge_btcbridge_proto_init()
+	md_FrostVaultKey = File_side_btcbridge_btcbridge_proto.Messages().ByName("FrostVaultKey")
+	fd_FrostVaultKey_vault = md_FrostVaultKey.Fields().ByName("vault")
+	fd_FrostVaultKey_pub_key = md_FrostVaultKey.Fields().ByName("pub_key")
+	fd_FrostVaultKey_threshold = md_FrostVaultKey.Fields().ByName("threshold")
+	fd_FrostVaultKey_signers = md_FrostVaultKey.Fields().ByName("signers")
+}
+
+var _ protoreflect.Message = (*fastReflection_FrostVaultKey)(nil)
+
+type fastReflection_FrostVaultKey FrostVaultKey
+
+func (x *FrostVaultKey) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_FrostVaultKey)(x)
+}
+
+func (x *FrostVaultKey) slowProtoReflect() protoreflect.Message {
+	mi := &file_side_btcbridge_btcbridge_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_FrostVaultKey_messageType fastReflection_FrostVaultKey_messageType
+var _ protoreflect.MessageType = fastReflection_FrostVaultKey_messageType{}
+
+type fastReflection_FrostVaultKey_messageType struct{}
+
+func (x fastReflection_FrostVaultKey_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_FrostVaultKey)(nil)
+}
+func (x fastReflection_FrostVaultKey_messageType) New() protoreflect.Message {
+	return new(fastReflection_FrostVaultKey)
+}
+func (x fastReflection_FrostVaultKey_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_FrostVaultKey
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_FrostVaultKey) Descriptor() protoreflect.MessageDescriptor {
+	return md_FrostVaultKey
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_FrostVaultKey) Type() protoreflect.MessageType {
+	return _fastReflection_FrostVaultKey_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_FrostVaultKey) New() protoreflect.Message {
+	return new(fastReflection_FrostVaultKey)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_FrostVaultKey) Interface() protoreflect.ProtoMessage {
+	return (*FrostVaultKey)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_FrostVaultKey) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Vault != "" {
+		value := protoreflect.ValueOfString(x.Vault)
+		if !f(fd_FrostVaultKey_vault, value) {
+			return
+		}
+	}
+	if x.PubKey != "" {
+		value := protoreflect.ValueOfString(x.PubKey)
+		if !f(fd_FrostVaultKey_pub_key, value) {
+			return
+		}
+	}
+	if x.Threshold != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Threshold)
+		if !f(fd_FrostVaultKey_threshold, value) {
+			return
+		}
+	}
+	if len(x.Signers) != 0 {
+		value := protoreflect.ValueOfList(&_FrostVaultKey_4_list{list: &x.Signers})
+		if !f(fd_FrostVaultKey_signers, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_FrostVaultKey) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "side.btcbridge.FrostVaultKey.vault":
+		return x.Vault != ""
+	case "side.btcbridge.FrostVaultKey.pub_key":
+		return x.PubKey != ""
+	case "side.btcbridge.FrostVaultKey.threshold":
+		return x.Threshold != uint32(0)
+	case "side.btcbridge.FrostVaultKey.signers":
+		return len(x.Signers) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.FrostVaultKey"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.FrostVaultKey does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FrostVaultKey) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "side.btcbridge.FrostVaultKey.vault":
+		x.Vault = ""
+	case "side.btcbridge.FrostVaultKey.pub_key":
+		x.PubKey = ""
+	case "side.btcbridge.FrostVaultKey.threshold":
+		x.Threshold = uint32(0)
+	case "side.btcbridge.FrostVaultKey.signers":
+		x.Signers = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.FrostVaultKey"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.FrostVaultKey does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_FrostVaultKey) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "side.btcbridge.FrostVaultKey.vault":
+		value := x.Vault
+		return protoreflect.ValueOfString(value)
+	case "side.btcbridge.FrostVaultKey.pub_key":
+		value := x.PubKey
+		return protoreflect.ValueOfString(value)
+	case "side.btcbridge.FrostVaultKey.threshold":
+		value := x.Threshold
+		return protoreflect.ValueOfUint32(value)
+	case "side.btcbridge.FrostVaultKey.signers":
+		if len(x.Signers) == 0 {
+			return protoreflect.ValueOfList(&_FrostVaultKey_4_list{})
+		}
+		listValue := &_FrostVaultKey_4_list{list: &x.Signers}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.FrostVaultKey"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.FrostVaultKey does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FrostVaultKey) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "side.btcbridge.FrostVaultKey.vault":
+		x.Vault = value.Interface().(string)
+	case "side.btcbridge.FrostVaultKey.pub_key":
+		x.PubKey = value.Interface().(string)
+	case "side.btcbridge.FrostVaultKey.threshold":
+		x.Threshold = uint32(value.Uint())
+	case "side.btcbridge.FrostVaultKey.signers":
+		lv := value.List()
+		clv := lv.(*_FrostVaultKey_4_list)
+		x.Signers = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.FrostVaultKey"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.FrostVaultKey does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FrostVaultKey) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "side.btcbridge.FrostVaultKey.signers":
+		if x.Signers == nil {
+			x.Signers = []*FrostSigner{}
+		}
+		value := &_FrostVaultKey_4_list{list: &x.Signers}
+		return protoreflect.ValueOfList(value)
+	case "side.btcbridge.FrostVaultKey.vault":
+		panic(fmt.Errorf("field vault of message side.btcbridge.FrostVaultKey is not mutable"))
+	case "side.btcbridge.FrostVaultKey.pub_key":
+		panic(fmt.Errorf("field pub_key of message side.btcbridge.FrostVaultKey is not mutable"))
+	case "side.btcbridge.FrostVaultKey.threshold":
+		panic(fmt.Errorf("field threshold of message side.btcbridge.FrostVaultKey is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.FrostVaultKey"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.FrostVaultKey does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_FrostVaultKey) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "side.btcbridge.FrostVaultKey.vault":
+		return protoreflect.ValueOfString("")
+	case "side.btcbridge.FrostVaultKey.pub_key":
+		return protoreflect.ValueOfString("")
+	case "side.btcbridge.FrostVaultKey.threshold":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "side.btcbridge.FrostVaultKey.signers":
+		list := []*FrostSigner{}
+		return protoreflect.ValueOfList(&_FrostVaultKey_4_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.FrostVaultKey"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.FrostVaultKey does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_FrostVaultKey) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in side.btcbridge.FrostVaultKey", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_FrostVaultKey) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FrostVaultKey) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_FrostVaultKey) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_FrostVaultKey) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*FrostVaultKey)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Vault)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.PubKey)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Threshold != 0 {
+			n += 1 + runtime.Sov(uint64(x.Threshold))
+		}
+		if len(x.Signers) > 0 {
+			for _, e := range x.Signers {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*FrostVaultKey)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Signers) > 0 {
+			for iNdEx := len(x.Signers) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Signers[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if x.Threshold != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Threshold))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.PubKey) > 0 {
+			i -= len(x.PubKey)
+			copy(dAtA[i:], x.PubKey)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PubKey)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Vault) > 0 {
+			i -= len(x.Vault)
+			copy(dAtA[i:], x.Vault)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Vault)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*FrostVaultKey)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FrostVaultKey: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FrostVaultKey: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Vault", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Vault = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PubKey = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+				}
+				x.Threshold = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Threshold |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Signers = append(x.Signers, &FrostSigner{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Signers[len(x.Signers)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_FrostNonceCommitment         protoreflect.MessageDescriptor
+	fd_FrostNonceCommitment_hiding  protoreflect.FieldDescriptor
+	fd_FrostNonceCommitment_binding protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_side_btcbridge_btcbridge_proto_init()
+	md_FrostNonceCommitment = File_side_btcbridge_btcbridge_proto.Messages().ByName("FrostNonceCommitment")
+	fd_FrostNonceCommitment_hiding = md_FrostNonceCommitment.Fields().ByName("hiding")
+	fd_FrostNonceCommitment_binding = md_FrostNonceCommitment.Fields().ByName("binding")
+}
+
+var _ protoreflect.Message = (*fastReflection_FrostNonceCommitment)(nil)
+
+type fastReflection_FrostNonceCommitment FrostNonceCommitment
+
+func (x *FrostNonceCommitment) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_FrostNonceCommitment)(x)
+}
+
+func (x *FrostNonceCommitment) slowProtoReflect() protoreflect.Message {
+	mi := &file_side_btcbridge_btcbridge_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_FrostNonceCommitment_messageType fastReflection_FrostNonceCommitment_messageType
+var _ protoreflect.MessageType = fastReflection_FrostNonceCommitment_messageType{}
+
+type fastReflection_FrostNonceCommitment_messageType struct{}
+
+func (x fastReflection_FrostNonceCommitment_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_FrostNonceCommitment)(nil)
+}
+func (x fastReflection_FrostNonceCommitment_messageType) New() protoreflect.Message {
+	return new(fastReflection_FrostNonceCommitment)
+}
+func (x fastReflection_FrostNonceCommitment_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_FrostNonceCommitment
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_FrostNonceCommitment) Descriptor() protoreflect.MessageDescriptor {
+	return md_FrostNonceCommitment
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_FrostNonceCommitment) Type() protoreflect.MessageType {
+	return _fastReflection_FrostNonceCommitment_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_FrostNonceCommitment) New() protoreflect.Message {
+	return new(fastReflection_FrostNonceCommitment)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_FrostNonceCommitment) Interface() protoreflect.ProtoMessage {
+	return (*FrostNonceCommitment)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_FrostNonceCommitment) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Hiding != "" {
+		value := protoreflect.ValueOfString(x.Hiding)
+		if !f(fd_FrostNonceCommitment_hiding, value) {
+			return
+		}
+	}
+	if x.Binding != "" {
+		value := protoreflect.ValueOfString(x.Binding)
+		if !f(fd_FrostNonceCommitment_binding, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_FrostNonceCommitment) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "side.btcbridge.FrostNonceCommitment.hiding":
+		return x.Hiding != ""
+	case "side.btcbridge.FrostNonceCommitment.binding":
+		return x.Binding != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.FrostNonceCommitment"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.FrostNonceCommitment does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FrostNonceCommitment) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "side.btcbridge.FrostNonceCommitment.hiding":
+		x.Hiding = ""
+	case "side.btcbridge.FrostNonceCommitment.binding":
+		x.Binding = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.FrostNonceCommitment"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.FrostNonceCommitment does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_FrostNonceCommitment) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "side.btcbridge.FrostNonceCommitment.hiding":
+		value := x.Hiding
+		return protoreflect.ValueOfString(value)
+	case "side.btcbridge.FrostNonceCommitment.binding":
+		value := x.Binding
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.FrostNonceCommitment"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.FrostNonceCommitment does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FrostNonceCommitment) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "side.btcbridge.FrostNonceCommitment.hiding":
+		x.Hiding = value.Interface().(string)
+	case "side.btcbridge.FrostNonceCommitment.binding":
+		x.Binding = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.FrostNonceCommitment"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.FrostNonceCommitment does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FrostNonceCommitment) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "side.btcbridge.FrostNonceCommitment.hiding":
+		panic(fmt.Errorf("field hiding of message side.btcbridge.FrostNonceCommitment is not mutable"))
+	case "side.btcbridge.FrostNonceCommitment.binding":
+		panic(fmt.Errorf("field binding of message side.btcbridge.FrostNonceCommitment is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.FrostNonceCommitment"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.FrostNonceCommitment does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_FrostNonceCommitment) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "side.btcbridge.FrostNonceCommitment.hiding":
+		return protoreflect.ValueOfString("")
+	case "side.btcbridge.FrostNonceCommitment.binding":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.FrostNonceCommitment"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.FrostNonceCommitment does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_FrostNonceCommitment) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in side.btcbridge.FrostNonceCommitment", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_FrostNonceCommitment) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FrostNonceCommitment) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_FrostNonceCommitment) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_FrostNonceCommitment) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*FrostNonceCommitment)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Hiding)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Binding)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*FrostNonceCommitment)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Binding) > 0 {
+			i -= len(x.Binding)
+			copy(dAtA[i:], x.Binding)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Binding)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Hiding) > 0 {
+			i -= len(x.Hiding)
+			copy(dAtA[i:], x.Hiding)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Hiding)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*FrostNonceCommitment)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FrostNonceCommitment: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FrostNonceCommitment: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Hiding", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Hiding = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Binding", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Binding = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_FrostSignerRound_2_list)(nil)
+
+type _FrostSignerRound_2_list struct {
+	list *[]*FrostNonceCommitment
+}
+
+func (x *_FrostSignerRound_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_FrostSignerRound_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_FrostSignerRound_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*FrostNonceCommitment)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_FrostSignerRound_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*FrostNonceCommitment)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_FrostSignerRound_2_list) AppendMutable() protoreflect.Value {
+	v := new(FrostNonceCommitment)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_FrostSignerRound_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_FrostSignerRound_2_list) NewElement() protoreflect.Value {
+	v := new(FrostNonceCommitment)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_FrostSignerRound_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_FrostSignerRound_3_list)(nil)
+
+type _FrostSignerRound_3_list struct {
+	list *[]string
+}
+
+func (x *_FrostSignerRound_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_FrostSignerRound_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_FrostSignerRound_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_FrostSignerRound_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_FrostSignerRound_3_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message FrostSignerRound at list field PartialSignatures as it is not of Message kind"))
+}
+
+func (x *_FrostSignerRound_3_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_FrostSignerRound_3_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_FrostSignerRound_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_FrostSignerRound                    protoreflect.MessageDescriptor
+	fd_FrostSignerRound_operator_address   protoreflect.FieldDescriptor
+	fd_FrostSignerRound_commitments        protoreflect.FieldDescriptor
+	fd_FrostSignerRound_partial_signatures protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_side_btcbridge_btcbridge_proto_init()
+	md_FrostSignerRound = File_side_btcbridge_btcbridge_proto.Messages().ByName("FrostSignerRound")
+	fd_FrostSignerRound_operator_address = md_FrostSignerRound.Fields().ByName("operator_address")
+	fd_FrostSignerRound_commitments = md_FrostSignerRound.Fields().ByName("commitments")
+	fd_FrostSignerRound_partial_signatures = md_FrostSignerRound.Fields().ByName("partial_signatures")
+}
+
+var _ protoreflect.Message = (*fastReflection_FrostSignerRound)(nil)
+
+type fastReflection_FrostSignerRound FrostSignerRound
+
+func (x *FrostSignerRound) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_FrostSignerRound)(x)
+}
+
+func (x *FrostSignerRound) slowProtoReflect() protoreflect.Message {
+	mi := &file_side_btcbridge_btcbridge_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_FrostSignerRound_messageType fastReflection_FrostSignerRound_messageType
+var _ protoreflect.MessageType = fastReflection_FrostSignerRound_messageType{}
+
+type fastReflection_FrostSignerRound_messageType struct{}
+
+func (x fastReflection_FrostSignerRound_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_FrostSignerRound)(nil)
+}
+func (x fastReflection_FrostSignerRound_messageType) New() protoreflect.Message {
+	return new(fastReflection_FrostSignerRound)
+}
+func (x fastReflection_FrostSignerRound_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_FrostSignerRound
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_FrostSignerRound) Descriptor() protoreflect.MessageDescriptor {
+	return md_FrostSignerRound
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_FrostSignerRound) Type() protoreflect.MessageType {
+	return _fastReflection_FrostSignerRound_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_FrostSignerRound) New() protoreflect.Message {
+	return new(fastReflection_FrostSignerRound)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_FrostSignerRound) Interface() protoreflect.ProtoMessage {
+	return (*FrostSignerRound)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_FrostSignerRound) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.OperatorAddress != "" {
+		value := protoreflect.ValueOfString(x.OperatorAddress)
+		if !f(fd_FrostSignerRound_operator_address, value) {
+			return
+		}
+	}
+	if len(x.Commitments) != 0 {
+		value := protoreflect.ValueOfList(&_FrostSignerRound_2_list{list: &x.Commitments})
+		if !f(fd_FrostSignerRound_commitments, value) {
+			return
+		}
+	}
+	if len(x.PartialSignatures) != 0 {
+		value := protoreflect.ValueOfList(&_FrostSignerRound_3_list{list: &x.PartialSignatures})
+		if !f(fd_FrostSignerRound_partial_signatures, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_FrostSignerRound) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "side.btcbridge.FrostSignerRound.operator_address":
+		return x.OperatorAddress != ""
+	case "side.btcbridge.FrostSignerRound.commitments":
+		return len(x.Commitments) != 0
+	case "side.btcbridge.FrostSignerRound.partial_signatures":
+		return len(x.PartialSignatures) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.FrostSignerRound"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.FrostSignerRound does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FrostSignerRound) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "side.btcbridge.FrostSignerRound.operator_address":
+		x.OperatorAddress = ""
+	case "side.btcbridge.FrostSignerRound.commitments":
+		x.Commitments = nil
+	case "side.btcbridge.FrostSignerRound.partial_signatures":
+		x.PartialSignatures = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.FrostSignerRound"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.FrostSignerRound does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_FrostSignerRound) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "side.btcbridge.FrostSignerRound.operator_address":
+		value := x.OperatorAddress
+		return protoreflect.ValueOfString(value)
+	case "side.btcbridge.FrostSignerRound.commitments":
+		if len(x.Commitments) == 0 {
+			return protoreflect.ValueOfList(&_FrostSignerRound_2_list{})
+		}
+		listValue := &_FrostSignerRound_2_list{list: &x.Commitments}
+		return protoreflect.ValueOfList(listValue)
+	case "side.btcbridge.FrostSignerRound.partial_signatures":
+		if len(x.PartialSignatures) == 0 {
+			return protoreflect.ValueOfList(&_FrostSignerRound_3_list{})
+		}
+		listValue := &_FrostSignerRound_3_list{list: &x.PartialSignatures}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.FrostSignerRound"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.FrostSignerRound does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FrostSignerRound) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "side.btcbridge.FrostSignerRound.operator_address":
+		x.OperatorAddress = value.Interface().(string)
+	case "side.btcbridge.FrostSignerRound.commitments":
+		lv := value.List()
+		clv := lv.(*_FrostSignerRound_2_list)
+		x.Commitments = *clv.list
+	case "side.btcbridge.FrostSignerRound.partial_signatures":
+		lv := value.List()
+		clv := lv.(*_FrostSignerRound_3_list)
+		x.PartialSignatures = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.FrostSignerRound"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.FrostSignerRound does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FrostSignerRound) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "side.btcbridge.FrostSignerRound.commitments":
+		if x.Commitments == nil {
+			x.Commitments = []*FrostNonceCommitment{}
+		}
+		value := &_FrostSignerRound_2_list{list: &x.Commitments}
+		return protoreflect.ValueOfList(value)
+	case "side.btcbridge.FrostSignerRound.partial_signatures":
+		if x.PartialSignatures == nil {
+			x.PartialSignatures = []string{}
+		}
+		value := &_FrostSignerRound_3_list{list: &x.PartialSignatures}
+		return protoreflect.ValueOfList(value)
+	case "side.btcbridge.FrostSignerRound.operator_address":
+		panic(fmt.Errorf("field operator_address of message side.btcbridge.FrostSignerRound is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.FrostSignerRound"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.FrostSignerRound does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_FrostSignerRound) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "side.btcbridge.FrostSignerRound.operator_address":
+		return protoreflect.ValueOfString("")
+	case "side.btcbridge.FrostSignerRound.commitments":
+		list := []*FrostNonceCommitment{}
+		return protoreflect.ValueOfList(&_FrostSignerRound_2_list{list: &list})
+	case "side.btcbridge.FrostSignerRound.partial_signatures":
+		list := []string{}
+		return protoreflect.ValueOfList(&_FrostSignerRound_3_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.FrostSignerRound"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.FrostSignerRound does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_FrostSignerRound) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in side.btcbridge.FrostSignerRound", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_FrostSignerRound) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FrostSignerRound) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_FrostSignerRound) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_FrostSignerRound) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*FrostSignerRound)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.OperatorAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Commitments) > 0 {
+			for _, e := range x.Commitments {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.PartialSignatures) > 0 {
+			for _, s := range x.PartialSignatures {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*FrostSignerRound)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.PartialSignatures) > 0 {
+			for iNdEx := len(x.PartialSignatures) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.PartialSignatures[iNdEx])
+				copy(dAtA[i:], x.PartialSignatures[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PartialSignatures[iNdEx])))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if len(x.Commitments) > 0 {
+			for iNdEx := len(x.Commitments) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Commitments[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if len(x.OperatorAddress) > 0 {
+			i -= len(x.OperatorAddress)
+			copy(dAtA[i:], x.OperatorAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.OperatorAddress)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*FrostSignerRound)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FrostSignerRound: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FrostSignerRound: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.OperatorAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Commitments", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Commitments = append(x.Commitments, &FrostNonceCommitment{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Commitments[len(x.Commitments)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PartialSignatures", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PartialSignatures = append(x.PartialSignatures, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_FrostSigningSession_5_list)(nil)
+
+type _FrostSigningSession_5_list struct {
+	list *[]*FrostSignerRound
+}
+
+func (x *_FrostSigningSession_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_FrostSigningSession_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_FrostSigningSession_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*FrostSignerRound)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_FrostSigningSession_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*FrostSignerRound)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_FrostSigningSession_5_list) AppendMutable() protoreflect.Value {
+	v := new(FrostSignerRound)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_FrostSigningSession_5_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_FrostSigningSession_5_list) NewElement() protoreflect.Value {
+	v := new(FrostSignerRound)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_FrostSigningSession_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_FrostSigningSession_6_list)(nil)
+
+type _FrostSigningSession_6_list struct {
+	list *[]string
+}
+
+func (x *_FrostSigningSession_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_FrostSigningSession_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_FrostSigningSession_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_FrostSigningSession_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_FrostSigningSession_6_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message FrostSigningSession at list field MisbehavingSigners as it is not of Message kind"))
+}
+
+func (x *_FrostSigningSession_6_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_FrostSigningSession_6_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_FrostSigningSession_6_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_FrostSigningSession                     protoreflect.MessageDescriptor
+	fd_FrostSigningSession_txid                protoreflect.FieldDescriptor
+	fd_FrostSigningSession_status              protoreflect.FieldDescriptor
+	fd_FrostSigningSession_round               protoreflect.FieldDescriptor
+	fd_FrostSigningSession_threshold           protoreflect.FieldDescriptor
+	fd_FrostSigningSession_signers             protoreflect.FieldDescriptor
+	fd_FrostSigningSession_misbehaving_signers protoreflect.FieldDescriptor
+	fd_FrostSigningSession_round_start_time    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_side_btcbridge_btcbridge_proto_init()
+	md_FrostSigningSession = File_side_btcbridge_btcbridge_proto.Messages().ByName("FrostSigningSession")
+	fd_FrostSigningSession_txid = md_FrostSigningSession.Fields().ByName("txid")
+	fd_FrostSigningSession_status = md_FrostSigningSession.Fields().ByName("status")
+	fd_FrostSigningSession_round = md_FrostSigningSession.Fields().ByName("round")
+	fd_FrostSigningSession_threshold = md_FrostSigningSession.Fields().ByName("threshold")
+	fd_FrostSigningSession_signers = md_FrostSigningSession.Fields().ByName("signers")
+	fd_FrostSigningSession_misbehaving_signers = md_FrostSigningSession.Fields().ByName("misbehaving_signers")
+	fd_FrostSigningSession_round_start_time = md_FrostSigningSession.Fields().ByName("round_start_time")
+}
+
+var _ protoreflect.Message = (*fastReflection_FrostSigningSession)(nil)
+
+type fastReflection_FrostSigningSession FrostSigningSession
+
+func (x *FrostSigningSession) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_FrostSigningSession)(x)
+}
+
+func (x *FrostSigningSession) slowProtoReflect() protoreflect.Message {
+	mi := &file_side_btcbridge_btcbridge_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_FrostSigningSession_messageType fastReflection_FrostSigningSession_messageType
+var _ protoreflect.MessageType = fastReflection_FrostSigningSession_messageType{}
+
+type fastReflection_FrostSigningSession_messageType struct{}
+
+func (x fastReflection_FrostSigningSession_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_FrostSigningSession)(nil)
+}
+func (x fastReflection_FrostSigningSession_messageType) New() protoreflect.Message {
+	return new(fastReflection_FrostSigningSession)
+}
+func (x fastReflection_FrostSigningSession_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_FrostSigningSession
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_FrostSigningSession) Descriptor() protoreflect.MessageDescriptor {
+	return md_FrostSigningSession
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_FrostSigningSession) Type() protoreflect.MessageType {
+	return _fastReflection_FrostSigningSession_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_FrostSigningSession) New() protoreflect.Message {
+	return new(fastReflection_FrostSigningSession)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_FrostSigningSession) Interface() protoreflect.ProtoMessage {
+	return (*FrostSigningSession)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_FrostSigningSession) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Txid != "" {
+		value := protoreflect.ValueOfString(x.Txid)
+		if !f(fd_FrostSigningSession_txid, value) {
+			return
+		}
+	}
+	if x.Status != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Status))
+		if !f(fd_FrostSigningSession_status, value) {
+			return
+		}
+	}
+	if x.Round != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Round)
+		if !f(fd_FrostSigningSession_round, value) {
+			return
+		}
+	}
+	if x.Threshold != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Threshold)
+		if !f(fd_FrostSigningSession_threshold, value) {
+			return
+		}
+	}
+	if len(x.Signers) != 0 {
+		value := protoreflect.ValueOfList(&_FrostSigningSession_5_list{list: &x.Signers})
+		if !f(fd_FrostSigningSession_signers, value) {
+			return
+		}
+	}
+	if len(x.MisbehavingSigners) != 0 {
+		value := protoreflect.ValueOfList(&_FrostSigningSession_6_list{list: &x.MisbehavingSigners})
+		if !f(fd_FrostSigningSession_misbehaving_signers, value) {
+			return
+		}
+	}
+	if x.RoundStartTime != nil {
+		value := protoreflect.ValueOfMessage(x.RoundStartTime.ProtoReflect())
+		if !f(fd_FrostSigningSession_round_start_time, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_FrostSigningSession) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "side.btcbridge.FrostSigningSession.txid":
+		return x.Txid != ""
+	case "side.btcbridge.FrostSigningSession.status":
+		return x.Status != 0
+	case "side.btcbridge.FrostSigningSession.round":
+		return x.Round != uint32(0)
+	case "side.btcbridge.FrostSigningSession.threshold":
+		return x.Threshold != uint32(0)
+	case "side.btcbridge.FrostSigningSession.signers":
+		return len(x.Signers) != 0
+	case "side.btcbridge.FrostSigningSession.misbehaving_signers":
+		return len(x.MisbehavingSigners) != 0
+	case "side.btcbridge.FrostSigningSession.round_start_time":
+		return x.RoundStartTime != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.FrostSigningSession"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.FrostSigningSession does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FrostSigningSession) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "side.btcbridge.FrostSigningSession.txid":
+		x.Txid = ""
+	case "side.btcbridge.FrostSigningSession.status":
+		x.Status = 0
+	case "side.btcbridge.FrostSigningSession.round":
+		x.Round = uint32(0)
+	case "side.btcbridge.FrostSigningSession.threshold":
+		x.Threshold = uint32(0)
+	case "side.btcbridge.FrostSigningSession.signers":
+		x.Signers = nil
+	case "side.btcbridge.FrostSigningSession.misbehaving_signers":
+		x.MisbehavingSigners = nil
+	case "side.btcbridge.FrostSigningSession.round_start_time":
+		x.RoundStartTime = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.FrostSigningSession"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.FrostSigningSession does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_FrostSigningSession) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "side.btcbridge.FrostSigningSession.txid":
+		value := x.Txid
+		return protoreflect.ValueOfString(value)
+	case "side.btcbridge.FrostSigningSession.status":
+		value := x.Status
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "side.btcbridge.FrostSigningSession.round":
+		value := x.Round
+		return protoreflect.ValueOfUint32(value)
+	case "side.btcbridge.FrostSigningSession.threshold":
+		value := x.Threshold
+		return protoreflect.ValueOfUint32(value)
+	case "side.btcbridge.FrostSigningSession.signers":
+		if len(x.Signers) == 0 {
+			return protoreflect.ValueOfList(&_FrostSigningSession_5_list{})
+		}
+		listValue := &_FrostSigningSession_5_list{list: &x.Signers}
+		return protoreflect.ValueOfList(listValue)
+	case "side.btcbridge.FrostSigningSession.misbehaving_signers":
+		if len(x.MisbehavingSigners) == 0 {
+			return protoreflect.ValueOfList(&_FrostSigningSession_6_list{})
+		}
+		listValue := &_FrostSigningSession_6_list{list: &x.MisbehavingSigners}
+		return protoreflect.ValueOfList(listValue)
+	case "side.btcbridge.FrostSigningSession.round_start_time":
+		value := x.RoundStartTime
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.FrostSigningSession"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.FrostSigningSession does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FrostSigningSession) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "side.btcbridge.FrostSigningSession.txid":
+		x.Txid = value.Interface().(string)
+	case "side.btcbridge.FrostSigningSession.status":
+		x.Status = (FrostSigningStatus)(value.Enum())
+	case "side.btcbridge.FrostSigningSession.round":
+		x.Round = uint32(value.Uint())
+	case "side.btcbridge.FrostSigningSession.threshold":
+		x.Threshold = uint32(value.Uint())
+	case "side.btcbridge.FrostSigningSession.signers":
+		lv := value.List()
+		clv := lv.(*_FrostSigningSession_5_list)
+		x.Signers = *clv.list
+	case "side.btcbridge.FrostSigningSession.misbehaving_signers":
+		lv := value.List()
+		clv := lv.(*_FrostSigningSession_6_list)
+		x.MisbehavingSigners = *clv.list
+	case "side.btcbridge.FrostSigningSession.round_start_time":
+		x.RoundStartTime = value.Message().Interface().(*timestamppb.Timestamp)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.FrostSigningSession"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.FrostSigningSession does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FrostSigningSession) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "side.btcbridge.FrostSigningSession.signers":
+		if x.Signers == nil {
+			x.Signers = []*FrostSignerRound{}
+		}
+		value := &_FrostSigningSession_5_list{list: &x.Signers}
+		return protoreflect.ValueOfList(value)
+	case "side.btcbridge.FrostSigningSession.misbehaving_signers":
+		if x.MisbehavingSigners == nil {
+			x.MisbehavingSigners = []string{}
+		}
+		value := &_FrostSigningSession_6_list{list: &x.MisbehavingSigners}
+		return protoreflect.ValueOfList(value)
+	case "side.btcbridge.FrostSigningSession.round_start_time":
+		if x.RoundStartTime == nil {
+			x.RoundStartTime = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.RoundStartTime.ProtoReflect())
+	case "side.btcbridge.FrostSigningSession.txid":
+		panic(fmt.Errorf("field txid of message side.btcbridge.FrostSigningSession is not mutable"))
+	case "side.btcbridge.FrostSigningSession.status":
+		panic(fmt.Errorf("field status of message side.btcbridge.FrostSigningSession is not mutable"))
+	case "side.btcbridge.FrostSigningSession.round":
+		panic(fmt.Errorf("field round of message side.btcbridge.FrostSigningSession is not mutable"))
+	case "side.btcbridge.FrostSigningSession.threshold":
+		panic(fmt.Errorf("field threshold of message side.btcbridge.FrostSigningSession is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.FrostSigningSession"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.FrostSigningSession does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_FrostSigningSession) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "side.btcbridge.FrostSigningSession.txid":
+		return protoreflect.ValueOfString("")
+	case "side.btcbridge.FrostSigningSession.status":
+		return protoreflect.ValueOfEnum(0)
+	case "side.btcbridge.FrostSigningSession.round":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "side.btcbridge.FrostSigningSession.threshold":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "side.btcbridge.FrostSigningSession.signers":
+		list := []*FrostSignerRound{}
+		return protoreflect.ValueOfList(&_FrostSigningSession_5_list{list: &list})
+	case "side.btcbridge.FrostSigningSession.misbehaving_signers":
+		list := []string{}
+		return protoreflect.ValueOfList(&_FrostSigningSession_6_list{list: &list})
+	case "side.btcbridge.FrostSigningSession.round_start_time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.FrostSigningSession"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.FrostSigningSession does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_FrostSigningSession) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in side.btcbridge.FrostSigningSession", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_FrostSigningSession) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FrostSigningSession) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_FrostSigningSession) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_FrostSigningSession) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*FrostSigningSession)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Txid)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Status != 0 {
+			n += 1 + runtime.Sov(uint64(x.Status))
+		}
+		if x.Round != 0 {
+			n += 1 + runtime.Sov(uint64(x.Round))
+		}
+		if x.Threshold != 0 {
+			n += 1 + runtime.Sov(uint64(x.Threshold))
+		}
+		if len(x.Signers) > 0 {
+			for _, e := range x.Signers {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.MisbehavingSigners) > 0 {
+			for _, s := range x.MisbehavingSigners {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.RoundStartTime != nil {
+			l = options.Size(x.RoundStartTime)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*FrostSigningSession)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.RoundStartTime != nil {
+			encoded, err := options.Marshal(x.RoundStartTime)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.MisbehavingSigners) > 0 {
+			for iNdEx := len(x.MisbehavingSigners) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.MisbehavingSigners[iNdEx])
+				copy(dAtA[i:], x.MisbehavingSigners[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MisbehavingSigners[iNdEx])))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
+		if len(x.Signers) > 0 {
+			for iNdEx := len(x.Signers) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Signers[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if x.Threshold != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Threshold))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.Round != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Round))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.Status != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Status))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Txid) > 0 {
+			i -= len(x.Txid)
+			copy(dAtA[i:], x.Txid)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Txid)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*FrostSigningSession)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FrostSigningSession: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FrostSigningSession: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Txid", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Txid = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				}
+				x.Status = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Status |= FrostSigningStatus(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+				}
+				x.Round = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Round |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+				}
+				x.Threshold = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Threshold |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Signers = append(x.Signers, &FrostSignerRound{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Signers[len(x.Signers)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MisbehavingSigners", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MisbehavingSigners = append(x.MisbehavingSigners, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RoundStartTime", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.RoundStartTime == nil {
+					x.RoundStartTime = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RoundStartTime); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_SignerPerformance_2_list)(nil)
+
+type _SignerPerformance_2_list struct {
+	list *[]int64
+}
+
+func (x *_SignerPerformance_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_SignerPerformance_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfInt64((*x.list)[i])
+}
+
+func (x *_SignerPerformance_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Int()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_SignerPerformance_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Int()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_SignerPerformance_2_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message SignerPerformance at list field MissedDkgHeights as it is not of Message kind"))
+}
+
+func (x *_SignerPerformance_2_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_SignerPerformance_2_list) NewElement() protoreflect.Value {
+	v := int64(0)
+	return protoreflect.ValueOfInt64(v)
+}
+
+func (x *_SignerPerformance_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_SignerPerformance_3_list)(nil)
+
+type _SignerPerformance_3_list struct {
+	list *[]int64
+}
+
+func (x *_SignerPerformance_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_SignerPerformance_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfInt64((*x.list)[i])
+}
+
+func (x *_SignerPerformance_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Int()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_SignerPerformance_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Int()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_SignerPerformance_3_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message SignerPerformance at list field MissedSigningHeights as it is not of Message kind"))
+}
+
+func (x *_SignerPerformance_3_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_SignerPerformance_3_list) NewElement() protoreflect.Value {
+	v := int64(0)
+	return protoreflect.ValueOfInt64(v)
+}
+
+func (x *_SignerPerformance_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_SignerPerformance                        protoreflect.MessageDescriptor
+	fd_SignerPerformance_operator_address       protoreflect.FieldDescriptor
+	fd_SignerPerformance_missed_dkg_heights     protoreflect.FieldDescriptor
+	fd_SignerPerformance_missed_signing_heights protoreflect.FieldDescriptor
+	fd_SignerPerformance_total_missed_dkgs      protoreflect.FieldDescriptor
+	fd_SignerPerformance_total_missed_signings  protoreflect.FieldDescriptor
+	fd_SignerPerformance_punishments            protoreflect.FieldDescriptor
+	fd_SignerPerformance_last_punishment_height protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_side_btcbridge_btcbridge_proto_init()
+	md_SignerPerformance = File_side_btcbridge_btcbridge_proto.Messages().ByName("SignerPerformance")
+	fd_SignerPerformance_operator_address = md_SignerPerformance.Fields().ByName("operator_address")
+	fd_SignerPerformance_missed_dkg_heights = md_SignerPerformance.Fields().ByName("missed_dkg_heights")
+	fd_SignerPerformance_missed_signing_heights = md_SignerPerformance.Fields().ByName("missed_signing_heights")
+	fd_SignerPerformance_total_missed_dkgs = md_SignerPerformance.Fields().ByName("total_missed_dkgs")
+	fd_SignerPerformance_total_missed_signings = md_SignerPerformance.Fields().ByName("total_missed_signings")
+	fd_SignerPerformance_punishments = md_SignerPerformance.Fields().ByName("punishments")
+	fd_SignerPerformance_last_punishment_height = md_SignerPerformance.Fields().ByName("last_punishment_height")
+}
+
+var _ protoreflect.Message = (*fastReflection_SignerPerformance)(nil)
+
+type fastReflection_SignerPerformance SignerPerformance
+
+func (x *SignerPerformance) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SignerPerformance)(x)
+}
+
+func (x *SignerPerformance) slowProtoReflect() protoreflect.Message {
+	mi := &file_side_btcbridge_btcbridge_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_SignerPerformance_messageType fastReflection_SignerPerformance_messageType
+var _ protoreflect.MessageType = fastReflection_SignerPerformance_messageType{}
+
+type fastReflection_SignerPerformance_messageType struct{}
+
+func (x fastReflection_SignerPerformance_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SignerPerformance)(nil)
+}
+func (x fastReflection_SignerPerformance_messageType) New() protoreflect.Message {
+	return new(fastReflection_SignerPerformance)
+}
+func (x fastReflection_SignerPerformance_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SignerPerformance
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_SignerPerformance) Descriptor() protoreflect.MessageDescriptor {
+	return md_SignerPerformance
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_SignerPerformance) Type() protoreflect.MessageType {
+	return _fastReflection_SignerPerformance_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_SignerPerformance) New() protoreflect.Message {
+	return new(fastReflection_SignerPerformance)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_SignerPerformance) Interface() protoreflect.ProtoMessage {
+	return (*SignerPerformance)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_SignerPerformance) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.OperatorAddress != "" {
+		value := protoreflect.ValueOfString(x.OperatorAddress)
+		if !f(fd_SignerPerformance_operator_address, value) {
+			return
+		}
+	}
+	if len(x.MissedDkgHeights) != 0 {
+		value := protoreflect.ValueOfList(&_SignerPerformance_2_list{list: &x.MissedDkgHeights})
+		if !f(fd_SignerPerformance_missed_dkg_heights, value) {
+			return
+		}
+	}
+	if len(x.MissedSigningHeights) != 0 {
+		value := protoreflect.ValueOfList(&_SignerPerformance_3_list{list: &x.MissedSigningHeights})
+		if !f(fd_SignerPerformance_missed_signing_heights, value) {
+			return
+		}
+	}
+	if x.TotalMissedDkgs != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TotalMissedDkgs)
+		if !f(fd_SignerPerformance_total_missed_dkgs, value) {
+			return
+		}
+	}
+	if x.TotalMissedSignings != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TotalMissedSignings)
+		if !f(fd_SignerPerformance_total_missed_signings, value) {
+			return
+		}
+	}
+	if x.Punishments != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Punishments)
+		if !f(fd_SignerPerformance_punishments, value) {
+			return
+		}
+	}
+	if x.LastPunishmentHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.LastPunishmentHeight)
+		if !f(fd_SignerPerformance_last_punishment_height, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_SignerPerformance) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "side.btcbridge.SignerPerformance.operator_address":
+		return x.OperatorAddress != ""
+	case "side.btcbridge.SignerPerformance.missed_dkg_heights":
+		return len(x.MissedDkgHeights) != 0
+	case "side.btcbridge.SignerPerformance.missed_signing_heights":
+		return len(x.MissedSigningHeights) != 0
+	case "side.btcbridge.SignerPerformance.total_missed_dkgs":
+		return x.TotalMissedDkgs != uint64(0)
+	case "side.btcbridge.SignerPerformance.total_missed_signings":
+		return x.TotalMissedSignings != uint64(0)
+	case "side.btcbridge.SignerPerformance.punishments":
+		return x.Punishments != uint64(0)
+	case "side.btcbridge.SignerPerformance.last_punishment_height":
+		return x.LastPunishmentHeight != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.SignerPerformance"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.SignerPerformance does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SignerPerformance) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "side.btcbridge.SignerPerformance.operator_address":
+		x.OperatorAddress = ""
+	case "side.btcbridge.SignerPerformance.missed_dkg_heights":
+		x.MissedDkgHeights = nil
+	case "side.btcbridge.SignerPerformance.missed_signing_heights":
+		x.MissedSigningHeights = nil
+	case "side.btcbridge.SignerPerformance.total_missed_dkgs":
+		x.TotalMissedDkgs = uint64(0)
+	case "side.btcbridge.SignerPerformance.total_missed_signings":
+		x.TotalMissedSignings = uint64(0)
+	case "side.btcbridge.SignerPerformance.punishments":
+		x.Punishments = uint64(0)
+	case "side.btcbridge.SignerPerformance.last_punishment_height":
+		x.LastPunishmentHeight = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.SignerPerformance"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.SignerPerformance does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_SignerPerformance) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "side.btcbridge.SignerPerformance.operator_address":
+		value := x.OperatorAddress
+		return protoreflect.ValueOfString(value)
+	case "side.btcbridge.SignerPerformance.missed_dkg_heights":
+		if len(x.MissedDkgHeights) == 0 {
+			return protoreflect.ValueOfList(&_SignerPerformance_2_list{})
+		}
+		listValue := &_SignerPerformance_2_list{list: &x.MissedDkgHeights}
+		return protoreflect.ValueOfList(listValue)
+	case "side.btcbridge.SignerPerformance.missed_signing_heights":
+		if len(x.MissedSigningHeights) == 0 {
+			return protoreflect.ValueOfList(&_SignerPerformance_3_list{})
+		}
+		listValue := &_SignerPerformance_3_list{list: &x.MissedSigningHeights}
+		return protoreflect.ValueOfList(listValue)
+	case "side.btcbridge.SignerPerformance.total_missed_dkgs":
+		value := x.TotalMissedDkgs
+		return protoreflect.ValueOfUint64(value)
+	case "side.btcbridge.SignerPerformance.total_missed_signings":
+		value := x.TotalMissedSignings
+		return protoreflect.ValueOfUint64(value)
+	case "side.btcbridge.SignerPerformance.punishments":
+		value := x.Punishments
+		return protoreflect.ValueOfUint64(value)
+	case "side.btcbridge.SignerPerformance.last_punishment_height":
+		value := x.LastPunishmentHeight
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.SignerPerformance"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.SignerPerformance does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SignerPerformance) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "side.btcbridge.SignerPerformance.operator_address":
+		x.OperatorAddress = value.Interface().(string)
+	case "side.btcbridge.SignerPerformance.missed_dkg_heights":
+		lv := value.List()
+		clv := lv.(*_SignerPerformance_2_list)
+		x.MissedDkgHeights = *clv.list
+	case "side.btcbridge.SignerPerformance.missed_signing_heights":
+		lv := value.List()
+		clv := lv.(*_SignerPerformance_3_list)
+		x.MissedSigningHeights = *clv.list
+	case "side.btcbridge.SignerPerformance.total_missed_dkgs":
+		x.TotalMissedDkgs = value.Uint()
+	case "side.btcbridge.SignerPerformance.total_missed_signings":
+		x.TotalMissedSignings = value.Uint()
+	case "side.btcbridge.SignerPerformance.punishments":
+		x.Punishments = value.Uint()
+	case "side.btcbridge.SignerPerformance.last_punishment_height":
+		x.LastPunishmentHeight = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.SignerPerformance"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.SignerPerformance does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SignerPerformance) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "side.btcbridge.SignerPerformance.missed_dkg_heights":
+		if x.MissedDkgHeights == nil {
+			x.MissedDkgHeights = []int64{}
+		}
+		value := &_SignerPerformance_2_list{list: &x.MissedDkgHeights}
+		return protoreflect.ValueOfList(value)
+	case "side.btcbridge.SignerPerformance.missed_signing_heights":
+		if x.MissedSigningHeights == nil {
+			x.MissedSigningHeights = []int64{}
+		}
+		value := &_SignerPerformance_3_list{list: &x.MissedSigningHeights}
+		return protoreflect.ValueOfList(value)
+	case "side.btcbridge.SignerPerformance.operator_address":
+		panic(fmt.Errorf("field operator_address of message side.btcbridge.SignerPerformance is not mutable"))
+	case "side.btcbridge.SignerPerformance.total_missed_dkgs":
+		panic(fmt.Errorf("field total_missed_dkgs of message side.btcbridge.SignerPerformance is not mutable"))
+	case "side.btcbridge.SignerPerformance.total_missed_signings":
+		panic(fmt.Errorf("field total_missed_signings of message side.btcbridge.SignerPerformance is not mutable"))
+	case "side.btcbridge.SignerPerformance.punishments":
+		panic(fmt.Errorf("field punishments of message side.btcbridge.SignerPerformance is not mutable"))
+	case "side.btcbridge.SignerPerformance.last_punishment_height":
+		panic(fmt.Errorf("field last_punishment_height of message side.btcbridge.SignerPerformance is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.SignerPerformance"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.SignerPerformance does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_SignerPerformance) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "side.btcbridge.SignerPerformance.operator_address":
+		return protoreflect.ValueOfString("")
+	case "side.btcbridge.SignerPerformance.missed_dkg_heights":
+		list := []int64{}
+		return protoreflect.ValueOfList(&_SignerPerformance_2_list{list: &list})
+	case "side.btcbridge.SignerPerformance.missed_signing_heights":
+		list := []int64{}
+		return protoreflect.ValueOfList(&_SignerPerformance_3_list{list: &list})
+	case "side.btcbridge.SignerPerformance.total_missed_dkgs":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "side.btcbridge.SignerPerformance.total_missed_signings":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "side.btcbridge.SignerPerformance.punishments":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "side.btcbridge.SignerPerformance.last_punishment_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.SignerPerformance"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.SignerPerformance does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_SignerPerformance) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in side.btcbridge.SignerPerformance", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_SignerPerformance) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SignerPerformance) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_SignerPerformance) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_SignerPerformance) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*SignerPerformance)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.OperatorAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.MissedDkgHeights) > 0 {
+			l = 0
+			for _, e := range x.MissedDkgHeights {
+				l += runtime.Sov(uint64(e))
+			}
+			n += 1 + runtime.Sov(uint64(l)) + l
+		}
+		if len(x.MissedSigningHeights) > 0 {
+			l = 0
+			for _, e := range x.MissedSigningHeights {
+				l += runtime.Sov(uint64(e))
+			}
+			n += 1 + runtime.Sov(uint64(l)) + l
+		}
+		if x.TotalMissedDkgs != 0 {
+			n += 1 + runtime.Sov(uint64(x.TotalMissedDkgs))
+		}
+		if x.TotalMissedSignings != 0 {
+			n += 1 + runtime.Sov(uint64(x.TotalMissedSignings))
+		}
+		if x.Punishments != 0 {
+			n += 1 + runtime.Sov(uint64(x.Punishments))
+		}
+		if x.LastPunishmentHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.LastPunishmentHeight))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*SignerPerformance)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.LastPunishmentHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LastPunishmentHeight))
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.Punishments != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Punishments))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.TotalMissedSignings != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TotalMissedSignings))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.TotalMissedDkgs != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TotalMissedDkgs))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.MissedSigningHeights) > 0 {
+			var pksize2 int
+			for _, num := range x.MissedSigningHeights {
+				pksize2 += runtime.Sov(uint64(num))
+			}
+			i -= pksize2
+			j1 := i
+			for _, num1 := range x.MissedSigningHeights {
+				num := uint64(num1)
+				for num >= 1<<7 {
+					dAtA[j1] = uint8(uint64(num)&0x7f | 0x80)
+					num >>= 7
+					j1++
+				}
+				dAtA[j1] = uint8(num)
+				j1++
+			}
+			i = runtime.EncodeVarint(dAtA, i, uint64(pksize2))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.MissedDkgHeights) > 0 {
+			var pksize4 int
+			for _, num := range x.MissedDkgHeights {
+				pksize4 += runtime.Sov(uint64(num))
+			}
+			i -= pksize4
+			j3 := i
+			for _, num1 := range x.MissedDkgHeights {
+				num := uint64(num1)
+				for num >= 1<<7 {
+					dAtA[j3] = uint8(uint64(num)&0x7f | 0x80)
+					num >>= 7
+					j3++
+				}
+				dAtA[j3] = uint8(num)
+				j3++
+			}
+			i = runtime.EncodeVarint(dAtA, i, uint64(pksize4))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.OperatorAddress) > 0 {
+			i -= len(x.OperatorAddress)
+			copy(dAtA[i:], x.OperatorAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.OperatorAddress)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*SignerPerformance)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SignerPerformance: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SignerPerformance: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.OperatorAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType == 0 {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					x.MissedDkgHeights = append(x.MissedDkgHeights, v)
+				} else if wireType == 2 {
+					var packedLen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						packedLen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if packedLen < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					postIndex := iNdEx + packedLen
+					if postIndex < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if postIndex > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					var elementCount int
+					var count int
+					for _, integer := range dAtA[iNdEx:postIndex] {
+						if integer < 128 {
+							count++
+						}
+					}
+					elementCount = count
+					if elementCount != 0 && len(x.MissedDkgHeights) == 0 {
+						x.MissedDkgHeights = make([]int64, 0, elementCount)
+					}
+					for iNdEx < postIndex {
+						var v int64
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							v |= int64(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						x.MissedDkgHeights = append(x.MissedDkgHeights, v)
+					}
+				} else {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MissedDkgHeights", wireType)
+				}
+			case 3:
+				if wireType == 0 {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					x.MissedSigningHeights = append(x.MissedSigningHeights, v)
+				} else if wireType == 2 {
+					var packedLen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						packedLen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if packedLen < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					postIndex := iNdEx + packedLen
+					if postIndex < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if postIndex > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					var elementCount int
+					var count int
+					for _, integer := range dAtA[iNdEx:postIndex] {
+						if integer < 128 {
+							count++
+						}
+					}
+					elementCount = count
+					if elementCount != 0 && len(x.MissedSigningHeights) == 0 {
+						x.MissedSigningHeights = make([]int64, 0, elementCount)
+					}
+					for iNdEx < postIndex {
+						var v int64
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							v |= int64(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						x.MissedSigningHeights = append(x.MissedSigningHeights, v)
+					}
+				} else {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MissedSigningHeights", wireType)
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TotalMissedDkgs", wireType)
+				}
+				x.TotalMissedDkgs = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TotalMissedDkgs |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TotalMissedSignings", wireType)
+				}
+				x.TotalMissedSignings = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TotalMissedSignings |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Punishments", wireType)
+				}
+				x.Punishments = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Punishments |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LastPunishmentHeight", wireType)
+				}
+				x.LastPunishmentHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.LastPunishmentHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_AssetTransfer_5_list)(nil)
+
+type _AssetTransfer_5_list struct {
+	list *[]string
+}
+
+func (x *_AssetTransfer_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_AssetTransfer_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_AssetTransfer_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_AssetTransfer_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_AssetTransfer_5_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message AssetTransfer at list field SigningRequests as it is not of Message kind"))
+}
+
+func (x *_AssetTransfer_5_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_AssetTransfer_5_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_AssetTransfer_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_AssetTransfer                    protoreflect.MessageDescriptor
+	fd_AssetTransfer_asset_type         protoreflect.FieldDescriptor
+	fd_AssetTransfer_source_vault       protoreflect.FieldDescriptor
+	fd_AssetTransfer_dest_vault         protoreflect.FieldDescriptor
+	fd_AssetTransfer_status             protoreflect.FieldDescriptor
+	fd_AssetTransfer_signing_requests   protoreflect.FieldDescriptor
+	fd_AssetTransfer_remaining_utxo_num protoreflect.FieldDescriptor
+	fd_AssetTransfer_remaining_value    protoreflect.FieldDescriptor
+	fd_AssetTransfer_last_error         protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_side_btcbridge_btcbridge_proto_init()
+	md_AssetTransfer = File_side_btcbridge_btcbridge_proto.Messages().ByName("AssetTransfer")
+	fd_AssetTransfer_asset_type = md_AssetTransfer.Fields().ByName("asset_type")
+	fd_AssetTransfer_source_vault = md_AssetTransfer.Fields().ByName("source_vault")
+	fd_AssetTransfer_dest_vault = md_AssetTransfer.Fields().ByName("dest_vault")
+	fd_AssetTransfer_status = md_AssetTransfer.Fields().ByName("status")
+	fd_AssetTransfer_signing_requests = md_AssetTransfer.Fields().ByName("signing_requests")
+	fd_AssetTransfer_remaining_utxo_num = md_AssetTransfer.Fields().ByName("remaining_utxo_num")
+	fd_AssetTransfer_remaining_value = md_AssetTransfer.Fields().ByName("remaining_value")
+	fd_AssetTransfer_last_error = md_AssetTransfer.Fields().ByName("last_error")
+}
+
+var _ protoreflect.Message = (*fastReflection_AssetTransfer)(nil)
+
+type fastReflection_AssetTransfer AssetTransfer
+
+func (x *AssetTransfer) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_AssetTransfer)(x)
+}
+
+func (x *AssetTransfer) slowProtoReflect() protoreflect.Message {
+	mi := &file_side_btcbridge_btcbridge_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_AssetTransfer_messageType fastReflection_AssetTransfer_messageType
+var _ protoreflect.MessageType = fastReflection_AssetTransfer_messageType{}
+
+type fastReflection_AssetTransfer_messageType struct{}
+
+func (x fastReflection_AssetTransfer_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_AssetTransfer)(nil)
+}
+func (x fastReflection_AssetTransfer_messageType) New() protoreflect.Message {
+	return new(fastReflection_AssetTransfer)
+}
+func (x fastReflection_AssetTransfer_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_AssetTransfer
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_AssetTransfer) Descriptor() protoreflect.MessageDescriptor {
+	return md_AssetTransfer
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_AssetTransfer) Type() protoreflect.MessageType {
+	return _fastReflection_AssetTransfer_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_AssetTransfer) New() protoreflect.Message {
+	return new(fastReflection_AssetTransfer)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_AssetTransfer) Interface() protoreflect.ProtoMessage {
+	return (*AssetTransfer)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_AssetTransfer) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.AssetType != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.AssetType))
+		if !f(fd_AssetTransfer_asset_type, value) {
+			return
+		}
+	}
+	if x.SourceVault != "" {
+		value := protoreflect.ValueOfString(x.SourceVault)
+		if !f(fd_AssetTransfer_source_vault, value) {
+			return
+		}
+	}
+	if x.DestVault != "" {
+		value := protoreflect.ValueOfString(x.DestVault)
+		if !f(fd_AssetTransfer_dest_vault, value) {
+			return
+		}
+	}
+	if x.Status != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Status))
+		if !f(fd_AssetTransfer_status, value) {
+			return
+		}
+	}
+	if len(x.SigningRequests) != 0 {
+		value := protoreflect.ValueOfList(&_AssetTransfer_5_list{list: &x.SigningRequests})
+		if !f(fd_AssetTransfer_signing_requests, value) {
+			return
+		}
+	}
+	if x.RemainingUtxoNum != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.RemainingUtxoNum)
+		if !f(fd_AssetTransfer_remaining_utxo_num, value) {
+			return
+		}
+	}
+	if x.RemainingValue != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.RemainingValue)
+		if !f(fd_AssetTransfer_remaining_value, value) {
+			return
+		}
+	}
+	if x.LastError != "" {
+		value := protoreflect.ValueOfString(x.LastError)
+		if !f(fd_AssetTransfer_last_error, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_AssetTransfer) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "side.btcbridge.AssetTransfer.asset_type":
+		return x.AssetType != 0
+	case "side.btcbridge.AssetTransfer.source_vault":
+		return x.SourceVault != ""
+	case "side.btcbridge.AssetTransfer.dest_vault":
+		return x.DestVault != ""
+	case "side.btcbridge.AssetTransfer.status":
+		return x.Status != 0
+	case "side.btcbridge.AssetTransfer.signing_requests":
+		return len(x.SigningRequests) != 0
+	case "side.btcbridge.AssetTransfer.remaining_utxo_num":
+		return x.RemainingUtxoNum != uint64(0)
+	case "side.btcbridge.AssetTransfer.remaining_value":
+		return x.RemainingValue != uint64(0)
+	case "side.btcbridge.AssetTransfer.last_error":
+		return x.LastError != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.AssetTransfer"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.AssetTransfer does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AssetTransfer) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "side.btcbridge.AssetTransfer.asset_type":
+		x.AssetType = 0
+	case "side.btcbridge.AssetTransfer.source_vault":
+		x.SourceVault = ""
+	case "side.btcbridge.AssetTransfer.dest_vault":
+		x.DestVault = ""
+	case "side.btcbridge.AssetTransfer.status":
+		x.Status = 0
+	case "side.btcbridge.AssetTransfer.signing_requests":
+		x.SigningRequests = nil
+	case "side.btcbridge.AssetTransfer.remaining_utxo_num":
+		x.RemainingUtxoNum = uint64(0)
+	case "side.btcbridge.AssetTransfer.remaining_value":
+		x.RemainingValue = uint64(0)
+	case "side.btcbridge.AssetTransfer.last_error":
+		x.LastError = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.AssetTransfer"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.AssetTransfer does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_AssetTransfer) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "side.btcbridge.AssetTransfer.asset_type":
+		value := x.AssetType
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "side.btcbridge.AssetTransfer.source_vault":
+		value := x.SourceVault
+		return protoreflect.ValueOfString(value)
+	case "side.btcbridge.AssetTransfer.dest_vault":
+		value := x.DestVault
+		return protoreflect.ValueOfString(value)
+	case "side.btcbridge.AssetTransfer.status":
+		value := x.Status
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "side.btcbridge.AssetTransfer.signing_requests":
+		if len(x.SigningRequests) == 0 {
+			return protoreflect.ValueOfList(&_AssetTransfer_5_list{})
+		}
+		listValue := &_AssetTransfer_5_list{list: &x.SigningRequests}
+		return protoreflect.ValueOfList(listValue)
+	case "side.btcbridge.AssetTransfer.remaining_utxo_num":
+		value := x.RemainingUtxoNum
+		return protoreflect.ValueOfUint64(value)
+	case "side.btcbridge.AssetTransfer.remaining_value":
+		value := x.RemainingValue
+		return protoreflect.ValueOfUint64(value)
+	case "side.btcbridge.AssetTransfer.last_error":
+		value := x.LastError
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.AssetTransfer"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.AssetTransfer does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AssetTransfer) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "side.btcbridge.AssetTransfer.asset_type":
+		x.AssetType = (AssetType)(value.Enum())
+	case "side.btcbridge.AssetTransfer.source_vault":
+		x.SourceVault = value.Interface().(string)
+	case "side.btcbridge.AssetTransfer.dest_vault":
+		x.DestVault = value.Interface().(string)
+	case "side.btcbridge.AssetTransfer.status":
+		x.Status = (VaultTransferStatus)(value.Enum())
+	case "side.btcbridge.AssetTransfer.signing_requests":
+		lv := value.List()
+		clv := lv.(*_AssetTransfer_5_list)
+		x.SigningRequests = *clv.list
+	case "side.btcbridge.AssetTransfer.remaining_utxo_num":
+		x.RemainingUtxoNum = value.Uint()
+	case "side.btcbridge.AssetTransfer.remaining_value":
+		x.RemainingValue = value.Uint()
+	case "side.btcbridge.AssetTransfer.last_error":
+		x.LastError = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.AssetTransfer"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.AssetTransfer does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AssetTransfer) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "side.btcbridge.AssetTransfer.signing_requests":
+		if x.SigningRequests == nil {
+			x.SigningRequests = []string{}
+		}
+		value := &_AssetTransfer_5_list{list: &x.SigningRequests}
+		return protoreflect.ValueOfList(value)
+	case "side.btcbridge.AssetTransfer.asset_type":
+		panic(fmt.Errorf("field asset_type of message side.btcbridge.AssetTransfer is not mutable"))
+	case "side.btcbridge.AssetTransfer.source_vault":
+		panic(fmt.Errorf("field source_vault of message side.btcbridge.AssetTransfer is not mutable"))
+	case "side.btcbridge.AssetTransfer.dest_vault":
+		panic(fmt.Errorf("field dest_vault of message side.btcbridge.AssetTransfer is not mutable"))
+	case "side.btcbridge.AssetTransfer.status":
+		panic(fmt.Errorf("field status of message side.btcbridge.AssetTransfer is not mutable"))
+	case "side.btcbridge.AssetTransfer.remaining_utxo_num":
+		panic(fmt.Errorf("field remaining_utxo_num of message side.btcbridge.AssetTransfer is not mutable"))
+	case "side.btcbridge.AssetTransfer.remaining_value":
+		panic(fmt.Errorf("field remaining_value of message side.btcbridge.AssetTransfer is not mutable"))
+	case "side.btcbridge.AssetTransfer.last_error":
+		panic(fmt.Errorf("field last_error of message side.btcbridge.AssetTransfer is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.AssetTransfer"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.AssetTransfer does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_AssetTransfer) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "side.btcbridge.AssetTransfer.asset_type":
+		return protoreflect.ValueOfEnum(0)
+	case "side.btcbridge.AssetTransfer.source_vault":
+		return protoreflect.ValueOfString("")
+	case "side.btcbridge.AssetTransfer.dest_vault":
+		return protoreflect.ValueOfString("")
+	case "side.btcbridge.AssetTransfer.status":
+		return protoreflect.ValueOfEnum(0)
+	case "side.btcbridge.AssetTransfer.signing_requests":
+		list := []string{}
+		return protoreflect.ValueOfList(&_AssetTransfer_5_list{list: &list})
+	case "side.btcbridge.AssetTransfer.remaining_utxo_num":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "side.btcbridge.AssetTransfer.remaining_value":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "side.btcbridge.AssetTransfer.last_error":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.AssetTransfer"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.AssetTransfer does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_AssetTransfer) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in side.btcbridge.AssetTransfer", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_AssetTransfer) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AssetTransfer) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_AssetTransfer) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_AssetTransfer) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*AssetTransfer)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.AssetType != 0 {
+			n += 1 + runtime.Sov(uint64(x.AssetType))
+		}
+		l = len(x.SourceVault)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.DestVault)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Status != 0 {
+			n += 1 + runtime.Sov(uint64(x.Status))
+		}
+		if len(x.SigningRequests) > 0 {
+			for _, s := range x.SigningRequests {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.RemainingUtxoNum != 0 {
+			n += 1 + runtime.Sov(uint64(x.RemainingUtxoNum))
+		}
+		if x.RemainingValue != 0 {
+			n += 1 + runtime.Sov(uint64(x.RemainingValue))
+		}
+		l = len(x.LastError)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*AssetTransfer)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.LastError) > 0 {
+			i -= len(x.LastError)
+			copy(dAtA[i:], x.LastError)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.LastError)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if x.RemainingValue != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RemainingValue))
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.RemainingUtxoNum != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RemainingUtxoNum))
+			i--
+			dAtA[i] = 0x30
+		}
+		if len(x.SigningRequests) > 0 {
+			for iNdEx := len(x.SigningRequests) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.SigningRequests[iNdEx])
+				copy(dAtA[i:], x.SigningRequests[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SigningRequests[iNdEx])))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if x.Status != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Status))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.DestVault) > 0 {
+			i -= len(x.DestVault)
+			copy(dAtA[i:], x.DestVault)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DestVault)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.SourceVault) > 0 {
+			i -= len(x.SourceVault)
+			copy(dAtA[i:], x.SourceVault)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SourceVault)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.AssetType != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AssetType))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*AssetTransfer)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AssetTransfer: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AssetTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AssetType", wireType)
+				}
+				x.AssetType = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.AssetType |= AssetType(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SourceVault", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SourceVault = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DestVault", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DestVault = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				}
+				x.Status = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Status |= VaultTransferStatus(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SigningRequests", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SigningRequests = append(x.SigningRequests, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RemainingUtxoNum", wireType)
+				}
+				x.RemainingUtxoNum = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RemainingUtxoNum |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RemainingValue", wireType)
+				}
+				x.RemainingValue = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RemainingValue |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LastError", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.LastError = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_VaultTransfer_5_list)(nil)
+
+type _VaultTransfer_5_list struct {
+	list *[]*AssetTransfer
+}
+
+func (x *_VaultTransfer_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_VaultTransfer_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_VaultTransfer_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*AssetTransfer)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_VaultTransfer_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*AssetTransfer)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_VaultTransfer_5_list) AppendMutable() protoreflect.Value {
+	v := new(AssetTransfer)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_VaultTransfer_5_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_VaultTransfer_5_list) NewElement() protoreflect.Value {
+	v := new(AssetTransfer)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_VaultTransfer_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_VaultTransfer                   protoreflect.MessageDescriptor
+	fd_VaultTransfer_source_version    protoreflect.FieldDescriptor
+	fd_VaultTransfer_dest_version      protoreflect.FieldDescriptor
+	fd_VaultTransfer_dkg_id            protoreflect.FieldDescriptor
+	fd_VaultTransfer_status            protoreflect.FieldDescriptor
+	fd_VaultTransfer_assets            protoreflect.FieldDescriptor
+	fd_VaultTransfer_start_height      protoreflect.FieldDescriptor
+	fd_VaultTransfer_completion_height protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_side_btcbridge_btcbridge_proto_init()
+	md_VaultTransfer = File_side_btcbridge_btcbridge_proto.Messages().ByName("VaultTransfer")
+	fd_VaultTransfer_source_version = md_VaultTransfer.Fields().ByName("source_version")
+	fd_VaultTransfer_dest_version = md_VaultTransfer.Fields().ByName("dest_version")
+	fd_VaultTransfer_dkg_id = md_VaultTransfer.Fields().ByName("dkg_id")
+	fd_VaultTransfer_status = md_VaultTransfer.Fields().ByName("status")
+	fd_VaultTransfer_assets = md_VaultTransfer.Fields().ByName("assets")
+	fd_VaultTransfer_start_height = md_VaultTransfer.Fields().ByName("start_height")
+	fd_VaultTransfer_completion_height = md_VaultTransfer.Fields().ByName("completion_height")
+}
+
+var _ protoreflect.Message = (*fastReflection_VaultTransfer)(nil)
+
+type fastReflection_VaultTransfer VaultTransfer
+
+func (x *VaultTransfer) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_VaultTransfer)(x)
+}
+
+func (x *VaultTransfer) slowProtoReflect() protoreflect.Message {
+	mi := &file_side_btcbridge_btcbridge_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_VaultTransfer_messageType fastReflection_VaultTransfer_messageType
+var _ protoreflect.MessageType = fastReflection_VaultTransfer_messageType{}
+
+type fastReflection_VaultTransfer_messageType struct{}
+
+func (x fastReflection_VaultTransfer_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_VaultTransfer)(nil)
+}
+func (x fastReflection_VaultTransfer_messageType) New() protoreflect.Message {
+	return new(fastReflection_VaultTransfer)
+}
+func (x fastReflection_VaultTransfer_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_VaultTransfer
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_VaultTransfer) Descriptor() protoreflect.MessageDescriptor {
+	return md_VaultTransfer
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_VaultTransfer) Type() protoreflect.MessageType {
+	return _fastReflection_VaultTransfer_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_VaultTransfer) New() protoreflect.Message {
+	return new(fastReflection_VaultTransfer)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_VaultTransfer) Interface() protoreflect.ProtoMessage {
+	return (*VaultTransfer)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_VaultTransfer) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.SourceVersion != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.SourceVersion)
+		if !f(fd_VaultTransfer_source_version, value) {
+			return
+		}
+	}
+	if x.DestVersion != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.DestVersion)
+		if !f(fd_VaultTransfer_dest_version, value) {
+			return
+		}
+	}
+	if x.DkgId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.DkgId)
+		if !f(fd_VaultTransfer_dkg_id, value) {
+			return
+		}
+	}
+	if x.Status != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Status))
+		if !f(fd_VaultTransfer_status, value) {
+			return
+		}
+	}
+	if len(x.Assets) != 0 {
+		value := protoreflect.ValueOfList(&_VaultTransfer_5_list{list: &x.Assets})
+		if !f(fd_VaultTransfer_assets, value) {
+			return
+		}
+	}
+	if x.StartHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.StartHeight)
+		if !f(fd_VaultTransfer_start_height, value) {
+			return
+		}
+	}
+	if x.CompletionHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.CompletionHeight)
+		if !f(fd_VaultTransfer_completion_height, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_VaultTransfer) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "side.btcbridge.VaultTransfer.source_version":
+		return x.SourceVersion != uint64(0)
+	case "side.btcbridge.VaultTransfer.dest_version":
+		return x.DestVersion != uint64(0)
+	case "side.btcbridge.VaultTransfer.dkg_id":
+		return x.DkgId != uint64(0)
+	case "side.btcbridge.VaultTransfer.status":
+		return x.Status != 0
+	case "side.btcbridge.VaultTransfer.assets":
+		return len(x.Assets) != 0
+	case "side.btcbridge.VaultTransfer.start_height":
+		return x.StartHeight != int64(0)
+	case "side.btcbridge.VaultTransfer.completion_height":
+		return x.CompletionHeight != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.VaultTransfer"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.VaultTransfer does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VaultTransfer) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "side.btcbridge.VaultTransfer.source_version":
+		x.SourceVersion = uint64(0)
+	case "side.btcbridge.VaultTransfer.dest_version":
+		x.DestVersion = uint64(0)
+	case "side.btcbridge.VaultTransfer.dkg_id":
+		x.DkgId = uint64(0)
+	case "side.btcbridge.VaultTransfer.status":
+		x.Status = 0
+	case "side.btcbridge.VaultTransfer.assets":
+		x.Assets = nil
+	case "side.btcbridge.VaultTransfer.start_height":
+		x.StartHeight = int64(0)
+	case "side.btcbridge.VaultTransfer.completion_height":
+		x.CompletionHeight = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.VaultTransfer"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.VaultTransfer does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_VaultTransfer) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "side.btcbridge.VaultTransfer.source_version":
+		value := x.SourceVersion
+		return protoreflect.ValueOfUint64(value)
+	case "side.btcbridge.VaultTransfer.dest_version":
+		value := x.DestVersion
+		return protoreflect.ValueOfUint64(value)
+	case "side.btcbridge.VaultTransfer.dkg_id":
+		value := x.DkgId
+		return protoreflect.ValueOfUint64(value)
+	case "side.btcbridge.VaultTransfer.status":
+		value := x.Status
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "side.btcbridge.VaultTransfer.assets":
+		if len(x.Assets) == 0 {
+			return protoreflect.ValueOfList(&_VaultTransfer_5_list{})
+		}
+		listValue := &_VaultTransfer_5_list{list: &x.Assets}
+		return protoreflect.ValueOfList(listValue)
+	case "side.btcbridge.VaultTransfer.start_height":
+		value := x.StartHeight
+		return protoreflect.ValueOfInt64(value)
+	case "side.btcbridge.VaultTransfer.completion_height":
+		value := x.CompletionHeight
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.VaultTransfer"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.VaultTransfer does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VaultTransfer) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "side.btcbridge.VaultTransfer.source_version":
+		x.SourceVersion = value.Uint()
+	case "side.btcbridge.VaultTransfer.dest_version":
+		x.DestVersion = value.Uint()
+	case "side.btcbridge.VaultTransfer.dkg_id":
+		x.DkgId = value.Uint()
+	case "side.btcbridge.VaultTransfer.status":
+		x.Status = (VaultTransferStatus)(value.Enum())
+	case "side.btcbridge.VaultTransfer.assets":
+		lv := value.List()
+		clv := lv.(*_VaultTransfer_5_list)
+		x.Assets = *clv.list
+	case "side.btcbridge.VaultTransfer.start_height":
+		x.StartHeight = value.Int()
+	case "side.btcbridge.VaultTransfer.completion_height":
+		x.CompletionHeight = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.VaultTransfer"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.VaultTransfer does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VaultTransfer) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "side.btcbridge.VaultTransfer.assets":
+		if x.Assets == nil {
+			x.Assets = []*AssetTransfer{}
+		}
+		value := &_VaultTransfer_5_list{list: &x.Assets}
+		return protoreflect.ValueOfList(value)
+	case "side.btcbridge.VaultTransfer.source_version":
+		panic(fmt.Errorf("field source_version of message side.btcbridge.VaultTransfer is not mutable"))
+	case "side.btcbridge.VaultTransfer.dest_version":
+		panic(fmt.Errorf("field dest_version of message side.btcbridge.VaultTransfer is not mutable"))
+	case "side.btcbridge.VaultTransfer.dkg_id":
+		panic(fmt.Errorf("field dkg_id of message side.btcbridge.VaultTransfer is not mutable"))
+	case "side.btcbridge.VaultTransfer.status":
+		panic(fmt.Errorf("field status of message side.btcbridge.VaultTransfer is not mutable"))
+	case "side.btcbridge.VaultTransfer.start_height":
+		panic(fmt.Errorf("field start_height of message side.btcbridge.VaultTransfer is not mutable"))
+	case "side.btcbridge.VaultTransfer.completion_height":
+		panic(fmt.Errorf("field completion_height of message side.btcbridge.VaultTransfer is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.VaultTransfer"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.VaultTransfer does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_VaultTransfer) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "side.btcbridge.VaultTransfer.source_version":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "side.btcbridge.VaultTransfer.dest_version":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "side.btcbridge.VaultTransfer.dkg_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "side.btcbridge.VaultTransfer.status":
+		return protoreflect.ValueOfEnum(0)
+	case "side.btcbridge.VaultTransfer.assets":
+		list := []*AssetTransfer{}
+		return protoreflect.ValueOfList(&_VaultTransfer_5_list{list: &list})
+	case "side.btcbridge.VaultTransfer.start_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "side.btcbridge.VaultTransfer.completion_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.VaultTransfer"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.VaultTransfer does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_VaultTransfer) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in side.btcbridge.VaultTransfer", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_VaultTransfer) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VaultTransfer) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_VaultTransfer) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_VaultTransfer) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*VaultTransfer)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.SourceVersion != 0 {
+			n += 1 + runtime.Sov(uint64(x.SourceVersion))
+		}
+		if x.DestVersion != 0 {
+			n += 1 + runtime.Sov(uint64(x.DestVersion))
+		}
+		if x.DkgId != 0 {
+			n += 1 + runtime.Sov(uint64(x.DkgId))
+		}
+		if x.Status != 0 {
+			n += 1 + runtime.Sov(uint64(x.Status))
+		}
+		if len(x.Assets) > 0 {
+			for _, e := range x.Assets {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.StartHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.StartHeight))
+		}
+		if x.CompletionHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.CompletionHeight))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*VaultTransfer)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.CompletionHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.CompletionHeight))
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.StartHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.StartHeight))
+			i--
+			dAtA[i] = 0x30
+		}
+		if len(x.Assets) > 0 {
+			for iNdEx := len(x.Assets) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Assets[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if x.Status != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Status))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.DkgId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DkgId))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.DestVersion != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DestVersion))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.SourceVersion != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SourceVersion))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*VaultTransfer)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: VaultTransfer: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: VaultTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SourceVersion", wireType)
+				}
+				x.SourceVersion = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SourceVersion |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DestVersion", wireType)
+				}
+				x.DestVersion = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.DestVersion |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DkgId", wireType)
+				}
+				x.DkgId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.DkgId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				}
+				x.Status = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Status |= VaultTransferStatus(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Assets", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Assets = append(x.Assets, &AssetTransfer{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Assets[len(x.Assets)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+				}
+				x.StartHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.StartHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CompletionHeight", wireType)
+				}
+				x.CompletionHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.CompletionHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_FeeBucketStats_2_list)(nil)
+
+type _FeeBucketStats_2_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_FeeBucketStats_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_FeeBucketStats_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_FeeBucketStats_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_FeeBucketStats_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_FeeBucketStats_2_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_FeeBucketStats_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_FeeBucketStats_2_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_FeeBucketStats_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_FeeBucketStats_3_list)(nil)
+
+type _FeeBucketStats_3_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_FeeBucketStats_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_FeeBucketStats_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_FeeBucketStats_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_FeeBucketStats_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_FeeBucketStats_3_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_FeeBucketStats_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_FeeBucketStats_3_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_FeeBucketStats_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_FeeBucketStats             protoreflect.MessageDescriptor
+	fd_FeeBucketStats_bucket      protoreflect.FieldDescriptor
+	fd_FeeBucketStats_accumulated protoreflect.FieldDescriptor
+	fd_FeeBucketStats_distributed protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_side_btcbridge_btcbridge_proto_init()
+	md_FeeBucketStats = File_side_btcbridge_btcbridge_proto.Messages().ByName("FeeBucketStats")
+	fd_FeeBucketStats_bucket = md_FeeBucketStats.Fields().ByName("bucket")
+	fd_FeeBucketStats_accumulated = md_FeeBucketStats.Fields().ByName("accumulated")
+	fd_FeeBucketStats_distributed = md_FeeBucketStats.Fields().ByName("distributed")
+}
+
+var _ protoreflect.Message = (*fastReflection_FeeBucketStats)(nil)
+
+type fastReflection_FeeBucketStats FeeBucketStats
+
+func (x *FeeBucketStats) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_FeeBucketStats)(x)
+}
+
+func (x *FeeBucketStats) slowProtoReflect() protoreflect.Message {
+	mi := &file_side_btcbridge_btcbridge_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_FeeBucketStats_messageType fastReflection_FeeBucketStats_messageType
+var _ protoreflect.MessageType = fastReflection_FeeBucketStats_messageType{}
+
+type fastReflection_FeeBucketStats_messageType struct{}
+
+func (x fastReflection_FeeBucketStats_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_FeeBucketStats)(nil)
+}
+func (x fastReflection_FeeBucketStats_messageType) New() protoreflect.Message {
+	return new(fastReflection_FeeBucketStats)
+}
+func (x fastReflection_FeeBucketStats_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_FeeBucketStats
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_FeeBucketStats) Descriptor() protoreflect.MessageDescriptor {
+	return md_FeeBucketStats
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_FeeBucketStats) Type() protoreflect.MessageType {
+	return _fastReflection_FeeBucketStats_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_FeeBucketStats) New() protoreflect.Message {
+	return new(fastReflection_FeeBucketStats)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_FeeBucketStats) Interface() protoreflect.ProtoMessage {
+	return (*FeeBucketStats)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_FeeBucketStats) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Bucket != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Bucket))
+		if !f(fd_FeeBucketStats_bucket, value) {
+			return
+		}
+	}
+	if len(x.Accumulated) != 0 {
+		value := protoreflect.ValueOfList(&_FeeBucketStats_2_list{list: &x.Accumulated})
+		if !f(fd_FeeBucketStats_accumulated, value) {
+			return
+		}
+	}
+	if len(x.Distributed) != 0 {
+		value := protoreflect.ValueOfList(&_FeeBucketStats_3_list{list: &x.Distributed})
+		if !f(fd_FeeBucketStats_distributed, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_FeeBucketStats) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "side.btcbridge.FeeBucketStats.bucket":
+		return x.Bucket != 0
+	case "side.btcbridge.FeeBucketStats.accumulated":
+		return len(x.Accumulated) != 0
+	case "side.btcbridge.FeeBucketStats.distributed":
+		return len(x.Distributed) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.FeeBucketStats"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.FeeBucketStats does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeeBucketStats) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "side.btcbridge.FeeBucketStats.bucket":
+		x.Bucket = 0
+	case "side.btcbridge.FeeBucketStats.accumulated":
+		x.Accumulated = nil
+	case "side.btcbridge.FeeBucketStats.distributed":
+		x.Distributed = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.FeeBucketStats"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.FeeBucketStats does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_FeeBucketStats) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "side.btcbridge.FeeBucketStats.bucket":
+		value := x.Bucket
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "side.btcbridge.FeeBucketStats.accumulated":
+		if len(x.Accumulated) == 0 {
+			return protoreflect.ValueOfList(&_FeeBucketStats_2_list{})
+		}
+		listValue := &_FeeBucketStats_2_list{list: &x.Accumulated}
+		return protoreflect.ValueOfList(listValue)
+	case "side.btcbridge.FeeBucketStats.distributed":
+		if len(x.Distributed) == 0 {
+			return protoreflect.ValueOfList(&_FeeBucketStats_3_list{})
+		}
+		listValue := &_FeeBucketStats_3_list{list: &x.Distributed}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.FeeBucketStats"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.FeeBucketStats does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeeBucketStats) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "side.btcbridge.FeeBucketStats.bucket":
+		x.Bucket = (FeeBucket)(value.Enum())
+	case "side.btcbridge.FeeBucketStats.accumulated":
+		lv := value.List()
+		clv := lv.(*_FeeBucketStats_2_list)
+		x.Accumulated = *clv.list
+	case "side.btcbridge.FeeBucketStats.distributed":
+		lv := value.List()
+		clv := lv.(*_FeeBucketStats_3_list)
+		x.Distributed = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.FeeBucketStats"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.FeeBucketStats does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeeBucketStats) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "side.btcbridge.FeeBucketStats.accumulated":
+		if x.Accumulated == nil {
+			x.Accumulated = []*v1beta1.Coin{}
+		}
+		value := &_FeeBucketStats_2_list{list: &x.Accumulated}
+		return protoreflect.ValueOfList(value)
+	case "side.btcbridge.FeeBucketStats.distributed":
+		if x.Distributed == nil {
+			x.Distributed = []*v1beta1.Coin{}
+		}
+		value := &_FeeBucketStats_3_list{list: &x.Distributed}
+		return protoreflect.ValueOfList(value)
+	case "side.btcbridge.FeeBucketStats.bucket":
+		panic(fmt.Errorf("field bucket of message side.btcbridge.FeeBucketStats is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.FeeBucketStats"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.FeeBucketStats does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_FeeBucketStats) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "side.btcbridge.FeeBucketStats.bucket":
+		return protoreflect.ValueOfEnum(0)
+	case "side.btcbridge.FeeBucketStats.accumulated":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_FeeBucketStats_2_list{list: &list})
+	case "side.btcbridge.FeeBucketStats.distributed":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_FeeBucketStats_3_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.FeeBucketStats"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.FeeBucketStats does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_FeeBucketStats) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in side.btcbridge.FeeBucketStats", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_FeeBucketStats) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeeBucketStats) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_FeeBucketStats) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_FeeBucketStats) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*FeeBucketStats)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Bucket != 0 {
+			n += 1 + runtime.Sov(uint64(x.Bucket))
+		}
+		if len(x.Accumulated) > 0 {
+			for _, e := range x.Accumulated {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.Distributed) > 0 {
+			for _, e := range x.Distributed {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*FeeBucketStats)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Distributed) > 0 {
+			for iNdEx := len(x.Distributed) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Distributed[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if len(x.Accumulated) > 0 {
+			for iNdEx := len(x.Accumulated) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Accumulated[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if x.Bucket != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Bucket))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*FeeBucketStats)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FeeBucketStats: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FeeBucketStats: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Bucket", wireType)
+				}
+				x.Bucket = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Bucket |= FeeBucket(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Accumulated", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Accumulated = append(x.Accumulated, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Accumulated[len(x.Accumulated)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Distributed", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Distributed = append(x.Distributed, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Distributed[len(x.Distributed)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_CircuitBreaker         protoreflect.MessageDescriptor
+	fd_CircuitBreaker_tripped protoreflect.FieldDescriptor
+	fd_CircuitBreaker_height  protoreflect.FieldDescriptor
+	fd_CircuitBreaker_denom   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_side_btcbridge_btcbridge_proto_init()
+	md_CircuitBreaker = File_side_btcbridge_btcbridge_proto.Messages().ByName("CircuitBreaker")
+	fd_CircuitBreaker_tripped = md_CircuitBreaker.Fields().ByName("tripped")
+	fd_CircuitBreaker_height = md_CircuitBreaker.Fields().ByName("height")
+	fd_CircuitBreaker_denom = md_CircuitBreaker.Fields().ByName("denom")
+}
+
+var _ protoreflect.Message = (*fastReflection_CircuitBreaker)(nil)
+
+type fastReflection_CircuitBreaker CircuitBreaker
+
+func (x *CircuitBreaker) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_CircuitBreaker)(x)
+}
+
+func (x *CircuitBreaker) slowProtoReflect() protoreflect.Message {
+	mi := &file_side_btcbridge_btcbridge_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_CircuitBreaker_messageType fastReflection_CircuitBreaker_messageType
+var _ protoreflect.MessageType = fastReflection_CircuitBreaker_messageType{}
+
+type fastReflection_CircuitBreaker_messageType struct{}
+
+func (x fastReflection_CircuitBreaker_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_CircuitBreaker)(nil)
+}
+func (x fastReflection_CircuitBreaker_messageType) New() protoreflect.Message {
+	return new(fastReflection_CircuitBreaker)
+}
+func (x fastReflection_CircuitBreaker_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_CircuitBreaker
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_CircuitBreaker) Descriptor() protoreflect.MessageDescriptor {
+	return md_CircuitBreaker
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_CircuitBreaker) Type() protoreflect.MessageType {
+	return _fastReflection_CircuitBreaker_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_CircuitBreaker) New() protoreflect.Message {
+	return new(fastReflection_CircuitBreaker)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_CircuitBreaker) Interface() protoreflect.ProtoMessage {
+	return (*CircuitBreaker)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_CircuitBreaker) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Tripped != false {
+		value := protoreflect.ValueOfBool(x.Tripped)
+		if !f(fd_CircuitBreaker_tripped, value) {
+			return
+		}
+	}
+	if x.Height != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Height)
+		if !f(fd_CircuitBreaker_height, value) {
+			return
+		}
+	}
+	if x.Denom != "" {
+		value := protoreflect.ValueOfString(x.Denom)
+		if !f(fd_CircuitBreaker_denom, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_CircuitBreaker) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "side.btcbridge.CircuitBreaker.tripped":
+		return x.Tripped != false
+	case "side.btcbridge.CircuitBreaker.height":
+		return x.Height != int64(0)
+	case "side.btcbridge.CircuitBreaker.denom":
+		return x.Denom != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.CircuitBreaker"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.CircuitBreaker does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CircuitBreaker) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "side.btcbridge.CircuitBreaker.tripped":
+		x.Tripped = false
+	case "side.btcbridge.CircuitBreaker.height":
+		x.Height = int64(0)
+	case "side.btcbridge.CircuitBreaker.denom":
+		x.Denom = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.CircuitBreaker"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.CircuitBreaker does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_CircuitBreaker) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "side.btcbridge.CircuitBreaker.tripped":
+		value := x.Tripped
+		return protoreflect.ValueOfBool(value)
+	case "side.btcbridge.CircuitBreaker.height":
+		value := x.Height
+		return protoreflect.ValueOfInt64(value)
+	case "side.btcbridge.CircuitBreaker.denom":
+		value := x.Denom
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.CircuitBreaker"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.CircuitBreaker does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CircuitBreaker) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "side.btcbridge.CircuitBreaker.tripped":
+		x.Tripped = value.Bool()
+	case "side.btcbridge.CircuitBreaker.height":
+		x.Height = value.Int()
+	case "side.btcbridge.CircuitBreaker.denom":
+		x.Denom = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.CircuitBreaker"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.CircuitBreaker does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CircuitBreaker) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "side.btcbridge.CircuitBreaker.tripped":
+		panic(fmt.Errorf("field tripped of message side.btcbridge.CircuitBreaker is not mutable"))
+	case "side.btcbridge.CircuitBreaker.height":
+		panic(fmt.Errorf("field height of message side.btcbridge.CircuitBreaker is not mutable"))
+	case "side.btcbridge.CircuitBreaker.denom":
+		panic(fmt.Errorf("field denom of message side.btcbridge.CircuitBreaker is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.CircuitBreaker"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.CircuitBreaker does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_CircuitBreaker) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "side.btcbridge.CircuitBreaker.tripped":
+		return protoreflect.ValueOfBool(false)
+	case "side.btcbridge.CircuitBreaker.height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "side.btcbridge.CircuitBreaker.denom":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.CircuitBreaker"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.CircuitBreaker does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_CircuitBreaker) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in side.btcbridge.CircuitBreaker", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_CircuitBreaker) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CircuitBreaker) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_CircuitBreaker) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_CircuitBreaker) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*CircuitBreaker)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Tripped {
+			n += 2
+		}
+		if x.Height != 0 {
+			n += 1 + runtime.Sov(uint64(x.Height))
+		}
+		l = len(x.Denom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*CircuitBreaker)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Denom) > 0 {
+			i -= len(x.Denom)
+			copy(dAtA[i:], x.Denom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.Height != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.Tripped {
+			i--
+			if x.Tripped {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*CircuitBreaker)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CircuitBreaker: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CircuitBreaker: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Tripped", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Tripped = bool(v != 0)
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				}
+				x.Height = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Height |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Denom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_OutflowUsage       protoreflect.MessageDescriptor
+	fd_OutflowUsage_denom protoreflect.FieldDescriptor
+	fd_OutflowUsage_used  protoreflect.FieldDescriptor
+	fd_OutflowUsage_limit protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_side_btcbridge_btcbridge_proto_init()
+	md_OutflowUsage = File_side_btcbridge_btcbridge_proto.Messages().ByName("OutflowUsage")
+	fd_OutflowUsage_denom = md_OutflowUsage.Fields().ByName("denom")
+	fd_OutflowUsage_used = md_OutflowUsage.Fields().ByName("used")
+	fd_OutflowUsage_limit = md_OutflowUsage.Fields().ByName("limit")
+}
+
+var _ protoreflect.Message = (*fastReflection_OutflowUsage)(nil)
+
+type fastReflection_OutflowUsage OutflowUsage
+
+func (x *OutflowUsage) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_OutflowUsage)(x)
+}
+
+func (x *OutflowUsage) slowProtoReflect() protoreflect.Message {
+	mi := &file_side_btcbridge_btcbridge_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_OutflowUsage_messageType fastReflection_OutflowUsage_messageType
+var _ protoreflect.MessageType = fastReflection_OutflowUsage_messageType{}
+
+type fastReflection_OutflowUsage_messageType struct{}
+
+func (x fastReflection_OutflowUsage_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_OutflowUsage)(nil)
+}
+func (x fastReflection_OutflowUsage_messageType) New() protoreflect.Message {
+	return new(fastReflection_OutflowUsage)
+}
+func (x fastReflection_OutflowUsage_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_OutflowUsage
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_OutflowUsage) Descriptor() protoreflect.MessageDescriptor {
+	return md_OutflowUsage
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_OutflowUsage) Type() protoreflect.MessageType {
+	return _fastReflection_OutflowUsage_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_OutflowUsage) New() protoreflect.Message {
+	return new(fastReflection_OutflowUsage)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_OutflowUsage) Interface() protoreflect.ProtoMessage {
+	return (*OutflowUsage)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_OutflowUsage) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Denom != "" {
+		value := protoreflect.ValueOfString(x.Denom)
+		if !f(fd_OutflowUsage_denom, value) {
+			return
+		}
+	}
+	if x.Used != "" {
+		value := protoreflect.ValueOfString(x.Used)
+		if !f(fd_OutflowUsage_used, value) {
+			return
+		}
+	}
+	if x.Limit != "" {
+		value := protoreflect.ValueOfString(x.Limit)
+		if !f(fd_OutflowUsage_limit, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_OutflowUsage) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "side.btcbridge.OutflowUsage.denom":
+		return x.Denom != ""
+	case "side.btcbridge.OutflowUsage.used":
+		return x.Used != ""
+	case "side.btcbridge.OutflowUsage.limit":
+		return x.Limit != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.OutflowUsage"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.OutflowUsage does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_OutflowUsage) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "side.btcbridge.OutflowUsage.denom":
+		x.Denom = ""
+	case "side.btcbridge.OutflowUsage.used":
+		x.Used = ""
+	case "side.btcbridge.OutflowUsage.limit":
+		x.Limit = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.OutflowUsage"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.OutflowUsage does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_OutflowUsage) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "side.btcbridge.OutflowUsage.denom":
+		value := x.Denom
+		return protoreflect.ValueOfString(value)
+	case "side.btcbridge.OutflowUsage.used":
+		value := x.Used
+		return protoreflect.ValueOfString(value)
+	case "side.btcbridge.OutflowUsage.limit":
+		value := x.Limit
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.OutflowUsage"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.OutflowUsage does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_OutflowUsage) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "side.btcbridge.OutflowUsage.denom":
+		x.Denom = value.Interface().(string)
+	case "side.btcbridge.OutflowUsage.used":
+		x.Used = value.Interface().(string)
+	case "side.btcbridge.OutflowUsage.limit":
+		x.Limit = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.OutflowUsage"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.OutflowUsage does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_OutflowUsage) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "side.btcbridge.OutflowUsage.denom":
+		panic(fmt.Errorf("field denom of message side.btcbridge.OutflowUsage is not mutable"))
+	case "side.btcbridge.OutflowUsage.used":
+		panic(fmt.Errorf("field used of message side.btcbridge.OutflowUsage is not mutable"))
+	case "side.btcbridge.OutflowUsage.limit":
+		panic(fmt.Errorf("field limit of message side.btcbridge.OutflowUsage is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.OutflowUsage"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.OutflowUsage does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_OutflowUsage) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "side.btcbridge.OutflowUsage.denom":
+		return protoreflect.ValueOfString("")
+	case "side.btcbridge.OutflowUsage.used":
+		return protoreflect.ValueOfString("")
+	case "side.btcbridge.OutflowUsage.limit":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.OutflowUsage"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.OutflowUsage does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_OutflowUsage) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in side.btcbridge.OutflowUsage", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_OutflowUsage) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_OutflowUsage) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_OutflowUsage) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_OutflowUsage) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*OutflowUsage)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Denom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Used)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Limit)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*OutflowUsage)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Limit) > 0 {
+			i -= len(x.Limit)
+			copy(dAtA[i:], x.Limit)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Limit)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Used) > 0 {
+			i -= len(x.Used)
+			copy(dAtA[i:], x.Used)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Used)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Denom) > 0 {
+			i -= len(x.Denom)
+			copy(dAtA[i:], x.Denom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*OutflowUsage)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: OutflowUsage: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: OutflowUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Denom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Used", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Used = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Limit = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_EmergencyPause            protoreflect.MessageDescriptor
+	fd_EmergencyPause_id         protoreflect.FieldDescriptor
+	fd_EmergencyPause_guardian   protoreflect.FieldDescriptor
+	fd_EmergencyPause_scope      protoreflect.FieldDescriptor
+	fd_EmergencyPause_denom      protoreflect.FieldDescriptor
+	fd_EmergencyPause_start_time protoreflect.FieldDescriptor
+	fd_EmergencyPause_expiration protoreflect.FieldDescriptor
+	fd_EmergencyPause_lifted     protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_side_btcbridge_btcbridge_proto_init()
+	md_EmergencyPause = File_side_btcbridge_btcbridge_proto.Messages().ByName("EmergencyPause")
+	fd_EmergencyPause_id = md_EmergencyPause.Fields().ByName("id")
+	fd_EmergencyPause_guardian = md_EmergencyPause.Fields().ByName("guardian")
+	fd_EmergencyPause_scope = md_EmergencyPause.Fields().ByName("scope")
+	fd_EmergencyPause_denom = md_EmergencyPause.Fields().ByName("denom")
+	fd_EmergencyPause_start_time = md_EmergencyPause.Fields().ByName("start_time")
+	fd_EmergencyPause_expiration = md_EmergencyPause.Fields().ByName("expiration")
+	fd_EmergencyPause_lifted = md_EmergencyPause.Fields().ByName("lifted")
+}
+
+var _ protoreflect.Message = (*fastReflection_EmergencyPause)(nil)
+
+type fastReflection_EmergencyPause EmergencyPause
+
+func (x *EmergencyPause) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EmergencyPause)(x)
+}
+
+func (x *EmergencyPause) slowProtoReflect() protoreflect.Message {
+	mi := &file_side_btcbridge_btcbridge_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EmergencyPause_messageType fastReflection_EmergencyPause_messageType
+var _ protoreflect.MessageType = fastReflection_EmergencyPause_messageType{}
+
+type fastReflection_EmergencyPause_messageType struct{}
+
+func (x fastReflection_EmergencyPause_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EmergencyPause)(nil)
+}
+func (x fastReflection_EmergencyPause_messageType) New() protoreflect.Message {
+	return new(fastReflection_EmergencyPause)
+}
+func (x fastReflection_EmergencyPause_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EmergencyPause
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EmergencyPause) Descriptor() protoreflect.MessageDescriptor {
+	return md_EmergencyPause
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EmergencyPause) Type() protoreflect.MessageType {
+	return _fastReflection_EmergencyPause_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EmergencyPause) New() protoreflect.Message {
+	return new(fastReflection_EmergencyPause)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EmergencyPause) Interface() protoreflect.ProtoMessage {
+	return (*EmergencyPause)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EmergencyPause) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_EmergencyPause_id, value) {
+			return
+		}
+	}
+	if x.Guardian != "" {
+		value := protoreflect.ValueOfString(x.Guardian)
+		if !f(fd_EmergencyPause_guardian, value) {
+			return
+		}
+	}
+	if x.Scope != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Scope))
+		if !f(fd_EmergencyPause_scope, value) {
+			return
+		}
+	}
+	if x.Denom != "" {
+		value := protoreflect.ValueOfString(x.Denom)
+		if !f(fd_EmergencyPause_denom, value) {
+			return
+		}
+	}
+	if x.StartTime != nil {
+		value := protoreflect.ValueOfMessage(x.StartTime.ProtoReflect())
+		if !f(fd_EmergencyPause_start_time, value) {
+			return
+		}
+	}
+	if x.Expiration != nil {
+		value := protoreflect.ValueOfMessage(x.Expiration.ProtoReflect())
+		if !f(fd_EmergencyPause_expiration, value) {
+			return
+		}
+	}
+	if x.Lifted != false {
+		value := protoreflect.ValueOfBool(x.Lifted)
+		if !f(fd_EmergencyPause_lifted, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EmergencyPause) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "side.btcbridge.EmergencyPause.id":
+		return x.Id != uint64(0)
+	case "side.btcbridge.EmergencyPause.guardian":
+		return x.Guardian != ""
+	case "side.btcbridge.EmergencyPause.scope":
+		return x.Scope != 0
+	case "side.btcbridge.EmergencyPause.denom":
+		return x.Denom != ""
+	case "side.btcbridge.EmergencyPause.start_time":
+		return x.StartTime != nil
+	case "side.btcbridge.EmergencyPause.expiration":
+		return x.Expiration != nil
+	case "side.btcbridge.EmergencyPause.lifted":
+		return x.Lifted != false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.EmergencyPause"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.EmergencyPause does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EmergencyPause) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "side.btcbridge.EmergencyPause.id":
+		x.Id = uint64(0)
+	case "side.btcbridge.EmergencyPause.guardian":
+		x.Guardian = ""
+	case "side.btcbridge.EmergencyPause.scope":
+		x.Scope = 0
+	case "side.btcbridge.EmergencyPause.denom":
+		x.Denom = ""
+	case "side.btcbridge.EmergencyPause.start_time":
+		x.StartTime = nil
+	case "side.btcbridge.EmergencyPause.expiration":
+		x.Expiration = nil
+	case "side.btcbridge.EmergencyPause.lifted":
+		x.Lifted = false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.EmergencyPause"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.EmergencyPause does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EmergencyPause) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "side.btcbridge.EmergencyPause.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "side.btcbridge.EmergencyPause.guardian":
+		value := x.Guardian
+		return protoreflect.ValueOfString(value)
+	case "side.btcbridge.EmergencyPause.scope":
+		value := x.Scope
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "side.btcbridge.EmergencyPause.denom":
+		value := x.Denom
+		return protoreflect.ValueOfString(value)
+	case "side.btcbridge.EmergencyPause.start_time":
+		value := x.StartTime
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "side.btcbridge.EmergencyPause.expiration":
+		value := x.Expiration
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "side.btcbridge.EmergencyPause.lifted":
+		value := x.Lifted
+		return protoreflect.ValueOfBool(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.EmergencyPause"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.EmergencyPause does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EmergencyPause) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "side.btcbridge.EmergencyPause.id":
+		x.Id = value.Uint()
+	case "side.btcbridge.EmergencyPause.guardian":
+		x.Guardian = value.Interface().(string)
+	case "side.btcbridge.EmergencyPause.scope":
+		x.Scope = (PauseScope)(value.Enum())
+	case "side.btcbridge.EmergencyPause.denom":
+		x.Denom = value.Interface().(string)
+	case "side.btcbridge.EmergencyPause.start_time":
+		x.StartTime = value.Message().Interface().(*timestamppb.Timestamp)
+	case "side.btcbridge.EmergencyPause.expiration":
+		x.Expiration = value.Message().Interface().(*timestamppb.Timestamp)
+	case "side.btcbridge.EmergencyPause.lifted":
+		x.Lifted = value.Bool()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.EmergencyPause"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.EmergencyPause does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EmergencyPause) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "side.btcbridge.EmergencyPause.start_time":
+		if x.StartTime == nil {
+			x.StartTime = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.StartTime.ProtoReflect())
+	case "side.btcbridge.EmergencyPause.expiration":
+		if x.Expiration == nil {
+			x.Expiration = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.Expiration.ProtoReflect())
+	case "side.btcbridge.EmergencyPause.id":
+		panic(fmt.Errorf("field id of message side.btcbridge.EmergencyPause is not mutable"))
+	case "side.btcbridge.EmergencyPause.guardian":
+		panic(fmt.Errorf("field guardian of message side.btcbridge.EmergencyPause is not mutable"))
+	case "side.btcbridge.EmergencyPause.scope":
+		panic(fmt.Errorf("field scope of message side.btcbridge.EmergencyPause is not mutable"))
+	case "side.btcbridge.EmergencyPause.denom":
+		panic(fmt.Errorf("field denom of message side.btcbridge.EmergencyPause is not mutable"))
+	case "side.btcbridge.EmergencyPause.lifted":
+		panic(fmt.Errorf("field lifted of message side.btcbridge.EmergencyPause is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.EmergencyPause"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.EmergencyPause does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EmergencyPause) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "side.btcbridge.EmergencyPause.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "side.btcbridge.EmergencyPause.guardian":
+		return protoreflect.ValueOfString("")
+	case "side.btcbridge.EmergencyPause.scope":
+		return protoreflect.ValueOfEnum(0)
+	case "side.btcbridge.EmergencyPause.denom":
+		return protoreflect.ValueOfString("")
+	case "side.btcbridge.EmergencyPause.start_time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "side.btcbridge.EmergencyPause.expiration":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "side.btcbridge.EmergencyPause.lifted":
+		return protoreflect.ValueOfBool(false)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.EmergencyPause"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.EmergencyPause does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EmergencyPause) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in side.btcbridge.EmergencyPause", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EmergencyPause) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EmergencyPause) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EmergencyPause) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EmergencyPause) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EmergencyPause)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		l = len(x.Guardian)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Scope != 0 {
+			n += 1 + runtime.Sov(uint64(x.Scope))
+		}
+		l = len(x.Denom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.StartTime != nil {
+			l = options.Size(x.StartTime)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Expiration != nil {
+			l = options.Size(x.Expiration)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Lifted {
+			n += 2
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EmergencyPause)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Lifted {
+			i--
+			if x.Lifted {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.Expiration != nil {
+			encoded, err := options.Marshal(x.Expiration)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.StartTime != nil {
+			encoded, err := options.Marshal(x.StartTime)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.Denom) > 0 {
+			i -= len(x.Denom)
+			copy(dAtA[i:], x.Denom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.Scope != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Scope))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Guardian) > 0 {
+			i -= len(x.Guardian)
+			copy(dAtA[i:], x.Guardian)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Guardian)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EmergencyPause)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EmergencyPause: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EmergencyPause: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Guardian", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Guardian = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
+				}
+				x.Scope = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Scope |= PauseScope(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Denom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.StartTime == nil {
+					x.StartTime = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.StartTime); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Expiration == nil {
+					x.Expiration = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Expiration); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Lifted", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Lifted = bool(v != 0)
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: side/btcbridge/btcbridge.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
-// Bitcoin Signing Status
-type SigningStatus int32
+// Bitcoin Signing Status
+type SigningStatus int32
+
+const (
+	// SIGNING_STATUS_UNSPECIFIED - Default value, should not be used
+	SigningStatus_SIGNING_STATUS_UNSPECIFIED SigningStatus = 0
+	// SIGNING_STATUS_PENDING - The signing request is pending
+	SigningStatus_SIGNING_STATUS_PENDING SigningStatus = 1
+	// SIGNING_STATUS_BROADCASTED - The signing request is broadcasted
+	SigningStatus_SIGNING_STATUS_BROADCASTED SigningStatus = 2
+	// SIGNING_STATUS_CONFIRMED - The signing request is confirmed
+	SigningStatus_SIGNING_STATUS_CONFIRMED SigningStatus = 3
+	// SIGNING_STATUS_FAILED - The signing request failed to be signed or broadcast due to unexpected exceptions
+	SigningStatus_SIGNING_STATUS_FAILED SigningStatus = 4
+	// SIGNING_STATUS_REPLACED - The signing request is replaced by another signing request
+	SigningStatus_SIGNING_STATUS_REPLACED SigningStatus = 5
+)
+
+// Enum value maps for SigningStatus.
+var (
+	SigningStatus_name = map[int32]string{
+		0: "SIGNING_STATUS_UNSPECIFIED",
+		1: "SIGNING_STATUS_PENDING",
+		2: "SIGNING_STATUS_BROADCASTED",
+		3: "SIGNING_STATUS_CONFIRMED",
+		4: "SIGNING_STATUS_FAILED",
+		5: "SIGNING_STATUS_REPLACED",
+	}
+	SigningStatus_value = map[string]int32{
+		"SIGNING_STATUS_UNSPECIFIED": 0,
+		"SIGNING_STATUS_PENDING":     1,
+		"SIGNING_STATUS_BROADCASTED": 2,
+		"SIGNING_STATUS_CONFIRMED":   3,
+		"SIGNING_STATUS_FAILED":      4,
+		"SIGNING_STATUS_REPLACED":    5,
+	}
+)
+
+func (x SigningStatus) Enum() *SigningStatus {
+	p := new(SigningStatus)
+	*p = x
+	return p
+}
+
+func (x SigningStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SigningStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_side_btcbridge_btcbridge_proto_enumTypes[0].Descriptor()
+}
+
+func (SigningStatus) Type() protoreflect.EnumType {
+	return &file_side_btcbridge_btcbridge_proto_enumTypes[0]
+}
+
+func (x SigningStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SigningStatus.Descriptor instead.
+func (SigningStatus) EnumDescriptor() ([]byte, []int) {
+	return file_side_btcbridge_btcbridge_proto_rawDescGZIP(), []int{0}
+}
+
+// SigningTransition enumerates the transitions of the signing request
+type SigningTransition int32
+
+const (
+	// SIGNING_TRANSITION_UNSPECIFIED - Default value, should not be used
+	SigningTransition_SIGNING_TRANSITION_UNSPECIFIED SigningTransition = 0
+	// SIGNING_TRANSITION_CREATED - The signing request is created
+	SigningTransition_SIGNING_TRANSITION_CREATED SigningTransition = 1
+	// SIGNING_TRANSITION_SIGNATURES_SUBMITTED - The signatures are submitted by the signer
+	SigningTransition_SIGNING_TRANSITION_SIGNATURES_SUBMITTED SigningTransition = 2
+	// SIGNING_TRANSITION_BROADCASTED - The signing request is broadcasted
+	SigningTransition_SIGNING_TRANSITION_BROADCASTED SigningTransition = 3
+	// SIGNING_TRANSITION_CONFIRMED - The signing request is confirmed
+	SigningTransition_SIGNING_TRANSITION_CONFIRMED SigningTransition = 4
+	// SIGNING_TRANSITION_FAILED - The signing request failed
+	SigningTransition_SIGNING_TRANSITION_FAILED SigningTransition = 5
+	// SIGNING_TRANSITION_REPLACED - The signing request is replaced
+	SigningTransition_SIGNING_TRANSITION_REPLACED SigningTransition = 6
+)
+
+// Enum value maps for SigningTransition.
+var (
+	SigningTransition_name = map[int32]string{
+		0: "SIGNING_TRANSITION_UNSPECIFIED",
+		1: "SIGNING_TRANSITION_CREATED",
+		2: "SIGNING_TRANSITION_SIGNATURES_SUBMITTED",
+		3: "SIGNING_TRANSITION_BROADCASTED",
+		4: "SIGNING_TRANSITION_CONFIRMED",
+		5: "SIGNING_TRANSITION_FAILED",
+		6: "SIGNING_TRANSITION_REPLACED",
+	}
+	SigningTransition_value = map[string]int32{
+		"SIGNING_TRANSITION_UNSPECIFIED":          0,
+		"SIGNING_TRANSITION_CREATED":              1,
+		"SIGNING_TRANSITION_SIGNATURES_SUBMITTED": 2,
+		"SIGNING_TRANSITION_BROADCASTED":          3,
+		"SIGNING_TRANSITION_CONFIRMED":            4,
+		"SIGNING_TRANSITION_FAILED":               5,
+		"SIGNING_TRANSITION_REPLACED":             6,
+	}
+)
+
+func (x SigningTransition) Enum() *SigningTransition {
+	p := new(SigningTransition)
+	*p = x
+	return p
+}
+
+func (x SigningTransition) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SigningTransition) Descriptor() protoreflect.EnumDescriptor {
+	return file_side_btcbridge_btcbridge_proto_enumTypes[1].Descriptor()
+}
+
+func (SigningTransition) Type() protoreflect.EnumType {
+	return &file_side_btcbridge_btcbridge_proto_enumTypes[1]
+}
+
+func (x SigningTransition) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SigningTransition.Descriptor instead.
+func (SigningTransition) EnumDescriptor() ([]byte, []int) {
+	return file_side_btcbridge_btcbridge_proto_rawDescGZIP(), []int{1}
+}
+
+type DKGRequestStatus int32
+
+const (
+	// DKG_REQUEST_STATUS_UNSPECIFIED defines the unknown DKG request status
+	DKGRequestStatus_DKG_REQUEST_STATUS_UNSPECIFIED DKGRequestStatus = 0
+	// DKG_REQUEST_STATUS_PENDING defines the status of the DKG request which is pending
+	DKGRequestStatus_DKG_REQUEST_STATUS_PENDING DKGRequestStatus = 1
+	// DKG_REQUEST_STATUS_COMPLETED defines the status of the DKG request which is completed
+	DKGRequestStatus_DKG_REQUEST_STATUS_COMPLETED DKGRequestStatus = 2
+	// DKG_REQUEST_STATUS_FAILED defines the status of the DKG request which failed
+	DKGRequestStatus_DKG_REQUEST_STATUS_FAILED DKGRequestStatus = 3
+	// DKG_REQUEST_STATUS_TIMEDOUT defines the status of the DKG request which timed out
+	DKGRequestStatus_DKG_REQUEST_STATUS_TIMEDOUT DKGRequestStatus = 4
+)
+
+// Enum value maps for DKGRequestStatus.
+var (
+	DKGRequestStatus_name = map[int32]string{
+		0: "DKG_REQUEST_STATUS_UNSPECIFIED",
+		1: "DKG_REQUEST_STATUS_PENDING",
+		2: "DKG_REQUEST_STATUS_COMPLETED",
+		3: "DKG_REQUEST_STATUS_FAILED",
+		4: "DKG_REQUEST_STATUS_TIMEDOUT",
+	}
+	DKGRequestStatus_value = map[string]int32{
+		"DKG_REQUEST_STATUS_UNSPECIFIED": 0,
+		"DKG_REQUEST_STATUS_PENDING":     1,
+		"DKG_REQUEST_STATUS_COMPLETED":   2,
+		"DKG_REQUEST_STATUS_FAILED":      3,
+		"DKG_REQUEST_STATUS_TIMEDOUT":    4,
+	}
+)
+
+func (x DKGRequestStatus) Enum() *DKGRequestStatus {
+	p := new(DKGRequestStatus)
+	*p = x
+	return p
+}
+
+func (x DKGRequestStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DKGRequestStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_side_btcbridge_btcbridge_proto_enumTypes[2].Descriptor()
+}
+
+func (DKGRequestStatus) Type() protoreflect.EnumType {
+	return &file_side_btcbridge_btcbridge_proto_enumTypes[2]
+}
+
+func (x DKGRequestStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DKGRequestStatus.Descriptor instead.
+func (DKGRequestStatus) EnumDescriptor() ([]byte, []int) {
+	return file_side_btcbridge_btcbridge_proto_rawDescGZIP(), []int{2}
+}
+
+// FrostSigningStatus defines the status of the FROST signing session
+type FrostSigningStatus int32
+
+const (
+	// FROST_SIGNING_STATUS_UNSPECIFIED defines the unknown status
+	FrostSigningStatus_FROST_SIGNING_STATUS_UNSPECIFIED FrostSigningStatus = 0
+	// FROST_SIGNING_STATUS_COMMITMENT defines the status in which the nonce commitments are collected
+	FrostSigningStatus_FROST_SIGNING_STATUS_COMMITMENT FrostSigningStatus = 1
+	// FROST_SIGNING_STATUS_SIGNING defines the status in which the partial signatures are collected
+	FrostSigningStatus_FROST_SIGNING_STATUS_SIGNING FrostSigningStatus = 2
+	// FROST_SIGNING_STATUS_COMPLETED defines the status in which the signatures are aggregated
+	FrostSigningStatus_FROST_SIGNING_STATUS_COMPLETED FrostSigningStatus = 3
+	// FROST_SIGNING_STATUS_FAILED defines the status in which the threshold can no longer be reached due to the misbehaving signers
+	FrostSigningStatus_FROST_SIGNING_STATUS_FAILED FrostSigningStatus = 4
+)
+
+// Enum value maps for FrostSigningStatus.
+var (
+	FrostSigningStatus_name = map[int32]string{
+		0: "FROST_SIGNING_STATUS_UNSPECIFIED",
+		1: "FROST_SIGNING_STATUS_COMMITMENT",
+		2: "FROST_SIGNING_STATUS_SIGNING",
+		3: "FROST_SIGNING_STATUS_COMPLETED",
+		4: "FROST_SIGNING_STATUS_FAILED",
+	}
+	FrostSigningStatus_value = map[string]int32{
+		"FROST_SIGNING_STATUS_UNSPECIFIED": 0,
+		"FROST_SIGNING_STATUS_COMMITMENT":  1,
+		"FROST_SIGNING_STATUS_SIGNING":     2,
+		"FROST_SIGNING_STATUS_COMPLETED":   3,
+		"FROST_SIGNING_STATUS_FAILED":      4,
+	}
+)
+
+func (x FrostSigningStatus) Enum() *FrostSigningStatus {
+	p := new(FrostSigningStatus)
+	*p = x
+	return p
+}
+
+func (x FrostSigningStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FrostSigningStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_side_btcbridge_btcbridge_proto_enumTypes[3].Descriptor()
+}
+
+func (FrostSigningStatus) Type() protoreflect.EnumType {
+	return &file_side_btcbridge_btcbridge_proto_enumTypes[3]
+}
+
+func (x FrostSigningStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FrostSigningStatus.Descriptor instead.
+func (FrostSigningStatus) EnumDescriptor() ([]byte, []int) {
+	return file_side_btcbridge_btcbridge_proto_rawDescGZIP(), []int{3}
+}
+
+// VaultTransferStatus defines the status of the vault asset transfer
+type VaultTransferStatus int32
+
+const (
+	// VAULT_TRANSFER_STATUS_UNSPECIFIED defines the unknown status
+	VaultTransferStatus_VAULT_TRANSFER_STATUS_UNSPECIFIED VaultTransferStatus = 0
+	// VAULT_TRANSFER_STATUS_PENDING defines the status of the transfer not started yet
+	VaultTransferStatus_VAULT_TRANSFER_STATUS_PENDING VaultTransferStatus = 1
+	// VAULT_TRANSFER_STATUS_IN_PROGRESS defines the status of the transfer with the assets remaining in the source vault
+	VaultTransferStatus_VAULT_TRANSFER_STATUS_IN_PROGRESS VaultTransferStatus = 2
+	// VAULT_TRANSFER_STATUS_COMPLETED defines the status of the transfer with no assets remaining in the source vault
+	VaultTransferStatus_VAULT_TRANSFER_STATUS_COMPLETED VaultTransferStatus = 3
+)
+
+// Enum value maps for VaultTransferStatus.
+var (
+	VaultTransferStatus_name = map[int32]string{
+		0: "VAULT_TRANSFER_STATUS_UNSPECIFIED",
+		1: "VAULT_TRANSFER_STATUS_PENDING",
+		2: "VAULT_TRANSFER_STATUS_IN_PROGRESS",
+		3: "VAULT_TRANSFER_STATUS_COMPLETED",
+	}
+	VaultTransferStatus_value = map[string]int32{
+		"VAULT_TRANSFER_STATUS_UNSPECIFIED": 0,
+		"VAULT_TRANSFER_STATUS_PENDING":     1,
+		"VAULT_TRANSFER_STATUS_IN_PROGRESS": 2,
+		"VAULT_TRANSFER_STATUS_COMPLETED":   3,
+	}
+)
+
+func (x VaultTransferStatus) Enum() *VaultTransferStatus {
+	p := new(VaultTransferStatus)
+	*p = x
+	return p
+}
+
+func (x VaultTransferStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (VaultTransferStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_side_btcbridge_btcbridge_proto_enumTypes[4].Descriptor()
+}
+
+func (VaultTransferStatus) Type() protoreflect.EnumType {
+	return &file_side_btcbridge_btcbridge_proto_enumTypes[4]
+}
+
+func (x VaultTransferStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use VaultTransferStatus.Descriptor instead.
+func (VaultTransferStatus) EnumDescriptor() ([]byte, []int) {
+	return file_side_btcbridge_btcbridge_proto_rawDescGZIP(), []int{4}
+}
+
+// FeeBucket defines the destination of the protocol fee distribution
+type FeeBucket int32
+
+const (
+	// FEE_BUCKET_UNSPECIFIED defines the unknown bucket
+	FeeBucket_FEE_BUCKET_UNSPECIFIED FeeBucket = 0
+	// FEE_BUCKET_COMMUNITY_POOL defines the community pool
+	FeeBucket_FEE_BUCKET_COMMUNITY_POOL FeeBucket = 1
+	// FEE_BUCKET_RELAYER_POOL defines the relayer reward pool paid out to the trusted relayers at the settlement
+	FeeBucket_FEE_BUCKET_RELAYER_POOL FeeBucket = 2
+	// FEE_BUCKET_SIGNER_POOL defines the signer pool paid out to the current TSS participants at the settlement
+	FeeBucket_FEE_BUCKET_SIGNER_POOL FeeBucket = 3
+	// FEE_BUCKET_INSURANCE defines the insurance reserve
+	FeeBucket_FEE_BUCKET_INSURANCE FeeBucket = 4
+	// FEE_BUCKET_COLLECTOR defines the protocol fee collector
+	FeeBucket_FEE_BUCKET_COLLECTOR FeeBucket = 5
+)
+
+// Enum value maps for FeeBucket.
+var (
+	FeeBucket_name = map[int32]string{
+		0: "FEE_BUCKET_UNSPECIFIED",
+		1: "FEE_BUCKET_COMMUNITY_POOL",
+		2: "FEE_BUCKET_RELAYER_POOL",
+		3: "FEE_BUCKET_SIGNER_POOL",
+		4: "FEE_BUCKET_INSURANCE",
+		5: "FEE_BUCKET_COLLECTOR",
+	}
+	FeeBucket_value = map[string]int32{
+		"FEE_BUCKET_UNSPECIFIED":    0,
+		"FEE_BUCKET_COMMUNITY_POOL": 1,
+		"FEE_BUCKET_RELAYER_POOL":   2,
+		"FEE_BUCKET_SIGNER_POOL":    3,
+		"FEE_BUCKET_INSURANCE":      4,
+		"FEE_BUCKET_COLLECTOR":      5,
+	}
+)
+
+func (x FeeBucket) Enum() *FeeBucket {
+	p := new(FeeBucket)
+	*p = x
+	return p
+}
+
+func (x FeeBucket) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FeeBucket) Descriptor() protoreflect.EnumDescriptor {
+	return file_side_btcbridge_btcbridge_proto_enumTypes[5].Descriptor()
+}
+
+func (FeeBucket) Type() protoreflect.EnumType {
+	return &file_side_btcbridge_btcbridge_proto_enumTypes[5]
+}
+
+func (x FeeBucket) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FeeBucket.Descriptor instead.
+func (FeeBucket) EnumDescriptor() ([]byte, []int) {
+	return file_side_btcbridge_btcbridge_proto_rawDescGZIP(), []int{5}
+}
+
+// PauseScope defines the scope of the emergency pause
+type PauseScope int32
+
+const (
+	// PAUSE_SCOPE_UNSPECIFIED defines the unknown scope
+	PauseScope_PAUSE_SCOPE_UNSPECIFIED PauseScope = 0
+	// PAUSE_SCOPE_DEPOSIT pauses all deposits
+	PauseScope_PAUSE_SCOPE_DEPOSIT PauseScope = 1
+	// PAUSE_SCOPE_WITHDRAW pauses all withdrawals
+	PauseScope_PAUSE_SCOPE_WITHDRAW PauseScope = 2
+	// PAUSE_SCOPE_SIGNING pauses the signing request creation
+	PauseScope_PAUSE_SCOPE_SIGNING PauseScope = 3
+	// PAUSE_SCOPE_ASSET pauses the deposits and withdrawals of the given asset
+	PauseScope_PAUSE_SCOPE_ASSET PauseScope = 4
+)
+
+// Enum value maps for PauseScope.
+var (
+	PauseScope_name = map[int32]string{
+		0: "PAUSE_SCOPE_UNSPECIFIED",
+		1: "PAUSE_SCOPE_DEPOSIT",
+		2: "PAUSE_SCOPE_WITHDRAW",
+		3: "PAUSE_SCOPE_SIGNING",
+		4: "PAUSE_SCOPE_ASSET",
+	}
+	PauseScope_value = map[string]int32{
+		"PAUSE_SCOPE_UNSPECIFIED": 0,
+		"PAUSE_SCOPE_DEPOSIT":     1,
+		"PAUSE_SCOPE_WITHDRAW":    2,
+		"PAUSE_SCOPE_SIGNING":     3,
+		"PAUSE_SCOPE_ASSET":       4,
+	}
+)
+
+func (x PauseScope) Enum() *PauseScope {
+	p := new(PauseScope)
+	*p = x
+	return p
+}
+
+func (x PauseScope) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PauseScope) Descriptor() protoreflect.EnumDescriptor {
+	return file_side_btcbridge_btcbridge_proto_enumTypes[6].Descriptor()
+}
+
+func (PauseScope) Type() protoreflect.EnumType {
+	return &file_side_btcbridge_btcbridge_proto_enumTypes[6]
+}
+
+func (x PauseScope) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PauseScope.Descriptor instead.
+func (PauseScope) EnumDescriptor() ([]byte, []int) {
+	return file_side_btcbridge_btcbridge_proto_rawDescGZIP(), []int{6}
+}
+
+// Bitcoin Block Header
+type BlockHeader struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Version           uint64 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	Hash              string `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	Height            uint64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	PreviousBlockHash string `protobuf:"bytes,4,opt,name=previous_block_hash,json=previousBlockHash,proto3" json:"previous_block_hash,omitempty"`
+	MerkleRoot        string `protobuf:"bytes,5,opt,name=merkle_root,json=merkleRoot,proto3" json:"merkle_root,omitempty"`
+	Nonce             uint64 `protobuf:"varint,6,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	Bits              string `protobuf:"bytes,7,opt,name=bits,proto3" json:"bits,omitempty"`
+	Time              uint64 `protobuf:"varint,8,opt,name=time,proto3" json:"time,omitempty"`
+	Ntx               uint64 `protobuf:"varint,9,opt,name=ntx,proto3" json:"ntx,omitempty"`
+}
+
+func (x *BlockHeader) Reset() {
+	*x = BlockHeader{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_side_btcbridge_btcbridge_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlockHeader) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockHeader) ProtoMessage() {}
+
+// Deprecated: Use BlockHeader.ProtoReflect.Descriptor instead.
+func (*BlockHeader) Descriptor() ([]byte, []int) {
+	return file_side_btcbridge_btcbridge_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *BlockHeader) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *BlockHeader) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *BlockHeader) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *BlockHeader) GetPreviousBlockHash() string {
+	if x != nil {
+		return x.PreviousBlockHash
+	}
+	return ""
+}
+
+func (x *BlockHeader) GetMerkleRoot() string {
+	if x != nil {
+		return x.MerkleRoot
+	}
+	return ""
+}
+
+func (x *BlockHeader) GetNonce() uint64 {
+	if x != nil {
+		return x.Nonce
+	}
+	return 0
+}
+
+func (x *BlockHeader) GetBits() string {
+	if x != nil {
+		return x.Bits
+	}
+	return ""
+}
+
+func (x *BlockHeader) GetTime() uint64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+func (x *BlockHeader) GetNtx() uint64 {
+	if x != nil {
+		return x.Ntx
+	}
+	return 0
+}
+
+// Fee rate
+type FeeRate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// fee rate
+	Value int64 `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
+	// block height at which the fee rate is submitted
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (x *FeeRate) Reset() {
+	*x = FeeRate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_side_btcbridge_btcbridge_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FeeRate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeeRate) ProtoMessage() {}
+
+// Deprecated: Use FeeRate.ProtoReflect.Descriptor instead.
+func (*FeeRate) Descriptor() ([]byte, []int) {
+	return file_side_btcbridge_btcbridge_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *FeeRate) GetValue() int64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *FeeRate) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+// Bitcoin Signing Request
+type SigningRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address      string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Sequence     uint64                 `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Type_        AssetType              `protobuf:"varint,3,opt,name=type,proto3,enum=side.btcbridge.AssetType" json:"type,omitempty"`
+	Txid         string                 `protobuf:"bytes,4,opt,name=txid,proto3" json:"txid,omitempty"`
+	Psbt         string                 `protobuf:"bytes,5,opt,name=psbt,proto3" json:"psbt,omitempty"`
+	CreationTime *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=creation_time,json=creationTime,proto3" json:"creation_time,omitempty"`
+	Status       SigningStatus          `protobuf:"varint,7,opt,name=status,proto3,enum=side.btcbridge.SigningStatus" json:"status,omitempty"`
+}
+
+func (x *SigningRequest) Reset() {
+	*x = SigningRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_side_btcbridge_btcbridge_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SigningRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SigningRequest) ProtoMessage() {}
+
+// Deprecated: Use SigningRequest.ProtoReflect.Descriptor instead.
+func (*SigningRequest) Descriptor() ([]byte, []int) {
+	return file_side_btcbridge_btcbridge_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SigningRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *SigningRequest) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *SigningRequest) GetType_() AssetType {
+	if x != nil {
+		return x.Type_
+	}
+	return AssetType_ASSET_TYPE_UNSPECIFIED
+}
+
+func (x *SigningRequest) GetTxid() string {
+	if x != nil {
+		return x.Txid
+	}
+	return ""
+}
+
+func (x *SigningRequest) GetPsbt() string {
+	if x != nil {
+		return x.Psbt
+	}
+	return ""
+}
+
+func (x *SigningRequest) GetCreationTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreationTime
+	}
+	return nil
+}
+
+func (x *SigningRequest) GetStatus() SigningStatus {
+	if x != nil {
+		return x.Status
+	}
+	return SigningStatus_SIGNING_STATUS_UNSPECIFIED
+}
+
+// Signing Request History Entry
+type SigningRequestHistoryEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Transition SigningTransition `protobuf:"varint,1,opt,name=transition,proto3,enum=side.btcbridge.SigningTransition" json:"transition,omitempty"`
+	// the account which triggers the transition if any, e.g. the signer submitting signatures
+	Actor  string                 `protobuf:"bytes,2,opt,name=actor,proto3" json:"actor,omitempty"`
+	Height int64                  `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	Time   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=time,proto3" json:"time,omitempty"`
+}
+
+func (x *SigningRequestHistoryEntry) Reset() {
+	*x = SigningRequestHistoryEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_side_btcbridge_btcbridge_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SigningRequestHistoryEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SigningRequestHistoryEntry) ProtoMessage() {}
+
+// Deprecated: Use SigningRequestHistoryEntry.ProtoReflect.Descriptor instead.
+func (*SigningRequestHistoryEntry) Descriptor() ([]byte, []int) {
+	return file_side_btcbridge_btcbridge_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SigningRequestHistoryEntry) GetTransition() SigningTransition {
+	if x != nil {
+		return x.Transition
+	}
+	return SigningTransition_SIGNING_TRANSITION_UNSPECIFIED
+}
+
+func (x *SigningRequestHistoryEntry) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *SigningRequestHistoryEntry) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *SigningRequestHistoryEntry) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+// Signing Request History
+type SigningRequestHistory struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// the sequence of the signing request
+	Sequence uint64                        `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Entries  []*SigningRequestHistoryEntry `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries,omitempty"`
+}
+
+func (x *SigningRequestHistory) Reset() {
+	*x = SigningRequestHistory{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_side_btcbridge_btcbridge_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SigningRequestHistory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SigningRequestHistory) ProtoMessage() {}
+
+// Deprecated: Use SigningRequestHistory.ProtoReflect.Descriptor instead.
+func (*SigningRequestHistory) Descriptor() ([]byte, []int) {
+	return file_side_btcbridge_btcbridge_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SigningRequestHistory) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *SigningRequestHistory) GetEntries() []*SigningRequestHistoryEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+// Withdrawal Request
+type WithdrawRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address  string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Amount   string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Sequence uint64 `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Txid     string `protobuf:"bytes,4,opt,name=txid,proto3" json:"txid,omitempty"`
+}
+
+func (x *WithdrawRequest) Reset() {
+	*x = WithdrawRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_side_btcbridge_btcbridge_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WithdrawRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WithdrawRequest) ProtoMessage() {}
+
+// Deprecated: Use WithdrawRequest.ProtoReflect.Descriptor instead.
+func (*WithdrawRequest) Descriptor() ([]byte, []int) {
+	return file_side_btcbridge_btcbridge_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *WithdrawRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *WithdrawRequest) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *WithdrawRequest) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *WithdrawRequest) GetTxid() string {
+	if x != nil {
+		return x.Txid
+	}
+	return ""
+}
+
+// Bitcoin UTXO
+type UTXO struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Txid         string `protobuf:"bytes,1,opt,name=txid,proto3" json:"txid,omitempty"`
+	Vout         uint64 `protobuf:"varint,2,opt,name=vout,proto3" json:"vout,omitempty"`
+	Address      string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	Amount       uint64 `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Height       uint64 `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+	PubKeyScript []byte `protobuf:"bytes,6,opt,name=pub_key_script,json=pubKeyScript,proto3" json:"pub_key_script,omitempty"`
+	IsLocked     bool   `protobuf:"varint,7,opt,name=is_locked,json=isLocked,proto3" json:"is_locked,omitempty"`
+	// rune balances associated with the UTXO
+	Runes []*RuneBalance `protobuf:"bytes,8,rep,name=runes,proto3" json:"runes,omitempty"`
+	// witness script of the script hash output, e.g. P2WSH multisig
+	WitnessScript []byte `protobuf:"bytes,9,opt,name=witness_script,json=witnessScript,proto3" json:"witness_script,omitempty"`
+}
+
+func (x *UTXO) Reset() {
+	*x = UTXO{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_side_btcbridge_btcbridge_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UTXO) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UTXO) ProtoMessage() {}
+
+// Deprecated: Use UTXO.ProtoReflect.Descriptor instead.
+func (*UTXO) Descriptor() ([]byte, []int) {
+	return file_side_btcbridge_btcbridge_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UTXO) GetTxid() string {
+	if x != nil {
+		return x.Txid
+	}
+	return ""
+}
+
+func (x *UTXO) GetVout() uint64 {
+	if x != nil {
+		return x.Vout
+	}
+	return 0
+}
+
+func (x *UTXO) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *UTXO) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *UTXO) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *UTXO) GetPubKeyScript() []byte {
+	if x != nil {
+		return x.PubKeyScript
+	}
+	return nil
+}
+
+func (x *UTXO) GetIsLocked() bool {
+	if x != nil {
+		return x.IsLocked
+	}
+	return false
+}
+
+func (x *UTXO) GetRunes() []*RuneBalance {
+	if x != nil {
+		return x.Runes
+	}
+	return nil
+}
+
+func (x *UTXO) GetWitnessScript() []byte {
+	if x != nil {
+		return x.WitnessScript
+	}
+	return nil
+}
+
+// Rune Balance
+type RuneBalance struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// serialized rune id
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// rune amount
+	Amount string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *RuneBalance) Reset() {
+	*x = RuneBalance{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_side_btcbridge_btcbridge_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RuneBalance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RuneBalance) ProtoMessage() {}
+
+// Deprecated: Use RuneBalance.ProtoReflect.Descriptor instead.
+func (*RuneBalance) Descriptor() ([]byte, []int) {
+	return file_side_btcbridge_btcbridge_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *RuneBalance) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RuneBalance) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+// Rune ID
+type RuneId struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// block height
+	Block uint64 `protobuf:"varint,1,opt,name=block,proto3" json:"block,omitempty"`
+	// tx index
+	Tx uint32 `protobuf:"varint,2,opt,name=tx,proto3" json:"tx,omitempty"`
+}
+
+func (x *RuneId) Reset() {
+	*x = RuneId{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_side_btcbridge_btcbridge_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RuneId) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RuneId) ProtoMessage() {}
+
+// Deprecated: Use RuneId.ProtoReflect.Descriptor instead.
+func (*RuneId) Descriptor() ([]byte, []int) {
+	return file_side_btcbridge_btcbridge_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *RuneId) GetBlock() uint64 {
+	if x != nil {
+		return x.Block
+	}
+	return 0
+}
+
+func (x *RuneId) GetTx() uint32 {
+	if x != nil {
+		return x.Tx
+	}
+	return 0
+}
+
+// Rune Edict
+type Edict struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     *RuneId `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Amount string  `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Output uint32  `protobuf:"varint,3,opt,name=output,proto3" json:"output,omitempty"`
+}
+
+func (x *Edict) Reset() {
+	*x = Edict{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_side_btcbridge_btcbridge_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Edict) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Edict) ProtoMessage() {}
+
+// Deprecated: Use Edict.ProtoReflect.Descriptor instead.
+func (*Edict) Descriptor() ([]byte, []int) {
+	return file_side_btcbridge_btcbridge_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *Edict) GetId() *RuneId {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *Edict) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *Edict) GetOutput() uint32 {
+	if x != nil {
+		return x.Output
+	}
+	return 0
+}
+
+// Rune Metadata
+type RuneMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// rune id
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// spaced rune name, e.g. UNCOMMON•GOODS
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// currency symbol
+	Symbol string `protobuf:"bytes,3,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	// divisibility
+	Divisibility uint32 `protobuf:"varint,4,opt,name=divisibility,proto3" json:"divisibility,omitempty"`
+	// hash of the etching tx
+	EtchingTx string `protobuf:"bytes,5,opt,name=etching_tx,json=etchingTx,proto3" json:"etching_tx,omitempty"`
+}
+
+func (x *RuneMetadata) Reset() {
+	*x = RuneMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_side_btcbridge_btcbridge_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RuneMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RuneMetadata) ProtoMessage() {}
+
+// Deprecated: Use RuneMetadata.ProtoReflect.Descriptor instead.
+func (*RuneMetadata) Descriptor() ([]byte, []int) {
+	return file_side_btcbridge_btcbridge_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *RuneMetadata) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RuneMetadata) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RuneMetadata) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *RuneMetadata) GetDivisibility() uint32 {
+	if x != nil {
+		return x.Divisibility
+	}
+	return 0
+}
+
+func (x *RuneMetadata) GetEtchingTx() string {
+	if x != nil {
+		return x.EtchingTx
+	}
+	return ""
+}
+
+// BRC20 Metadata
+type Brc20Metadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// brc20 ticker
+	Tick string `protobuf:"bytes,1,opt,name=tick,proto3" json:"tick,omitempty"`
+	// decimals of the ticker by the deploy inscription
+	Decimals uint32 `protobuf:"varint,2,opt,name=decimals,proto3" json:"decimals,omitempty"`
+}
+
+func (x *Brc20Metadata) Reset() {
+	*x = Brc20Metadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_side_btcbridge_btcbridge_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Brc20Metadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Brc20Metadata) ProtoMessage() {}
+
+// Deprecated: Use Brc20Metadata.ProtoReflect.Descriptor instead.
+func (*Brc20Metadata) Descriptor() ([]byte, []int) {
+	return file_side_btcbridge_btcbridge_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *Brc20Metadata) GetTick() string {
+	if x != nil {
+		return x.Tick
+	}
+	return ""
+}
+
+func (x *Brc20Metadata) GetDecimals() uint32 {
+	if x != nil {
+		return x.Decimals
+	}
+	return 0
+}
+
+// BTC UTXO Consolidation
+type BtcConsolidation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// maximum threshold of the btc value
+	TargetThreshold int64 `protobuf:"varint,1,opt,name=target_threshold,json=targetThreshold,proto3" json:"target_threshold,omitempty"`
+	// maximum number of the utxos to be consolidated; 0 means all
+	MaxNum uint32 `protobuf:"varint,2,opt,name=max_num,json=maxNum,proto3" json:"max_num,omitempty"`
+}
+
+func (x *BtcConsolidation) Reset() {
+	*x = BtcConsolidation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_side_btcbridge_btcbridge_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BtcConsolidation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BtcConsolidation) ProtoMessage() {}
+
+// Deprecated: Use BtcConsolidation.ProtoReflect.Descriptor instead.
+func (*BtcConsolidation) Descriptor() ([]byte, []int) {
+	return file_side_btcbridge_btcbridge_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *BtcConsolidation) GetTargetThreshold() int64 {
+	if x != nil {
+		return x.TargetThreshold
+	}
+	return 0
+}
+
+func (x *BtcConsolidation) GetMaxNum() uint32 {
+	if x != nil {
+		return x.MaxNum
+	}
+	return 0
+}
+
+// Runes UTXO Consolidation
+type RunesConsolidation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// rune id
+	RuneId string `protobuf:"bytes,1,opt,name=rune_id,json=runeId,proto3" json:"rune_id,omitempty"`
+	// maximum threshold of the corresponding rune balance
+	TargetThreshold string `protobuf:"bytes,2,opt,name=target_threshold,json=targetThreshold,proto3" json:"target_threshold,omitempty"`
+	// maximum number of the utxos to be consolidated; 0 means all
+	MaxNum uint32 `protobuf:"varint,3,opt,name=max_num,json=maxNum,proto3" json:"max_num,omitempty"`
+}
+
+func (x *RunesConsolidation) Reset() {
+	*x = RunesConsolidation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_side_btcbridge_btcbridge_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RunesConsolidation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
 
-const (
-	// SIGNING_STATUS_UNSPECIFIED - Default value, should not be used
-	SigningStatus_SIGNING_STATUS_UNSPECIFIED SigningStatus = 0
-	// SIGNING_STATUS_PENDING - The signing request is pending
-	SigningStatus_SIGNING_STATUS_PENDING SigningStatus = 1
-	// SIGNING_STATUS_BROADCASTED - The signing request is broadcasted
-	SigningStatus_SIGNING_STATUS_BROADCASTED SigningStatus = 2
-	// SIGNING_STATUS_CONFIRMED - The signing request is confirmed
-	SigningStatus_SIGNING_STATUS_CONFIRMED SigningStatus = 3
-	// SIGNING_STATUS_FAILED - The signing request failed to be signed or broadcast due to unexpected exceptions
-	SigningStatus_SIGNING_STATUS_FAILED SigningStatus = 4
-)
+func (*RunesConsolidation) ProtoMessage() {}
 
-// Enum value maps for SigningStatus.
-var (
-	SigningStatus_name = map[int32]string{
-		0: "SIGNING_STATUS_UNSPECIFIED",
-		1: "SIGNING_STATUS_PENDING",
-		2: "SIGNING_STATUS_BROADCASTED",
-		3: "SIGNING_STATUS_CONFIRMED",
-		4: "SIGNING_STATUS_FAILED",
+// Deprecated: Use RunesConsolidation.ProtoReflect.Descriptor instead.
+func (*RunesConsolidation) Descriptor() ([]byte, []int) {
+	return file_side_btcbridge_btcbridge_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *RunesConsolidation) GetRuneId() string {
+	if x != nil {
+		return x.RuneId
 	}
-	SigningStatus_value = map[string]int32{
-		"SIGNING_STATUS_UNSPECIFIED": 0,
-		"SIGNING_STATUS_PENDING":     1,
-		"SIGNING_STATUS_BROADCASTED": 2,
-		"SIGNING_STATUS_CONFIRMED":   3,
-		"SIGNING_STATUS_FAILED":      4,
+	return ""
+}
+
+func (x *RunesConsolidation) GetTargetThreshold() string {
+	if x != nil {
+		return x.TargetThreshold
 	}
-)
+	return ""
+}
 
-func (x SigningStatus) Enum() *SigningStatus {
-	p := new(SigningStatus)
-	*p = x
-	return p
+func (x *RunesConsolidation) GetMaxNum() uint32 {
+	if x != nil {
+		return x.MaxNum
+	}
+	return 0
 }
 
-func (x SigningStatus) String() string {
-	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+// DKG Participant
+type DKGParticipant struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// the moniker of the corresponding validator
+	Moniker string `protobuf:"bytes,1,opt,name=moniker,proto3" json:"moniker,omitempty"`
+	// the operator address of the corresponding validator
+	OperatorAddress string `protobuf:"bytes,2,opt,name=operator_address,json=operatorAddress,proto3" json:"operator_address,omitempty"`
+	// the consensus public key of the corresponding validator
+	ConsensusPubkey string `protobuf:"bytes,3,opt,name=consensus_pubkey,json=consensusPubkey,proto3" json:"consensus_pubkey,omitempty"`
 }
 
-func (SigningStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_side_btcbridge_btcbridge_proto_enumTypes[0].Descriptor()
+func (x *DKGParticipant) Reset() {
+	*x = DKGParticipant{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_side_btcbridge_btcbridge_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-func (SigningStatus) Type() protoreflect.EnumType {
-	return &file_side_btcbridge_btcbridge_proto_enumTypes[0]
+func (x *DKGParticipant) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x SigningStatus) Number() protoreflect.EnumNumber {
-	return protoreflect.EnumNumber(x)
+func (*DKGParticipant) ProtoMessage() {}
+
+// Deprecated: Use DKGParticipant.ProtoReflect.Descriptor instead.
+func (*DKGParticipant) Descriptor() ([]byte, []int) {
+	return file_side_btcbridge_btcbridge_proto_rawDescGZIP(), []int{14}
 }
 
-// Deprecated: Use SigningStatus.Descriptor instead.
-func (SigningStatus) EnumDescriptor() ([]byte, []int) {
-	return file_side_btcbridge_btcbridge_proto_rawDescGZIP(), []int{0}
+func (x *DKGParticipant) GetMoniker() string {
+	if x != nil {
+		return x.Moniker
+	}
+	return ""
 }
 
-type DKGRequestStatus int32
+func (x *DKGParticipant) GetOperatorAddress() string {
+	if x != nil {
+		return x.OperatorAddress
+	}
+	return ""
+}
 
-const (
-	// DKG_REQUEST_STATUS_UNSPECIFIED defines the unknown DKG request status
-	DKGRequestStatus_DKG_REQUEST_STATUS_UNSPECIFIED DKGRequestStatus = 0
-	// DKG_REQUEST_STATUS_PENDING defines the status of the DKG request which is pending
-	DKGRequestStatus_DKG_REQUEST_STATUS_PENDING DKGRequestStatus = 1
-	// DKG_REQUEST_STATUS_COMPLETED defines the status of the DKG request which is completed
-	DKGRequestStatus_DKG_REQUEST_STATUS_COMPLETED DKGRequestStatus = 2
-	// DKG_REQUEST_STATUS_FAILED defines the status of the DKG request which failed
-	DKGRequestStatus_DKG_REQUEST_STATUS_FAILED DKGRequestStatus = 3
-	// DKG_REQUEST_STATUS_TIMEDOUT defines the status of the DKG request which timed out
-	DKGRequestStatus_DKG_REQUEST_STATUS_TIMEDOUT DKGRequestStatus = 4
-)
+func (x *DKGParticipant) GetConsensusPubkey() string {
+	if x != nil {
+		return x.ConsensusPubkey
+	}
+	return ""
+}
 
-// Enum value maps for DKGRequestStatus.
-var (
-	DKGRequestStatus_name = map[int32]string{
-		0: "DKG_REQUEST_STATUS_UNSPECIFIED",
-		1: "DKG_REQUEST_STATUS_PENDING",
-		2: "DKG_REQUEST_STATUS_COMPLETED",
-		3: "DKG_REQUEST_STATUS_FAILED",
-		4: "DKG_REQUEST_STATUS_TIMEDOUT",
+// DKG Request
+type DKGRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// the unique request id
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// participant set
+	Participants []*DKGParticipant `protobuf:"bytes,2,rep,name=participants,proto3" json:"participants,omitempty"`
+	// threshold required to perform DKG
+	Threshold uint32 `protobuf:"varint,3,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// asset types of vaults to be generated
+	VaultTypes []AssetType `protobuf:"varint,4,rep,packed,name=vault_types,json=vaultTypes,proto3,enum=side.btcbridge.AssetType" json:"vault_types,omitempty"`
+	// indicates if transferring assets to the newly generated vaults when the DKG request is completed
+	EnableTransfer bool `protobuf:"varint,5,opt,name=enable_transfer,json=enableTransfer,proto3" json:"enable_transfer,omitempty"`
+	// target number of the UTXOs to be transferred each time
+	TargetUtxoNum uint32 `protobuf:"varint,6,opt,name=target_utxo_num,json=targetUtxoNum,proto3" json:"target_utxo_num,omitempty"`
+	// expiration time
+	Expiration *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=expiration,proto3" json:"expiration,omitempty"`
+	// status
+	Status DKGRequestStatus `protobuf:"varint,8,opt,name=status,proto3,enum=side.btcbridge.DKGRequestStatus" json:"status,omitempty"`
+	// taproot script tree to be committed by the generated vaults; nil if no script path
+	TaprootTree *VaultTaprootTree `protobuf:"bytes,9,opt,name=taproot_tree,json=taprootTree,proto3" json:"taproot_tree,omitempty"`
+	// participants which did not submit the completion request before expiration
+	NonResponders []*DKGParticipant `protobuf:"bytes,10,rep,name=non_responders,json=nonResponders,proto3" json:"non_responders,omitempty"`
+	// id of the timed out DKG request retried by this request; 0 if not a retry
+	RetryOf uint64 `protobuf:"varint,11,opt,name=retry_of,json=retryOf,proto3" json:"retry_of,omitempty"`
+	// number of the retries so far
+	RetryCount uint32 `protobuf:"varint,12,opt,name=retry_count,json=retryCount,proto3" json:"retry_count,omitempty"`
+}
+
+func (x *DKGRequest) Reset() {
+	*x = DKGRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_side_btcbridge_btcbridge_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	DKGRequestStatus_value = map[string]int32{
-		"DKG_REQUEST_STATUS_UNSPECIFIED": 0,
-		"DKG_REQUEST_STATUS_PENDING":     1,
-		"DKG_REQUEST_STATUS_COMPLETED":   2,
-		"DKG_REQUEST_STATUS_FAILED":      3,
-		"DKG_REQUEST_STATUS_TIMEDOUT":    4,
+}
+
+func (x *DKGRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DKGRequest) ProtoMessage() {}
+
+// Deprecated: Use DKGRequest.ProtoReflect.Descriptor instead.
+func (*DKGRequest) Descriptor() ([]byte, []int) {
+	return file_side_btcbridge_btcbridge_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *DKGRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
 	}
-)
+	return 0
+}
 
-func (x DKGRequestStatus) Enum() *DKGRequestStatus {
-	p := new(DKGRequestStatus)
-	*p = x
-	return p
+func (x *DKGRequest) GetParticipants() []*DKGParticipant {
+	if x != nil {
+		return x.Participants
+	}
+	return nil
 }
 
-func (x DKGRequestStatus) String() string {
-	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+func (x *DKGRequest) GetThreshold() uint32 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
 }
 
-func (DKGRequestStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_side_btcbridge_btcbridge_proto_enumTypes[1].Descriptor()
+func (x *DKGRequest) GetVaultTypes() []AssetType {
+	if x != nil {
+		return x.VaultTypes
+	}
+	return nil
 }
 
-func (DKGRequestStatus) Type() protoreflect.EnumType {
-	return &file_side_btcbridge_btcbridge_proto_enumTypes[1]
+func (x *DKGRequest) GetEnableTransfer() bool {
+	if x != nil {
+		return x.EnableTransfer
+	}
+	return false
 }
 
-func (x DKGRequestStatus) Number() protoreflect.EnumNumber {
-	return protoreflect.EnumNumber(x)
+func (x *DKGRequest) GetTargetUtxoNum() uint32 {
+	if x != nil {
+		return x.TargetUtxoNum
+	}
+	return 0
 }
 
-// Deprecated: Use DKGRequestStatus.Descriptor instead.
-func (DKGRequestStatus) EnumDescriptor() ([]byte, []int) {
-	return file_side_btcbridge_btcbridge_proto_rawDescGZIP(), []int{1}
+func (x *DKGRequest) GetExpiration() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Expiration
+	}
+	return nil
+}
+
+func (x *DKGRequest) GetStatus() DKGRequestStatus {
+	if x != nil {
+		return x.Status
+	}
+	return DKGRequestStatus_DKG_REQUEST_STATUS_UNSPECIFIED
+}
+
+func (x *DKGRequest) GetTaprootTree() *VaultTaprootTree {
+	if x != nil {
+		return x.TaprootTree
+	}
+	return nil
+}
+
+func (x *DKGRequest) GetNonResponders() []*DKGParticipant {
+	if x != nil {
+		return x.NonResponders
+	}
+	return nil
+}
+
+func (x *DKGRequest) GetRetryOf() uint64 {
+	if x != nil {
+		return x.RetryOf
+	}
+	return 0
+}
+
+func (x *DKGRequest) GetRetryCount() uint32 {
+	if x != nil {
+		return x.RetryCount
+	}
+	return 0
 }
 
-// Bitcoin Block Header
-type BlockHeader struct {
+// DKG Completion Request
+type DKGCompletionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Version           uint64 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
-	Hash              string `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
-	Height            uint64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
-	PreviousBlockHash string `protobuf:"bytes,4,opt,name=previous_block_hash,json=previousBlockHash,proto3" json:"previous_block_hash,omitempty"`
-	MerkleRoot        string `protobuf:"bytes,5,opt,name=merkle_root,json=merkleRoot,proto3" json:"merkle_root,omitempty"`
-	Nonce             uint64 `protobuf:"varint,6,opt,name=nonce,proto3" json:"nonce,omitempty"`
-	Bits              string `protobuf:"bytes,7,opt,name=bits,proto3" json:"bits,omitempty"`
-	Time              uint64 `protobuf:"varint,8,opt,name=time,proto3" json:"time,omitempty"`
-	Ntx               uint64 `protobuf:"varint,9,opt,name=ntx,proto3" json:"ntx,omitempty"`
+	// request id
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// sender
+	Sender string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	// new vaults generated by DKG
+	Vaults []string `protobuf:"bytes,3,rep,name=vaults,proto3" json:"vaults,omitempty"`
+	// consensus address of the corresponding validator
+	ConsensusAddress string `protobuf:"bytes,4,opt,name=consensus_address,json=consensusAddress,proto3" json:"consensus_address,omitempty"`
+	// hex encoded validator signature over the id, vaults, public keys, proofs and verification shares
+	Signature string `protobuf:"bytes,5,opt,name=signature,proto3" json:"signature,omitempty"`
+	// hex encoded aggregate public keys of the new vaults
+	PubKeys []string `protobuf:"bytes,6,rep,name=pub_keys,json=pubKeys,proto3" json:"pub_keys,omitempty"`
+	// hex encoded schnorr signatures of the aggregate public keys as the proof of possession
+	Proofs []string `protobuf:"bytes,7,rep,name=proofs,proto3" json:"proofs,omitempty"`
+	// FROST verification shares of the new vaults; empty for the vaults not signed through the chain
+	VerificationShares []*VerificationShares `protobuf:"bytes,8,rep,name=verification_shares,json=verificationShares,proto3" json:"verification_shares,omitempty"`
 }
 
-func (x *BlockHeader) Reset() {
-	*x = BlockHeader{}
+func (x *DKGCompletionRequest) Reset() {
+	*x = DKGCompletionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_side_btcbridge_btcbridge_proto_msgTypes[0]
+		mi := &file_side_btcbridge_btcbridge_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BlockHeader) String() string {
+func (x *DKGCompletionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BlockHeader) ProtoMessage() {}
+func (*DKGCompletionRequest) ProtoMessage() {}
 
-// Deprecated: Use BlockHeader.ProtoReflect.Descriptor instead.
-func (*BlockHeader) Descriptor() ([]byte, []int) {
-	return file_side_btcbridge_btcbridge_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use DKGCompletionRequest.ProtoReflect.Descriptor instead.
+func (*DKGCompletionRequest) Descriptor() ([]byte, []int) {
+	return file_side_btcbridge_btcbridge_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *BlockHeader) GetVersion() uint64 {
+func (x *DKGCompletionRequest) GetId() uint64 {
 	if x != nil {
-		return x.Version
+		return x.Id
 	}
 	return 0
 }
 
-func (x *BlockHeader) GetHash() string {
+func (x *DKGCompletionRequest) GetSender() string {
 	if x != nil {
-		return x.Hash
+		return x.Sender
 	}
 	return ""
 }
 
-func (x *BlockHeader) GetHeight() uint64 {
+func (x *DKGCompletionRequest) GetVaults() []string {
 	if x != nil {
-		return x.Height
+		return x.Vaults
 	}
-	return 0
+	return nil
 }
 
-func (x *BlockHeader) GetPreviousBlockHash() string {
+func (x *DKGCompletionRequest) GetConsensusAddress() string {
 	if x != nil {
-		return x.PreviousBlockHash
+		return x.ConsensusAddress
 	}
 	return ""
 }
 
-func (x *BlockHeader) GetMerkleRoot() string {
+func (x *DKGCompletionRequest) GetSignature() string {
 	if x != nil {
-		return x.MerkleRoot
+		return x.Signature
 	}
 	return ""
 }
 
-func (x *BlockHeader) GetNonce() uint64 {
-	if x != nil {
-		return x.Nonce
-	}
-	return 0
-}
-
-func (x *BlockHeader) GetBits() string {
+func (x *DKGCompletionRequest) GetPubKeys() []string {
 	if x != nil {
-		return x.Bits
+		return x.PubKeys
 	}
-	return ""
+	return nil
 }
 
-func (x *BlockHeader) GetTime() uint64 {
+func (x *DKGCompletionRequest) GetProofs() []string {
 	if x != nil {
-		return x.Time
+		return x.Proofs
 	}
-	return 0
+	return nil
 }
 
-func (x *BlockHeader) GetNtx() uint64 {
+func (x *DKGCompletionRequest) GetVerificationShares() []*VerificationShares {
 	if x != nil {
-		return x.Ntx
+		return x.VerificationShares
 	}
-	return 0
+	return nil
 }
 
-// Fee rate
-type FeeRate struct {
+// VerificationShares defines the FROST verification shares of the vault
+type VerificationShares struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// fee rate
-	Value int64 `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
-	// block height at which the fee rate is submitted
-	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// hex encoded compressed verification shares in the order of the DKG participants
+	Shares []string `protobuf:"bytes,1,rep,name=shares,proto3" json:"shares,omitempty"`
 }
 
-func (x *FeeRate) Reset() {
-	*x = FeeRate{}
+func (x *VerificationShares) Reset() {
+	*x = VerificationShares{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_side_btcbridge_btcbridge_proto_msgTypes[1]
+		mi := &file_side_btcbridge_btcbridge_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FeeRate) String() string {
+func (x *VerificationShares) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FeeRate) ProtoMessage() {}
-
-// Deprecated: Use FeeRate.ProtoReflect.Descriptor instead.
-func (*FeeRate) Descriptor() ([]byte, []int) {
-	return file_side_btcbridge_btcbridge_proto_rawDescGZIP(), []int{1}
-}
+func (*VerificationShares) ProtoMessage() {}
 
-func (x *FeeRate) GetValue() int64 {
-	if x != nil {
-		return x.Value
-	}
-	return 0
+// Deprecated: Use VerificationShares.ProtoReflect.Descriptor instead.
+func (*VerificationShares) Descriptor() ([]byte, []int) {
+	return file_side_btcbridge_btcbridge_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *FeeRate) GetHeight() int64 {
+func (x *VerificationShares) GetShares() []string {
 	if x != nil {
-		return x.Height
+		return x.Shares
 	}
-	return 0
+	return nil
 }
 
-// Bitcoin Signing Request
-type SigningRequest struct {
+// FrostSigner defines the FROST signer of the vault
+type FrostSigner struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Address      string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	Sequence     uint64                 `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
-	Type_        AssetType              `protobuf:"varint,3,opt,name=type,proto3,enum=side.btcbridge.AssetType" json:"type,omitempty"`
-	Txid         string                 `protobuf:"bytes,4,opt,name=txid,proto3" json:"txid,omitempty"`
-	Psbt         string                 `protobuf:"bytes,5,opt,name=psbt,proto3" json:"psbt,omitempty"`
-	CreationTime *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=creation_time,json=creationTime,proto3" json:"creation_time,omitempty"`
-	Status       SigningStatus          `protobuf:"varint,7,opt,name=status,proto3,enum=side.btcbridge.SigningStatus" json:"status,omitempty"`
+	// operator address of the signer
+	OperatorAddress string `protobuf:"bytes,1,opt,name=operator_address,json=operatorAddress,proto3" json:"operator_address,omitempty"`
+	// signer identifier starting from 1
+	Index uint32 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	// hex encoded compressed verification share
+	VerificationShare string `protobuf:"bytes,3,opt,name=verification_share,json=verificationShare,proto3" json:"verification_share,omitempty"`
 }
 
-func (x *SigningRequest) Reset() {
-	*x = SigningRequest{}
+func (x *FrostSigner) Reset() {
+	*x = FrostSigner{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_side_btcbridge_btcbridge_proto_msgTypes[2]
+		mi := &file_side_btcbridge_btcbridge_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SigningRequest) String() string {
+func (x *FrostSigner) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SigningRequest) ProtoMessage() {}
+func (*FrostSigner) ProtoMessage() {}
 
-// Deprecated: Use SigningRequest.ProtoReflect.Descriptor instead.
-func (*SigningRequest) Descriptor() ([]byte, []int) {
-	return file_side_btcbridge_btcbridge_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use FrostSigner.ProtoReflect.Descriptor instead.
+func (*FrostSigner) Descriptor() ([]byte, []int) {
+	return file_side_btcbridge_btcbridge_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *SigningRequest) GetAddress() string {
+func (x *FrostSigner) GetOperatorAddress() string {
 	if x != nil {
-		return x.Address
+		return x.OperatorAddress
 	}
 	return ""
 }
 
-func (x *SigningRequest) GetSequence() uint64 {
+func (x *FrostSigner) GetIndex() uint32 {
 	if x != nil {
-		return x.Sequence
+		return x.Index
 	}
 	return 0
 }
 
-func (x *SigningRequest) GetType_() AssetType {
+func (x *FrostSigner) GetVerificationShare() string {
 	if x != nil {
-		return x.Type_
+		return x.VerificationShare
 	}
-	return AssetType_ASSET_TYPE_UNSPECIFIED
+	return ""
 }
 
-func (x *SigningRequest) GetTxid() string {
+// FrostVaultKey defines the FROST key of the vault generated by DKG
+type FrostVaultKey struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// vault address
+	Vault string `protobuf:"bytes,1,opt,name=vault,proto3" json:"vault,omitempty"`
+	// hex encoded compressed aggregate public key
+	PubKey string `protobuf:"bytes,2,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+	// signing threshold
+	Threshold uint32 `protobuf:"varint,3,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// signers
+	Signers []*FrostSigner `protobuf:"bytes,4,rep,name=signers,proto3" json:"signers,omitempty"`
+}
+
+func (x *FrostVaultKey) Reset() {
+	*x = FrostVaultKey{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_side_btcbridge_btcbridge_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FrostVaultKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FrostVaultKey) ProtoMessage() {}
+
+// Deprecated: Use FrostVaultKey.ProtoReflect.Descriptor instead.
+func (*FrostVaultKey) Descriptor() ([]byte, []int) {
+	return file_side_btcbridge_btcbridge_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *FrostVaultKey) GetVault() string {
 	if x != nil {
-		return x.Txid
+		return x.Vault
 	}
 	return ""
 }
 
-func (x *SigningRequest) GetPsbt() string {
+func (x *FrostVaultKey) GetPubKey() string {
 	if x != nil {
-		return x.Psbt
+		return x.PubKey
 	}
 	return ""
 }
 
-func (x *SigningRequest) GetCreationTime() *timestamppb.Timestamp {
+func (x *FrostVaultKey) GetThreshold() uint32 {
 	if x != nil {
-		return x.CreationTime
+		return x.Threshold
 	}
-	return nil
+	return 0
 }
 
-func (x *SigningRequest) GetStatus() SigningStatus {
+func (x *FrostVaultKey) GetSigners() []*FrostSigner {
 	if x != nil {
-		return x.Status
+		return x.Signers
 	}
-	return SigningStatus_SIGNING_STATUS_UNSPECIFIED
+	return nil
 }
 
-// Withdrawal Request
-type WithdrawRequest struct {
+// FrostNonceCommitment defines the FROST nonce commitment for a signing request input
+type FrostNonceCommitment struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Address  string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	Amount   string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
-	Sequence uint64 `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
-	Txid     string `protobuf:"bytes,4,opt,name=txid,proto3" json:"txid,omitempty"`
+	// hex encoded compressed hiding nonce commitment
+	Hiding string `protobuf:"bytes,1,opt,name=hiding,proto3" json:"hiding,omitempty"`
+	// hex encoded compressed binding nonce commitment
+	Binding string `protobuf:"bytes,2,opt,name=binding,proto3" json:"binding,omitempty"`
 }
 
-func (x *WithdrawRequest) Reset() {
-	*x = WithdrawRequest{}
+func (x *FrostNonceCommitment) Reset() {
+	*x = FrostNonceCommitment{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_side_btcbridge_btcbridge_proto_msgTypes[3]
+		mi := &file_side_btcbridge_btcbridge_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *WithdrawRequest) String() string {
+func (x *FrostNonceCommitment) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WithdrawRequest) ProtoMessage() {}
+func (*FrostNonceCommitment) ProtoMessage() {}
 
-// Deprecated: Use WithdrawRequest.ProtoReflect.Descriptor instead.
-func (*WithdrawRequest) Descriptor() ([]byte, []int) {
-	return file_side_btcbridge_btcbridge_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use FrostNonceCommitment.ProtoReflect.Descriptor instead.
+func (*FrostNonceCommitment) Descriptor() ([]byte, []int) {
+	return file_side_btcbridge_btcbridge_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *WithdrawRequest) GetAddress() string {
+func (x *FrostNonceCommitment) GetHiding() string {
 	if x != nil {
-		return x.Address
+		return x.Hiding
 	}
 	return ""
 }
 
-func (x *WithdrawRequest) GetAmount() string {
+func (x *FrostNonceCommitment) GetBinding() string {
 	if x != nil {
-		return x.Amount
+		return x.Binding
 	}
 	return ""
 }
 
-func (x *WithdrawRequest) GetSequence() uint64 {
-	if x != nil {
-		return x.Sequence
+// FrostSignerRound defines the round data submitted by the FROST signer
+type FrostSignerRound struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// operator address of the signer
+	OperatorAddress string `protobuf:"bytes,1,opt,name=operator_address,json=operatorAddress,proto3" json:"operator_address,omitempty"`
+	// nonce commitments for each input
+	Commitments []*FrostNonceCommitment `protobuf:"bytes,2,rep,name=commitments,proto3" json:"commitments,omitempty"`
+	// hex encoded partial signatures for each input
+	PartialSignatures []string `protobuf:"bytes,3,rep,name=partial_signatures,json=partialSignatures,proto3" json:"partial_signatures,omitempty"`
+}
+
+func (x *FrostSignerRound) Reset() {
+	*x = FrostSignerRound{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_side_btcbridge_btcbridge_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *WithdrawRequest) GetTxid() string {
+func (x *FrostSignerRound) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FrostSignerRound) ProtoMessage() {}
+
+// Deprecated: Use FrostSignerRound.ProtoReflect.Descriptor instead.
+func (*FrostSignerRound) Descriptor() ([]byte, []int) {
+	return file_side_btcbridge_btcbridge_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *FrostSignerRound) GetOperatorAddress() string {
 	if x != nil {
-		return x.Txid
+		return x.OperatorAddress
 	}
 	return ""
 }
 
-// Bitcoin UTXO
-type UTXO struct {
+func (x *FrostSignerRound) GetCommitments() []*FrostNonceCommitment {
+	if x != nil {
+		return x.Commitments
+	}
+	return nil
+}
+
+func (x *FrostSignerRound) GetPartialSignatures() []string {
+	if x != nil {
+		return x.PartialSignatures
+	}
+	return nil
+}
+
+// FrostSigningSession defines the FROST signing session of the signing request
+type FrostSigningSession struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Txid         string `protobuf:"bytes,1,opt,name=txid,proto3" json:"txid,omitempty"`
-	Vout         uint64 `protobuf:"varint,2,opt,name=vout,proto3" json:"vout,omitempty"`
-	Address      string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
-	Amount       uint64 `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
-	Height       uint64 `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
-	PubKeyScript []byte `protobuf:"bytes,6,opt,name=pub_key_script,json=pubKeyScript,proto3" json:"pub_key_script,omitempty"`
-	IsLocked     bool   `protobuf:"varint,7,opt,name=is_locked,json=isLocked,proto3" json:"is_locked,omitempty"`
-	// rune balances associated with the UTXO
-	Runes []*RuneBalance `protobuf:"bytes,8,rep,name=runes,proto3" json:"runes,omitempty"`
-}
-
-func (x *UTXO) Reset() {
-	*x = UTXO{}
+	// tx hash of the signing request
+	Txid string `protobuf:"bytes,1,opt,name=txid,proto3" json:"txid,omitempty"`
+	// status
+	Status FrostSigningStatus `protobuf:"varint,2,opt,name=status,proto3,enum=side.btcbridge.FrostSigningStatus" json:"status,omitempty"`
+	// signing round starting from 1, which is increased once any signer misbehaves
+	Round uint32 `protobuf:"varint,3,opt,name=round,proto3" json:"round,omitempty"`
+	// number of the signers required
+	Threshold uint32 `protobuf:"varint,4,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// signers of the current round in the order of submission
+	Signers []*FrostSignerRound `protobuf:"bytes,5,rep,name=signers,proto3" json:"signers,omitempty"`
+	// operator addresses of the misbehaving signers excluded from the session
+	MisbehavingSigners []string `protobuf:"bytes,6,rep,name=misbehaving_signers,json=misbehavingSigners,proto3" json:"misbehaving_signers,omitempty"`
+	// start time of the current phase of the round
+	RoundStartTime *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=round_start_time,json=roundStartTime,proto3" json:"round_start_time,omitempty"`
+}
+
+func (x *FrostSigningSession) Reset() {
+	*x = FrostSigningSession{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_side_btcbridge_btcbridge_proto_msgTypes[4]
+		mi := &file_side_btcbridge_btcbridge_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UTXO) String() string {
+func (x *FrostSigningSession) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UTXO) ProtoMessage() {}
+func (*FrostSigningSession) ProtoMessage() {}
 
-// Deprecated: Use UTXO.ProtoReflect.Descriptor instead.
-func (*UTXO) Descriptor() ([]byte, []int) {
-	return file_side_btcbridge_btcbridge_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use FrostSigningSession.ProtoReflect.Descriptor instead.
+func (*FrostSigningSession) Descriptor() ([]byte, []int) {
+	return file_side_btcbridge_btcbridge_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *UTXO) GetTxid() string {
+func (x *FrostSigningSession) GetTxid() string {
 	if x != nil {
 		return x.Txid
 	}
 	return ""
 }
 
-func (x *UTXO) GetVout() uint64 {
-	if x != nil {
-		return x.Vout
-	}
-	return 0
-}
-
-func (x *UTXO) GetAddress() string {
+func (x *FrostSigningSession) GetStatus() FrostSigningStatus {
 	if x != nil {
-		return x.Address
+		return x.Status
 	}
-	return ""
+	return FrostSigningStatus_FROST_SIGNING_STATUS_UNSPECIFIED
 }
 
-func (x *UTXO) GetAmount() uint64 {
+func (x *FrostSigningSession) GetRound() uint32 {
 	if x != nil {
-		return x.Amount
+		return x.Round
 	}
 	return 0
 }
 
-func (x *UTXO) GetHeight() uint64 {
+func (x *FrostSigningSession) GetThreshold() uint32 {
 	if x != nil {
-		return x.Height
+		return x.Threshold
 	}
 	return 0
 }
 
-func (x *UTXO) GetPubKeyScript() []byte {
+func (x *FrostSigningSession) GetSigners() []*FrostSignerRound {
 	if x != nil {
-		return x.PubKeyScript
+		return x.Signers
 	}
 	return nil
 }
 
-func (x *UTXO) GetIsLocked() bool {
+func (x *FrostSigningSession) GetMisbehavingSigners() []string {
 	if x != nil {
-		return x.IsLocked
+		return x.MisbehavingSigners
 	}
-	return false
+	return nil
 }
 
-func (x *UTXO) GetRunes() []*RuneBalance {
+func (x *FrostSigningSession) GetRoundStartTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Runes
+		return x.RoundStartTime
 	}
 	return nil
 }
 
-// Rune Balance
-type RuneBalance struct {
+// SignerPerformance defines the liveness performance of the TSS participant
+type SignerPerformance struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// serialized rune id
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// rune amount
-	Amount string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
-}
-
-func (x *RuneBalance) Reset() {
-	*x = RuneBalance{}
+	// operator address of the participant
+	OperatorAddress string `protobuf:"bytes,1,opt,name=operator_address,json=operatorAddress,proto3" json:"operator_address,omitempty"`
+	// block heights of the missed DKG completions in the current window
+	MissedDkgHeights []int64 `protobuf:"varint,2,rep,packed,name=missed_dkg_heights,json=missedDkgHeights,proto3" json:"missed_dkg_heights,omitempty"`
+	// block heights of the missed signing rounds in the current window
+	MissedSigningHeights []int64 `protobuf:"varint,3,rep,packed,name=missed_signing_heights,json=missedSigningHeights,proto3" json:"missed_signing_heights,omitempty"`
+	// total number of the missed DKG completions
+	TotalMissedDkgs uint64 `protobuf:"varint,4,opt,name=total_missed_dkgs,json=totalMissedDkgs,proto3" json:"total_missed_dkgs,omitempty"`
+	// total number of the missed signing rounds
+	TotalMissedSignings uint64 `protobuf:"varint,5,opt,name=total_missed_signings,json=totalMissedSignings,proto3" json:"total_missed_signings,omitempty"`
+	// number of times the participant has been punished
+	Punishments uint64 `protobuf:"varint,6,opt,name=punishments,proto3" json:"punishments,omitempty"`
+	// block height of the last punishment
+	LastPunishmentHeight int64 `protobuf:"varint,7,opt,name=last_punishment_height,json=lastPunishmentHeight,proto3" json:"last_punishment_height,omitempty"`
+}
+
+func (x *SignerPerformance) Reset() {
+	*x = SignerPerformance{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_side_btcbridge_btcbridge_proto_msgTypes[5]
+		mi := &file_side_btcbridge_btcbridge_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RuneBalance) String() string {
+func (x *SignerPerformance) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RuneBalance) ProtoMessage() {}
+func (*SignerPerformance) ProtoMessage() {}
 
-// Deprecated: Use RuneBalance.ProtoReflect.Descriptor instead.
-func (*RuneBalance) Descriptor() ([]byte, []int) {
-	return file_side_btcbridge_btcbridge_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use SignerPerformance.ProtoReflect.Descriptor instead.
+func (*SignerPerformance) Descriptor() ([]byte, []int) {
+	return file_side_btcbridge_btcbridge_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *RuneBalance) GetId() string {
+func (x *SignerPerformance) GetOperatorAddress() string {
 	if x != nil {
-		return x.Id
+		return x.OperatorAddress
 	}
 	return ""
 }
 
-func (x *RuneBalance) GetAmount() string {
+func (x *SignerPerformance) GetMissedDkgHeights() []int64 {
 	if x != nil {
-		return x.Amount
+		return x.MissedDkgHeights
 	}
-	return ""
-}
-
-// Rune ID
-type RuneId struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// block height
-	Block uint64 `protobuf:"varint,1,opt,name=block,proto3" json:"block,omitempty"`
-	// tx index
-	Tx uint32 `protobuf:"varint,2,opt,name=tx,proto3" json:"tx,omitempty"`
+	return nil
 }
 
-func (x *RuneId) Reset() {
-	*x = RuneId{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_side_btcbridge_btcbridge_proto_msgTypes[6]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *SignerPerformance) GetMissedSigningHeights() []int64 {
+	if x != nil {
+		return x.MissedSigningHeights
 	}
+	return nil
 }
 
-func (x *RuneId) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *SignerPerformance) GetTotalMissedDkgs() uint64 {
+	if x != nil {
+		return x.TotalMissedDkgs
+	}
+	return 0
 }
 
-func (*RuneId) ProtoMessage() {}
-
-// Deprecated: Use RuneId.ProtoReflect.Descriptor instead.
-func (*RuneId) Descriptor() ([]byte, []int) {
-	return file_side_btcbridge_btcbridge_proto_rawDescGZIP(), []int{6}
+func (x *SignerPerformance) GetTotalMissedSignings() uint64 {
+	if x != nil {
+		return x.TotalMissedSignings
+	}
+	return 0
 }
 
-func (x *RuneId) GetBlock() uint64 {
+func (x *SignerPerformance) GetPunishments() uint64 {
 	if x != nil {
-		return x.Block
+		return x.Punishments
 	}
 	return 0
 }
 
-func (x *RuneId) GetTx() uint32 {
+func (x *SignerPerformance) GetLastPunishmentHeight() int64 {
 	if x != nil {
-		return x.Tx
+		return x.LastPunishmentHeight
 	}
 	return 0
 }
 
-// Rune Edict
-type Edict struct {
+// AssetTransfer defines the transfer progress of the vault of the given asset type
+type AssetTransfer struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id     *RuneId `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Amount string  `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
-	Output uint32  `protobuf:"varint,3,opt,name=output,proto3" json:"output,omitempty"`
-}
-
-func (x *Edict) Reset() {
-	*x = Edict{}
+	// asset type
+	AssetType AssetType `protobuf:"varint,1,opt,name=asset_type,json=assetType,proto3,enum=side.btcbridge.AssetType" json:"asset_type,omitempty"`
+	// source vault address
+	SourceVault string `protobuf:"bytes,2,opt,name=source_vault,json=sourceVault,proto3" json:"source_vault,omitempty"`
+	// destination vault address
+	DestVault string `protobuf:"bytes,3,opt,name=dest_vault,json=destVault,proto3" json:"dest_vault,omitempty"`
+	// status
+	Status VaultTransferStatus `protobuf:"varint,4,opt,name=status,proto3,enum=side.btcbridge.VaultTransferStatus" json:"status,omitempty"`
+	// tx hashes of the signing requests created for the transfer
+	SigningRequests []string `protobuf:"bytes,5,rep,name=signing_requests,json=signingRequests,proto3" json:"signing_requests,omitempty"`
+	// number of the utxos remaining in the source vault
+	RemainingUtxoNum uint64 `protobuf:"varint,6,opt,name=remaining_utxo_num,json=remainingUtxoNum,proto3" json:"remaining_utxo_num,omitempty"`
+	// total value in satoshis of the utxos remaining in the source vault
+	RemainingValue uint64 `protobuf:"varint,7,opt,name=remaining_value,json=remainingValue,proto3" json:"remaining_value,omitempty"`
+	// error of the last failed transfer step; cleared once a step succeeds
+	LastError string `protobuf:"bytes,8,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+}
+
+func (x *AssetTransfer) Reset() {
+	*x = AssetTransfer{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_side_btcbridge_btcbridge_proto_msgTypes[7]
+		mi := &file_side_btcbridge_btcbridge_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Edict) String() string {
+func (x *AssetTransfer) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Edict) ProtoMessage() {}
+func (*AssetTransfer) ProtoMessage() {}
 
-// Deprecated: Use Edict.ProtoReflect.Descriptor instead.
-func (*Edict) Descriptor() ([]byte, []int) {
-	return file_side_btcbridge_btcbridge_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use AssetTransfer.ProtoReflect.Descriptor instead.
+func (*AssetTransfer) Descriptor() ([]byte, []int) {
+	return file_side_btcbridge_btcbridge_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *Edict) GetId() *RuneId {
+func (x *AssetTransfer) GetAssetType() AssetType {
 	if x != nil {
-		return x.Id
+		return x.AssetType
 	}
-	return nil
+	return AssetType_ASSET_TYPE_UNSPECIFIED
 }
 
-func (x *Edict) GetAmount() string {
+func (x *AssetTransfer) GetSourceVault() string {
 	if x != nil {
-		return x.Amount
+		return x.SourceVault
 	}
 	return ""
 }
 
-func (x *Edict) GetOutput() uint32 {
+func (x *AssetTransfer) GetDestVault() string {
 	if x != nil {
-		return x.Output
+		return x.DestVault
 	}
-	return 0
-}
-
-// BTC UTXO Consolidation
-type BtcConsolidation struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// maximum threshold of the btc value
-	TargetThreshold int64 `protobuf:"varint,1,opt,name=target_threshold,json=targetThreshold,proto3" json:"target_threshold,omitempty"`
-	// maximum number of the utxos to be consolidated; 0 means all
-	MaxNum uint32 `protobuf:"varint,2,opt,name=max_num,json=maxNum,proto3" json:"max_num,omitempty"`
+	return ""
 }
 
-func (x *BtcConsolidation) Reset() {
-	*x = BtcConsolidation{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_side_btcbridge_btcbridge_proto_msgTypes[8]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *AssetTransfer) GetStatus() VaultTransferStatus {
+	if x != nil {
+		return x.Status
 	}
+	return VaultTransferStatus_VAULT_TRANSFER_STATUS_UNSPECIFIED
 }
 
-func (x *BtcConsolidation) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *AssetTransfer) GetSigningRequests() []string {
+	if x != nil {
+		return x.SigningRequests
+	}
+	return nil
 }
 
-func (*BtcConsolidation) ProtoMessage() {}
-
-// Deprecated: Use BtcConsolidation.ProtoReflect.Descriptor instead.
-func (*BtcConsolidation) Descriptor() ([]byte, []int) {
-	return file_side_btcbridge_btcbridge_proto_rawDescGZIP(), []int{8}
+func (x *AssetTransfer) GetRemainingUtxoNum() uint64 {
+	if x != nil {
+		return x.RemainingUtxoNum
+	}
+	return 0
 }
 
-func (x *BtcConsolidation) GetTargetThreshold() int64 {
+func (x *AssetTransfer) GetRemainingValue() uint64 {
 	if x != nil {
-		return x.TargetThreshold
+		return x.RemainingValue
 	}
 	return 0
 }
 
-func (x *BtcConsolidation) GetMaxNum() uint32 {
+func (x *AssetTransfer) GetLastError() string {
 	if x != nil {
-		return x.MaxNum
+		return x.LastError
 	}
-	return 0
+	return ""
 }
 
-// Runes UTXO Consolidation
-type RunesConsolidation struct {
+// VaultTransfer defines the asset transfer from the vaults of the source version to the destination version
+type VaultTransfer struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// rune id
-	RuneId string `protobuf:"bytes,1,opt,name=rune_id,json=runeId,proto3" json:"rune_id,omitempty"`
-	// maximum threshold of the corresponding rune balance
-	TargetThreshold string `protobuf:"bytes,2,opt,name=target_threshold,json=targetThreshold,proto3" json:"target_threshold,omitempty"`
-	// maximum number of the utxos to be consolidated; 0 means all
-	MaxNum uint32 `protobuf:"varint,3,opt,name=max_num,json=maxNum,proto3" json:"max_num,omitempty"`
+	// source vault version
+	SourceVersion uint64 `protobuf:"varint,1,opt,name=source_version,json=sourceVersion,proto3" json:"source_version,omitempty"`
+	// destination vault version
+	DestVersion uint64 `protobuf:"varint,2,opt,name=dest_version,json=destVersion,proto3" json:"dest_version,omitempty"`
+	// id of the DKG request enabling the transfer; 0 means the transfer initiated by governance
+	DkgId uint64 `protobuf:"varint,3,opt,name=dkg_id,json=dkgId,proto3" json:"dkg_id,omitempty"`
+	// status
+	Status VaultTransferStatus `protobuf:"varint,4,opt,name=status,proto3,enum=side.btcbridge.VaultTransferStatus" json:"status,omitempty"`
+	// transfer progress per asset type
+	Assets []*AssetTransfer `protobuf:"bytes,5,rep,name=assets,proto3" json:"assets,omitempty"`
+	// block height at which the transfer started
+	StartHeight int64 `protobuf:"varint,6,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// block height at which the transfer completed
+	CompletionHeight int64 `protobuf:"varint,7,opt,name=completion_height,json=completionHeight,proto3" json:"completion_height,omitempty"`
 }
 
-func (x *RunesConsolidation) Reset() {
-	*x = RunesConsolidation{}
+func (x *VaultTransfer) Reset() {
+	*x = VaultTransfer{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_side_btcbridge_btcbridge_proto_msgTypes[9]
+		mi := &file_side_btcbridge_btcbridge_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RunesConsolidation) String() string {
+func (x *VaultTransfer) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RunesConsolidation) ProtoMessage() {}
+func (*VaultTransfer) ProtoMessage() {}
 
-// Deprecated: Use RunesConsolidation.ProtoReflect.Descriptor instead.
-func (*RunesConsolidation) Descriptor() ([]byte, []int) {
-	return file_side_btcbridge_btcbridge_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use VaultTransfer.ProtoReflect.Descriptor instead.
+func (*VaultTransfer) Descriptor() ([]byte, []int) {
+	return file_side_btcbridge_btcbridge_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *RunesConsolidation) GetRuneId() string {
+func (x *VaultTransfer) GetSourceVersion() uint64 {
 	if x != nil {
-		return x.RuneId
+		return x.SourceVersion
 	}
-	return ""
+	return 0
 }
 
-func (x *RunesConsolidation) GetTargetThreshold() string {
+func (x *VaultTransfer) GetDestVersion() uint64 {
 	if x != nil {
-		return x.TargetThreshold
+		return x.DestVersion
 	}
-	return ""
+	return 0
 }
 
-func (x *RunesConsolidation) GetMaxNum() uint32 {
+func (x *VaultTransfer) GetDkgId() uint64 {
 	if x != nil {
-		return x.MaxNum
+		return x.DkgId
 	}
 	return 0
 }
 
-// DKG Participant
-type DKGParticipant struct {
+func (x *VaultTransfer) GetStatus() VaultTransferStatus {
+	if x != nil {
+		return x.Status
+	}
+	return VaultTransferStatus_VAULT_TRANSFER_STATUS_UNSPECIFIED
+}
+
+func (x *VaultTransfer) GetAssets() []*AssetTransfer {
+	if x != nil {
+		return x.Assets
+	}
+	return nil
+}
+
+func (x *VaultTransfer) GetStartHeight() int64 {
+	if x != nil {
+		return x.StartHeight
+	}
+	return 0
+}
+
+func (x *VaultTransfer) GetCompletionHeight() int64 {
+	if x != nil {
+		return x.CompletionHeight
+	}
+	return 0
+}
+
+// FeeBucketStats defines the protocol fee statistics of the given bucket
+type FeeBucketStats struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// the moniker of the corresponding validator
-	Moniker string `protobuf:"bytes,1,opt,name=moniker,proto3" json:"moniker,omitempty"`
-	// the operator address of the corresponding validator
-	OperatorAddress string `protobuf:"bytes,2,opt,name=operator_address,json=operatorAddress,proto3" json:"operator_address,omitempty"`
-	// the consensus public key of the corresponding validator
-	ConsensusPubkey string `protobuf:"bytes,3,opt,name=consensus_pubkey,json=consensusPubkey,proto3" json:"consensus_pubkey,omitempty"`
+	// bucket
+	Bucket FeeBucket `protobuf:"varint,1,opt,name=bucket,proto3,enum=side.btcbridge.FeeBucket" json:"bucket,omitempty"`
+	// fees allocated to the bucket at the settlements in total
+	Accumulated []*v1beta1.Coin `protobuf:"bytes,2,rep,name=accumulated,proto3" json:"accumulated,omitempty"`
+	// fees paid out of the bucket to the recipients in total
+	Distributed []*v1beta1.Coin `protobuf:"bytes,3,rep,name=distributed,proto3" json:"distributed,omitempty"`
 }
 
-func (x *DKGParticipant) Reset() {
-	*x = DKGParticipant{}
+func (x *FeeBucketStats) Reset() {
+	*x = FeeBucketStats{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_side_btcbridge_btcbridge_proto_msgTypes[10]
+		mi := &file_side_btcbridge_btcbridge_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DKGParticipant) String() string {
+func (x *FeeBucketStats) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DKGParticipant) ProtoMessage() {}
+func (*FeeBucketStats) ProtoMessage() {}
 
-// Deprecated: Use DKGParticipant.ProtoReflect.Descriptor instead.
-func (*DKGParticipant) Descriptor() ([]byte, []int) {
-	return file_side_btcbridge_btcbridge_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use FeeBucketStats.ProtoReflect.Descriptor instead.
+func (*FeeBucketStats) Descriptor() ([]byte, []int) {
+	return file_side_btcbridge_btcbridge_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *DKGParticipant) GetMoniker() string {
+func (x *FeeBucketStats) GetBucket() FeeBucket {
 	if x != nil {
-		return x.Moniker
+		return x.Bucket
 	}
-	return ""
+	return FeeBucket_FEE_BUCKET_UNSPECIFIED
 }
 
-func (x *DKGParticipant) GetOperatorAddress() string {
+func (x *FeeBucketStats) GetAccumulated() []*v1beta1.Coin {
 	if x != nil {
-		return x.OperatorAddress
+		return x.Accumulated
 	}
-	return ""
+	return nil
 }
 
-func (x *DKGParticipant) GetConsensusPubkey() string {
+func (x *FeeBucketStats) GetDistributed() []*v1beta1.Coin {
 	if x != nil {
-		return x.ConsensusPubkey
+		return x.Distributed
 	}
-	return ""
+	return nil
 }
 
-// DKG Request
-type DKGRequest struct {
+// CircuitBreaker defines the state of the withdrawal circuit breaker
+type CircuitBreaker struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// the unique request id
-	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	// participant set
-	Participants []*DKGParticipant `protobuf:"bytes,2,rep,name=participants,proto3" json:"participants,omitempty"`
-	// threshold required to perform DKG
-	Threshold uint32 `protobuf:"varint,3,opt,name=threshold,proto3" json:"threshold,omitempty"`
-	// asset types of vaults to be generated
-	VaultTypes []AssetType `protobuf:"varint,4,rep,packed,name=vault_types,json=vaultTypes,proto3,enum=side.btcbridge.AssetType" json:"vault_types,omitempty"`
-	// indicates if transferring assets to the newly generated vaults when the DKG request is completed
-	EnableTransfer bool `protobuf:"varint,5,opt,name=enable_transfer,json=enableTransfer,proto3" json:"enable_transfer,omitempty"`
-	// target number of the UTXOs to be transferred each time
-	TargetUtxoNum uint32 `protobuf:"varint,6,opt,name=target_utxo_num,json=targetUtxoNum,proto3" json:"target_utxo_num,omitempty"`
-	// expiration time
-	Expiration *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=expiration,proto3" json:"expiration,omitempty"`
-	// status
-	Status DKGRequestStatus `protobuf:"varint,8,opt,name=status,proto3,enum=side.btcbridge.DKGRequestStatus" json:"status,omitempty"`
+	// Indicates if the breaker is tripped, in which case the withdrawals are paused until reset by governance
+	Tripped bool `protobuf:"varint,1,opt,name=tripped,proto3" json:"tripped,omitempty"`
+	// block height at which the breaker is tripped
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// denom of the asset whose outflow tripped the breaker; empty for the global limit
+	Denom string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
-func (x *DKGRequest) Reset() {
-	*x = DKGRequest{}
+func (x *CircuitBreaker) Reset() {
+	*x = CircuitBreaker{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_side_btcbridge_btcbridge_proto_msgTypes[11]
+		mi := &file_side_btcbridge_btcbridge_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DKGRequest) String() string {
+func (x *CircuitBreaker) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DKGRequest) ProtoMessage() {}
+func (*CircuitBreaker) ProtoMessage() {}
 
-// Deprecated: Use DKGRequest.ProtoReflect.Descriptor instead.
-func (*DKGRequest) Descriptor() ([]byte, []int) {
-	return file_side_btcbridge_btcbridge_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use CircuitBreaker.ProtoReflect.Descriptor instead.
+func (*CircuitBreaker) Descriptor() ([]byte, []int) {
+	return file_side_btcbridge_btcbridge_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *DKGRequest) GetId() uint64 {
+func (x *CircuitBreaker) GetTripped() bool {
 	if x != nil {
-		return x.Id
+		return x.Tripped
 	}
-	return 0
+	return false
 }
 
-func (x *DKGRequest) GetParticipants() []*DKGParticipant {
+func (x *CircuitBreaker) GetHeight() int64 {
 	if x != nil {
-		return x.Participants
+		return x.Height
 	}
-	return nil
+	return 0
 }
 
-func (x *DKGRequest) GetThreshold() uint32 {
+func (x *CircuitBreaker) GetDenom() string {
 	if x != nil {
-		return x.Threshold
+		return x.Denom
 	}
-	return 0
+	return ""
 }
 
-func (x *DKGRequest) GetVaultTypes() []AssetType {
-	if x != nil {
-		return x.VaultTypes
+// OutflowUsage defines the outflow of the current rate limit window
+type OutflowUsage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// asset denom; empty for the global limit
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// outflow amount in the current window
+	Used string `protobuf:"bytes,2,opt,name=used,proto3" json:"used,omitempty"`
+	// outflow limit per window; zero means no limit
+	Limit string `protobuf:"bytes,3,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *OutflowUsage) Reset() {
+	*x = OutflowUsage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_side_btcbridge_btcbridge_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *DKGRequest) GetEnableTransfer() bool {
-	if x != nil {
-		return x.EnableTransfer
-	}
-	return false
+func (x *OutflowUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *DKGRequest) GetTargetUtxoNum() uint32 {
+func (*OutflowUsage) ProtoMessage() {}
+
+// Deprecated: Use OutflowUsage.ProtoReflect.Descriptor instead.
+func (*OutflowUsage) Descriptor() ([]byte, []int) {
+	return file_side_btcbridge_btcbridge_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *OutflowUsage) GetDenom() string {
 	if x != nil {
-		return x.TargetUtxoNum
+		return x.Denom
 	}
-	return 0
+	return ""
 }
 
-func (x *DKGRequest) GetExpiration() *timestamppb.Timestamp {
+func (x *OutflowUsage) GetUsed() string {
 	if x != nil {
-		return x.Expiration
+		return x.Used
 	}
-	return nil
+	return ""
 }
 
-func (x *DKGRequest) GetStatus() DKGRequestStatus {
+func (x *OutflowUsage) GetLimit() string {
 	if x != nil {
-		return x.Status
+		return x.Limit
 	}
-	return DKGRequestStatus_DKG_REQUEST_STATUS_UNSPECIFIED
+	return ""
 }
 
-// DKG Completion Request
-type DKGCompletionRequest struct {
+// EmergencyPause defines the emergency pause issued by the guardian
+type EmergencyPause struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// request id
+	// pause id
 	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	// sender
-	Sender string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
-	// new vaults generated by DKG
-	Vaults []string `protobuf:"bytes,3,rep,name=vaults,proto3" json:"vaults,omitempty"`
-	// consensus address of the corresponding validator
-	ConsensusAddress string `protobuf:"bytes,4,opt,name=consensus_address,json=consensusAddress,proto3" json:"consensus_address,omitempty"`
-	// hex encoded validator signature
-	Signature string `protobuf:"bytes,5,opt,name=signature,proto3" json:"signature,omitempty"`
+	// guardian who issued the pause
+	Guardian string `protobuf:"bytes,2,opt,name=guardian,proto3" json:"guardian,omitempty"`
+	// pause scope
+	Scope PauseScope `protobuf:"varint,3,opt,name=scope,proto3,enum=side.btcbridge.PauseScope" json:"scope,omitempty"`
+	// asset denom for the asset scope
+	Denom string `protobuf:"bytes,4,opt,name=denom,proto3" json:"denom,omitempty"`
+	// start time
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// expiration time
+	Expiration *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=expiration,proto3" json:"expiration,omitempty"`
+	// Indicates if the pause is lifted by governance before expiration
+	Lifted bool `protobuf:"varint,7,opt,name=lifted,proto3" json:"lifted,omitempty"`
 }
 
-func (x *DKGCompletionRequest) Reset() {
-	*x = DKGCompletionRequest{}
+func (x *EmergencyPause) Reset() {
+	*x = EmergencyPause{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_side_btcbridge_btcbridge_proto_msgTypes[12]
+		mi := &file_side_btcbridge_btcbridge_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DKGCompletionRequest) String() string {
+func (x *EmergencyPause) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DKGCompletionRequest) ProtoMessage() {}
+func (*EmergencyPause) ProtoMessage() {}
 
-// Deprecated: Use DKGCompletionRequest.ProtoReflect.Descriptor instead.
-func (*DKGCompletionRequest) Descriptor() ([]byte, []int) {
-	return file_side_btcbridge_btcbridge_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use EmergencyPause.ProtoReflect.Descriptor instead.
+func (*EmergencyPause) Descriptor() ([]byte, []int) {
+	return file_side_btcbridge_btcbridge_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *DKGCompletionRequest) GetId() uint64 {
+func (x *EmergencyPause) GetId() uint64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *DKGCompletionRequest) GetSender() string {
+func (x *EmergencyPause) GetGuardian() string {
 	if x != nil {
-		return x.Sender
+		return x.Guardian
 	}
 	return ""
 }
 
-func (x *DKGCompletionRequest) GetVaults() []string {
+func (x *EmergencyPause) GetScope() PauseScope {
 	if x != nil {
-		return x.Vaults
+		return x.Scope
 	}
-	return nil
+	return PauseScope_PAUSE_SCOPE_UNSPECIFIED
 }
 
-func (x *DKGCompletionRequest) GetConsensusAddress() string {
+func (x *EmergencyPause) GetDenom() string {
 	if x != nil {
-		return x.ConsensusAddress
+		return x.Denom
 	}
 	return ""
 }
 
-func (x *DKGCompletionRequest) GetSignature() string {
+func (x *EmergencyPause) GetStartTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Signature
+		return x.StartTime
 	}
-	return ""
+	return nil
+}
+
+func (x *EmergencyPause) GetExpiration() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Expiration
+	}
+	return nil
+}
+
+func (x *EmergencyPause) GetLifted() bool {
+	if x != nil {
+		return x.Lifted
+	}
+	return false
 }
 
 var File_side_btcbridge_btcbridge_proto protoreflect.FileDescriptor
@@ -9345,98 +22790,326 @@ var file_side_btcbridge_btcbridge_proto_rawDesc = []byte{
 	0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
 	0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x73, 0x69, 0x64, 0x65, 0x2e, 0x62, 0x74, 0x63,
 	0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x73, 0x0a, 0x0f,
-	0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f,
-	0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
-	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x12, 0x0a,
-	0x04, 0x74, 0x78, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x78, 0x69,
-	0x64, 0x22, 0xee, 0x01, 0x0a, 0x04, 0x55, 0x54, 0x58, 0x4f, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x78,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x78, 0x69, 0x64, 0x12, 0x12,
-	0x0a, 0x04, 0x76, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x76, 0x6f,
-	0x75, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06,
-	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d,
-	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x24, 0x0a, 0x0e,
-	0x70, 0x75, 0x62, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x70, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x53, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x4c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x12,
-	0x31, 0x0a, 0x05, 0x72, 0x75, 0x6e, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b,
-	0x2e, 0x73, 0x69, 0x64, 0x65, 0x2e, 0x62, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e,
-	0x52, 0x75, 0x6e, 0x65, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x05, 0x72, 0x75, 0x6e,
-	0x65, 0x73, 0x22, 0x35, 0x0a, 0x0b, 0x52, 0x75, 0x6e, 0x65, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63,
-	0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
-	0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x2e, 0x0a, 0x06, 0x52, 0x75, 0x6e,
-	0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x78, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x74, 0x78, 0x22, 0x5f, 0x0a, 0x05, 0x45, 0x64, 0x69,
-	0x63, 0x74, 0x12, 0x26, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16,
-	0x2e, 0x73, 0x69, 0x64, 0x65, 0x2e, 0x62, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e,
-	0x52, 0x75, 0x6e, 0x65, 0x49, 0x64, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d,
-	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75,
-	0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0x56, 0x0a, 0x10, 0x42, 0x74,
-	0x63, 0x43, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x29,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xc7, 0x01, 0x0a,
+	0x1a, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x41, 0x0a, 0x0a, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x21, 0x2e, 0x73, 0x69, 0x64, 0x65, 0x2e, 0x62, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65,
+	0x2e, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x0a, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14,
+	0x0a, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61,
+	0x63, 0x74, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x38, 0x0a, 0x04,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01,
+	0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x79, 0x0a, 0x15, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e,
+	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12,
+	0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x44, 0x0a, 0x07, 0x65,
+	0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x73,
+	0x69, 0x64, 0x65, 0x2e, 0x62, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x53, 0x69,
+	0x67, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65,
+	0x73, 0x22, 0x73, 0x0a, 0x0f, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16,
+	0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e,
+	0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e,
+	0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x78, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x74, 0x78, 0x69, 0x64, 0x22, 0x95, 0x02, 0x0a, 0x04, 0x55, 0x54, 0x58, 0x4f, 0x12,
+	0x12, 0x0a, 0x04, 0x74, 0x78, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74,
+	0x78, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x76, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x04, 0x76, 0x6f, 0x75, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x12, 0x24, 0x0a, 0x0e, 0x70, 0x75, 0x62, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x70, 0x75, 0x62, 0x4b, 0x65,
+	0x79, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x6c, 0x6f,
+	0x63, 0x6b, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x4c, 0x6f,
+	0x63, 0x6b, 0x65, 0x64, 0x12, 0x31, 0x0a, 0x05, 0x72, 0x75, 0x6e, 0x65, 0x73, 0x18, 0x08, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x69, 0x64, 0x65, 0x2e, 0x62, 0x74, 0x63, 0x62, 0x72,
+	0x69, 0x64, 0x67, 0x65, 0x2e, 0x52, 0x75, 0x6e, 0x65, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x52, 0x05, 0x72, 0x75, 0x6e, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x77, 0x69, 0x74, 0x6e, 0x65,
+	0x73, 0x73, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x0d, 0x77, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x22, 0x35,
+	0x0a, 0x0b, 0x52, 0x75, 0x6e, 0x65, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x2e, 0x0a, 0x06, 0x52, 0x75, 0x6e, 0x65, 0x49, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x02, 0x74, 0x78, 0x22, 0x5f, 0x0a, 0x05, 0x45, 0x64, 0x69, 0x63, 0x74, 0x12, 0x26,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x69, 0x64,
+	0x65, 0x2e, 0x62, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x52, 0x75, 0x6e, 0x65,
+	0x49, 0x64, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06,
+	0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0x8d, 0x01, 0x0a, 0x0c, 0x52, 0x75, 0x6e, 0x65, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d,
+	0x62, 0x6f, 0x6c, 0x12, 0x22, 0x0a, 0x0c, 0x64, 0x69, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c,
+	0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x64, 0x69, 0x76, 0x69, 0x73,
+	0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x74, 0x63, 0x68, 0x69,
+	0x6e, 0x67, 0x5f, 0x74, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x74, 0x63,
+	0x68, 0x69, 0x6e, 0x67, 0x54, 0x78, 0x22, 0x3f, 0x0a, 0x0d, 0x42, 0x72, 0x63, 0x32, 0x30, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x63, 0x6b, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x69, 0x63, 0x6b, 0x12, 0x1a, 0x0a, 0x08, 0x64,
+	0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x64,
+	0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x73, 0x22, 0x56, 0x0a, 0x10, 0x42, 0x74, 0x63, 0x43, 0x6f,
+	0x6e, 0x73, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x0a, 0x10, 0x74,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x54, 0x68, 0x72,
+	0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x6d, 0x61, 0x78, 0x5f, 0x6e, 0x75,
+	0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6d, 0x61, 0x78, 0x4e, 0x75, 0x6d, 0x22,
+	0x71, 0x0a, 0x12, 0x52, 0x75, 0x6e, 0x65, 0x73, 0x43, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x75, 0x6e, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x75, 0x6e, 0x65, 0x49, 0x64, 0x12, 0x29,
 	0x0a, 0x10, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f,
-	0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
 	0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x6d, 0x61, 0x78,
-	0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6d, 0x61, 0x78, 0x4e,
-	0x75, 0x6d, 0x22, 0x71, 0x0a, 0x12, 0x52, 0x75, 0x6e, 0x65, 0x73, 0x43, 0x6f, 0x6e, 0x73, 0x6f,
-	0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x75, 0x6e, 0x65,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x75, 0x6e, 0x65, 0x49,
-	0x64, 0x12, 0x29, 0x0a, 0x10, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x74, 0x68, 0x72, 0x65,
-	0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x74, 0x61, 0x72,
-	0x67, 0x65, 0x74, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x17, 0x0a, 0x07,
-	0x6d, 0x61, 0x78, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6d,
-	0x61, 0x78, 0x4e, 0x75, 0x6d, 0x22, 0x80, 0x01, 0x0a, 0x0e, 0x44, 0x4b, 0x47, 0x50, 0x61, 0x72,
-	0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x6f, 0x6e, 0x69,
-	0x6b, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x6f, 0x6e, 0x69, 0x6b,
-	0x65, 0x72, 0x12, 0x29, 0x0a, 0x10, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6f, 0x70,
-	0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x29, 0x0a,
-	0x10, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x5f, 0x70, 0x75, 0x62, 0x6b, 0x65,
-	0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73,
-	0x75, 0x73, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x22, 0x8b, 0x03, 0x0a, 0x0a, 0x44, 0x4b, 0x47,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x42, 0x0a, 0x0c, 0x70, 0x61, 0x72, 0x74, 0x69,
-	0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e,
-	0x73, 0x69, 0x64, 0x65, 0x2e, 0x62, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x44,
-	0x4b, 0x47, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x52, 0x0c, 0x70,
-	0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74,
+	0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6d, 0x61, 0x78, 0x4e,
+	0x75, 0x6d, 0x22, 0x80, 0x01, 0x0a, 0x0e, 0x44, 0x4b, 0x47, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63,
+	0x69, 0x70, 0x61, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x6f, 0x6e, 0x69, 0x6b, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x6f, 0x6e, 0x69, 0x6b, 0x65, 0x72, 0x12,
+	0x29, 0x0a, 0x10, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x6f,
+	0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x5f, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x50,
+	0x75, 0x62, 0x6b, 0x65, 0x79, 0x22, 0xd3, 0x04, 0x0a, 0x0a, 0x44, 0x4b, 0x47, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x42, 0x0a, 0x0c, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70,
+	0x61, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x69, 0x64,
+	0x65, 0x2e, 0x62, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x44, 0x4b, 0x47, 0x50,
+	0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x52, 0x0c, 0x70, 0x61, 0x72, 0x74,
+	0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x68, 0x72, 0x65,
+	0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x74, 0x68, 0x72,
+	0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x3a, 0x0a, 0x0b, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x73, 0x69,
+	0x64, 0x65, 0x2e, 0x62, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x41, 0x73, 0x73,
+	0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0a, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x54, 0x79, 0x70,
+	0x65, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x65, 0x6e, 0x61,
+	0x62, 0x6c, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x12, 0x26, 0x0a, 0x0f, 0x74,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x75, 0x74, 0x78, 0x6f, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x55, 0x74, 0x78, 0x6f,
+	0x4e, 0x75, 0x6d, 0x12, 0x44, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x01, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x0a, 0x65,
+	0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x73, 0x69, 0x64, 0x65,
+	0x2e, 0x62, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x44, 0x4b, 0x47, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x43, 0x0a, 0x0c, 0x74, 0x61, 0x70, 0x72, 0x6f, 0x6f, 0x74, 0x5f, 0x74,
+	0x72, 0x65, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x69, 0x64, 0x65,
+	0x2e, 0x62, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x56, 0x61, 0x75, 0x6c, 0x74,
+	0x54, 0x61, 0x70, 0x72, 0x6f, 0x6f, 0x74, 0x54, 0x72, 0x65, 0x65, 0x52, 0x0b, 0x74, 0x61, 0x70,
+	0x72, 0x6f, 0x6f, 0x74, 0x54, 0x72, 0x65, 0x65, 0x12, 0x45, 0x0a, 0x0e, 0x6e, 0x6f, 0x6e, 0x5f,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x65, 0x72, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1e, 0x2e, 0x73, 0x69, 0x64, 0x65, 0x2e, 0x62, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64, 0x67,
+	0x65, 0x2e, 0x44, 0x4b, 0x47, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74,
+	0x52, 0x0d, 0x6e, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x65, 0x72, 0x73, 0x12,
+	0x19, 0x0a, 0x08, 0x72, 0x65, 0x74, 0x72, 0x79, 0x5f, 0x6f, 0x66, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x07, 0x72, 0x65, 0x74, 0x72, 0x79, 0x4f, 0x66, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65,
+	0x74, 0x72, 0x79, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0a, 0x72, 0x65, 0x74, 0x72, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xaf, 0x02, 0x0a, 0x14,
+	0x44, 0x4b, 0x47, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06,
+	0x76, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x76, 0x61,
+	0x75, 0x6c, 0x74, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75,
+	0x73, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x10, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12,
+	0x19, 0x0a, 0x08, 0x70, 0x75, 0x62, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x07, 0x70, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72,
+	0x6f, 0x6f, 0x66, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x70, 0x72, 0x6f, 0x6f,
+	0x66, 0x73, 0x12, 0x59, 0x0a, 0x13, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x22, 0x2e, 0x73, 0x69, 0x64, 0x65, 0x2e, 0x62, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65,
+	0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x68, 0x61,
+	0x72, 0x65, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x12, 0x76, 0x65, 0x72, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x73, 0x22, 0x2c, 0x0a,
+	0x12, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x68, 0x61,
+	0x72, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x68, 0x61, 0x72, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x06, 0x73, 0x68, 0x61, 0x72, 0x65, 0x73, 0x22, 0x7d, 0x0a, 0x0b, 0x46,
+	0x72, 0x6f, 0x73, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x12, 0x29, 0x0a, 0x10, 0x6f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x2d, 0x0a, 0x12, 0x76,
+	0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x68, 0x61, 0x72,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x22, 0x99, 0x01, 0x0a, 0x0d, 0x46,
+	0x72, 0x6f, 0x73, 0x74, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x75,
+	0x6c, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x75, 0x62, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x74,
 	0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09,
-	0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x3a, 0x0a, 0x0b, 0x76, 0x61, 0x75,
-	0x6c, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x19,
+	0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x3b, 0x0a, 0x07, 0x73, 0x69, 0x67,
+	0x6e, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x69, 0x64,
+	0x65, 0x2e, 0x62, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x46, 0x72, 0x6f, 0x73,
+	0x74, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x07, 0x73,
+	0x69, 0x67, 0x6e, 0x65, 0x72, 0x73, 0x22, 0x48, 0x0a, 0x14, 0x46, 0x72, 0x6f, 0x73, 0x74, 0x4e,
+	0x6f, 0x6e, 0x63, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x68, 0x69, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x68, 0x69, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x22, 0xba, 0x01, 0x0a, 0x10, 0x46, 0x72, 0x6f, 0x73, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x72,
+	0x52, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f,
+	0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x4c, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73, 0x69, 0x64, 0x65, 0x2e, 0x62, 0x74, 0x63,
+	0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x46, 0x72, 0x6f, 0x73, 0x74, 0x4e, 0x6f, 0x6e, 0x63,
+	0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f,
+	0x00, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x2d,
+	0x0a, 0x12, 0x70, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x11, 0x70, 0x61, 0x72, 0x74,
+	0x69, 0x61, 0x6c, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x22, 0xdc, 0x02,
+	0x0a, 0x13, 0x46, 0x72, 0x6f, 0x73, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x53, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x78, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x78, 0x69, 0x64, 0x12, 0x3a, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x73, 0x69, 0x64, 0x65,
+	0x2e, 0x62, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x46, 0x72, 0x6f, 0x73, 0x74,
+	0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x74,
+	0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09,
+	0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x40, 0x0a, 0x07, 0x73, 0x69, 0x67,
+	0x6e, 0x65, 0x72, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x69, 0x64,
+	0x65, 0x2e, 0x62, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x46, 0x72, 0x6f, 0x73,
+	0x74, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x42, 0x04, 0xc8, 0xde,
+	0x1f, 0x00, 0x52, 0x07, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x2f, 0x0a, 0x13, 0x6d,
+	0x69, 0x73, 0x62, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x65,
+	0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x12, 0x6d, 0x69, 0x73, 0x62, 0x65, 0x68,
+	0x61, 0x76, 0x69, 0x6e, 0x67, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x4e, 0x0a, 0x10,
+	0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x0e, 0x72, 0x6f,
+	0x75, 0x6e, 0x64, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x22, 0xda, 0x02, 0x0a,
+	0x11, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e,
+	0x63, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x2c, 0x0a,
+	0x12, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x5f, 0x64, 0x6b, 0x67, 0x5f, 0x68, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x03, 0x52, 0x10, 0x6d, 0x69, 0x73, 0x73, 0x65,
+	0x64, 0x44, 0x6b, 0x67, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x12, 0x34, 0x0a, 0x16, 0x6d,
+	0x69, 0x73, 0x73, 0x65, 0x64, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x68, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x03, 0x52, 0x14, 0x6d, 0x69, 0x73,
+	0x73, 0x65, 0x64, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x73, 0x12, 0x2a, 0x0a, 0x11, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x6d, 0x69, 0x73, 0x73, 0x65,
+	0x64, 0x5f, 0x64, 0x6b, 0x67, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x4d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x44, 0x6b, 0x67, 0x73, 0x12, 0x32, 0x0a,
+	0x15, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x5f, 0x73, 0x69,
+	0x67, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x4d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67,
+	0x73, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x75, 0x6e, 0x69, 0x73, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x70, 0x75, 0x6e, 0x69, 0x73, 0x68, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x12, 0x34, 0x0a, 0x16, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x70, 0x75, 0x6e, 0x69,
+	0x73, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x14, 0x6c, 0x61, 0x73, 0x74, 0x50, 0x75, 0x6e, 0x69, 0x73, 0x68, 0x6d,
+	0x65, 0x6e, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0xe9, 0x02, 0x0a, 0x0d, 0x41, 0x73,
+	0x73, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x12, 0x38, 0x0a, 0x0a, 0x61,
+	0x73, 0x73, 0x65, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x19, 0x2e, 0x73, 0x69, 0x64, 0x65, 0x2e, 0x62, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65,
+	0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x61, 0x73, 0x73, 0x65,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f,
+	0x76, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x65, 0x73, 0x74,
+	0x5f, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x65,
+	0x73, 0x74, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x3b, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x73, 0x69, 0x64, 0x65, 0x2e, 0x62,
+	0x74, 0x63, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x5f,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f,
+	0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12,
+	0x2c, 0x0a, 0x12, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x75, 0x74, 0x78,
+	0x6f, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x72, 0x65, 0x6d,
+	0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x55, 0x74, 0x78, 0x6f, 0x4e, 0x75, 0x6d, 0x12, 0x27, 0x0a,
+	0x0f, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e,
+	0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x61, 0x73, 0x74,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xba, 0x02, 0x0a, 0x0d, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0d, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x21,
+	0x0a, 0x0c, 0x64, 0x65, 0x73, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x15, 0x0a, 0x06, 0x64, 0x6b, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x05, 0x64, 0x6b, 0x67, 0x49, 0x64, 0x12, 0x3b, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x73, 0x69, 0x64, 0x65, 0x2e,
+	0x62, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3b, 0x0a, 0x06, 0x61, 0x73, 0x73, 0x65, 0x74, 0x73, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x73, 0x69, 0x64, 0x65, 0x2e, 0x62, 0x74, 0x63,
+	0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x66, 0x65, 0x72, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x61, 0x73, 0x73, 0x65,
+	0x74, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x68, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x48,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x10, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x22, 0xa1, 0x02, 0x0a, 0x0e, 0x46, 0x65, 0x65, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x31, 0x0a, 0x06, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x73, 0x69, 0x64, 0x65, 0x2e, 0x62, 0x74, 0x63,
+	0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x46, 0x65, 0x65, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74,
+	0x52, 0x06, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x6d, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x75,
+	0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf,
+	0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x75,
+	0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x12, 0x6d, 0x0a, 0x0b, 0x64, 0x69, 0x73, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f,
+	0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x65, 0x64, 0x22, 0x58, 0x0a, 0x0e, 0x43, 0x69, 0x72, 0x63, 0x75, 0x69,
+	0x74, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x72, 0x69, 0x70,
+	0x70, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x74, 0x72, 0x69, 0x70, 0x70,
+	0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65,
+	0x6e, 0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d,
+	0x22, 0x4e, 0x0a, 0x0c, 0x4f, 0x75, 0x74, 0x66, 0x6c, 0x6f, 0x77, 0x55, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x22, 0xa7, 0x02, 0x0a, 0x0e, 0x45, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x63, 0x79, 0x50, 0x61,
+	0x75, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x67, 0x75, 0x61, 0x72, 0x64, 0x69, 0x61, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x67, 0x75, 0x61, 0x72, 0x64, 0x69, 0x61, 0x6e, 0x12,
+	0x30, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a,
 	0x2e, 0x73, 0x69, 0x64, 0x65, 0x2e, 0x62, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e,
-	0x41, 0x73, 0x73, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0a, 0x76, 0x61, 0x75, 0x6c, 0x74,
-	0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f,
-	0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e,
-	0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x12, 0x26,
-	0x0a, 0x0f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x75, 0x74, 0x78, 0x6f, 0x5f, 0x6e, 0x75,
-	0x6d, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x55,
-	0x74, 0x78, 0x6f, 0x4e, 0x75, 0x6d, 0x12, 0x44, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x01, 0x90, 0xdf, 0x1f, 0x01,
-	0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x06,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x73,
-	0x69, 0x64, 0x65, 0x2e, 0x62, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x44, 0x4b,
-	0x47, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xa1, 0x01, 0x0a, 0x14, 0x44, 0x4b, 0x47, 0x43, 0x6f,
-	0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12,
-	0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x61, 0x75, 0x6c, 0x74,
-	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x12,
-	0x2b, 0x0a, 0x11, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x5f, 0x61, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x63, 0x6f, 0x6e, 0x73,
-	0x65, 0x6e, 0x73, 0x75, 0x73, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1c, 0x0a, 0x09,
-	0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x2a, 0xa4, 0x01, 0x0a, 0x0d, 0x53,
+	0x50, 0x61, 0x75, 0x73, 0x65, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x70,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x43, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f,
+	0x01, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x44, 0x0a, 0x0a,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xc8, 0xde,
+	0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x69, 0x66, 0x74, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x06, 0x6c, 0x69, 0x66, 0x74, 0x65, 0x64, 0x2a, 0xc1, 0x01, 0x0a, 0x0d, 0x53,
 	0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1e, 0x0a, 0x1a,
 	0x53, 0x49, 0x47, 0x4e, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55,
 	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16,
@@ -9447,29 +23120,91 @@ var file_side_btcbridge_btcbridge_proto_rawDesc = []byte{
 	0x49, 0x4e, 0x47, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49,
 	0x52, 0x4d, 0x45, 0x44, 0x10, 0x03, 0x12, 0x19, 0x0a, 0x15, 0x53, 0x49, 0x47, 0x4e, 0x49, 0x4e,
 	0x47, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10,
-	0x04, 0x2a, 0xb8, 0x01, 0x0a, 0x10, 0x44, 0x4b, 0x47, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x22, 0x0a, 0x1e, 0x44, 0x4b, 0x47, 0x5f, 0x52, 0x45,
-	0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53,
-	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1e, 0x0a, 0x1a, 0x44, 0x4b,
-	0x47, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
-	0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x20, 0x0a, 0x1c, 0x44, 0x4b,
-	0x47, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
-	0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x1d, 0x0a, 0x19,
-	0x44, 0x4b, 0x47, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54,
-	0x55, 0x53, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x12, 0x1f, 0x0a, 0x1b, 0x44,
-	0x4b, 0x47, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55,
-	0x53, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x44, 0x4f, 0x55, 0x54, 0x10, 0x04, 0x42, 0x9e, 0x01, 0x0a,
-	0x12, 0x63, 0x6f, 0x6d, 0x2e, 0x73, 0x69, 0x64, 0x65, 0x2e, 0x62, 0x74, 0x63, 0x62, 0x72, 0x69,
-	0x64, 0x67, 0x65, 0x42, 0x0e, 0x42, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x50, 0x72,
-	0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x1f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b,
-	0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x73, 0x69, 0x64, 0x65, 0x2f, 0x62, 0x74, 0x63,
-	0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0xa2, 0x02, 0x03, 0x53, 0x42, 0x58, 0xaa, 0x02, 0x0e, 0x53,
-	0x69, 0x64, 0x65, 0x2e, 0x42, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0xca, 0x02, 0x0e,
-	0x53, 0x69, 0x64, 0x65, 0x5c, 0x42, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0xe2, 0x02,
-	0x1a, 0x53, 0x69, 0x64, 0x65, 0x5c, 0x42, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x5c,
-	0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0f, 0x53, 0x69,
-	0x64, 0x65, 0x3a, 0x3a, 0x42, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x04, 0x12, 0x1b, 0x0a, 0x17, 0x53, 0x49, 0x47, 0x4e, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x55, 0x53, 0x5f, 0x52, 0x45, 0x50, 0x4c, 0x41, 0x43, 0x45, 0x44, 0x10, 0x05, 0x2a, 0x8a,
+	0x02, 0x0a, 0x11, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x1e, 0x53, 0x49, 0x47, 0x4e, 0x49, 0x4e, 0x47, 0x5f,
+	0x54, 0x52, 0x41, 0x4e, 0x53, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1e, 0x0a, 0x1a, 0x53, 0x49, 0x47, 0x4e,
+	0x49, 0x4e, 0x47, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x43,
+	0x52, 0x45, 0x41, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x2b, 0x0a, 0x27, 0x53, 0x49, 0x47, 0x4e,
+	0x49, 0x4e, 0x47, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53,
+	0x49, 0x47, 0x4e, 0x41, 0x54, 0x55, 0x52, 0x45, 0x53, 0x5f, 0x53, 0x55, 0x42, 0x4d, 0x49, 0x54,
+	0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x22, 0x0a, 0x1e, 0x53, 0x49, 0x47, 0x4e, 0x49, 0x4e, 0x47,
+	0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x42, 0x52, 0x4f, 0x41,
+	0x44, 0x43, 0x41, 0x53, 0x54, 0x45, 0x44, 0x10, 0x03, 0x12, 0x20, 0x0a, 0x1c, 0x53, 0x49, 0x47,
+	0x4e, 0x49, 0x4e, 0x47, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x43, 0x4f, 0x4e, 0x46, 0x49, 0x52, 0x4d, 0x45, 0x44, 0x10, 0x04, 0x12, 0x1d, 0x0a, 0x19, 0x53,
+	0x49, 0x47, 0x4e, 0x49, 0x4e, 0x47, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x49, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x05, 0x12, 0x1f, 0x0a, 0x1b, 0x53, 0x49,
+	0x47, 0x4e, 0x49, 0x4e, 0x47, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x49, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x52, 0x45, 0x50, 0x4c, 0x41, 0x43, 0x45, 0x44, 0x10, 0x06, 0x2a, 0xb8, 0x01, 0x0a, 0x10,
+	0x44, 0x4b, 0x47, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x22, 0x0a, 0x1e, 0x44, 0x4b, 0x47, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x1e, 0x0a, 0x1a, 0x44, 0x4b, 0x47, 0x5f, 0x52, 0x45, 0x51, 0x55,
+	0x45, 0x53, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49,
+	0x4e, 0x47, 0x10, 0x01, 0x12, 0x20, 0x0a, 0x1c, 0x44, 0x4b, 0x47, 0x5f, 0x52, 0x45, 0x51, 0x55,
+	0x45, 0x53, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x4c,
+	0x45, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x1d, 0x0a, 0x19, 0x44, 0x4b, 0x47, 0x5f, 0x52, 0x45,
+	0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x46, 0x41, 0x49,
+	0x4c, 0x45, 0x44, 0x10, 0x03, 0x12, 0x1f, 0x0a, 0x1b, 0x44, 0x4b, 0x47, 0x5f, 0x52, 0x45, 0x51,
+	0x55, 0x45, 0x53, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x54, 0x49, 0x4d, 0x45,
+	0x44, 0x4f, 0x55, 0x54, 0x10, 0x04, 0x2a, 0xc6, 0x01, 0x0a, 0x12, 0x46, 0x72, 0x6f, 0x73, 0x74,
+	0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x24, 0x0a,
+	0x20, 0x46, 0x52, 0x4f, 0x53, 0x54, 0x5f, 0x53, 0x49, 0x47, 0x4e, 0x49, 0x4e, 0x47, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x23, 0x0a, 0x1f, 0x46, 0x52, 0x4f, 0x53, 0x54, 0x5f, 0x53, 0x49, 0x47,
+	0x4e, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x4f, 0x4d, 0x4d,
+	0x49, 0x54, 0x4d, 0x45, 0x4e, 0x54, 0x10, 0x01, 0x12, 0x20, 0x0a, 0x1c, 0x46, 0x52, 0x4f, 0x53,
+	0x54, 0x5f, 0x53, 0x49, 0x47, 0x4e, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
+	0x5f, 0x53, 0x49, 0x47, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x22, 0x0a, 0x1e, 0x46, 0x52,
+	0x4f, 0x53, 0x54, 0x5f, 0x53, 0x49, 0x47, 0x4e, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x55, 0x53, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x10, 0x03, 0x12, 0x1f,
+	0x0a, 0x1b, 0x46, 0x52, 0x4f, 0x53, 0x54, 0x5f, 0x53, 0x49, 0x47, 0x4e, 0x49, 0x4e, 0x47, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x04, 0x2a,
+	0xab, 0x01, 0x0a, 0x13, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
+	0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x25, 0x0a, 0x21, 0x56, 0x41, 0x55, 0x4c, 0x54,
+	0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x21,
+	0x0a, 0x1d, 0x56, 0x41, 0x55, 0x4c, 0x54, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x45, 0x52,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10,
+	0x01, 0x12, 0x25, 0x0a, 0x21, 0x56, 0x41, 0x55, 0x4c, 0x54, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53,
+	0x46, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x49, 0x4e, 0x5f, 0x50, 0x52,
+	0x4f, 0x47, 0x52, 0x45, 0x53, 0x53, 0x10, 0x02, 0x12, 0x23, 0x0a, 0x1f, 0x56, 0x41, 0x55, 0x4c,
+	0x54, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55,
+	0x53, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x10, 0x03, 0x2a, 0xb3, 0x01,
+	0x0a, 0x09, 0x46, 0x65, 0x65, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x1a, 0x0a, 0x16, 0x46,
+	0x45, 0x45, 0x5f, 0x42, 0x55, 0x43, 0x4b, 0x45, 0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1d, 0x0a, 0x19, 0x46, 0x45, 0x45, 0x5f, 0x42,
+	0x55, 0x43, 0x4b, 0x45, 0x54, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x55, 0x4e, 0x49, 0x54, 0x59, 0x5f,
+	0x50, 0x4f, 0x4f, 0x4c, 0x10, 0x01, 0x12, 0x1b, 0x0a, 0x17, 0x46, 0x45, 0x45, 0x5f, 0x42, 0x55,
+	0x43, 0x4b, 0x45, 0x54, 0x5f, 0x52, 0x45, 0x4c, 0x41, 0x59, 0x45, 0x52, 0x5f, 0x50, 0x4f, 0x4f,
+	0x4c, 0x10, 0x02, 0x12, 0x1a, 0x0a, 0x16, 0x46, 0x45, 0x45, 0x5f, 0x42, 0x55, 0x43, 0x4b, 0x45,
+	0x54, 0x5f, 0x53, 0x49, 0x47, 0x4e, 0x45, 0x52, 0x5f, 0x50, 0x4f, 0x4f, 0x4c, 0x10, 0x03, 0x12,
+	0x18, 0x0a, 0x14, 0x46, 0x45, 0x45, 0x5f, 0x42, 0x55, 0x43, 0x4b, 0x45, 0x54, 0x5f, 0x49, 0x4e,
+	0x53, 0x55, 0x52, 0x41, 0x4e, 0x43, 0x45, 0x10, 0x04, 0x12, 0x18, 0x0a, 0x14, 0x46, 0x45, 0x45,
+	0x5f, 0x42, 0x55, 0x43, 0x4b, 0x45, 0x54, 0x5f, 0x43, 0x4f, 0x4c, 0x4c, 0x45, 0x43, 0x54, 0x4f,
+	0x52, 0x10, 0x05, 0x2a, 0x8c, 0x01, 0x0a, 0x0a, 0x50, 0x61, 0x75, 0x73, 0x65, 0x53, 0x63, 0x6f,
+	0x70, 0x65, 0x12, 0x1b, 0x0a, 0x17, 0x50, 0x41, 0x55, 0x53, 0x45, 0x5f, 0x53, 0x43, 0x4f, 0x50,
+	0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x17, 0x0a, 0x13, 0x50, 0x41, 0x55, 0x53, 0x45, 0x5f, 0x53, 0x43, 0x4f, 0x50, 0x45, 0x5f, 0x44,
+	0x45, 0x50, 0x4f, 0x53, 0x49, 0x54, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x50, 0x41, 0x55, 0x53,
+	0x45, 0x5f, 0x53, 0x43, 0x4f, 0x50, 0x45, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x44, 0x52, 0x41, 0x57,
+	0x10, 0x02, 0x12, 0x17, 0x0a, 0x13, 0x50, 0x41, 0x55, 0x53, 0x45, 0x5f, 0x53, 0x43, 0x4f, 0x50,
+	0x45, 0x5f, 0x53, 0x49, 0x47, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x03, 0x12, 0x15, 0x0a, 0x11, 0x50,
+	0x41, 0x55, 0x53, 0x45, 0x5f, 0x53, 0x43, 0x4f, 0x50, 0x45, 0x5f, 0x41, 0x53, 0x53, 0x45, 0x54,
+	0x10, 0x04, 0x42, 0x9e, 0x01, 0x0a, 0x12, 0x63, 0x6f, 0x6d, 0x2e, 0x73, 0x69, 0x64, 0x65, 0x2e,
+	0x62, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x42, 0x0e, 0x42, 0x74, 0x63, 0x62, 0x72,
+	0x69, 0x64, 0x67, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x1f, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x73, 0x69,
+	0x64, 0x65, 0x2f, 0x62, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0xa2, 0x02, 0x03, 0x53,
+	0x42, 0x58, 0xaa, 0x02, 0x0e, 0x53, 0x69, 0x64, 0x65, 0x2e, 0x42, 0x74, 0x63, 0x62, 0x72, 0x69,
+	0x64, 0x67, 0x65, 0xca, 0x02, 0x0e, 0x53, 0x69, 0x64, 0x65, 0x5c, 0x42, 0x74, 0x63, 0x62, 0x72,
+	0x69, 0x64, 0x67, 0x65, 0xe2, 0x02, 0x1a, 0x53, 0x69, 0x64, 0x65, 0x5c, 0x42, 0x74, 0x63, 0x62,
+	0x72, 0x69, 0x64, 0x67, 0x65, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0xea, 0x02, 0x0f, 0x53, 0x69, 0x64, 0x65, 0x3a, 0x3a, 0x42, 0x74, 0x63, 0x62, 0x72, 0x69,
+	0x64, 0x67, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -9484,42 +23219,87 @@ func file_side_btcbridge_btcbridge_proto_rawDescGZIP() []byte {
 	return file_side_btcbridge_btcbridge_proto_rawDescData
 }
 
-var file_side_btcbridge_btcbridge_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_side_btcbridge_btcbridge_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_side_btcbridge_btcbridge_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
+var file_side_btcbridge_btcbridge_proto_msgTypes = make([]protoimpl.MessageInfo, 30)
 var file_side_btcbridge_btcbridge_proto_goTypes = []interface{}{
-	(SigningStatus)(0),            // 0: side.btcbridge.SigningStatus
-	(DKGRequestStatus)(0),         // 1: side.btcbridge.DKGRequestStatus
-	(*BlockHeader)(nil),           // 2: side.btcbridge.BlockHeader
-	(*FeeRate)(nil),               // 3: side.btcbridge.FeeRate
-	(*SigningRequest)(nil),        // 4: side.btcbridge.SigningRequest
-	(*WithdrawRequest)(nil),       // 5: side.btcbridge.WithdrawRequest
-	(*UTXO)(nil),                  // 6: side.btcbridge.UTXO
-	(*RuneBalance)(nil),           // 7: side.btcbridge.RuneBalance
-	(*RuneId)(nil),                // 8: side.btcbridge.RuneId
-	(*Edict)(nil),                 // 9: side.btcbridge.Edict
-	(*BtcConsolidation)(nil),      // 10: side.btcbridge.BtcConsolidation
-	(*RunesConsolidation)(nil),    // 11: side.btcbridge.RunesConsolidation
-	(*DKGParticipant)(nil),        // 12: side.btcbridge.DKGParticipant
-	(*DKGRequest)(nil),            // 13: side.btcbridge.DKGRequest
-	(*DKGCompletionRequest)(nil),  // 14: side.btcbridge.DKGCompletionRequest
-	(AssetType)(0),                // 15: side.btcbridge.AssetType
-	(*timestamppb.Timestamp)(nil), // 16: google.protobuf.Timestamp
+	(SigningStatus)(0),                 // 0: side.btcbridge.SigningStatus
+	(SigningTransition)(0),             // 1: side.btcbridge.SigningTransition
+	(DKGRequestStatus)(0),              // 2: side.btcbridge.DKGRequestStatus
+	(FrostSigningStatus)(0),            // 3: side.btcbridge.FrostSigningStatus
+	(VaultTransferStatus)(0),           // 4: side.btcbridge.VaultTransferStatus
+	(FeeBucket)(0),                     // 5: side.btcbridge.FeeBucket
+	(PauseScope)(0),                    // 6: side.btcbridge.PauseScope
+	(*BlockHeader)(nil),                // 7: side.btcbridge.BlockHeader
+	(*FeeRate)(nil),                    // 8: side.btcbridge.FeeRate
+	(*SigningRequest)(nil),             // 9: side.btcbridge.SigningRequest
+	(*SigningRequestHistoryEntry)(nil), // 10: side.btcbridge.SigningRequestHistoryEntry
+	(*SigningRequestHistory)(nil),      // 11: side.btcbridge.SigningRequestHistory
+	(*WithdrawRequest)(nil),            // 12: side.btcbridge.WithdrawRequest
+	(*UTXO)(nil),                       // 13: side.btcbridge.UTXO
+	(*RuneBalance)(nil),                // 14: side.btcbridge.RuneBalance
+	(*RuneId)(nil),                     // 15: side.btcbridge.RuneId
+	(*Edict)(nil),                      // 16: side.btcbridge.Edict
+	(*RuneMetadata)(nil),               // 17: side.btcbridge.RuneMetadata
+	(*Brc20Metadata)(nil),              // 18: side.btcbridge.Brc20Metadata
+	(*BtcConsolidation)(nil),           // 19: side.btcbridge.BtcConsolidation
+	(*RunesConsolidation)(nil),         // 20: side.btcbridge.RunesConsolidation
+	(*DKGParticipant)(nil),             // 21: side.btcbridge.DKGParticipant
+	(*DKGRequest)(nil),                 // 22: side.btcbridge.DKGRequest
+	(*DKGCompletionRequest)(nil),       // 23: side.btcbridge.DKGCompletionRequest
+	(*VerificationShares)(nil),         // 24: side.btcbridge.VerificationShares
+	(*FrostSigner)(nil),                // 25: side.btcbridge.FrostSigner
+	(*FrostVaultKey)(nil),              // 26: side.btcbridge.FrostVaultKey
+	(*FrostNonceCommitment)(nil),       // 27: side.btcbridge.FrostNonceCommitment
+	(*FrostSignerRound)(nil),           // 28: side.btcbridge.FrostSignerRound
+	(*FrostSigningSession)(nil),        // 29: side.btcbridge.FrostSigningSession
+	(*SignerPerformance)(nil),          // 30: side.btcbridge.SignerPerformance
+	(*AssetTransfer)(nil),              // 31: side.btcbridge.AssetTransfer
+	(*VaultTransfer)(nil),              // 32: side.btcbridge.VaultTransfer
+	(*FeeBucketStats)(nil),             // 33: side.btcbridge.FeeBucketStats
+	(*CircuitBreaker)(nil),             // 34: side.btcbridge.CircuitBreaker
+	(*OutflowUsage)(nil),               // 35: side.btcbridge.OutflowUsage
+	(*EmergencyPause)(nil),             // 36: side.btcbridge.EmergencyPause
+	(AssetType)(0),                     // 37: side.btcbridge.AssetType
+	(*timestamppb.Timestamp)(nil),      // 38: google.protobuf.Timestamp
+	(*VaultTaprootTree)(nil),           // 39: side.btcbridge.VaultTaprootTree
+	(*v1beta1.Coin)(nil),               // 40: cosmos.base.v1beta1.Coin
 }
 var file_side_btcbridge_btcbridge_proto_depIdxs = []int32{
-	15, // 0: side.btcbridge.SigningRequest.type:type_name -> side.btcbridge.AssetType
-	16, // 1: side.btcbridge.SigningRequest.creation_time:type_name -> google.protobuf.Timestamp
+	37, // 0: side.btcbridge.SigningRequest.type:type_name -> side.btcbridge.AssetType
+	38, // 1: side.btcbridge.SigningRequest.creation_time:type_name -> google.protobuf.Timestamp
 	0,  // 2: side.btcbridge.SigningRequest.status:type_name -> side.btcbridge.SigningStatus
-	7,  // 3: side.btcbridge.UTXO.runes:type_name -> side.btcbridge.RuneBalance
-	8,  // 4: side.btcbridge.Edict.id:type_name -> side.btcbridge.RuneId
-	12, // 5: side.btcbridge.DKGRequest.participants:type_name -> side.btcbridge.DKGParticipant
-	15, // 6: side.btcbridge.DKGRequest.vault_types:type_name -> side.btcbridge.AssetType
-	16, // 7: side.btcbridge.DKGRequest.expiration:type_name -> google.protobuf.Timestamp
-	1,  // 8: side.btcbridge.DKGRequest.status:type_name -> side.btcbridge.DKGRequestStatus
-	9,  // [9:9] is the sub-list for method output_type
-	9,  // [9:9] is the sub-list for method input_type
-	9,  // [9:9] is the sub-list for extension type_name
-	9,  // [9:9] is the sub-list for extension extendee
-	0,  // [0:9] is the sub-list for field type_name
+	1,  // 3: side.btcbridge.SigningRequestHistoryEntry.transition:type_name -> side.btcbridge.SigningTransition
+	38, // 4: side.btcbridge.SigningRequestHistoryEntry.time:type_name -> google.protobuf.Timestamp
+	10, // 5: side.btcbridge.SigningRequestHistory.entries:type_name -> side.btcbridge.SigningRequestHistoryEntry
+	14, // 6: side.btcbridge.UTXO.runes:type_name -> side.btcbridge.RuneBalance
+	15, // 7: side.btcbridge.Edict.id:type_name -> side.btcbridge.RuneId
+	21, // 8: side.btcbridge.DKGRequest.participants:type_name -> side.btcbridge.DKGParticipant
+	37, // 9: side.btcbridge.DKGRequest.vault_types:type_name -> side.btcbridge.AssetType
+	38, // 10: side.btcbridge.DKGRequest.expiration:type_name -> google.protobuf.Timestamp
+	2,  // 11: side.btcbridge.DKGRequest.status:type_name -> side.btcbridge.DKGRequestStatus
+	39, // 12: side.btcbridge.DKGRequest.taproot_tree:type_name -> side.btcbridge.VaultTaprootTree
+	21, // 13: side.btcbridge.DKGRequest.non_responders:type_name -> side.btcbridge.DKGParticipant
+	24, // 14: side.btcbridge.DKGCompletionRequest.verification_shares:type_name -> side.btcbridge.VerificationShares
+	25, // 15: side.btcbridge.FrostVaultKey.signers:type_name -> side.btcbridge.FrostSigner
+	27, // 16: side.btcbridge.FrostSignerRound.commitments:type_name -> side.btcbridge.FrostNonceCommitment
+	3,  // 17: side.btcbridge.FrostSigningSession.status:type_name -> side.btcbridge.FrostSigningStatus
+	28, // 18: side.btcbridge.FrostSigningSession.signers:type_name -> side.btcbridge.FrostSignerRound
+	38, // 19: side.btcbridge.FrostSigningSession.round_start_time:type_name -> google.protobuf.Timestamp
+	37, // 20: side.btcbridge.AssetTransfer.asset_type:type_name -> side.btcbridge.AssetType
+	4,  // 21: side.btcbridge.AssetTransfer.status:type_name -> side.btcbridge.VaultTransferStatus
+	4,  // 22: side.btcbridge.VaultTransfer.status:type_name -> side.btcbridge.VaultTransferStatus
+	31, // 23: side.btcbridge.VaultTransfer.assets:type_name -> side.btcbridge.AssetTransfer
+	5,  // 24: side.btcbridge.FeeBucketStats.bucket:type_name -> side.btcbridge.FeeBucket
+	40, // 25: side.btcbridge.FeeBucketStats.accumulated:type_name -> cosmos.base.v1beta1.Coin
+	40, // 26: side.btcbridge.FeeBucketStats.distributed:type_name -> cosmos.base.v1beta1.Coin
+	6,  // 27: side.btcbridge.EmergencyPause.scope:type_name -> side.btcbridge.PauseScope
+	38, // 28: side.btcbridge.EmergencyPause.start_time:type_name -> google.protobuf.Timestamp
+	38, // 29: side.btcbridge.EmergencyPause.expiration:type_name -> google.protobuf.Timestamp
+	30, // [30:30] is the sub-list for method output_type
+	30, // [30:30] is the sub-list for method input_type
+	30, // [30:30] is the sub-list for extension type_name
+	30, // [30:30] is the sub-list for extension extendee
+	0,  // [0:30] is the sub-list for field type_name
 }
 
 func init() { file_side_btcbridge_btcbridge_proto_init() }
@@ -9566,7 +23346,7 @@ func file_side_btcbridge_btcbridge_proto_init() {
 			}
 		}
 		file_side_btcbridge_btcbridge_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WithdrawRequest); i {
+			switch v := v.(*SigningRequestHistoryEntry); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9578,7 +23358,7 @@ func file_side_btcbridge_btcbridge_proto_init() {
 			}
 		}
 		file_side_btcbridge_btcbridge_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UTXO); i {
+			switch v := v.(*SigningRequestHistory); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9590,7 +23370,7 @@ func file_side_btcbridge_btcbridge_proto_init() {
 			}
 		}
 		file_side_btcbridge_btcbridge_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RuneBalance); i {
+			switch v := v.(*WithdrawRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9602,7 +23382,7 @@ func file_side_btcbridge_btcbridge_proto_init() {
 			}
 		}
 		file_side_btcbridge_btcbridge_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RuneId); i {
+			switch v := v.(*UTXO); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9614,7 +23394,7 @@ func file_side_btcbridge_btcbridge_proto_init() {
 			}
 		}
 		file_side_btcbridge_btcbridge_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Edict); i {
+			switch v := v.(*RuneBalance); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9626,7 +23406,7 @@ func file_side_btcbridge_btcbridge_proto_init() {
 			}
 		}
 		file_side_btcbridge_btcbridge_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BtcConsolidation); i {
+			switch v := v.(*RuneId); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9638,7 +23418,7 @@ func file_side_btcbridge_btcbridge_proto_init() {
 			}
 		}
 		file_side_btcbridge_btcbridge_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RunesConsolidation); i {
+			switch v := v.(*Edict); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9650,7 +23430,7 @@ func file_side_btcbridge_btcbridge_proto_init() {
 			}
 		}
 		file_side_btcbridge_btcbridge_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DKGParticipant); i {
+			switch v := v.(*RuneMetadata); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9662,7 +23442,7 @@ func file_side_btcbridge_btcbridge_proto_init() {
 			}
 		}
 		file_side_btcbridge_btcbridge_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DKGRequest); i {
+			switch v := v.(*Brc20Metadata); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9674,6 +23454,54 @@ func file_side_btcbridge_btcbridge_proto_init() {
 			}
 		}
 		file_side_btcbridge_btcbridge_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BtcConsolidation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_side_btcbridge_btcbridge_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RunesConsolidation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_side_btcbridge_btcbridge_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DKGParticipant); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_side_btcbridge_btcbridge_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DKGRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_side_btcbridge_btcbridge_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DKGCompletionRequest); i {
 			case 0:
 				return &v.state
@@ -9685,14 +23513,170 @@ func file_side_btcbridge_btcbridge_proto_init() {
 				return nil
 			}
 		}
+		file_side_btcbridge_btcbridge_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerificationShares); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_side_btcbridge_btcbridge_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FrostSigner); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_side_btcbridge_btcbridge_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FrostVaultKey); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_side_btcbridge_btcbridge_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FrostNonceCommitment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_side_btcbridge_btcbridge_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FrostSignerRound); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_side_btcbridge_btcbridge_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FrostSigningSession); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_side_btcbridge_btcbridge_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignerPerformance); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_side_btcbridge_btcbridge_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AssetTransfer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_side_btcbridge_btcbridge_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VaultTransfer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_side_btcbridge_btcbridge_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FeeBucketStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_side_btcbridge_btcbridge_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CircuitBreaker); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_side_btcbridge_btcbridge_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OutflowUsage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_side_btcbridge_btcbridge_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EmergencyPause); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_side_btcbridge_btcbridge_proto_rawDesc,
-			NumEnums:      2,
-			NumMessages:   13,
+			NumEnums:      7,
+			NumMessages:   30,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
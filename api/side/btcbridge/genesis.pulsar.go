@@ -115,13 +115,273 @@ func (x *_GenesisState_4_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_GenesisState_6_list)(nil)
+
+type _GenesisState_6_list struct {
+	list *[]*RuneMetadata
+}
+
+func (x *_GenesisState_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*RuneMetadata)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*RuneMetadata)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_6_list) AppendMutable() protoreflect.Value {
+	v := new(RuneMetadata)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_6_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_6_list) NewElement() protoreflect.Value {
+	v := new(RuneMetadata)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_6_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_7_list)(nil)
+
+type _GenesisState_7_list struct {
+	list *[]*FrostVaultKey
+}
+
+func (x *_GenesisState_7_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_7_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_7_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*FrostVaultKey)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_7_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*FrostVaultKey)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_7_list) AppendMutable() protoreflect.Value {
+	v := new(FrostVaultKey)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_7_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_7_list) NewElement() protoreflect.Value {
+	v := new(FrostVaultKey)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_7_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_8_list)(nil)
+
+type _GenesisState_8_list struct {
+	list *[]*VaultTransfer
+}
+
+func (x *_GenesisState_8_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_8_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_8_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*VaultTransfer)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_8_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*VaultTransfer)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_8_list) AppendMutable() protoreflect.Value {
+	v := new(VaultTransfer)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_8_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_8_list) NewElement() protoreflect.Value {
+	v := new(VaultTransfer)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_8_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_9_list)(nil)
+
+type _GenesisState_9_list struct {
+	list *[]*SigningRequestHistory
+}
+
+func (x *_GenesisState_9_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_9_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_9_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SigningRequestHistory)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_9_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SigningRequestHistory)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_9_list) AppendMutable() protoreflect.Value {
+	v := new(SigningRequestHistory)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_9_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_9_list) NewElement() protoreflect.Value {
+	v := new(SigningRequestHistory)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_9_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_10_list)(nil)
+
+type _GenesisState_10_list struct {
+	list *[]*Brc20Metadata
+}
+
+func (x *_GenesisState_10_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_10_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_10_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Brc20Metadata)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_10_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Brc20Metadata)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_10_list) AppendMutable() protoreflect.Value {
+	v := new(Brc20Metadata)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_10_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_10_list) NewElement() protoreflect.Value {
+	v := new(Brc20Metadata)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_10_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_GenesisState                   protoreflect.MessageDescriptor
-	fd_GenesisState_params            protoreflect.FieldDescriptor
-	fd_GenesisState_best_block_header protoreflect.FieldDescriptor
-	fd_GenesisState_block_headers     protoreflect.FieldDescriptor
-	fd_GenesisState_utxos             protoreflect.FieldDescriptor
-	fd_GenesisState_dkg_request       protoreflect.FieldDescriptor
+	md_GenesisState                           protoreflect.MessageDescriptor
+	fd_GenesisState_params                    protoreflect.FieldDescriptor
+	fd_GenesisState_best_block_header         protoreflect.FieldDescriptor
+	fd_GenesisState_block_headers             protoreflect.FieldDescriptor
+	fd_GenesisState_utxos                     protoreflect.FieldDescriptor
+	fd_GenesisState_dkg_request               protoreflect.FieldDescriptor
+	fd_GenesisState_runes                     protoreflect.FieldDescriptor
+	fd_GenesisState_frost_vault_keys          protoreflect.FieldDescriptor
+	fd_GenesisState_vault_transfers           protoreflect.FieldDescriptor
+	fd_GenesisState_signing_request_histories protoreflect.FieldDescriptor
+	fd_GenesisState_brc20_tickers             protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -132,6 +392,11 @@ func init() {
 	fd_GenesisState_block_headers = md_GenesisState.Fields().ByName("block_headers")
 	fd_GenesisState_utxos = md_GenesisState.Fields().ByName("utxos")
 	fd_GenesisState_dkg_request = md_GenesisState.Fields().ByName("dkg_request")
+	fd_GenesisState_runes = md_GenesisState.Fields().ByName("runes")
+	fd_GenesisState_frost_vault_keys = md_GenesisState.Fields().ByName("frost_vault_keys")
+	fd_GenesisState_vault_transfers = md_GenesisState.Fields().ByName("vault_transfers")
+	fd_GenesisState_signing_request_histories = md_GenesisState.Fields().ByName("signing_request_histories")
+	fd_GenesisState_brc20_tickers = md_GenesisState.Fields().ByName("brc20_tickers")
 }
 
 var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
@@ -229,6 +494,36 @@ func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor,
 			return
 		}
 	}
+	if len(x.Runes) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_6_list{list: &x.Runes})
+		if !f(fd_GenesisState_runes, value) {
+			return
+		}
+	}
+	if len(x.FrostVaultKeys) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_7_list{list: &x.FrostVaultKeys})
+		if !f(fd_GenesisState_frost_vault_keys, value) {
+			return
+		}
+	}
+	if len(x.VaultTransfers) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_8_list{list: &x.VaultTransfers})
+		if !f(fd_GenesisState_vault_transfers, value) {
+			return
+		}
+	}
+	if len(x.SigningRequestHistories) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_9_list{list: &x.SigningRequestHistories})
+		if !f(fd_GenesisState_signing_request_histories, value) {
+			return
+		}
+	}
+	if len(x.Brc20Tickers) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_10_list{list: &x.Brc20Tickers})
+		if !f(fd_GenesisState_brc20_tickers, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -254,6 +549,16 @@ func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool
 		return len(x.Utxos) != 0
 	case "side.btcbridge.GenesisState.dkg_request":
 		return x.DkgRequest != nil
+	case "side.btcbridge.GenesisState.runes":
+		return len(x.Runes) != 0
+	case "side.btcbridge.GenesisState.frost_vault_keys":
+		return len(x.FrostVaultKeys) != 0
+	case "side.btcbridge.GenesisState.vault_transfers":
+		return len(x.VaultTransfers) != 0
+	case "side.btcbridge.GenesisState.signing_request_histories":
+		return len(x.SigningRequestHistories) != 0
+	case "side.btcbridge.GenesisState.brc20_tickers":
+		return len(x.Brc20Tickers) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.GenesisState"))
@@ -280,6 +585,16 @@ func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
 		x.Utxos = nil
 	case "side.btcbridge.GenesisState.dkg_request":
 		x.DkgRequest = nil
+	case "side.btcbridge.GenesisState.runes":
+		x.Runes = nil
+	case "side.btcbridge.GenesisState.frost_vault_keys":
+		x.FrostVaultKeys = nil
+	case "side.btcbridge.GenesisState.vault_transfers":
+		x.VaultTransfers = nil
+	case "side.btcbridge.GenesisState.signing_request_histories":
+		x.SigningRequestHistories = nil
+	case "side.btcbridge.GenesisState.brc20_tickers":
+		x.Brc20Tickers = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.GenesisState"))
@@ -317,6 +632,36 @@ func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescripto
 	case "side.btcbridge.GenesisState.dkg_request":
 		value := x.DkgRequest
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "side.btcbridge.GenesisState.runes":
+		if len(x.Runes) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_6_list{})
+		}
+		listValue := &_GenesisState_6_list{list: &x.Runes}
+		return protoreflect.ValueOfList(listValue)
+	case "side.btcbridge.GenesisState.frost_vault_keys":
+		if len(x.FrostVaultKeys) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_7_list{})
+		}
+		listValue := &_GenesisState_7_list{list: &x.FrostVaultKeys}
+		return protoreflect.ValueOfList(listValue)
+	case "side.btcbridge.GenesisState.vault_transfers":
+		if len(x.VaultTransfers) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_8_list{})
+		}
+		listValue := &_GenesisState_8_list{list: &x.VaultTransfers}
+		return protoreflect.ValueOfList(listValue)
+	case "side.btcbridge.GenesisState.signing_request_histories":
+		if len(x.SigningRequestHistories) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_9_list{})
+		}
+		listValue := &_GenesisState_9_list{list: &x.SigningRequestHistories}
+		return protoreflect.ValueOfList(listValue)
+	case "side.btcbridge.GenesisState.brc20_tickers":
+		if len(x.Brc20Tickers) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_10_list{})
+		}
+		listValue := &_GenesisState_10_list{list: &x.Brc20Tickers}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.GenesisState"))
@@ -351,6 +696,26 @@ func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value
 		x.Utxos = *clv.list
 	case "side.btcbridge.GenesisState.dkg_request":
 		x.DkgRequest = value.Message().Interface().(*DKGRequest)
+	case "side.btcbridge.GenesisState.runes":
+		lv := value.List()
+		clv := lv.(*_GenesisState_6_list)
+		x.Runes = *clv.list
+	case "side.btcbridge.GenesisState.frost_vault_keys":
+		lv := value.List()
+		clv := lv.(*_GenesisState_7_list)
+		x.FrostVaultKeys = *clv.list
+	case "side.btcbridge.GenesisState.vault_transfers":
+		lv := value.List()
+		clv := lv.(*_GenesisState_8_list)
+		x.VaultTransfers = *clv.list
+	case "side.btcbridge.GenesisState.signing_request_histories":
+		lv := value.List()
+		clv := lv.(*_GenesisState_9_list)
+		x.SigningRequestHistories = *clv.list
+	case "side.btcbridge.GenesisState.brc20_tickers":
+		lv := value.List()
+		clv := lv.(*_GenesisState_10_list)
+		x.Brc20Tickers = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.GenesisState"))
@@ -398,6 +763,36 @@ func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) p
 			x.DkgRequest = new(DKGRequest)
 		}
 		return protoreflect.ValueOfMessage(x.DkgRequest.ProtoReflect())
+	case "side.btcbridge.GenesisState.runes":
+		if x.Runes == nil {
+			x.Runes = []*RuneMetadata{}
+		}
+		value := &_GenesisState_6_list{list: &x.Runes}
+		return protoreflect.ValueOfList(value)
+	case "side.btcbridge.GenesisState.frost_vault_keys":
+		if x.FrostVaultKeys == nil {
+			x.FrostVaultKeys = []*FrostVaultKey{}
+		}
+		value := &_GenesisState_7_list{list: &x.FrostVaultKeys}
+		return protoreflect.ValueOfList(value)
+	case "side.btcbridge.GenesisState.vault_transfers":
+		if x.VaultTransfers == nil {
+			x.VaultTransfers = []*VaultTransfer{}
+		}
+		value := &_GenesisState_8_list{list: &x.VaultTransfers}
+		return protoreflect.ValueOfList(value)
+	case "side.btcbridge.GenesisState.signing_request_histories":
+		if x.SigningRequestHistories == nil {
+			x.SigningRequestHistories = []*SigningRequestHistory{}
+		}
+		value := &_GenesisState_9_list{list: &x.SigningRequestHistories}
+		return protoreflect.ValueOfList(value)
+	case "side.btcbridge.GenesisState.brc20_tickers":
+		if x.Brc20Tickers == nil {
+			x.Brc20Tickers = []*Brc20Metadata{}
+		}
+		value := &_GenesisState_10_list{list: &x.Brc20Tickers}
+		return protoreflect.ValueOfList(value)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.GenesisState"))
@@ -426,6 +821,21 @@ func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor)
 	case "side.btcbridge.GenesisState.dkg_request":
 		m := new(DKGRequest)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "side.btcbridge.GenesisState.runes":
+		list := []*RuneMetadata{}
+		return protoreflect.ValueOfList(&_GenesisState_6_list{list: &list})
+	case "side.btcbridge.GenesisState.frost_vault_keys":
+		list := []*FrostVaultKey{}
+		return protoreflect.ValueOfList(&_GenesisState_7_list{list: &list})
+	case "side.btcbridge.GenesisState.vault_transfers":
+		list := []*VaultTransfer{}
+		return protoreflect.ValueOfList(&_GenesisState_8_list{list: &list})
+	case "side.btcbridge.GenesisState.signing_request_histories":
+		list := []*SigningRequestHistory{}
+		return protoreflect.ValueOfList(&_GenesisState_9_list{list: &list})
+	case "side.btcbridge.GenesisState.brc20_tickers":
+		list := []*Brc20Metadata{}
+		return protoreflect.ValueOfList(&_GenesisState_10_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.GenesisState"))
@@ -519,6 +929,36 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 			l = options.Size(x.DkgRequest)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if len(x.Runes) > 0 {
+			for _, e := range x.Runes {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.FrostVaultKeys) > 0 {
+			for _, e := range x.FrostVaultKeys {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.VaultTransfers) > 0 {
+			for _, e := range x.VaultTransfers {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.SigningRequestHistories) > 0 {
+			for _, e := range x.SigningRequestHistories {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.Brc20Tickers) > 0 {
+			for _, e := range x.Brc20Tickers {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -548,6 +988,86 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.Brc20Tickers) > 0 {
+			for iNdEx := len(x.Brc20Tickers) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Brc20Tickers[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x52
+			}
+		}
+		if len(x.SigningRequestHistories) > 0 {
+			for iNdEx := len(x.SigningRequestHistories) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.SigningRequestHistories[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x4a
+			}
+		}
+		if len(x.VaultTransfers) > 0 {
+			for iNdEx := len(x.VaultTransfers) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.VaultTransfers[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x42
+			}
+		}
+		if len(x.FrostVaultKeys) > 0 {
+			for iNdEx := len(x.FrostVaultKeys) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.FrostVaultKeys[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x3a
+			}
+		}
+		if len(x.Runes) > 0 {
+			for iNdEx := len(x.Runes) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Runes[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
 		if x.DkgRequest != nil {
 			encoded, err := options.Marshal(x.DkgRequest)
 			if err != nil {
@@ -847,6 +1367,176 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Runes", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Runes = append(x.Runes, &RuneMetadata{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Runes[len(x.Runes)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FrostVaultKeys", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.FrostVaultKeys = append(x.FrostVaultKeys, &FrostVaultKey{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.FrostVaultKeys[len(x.FrostVaultKeys)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VaultTransfers", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.VaultTransfers = append(x.VaultTransfers, &VaultTransfer{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.VaultTransfers[len(x.VaultTransfers)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SigningRequestHistories", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SigningRequestHistories = append(x.SigningRequestHistories, &SigningRequestHistory{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.SigningRequestHistories[len(x.SigningRequestHistories)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Brc20Tickers", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Brc20Tickers = append(x.Brc20Tickers, &Brc20Metadata{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Brc20Tickers[len(x.Brc20Tickers)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -903,10 +1593,15 @@ type GenesisState struct {
 
 	Params *Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
 	// the chain tip of the bitcoin chain
-	BestBlockHeader *BlockHeader   `protobuf:"bytes,2,opt,name=best_block_header,json=bestBlockHeader,proto3" json:"best_block_header,omitempty"`
-	BlockHeaders    []*BlockHeader `protobuf:"bytes,3,rep,name=block_headers,json=blockHeaders,proto3" json:"block_headers,omitempty"`
-	Utxos           []*UTXO        `protobuf:"bytes,4,rep,name=utxos,proto3" json:"utxos,omitempty"`
-	DkgRequest      *DKGRequest    `protobuf:"bytes,5,opt,name=dkg_request,json=dkgRequest,proto3" json:"dkg_request,omitempty"`
+	BestBlockHeader         *BlockHeader             `protobuf:"bytes,2,opt,name=best_block_header,json=bestBlockHeader,proto3" json:"best_block_header,omitempty"`
+	BlockHeaders            []*BlockHeader           `protobuf:"bytes,3,rep,name=block_headers,json=blockHeaders,proto3" json:"block_headers,omitempty"`
+	Utxos                   []*UTXO                  `protobuf:"bytes,4,rep,name=utxos,proto3" json:"utxos,omitempty"`
+	DkgRequest              *DKGRequest              `protobuf:"bytes,5,opt,name=dkg_request,json=dkgRequest,proto3" json:"dkg_request,omitempty"`
+	Runes                   []*RuneMetadata          `protobuf:"bytes,6,rep,name=runes,proto3" json:"runes,omitempty"`
+	FrostVaultKeys          []*FrostVaultKey         `protobuf:"bytes,7,rep,name=frost_vault_keys,json=frostVaultKeys,proto3" json:"frost_vault_keys,omitempty"`
+	VaultTransfers          []*VaultTransfer         `protobuf:"bytes,8,rep,name=vault_transfers,json=vaultTransfers,proto3" json:"vault_transfers,omitempty"`
+	SigningRequestHistories []*SigningRequestHistory `protobuf:"bytes,9,rep,name=signing_request_histories,json=signingRequestHistories,proto3" json:"signing_request_histories,omitempty"`
+	Brc20Tickers            []*Brc20Metadata         `protobuf:"bytes,10,rep,name=brc20_tickers,json=brc20Tickers,proto3" json:"brc20_tickers,omitempty"`
 }
 
 func (x *GenesisState) Reset() {
@@ -964,6 +1659,41 @@ func (x *GenesisState) GetDkgRequest() *DKGRequest {
 	return nil
 }
 
+func (x *GenesisState) GetRunes() []*RuneMetadata {
+	if x != nil {
+		return x.Runes
+	}
+	return nil
+}
+
+func (x *GenesisState) GetFrostVaultKeys() []*FrostVaultKey {
+	if x != nil {
+		return x.FrostVaultKeys
+	}
+	return nil
+}
+
+func (x *GenesisState) GetVaultTransfers() []*VaultTransfer {
+	if x != nil {
+		return x.VaultTransfers
+	}
+	return nil
+}
+
+func (x *GenesisState) GetSigningRequestHistories() []*SigningRequestHistory {
+	if x != nil {
+		return x.SigningRequestHistories
+	}
+	return nil
+}
+
+func (x *GenesisState) GetBrc20Tickers() []*Brc20Metadata {
+	if x != nil {
+		return x.Brc20Tickers
+	}
+	return nil
+}
+
 var File_side_btcbridge_genesis_proto protoreflect.FileDescriptor
 
 var file_side_btcbridge_genesis_proto_rawDesc = []byte{
@@ -975,7 +1705,7 @@ var file_side_btcbridge_genesis_proto_rawDesc = []byte{
 	0x69, 0x64, 0x67, 0x65, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x1a, 0x1e, 0x73, 0x69, 0x64, 0x65, 0x2f, 0x62, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64, 0x67,
 	0x65, 0x2f, 0x62, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x22, 0xb8, 0x02, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61,
+	0x6f, 0x22, 0xa4, 0x05, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61,
 	0x74, 0x65, 0x12, 0x34, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x69, 0x64, 0x65, 0x2e, 0x62, 0x74, 0x63, 0x62, 0x72, 0x69,
 	0x64, 0x67, 0x65, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00,
@@ -994,18 +1724,40 @@ var file_side_btcbridge_genesis_proto_rawDesc = []byte{
 	0x3b, 0x0a, 0x0b, 0x64, 0x6b, 0x67, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x05,
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x69, 0x64, 0x65, 0x2e, 0x62, 0x74, 0x63, 0x62,
 	0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x44, 0x4b, 0x47, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x52, 0x0a, 0x64, 0x6b, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x9c, 0x01, 0x0a,
-	0x12, 0x63, 0x6f, 0x6d, 0x2e, 0x73, 0x69, 0x64, 0x65, 0x2e, 0x62, 0x74, 0x63, 0x62, 0x72, 0x69,
-	0x64, 0x67, 0x65, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74,
-	0x6f, 0x50, 0x01, 0x5a, 0x1f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69,
-	0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x73, 0x69, 0x64, 0x65, 0x2f, 0x62, 0x74, 0x63, 0x62, 0x72,
-	0x69, 0x64, 0x67, 0x65, 0xa2, 0x02, 0x03, 0x53, 0x42, 0x58, 0xaa, 0x02, 0x0e, 0x53, 0x69, 0x64,
-	0x65, 0x2e, 0x42, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0xca, 0x02, 0x0e, 0x53, 0x69,
-	0x64, 0x65, 0x5c, 0x42, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0xe2, 0x02, 0x1a, 0x53,
-	0x69, 0x64, 0x65, 0x5c, 0x42, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x5c, 0x47, 0x50,
-	0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0f, 0x53, 0x69, 0x64, 0x65,
-	0x3a, 0x3a, 0x42, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x52, 0x0a, 0x64, 0x6b, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x05,
+	0x72, 0x75, 0x6e, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x69,
+	0x64, 0x65, 0x2e, 0x62, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x52, 0x75, 0x6e,
+	0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x05, 0x72, 0x75, 0x6e, 0x65, 0x73,
+	0x12, 0x47, 0x0a, 0x10, 0x66, 0x72, 0x6f, 0x73, 0x74, 0x5f, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x5f,
+	0x6b, 0x65, 0x79, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x73, 0x69, 0x64,
+	0x65, 0x2e, 0x62, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x46, 0x72, 0x6f, 0x73,
+	0x74, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x4b, 0x65, 0x79, 0x52, 0x0e, 0x66, 0x72, 0x6f, 0x73, 0x74,
+	0x56, 0x61, 0x75, 0x6c, 0x74, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x46, 0x0a, 0x0f, 0x76, 0x61, 0x75,
+	0x6c, 0x74, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x18, 0x08, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x73, 0x69, 0x64, 0x65, 0x2e, 0x62, 0x74, 0x63, 0x62, 0x72, 0x69,
+	0x64, 0x67, 0x65, 0x2e, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
+	0x72, 0x52, 0x0e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72,
+	0x73, 0x12, 0x61, 0x0a, 0x19, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x09,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x73, 0x69, 0x64, 0x65, 0x2e, 0x62, 0x74, 0x63, 0x62,
+	0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x17, 0x73, 0x69, 0x67,
+	0x6e, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x69, 0x65, 0x73, 0x12, 0x42, 0x0a, 0x0d, 0x62, 0x72, 0x63, 0x32, 0x30, 0x5f, 0x74, 0x69,
+	0x63, 0x6b, 0x65, 0x72, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x73, 0x69,
+	0x64, 0x65, 0x2e, 0x62, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x42, 0x72, 0x63,
+	0x32, 0x30, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x0c, 0x62, 0x72, 0x63, 0x32,
+	0x30, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x73, 0x42, 0x9c, 0x01, 0x0a, 0x12, 0x63, 0x6f, 0x6d,
+	0x2e, 0x73, 0x69, 0x64, 0x65, 0x2e, 0x62, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x42,
+	0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a,
+	0x1f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x73, 0x69, 0x64, 0x65, 0x2f, 0x62, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65,
+	0xa2, 0x02, 0x03, 0x53, 0x42, 0x58, 0xaa, 0x02, 0x0e, 0x53, 0x69, 0x64, 0x65, 0x2e, 0x42, 0x74,
+	0x63, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0xca, 0x02, 0x0e, 0x53, 0x69, 0x64, 0x65, 0x5c, 0x42,
+	0x74, 0x63, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0xe2, 0x02, 0x1a, 0x53, 0x69, 0x64, 0x65, 0x5c,
+	0x42, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0f, 0x53, 0x69, 0x64, 0x65, 0x3a, 0x3a, 0x42, 0x74,
+	0x63, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1022,23 +1774,33 @@ func file_side_btcbridge_genesis_proto_rawDescGZIP() []byte {
 
 var file_side_btcbridge_genesis_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
 var file_side_btcbridge_genesis_proto_goTypes = []interface{}{
-	(*GenesisState)(nil), // 0: side.btcbridge.GenesisState
-	(*Params)(nil),       // 1: side.btcbridge.Params
-	(*BlockHeader)(nil),  // 2: side.btcbridge.BlockHeader
-	(*UTXO)(nil),         // 3: side.btcbridge.UTXO
-	(*DKGRequest)(nil),   // 4: side.btcbridge.DKGRequest
+	(*GenesisState)(nil),          // 0: side.btcbridge.GenesisState
+	(*Params)(nil),                // 1: side.btcbridge.Params
+	(*BlockHeader)(nil),           // 2: side.btcbridge.BlockHeader
+	(*UTXO)(nil),                  // 3: side.btcbridge.UTXO
+	(*DKGRequest)(nil),            // 4: side.btcbridge.DKGRequest
+	(*RuneMetadata)(nil),          // 5: side.btcbridge.RuneMetadata
+	(*FrostVaultKey)(nil),         // 6: side.btcbridge.FrostVaultKey
+	(*VaultTransfer)(nil),         // 7: side.btcbridge.VaultTransfer
+	(*SigningRequestHistory)(nil), // 8: side.btcbridge.SigningRequestHistory
+	(*Brc20Metadata)(nil),         // 9: side.btcbridge.Brc20Metadata
 }
 var file_side_btcbridge_genesis_proto_depIdxs = []int32{
-	1, // 0: side.btcbridge.GenesisState.params:type_name -> side.btcbridge.Params
-	2, // 1: side.btcbridge.GenesisState.best_block_header:type_name -> side.btcbridge.BlockHeader
-	2, // 2: side.btcbridge.GenesisState.block_headers:type_name -> side.btcbridge.BlockHeader
-	3, // 3: side.btcbridge.GenesisState.utxos:type_name -> side.btcbridge.UTXO
-	4, // 4: side.btcbridge.GenesisState.dkg_request:type_name -> side.btcbridge.DKGRequest
-	5, // [5:5] is the sub-list for method output_type
-	5, // [5:5] is the sub-list for method input_type
-	5, // [5:5] is the sub-list for extension type_name
-	5, // [5:5] is the sub-list for extension extendee
-	0, // [0:5] is the sub-list for field type_name
+	1,  // 0: side.btcbridge.GenesisState.params:type_name -> side.btcbridge.Params
+	2,  // 1: side.btcbridge.GenesisState.best_block_header:type_name -> side.btcbridge.BlockHeader
+	2,  // 2: side.btcbridge.GenesisState.block_headers:type_name -> side.btcbridge.BlockHeader
+	3,  // 3: side.btcbridge.GenesisState.utxos:type_name -> side.btcbridge.UTXO
+	4,  // 4: side.btcbridge.GenesisState.dkg_request:type_name -> side.btcbridge.DKGRequest
+	5,  // 5: side.btcbridge.GenesisState.runes:type_name -> side.btcbridge.RuneMetadata
+	6,  // 6: side.btcbridge.GenesisState.frost_vault_keys:type_name -> side.btcbridge.FrostVaultKey
+	7,  // 7: side.btcbridge.GenesisState.vault_transfers:type_name -> side.btcbridge.VaultTransfer
+	8,  // 8: side.btcbridge.GenesisState.signing_request_histories:type_name -> side.btcbridge.SigningRequestHistory
+	9,  // 9: side.btcbridge.GenesisState.brc20_tickers:type_name -> side.btcbridge.Brc20Metadata
+	10, // [10:10] is the sub-list for method output_type
+	10, // [10:10] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
 }
 
 func init() { file_side_btcbridge_genesis_proto_init() }
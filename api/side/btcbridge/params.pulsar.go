@@ -2,7 +2,7 @@
 package btcbridge
 
 import (
-	_ "cosmossdk.io/api/cosmos/base/v1beta1"
+	v1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
 	fmt "fmt"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -10,6 +10,7 @@ import (
 	protoiface "google.golang.org/protobuf/runtime/protoiface"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	reflect "reflect"
 	sync "sync"
@@ -204,6 +205,103 @@ func (x *_Params_12_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_Params_17_list)(nil)
+
+type _Params_17_list struct {
+	list *[]*RuneConfig
+}
+
+func (x *_Params_17_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_17_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Params_17_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*RuneConfig)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_17_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*RuneConfig)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_17_list) AppendMutable() protoreflect.Value {
+	v := new(RuneConfig)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_17_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_17_list) NewElement() protoreflect.Value {
+	v := new(RuneConfig)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_17_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Params_19_list)(nil)
+
+type _Params_19_list struct {
+	list *[]string
+}
+
+func (x *_Params_19_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_19_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_Params_19_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_19_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_19_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message Params at list field Guardians as it is not of Message kind"))
+}
+
+func (x *_Params_19_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_19_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_Params_19_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
 	md_Params                             protoreflect.MessageDescriptor
 	fd_Params_deposit_confirmation_depth  protoreflect.FieldDescriptor
@@ -222,6 +320,13 @@ var (
 	fd_Params_protocol_limits             protoreflect.FieldDescriptor
 	fd_Params_protocol_fees               protoreflect.FieldDescriptor
 	fd_Params_tss_params                  protoreflect.FieldDescriptor
+	fd_Params_rune_configs                protoreflect.FieldDescriptor
+	fd_Params_withdraw_rate_limit         protoreflect.FieldDescriptor
+	fd_Params_guardians                   protoreflect.FieldDescriptor
+	fd_Params_max_pause_duration          protoreflect.FieldDescriptor
+	fd_Params_consolidation_policy        protoreflect.FieldDescriptor
+	fd_Params_vault_recovery_policy       protoreflect.FieldDescriptor
+	fd_Params_vault_assignment_strategy   protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -243,6 +348,13 @@ func init() {
 	fd_Params_protocol_limits = md_Params.Fields().ByName("protocol_limits")
 	fd_Params_protocol_fees = md_Params.Fields().ByName("protocol_fees")
 	fd_Params_tss_params = md_Params.Fields().ByName("tss_params")
+	fd_Params_rune_configs = md_Params.Fields().ByName("rune_configs")
+	fd_Params_withdraw_rate_limit = md_Params.Fields().ByName("withdraw_rate_limit")
+	fd_Params_guardians = md_Params.Fields().ByName("guardians")
+	fd_Params_max_pause_duration = md_Params.Fields().ByName("max_pause_duration")
+	fd_Params_consolidation_policy = md_Params.Fields().ByName("consolidation_policy")
+	fd_Params_vault_recovery_policy = md_Params.Fields().ByName("vault_recovery_policy")
+	fd_Params_vault_assignment_strategy = md_Params.Fields().ByName("vault_assignment_strategy")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -406,6 +518,48 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if len(x.RuneConfigs) != 0 {
+		value := protoreflect.ValueOfList(&_Params_17_list{list: &x.RuneConfigs})
+		if !f(fd_Params_rune_configs, value) {
+			return
+		}
+	}
+	if x.WithdrawRateLimit != nil {
+		value := protoreflect.ValueOfMessage(x.WithdrawRateLimit.ProtoReflect())
+		if !f(fd_Params_withdraw_rate_limit, value) {
+			return
+		}
+	}
+	if len(x.Guardians) != 0 {
+		value := protoreflect.ValueOfList(&_Params_19_list{list: &x.Guardians})
+		if !f(fd_Params_guardians, value) {
+			return
+		}
+	}
+	if x.MaxPauseDuration != nil {
+		value := protoreflect.ValueOfMessage(x.MaxPauseDuration.ProtoReflect())
+		if !f(fd_Params_max_pause_duration, value) {
+			return
+		}
+	}
+	if x.ConsolidationPolicy != nil {
+		value := protoreflect.ValueOfMessage(x.ConsolidationPolicy.ProtoReflect())
+		if !f(fd_Params_consolidation_policy, value) {
+			return
+		}
+	}
+	if x.VaultRecoveryPolicy != nil {
+		value := protoreflect.ValueOfMessage(x.VaultRecoveryPolicy.ProtoReflect())
+		if !f(fd_Params_vault_recovery_policy, value) {
+			return
+		}
+	}
+	if x.VaultAssignmentStrategy != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.VaultAssignmentStrategy))
+		if !f(fd_Params_vault_assignment_strategy, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -453,6 +607,20 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.ProtocolFees != nil
 	case "side.btcbridge.Params.tss_params":
 		return x.TssParams != nil
+	case "side.btcbridge.Params.rune_configs":
+		return len(x.RuneConfigs) != 0
+	case "side.btcbridge.Params.withdraw_rate_limit":
+		return x.WithdrawRateLimit != nil
+	case "side.btcbridge.Params.guardians":
+		return len(x.Guardians) != 0
+	case "side.btcbridge.Params.max_pause_duration":
+		return x.MaxPauseDuration != nil
+	case "side.btcbridge.Params.consolidation_policy":
+		return x.ConsolidationPolicy != nil
+	case "side.btcbridge.Params.vault_recovery_policy":
+		return x.VaultRecoveryPolicy != nil
+	case "side.btcbridge.Params.vault_assignment_strategy":
+		return x.VaultAssignmentStrategy != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.Params"))
@@ -501,6 +669,20 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 		x.ProtocolFees = nil
 	case "side.btcbridge.Params.tss_params":
 		x.TssParams = nil
+	case "side.btcbridge.Params.rune_configs":
+		x.RuneConfigs = nil
+	case "side.btcbridge.Params.withdraw_rate_limit":
+		x.WithdrawRateLimit = nil
+	case "side.btcbridge.Params.guardians":
+		x.Guardians = nil
+	case "side.btcbridge.Params.max_pause_duration":
+		x.MaxPauseDuration = nil
+	case "side.btcbridge.Params.consolidation_policy":
+		x.ConsolidationPolicy = nil
+	case "side.btcbridge.Params.vault_recovery_policy":
+		x.VaultRecoveryPolicy = nil
+	case "side.btcbridge.Params.vault_assignment_strategy":
+		x.VaultAssignmentStrategy = 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.Params"))
@@ -577,6 +759,33 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "side.btcbridge.Params.tss_params":
 		value := x.TssParams
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "side.btcbridge.Params.rune_configs":
+		if len(x.RuneConfigs) == 0 {
+			return protoreflect.ValueOfList(&_Params_17_list{})
+		}
+		listValue := &_Params_17_list{list: &x.RuneConfigs}
+		return protoreflect.ValueOfList(listValue)
+	case "side.btcbridge.Params.withdraw_rate_limit":
+		value := x.WithdrawRateLimit
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "side.btcbridge.Params.guardians":
+		if len(x.Guardians) == 0 {
+			return protoreflect.ValueOfList(&_Params_19_list{})
+		}
+		listValue := &_Params_19_list{list: &x.Guardians}
+		return protoreflect.ValueOfList(listValue)
+	case "side.btcbridge.Params.max_pause_duration":
+		value := x.MaxPauseDuration
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "side.btcbridge.Params.consolidation_policy":
+		value := x.ConsolidationPolicy
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "side.btcbridge.Params.vault_recovery_policy":
+		value := x.VaultRecoveryPolicy
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "side.btcbridge.Params.vault_assignment_strategy":
+		value := x.VaultAssignmentStrategy
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.Params"))
@@ -637,6 +846,24 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 		x.ProtocolFees = value.Message().Interface().(*ProtocolFees)
 	case "side.btcbridge.Params.tss_params":
 		x.TssParams = value.Message().Interface().(*TSSParams)
+	case "side.btcbridge.Params.rune_configs":
+		lv := value.List()
+		clv := lv.(*_Params_17_list)
+		x.RuneConfigs = *clv.list
+	case "side.btcbridge.Params.withdraw_rate_limit":
+		x.WithdrawRateLimit = value.Message().Interface().(*WithdrawRateLimit)
+	case "side.btcbridge.Params.guardians":
+		lv := value.List()
+		clv := lv.(*_Params_19_list)
+		x.Guardians = *clv.list
+	case "side.btcbridge.Params.max_pause_duration":
+		x.MaxPauseDuration = value.Message().Interface().(*durationpb.Duration)
+	case "side.btcbridge.Params.consolidation_policy":
+		x.ConsolidationPolicy = value.Message().Interface().(*ConsolidationPolicy)
+	case "side.btcbridge.Params.vault_recovery_policy":
+		x.VaultRecoveryPolicy = value.Message().Interface().(*VaultRecoveryPolicy)
+	case "side.btcbridge.Params.vault_assignment_strategy":
+		x.VaultAssignmentStrategy = (VaultAssignmentStrategy)(value.Enum())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.Params"))
@@ -701,6 +928,38 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 			x.TssParams = new(TSSParams)
 		}
 		return protoreflect.ValueOfMessage(x.TssParams.ProtoReflect())
+	case "side.btcbridge.Params.rune_configs":
+		if x.RuneConfigs == nil {
+			x.RuneConfigs = []*RuneConfig{}
+		}
+		value := &_Params_17_list{list: &x.RuneConfigs}
+		return protoreflect.ValueOfList(value)
+	case "side.btcbridge.Params.withdraw_rate_limit":
+		if x.WithdrawRateLimit == nil {
+			x.WithdrawRateLimit = new(WithdrawRateLimit)
+		}
+		return protoreflect.ValueOfMessage(x.WithdrawRateLimit.ProtoReflect())
+	case "side.btcbridge.Params.guardians":
+		if x.Guardians == nil {
+			x.Guardians = []string{}
+		}
+		value := &_Params_19_list{list: &x.Guardians}
+		return protoreflect.ValueOfList(value)
+	case "side.btcbridge.Params.max_pause_duration":
+		if x.MaxPauseDuration == nil {
+			x.MaxPauseDuration = new(durationpb.Duration)
+		}
+		return protoreflect.ValueOfMessage(x.MaxPauseDuration.ProtoReflect())
+	case "side.btcbridge.Params.consolidation_policy":
+		if x.ConsolidationPolicy == nil {
+			x.ConsolidationPolicy = new(ConsolidationPolicy)
+		}
+		return protoreflect.ValueOfMessage(x.ConsolidationPolicy.ProtoReflect())
+	case "side.btcbridge.Params.vault_recovery_policy":
+		if x.VaultRecoveryPolicy == nil {
+			x.VaultRecoveryPolicy = new(VaultRecoveryPolicy)
+		}
+		return protoreflect.ValueOfMessage(x.VaultRecoveryPolicy.ProtoReflect())
 	case "side.btcbridge.Params.deposit_confirmation_depth":
 		panic(fmt.Errorf("field deposit_confirmation_depth of message side.btcbridge.Params is not mutable"))
 	case "side.btcbridge.Params.withdraw_confirmation_depth":
@@ -717,6 +976,8 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 		panic(fmt.Errorf("field withdraw_enabled of message side.btcbridge.Params is not mutable"))
 	case "side.btcbridge.Params.fee_rate_validity_period":
 		panic(fmt.Errorf("field fee_rate_validity_period of message side.btcbridge.Params is not mutable"))
+	case "side.btcbridge.Params.vault_assignment_strategy":
+		panic(fmt.Errorf("field vault_assignment_strategy of message side.btcbridge.Params is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.Params"))
@@ -770,6 +1031,26 @@ func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protor
 	case "side.btcbridge.Params.tss_params":
 		m := new(TSSParams)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "side.btcbridge.Params.rune_configs":
+		list := []*RuneConfig{}
+		return protoreflect.ValueOfList(&_Params_17_list{list: &list})
+	case "side.btcbridge.Params.withdraw_rate_limit":
+		m := new(WithdrawRateLimit)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "side.btcbridge.Params.guardians":
+		list := []string{}
+		return protoreflect.ValueOfList(&_Params_19_list{list: &list})
+	case "side.btcbridge.Params.max_pause_duration":
+		m := new(durationpb.Duration)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "side.btcbridge.Params.consolidation_policy":
+		m := new(ConsolidationPolicy)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "side.btcbridge.Params.vault_recovery_policy":
+		m := new(VaultRecoveryPolicy)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "side.btcbridge.Params.vault_assignment_strategy":
+		return protoreflect.ValueOfEnum(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.Params"))
@@ -904,6 +1185,37 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			l = options.Size(x.TssParams)
 			n += 2 + l + runtime.Sov(uint64(l))
 		}
+		if len(x.RuneConfigs) > 0 {
+			for _, e := range x.RuneConfigs {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.WithdrawRateLimit != nil {
+			l = options.Size(x.WithdrawRateLimit)
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Guardians) > 0 {
+			for _, s := range x.Guardians {
+				l = len(s)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.MaxPauseDuration != nil {
+			l = options.Size(x.MaxPauseDuration)
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.ConsolidationPolicy != nil {
+			l = options.Size(x.ConsolidationPolicy)
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.VaultRecoveryPolicy != nil {
+			l = options.Size(x.VaultRecoveryPolicy)
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.VaultAssignmentStrategy != 0 {
+			n += 2 + runtime.Sov(uint64(x.VaultAssignmentStrategy))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -933,6 +1245,106 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.VaultAssignmentStrategy != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.VaultAssignmentStrategy))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb8
+		}
+		if x.VaultRecoveryPolicy != nil {
+			encoded, err := options.Marshal(x.VaultRecoveryPolicy)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb2
+		}
+		if x.ConsolidationPolicy != nil {
+			encoded, err := options.Marshal(x.ConsolidationPolicy)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xaa
+		}
+		if x.MaxPauseDuration != nil {
+			encoded, err := options.Marshal(x.MaxPauseDuration)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+		if len(x.Guardians) > 0 {
+			for iNdEx := len(x.Guardians) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Guardians[iNdEx])
+				copy(dAtA[i:], x.Guardians[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Guardians[iNdEx])))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0x9a
+			}
+		}
+		if x.WithdrawRateLimit != nil {
+			encoded, err := options.Marshal(x.WithdrawRateLimit)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+		if len(x.RuneConfigs) > 0 {
+			for iNdEx := len(x.RuneConfigs) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.RuneConfigs[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0x8a
+			}
+		}
 		if x.TssParams != nil {
 			encoded, err := options.Marshal(x.TssParams)
 			if err != nil {
@@ -1576,47 +1988,280 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			case 17:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RuneConfigs", wireType)
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if (iNdEx + skippy) > l {
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				x.RuneConfigs = append(x.RuneConfigs, &RuneConfig{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RuneConfigs[len(x.RuneConfigs)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				iNdEx += skippy
-			}
-		}
-
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
-	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
-	}
+				iNdEx = postIndex
+			case 18:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WithdrawRateLimit", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.WithdrawRateLimit == nil {
+					x.WithdrawRateLimit = &WithdrawRateLimit{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.WithdrawRateLimit); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 19:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Guardians", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Guardians = append(x.Guardians, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 20:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxPauseDuration", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.MaxPauseDuration == nil {
+					x.MaxPauseDuration = &durationpb.Duration{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MaxPauseDuration); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 21:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ConsolidationPolicy", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.ConsolidationPolicy == nil {
+					x.ConsolidationPolicy = &ConsolidationPolicy{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ConsolidationPolicy); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 22:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VaultRecoveryPolicy", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.VaultRecoveryPolicy == nil {
+					x.VaultRecoveryPolicy = &VaultRecoveryPolicy{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.VaultRecoveryPolicy); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 23:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VaultAssignmentStrategy", wireType)
+				}
+				x.VaultAssignmentStrategy = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.VaultAssignmentStrategy |= VaultAssignmentStrategy(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
 }
 
 var (
-	md_Vault            protoreflect.MessageDescriptor
-	fd_Vault_address    protoreflect.FieldDescriptor
-	fd_Vault_pub_key    protoreflect.FieldDescriptor
-	fd_Vault_asset_type protoreflect.FieldDescriptor
-	fd_Vault_version    protoreflect.FieldDescriptor
+	md_Vault                   protoreflect.MessageDescriptor
+	fd_Vault_address           protoreflect.FieldDescriptor
+	fd_Vault_pub_key           protoreflect.FieldDescriptor
+	fd_Vault_asset_type        protoreflect.FieldDescriptor
+	fd_Vault_version           protoreflect.FieldDescriptor
+	fd_Vault_script_descriptor protoreflect.FieldDescriptor
+	fd_Vault_taproot_tree      protoreflect.FieldDescriptor
+	fd_Vault_retirement_time   protoreflect.FieldDescriptor
+	fd_Vault_state             protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -1626,6 +2271,10 @@ func init() {
 	fd_Vault_pub_key = md_Vault.Fields().ByName("pub_key")
 	fd_Vault_asset_type = md_Vault.Fields().ByName("asset_type")
 	fd_Vault_version = md_Vault.Fields().ByName("version")
+	fd_Vault_script_descriptor = md_Vault.Fields().ByName("script_descriptor")
+	fd_Vault_taproot_tree = md_Vault.Fields().ByName("taproot_tree")
+	fd_Vault_retirement_time = md_Vault.Fields().ByName("retirement_time")
+	fd_Vault_state = md_Vault.Fields().ByName("state")
 }
 
 var _ protoreflect.Message = (*fastReflection_Vault)(nil)
@@ -1717,6 +2366,30 @@ func (x *fastReflection_Vault) Range(f func(protoreflect.FieldDescriptor, protor
 			return
 		}
 	}
+	if x.ScriptDescriptor != nil {
+		value := protoreflect.ValueOfMessage(x.ScriptDescriptor.ProtoReflect())
+		if !f(fd_Vault_script_descriptor, value) {
+			return
+		}
+	}
+	if x.TaprootTree != nil {
+		value := protoreflect.ValueOfMessage(x.TaprootTree.ProtoReflect())
+		if !f(fd_Vault_taproot_tree, value) {
+			return
+		}
+	}
+	if x.RetirementTime != nil {
+		value := protoreflect.ValueOfMessage(x.RetirementTime.ProtoReflect())
+		if !f(fd_Vault_retirement_time, value) {
+			return
+		}
+	}
+	if x.State != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.State))
+		if !f(fd_Vault_state, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -1740,6 +2413,14 @@ func (x *fastReflection_Vault) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.AssetType != 0
 	case "side.btcbridge.Vault.version":
 		return x.Version != uint64(0)
+	case "side.btcbridge.Vault.script_descriptor":
+		return x.ScriptDescriptor != nil
+	case "side.btcbridge.Vault.taproot_tree":
+		return x.TaprootTree != nil
+	case "side.btcbridge.Vault.retirement_time":
+		return x.RetirementTime != nil
+	case "side.btcbridge.Vault.state":
+		return x.State != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.Vault"))
@@ -1764,6 +2445,14 @@ func (x *fastReflection_Vault) Clear(fd protoreflect.FieldDescriptor) {
 		x.AssetType = 0
 	case "side.btcbridge.Vault.version":
 		x.Version = uint64(0)
+	case "side.btcbridge.Vault.script_descriptor":
+		x.ScriptDescriptor = nil
+	case "side.btcbridge.Vault.taproot_tree":
+		x.TaprootTree = nil
+	case "side.btcbridge.Vault.retirement_time":
+		x.RetirementTime = nil
+	case "side.btcbridge.Vault.state":
+		x.State = 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.Vault"))
@@ -1792,6 +2481,18 @@ func (x *fastReflection_Vault) Get(descriptor protoreflect.FieldDescriptor) prot
 	case "side.btcbridge.Vault.version":
 		value := x.Version
 		return protoreflect.ValueOfUint64(value)
+	case "side.btcbridge.Vault.script_descriptor":
+		value := x.ScriptDescriptor
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "side.btcbridge.Vault.taproot_tree":
+		value := x.TaprootTree
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "side.btcbridge.Vault.retirement_time":
+		value := x.RetirementTime
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "side.btcbridge.Vault.state":
+		value := x.State
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.Vault"))
@@ -1820,6 +2521,14 @@ func (x *fastReflection_Vault) Set(fd protoreflect.FieldDescriptor, value protor
 		x.AssetType = (AssetType)(value.Enum())
 	case "side.btcbridge.Vault.version":
 		x.Version = value.Uint()
+	case "side.btcbridge.Vault.script_descriptor":
+		x.ScriptDescriptor = value.Message().Interface().(*VaultDescriptor)
+	case "side.btcbridge.Vault.taproot_tree":
+		x.TaprootTree = value.Message().Interface().(*VaultTaprootTree)
+	case "side.btcbridge.Vault.retirement_time":
+		x.RetirementTime = value.Message().Interface().(*timestamppb.Timestamp)
+	case "side.btcbridge.Vault.state":
+		x.State = (VaultState)(value.Enum())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.Vault"))
@@ -1840,6 +2549,21 @@ func (x *fastReflection_Vault) Set(fd protoreflect.FieldDescriptor, value protor
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_Vault) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "side.btcbridge.Vault.script_descriptor":
+		if x.ScriptDescriptor == nil {
+			x.ScriptDescriptor = new(VaultDescriptor)
+		}
+		return protoreflect.ValueOfMessage(x.ScriptDescriptor.ProtoReflect())
+	case "side.btcbridge.Vault.taproot_tree":
+		if x.TaprootTree == nil {
+			x.TaprootTree = new(VaultTaprootTree)
+		}
+		return protoreflect.ValueOfMessage(x.TaprootTree.ProtoReflect())
+	case "side.btcbridge.Vault.retirement_time":
+		if x.RetirementTime == nil {
+			x.RetirementTime = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.RetirementTime.ProtoReflect())
 	case "side.btcbridge.Vault.address":
 		panic(fmt.Errorf("field address of message side.btcbridge.Vault is not mutable"))
 	case "side.btcbridge.Vault.pub_key":
@@ -1848,6 +2572,8 @@ func (x *fastReflection_Vault) Mutable(fd protoreflect.FieldDescriptor) protoref
 		panic(fmt.Errorf("field asset_type of message side.btcbridge.Vault is not mutable"))
 	case "side.btcbridge.Vault.version":
 		panic(fmt.Errorf("field version of message side.btcbridge.Vault is not mutable"))
+	case "side.btcbridge.Vault.state":
+		panic(fmt.Errorf("field state of message side.btcbridge.Vault is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.Vault"))
@@ -1869,6 +2595,17 @@ func (x *fastReflection_Vault) NewField(fd protoreflect.FieldDescriptor) protore
 		return protoreflect.ValueOfEnum(0)
 	case "side.btcbridge.Vault.version":
 		return protoreflect.ValueOfUint64(uint64(0))
+	case "side.btcbridge.Vault.script_descriptor":
+		m := new(VaultDescriptor)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "side.btcbridge.Vault.taproot_tree":
+		m := new(VaultTaprootTree)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "side.btcbridge.Vault.retirement_time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "side.btcbridge.Vault.state":
+		return protoreflect.ValueOfEnum(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.Vault"))
@@ -1952,6 +2689,21 @@ func (x *fastReflection_Vault) ProtoMethods() *protoiface.Methods {
 		if x.Version != 0 {
 			n += 1 + runtime.Sov(uint64(x.Version))
 		}
+		if x.ScriptDescriptor != nil {
+			l = options.Size(x.ScriptDescriptor)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.TaprootTree != nil {
+			l = options.Size(x.TaprootTree)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.RetirementTime != nil {
+			l = options.Size(x.RetirementTime)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.State != 0 {
+			n += 1 + runtime.Sov(uint64(x.State))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1981,25 +2733,72 @@ func (x *fastReflection_Vault) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Version != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Version))
-			i--
-			dAtA[i] = 0x20
-		}
-		if x.AssetType != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.AssetType))
+		if x.State != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.State))
 			i--
-			dAtA[i] = 0x18
+			dAtA[i] = 0x40
 		}
-		if len(x.PubKey) > 0 {
-			i -= len(x.PubKey)
-			copy(dAtA[i:], x.PubKey)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PubKey)))
+		if x.RetirementTime != nil {
+			encoded, err := options.Marshal(x.RetirementTime)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x3a
 		}
-		if len(x.Address) > 0 {
-			i -= len(x.Address)
+		if x.TaprootTree != nil {
+			encoded, err := options.Marshal(x.TaprootTree)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.ScriptDescriptor != nil {
+			encoded, err := options.Marshal(x.ScriptDescriptor)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.Version != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Version))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.AssetType != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AssetType))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.PubKey) > 0 {
+			i -= len(x.PubKey)
+			copy(dAtA[i:], x.PubKey)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PubKey)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
 			copy(dAtA[i:], x.Address)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
 			i--
@@ -2156,6 +2955,133 @@ func (x *fastReflection_Vault) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ScriptDescriptor", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.ScriptDescriptor == nil {
+					x.ScriptDescriptor = &VaultDescriptor{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ScriptDescriptor); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TaprootTree", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.TaprootTree == nil {
+					x.TaprootTree = &VaultTaprootTree{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TaprootTree); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RetirementTime", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.RetirementTime == nil {
+					x.RetirementTime = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RetirementTime); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+				}
+				x.State = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.State |= VaultState(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2192,29 +3118,25 @@ func (x *fastReflection_Vault) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_WithdrawParams                            protoreflect.MessageDescriptor
-	fd_WithdrawParams_max_utxo_num               protoreflect.FieldDescriptor
-	fd_WithdrawParams_btc_batch_withdraw_period  protoreflect.FieldDescriptor
-	fd_WithdrawParams_max_btc_batch_withdraw_num protoreflect.FieldDescriptor
+	md_VaultTaprootTree                 protoreflect.MessageDescriptor
+	fd_VaultTaprootTree_recovery_script protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_side_btcbridge_params_proto_init()
-	md_WithdrawParams = File_side_btcbridge_params_proto.Messages().ByName("WithdrawParams")
-	fd_WithdrawParams_max_utxo_num = md_WithdrawParams.Fields().ByName("max_utxo_num")
-	fd_WithdrawParams_btc_batch_withdraw_period = md_WithdrawParams.Fields().ByName("btc_batch_withdraw_period")
-	fd_WithdrawParams_max_btc_batch_withdraw_num = md_WithdrawParams.Fields().ByName("max_btc_batch_withdraw_num")
+	md_VaultTaprootTree = File_side_btcbridge_params_proto.Messages().ByName("VaultTaprootTree")
+	fd_VaultTaprootTree_recovery_script = md_VaultTaprootTree.Fields().ByName("recovery_script")
 }
 
-var _ protoreflect.Message = (*fastReflection_WithdrawParams)(nil)
+var _ protoreflect.Message = (*fastReflection_VaultTaprootTree)(nil)
 
-type fastReflection_WithdrawParams WithdrawParams
+type fastReflection_VaultTaprootTree VaultTaprootTree
 
-func (x *WithdrawParams) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_WithdrawParams)(x)
+func (x *VaultTaprootTree) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_VaultTaprootTree)(x)
 }
 
-func (x *WithdrawParams) slowProtoReflect() protoreflect.Message {
+func (x *VaultTaprootTree) slowProtoReflect() protoreflect.Message {
 	mi := &file_side_btcbridge_params_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2226,43 +3148,43 @@ func (x *WithdrawParams) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_WithdrawParams_messageType fastReflection_WithdrawParams_messageType
-var _ protoreflect.MessageType = fastReflection_WithdrawParams_messageType{}
+var _fastReflection_VaultTaprootTree_messageType fastReflection_VaultTaprootTree_messageType
+var _ protoreflect.MessageType = fastReflection_VaultTaprootTree_messageType{}
 
-type fastReflection_WithdrawParams_messageType struct{}
+type fastReflection_VaultTaprootTree_messageType struct{}
 
-func (x fastReflection_WithdrawParams_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_WithdrawParams)(nil)
+func (x fastReflection_VaultTaprootTree_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_VaultTaprootTree)(nil)
 }
-func (x fastReflection_WithdrawParams_messageType) New() protoreflect.Message {
-	return new(fastReflection_WithdrawParams)
+func (x fastReflection_VaultTaprootTree_messageType) New() protoreflect.Message {
+	return new(fastReflection_VaultTaprootTree)
 }
-func (x fastReflection_WithdrawParams_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_WithdrawParams
+func (x fastReflection_VaultTaprootTree_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_VaultTaprootTree
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_WithdrawParams) Descriptor() protoreflect.MessageDescriptor {
-	return md_WithdrawParams
+func (x *fastReflection_VaultTaprootTree) Descriptor() protoreflect.MessageDescriptor {
+	return md_VaultTaprootTree
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_WithdrawParams) Type() protoreflect.MessageType {
-	return _fastReflection_WithdrawParams_messageType
+func (x *fastReflection_VaultTaprootTree) Type() protoreflect.MessageType {
+	return _fastReflection_VaultTaprootTree_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_WithdrawParams) New() protoreflect.Message {
-	return new(fastReflection_WithdrawParams)
+func (x *fastReflection_VaultTaprootTree) New() protoreflect.Message {
+	return new(fastReflection_VaultTaprootTree)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_WithdrawParams) Interface() protoreflect.ProtoMessage {
-	return (*WithdrawParams)(x)
+func (x *fastReflection_VaultTaprootTree) Interface() protoreflect.ProtoMessage {
+	return (*VaultTaprootTree)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2270,22 +3192,10 @@ func (x *fastReflection_WithdrawParams) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_WithdrawParams) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.MaxUtxoNum != uint32(0) {
-		value := protoreflect.ValueOfUint32(x.MaxUtxoNum)
-		if !f(fd_WithdrawParams_max_utxo_num, value) {
-			return
-		}
-	}
-	if x.BtcBatchWithdrawPeriod != int64(0) {
-		value := protoreflect.ValueOfInt64(x.BtcBatchWithdrawPeriod)
-		if !f(fd_WithdrawParams_btc_batch_withdraw_period, value) {
-			return
-		}
-	}
-	if x.MaxBtcBatchWithdrawNum != uint32(0) {
-		value := protoreflect.ValueOfUint32(x.MaxBtcBatchWithdrawNum)
-		if !f(fd_WithdrawParams_max_btc_batch_withdraw_num, value) {
+func (x *fastReflection_VaultTaprootTree) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.RecoveryScript != "" {
+		value := protoreflect.ValueOfString(x.RecoveryScript)
+		if !f(fd_VaultTaprootTree_recovery_script, value) {
 			return
 		}
 	}
@@ -2302,19 +3212,15 @@ func (x *fastReflection_WithdrawParams) Range(f func(protoreflect.FieldDescripto
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_WithdrawParams) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_VaultTaprootTree) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "side.btcbridge.WithdrawParams.max_utxo_num":
-		return x.MaxUtxoNum != uint32(0)
-	case "side.btcbridge.WithdrawParams.btc_batch_withdraw_period":
-		return x.BtcBatchWithdrawPeriod != int64(0)
-	case "side.btcbridge.WithdrawParams.max_btc_batch_withdraw_num":
-		return x.MaxBtcBatchWithdrawNum != uint32(0)
+	case "side.btcbridge.VaultTaprootTree.recovery_script":
+		return x.RecoveryScript != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.WithdrawParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.VaultTaprootTree"))
 		}
-		panic(fmt.Errorf("message side.btcbridge.WithdrawParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message side.btcbridge.VaultTaprootTree does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2324,19 +3230,15 @@ func (x *fastReflection_WithdrawParams) Has(fd protoreflect.FieldDescriptor) boo
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_WithdrawParams) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_VaultTaprootTree) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "side.btcbridge.WithdrawParams.max_utxo_num":
-		x.MaxUtxoNum = uint32(0)
-	case "side.btcbridge.WithdrawParams.btc_batch_withdraw_period":
-		x.BtcBatchWithdrawPeriod = int64(0)
-	case "side.btcbridge.WithdrawParams.max_btc_batch_withdraw_num":
-		x.MaxBtcBatchWithdrawNum = uint32(0)
+	case "side.btcbridge.VaultTaprootTree.recovery_script":
+		x.RecoveryScript = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.WithdrawParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.VaultTaprootTree"))
 		}
-		panic(fmt.Errorf("message side.btcbridge.WithdrawParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message side.btcbridge.VaultTaprootTree does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2346,22 +3248,16 @@ func (x *fastReflection_WithdrawParams) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_WithdrawParams) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_VaultTaprootTree) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "side.btcbridge.WithdrawParams.max_utxo_num":
-		value := x.MaxUtxoNum
-		return protoreflect.ValueOfUint32(value)
-	case "side.btcbridge.WithdrawParams.btc_batch_withdraw_period":
-		value := x.BtcBatchWithdrawPeriod
-		return protoreflect.ValueOfInt64(value)
-	case "side.btcbridge.WithdrawParams.max_btc_batch_withdraw_num":
-		value := x.MaxBtcBatchWithdrawNum
-		return protoreflect.ValueOfUint32(value)
+	case "side.btcbridge.VaultTaprootTree.recovery_script":
+		value := x.RecoveryScript
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.WithdrawParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.VaultTaprootTree"))
 		}
-		panic(fmt.Errorf("message side.btcbridge.WithdrawParams does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message side.btcbridge.VaultTaprootTree does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2375,19 +3271,15 @@ func (x *fastReflection_WithdrawParams) Get(descriptor protoreflect.FieldDescrip
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_WithdrawParams) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_VaultTaprootTree) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "side.btcbridge.WithdrawParams.max_utxo_num":
-		x.MaxUtxoNum = uint32(value.Uint())
-	case "side.btcbridge.WithdrawParams.btc_batch_withdraw_period":
-		x.BtcBatchWithdrawPeriod = value.Int()
-	case "side.btcbridge.WithdrawParams.max_btc_batch_withdraw_num":
-		x.MaxBtcBatchWithdrawNum = uint32(value.Uint())
+	case "side.btcbridge.VaultTaprootTree.recovery_script":
+		x.RecoveryScript = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.WithdrawParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.VaultTaprootTree"))
 		}
-		panic(fmt.Errorf("message side.btcbridge.WithdrawParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message side.btcbridge.VaultTaprootTree does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2401,48 +3293,40 @@ func (x *fastReflection_WithdrawParams) Set(fd protoreflect.FieldDescriptor, val
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_WithdrawParams) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_VaultTaprootTree) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "side.btcbridge.WithdrawParams.max_utxo_num":
-		panic(fmt.Errorf("field max_utxo_num of message side.btcbridge.WithdrawParams is not mutable"))
-	case "side.btcbridge.WithdrawParams.btc_batch_withdraw_period":
-		panic(fmt.Errorf("field btc_batch_withdraw_period of message side.btcbridge.WithdrawParams is not mutable"))
-	case "side.btcbridge.WithdrawParams.max_btc_batch_withdraw_num":
-		panic(fmt.Errorf("field max_btc_batch_withdraw_num of message side.btcbridge.WithdrawParams is not mutable"))
+	case "side.btcbridge.VaultTaprootTree.recovery_script":
+		panic(fmt.Errorf("field recovery_script of message side.btcbridge.VaultTaprootTree is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.WithdrawParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.VaultTaprootTree"))
 		}
-		panic(fmt.Errorf("message side.btcbridge.WithdrawParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message side.btcbridge.VaultTaprootTree does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_WithdrawParams) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_VaultTaprootTree) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "side.btcbridge.WithdrawParams.max_utxo_num":
-		return protoreflect.ValueOfUint32(uint32(0))
-	case "side.btcbridge.WithdrawParams.btc_batch_withdraw_period":
-		return protoreflect.ValueOfInt64(int64(0))
-	case "side.btcbridge.WithdrawParams.max_btc_batch_withdraw_num":
-		return protoreflect.ValueOfUint32(uint32(0))
+	case "side.btcbridge.VaultTaprootTree.recovery_script":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.WithdrawParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.VaultTaprootTree"))
 		}
-		panic(fmt.Errorf("message side.btcbridge.WithdrawParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message side.btcbridge.VaultTaprootTree does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_WithdrawParams) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_VaultTaprootTree) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in side.btcbridge.WithdrawParams", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in side.btcbridge.VaultTaprootTree", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -2450,7 +3334,7 @@ func (x *fastReflection_WithdrawParams) WhichOneof(d protoreflect.OneofDescripto
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_WithdrawParams) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_VaultTaprootTree) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -2461,7 +3345,7 @@ func (x *fastReflection_WithdrawParams) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_WithdrawParams) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_VaultTaprootTree) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -2473,7 +3357,7 @@ func (x *fastReflection_WithdrawParams) SetUnknown(fields protoreflect.RawFields
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_WithdrawParams) IsValid() bool {
+func (x *fastReflection_VaultTaprootTree) IsValid() bool {
 	return x != nil
 }
 
@@ -2483,9 +3367,9 @@ func (x *fastReflection_WithdrawParams) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_WithdrawParams) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_VaultTaprootTree) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*WithdrawParams)
+		x := input.Message.Interface().(*VaultTaprootTree)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2497,14 +3381,9 @@ func (x *fastReflection_WithdrawParams) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.MaxUtxoNum != 0 {
-			n += 1 + runtime.Sov(uint64(x.MaxUtxoNum))
-		}
-		if x.BtcBatchWithdrawPeriod != 0 {
-			n += 1 + runtime.Sov(uint64(x.BtcBatchWithdrawPeriod))
-		}
-		if x.MaxBtcBatchWithdrawNum != 0 {
-			n += 1 + runtime.Sov(uint64(x.MaxBtcBatchWithdrawNum))
+		l = len(x.RecoveryScript)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -2516,7 +3395,7 @@ func (x *fastReflection_WithdrawParams) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*WithdrawParams)
+		x := input.Message.Interface().(*VaultTaprootTree)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2535,20 +3414,12 @@ func (x *fastReflection_WithdrawParams) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.MaxBtcBatchWithdrawNum != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxBtcBatchWithdrawNum))
-			i--
-			dAtA[i] = 0x18
-		}
-		if x.BtcBatchWithdrawPeriod != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.BtcBatchWithdrawPeriod))
-			i--
-			dAtA[i] = 0x10
-		}
-		if x.MaxUtxoNum != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxUtxoNum))
+		if len(x.RecoveryScript) > 0 {
+			i -= len(x.RecoveryScript)
+			copy(dAtA[i:], x.RecoveryScript)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RecoveryScript)))
 			i--
-			dAtA[i] = 0x8
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -2561,7 +3432,7 @@ func (x *fastReflection_WithdrawParams) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*WithdrawParams)
+		x := input.Message.Interface().(*VaultTaprootTree)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2593,17 +3464,17 @@ func (x *fastReflection_WithdrawParams) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: WithdrawParams: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: VaultTaprootTree: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: WithdrawParams: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: VaultTaprootTree: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxUtxoNum", wireType)
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RecoveryScript", wireType)
 				}
-				x.MaxUtxoNum = 0
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -2613,49 +3484,24 @@ func (x *fastReflection_WithdrawParams) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.MaxUtxoNum |= uint32(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-			case 2:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BtcBatchWithdrawPeriod", wireType)
-				}
-				x.BtcBatchWithdrawPeriod = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.BtcBatchWithdrawPeriod |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-			case 3:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxBtcBatchWithdrawNum", wireType)
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				x.MaxBtcBatchWithdrawNum = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.MaxBtcBatchWithdrawNum |= uint32(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
+				x.RecoveryScript = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2691,30 +3537,76 @@ func (x *fastReflection_WithdrawParams) ProtoMethods() *protoiface.Methods {
 	}
 }
 
+var _ protoreflect.List = (*_VaultRecoveryPolicy_1_list)(nil)
+
+type _VaultRecoveryPolicy_1_list struct {
+	list *[]string
+}
+
+func (x *_VaultRecoveryPolicy_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_VaultRecoveryPolicy_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_VaultRecoveryPolicy_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_VaultRecoveryPolicy_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_VaultRecoveryPolicy_1_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message VaultRecoveryPolicy at list field PubKeys as it is not of Message kind"))
+}
+
+func (x *_VaultRecoveryPolicy_1_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_VaultRecoveryPolicy_1_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_VaultRecoveryPolicy_1_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_ProtocolLimits                  protoreflect.MessageDescriptor
-	fd_ProtocolLimits_btc_min_deposit  protoreflect.FieldDescriptor
-	fd_ProtocolLimits_btc_min_withdraw protoreflect.FieldDescriptor
-	fd_ProtocolLimits_btc_max_withdraw protoreflect.FieldDescriptor
+	md_VaultRecoveryPolicy           protoreflect.MessageDescriptor
+	fd_VaultRecoveryPolicy_pub_keys  protoreflect.FieldDescriptor
+	fd_VaultRecoveryPolicy_threshold protoreflect.FieldDescriptor
+	fd_VaultRecoveryPolicy_timelock  protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_side_btcbridge_params_proto_init()
-	md_ProtocolLimits = File_side_btcbridge_params_proto.Messages().ByName("ProtocolLimits")
-	fd_ProtocolLimits_btc_min_deposit = md_ProtocolLimits.Fields().ByName("btc_min_deposit")
-	fd_ProtocolLimits_btc_min_withdraw = md_ProtocolLimits.Fields().ByName("btc_min_withdraw")
-	fd_ProtocolLimits_btc_max_withdraw = md_ProtocolLimits.Fields().ByName("btc_max_withdraw")
+	md_VaultRecoveryPolicy = File_side_btcbridge_params_proto.Messages().ByName("VaultRecoveryPolicy")
+	fd_VaultRecoveryPolicy_pub_keys = md_VaultRecoveryPolicy.Fields().ByName("pub_keys")
+	fd_VaultRecoveryPolicy_threshold = md_VaultRecoveryPolicy.Fields().ByName("threshold")
+	fd_VaultRecoveryPolicy_timelock = md_VaultRecoveryPolicy.Fields().ByName("timelock")
 }
 
-var _ protoreflect.Message = (*fastReflection_ProtocolLimits)(nil)
+var _ protoreflect.Message = (*fastReflection_VaultRecoveryPolicy)(nil)
 
-type fastReflection_ProtocolLimits ProtocolLimits
+type fastReflection_VaultRecoveryPolicy VaultRecoveryPolicy
 
-func (x *ProtocolLimits) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_ProtocolLimits)(x)
+func (x *VaultRecoveryPolicy) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_VaultRecoveryPolicy)(x)
 }
 
-func (x *ProtocolLimits) slowProtoReflect() protoreflect.Message {
+func (x *VaultRecoveryPolicy) slowProtoReflect() protoreflect.Message {
 	mi := &file_side_btcbridge_params_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2726,43 +3618,43 @@ func (x *ProtocolLimits) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_ProtocolLimits_messageType fastReflection_ProtocolLimits_messageType
-var _ protoreflect.MessageType = fastReflection_ProtocolLimits_messageType{}
+var _fastReflection_VaultRecoveryPolicy_messageType fastReflection_VaultRecoveryPolicy_messageType
+var _ protoreflect.MessageType = fastReflection_VaultRecoveryPolicy_messageType{}
 
-type fastReflection_ProtocolLimits_messageType struct{}
+type fastReflection_VaultRecoveryPolicy_messageType struct{}
 
-func (x fastReflection_ProtocolLimits_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_ProtocolLimits)(nil)
+func (x fastReflection_VaultRecoveryPolicy_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_VaultRecoveryPolicy)(nil)
 }
-func (x fastReflection_ProtocolLimits_messageType) New() protoreflect.Message {
-	return new(fastReflection_ProtocolLimits)
+func (x fastReflection_VaultRecoveryPolicy_messageType) New() protoreflect.Message {
+	return new(fastReflection_VaultRecoveryPolicy)
 }
-func (x fastReflection_ProtocolLimits_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_ProtocolLimits
+func (x fastReflection_VaultRecoveryPolicy_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_VaultRecoveryPolicy
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_ProtocolLimits) Descriptor() protoreflect.MessageDescriptor {
-	return md_ProtocolLimits
+func (x *fastReflection_VaultRecoveryPolicy) Descriptor() protoreflect.MessageDescriptor {
+	return md_VaultRecoveryPolicy
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_ProtocolLimits) Type() protoreflect.MessageType {
-	return _fastReflection_ProtocolLimits_messageType
+func (x *fastReflection_VaultRecoveryPolicy) Type() protoreflect.MessageType {
+	return _fastReflection_VaultRecoveryPolicy_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_ProtocolLimits) New() protoreflect.Message {
-	return new(fastReflection_ProtocolLimits)
+func (x *fastReflection_VaultRecoveryPolicy) New() protoreflect.Message {
+	return new(fastReflection_VaultRecoveryPolicy)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_ProtocolLimits) Interface() protoreflect.ProtoMessage {
-	return (*ProtocolLimits)(x)
+func (x *fastReflection_VaultRecoveryPolicy) Interface() protoreflect.ProtoMessage {
+	return (*VaultRecoveryPolicy)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2770,22 +3662,22 @@ func (x *fastReflection_ProtocolLimits) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_ProtocolLimits) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.BtcMinDeposit != int64(0) {
-		value := protoreflect.ValueOfInt64(x.BtcMinDeposit)
-		if !f(fd_ProtocolLimits_btc_min_deposit, value) {
+func (x *fastReflection_VaultRecoveryPolicy) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.PubKeys) != 0 {
+		value := protoreflect.ValueOfList(&_VaultRecoveryPolicy_1_list{list: &x.PubKeys})
+		if !f(fd_VaultRecoveryPolicy_pub_keys, value) {
 			return
 		}
 	}
-	if x.BtcMinWithdraw != int64(0) {
-		value := protoreflect.ValueOfInt64(x.BtcMinWithdraw)
-		if !f(fd_ProtocolLimits_btc_min_withdraw, value) {
+	if x.Threshold != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Threshold)
+		if !f(fd_VaultRecoveryPolicy_threshold, value) {
 			return
 		}
 	}
-	if x.BtcMaxWithdraw != int64(0) {
-		value := protoreflect.ValueOfInt64(x.BtcMaxWithdraw)
-		if !f(fd_ProtocolLimits_btc_max_withdraw, value) {
+	if x.Timelock != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Timelock)
+		if !f(fd_VaultRecoveryPolicy_timelock, value) {
 			return
 		}
 	}
@@ -2802,19 +3694,19 @@ func (x *fastReflection_ProtocolLimits) Range(f func(protoreflect.FieldDescripto
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_ProtocolLimits) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_VaultRecoveryPolicy) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "side.btcbridge.ProtocolLimits.btc_min_deposit":
-		return x.BtcMinDeposit != int64(0)
-	case "side.btcbridge.ProtocolLimits.btc_min_withdraw":
-		return x.BtcMinWithdraw != int64(0)
-	case "side.btcbridge.ProtocolLimits.btc_max_withdraw":
-		return x.BtcMaxWithdraw != int64(0)
+	case "side.btcbridge.VaultRecoveryPolicy.pub_keys":
+		return len(x.PubKeys) != 0
+	case "side.btcbridge.VaultRecoveryPolicy.threshold":
+		return x.Threshold != uint32(0)
+	case "side.btcbridge.VaultRecoveryPolicy.timelock":
+		return x.Timelock != uint32(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.ProtocolLimits"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.VaultRecoveryPolicy"))
 		}
-		panic(fmt.Errorf("message side.btcbridge.ProtocolLimits does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message side.btcbridge.VaultRecoveryPolicy does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2824,19 +3716,19 @@ func (x *fastReflection_ProtocolLimits) Has(fd protoreflect.FieldDescriptor) boo
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ProtocolLimits) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_VaultRecoveryPolicy) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "side.btcbridge.ProtocolLimits.btc_min_deposit":
-		x.BtcMinDeposit = int64(0)
-	case "side.btcbridge.ProtocolLimits.btc_min_withdraw":
-		x.BtcMinWithdraw = int64(0)
-	case "side.btcbridge.ProtocolLimits.btc_max_withdraw":
-		x.BtcMaxWithdraw = int64(0)
+	case "side.btcbridge.VaultRecoveryPolicy.pub_keys":
+		x.PubKeys = nil
+	case "side.btcbridge.VaultRecoveryPolicy.threshold":
+		x.Threshold = uint32(0)
+	case "side.btcbridge.VaultRecoveryPolicy.timelock":
+		x.Timelock = uint32(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.ProtocolLimits"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.VaultRecoveryPolicy"))
 		}
-		panic(fmt.Errorf("message side.btcbridge.ProtocolLimits does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message side.btcbridge.VaultRecoveryPolicy does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2846,22 +3738,25 @@ func (x *fastReflection_ProtocolLimits) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_ProtocolLimits) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_VaultRecoveryPolicy) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "side.btcbridge.ProtocolLimits.btc_min_deposit":
-		value := x.BtcMinDeposit
-		return protoreflect.ValueOfInt64(value)
-	case "side.btcbridge.ProtocolLimits.btc_min_withdraw":
-		value := x.BtcMinWithdraw
-		return protoreflect.ValueOfInt64(value)
-	case "side.btcbridge.ProtocolLimits.btc_max_withdraw":
-		value := x.BtcMaxWithdraw
-		return protoreflect.ValueOfInt64(value)
+	case "side.btcbridge.VaultRecoveryPolicy.pub_keys":
+		if len(x.PubKeys) == 0 {
+			return protoreflect.ValueOfList(&_VaultRecoveryPolicy_1_list{})
+		}
+		listValue := &_VaultRecoveryPolicy_1_list{list: &x.PubKeys}
+		return protoreflect.ValueOfList(listValue)
+	case "side.btcbridge.VaultRecoveryPolicy.threshold":
+		value := x.Threshold
+		return protoreflect.ValueOfUint32(value)
+	case "side.btcbridge.VaultRecoveryPolicy.timelock":
+		value := x.Timelock
+		return protoreflect.ValueOfUint32(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.ProtocolLimits"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.VaultRecoveryPolicy"))
 		}
-		panic(fmt.Errorf("message side.btcbridge.ProtocolLimits does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message side.btcbridge.VaultRecoveryPolicy does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2875,19 +3770,21 @@ func (x *fastReflection_ProtocolLimits) Get(descriptor protoreflect.FieldDescrip
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ProtocolLimits) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_VaultRecoveryPolicy) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "side.btcbridge.ProtocolLimits.btc_min_deposit":
-		x.BtcMinDeposit = value.Int()
-	case "side.btcbridge.ProtocolLimits.btc_min_withdraw":
-		x.BtcMinWithdraw = value.Int()
-	case "side.btcbridge.ProtocolLimits.btc_max_withdraw":
-		x.BtcMaxWithdraw = value.Int()
+	case "side.btcbridge.VaultRecoveryPolicy.pub_keys":
+		lv := value.List()
+		clv := lv.(*_VaultRecoveryPolicy_1_list)
+		x.PubKeys = *clv.list
+	case "side.btcbridge.VaultRecoveryPolicy.threshold":
+		x.Threshold = uint32(value.Uint())
+	case "side.btcbridge.VaultRecoveryPolicy.timelock":
+		x.Timelock = uint32(value.Uint())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.ProtocolLimits"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.VaultRecoveryPolicy"))
 		}
-		panic(fmt.Errorf("message side.btcbridge.ProtocolLimits does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message side.btcbridge.VaultRecoveryPolicy does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2901,48 +3798,53 @@ func (x *fastReflection_ProtocolLimits) Set(fd protoreflect.FieldDescriptor, val
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ProtocolLimits) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_VaultRecoveryPolicy) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "side.btcbridge.ProtocolLimits.btc_min_deposit":
-		panic(fmt.Errorf("field btc_min_deposit of message side.btcbridge.ProtocolLimits is not mutable"))
-	case "side.btcbridge.ProtocolLimits.btc_min_withdraw":
-		panic(fmt.Errorf("field btc_min_withdraw of message side.btcbridge.ProtocolLimits is not mutable"))
-	case "side.btcbridge.ProtocolLimits.btc_max_withdraw":
-		panic(fmt.Errorf("field btc_max_withdraw of message side.btcbridge.ProtocolLimits is not mutable"))
+	case "side.btcbridge.VaultRecoveryPolicy.pub_keys":
+		if x.PubKeys == nil {
+			x.PubKeys = []string{}
+		}
+		value := &_VaultRecoveryPolicy_1_list{list: &x.PubKeys}
+		return protoreflect.ValueOfList(value)
+	case "side.btcbridge.VaultRecoveryPolicy.threshold":
+		panic(fmt.Errorf("field threshold of message side.btcbridge.VaultRecoveryPolicy is not mutable"))
+	case "side.btcbridge.VaultRecoveryPolicy.timelock":
+		panic(fmt.Errorf("field timelock of message side.btcbridge.VaultRecoveryPolicy is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.ProtocolLimits"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.VaultRecoveryPolicy"))
 		}
-		panic(fmt.Errorf("message side.btcbridge.ProtocolLimits does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message side.btcbridge.VaultRecoveryPolicy does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_ProtocolLimits) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_VaultRecoveryPolicy) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "side.btcbridge.ProtocolLimits.btc_min_deposit":
-		return protoreflect.ValueOfInt64(int64(0))
-	case "side.btcbridge.ProtocolLimits.btc_min_withdraw":
-		return protoreflect.ValueOfInt64(int64(0))
-	case "side.btcbridge.ProtocolLimits.btc_max_withdraw":
-		return protoreflect.ValueOfInt64(int64(0))
+	case "side.btcbridge.VaultRecoveryPolicy.pub_keys":
+		list := []string{}
+		return protoreflect.ValueOfList(&_VaultRecoveryPolicy_1_list{list: &list})
+	case "side.btcbridge.VaultRecoveryPolicy.threshold":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "side.btcbridge.VaultRecoveryPolicy.timelock":
+		return protoreflect.ValueOfUint32(uint32(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.ProtocolLimits"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.VaultRecoveryPolicy"))
 		}
-		panic(fmt.Errorf("message side.btcbridge.ProtocolLimits does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message side.btcbridge.VaultRecoveryPolicy does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_ProtocolLimits) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_VaultRecoveryPolicy) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in side.btcbridge.ProtocolLimits", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in side.btcbridge.VaultRecoveryPolicy", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -2950,7 +3852,7 @@ func (x *fastReflection_ProtocolLimits) WhichOneof(d protoreflect.OneofDescripto
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_ProtocolLimits) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_VaultRecoveryPolicy) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -2961,7 +3863,7 @@ func (x *fastReflection_ProtocolLimits) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ProtocolLimits) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_VaultRecoveryPolicy) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -2973,7 +3875,7 @@ func (x *fastReflection_ProtocolLimits) SetUnknown(fields protoreflect.RawFields
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_ProtocolLimits) IsValid() bool {
+func (x *fastReflection_VaultRecoveryPolicy) IsValid() bool {
 	return x != nil
 }
 
@@ -2983,9 +3885,9 @@ func (x *fastReflection_ProtocolLimits) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_ProtocolLimits) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_VaultRecoveryPolicy) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*ProtocolLimits)
+		x := input.Message.Interface().(*VaultRecoveryPolicy)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2997,14 +3899,17 @@ func (x *fastReflection_ProtocolLimits) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.BtcMinDeposit != 0 {
-			n += 1 + runtime.Sov(uint64(x.BtcMinDeposit))
+		if len(x.PubKeys) > 0 {
+			for _, s := range x.PubKeys {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
 		}
-		if x.BtcMinWithdraw != 0 {
-			n += 1 + runtime.Sov(uint64(x.BtcMinWithdraw))
+		if x.Threshold != 0 {
+			n += 1 + runtime.Sov(uint64(x.Threshold))
 		}
-		if x.BtcMaxWithdraw != 0 {
-			n += 1 + runtime.Sov(uint64(x.BtcMaxWithdraw))
+		if x.Timelock != 0 {
+			n += 1 + runtime.Sov(uint64(x.Timelock))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -3016,7 +3921,7 @@ func (x *fastReflection_ProtocolLimits) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*ProtocolLimits)
+		x := input.Message.Interface().(*VaultRecoveryPolicy)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3035,20 +3940,24 @@ func (x *fastReflection_ProtocolLimits) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.BtcMaxWithdraw != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.BtcMaxWithdraw))
+		if x.Timelock != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Timelock))
 			i--
 			dAtA[i] = 0x18
 		}
-		if x.BtcMinWithdraw != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.BtcMinWithdraw))
+		if x.Threshold != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Threshold))
 			i--
 			dAtA[i] = 0x10
 		}
-		if x.BtcMinDeposit != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.BtcMinDeposit))
-			i--
-			dAtA[i] = 0x8
+		if len(x.PubKeys) > 0 {
+			for iNdEx := len(x.PubKeys) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.PubKeys[iNdEx])
+				copy(dAtA[i:], x.PubKeys[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PubKeys[iNdEx])))
+				i--
+				dAtA[i] = 0xa
+			}
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -3061,7 +3970,7 @@ func (x *fastReflection_ProtocolLimits) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*ProtocolLimits)
+		x := input.Message.Interface().(*VaultRecoveryPolicy)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3093,17 +4002,17 @@ func (x *fastReflection_ProtocolLimits) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ProtocolLimits: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: VaultRecoveryPolicy: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ProtocolLimits: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: VaultRecoveryPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BtcMinDeposit", wireType)
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PubKeys", wireType)
 				}
-				x.BtcMinDeposit = 0
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -3113,16 +4022,29 @@ func (x *fastReflection_ProtocolLimits) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.BtcMinDeposit |= int64(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PubKeys = append(x.PubKeys, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
 			case 2:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BtcMinWithdraw", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
 				}
-				x.BtcMinWithdraw = 0
+				x.Threshold = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -3132,16 +4054,16 @@ func (x *fastReflection_ProtocolLimits) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.BtcMinWithdraw |= int64(b&0x7F) << shift
+					x.Threshold |= uint32(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
 			case 3:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BtcMaxWithdraw", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Timelock", wireType)
 				}
-				x.BtcMaxWithdraw = 0
+				x.Timelock = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -3151,7 +4073,7 @@ func (x *fastReflection_ProtocolLimits) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.BtcMaxWithdraw |= int64(b&0x7F) << shift
+					x.Timelock |= uint32(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
@@ -3191,30 +4113,76 @@ func (x *fastReflection_ProtocolLimits) ProtoMethods() *protoiface.Methods {
 	}
 }
 
+var _ protoreflect.List = (*_VaultDescriptor_3_list)(nil)
+
+type _VaultDescriptor_3_list struct {
+	list *[]string
+}
+
+func (x *_VaultDescriptor_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_VaultDescriptor_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_VaultDescriptor_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_VaultDescriptor_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_VaultDescriptor_3_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message VaultDescriptor at list field PubKeys as it is not of Message kind"))
+}
+
+func (x *_VaultDescriptor_3_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_VaultDescriptor_3_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_VaultDescriptor_3_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_ProtocolFees              protoreflect.MessageDescriptor
-	fd_ProtocolFees_deposit_fee  protoreflect.FieldDescriptor
-	fd_ProtocolFees_withdraw_fee protoreflect.FieldDescriptor
-	fd_ProtocolFees_collector    protoreflect.FieldDescriptor
+	md_VaultDescriptor             protoreflect.MessageDescriptor
+	fd_VaultDescriptor_script_type protoreflect.FieldDescriptor
+	fd_VaultDescriptor_threshold   protoreflect.FieldDescriptor
+	fd_VaultDescriptor_pub_keys    protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_side_btcbridge_params_proto_init()
-	md_ProtocolFees = File_side_btcbridge_params_proto.Messages().ByName("ProtocolFees")
-	fd_ProtocolFees_deposit_fee = md_ProtocolFees.Fields().ByName("deposit_fee")
-	fd_ProtocolFees_withdraw_fee = md_ProtocolFees.Fields().ByName("withdraw_fee")
-	fd_ProtocolFees_collector = md_ProtocolFees.Fields().ByName("collector")
+	md_VaultDescriptor = File_side_btcbridge_params_proto.Messages().ByName("VaultDescriptor")
+	fd_VaultDescriptor_script_type = md_VaultDescriptor.Fields().ByName("script_type")
+	fd_VaultDescriptor_threshold = md_VaultDescriptor.Fields().ByName("threshold")
+	fd_VaultDescriptor_pub_keys = md_VaultDescriptor.Fields().ByName("pub_keys")
 }
 
-var _ protoreflect.Message = (*fastReflection_ProtocolFees)(nil)
+var _ protoreflect.Message = (*fastReflection_VaultDescriptor)(nil)
 
-type fastReflection_ProtocolFees ProtocolFees
+type fastReflection_VaultDescriptor VaultDescriptor
 
-func (x *ProtocolFees) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_ProtocolFees)(x)
+func (x *VaultDescriptor) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_VaultDescriptor)(x)
 }
 
-func (x *ProtocolFees) slowProtoReflect() protoreflect.Message {
+func (x *VaultDescriptor) slowProtoReflect() protoreflect.Message {
 	mi := &file_side_btcbridge_params_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3226,43 +4194,43 @@ func (x *ProtocolFees) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_ProtocolFees_messageType fastReflection_ProtocolFees_messageType
-var _ protoreflect.MessageType = fastReflection_ProtocolFees_messageType{}
+var _fastReflection_VaultDescriptor_messageType fastReflection_VaultDescriptor_messageType
+var _ protoreflect.MessageType = fastReflection_VaultDescriptor_messageType{}
 
-type fastReflection_ProtocolFees_messageType struct{}
+type fastReflection_VaultDescriptor_messageType struct{}
 
-func (x fastReflection_ProtocolFees_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_ProtocolFees)(nil)
+func (x fastReflection_VaultDescriptor_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_VaultDescriptor)(nil)
 }
-func (x fastReflection_ProtocolFees_messageType) New() protoreflect.Message {
-	return new(fastReflection_ProtocolFees)
+func (x fastReflection_VaultDescriptor_messageType) New() protoreflect.Message {
+	return new(fastReflection_VaultDescriptor)
 }
-func (x fastReflection_ProtocolFees_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_ProtocolFees
+func (x fastReflection_VaultDescriptor_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_VaultDescriptor
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_ProtocolFees) Descriptor() protoreflect.MessageDescriptor {
-	return md_ProtocolFees
+func (x *fastReflection_VaultDescriptor) Descriptor() protoreflect.MessageDescriptor {
+	return md_VaultDescriptor
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_ProtocolFees) Type() protoreflect.MessageType {
-	return _fastReflection_ProtocolFees_messageType
+func (x *fastReflection_VaultDescriptor) Type() protoreflect.MessageType {
+	return _fastReflection_VaultDescriptor_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_ProtocolFees) New() protoreflect.Message {
-	return new(fastReflection_ProtocolFees)
+func (x *fastReflection_VaultDescriptor) New() protoreflect.Message {
+	return new(fastReflection_VaultDescriptor)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_ProtocolFees) Interface() protoreflect.ProtoMessage {
-	return (*ProtocolFees)(x)
+func (x *fastReflection_VaultDescriptor) Interface() protoreflect.ProtoMessage {
+	return (*VaultDescriptor)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3270,22 +4238,22 @@ func (x *fastReflection_ProtocolFees) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_ProtocolFees) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.DepositFee != int64(0) {
-		value := protoreflect.ValueOfInt64(x.DepositFee)
-		if !f(fd_ProtocolFees_deposit_fee, value) {
+func (x *fastReflection_VaultDescriptor) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ScriptType != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.ScriptType))
+		if !f(fd_VaultDescriptor_script_type, value) {
 			return
 		}
 	}
-	if x.WithdrawFee != int64(0) {
-		value := protoreflect.ValueOfInt64(x.WithdrawFee)
-		if !f(fd_ProtocolFees_withdraw_fee, value) {
+	if x.Threshold != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Threshold)
+		if !f(fd_VaultDescriptor_threshold, value) {
 			return
 		}
 	}
-	if x.Collector != "" {
-		value := protoreflect.ValueOfString(x.Collector)
-		if !f(fd_ProtocolFees_collector, value) {
+	if len(x.PubKeys) != 0 {
+		value := protoreflect.ValueOfList(&_VaultDescriptor_3_list{list: &x.PubKeys})
+		if !f(fd_VaultDescriptor_pub_keys, value) {
 			return
 		}
 	}
@@ -3302,19 +4270,19 @@ func (x *fastReflection_ProtocolFees) Range(f func(protoreflect.FieldDescriptor,
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_ProtocolFees) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_VaultDescriptor) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "side.btcbridge.ProtocolFees.deposit_fee":
-		return x.DepositFee != int64(0)
-	case "side.btcbridge.ProtocolFees.withdraw_fee":
-		return x.WithdrawFee != int64(0)
-	case "side.btcbridge.ProtocolFees.collector":
-		return x.Collector != ""
+	case "side.btcbridge.VaultDescriptor.script_type":
+		return x.ScriptType != 0
+	case "side.btcbridge.VaultDescriptor.threshold":
+		return x.Threshold != uint32(0)
+	case "side.btcbridge.VaultDescriptor.pub_keys":
+		return len(x.PubKeys) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.ProtocolFees"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.VaultDescriptor"))
 		}
-		panic(fmt.Errorf("message side.btcbridge.ProtocolFees does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message side.btcbridge.VaultDescriptor does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3324,19 +4292,19 @@ func (x *fastReflection_ProtocolFees) Has(fd protoreflect.FieldDescriptor) bool
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ProtocolFees) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_VaultDescriptor) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "side.btcbridge.ProtocolFees.deposit_fee":
-		x.DepositFee = int64(0)
-	case "side.btcbridge.ProtocolFees.withdraw_fee":
-		x.WithdrawFee = int64(0)
-	case "side.btcbridge.ProtocolFees.collector":
-		x.Collector = ""
+	case "side.btcbridge.VaultDescriptor.script_type":
+		x.ScriptType = 0
+	case "side.btcbridge.VaultDescriptor.threshold":
+		x.Threshold = uint32(0)
+	case "side.btcbridge.VaultDescriptor.pub_keys":
+		x.PubKeys = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.ProtocolFees"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.VaultDescriptor"))
 		}
-		panic(fmt.Errorf("message side.btcbridge.ProtocolFees does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message side.btcbridge.VaultDescriptor does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3346,22 +4314,25 @@ func (x *fastReflection_ProtocolFees) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_ProtocolFees) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_VaultDescriptor) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "side.btcbridge.ProtocolFees.deposit_fee":
-		value := x.DepositFee
-		return protoreflect.ValueOfInt64(value)
-	case "side.btcbridge.ProtocolFees.withdraw_fee":
-		value := x.WithdrawFee
-		return protoreflect.ValueOfInt64(value)
-	case "side.btcbridge.ProtocolFees.collector":
-		value := x.Collector
-		return protoreflect.ValueOfString(value)
+	case "side.btcbridge.VaultDescriptor.script_type":
+		value := x.ScriptType
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "side.btcbridge.VaultDescriptor.threshold":
+		value := x.Threshold
+		return protoreflect.ValueOfUint32(value)
+	case "side.btcbridge.VaultDescriptor.pub_keys":
+		if len(x.PubKeys) == 0 {
+			return protoreflect.ValueOfList(&_VaultDescriptor_3_list{})
+		}
+		listValue := &_VaultDescriptor_3_list{list: &x.PubKeys}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.ProtocolFees"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.VaultDescriptor"))
 		}
-		panic(fmt.Errorf("message side.btcbridge.ProtocolFees does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message side.btcbridge.VaultDescriptor does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -3375,19 +4346,21 @@ func (x *fastReflection_ProtocolFees) Get(descriptor protoreflect.FieldDescripto
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ProtocolFees) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_VaultDescriptor) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "side.btcbridge.ProtocolFees.deposit_fee":
-		x.DepositFee = value.Int()
-	case "side.btcbridge.ProtocolFees.withdraw_fee":
-		x.WithdrawFee = value.Int()
-	case "side.btcbridge.ProtocolFees.collector":
-		x.Collector = value.Interface().(string)
+	case "side.btcbridge.VaultDescriptor.script_type":
+		x.ScriptType = (VaultScriptType)(value.Enum())
+	case "side.btcbridge.VaultDescriptor.threshold":
+		x.Threshold = uint32(value.Uint())
+	case "side.btcbridge.VaultDescriptor.pub_keys":
+		lv := value.List()
+		clv := lv.(*_VaultDescriptor_3_list)
+		x.PubKeys = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.ProtocolFees"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.VaultDescriptor"))
 		}
-		panic(fmt.Errorf("message side.btcbridge.ProtocolFees does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message side.btcbridge.VaultDescriptor does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3401,48 +4374,53 @@ func (x *fastReflection_ProtocolFees) Set(fd protoreflect.FieldDescriptor, value
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ProtocolFees) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_VaultDescriptor) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "side.btcbridge.ProtocolFees.deposit_fee":
-		panic(fmt.Errorf("field deposit_fee of message side.btcbridge.ProtocolFees is not mutable"))
-	case "side.btcbridge.ProtocolFees.withdraw_fee":
-		panic(fmt.Errorf("field withdraw_fee of message side.btcbridge.ProtocolFees is not mutable"))
-	case "side.btcbridge.ProtocolFees.collector":
-		panic(fmt.Errorf("field collector of message side.btcbridge.ProtocolFees is not mutable"))
+	case "side.btcbridge.VaultDescriptor.pub_keys":
+		if x.PubKeys == nil {
+			x.PubKeys = []string{}
+		}
+		value := &_VaultDescriptor_3_list{list: &x.PubKeys}
+		return protoreflect.ValueOfList(value)
+	case "side.btcbridge.VaultDescriptor.script_type":
+		panic(fmt.Errorf("field script_type of message side.btcbridge.VaultDescriptor is not mutable"))
+	case "side.btcbridge.VaultDescriptor.threshold":
+		panic(fmt.Errorf("field threshold of message side.btcbridge.VaultDescriptor is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.ProtocolFees"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.VaultDescriptor"))
 		}
-		panic(fmt.Errorf("message side.btcbridge.ProtocolFees does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message side.btcbridge.VaultDescriptor does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_ProtocolFees) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_VaultDescriptor) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "side.btcbridge.ProtocolFees.deposit_fee":
-		return protoreflect.ValueOfInt64(int64(0))
-	case "side.btcbridge.ProtocolFees.withdraw_fee":
-		return protoreflect.ValueOfInt64(int64(0))
-	case "side.btcbridge.ProtocolFees.collector":
-		return protoreflect.ValueOfString("")
+	case "side.btcbridge.VaultDescriptor.script_type":
+		return protoreflect.ValueOfEnum(0)
+	case "side.btcbridge.VaultDescriptor.threshold":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "side.btcbridge.VaultDescriptor.pub_keys":
+		list := []string{}
+		return protoreflect.ValueOfList(&_VaultDescriptor_3_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.ProtocolFees"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.VaultDescriptor"))
 		}
-		panic(fmt.Errorf("message side.btcbridge.ProtocolFees does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message side.btcbridge.VaultDescriptor does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_ProtocolFees) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_VaultDescriptor) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in side.btcbridge.ProtocolFees", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in side.btcbridge.VaultDescriptor", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3450,7 +4428,7 @@ func (x *fastReflection_ProtocolFees) WhichOneof(d protoreflect.OneofDescriptor)
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_ProtocolFees) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_VaultDescriptor) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3461,7 +4439,7 @@ func (x *fastReflection_ProtocolFees) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ProtocolFees) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_VaultDescriptor) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3473,7 +4451,7 @@ func (x *fastReflection_ProtocolFees) SetUnknown(fields protoreflect.RawFields)
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_ProtocolFees) IsValid() bool {
+func (x *fastReflection_VaultDescriptor) IsValid() bool {
 	return x != nil
 }
 
@@ -3483,9 +4461,9 @@ func (x *fastReflection_ProtocolFees) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_ProtocolFees) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_VaultDescriptor) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*ProtocolFees)
+		x := input.Message.Interface().(*VaultDescriptor)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3497,15 +4475,17 @@ func (x *fastReflection_ProtocolFees) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.DepositFee != 0 {
-			n += 1 + runtime.Sov(uint64(x.DepositFee))
+		if x.ScriptType != 0 {
+			n += 1 + runtime.Sov(uint64(x.ScriptType))
 		}
-		if x.WithdrawFee != 0 {
-			n += 1 + runtime.Sov(uint64(x.WithdrawFee))
+		if x.Threshold != 0 {
+			n += 1 + runtime.Sov(uint64(x.Threshold))
 		}
-		l = len(x.Collector)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if len(x.PubKeys) > 0 {
+			for _, s := range x.PubKeys {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -3517,7 +4497,7 @@ func (x *fastReflection_ProtocolFees) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*ProtocolFees)
+		x := input.Message.Interface().(*VaultDescriptor)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3536,20 +4516,22 @@ func (x *fastReflection_ProtocolFees) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Collector) > 0 {
-			i -= len(x.Collector)
-			copy(dAtA[i:], x.Collector)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Collector)))
-			i--
-			dAtA[i] = 0x1a
+		if len(x.PubKeys) > 0 {
+			for iNdEx := len(x.PubKeys) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.PubKeys[iNdEx])
+				copy(dAtA[i:], x.PubKeys[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PubKeys[iNdEx])))
+				i--
+				dAtA[i] = 0x1a
+			}
 		}
-		if x.WithdrawFee != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.WithdrawFee))
+		if x.Threshold != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Threshold))
 			i--
 			dAtA[i] = 0x10
 		}
-		if x.DepositFee != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.DepositFee))
+		if x.ScriptType != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ScriptType))
 			i--
 			dAtA[i] = 0x8
 		}
@@ -3564,7 +4546,7 @@ func (x *fastReflection_ProtocolFees) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*ProtocolFees)
+		x := input.Message.Interface().(*VaultDescriptor)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3596,17 +4578,17 @@ func (x *fastReflection_ProtocolFees) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ProtocolFees: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: VaultDescriptor: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ProtocolFees: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: VaultDescriptor: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DepositFee", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ScriptType", wireType)
 				}
-				x.DepositFee = 0
+				x.ScriptType = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -3616,16 +4598,16 @@ func (x *fastReflection_ProtocolFees) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.DepositFee |= int64(b&0x7F) << shift
+					x.ScriptType |= VaultScriptType(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
 			case 2:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WithdrawFee", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
 				}
-				x.WithdrawFee = 0
+				x.Threshold = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -3635,14 +4617,14 @@ func (x *fastReflection_ProtocolFees) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.WithdrawFee |= int64(b&0x7F) << shift
+					x.Threshold |= uint32(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
 			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Collector", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PubKeys", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -3670,7 +4652,7 @@ func (x *fastReflection_ProtocolFees) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Collector = string(dAtA[iNdEx:postIndex])
+				x.PubKeys = append(x.PubKeys, string(dAtA[iNdEx:postIndex]))
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -3708,27 +4690,31 @@ func (x *fastReflection_ProtocolFees) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_TSSParams                                      protoreflect.MessageDescriptor
-	fd_TSSParams_dkg_timeout_period                   protoreflect.FieldDescriptor
-	fd_TSSParams_participant_update_transition_period protoreflect.FieldDescriptor
+	md_WithdrawParams                            protoreflect.MessageDescriptor
+	fd_WithdrawParams_max_utxo_num               protoreflect.FieldDescriptor
+	fd_WithdrawParams_btc_batch_withdraw_period  protoreflect.FieldDescriptor
+	fd_WithdrawParams_max_btc_batch_withdraw_num protoreflect.FieldDescriptor
+	fd_WithdrawParams_coin_selection             protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_side_btcbridge_params_proto_init()
-	md_TSSParams = File_side_btcbridge_params_proto.Messages().ByName("TSSParams")
-	fd_TSSParams_dkg_timeout_period = md_TSSParams.Fields().ByName("dkg_timeout_period")
-	fd_TSSParams_participant_update_transition_period = md_TSSParams.Fields().ByName("participant_update_transition_period")
+	md_WithdrawParams = File_side_btcbridge_params_proto.Messages().ByName("WithdrawParams")
+	fd_WithdrawParams_max_utxo_num = md_WithdrawParams.Fields().ByName("max_utxo_num")
+	fd_WithdrawParams_btc_batch_withdraw_period = md_WithdrawParams.Fields().ByName("btc_batch_withdraw_period")
+	fd_WithdrawParams_max_btc_batch_withdraw_num = md_WithdrawParams.Fields().ByName("max_btc_batch_withdraw_num")
+	fd_WithdrawParams_coin_selection = md_WithdrawParams.Fields().ByName("coin_selection")
 }
 
-var _ protoreflect.Message = (*fastReflection_TSSParams)(nil)
+var _ protoreflect.Message = (*fastReflection_WithdrawParams)(nil)
 
-type fastReflection_TSSParams TSSParams
+type fastReflection_WithdrawParams WithdrawParams
 
-func (x *TSSParams) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_TSSParams)(x)
+func (x *WithdrawParams) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_WithdrawParams)(x)
 }
 
-func (x *TSSParams) slowProtoReflect() protoreflect.Message {
+func (x *WithdrawParams) slowProtoReflect() protoreflect.Message {
 	mi := &file_side_btcbridge_params_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3740,43 +4726,43 @@ func (x *TSSParams) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_TSSParams_messageType fastReflection_TSSParams_messageType
-var _ protoreflect.MessageType = fastReflection_TSSParams_messageType{}
+var _fastReflection_WithdrawParams_messageType fastReflection_WithdrawParams_messageType
+var _ protoreflect.MessageType = fastReflection_WithdrawParams_messageType{}
 
-type fastReflection_TSSParams_messageType struct{}
+type fastReflection_WithdrawParams_messageType struct{}
 
-func (x fastReflection_TSSParams_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_TSSParams)(nil)
+func (x fastReflection_WithdrawParams_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_WithdrawParams)(nil)
 }
-func (x fastReflection_TSSParams_messageType) New() protoreflect.Message {
-	return new(fastReflection_TSSParams)
+func (x fastReflection_WithdrawParams_messageType) New() protoreflect.Message {
+	return new(fastReflection_WithdrawParams)
 }
-func (x fastReflection_TSSParams_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_TSSParams
+func (x fastReflection_WithdrawParams_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_WithdrawParams
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_TSSParams) Descriptor() protoreflect.MessageDescriptor {
-	return md_TSSParams
+func (x *fastReflection_WithdrawParams) Descriptor() protoreflect.MessageDescriptor {
+	return md_WithdrawParams
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_TSSParams) Type() protoreflect.MessageType {
-	return _fastReflection_TSSParams_messageType
+func (x *fastReflection_WithdrawParams) Type() protoreflect.MessageType {
+	return _fastReflection_WithdrawParams_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_TSSParams) New() protoreflect.Message {
-	return new(fastReflection_TSSParams)
+func (x *fastReflection_WithdrawParams) New() protoreflect.Message {
+	return new(fastReflection_WithdrawParams)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_TSSParams) Interface() protoreflect.ProtoMessage {
-	return (*TSSParams)(x)
+func (x *fastReflection_WithdrawParams) Interface() protoreflect.ProtoMessage {
+	return (*WithdrawParams)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3784,16 +4770,28 @@ func (x *fastReflection_TSSParams) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_TSSParams) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.DkgTimeoutPeriod != nil {
-		value := protoreflect.ValueOfMessage(x.DkgTimeoutPeriod.ProtoReflect())
-		if !f(fd_TSSParams_dkg_timeout_period, value) {
+func (x *fastReflection_WithdrawParams) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.MaxUtxoNum != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.MaxUtxoNum)
+		if !f(fd_WithdrawParams_max_utxo_num, value) {
 			return
 		}
 	}
-	if x.ParticipantUpdateTransitionPeriod != nil {
-		value := protoreflect.ValueOfMessage(x.ParticipantUpdateTransitionPeriod.ProtoReflect())
-		if !f(fd_TSSParams_participant_update_transition_period, value) {
+	if x.BtcBatchWithdrawPeriod != int64(0) {
+		value := protoreflect.ValueOfInt64(x.BtcBatchWithdrawPeriod)
+		if !f(fd_WithdrawParams_btc_batch_withdraw_period, value) {
+			return
+		}
+	}
+	if x.MaxBtcBatchWithdrawNum != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.MaxBtcBatchWithdrawNum)
+		if !f(fd_WithdrawParams_max_btc_batch_withdraw_num, value) {
+			return
+		}
+	}
+	if x.CoinSelection != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.CoinSelection))
+		if !f(fd_WithdrawParams_coin_selection, value) {
 			return
 		}
 	}
@@ -3810,17 +4808,21 @@ func (x *fastReflection_TSSParams) Range(f func(protoreflect.FieldDescriptor, pr
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_TSSParams) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_WithdrawParams) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "side.btcbridge.TSSParams.dkg_timeout_period":
-		return x.DkgTimeoutPeriod != nil
-	case "side.btcbridge.TSSParams.participant_update_transition_period":
-		return x.ParticipantUpdateTransitionPeriod != nil
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.TSSParams"))
-		}
-		panic(fmt.Errorf("message side.btcbridge.TSSParams does not contain field %s", fd.FullName()))
+	case "side.btcbridge.WithdrawParams.max_utxo_num":
+		return x.MaxUtxoNum != uint32(0)
+	case "side.btcbridge.WithdrawParams.btc_batch_withdraw_period":
+		return x.BtcBatchWithdrawPeriod != int64(0)
+	case "side.btcbridge.WithdrawParams.max_btc_batch_withdraw_num":
+		return x.MaxBtcBatchWithdrawNum != uint32(0)
+	case "side.btcbridge.WithdrawParams.coin_selection":
+		return x.CoinSelection != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.WithdrawParams"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.WithdrawParams does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3830,17 +4832,21 @@ func (x *fastReflection_TSSParams) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TSSParams) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_WithdrawParams) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "side.btcbridge.TSSParams.dkg_timeout_period":
-		x.DkgTimeoutPeriod = nil
-	case "side.btcbridge.TSSParams.participant_update_transition_period":
-		x.ParticipantUpdateTransitionPeriod = nil
+	case "side.btcbridge.WithdrawParams.max_utxo_num":
+		x.MaxUtxoNum = uint32(0)
+	case "side.btcbridge.WithdrawParams.btc_batch_withdraw_period":
+		x.BtcBatchWithdrawPeriod = int64(0)
+	case "side.btcbridge.WithdrawParams.max_btc_batch_withdraw_num":
+		x.MaxBtcBatchWithdrawNum = uint32(0)
+	case "side.btcbridge.WithdrawParams.coin_selection":
+		x.CoinSelection = 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.TSSParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.WithdrawParams"))
 		}
-		panic(fmt.Errorf("message side.btcbridge.TSSParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message side.btcbridge.WithdrawParams does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3850,19 +4856,25 @@ func (x *fastReflection_TSSParams) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_TSSParams) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_WithdrawParams) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "side.btcbridge.TSSParams.dkg_timeout_period":
-		value := x.DkgTimeoutPeriod
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "side.btcbridge.TSSParams.participant_update_transition_period":
-		value := x.ParticipantUpdateTransitionPeriod
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "side.btcbridge.WithdrawParams.max_utxo_num":
+		value := x.MaxUtxoNum
+		return protoreflect.ValueOfUint32(value)
+	case "side.btcbridge.WithdrawParams.btc_batch_withdraw_period":
+		value := x.BtcBatchWithdrawPeriod
+		return protoreflect.ValueOfInt64(value)
+	case "side.btcbridge.WithdrawParams.max_btc_batch_withdraw_num":
+		value := x.MaxBtcBatchWithdrawNum
+		return protoreflect.ValueOfUint32(value)
+	case "side.btcbridge.WithdrawParams.coin_selection":
+		value := x.CoinSelection
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.TSSParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.WithdrawParams"))
 		}
-		panic(fmt.Errorf("message side.btcbridge.TSSParams does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message side.btcbridge.WithdrawParams does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -3876,17 +4888,21 @@ func (x *fastReflection_TSSParams) Get(descriptor protoreflect.FieldDescriptor)
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TSSParams) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_WithdrawParams) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "side.btcbridge.TSSParams.dkg_timeout_period":
-		x.DkgTimeoutPeriod = value.Message().Interface().(*durationpb.Duration)
-	case "side.btcbridge.TSSParams.participant_update_transition_period":
-		x.ParticipantUpdateTransitionPeriod = value.Message().Interface().(*durationpb.Duration)
+	case "side.btcbridge.WithdrawParams.max_utxo_num":
+		x.MaxUtxoNum = uint32(value.Uint())
+	case "side.btcbridge.WithdrawParams.btc_batch_withdraw_period":
+		x.BtcBatchWithdrawPeriod = value.Int()
+	case "side.btcbridge.WithdrawParams.max_btc_batch_withdraw_num":
+		x.MaxBtcBatchWithdrawNum = uint32(value.Uint())
+	case "side.btcbridge.WithdrawParams.coin_selection":
+		x.CoinSelection = (CoinSelectionStrategy)(value.Enum())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.TSSParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.WithdrawParams"))
 		}
-		panic(fmt.Errorf("message side.btcbridge.TSSParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message side.btcbridge.WithdrawParams does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3900,52 +4916,52 @@ func (x *fastReflection_TSSParams) Set(fd protoreflect.FieldDescriptor, value pr
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TSSParams) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_WithdrawParams) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "side.btcbridge.TSSParams.dkg_timeout_period":
-		if x.DkgTimeoutPeriod == nil {
-			x.DkgTimeoutPeriod = new(durationpb.Duration)
-		}
-		return protoreflect.ValueOfMessage(x.DkgTimeoutPeriod.ProtoReflect())
-	case "side.btcbridge.TSSParams.participant_update_transition_period":
-		if x.ParticipantUpdateTransitionPeriod == nil {
-			x.ParticipantUpdateTransitionPeriod = new(durationpb.Duration)
-		}
-		return protoreflect.ValueOfMessage(x.ParticipantUpdateTransitionPeriod.ProtoReflect())
+	case "side.btcbridge.WithdrawParams.max_utxo_num":
+		panic(fmt.Errorf("field max_utxo_num of message side.btcbridge.WithdrawParams is not mutable"))
+	case "side.btcbridge.WithdrawParams.btc_batch_withdraw_period":
+		panic(fmt.Errorf("field btc_batch_withdraw_period of message side.btcbridge.WithdrawParams is not mutable"))
+	case "side.btcbridge.WithdrawParams.max_btc_batch_withdraw_num":
+		panic(fmt.Errorf("field max_btc_batch_withdraw_num of message side.btcbridge.WithdrawParams is not mutable"))
+	case "side.btcbridge.WithdrawParams.coin_selection":
+		panic(fmt.Errorf("field coin_selection of message side.btcbridge.WithdrawParams is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.TSSParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.WithdrawParams"))
 		}
-		panic(fmt.Errorf("message side.btcbridge.TSSParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message side.btcbridge.WithdrawParams does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_TSSParams) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_WithdrawParams) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "side.btcbridge.TSSParams.dkg_timeout_period":
-		m := new(durationpb.Duration)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "side.btcbridge.TSSParams.participant_update_transition_period":
-		m := new(durationpb.Duration)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "side.btcbridge.WithdrawParams.max_utxo_num":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "side.btcbridge.WithdrawParams.btc_batch_withdraw_period":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "side.btcbridge.WithdrawParams.max_btc_batch_withdraw_num":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "side.btcbridge.WithdrawParams.coin_selection":
+		return protoreflect.ValueOfEnum(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.TSSParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.WithdrawParams"))
 		}
-		panic(fmt.Errorf("message side.btcbridge.TSSParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message side.btcbridge.WithdrawParams does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_TSSParams) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_WithdrawParams) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in side.btcbridge.TSSParams", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in side.btcbridge.WithdrawParams", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3953,7 +4969,7 @@ func (x *fastReflection_TSSParams) WhichOneof(d protoreflect.OneofDescriptor) pr
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_TSSParams) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_WithdrawParams) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3964,7 +4980,7 @@ func (x *fastReflection_TSSParams) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TSSParams) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_WithdrawParams) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3976,7 +4992,7 @@ func (x *fastReflection_TSSParams) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_TSSParams) IsValid() bool {
+func (x *fastReflection_WithdrawParams) IsValid() bool {
 	return x != nil
 }
 
@@ -3986,9 +5002,9 @@ func (x *fastReflection_TSSParams) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_TSSParams) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_WithdrawParams) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*TSSParams)
+		x := input.Message.Interface().(*WithdrawParams)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4000,13 +5016,17 @@ func (x *fastReflection_TSSParams) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.DkgTimeoutPeriod != nil {
-			l = options.Size(x.DkgTimeoutPeriod)
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.MaxUtxoNum != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxUtxoNum))
 		}
-		if x.ParticipantUpdateTransitionPeriod != nil {
-			l = options.Size(x.ParticipantUpdateTransitionPeriod)
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.BtcBatchWithdrawPeriod != 0 {
+			n += 1 + runtime.Sov(uint64(x.BtcBatchWithdrawPeriod))
+		}
+		if x.MaxBtcBatchWithdrawNum != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxBtcBatchWithdrawNum))
+		}
+		if x.CoinSelection != 0 {
+			n += 1 + runtime.Sov(uint64(x.CoinSelection))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -4018,7 +5038,7 @@ func (x *fastReflection_TSSParams) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*TSSParams)
+		x := input.Message.Interface().(*WithdrawParams)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4037,33 +5057,25 @@ func (x *fastReflection_TSSParams) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.ParticipantUpdateTransitionPeriod != nil {
-			encoded, err := options.Marshal(x.ParticipantUpdateTransitionPeriod)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+		if x.CoinSelection != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.CoinSelection))
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x20
 		}
-		if x.DkgTimeoutPeriod != nil {
-			encoded, err := options.Marshal(x.DkgTimeoutPeriod)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+		if x.MaxBtcBatchWithdrawNum != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxBtcBatchWithdrawNum))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x18
+		}
+		if x.BtcBatchWithdrawPeriod != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BtcBatchWithdrawPeriod))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.MaxUtxoNum != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxUtxoNum))
+			i--
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -4076,7 +5088,7 @@ func (x *fastReflection_TSSParams) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*TSSParams)
+		x := input.Message.Interface().(*WithdrawParams)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4108,17 +5120,17 @@ func (x *fastReflection_TSSParams) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TSSParams: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: WithdrawParams: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TSSParams: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: WithdrawParams: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DkgTimeoutPeriod", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxUtxoNum", wireType)
 				}
-				var msglen int
+				x.MaxUtxoNum = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -4128,33 +5140,54 @@ func (x *fastReflection_TSSParams) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					x.MaxUtxoNum |= uint32(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BtcBatchWithdrawPeriod", wireType)
 				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				x.BtcBatchWithdrawPeriod = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BtcBatchWithdrawPeriod |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if x.DkgTimeoutPeriod == nil {
-					x.DkgTimeoutPeriod = &durationpb.Duration{}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxBtcBatchWithdrawNum", wireType)
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DkgTimeoutPeriod); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				x.MaxBtcBatchWithdrawNum = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxBtcBatchWithdrawNum |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				iNdEx = postIndex
-			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ParticipantUpdateTransitionPeriod", wireType)
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CoinSelection", wireType)
 				}
-				var msglen int
+				x.CoinSelection = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -4164,38 +5197,21 @@ func (x *fastReflection_TSSParams) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					x.CoinSelection |= CoinSelectionStrategy(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.ParticipantUpdateTransitionPeriod == nil {
-					x.ParticipantUpdateTransitionPeriod = &durationpb.Duration{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ParticipantUpdateTransitionPeriod); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if (iNdEx + skippy) > l {
+				if (iNdEx + skippy) > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
 				if !options.DiscardUnknown {
@@ -4221,520 +5237,9212 @@ func (x *fastReflection_TSSParams) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: side/btcbridge/params.proto
+var _ protoreflect.List = (*_WithdrawRateLimit_3_list)(nil)
 
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
-)
+type _WithdrawRateLimit_3_list struct {
+	list *[]*v1beta1.Coin
+}
 
-// AssetType defines the type of asset
-type AssetType int32
+func (x *_WithdrawRateLimit_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
 
-const (
-	// Unspecified asset type
-	AssetType_ASSET_TYPE_UNSPECIFIED AssetType = 0
-	// BTC
-	AssetType_ASSET_TYPE_BTC AssetType = 1
-	// BRC20: ordi, sats
-	AssetType_ASSET_TYPE_BRC20 AssetType = 2
-	// RUNE: dog•go•to•the•moon
-	AssetType_ASSET_TYPE_RUNES AssetType = 3
-)
+func (x *_WithdrawRateLimit_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_WithdrawRateLimit_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_WithdrawRateLimit_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_WithdrawRateLimit_3_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_WithdrawRateLimit_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_WithdrawRateLimit_3_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_WithdrawRateLimit_3_list) IsValid() bool {
+	return x.list != nil
+}
 
-// Enum value maps for AssetType.
 var (
-	AssetType_name = map[int32]string{
-		0: "ASSET_TYPE_UNSPECIFIED",
-		1: "ASSET_TYPE_BTC",
-		2: "ASSET_TYPE_BRC20",
-		3: "ASSET_TYPE_RUNES",
+	md_WithdrawRateLimit                       protoreflect.MessageDescriptor
+	fd_WithdrawRateLimit_window                protoreflect.FieldDescriptor
+	fd_WithdrawRateLimit_global_limit          protoreflect.FieldDescriptor
+	fd_WithdrawRateLimit_asset_limits          protoreflect.FieldDescriptor
+	fd_WithdrawRateLimit_breaker_threshold_bps protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_side_btcbridge_params_proto_init()
+	md_WithdrawRateLimit = File_side_btcbridge_params_proto.Messages().ByName("WithdrawRateLimit")
+	fd_WithdrawRateLimit_window = md_WithdrawRateLimit.Fields().ByName("window")
+	fd_WithdrawRateLimit_global_limit = md_WithdrawRateLimit.Fields().ByName("global_limit")
+	fd_WithdrawRateLimit_asset_limits = md_WithdrawRateLimit.Fields().ByName("asset_limits")
+	fd_WithdrawRateLimit_breaker_threshold_bps = md_WithdrawRateLimit.Fields().ByName("breaker_threshold_bps")
+}
+
+var _ protoreflect.Message = (*fastReflection_WithdrawRateLimit)(nil)
+
+type fastReflection_WithdrawRateLimit WithdrawRateLimit
+
+func (x *WithdrawRateLimit) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_WithdrawRateLimit)(x)
+}
+
+func (x *WithdrawRateLimit) slowProtoReflect() protoreflect.Message {
+	mi := &file_side_btcbridge_params_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	AssetType_value = map[string]int32{
-		"ASSET_TYPE_UNSPECIFIED": 0,
-		"ASSET_TYPE_BTC":         1,
-		"ASSET_TYPE_BRC20":       2,
-		"ASSET_TYPE_RUNES":       3,
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_WithdrawRateLimit_messageType fastReflection_WithdrawRateLimit_messageType
+var _ protoreflect.MessageType = fastReflection_WithdrawRateLimit_messageType{}
+
+type fastReflection_WithdrawRateLimit_messageType struct{}
+
+func (x fastReflection_WithdrawRateLimit_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_WithdrawRateLimit)(nil)
+}
+func (x fastReflection_WithdrawRateLimit_messageType) New() protoreflect.Message {
+	return new(fastReflection_WithdrawRateLimit)
+}
+func (x fastReflection_WithdrawRateLimit_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_WithdrawRateLimit
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_WithdrawRateLimit) Descriptor() protoreflect.MessageDescriptor {
+	return md_WithdrawRateLimit
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_WithdrawRateLimit) Type() protoreflect.MessageType {
+	return _fastReflection_WithdrawRateLimit_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_WithdrawRateLimit) New() protoreflect.Message {
+	return new(fastReflection_WithdrawRateLimit)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_WithdrawRateLimit) Interface() protoreflect.ProtoMessage {
+	return (*WithdrawRateLimit)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_WithdrawRateLimit) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Window != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Window)
+		if !f(fd_WithdrawRateLimit_window, value) {
+			return
+		}
 	}
-)
+	if x.GlobalLimit != int64(0) {
+		value := protoreflect.ValueOfInt64(x.GlobalLimit)
+		if !f(fd_WithdrawRateLimit_global_limit, value) {
+			return
+		}
+	}
+	if len(x.AssetLimits) != 0 {
+		value := protoreflect.ValueOfList(&_WithdrawRateLimit_3_list{list: &x.AssetLimits})
+		if !f(fd_WithdrawRateLimit_asset_limits, value) {
+			return
+		}
+	}
+	if x.BreakerThresholdBps != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.BreakerThresholdBps)
+		if !f(fd_WithdrawRateLimit_breaker_threshold_bps, value) {
+			return
+		}
+	}
+}
 
-func (x AssetType) Enum() *AssetType {
-	p := new(AssetType)
-	*p = x
-	return p
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_WithdrawRateLimit) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "side.btcbridge.WithdrawRateLimit.window":
+		return x.Window != int64(0)
+	case "side.btcbridge.WithdrawRateLimit.global_limit":
+		return x.GlobalLimit != int64(0)
+	case "side.btcbridge.WithdrawRateLimit.asset_limits":
+		return len(x.AssetLimits) != 0
+	case "side.btcbridge.WithdrawRateLimit.breaker_threshold_bps":
+		return x.BreakerThresholdBps != uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.WithdrawRateLimit"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.WithdrawRateLimit does not contain field %s", fd.FullName()))
+	}
 }
 
-func (x AssetType) String() string {
-	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_WithdrawRateLimit) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "side.btcbridge.WithdrawRateLimit.window":
+		x.Window = int64(0)
+	case "side.btcbridge.WithdrawRateLimit.global_limit":
+		x.GlobalLimit = int64(0)
+	case "side.btcbridge.WithdrawRateLimit.asset_limits":
+		x.AssetLimits = nil
+	case "side.btcbridge.WithdrawRateLimit.breaker_threshold_bps":
+		x.BreakerThresholdBps = uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.WithdrawRateLimit"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.WithdrawRateLimit does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_WithdrawRateLimit) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "side.btcbridge.WithdrawRateLimit.window":
+		value := x.Window
+		return protoreflect.ValueOfInt64(value)
+	case "side.btcbridge.WithdrawRateLimit.global_limit":
+		value := x.GlobalLimit
+		return protoreflect.ValueOfInt64(value)
+	case "side.btcbridge.WithdrawRateLimit.asset_limits":
+		if len(x.AssetLimits) == 0 {
+			return protoreflect.ValueOfList(&_WithdrawRateLimit_3_list{})
+		}
+		listValue := &_WithdrawRateLimit_3_list{list: &x.AssetLimits}
+		return protoreflect.ValueOfList(listValue)
+	case "side.btcbridge.WithdrawRateLimit.breaker_threshold_bps":
+		value := x.BreakerThresholdBps
+		return protoreflect.ValueOfUint32(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.WithdrawRateLimit"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.WithdrawRateLimit does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_WithdrawRateLimit) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "side.btcbridge.WithdrawRateLimit.window":
+		x.Window = value.Int()
+	case "side.btcbridge.WithdrawRateLimit.global_limit":
+		x.GlobalLimit = value.Int()
+	case "side.btcbridge.WithdrawRateLimit.asset_limits":
+		lv := value.List()
+		clv := lv.(*_WithdrawRateLimit_3_list)
+		x.AssetLimits = *clv.list
+	case "side.btcbridge.WithdrawRateLimit.breaker_threshold_bps":
+		x.BreakerThresholdBps = uint32(value.Uint())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.WithdrawRateLimit"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.WithdrawRateLimit does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_WithdrawRateLimit) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "side.btcbridge.WithdrawRateLimit.asset_limits":
+		if x.AssetLimits == nil {
+			x.AssetLimits = []*v1beta1.Coin{}
+		}
+		value := &_WithdrawRateLimit_3_list{list: &x.AssetLimits}
+		return protoreflect.ValueOfList(value)
+	case "side.btcbridge.WithdrawRateLimit.window":
+		panic(fmt.Errorf("field window of message side.btcbridge.WithdrawRateLimit is not mutable"))
+	case "side.btcbridge.WithdrawRateLimit.global_limit":
+		panic(fmt.Errorf("field global_limit of message side.btcbridge.WithdrawRateLimit is not mutable"))
+	case "side.btcbridge.WithdrawRateLimit.breaker_threshold_bps":
+		panic(fmt.Errorf("field breaker_threshold_bps of message side.btcbridge.WithdrawRateLimit is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.WithdrawRateLimit"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.WithdrawRateLimit does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_WithdrawRateLimit) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "side.btcbridge.WithdrawRateLimit.window":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "side.btcbridge.WithdrawRateLimit.global_limit":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "side.btcbridge.WithdrawRateLimit.asset_limits":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_WithdrawRateLimit_3_list{list: &list})
+	case "side.btcbridge.WithdrawRateLimit.breaker_threshold_bps":
+		return protoreflect.ValueOfUint32(uint32(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.WithdrawRateLimit"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.WithdrawRateLimit does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_WithdrawRateLimit) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in side.btcbridge.WithdrawRateLimit", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_WithdrawRateLimit) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_WithdrawRateLimit) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_WithdrawRateLimit) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_WithdrawRateLimit) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*WithdrawRateLimit)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Window != 0 {
+			n += 1 + runtime.Sov(uint64(x.Window))
+		}
+		if x.GlobalLimit != 0 {
+			n += 1 + runtime.Sov(uint64(x.GlobalLimit))
+		}
+		if len(x.AssetLimits) > 0 {
+			for _, e := range x.AssetLimits {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.BreakerThresholdBps != 0 {
+			n += 1 + runtime.Sov(uint64(x.BreakerThresholdBps))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*WithdrawRateLimit)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.BreakerThresholdBps != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BreakerThresholdBps))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.AssetLimits) > 0 {
+			for iNdEx := len(x.AssetLimits) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.AssetLimits[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if x.GlobalLimit != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.GlobalLimit))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.Window != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Window))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*WithdrawRateLimit)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: WithdrawRateLimit: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: WithdrawRateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+				}
+				x.Window = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Window |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GlobalLimit", wireType)
+				}
+				x.GlobalLimit = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.GlobalLimit |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AssetLimits", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AssetLimits = append(x.AssetLimits, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.AssetLimits[len(x.AssetLimits)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BreakerThresholdBps", wireType)
+				}
+				x.BreakerThresholdBps = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BreakerThresholdBps |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_ProtocolLimits                  protoreflect.MessageDescriptor
+	fd_ProtocolLimits_btc_min_deposit  protoreflect.FieldDescriptor
+	fd_ProtocolLimits_btc_min_withdraw protoreflect.FieldDescriptor
+	fd_ProtocolLimits_btc_max_withdraw protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_side_btcbridge_params_proto_init()
+	md_ProtocolLimits = File_side_btcbridge_params_proto.Messages().ByName("ProtocolLimits")
+	fd_ProtocolLimits_btc_min_deposit = md_ProtocolLimits.Fields().ByName("btc_min_deposit")
+	fd_ProtocolLimits_btc_min_withdraw = md_ProtocolLimits.Fields().ByName("btc_min_withdraw")
+	fd_ProtocolLimits_btc_max_withdraw = md_ProtocolLimits.Fields().ByName("btc_max_withdraw")
+}
+
+var _ protoreflect.Message = (*fastReflection_ProtocolLimits)(nil)
+
+type fastReflection_ProtocolLimits ProtocolLimits
+
+func (x *ProtocolLimits) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ProtocolLimits)(x)
+}
+
+func (x *ProtocolLimits) slowProtoReflect() protoreflect.Message {
+	mi := &file_side_btcbridge_params_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ProtocolLimits_messageType fastReflection_ProtocolLimits_messageType
+var _ protoreflect.MessageType = fastReflection_ProtocolLimits_messageType{}
+
+type fastReflection_ProtocolLimits_messageType struct{}
+
+func (x fastReflection_ProtocolLimits_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ProtocolLimits)(nil)
+}
+func (x fastReflection_ProtocolLimits_messageType) New() protoreflect.Message {
+	return new(fastReflection_ProtocolLimits)
+}
+func (x fastReflection_ProtocolLimits_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ProtocolLimits
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ProtocolLimits) Descriptor() protoreflect.MessageDescriptor {
+	return md_ProtocolLimits
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ProtocolLimits) Type() protoreflect.MessageType {
+	return _fastReflection_ProtocolLimits_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ProtocolLimits) New() protoreflect.Message {
+	return new(fastReflection_ProtocolLimits)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ProtocolLimits) Interface() protoreflect.ProtoMessage {
+	return (*ProtocolLimits)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ProtocolLimits) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.BtcMinDeposit != int64(0) {
+		value := protoreflect.ValueOfInt64(x.BtcMinDeposit)
+		if !f(fd_ProtocolLimits_btc_min_deposit, value) {
+			return
+		}
+	}
+	if x.BtcMinWithdraw != int64(0) {
+		value := protoreflect.ValueOfInt64(x.BtcMinWithdraw)
+		if !f(fd_ProtocolLimits_btc_min_withdraw, value) {
+			return
+		}
+	}
+	if x.BtcMaxWithdraw != int64(0) {
+		value := protoreflect.ValueOfInt64(x.BtcMaxWithdraw)
+		if !f(fd_ProtocolLimits_btc_max_withdraw, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ProtocolLimits) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "side.btcbridge.ProtocolLimits.btc_min_deposit":
+		return x.BtcMinDeposit != int64(0)
+	case "side.btcbridge.ProtocolLimits.btc_min_withdraw":
+		return x.BtcMinWithdraw != int64(0)
+	case "side.btcbridge.ProtocolLimits.btc_max_withdraw":
+		return x.BtcMaxWithdraw != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.ProtocolLimits"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.ProtocolLimits does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ProtocolLimits) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "side.btcbridge.ProtocolLimits.btc_min_deposit":
+		x.BtcMinDeposit = int64(0)
+	case "side.btcbridge.ProtocolLimits.btc_min_withdraw":
+		x.BtcMinWithdraw = int64(0)
+	case "side.btcbridge.ProtocolLimits.btc_max_withdraw":
+		x.BtcMaxWithdraw = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.ProtocolLimits"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.ProtocolLimits does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ProtocolLimits) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "side.btcbridge.ProtocolLimits.btc_min_deposit":
+		value := x.BtcMinDeposit
+		return protoreflect.ValueOfInt64(value)
+	case "side.btcbridge.ProtocolLimits.btc_min_withdraw":
+		value := x.BtcMinWithdraw
+		return protoreflect.ValueOfInt64(value)
+	case "side.btcbridge.ProtocolLimits.btc_max_withdraw":
+		value := x.BtcMaxWithdraw
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.ProtocolLimits"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.ProtocolLimits does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ProtocolLimits) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "side.btcbridge.ProtocolLimits.btc_min_deposit":
+		x.BtcMinDeposit = value.Int()
+	case "side.btcbridge.ProtocolLimits.btc_min_withdraw":
+		x.BtcMinWithdraw = value.Int()
+	case "side.btcbridge.ProtocolLimits.btc_max_withdraw":
+		x.BtcMaxWithdraw = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.ProtocolLimits"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.ProtocolLimits does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ProtocolLimits) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "side.btcbridge.ProtocolLimits.btc_min_deposit":
+		panic(fmt.Errorf("field btc_min_deposit of message side.btcbridge.ProtocolLimits is not mutable"))
+	case "side.btcbridge.ProtocolLimits.btc_min_withdraw":
+		panic(fmt.Errorf("field btc_min_withdraw of message side.btcbridge.ProtocolLimits is not mutable"))
+	case "side.btcbridge.ProtocolLimits.btc_max_withdraw":
+		panic(fmt.Errorf("field btc_max_withdraw of message side.btcbridge.ProtocolLimits is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.ProtocolLimits"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.ProtocolLimits does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ProtocolLimits) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "side.btcbridge.ProtocolLimits.btc_min_deposit":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "side.btcbridge.ProtocolLimits.btc_min_withdraw":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "side.btcbridge.ProtocolLimits.btc_max_withdraw":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.ProtocolLimits"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.ProtocolLimits does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ProtocolLimits) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in side.btcbridge.ProtocolLimits", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ProtocolLimits) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ProtocolLimits) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ProtocolLimits) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ProtocolLimits) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ProtocolLimits)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.BtcMinDeposit != 0 {
+			n += 1 + runtime.Sov(uint64(x.BtcMinDeposit))
+		}
+		if x.BtcMinWithdraw != 0 {
+			n += 1 + runtime.Sov(uint64(x.BtcMinWithdraw))
+		}
+		if x.BtcMaxWithdraw != 0 {
+			n += 1 + runtime.Sov(uint64(x.BtcMaxWithdraw))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ProtocolLimits)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.BtcMaxWithdraw != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BtcMaxWithdraw))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.BtcMinWithdraw != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BtcMinWithdraw))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.BtcMinDeposit != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BtcMinDeposit))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ProtocolLimits)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ProtocolLimits: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ProtocolLimits: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BtcMinDeposit", wireType)
+				}
+				x.BtcMinDeposit = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BtcMinDeposit |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BtcMinWithdraw", wireType)
+				}
+				x.BtcMinWithdraw = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BtcMinWithdraw |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BtcMaxWithdraw", wireType)
+				}
+				x.BtcMaxWithdraw = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BtcMaxWithdraw |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_ProtocolFees                       protoreflect.MessageDescriptor
+	fd_ProtocolFees_collector             protoreflect.FieldDescriptor
+	fd_ProtocolFees_deposit_fee_schedule  protoreflect.FieldDescriptor
+	fd_ProtocolFees_withdraw_fee_schedule protoreflect.FieldDescriptor
+	fd_ProtocolFees_distribution          protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_side_btcbridge_params_proto_init()
+	md_ProtocolFees = File_side_btcbridge_params_proto.Messages().ByName("ProtocolFees")
+	fd_ProtocolFees_collector = md_ProtocolFees.Fields().ByName("collector")
+	fd_ProtocolFees_deposit_fee_schedule = md_ProtocolFees.Fields().ByName("deposit_fee_schedule")
+	fd_ProtocolFees_withdraw_fee_schedule = md_ProtocolFees.Fields().ByName("withdraw_fee_schedule")
+	fd_ProtocolFees_distribution = md_ProtocolFees.Fields().ByName("distribution")
+}
+
+var _ protoreflect.Message = (*fastReflection_ProtocolFees)(nil)
+
+type fastReflection_ProtocolFees ProtocolFees
+
+func (x *ProtocolFees) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ProtocolFees)(x)
+}
+
+func (x *ProtocolFees) slowProtoReflect() protoreflect.Message {
+	mi := &file_side_btcbridge_params_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ProtocolFees_messageType fastReflection_ProtocolFees_messageType
+var _ protoreflect.MessageType = fastReflection_ProtocolFees_messageType{}
+
+type fastReflection_ProtocolFees_messageType struct{}
+
+func (x fastReflection_ProtocolFees_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ProtocolFees)(nil)
+}
+func (x fastReflection_ProtocolFees_messageType) New() protoreflect.Message {
+	return new(fastReflection_ProtocolFees)
+}
+func (x fastReflection_ProtocolFees_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ProtocolFees
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ProtocolFees) Descriptor() protoreflect.MessageDescriptor {
+	return md_ProtocolFees
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ProtocolFees) Type() protoreflect.MessageType {
+	return _fastReflection_ProtocolFees_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ProtocolFees) New() protoreflect.Message {
+	return new(fastReflection_ProtocolFees)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ProtocolFees) Interface() protoreflect.ProtoMessage {
+	return (*ProtocolFees)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ProtocolFees) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Collector != "" {
+		value := protoreflect.ValueOfString(x.Collector)
+		if !f(fd_ProtocolFees_collector, value) {
+			return
+		}
+	}
+	if x.DepositFeeSchedule != nil {
+		value := protoreflect.ValueOfMessage(x.DepositFeeSchedule.ProtoReflect())
+		if !f(fd_ProtocolFees_deposit_fee_schedule, value) {
+			return
+		}
+	}
+	if x.WithdrawFeeSchedule != nil {
+		value := protoreflect.ValueOfMessage(x.WithdrawFeeSchedule.ProtoReflect())
+		if !f(fd_ProtocolFees_withdraw_fee_schedule, value) {
+			return
+		}
+	}
+	if x.Distribution != nil {
+		value := protoreflect.ValueOfMessage(x.Distribution.ProtoReflect())
+		if !f(fd_ProtocolFees_distribution, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ProtocolFees) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "side.btcbridge.ProtocolFees.collector":
+		return x.Collector != ""
+	case "side.btcbridge.ProtocolFees.deposit_fee_schedule":
+		return x.DepositFeeSchedule != nil
+	case "side.btcbridge.ProtocolFees.withdraw_fee_schedule":
+		return x.WithdrawFeeSchedule != nil
+	case "side.btcbridge.ProtocolFees.distribution":
+		return x.Distribution != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.ProtocolFees"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.ProtocolFees does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ProtocolFees) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "side.btcbridge.ProtocolFees.collector":
+		x.Collector = ""
+	case "side.btcbridge.ProtocolFees.deposit_fee_schedule":
+		x.DepositFeeSchedule = nil
+	case "side.btcbridge.ProtocolFees.withdraw_fee_schedule":
+		x.WithdrawFeeSchedule = nil
+	case "side.btcbridge.ProtocolFees.distribution":
+		x.Distribution = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.ProtocolFees"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.ProtocolFees does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ProtocolFees) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "side.btcbridge.ProtocolFees.collector":
+		value := x.Collector
+		return protoreflect.ValueOfString(value)
+	case "side.btcbridge.ProtocolFees.deposit_fee_schedule":
+		value := x.DepositFeeSchedule
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "side.btcbridge.ProtocolFees.withdraw_fee_schedule":
+		value := x.WithdrawFeeSchedule
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "side.btcbridge.ProtocolFees.distribution":
+		value := x.Distribution
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.ProtocolFees"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.ProtocolFees does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ProtocolFees) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "side.btcbridge.ProtocolFees.collector":
+		x.Collector = value.Interface().(string)
+	case "side.btcbridge.ProtocolFees.deposit_fee_schedule":
+		x.DepositFeeSchedule = value.Message().Interface().(*FeeSchedule)
+	case "side.btcbridge.ProtocolFees.withdraw_fee_schedule":
+		x.WithdrawFeeSchedule = value.Message().Interface().(*FeeSchedule)
+	case "side.btcbridge.ProtocolFees.distribution":
+		x.Distribution = value.Message().Interface().(*FeeDistribution)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.ProtocolFees"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.ProtocolFees does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ProtocolFees) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "side.btcbridge.ProtocolFees.deposit_fee_schedule":
+		if x.DepositFeeSchedule == nil {
+			x.DepositFeeSchedule = new(FeeSchedule)
+		}
+		return protoreflect.ValueOfMessage(x.DepositFeeSchedule.ProtoReflect())
+	case "side.btcbridge.ProtocolFees.withdraw_fee_schedule":
+		if x.WithdrawFeeSchedule == nil {
+			x.WithdrawFeeSchedule = new(FeeSchedule)
+		}
+		return protoreflect.ValueOfMessage(x.WithdrawFeeSchedule.ProtoReflect())
+	case "side.btcbridge.ProtocolFees.distribution":
+		if x.Distribution == nil {
+			x.Distribution = new(FeeDistribution)
+		}
+		return protoreflect.ValueOfMessage(x.Distribution.ProtoReflect())
+	case "side.btcbridge.ProtocolFees.collector":
+		panic(fmt.Errorf("field collector of message side.btcbridge.ProtocolFees is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.ProtocolFees"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.ProtocolFees does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ProtocolFees) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "side.btcbridge.ProtocolFees.collector":
+		return protoreflect.ValueOfString("")
+	case "side.btcbridge.ProtocolFees.deposit_fee_schedule":
+		m := new(FeeSchedule)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "side.btcbridge.ProtocolFees.withdraw_fee_schedule":
+		m := new(FeeSchedule)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "side.btcbridge.ProtocolFees.distribution":
+		m := new(FeeDistribution)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.ProtocolFees"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.ProtocolFees does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ProtocolFees) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in side.btcbridge.ProtocolFees", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ProtocolFees) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ProtocolFees) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ProtocolFees) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ProtocolFees) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ProtocolFees)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Collector)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.DepositFeeSchedule != nil {
+			l = options.Size(x.DepositFeeSchedule)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.WithdrawFeeSchedule != nil {
+			l = options.Size(x.WithdrawFeeSchedule)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Distribution != nil {
+			l = options.Size(x.Distribution)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ProtocolFees)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Distribution != nil {
+			encoded, err := options.Marshal(x.Distribution)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.WithdrawFeeSchedule != nil {
+			encoded, err := options.Marshal(x.WithdrawFeeSchedule)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.DepositFeeSchedule != nil {
+			encoded, err := options.Marshal(x.DepositFeeSchedule)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Collector) > 0 {
+			i -= len(x.Collector)
+			copy(dAtA[i:], x.Collector)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Collector)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ProtocolFees)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ProtocolFees: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ProtocolFees: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Collector", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Collector = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DepositFeeSchedule", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.DepositFeeSchedule == nil {
+					x.DepositFeeSchedule = &FeeSchedule{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DepositFeeSchedule); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WithdrawFeeSchedule", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.WithdrawFeeSchedule == nil {
+					x.WithdrawFeeSchedule = &FeeSchedule{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.WithdrawFeeSchedule); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Distribution", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Distribution == nil {
+					x.Distribution = &FeeDistribution{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Distribution); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_FeeDistribution                       protoreflect.MessageDescriptor
+	fd_FeeDistribution_epoch                 protoreflect.FieldDescriptor
+	fd_FeeDistribution_community_pool_weight protoreflect.FieldDescriptor
+	fd_FeeDistribution_relayer_pool_weight   protoreflect.FieldDescriptor
+	fd_FeeDistribution_signer_pool_weight    protoreflect.FieldDescriptor
+	fd_FeeDistribution_insurance_weight      protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_side_btcbridge_params_proto_init()
+	md_FeeDistribution = File_side_btcbridge_params_proto.Messages().ByName("FeeDistribution")
+	fd_FeeDistribution_epoch = md_FeeDistribution.Fields().ByName("epoch")
+	fd_FeeDistribution_community_pool_weight = md_FeeDistribution.Fields().ByName("community_pool_weight")
+	fd_FeeDistribution_relayer_pool_weight = md_FeeDistribution.Fields().ByName("relayer_pool_weight")
+	fd_FeeDistribution_signer_pool_weight = md_FeeDistribution.Fields().ByName("signer_pool_weight")
+	fd_FeeDistribution_insurance_weight = md_FeeDistribution.Fields().ByName("insurance_weight")
+}
+
+var _ protoreflect.Message = (*fastReflection_FeeDistribution)(nil)
+
+type fastReflection_FeeDistribution FeeDistribution
+
+func (x *FeeDistribution) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_FeeDistribution)(x)
+}
+
+func (x *FeeDistribution) slowProtoReflect() protoreflect.Message {
+	mi := &file_side_btcbridge_params_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_FeeDistribution_messageType fastReflection_FeeDistribution_messageType
+var _ protoreflect.MessageType = fastReflection_FeeDistribution_messageType{}
+
+type fastReflection_FeeDistribution_messageType struct{}
+
+func (x fastReflection_FeeDistribution_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_FeeDistribution)(nil)
+}
+func (x fastReflection_FeeDistribution_messageType) New() protoreflect.Message {
+	return new(fastReflection_FeeDistribution)
+}
+func (x fastReflection_FeeDistribution_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_FeeDistribution
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_FeeDistribution) Descriptor() protoreflect.MessageDescriptor {
+	return md_FeeDistribution
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_FeeDistribution) Type() protoreflect.MessageType {
+	return _fastReflection_FeeDistribution_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_FeeDistribution) New() protoreflect.Message {
+	return new(fastReflection_FeeDistribution)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_FeeDistribution) Interface() protoreflect.ProtoMessage {
+	return (*FeeDistribution)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_FeeDistribution) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Epoch != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Epoch)
+		if !f(fd_FeeDistribution_epoch, value) {
+			return
+		}
+	}
+	if x.CommunityPoolWeight != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.CommunityPoolWeight)
+		if !f(fd_FeeDistribution_community_pool_weight, value) {
+			return
+		}
+	}
+	if x.RelayerPoolWeight != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.RelayerPoolWeight)
+		if !f(fd_FeeDistribution_relayer_pool_weight, value) {
+			return
+		}
+	}
+	if x.SignerPoolWeight != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.SignerPoolWeight)
+		if !f(fd_FeeDistribution_signer_pool_weight, value) {
+			return
+		}
+	}
+	if x.InsuranceWeight != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.InsuranceWeight)
+		if !f(fd_FeeDistribution_insurance_weight, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_FeeDistribution) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "side.btcbridge.FeeDistribution.epoch":
+		return x.Epoch != int64(0)
+	case "side.btcbridge.FeeDistribution.community_pool_weight":
+		return x.CommunityPoolWeight != uint32(0)
+	case "side.btcbridge.FeeDistribution.relayer_pool_weight":
+		return x.RelayerPoolWeight != uint32(0)
+	case "side.btcbridge.FeeDistribution.signer_pool_weight":
+		return x.SignerPoolWeight != uint32(0)
+	case "side.btcbridge.FeeDistribution.insurance_weight":
+		return x.InsuranceWeight != uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.FeeDistribution"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.FeeDistribution does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeeDistribution) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "side.btcbridge.FeeDistribution.epoch":
+		x.Epoch = int64(0)
+	case "side.btcbridge.FeeDistribution.community_pool_weight":
+		x.CommunityPoolWeight = uint32(0)
+	case "side.btcbridge.FeeDistribution.relayer_pool_weight":
+		x.RelayerPoolWeight = uint32(0)
+	case "side.btcbridge.FeeDistribution.signer_pool_weight":
+		x.SignerPoolWeight = uint32(0)
+	case "side.btcbridge.FeeDistribution.insurance_weight":
+		x.InsuranceWeight = uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.FeeDistribution"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.FeeDistribution does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_FeeDistribution) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "side.btcbridge.FeeDistribution.epoch":
+		value := x.Epoch
+		return protoreflect.ValueOfInt64(value)
+	case "side.btcbridge.FeeDistribution.community_pool_weight":
+		value := x.CommunityPoolWeight
+		return protoreflect.ValueOfUint32(value)
+	case "side.btcbridge.FeeDistribution.relayer_pool_weight":
+		value := x.RelayerPoolWeight
+		return protoreflect.ValueOfUint32(value)
+	case "side.btcbridge.FeeDistribution.signer_pool_weight":
+		value := x.SignerPoolWeight
+		return protoreflect.ValueOfUint32(value)
+	case "side.btcbridge.FeeDistribution.insurance_weight":
+		value := x.InsuranceWeight
+		return protoreflect.ValueOfUint32(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.FeeDistribution"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.FeeDistribution does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeeDistribution) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "side.btcbridge.FeeDistribution.epoch":
+		x.Epoch = value.Int()
+	case "side.btcbridge.FeeDistribution.community_pool_weight":
+		x.CommunityPoolWeight = uint32(value.Uint())
+	case "side.btcbridge.FeeDistribution.relayer_pool_weight":
+		x.RelayerPoolWeight = uint32(value.Uint())
+	case "side.btcbridge.FeeDistribution.signer_pool_weight":
+		x.SignerPoolWeight = uint32(value.Uint())
+	case "side.btcbridge.FeeDistribution.insurance_weight":
+		x.InsuranceWeight = uint32(value.Uint())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.FeeDistribution"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.FeeDistribution does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeeDistribution) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "side.btcbridge.FeeDistribution.epoch":
+		panic(fmt.Errorf("field epoch of message side.btcbridge.FeeDistribution is not mutable"))
+	case "side.btcbridge.FeeDistribution.community_pool_weight":
+		panic(fmt.Errorf("field community_pool_weight of message side.btcbridge.FeeDistribution is not mutable"))
+	case "side.btcbridge.FeeDistribution.relayer_pool_weight":
+		panic(fmt.Errorf("field relayer_pool_weight of message side.btcbridge.FeeDistribution is not mutable"))
+	case "side.btcbridge.FeeDistribution.signer_pool_weight":
+		panic(fmt.Errorf("field signer_pool_weight of message side.btcbridge.FeeDistribution is not mutable"))
+	case "side.btcbridge.FeeDistribution.insurance_weight":
+		panic(fmt.Errorf("field insurance_weight of message side.btcbridge.FeeDistribution is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.FeeDistribution"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.FeeDistribution does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_FeeDistribution) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "side.btcbridge.FeeDistribution.epoch":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "side.btcbridge.FeeDistribution.community_pool_weight":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "side.btcbridge.FeeDistribution.relayer_pool_weight":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "side.btcbridge.FeeDistribution.signer_pool_weight":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "side.btcbridge.FeeDistribution.insurance_weight":
+		return protoreflect.ValueOfUint32(uint32(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.FeeDistribution"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.FeeDistribution does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_FeeDistribution) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in side.btcbridge.FeeDistribution", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_FeeDistribution) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeeDistribution) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_FeeDistribution) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_FeeDistribution) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*FeeDistribution)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Epoch != 0 {
+			n += 1 + runtime.Sov(uint64(x.Epoch))
+		}
+		if x.CommunityPoolWeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.CommunityPoolWeight))
+		}
+		if x.RelayerPoolWeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.RelayerPoolWeight))
+		}
+		if x.SignerPoolWeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.SignerPoolWeight))
+		}
+		if x.InsuranceWeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.InsuranceWeight))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*FeeDistribution)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.InsuranceWeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.InsuranceWeight))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.SignerPoolWeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SignerPoolWeight))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.RelayerPoolWeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RelayerPoolWeight))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.CommunityPoolWeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.CommunityPoolWeight))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.Epoch != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Epoch))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*FeeDistribution)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FeeDistribution: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FeeDistribution: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+				}
+				x.Epoch = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Epoch |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CommunityPoolWeight", wireType)
+				}
+				x.CommunityPoolWeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.CommunityPoolWeight |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RelayerPoolWeight", wireType)
+				}
+				x.RelayerPoolWeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RelayerPoolWeight |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SignerPoolWeight", wireType)
+				}
+				x.SignerPoolWeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SignerPoolWeight |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InsuranceWeight", wireType)
+				}
+				x.InsuranceWeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.InsuranceWeight |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_FeeSchedule_4_list)(nil)
+
+type _FeeSchedule_4_list struct {
+	list *[]*FeeTier
+}
+
+func (x *_FeeSchedule_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_FeeSchedule_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_FeeSchedule_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*FeeTier)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_FeeSchedule_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*FeeTier)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_FeeSchedule_4_list) AppendMutable() protoreflect.Value {
+	v := new(FeeTier)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_FeeSchedule_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_FeeSchedule_4_list) NewElement() protoreflect.Value {
+	v := new(FeeTier)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_FeeSchedule_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_FeeSchedule         protoreflect.MessageDescriptor
+	fd_FeeSchedule_bps     protoreflect.FieldDescriptor
+	fd_FeeSchedule_min_fee protoreflect.FieldDescriptor
+	fd_FeeSchedule_max_fee protoreflect.FieldDescriptor
+	fd_FeeSchedule_tiers   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_side_btcbridge_params_proto_init()
+	md_FeeSchedule = File_side_btcbridge_params_proto.Messages().ByName("FeeSchedule")
+	fd_FeeSchedule_bps = md_FeeSchedule.Fields().ByName("bps")
+	fd_FeeSchedule_min_fee = md_FeeSchedule.Fields().ByName("min_fee")
+	fd_FeeSchedule_max_fee = md_FeeSchedule.Fields().ByName("max_fee")
+	fd_FeeSchedule_tiers = md_FeeSchedule.Fields().ByName("tiers")
+}
+
+var _ protoreflect.Message = (*fastReflection_FeeSchedule)(nil)
+
+type fastReflection_FeeSchedule FeeSchedule
+
+func (x *FeeSchedule) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_FeeSchedule)(x)
+}
+
+func (x *FeeSchedule) slowProtoReflect() protoreflect.Message {
+	mi := &file_side_btcbridge_params_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_FeeSchedule_messageType fastReflection_FeeSchedule_messageType
+var _ protoreflect.MessageType = fastReflection_FeeSchedule_messageType{}
+
+type fastReflection_FeeSchedule_messageType struct{}
+
+func (x fastReflection_FeeSchedule_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_FeeSchedule)(nil)
+}
+func (x fastReflection_FeeSchedule_messageType) New() protoreflect.Message {
+	return new(fastReflection_FeeSchedule)
+}
+func (x fastReflection_FeeSchedule_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_FeeSchedule
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_FeeSchedule) Descriptor() protoreflect.MessageDescriptor {
+	return md_FeeSchedule
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_FeeSchedule) Type() protoreflect.MessageType {
+	return _fastReflection_FeeSchedule_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_FeeSchedule) New() protoreflect.Message {
+	return new(fastReflection_FeeSchedule)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_FeeSchedule) Interface() protoreflect.ProtoMessage {
+	return (*FeeSchedule)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_FeeSchedule) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Bps != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Bps)
+		if !f(fd_FeeSchedule_bps, value) {
+			return
+		}
+	}
+	if x.MinFee != "" {
+		value := protoreflect.ValueOfString(x.MinFee)
+		if !f(fd_FeeSchedule_min_fee, value) {
+			return
+		}
+	}
+	if x.MaxFee != "" {
+		value := protoreflect.ValueOfString(x.MaxFee)
+		if !f(fd_FeeSchedule_max_fee, value) {
+			return
+		}
+	}
+	if len(x.Tiers) != 0 {
+		value := protoreflect.ValueOfList(&_FeeSchedule_4_list{list: &x.Tiers})
+		if !f(fd_FeeSchedule_tiers, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_FeeSchedule) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "side.btcbridge.FeeSchedule.bps":
+		return x.Bps != uint32(0)
+	case "side.btcbridge.FeeSchedule.min_fee":
+		return x.MinFee != ""
+	case "side.btcbridge.FeeSchedule.max_fee":
+		return x.MaxFee != ""
+	case "side.btcbridge.FeeSchedule.tiers":
+		return len(x.Tiers) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.FeeSchedule"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.FeeSchedule does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeeSchedule) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "side.btcbridge.FeeSchedule.bps":
+		x.Bps = uint32(0)
+	case "side.btcbridge.FeeSchedule.min_fee":
+		x.MinFee = ""
+	case "side.btcbridge.FeeSchedule.max_fee":
+		x.MaxFee = ""
+	case "side.btcbridge.FeeSchedule.tiers":
+		x.Tiers = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.FeeSchedule"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.FeeSchedule does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_FeeSchedule) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "side.btcbridge.FeeSchedule.bps":
+		value := x.Bps
+		return protoreflect.ValueOfUint32(value)
+	case "side.btcbridge.FeeSchedule.min_fee":
+		value := x.MinFee
+		return protoreflect.ValueOfString(value)
+	case "side.btcbridge.FeeSchedule.max_fee":
+		value := x.MaxFee
+		return protoreflect.ValueOfString(value)
+	case "side.btcbridge.FeeSchedule.tiers":
+		if len(x.Tiers) == 0 {
+			return protoreflect.ValueOfList(&_FeeSchedule_4_list{})
+		}
+		listValue := &_FeeSchedule_4_list{list: &x.Tiers}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.FeeSchedule"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.FeeSchedule does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeeSchedule) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "side.btcbridge.FeeSchedule.bps":
+		x.Bps = uint32(value.Uint())
+	case "side.btcbridge.FeeSchedule.min_fee":
+		x.MinFee = value.Interface().(string)
+	case "side.btcbridge.FeeSchedule.max_fee":
+		x.MaxFee = value.Interface().(string)
+	case "side.btcbridge.FeeSchedule.tiers":
+		lv := value.List()
+		clv := lv.(*_FeeSchedule_4_list)
+		x.Tiers = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.FeeSchedule"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.FeeSchedule does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeeSchedule) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "side.btcbridge.FeeSchedule.tiers":
+		if x.Tiers == nil {
+			x.Tiers = []*FeeTier{}
+		}
+		value := &_FeeSchedule_4_list{list: &x.Tiers}
+		return protoreflect.ValueOfList(value)
+	case "side.btcbridge.FeeSchedule.bps":
+		panic(fmt.Errorf("field bps of message side.btcbridge.FeeSchedule is not mutable"))
+	case "side.btcbridge.FeeSchedule.min_fee":
+		panic(fmt.Errorf("field min_fee of message side.btcbridge.FeeSchedule is not mutable"))
+	case "side.btcbridge.FeeSchedule.max_fee":
+		panic(fmt.Errorf("field max_fee of message side.btcbridge.FeeSchedule is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.FeeSchedule"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.FeeSchedule does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_FeeSchedule) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "side.btcbridge.FeeSchedule.bps":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "side.btcbridge.FeeSchedule.min_fee":
+		return protoreflect.ValueOfString("")
+	case "side.btcbridge.FeeSchedule.max_fee":
+		return protoreflect.ValueOfString("")
+	case "side.btcbridge.FeeSchedule.tiers":
+		list := []*FeeTier{}
+		return protoreflect.ValueOfList(&_FeeSchedule_4_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.FeeSchedule"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.FeeSchedule does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_FeeSchedule) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in side.btcbridge.FeeSchedule", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_FeeSchedule) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeeSchedule) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_FeeSchedule) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_FeeSchedule) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*FeeSchedule)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Bps != 0 {
+			n += 1 + runtime.Sov(uint64(x.Bps))
+		}
+		l = len(x.MinFee)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MaxFee)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Tiers) > 0 {
+			for _, e := range x.Tiers {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*FeeSchedule)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Tiers) > 0 {
+			for iNdEx := len(x.Tiers) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Tiers[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if len(x.MaxFee) > 0 {
+			i -= len(x.MaxFee)
+			copy(dAtA[i:], x.MaxFee)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaxFee)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.MinFee) > 0 {
+			i -= len(x.MinFee)
+			copy(dAtA[i:], x.MinFee)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MinFee)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Bps != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Bps))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*FeeSchedule)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FeeSchedule: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FeeSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Bps", wireType)
+				}
+				x.Bps = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Bps |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinFee", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MinFee = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxFee", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MaxFee = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Tiers", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Tiers = append(x.Tiers, &FeeTier{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Tiers[len(x.Tiers)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_FeeTier            protoreflect.MessageDescriptor
+	fd_FeeTier_min_amount protoreflect.FieldDescriptor
+	fd_FeeTier_bps        protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_side_btcbridge_params_proto_init()
+	md_FeeTier = File_side_btcbridge_params_proto.Messages().ByName("FeeTier")
+	fd_FeeTier_min_amount = md_FeeTier.Fields().ByName("min_amount")
+	fd_FeeTier_bps = md_FeeTier.Fields().ByName("bps")
+}
+
+var _ protoreflect.Message = (*fastReflection_FeeTier)(nil)
+
+type fastReflection_FeeTier FeeTier
+
+func (x *FeeTier) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_FeeTier)(x)
+}
+
+func (x *FeeTier) slowProtoReflect() protoreflect.Message {
+	mi := &file_side_btcbridge_params_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_FeeTier_messageType fastReflection_FeeTier_messageType
+var _ protoreflect.MessageType = fastReflection_FeeTier_messageType{}
+
+type fastReflection_FeeTier_messageType struct{}
+
+func (x fastReflection_FeeTier_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_FeeTier)(nil)
+}
+func (x fastReflection_FeeTier_messageType) New() protoreflect.Message {
+	return new(fastReflection_FeeTier)
+}
+func (x fastReflection_FeeTier_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_FeeTier
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_FeeTier) Descriptor() protoreflect.MessageDescriptor {
+	return md_FeeTier
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_FeeTier) Type() protoreflect.MessageType {
+	return _fastReflection_FeeTier_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_FeeTier) New() protoreflect.Message {
+	return new(fastReflection_FeeTier)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_FeeTier) Interface() protoreflect.ProtoMessage {
+	return (*FeeTier)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_FeeTier) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.MinAmount != "" {
+		value := protoreflect.ValueOfString(x.MinAmount)
+		if !f(fd_FeeTier_min_amount, value) {
+			return
+		}
+	}
+	if x.Bps != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Bps)
+		if !f(fd_FeeTier_bps, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_FeeTier) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "side.btcbridge.FeeTier.min_amount":
+		return x.MinAmount != ""
+	case "side.btcbridge.FeeTier.bps":
+		return x.Bps != uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.FeeTier"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.FeeTier does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeeTier) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "side.btcbridge.FeeTier.min_amount":
+		x.MinAmount = ""
+	case "side.btcbridge.FeeTier.bps":
+		x.Bps = uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.FeeTier"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.FeeTier does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_FeeTier) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "side.btcbridge.FeeTier.min_amount":
+		value := x.MinAmount
+		return protoreflect.ValueOfString(value)
+	case "side.btcbridge.FeeTier.bps":
+		value := x.Bps
+		return protoreflect.ValueOfUint32(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.FeeTier"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.FeeTier does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeeTier) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "side.btcbridge.FeeTier.min_amount":
+		x.MinAmount = value.Interface().(string)
+	case "side.btcbridge.FeeTier.bps":
+		x.Bps = uint32(value.Uint())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.FeeTier"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.FeeTier does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeeTier) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "side.btcbridge.FeeTier.min_amount":
+		panic(fmt.Errorf("field min_amount of message side.btcbridge.FeeTier is not mutable"))
+	case "side.btcbridge.FeeTier.bps":
+		panic(fmt.Errorf("field bps of message side.btcbridge.FeeTier is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.FeeTier"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.FeeTier does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_FeeTier) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "side.btcbridge.FeeTier.min_amount":
+		return protoreflect.ValueOfString("")
+	case "side.btcbridge.FeeTier.bps":
+		return protoreflect.ValueOfUint32(uint32(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.FeeTier"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.FeeTier does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_FeeTier) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in side.btcbridge.FeeTier", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_FeeTier) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeeTier) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_FeeTier) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_FeeTier) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*FeeTier)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.MinAmount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Bps != 0 {
+			n += 1 + runtime.Sov(uint64(x.Bps))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*FeeTier)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Bps != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Bps))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.MinAmount) > 0 {
+			i -= len(x.MinAmount)
+			copy(dAtA[i:], x.MinAmount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MinAmount)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*FeeTier)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FeeTier: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FeeTier: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinAmount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MinAmount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Bps", wireType)
+				}
+				x.Bps = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Bps |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_RuneConfig              protoreflect.MessageDescriptor
+	fd_RuneConfig_id           protoreflect.FieldDescriptor
+	fd_RuneConfig_enabled      protoreflect.FieldDescriptor
+	fd_RuneConfig_min_deposit  protoreflect.FieldDescriptor
+	fd_RuneConfig_min_withdraw protoreflect.FieldDescriptor
+	fd_RuneConfig_max_withdraw protoreflect.FieldDescriptor
+	fd_RuneConfig_supply_cap   protoreflect.FieldDescriptor
+	fd_RuneConfig_fee_override protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_side_btcbridge_params_proto_init()
+	md_RuneConfig = File_side_btcbridge_params_proto.Messages().ByName("RuneConfig")
+	fd_RuneConfig_id = md_RuneConfig.Fields().ByName("id")
+	fd_RuneConfig_enabled = md_RuneConfig.Fields().ByName("enabled")
+	fd_RuneConfig_min_deposit = md_RuneConfig.Fields().ByName("min_deposit")
+	fd_RuneConfig_min_withdraw = md_RuneConfig.Fields().ByName("min_withdraw")
+	fd_RuneConfig_max_withdraw = md_RuneConfig.Fields().ByName("max_withdraw")
+	fd_RuneConfig_supply_cap = md_RuneConfig.Fields().ByName("supply_cap")
+	fd_RuneConfig_fee_override = md_RuneConfig.Fields().ByName("fee_override")
+}
+
+var _ protoreflect.Message = (*fastReflection_RuneConfig)(nil)
+
+type fastReflection_RuneConfig RuneConfig
+
+func (x *RuneConfig) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_RuneConfig)(x)
+}
+
+func (x *RuneConfig) slowProtoReflect() protoreflect.Message {
+	mi := &file_side_btcbridge_params_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_RuneConfig_messageType fastReflection_RuneConfig_messageType
+var _ protoreflect.MessageType = fastReflection_RuneConfig_messageType{}
+
+type fastReflection_RuneConfig_messageType struct{}
+
+func (x fastReflection_RuneConfig_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_RuneConfig)(nil)
+}
+func (x fastReflection_RuneConfig_messageType) New() protoreflect.Message {
+	return new(fastReflection_RuneConfig)
+}
+func (x fastReflection_RuneConfig_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_RuneConfig
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_RuneConfig) Descriptor() protoreflect.MessageDescriptor {
+	return md_RuneConfig
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_RuneConfig) Type() protoreflect.MessageType {
+	return _fastReflection_RuneConfig_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_RuneConfig) New() protoreflect.Message {
+	return new(fastReflection_RuneConfig)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_RuneConfig) Interface() protoreflect.ProtoMessage {
+	return (*RuneConfig)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_RuneConfig) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != "" {
+		value := protoreflect.ValueOfString(x.Id)
+		if !f(fd_RuneConfig_id, value) {
+			return
+		}
+	}
+	if x.Enabled != false {
+		value := protoreflect.ValueOfBool(x.Enabled)
+		if !f(fd_RuneConfig_enabled, value) {
+			return
+		}
+	}
+	if x.MinDeposit != "" {
+		value := protoreflect.ValueOfString(x.MinDeposit)
+		if !f(fd_RuneConfig_min_deposit, value) {
+			return
+		}
+	}
+	if x.MinWithdraw != "" {
+		value := protoreflect.ValueOfString(x.MinWithdraw)
+		if !f(fd_RuneConfig_min_withdraw, value) {
+			return
+		}
+	}
+	if x.MaxWithdraw != "" {
+		value := protoreflect.ValueOfString(x.MaxWithdraw)
+		if !f(fd_RuneConfig_max_withdraw, value) {
+			return
+		}
+	}
+	if x.SupplyCap != "" {
+		value := protoreflect.ValueOfString(x.SupplyCap)
+		if !f(fd_RuneConfig_supply_cap, value) {
+			return
+		}
+	}
+	if x.FeeOverride != nil {
+		value := protoreflect.ValueOfMessage(x.FeeOverride.ProtoReflect())
+		if !f(fd_RuneConfig_fee_override, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_RuneConfig) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "side.btcbridge.RuneConfig.id":
+		return x.Id != ""
+	case "side.btcbridge.RuneConfig.enabled":
+		return x.Enabled != false
+	case "side.btcbridge.RuneConfig.min_deposit":
+		return x.MinDeposit != ""
+	case "side.btcbridge.RuneConfig.min_withdraw":
+		return x.MinWithdraw != ""
+	case "side.btcbridge.RuneConfig.max_withdraw":
+		return x.MaxWithdraw != ""
+	case "side.btcbridge.RuneConfig.supply_cap":
+		return x.SupplyCap != ""
+	case "side.btcbridge.RuneConfig.fee_override":
+		return x.FeeOverride != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.RuneConfig"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.RuneConfig does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RuneConfig) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "side.btcbridge.RuneConfig.id":
+		x.Id = ""
+	case "side.btcbridge.RuneConfig.enabled":
+		x.Enabled = false
+	case "side.btcbridge.RuneConfig.min_deposit":
+		x.MinDeposit = ""
+	case "side.btcbridge.RuneConfig.min_withdraw":
+		x.MinWithdraw = ""
+	case "side.btcbridge.RuneConfig.max_withdraw":
+		x.MaxWithdraw = ""
+	case "side.btcbridge.RuneConfig.supply_cap":
+		x.SupplyCap = ""
+	case "side.btcbridge.RuneConfig.fee_override":
+		x.FeeOverride = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.RuneConfig"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.RuneConfig does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_RuneConfig) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "side.btcbridge.RuneConfig.id":
+		value := x.Id
+		return protoreflect.ValueOfString(value)
+	case "side.btcbridge.RuneConfig.enabled":
+		value := x.Enabled
+		return protoreflect.ValueOfBool(value)
+	case "side.btcbridge.RuneConfig.min_deposit":
+		value := x.MinDeposit
+		return protoreflect.ValueOfString(value)
+	case "side.btcbridge.RuneConfig.min_withdraw":
+		value := x.MinWithdraw
+		return protoreflect.ValueOfString(value)
+	case "side.btcbridge.RuneConfig.max_withdraw":
+		value := x.MaxWithdraw
+		return protoreflect.ValueOfString(value)
+	case "side.btcbridge.RuneConfig.supply_cap":
+		value := x.SupplyCap
+		return protoreflect.ValueOfString(value)
+	case "side.btcbridge.RuneConfig.fee_override":
+		value := x.FeeOverride
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.RuneConfig"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.RuneConfig does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RuneConfig) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "side.btcbridge.RuneConfig.id":
+		x.Id = value.Interface().(string)
+	case "side.btcbridge.RuneConfig.enabled":
+		x.Enabled = value.Bool()
+	case "side.btcbridge.RuneConfig.min_deposit":
+		x.MinDeposit = value.Interface().(string)
+	case "side.btcbridge.RuneConfig.min_withdraw":
+		x.MinWithdraw = value.Interface().(string)
+	case "side.btcbridge.RuneConfig.max_withdraw":
+		x.MaxWithdraw = value.Interface().(string)
+	case "side.btcbridge.RuneConfig.supply_cap":
+		x.SupplyCap = value.Interface().(string)
+	case "side.btcbridge.RuneConfig.fee_override":
+		x.FeeOverride = value.Message().Interface().(*RuneFees)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.RuneConfig"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.RuneConfig does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RuneConfig) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "side.btcbridge.RuneConfig.fee_override":
+		if x.FeeOverride == nil {
+			x.FeeOverride = new(RuneFees)
+		}
+		return protoreflect.ValueOfMessage(x.FeeOverride.ProtoReflect())
+	case "side.btcbridge.RuneConfig.id":
+		panic(fmt.Errorf("field id of message side.btcbridge.RuneConfig is not mutable"))
+	case "side.btcbridge.RuneConfig.enabled":
+		panic(fmt.Errorf("field enabled of message side.btcbridge.RuneConfig is not mutable"))
+	case "side.btcbridge.RuneConfig.min_deposit":
+		panic(fmt.Errorf("field min_deposit of message side.btcbridge.RuneConfig is not mutable"))
+	case "side.btcbridge.RuneConfig.min_withdraw":
+		panic(fmt.Errorf("field min_withdraw of message side.btcbridge.RuneConfig is not mutable"))
+	case "side.btcbridge.RuneConfig.max_withdraw":
+		panic(fmt.Errorf("field max_withdraw of message side.btcbridge.RuneConfig is not mutable"))
+	case "side.btcbridge.RuneConfig.supply_cap":
+		panic(fmt.Errorf("field supply_cap of message side.btcbridge.RuneConfig is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.RuneConfig"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.RuneConfig does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_RuneConfig) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "side.btcbridge.RuneConfig.id":
+		return protoreflect.ValueOfString("")
+	case "side.btcbridge.RuneConfig.enabled":
+		return protoreflect.ValueOfBool(false)
+	case "side.btcbridge.RuneConfig.min_deposit":
+		return protoreflect.ValueOfString("")
+	case "side.btcbridge.RuneConfig.min_withdraw":
+		return protoreflect.ValueOfString("")
+	case "side.btcbridge.RuneConfig.max_withdraw":
+		return protoreflect.ValueOfString("")
+	case "side.btcbridge.RuneConfig.supply_cap":
+		return protoreflect.ValueOfString("")
+	case "side.btcbridge.RuneConfig.fee_override":
+		m := new(RuneFees)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.RuneConfig"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.RuneConfig does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_RuneConfig) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in side.btcbridge.RuneConfig", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_RuneConfig) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RuneConfig) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_RuneConfig) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_RuneConfig) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*RuneConfig)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Id)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Enabled {
+			n += 2
+		}
+		l = len(x.MinDeposit)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MinWithdraw)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MaxWithdraw)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.SupplyCap)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.FeeOverride != nil {
+			l = options.Size(x.FeeOverride)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*RuneConfig)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.FeeOverride != nil {
+			encoded, err := options.Marshal(x.FeeOverride)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.SupplyCap) > 0 {
+			i -= len(x.SupplyCap)
+			copy(dAtA[i:], x.SupplyCap)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SupplyCap)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.MaxWithdraw) > 0 {
+			i -= len(x.MaxWithdraw)
+			copy(dAtA[i:], x.MaxWithdraw)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaxWithdraw)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.MinWithdraw) > 0 {
+			i -= len(x.MinWithdraw)
+			copy(dAtA[i:], x.MinWithdraw)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MinWithdraw)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.MinDeposit) > 0 {
+			i -= len(x.MinDeposit)
+			copy(dAtA[i:], x.MinDeposit)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MinDeposit)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.Enabled {
+			i--
+			if x.Enabled {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Id) > 0 {
+			i -= len(x.Id)
+			copy(dAtA[i:], x.Id)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Id)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*RuneConfig)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RuneConfig: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RuneConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Id = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Enabled = bool(v != 0)
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinDeposit", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MinDeposit = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinWithdraw", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MinWithdraw = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxWithdraw", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MaxWithdraw = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SupplyCap", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SupplyCap = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FeeOverride", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.FeeOverride == nil {
+					x.FeeOverride = &RuneFees{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.FeeOverride); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_RuneFees                       protoreflect.MessageDescriptor
+	fd_RuneFees_deposit_fee_schedule  protoreflect.FieldDescriptor
+	fd_RuneFees_withdraw_fee_schedule protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_side_btcbridge_params_proto_init()
+	md_RuneFees = File_side_btcbridge_params_proto.Messages().ByName("RuneFees")
+	fd_RuneFees_deposit_fee_schedule = md_RuneFees.Fields().ByName("deposit_fee_schedule")
+	fd_RuneFees_withdraw_fee_schedule = md_RuneFees.Fields().ByName("withdraw_fee_schedule")
+}
+
+var _ protoreflect.Message = (*fastReflection_RuneFees)(nil)
+
+type fastReflection_RuneFees RuneFees
+
+func (x *RuneFees) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_RuneFees)(x)
+}
+
+func (x *RuneFees) slowProtoReflect() protoreflect.Message {
+	mi := &file_side_btcbridge_params_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_RuneFees_messageType fastReflection_RuneFees_messageType
+var _ protoreflect.MessageType = fastReflection_RuneFees_messageType{}
+
+type fastReflection_RuneFees_messageType struct{}
+
+func (x fastReflection_RuneFees_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_RuneFees)(nil)
+}
+func (x fastReflection_RuneFees_messageType) New() protoreflect.Message {
+	return new(fastReflection_RuneFees)
+}
+func (x fastReflection_RuneFees_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_RuneFees
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_RuneFees) Descriptor() protoreflect.MessageDescriptor {
+	return md_RuneFees
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_RuneFees) Type() protoreflect.MessageType {
+	return _fastReflection_RuneFees_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_RuneFees) New() protoreflect.Message {
+	return new(fastReflection_RuneFees)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_RuneFees) Interface() protoreflect.ProtoMessage {
+	return (*RuneFees)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_RuneFees) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.DepositFeeSchedule != nil {
+		value := protoreflect.ValueOfMessage(x.DepositFeeSchedule.ProtoReflect())
+		if !f(fd_RuneFees_deposit_fee_schedule, value) {
+			return
+		}
+	}
+	if x.WithdrawFeeSchedule != nil {
+		value := protoreflect.ValueOfMessage(x.WithdrawFeeSchedule.ProtoReflect())
+		if !f(fd_RuneFees_withdraw_fee_schedule, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_RuneFees) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "side.btcbridge.RuneFees.deposit_fee_schedule":
+		return x.DepositFeeSchedule != nil
+	case "side.btcbridge.RuneFees.withdraw_fee_schedule":
+		return x.WithdrawFeeSchedule != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.RuneFees"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.RuneFees does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RuneFees) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "side.btcbridge.RuneFees.deposit_fee_schedule":
+		x.DepositFeeSchedule = nil
+	case "side.btcbridge.RuneFees.withdraw_fee_schedule":
+		x.WithdrawFeeSchedule = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.RuneFees"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.RuneFees does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_RuneFees) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "side.btcbridge.RuneFees.deposit_fee_schedule":
+		value := x.DepositFeeSchedule
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "side.btcbridge.RuneFees.withdraw_fee_schedule":
+		value := x.WithdrawFeeSchedule
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.RuneFees"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.RuneFees does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RuneFees) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "side.btcbridge.RuneFees.deposit_fee_schedule":
+		x.DepositFeeSchedule = value.Message().Interface().(*FeeSchedule)
+	case "side.btcbridge.RuneFees.withdraw_fee_schedule":
+		x.WithdrawFeeSchedule = value.Message().Interface().(*FeeSchedule)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.RuneFees"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.RuneFees does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RuneFees) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "side.btcbridge.RuneFees.deposit_fee_schedule":
+		if x.DepositFeeSchedule == nil {
+			x.DepositFeeSchedule = new(FeeSchedule)
+		}
+		return protoreflect.ValueOfMessage(x.DepositFeeSchedule.ProtoReflect())
+	case "side.btcbridge.RuneFees.withdraw_fee_schedule":
+		if x.WithdrawFeeSchedule == nil {
+			x.WithdrawFeeSchedule = new(FeeSchedule)
+		}
+		return protoreflect.ValueOfMessage(x.WithdrawFeeSchedule.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.RuneFees"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.RuneFees does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_RuneFees) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "side.btcbridge.RuneFees.deposit_fee_schedule":
+		m := new(FeeSchedule)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "side.btcbridge.RuneFees.withdraw_fee_schedule":
+		m := new(FeeSchedule)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.RuneFees"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.RuneFees does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_RuneFees) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in side.btcbridge.RuneFees", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_RuneFees) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RuneFees) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_RuneFees) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_RuneFees) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*RuneFees)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.DepositFeeSchedule != nil {
+			l = options.Size(x.DepositFeeSchedule)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.WithdrawFeeSchedule != nil {
+			l = options.Size(x.WithdrawFeeSchedule)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*RuneFees)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.WithdrawFeeSchedule != nil {
+			encoded, err := options.Marshal(x.WithdrawFeeSchedule)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.DepositFeeSchedule != nil {
+			encoded, err := options.Marshal(x.DepositFeeSchedule)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*RuneFees)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RuneFees: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RuneFees: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DepositFeeSchedule", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.DepositFeeSchedule == nil {
+					x.DepositFeeSchedule = &FeeSchedule{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DepositFeeSchedule); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WithdrawFeeSchedule", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.WithdrawFeeSchedule == nil {
+					x.WithdrawFeeSchedule = &FeeSchedule{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.WithdrawFeeSchedule); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_TSSParams                                      protoreflect.MessageDescriptor
+	fd_TSSParams_dkg_timeout_period                   protoreflect.FieldDescriptor
+	fd_TSSParams_participant_update_transition_period protoreflect.FieldDescriptor
+	fd_TSSParams_dkg_max_retries                      protoreflect.FieldDescriptor
+	fd_TSSParams_auto_rotation_enabled                protoreflect.FieldDescriptor
+	fd_TSSParams_max_participants                     protoreflect.FieldDescriptor
+	fd_TSSParams_stake_shift_threshold_bps            protoreflect.FieldDescriptor
+	fd_TSSParams_signer_liveness                      protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_side_btcbridge_params_proto_init()
+	md_TSSParams = File_side_btcbridge_params_proto.Messages().ByName("TSSParams")
+	fd_TSSParams_dkg_timeout_period = md_TSSParams.Fields().ByName("dkg_timeout_period")
+	fd_TSSParams_participant_update_transition_period = md_TSSParams.Fields().ByName("participant_update_transition_period")
+	fd_TSSParams_dkg_max_retries = md_TSSParams.Fields().ByName("dkg_max_retries")
+	fd_TSSParams_auto_rotation_enabled = md_TSSParams.Fields().ByName("auto_rotation_enabled")
+	fd_TSSParams_max_participants = md_TSSParams.Fields().ByName("max_participants")
+	fd_TSSParams_stake_shift_threshold_bps = md_TSSParams.Fields().ByName("stake_shift_threshold_bps")
+	fd_TSSParams_signer_liveness = md_TSSParams.Fields().ByName("signer_liveness")
+}
+
+var _ protoreflect.Message = (*fastReflection_TSSParams)(nil)
+
+type fastReflection_TSSParams TSSParams
+
+func (x *TSSParams) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TSSParams)(x)
+}
+
+func (x *TSSParams) slowProtoReflect() protoreflect.Message {
+	mi := &file_side_btcbridge_params_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_TSSParams_messageType fastReflection_TSSParams_messageType
+var _ protoreflect.MessageType = fastReflection_TSSParams_messageType{}
+
+type fastReflection_TSSParams_messageType struct{}
+
+func (x fastReflection_TSSParams_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TSSParams)(nil)
+}
+func (x fastReflection_TSSParams_messageType) New() protoreflect.Message {
+	return new(fastReflection_TSSParams)
+}
+func (x fastReflection_TSSParams_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TSSParams
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_TSSParams) Descriptor() protoreflect.MessageDescriptor {
+	return md_TSSParams
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_TSSParams) Type() protoreflect.MessageType {
+	return _fastReflection_TSSParams_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_TSSParams) New() protoreflect.Message {
+	return new(fastReflection_TSSParams)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_TSSParams) Interface() protoreflect.ProtoMessage {
+	return (*TSSParams)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_TSSParams) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.DkgTimeoutPeriod != nil {
+		value := protoreflect.ValueOfMessage(x.DkgTimeoutPeriod.ProtoReflect())
+		if !f(fd_TSSParams_dkg_timeout_period, value) {
+			return
+		}
+	}
+	if x.ParticipantUpdateTransitionPeriod != nil {
+		value := protoreflect.ValueOfMessage(x.ParticipantUpdateTransitionPeriod.ProtoReflect())
+		if !f(fd_TSSParams_participant_update_transition_period, value) {
+			return
+		}
+	}
+	if x.DkgMaxRetries != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.DkgMaxRetries)
+		if !f(fd_TSSParams_dkg_max_retries, value) {
+			return
+		}
+	}
+	if x.AutoRotationEnabled != false {
+		value := protoreflect.ValueOfBool(x.AutoRotationEnabled)
+		if !f(fd_TSSParams_auto_rotation_enabled, value) {
+			return
+		}
+	}
+	if x.MaxParticipants != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.MaxParticipants)
+		if !f(fd_TSSParams_max_participants, value) {
+			return
+		}
+	}
+	if x.StakeShiftThresholdBps != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.StakeShiftThresholdBps)
+		if !f(fd_TSSParams_stake_shift_threshold_bps, value) {
+			return
+		}
+	}
+	if x.SignerLiveness != nil {
+		value := protoreflect.ValueOfMessage(x.SignerLiveness.ProtoReflect())
+		if !f(fd_TSSParams_signer_liveness, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_TSSParams) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "side.btcbridge.TSSParams.dkg_timeout_period":
+		return x.DkgTimeoutPeriod != nil
+	case "side.btcbridge.TSSParams.participant_update_transition_period":
+		return x.ParticipantUpdateTransitionPeriod != nil
+	case "side.btcbridge.TSSParams.dkg_max_retries":
+		return x.DkgMaxRetries != uint32(0)
+	case "side.btcbridge.TSSParams.auto_rotation_enabled":
+		return x.AutoRotationEnabled != false
+	case "side.btcbridge.TSSParams.max_participants":
+		return x.MaxParticipants != uint32(0)
+	case "side.btcbridge.TSSParams.stake_shift_threshold_bps":
+		return x.StakeShiftThresholdBps != uint32(0)
+	case "side.btcbridge.TSSParams.signer_liveness":
+		return x.SignerLiveness != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.TSSParams"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.TSSParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TSSParams) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "side.btcbridge.TSSParams.dkg_timeout_period":
+		x.DkgTimeoutPeriod = nil
+	case "side.btcbridge.TSSParams.participant_update_transition_period":
+		x.ParticipantUpdateTransitionPeriod = nil
+	case "side.btcbridge.TSSParams.dkg_max_retries":
+		x.DkgMaxRetries = uint32(0)
+	case "side.btcbridge.TSSParams.auto_rotation_enabled":
+		x.AutoRotationEnabled = false
+	case "side.btcbridge.TSSParams.max_participants":
+		x.MaxParticipants = uint32(0)
+	case "side.btcbridge.TSSParams.stake_shift_threshold_bps":
+		x.StakeShiftThresholdBps = uint32(0)
+	case "side.btcbridge.TSSParams.signer_liveness":
+		x.SignerLiveness = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.TSSParams"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.TSSParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_TSSParams) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "side.btcbridge.TSSParams.dkg_timeout_period":
+		value := x.DkgTimeoutPeriod
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "side.btcbridge.TSSParams.participant_update_transition_period":
+		value := x.ParticipantUpdateTransitionPeriod
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "side.btcbridge.TSSParams.dkg_max_retries":
+		value := x.DkgMaxRetries
+		return protoreflect.ValueOfUint32(value)
+	case "side.btcbridge.TSSParams.auto_rotation_enabled":
+		value := x.AutoRotationEnabled
+		return protoreflect.ValueOfBool(value)
+	case "side.btcbridge.TSSParams.max_participants":
+		value := x.MaxParticipants
+		return protoreflect.ValueOfUint32(value)
+	case "side.btcbridge.TSSParams.stake_shift_threshold_bps":
+		value := x.StakeShiftThresholdBps
+		return protoreflect.ValueOfUint32(value)
+	case "side.btcbridge.TSSParams.signer_liveness":
+		value := x.SignerLiveness
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.TSSParams"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.TSSParams does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TSSParams) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "side.btcbridge.TSSParams.dkg_timeout_period":
+		x.DkgTimeoutPeriod = value.Message().Interface().(*durationpb.Duration)
+	case "side.btcbridge.TSSParams.participant_update_transition_period":
+		x.ParticipantUpdateTransitionPeriod = value.Message().Interface().(*durationpb.Duration)
+	case "side.btcbridge.TSSParams.dkg_max_retries":
+		x.DkgMaxRetries = uint32(value.Uint())
+	case "side.btcbridge.TSSParams.auto_rotation_enabled":
+		x.AutoRotationEnabled = value.Bool()
+	case "side.btcbridge.TSSParams.max_participants":
+		x.MaxParticipants = uint32(value.Uint())
+	case "side.btcbridge.TSSParams.stake_shift_threshold_bps":
+		x.StakeShiftThresholdBps = uint32(value.Uint())
+	case "side.btcbridge.TSSParams.signer_liveness":
+		x.SignerLiveness = value.Message().Interface().(*SignerLivenessParams)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.TSSParams"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.TSSParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TSSParams) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "side.btcbridge.TSSParams.dkg_timeout_period":
+		if x.DkgTimeoutPeriod == nil {
+			x.DkgTimeoutPeriod = new(durationpb.Duration)
+		}
+		return protoreflect.ValueOfMessage(x.DkgTimeoutPeriod.ProtoReflect())
+	case "side.btcbridge.TSSParams.participant_update_transition_period":
+		if x.ParticipantUpdateTransitionPeriod == nil {
+			x.ParticipantUpdateTransitionPeriod = new(durationpb.Duration)
+		}
+		return protoreflect.ValueOfMessage(x.ParticipantUpdateTransitionPeriod.ProtoReflect())
+	case "side.btcbridge.TSSParams.signer_liveness":
+		if x.SignerLiveness == nil {
+			x.SignerLiveness = new(SignerLivenessParams)
+		}
+		return protoreflect.ValueOfMessage(x.SignerLiveness.ProtoReflect())
+	case "side.btcbridge.TSSParams.dkg_max_retries":
+		panic(fmt.Errorf("field dkg_max_retries of message side.btcbridge.TSSParams is not mutable"))
+	case "side.btcbridge.TSSParams.auto_rotation_enabled":
+		panic(fmt.Errorf("field auto_rotation_enabled of message side.btcbridge.TSSParams is not mutable"))
+	case "side.btcbridge.TSSParams.max_participants":
+		panic(fmt.Errorf("field max_participants of message side.btcbridge.TSSParams is not mutable"))
+	case "side.btcbridge.TSSParams.stake_shift_threshold_bps":
+		panic(fmt.Errorf("field stake_shift_threshold_bps of message side.btcbridge.TSSParams is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.TSSParams"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.TSSParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_TSSParams) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "side.btcbridge.TSSParams.dkg_timeout_period":
+		m := new(durationpb.Duration)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "side.btcbridge.TSSParams.participant_update_transition_period":
+		m := new(durationpb.Duration)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "side.btcbridge.TSSParams.dkg_max_retries":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "side.btcbridge.TSSParams.auto_rotation_enabled":
+		return protoreflect.ValueOfBool(false)
+	case "side.btcbridge.TSSParams.max_participants":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "side.btcbridge.TSSParams.stake_shift_threshold_bps":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "side.btcbridge.TSSParams.signer_liveness":
+		m := new(SignerLivenessParams)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.TSSParams"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.TSSParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_TSSParams) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in side.btcbridge.TSSParams", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_TSSParams) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TSSParams) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_TSSParams) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_TSSParams) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*TSSParams)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.DkgTimeoutPeriod != nil {
+			l = options.Size(x.DkgTimeoutPeriod)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ParticipantUpdateTransitionPeriod != nil {
+			l = options.Size(x.ParticipantUpdateTransitionPeriod)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.DkgMaxRetries != 0 {
+			n += 1 + runtime.Sov(uint64(x.DkgMaxRetries))
+		}
+		if x.AutoRotationEnabled {
+			n += 2
+		}
+		if x.MaxParticipants != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxParticipants))
+		}
+		if x.StakeShiftThresholdBps != 0 {
+			n += 1 + runtime.Sov(uint64(x.StakeShiftThresholdBps))
+		}
+		if x.SignerLiveness != nil {
+			l = options.Size(x.SignerLiveness)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*TSSParams)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.SignerLiveness != nil {
+			encoded, err := options.Marshal(x.SignerLiveness)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if x.StakeShiftThresholdBps != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.StakeShiftThresholdBps))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.MaxParticipants != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxParticipants))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.AutoRotationEnabled {
+			i--
+			if x.AutoRotationEnabled {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.DkgMaxRetries != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DkgMaxRetries))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.ParticipantUpdateTransitionPeriod != nil {
+			encoded, err := options.Marshal(x.ParticipantUpdateTransitionPeriod)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.DkgTimeoutPeriod != nil {
+			encoded, err := options.Marshal(x.DkgTimeoutPeriod)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*TSSParams)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TSSParams: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TSSParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DkgTimeoutPeriod", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.DkgTimeoutPeriod == nil {
+					x.DkgTimeoutPeriod = &durationpb.Duration{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DkgTimeoutPeriod); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ParticipantUpdateTransitionPeriod", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.ParticipantUpdateTransitionPeriod == nil {
+					x.ParticipantUpdateTransitionPeriod = &durationpb.Duration{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ParticipantUpdateTransitionPeriod); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DkgMaxRetries", wireType)
+				}
+				x.DkgMaxRetries = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.DkgMaxRetries |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AutoRotationEnabled", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.AutoRotationEnabled = bool(v != 0)
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxParticipants", wireType)
+				}
+				x.MaxParticipants = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxParticipants |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StakeShiftThresholdBps", wireType)
+				}
+				x.StakeShiftThresholdBps = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.StakeShiftThresholdBps |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SignerLiveness", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.SignerLiveness == nil {
+					x.SignerLiveness = &SignerLivenessParams{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.SignerLiveness); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_SignerLivenessParams                       protoreflect.MessageDescriptor
+	fd_SignerLivenessParams_window                protoreflect.FieldDescriptor
+	fd_SignerLivenessParams_max_missed_dkgs       protoreflect.FieldDescriptor
+	fd_SignerLivenessParams_max_missed_signings   protoreflect.FieldDescriptor
+	fd_SignerLivenessParams_slash_fraction_bps    protoreflect.FieldDescriptor
+	fd_SignerLivenessParams_jail_duration         protoreflect.FieldDescriptor
+	fd_SignerLivenessParams_signing_round_timeout protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_side_btcbridge_params_proto_init()
+	md_SignerLivenessParams = File_side_btcbridge_params_proto.Messages().ByName("SignerLivenessParams")
+	fd_SignerLivenessParams_window = md_SignerLivenessParams.Fields().ByName("window")
+	fd_SignerLivenessParams_max_missed_dkgs = md_SignerLivenessParams.Fields().ByName("max_missed_dkgs")
+	fd_SignerLivenessParams_max_missed_signings = md_SignerLivenessParams.Fields().ByName("max_missed_signings")
+	fd_SignerLivenessParams_slash_fraction_bps = md_SignerLivenessParams.Fields().ByName("slash_fraction_bps")
+	fd_SignerLivenessParams_jail_duration = md_SignerLivenessParams.Fields().ByName("jail_duration")
+	fd_SignerLivenessParams_signing_round_timeout = md_SignerLivenessParams.Fields().ByName("signing_round_timeout")
+}
+
+var _ protoreflect.Message = (*fastReflection_SignerLivenessParams)(nil)
+
+type fastReflection_SignerLivenessParams SignerLivenessParams
+
+func (x *SignerLivenessParams) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SignerLivenessParams)(x)
+}
+
+func (x *SignerLivenessParams) slowProtoReflect() protoreflect.Message {
+	mi := &file_side_btcbridge_params_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_SignerLivenessParams_messageType fastReflection_SignerLivenessParams_messageType
+var _ protoreflect.MessageType = fastReflection_SignerLivenessParams_messageType{}
+
+type fastReflection_SignerLivenessParams_messageType struct{}
+
+func (x fastReflection_SignerLivenessParams_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SignerLivenessParams)(nil)
+}
+func (x fastReflection_SignerLivenessParams_messageType) New() protoreflect.Message {
+	return new(fastReflection_SignerLivenessParams)
+}
+func (x fastReflection_SignerLivenessParams_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SignerLivenessParams
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_SignerLivenessParams) Descriptor() protoreflect.MessageDescriptor {
+	return md_SignerLivenessParams
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_SignerLivenessParams) Type() protoreflect.MessageType {
+	return _fastReflection_SignerLivenessParams_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_SignerLivenessParams) New() protoreflect.Message {
+	return new(fastReflection_SignerLivenessParams)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_SignerLivenessParams) Interface() protoreflect.ProtoMessage {
+	return (*SignerLivenessParams)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_SignerLivenessParams) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Window != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Window)
+		if !f(fd_SignerLivenessParams_window, value) {
+			return
+		}
+	}
+	if x.MaxMissedDkgs != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.MaxMissedDkgs)
+		if !f(fd_SignerLivenessParams_max_missed_dkgs, value) {
+			return
+		}
+	}
+	if x.MaxMissedSignings != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.MaxMissedSignings)
+		if !f(fd_SignerLivenessParams_max_missed_signings, value) {
+			return
+		}
+	}
+	if x.SlashFractionBps != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.SlashFractionBps)
+		if !f(fd_SignerLivenessParams_slash_fraction_bps, value) {
+			return
+		}
+	}
+	if x.JailDuration != nil {
+		value := protoreflect.ValueOfMessage(x.JailDuration.ProtoReflect())
+		if !f(fd_SignerLivenessParams_jail_duration, value) {
+			return
+		}
+	}
+	if x.SigningRoundTimeout != nil {
+		value := protoreflect.ValueOfMessage(x.SigningRoundTimeout.ProtoReflect())
+		if !f(fd_SignerLivenessParams_signing_round_timeout, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_SignerLivenessParams) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "side.btcbridge.SignerLivenessParams.window":
+		return x.Window != int64(0)
+	case "side.btcbridge.SignerLivenessParams.max_missed_dkgs":
+		return x.MaxMissedDkgs != uint32(0)
+	case "side.btcbridge.SignerLivenessParams.max_missed_signings":
+		return x.MaxMissedSignings != uint32(0)
+	case "side.btcbridge.SignerLivenessParams.slash_fraction_bps":
+		return x.SlashFractionBps != uint32(0)
+	case "side.btcbridge.SignerLivenessParams.jail_duration":
+		return x.JailDuration != nil
+	case "side.btcbridge.SignerLivenessParams.signing_round_timeout":
+		return x.SigningRoundTimeout != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.SignerLivenessParams"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.SignerLivenessParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SignerLivenessParams) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "side.btcbridge.SignerLivenessParams.window":
+		x.Window = int64(0)
+	case "side.btcbridge.SignerLivenessParams.max_missed_dkgs":
+		x.MaxMissedDkgs = uint32(0)
+	case "side.btcbridge.SignerLivenessParams.max_missed_signings":
+		x.MaxMissedSignings = uint32(0)
+	case "side.btcbridge.SignerLivenessParams.slash_fraction_bps":
+		x.SlashFractionBps = uint32(0)
+	case "side.btcbridge.SignerLivenessParams.jail_duration":
+		x.JailDuration = nil
+	case "side.btcbridge.SignerLivenessParams.signing_round_timeout":
+		x.SigningRoundTimeout = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.SignerLivenessParams"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.SignerLivenessParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_SignerLivenessParams) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "side.btcbridge.SignerLivenessParams.window":
+		value := x.Window
+		return protoreflect.ValueOfInt64(value)
+	case "side.btcbridge.SignerLivenessParams.max_missed_dkgs":
+		value := x.MaxMissedDkgs
+		return protoreflect.ValueOfUint32(value)
+	case "side.btcbridge.SignerLivenessParams.max_missed_signings":
+		value := x.MaxMissedSignings
+		return protoreflect.ValueOfUint32(value)
+	case "side.btcbridge.SignerLivenessParams.slash_fraction_bps":
+		value := x.SlashFractionBps
+		return protoreflect.ValueOfUint32(value)
+	case "side.btcbridge.SignerLivenessParams.jail_duration":
+		value := x.JailDuration
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "side.btcbridge.SignerLivenessParams.signing_round_timeout":
+		value := x.SigningRoundTimeout
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.SignerLivenessParams"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.SignerLivenessParams does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SignerLivenessParams) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "side.btcbridge.SignerLivenessParams.window":
+		x.Window = value.Int()
+	case "side.btcbridge.SignerLivenessParams.max_missed_dkgs":
+		x.MaxMissedDkgs = uint32(value.Uint())
+	case "side.btcbridge.SignerLivenessParams.max_missed_signings":
+		x.MaxMissedSignings = uint32(value.Uint())
+	case "side.btcbridge.SignerLivenessParams.slash_fraction_bps":
+		x.SlashFractionBps = uint32(value.Uint())
+	case "side.btcbridge.SignerLivenessParams.jail_duration":
+		x.JailDuration = value.Message().Interface().(*durationpb.Duration)
+	case "side.btcbridge.SignerLivenessParams.signing_round_timeout":
+		x.SigningRoundTimeout = value.Message().Interface().(*durationpb.Duration)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.SignerLivenessParams"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.SignerLivenessParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SignerLivenessParams) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "side.btcbridge.SignerLivenessParams.jail_duration":
+		if x.JailDuration == nil {
+			x.JailDuration = new(durationpb.Duration)
+		}
+		return protoreflect.ValueOfMessage(x.JailDuration.ProtoReflect())
+	case "side.btcbridge.SignerLivenessParams.signing_round_timeout":
+		if x.SigningRoundTimeout == nil {
+			x.SigningRoundTimeout = new(durationpb.Duration)
+		}
+		return protoreflect.ValueOfMessage(x.SigningRoundTimeout.ProtoReflect())
+	case "side.btcbridge.SignerLivenessParams.window":
+		panic(fmt.Errorf("field window of message side.btcbridge.SignerLivenessParams is not mutable"))
+	case "side.btcbridge.SignerLivenessParams.max_missed_dkgs":
+		panic(fmt.Errorf("field max_missed_dkgs of message side.btcbridge.SignerLivenessParams is not mutable"))
+	case "side.btcbridge.SignerLivenessParams.max_missed_signings":
+		panic(fmt.Errorf("field max_missed_signings of message side.btcbridge.SignerLivenessParams is not mutable"))
+	case "side.btcbridge.SignerLivenessParams.slash_fraction_bps":
+		panic(fmt.Errorf("field slash_fraction_bps of message side.btcbridge.SignerLivenessParams is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.SignerLivenessParams"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.SignerLivenessParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_SignerLivenessParams) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "side.btcbridge.SignerLivenessParams.window":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "side.btcbridge.SignerLivenessParams.max_missed_dkgs":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "side.btcbridge.SignerLivenessParams.max_missed_signings":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "side.btcbridge.SignerLivenessParams.slash_fraction_bps":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "side.btcbridge.SignerLivenessParams.jail_duration":
+		m := new(durationpb.Duration)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "side.btcbridge.SignerLivenessParams.signing_round_timeout":
+		m := new(durationpb.Duration)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.SignerLivenessParams"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.SignerLivenessParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_SignerLivenessParams) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in side.btcbridge.SignerLivenessParams", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_SignerLivenessParams) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SignerLivenessParams) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_SignerLivenessParams) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_SignerLivenessParams) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*SignerLivenessParams)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Window != 0 {
+			n += 1 + runtime.Sov(uint64(x.Window))
+		}
+		if x.MaxMissedDkgs != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxMissedDkgs))
+		}
+		if x.MaxMissedSignings != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxMissedSignings))
+		}
+		if x.SlashFractionBps != 0 {
+			n += 1 + runtime.Sov(uint64(x.SlashFractionBps))
+		}
+		if x.JailDuration != nil {
+			l = options.Size(x.JailDuration)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.SigningRoundTimeout != nil {
+			l = options.Size(x.SigningRoundTimeout)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*SignerLivenessParams)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.SigningRoundTimeout != nil {
+			encoded, err := options.Marshal(x.SigningRoundTimeout)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.JailDuration != nil {
+			encoded, err := options.Marshal(x.JailDuration)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.SlashFractionBps != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SlashFractionBps))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.MaxMissedSignings != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxMissedSignings))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.MaxMissedDkgs != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxMissedDkgs))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.Window != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Window))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*SignerLivenessParams)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SignerLivenessParams: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SignerLivenessParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+				}
+				x.Window = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Window |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxMissedDkgs", wireType)
+				}
+				x.MaxMissedDkgs = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxMissedDkgs |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxMissedSignings", wireType)
+				}
+				x.MaxMissedSignings = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxMissedSignings |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SlashFractionBps", wireType)
+				}
+				x.SlashFractionBps = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SlashFractionBps |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field JailDuration", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.JailDuration == nil {
+					x.JailDuration = &durationpb.Duration{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.JailDuration); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SigningRoundTimeout", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.SigningRoundTimeout == nil {
+					x.SigningRoundTimeout = &durationpb.Duration{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.SigningRoundTimeout); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_ConsolidationPolicy_4_list)(nil)
+
+type _ConsolidationPolicy_4_list struct {
+	list *[]*RuneConsolidationThreshold
+}
+
+func (x *_ConsolidationPolicy_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_ConsolidationPolicy_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_ConsolidationPolicy_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*RuneConsolidationThreshold)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_ConsolidationPolicy_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*RuneConsolidationThreshold)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_ConsolidationPolicy_4_list) AppendMutable() protoreflect.Value {
+	v := new(RuneConsolidationThreshold)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_ConsolidationPolicy_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_ConsolidationPolicy_4_list) NewElement() protoreflect.Value {
+	v := new(RuneConsolidationThreshold)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_ConsolidationPolicy_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_ConsolidationPolicy                      protoreflect.MessageDescriptor
+	fd_ConsolidationPolicy_utxo_num_trigger     protoreflect.FieldDescriptor
+	fd_ConsolidationPolicy_max_fee_rate         protoreflect.FieldDescriptor
+	fd_ConsolidationPolicy_btc_target_threshold protoreflect.FieldDescriptor
+	fd_ConsolidationPolicy_rune_thresholds      protoreflect.FieldDescriptor
+	fd_ConsolidationPolicy_max_num              protoreflect.FieldDescriptor
+	fd_ConsolidationPolicy_cooldown             protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_side_btcbridge_params_proto_init()
+	md_ConsolidationPolicy = File_side_btcbridge_params_proto.Messages().ByName("ConsolidationPolicy")
+	fd_ConsolidationPolicy_utxo_num_trigger = md_ConsolidationPolicy.Fields().ByName("utxo_num_trigger")
+	fd_ConsolidationPolicy_max_fee_rate = md_ConsolidationPolicy.Fields().ByName("max_fee_rate")
+	fd_ConsolidationPolicy_btc_target_threshold = md_ConsolidationPolicy.Fields().ByName("btc_target_threshold")
+	fd_ConsolidationPolicy_rune_thresholds = md_ConsolidationPolicy.Fields().ByName("rune_thresholds")
+	fd_ConsolidationPolicy_max_num = md_ConsolidationPolicy.Fields().ByName("max_num")
+	fd_ConsolidationPolicy_cooldown = md_ConsolidationPolicy.Fields().ByName("cooldown")
+}
+
+var _ protoreflect.Message = (*fastReflection_ConsolidationPolicy)(nil)
+
+type fastReflection_ConsolidationPolicy ConsolidationPolicy
+
+func (x *ConsolidationPolicy) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ConsolidationPolicy)(x)
+}
+
+func (x *ConsolidationPolicy) slowProtoReflect() protoreflect.Message {
+	mi := &file_side_btcbridge_params_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ConsolidationPolicy_messageType fastReflection_ConsolidationPolicy_messageType
+var _ protoreflect.MessageType = fastReflection_ConsolidationPolicy_messageType{}
+
+type fastReflection_ConsolidationPolicy_messageType struct{}
+
+func (x fastReflection_ConsolidationPolicy_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ConsolidationPolicy)(nil)
+}
+func (x fastReflection_ConsolidationPolicy_messageType) New() protoreflect.Message {
+	return new(fastReflection_ConsolidationPolicy)
+}
+func (x fastReflection_ConsolidationPolicy_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ConsolidationPolicy
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ConsolidationPolicy) Descriptor() protoreflect.MessageDescriptor {
+	return md_ConsolidationPolicy
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ConsolidationPolicy) Type() protoreflect.MessageType {
+	return _fastReflection_ConsolidationPolicy_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ConsolidationPolicy) New() protoreflect.Message {
+	return new(fastReflection_ConsolidationPolicy)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ConsolidationPolicy) Interface() protoreflect.ProtoMessage {
+	return (*ConsolidationPolicy)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ConsolidationPolicy) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.UtxoNumTrigger != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.UtxoNumTrigger)
+		if !f(fd_ConsolidationPolicy_utxo_num_trigger, value) {
+			return
+		}
+	}
+	if x.MaxFeeRate != int64(0) {
+		value := protoreflect.ValueOfInt64(x.MaxFeeRate)
+		if !f(fd_ConsolidationPolicy_max_fee_rate, value) {
+			return
+		}
+	}
+	if x.BtcTargetThreshold != int64(0) {
+		value := protoreflect.ValueOfInt64(x.BtcTargetThreshold)
+		if !f(fd_ConsolidationPolicy_btc_target_threshold, value) {
+			return
+		}
+	}
+	if len(x.RuneThresholds) != 0 {
+		value := protoreflect.ValueOfList(&_ConsolidationPolicy_4_list{list: &x.RuneThresholds})
+		if !f(fd_ConsolidationPolicy_rune_thresholds, value) {
+			return
+		}
+	}
+	if x.MaxNum != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.MaxNum)
+		if !f(fd_ConsolidationPolicy_max_num, value) {
+			return
+		}
+	}
+	if x.Cooldown != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Cooldown)
+		if !f(fd_ConsolidationPolicy_cooldown, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ConsolidationPolicy) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "side.btcbridge.ConsolidationPolicy.utxo_num_trigger":
+		return x.UtxoNumTrigger != uint32(0)
+	case "side.btcbridge.ConsolidationPolicy.max_fee_rate":
+		return x.MaxFeeRate != int64(0)
+	case "side.btcbridge.ConsolidationPolicy.btc_target_threshold":
+		return x.BtcTargetThreshold != int64(0)
+	case "side.btcbridge.ConsolidationPolicy.rune_thresholds":
+		return len(x.RuneThresholds) != 0
+	case "side.btcbridge.ConsolidationPolicy.max_num":
+		return x.MaxNum != uint32(0)
+	case "side.btcbridge.ConsolidationPolicy.cooldown":
+		return x.Cooldown != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.ConsolidationPolicy"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.ConsolidationPolicy does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ConsolidationPolicy) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "side.btcbridge.ConsolidationPolicy.utxo_num_trigger":
+		x.UtxoNumTrigger = uint32(0)
+	case "side.btcbridge.ConsolidationPolicy.max_fee_rate":
+		x.MaxFeeRate = int64(0)
+	case "side.btcbridge.ConsolidationPolicy.btc_target_threshold":
+		x.BtcTargetThreshold = int64(0)
+	case "side.btcbridge.ConsolidationPolicy.rune_thresholds":
+		x.RuneThresholds = nil
+	case "side.btcbridge.ConsolidationPolicy.max_num":
+		x.MaxNum = uint32(0)
+	case "side.btcbridge.ConsolidationPolicy.cooldown":
+		x.Cooldown = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.ConsolidationPolicy"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.ConsolidationPolicy does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ConsolidationPolicy) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "side.btcbridge.ConsolidationPolicy.utxo_num_trigger":
+		value := x.UtxoNumTrigger
+		return protoreflect.ValueOfUint32(value)
+	case "side.btcbridge.ConsolidationPolicy.max_fee_rate":
+		value := x.MaxFeeRate
+		return protoreflect.ValueOfInt64(value)
+	case "side.btcbridge.ConsolidationPolicy.btc_target_threshold":
+		value := x.BtcTargetThreshold
+		return protoreflect.ValueOfInt64(value)
+	case "side.btcbridge.ConsolidationPolicy.rune_thresholds":
+		if len(x.RuneThresholds) == 0 {
+			return protoreflect.ValueOfList(&_ConsolidationPolicy_4_list{})
+		}
+		listValue := &_ConsolidationPolicy_4_list{list: &x.RuneThresholds}
+		return protoreflect.ValueOfList(listValue)
+	case "side.btcbridge.ConsolidationPolicy.max_num":
+		value := x.MaxNum
+		return protoreflect.ValueOfUint32(value)
+	case "side.btcbridge.ConsolidationPolicy.cooldown":
+		value := x.Cooldown
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.ConsolidationPolicy"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.ConsolidationPolicy does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ConsolidationPolicy) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "side.btcbridge.ConsolidationPolicy.utxo_num_trigger":
+		x.UtxoNumTrigger = uint32(value.Uint())
+	case "side.btcbridge.ConsolidationPolicy.max_fee_rate":
+		x.MaxFeeRate = value.Int()
+	case "side.btcbridge.ConsolidationPolicy.btc_target_threshold":
+		x.BtcTargetThreshold = value.Int()
+	case "side.btcbridge.ConsolidationPolicy.rune_thresholds":
+		lv := value.List()
+		clv := lv.(*_ConsolidationPolicy_4_list)
+		x.RuneThresholds = *clv.list
+	case "side.btcbridge.ConsolidationPolicy.max_num":
+		x.MaxNum = uint32(value.Uint())
+	case "side.btcbridge.ConsolidationPolicy.cooldown":
+		x.Cooldown = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.ConsolidationPolicy"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.ConsolidationPolicy does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ConsolidationPolicy) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "side.btcbridge.ConsolidationPolicy.rune_thresholds":
+		if x.RuneThresholds == nil {
+			x.RuneThresholds = []*RuneConsolidationThreshold{}
+		}
+		value := &_ConsolidationPolicy_4_list{list: &x.RuneThresholds}
+		return protoreflect.ValueOfList(value)
+	case "side.btcbridge.ConsolidationPolicy.utxo_num_trigger":
+		panic(fmt.Errorf("field utxo_num_trigger of message side.btcbridge.ConsolidationPolicy is not mutable"))
+	case "side.btcbridge.ConsolidationPolicy.max_fee_rate":
+		panic(fmt.Errorf("field max_fee_rate of message side.btcbridge.ConsolidationPolicy is not mutable"))
+	case "side.btcbridge.ConsolidationPolicy.btc_target_threshold":
+		panic(fmt.Errorf("field btc_target_threshold of message side.btcbridge.ConsolidationPolicy is not mutable"))
+	case "side.btcbridge.ConsolidationPolicy.max_num":
+		panic(fmt.Errorf("field max_num of message side.btcbridge.ConsolidationPolicy is not mutable"))
+	case "side.btcbridge.ConsolidationPolicy.cooldown":
+		panic(fmt.Errorf("field cooldown of message side.btcbridge.ConsolidationPolicy is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.ConsolidationPolicy"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.ConsolidationPolicy does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ConsolidationPolicy) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "side.btcbridge.ConsolidationPolicy.utxo_num_trigger":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "side.btcbridge.ConsolidationPolicy.max_fee_rate":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "side.btcbridge.ConsolidationPolicy.btc_target_threshold":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "side.btcbridge.ConsolidationPolicy.rune_thresholds":
+		list := []*RuneConsolidationThreshold{}
+		return protoreflect.ValueOfList(&_ConsolidationPolicy_4_list{list: &list})
+	case "side.btcbridge.ConsolidationPolicy.max_num":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "side.btcbridge.ConsolidationPolicy.cooldown":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.ConsolidationPolicy"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.ConsolidationPolicy does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ConsolidationPolicy) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in side.btcbridge.ConsolidationPolicy", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ConsolidationPolicy) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ConsolidationPolicy) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ConsolidationPolicy) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ConsolidationPolicy) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ConsolidationPolicy)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.UtxoNumTrigger != 0 {
+			n += 1 + runtime.Sov(uint64(x.UtxoNumTrigger))
+		}
+		if x.MaxFeeRate != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxFeeRate))
+		}
+		if x.BtcTargetThreshold != 0 {
+			n += 1 + runtime.Sov(uint64(x.BtcTargetThreshold))
+		}
+		if len(x.RuneThresholds) > 0 {
+			for _, e := range x.RuneThresholds {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.MaxNum != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxNum))
+		}
+		if x.Cooldown != 0 {
+			n += 1 + runtime.Sov(uint64(x.Cooldown))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ConsolidationPolicy)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Cooldown != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Cooldown))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.MaxNum != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxNum))
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.RuneThresholds) > 0 {
+			for iNdEx := len(x.RuneThresholds) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.RuneThresholds[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if x.BtcTargetThreshold != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BtcTargetThreshold))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.MaxFeeRate != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxFeeRate))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.UtxoNumTrigger != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.UtxoNumTrigger))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ConsolidationPolicy)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ConsolidationPolicy: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ConsolidationPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UtxoNumTrigger", wireType)
+				}
+				x.UtxoNumTrigger = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.UtxoNumTrigger |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxFeeRate", wireType)
+				}
+				x.MaxFeeRate = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxFeeRate |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BtcTargetThreshold", wireType)
+				}
+				x.BtcTargetThreshold = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BtcTargetThreshold |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RuneThresholds", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RuneThresholds = append(x.RuneThresholds, &RuneConsolidationThreshold{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RuneThresholds[len(x.RuneThresholds)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxNum", wireType)
+				}
+				x.MaxNum = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxNum |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Cooldown", wireType)
+				}
+				x.Cooldown = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Cooldown |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_RuneConsolidationThreshold                  protoreflect.MessageDescriptor
+	fd_RuneConsolidationThreshold_rune_id          protoreflect.FieldDescriptor
+	fd_RuneConsolidationThreshold_target_threshold protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_side_btcbridge_params_proto_init()
+	md_RuneConsolidationThreshold = File_side_btcbridge_params_proto.Messages().ByName("RuneConsolidationThreshold")
+	fd_RuneConsolidationThreshold_rune_id = md_RuneConsolidationThreshold.Fields().ByName("rune_id")
+	fd_RuneConsolidationThreshold_target_threshold = md_RuneConsolidationThreshold.Fields().ByName("target_threshold")
+}
+
+var _ protoreflect.Message = (*fastReflection_RuneConsolidationThreshold)(nil)
+
+type fastReflection_RuneConsolidationThreshold RuneConsolidationThreshold
+
+func (x *RuneConsolidationThreshold) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_RuneConsolidationThreshold)(x)
+}
+
+func (x *RuneConsolidationThreshold) slowProtoReflect() protoreflect.Message {
+	mi := &file_side_btcbridge_params_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_RuneConsolidationThreshold_messageType fastReflection_RuneConsolidationThreshold_messageType
+var _ protoreflect.MessageType = fastReflection_RuneConsolidationThreshold_messageType{}
+
+type fastReflection_RuneConsolidationThreshold_messageType struct{}
+
+func (x fastReflection_RuneConsolidationThreshold_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_RuneConsolidationThreshold)(nil)
+}
+func (x fastReflection_RuneConsolidationThreshold_messageType) New() protoreflect.Message {
+	return new(fastReflection_RuneConsolidationThreshold)
+}
+func (x fastReflection_RuneConsolidationThreshold_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_RuneConsolidationThreshold
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_RuneConsolidationThreshold) Descriptor() protoreflect.MessageDescriptor {
+	return md_RuneConsolidationThreshold
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_RuneConsolidationThreshold) Type() protoreflect.MessageType {
+	return _fastReflection_RuneConsolidationThreshold_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_RuneConsolidationThreshold) New() protoreflect.Message {
+	return new(fastReflection_RuneConsolidationThreshold)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_RuneConsolidationThreshold) Interface() protoreflect.ProtoMessage {
+	return (*RuneConsolidationThreshold)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_RuneConsolidationThreshold) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.RuneId != "" {
+		value := protoreflect.ValueOfString(x.RuneId)
+		if !f(fd_RuneConsolidationThreshold_rune_id, value) {
+			return
+		}
+	}
+	if x.TargetThreshold != "" {
+		value := protoreflect.ValueOfString(x.TargetThreshold)
+		if !f(fd_RuneConsolidationThreshold_target_threshold, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_RuneConsolidationThreshold) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "side.btcbridge.RuneConsolidationThreshold.rune_id":
+		return x.RuneId != ""
+	case "side.btcbridge.RuneConsolidationThreshold.target_threshold":
+		return x.TargetThreshold != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.RuneConsolidationThreshold"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.RuneConsolidationThreshold does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RuneConsolidationThreshold) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "side.btcbridge.RuneConsolidationThreshold.rune_id":
+		x.RuneId = ""
+	case "side.btcbridge.RuneConsolidationThreshold.target_threshold":
+		x.TargetThreshold = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.RuneConsolidationThreshold"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.RuneConsolidationThreshold does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_RuneConsolidationThreshold) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "side.btcbridge.RuneConsolidationThreshold.rune_id":
+		value := x.RuneId
+		return protoreflect.ValueOfString(value)
+	case "side.btcbridge.RuneConsolidationThreshold.target_threshold":
+		value := x.TargetThreshold
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.RuneConsolidationThreshold"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.RuneConsolidationThreshold does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RuneConsolidationThreshold) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "side.btcbridge.RuneConsolidationThreshold.rune_id":
+		x.RuneId = value.Interface().(string)
+	case "side.btcbridge.RuneConsolidationThreshold.target_threshold":
+		x.TargetThreshold = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.RuneConsolidationThreshold"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.RuneConsolidationThreshold does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RuneConsolidationThreshold) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "side.btcbridge.RuneConsolidationThreshold.rune_id":
+		panic(fmt.Errorf("field rune_id of message side.btcbridge.RuneConsolidationThreshold is not mutable"))
+	case "side.btcbridge.RuneConsolidationThreshold.target_threshold":
+		panic(fmt.Errorf("field target_threshold of message side.btcbridge.RuneConsolidationThreshold is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.RuneConsolidationThreshold"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.RuneConsolidationThreshold does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_RuneConsolidationThreshold) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "side.btcbridge.RuneConsolidationThreshold.rune_id":
+		return protoreflect.ValueOfString("")
+	case "side.btcbridge.RuneConsolidationThreshold.target_threshold":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: side.btcbridge.RuneConsolidationThreshold"))
+		}
+		panic(fmt.Errorf("message side.btcbridge.RuneConsolidationThreshold does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_RuneConsolidationThreshold) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in side.btcbridge.RuneConsolidationThreshold", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_RuneConsolidationThreshold) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RuneConsolidationThreshold) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_RuneConsolidationThreshold) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_RuneConsolidationThreshold) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*RuneConsolidationThreshold)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.RuneId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.TargetThreshold)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*RuneConsolidationThreshold)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.TargetThreshold) > 0 {
+			i -= len(x.TargetThreshold)
+			copy(dAtA[i:], x.TargetThreshold)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TargetThreshold)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.RuneId) > 0 {
+			i -= len(x.RuneId)
+			copy(dAtA[i:], x.RuneId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RuneId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*RuneConsolidationThreshold)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RuneConsolidationThreshold: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RuneConsolidationThreshold: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RuneId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RuneId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TargetThreshold", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TargetThreshold = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: side/btcbridge/params.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// VaultAssignmentStrategy defines the strategy to assign the deposit vault among the active vaults
+type VaultAssignmentStrategy int32
+
+const (
+	// Round-robin: the active vaults are assigned in turn as deposits arrive
+	VaultAssignmentStrategy_VAULT_ASSIGNMENT_STRATEGY_ROUND_ROBIN VaultAssignmentStrategy = 0
+	// Least utxo: the active vault with the fewest unlocked utxos is assigned
+	VaultAssignmentStrategy_VAULT_ASSIGNMENT_STRATEGY_LEAST_UTXO VaultAssignmentStrategy = 1
+)
+
+// Enum value maps for VaultAssignmentStrategy.
+var (
+	VaultAssignmentStrategy_name = map[int32]string{
+		0: "VAULT_ASSIGNMENT_STRATEGY_ROUND_ROBIN",
+		1: "VAULT_ASSIGNMENT_STRATEGY_LEAST_UTXO",
+	}
+	VaultAssignmentStrategy_value = map[string]int32{
+		"VAULT_ASSIGNMENT_STRATEGY_ROUND_ROBIN": 0,
+		"VAULT_ASSIGNMENT_STRATEGY_LEAST_UTXO":  1,
+	}
+)
+
+func (x VaultAssignmentStrategy) Enum() *VaultAssignmentStrategy {
+	p := new(VaultAssignmentStrategy)
+	*p = x
+	return p
+}
+
+func (x VaultAssignmentStrategy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (VaultAssignmentStrategy) Descriptor() protoreflect.EnumDescriptor {
+	return file_side_btcbridge_params_proto_enumTypes[0].Descriptor()
+}
+
+func (VaultAssignmentStrategy) Type() protoreflect.EnumType {
+	return &file_side_btcbridge_params_proto_enumTypes[0]
+}
+
+func (x VaultAssignmentStrategy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use VaultAssignmentStrategy.Descriptor instead.
+func (VaultAssignmentStrategy) EnumDescriptor() ([]byte, []int) {
+	return file_side_btcbridge_params_proto_rawDescGZIP(), []int{0}
+}
+
+// AssetType defines the type of asset
+type AssetType int32
+
+const (
+	// Unspecified asset type
+	AssetType_ASSET_TYPE_UNSPECIFIED AssetType = 0
+	// BTC
+	AssetType_ASSET_TYPE_BTC AssetType = 1
+	// BRC20: ordi, sats
+	AssetType_ASSET_TYPE_BRC20 AssetType = 2
+	// RUNE: dog•go•to•the•moon
+	AssetType_ASSET_TYPE_RUNES AssetType = 3
+)
+
+// Enum value maps for AssetType.
+var (
+	AssetType_name = map[int32]string{
+		0: "ASSET_TYPE_UNSPECIFIED",
+		1: "ASSET_TYPE_BTC",
+		2: "ASSET_TYPE_BRC20",
+		3: "ASSET_TYPE_RUNES",
+	}
+	AssetType_value = map[string]int32{
+		"ASSET_TYPE_UNSPECIFIED": 0,
+		"ASSET_TYPE_BTC":         1,
+		"ASSET_TYPE_BRC20":       2,
+		"ASSET_TYPE_RUNES":       3,
+	}
+)
+
+func (x AssetType) Enum() *AssetType {
+	p := new(AssetType)
+	*p = x
+	return p
+}
+
+func (x AssetType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AssetType) Descriptor() protoreflect.EnumDescriptor {
+	return file_side_btcbridge_params_proto_enumTypes[1].Descriptor()
+}
+
+func (AssetType) Type() protoreflect.EnumType {
+	return &file_side_btcbridge_params_proto_enumTypes[1]
+}
+
+func (x AssetType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AssetType.Descriptor instead.
+func (AssetType) EnumDescriptor() ([]byte, []int) {
+	return file_side_btcbridge_params_proto_rawDescGZIP(), []int{1}
+}
+
+// VaultState defines the lifecycle state of the vault
+type VaultState int32
+
+const (
+	// VAULT_STATE_ACTIVE defines the vault in use; the default state
+	VaultState_VAULT_STATE_ACTIVE VaultState = 0
+	// VAULT_STATE_DEPRECATED defines the vault superseded by the new version, of which the deposits are accepted and swept to the latest version automatically
+	VaultState_VAULT_STATE_DEPRECATED VaultState = 1
+	// VAULT_STATE_RETIRED defines the vault of which the deposits are rejected and only swept by governance
+	VaultState_VAULT_STATE_RETIRED VaultState = 2
+)
+
+// Enum value maps for VaultState.
+var (
+	VaultState_name = map[int32]string{
+		0: "VAULT_STATE_ACTIVE",
+		1: "VAULT_STATE_DEPRECATED",
+		2: "VAULT_STATE_RETIRED",
+	}
+	VaultState_value = map[string]int32{
+		"VAULT_STATE_ACTIVE":     0,
+		"VAULT_STATE_DEPRECATED": 1,
+		"VAULT_STATE_RETIRED":    2,
+	}
+)
+
+func (x VaultState) Enum() *VaultState {
+	p := new(VaultState)
+	*p = x
+	return p
+}
+
+func (x VaultState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (VaultState) Descriptor() protoreflect.EnumDescriptor {
+	return file_side_btcbridge_params_proto_enumTypes[2].Descriptor()
+}
+
+func (VaultState) Type() protoreflect.EnumType {
+	return &file_side_btcbridge_params_proto_enumTypes[2]
+}
+
+func (x VaultState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use VaultState.Descriptor instead.
+func (VaultState) EnumDescriptor() ([]byte, []int) {
+	return file_side_btcbridge_params_proto_rawDescGZIP(), []int{2}
+}
+
+// VaultScriptType defines the script type of the vault described by the descriptor
+type VaultScriptType int32
+
+const (
+	// Unspecified script type
+	VaultScriptType_VAULT_SCRIPT_TYPE_UNSPECIFIED VaultScriptType = 0
+	// P2WSH m-of-n OP_CHECKMULTISIG
+	VaultScriptType_VAULT_SCRIPT_TYPE_P2WSH_MULTISIG VaultScriptType = 1
+	// P2SH-wrapped P2WSH m-of-n OP_CHECKMULTISIG
+	VaultScriptType_VAULT_SCRIPT_TYPE_P2SH_P2WSH_MULTISIG VaultScriptType = 2
+)
+
+// Enum value maps for VaultScriptType.
+var (
+	VaultScriptType_name = map[int32]string{
+		0: "VAULT_SCRIPT_TYPE_UNSPECIFIED",
+		1: "VAULT_SCRIPT_TYPE_P2WSH_MULTISIG",
+		2: "VAULT_SCRIPT_TYPE_P2SH_P2WSH_MULTISIG",
+	}
+	VaultScriptType_value = map[string]int32{
+		"VAULT_SCRIPT_TYPE_UNSPECIFIED":         0,
+		"VAULT_SCRIPT_TYPE_P2WSH_MULTISIG":      1,
+		"VAULT_SCRIPT_TYPE_P2SH_P2WSH_MULTISIG": 2,
+	}
+)
+
+func (x VaultScriptType) Enum() *VaultScriptType {
+	p := new(VaultScriptType)
+	*p = x
+	return p
+}
+
+func (x VaultScriptType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (VaultScriptType) Descriptor() protoreflect.EnumDescriptor {
+	return file_side_btcbridge_params_proto_enumTypes[3].Descriptor()
+}
+
+func (VaultScriptType) Type() protoreflect.EnumType {
+	return &file_side_btcbridge_params_proto_enumTypes[3]
+}
+
+func (x VaultScriptType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use VaultScriptType.Descriptor instead.
+func (VaultScriptType) EnumDescriptor() ([]byte, []int) {
+	return file_side_btcbridge_params_proto_rawDescGZIP(), []int{3}
+}
+
+// CoinSelectionStrategy defines the strategy to select the payment utxos
+type CoinSelectionStrategy int32
+
+const (
+	// Default: the minimum utxo first and then the largest ones
+	CoinSelectionStrategy_COIN_SELECTION_STRATEGY_DEFAULT CoinSelectionStrategy = 0
+	// Largest first
+	CoinSelectionStrategy_COIN_SELECTION_STRATEGY_LARGEST_FIRST CoinSelectionStrategy = 1
+	// Branch and bound, preferring the changeless selection
+	CoinSelectionStrategy_COIN_SELECTION_STRATEGY_BRANCH_AND_BOUND CoinSelectionStrategy = 2
+	// Fee-aware privacy, avoiding the change output and merging utxos where possible
+	CoinSelectionStrategy_COIN_SELECTION_STRATEGY_PRIVACY CoinSelectionStrategy = 3
+)
+
+// Enum value maps for CoinSelectionStrategy.
+var (
+	CoinSelectionStrategy_name = map[int32]string{
+		0: "COIN_SELECTION_STRATEGY_DEFAULT",
+		1: "COIN_SELECTION_STRATEGY_LARGEST_FIRST",
+		2: "COIN_SELECTION_STRATEGY_BRANCH_AND_BOUND",
+		3: "COIN_SELECTION_STRATEGY_PRIVACY",
+	}
+	CoinSelectionStrategy_value = map[string]int32{
+		"COIN_SELECTION_STRATEGY_DEFAULT":          0,
+		"COIN_SELECTION_STRATEGY_LARGEST_FIRST":    1,
+		"COIN_SELECTION_STRATEGY_BRANCH_AND_BOUND": 2,
+		"COIN_SELECTION_STRATEGY_PRIVACY":          3,
+	}
+)
+
+func (x CoinSelectionStrategy) Enum() *CoinSelectionStrategy {
+	p := new(CoinSelectionStrategy)
+	*p = x
+	return p
+}
+
+func (x CoinSelectionStrategy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CoinSelectionStrategy) Descriptor() protoreflect.EnumDescriptor {
+	return file_side_btcbridge_params_proto_enumTypes[4].Descriptor()
+}
+
+func (CoinSelectionStrategy) Type() protoreflect.EnumType {
+	return &file_side_btcbridge_params_proto_enumTypes[4]
+}
+
+func (x CoinSelectionStrategy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CoinSelectionStrategy.Descriptor instead.
+func (CoinSelectionStrategy) EnumDescriptor() ([]byte, []int) {
+	return file_side_btcbridge_params_proto_rawDescGZIP(), []int{4}
+}
+
+// Params defines the parameters for the module.
+type Params struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The minimum number of confirmations required for the deposit transactions
+	DepositConfirmationDepth int32 `protobuf:"varint,1,opt,name=deposit_confirmation_depth,json=depositConfirmationDepth,proto3" json:"deposit_confirmation_depth,omitempty"`
+	// The minimum number of confirmations required for the withdrawal transactions
+	WithdrawConfirmationDepth int32 `protobuf:"varint,2,opt,name=withdraw_confirmation_depth,json=withdrawConfirmationDepth,proto3" json:"withdraw_confirmation_depth,omitempty"`
+	// The allowed maximum depth for bitcoin block reorganization
+	MaxReorgDepth int32 `protobuf:"varint,3,opt,name=max_reorg_depth,json=maxReorgDepth,proto3" json:"max_reorg_depth,omitempty"`
+	// Indicates the maximum depth or distance from the latest block up to which transactions are considered for acceptance.
+	MaxAcceptableBlockDepth uint64 `protobuf:"varint,4,opt,name=max_acceptable_block_depth,json=maxAcceptableBlockDepth,proto3" json:"max_acceptable_block_depth,omitempty"`
+	// The denomination of the voucher
+	BtcVoucherDenom string `protobuf:"bytes,5,opt,name=btc_voucher_denom,json=btcVoucherDenom,proto3" json:"btc_voucher_denom,omitempty"`
+	// Indicates if deposit is enabled
+	DepositEnabled bool `protobuf:"varint,6,opt,name=deposit_enabled,json=depositEnabled,proto3" json:"deposit_enabled,omitempty"`
+	// Indicates if withdrawal is enabled
+	WithdrawEnabled bool `protobuf:"varint,7,opt,name=withdraw_enabled,json=withdrawEnabled,proto3" json:"withdraw_enabled,omitempty"`
+	// Trusted relayers to submit bitcoin block headers
+	TrustedBtcRelayers []string `protobuf:"bytes,8,rep,name=trusted_btc_relayers,json=trustedBtcRelayers,proto3" json:"trusted_btc_relayers,omitempty"`
+	// Trusted relayers for non-btc asset deposit
+	TrustedNonBtcRelayers []string `protobuf:"bytes,9,rep,name=trusted_non_btc_relayers,json=trustedNonBtcRelayers,proto3" json:"trusted_non_btc_relayers,omitempty"`
+	// Trusted fee providers to submit bitcoin fee rate
+	TrustedFeeProviders []string `protobuf:"bytes,10,rep,name=trusted_fee_providers,json=trustedFeeProviders,proto3" json:"trusted_fee_providers,omitempty"`
+	// Period of validity for the fee rate
+	FeeRateValidityPeriod int64 `protobuf:"varint,11,opt,name=fee_rate_validity_period,json=feeRateValidityPeriod,proto3" json:"fee_rate_validity_period,omitempty"`
+	// Asset vaults
+	Vaults []*Vault `protobuf:"bytes,12,rep,name=vaults,proto3" json:"vaults,omitempty"`
+	// Withdrawal params
+	WithdrawParams *WithdrawParams `protobuf:"bytes,13,opt,name=withdraw_params,json=withdrawParams,proto3" json:"withdraw_params,omitempty"`
+	// Protocol limitations
+	ProtocolLimits *ProtocolLimits `protobuf:"bytes,14,opt,name=protocol_limits,json=protocolLimits,proto3" json:"protocol_limits,omitempty"`
+	// Protocol fees
+	ProtocolFees *ProtocolFees `protobuf:"bytes,15,opt,name=protocol_fees,json=protocolFees,proto3" json:"protocol_fees,omitempty"`
+	// TSS params
+	TssParams *TSSParams `protobuf:"bytes,16,opt,name=tss_params,json=tssParams,proto3" json:"tss_params,omitempty"`
+	// Per-rune configurations; the runes not configured are not allowed
+	RuneConfigs []*RuneConfig `protobuf:"bytes,17,rep,name=rune_configs,json=runeConfigs,proto3" json:"rune_configs,omitempty"`
+	// Withdrawal rate limit
+	WithdrawRateLimit *WithdrawRateLimit `protobuf:"bytes,18,opt,name=withdraw_rate_limit,json=withdrawRateLimit,proto3" json:"withdraw_rate_limit,omitempty"`
+	// Guardians allowed to pause the bridge in emergency, e.g. multisig or group policy accounts
+	Guardians []string `protobuf:"bytes,19,rep,name=guardians,proto3" json:"guardians,omitempty"`
+	// Maximum duration of the emergency pause by guardians
+	MaxPauseDuration *durationpb.Duration `protobuf:"bytes,20,opt,name=max_pause_duration,json=maxPauseDuration,proto3" json:"max_pause_duration,omitempty"`
+	// Automatic vault utxo consolidation policy
+	ConsolidationPolicy *ConsolidationPolicy `protobuf:"bytes,21,opt,name=consolidation_policy,json=consolidationPolicy,proto3" json:"consolidation_policy,omitempty"`
+	// Recovery policy of the vaults generated by DKG
+	VaultRecoveryPolicy *VaultRecoveryPolicy `protobuf:"bytes,22,opt,name=vault_recovery_policy,json=vaultRecoveryPolicy,proto3" json:"vault_recovery_policy,omitempty"`
+	// Strategy to assign the deposit address among the active vaults of the same asset type
+	VaultAssignmentStrategy VaultAssignmentStrategy `protobuf:"varint,23,opt,name=vault_assignment_strategy,json=vaultAssignmentStrategy,proto3,enum=side.btcbridge.VaultAssignmentStrategy" json:"vault_assignment_strategy,omitempty"`
+}
+
+func (x *Params) Reset() {
+	*x = Params{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_side_btcbridge_params_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Params) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Params) ProtoMessage() {}
+
+// Deprecated: Use Params.ProtoReflect.Descriptor instead.
+func (*Params) Descriptor() ([]byte, []int) {
+	return file_side_btcbridge_params_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Params) GetDepositConfirmationDepth() int32 {
+	if x != nil {
+		return x.DepositConfirmationDepth
+	}
+	return 0
+}
+
+func (x *Params) GetWithdrawConfirmationDepth() int32 {
+	if x != nil {
+		return x.WithdrawConfirmationDepth
+	}
+	return 0
+}
+
+func (x *Params) GetMaxReorgDepth() int32 {
+	if x != nil {
+		return x.MaxReorgDepth
+	}
+	return 0
+}
+
+func (x *Params) GetMaxAcceptableBlockDepth() uint64 {
+	if x != nil {
+		return x.MaxAcceptableBlockDepth
+	}
+	return 0
+}
+
+func (x *Params) GetBtcVoucherDenom() string {
+	if x != nil {
+		return x.BtcVoucherDenom
+	}
+	return ""
+}
+
+func (x *Params) GetDepositEnabled() bool {
+	if x != nil {
+		return x.DepositEnabled
+	}
+	return false
+}
+
+func (x *Params) GetWithdrawEnabled() bool {
+	if x != nil {
+		return x.WithdrawEnabled
+	}
+	return false
+}
+
+func (x *Params) GetTrustedBtcRelayers() []string {
+	if x != nil {
+		return x.TrustedBtcRelayers
+	}
+	return nil
+}
+
+func (x *Params) GetTrustedNonBtcRelayers() []string {
+	if x != nil {
+		return x.TrustedNonBtcRelayers
+	}
+	return nil
+}
+
+func (x *Params) GetTrustedFeeProviders() []string {
+	if x != nil {
+		return x.TrustedFeeProviders
+	}
+	return nil
+}
+
+func (x *Params) GetFeeRateValidityPeriod() int64 {
+	if x != nil {
+		return x.FeeRateValidityPeriod
+	}
+	return 0
+}
+
+func (x *Params) GetVaults() []*Vault {
+	if x != nil {
+		return x.Vaults
+	}
+	return nil
+}
+
+func (x *Params) GetWithdrawParams() *WithdrawParams {
+	if x != nil {
+		return x.WithdrawParams
+	}
+	return nil
+}
+
+func (x *Params) GetProtocolLimits() *ProtocolLimits {
+	if x != nil {
+		return x.ProtocolLimits
+	}
+	return nil
+}
+
+func (x *Params) GetProtocolFees() *ProtocolFees {
+	if x != nil {
+		return x.ProtocolFees
+	}
+	return nil
+}
+
+func (x *Params) GetTssParams() *TSSParams {
+	if x != nil {
+		return x.TssParams
+	}
+	return nil
+}
+
+func (x *Params) GetRuneConfigs() []*RuneConfig {
+	if x != nil {
+		return x.RuneConfigs
+	}
+	return nil
+}
+
+func (x *Params) GetWithdrawRateLimit() *WithdrawRateLimit {
+	if x != nil {
+		return x.WithdrawRateLimit
+	}
+	return nil
+}
+
+func (x *Params) GetGuardians() []string {
+	if x != nil {
+		return x.Guardians
+	}
+	return nil
+}
+
+func (x *Params) GetMaxPauseDuration() *durationpb.Duration {
+	if x != nil {
+		return x.MaxPauseDuration
+	}
+	return nil
+}
+
+func (x *Params) GetConsolidationPolicy() *ConsolidationPolicy {
+	if x != nil {
+		return x.ConsolidationPolicy
+	}
+	return nil
+}
+
+func (x *Params) GetVaultRecoveryPolicy() *VaultRecoveryPolicy {
+	if x != nil {
+		return x.VaultRecoveryPolicy
+	}
+	return nil
+}
+
+func (x *Params) GetVaultAssignmentStrategy() VaultAssignmentStrategy {
+	if x != nil {
+		return x.VaultAssignmentStrategy
+	}
+	return VaultAssignmentStrategy_VAULT_ASSIGNMENT_STRATEGY_ROUND_ROBIN
+}
+
+// Vault defines the asset vault
+type Vault struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// the vault address for deposit
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// public key of the vault
+	PubKey string `protobuf:"bytes,2,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+	// the asset type supported by the vault
+	AssetType AssetType `protobuf:"varint,3,opt,name=asset_type,json=assetType,proto3,enum=side.btcbridge.AssetType" json:"asset_type,omitempty"`
+	// version
+	Version uint64 `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`
+	// script descriptor of the vault; nil for the single key vault, i.e. P2WPKH or P2TR
+	ScriptDescriptor *VaultDescriptor `protobuf:"bytes,5,opt,name=script_descriptor,json=scriptDescriptor,proto3" json:"script_descriptor,omitempty"`
+	// taproot script tree committed by the vault output besides the TSS key path; nil if no script path
+	TaprootTree *VaultTaprootTree `protobuf:"bytes,6,opt,name=taproot_tree,json=taprootTree,proto3" json:"taproot_tree,omitempty"`
+	// time after which the vault is retired and deposits are rejected; nil if not scheduled
+	RetirementTime *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=retirement_time,json=retirementTime,proto3" json:"retirement_time,omitempty"`
+	// lifecycle state
+	State VaultState `protobuf:"varint,8,opt,name=state,proto3,enum=side.btcbridge.VaultState" json:"state,omitempty"`
+}
+
+func (x *Vault) Reset() {
+	*x = Vault{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_side_btcbridge_params_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Vault) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Vault) ProtoMessage() {}
+
+// Deprecated: Use Vault.ProtoReflect.Descriptor instead.
+func (*Vault) Descriptor() ([]byte, []int) {
+	return file_side_btcbridge_params_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Vault) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *Vault) GetPubKey() string {
+	if x != nil {
+		return x.PubKey
+	}
+	return ""
+}
+
+func (x *Vault) GetAssetType() AssetType {
+	if x != nil {
+		return x.AssetType
+	}
+	return AssetType_ASSET_TYPE_UNSPECIFIED
+}
+
+func (x *Vault) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Vault) GetScriptDescriptor() *VaultDescriptor {
+	if x != nil {
+		return x.ScriptDescriptor
+	}
+	return nil
+}
+
+func (x *Vault) GetTaprootTree() *VaultTaprootTree {
+	if x != nil {
+		return x.TaprootTree
+	}
+	return nil
+}
+
+func (x *Vault) GetRetirementTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RetirementTime
+	}
+	return nil
+}
+
+func (x *Vault) GetState() VaultState {
+	if x != nil {
+		return x.State
+	}
+	return VaultState_VAULT_STATE_ACTIVE
+}
+
+// VaultTaprootTree defines the taproot script tree of the vault
+type VaultTaprootTree struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// hex encoded tapscript of the timelocked recovery leaf
+	RecoveryScript string `protobuf:"bytes,1,opt,name=recovery_script,json=recoveryScript,proto3" json:"recovery_script,omitempty"`
+}
+
+func (x *VaultTaprootTree) Reset() {
+	*x = VaultTaprootTree{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_side_btcbridge_params_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VaultTaprootTree) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VaultTaprootTree) ProtoMessage() {}
+
+// Deprecated: Use VaultTaprootTree.ProtoReflect.Descriptor instead.
+func (*VaultTaprootTree) Descriptor() ([]byte, []int) {
+	return file_side_btcbridge_params_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *VaultTaprootTree) GetRecoveryScript() string {
+	if x != nil {
+		return x.RecoveryScript
+	}
+	return ""
+}
+
+// VaultRecoveryPolicy defines the timelocked recovery script path committed by the vaults generated by DKG
+type VaultRecoveryPolicy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// hex encoded x-only public keys of the governance cold key or multisig; empty means no recovery script path
+	PubKeys []string `protobuf:"bytes,1,rep,name=pub_keys,json=pubKeys,proto3" json:"pub_keys,omitempty"`
+	// number of the signatures required
+	Threshold uint32 `protobuf:"varint,2,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// relative timelock in blocks enforced by OP_CHECKSEQUENCEVERIFY
+	Timelock uint32 `protobuf:"varint,3,opt,name=timelock,proto3" json:"timelock,omitempty"`
+}
+
+func (x *VaultRecoveryPolicy) Reset() {
+	*x = VaultRecoveryPolicy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_side_btcbridge_params_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VaultRecoveryPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VaultRecoveryPolicy) ProtoMessage() {}
+
+// Deprecated: Use VaultRecoveryPolicy.ProtoReflect.Descriptor instead.
+func (*VaultRecoveryPolicy) Descriptor() ([]byte, []int) {
+	return file_side_btcbridge_params_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *VaultRecoveryPolicy) GetPubKeys() []string {
+	if x != nil {
+		return x.PubKeys
+	}
+	return nil
+}
+
+func (x *VaultRecoveryPolicy) GetThreshold() uint32 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+func (x *VaultRecoveryPolicy) GetTimelock() uint32 {
+	if x != nil {
+		return x.Timelock
+	}
+	return 0
+}
+
+// VaultDescriptor defines the script descriptor of the script hash vault
+type VaultDescriptor struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// script type
+	ScriptType VaultScriptType `protobuf:"varint,1,opt,name=script_type,json=scriptType,proto3,enum=side.btcbridge.VaultScriptType" json:"script_type,omitempty"`
+	// number of the signatures required
+	Threshold uint32 `protobuf:"varint,2,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// hex encoded compressed public keys in the script order
+	PubKeys []string `protobuf:"bytes,3,rep,name=pub_keys,json=pubKeys,proto3" json:"pub_keys,omitempty"`
+}
+
+func (x *VaultDescriptor) Reset() {
+	*x = VaultDescriptor{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_side_btcbridge_params_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VaultDescriptor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VaultDescriptor) ProtoMessage() {}
+
+// Deprecated: Use VaultDescriptor.ProtoReflect.Descriptor instead.
+func (*VaultDescriptor) Descriptor() ([]byte, []int) {
+	return file_side_btcbridge_params_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *VaultDescriptor) GetScriptType() VaultScriptType {
+	if x != nil {
+		return x.ScriptType
+	}
+	return VaultScriptType_VAULT_SCRIPT_TYPE_UNSPECIFIED
+}
+
+func (x *VaultDescriptor) GetThreshold() uint32 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+func (x *VaultDescriptor) GetPubKeys() []string {
+	if x != nil {
+		return x.PubKeys
+	}
+	return nil
+}
+
+type WithdrawParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Maximum number of utxos used to build the signing request; O means unlimited
+	MaxUtxoNum uint32 `protobuf:"varint,1,opt,name=max_utxo_num,json=maxUtxoNum,proto3" json:"max_utxo_num,omitempty"`
+	// Period for handling btc withdrawal requests
+	BtcBatchWithdrawPeriod int64 `protobuf:"varint,2,opt,name=btc_batch_withdraw_period,json=btcBatchWithdrawPeriod,proto3" json:"btc_batch_withdraw_period,omitempty"`
+	// Maximum number of btc withdrawal requests to be handled per batch
+	MaxBtcBatchWithdrawNum uint32 `protobuf:"varint,3,opt,name=max_btc_batch_withdraw_num,json=maxBtcBatchWithdrawNum,proto3" json:"max_btc_batch_withdraw_num,omitempty"`
+	// Coin selection strategy used to select the payment utxos
+	CoinSelection CoinSelectionStrategy `protobuf:"varint,4,opt,name=coin_selection,json=coinSelection,proto3,enum=side.btcbridge.CoinSelectionStrategy" json:"coin_selection,omitempty"`
+}
+
+func (x *WithdrawParams) Reset() {
+	*x = WithdrawParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_side_btcbridge_params_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WithdrawParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WithdrawParams) ProtoMessage() {}
+
+// Deprecated: Use WithdrawParams.ProtoReflect.Descriptor instead.
+func (*WithdrawParams) Descriptor() ([]byte, []int) {
+	return file_side_btcbridge_params_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *WithdrawParams) GetMaxUtxoNum() uint32 {
+	if x != nil {
+		return x.MaxUtxoNum
+	}
+	return 0
+}
+
+func (x *WithdrawParams) GetBtcBatchWithdrawPeriod() int64 {
+	if x != nil {
+		return x.BtcBatchWithdrawPeriod
+	}
+	return 0
+}
+
+func (x *WithdrawParams) GetMaxBtcBatchWithdrawNum() uint32 {
+	if x != nil {
+		return x.MaxBtcBatchWithdrawNum
+	}
+	return 0
+}
+
+func (x *WithdrawParams) GetCoinSelection() CoinSelectionStrategy {
+	if x != nil {
+		return x.CoinSelection
+	}
+	return CoinSelectionStrategy_COIN_SELECTION_STRATEGY_DEFAULT
+}
+
+// WithdrawRateLimit defines the rolling window outflow limits for withdrawals
+// The btc withdrawals are limited when batched and the other assets when requested
+type WithdrawRateLimit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Rolling window in blocks; 0 means no rate limit
+	Window int64 `protobuf:"varint,1,opt,name=window,proto3" json:"window,omitempty"`
+	// Maximum btc in sat leaving the vaults per window, including the network fees and the btc attached to non-btc assets; 0 means no limit
+	GlobalLimit int64 `protobuf:"varint,2,opt,name=global_limit,json=globalLimit,proto3" json:"global_limit,omitempty"`
+	// Maximum withdrawal amount per window for each asset; the assets not listed are not limited
+	AssetLimits []*v1beta1.Coin `protobuf:"bytes,3,rep,name=asset_limits,json=assetLimits,proto3" json:"asset_limits,omitempty"`
+	// High-water mark in basis points of the limits, reaching which trips the circuit breaker; 0 means no circuit breaker
+	BreakerThresholdBps uint32 `protobuf:"varint,4,opt,name=breaker_threshold_bps,json=breakerThresholdBps,proto3" json:"breaker_threshold_bps,omitempty"`
+}
+
+func (x *WithdrawRateLimit) Reset() {
+	*x = WithdrawRateLimit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_side_btcbridge_params_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WithdrawRateLimit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WithdrawRateLimit) ProtoMessage() {}
+
+// Deprecated: Use WithdrawRateLimit.ProtoReflect.Descriptor instead.
+func (*WithdrawRateLimit) Descriptor() ([]byte, []int) {
+	return file_side_btcbridge_params_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *WithdrawRateLimit) GetWindow() int64 {
+	if x != nil {
+		return x.Window
+	}
+	return 0
+}
+
+func (x *WithdrawRateLimit) GetGlobalLimit() int64 {
+	if x != nil {
+		return x.GlobalLimit
+	}
+	return 0
+}
+
+func (x *WithdrawRateLimit) GetAssetLimits() []*v1beta1.Coin {
+	if x != nil {
+		return x.AssetLimits
+	}
+	return nil
+}
+
+func (x *WithdrawRateLimit) GetBreakerThresholdBps() uint32 {
+	if x != nil {
+		return x.BreakerThresholdBps
+	}
+	return 0
+}
+
+// ProtocolLimits defines the params related to the the protocol limitations
+type ProtocolLimits struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The minimum deposit amount for btc in sat
+	BtcMinDeposit int64 `protobuf:"varint,1,opt,name=btc_min_deposit,json=btcMinDeposit,proto3" json:"btc_min_deposit,omitempty"`
+	// The minimum withdrawal amount for btc in sat
+	BtcMinWithdraw int64 `protobuf:"varint,2,opt,name=btc_min_withdraw,json=btcMinWithdraw,proto3" json:"btc_min_withdraw,omitempty"`
+	// The maximum withdrawal amount for btc in sat
+	BtcMaxWithdraw int64 `protobuf:"varint,3,opt,name=btc_max_withdraw,json=btcMaxWithdraw,proto3" json:"btc_max_withdraw,omitempty"`
+}
+
+func (x *ProtocolLimits) Reset() {
+	*x = ProtocolLimits{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_side_btcbridge_params_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProtocolLimits) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProtocolLimits) ProtoMessage() {}
+
+// Deprecated: Use ProtocolLimits.ProtoReflect.Descriptor instead.
+func (*ProtocolLimits) Descriptor() ([]byte, []int) {
+	return file_side_btcbridge_params_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ProtocolLimits) GetBtcMinDeposit() int64 {
+	if x != nil {
+		return x.BtcMinDeposit
+	}
+	return 0
+}
+
+func (x *ProtocolLimits) GetBtcMinWithdraw() int64 {
+	if x != nil {
+		return x.BtcMinWithdraw
+	}
+	return 0
+}
+
+func (x *ProtocolLimits) GetBtcMaxWithdraw() int64 {
+	if x != nil {
+		return x.BtcMaxWithdraw
+	}
+	return 0
+}
+
+// ProtocolFees defines the params related to the protocol fees
+// The protocol fees are charged in the denom of the asset being deposited or withdrawn
+type ProtocolFees struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Protocol fee collector which receives all the fees if the distribution weights are all zero
+	Collector string `protobuf:"bytes,3,opt,name=collector,proto3" json:"collector,omitempty"`
+	// Protocol fee schedule for deposit
+	// The floor and cap are in sat and only apply to btc unless overridden by the asset
+	DepositFeeSchedule *FeeSchedule `protobuf:"bytes,4,opt,name=deposit_fee_schedule,json=depositFeeSchedule,proto3" json:"deposit_fee_schedule,omitempty"`
+	// Protocol fee schedule for withdrawal
+	// The floor and cap are in sat and only apply to btc unless overridden by the asset
+	WithdrawFeeSchedule *FeeSchedule `protobuf:"bytes,5,opt,name=withdraw_fee_schedule,json=withdrawFeeSchedule,proto3" json:"withdraw_fee_schedule,omitempty"`
+	// Protocol fee distribution
+	Distribution *FeeDistribution `protobuf:"bytes,6,opt,name=distribution,proto3" json:"distribution,omitempty"`
+}
+
+func (x *ProtocolFees) Reset() {
+	*x = ProtocolFees{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_side_btcbridge_params_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProtocolFees) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProtocolFees) ProtoMessage() {}
+
+// Deprecated: Use ProtocolFees.ProtoReflect.Descriptor instead.
+func (*ProtocolFees) Descriptor() ([]byte, []int) {
+	return file_side_btcbridge_params_proto_rawDescGZIP(), []int{8}
 }
 
-func (AssetType) Descriptor() protoreflect.EnumDescriptor {
-	return file_side_btcbridge_params_proto_enumTypes[0].Descriptor()
+func (x *ProtocolFees) GetCollector() string {
+	if x != nil {
+		return x.Collector
+	}
+	return ""
 }
 
-func (AssetType) Type() protoreflect.EnumType {
-	return &file_side_btcbridge_params_proto_enumTypes[0]
+func (x *ProtocolFees) GetDepositFeeSchedule() *FeeSchedule {
+	if x != nil {
+		return x.DepositFeeSchedule
+	}
+	return nil
 }
 
-func (x AssetType) Number() protoreflect.EnumNumber {
-	return protoreflect.EnumNumber(x)
+func (x *ProtocolFees) GetWithdrawFeeSchedule() *FeeSchedule {
+	if x != nil {
+		return x.WithdrawFeeSchedule
+	}
+	return nil
 }
 
-// Deprecated: Use AssetType.Descriptor instead.
-func (AssetType) EnumDescriptor() ([]byte, []int) {
-	return file_side_btcbridge_params_proto_rawDescGZIP(), []int{0}
+func (x *ProtocolFees) GetDistribution() *FeeDistribution {
+	if x != nil {
+		return x.Distribution
+	}
+	return nil
 }
 
-// Params defines the parameters for the module.
-type Params struct {
+// FeeDistribution defines the distribution of the accrued protocol fees
+// The fees are all distributed to the collector if the weights are all zero
+type FeeDistribution struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The minimum number of confirmations required for the deposit transactions
-	DepositConfirmationDepth int32 `protobuf:"varint,1,opt,name=deposit_confirmation_depth,json=depositConfirmationDepth,proto3" json:"deposit_confirmation_depth,omitempty"`
-	// The minimum number of confirmations required for the withdrawal transactions
-	WithdrawConfirmationDepth int32 `protobuf:"varint,2,opt,name=withdraw_confirmation_depth,json=withdrawConfirmationDepth,proto3" json:"withdraw_confirmation_depth,omitempty"`
-	// The allowed maximum depth for bitcoin block reorganization
-	MaxReorgDepth int32 `protobuf:"varint,3,opt,name=max_reorg_depth,json=maxReorgDepth,proto3" json:"max_reorg_depth,omitempty"`
-	// Indicates the maximum depth or distance from the latest block up to which transactions are considered for acceptance.
-	MaxAcceptableBlockDepth uint64 `protobuf:"varint,4,opt,name=max_acceptable_block_depth,json=maxAcceptableBlockDepth,proto3" json:"max_acceptable_block_depth,omitempty"`
-	// The denomination of the voucher
-	BtcVoucherDenom string `protobuf:"bytes,5,opt,name=btc_voucher_denom,json=btcVoucherDenom,proto3" json:"btc_voucher_denom,omitempty"`
-	// Indicates if deposit is enabled
-	DepositEnabled bool `protobuf:"varint,6,opt,name=deposit_enabled,json=depositEnabled,proto3" json:"deposit_enabled,omitempty"`
-	// Indicates if withdrawal is enabled
-	WithdrawEnabled bool `protobuf:"varint,7,opt,name=withdraw_enabled,json=withdrawEnabled,proto3" json:"withdraw_enabled,omitempty"`
-	// Trusted relayers to submit bitcoin block headers
-	TrustedBtcRelayers []string `protobuf:"bytes,8,rep,name=trusted_btc_relayers,json=trustedBtcRelayers,proto3" json:"trusted_btc_relayers,omitempty"`
-	// Trusted relayers for non-btc asset deposit
-	TrustedNonBtcRelayers []string `protobuf:"bytes,9,rep,name=trusted_non_btc_relayers,json=trustedNonBtcRelayers,proto3" json:"trusted_non_btc_relayers,omitempty"`
-	// Trusted fee providers to submit bitcoin fee rate
-	TrustedFeeProviders []string `protobuf:"bytes,10,rep,name=trusted_fee_providers,json=trustedFeeProviders,proto3" json:"trusted_fee_providers,omitempty"`
-	// Period of validity for the fee rate
-	FeeRateValidityPeriod int64 `protobuf:"varint,11,opt,name=fee_rate_validity_period,json=feeRateValidityPeriod,proto3" json:"fee_rate_validity_period,omitempty"`
-	// Asset vaults
-	Vaults []*Vault `protobuf:"bytes,12,rep,name=vaults,proto3" json:"vaults,omitempty"`
-	// Withdrawal params
-	WithdrawParams *WithdrawParams `protobuf:"bytes,13,opt,name=withdraw_params,json=withdrawParams,proto3" json:"withdraw_params,omitempty"`
-	// Protocol limitations
-	ProtocolLimits *ProtocolLimits `protobuf:"bytes,14,opt,name=protocol_limits,json=protocolLimits,proto3" json:"protocol_limits,omitempty"`
-	// Protocol fees
-	ProtocolFees *ProtocolFees `protobuf:"bytes,15,opt,name=protocol_fees,json=protocolFees,proto3" json:"protocol_fees,omitempty"`
-	// TSS params
-	TssParams *TSSParams `protobuf:"bytes,16,opt,name=tss_params,json=tssParams,proto3" json:"tss_params,omitempty"`
+	// Settlement period in blocks
+	Epoch int64 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// Weight of the community pool
+	CommunityPoolWeight uint32 `protobuf:"varint,2,opt,name=community_pool_weight,json=communityPoolWeight,proto3" json:"community_pool_weight,omitempty"`
+	// Weight of the relayer reward pool
+	RelayerPoolWeight uint32 `protobuf:"varint,3,opt,name=relayer_pool_weight,json=relayerPoolWeight,proto3" json:"relayer_pool_weight,omitempty"`
+	// Weight of the signer pool for the TSS participants
+	SignerPoolWeight uint32 `protobuf:"varint,4,opt,name=signer_pool_weight,json=signerPoolWeight,proto3" json:"signer_pool_weight,omitempty"`
+	// Weight of the insurance reserve
+	InsuranceWeight uint32 `protobuf:"varint,5,opt,name=insurance_weight,json=insuranceWeight,proto3" json:"insurance_weight,omitempty"`
 }
 
-func (x *Params) Reset() {
-	*x = Params{}
+func (x *FeeDistribution) Reset() {
+	*x = FeeDistribution{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_side_btcbridge_params_proto_msgTypes[0]
+		mi := &file_side_btcbridge_params_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Params) String() string {
+func (x *FeeDistribution) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Params) ProtoMessage() {}
+func (*FeeDistribution) ProtoMessage() {}
 
-// Deprecated: Use Params.ProtoReflect.Descriptor instead.
-func (*Params) Descriptor() ([]byte, []int) {
-	return file_side_btcbridge_params_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use FeeDistribution.ProtoReflect.Descriptor instead.
+func (*FeeDistribution) Descriptor() ([]byte, []int) {
+	return file_side_btcbridge_params_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *Params) GetDepositConfirmationDepth() int32 {
+func (x *FeeDistribution) GetEpoch() int64 {
 	if x != nil {
-		return x.DepositConfirmationDepth
+		return x.Epoch
 	}
 	return 0
 }
 
-func (x *Params) GetWithdrawConfirmationDepth() int32 {
+func (x *FeeDistribution) GetCommunityPoolWeight() uint32 {
 	if x != nil {
-		return x.WithdrawConfirmationDepth
+		return x.CommunityPoolWeight
 	}
 	return 0
 }
 
-func (x *Params) GetMaxReorgDepth() int32 {
+func (x *FeeDistribution) GetRelayerPoolWeight() uint32 {
 	if x != nil {
-		return x.MaxReorgDepth
+		return x.RelayerPoolWeight
 	}
 	return 0
 }
 
-func (x *Params) GetMaxAcceptableBlockDepth() uint64 {
+func (x *FeeDistribution) GetSignerPoolWeight() uint32 {
 	if x != nil {
-		return x.MaxAcceptableBlockDepth
+		return x.SignerPoolWeight
 	}
 	return 0
 }
 
-func (x *Params) GetBtcVoucherDenom() string {
+func (x *FeeDistribution) GetInsuranceWeight() uint32 {
 	if x != nil {
-		return x.BtcVoucherDenom
+		return x.InsuranceWeight
 	}
-	return ""
+	return 0
 }
 
-func (x *Params) GetDepositEnabled() bool {
+// FeeSchedule defines the percentage-based fee with the floor, cap and optional volume tiers
+// The amounts are in the smallest unit of the asset
+type FeeSchedule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Fee rate in basis points
+	Bps uint32 `protobuf:"varint,1,opt,name=bps,proto3" json:"bps,omitempty"`
+	// The minimum fee amount
+	MinFee string `protobuf:"bytes,2,opt,name=min_fee,json=minFee,proto3" json:"min_fee,omitempty"`
+	// The maximum fee amount; empty or zero means no cap
+	MaxFee string `protobuf:"bytes,3,opt,name=max_fee,json=maxFee,proto3" json:"max_fee,omitempty"`
+	// Volume tiers in ascending order of the minimum amount
+	// The fee rate of the highest tier reached by the amount takes precedence
+	Tiers []*FeeTier `protobuf:"bytes,4,rep,name=tiers,proto3" json:"tiers,omitempty"`
+}
+
+func (x *FeeSchedule) Reset() {
+	*x = FeeSchedule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_side_btcbridge_params_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FeeSchedule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeeSchedule) ProtoMessage() {}
+
+// Deprecated: Use FeeSchedule.ProtoReflect.Descriptor instead.
+func (*FeeSchedule) Descriptor() ([]byte, []int) {
+	return file_side_btcbridge_params_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *FeeSchedule) GetBps() uint32 {
 	if x != nil {
-		return x.DepositEnabled
+		return x.Bps
 	}
-	return false
+	return 0
 }
 
-func (x *Params) GetWithdrawEnabled() bool {
+func (x *FeeSchedule) GetMinFee() string {
 	if x != nil {
-		return x.WithdrawEnabled
+		return x.MinFee
 	}
-	return false
+	return ""
 }
 
-func (x *Params) GetTrustedBtcRelayers() []string {
+func (x *FeeSchedule) GetMaxFee() string {
 	if x != nil {
-		return x.TrustedBtcRelayers
+		return x.MaxFee
 	}
-	return nil
+	return ""
 }
 
-func (x *Params) GetTrustedNonBtcRelayers() []string {
+func (x *FeeSchedule) GetTiers() []*FeeTier {
 	if x != nil {
-		return x.TrustedNonBtcRelayers
+		return x.Tiers
 	}
 	return nil
 }
 
-func (x *Params) GetTrustedFeeProviders() []string {
+// FeeTier defines the fee rate applied to the amount not less than the given minimum amount
+type FeeTier struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The minimum amount to reach the tier
+	MinAmount string `protobuf:"bytes,1,opt,name=min_amount,json=minAmount,proto3" json:"min_amount,omitempty"`
+	// Fee rate in basis points
+	Bps uint32 `protobuf:"varint,2,opt,name=bps,proto3" json:"bps,omitempty"`
+}
+
+func (x *FeeTier) Reset() {
+	*x = FeeTier{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_side_btcbridge_params_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FeeTier) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeeTier) ProtoMessage() {}
+
+// Deprecated: Use FeeTier.ProtoReflect.Descriptor instead.
+func (*FeeTier) Descriptor() ([]byte, []int) {
+	return file_side_btcbridge_params_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *FeeTier) GetMinAmount() string {
 	if x != nil {
-		return x.TrustedFeeProviders
+		return x.MinAmount
 	}
-	return nil
+	return ""
 }
 
-func (x *Params) GetFeeRateValidityPeriod() int64 {
+func (x *FeeTier) GetBps() uint32 {
 	if x != nil {
-		return x.FeeRateValidityPeriod
+		return x.Bps
 	}
 	return 0
 }
 
-func (x *Params) GetVaults() []*Vault {
+// RuneConfig defines the governance controlled configuration for the given rune
+// The amounts are in the smallest unit of the rune; empty or zero means no limit
+type RuneConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// rune id
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Indicates if deposit and withdrawal are enabled for the rune
+	Enabled bool `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// The minimum deposit amount
+	MinDeposit string `protobuf:"bytes,3,opt,name=min_deposit,json=minDeposit,proto3" json:"min_deposit,omitempty"`
+	// The minimum withdrawal amount
+	MinWithdraw string `protobuf:"bytes,4,opt,name=min_withdraw,json=minWithdraw,proto3" json:"min_withdraw,omitempty"`
+	// The maximum withdrawal amount
+	MaxWithdraw string `protobuf:"bytes,5,opt,name=max_withdraw,json=maxWithdraw,proto3" json:"max_withdraw,omitempty"`
+	// The maximum supply of the voucher token
+	SupplyCap string `protobuf:"bytes,6,opt,name=supply_cap,json=supplyCap,proto3" json:"supply_cap,omitempty"`
+	// Protocol fees overriding the default protocol fees; nil means no override
+	FeeOverride *RuneFees `protobuf:"bytes,7,opt,name=fee_override,json=feeOverride,proto3" json:"fee_override,omitempty"`
+}
+
+func (x *RuneConfig) Reset() {
+	*x = RuneConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_side_btcbridge_params_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RuneConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RuneConfig) ProtoMessage() {}
+
+// Deprecated: Use RuneConfig.ProtoReflect.Descriptor instead.
+func (*RuneConfig) Descriptor() ([]byte, []int) {
+	return file_side_btcbridge_params_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *RuneConfig) GetId() string {
 	if x != nil {
-		return x.Vaults
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-func (x *Params) GetWithdrawParams() *WithdrawParams {
+func (x *RuneConfig) GetEnabled() bool {
 	if x != nil {
-		return x.WithdrawParams
+		return x.Enabled
 	}
-	return nil
+	return false
 }
 
-func (x *Params) GetProtocolLimits() *ProtocolLimits {
+func (x *RuneConfig) GetMinDeposit() string {
 	if x != nil {
-		return x.ProtocolLimits
+		return x.MinDeposit
+	}
+	return ""
+}
+
+func (x *RuneConfig) GetMinWithdraw() string {
+	if x != nil {
+		return x.MinWithdraw
+	}
+	return ""
+}
+
+func (x *RuneConfig) GetMaxWithdraw() string {
+	if x != nil {
+		return x.MaxWithdraw
+	}
+	return ""
+}
+
+func (x *RuneConfig) GetSupplyCap() string {
+	if x != nil {
+		return x.SupplyCap
+	}
+	return ""
+}
+
+func (x *RuneConfig) GetFeeOverride() *RuneFees {
+	if x != nil {
+		return x.FeeOverride
 	}
 	return nil
 }
 
-func (x *Params) GetProtocolFees() *ProtocolFees {
+// RuneFees defines the protocol fees for the given rune
+// The amounts of the fee schedules are in the smallest unit of the rune
+type RuneFees struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Protocol fee schedule for deposit
+	DepositFeeSchedule *FeeSchedule `protobuf:"bytes,1,opt,name=deposit_fee_schedule,json=depositFeeSchedule,proto3" json:"deposit_fee_schedule,omitempty"`
+	// Protocol fee schedule for withdrawal
+	WithdrawFeeSchedule *FeeSchedule `protobuf:"bytes,2,opt,name=withdraw_fee_schedule,json=withdrawFeeSchedule,proto3" json:"withdraw_fee_schedule,omitempty"`
+}
+
+func (x *RuneFees) Reset() {
+	*x = RuneFees{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_side_btcbridge_params_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RuneFees) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RuneFees) ProtoMessage() {}
+
+// Deprecated: Use RuneFees.ProtoReflect.Descriptor instead.
+func (*RuneFees) Descriptor() ([]byte, []int) {
+	return file_side_btcbridge_params_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *RuneFees) GetDepositFeeSchedule() *FeeSchedule {
 	if x != nil {
-		return x.ProtocolFees
+		return x.DepositFeeSchedule
 	}
 	return nil
 }
 
-func (x *Params) GetTssParams() *TSSParams {
+func (x *RuneFees) GetWithdrawFeeSchedule() *FeeSchedule {
 	if x != nil {
-		return x.TssParams
+		return x.WithdrawFeeSchedule
 	}
 	return nil
 }
 
-// Vault defines the asset vault
-type Vault struct {
+// TSSParams defines the params related to TSS
+type TSSParams struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// the vault address for deposit
-	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	// public key of the vault
-	PubKey string `protobuf:"bytes,2,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
-	// the asset type supported by the vault
-	AssetType AssetType `protobuf:"varint,3,opt,name=asset_type,json=assetType,proto3,enum=side.btcbridge.AssetType" json:"asset_type,omitempty"`
-	// version
-	Version uint64 `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`
+	// Timeout duration for DKG request
+	DkgTimeoutPeriod *durationpb.Duration `protobuf:"bytes,1,opt,name=dkg_timeout_period,json=dkgTimeoutPeriod,proto3" json:"dkg_timeout_period,omitempty"`
+	// Transition period after which TSS participants update process is completed
+	ParticipantUpdateTransitionPeriod *durationpb.Duration `protobuf:"bytes,2,opt,name=participant_update_transition_period,json=participantUpdateTransitionPeriod,proto3" json:"participant_update_transition_period,omitempty"`
+	// Maximum number of the automatic retries of the timed out DKG request without the non-responders; 0 means no retry
+	DkgMaxRetries uint32 `protobuf:"varint,3,opt,name=dkg_max_retries,json=dkgMaxRetries,proto3" json:"dkg_max_retries,omitempty"`
+	// Indicates if the new DKG is initiated automatically when the bonded validator set changes
+	AutoRotationEnabled bool `protobuf:"varint,4,opt,name=auto_rotation_enabled,json=autoRotationEnabled,proto3" json:"auto_rotation_enabled,omitempty"`
+	// Maximum number of the top bonded validators as the TSS participants; 0 means the number of the current participants
+	MaxParticipants uint32 `protobuf:"varint,5,opt,name=max_participants,json=maxParticipants,proto3" json:"max_participants,omitempty"`
+	// Stake shift in basis points of the top validators power which triggers the rotation; 0 means only the leaving participants trigger
+	StakeShiftThresholdBps uint32 `protobuf:"varint,6,opt,name=stake_shift_threshold_bps,json=stakeShiftThresholdBps,proto3" json:"stake_shift_threshold_bps,omitempty"`
+	// Liveness params of the TSS participants
+	SignerLiveness *SignerLivenessParams `protobuf:"bytes,7,opt,name=signer_liveness,json=signerLiveness,proto3" json:"signer_liveness,omitempty"`
 }
 
-func (x *Vault) Reset() {
-	*x = Vault{}
+func (x *TSSParams) Reset() {
+	*x = TSSParams{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_side_btcbridge_params_proto_msgTypes[1]
+		mi := &file_side_btcbridge_params_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Vault) String() string {
+func (x *TSSParams) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Vault) ProtoMessage() {}
+func (*TSSParams) ProtoMessage() {}
 
-// Deprecated: Use Vault.ProtoReflect.Descriptor instead.
-func (*Vault) Descriptor() ([]byte, []int) {
-	return file_side_btcbridge_params_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use TSSParams.ProtoReflect.Descriptor instead.
+func (*TSSParams) Descriptor() ([]byte, []int) {
+	return file_side_btcbridge_params_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *Vault) GetAddress() string {
+func (x *TSSParams) GetDkgTimeoutPeriod() *durationpb.Duration {
 	if x != nil {
-		return x.Address
+		return x.DkgTimeoutPeriod
 	}
-	return ""
+	return nil
 }
 
-func (x *Vault) GetPubKey() string {
+func (x *TSSParams) GetParticipantUpdateTransitionPeriod() *durationpb.Duration {
+	if x != nil {
+		return x.ParticipantUpdateTransitionPeriod
+	}
+	return nil
+}
+
+func (x *TSSParams) GetDkgMaxRetries() uint32 {
+	if x != nil {
+		return x.DkgMaxRetries
+	}
+	return 0
+}
+
+func (x *TSSParams) GetAutoRotationEnabled() bool {
+	if x != nil {
+		return x.AutoRotationEnabled
+	}
+	return false
+}
+
+func (x *TSSParams) GetMaxParticipants() uint32 {
 	if x != nil {
-		return x.PubKey
+		return x.MaxParticipants
 	}
-	return ""
+	return 0
 }
 
-func (x *Vault) GetAssetType() AssetType {
+func (x *TSSParams) GetStakeShiftThresholdBps() uint32 {
 	if x != nil {
-		return x.AssetType
+		return x.StakeShiftThresholdBps
 	}
-	return AssetType_ASSET_TYPE_UNSPECIFIED
+	return 0
 }
 
-func (x *Vault) GetVersion() uint64 {
+func (x *TSSParams) GetSignerLiveness() *SignerLivenessParams {
 	if x != nil {
-		return x.Version
+		return x.SignerLiveness
 	}
-	return 0
+	return nil
 }
 
-type WithdrawParams struct {
+// SignerLivenessParams defines the params for the liveness tracking of the TSS participants
+type SignerLivenessParams struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Maximum number of utxos used to build the signing request; O means unlimited
-	MaxUtxoNum uint32 `protobuf:"varint,1,opt,name=max_utxo_num,json=maxUtxoNum,proto3" json:"max_utxo_num,omitempty"`
-	// Period for handling btc withdrawal requests
-	BtcBatchWithdrawPeriod int64 `protobuf:"varint,2,opt,name=btc_batch_withdraw_period,json=btcBatchWithdrawPeriod,proto3" json:"btc_batch_withdraw_period,omitempty"`
-	// Maximum number of btc withdrawal requests to be handled per batch
-	MaxBtcBatchWithdrawNum uint32 `protobuf:"varint,3,opt,name=max_btc_batch_withdraw_num,json=maxBtcBatchWithdrawNum,proto3" json:"max_btc_batch_withdraw_num,omitempty"`
-}
-
-func (x *WithdrawParams) Reset() {
-	*x = WithdrawParams{}
+	// Sliding window in blocks in which the missed DKG completions and signing rounds are counted; 0 means disabled
+	Window int64 `protobuf:"varint,1,opt,name=window,proto3" json:"window,omitempty"`
+	// Maximum number of the missed DKG completions in the window; 0 means no limit
+	MaxMissedDkgs uint32 `protobuf:"varint,2,opt,name=max_missed_dkgs,json=maxMissedDkgs,proto3" json:"max_missed_dkgs,omitempty"`
+	// Maximum number of the missed signing rounds in the window; 0 means no limit
+	MaxMissedSignings uint32 `protobuf:"varint,3,opt,name=max_missed_signings,json=maxMissedSignings,proto3" json:"max_missed_signings,omitempty"`
+	// Fraction in basis points of the stake slashed when the participant exceeds the limits; 0 means no slashing
+	SlashFractionBps uint32 `protobuf:"varint,4,opt,name=slash_fraction_bps,json=slashFractionBps,proto3" json:"slash_fraction_bps,omitempty"`
+	// Jail duration of the participant exceeding the limits; 0 means no jailing
+	JailDuration *durationpb.Duration `protobuf:"bytes,5,opt,name=jail_duration,json=jailDuration,proto3" json:"jail_duration,omitempty"`
+	// Timeout of the signing round after which the non-responding signers are considered missed; 0 means no timeout
+	SigningRoundTimeout *durationpb.Duration `protobuf:"bytes,6,opt,name=signing_round_timeout,json=signingRoundTimeout,proto3" json:"signing_round_timeout,omitempty"`
+}
+
+func (x *SignerLivenessParams) Reset() {
+	*x = SignerLivenessParams{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_side_btcbridge_params_proto_msgTypes[2]
+		mi := &file_side_btcbridge_params_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *WithdrawParams) String() string {
+func (x *SignerLivenessParams) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WithdrawParams) ProtoMessage() {}
+func (*SignerLivenessParams) ProtoMessage() {}
 
-// Deprecated: Use WithdrawParams.ProtoReflect.Descriptor instead.
-func (*WithdrawParams) Descriptor() ([]byte, []int) {
-	return file_side_btcbridge_params_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use SignerLivenessParams.ProtoReflect.Descriptor instead.
+func (*SignerLivenessParams) Descriptor() ([]byte, []int) {
+	return file_side_btcbridge_params_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *WithdrawParams) GetMaxUtxoNum() uint32 {
+func (x *SignerLivenessParams) GetWindow() int64 {
 	if x != nil {
-		return x.MaxUtxoNum
+		return x.Window
 	}
 	return 0
 }
 
-func (x *WithdrawParams) GetBtcBatchWithdrawPeriod() int64 {
+func (x *SignerLivenessParams) GetMaxMissedDkgs() uint32 {
 	if x != nil {
-		return x.BtcBatchWithdrawPeriod
+		return x.MaxMissedDkgs
 	}
 	return 0
 }
 
-func (x *WithdrawParams) GetMaxBtcBatchWithdrawNum() uint32 {
+func (x *SignerLivenessParams) GetMaxMissedSignings() uint32 {
 	if x != nil {
-		return x.MaxBtcBatchWithdrawNum
+		return x.MaxMissedSignings
 	}
 	return 0
 }
 
-// ProtocolLimits defines the params related to the the protocol limitations
-type ProtocolLimits struct {
+func (x *SignerLivenessParams) GetSlashFractionBps() uint32 {
+	if x != nil {
+		return x.SlashFractionBps
+	}
+	return 0
+}
+
+func (x *SignerLivenessParams) GetJailDuration() *durationpb.Duration {
+	if x != nil {
+		return x.JailDuration
+	}
+	return nil
+}
+
+func (x *SignerLivenessParams) GetSigningRoundTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.SigningRoundTimeout
+	}
+	return nil
+}
+
+// ConsolidationPolicy defines the policy for the automatic vault utxo consolidation in EndBlocker
+// The policy applies to the vaults of the latest version
+type ConsolidationPolicy struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The minimum deposit amount for btc in sat
-	BtcMinDeposit int64 `protobuf:"varint,1,opt,name=btc_min_deposit,json=btcMinDeposit,proto3" json:"btc_min_deposit,omitempty"`
-	// The minimum withdrawal amount for btc in sat
-	BtcMinWithdraw int64 `protobuf:"varint,2,opt,name=btc_min_withdraw,json=btcMinWithdraw,proto3" json:"btc_min_withdraw,omitempty"`
-	// The maximum withdrawal amount for btc in sat
-	BtcMaxWithdraw int64 `protobuf:"varint,3,opt,name=btc_max_withdraw,json=btcMaxWithdraw,proto3" json:"btc_max_withdraw,omitempty"`
-}
-
-func (x *ProtocolLimits) Reset() {
-	*x = ProtocolLimits{}
+	// Number of the unlocked vault utxos to trigger the consolidation; 0 means disabled
+	UtxoNumTrigger uint32 `protobuf:"varint,1,opt,name=utxo_num_trigger,json=utxoNumTrigger,proto3" json:"utxo_num_trigger,omitempty"`
+	// Maximum network fee rate in sat/vbyte allowed for the consolidation; 0 means no ceiling
+	MaxFeeRate int64 `protobuf:"varint,2,opt,name=max_fee_rate,json=maxFeeRate,proto3" json:"max_fee_rate,omitempty"`
+	// Maximum threshold of the btc value of the utxos to be consolidated; 0 means the btc vault is not consolidated
+	BtcTargetThreshold int64 `protobuf:"varint,3,opt,name=btc_target_threshold,json=btcTargetThreshold,proto3" json:"btc_target_threshold,omitempty"`
+	// Runes to be consolidated with the maximum threshold of the rune balance
+	RuneThresholds []*RuneConsolidationThreshold `protobuf:"bytes,4,rep,name=rune_thresholds,json=runeThresholds,proto3" json:"rune_thresholds,omitempty"`
+	// Maximum number of the utxos to be consolidated per run; 0 means the maximum utxo number of the withdrawal params
+	MaxNum uint32 `protobuf:"varint,5,opt,name=max_num,json=maxNum,proto3" json:"max_num,omitempty"`
+	// Minimum number of blocks between two runs
+	Cooldown int64 `protobuf:"varint,6,opt,name=cooldown,proto3" json:"cooldown,omitempty"`
+}
+
+func (x *ConsolidationPolicy) Reset() {
+	*x = ConsolidationPolicy{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_side_btcbridge_params_proto_msgTypes[3]
+		mi := &file_side_btcbridge_params_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ProtocolLimits) String() string {
+func (x *ConsolidationPolicy) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProtocolLimits) ProtoMessage() {}
+func (*ConsolidationPolicy) ProtoMessage() {}
 
-// Deprecated: Use ProtocolLimits.ProtoReflect.Descriptor instead.
-func (*ProtocolLimits) Descriptor() ([]byte, []int) {
-	return file_side_btcbridge_params_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use ConsolidationPolicy.ProtoReflect.Descriptor instead.
+func (*ConsolidationPolicy) Descriptor() ([]byte, []int) {
+	return file_side_btcbridge_params_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *ProtocolLimits) GetBtcMinDeposit() int64 {
+func (x *ConsolidationPolicy) GetUtxoNumTrigger() uint32 {
 	if x != nil {
-		return x.BtcMinDeposit
+		return x.UtxoNumTrigger
 	}
 	return 0
 }
 
-func (x *ProtocolLimits) GetBtcMinWithdraw() int64 {
+func (x *ConsolidationPolicy) GetMaxFeeRate() int64 {
 	if x != nil {
-		return x.BtcMinWithdraw
+		return x.MaxFeeRate
 	}
 	return 0
 }
 
-func (x *ProtocolLimits) GetBtcMaxWithdraw() int64 {
+func (x *ConsolidationPolicy) GetBtcTargetThreshold() int64 {
 	if x != nil {
-		return x.BtcMaxWithdraw
+		return x.BtcTargetThreshold
 	}
 	return 0
 }
 
-// ProtocolFees defines the params related to the protocol fees
-type ProtocolFees struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// Protocol fee amount for deposit in sat
-	DepositFee int64 `protobuf:"varint,1,opt,name=deposit_fee,json=depositFee,proto3" json:"deposit_fee,omitempty"`
-	// Protocol fee amount for withdrawal in sat
-	WithdrawFee int64 `protobuf:"varint,2,opt,name=withdraw_fee,json=withdrawFee,proto3" json:"withdraw_fee,omitempty"`
-	// Protocol fee collector
-	Collector string `protobuf:"bytes,3,opt,name=collector,proto3" json:"collector,omitempty"`
-}
-
-func (x *ProtocolFees) Reset() {
-	*x = ProtocolFees{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_side_btcbridge_params_proto_msgTypes[4]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *ProtocolFees) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*ProtocolFees) ProtoMessage() {}
-
-// Deprecated: Use ProtocolFees.ProtoReflect.Descriptor instead.
-func (*ProtocolFees) Descriptor() ([]byte, []int) {
-	return file_side_btcbridge_params_proto_rawDescGZIP(), []int{4}
-}
-
-func (x *ProtocolFees) GetDepositFee() int64 {
+func (x *ConsolidationPolicy) GetRuneThresholds() []*RuneConsolidationThreshold {
 	if x != nil {
-		return x.DepositFee
+		return x.RuneThresholds
 	}
-	return 0
+	return nil
 }
 
-func (x *ProtocolFees) GetWithdrawFee() int64 {
+func (x *ConsolidationPolicy) GetMaxNum() uint32 {
 	if x != nil {
-		return x.WithdrawFee
+		return x.MaxNum
 	}
 	return 0
 }
 
-func (x *ProtocolFees) GetCollector() string {
+func (x *ConsolidationPolicy) GetCooldown() int64 {
 	if x != nil {
-		return x.Collector
+		return x.Cooldown
 	}
-	return ""
+	return 0
 }
 
-// TSSParams defines the params related to TSS
-type TSSParams struct {
+// RuneConsolidationThreshold defines the target threshold for the automatic runes consolidation
+type RuneConsolidationThreshold struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Timeout duration for DKG request
-	DkgTimeoutPeriod *durationpb.Duration `protobuf:"bytes,1,opt,name=dkg_timeout_period,json=dkgTimeoutPeriod,proto3" json:"dkg_timeout_period,omitempty"`
-	// Transition period after which TSS participants update process is completed
-	ParticipantUpdateTransitionPeriod *durationpb.Duration `protobuf:"bytes,2,opt,name=participant_update_transition_period,json=participantUpdateTransitionPeriod,proto3" json:"participant_update_transition_period,omitempty"`
+	// rune id
+	RuneId string `protobuf:"bytes,1,opt,name=rune_id,json=runeId,proto3" json:"rune_id,omitempty"`
+	// maximum threshold of the corresponding rune balance
+	TargetThreshold string `protobuf:"bytes,2,opt,name=target_threshold,json=targetThreshold,proto3" json:"target_threshold,omitempty"`
 }
 
-func (x *TSSParams) Reset() {
-	*x = TSSParams{}
+func (x *RuneConsolidationThreshold) Reset() {
+	*x = RuneConsolidationThreshold{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_side_btcbridge_params_proto_msgTypes[5]
+		mi := &file_side_btcbridge_params_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TSSParams) String() string {
+func (x *RuneConsolidationThreshold) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TSSParams) ProtoMessage() {}
+func (*RuneConsolidationThreshold) ProtoMessage() {}
 
-// Deprecated: Use TSSParams.ProtoReflect.Descriptor instead.
-func (*TSSParams) Descriptor() ([]byte, []int) {
-	return file_side_btcbridge_params_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use RuneConsolidationThreshold.ProtoReflect.Descriptor instead.
+func (*RuneConsolidationThreshold) Descriptor() ([]byte, []int) {
+	return file_side_btcbridge_params_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *TSSParams) GetDkgTimeoutPeriod() *durationpb.Duration {
+func (x *RuneConsolidationThreshold) GetRuneId() string {
 	if x != nil {
-		return x.DkgTimeoutPeriod
+		return x.RuneId
 	}
-	return nil
+	return ""
 }
 
-func (x *TSSParams) GetParticipantUpdateTransitionPeriod() *durationpb.Duration {
+func (x *RuneConsolidationThreshold) GetTargetThreshold() string {
 	if x != nil {
-		return x.ParticipantUpdateTransitionPeriod
+		return x.TargetThreshold
 	}
-	return nil
+	return ""
 }
 
 var File_side_btcbridge_params_proto protoreflect.FileDescriptor
@@ -4746,133 +14454,391 @@ var file_side_btcbridge_params_proto_rawDesc = []byte{
 	0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65,
-	0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x63, 0x6f, 0x69, 0x6e, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x22, 0x99, 0x07, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x3c,
-	0x0a, 0x1a, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72,
-	0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x65, 0x70, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x18, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x70, 0x74, 0x68, 0x12, 0x3e, 0x0a, 0x1b,
-	0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x65, 0x70, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x19, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x70, 0x74, 0x68, 0x12, 0x26, 0x0a, 0x0f,
-	0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x6f, 0x72, 0x67, 0x5f, 0x64, 0x65, 0x70, 0x74, 0x68, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x6f, 0x72, 0x67, 0x44,
-	0x65, 0x70, 0x74, 0x68, 0x12, 0x3b, 0x0a, 0x1a, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x63, 0x63, 0x65,
-	0x70, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x64, 0x65, 0x70,
-	0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x17, 0x6d, 0x61, 0x78, 0x41, 0x63, 0x63,
-	0x65, 0x70, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x44, 0x65, 0x70, 0x74,
-	0x68, 0x12, 0x2a, 0x0a, 0x11, 0x62, 0x74, 0x63, 0x5f, 0x76, 0x6f, 0x75, 0x63, 0x68, 0x65, 0x72,
-	0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x62, 0x74,
-	0x63, 0x56, 0x6f, 0x75, 0x63, 0x68, 0x65, 0x72, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x27, 0x0a,
-	0x0f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x45,
-	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72,
-	0x61, 0x77, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x0f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65,
-	0x64, 0x12, 0x30, 0x0a, 0x14, 0x74, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x74, 0x63,
-	0x5f, 0x72, 0x65, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x12, 0x74, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x42, 0x74, 0x63, 0x52, 0x65, 0x6c, 0x61, 0x79,
-	0x65, 0x72, 0x73, 0x12, 0x37, 0x0a, 0x18, 0x74, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x6e,
-	0x6f, 0x6e, 0x5f, 0x62, 0x74, 0x63, 0x5f, 0x72, 0x65, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18,
-	0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x15, 0x74, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x4e, 0x6f,
-	0x6e, 0x42, 0x74, 0x63, 0x52, 0x65, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x12, 0x32, 0x0a, 0x15,
-	0x74, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x76,
-	0x69, 0x64, 0x65, 0x72, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x13, 0x74, 0x72, 0x75,
-	0x73, 0x74, 0x65, 0x64, 0x46, 0x65, 0x65, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73,
-	0x12, 0x37, 0x0a, 0x18, 0x66, 0x65, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x76, 0x61, 0x6c,
-	0x69, 0x64, 0x69, 0x74, 0x79, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x0b, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x15, 0x66, 0x65, 0x65, 0x52, 0x61, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64,
-	0x69, 0x74, 0x79, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x2d, 0x0a, 0x06, 0x76, 0x61, 0x75,
-	0x6c, 0x74, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x69, 0x64, 0x65,
-	0x2e, 0x62, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x56, 0x61, 0x75, 0x6c, 0x74,
-	0x52, 0x06, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x4d, 0x0a, 0x0f, 0x77, 0x69, 0x74, 0x68,
-	0x64, 0x72, 0x61, 0x77, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73,
+	0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x63, 0x6f, 0x69, 0x6e, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0xca, 0x0b, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12,
+	0x3c, 0x0a, 0x1a, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x65, 0x70, 0x74, 0x68, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x18, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x70, 0x74, 0x68, 0x12, 0x3e, 0x0a,
+	0x1b, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72,
+	0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x65, 0x70, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x19, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x70, 0x74, 0x68, 0x12, 0x26, 0x0a,
+	0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x6f, 0x72, 0x67, 0x5f, 0x64, 0x65, 0x70, 0x74, 0x68,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x6f, 0x72, 0x67,
+	0x44, 0x65, 0x70, 0x74, 0x68, 0x12, 0x3b, 0x0a, 0x1a, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x63, 0x63,
+	0x65, 0x70, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x64, 0x65,
+	0x70, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x17, 0x6d, 0x61, 0x78, 0x41, 0x63,
+	0x63, 0x65, 0x70, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x44, 0x65, 0x70,
+	0x74, 0x68, 0x12, 0x2a, 0x0a, 0x11, 0x62, 0x74, 0x63, 0x5f, 0x76, 0x6f, 0x75, 0x63, 0x68, 0x65,
+	0x72, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x62,
+	0x74, 0x63, 0x56, 0x6f, 0x75, 0x63, 0x68, 0x65, 0x72, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x27,
+	0x0a, 0x0f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65,
+	0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x77, 0x69, 0x74, 0x68, 0x64,
+	0x72, 0x61, 0x77, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x45, 0x6e, 0x61, 0x62, 0x6c,
+	0x65, 0x64, 0x12, 0x30, 0x0a, 0x14, 0x74, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x74,
+	0x63, 0x5f, 0x72, 0x65, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x12, 0x74, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x42, 0x74, 0x63, 0x52, 0x65, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x73, 0x12, 0x37, 0x0a, 0x18, 0x74, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x5f,
+	0x6e, 0x6f, 0x6e, 0x5f, 0x62, 0x74, 0x63, 0x5f, 0x72, 0x65, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73,
+	0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x15, 0x74, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x4e,
+	0x6f, 0x6e, 0x42, 0x74, 0x63, 0x52, 0x65, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x12, 0x32, 0x0a,
+	0x15, 0x74, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x70, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x13, 0x74, 0x72,
+	0x75, 0x73, 0x74, 0x65, 0x64, 0x46, 0x65, 0x65, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x73, 0x12, 0x37, 0x0a, 0x18, 0x66, 0x65, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x69, 0x74, 0x79, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x15, 0x66, 0x65, 0x65, 0x52, 0x61, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x69, 0x74, 0x79, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x2d, 0x0a, 0x06, 0x76, 0x61,
+	0x75, 0x6c, 0x74, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x69, 0x64,
+	0x65, 0x2e, 0x62, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x56, 0x61, 0x75, 0x6c,
+	0x74, 0x52, 0x06, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x4d, 0x0a, 0x0f, 0x77, 0x69, 0x74,
+	0x68, 0x64, 0x72, 0x61, 0x77, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x69, 0x64, 0x65, 0x2e, 0x62, 0x74, 0x63, 0x62, 0x72, 0x69,
+	0x64, 0x67, 0x65, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0e, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72,
+	0x61, 0x77, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x4d, 0x0a, 0x0f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x63, 0x6f, 0x6c, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28,
 	0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x69, 0x64, 0x65, 0x2e, 0x62, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64,
-	0x67, 0x65, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x50, 0x61, 0x72, 0x61, 0x6d,
-	0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0e, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61,
-	0x77, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x4d, 0x0a, 0x0f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x63, 0x6f, 0x6c, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1e, 0x2e, 0x73, 0x69, 0x64, 0x65, 0x2e, 0x62, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64, 0x67,
-	0x65, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73,
-	0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
-	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x47, 0x0a, 0x0d, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
-	0x6f, 0x6c, 0x5f, 0x66, 0x65, 0x65, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
-	0x73, 0x69, 0x64, 0x65, 0x2e, 0x62, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x50,
-	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x46, 0x65, 0x65, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f,
-	0x00, 0x52, 0x0c, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x46, 0x65, 0x65, 0x73, 0x12,
-	0x3e, 0x0a, 0x0a, 0x74, 0x73, 0x73, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x10, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x69, 0x64, 0x65, 0x2e, 0x62, 0x74, 0x63, 0x62, 0x72,
-	0x69, 0x64, 0x67, 0x65, 0x2e, 0x54, 0x53, 0x53, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x04,
-	0xc8, 0xde, 0x1f, 0x00, 0x52, 0x09, 0x74, 0x73, 0x73, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22,
-	0x8e, 0x01, 0x0a, 0x05, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x75, 0x62, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x12, 0x38, 0x0a, 0x0a,
-	0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x19, 0x2e, 0x73, 0x69, 0x64, 0x65, 0x2e, 0x62, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64, 0x67,
-	0x65, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x61, 0x73, 0x73,
-	0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
-	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x22, 0xa9, 0x01, 0x0a, 0x0e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x50, 0x61, 0x72,
-	0x61, 0x6d, 0x73, 0x12, 0x20, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x75, 0x74, 0x78, 0x6f, 0x5f,
-	0x6e, 0x75, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x55, 0x74,
-	0x78, 0x6f, 0x4e, 0x75, 0x6d, 0x12, 0x39, 0x0a, 0x19, 0x62, 0x74, 0x63, 0x5f, 0x62, 0x61, 0x74,
-	0x63, 0x68, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x5f, 0x70, 0x65, 0x72, 0x69,
-	0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x16, 0x62, 0x74, 0x63, 0x42, 0x61, 0x74,
-	0x63, 0x68, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64,
-	0x12, 0x3a, 0x0a, 0x1a, 0x6d, 0x61, 0x78, 0x5f, 0x62, 0x74, 0x63, 0x5f, 0x62, 0x61, 0x74, 0x63,
-	0x68, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x16, 0x6d, 0x61, 0x78, 0x42, 0x74, 0x63, 0x42, 0x61, 0x74, 0x63,
-	0x68, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x4e, 0x75, 0x6d, 0x22, 0x8c, 0x01, 0x0a,
-	0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x12,
-	0x26, 0x0a, 0x0f, 0x62, 0x74, 0x63, 0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73,
-	0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x62, 0x74, 0x63, 0x4d, 0x69, 0x6e,
-	0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x62, 0x74, 0x63, 0x5f, 0x6d,
-	0x69, 0x6e, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x0e, 0x62, 0x74, 0x63, 0x4d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61,
-	0x77, 0x12, 0x28, 0x0a, 0x10, 0x62, 0x74, 0x63, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x77, 0x69, 0x74,
-	0x68, 0x64, 0x72, 0x61, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x62, 0x74, 0x63,
-	0x4d, 0x61, 0x78, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x22, 0x70, 0x0a, 0x0c, 0x50,
-	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x46, 0x65, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x64,
-	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x0a, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x46, 0x65, 0x65, 0x12, 0x21, 0x0a, 0x0c,
-	0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x0b, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x46, 0x65, 0x65, 0x12,
-	0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x22, 0xd4, 0x01,
-	0x0a, 0x09, 0x54, 0x53, 0x53, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x51, 0x0a, 0x12, 0x64,
-	0x6b, 0x67, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x98, 0xdf, 0x1f, 0x01, 0x52, 0x10, 0x64, 0x6b,
-	0x67, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x74,
-	0x0a, 0x24, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f, 0x75, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
-	0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44,
-	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x98, 0xdf, 0x1f,
-	0x01, 0x52, 0x21, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x65,
-	0x72, 0x69, 0x6f, 0x64, 0x2a, 0x67, 0x0a, 0x09, 0x41, 0x73, 0x73, 0x65, 0x74, 0x54, 0x79, 0x70,
-	0x65, 0x12, 0x1a, 0x0a, 0x16, 0x41, 0x53, 0x53, 0x45, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
-	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x12, 0x0a,
-	0x0e, 0x41, 0x53, 0x53, 0x45, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x42, 0x54, 0x43, 0x10,
-	0x01, 0x12, 0x14, 0x0a, 0x10, 0x41, 0x53, 0x53, 0x45, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
-	0x42, 0x52, 0x43, 0x32, 0x30, 0x10, 0x02, 0x12, 0x14, 0x0a, 0x10, 0x41, 0x53, 0x53, 0x45, 0x54,
-	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x52, 0x55, 0x4e, 0x45, 0x53, 0x10, 0x03, 0x42, 0x9b, 0x01,
-	0x0a, 0x12, 0x63, 0x6f, 0x6d, 0x2e, 0x73, 0x69, 0x64, 0x65, 0x2e, 0x62, 0x74, 0x63, 0x62, 0x72,
-	0x69, 0x64, 0x67, 0x65, 0x42, 0x0b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x50, 0x72, 0x6f, 0x74,
-	0x6f, 0x50, 0x01, 0x5a, 0x1f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69,
-	0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x73, 0x69, 0x64, 0x65, 0x2f, 0x62, 0x74, 0x63, 0x62, 0x72,
-	0x69, 0x64, 0x67, 0x65, 0xa2, 0x02, 0x03, 0x53, 0x42, 0x58, 0xaa, 0x02, 0x0e, 0x53, 0x69, 0x64,
-	0x65, 0x2e, 0x42, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0xca, 0x02, 0x0e, 0x53, 0x69,
-	0x64, 0x65, 0x5c, 0x42, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0xe2, 0x02, 0x1a, 0x53,
-	0x69, 0x64, 0x65, 0x5c, 0x42, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x5c, 0x47, 0x50,
-	0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0f, 0x53, 0x69, 0x64, 0x65,
-	0x3a, 0x3a, 0x42, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x67, 0x65, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x4c, 0x69, 0x6d, 0x69, 0x74,
+	0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
+	0x6c, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x47, 0x0a, 0x0d, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x6f, 0x6c, 0x5f, 0x66, 0x65, 0x65, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x73, 0x69, 0x64, 0x65, 0x2e, 0x62, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x46, 0x65, 0x65, 0x73, 0x42, 0x04, 0xc8, 0xde,
+	0x1f, 0x00, 0x52, 0x0c, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x46, 0x65, 0x65, 0x73,
+	0x12, 0x3e, 0x0a, 0x0a, 0x74, 0x73, 0x73, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x10,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x69, 0x64, 0x65, 0x2e, 0x62, 0x74, 0x63, 0x62,
+	0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x54, 0x53, 0x53, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42,
+	0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x09, 0x74, 0x73, 0x73, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x12, 0x43, 0x0a, 0x0c, 0x72, 0x75, 0x6e, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73,
+	0x18, 0x11, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x69, 0x64, 0x65, 0x2e, 0x62, 0x74,
+	0x63, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x52, 0x75, 0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0b, 0x72, 0x75, 0x6e, 0x65, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x73, 0x12, 0x57, 0x0a, 0x13, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61,
+	0x77, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x12, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x21, 0x2e, 0x73, 0x69, 0x64, 0x65, 0x2e, 0x62, 0x74, 0x63, 0x62, 0x72, 0x69,
+	0x64, 0x67, 0x65, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x61, 0x74, 0x65,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x11, 0x77, 0x69, 0x74,
+	0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1c,
+	0x0a, 0x09, 0x67, 0x75, 0x61, 0x72, 0x64, 0x69, 0x61, 0x6e, 0x73, 0x18, 0x13, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x09, 0x67, 0x75, 0x61, 0x72, 0x64, 0x69, 0x61, 0x6e, 0x73, 0x12, 0x51, 0x0a, 0x12,
+	0x6d, 0x61, 0x78, 0x5f, 0x70, 0x61, 0x75, 0x73, 0x65, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x98, 0xdf, 0x1f, 0x01, 0x52, 0x10, 0x6d,
+	0x61, 0x78, 0x50, 0x61, 0x75, 0x73, 0x65, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x5c, 0x0a, 0x14, 0x63, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e,
+	0x73, 0x69, 0x64, 0x65, 0x2e, 0x62, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x43,
+	0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x13, 0x63, 0x6f, 0x6e, 0x73, 0x6f, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x5d, 0x0a,
+	0x15, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x5f,
+	0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x73,
+	0x69, 0x64, 0x65, 0x2e, 0x62, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x56, 0x61,
+	0x75, 0x6c, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x13, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x65,
+	0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x63, 0x0a, 0x19,
+	0x76, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
+	0x5f, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x27, 0x2e, 0x73, 0x69, 0x64, 0x65, 0x2e, 0x62, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65,
+	0x2e, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
+	0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x52, 0x17, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x41,
+	0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67,
+	0x79, 0x22, 0xa2, 0x03, 0x0a, 0x05, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x75, 0x62, 0x5f, 0x6b, 0x65, 0x79,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x12, 0x38,
+	0x0a, 0x0a, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x19, 0x2e, 0x73, 0x69, 0x64, 0x65, 0x2e, 0x62, 0x74, 0x63, 0x62, 0x72, 0x69,
+	0x64, 0x67, 0x65, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x61,
+	0x73, 0x73, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x4c, 0x0a, 0x11, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
+	0x73, 0x69, 0x64, 0x65, 0x2e, 0x62, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x56,
+	0x61, 0x75, 0x6c, 0x74, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x10,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72,
+	0x12, 0x43, 0x0a, 0x0c, 0x74, 0x61, 0x70, 0x72, 0x6f, 0x6f, 0x74, 0x5f, 0x74, 0x72, 0x65, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x69, 0x64, 0x65, 0x2e, 0x62, 0x74,
+	0x63, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x54, 0x61, 0x70,
+	0x72, 0x6f, 0x6f, 0x74, 0x54, 0x72, 0x65, 0x65, 0x52, 0x0b, 0x74, 0x61, 0x70, 0x72, 0x6f, 0x6f,
+	0x74, 0x54, 0x72, 0x65, 0x65, 0x12, 0x4d, 0x0a, 0x0f, 0x72, 0x65, 0x74, 0x69, 0x72, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x01,
+	0x90, 0xdf, 0x1f, 0x01, 0x52, 0x0e, 0x72, 0x65, 0x74, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x73, 0x69, 0x64, 0x65, 0x2e, 0x62, 0x74, 0x63, 0x62, 0x72,
+	0x69, 0x64, 0x67, 0x65, 0x2e, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52,
+	0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x22, 0x3b, 0x0a, 0x10, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x54,
+	0x61, 0x70, 0x72, 0x6f, 0x6f, 0x74, 0x54, 0x72, 0x65, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65,
+	0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x53, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x22, 0x6a, 0x0a, 0x13, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x63, 0x6f,
+	0x76, 0x65, 0x72, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x75,
+	0x62, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x70, 0x75,
+	0x62, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f,
+	0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68,
+	0x6f, 0x6c, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x6c, 0x6f, 0x63, 0x6b, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x6c, 0x6f, 0x63, 0x6b, 0x22,
+	0x8c, 0x01, 0x0a, 0x0f, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x6f, 0x72, 0x12, 0x40, 0x0a, 0x0b, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x73, 0x69, 0x64, 0x65, 0x2e,
+	0x62, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x53,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0a, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f,
+	0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68,
+	0x6f, 0x6c, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x75, 0x62, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x70, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x73, 0x22, 0xf7,
+	0x01, 0x0a, 0x0e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x12, 0x20, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x75, 0x74, 0x78, 0x6f, 0x5f, 0x6e, 0x75,
+	0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x55, 0x74, 0x78, 0x6f,
+	0x4e, 0x75, 0x6d, 0x12, 0x39, 0x0a, 0x19, 0x62, 0x74, 0x63, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68,
+	0x5f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x16, 0x62, 0x74, 0x63, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x3a,
+	0x0a, 0x1a, 0x6d, 0x61, 0x78, 0x5f, 0x62, 0x74, 0x63, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f,
+	0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x16, 0x6d, 0x61, 0x78, 0x42, 0x74, 0x63, 0x42, 0x61, 0x74, 0x63, 0x68, 0x57,
+	0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x4e, 0x75, 0x6d, 0x12, 0x4c, 0x0a, 0x0e, 0x63, 0x6f,
+	0x69, 0x6e, 0x5f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x25, 0x2e, 0x73, 0x69, 0x64, 0x65, 0x2e, 0x62, 0x74, 0x63, 0x62, 0x72, 0x69,
+	0x64, 0x67, 0x65, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x52, 0x0d, 0x63, 0x6f, 0x69, 0x6e, 0x53,
+	0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xf2, 0x01, 0x0a, 0x11, 0x57, 0x69, 0x74,
+	0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x21, 0x0a, 0x0c, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c,
+	0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x67, 0x6c,
+	0x6f, 0x62, 0x61, 0x6c, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x6e, 0x0a, 0x0c, 0x61, 0x73, 0x73,
+	0x65, 0x74, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00,
+	0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b,
+	0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x0b, 0x61, 0x73,
+	0x73, 0x65, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x32, 0x0a, 0x15, 0x62, 0x72, 0x65,
+	0x61, 0x6b, 0x65, 0x72, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x5f, 0x62,
+	0x70, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x13, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x65,
+	0x72, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x42, 0x70, 0x73, 0x22, 0x8c, 0x01,
+	0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73,
+	0x12, 0x26, 0x0a, 0x0f, 0x62, 0x74, 0x63, 0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x65, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x62, 0x74, 0x63, 0x4d, 0x69,
+	0x6e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x62, 0x74, 0x63, 0x5f,
+	0x6d, 0x69, 0x6e, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0e, 0x62, 0x74, 0x63, 0x4d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72,
+	0x61, 0x77, 0x12, 0x28, 0x0a, 0x10, 0x62, 0x74, 0x63, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x77, 0x69,
+	0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x62, 0x74,
+	0x63, 0x4d, 0x61, 0x78, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x22, 0xca, 0x02, 0x0a,
+	0x0c, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x46, 0x65, 0x65, 0x73, 0x12, 0x1c, 0x0a,
+	0x09, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x53, 0x0a, 0x14, 0x64,
+	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x69, 0x64, 0x65,
+	0x2e, 0x62, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x46, 0x65, 0x65, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x12, 0x64, 0x65,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x46, 0x65, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x12, 0x55, 0x0a, 0x15, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x5f, 0x66, 0x65, 0x65,
+	0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x73, 0x69, 0x64, 0x65, 0x2e, 0x62, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65,
+	0x2e, 0x46, 0x65, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x42, 0x04, 0xc8, 0xde,
+	0x1f, 0x00, 0x52, 0x13, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x46, 0x65, 0x65, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x49, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
+	0x73, 0x69, 0x64, 0x65, 0x2e, 0x62, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x46,
+	0x65, 0x65, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x04,
+	0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0c, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x4a, 0x04, 0x08, 0x01, 0x10, 0x02, 0x4a, 0x04, 0x08, 0x02, 0x10, 0x03, 0x52, 0x0b,
+	0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x66, 0x65, 0x65, 0x52, 0x0c, 0x77, 0x69, 0x74,
+	0x68, 0x64, 0x72, 0x61, 0x77, 0x5f, 0x66, 0x65, 0x65, 0x22, 0xe4, 0x01, 0x0a, 0x0f, 0x46, 0x65,
+	0x65, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a,
+	0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x65, 0x70,
+	0x6f, 0x63, 0x68, 0x12, 0x32, 0x0a, 0x15, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79,
+	0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x13, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x50, 0x6f, 0x6f,
+	0x6c, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x2e, 0x0a, 0x13, 0x72, 0x65, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x72, 0x65, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x50, 0x6f, 0x6f,
+	0x6c, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x69, 0x67, 0x6e, 0x65,
+	0x72, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x10, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x50, 0x6f, 0x6f, 0x6c, 0x57,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x69, 0x6e, 0x73, 0x75, 0x72, 0x61, 0x6e,
+	0x63, 0x65, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0f, 0x69, 0x6e, 0x73, 0x75, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x22, 0x86, 0x01, 0x0a, 0x0b, 0x46, 0x65, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x12, 0x10, 0x0a, 0x03, 0x62, 0x70, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x62,
+	0x70, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x6d, 0x69, 0x6e, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x69, 0x6e, 0x46, 0x65, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x6d,
+	0x61, 0x78, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x61,
+	0x78, 0x46, 0x65, 0x65, 0x12, 0x33, 0x0a, 0x05, 0x74, 0x69, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x69, 0x64, 0x65, 0x2e, 0x62, 0x74, 0x63, 0x62, 0x72,
+	0x69, 0x64, 0x67, 0x65, 0x2e, 0x46, 0x65, 0x65, 0x54, 0x69, 0x65, 0x72, 0x42, 0x04, 0xc8, 0xde,
+	0x1f, 0x00, 0x52, 0x05, 0x74, 0x69, 0x65, 0x72, 0x73, 0x22, 0x3a, 0x0a, 0x07, 0x46, 0x65, 0x65,
+	0x54, 0x69, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x69, 0x6e, 0x5f, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x69, 0x6e, 0x41, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x62, 0x70, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x03, 0x62, 0x70, 0x73, 0x22, 0xf9, 0x01, 0x0a, 0x0a, 0x52, 0x75, 0x6e, 0x65, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x1f,
+	0x0a, 0x0b, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x69, 0x6e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12,
+	0x21, 0x0a, 0x0c, 0x6d, 0x69, 0x6e, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72,
+	0x61, 0x77, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72,
+	0x61, 0x77, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x57, 0x69, 0x74,
+	0x68, 0x64, 0x72, 0x61, 0x77, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x5f,
+	0x63, 0x61, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x75, 0x70, 0x70, 0x6c,
+	0x79, 0x43, 0x61, 0x70, 0x12, 0x3b, 0x0a, 0x0c, 0x66, 0x65, 0x65, 0x5f, 0x6f, 0x76, 0x65, 0x72,
+	0x72, 0x69, 0x64, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x69, 0x64,
+	0x65, 0x2e, 0x62, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x52, 0x75, 0x6e, 0x65,
+	0x46, 0x65, 0x65, 0x73, 0x52, 0x0b, 0x66, 0x65, 0x65, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64,
+	0x65, 0x22, 0xb6, 0x01, 0x0a, 0x08, 0x52, 0x75, 0x6e, 0x65, 0x46, 0x65, 0x65, 0x73, 0x12, 0x53,
+	0x0a, 0x14, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x73, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73,
+	0x69, 0x64, 0x65, 0x2e, 0x62, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x46, 0x65,
+	0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52,
+	0x12, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x46, 0x65, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x12, 0x55, 0x0a, 0x15, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x5f,
+	0x66, 0x65, 0x65, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x69, 0x64, 0x65, 0x2e, 0x62, 0x74, 0x63, 0x62, 0x72, 0x69,
+	0x64, 0x67, 0x65, 0x2e, 0x46, 0x65, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x42,
+	0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x13, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x46,
+	0x65, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x22, 0xeb, 0x03, 0x0a, 0x09, 0x54,
+	0x53, 0x53, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x51, 0x0a, 0x12, 0x64, 0x6b, 0x67, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42,
+	0x08, 0xc8, 0xde, 0x1f, 0x00, 0x98, 0xdf, 0x1f, 0x01, 0x52, 0x10, 0x64, 0x6b, 0x67, 0x54, 0x69,
+	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x74, 0x0a, 0x24, 0x70,
+	0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x65, 0x72,
+	0x69, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x98, 0xdf, 0x1f, 0x01, 0x52, 0x21,
+	0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x69, 0x6f,
+	0x64, 0x12, 0x26, 0x0a, 0x0f, 0x64, 0x6b, 0x67, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x74,
+	0x72, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x64, 0x6b, 0x67, 0x4d,
+	0x61, 0x78, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x32, 0x0a, 0x15, 0x61, 0x75, 0x74,
+	0x6f, 0x5f, 0x72, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c,
+	0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x61, 0x75, 0x74, 0x6f, 0x52, 0x6f,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x29, 0x0a,
+	0x10, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74,
+	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x6d, 0x61, 0x78, 0x50, 0x61, 0x72, 0x74,
+	0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x39, 0x0a, 0x19, 0x73, 0x74, 0x61, 0x6b,
+	0x65, 0x5f, 0x73, 0x68, 0x69, 0x66, 0x74, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c,
+	0x64, 0x5f, 0x62, 0x70, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x16, 0x73, 0x74, 0x61,
+	0x6b, 0x65, 0x53, 0x68, 0x69, 0x66, 0x74, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64,
+	0x42, 0x70, 0x73, 0x12, 0x53, 0x0a, 0x0f, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x5f, 0x6c, 0x69,
+	0x76, 0x65, 0x6e, 0x65, 0x73, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73,
+	0x69, 0x64, 0x65, 0x2e, 0x62, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x53, 0x69,
+	0x67, 0x6e, 0x65, 0x72, 0x4c, 0x69, 0x76, 0x65, 0x6e, 0x65, 0x73, 0x73, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0e, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72,
+	0x4c, 0x69, 0x76, 0x65, 0x6e, 0x65, 0x73, 0x73, 0x22, 0xd7, 0x02, 0x0a, 0x14, 0x53, 0x69, 0x67,
+	0x6e, 0x65, 0x72, 0x4c, 0x69, 0x76, 0x65, 0x6e, 0x65, 0x73, 0x73, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x26, 0x0a, 0x0f, 0x6d, 0x61, 0x78,
+	0x5f, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x5f, 0x64, 0x6b, 0x67, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0d, 0x6d, 0x61, 0x78, 0x4d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x44, 0x6b, 0x67,
+	0x73, 0x12, 0x2e, 0x0a, 0x13, 0x6d, 0x61, 0x78, 0x5f, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x5f,
+	0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11,
+	0x6d, 0x61, 0x78, 0x4d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67,
+	0x73, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x5f, 0x66, 0x72, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x70, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x73,
+	0x6c, 0x61, 0x73, 0x68, 0x46, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x70, 0x73, 0x12,
+	0x48, 0x0a, 0x0d, 0x6a, 0x61, 0x69, 0x6c, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x98, 0xdf, 0x1f, 0x01, 0x52, 0x0c, 0x6a, 0x61, 0x69,
+	0x6c, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x57, 0x0a, 0x15, 0x73, 0x69, 0x67,
+	0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f,
+	0x75, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x98, 0xdf, 0x1f, 0x01, 0x52, 0x13, 0x73,
+	0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x6f,
+	0x75, 0x74, 0x22, 0xa3, 0x02, 0x0a, 0x13, 0x43, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x28, 0x0a, 0x10, 0x75, 0x74,
+	0x78, 0x6f, 0x5f, 0x6e, 0x75, 0x6d, 0x5f, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x75, 0x74, 0x78, 0x6f, 0x4e, 0x75, 0x6d, 0x54, 0x72, 0x69,
+	0x67, 0x67, 0x65, 0x72, 0x12, 0x20, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x66, 0x65, 0x65, 0x5f,
+	0x72, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x46,
+	0x65, 0x65, 0x52, 0x61, 0x74, 0x65, 0x12, 0x30, 0x0a, 0x14, 0x62, 0x74, 0x63, 0x5f, 0x74, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x62, 0x74, 0x63, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x54,
+	0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x59, 0x0a, 0x0f, 0x72, 0x75, 0x6e, 0x65,
+	0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x2a, 0x2e, 0x73, 0x69, 0x64, 0x65, 0x2e, 0x62, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64,
+	0x67, 0x65, 0x2e, 0x52, 0x75, 0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x42, 0x04, 0xc8,
+	0xde, 0x1f, 0x00, 0x52, 0x0e, 0x72, 0x75, 0x6e, 0x65, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f,
+	0x6c, 0x64, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x6d, 0x61, 0x78, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6d, 0x61, 0x78, 0x4e, 0x75, 0x6d, 0x12, 0x1a, 0x0a, 0x08,
+	0x63, 0x6f, 0x6f, 0x6c, 0x64, 0x6f, 0x77, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
+	0x63, 0x6f, 0x6f, 0x6c, 0x64, 0x6f, 0x77, 0x6e, 0x22, 0x60, 0x0a, 0x1a, 0x52, 0x75, 0x6e, 0x65,
+	0x43, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x68, 0x72,
+	0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x75, 0x6e, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x75, 0x6e, 0x65, 0x49, 0x64, 0x12,
+	0x29, 0x0a, 0x10, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68,
+	0x6f, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x74, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x2a, 0x6e, 0x0a, 0x17, 0x56, 0x61,
+	0x75, 0x6c, 0x74, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x72,
+	0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x29, 0x0a, 0x25, 0x56, 0x41, 0x55, 0x4c, 0x54, 0x5f, 0x41,
+	0x53, 0x53, 0x49, 0x47, 0x4e, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x52, 0x41, 0x54, 0x45,
+	0x47, 0x59, 0x5f, 0x52, 0x4f, 0x55, 0x4e, 0x44, 0x5f, 0x52, 0x4f, 0x42, 0x49, 0x4e, 0x10, 0x00,
+	0x12, 0x28, 0x0a, 0x24, 0x56, 0x41, 0x55, 0x4c, 0x54, 0x5f, 0x41, 0x53, 0x53, 0x49, 0x47, 0x4e,
+	0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x52, 0x41, 0x54, 0x45, 0x47, 0x59, 0x5f, 0x4c, 0x45,
+	0x41, 0x53, 0x54, 0x5f, 0x55, 0x54, 0x58, 0x4f, 0x10, 0x01, 0x2a, 0x67, 0x0a, 0x09, 0x41, 0x73,
+	0x73, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x16, 0x41, 0x53, 0x53, 0x45, 0x54,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x41, 0x53, 0x53, 0x45, 0x54, 0x5f, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x42, 0x54, 0x43, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x41, 0x53, 0x53, 0x45, 0x54,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x42, 0x52, 0x43, 0x32, 0x30, 0x10, 0x02, 0x12, 0x14, 0x0a,
+	0x10, 0x41, 0x53, 0x53, 0x45, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x52, 0x55, 0x4e, 0x45,
+	0x53, 0x10, 0x03, 0x2a, 0x59, 0x0a, 0x0a, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x12, 0x16, 0x0a, 0x12, 0x56, 0x41, 0x55, 0x4c, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45,
+	0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x56, 0x41, 0x55,
+	0x4c, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x44, 0x45, 0x50, 0x52, 0x45, 0x43, 0x41,
+	0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x56, 0x41, 0x55, 0x4c, 0x54, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x45, 0x5f, 0x52, 0x45, 0x54, 0x49, 0x52, 0x45, 0x44, 0x10, 0x02, 0x2a, 0x85,
+	0x01, 0x0a, 0x0f, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x21, 0x0a, 0x1d, 0x56, 0x41, 0x55, 0x4c, 0x54, 0x5f, 0x53, 0x43, 0x52, 0x49,
+	0x50, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
+	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x24, 0x0a, 0x20, 0x56, 0x41, 0x55, 0x4c, 0x54, 0x5f, 0x53,
+	0x43, 0x52, 0x49, 0x50, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x32, 0x57, 0x53, 0x48,
+	0x5f, 0x4d, 0x55, 0x4c, 0x54, 0x49, 0x53, 0x49, 0x47, 0x10, 0x01, 0x12, 0x29, 0x0a, 0x25, 0x56,
+	0x41, 0x55, 0x4c, 0x54, 0x5f, 0x53, 0x43, 0x52, 0x49, 0x50, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x50, 0x32, 0x53, 0x48, 0x5f, 0x50, 0x32, 0x57, 0x53, 0x48, 0x5f, 0x4d, 0x55, 0x4c, 0x54,
+	0x49, 0x53, 0x49, 0x47, 0x10, 0x02, 0x2a, 0xba, 0x01, 0x0a, 0x15, 0x43, 0x6f, 0x69, 0x6e, 0x53,
+	0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79,
+	0x12, 0x23, 0x0a, 0x1f, 0x43, 0x4f, 0x49, 0x4e, 0x5f, 0x53, 0x45, 0x4c, 0x45, 0x43, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x52, 0x41, 0x54, 0x45, 0x47, 0x59, 0x5f, 0x44, 0x45, 0x46, 0x41,
+	0x55, 0x4c, 0x54, 0x10, 0x00, 0x12, 0x29, 0x0a, 0x25, 0x43, 0x4f, 0x49, 0x4e, 0x5f, 0x53, 0x45,
+	0x4c, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x52, 0x41, 0x54, 0x45, 0x47, 0x59,
+	0x5f, 0x4c, 0x41, 0x52, 0x47, 0x45, 0x53, 0x54, 0x5f, 0x46, 0x49, 0x52, 0x53, 0x54, 0x10, 0x01,
+	0x12, 0x2c, 0x0a, 0x28, 0x43, 0x4f, 0x49, 0x4e, 0x5f, 0x53, 0x45, 0x4c, 0x45, 0x43, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x52, 0x41, 0x54, 0x45, 0x47, 0x59, 0x5f, 0x42, 0x52, 0x41, 0x4e,
+	0x43, 0x48, 0x5f, 0x41, 0x4e, 0x44, 0x5f, 0x42, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x02, 0x12, 0x23,
+	0x0a, 0x1f, 0x43, 0x4f, 0x49, 0x4e, 0x5f, 0x53, 0x45, 0x4c, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x53, 0x54, 0x52, 0x41, 0x54, 0x45, 0x47, 0x59, 0x5f, 0x50, 0x52, 0x49, 0x56, 0x41, 0x43,
+	0x59, 0x10, 0x03, 0x42, 0x9b, 0x01, 0x0a, 0x12, 0x63, 0x6f, 0x6d, 0x2e, 0x73, 0x69, 0x64, 0x65,
+	0x2e, 0x62, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x42, 0x0b, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x1f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x73, 0x69, 0x64, 0x65,
+	0x2f, 0x62, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0xa2, 0x02, 0x03, 0x53, 0x42, 0x58,
+	0xaa, 0x02, 0x0e, 0x53, 0x69, 0x64, 0x65, 0x2e, 0x42, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64, 0x67,
+	0x65, 0xca, 0x02, 0x0e, 0x53, 0x69, 0x64, 0x65, 0x5c, 0x42, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64,
+	0x67, 0x65, 0xe2, 0x02, 0x1a, 0x53, 0x69, 0x64, 0x65, 0x5c, 0x42, 0x74, 0x63, 0x62, 0x72, 0x69,
+	0x64, 0x67, 0x65, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea,
+	0x02, 0x0f, 0x53, 0x69, 0x64, 0x65, 0x3a, 0x3a, 0x42, 0x74, 0x63, 0x62, 0x72, 0x69, 0x64, 0x67,
+	0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -4887,32 +14853,74 @@ func file_side_btcbridge_params_proto_rawDescGZIP() []byte {
 	return file_side_btcbridge_params_proto_rawDescData
 }
 
-var file_side_btcbridge_params_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_side_btcbridge_params_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_side_btcbridge_params_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_side_btcbridge_params_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
 var file_side_btcbridge_params_proto_goTypes = []interface{}{
-	(AssetType)(0),              // 0: side.btcbridge.AssetType
-	(*Params)(nil),              // 1: side.btcbridge.Params
-	(*Vault)(nil),               // 2: side.btcbridge.Vault
-	(*WithdrawParams)(nil),      // 3: side.btcbridge.WithdrawParams
-	(*ProtocolLimits)(nil),      // 4: side.btcbridge.ProtocolLimits
-	(*ProtocolFees)(nil),        // 5: side.btcbridge.ProtocolFees
-	(*TSSParams)(nil),           // 6: side.btcbridge.TSSParams
-	(*durationpb.Duration)(nil), // 7: google.protobuf.Duration
+	(VaultAssignmentStrategy)(0),       // 0: side.btcbridge.VaultAssignmentStrategy
+	(AssetType)(0),                     // 1: side.btcbridge.AssetType
+	(VaultState)(0),                    // 2: side.btcbridge.VaultState
+	(VaultScriptType)(0),               // 3: side.btcbridge.VaultScriptType
+	(CoinSelectionStrategy)(0),         // 4: side.btcbridge.CoinSelectionStrategy
+	(*Params)(nil),                     // 5: side.btcbridge.Params
+	(*Vault)(nil),                      // 6: side.btcbridge.Vault
+	(*VaultTaprootTree)(nil),           // 7: side.btcbridge.VaultTaprootTree
+	(*VaultRecoveryPolicy)(nil),        // 8: side.btcbridge.VaultRecoveryPolicy
+	(*VaultDescriptor)(nil),            // 9: side.btcbridge.VaultDescriptor
+	(*WithdrawParams)(nil),             // 10: side.btcbridge.WithdrawParams
+	(*WithdrawRateLimit)(nil),          // 11: side.btcbridge.WithdrawRateLimit
+	(*ProtocolLimits)(nil),             // 12: side.btcbridge.ProtocolLimits
+	(*ProtocolFees)(nil),               // 13: side.btcbridge.ProtocolFees
+	(*FeeDistribution)(nil),            // 14: side.btcbridge.FeeDistribution
+	(*FeeSchedule)(nil),                // 15: side.btcbridge.FeeSchedule
+	(*FeeTier)(nil),                    // 16: side.btcbridge.FeeTier
+	(*RuneConfig)(nil),                 // 17: side.btcbridge.RuneConfig
+	(*RuneFees)(nil),                   // 18: side.btcbridge.RuneFees
+	(*TSSParams)(nil),                  // 19: side.btcbridge.TSSParams
+	(*SignerLivenessParams)(nil),       // 20: side.btcbridge.SignerLivenessParams
+	(*ConsolidationPolicy)(nil),        // 21: side.btcbridge.ConsolidationPolicy
+	(*RuneConsolidationThreshold)(nil), // 22: side.btcbridge.RuneConsolidationThreshold
+	(*durationpb.Duration)(nil),        // 23: google.protobuf.Duration
+	(*timestamppb.Timestamp)(nil),      // 24: google.protobuf.Timestamp
+	(*v1beta1.Coin)(nil),               // 25: cosmos.base.v1beta1.Coin
 }
 var file_side_btcbridge_params_proto_depIdxs = []int32{
-	2, // 0: side.btcbridge.Params.vaults:type_name -> side.btcbridge.Vault
-	3, // 1: side.btcbridge.Params.withdraw_params:type_name -> side.btcbridge.WithdrawParams
-	4, // 2: side.btcbridge.Params.protocol_limits:type_name -> side.btcbridge.ProtocolLimits
-	5, // 3: side.btcbridge.Params.protocol_fees:type_name -> side.btcbridge.ProtocolFees
-	6, // 4: side.btcbridge.Params.tss_params:type_name -> side.btcbridge.TSSParams
-	0, // 5: side.btcbridge.Vault.asset_type:type_name -> side.btcbridge.AssetType
-	7, // 6: side.btcbridge.TSSParams.dkg_timeout_period:type_name -> google.protobuf.Duration
-	7, // 7: side.btcbridge.TSSParams.participant_update_transition_period:type_name -> google.protobuf.Duration
-	8, // [8:8] is the sub-list for method output_type
-	8, // [8:8] is the sub-list for method input_type
-	8, // [8:8] is the sub-list for extension type_name
-	8, // [8:8] is the sub-list for extension extendee
-	0, // [0:8] is the sub-list for field type_name
+	6,  // 0: side.btcbridge.Params.vaults:type_name -> side.btcbridge.Vault
+	10, // 1: side.btcbridge.Params.withdraw_params:type_name -> side.btcbridge.WithdrawParams
+	12, // 2: side.btcbridge.Params.protocol_limits:type_name -> side.btcbridge.ProtocolLimits
+	13, // 3: side.btcbridge.Params.protocol_fees:type_name -> side.btcbridge.ProtocolFees
+	19, // 4: side.btcbridge.Params.tss_params:type_name -> side.btcbridge.TSSParams
+	17, // 5: side.btcbridge.Params.rune_configs:type_name -> side.btcbridge.RuneConfig
+	11, // 6: side.btcbridge.Params.withdraw_rate_limit:type_name -> side.btcbridge.WithdrawRateLimit
+	23, // 7: side.btcbridge.Params.max_pause_duration:type_name -> google.protobuf.Duration
+	21, // 8: side.btcbridge.Params.consolidation_policy:type_name -> side.btcbridge.ConsolidationPolicy
+	8,  // 9: side.btcbridge.Params.vault_recovery_policy:type_name -> side.btcbridge.VaultRecoveryPolicy
+	0,  // 10: side.btcbridge.Params.vault_assignment_strategy:type_name -> side.btcbridge.VaultAssignmentStrategy
+	1,  // 11: side.btcbridge.Vault.asset_type:type_name -> side.btcbridge.AssetType
+	9,  // 12: side.btcbridge.Vault.script_descriptor:type_name -> side.btcbridge.VaultDescriptor
+	7,  // 13: side.btcbridge.Vault.taproot_tree:type_name -> side.btcbridge.VaultTaprootTree
+	24, // 14: side.btcbridge.Vault.retirement_time:type_name -> google.protobuf.Timestamp
+	2,  // 15: side.btcbridge.Vault.state:type_name -> side.btcbridge.VaultState
+	3,  // 16: side.btcbridge.VaultDescriptor.script_type:type_name -> side.btcbridge.VaultScriptType
+	4,  // 17: side.btcbridge.WithdrawParams.coin_selection:type_name -> side.btcbridge.CoinSelectionStrategy
+	25, // 18: side.btcbridge.WithdrawRateLimit.asset_limits:type_name -> cosmos.base.v1beta1.Coin
+	15, // 19: side.btcbridge.ProtocolFees.deposit_fee_schedule:type_name -> side.btcbridge.FeeSchedule
+	15, // 20: side.btcbridge.ProtocolFees.withdraw_fee_schedule:type_name -> side.btcbridge.FeeSchedule
+	14, // 21: side.btcbridge.ProtocolFees.distribution:type_name -> side.btcbridge.FeeDistribution
+	16, // 22: side.btcbridge.FeeSchedule.tiers:type_name -> side.btcbridge.FeeTier
+	18, // 23: side.btcbridge.RuneConfig.fee_override:type_name -> side.btcbridge.RuneFees
+	15, // 24: side.btcbridge.RuneFees.deposit_fee_schedule:type_name -> side.btcbridge.FeeSchedule
+	15, // 25: side.btcbridge.RuneFees.withdraw_fee_schedule:type_name -> side.btcbridge.FeeSchedule
+	23, // 26: side.btcbridge.TSSParams.dkg_timeout_period:type_name -> google.protobuf.Duration
+	23, // 27: side.btcbridge.TSSParams.participant_update_transition_period:type_name -> google.protobuf.Duration
+	20, // 28: side.btcbridge.TSSParams.signer_liveness:type_name -> side.btcbridge.SignerLivenessParams
+	23, // 29: side.btcbridge.SignerLivenessParams.jail_duration:type_name -> google.protobuf.Duration
+	23, // 30: side.btcbridge.SignerLivenessParams.signing_round_timeout:type_name -> google.protobuf.Duration
+	22, // 31: side.btcbridge.ConsolidationPolicy.rune_thresholds:type_name -> side.btcbridge.RuneConsolidationThreshold
+	32, // [32:32] is the sub-list for method output_type
+	32, // [32:32] is the sub-list for method input_type
+	32, // [32:32] is the sub-list for extension type_name
+	32, // [32:32] is the sub-list for extension extendee
+	0,  // [0:32] is the sub-list for field type_name
 }
 
 func init() { file_side_btcbridge_params_proto_init() }
@@ -4946,7 +14954,7 @@ func file_side_btcbridge_params_proto_init() {
 			}
 		}
 		file_side_btcbridge_params_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WithdrawParams); i {
+			switch v := v.(*VaultTaprootTree); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4958,7 +14966,7 @@ func file_side_btcbridge_params_proto_init() {
 			}
 		}
 		file_side_btcbridge_params_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtocolLimits); i {
+			switch v := v.(*VaultRecoveryPolicy); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4970,7 +14978,7 @@ func file_side_btcbridge_params_proto_init() {
 			}
 		}
 		file_side_btcbridge_params_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtocolFees); i {
+			switch v := v.(*VaultDescriptor); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4982,6 +14990,114 @@ func file_side_btcbridge_params_proto_init() {
 			}
 		}
 		file_side_btcbridge_params_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WithdrawParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_side_btcbridge_params_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WithdrawRateLimit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_side_btcbridge_params_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtocolLimits); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_side_btcbridge_params_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtocolFees); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_side_btcbridge_params_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FeeDistribution); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_side_btcbridge_params_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FeeSchedule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_side_btcbridge_params_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FeeTier); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_side_btcbridge_params_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RuneConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_side_btcbridge_params_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RuneFees); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_side_btcbridge_params_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TSSParams); i {
 			case 0:
 				return &v.state
@@ -4993,14 +15109,50 @@ func file_side_btcbridge_params_proto_init() {
 				return nil
 			}
 		}
+		file_side_btcbridge_params_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignerLivenessParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_side_btcbridge_params_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConsolidationPolicy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_side_btcbridge_params_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RuneConsolidationThreshold); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_side_btcbridge_params_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   6,
+			NumEnums:      5,
+			NumMessages:   18,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -39,6 +39,20 @@ const (
 	Query_QueryDKGRequests_FullMethodName                   = "/side.btcbridge.Query/QueryDKGRequests"
 	Query_QueryAllDKGRequests_FullMethodName                = "/side.btcbridge.Query/QueryAllDKGRequests"
 	Query_QueryDKGCompletionRequests_FullMethodName         = "/side.btcbridge.Query/QueryDKGCompletionRequests"
+	Query_QueryRunes_FullMethodName                         = "/side.btcbridge.Query/QueryRunes"
+	Query_QueryRune_FullMethodName                          = "/side.btcbridge.Query/QueryRune"
+	Query_QueryProtocolFees_FullMethodName                  = "/side.btcbridge.Query/QueryProtocolFees"
+	Query_QueryFeeDistribution_FullMethodName               = "/side.btcbridge.Query/QueryFeeDistribution"
+	Query_QueryRuneConfigs_FullMethodName                   = "/side.btcbridge.Query/QueryRuneConfigs"
+	Query_QueryOutflowUsage_FullMethodName                  = "/side.btcbridge.Query/QueryOutflowUsage"
+	Query_QueryEmergencyPauses_FullMethodName               = "/side.btcbridge.Query/QueryEmergencyPauses"
+	Query_QueryDepositAddress_FullMethodName                = "/side.btcbridge.Query/QueryDepositAddress"
+	Query_QueryFrostSigningSession_FullMethodName           = "/side.btcbridge.Query/QueryFrostSigningSession"
+	Query_QueryFrostVaultKey_FullMethodName                 = "/side.btcbridge.Query/QueryFrostVaultKey"
+	Query_QuerySignerPerformance_FullMethodName             = "/side.btcbridge.Query/QuerySignerPerformance"
+	Query_QuerySignerPerformances_FullMethodName            = "/side.btcbridge.Query/QuerySignerPerformances"
+	Query_QueryVaultTransfer_FullMethodName                 = "/side.btcbridge.Query/QueryVaultTransfer"
+	Query_QueryVaultTransfers_FullMethodName                = "/side.btcbridge.Query/QueryVaultTransfers"
 )
 
 // QueryClient is the client API for Query service.
@@ -85,6 +99,34 @@ type QueryClient interface {
 	QueryAllDKGRequests(ctx context.Context, in *QueryAllDKGRequestsRequest, opts ...grpc.CallOption) (*QueryAllDKGRequestsResponse, error)
 	// QueryDKGCompletionRequests queries DKG completion requests by the given id.
 	QueryDKGCompletionRequests(ctx context.Context, in *QueryDKGCompletionRequestsRequest, opts ...grpc.CallOption) (*QueryDKGCompletionRequestsResponse, error)
+	// QueryRunes queries all registered runes.
+	QueryRunes(ctx context.Context, in *QueryRunesRequest, opts ...grpc.CallOption) (*QueryRunesResponse, error)
+	// QueryRune queries the registered rune by the given id.
+	QueryRune(ctx context.Context, in *QueryRuneRequest, opts ...grpc.CallOption) (*QueryRuneResponse, error)
+	// QueryProtocolFees queries the protocol fees quoted for the given amount.
+	QueryProtocolFees(ctx context.Context, in *QueryProtocolFeesRequest, opts ...grpc.CallOption) (*QueryProtocolFeesResponse, error)
+	// QueryFeeDistribution queries the accumulated and distributed protocol fees of each bucket.
+	QueryFeeDistribution(ctx context.Context, in *QueryFeeDistributionRequest, opts ...grpc.CallOption) (*QueryFeeDistributionResponse, error)
+	// QueryRuneConfigs queries the configurations and voucher supplies of the configured runes.
+	QueryRuneConfigs(ctx context.Context, in *QueryRuneConfigsRequest, opts ...grpc.CallOption) (*QueryRuneConfigsResponse, error)
+	// QueryOutflowUsage queries the withdrawal outflow of the current rate limit window.
+	QueryOutflowUsage(ctx context.Context, in *QueryOutflowUsageRequest, opts ...grpc.CallOption) (*QueryOutflowUsageResponse, error)
+	// QueryEmergencyPauses queries the emergency pauses.
+	QueryEmergencyPauses(ctx context.Context, in *QueryEmergencyPausesRequest, opts ...grpc.CallOption) (*QueryEmergencyPausesResponse, error)
+	// QueryDepositAddress queries the deposit address assigned among the active vaults of the given asset type.
+	QueryDepositAddress(ctx context.Context, in *QueryDepositAddressRequest, opts ...grpc.CallOption) (*QueryDepositAddressResponse, error)
+	// QueryFrostSigningSession queries the FROST signing session of the given signing request.
+	QueryFrostSigningSession(ctx context.Context, in *QueryFrostSigningSessionRequest, opts ...grpc.CallOption) (*QueryFrostSigningSessionResponse, error)
+	// QueryFrostVaultKey queries the FROST key of the given vault.
+	QueryFrostVaultKey(ctx context.Context, in *QueryFrostVaultKeyRequest, opts ...grpc.CallOption) (*QueryFrostVaultKeyResponse, error)
+	// QuerySignerPerformance queries the liveness performance of the given TSS participant.
+	QuerySignerPerformance(ctx context.Context, in *QuerySignerPerformanceRequest, opts ...grpc.CallOption) (*QuerySignerPerformanceResponse, error)
+	// QuerySignerPerformances queries the liveness performances of all the TSS participants.
+	QuerySignerPerformances(ctx context.Context, in *QuerySignerPerformancesRequest, opts ...grpc.CallOption) (*QuerySignerPerformancesResponse, error)
+	// QueryVaultTransfer queries the vault transfer from the given source version.
+	QueryVaultTransfer(ctx context.Context, in *QueryVaultTransferRequest, opts ...grpc.CallOption) (*QueryVaultTransferResponse, error)
+	// QueryVaultTransfers queries all vault transfers.
+	QueryVaultTransfers(ctx context.Context, in *QueryVaultTransfersRequest, opts ...grpc.CallOption) (*QueryVaultTransfersResponse, error)
 }
 
 type queryClient struct {
@@ -275,6 +317,132 @@ func (c *queryClient) QueryDKGCompletionRequests(ctx context.Context, in *QueryD
 	return out, nil
 }
 
+func (c *queryClient) QueryRunes(ctx context.Context, in *QueryRunesRequest, opts ...grpc.CallOption) (*QueryRunesResponse, error) {
+	out := new(QueryRunesResponse)
+	err := c.cc.Invoke(ctx, Query_QueryRunes_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryRune(ctx context.Context, in *QueryRuneRequest, opts ...grpc.CallOption) (*QueryRuneResponse, error) {
+	out := new(QueryRuneResponse)
+	err := c.cc.Invoke(ctx, Query_QueryRune_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryProtocolFees(ctx context.Context, in *QueryProtocolFeesRequest, opts ...grpc.CallOption) (*QueryProtocolFeesResponse, error) {
+	out := new(QueryProtocolFeesResponse)
+	err := c.cc.Invoke(ctx, Query_QueryProtocolFees_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryFeeDistribution(ctx context.Context, in *QueryFeeDistributionRequest, opts ...grpc.CallOption) (*QueryFeeDistributionResponse, error) {
+	out := new(QueryFeeDistributionResponse)
+	err := c.cc.Invoke(ctx, Query_QueryFeeDistribution_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryRuneConfigs(ctx context.Context, in *QueryRuneConfigsRequest, opts ...grpc.CallOption) (*QueryRuneConfigsResponse, error) {
+	out := new(QueryRuneConfigsResponse)
+	err := c.cc.Invoke(ctx, Query_QueryRuneConfigs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryOutflowUsage(ctx context.Context, in *QueryOutflowUsageRequest, opts ...grpc.CallOption) (*QueryOutflowUsageResponse, error) {
+	out := new(QueryOutflowUsageResponse)
+	err := c.cc.Invoke(ctx, Query_QueryOutflowUsage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryEmergencyPauses(ctx context.Context, in *QueryEmergencyPausesRequest, opts ...grpc.CallOption) (*QueryEmergencyPausesResponse, error) {
+	out := new(QueryEmergencyPausesResponse)
+	err := c.cc.Invoke(ctx, Query_QueryEmergencyPauses_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryDepositAddress(ctx context.Context, in *QueryDepositAddressRequest, opts ...grpc.CallOption) (*QueryDepositAddressResponse, error) {
+	out := new(QueryDepositAddressResponse)
+	err := c.cc.Invoke(ctx, Query_QueryDepositAddress_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryFrostSigningSession(ctx context.Context, in *QueryFrostSigningSessionRequest, opts ...grpc.CallOption) (*QueryFrostSigningSessionResponse, error) {
+	out := new(QueryFrostSigningSessionResponse)
+	err := c.cc.Invoke(ctx, Query_QueryFrostSigningSession_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryFrostVaultKey(ctx context.Context, in *QueryFrostVaultKeyRequest, opts ...grpc.CallOption) (*QueryFrostVaultKeyResponse, error) {
+	out := new(QueryFrostVaultKeyResponse)
+	err := c.cc.Invoke(ctx, Query_QueryFrostVaultKey_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QuerySignerPerformance(ctx context.Context, in *QuerySignerPerformanceRequest, opts ...grpc.CallOption) (*QuerySignerPerformanceResponse, error) {
+	out := new(QuerySignerPerformanceResponse)
+	err := c.cc.Invoke(ctx, Query_QuerySignerPerformance_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QuerySignerPerformances(ctx context.Context, in *QuerySignerPerformancesRequest, opts ...grpc.CallOption) (*QuerySignerPerformancesResponse, error) {
+	out := new(QuerySignerPerformancesResponse)
+	err := c.cc.Invoke(ctx, Query_QuerySignerPerformances_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryVaultTransfer(ctx context.Context, in *QueryVaultTransferRequest, opts ...grpc.CallOption) (*QueryVaultTransferResponse, error) {
+	out := new(QueryVaultTransferResponse)
+	err := c.cc.Invoke(ctx, Query_QueryVaultTransfer_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryVaultTransfers(ctx context.Context, in *QueryVaultTransfersRequest, opts ...grpc.CallOption) (*QueryVaultTransfersResponse, error) {
+	out := new(QueryVaultTransfersResponse)
+	err := c.cc.Invoke(ctx, Query_QueryVaultTransfers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -319,6 +487,34 @@ type QueryServer interface {
 	QueryAllDKGRequests(context.Context, *QueryAllDKGRequestsRequest) (*QueryAllDKGRequestsResponse, error)
 	// QueryDKGCompletionRequests queries DKG completion requests by the given id.
 	QueryDKGCompletionRequests(context.Context, *QueryDKGCompletionRequestsRequest) (*QueryDKGCompletionRequestsResponse, error)
+	// QueryRunes queries all registered runes.
+	QueryRunes(context.Context, *QueryRunesRequest) (*QueryRunesResponse, error)
+	// QueryRune queries the registered rune by the given id.
+	QueryRune(context.Context, *QueryRuneRequest) (*QueryRuneResponse, error)
+	// QueryProtocolFees queries the protocol fees quoted for the given amount.
+	QueryProtocolFees(context.Context, *QueryProtocolFeesRequest) (*QueryProtocolFeesResponse, error)
+	// QueryFeeDistribution queries the accumulated and distributed protocol fees of each bucket.
+	QueryFeeDistribution(context.Context, *QueryFeeDistributionRequest) (*QueryFeeDistributionResponse, error)
+	// QueryRuneConfigs queries the configurations and voucher supplies of the configured runes.
+	QueryRuneConfigs(context.Context, *QueryRuneConfigsRequest) (*QueryRuneConfigsResponse, error)
+	// QueryOutflowUsage queries the withdrawal outflow of the current rate limit window.
+	QueryOutflowUsage(context.Context, *QueryOutflowUsageRequest) (*QueryOutflowUsageResponse, error)
+	// QueryEmergencyPauses queries the emergency pauses.
+	QueryEmergencyPauses(context.Context, *QueryEmergencyPausesRequest) (*QueryEmergencyPausesResponse, error)
+	// QueryDepositAddress queries the deposit address assigned among the active vaults of the given asset type.
+	QueryDepositAddress(context.Context, *QueryDepositAddressRequest) (*QueryDepositAddressResponse, error)
+	// QueryFrostSigningSession queries the FROST signing session of the given signing request.
+	QueryFrostSigningSession(context.Context, *QueryFrostSigningSessionRequest) (*QueryFrostSigningSessionResponse, error)
+	// QueryFrostVaultKey queries the FROST key of the given vault.
+	QueryFrostVaultKey(context.Context, *QueryFrostVaultKeyRequest) (*QueryFrostVaultKeyResponse, error)
+	// QuerySignerPerformance queries the liveness performance of the given TSS participant.
+	QuerySignerPerformance(context.Context, *QuerySignerPerformanceRequest) (*QuerySignerPerformanceResponse, error)
+	// QuerySignerPerformances queries the liveness performances of all the TSS participants.
+	QuerySignerPerformances(context.Context, *QuerySignerPerformancesRequest) (*QuerySignerPerformancesResponse, error)
+	// QueryVaultTransfer queries the vault transfer from the given source version.
+	QueryVaultTransfer(context.Context, *QueryVaultTransferRequest) (*QueryVaultTransferResponse, error)
+	// QueryVaultTransfers queries all vault transfers.
+	QueryVaultTransfers(context.Context, *QueryVaultTransfersRequest) (*QueryVaultTransfersResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -386,6 +582,48 @@ func (UnimplementedQueryServer) QueryAllDKGRequests(context.Context, *QueryAllDK
 func (UnimplementedQueryServer) QueryDKGCompletionRequests(context.Context, *QueryDKGCompletionRequestsRequest) (*QueryDKGCompletionRequestsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method QueryDKGCompletionRequests not implemented")
 }
+func (UnimplementedQueryServer) QueryRunes(context.Context, *QueryRunesRequest) (*QueryRunesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryRunes not implemented")
+}
+func (UnimplementedQueryServer) QueryRune(context.Context, *QueryRuneRequest) (*QueryRuneResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryRune not implemented")
+}
+func (UnimplementedQueryServer) QueryProtocolFees(context.Context, *QueryProtocolFeesRequest) (*QueryProtocolFeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryProtocolFees not implemented")
+}
+func (UnimplementedQueryServer) QueryFeeDistribution(context.Context, *QueryFeeDistributionRequest) (*QueryFeeDistributionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryFeeDistribution not implemented")
+}
+func (UnimplementedQueryServer) QueryRuneConfigs(context.Context, *QueryRuneConfigsRequest) (*QueryRuneConfigsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryRuneConfigs not implemented")
+}
+func (UnimplementedQueryServer) QueryOutflowUsage(context.Context, *QueryOutflowUsageRequest) (*QueryOutflowUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryOutflowUsage not implemented")
+}
+func (UnimplementedQueryServer) QueryEmergencyPauses(context.Context, *QueryEmergencyPausesRequest) (*QueryEmergencyPausesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryEmergencyPauses not implemented")
+}
+func (UnimplementedQueryServer) QueryDepositAddress(context.Context, *QueryDepositAddressRequest) (*QueryDepositAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryDepositAddress not implemented")
+}
+func (UnimplementedQueryServer) QueryFrostSigningSession(context.Context, *QueryFrostSigningSessionRequest) (*QueryFrostSigningSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryFrostSigningSession not implemented")
+}
+func (UnimplementedQueryServer) QueryFrostVaultKey(context.Context, *QueryFrostVaultKeyRequest) (*QueryFrostVaultKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryFrostVaultKey not implemented")
+}
+func (UnimplementedQueryServer) QuerySignerPerformance(context.Context, *QuerySignerPerformanceRequest) (*QuerySignerPerformanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuerySignerPerformance not implemented")
+}
+func (UnimplementedQueryServer) QuerySignerPerformances(context.Context, *QuerySignerPerformancesRequest) (*QuerySignerPerformancesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuerySignerPerformances not implemented")
+}
+func (UnimplementedQueryServer) QueryVaultTransfer(context.Context, *QueryVaultTransferRequest) (*QueryVaultTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryVaultTransfer not implemented")
+}
+func (UnimplementedQueryServer) QueryVaultTransfers(context.Context, *QueryVaultTransfersRequest) (*QueryVaultTransfersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryVaultTransfers not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -759,6 +997,258 @@ func _Query_QueryDKGCompletionRequests_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_QueryRunes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRunesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryRunes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_QueryRunes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryRunes(ctx, req.(*QueryRunesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryRune_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRuneRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryRune(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_QueryRune_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryRune(ctx, req.(*QueryRuneRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryProtocolFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProtocolFeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryProtocolFees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_QueryProtocolFees_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryProtocolFees(ctx, req.(*QueryProtocolFeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryFeeDistribution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeDistributionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryFeeDistribution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_QueryFeeDistribution_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryFeeDistribution(ctx, req.(*QueryFeeDistributionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryRuneConfigs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRuneConfigsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryRuneConfigs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_QueryRuneConfigs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryRuneConfigs(ctx, req.(*QueryRuneConfigsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryOutflowUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOutflowUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryOutflowUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_QueryOutflowUsage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryOutflowUsage(ctx, req.(*QueryOutflowUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryEmergencyPauses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEmergencyPausesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryEmergencyPauses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_QueryEmergencyPauses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryEmergencyPauses(ctx, req.(*QueryEmergencyPausesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryDepositAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDepositAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryDepositAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_QueryDepositAddress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryDepositAddress(ctx, req.(*QueryDepositAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryFrostSigningSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFrostSigningSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryFrostSigningSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_QueryFrostSigningSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryFrostSigningSession(ctx, req.(*QueryFrostSigningSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryFrostVaultKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFrostVaultKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryFrostVaultKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_QueryFrostVaultKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryFrostVaultKey(ctx, req.(*QueryFrostVaultKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QuerySignerPerformance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySignerPerformanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QuerySignerPerformance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_QuerySignerPerformance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QuerySignerPerformance(ctx, req.(*QuerySignerPerformanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QuerySignerPerformances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySignerPerformancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QuerySignerPerformances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_QuerySignerPerformances_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QuerySignerPerformances(ctx, req.(*QuerySignerPerformancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryVaultTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVaultTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryVaultTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_QueryVaultTransfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryVaultTransfer(ctx, req.(*QueryVaultTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryVaultTransfers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVaultTransfersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryVaultTransfers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_QueryVaultTransfers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryVaultTransfers(ctx, req.(*QueryVaultTransfersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -846,6 +1336,62 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "QueryDKGCompletionRequests",
 			Handler:    _Query_QueryDKGCompletionRequests_Handler,
 		},
+		{
+			MethodName: "QueryRunes",
+			Handler:    _Query_QueryRunes_Handler,
+		},
+		{
+			MethodName: "QueryRune",
+			Handler:    _Query_QueryRune_Handler,
+		},
+		{
+			MethodName: "QueryProtocolFees",
+			Handler:    _Query_QueryProtocolFees_Handler,
+		},
+		{
+			MethodName: "QueryFeeDistribution",
+			Handler:    _Query_QueryFeeDistribution_Handler,
+		},
+		{
+			MethodName: "QueryRuneConfigs",
+			Handler:    _Query_QueryRuneConfigs_Handler,
+		},
+		{
+			MethodName: "QueryOutflowUsage",
+			Handler:    _Query_QueryOutflowUsage_Handler,
+		},
+		{
+			MethodName: "QueryEmergencyPauses",
+			Handler:    _Query_QueryEmergencyPauses_Handler,
+		},
+		{
+			MethodName: "QueryDepositAddress",
+			Handler:    _Query_QueryDepositAddress_Handler,
+		},
+		{
+			MethodName: "QueryFrostSigningSession",
+			Handler:    _Query_QueryFrostSigningSession_Handler,
+		},
+		{
+			MethodName: "QueryFrostVaultKey",
+			Handler:    _Query_QueryFrostVaultKey_Handler,
+		},
+		{
+			MethodName: "QuerySignerPerformance",
+			Handler:    _Query_QuerySignerPerformance_Handler,
+		},
+		{
+			MethodName: "QuerySignerPerformances",
+			Handler:    _Query_QuerySignerPerformances_Handler,
+		},
+		{
+			MethodName: "QueryVaultTransfer",
+			Handler:    _Query_QueryVaultTransfer_Handler,
+		},
+		{
+			MethodName: "QueryVaultTransfers",
+			Handler:    _Query_QueryVaultTransfers_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "side/btcbridge/query.proto",
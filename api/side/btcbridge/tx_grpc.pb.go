@@ -31,6 +31,15 @@ const (
 	Msg_InitiateDKG_FullMethodName                 = "/side.btcbridge.Msg/InitiateDKG"
 	Msg_CompleteDKG_FullMethodName                 = "/side.btcbridge.Msg/CompleteDKG"
 	Msg_TransferVault_FullMethodName               = "/side.btcbridge.Msg/TransferVault"
+	Msg_RegisterRunes_FullMethodName               = "/side.btcbridge.Msg/RegisterRunes"
+	Msg_RegisterBrc20Tickers_FullMethodName        = "/side.btcbridge.Msg/RegisterBrc20Tickers"
+	Msg_ResetCircuitBreaker_FullMethodName         = "/side.btcbridge.Msg/ResetCircuitBreaker"
+	Msg_EmergencyPause_FullMethodName              = "/side.btcbridge.Msg/EmergencyPause"
+	Msg_LiftEmergencyPause_FullMethodName          = "/side.btcbridge.Msg/LiftEmergencyPause"
+	Msg_RecoverVault_FullMethodName                = "/side.btcbridge.Msg/RecoverVault"
+	Msg_SweepVault_FullMethodName                  = "/side.btcbridge.Msg/SweepVault"
+	Msg_SubmitNonceCommitments_FullMethodName      = "/side.btcbridge.Msg/SubmitNonceCommitments"
+	Msg_SubmitPartialSignatures_FullMethodName     = "/side.btcbridge.Msg/SubmitPartialSignatures"
 	Msg_UpdateParams_FullMethodName                = "/side.btcbridge.Msg/UpdateParams"
 )
 
@@ -62,6 +71,24 @@ type MsgClient interface {
 	CompleteDKG(ctx context.Context, in *MsgCompleteDKG, opts ...grpc.CallOption) (*MsgCompleteDKGResponse, error)
 	// TransferVault transfers the vault asset from the source version to the destination version.
 	TransferVault(ctx context.Context, in *MsgTransferVault, opts ...grpc.CallOption) (*MsgTransferVaultResponse, error)
+	// RegisterRunes registers the metadata of the given runes.
+	RegisterRunes(ctx context.Context, in *MsgRegisterRunes, opts ...grpc.CallOption) (*MsgRegisterRunesResponse, error)
+	// RegisterBrc20Tickers registers the metadata of the given brc20 tickers.
+	RegisterBrc20Tickers(ctx context.Context, in *MsgRegisterBrc20Tickers, opts ...grpc.CallOption) (*MsgRegisterBrc20TickersResponse, error)
+	// ResetCircuitBreaker resets the tripped withdrawal circuit breaker.
+	ResetCircuitBreaker(ctx context.Context, in *MsgResetCircuitBreaker, opts ...grpc.CallOption) (*MsgResetCircuitBreakerResponse, error)
+	// EmergencyPause pauses the bridge in the given scope until expiration.
+	EmergencyPause(ctx context.Context, in *MsgEmergencyPause, opts ...grpc.CallOption) (*MsgEmergencyPauseResponse, error)
+	// LiftEmergencyPause lifts the given emergency pause before expiration.
+	LiftEmergencyPause(ctx context.Context, in *MsgLiftEmergencyPause, opts ...grpc.CallOption) (*MsgLiftEmergencyPauseResponse, error)
+	// RecoverVault recovers the vault asset through the timelocked recovery script path.
+	RecoverVault(ctx context.Context, in *MsgRecoverVault, opts ...grpc.CallOption) (*MsgRecoverVaultResponse, error)
+	// SweepVault sweeps the outputs of the retired vault.
+	SweepVault(ctx context.Context, in *MsgSweepVault, opts ...grpc.CallOption) (*MsgSweepVaultResponse, error)
+	// SubmitNonceCommitments submits the FROST nonce commitments of the signing request.
+	SubmitNonceCommitments(ctx context.Context, in *MsgSubmitNonceCommitments, opts ...grpc.CallOption) (*MsgSubmitNonceCommitmentsResponse, error)
+	// SubmitPartialSignatures submits the FROST partial signatures of the signing request.
+	SubmitPartialSignatures(ctx context.Context, in *MsgSubmitPartialSignatures, opts ...grpc.CallOption) (*MsgSubmitPartialSignaturesResponse, error)
 	// UpdateParams defines a governance operation for updating the x/btcbridge module
 	// parameters. The authority defaults to the x/gov module account.
 	//
@@ -185,6 +212,87 @@ func (c *msgClient) TransferVault(ctx context.Context, in *MsgTransferVault, opt
 	return out, nil
 }
 
+func (c *msgClient) RegisterRunes(ctx context.Context, in *MsgRegisterRunes, opts ...grpc.CallOption) (*MsgRegisterRunesResponse, error) {
+	out := new(MsgRegisterRunesResponse)
+	err := c.cc.Invoke(ctx, Msg_RegisterRunes_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RegisterBrc20Tickers(ctx context.Context, in *MsgRegisterBrc20Tickers, opts ...grpc.CallOption) (*MsgRegisterBrc20TickersResponse, error) {
+	out := new(MsgRegisterBrc20TickersResponse)
+	err := c.cc.Invoke(ctx, Msg_RegisterBrc20Tickers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ResetCircuitBreaker(ctx context.Context, in *MsgResetCircuitBreaker, opts ...grpc.CallOption) (*MsgResetCircuitBreakerResponse, error) {
+	out := new(MsgResetCircuitBreakerResponse)
+	err := c.cc.Invoke(ctx, Msg_ResetCircuitBreaker_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) EmergencyPause(ctx context.Context, in *MsgEmergencyPause, opts ...grpc.CallOption) (*MsgEmergencyPauseResponse, error) {
+	out := new(MsgEmergencyPauseResponse)
+	err := c.cc.Invoke(ctx, Msg_EmergencyPause_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) LiftEmergencyPause(ctx context.Context, in *MsgLiftEmergencyPause, opts ...grpc.CallOption) (*MsgLiftEmergencyPauseResponse, error) {
+	out := new(MsgLiftEmergencyPauseResponse)
+	err := c.cc.Invoke(ctx, Msg_LiftEmergencyPause_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RecoverVault(ctx context.Context, in *MsgRecoverVault, opts ...grpc.CallOption) (*MsgRecoverVaultResponse, error) {
+	out := new(MsgRecoverVaultResponse)
+	err := c.cc.Invoke(ctx, Msg_RecoverVault_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SweepVault(ctx context.Context, in *MsgSweepVault, opts ...grpc.CallOption) (*MsgSweepVaultResponse, error) {
+	out := new(MsgSweepVaultResponse)
+	err := c.cc.Invoke(ctx, Msg_SweepVault_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SubmitNonceCommitments(ctx context.Context, in *MsgSubmitNonceCommitments, opts ...grpc.CallOption) (*MsgSubmitNonceCommitmentsResponse, error) {
+	out := new(MsgSubmitNonceCommitmentsResponse)
+	err := c.cc.Invoke(ctx, Msg_SubmitNonceCommitments_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SubmitPartialSignatures(ctx context.Context, in *MsgSubmitPartialSignatures, opts ...grpc.CallOption) (*MsgSubmitPartialSignaturesResponse, error) {
+	out := new(MsgSubmitPartialSignaturesResponse)
+	err := c.cc.Invoke(ctx, Msg_SubmitPartialSignatures_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
 	out := new(MsgUpdateParamsResponse)
 	err := c.cc.Invoke(ctx, Msg_UpdateParams_FullMethodName, in, out, opts...)
@@ -222,6 +330,24 @@ type MsgServer interface {
 	CompleteDKG(context.Context, *MsgCompleteDKG) (*MsgCompleteDKGResponse, error)
 	// TransferVault transfers the vault asset from the source version to the destination version.
 	TransferVault(context.Context, *MsgTransferVault) (*MsgTransferVaultResponse, error)
+	// RegisterRunes registers the metadata of the given runes.
+	RegisterRunes(context.Context, *MsgRegisterRunes) (*MsgRegisterRunesResponse, error)
+	// RegisterBrc20Tickers registers the metadata of the given brc20 tickers.
+	RegisterBrc20Tickers(context.Context, *MsgRegisterBrc20Tickers) (*MsgRegisterBrc20TickersResponse, error)
+	// ResetCircuitBreaker resets the tripped withdrawal circuit breaker.
+	ResetCircuitBreaker(context.Context, *MsgResetCircuitBreaker) (*MsgResetCircuitBreakerResponse, error)
+	// EmergencyPause pauses the bridge in the given scope until expiration.
+	EmergencyPause(context.Context, *MsgEmergencyPause) (*MsgEmergencyPauseResponse, error)
+	// LiftEmergencyPause lifts the given emergency pause before expiration.
+	LiftEmergencyPause(context.Context, *MsgLiftEmergencyPause) (*MsgLiftEmergencyPauseResponse, error)
+	// RecoverVault recovers the vault asset through the timelocked recovery script path.
+	RecoverVault(context.Context, *MsgRecoverVault) (*MsgRecoverVaultResponse, error)
+	// SweepVault sweeps the outputs of the retired vault.
+	SweepVault(context.Context, *MsgSweepVault) (*MsgSweepVaultResponse, error)
+	// SubmitNonceCommitments submits the FROST nonce commitments of the signing request.
+	SubmitNonceCommitments(context.Context, *MsgSubmitNonceCommitments) (*MsgSubmitNonceCommitmentsResponse, error)
+	// SubmitPartialSignatures submits the FROST partial signatures of the signing request.
+	SubmitPartialSignatures(context.Context, *MsgSubmitPartialSignatures) (*MsgSubmitPartialSignaturesResponse, error)
 	// UpdateParams defines a governance operation for updating the x/btcbridge module
 	// parameters. The authority defaults to the x/gov module account.
 	//
@@ -270,6 +396,33 @@ func (UnimplementedMsgServer) CompleteDKG(context.Context, *MsgCompleteDKG) (*Ms
 func (UnimplementedMsgServer) TransferVault(context.Context, *MsgTransferVault) (*MsgTransferVaultResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TransferVault not implemented")
 }
+func (UnimplementedMsgServer) RegisterRunes(context.Context, *MsgRegisterRunes) (*MsgRegisterRunesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterRunes not implemented")
+}
+func (UnimplementedMsgServer) RegisterBrc20Tickers(context.Context, *MsgRegisterBrc20Tickers) (*MsgRegisterBrc20TickersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterBrc20Tickers not implemented")
+}
+func (UnimplementedMsgServer) ResetCircuitBreaker(context.Context, *MsgResetCircuitBreaker) (*MsgResetCircuitBreakerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetCircuitBreaker not implemented")
+}
+func (UnimplementedMsgServer) EmergencyPause(context.Context, *MsgEmergencyPause) (*MsgEmergencyPauseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EmergencyPause not implemented")
+}
+func (UnimplementedMsgServer) LiftEmergencyPause(context.Context, *MsgLiftEmergencyPause) (*MsgLiftEmergencyPauseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LiftEmergencyPause not implemented")
+}
+func (UnimplementedMsgServer) RecoverVault(context.Context, *MsgRecoverVault) (*MsgRecoverVaultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecoverVault not implemented")
+}
+func (UnimplementedMsgServer) SweepVault(context.Context, *MsgSweepVault) (*MsgSweepVaultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SweepVault not implemented")
+}
+func (UnimplementedMsgServer) SubmitNonceCommitments(context.Context, *MsgSubmitNonceCommitments) (*MsgSubmitNonceCommitmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitNonceCommitments not implemented")
+}
+func (UnimplementedMsgServer) SubmitPartialSignatures(context.Context, *MsgSubmitPartialSignatures) (*MsgSubmitPartialSignaturesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitPartialSignatures not implemented")
+}
 func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
@@ -502,6 +655,168 @@ func _Msg_TransferVault_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RegisterRunes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterRunes)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterRunes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RegisterRunes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterRunes(ctx, req.(*MsgRegisterRunes))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RegisterBrc20Tickers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterBrc20Tickers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterBrc20Tickers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RegisterBrc20Tickers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterBrc20Tickers(ctx, req.(*MsgRegisterBrc20Tickers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ResetCircuitBreaker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgResetCircuitBreaker)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ResetCircuitBreaker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ResetCircuitBreaker_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ResetCircuitBreaker(ctx, req.(*MsgResetCircuitBreaker))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_EmergencyPause_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgEmergencyPause)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).EmergencyPause(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_EmergencyPause_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).EmergencyPause(ctx, req.(*MsgEmergencyPause))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_LiftEmergencyPause_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgLiftEmergencyPause)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).LiftEmergencyPause(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_LiftEmergencyPause_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).LiftEmergencyPause(ctx, req.(*MsgLiftEmergencyPause))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RecoverVault_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRecoverVault)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RecoverVault(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RecoverVault_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RecoverVault(ctx, req.(*MsgRecoverVault))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SweepVault_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSweepVault)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SweepVault(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SweepVault_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SweepVault(ctx, req.(*MsgSweepVault))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SubmitNonceCommitments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitNonceCommitments)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitNonceCommitments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SubmitNonceCommitments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitNonceCommitments(ctx, req.(*MsgSubmitNonceCommitments))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SubmitPartialSignatures_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitPartialSignatures)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitPartialSignatures(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SubmitPartialSignatures_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitPartialSignatures(ctx, req.(*MsgSubmitPartialSignatures))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgUpdateParams)
 	if err := dec(in); err != nil {
@@ -575,6 +890,42 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "TransferVault",
 			Handler:    _Msg_TransferVault_Handler,
 		},
+		{
+			MethodName: "RegisterRunes",
+			Handler:    _Msg_RegisterRunes_Handler,
+		},
+		{
+			MethodName: "RegisterBrc20Tickers",
+			Handler:    _Msg_RegisterBrc20Tickers_Handler,
+		},
+		{
+			MethodName: "ResetCircuitBreaker",
+			Handler:    _Msg_ResetCircuitBreaker_Handler,
+		},
+		{
+			MethodName: "EmergencyPause",
+			Handler:    _Msg_EmergencyPause_Handler,
+		},
+		{
+			MethodName: "LiftEmergencyPause",
+			Handler:    _Msg_LiftEmergencyPause_Handler,
+		},
+		{
+			MethodName: "RecoverVault",
+			Handler:    _Msg_RecoverVault_Handler,
+		},
+		{
+			MethodName: "SweepVault",
+			Handler:    _Msg_SweepVault_Handler,
+		},
+		{
+			MethodName: "SubmitNonceCommitments",
+			Handler:    _Msg_SubmitNonceCommitments_Handler,
+		},
+		{
+			MethodName: "SubmitPartialSignatures",
+			Handler:    _Msg_SubmitPartialSignatures_Handler,
+		},
 		{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
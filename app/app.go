@@ -194,18 +194,22 @@ var (
 
 	// module account permissions
 	maccPerms = map[string][]string{
-		authtypes.FeeCollectorName:     nil,
-		distrtypes.ModuleName:          nil,
-		icatypes.ModuleName:            nil,
-		minttypes.ModuleName:           {authtypes.Minter},
-		stakingtypes.BondedPoolName:    {authtypes.Burner, authtypes.Staking},
-		stakingtypes.NotBondedPoolName: {authtypes.Burner, authtypes.Staking},
-		govtypes.ModuleName:            {authtypes.Burner},
-		ibcfeetypes.ModuleName:         nil,
-		ibctransfertypes.ModuleName:    {authtypes.Minter, authtypes.Burner},
-		wasmtypes.ModuleName:           {authtypes.Burner},
-		btcbridgetypes.ModuleName:      {authtypes.Minter, authtypes.Burner},
-		incentivetypes.ModuleName:      nil,
+		authtypes.FeeCollectorName:         nil,
+		distrtypes.ModuleName:              nil,
+		icatypes.ModuleName:                nil,
+		minttypes.ModuleName:               {authtypes.Minter},
+		stakingtypes.BondedPoolName:        {authtypes.Burner, authtypes.Staking},
+		stakingtypes.NotBondedPoolName:     {authtypes.Burner, authtypes.Staking},
+		govtypes.ModuleName:                {authtypes.Burner},
+		ibcfeetypes.ModuleName:             nil,
+		ibctransfertypes.ModuleName:        {authtypes.Minter, authtypes.Burner},
+		wasmtypes.ModuleName:               {authtypes.Burner},
+		btcbridgetypes.ModuleName:          {authtypes.Minter, authtypes.Burner},
+		btcbridgetypes.ProtocolFeePoolName: nil,
+		btcbridgetypes.RelayerPoolName:     nil,
+		btcbridgetypes.SignerPoolName:      nil,
+		btcbridgetypes.InsurancePoolName:   nil,
+		incentivetypes.ModuleName:          nil,
 		// this line is used by starport scaffolding # stargate/app/maccPerms
 	}
 )
@@ -618,6 +622,8 @@ func New(
 		keys[btcbridgetypes.MemStoreKey],
 		app.BankKeeper,
 		app.StakingKeeper,
+		app.SlashingKeeper,
+		app.DistrKeeper,
 		app.IncentiveKeeper,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
@@ -686,6 +692,7 @@ func New(
 			// insert staking hooks receivers here
 			app.DistrKeeper.Hooks(),
 			app.SlashingKeeper.Hooks(),
+			app.BtcBridgeKeeper.Hooks(),
 		),
 	)
 
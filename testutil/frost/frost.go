@@ -0,0 +1,114 @@
+package frost
+
+import (
+	"encoding/hex"
+
+	secp256k1 "github.com/btcsuite/btcd/btcec/v2"
+
+	"github.com/sideprotocol/side/x/btcbridge/types"
+)
+
+// Key defines the FROST key generated by the trusted dealer for testing
+type Key struct {
+	GroupKey           *secp256k1.PublicKey
+	Shares             []*secp256k1.ModNScalar
+	VerificationShares []string
+}
+
+// Nonce defines the FROST signing nonce
+type Nonce struct {
+	Hiding  *secp256k1.ModNScalar
+	Binding *secp256k1.ModNScalar
+}
+
+// GenerateKey generates the FROST key of the given threshold with the trusted dealer
+// The share of the signer i is Shares[i-1]
+func GenerateKey(n int, threshold int) (*Key, error) {
+	coefficients := make([]*secp256k1.ModNScalar, threshold)
+	for i := range coefficients {
+		privKey, err := secp256k1.NewPrivateKey()
+		if err != nil {
+			return nil, err
+		}
+
+		coefficients[i] = &privKey.Key
+	}
+
+	key := &Key{
+		GroupKey: scalarToPoint(coefficients[0]),
+	}
+
+	for i := 1; i <= n; i++ {
+		// evaluate the polynomial at i
+		share := new(secp256k1.ModNScalar)
+		x := new(secp256k1.ModNScalar).SetInt(uint32(i))
+		power := new(secp256k1.ModNScalar).SetInt(1)
+
+		for _, c := range coefficients {
+			share.Add(new(secp256k1.ModNScalar).Set(c).Mul(power))
+			power.Mul(x)
+		}
+
+		key.Shares = append(key.Shares, share)
+		key.VerificationShares = append(key.VerificationShares, hex.EncodeToString(scalarToPoint(share).SerializeCompressed()))
+	}
+
+	return key, nil
+}
+
+// GenerateNonce generates the signing nonce and the corresponding nonce commitment
+func GenerateNonce() (*Nonce, types.FrostNonceCommitment, error) {
+	hiding, err := secp256k1.NewPrivateKey()
+	if err != nil {
+		return nil, types.FrostNonceCommitment{}, err
+	}
+
+	binding, err := secp256k1.NewPrivateKey()
+	if err != nil {
+		return nil, types.FrostNonceCommitment{}, err
+	}
+
+	return &Nonce{Hiding: &hiding.Key, Binding: &binding.Key}, types.FrostNonceCommitment{
+		Hiding:  hex.EncodeToString(hiding.PubKey().SerializeCompressed()),
+		Binding: hex.EncodeToString(binding.PubKey().SerializeCompressed()),
+	}, nil
+}
+
+// PartialSign generates the partial signature of the given signer
+func (k *Key) PartialSign(input *types.FrostSigningInput, commitments []*types.FrostSignerCommitment, index uint32, nonce *Nonce) (*secp256k1.ModNScalar, error) {
+	groupCommitment, err := input.GroupCommitment(commitments)
+	if err != nil {
+		return nil, err
+	}
+
+	indexes := make([]uint32, len(commitments))
+	for i, c := range commitments {
+		indexes[i] = c.Index
+	}
+
+	// d_i + rho_i * e_i, negated if R is odd
+	z := new(secp256k1.ModNScalar).Set(nonce.Binding).Mul(input.BindingFactor(commitments, index)).Add(nonce.Hiding)
+
+	groupCommitment.Y.Normalize()
+	if groupCommitment.Y.IsOdd() {
+		z.Negate()
+	}
+
+	// c * lambda_i * s_i, negated if the parities of Q and Y differ
+	e := new(secp256k1.ModNScalar).Set(input.Challenge(&groupCommitment)).Mul(types.LagrangeCoefficient(index, indexes, 0)).Mul(k.Shares[index-1])
+	if input.OutputKey.Y().Bit(0) != input.GroupKey.Y().Bit(0) {
+		e.Negate()
+	}
+
+	return z.Add(e), nil
+}
+
+// scalarToPoint returns the point of the given scalar
+func scalarToPoint(s *secp256k1.ModNScalar) *secp256k1.PublicKey {
+	var p secp256k1.JacobianPoint
+
+	secp256k1.ScalarBaseMultNonConst(s, &p)
+	p.ToAffine()
+
+	return secp256k1.NewPublicKey(&p.X, &p.Y)
+}
@@ -32,12 +32,24 @@ func GetQueryCmd(_ string) *cobra.Command {
 	cmd.AddCommand(CmdBestBlock())
 	cmd.AddCommand(CmdQueryBlock())
 	cmd.AddCommand(CmdQueryFeeRate())
+	cmd.AddCommand(CmdQueryProtocolFees())
+	cmd.AddCommand(CmdQueryFeeDistribution())
 	cmd.AddCommand(CmdQueryWithdrawRequests())
 	cmd.AddCommand(CmdQuerySigningRequests())
+	cmd.AddCommand(CmdQuerySigningRequest())
 	cmd.AddCommand(CmdQueryUTXOs())
 	cmd.AddCommand(CmdQueryUTXOStats())
 	cmd.AddCommand(CmdQueryDKGRequests())
 	cmd.AddCommand(CmdQueryDKGCompletionRequests())
+	cmd.AddCommand(CmdQueryRunes())
+	cmd.AddCommand(CmdQueryRuneConfigs())
+	cmd.AddCommand(CmdQueryOutflowUsage())
+	cmd.AddCommand(CmdQueryEmergencyPauses())
+	cmd.AddCommand(CmdQueryDepositAddress())
+	cmd.AddCommand(CmdQueryFrostSigningSession())
+	cmd.AddCommand(CmdQueryFrostVaultKey())
+	cmd.AddCommand(CmdQuerySignerPerformance())
+	cmd.AddCommand(CmdQueryVaultTransfer())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
@@ -163,6 +175,62 @@ func CmdQueryFeeRate() *cobra.Command {
 	return cmd
 }
 
+// CmdQueryProtocolFees returns the command to query the protocol fees quoted for the given amount
+func CmdQueryProtocolFees() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "protocol-fees [amount]",
+		Short: "Query the protocol fees for depositing and withdrawing the given amount",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.QueryProtocolFees(cmd.Context(), &types.QueryProtocolFeesRequest{Amount: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdQueryFeeDistribution returns the command to query the protocol fee distribution
+func CmdQueryFeeDistribution() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fee-distribution",
+		Short: "Query the accumulated and distributed protocol fees of each bucket",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.QueryFeeDistribution(cmd.Context(), &types.QueryFeeDistributionRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // CmdQueryWithdrawRequests returns the command to query withdrawal requests
 func CmdQueryWithdrawRequests() *cobra.Command {
 	cmd := &cobra.Command{
@@ -268,6 +336,41 @@ func CmdQuerySigningRequests() *cobra.Command {
 	return cmd
 }
 
+// CmdQuerySigningRequest returns the command to query the signing request with the status history by sequence
+func CmdQuerySigningRequest() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "signing-request [sequence]",
+		Short: "Query the signing request with the status history by sequence",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			sequence, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.QuerySigningRequest(cmd.Context(), &types.QuerySigningRequestRequest{
+				Sequence: sequence,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // CmdQueryUTXOs returns the command to query utxos by the optional address
 func CmdQueryUTXOs() *cobra.Command {
 	cmd := &cobra.Command{
@@ -421,3 +524,326 @@ func CmdQueryDKGCompletionRequests() *cobra.Command {
 
 	return cmd
 }
+
+// CmdQueryRunes returns the command to query the registered runes
+func CmdQueryRunes() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "runes [id]",
+		Short: "Query all registered runes or the given rune",
+		Args:  cobra.RangeArgs(0, 1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			if len(args) == 0 {
+				res, err := queryClient.QueryRunes(cmd.Context(), &types.QueryRunesRequest{})
+				if err != nil {
+					return err
+				}
+
+				return clientCtx.PrintProto(res)
+			}
+
+			res, err := queryClient.QueryRune(cmd.Context(), &types.QueryRuneRequest{Id: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdQueryRuneConfigs returns the command to query the rune configs along with the voucher supplies
+func CmdQueryRuneConfigs() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rune-configs [id]",
+		Short: "Query the configs and voucher supplies of all configured runes or the given rune",
+		Args:  cobra.RangeArgs(0, 1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryRuneConfigsRequest{}
+			if len(args) > 0 {
+				req.Id = args[0]
+			}
+
+			res, err := queryClient.QueryRuneConfigs(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdQueryOutflowUsage returns the command to query the withdrawal outflow usage
+func CmdQueryOutflowUsage() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "outflow-usage [denom]",
+		Short: "Query the withdrawal outflow of the current rate limit window and the circuit breaker state",
+		Args:  cobra.RangeArgs(0, 1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryOutflowUsageRequest{}
+			if len(args) > 0 {
+				req.Denom = args[0]
+			}
+
+			res, err := queryClient.QueryOutflowUsage(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdQueryEmergencyPauses returns the command to query the emergency pauses
+func CmdQueryEmergencyPauses() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "emergency-pauses [active-only]",
+		Short: "Query all emergency pauses or the active ones only",
+		Args:  cobra.RangeArgs(0, 1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			activeOnly := false
+			if len(args) > 0 {
+				activeOnly, err = strconv.ParseBool(args[0])
+				if err != nil {
+					return err
+				}
+			}
+
+			res, err := queryClient.QueryEmergencyPauses(cmd.Context(), &types.QueryEmergencyPausesRequest{ActiveOnly: activeOnly})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdQueryDepositAddress returns the command to query the deposit address of the given asset type
+func CmdQueryDepositAddress() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deposit-address [asset type]",
+		Short: "Query the deposit address assigned among the active vaults of the given asset type",
+		Long:  "Query the deposit address assigned among the active vaults of the given asset type, e.g. ASSET_TYPE_BTC or 1",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			assetType, ok := types.AssetType_value[args[0]]
+			if !ok {
+				value, err := strconv.ParseInt(args[0], 10, 32)
+				if err != nil {
+					return err
+				}
+
+				assetType = int32(value)
+			}
+
+			res, err := queryClient.QueryDepositAddress(cmd.Context(), &types.QueryDepositAddressRequest{
+				AssetType: types.AssetType(assetType),
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdQueryFrostSigningSession returns the command to query the FROST signing session of the given signing request
+func CmdQueryFrostSigningSession() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "frost-session [txid]",
+		Short: "Query the FROST signing session of the given signing request",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.QueryFrostSigningSession(cmd.Context(), &types.QueryFrostSigningSessionRequest{
+				Txid: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdQueryFrostVaultKey returns the command to query the FROST key of the given vault
+func CmdQueryFrostVaultKey() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "frost-key [vault]",
+		Short: "Query the FROST key of the given vault",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.QueryFrostVaultKey(cmd.Context(), &types.QueryFrostVaultKeyRequest{
+				Vault: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdQuerySignerPerformance returns the command to query the liveness performance of the TSS participants
+func CmdQuerySignerPerformance() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "signer-performance [operator-address]",
+		Short: "Query the liveness performances of all TSS participants or the given participant",
+		Args:  cobra.RangeArgs(0, 1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			if len(args) == 0 {
+				res, err := queryClient.QuerySignerPerformances(cmd.Context(), &types.QuerySignerPerformancesRequest{})
+				if err != nil {
+					return err
+				}
+
+				return clientCtx.PrintProto(res)
+			}
+
+			res, err := queryClient.QuerySignerPerformance(cmd.Context(), &types.QuerySignerPerformanceRequest{
+				OperatorAddress: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdQueryVaultTransfer returns the command to query the vault transfers
+func CmdQueryVaultTransfer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vault-transfer [source-version]",
+		Short: "Query all vault transfers or the vault transfer from the given source version",
+		Args:  cobra.RangeArgs(0, 1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			if len(args) == 0 {
+				res, err := queryClient.QueryVaultTransfers(cmd.Context(), &types.QueryVaultTransfersRequest{})
+				if err != nil {
+					return err
+				}
+
+				return clientCtx.PrintProto(res)
+			}
+
+			sourceVersion, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.QueryVaultTransfer(cmd.Context(), &types.QueryVaultTransferRequest{
+				SourceVersion: sourceVersion,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
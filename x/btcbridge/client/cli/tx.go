@@ -26,6 +26,15 @@ var DefaultRelativePacketTimeoutTimestamp = uint64((time.Duration(10) * time.Min
 const (
 	// flagPacketTimeoutTimestamp = "packet-timeout-timestamp"
 	listSeparator = ","
+	// separator of the verification shares among vaults
+	vaultSeparator = ";"
+	// separator of the hiding and binding nonce commitments
+	commitmentSeparator = ":"
+
+	flagFeeRate = "fee-rate"
+	flagMaxFee  = "max-fee"
+
+	flagVerificationShares = "verification-shares"
 )
 
 // GetTxCmd returns the transaction commands for this module
@@ -45,6 +54,11 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(CmdWithdrawToBitcoin())
 	cmd.AddCommand(CmdSubmitSignatures())
 	cmd.AddCommand(CmdCompleteDKG())
+	cmd.AddCommand(CmdSubmitNonceCommitments())
+	cmd.AddCommand(CmdSubmitPartialSignatures())
+	cmd.AddCommand(CmdRegisterRunes())
+	cmd.AddCommand(CmdRegisterBrc20Tickers())
+	cmd.AddCommand(CmdEmergencyPause())
 
 	return cmd
 }
@@ -196,6 +210,16 @@ func CmdWithdrawToBitcoin() *cobra.Command {
 				args[0],
 			)
 
+			msg.FeeRate, err = cmd.Flags().GetInt64(flagFeeRate)
+			if err != nil {
+				return err
+			}
+
+			msg.MaxFee, err = cmd.Flags().GetInt64(flagMaxFee)
+			if err != nil {
+				return err
+			}
+
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -204,6 +228,9 @@ func CmdWithdrawToBitcoin() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().Int64(flagFeeRate, 0, "Fee rate in sat/vbyte for the priority btc withdrawal signed immediately")
+	cmd.Flags().Int64(flagMaxFee, 0, "Maximum network fee in sat for the priority btc withdrawal")
+
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -252,9 +279,9 @@ func CmdSubmitSignatures() *cobra.Command {
 // Complete DKG
 func CmdCompleteDKG() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "complete-dkg [id] [vaults] [validator-address] [signature]",
-		Short: "Complete dkg request with new vaults",
-		Args:  cobra.ExactArgs(4),
+		Use:   "complete-dkg [id] [vaults] [pub-keys] [proofs] [validator-address] [signature]",
+		Short: "Complete dkg request with new vaults and the corresponding aggregate public keys and proofs",
+		Args:  cobra.ExactArgs(6),
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -267,13 +294,173 @@ func CmdCompleteDKG() *cobra.Command {
 			}
 
 			vaults := strings.Split(args[1], listSeparator)
+			pubKeys := strings.Split(args[2], listSeparator)
+			proofs := strings.Split(args[3], listSeparator)
+
+			verificationShares := []types.VerificationShares{}
+
+			sharesStr, err := cmd.Flags().GetString(flagVerificationShares)
+			if err != nil {
+				return err
+			}
+
+			if len(sharesStr) != 0 {
+				for _, shares := range strings.Split(sharesStr, vaultSeparator) {
+					verificationShares = append(verificationShares, types.VerificationShares{Shares: strings.Split(shares, listSeparator)})
+				}
+			}
 
 			msg := types.NewMsgCompleteDKG(
 				clientCtx.GetFromAddress().String(),
 				id,
 				vaults,
-				args[2],
-				args[3],
+				pubKeys,
+				proofs,
+				verificationShares,
+				args[4],
+				args[5],
+			)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagVerificationShares, "", "FROST verification shares of the vaults, separated by ';' among vaults and ',' among participants")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// Submit FROST nonce commitments
+func CmdSubmitNonceCommitments() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit-nonce-commitments [txid] [commitments]",
+		Short: "Submit the FROST nonce commitments of the signing request in the format of hiding:binding for each input, separated by ','",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			commitments := []types.FrostNonceCommitment{}
+
+			for _, c := range strings.Split(args[1], listSeparator) {
+				parts := strings.Split(c, commitmentSeparator)
+				if len(parts) != 2 {
+					return fmt.Errorf("invalid nonce commitment %s", c)
+				}
+
+				commitments = append(commitments, types.FrostNonceCommitment{Hiding: parts[0], Binding: parts[1]})
+			}
+
+			msg := types.NewMsgSubmitNonceCommitments(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				commitments,
+			)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// Submit FROST partial signatures
+func CmdSubmitPartialSignatures() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit-partial-signatures [txid] [partial-signatures]",
+		Short: "Submit the FROST partial signatures of the signing request for each input, separated by ','",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSubmitPartialSignatures(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				strings.Split(args[1], listSeparator),
+			)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdRegisterRunes() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register-runes [file]",
+		Short: "Register the metadata of the runes from the given json file",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			runes, err := readRuneMetadataFromFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRegisterRunes(
+				clientCtx.GetFromAddress().String(),
+				runes,
+			)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdRegisterBrc20Tickers() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register-brc20-tickers [file]",
+		Short: "Register the metadata of the brc20 tickers from the given json file",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			tickers, err := readBrc20MetadataFromFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRegisterBrc20Tickers(
+				clientCtx.GetFromAddress().String(),
+				tickers,
 			)
 
 			if err := msg.ValidateBasic(); err != nil {
@@ -306,3 +493,79 @@ func readBlockHeadersFromFile(filePath string) ([]*types.BlockHeader, error) {
 	}
 	return blockHeaders, nil
 }
+
+// readRuneMetadataFromFile reads the rune metadata from the file
+func readRuneMetadataFromFile(filePath string) ([]*types.RuneMetadata, error) {
+	bz, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	var runes []*types.RuneMetadata
+	if err := json.Unmarshal(bz, &runes); err != nil {
+		return nil, err
+	}
+
+	return runes, nil
+}
+
+func readBrc20MetadataFromFile(filePath string) ([]*types.Brc20Metadata, error) {
+	bz, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	var tickers []*types.Brc20Metadata
+	if err := json.Unmarshal(bz, &tickers); err != nil {
+		return nil, err
+	}
+
+	return tickers, nil
+}
+
+// CmdEmergencyPause returns the command to pause the bridge in emergency
+func CmdEmergencyPause() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "emergency-pause [deposit|withdraw|signing|asset] [duration] [denom]",
+		Short: "Pause the bridge in the given scope for the given duration by the guardian; denom is required for the asset scope",
+		Args:  cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			scope, ok := types.PauseScope_value[fmt.Sprintf("PAUSE_SCOPE_%s", strings.ToUpper(args[0]))]
+			if !ok {
+				return fmt.Errorf("invalid scope %s", args[0])
+			}
+
+			duration, err := time.ParseDuration(args[1])
+			if err != nil {
+				return err
+			}
+
+			denom := ""
+			if len(args) > 2 {
+				denom = args[2]
+			}
+
+			msg := types.NewMsgEmergencyPause(
+				clientCtx.GetFromAddress().String(),
+				types.PauseScope(scope),
+				denom,
+				duration,
+			)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,98 @@
+package keeper
+
+import (
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sideprotocol/side/x/btcbridge/types"
+)
+
+// RegisterBrc20Tickers registers the metadata of the given brc20 tickers
+// The registered ticker can only be updated by the authority
+func (k Keeper) RegisterBrc20Tickers(ctx sdk.Context, sender string, tickers []*types.Brc20Metadata) error {
+	isAuthority := sender == k.authority
+
+	if !isAuthority && !k.IsTrustedNonBtcRelayer(ctx, sender) {
+		return types.ErrUntrustedNonBtcRelayer
+	}
+
+	for _, metadata := range tickers {
+		if !isAuthority && k.HasBrc20Metadata(ctx, metadata.Tick) {
+			return types.ErrBrc20TickAlreadyRegistered
+		}
+
+		k.SetBrc20Metadata(ctx, metadata)
+	}
+
+	return nil
+}
+
+// SetBrc20Metadata sets the given brc20 metadata
+func (k Keeper) SetBrc20Metadata(ctx sdk.Context, metadata *types.Brc20Metadata) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := k.cdc.MustMarshal(metadata)
+	store.Set(types.Brc20MetadataKey(metadata.Tick), bz)
+}
+
+// HasBrc20Metadata returns true if the given brc20 ticker is registered, false otherwise
+func (k Keeper) HasBrc20Metadata(ctx sdk.Context, tick string) bool {
+	store := ctx.KVStore(k.storeKey)
+
+	return store.Has(types.Brc20MetadataKey(tick))
+}
+
+// GetBrc20Metadata gets the metadata of the given brc20 ticker
+func (k Keeper) GetBrc20Metadata(ctx sdk.Context, tick string) *types.Brc20Metadata {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.Brc20MetadataKey(tick))
+	if bz == nil {
+		return nil
+	}
+
+	var metadata types.Brc20Metadata
+	k.cdc.MustUnmarshal(bz, &metadata)
+
+	return &metadata
+}
+
+// GetAllBrc20Metadata gets the metadata of all registered brc20 tickers
+func (k Keeper) GetAllBrc20Metadata(ctx sdk.Context) []*types.Brc20Metadata {
+	tickers := make([]*types.Brc20Metadata, 0)
+
+	k.IterateBrc20Metadata(ctx, func(metadata *types.Brc20Metadata) (stop bool) {
+		tickers = append(tickers, metadata)
+		return false
+	})
+
+	return tickers
+}
+
+// IterateBrc20Metadata iterates through the metadata of all registered brc20 tickers
+func (k Keeper) IterateBrc20Metadata(ctx sdk.Context, cb func(metadata *types.Brc20Metadata) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := storetypes.KVStorePrefixIterator(store, types.Brc20MetadataKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var metadata types.Brc20Metadata
+		k.cdc.MustUnmarshal(iterator.Value(), &metadata)
+
+		if cb(&metadata) {
+			break
+		}
+	}
+}
+
+// CheckBrc20Amount checks if the given amount of the brc20 voucher token is allowed by the registered ticker
+func (k Keeper) CheckBrc20Amount(ctx sdk.Context, tick string, amount sdkmath.Int) error {
+	metadata := k.GetBrc20Metadata(ctx, tick)
+	if metadata == nil {
+		return types.ErrBrc20TickNotRegistered
+	}
+
+	return metadata.CheckAmount(amount)
+}
@@ -10,38 +10,176 @@ import (
 
 // ConsolidateVaults performs the UTXO consolidation for the given vaults
 func (k Keeper) ConsolidateVaults(ctx sdk.Context, vaultVersion uint64, btcConsolidation *types.BtcConsolidation, runesConsolidations []*types.RunesConsolidation) error {
+	if err := k.CheckNotPaused(ctx, types.PauseScope_PAUSE_SCOPE_SIGNING, ""); err != nil {
+		return err
+	}
+
 	feeRate := k.GetFeeRate(ctx)
 	if err := k.CheckFeeRate(ctx, feeRate); err != nil {
 		return err
 	}
 
 	if btcConsolidation != nil {
-		if err := k.handleBtcConsolidation(ctx, vaultVersion, btcConsolidation.TargetThreshold, btcConsolidation.MaxNum, feeRate.Value); err != nil {
+		vault := k.GetVaultByAssetTypeAndVersion(ctx, types.AssetType_ASSET_TYPE_BTC, vaultVersion)
+		if vault == nil {
+			return types.ErrVaultDoesNotExist
+		}
+
+		if err := k.handleBtcConsolidation(ctx, vault, btcConsolidation.TargetThreshold, btcConsolidation.MaxNum, feeRate.Value); err != nil {
 			return err
 		}
 	}
 
-	for _, rc := range runesConsolidations {
-		if err := k.handleRunesConsolidation(ctx, vaultVersion, rc.RuneId, rc.TargetThreshold, rc.MaxNum, feeRate.Value); err != nil {
-			return err
+	if len(runesConsolidations) > 0 {
+		vault := k.GetVaultByAssetTypeAndVersion(ctx, types.AssetType_ASSET_TYPE_RUNES, vaultVersion)
+		if vault == nil {
+			return types.ErrVaultDoesNotExist
+		}
+
+		for _, rc := range runesConsolidations {
+			if err := k.handleRunesConsolidation(ctx, vault, rc.RuneId, rc.TargetThreshold, rc.MaxNum, feeRate.Value); err != nil {
+				return err
+			}
 		}
 	}
 
 	return nil
 }
 
-// handleBtcConsolidation handles the given btc consolidation
-func (k Keeper) handleBtcConsolidation(ctx sdk.Context, vaultVersion uint64, targetThreshold int64, maxNum uint32, feeRate int64) error {
-	vault := k.GetVaultByAssetTypeAndVersion(ctx, types.AssetType_ASSET_TYPE_BTC, vaultVersion)
-	if vault == nil {
-		return types.ErrVaultDoesNotExist
+// AutoConsolidateVaults performs the automatic utxo consolidation for the latest vaults by the consolidation policy
+// The vault is consolidated once the number of the unlocked utxos reaches the trigger and the fee rate is below the ceiling
+func (k Keeper) AutoConsolidateVaults(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+
+	policy := params.ConsolidationPolicy
+	if !policy.IsEnabled() {
+		return
+	}
+
+	// no run is due during the cooldown
+	if lastHeight := k.GetLastConsolidationHeight(ctx); lastHeight > 0 && ctx.BlockHeight()-lastHeight < policy.Cooldown {
+		return
+	}
+
+	var btcVault, runesVault *types.Vault
+
+	if policy.BtcTargetThreshold > 0 {
+		btcVault = k.getVaultToConsolidate(ctx, params.Vaults, types.AssetType_ASSET_TYPE_BTC, policy.UtxoNumTrigger)
+	}
+
+	if len(policy.RuneThresholds) > 0 {
+		runesVault = k.getVaultToConsolidate(ctx, params.Vaults, types.AssetType_ASSET_TYPE_RUNES, policy.UtxoNumTrigger)
+	}
+
+	if btcVault == nil && runesVault == nil {
+		return
+	}
+
+	if k.IsPaused(ctx, types.PauseScope_PAUSE_SCOPE_SIGNING, "") {
+		k.emitConsolidationSkippedEvent(ctx, btcVault, runesVault, types.ConsolidationSkipReasonPaused)
+		return
+	}
+
+	feeRate := k.GetFeeRate(ctx)
+	if err := k.CheckFeeRate(ctx, feeRate); err != nil {
+		k.emitConsolidationSkippedEvent(ctx, btcVault, runesVault, types.ConsolidationSkipReasonFeeRateUnavailable)
+		return
+	}
+
+	if policy.MaxFeeRate > 0 && feeRate.Value > policy.MaxFeeRate {
+		k.emitConsolidationSkippedEvent(ctx, btcVault, runesVault, types.ConsolidationSkipReasonFeeRateTooHigh)
+		return
+	}
+
+	consolidated := false
+
+	if btcVault != nil {
+		cacheCtx, write := ctx.CacheContext()
+
+		if err := k.handleBtcConsolidation(cacheCtx, btcVault, policy.BtcTargetThreshold, policy.MaxNum, feeRate.Value); err != nil {
+			k.Logger(ctx).Info("failed to consolidate btc vault", "vault", btcVault.Address, "err", err)
+			k.emitConsolidationSkippedEvent(ctx, btcVault, nil, types.ConsolidationSkipReasonFailed)
+		} else {
+			write()
+			consolidated = true
+		}
 	}
 
-	maxUtxoNum := uint32(k.GetMaxUtxoNum(ctx))
-	if maxNum > maxUtxoNum {
-		maxNum = maxUtxoNum
+	if runesVault != nil {
+		for _, t := range policy.RuneThresholds {
+			cacheCtx, write := ctx.CacheContext()
+
+			if err := k.handleRunesConsolidation(cacheCtx, runesVault, t.RuneId, t.TargetThreshold, policy.MaxNum, feeRate.Value); err != nil {
+				k.Logger(ctx).Info("failed to consolidate runes vault", "vault", runesVault.Address, "rune id", t.RuneId, "err", err)
+				k.emitConsolidationSkippedEvent(ctx, nil, runesVault, types.ConsolidationSkipReasonFailed, sdk.NewAttribute("rune_id", t.RuneId))
+			} else {
+				write()
+				consolidated = true
+			}
+		}
+	}
+
+	if consolidated {
+		k.SetLastConsolidationHeight(ctx, ctx.BlockHeight())
+	}
+}
+
+// GetLastConsolidationHeight gets the block height of the last automatic consolidation
+func (k Keeper) GetLastConsolidationHeight(ctx sdk.Context) int64 {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.LastConsolidationHeightKey)
+	if bz == nil {
+		return 0
+	}
+
+	return int64(sdk.BigEndianToUint64(bz))
+}
+
+// SetLastConsolidationHeight sets the block height of the last automatic consolidation
+func (k Keeper) SetLastConsolidationHeight(ctx sdk.Context, height int64) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Set(types.LastConsolidationHeightKey, sdk.Uint64ToBigEndian(uint64(height)))
+}
+
+// getVaultToConsolidate gets the active vault of the given asset type with the most unlocked utxos if the number reaches the trigger
+func (k Keeper) getVaultToConsolidate(ctx sdk.Context, vaults []*types.Vault, assetType types.AssetType, trigger uint32) *types.Vault {
+	var vault *types.Vault
+	maxCount := uint32(0)
+
+	for _, v := range types.SelectActiveVaultsByAssetType(vaults, assetType) {
+		if count, _, _ := k.GetUnlockedUTXOCountAndBalancesByAddr(ctx, v.Address); count >= trigger && count > maxCount {
+			vault = v
+			maxCount = count
+		}
 	}
 
+	return vault
+}
+
+// emitConsolidationSkippedEvent emits the event that the automatic consolidation is skipped for the given vaults
+func (k Keeper) emitConsolidationSkippedEvent(ctx sdk.Context, btcVault *types.Vault, runesVault *types.Vault, reason string, attrs ...sdk.Attribute) {
+	for _, vault := range []*types.Vault{btcVault, runesVault} {
+		if vault == nil {
+			continue
+		}
+
+		k.EmitEvent(ctx, k.authority,
+			append([]sdk.Attribute{
+				sdk.NewAttribute("consolidation", "skipped"),
+				sdk.NewAttribute("vault", vault.Address),
+				sdk.NewAttribute("asset_type", vault.AssetType.String()),
+				sdk.NewAttribute("reason", reason),
+			}, attrs...)...,
+		)
+	}
+}
+
+// handleBtcConsolidation handles the given btc consolidation
+func (k Keeper) handleBtcConsolidation(ctx sdk.Context, vault *types.Vault, targetThreshold int64, maxNum uint32, feeRate int64) error {
+	maxNum = types.GetConsolidationMaxNum(maxNum, uint32(k.GetMaxUtxoNum(ctx)))
+
 	targetUTXOs := k.GetUnlockedUTXOsByAddrAndThreshold(ctx, vault.Address, targetThreshold, maxNum)
 	if len(targetUTXOs) == 0 {
 		return types.ErrInsufficientUTXOs
@@ -52,6 +190,10 @@ func (k Keeper) handleBtcConsolidation(ctx sdk.Context, vaultVersion uint64, tar
 		return err
 	}
 
+	if err := types.CheckTransactionWeight(p.UnsignedTx, targetUTXOs); err != nil {
+		return err
+	}
+
 	psbtB64, err := p.B64Encode()
 	if err != nil {
 		return types.ErrFailToSerializePsbt
@@ -87,21 +229,13 @@ func (k Keeper) handleBtcConsolidation(ctx sdk.Context, vaultVersion uint64, tar
 }
 
 // handleRunesConsolidation handles the given runes consolidation
-func (k Keeper) handleRunesConsolidation(ctx sdk.Context, vaultVersion uint64, runeId string, targetThreshold string, maxNum uint32, feeRate int64) error {
-	vault := k.GetVaultByAssetTypeAndVersion(ctx, types.AssetType_ASSET_TYPE_RUNES, vaultVersion)
-	if vault == nil {
-		return types.ErrVaultDoesNotExist
-	}
-
-	btcVault := k.GetVaultByAssetTypeAndVersion(ctx, types.AssetType_ASSET_TYPE_BTC, vaultVersion)
+func (k Keeper) handleRunesConsolidation(ctx sdk.Context, vault *types.Vault, runeId string, targetThreshold string, maxNum uint32, feeRate int64) error {
+	btcVault := k.GetVaultByAssetTypeAndVersion(ctx, types.AssetType_ASSET_TYPE_BTC, vault.Version)
 	if btcVault == nil {
 		return types.ErrVaultDoesNotExist
 	}
 
-	maxUtxoNum := uint32(k.GetMaxUtxoNum(ctx))
-	if maxNum > maxUtxoNum {
-		maxNum = maxUtxoNum
-	}
+	maxNum = types.GetConsolidationMaxNum(maxNum, uint32(k.GetMaxUtxoNum(ctx)))
 
 	targetRunesUTXOs, runeBalances := k.GetTargetRunesUTXOsByAddrAndThreshold(ctx, vault.Address, runeId, types.RuneAmountFromString(targetThreshold), maxNum)
 	if len(targetRunesUTXOs) == 0 {
@@ -110,7 +244,7 @@ func (k Keeper) handleRunesConsolidation(ctx sdk.Context, vaultVersion uint64, r
 
 	btcUtxoIterator := k.GetUTXOIteratorByAddr(ctx, btcVault.Address)
 
-	p, selectedUtxos, changeUtxo, runesRecipientUtxo, err := types.BuildTransferAllRunesPsbt(targetRunesUTXOs, btcUtxoIterator, vault.Address, runeBalances, feeRate, btcVault.Address, k.GetMaxUtxoNum(ctx))
+	p, selectedUtxos, changeUtxo, runesRecipientUtxo, err := types.BuildTransferAllRunesPsbt(targetRunesUTXOs, btcUtxoIterator, vault.Address, runeBalances, feeRate, btcVault.Address, k.GetMaxUtxoNum(ctx), k.GetCoinSelector(ctx))
 	if err != nil {
 		return err
 	}
@@ -49,31 +49,46 @@ func (k Keeper) Mint(ctx sdk.Context, sender string, tx *btcutil.Tx, prevTx *btc
 
 	// check if this is a valid runes deposit tx
 	// if any error encountered, this tx is illegal runes deposit
-	// if the edict is not nil, it indicates that this is a legal runes deposit tx
-	edict, err := types.CheckRunesDepositTransaction(tx.MsgTx(), params.Vaults)
+	// if the edicts are not empty, it indicates that this is a legal runes deposit tx
+	edicts, err := types.CheckRunesDepositTransaction(tx.MsgTx(), params.Vaults)
 	if err != nil {
 		return types.AssetType_ASSET_TYPE_UNSPECIFIED, nil, err
 	}
 
-	isRunes := edict != nil
+	// check if this is a valid brc20 deposit tx
+	// if the brc20 transfer is not nil, it indicates that this is a legal brc20 deposit tx
+	brc20Transfer, err := types.CheckBrc20DepositTransaction(tx.MsgTx(), prevTx.MsgTx(), params.Vaults)
+	if err != nil {
+		return types.AssetType_ASSET_TYPE_UNSPECIFIED, nil, err
+	}
 
 	assetType := types.AssetType_ASSET_TYPE_BTC
-	if isRunes {
+
+	switch {
+	case len(edicts) != 0 && brc20Transfer != nil:
+		return types.AssetType_ASSET_TYPE_UNSPECIFIED, nil, types.ErrInvalidDepositTransaction
+
+	case len(edicts) != 0:
 		assetType = types.AssetType_ASSET_TYPE_RUNES
+
+	case brc20Transfer != nil:
+		assetType = types.AssetType_ASSET_TYPE_BRC20
 	}
 
-	// check if the sender is trusted to relay runes deposit
-	if isRunes && !k.IsTrustedNonBtcRelayer(ctx, sender) {
+	// check if the sender is trusted to relay non-btc deposit
+	// the trusted non-btc relayer attests that the deposit is valid according to the indexer
+	if assetType != types.AssetType_ASSET_TYPE_BTC && !k.IsTrustedNonBtcRelayer(ctx, sender) {
 		return assetType, nil, types.ErrUntrustedNonBtcRelayer
 	}
 
 	// extract the recipient for minting voucher token
-	recipient, err := types.ExtractRecipientAddr(tx.MsgTx(), prevTx.MsgTx(), params.Vaults, isRunes, chainCfg)
+	recipient, err := types.ExtractRecipientAddr(tx.MsgTx(), prevTx.MsgTx(), params.Vaults, assetType, chainCfg)
 	if err != nil {
 		return assetType, nil, err
 	}
 
-	if !isRunes {
+	switch assetType {
+	case types.AssetType_ASSET_TYPE_BTC:
 		out, vout, vault, err := k.getOutputForMintBTC(ctx, tx.MsgTx(), chainCfg)
 		if err != nil {
 			return assetType, nil, err
@@ -82,17 +97,31 @@ func (k Keeper) Mint(ctx sdk.Context, sender string, tx *btcutil.Tx, prevTx *btc
 		if err := k.mintBTC(ctx, tx, height, recipient.EncodeAddress(), vault, out, vout, params.BtcVoucherDenom); err != nil {
 			return assetType, nil, err
 		}
-	} else {
-		outs, vouts, vaults, err := k.getOutputsForMintRunes(ctx, tx.MsgTx(), edict, chainCfg)
+
+	case types.AssetType_ASSET_TYPE_RUNES:
+		outs, vouts, vaults, err := k.getOutputsForMintNonBtc(ctx, tx.MsgTx(), assetType, edicts[0].Output, chainCfg)
+		if err != nil {
+			return assetType, nil, err
+		}
+
+		if err := k.mintRunes(ctx, tx, height, recipient.EncodeAddress(), vaults, outs, vouts, edicts); err != nil {
+			return assetType, nil, err
+		}
+
+	case types.AssetType_ASSET_TYPE_BRC20:
+		outs, vouts, vaults, err := k.getOutputsForMintNonBtc(ctx, tx.MsgTx(), assetType, 0, chainCfg)
 		if err != nil {
 			return assetType, nil, err
 		}
 
-		if err := k.mintRunes(ctx, tx, height, recipient.EncodeAddress(), vaults, outs, vouts, edict.Id, edict.Amount); err != nil {
+		if err := k.mintBrc20(ctx, tx, height, recipient.EncodeAddress(), vaults, outs, vouts, brc20Transfer); err != nil {
 			return assetType, nil, err
 		}
 	}
 
+	// move the deposit assignment to the next active vault
+	k.IncrementDepositVaultCursor(ctx, assetType)
+
 	return assetType, recipient, nil
 }
 
@@ -113,13 +142,14 @@ func (k Keeper) mintBTC(ctx sdk.Context, tx *btcutil.Tx, height uint64, recipien
 	}
 
 	utxo := types.UTXO{
-		Txid:         tx.Hash().String(),
-		Vout:         uint64(vout),
-		Amount:       uint64(out.Value),
-		PubKeyScript: out.PkScript,
-		Height:       height,
-		Address:      vault,
-		IsLocked:     false,
+		Txid:          tx.Hash().String(),
+		Vout:          uint64(vout),
+		Amount:        uint64(out.Value),
+		PubKeyScript:  out.PkScript,
+		Height:        height,
+		Address:       vault,
+		IsLocked:      false,
+		WitnessScript: k.GetVaultWitnessScript(ctx, vault),
 	}
 
 	k.saveUTXO(ctx, &utxo)
@@ -127,40 +157,99 @@ func (k Keeper) mintBTC(ctx sdk.Context, tx *btcutil.Tx, height uint64, recipien
 	return nil
 }
 
-func (k Keeper) mintRunes(ctx sdk.Context, tx *btcutil.Tx, height uint64, recipient string, vaults []string, outs []*wire.TxOut, vouts []int, id *types.RuneId, amount string) error {
-	coins := sdk.NewCoins(sdk.NewCoin(id.Denom(), sdkmath.NewIntFromBigInt(types.RuneAmountFromString(amount).Big())))
+// mintRunes mints the voucher token for each rune deposited to the same vault output
+func (k Keeper) mintRunes(ctx sdk.Context, tx *btcutil.Tx, height uint64, recipient string, vaults []string, outs []*wire.TxOut, vouts []int, edicts []*types.Edict) error {
+	coins := sdk.NewCoins()
+	runeBalances := make([]*types.RuneBalance, 0, len(edicts))
+	configs := make([]*types.RuneConfig, 0, len(edicts))
+
+	for _, edict := range edicts {
+		config, err := k.GetEnabledRuneConfig(ctx, edict.Id.ToString())
+		if err != nil {
+			return err
+		}
+
+		amount := sdkmath.NewIntFromBigInt(types.RuneAmountFromString(edict.Amount).Big())
+		if err := config.CheckDepositAmount(amount); err != nil {
+			return err
+		}
+
+		configs = append(configs, config)
+		coins = coins.Add(sdk.NewCoin(edict.Id.Denom(), amount))
+
+		runeBalances = append(runeBalances, &types.RuneBalance{
+			Id:     edict.Id.ToString(),
+			Amount: edict.Amount,
+		})
+	}
 
 	recipientAddr, err := sdk.AccAddressFromBech32(recipient)
 	if err != nil {
 		return err
 	}
 
-	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
+	if err := k.mintWithProtocolFee(ctx, recipientAddr, coins); err != nil {
 		return err
 	}
 
-	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipientAddr, coins); err != nil {
+	for _, config := range configs {
+		if err := config.CheckSupply(k.bankKeeper.GetSupply(ctx, types.RunesDenomFromId(config.Id)).Amount); err != nil {
+			return err
+		}
+	}
+
+	if err := k.mintAttachedBtc(ctx, tx.Hash().String(), height, recipientAddr, outs[1], vouts[1], vaults[1]); err != nil {
 		return err
 	}
 
-	if k.ProtocolDepositFeeEnabled(ctx) {
-		if err := k.handleRunesProtocolFee(ctx, tx.Hash().String(), height, outs[1], vouts[1], vaults[1]); err != nil {
-			return err
-		}
+	utxo := types.UTXO{
+		Txid:          tx.Hash().String(),
+		Vout:          uint64(vouts[0]),
+		Amount:        uint64(outs[0].Value),
+		PubKeyScript:  outs[0].PkScript,
+		Height:        height,
+		Address:       vaults[0],
+		IsLocked:      false,
+		WitnessScript: k.GetVaultWitnessScript(ctx, vaults[0]),
+		Runes:         runeBalances,
+	}
+
+	k.saveUTXO(ctx, &utxo)
+
+	return nil
+}
+
+func (k Keeper) mintBrc20(ctx sdk.Context, tx *btcutil.Tx, height uint64, recipient string, vaults []string, outs []*wire.TxOut, vouts []int, transfer *types.Brc20Transfer) error {
+	if err := k.CheckBrc20Amount(ctx, transfer.Tick, transfer.Amount()); err != nil {
+		return err
+	}
+
+	coins := sdk.NewCoins(sdk.NewCoin(transfer.Denom(), transfer.Amount()))
+
+	recipientAddr, err := sdk.AccAddressFromBech32(recipient)
+	if err != nil {
+		return err
+	}
+
+	if err := k.mintWithProtocolFee(ctx, recipientAddr, coins); err != nil {
+		return err
 	}
 
+	if err := k.mintAttachedBtc(ctx, tx.Hash().String(), height, recipientAddr, outs[1], vouts[1], vaults[1]); err != nil {
+		return err
+	}
+
+	// the transfer inscription is consumed once received by the vault
+	// so the inscription output can be treated as the common utxo of the brc20 vault
 	utxo := types.UTXO{
-		Txid:         tx.Hash().String(),
-		Vout:         uint64(vouts[0]),
-		Amount:       uint64(outs[0].Value),
-		PubKeyScript: outs[0].PkScript,
-		Height:       height,
-		Address:      vaults[0],
-		IsLocked:     false,
-		Runes: []*types.RuneBalance{{
-			Id:     id.ToString(),
-			Amount: amount,
-		}},
+		Txid:          tx.Hash().String(),
+		Vout:          uint64(vouts[0]),
+		Amount:        uint64(outs[0].Value),
+		PubKeyScript:  outs[0].PkScript,
+		Height:        height,
+		Address:       vaults[0],
+		IsLocked:      false,
+		WitnessScript: k.GetVaultWitnessScript(ctx, vaults[0]),
 	}
 
 	k.saveUTXO(ctx, &utxo)
@@ -170,21 +259,22 @@ func (k Keeper) mintRunes(ctx sdk.Context, tx *btcutil.Tx, height uint64, recipi
 
 // mintBTCWithProtocolFee performs btc minting along with the protocol fee handling
 func (k Keeper) mintBTCWithProtocolFee(ctx sdk.Context, recipient sdk.AccAddress, amount sdk.Coin) error {
+	if err := k.CheckAssetsNotPaused(ctx, sdk.NewCoins(amount)); err != nil {
+		return err
+	}
+
 	params := k.GetParams(ctx)
 
 	var err error
 	depositAmount := amount
 
-	if k.ProtocolDepositFeeEnabled(ctx) {
-		protocolFee := sdk.NewInt64Coin(params.BtcVoucherDenom, params.ProtocolFees.DepositFee)
-		protocolFeeCollector := sdk.MustAccAddressFromBech32(params.ProtocolFees.Collector)
-
+	if protocolFee := k.GetProtocolDepositFee(ctx, amount); protocolFee.IsPositive() {
 		depositAmount, err = depositAmount.SafeSub(protocolFee)
 		if err != nil {
-			return err
+			return types.ErrInvalidDepositAmount
 		}
 
-		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, protocolFeeCollector, sdk.NewCoins(protocolFee)); err != nil {
+		if err := k.collectProtocolFeesFromModule(ctx, sdk.NewCoins(protocolFee)); err != nil {
 			return err
 		}
 	}
@@ -196,36 +286,61 @@ func (k Keeper) mintBTCWithProtocolFee(ctx sdk.Context, recipient sdk.AccAddress
 	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, sdk.NewCoins(depositAmount))
 }
 
-// handleRunesProtocolFee performs the protocol fee handling for runes deposit
-// Assume that the protocol deposit fee is enabled
-func (k Keeper) handleRunesProtocolFee(ctx sdk.Context, txHash string, height uint64, btcOut *wire.TxOut, btcVout int, btcVault string) error {
-	params := k.GetParams(ctx)
+// mintWithProtocolFee mints the given non-btc voucher tokens along with the protocol fee handling
+// The protocol fee is charged in the same denom as the asset
+func (k Keeper) mintWithProtocolFee(ctx sdk.Context, recipient sdk.AccAddress, coins sdk.Coins) error {
+	if err := k.CheckAssetsNotPaused(ctx, coins); err != nil {
+		return err
+	}
 
-	btcAmount := sdk.NewInt64Coin(params.BtcVoucherDenom, btcOut.Value)
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
+		return err
+	}
 
-	protocolFee := sdk.NewInt64Coin(params.BtcVoucherDenom, params.ProtocolFees.DepositFee)
-	protocolFeeCollector := sdk.MustAccAddressFromBech32(params.ProtocolFees.Collector)
+	protocolFees := sdk.NewCoins()
+	for _, coin := range coins {
+		protocolFees = protocolFees.Add(k.GetProtocolDepositFee(ctx, coin))
+	}
 
-	if btcAmount.IsLT(protocolFee) {
+	depositAmount, hasNeg := coins.SafeSub(protocolFees...)
+	if hasNeg || len(depositAmount) != len(coins) {
 		return types.ErrInvalidDepositAmount
 	}
 
-	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(btcAmount)); err != nil {
+	if !protocolFees.IsZero() {
+		if err := k.collectProtocolFeesFromModule(ctx, protocolFees); err != nil {
+			return err
+		}
+	}
+
+	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, depositAmount)
+}
+
+// mintAttachedBtc mints the btc voucher token for the output to the btc vault attached to the non-btc deposit if any
+func (k Keeper) mintAttachedBtc(ctx sdk.Context, txHash string, height uint64, recipient sdk.AccAddress, btcOut *wire.TxOut, btcVout int, btcVault string) error {
+	if btcOut == nil {
+		return nil
+	}
+
+	btcAmount := sdk.NewCoins(sdk.NewInt64Coin(k.BtcDenom(ctx), btcOut.Value))
+
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, btcAmount); err != nil {
 		return err
 	}
 
-	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, protocolFeeCollector, sdk.NewCoins(protocolFee)); err != nil {
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, btcAmount); err != nil {
 		return err
 	}
 
 	utxo := types.UTXO{
-		Txid:         txHash,
-		Vout:         uint64(btcVout),
-		Amount:       uint64(btcOut.Value),
-		PubKeyScript: btcOut.PkScript,
-		Height:       height,
-		Address:      btcVault,
-		IsLocked:     false,
+		Txid:          txHash,
+		Vout:          uint64(btcVout),
+		Amount:        uint64(btcOut.Value),
+		PubKeyScript:  btcOut.PkScript,
+		Height:        height,
+		Address:       btcVault,
+		IsLocked:      false,
+		WitnessScript: k.GetVaultWitnessScript(ctx, btcVault),
 	}
 
 	k.saveUTXO(ctx, &utxo)
@@ -259,6 +374,10 @@ func (k Keeper) getOutputForMintBTC(ctx sdk.Context, tx *wire.MsgTx, chainCfg *c
 		}
 
 		if vault.AssetType == types.AssetType_ASSET_TYPE_BTC {
+			if vault.IsRetired(ctx.BlockTime()) {
+				return nil, 0, "", types.ErrVaultRetired
+			}
+
 			return out, i, vault.Address, nil
 		}
 	}
@@ -266,7 +385,9 @@ func (k Keeper) getOutputForMintBTC(ctx sdk.Context, tx *wire.MsgTx, chainCfg *c
 	return nil, 0, "", types.ErrInvalidDepositTransaction
 }
 
-func (k Keeper) getOutputsForMintRunes(ctx sdk.Context, tx *wire.MsgTx, edict *types.Edict, chainCfg *chaincfg.Params) ([]*wire.TxOut, []int, []string, error) {
+// getOutputsForMintNonBtc gets the vault outputs for minting the non-btc voucher token.
+// The first is the output to the vault of the given asset type at the expected index, the second is the attached output to the btc vault if any.
+func (k Keeper) getOutputsForMintNonBtc(ctx sdk.Context, tx *wire.MsgTx, assetType types.AssetType, expectedVout uint32, chainCfg *chaincfg.Params) ([]*wire.TxOut, []int, []string, error) {
 	params := k.GetParams(ctx)
 
 	outs := make([]*wire.TxOut, 2)
@@ -295,8 +416,12 @@ func (k Keeper) getOutputsForMintRunes(ctx sdk.Context, tx *wire.MsgTx, edict *t
 			continue
 		}
 
+		if vault.IsRetired(ctx.BlockTime()) && (vault.AssetType == assetType || vault.AssetType == types.AssetType_ASSET_TYPE_BTC) {
+			return nil, nil, nil, types.ErrVaultRetired
+		}
+
 		switch vault.AssetType {
-		case types.AssetType_ASSET_TYPE_RUNES:
+		case assetType:
 			outs[0] = out
 			vouts[0] = i
 			vaults[0] = vault.Address
@@ -308,11 +433,7 @@ func (k Keeper) getOutputsForMintRunes(ctx sdk.Context, tx *wire.MsgTx, edict *t
 		}
 	}
 
-	if outs[0] == nil || vouts[0] != int(edict.Output) {
-		return nil, nil, nil, types.ErrInvalidDepositTransaction
-	}
-
-	if k.ProtocolDepositFeeEnabled(ctx) && outs[1] == nil {
+	if outs[0] == nil || vouts[0] != int(expectedVout) {
 		return nil, nil, nil, types.ErrInvalidDepositTransaction
 	}
 
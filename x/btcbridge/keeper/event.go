@@ -6,6 +6,8 @@ import (
 	"github.com/sideprotocol/side/x/btcbridge/types"
 )
 
+// EmitEvent emits the generic module event with the given attributes
+// The generic event is kept alongside the typed events for compatibility and will be removed in the next release
 func (k Keeper) EmitEvent(ctx sdk.Context, sender string, attr ...sdk.Attribute) {
 	headerAttr := []sdk.Attribute{
 		{
@@ -0,0 +1,244 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/sideprotocol/side/x/btcbridge/types"
+)
+
+// collectProtocolFeesFromModule collects the given protocol fees from the module account to the fee pool
+func (k Keeper) collectProtocolFeesFromModule(ctx sdk.Context, fees sdk.Coins) error {
+	return k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, types.ProtocolFeePoolName, fees)
+}
+
+// collectProtocolFeesFromAccount collects the given protocol fees from the given account to the fee pool
+func (k Keeper) collectProtocolFeesFromAccount(ctx sdk.Context, sender sdk.AccAddress, fees sdk.Coins) error {
+	return k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ProtocolFeePoolName, fees)
+}
+
+// GetAccruedProtocolFees gets the protocol fees accrued in the fee pool and pending settlement
+func (k Keeper) GetAccruedProtocolFees(ctx sdk.Context) sdk.Coins {
+	return k.bankKeeper.GetAllBalances(ctx, authtypes.NewModuleAddress(types.ProtocolFeePoolName))
+}
+
+// SettleProtocolFees distributes the accrued protocol fees to the buckets by weight
+// The relayer pool and signer pool are paid out to the recipients afterwards
+func (k Keeper) SettleProtocolFees(ctx sdk.Context) error {
+	fees := k.GetAccruedProtocolFees(ctx)
+	if !fees.IsZero() {
+		distribution := k.GetParams(ctx).ProtocolFees.Distribution
+		shares := distribution.Split(fees)
+
+		for _, bucket := range types.FeeBuckets() {
+			share := shares[bucket]
+			if share.IsZero() {
+				continue
+			}
+
+			if err := k.distributeProtocolFees(ctx, bucket, share); err != nil {
+				return err
+			}
+
+			k.addFeeBucketStats(ctx, bucket, share, nil)
+
+			// the fees reach the final recipients except for the relayer pool and signer pool
+			if bucket != types.FeeBucket_FEE_BUCKET_RELAYER_POOL && bucket != types.FeeBucket_FEE_BUCKET_SIGNER_POOL {
+				k.addFeeBucketStats(ctx, bucket, nil, share)
+			}
+
+			k.EmitEvent(ctx, types.ProtocolFeePoolName,
+				sdk.NewAttribute("bucket", bucket.String()),
+				sdk.NewAttribute("amount", share.String()),
+			)
+		}
+	}
+
+	if err := k.payoutFeePool(ctx, types.FeeBucket_FEE_BUCKET_RELAYER_POOL, types.RelayerPoolName, k.getRelayerPoolRecipients(ctx)); err != nil {
+		return err
+	}
+
+	if err := k.payoutFeePool(ctx, types.FeeBucket_FEE_BUCKET_SIGNER_POOL, types.SignerPoolName, k.getSignerPoolRecipients(ctx)); err != nil {
+		return err
+	}
+
+	k.SetLastFeeSettlementHeight(ctx, ctx.BlockHeight())
+
+	return nil
+}
+
+// distributeProtocolFees sends the given protocol fees from the fee pool to the given bucket
+func (k Keeper) distributeProtocolFees(ctx sdk.Context, bucket types.FeeBucket, fees sdk.Coins) error {
+	switch bucket {
+	case types.FeeBucket_FEE_BUCKET_COMMUNITY_POOL:
+		return k.distrKeeper.FundCommunityPool(ctx, fees, authtypes.NewModuleAddress(types.ProtocolFeePoolName))
+
+	case types.FeeBucket_FEE_BUCKET_RELAYER_POOL:
+		return k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ProtocolFeePoolName, types.RelayerPoolName, fees)
+
+	case types.FeeBucket_FEE_BUCKET_SIGNER_POOL:
+		return k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ProtocolFeePoolName, types.SignerPoolName, fees)
+
+	case types.FeeBucket_FEE_BUCKET_INSURANCE:
+		return k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ProtocolFeePoolName, types.InsurancePoolName, fees)
+
+	case types.FeeBucket_FEE_BUCKET_COLLECTOR:
+		collector, err := sdk.AccAddressFromBech32(k.ProtocolFeeCollector(ctx))
+		if err != nil {
+			return err
+		}
+
+		return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ProtocolFeePoolName, collector, fees)
+
+	default:
+		return fmt.Errorf("unknown fee bucket %s", bucket)
+	}
+}
+
+// payoutFeePool pays out the balance of the given pool to the given recipients equally
+// The remainder due to rounding is left for the next settlement
+// The balance is kept in the pool if no recipient
+func (k Keeper) payoutFeePool(ctx sdk.Context, bucket types.FeeBucket, poolName string, recipients []sdk.AccAddress) error {
+	if len(recipients) == 0 {
+		return nil
+	}
+
+	balance := k.bankKeeper.GetAllBalances(ctx, authtypes.NewModuleAddress(poolName))
+
+	reward := sdk.NewCoins()
+	for _, coin := range balance {
+		reward = reward.Add(sdk.NewCoin(coin.Denom, coin.Amount.QuoRaw(int64(len(recipients)))))
+	}
+
+	if reward.IsZero() {
+		return nil
+	}
+
+	for _, recipient := range recipients {
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, poolName, recipient, reward); err != nil {
+			return err
+		}
+
+		k.EmitEvent(ctx, poolName,
+			sdk.NewAttribute("bucket", bucket.String()),
+			sdk.NewAttribute("recipient", recipient.String()),
+			sdk.NewAttribute("amount", reward.String()),
+		)
+	}
+
+	paid := sdk.NewCoins()
+	for _, coin := range reward {
+		paid = paid.Add(sdk.NewCoin(coin.Denom, coin.Amount.MulRaw(int64(len(recipients)))))
+	}
+
+	k.addFeeBucketStats(ctx, bucket, nil, paid)
+
+	return nil
+}
+
+// getRelayerPoolRecipients gets the trusted btc and non-btc relayers as the recipients of the relayer pool
+func (k Keeper) getRelayerPoolRecipients(ctx sdk.Context) []sdk.AccAddress {
+	params := k.GetParams(ctx)
+
+	recipients := []sdk.AccAddress{}
+	relayers := make(map[string]bool)
+
+	for _, relayer := range append(params.TrustedBtcRelayers, params.TrustedNonBtcRelayers...) {
+		if relayers[relayer] {
+			continue
+		}
+
+		relayers[relayer] = true
+
+		addr, err := sdk.AccAddressFromBech32(relayer)
+		if err != nil {
+			continue
+		}
+
+		recipients = append(recipients, addr)
+	}
+
+	return recipients
+}
+
+// getSignerPoolRecipients gets the operator accounts of the participants of the latest completed DKG as the recipients of the signer pool
+func (k Keeper) getSignerPoolRecipients(ctx sdk.Context) []sdk.AccAddress {
+	req := k.GetLatestCompletedDKGRequest(ctx)
+	if req == nil {
+		return nil
+	}
+
+	recipients := make([]sdk.AccAddress, 0, len(req.Participants))
+
+	for _, p := range req.Participants {
+		valAddr, err := sdk.ValAddressFromBech32(p.OperatorAddress)
+		if err != nil {
+			continue
+		}
+
+		recipients = append(recipients, sdk.AccAddress(valAddr))
+	}
+
+	return recipients
+}
+
+// GetFeeBucketStats gets the accumulated and distributed protocol fees of each bucket
+func (k Keeper) GetFeeBucketStats(ctx sdk.Context) []*types.FeeBucketStats {
+	stats := make([]*types.FeeBucketStats, 0, len(types.FeeBuckets()))
+
+	for _, bucket := range types.FeeBuckets() {
+		stats = append(stats, k.getFeeBucketStats(ctx, bucket))
+	}
+
+	return stats
+}
+
+// getFeeBucketStats gets the protocol fee statistics of the given bucket
+func (k Keeper) getFeeBucketStats(ctx sdk.Context, bucket types.FeeBucket) *types.FeeBucketStats {
+	store := ctx.KVStore(k.storeKey)
+
+	stats := types.FeeBucketStats{
+		Bucket:      bucket,
+		Accumulated: sdk.NewCoins(),
+		Distributed: sdk.NewCoins(),
+	}
+
+	bz := store.Get(types.FeeBucketStatsKey(bucket))
+	if bz != nil {
+		k.cdc.MustUnmarshal(bz, &stats)
+	}
+
+	return &stats
+}
+
+// addFeeBucketStats adds the given protocol fees to the accumulated and distributed totals of the given bucket
+func (k Keeper) addFeeBucketStats(ctx sdk.Context, bucket types.FeeBucket, accumulated sdk.Coins, distributed sdk.Coins) {
+	store := ctx.KVStore(k.storeKey)
+
+	stats := k.getFeeBucketStats(ctx, bucket)
+	stats.Accumulated = stats.Accumulated.Add(accumulated...)
+	stats.Distributed = stats.Distributed.Add(distributed...)
+
+	store.Set(types.FeeBucketStatsKey(bucket), k.cdc.MustMarshal(stats))
+}
+
+// GetLastFeeSettlementHeight gets the block height of the last protocol fee settlement
+func (k Keeper) GetLastFeeSettlementHeight(ctx sdk.Context) int64 {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.LastFeeSettlementHeightKey)
+	if bz == nil {
+		return 0
+	}
+
+	return int64(sdk.BigEndianToUint64(bz))
+}
+
+// SetLastFeeSettlementHeight sets the block height of the last protocol fee settlement
+func (k Keeper) SetLastFeeSettlementHeight(ctx sdk.Context, height int64) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Set(types.LastFeeSettlementHeightKey, sdk.Uint64ToBigEndian(uint64(height)))
+}
@@ -0,0 +1,555 @@
+package keeper
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"slices"
+	"strings"
+
+	secp256k1 "github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil/psbt"
+	"github.com/btcsuite/btcd/txscript"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sideprotocol/side/x/btcbridge/types"
+)
+
+// SetFrostVaultKey sets the FROST key of the vault
+func (k Keeper) SetFrostVaultKey(ctx sdk.Context, key *types.FrostVaultKey) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := k.cdc.MustMarshal(key)
+	store.Set(types.FrostVaultKeyKey(key.Vault), bz)
+}
+
+// GetFrostVaultKey gets the FROST key of the given vault
+func (k Keeper) GetFrostVaultKey(ctx sdk.Context, vault string) *types.FrostVaultKey {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.FrostVaultKeyKey(vault))
+	if bz == nil {
+		return nil
+	}
+
+	var key types.FrostVaultKey
+	k.cdc.MustUnmarshal(bz, &key)
+
+	return &key
+}
+
+// GetAllFrostVaultKeys gets all FROST vault keys
+func (k Keeper) GetAllFrostVaultKeys(ctx sdk.Context) []*types.FrostVaultKey {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := storetypes.KVStorePrefixIterator(store, types.FrostVaultKeyKeyPrefix)
+	defer iterator.Close()
+
+	keys := make([]*types.FrostVaultKey, 0)
+
+	for ; iterator.Valid(); iterator.Next() {
+		var key types.FrostVaultKey
+		k.cdc.MustUnmarshal(iterator.Value(), &key)
+
+		keys = append(keys, &key)
+	}
+
+	return keys
+}
+
+// SetFrostVaultKeys sets the FROST keys of the vaults generated by the given DKG request
+// Only the vaults with the verification shares are set
+func (k Keeper) SetFrostVaultKeys(ctx sdk.Context, req *types.DKGRequest, completionReq *types.DKGCompletionRequest) {
+	for i, shares := range completionReq.VerificationShares {
+		if len(shares.Shares) == 0 {
+			continue
+		}
+
+		pubKey, err := (&types.Vault{PubKey: completionReq.PubKeys[i]}).InternalKey()
+		if err != nil {
+			continue
+		}
+
+		// the verified aggregate public key with the actual y coordinate
+		groupKey, err := types.VerifyVerificationShares(pubKey, shares.Shares, req.Threshold)
+		if err != nil {
+			continue
+		}
+
+		signers := make([]types.FrostSigner, len(req.Participants))
+		for j, p := range req.Participants {
+			signers[j] = types.FrostSigner{
+				OperatorAddress:   p.OperatorAddress,
+				Index:             uint32(j + 1),
+				VerificationShare: shares.Shares[j],
+			}
+		}
+
+		k.SetFrostVaultKey(ctx, &types.FrostVaultKey{
+			Vault:     completionReq.Vaults[i],
+			PubKey:    hex.EncodeToString(groupKey.SerializeCompressed()),
+			Threshold: req.Threshold,
+			Signers:   signers,
+		})
+	}
+}
+
+// SetFrostSigningSession sets the FROST signing session
+// The session is indexed as active until completed
+func (k Keeper) SetFrostSigningSession(ctx sdk.Context, session *types.FrostSigningSession) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := k.cdc.MustMarshal(session)
+	store.Set(types.FrostSigningSessionKey(session.Txid), bz)
+
+	if session.Status == types.FrostSigningStatus_FROST_SIGNING_STATUS_COMPLETED || session.Status == types.FrostSigningStatus_FROST_SIGNING_STATUS_FAILED {
+		store.Delete(types.ActiveFrostSigningKey(session.Txid))
+	} else {
+		store.Set(types.ActiveFrostSigningKey(session.Txid), []byte{})
+	}
+}
+
+// GetFrostSigningSession gets the FROST signing session of the given signing request
+func (k Keeper) GetFrostSigningSession(ctx sdk.Context, txid string) *types.FrostSigningSession {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.FrostSigningSessionKey(txid))
+	if bz == nil {
+		return nil
+	}
+
+	var session types.FrostSigningSession
+	k.cdc.MustUnmarshal(bz, &session)
+
+	return &session
+}
+
+// SubmitNonceCommitments submits the FROST nonce commitments of the given signer for the signing request
+// The signing set is fixed once the threshold commitments are collected
+func (k Keeper) SubmitNonceCommitments(ctx sdk.Context, operatorAddress string, txid string, commitments []types.FrostNonceCommitment) (*types.FrostSigningSession, error) {
+	_, p, inputs, err := k.getFrostSigningInputs(ctx, txid)
+	if err != nil {
+		return nil, err
+	}
+
+	session := k.GetFrostSigningSession(ctx, txid)
+	if session == nil {
+		session = &types.FrostSigningSession{
+			Txid:   txid,
+			Status: types.FrostSigningStatus_FROST_SIGNING_STATUS_COMMITMENT,
+			Round:  1,
+		}
+
+		session.RoundStartTime = ctx.BlockTime()
+
+		for _, input := range inputs {
+			session.Threshold = max(session.Threshold, input.Key.Threshold)
+		}
+	}
+
+	if session.Status != types.FrostSigningStatus_FROST_SIGNING_STATUS_COMMITMENT {
+		return nil, errorsmod.Wrap(types.ErrInvalidFrostSigning, "not in the commitment phase")
+	}
+
+	if slices.Contains(session.MisbehavingSigners, operatorAddress) {
+		return nil, errorsmod.Wrap(types.ErrInvalidFrostSigning, "misbehaving signer")
+	}
+
+	if getFrostSignerRound(session, operatorAddress) != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidFrostSigning, "nonce commitments already submitted")
+	}
+
+	if len(commitments) != len(p.Inputs) {
+		return nil, errorsmod.Wrap(types.ErrInvalidFrostSigning, "mismatched nonce commitments")
+	}
+
+	if !isFrostSigner(inputs, operatorAddress) {
+		return nil, errorsmod.Wrap(types.ErrInvalidFrostSigning, "not a signer")
+	}
+
+	session.Signers = append(session.Signers, types.FrostSignerRound{
+		OperatorAddress: operatorAddress,
+		Commitments:     commitments,
+	})
+
+	if uint32(len(session.Signers)) >= session.Threshold {
+		session.Status = types.FrostSigningStatus_FROST_SIGNING_STATUS_SIGNING
+		session.RoundStartTime = ctx.BlockTime()
+	}
+
+	k.SetFrostSigningSession(ctx, session)
+
+	return session, nil
+}
+
+// SubmitPartialSignatures submits the FROST partial signatures of the given signer for the signing request
+// The signer is recorded as misbehaving and the session restarts without the signer if any partial signature is invalid
+// The signing request fails if the threshold can no longer be reached without the misbehaving signers
+// The partial signatures are aggregated into the final signatures once all signers of the signing set submit
+func (k Keeper) SubmitPartialSignatures(ctx sdk.Context, operatorAddress string, txid string, partialSigs []string) (*types.FrostSigningSession, error) {
+	signingRequest, p, inputs, err := k.getFrostSigningInputs(ctx, txid)
+	if err != nil {
+		return nil, err
+	}
+
+	session := k.GetFrostSigningSession(ctx, txid)
+	if session == nil {
+		return nil, types.ErrFrostSigningSessionDoesNotExist
+	}
+
+	if session.Status != types.FrostSigningStatus_FROST_SIGNING_STATUS_SIGNING {
+		return nil, errorsmod.Wrap(types.ErrInvalidFrostSigning, "not in the signing phase")
+	}
+
+	signerRound := getFrostSignerRound(session, operatorAddress)
+	if signerRound == nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidFrostSigning, "not in the signing set")
+	}
+
+	if len(signerRound.PartialSignatures) != 0 {
+		return nil, errorsmod.Wrap(types.ErrInvalidFrostSigning, "partial signatures already submitted")
+	}
+
+	if len(partialSigs) != len(inputs) {
+		return nil, errorsmod.Wrap(types.ErrInvalidFrostSigning, "mismatched partial signatures")
+	}
+
+	for i, input := range inputs {
+		commitments, err := getFrostSignerCommitments(session, input, i)
+		if err != nil {
+			return nil, err
+		}
+
+		partialSig, err := types.ParseFrostScalar(partialSigs[i])
+		if err != nil {
+			return nil, err
+		}
+
+		if err := input.VerifyPartialSignature(commitments, input.Signer(operatorAddress).Index, partialSig); err != nil {
+			k.restartFrostSigningSession(ctx, session, signingRequest, inputs, operatorAddress)
+
+			return session, nil
+		}
+	}
+
+	signerRound.PartialSignatures = partialSigs
+
+	k.RecordSigningRequestTransition(ctx, signingRequest, types.SigningTransition_SIGNING_TRANSITION_SIGNATURES_SUBMITTED, operatorAddress)
+
+	for _, signer := range session.Signers {
+		if len(signer.PartialSignatures) == 0 {
+			k.SetFrostSigningSession(ctx, session)
+
+			return session, nil
+		}
+	}
+
+	if err := k.aggregateFrostSignatures(ctx, session, signingRequest, p, inputs); err != nil {
+		return nil, err
+	}
+
+	return session, nil
+}
+
+// aggregateFrostSignatures aggregates the partial signatures into the final signatures and completes the signing request
+func (k Keeper) aggregateFrostSignatures(ctx sdk.Context, session *types.FrostSigningSession, signingRequest *types.SigningRequest, p *psbt.Packet, inputs []*types.FrostSigningInput) error {
+	for i, input := range inputs {
+		commitments, err := getFrostSignerCommitments(session, input, i)
+		if err != nil {
+			return err
+		}
+
+		partialSigs := make([]*secp256k1.ModNScalar, len(session.Signers))
+		for j, signer := range session.Signers {
+			partialSig, err := types.ParseFrostScalar(signer.PartialSignatures[i])
+			if err != nil {
+				return err
+			}
+
+			partialSigs[j] = partialSig
+		}
+
+		sig, err := input.AggregateSignatures(commitments, partialSigs)
+		if err != nil {
+			return err
+		}
+
+		p.Inputs[i].TaprootKeySpendSig = sig.Serialize()
+	}
+
+	if err := psbt.MaybeFinalizeAll(p); err != nil {
+		return errorsmod.Wrap(types.ErrInvalidSignatures, err.Error())
+	}
+
+	if !types.VerifyPsbtSignatures(p) {
+		return errorsmod.Wrap(types.ErrInvalidSignatures, "failed to verify")
+	}
+
+	psbtB64, err := p.B64Encode()
+	if err != nil {
+		return types.ErrFailToSerializePsbt
+	}
+
+	// set the signing request status to broadcasted
+	signingRequest.Psbt = psbtB64
+	signingRequest.Status = types.SigningStatus_SIGNING_STATUS_BROADCASTED
+
+	k.SetSigningRequest(ctx, signingRequest)
+
+	session.Status = types.FrostSigningStatus_FROST_SIGNING_STATUS_COMPLETED
+	k.SetFrostSigningSession(ctx, session)
+
+	k.EmitEvent(ctx, k.authority,
+		sdk.NewAttribute("txid", session.Txid),
+		sdk.NewAttribute("frost_signing", "completed"),
+		sdk.NewAttribute("round", fmt.Sprintf("%d", session.Round)),
+	)
+
+	return nil
+}
+
+// restartFrostSigningSession restarts the FROST signing session without the given misbehaving signer
+// The misbehaving signer is recorded as missing the signing round
+// The signing round of the signing request is recorded as replaced by the new round
+// The session and the signing request fail if the remaining signers are less than the threshold
+func (k Keeper) restartFrostSigningSession(ctx sdk.Context, session *types.FrostSigningSession, signingRequest *types.SigningRequest, inputs []*types.FrostSigningInput, misbehavingSigner string) {
+	session.MisbehavingSigners = append(session.MisbehavingSigners, misbehavingSigner)
+	session.Signers = nil
+	session.Status = types.FrostSigningStatus_FROST_SIGNING_STATUS_COMMITMENT
+	session.Round++
+	session.RoundStartTime = ctx.BlockTime()
+
+	if getFrostEligibleSignerCount(session, inputs) < session.Threshold {
+		session.Status = types.FrostSigningStatus_FROST_SIGNING_STATUS_FAILED
+
+		signingRequest.Status = types.SigningStatus_SIGNING_STATUS_FAILED
+		k.SetSigningRequest(ctx, signingRequest)
+	} else {
+		k.RecordSigningRequestTransition(ctx, signingRequest, types.SigningTransition_SIGNING_TRANSITION_REPLACED, misbehavingSigner)
+	}
+
+	k.SetFrostSigningSession(ctx, session)
+
+	k.RecordMissedSigning(ctx, misbehavingSigner)
+
+	k.EmitEvent(ctx, k.authority,
+		sdk.NewAttribute("txid", session.Txid),
+		sdk.NewAttribute("frost_signing", "misbehaved"),
+		sdk.NewAttribute("misbehaving_signer", misbehavingSigner),
+		sdk.NewAttribute("round", fmt.Sprintf("%d", session.Round)),
+	)
+}
+
+// HandleFrostSigningTimeouts handles the active FROST signing sessions of which the current round phase timed out
+// In the commitment phase, the eligible signers not committing miss the round and the phase restarts
+// In the signing phase, the signers of the signing set not submitting the partial signatures miss the round and the session restarts
+// The signing round of the signing request is recorded as replaced when the session restarts
+func (k Keeper) HandleFrostSigningTimeouts(ctx sdk.Context) {
+	timeout := k.GetParams(ctx).TssParams.SignerLiveness.SigningRoundTimeout
+	if timeout == 0 {
+		return
+	}
+
+	for _, session := range k.GetActiveFrostSigningSessions(ctx) {
+		if ctx.BlockTime().Before(session.RoundStartTime.Add(timeout)) {
+			continue
+		}
+
+		signingRequest, _, inputs, err := k.getFrostSigningInputs(ctx, session.Txid)
+		if err != nil {
+			// the signing request is no longer signed by FROST
+			ctx.KVStore(k.storeKey).Delete(types.ActiveFrostSigningKey(session.Txid))
+			continue
+		}
+
+		var missingSigners []string
+
+		switch session.Status {
+		case types.FrostSigningStatus_FROST_SIGNING_STATUS_COMMITMENT:
+			for _, signer := range inputs[0].Key.Signers {
+				if getFrostSignerRound(session, signer.OperatorAddress) == nil && !slices.Contains(session.MisbehavingSigners, signer.OperatorAddress) && isFrostSigner(inputs, signer.OperatorAddress) {
+					missingSigners = append(missingSigners, signer.OperatorAddress)
+				}
+			}
+
+		case types.FrostSigningStatus_FROST_SIGNING_STATUS_SIGNING:
+			for _, signer := range session.Signers {
+				if len(signer.PartialSignatures) == 0 {
+					missingSigners = append(missingSigners, signer.OperatorAddress)
+				}
+			}
+
+			session.Signers = nil
+			session.Status = types.FrostSigningStatus_FROST_SIGNING_STATUS_COMMITMENT
+			session.Round++
+
+			k.RecordSigningRequestTransition(ctx, signingRequest, types.SigningTransition_SIGNING_TRANSITION_REPLACED, "")
+		}
+
+		session.RoundStartTime = ctx.BlockTime()
+		k.SetFrostSigningSession(ctx, session)
+
+		for _, signer := range missingSigners {
+			k.RecordMissedSigning(ctx, signer)
+		}
+
+		k.EmitEvent(ctx, k.authority,
+			sdk.NewAttribute("txid", session.Txid),
+			sdk.NewAttribute("frost_signing", "timedout"),
+			sdk.NewAttribute("missing_signers", strings.Join(missingSigners, ",")),
+			sdk.NewAttribute("round", fmt.Sprintf("%d", session.Round)),
+		)
+	}
+}
+
+// GetActiveFrostSigningSessions gets the FROST signing sessions not completed
+func (k Keeper) GetActiveFrostSigningSessions(ctx sdk.Context) []*types.FrostSigningSession {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := storetypes.KVStorePrefixIterator(store, types.ActiveFrostSigningKeyPrefix)
+	defer iterator.Close()
+
+	sessions := make([]*types.FrostSigningSession, 0)
+
+	for ; iterator.Valid(); iterator.Next() {
+		txid := string(iterator.Key()[len(types.ActiveFrostSigningKeyPrefix):])
+
+		if session := k.GetFrostSigningSession(ctx, txid); session != nil {
+			sessions = append(sessions, session)
+		}
+	}
+
+	return sessions
+}
+
+// getFrostSigningInputs gets the FROST signing inputs of the given pending signing request
+// All inputs must be spent by the key path of the taproot vaults with the FROST keys
+func (k Keeper) getFrostSigningInputs(ctx sdk.Context, txid string) (*types.SigningRequest, *psbt.Packet, []*types.FrostSigningInput, error) {
+	signingRequest := k.GetSigningRequestByTxHash(ctx, txid)
+	if signingRequest == nil {
+		return nil, nil, nil, types.ErrSigningRequestDoesNotExist
+	}
+
+	if signingRequest.Status != types.SigningStatus_SIGNING_STATUS_PENDING {
+		return nil, nil, nil, errorsmod.Wrap(types.ErrInvalidFrostSigning, "signing request not pending")
+	}
+
+	p, err := psbt.NewFromRawBytes(bytes.NewReader([]byte(signingRequest.Psbt)), true)
+	if err != nil {
+		return nil, nil, nil, types.ErrInvalidPsbt
+	}
+
+	prevOutputFetcher := txscript.NewMultiPrevOutFetcher(nil)
+
+	for i, txIn := range p.UnsignedTx.TxIn {
+		prevOutput := p.Inputs[i].WitnessUtxo
+		if prevOutput == nil {
+			return nil, nil, nil, errorsmod.Wrap(types.ErrInvalidPsbt, "witness utxo required")
+		}
+
+		prevOutputFetcher.AddPrevOut(txIn.PreviousOutPoint, prevOutput)
+	}
+
+	sigHashes := txscript.NewTxSigHashes(p.UnsignedTx, prevOutputFetcher)
+	vaults := k.GetParams(ctx).Vaults
+
+	inputs := make([]*types.FrostSigningInput, len(p.Inputs))
+
+	for i := range p.Inputs {
+		pkScript := p.Inputs[i].WitnessUtxo.PkScript
+		if !txscript.IsPayToTaproot(pkScript) {
+			return nil, nil, nil, errorsmod.Wrap(types.ErrInvalidFrostSigning, "non taproot input")
+		}
+
+		vault := types.SelectVaultByPkScript(vaults, pkScript)
+		if vault == nil {
+			return nil, nil, nil, types.ErrVaultDoesNotExist
+		}
+
+		key := k.GetFrostVaultKey(ctx, vault.Address)
+		if key == nil {
+			return nil, nil, nil, errorsmod.Wrapf(types.ErrInvalidFrostSigning, "frost key of vault %s does not exist", vault.Address)
+		}
+
+		sigHash, err := txscript.CalcTaprootSignatureHash(sigHashes, types.DefaultSigHashType, p.UnsignedTx, i, prevOutputFetcher)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+
+		input, err := types.NewFrostSigningInput(key, vault.TaprootTree, sigHash)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+
+		if !bytes.Equal(pkScript[2:], input.OutputKey.SerializeCompressed()[1:]) {
+			return nil, nil, nil, errorsmod.Wrap(types.ErrInvalidFrostSigning, "output key mismatch")
+		}
+
+		inputs[i] = input
+	}
+
+	return signingRequest, p, inputs, nil
+}
+
+// getFrostSignerRound gets the round data of the given signer in the session
+func getFrostSignerRound(session *types.FrostSigningSession, operatorAddress string) *types.FrostSignerRound {
+	for i := range session.Signers {
+		if session.Signers[i].OperatorAddress == operatorAddress {
+			return &session.Signers[i]
+		}
+	}
+
+	return nil
+}
+
+// getFrostEligibleSignerCount gets the number of the signers of all inputs not excluded from the session
+func getFrostEligibleSignerCount(session *types.FrostSigningSession, inputs []*types.FrostSigningInput) uint32 {
+	count := uint32(0)
+
+	for _, signer := range inputs[0].Key.Signers {
+		if isFrostSigner(inputs, signer.OperatorAddress) && !slices.Contains(session.MisbehavingSigners, signer.OperatorAddress) {
+			count++
+		}
+	}
+
+	return count
+}
+
+// isFrostSigner returns true if the given operator is the signer of all inputs, false otherwise
+func isFrostSigner(inputs []*types.FrostSigningInput, operatorAddress string) bool {
+	for _, input := range inputs {
+		if input.Signer(operatorAddress) == nil {
+			return false
+		}
+	}
+
+	return true
+}
+
+// getFrostSignerCommitments gets the nonce commitments of the signing set for the given input
+func getFrostSignerCommitments(session *types.FrostSigningSession, input *types.FrostSigningInput, inputIndex int) ([]*types.FrostSignerCommitment, error) {
+	commitments := make([]*types.FrostSignerCommitment, len(session.Signers))
+
+	for i, signer := range session.Signers {
+		hiding, err := types.ParseFrostPoint(signer.Commitments[inputIndex].Hiding)
+		if err != nil {
+			return nil, err
+		}
+
+		binding, err := types.ParseFrostPoint(signer.Commitments[inputIndex].Binding)
+		if err != nil {
+			return nil, err
+		}
+
+		commitments[i] = &types.FrostSignerCommitment{
+			Index:   input.Signer(signer.OperatorAddress).Index,
+			Hiding:  hiding,
+			Binding: binding,
+		}
+	}
+
+	return commitments, nil
+}
@@ -30,6 +30,8 @@ type (
 
 		bankKeeper      types.BankKeeper
 		stakingKeeper   types.StakingKeeper
+		slashingKeeper  types.SlashingKeeper
+		distrKeeper     types.DistributionKeeper
 		incentiveKeeper types.IncentiveKeeper
 
 		authority string
@@ -42,6 +44,8 @@ func NewKeeper(
 	memKey storetypes.StoreKey,
 	bankKeeper types.BankKeeper,
 	stakingKeeper types.StakingKeeper,
+	slashingKeeper types.SlashingKeeper,
+	distrKeeper types.DistributionKeeper,
 	incentiveKeeper types.IncentiveKeeper,
 	authority string,
 ) *Keeper {
@@ -51,6 +55,8 @@ func NewKeeper(
 		memKey:          memKey,
 		bankKeeper:      bankKeeper,
 		stakingKeeper:   stakingKeeper,
+		slashingKeeper:  slashingKeeper,
+		distrKeeper:     distrKeeper,
 		incentiveKeeper: incentiveKeeper,
 		BaseUTXOKeeper:  *NewBaseUTXOKeeper(cdc, storeKey),
 		authority:       authority,
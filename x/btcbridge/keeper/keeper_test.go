@@ -2,29 +2,50 @@ package keeper_test
 
 import (
 	"bytes"
+	"encoding/base64"
+	"encoding/hex"
 	"fmt"
+	"slices"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 	"lukechampine.com/uint128"
 
+	secp256k1 "github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
 	"github.com/btcsuite/btcd/btcutil"
 	"github.com/btcsuite/btcd/btcutil/psbt"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
 
+	sdkmath "cosmossdk.io/math"
 	"github.com/cosmos/btcutil/bech32"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/segwit"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	simapp "github.com/sideprotocol/side/app"
+	"github.com/sideprotocol/side/testutil/frost"
+	"github.com/sideprotocol/side/x/btcbridge/keeper"
+	btcbridge "github.com/sideprotocol/side/x/btcbridge/module"
 	"github.com/sideprotocol/side/x/btcbridge/types"
 )
 
 var (
 	InitCoinAmount = int64(1000000000000)
+
+	// default params encoded by the v1 codec with the flat protocol fees
+	v1ParamsHex = "08061006180620642a037361743001380158646a0708c801100a1864720e08d0860310b0ea011880cab5ee017a3308b8171088271a2b73696465313064303779323635676d6d757674347a30773961773838306a6e73723730306a7772776c673582010c0a040880a30512040880ea49"
+
+	// btc amount attached to the non-btc deposit
+	AttachedBtcAmount = int64(8000)
 )
 
 type KeeperTestSuite struct {
@@ -35,11 +56,15 @@ type KeeperTestSuite struct {
 
 	btcVault   string
 	runesVault string
+	brc20Vault string
 	sender     string
 
 	btcVaultPkScript   []byte
 	runesVaultPkScript []byte
+	brc20VaultPkScript []byte
 	senderPkScript     []byte
+
+	brc20VaultPubKey string
 }
 
 func (suite *KeeperTestSuite) SetupTest() {
@@ -55,8 +80,13 @@ func (suite *KeeperTestSuite) SetupTest() {
 	suite.runesVault, _ = bech32.Encode(chainCfg.Bech32HRPSegwit, segwit.GenPrivKey().PubKey().Address())
 	suite.sender, _ = bech32.Encode(chainCfg.Bech32HRPSegwit, segwit.GenPrivKey().PubKey().Address())
 
+	brc20VaultPubKey := segwit.GenPrivKey().PubKey()
+	suite.brc20Vault, _ = bech32.Encode(chainCfg.Bech32HRPSegwit, brc20VaultPubKey.Address())
+	suite.brc20VaultPubKey = hex.EncodeToString(brc20VaultPubKey.Bytes())
+
 	suite.btcVaultPkScript = types.MustPkScriptFromAddress(suite.btcVault)
 	suite.runesVaultPkScript = types.MustPkScriptFromAddress(suite.runesVault)
+	suite.brc20VaultPkScript = types.MustPkScriptFromAddress(suite.brc20Vault)
 	suite.senderPkScript = types.MustPkScriptFromAddress(suite.sender)
 
 	suite.setupParams(suite.btcVault, suite.runesVault, suite.sender)
@@ -80,8 +110,17 @@ func (suite *KeeperTestSuite) setupParams(btcVault string, runesVault string, no
 			Address:   runesVault,
 			AssetType: types.AssetType_ASSET_TYPE_RUNES,
 		},
+		{
+			Address:   suite.brc20Vault,
+			PubKey:    suite.brc20VaultPubKey,
+			AssetType: types.AssetType_ASSET_TYPE_BRC20,
+		},
 	}
 	params.ProtocolFees.Collector = authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	params.RuneConfigs = []types.RuneConfig{
+		{Id: "840000:3", Enabled: true},
+		{Id: "840000:5", Enabled: true},
+	}
 
 	suite.app.BtcBridgeKeeper.SetParams(suite.ctx, params)
 }
@@ -115,20 +154,30 @@ func (suite *KeeperTestSuite) TestMintRunes() {
 	tx.AddTxOut(wire.NewTxOut(0, runesScript))
 	tx.AddTxOut(wire.NewTxOut(types.RunesOutValue, suite.senderPkScript))
 	tx.AddTxOut(wire.NewTxOut(types.RunesOutValue, suite.runesVaultPkScript))
-	tx.AddTxOut(wire.NewTxOut(params.ProtocolFees.DepositFee, suite.btcVaultPkScript))
+	tx.AddTxOut(wire.NewTxOut(AttachedBtcAmount, suite.btcVaultPkScript))
 
 	denom := fmt.Sprintf("%s/%s", types.RunesProtocolName, runeId)
 
 	balanceBefore := suite.app.BankKeeper.GetBalance(suite.ctx, sdk.MustAccAddressFromBech32(suite.sender), denom)
 	suite.True(balanceBefore.Amount.IsZero(), "%s balance before mint should be zero", denom)
 
+	btcBalanceBefore := suite.app.BankKeeper.GetBalance(suite.ctx, sdk.MustAccAddressFromBech32(suite.sender), params.BtcVoucherDenom)
+
 	assetType, recipient, err := suite.app.BtcBridgeKeeper.Mint(suite.ctx, suite.sender, btcutil.NewTx(tx), btcutil.NewTx(tx), 0)
 	suite.NoError(err)
 	suite.Equal(assetType, types.AssetType_ASSET_TYPE_RUNES)
 	suite.Equal(suite.sender, recipient.EncodeAddress(), "incorrect recipient")
 
+	// the protocol fee is charged in runes
+	protocolFee := suite.app.BtcBridgeKeeper.GetProtocolDepositFee(suite.ctx, sdk.NewInt64Coin(denom, int64(runeAmount)))
+	suite.Equal(int64(runeAmount/1000), protocolFee.Amount.Int64(), "incorrect protocol fee")
+
 	balanceAfter := suite.app.BankKeeper.GetBalance(suite.ctx, sdk.MustAccAddressFromBech32(suite.sender), denom)
-	suite.Equal(uint64(runeAmount), balanceAfter.Amount.Uint64(), "%s balance after mint should be %d", denom, runeAmount)
+	suite.Equal(int64(runeAmount)-protocolFee.Amount.Int64(), balanceAfter.Amount.Int64(), "incorrect %s balance after mint", denom)
+
+	// the attached btc is credited to the recipient
+	btcBalanceAfter := suite.app.BankKeeper.GetBalance(suite.ctx, sdk.MustAccAddressFromBech32(suite.sender), params.BtcVoucherDenom)
+	suite.Equal(AttachedBtcAmount, btcBalanceAfter.Sub(btcBalanceBefore).Amount.Int64(), "incorrect btc balance after mint")
 
 	utxos := suite.app.BtcBridgeKeeper.GetAllUTXOs(suite.ctx)
 	suite.Len(utxos, 2, "there should be 1 utxo(s)")
@@ -152,7 +201,7 @@ func (suite *KeeperTestSuite) TestMintRunes() {
 		Txid:         tx.TxHash().String(),
 		Vout:         3,
 		Address:      suite.btcVault,
-		Amount:       uint64(params.ProtocolFees.DepositFee),
+		Amount:       uint64(AttachedBtcAmount),
 		PubKeyScript: suite.btcVaultPkScript,
 		IsLocked:     false,
 	}
@@ -161,82 +210,1947 @@ func (suite *KeeperTestSuite) TestMintRunes() {
 	suite.Equal(expectedBtcUTXO, utxos[1], "btc utxo does not match")
 }
 
-func (suite *KeeperTestSuite) TestWithdrawRunes() {
-	runeId := "840000:3"
-	runeAmount := 500000000
+func (suite *KeeperTestSuite) TestMintMultipleRunes() {
+	runesOutputIndex := uint32(2)
 
-	runesUTXOs := []*types.UTXO{
+	runestone := &types.Runestone{
+		Edicts: []*types.Edict{
+			{Id: &types.RuneId{Block: 840000, Tx: 3}, Amount: "100", Output: runesOutputIndex},
+			{Id: &types.RuneId{Block: 840000, Tx: 5}, Amount: "200", Output: runesOutputIndex},
+			{Id: &types.RuneId{Block: 840000, Tx: 3}, Amount: "50", Output: runesOutputIndex},
+			{Id: &types.RuneId{Block: 840001, Tx: 1}, Amount: "300", Output: 1},
+		},
+	}
+
+	runesScript, err := runestone.Encipher()
+	suite.NoError(err)
+
+	tx := wire.NewMsgTx(types.TxVersion)
+	tx.AddTxOut(wire.NewTxOut(0, runesScript))
+	tx.AddTxOut(wire.NewTxOut(types.RunesOutValue, suite.senderPkScript))
+	tx.AddTxOut(wire.NewTxOut(types.RunesOutValue, suite.runesVaultPkScript))
+	tx.AddTxOut(wire.NewTxOut(AttachedBtcAmount, suite.btcVaultPkScript))
+
+	assetType, _, err := suite.app.BtcBridgeKeeper.Mint(suite.ctx, suite.sender, btcutil.NewTx(tx), btcutil.NewTx(tx), 0)
+	suite.NoError(err)
+	suite.Equal(types.AssetType_ASSET_TYPE_RUNES, assetType)
+
+	balances := suite.app.BankKeeper.GetAllBalances(suite.ctx, sdk.MustAccAddressFromBech32(suite.sender))
+	suite.Equal(int64(150), balances.AmountOf("runes/840000:3").Int64())
+	suite.Equal(int64(200), balances.AmountOf("runes/840000:5").Int64())
+	suite.True(balances.AmountOf("runes/840001:1").IsZero(), "runes not sent to the vault should not be minted")
+
+	utxo := suite.app.BtcBridgeKeeper.GetUTXO(suite.ctx, tx.TxHash().String(), uint64(runesOutputIndex))
+	suite.Equal([]*types.RuneBalance{{Id: "840000:3", Amount: "150"}, {Id: "840000:5", Amount: "200"}}, utxo.Runes)
+
+	// the unallocated runes can not go to the vault by the pointer
+	pointer := runesOutputIndex
+	runestone.Pointer = &pointer
+
+	runesScript, err = runestone.Encipher()
+	suite.NoError(err)
+
+	tx.TxOut[0].PkScript = runesScript
+
+	cacheCtx, _ := suite.ctx.CacheContext()
+	_, _, err = suite.app.BtcBridgeKeeper.Mint(cacheCtx, suite.sender, btcutil.NewTx(tx), btcutil.NewTx(tx), 0)
+	suite.ErrorIs(err, types.ErrInvalidDepositTransaction)
+
+	// the cenotaph is not allowed
+	cenotaphScript := append([]byte{}, runesScript...)
+	cenotaphScript = append(cenotaphScript, txscript.OP_VERIFY)
+
+	tx.TxOut[0].PkScript = cenotaphScript
+
+	cacheCtx, _ = suite.ctx.CacheContext()
+	_, _, err = suite.app.BtcBridgeKeeper.Mint(cacheCtx, suite.sender, btcutil.NewTx(tx), btcutil.NewTx(tx), 0)
+	suite.ErrorIs(err, types.ErrInvalidDepositTransaction)
+
+	// the edicts to the vault with the zero amount are not allowed
+	runestone.Pointer = nil
+	runestone.Edicts[0].Amount = "0"
+
+	runesScript, err = runestone.Encipher()
+	suite.NoError(err)
+
+	tx.TxOut[0].PkScript = runesScript
+
+	cacheCtx, _ = suite.ctx.CacheContext()
+	_, _, err = suite.app.BtcBridgeKeeper.Mint(cacheCtx, suite.sender, btcutil.NewTx(tx), btcutil.NewTx(tx), 0)
+	suite.ErrorIs(err, types.ErrInvalidDepositTransaction)
+}
+
+func (suite *KeeperTestSuite) TestRuneConfig() {
+	params := suite.app.BtcBridgeKeeper.GetParams(suite.ctx)
+	params.RuneConfigs = []types.RuneConfig{
 		{
-			Txid:         chainhash.HashH([]byte("runes")).String(),
-			Vout:         1,
-			Address:      suite.runesVault,
-			Amount:       types.RunesOutValue,
-			PubKeyScript: suite.runesVaultPkScript,
-			IsLocked:     false,
-			Runes: []*types.RuneBalance{
-				{
-					Id:     runeId,
-					Amount: fmt.Sprintf("%d", runeAmount),
-				},
+			Id:          "840000:3",
+			Enabled:     true,
+			MinDeposit:  "100",
+			MinWithdraw: "10",
+			MaxWithdraw: "500",
+			SupplyCap:   "1000",
+			FeeOverride: &types.RuneFees{
+				DepositFeeSchedule:  types.FeeSchedule{MinFee: "20"},
+				WithdrawFeeSchedule: types.FeeSchedule{Bps: 100},
 			},
 		},
+		{
+			Id:      "840000:5",
+			Enabled: false,
+		},
 	}
-	suite.setupUTXOs(runesUTXOs)
+	suite.app.BtcBridgeKeeper.SetParams(suite.ctx, params)
 
-	feeRate := 100
-	amount := runeAmount + 1
+	buildDepositTx := func(id *types.RuneId, amount string) *btcutil.Tx {
+		runestone := &types.Runestone{
+			Edicts: []*types.Edict{{Id: id, Amount: amount, Output: 2}},
+		}
 
-	denom := fmt.Sprintf("%s/%s", types.RunesProtocolName, runeId)
-	coin := sdk.NewInt64Coin(denom, int64(amount))
+		runesScript, err := runestone.Encipher()
+		suite.NoError(err)
 
-	_, err := suite.app.BtcBridgeKeeper.NewRunesSigningRequest(suite.ctx, suite.sender, coin, int64(feeRate), suite.runesVault, suite.btcVault)
-	suite.ErrorIs(err, types.ErrInsufficientUTXOs, "should fail due to insufficient runes utxos")
+		tx := wire.NewMsgTx(types.TxVersion)
+		tx.AddTxOut(wire.NewTxOut(0, runesScript))
+		tx.AddTxOut(wire.NewTxOut(types.RunesOutValue, suite.senderPkScript))
+		tx.AddTxOut(wire.NewTxOut(types.RunesOutValue, suite.runesVaultPkScript))
+		tx.AddTxOut(wire.NewTxOut(AttachedBtcAmount, suite.btcVaultPkScript))
 
-	amount = 100000000
-	coin = sdk.NewInt64Coin(denom, int64(amount))
+		return btcutil.NewTx(tx)
+	}
 
-	_, err = suite.app.BtcBridgeKeeper.NewRunesSigningRequest(suite.ctx, suite.sender, coin, int64(feeRate), suite.runesVault, suite.btcVault)
-	suite.ErrorIs(err, types.ErrInsufficientUTXOs, "should fail due to insufficient payment utxos")
+	enabledId := &types.RuneId{Block: 840000, Tx: 3}
+	disabledId := &types.RuneId{Block: 840000, Tx: 5}
+	unconfiguredId := &types.RuneId{Block: 840001, Tx: 1}
+
+	cacheCtx, _ := suite.ctx.CacheContext()
+	_, _, err := suite.app.BtcBridgeKeeper.Mint(cacheCtx, suite.sender, buildDepositTx(enabledId, "50"), buildDepositTx(enabledId, "50"), 0)
+	suite.ErrorIs(err, types.ErrInvalidDepositAmount, "should fail due to the minimum deposit amount")
+
+	cacheCtx, _ = suite.ctx.CacheContext()
+	_, _, err = suite.app.BtcBridgeKeeper.Mint(cacheCtx, suite.sender, buildDepositTx(disabledId, "500"), buildDepositTx(disabledId, "500"), 0)
+	suite.ErrorIs(err, types.ErrRuneNotEnabled, "should fail due to the disabled rune")
+
+	cacheCtx, _ = suite.ctx.CacheContext()
+	_, _, err = suite.app.BtcBridgeKeeper.Mint(cacheCtx, suite.sender, buildDepositTx(unconfiguredId, "500"), buildDepositTx(unconfiguredId, "500"), 0)
+	suite.ErrorIs(err, types.ErrRuneNotEnabled, "should fail due to the unconfigured rune")
+
+	tx := buildDepositTx(enabledId, "800")
+	_, _, err = suite.app.BtcBridgeKeeper.Mint(suite.ctx, suite.sender, tx, tx, 0)
+	suite.NoError(err)
+
+	suite.Equal(int64(780), suite.app.BankKeeper.GetBalance(suite.ctx, sdk.MustAccAddressFromBech32(suite.sender), enabledId.Denom()).Amount.Int64())
+	suite.Equal(int64(20), suite.app.BtcBridgeKeeper.GetAccruedProtocolFees(suite.ctx).AmountOf(enabledId.Denom()).Int64(), "the overridden protocol fee should be charged in runes")
+
+	cacheCtx, _ = suite.ctx.CacheContext()
+	_, _, err = suite.app.BtcBridgeKeeper.Mint(cacheCtx, suite.sender, buildDepositTx(enabledId, "300"), buildDepositTx(enabledId, "300"), 0)
+	suite.ErrorIs(err, types.ErrRuneSupplyCapExceeded, "should fail due to the supply cap")
+
+	_, err = suite.app.BtcBridgeKeeper.HandleRunesWithdrawal(suite.ctx, suite.sender, sdk.NewInt64Coin(enabledId.Denom(), 5))
+	suite.ErrorIs(err, types.ErrInvalidWithdrawAmount, "should fail due to the minimum withdrawal amount")
+
+	_, err = suite.app.BtcBridgeKeeper.HandleRunesWithdrawal(suite.ctx, suite.sender, sdk.NewInt64Coin(enabledId.Denom(), 600))
+	suite.ErrorIs(err, types.ErrInvalidWithdrawAmount, "should fail due to the maximum withdrawal amount")
+
+	_, err = suite.app.BtcBridgeKeeper.HandleRunesWithdrawal(suite.ctx, suite.sender, sdk.NewInt64Coin(disabledId.Denom(), 100))
+	suite.ErrorIs(err, types.ErrRuneNotEnabled, "should fail due to the disabled rune")
+
+	suite.Equal(int64(4), suite.app.BtcBridgeKeeper.GetProtocolWithdrawFee(suite.ctx, sdk.NewInt64Coin(enabledId.Denom(), 400)).Amount.Int64())
+
+	res, err := suite.app.BtcBridgeKeeper.QueryRuneConfigs(suite.ctx, &types.QueryRuneConfigsRequest{Id: "840000:3"})
+	suite.NoError(err)
+	suite.Len(res.Configs, 1)
+	suite.Equal("800", res.Configs[0].Supply)
+}
+
+func (suite *KeeperTestSuite) TestSettleProtocolFees() {
+	params := suite.app.BtcBridgeKeeper.GetParams(suite.ctx)
+	params.ProtocolFees.Distribution = types.FeeDistribution{
+		Epoch:               10,
+		CommunityPoolWeight: 1,
+		RelayerPoolWeight:   1,
+		SignerPoolWeight:    1,
+		InsuranceWeight:     0,
+	}
+	suite.app.BtcBridgeKeeper.SetParams(suite.ctx, params)
+
+	fees := sdk.NewCoins(sdk.NewInt64Coin(params.BtcVoucherDenom, 1000))
+	suite.NoError(suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, fees))
+	suite.NoError(suite.app.BankKeeper.SendCoinsFromModuleToModule(suite.ctx, types.ModuleName, types.ProtocolFeePoolName, fees))
+
+	communityPoolBefore, err := suite.app.DistrKeeper.FeePool.Get(suite.ctx)
+	suite.NoError(err)
+
+	relayer := sdk.MustAccAddressFromBech32(suite.sender)
+	relayerBalanceBefore := suite.app.BankKeeper.GetBalance(suite.ctx, relayer, params.BtcVoucherDenom)
+
+	suite.ctx = suite.ctx.WithBlockHeight(10)
+	suite.NoError(suite.app.BtcBridgeKeeper.SettleProtocolFees(suite.ctx))
+
+	moduleBalance := func(name string) int64 {
+		return suite.app.BankKeeper.GetBalance(suite.ctx, authtypes.NewModuleAddress(name), params.BtcVoucherDenom).Amount.Int64()
+	}
+
+	relayerBalanceAfter := suite.app.BankKeeper.GetBalance(suite.ctx, relayer, params.BtcVoucherDenom)
+	suite.Equal(int64(333), relayerBalanceAfter.Sub(relayerBalanceBefore).Amount.Int64(), "relayer pool should be paid out to the trusted relayer")
+
+	suite.Equal(int64(0), moduleBalance(types.RelayerPoolName))
+	suite.Equal(int64(333), moduleBalance(types.SignerPoolName), "signer pool should be kept without the TSS participants")
+	suite.Equal(int64(0), moduleBalance(types.InsurancePoolName))
+	suite.Equal(int64(1), moduleBalance(types.ProtocolFeePoolName), "the remainder should be left for the next settlement")
+
+	communityPoolAfter, err := suite.app.DistrKeeper.FeePool.Get(suite.ctx)
+	suite.NoError(err)
+	suite.Equal(int64(333), communityPoolAfter.CommunityPool.Sub(communityPoolBefore.CommunityPool).AmountOf(params.BtcVoucherDenom).TruncateInt64())
+
+	res, err := suite.app.BtcBridgeKeeper.QueryFeeDistribution(suite.ctx, &types.QueryFeeDistributionRequest{})
+	suite.NoError(err)
+	suite.Equal(int64(10), res.LastSettlementHeight)
+
+	for _, stats := range res.Buckets {
+		switch stats.Bucket {
+		case types.FeeBucket_FEE_BUCKET_COMMUNITY_POOL, types.FeeBucket_FEE_BUCKET_RELAYER_POOL:
+			suite.Equal(int64(333), stats.Accumulated.AmountOf(params.BtcVoucherDenom).Int64(), "incorrect accumulated fees of %s", stats.Bucket)
+			suite.Equal(int64(333), stats.Distributed.AmountOf(params.BtcVoucherDenom).Int64(), "incorrect distributed fees of %s", stats.Bucket)
+		case types.FeeBucket_FEE_BUCKET_SIGNER_POOL:
+			suite.Equal(int64(333), stats.Accumulated.AmountOf(params.BtcVoucherDenom).Int64(), "incorrect accumulated fees of %s", stats.Bucket)
+			suite.True(stats.Distributed.IsZero(), "signer pool should not be paid out without the TSS participants")
+		default:
+			suite.True(stats.Accumulated.IsZero(), "no fees should be accumulated for %s", stats.Bucket)
+			suite.True(stats.Distributed.IsZero(), "no fees should be distributed to %s", stats.Bucket)
+		}
+	}
+
+	// all fees go to the collector if the weights are all zero
+	params.ProtocolFees.Distribution = types.FeeDistribution{Epoch: 10}
+	suite.app.BtcBridgeKeeper.SetParams(suite.ctx, params)
+
+	// the signer pool is paid out to the current TSS participants
+	participants := suite.setupDKGParticipants(3)
+
+	req, err := suite.app.BtcBridgeKeeper.InitiateDKG(suite.ctx, participants, 2, types.SupportedAssetTypes(), false, 0)
+	suite.NoError(err)
+
+	req.Status = types.DKGRequestStatus_DKG_REQUEST_STATUS_COMPLETED
+	suite.app.BtcBridgeKeeper.SetDKGRequest(suite.ctx, req)
+
+	collector := sdk.MustAccAddressFromBech32(params.ProtocolFees.Collector)
+	collectorBalanceBefore := suite.app.BankKeeper.GetBalance(suite.ctx, collector, params.BtcVoucherDenom)
+
+	suite.NoError(suite.app.BtcBridgeKeeper.SettleProtocolFees(suite.ctx))
+
+	collectorBalanceAfter := suite.app.BankKeeper.GetBalance(suite.ctx, collector, params.BtcVoucherDenom)
+	suite.Equal(int64(1), collectorBalanceAfter.Sub(collectorBalanceBefore).Amount.Int64())
+	suite.True(suite.app.BtcBridgeKeeper.GetAccruedProtocolFees(suite.ctx).IsZero())
+
+	for _, p := range participants {
+		valAddr, err := sdk.ValAddressFromBech32(p.OperatorAddress)
+		suite.NoError(err)
+		suite.Equal(int64(111), suite.app.BankKeeper.GetBalance(suite.ctx, sdk.AccAddress(valAddr), params.BtcVoucherDenom).Amount.Int64(), "signer should be paid out")
+	}
+
+	suite.Equal(int64(0), moduleBalance(types.SignerPoolName))
+
+	res, err = suite.app.BtcBridgeKeeper.QueryFeeDistribution(suite.ctx, &types.QueryFeeDistributionRequest{})
+	suite.NoError(err)
+
+	for _, stats := range res.Buckets {
+		switch stats.Bucket {
+		case types.FeeBucket_FEE_BUCKET_SIGNER_POOL:
+			suite.Equal(int64(333), stats.Distributed.AmountOf(params.BtcVoucherDenom).Int64(), "signer pool should be paid out")
+		case types.FeeBucket_FEE_BUCKET_COLLECTOR:
+			suite.Equal(int64(1), stats.Accumulated.AmountOf(params.BtcVoucherDenom).Int64(), "incorrect accumulated fees of the collector")
+			suite.Equal(int64(1), stats.Distributed.AmountOf(params.BtcVoucherDenom).Int64(), "incorrect distributed fees of the collector")
+		}
+	}
+}
+
+func (suite *KeeperTestSuite) TestMigrate1to2() {
+	k := suite.app.BtcBridgeKeeper
+
+	// v1 default params encoded by the v1 codec with the flat deposit fee 3000 and withdrawal fee 5000
+	bz, err := hex.DecodeString(v1ParamsHex)
+	suite.NoError(err)
+
+	suite.ctx.KVStore(suite.app.GetKey(types.StoreKey)).Set(types.ParamsStoreKey, bz)
+
+	// stale voucher metadata
+	suite.app.BankKeeper.SetDenomMetaData(suite.ctx, banktypes.Metadata{Base: types.DefaultBtcVoucherDenom, Display: types.DefaultBtcVoucherDenom})
+
+	suite.NoError(keeper.NewMigrator(k).Migrate1to2(suite.ctx))
+
+	defaultParams := types.DefaultParams()
+	migratedParams := k.GetParams(suite.ctx)
+
+	suite.Equal(sdkmath.NewInt(3000), migratedParams.ProtocolFees.DepositFeeSchedule.Compute(sdkmath.NewInt(100000000)), "legacy deposit fee should be kept")
+	suite.Equal(sdkmath.NewInt(5000), migratedParams.ProtocolFees.WithdrawFeeSchedule.Compute(sdkmath.NewInt(100000000)), "legacy withdrawal fee should be kept")
+	suite.Equal("side10d07y265gmmuvt4z0w9aw880jnsr700jwrwlg5", migratedParams.ProtocolFees.Collector)
+	suite.Equal(types.DefaultBtcVoucherDenom, migratedParams.BtcVoucherDenom)
+	suite.Equal(types.DefaultMaxUtxoNum, migratedParams.WithdrawParams.MaxUtxoNum)
+	suite.Equal(defaultParams.ProtocolFees.Distribution, migratedParams.ProtocolFees.Distribution)
+	suite.Equal(defaultParams.MaxPauseDuration, migratedParams.MaxPauseDuration)
+	suite.Equal(defaultParams.WithdrawRateLimit.Window, migratedParams.WithdrawRateLimit.Window)
+	suite.Equal(defaultParams.TssParams.DkgMaxRetries, migratedParams.TssParams.DkgMaxRetries)
+	suite.Equal(defaultParams.TssParams.SignerLiveness, migratedParams.TssParams.SignerLiveness)
+	suite.NoError(migratedParams.Validate())
+
+	metadata, found := suite.app.BankKeeper.GetDenomMetaData(suite.ctx, types.DefaultBtcVoucherDenom)
+	suite.True(found)
+	suite.Equal(types.BtcVoucherMetadata(types.DefaultBtcVoucherDenom), metadata, "voucher metadata should be registered")
+}
+
+func (suite *KeeperTestSuite) TestWithdrawRateLimit() {
+	k := suite.app.BtcBridgeKeeper
+
+	params := k.GetParams(suite.ctx)
+	params.WithdrawRateLimit = types.WithdrawRateLimit{
+		Window:              100,
+		GlobalLimit:         1100000,
+		AssetLimits:         sdk.NewCoins(sdk.NewInt64Coin(types.DefaultBtcVoucherDenom, 1000000)),
+		BreakerThresholdBps: 8000,
+	}
+	k.SetParams(suite.ctx, params)
+
+	_, err := k.HandleWithdrawal(suite.ctx, suite.sender, sdk.NewInt64Coin(types.DefaultBtcVoucherDenom, 2000000))
+	suite.ErrorIs(err, types.ErrWithdrawRateLimitExceeded, "should fail due to the amount exceeding the asset limit")
+
+	withdrawRequests := []*types.WithdrawRequest{
+		{Address: suite.sender, Amount: "400000sat", Sequence: 1},
+		{Address: suite.sender, Amount: "400000sat", Sequence: 2},
+		{Address: suite.sender, Amount: "400000sat", Sequence: 3},
+	}
+
+	suite.Len(k.GetBtcWithdrawRequestsWithinRateLimit(suite.ctx, withdrawRequests), 2, "only 2 requests should be within the rate limit")
+
+	k.RecordOutflow(suite.ctx, sdk.NewInt64Coin(types.DefaultBtcVoucherDenom, 700000), 710000)
+	suite.False(k.IsCircuitBreakerTripped(suite.ctx), "circuit breaker should not be tripped")
+	suite.ErrorIs(k.CheckOutflow(suite.ctx, sdk.NewInt64Coin(types.DefaultBtcVoucherDenom, 400000), 400000), types.ErrWithdrawRateLimitExceeded, "should fail due to the rate limit")
+
+	k.RecordOutflow(suite.ctx, sdk.NewInt64Coin(types.DefaultBtcVoucherDenom, 100000), 110000)
+	suite.True(k.IsCircuitBreakerTripped(suite.ctx), "circuit breaker should be tripped")
+	suite.Equal(types.DefaultBtcVoucherDenom, k.GetCircuitBreaker(suite.ctx).Denom, "incorrect denom of the circuit breaker")
+
+	_, err = k.HandleWithdrawal(suite.ctx, suite.sender, sdk.NewInt64Coin(types.DefaultBtcVoucherDenom, 100000))
+	suite.ErrorIs(err, types.ErrCircuitBreakerTripped, "should fail due to the tripped circuit breaker")
+	suite.Empty(k.GetBtcWithdrawRequestsWithinRateLimit(suite.ctx, withdrawRequests), "no request should be batched")
+
+	res, err := k.QueryOutflowUsage(suite.ctx, &types.QueryOutflowUsageRequest{})
+	suite.NoError(err)
+	suite.Equal("820000", res.Global.Used, "incorrect global outflow")
+	suite.Len(res.Assets, 1, "there should be 1 limited asset")
+	suite.Equal("800000", res.Assets[0].Used, "incorrect asset outflow")
+	suite.True(res.Breaker.Tripped, "circuit breaker should be tripped")
+
+	msgServer := keeper.NewMsgServerImpl(k)
+
+	_, err = msgServer.ResetCircuitBreaker(suite.ctx, &types.MsgResetCircuitBreaker{Authority: suite.sender})
+	suite.Error(err, "should fail due to invalid authority")
+
+	_, err = msgServer.ResetCircuitBreaker(suite.ctx, &types.MsgResetCircuitBreaker{Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String()})
+	suite.NoError(err)
+	suite.False(k.IsCircuitBreakerTripped(suite.ctx), "circuit breaker should be reset")
+
+	suite.Len(k.GetBtcWithdrawRequestsWithinRateLimit(suite.ctx, withdrawRequests), 0, "no request should be within the rate limit")
+
+	// move to the next window
+	suite.ctx = suite.ctx.WithBlockHeight(suite.ctx.BlockHeight() + params.WithdrawRateLimit.Window)
+	k.PruneOutflows(suite.ctx)
+
+	suite.True(k.GetOutflow(suite.ctx, types.DefaultBtcVoucherDenom).IsZero(), "outflow should be reset in the next window")
+	suite.True(k.GetGlobalOutflow(suite.ctx).IsZero(), "global outflow should be reset in the next window")
+	suite.Len(k.GetBtcWithdrawRequestsWithinRateLimit(suite.ctx, withdrawRequests), 2, "only 2 requests should be within the rate limit")
+
+	// the batch outflow including the network fee must not exceed the global limit
+	params.WithdrawRateLimit.GlobalLimit = 800000
+	k.SetParams(suite.ctx, params)
+
+	suite.setupUTXOs([]*types.UTXO{
+		{
+			Txid:         chainhash.HashH([]byte("payment")).String(),
+			Vout:         1,
+			Address:      suite.btcVault,
+			Amount:       2000000,
+			PubKeyScript: suite.btcVaultPkScript,
+		},
+	})
+
+	withdrawRequests = k.GetBtcWithdrawRequestsWithinRateLimit(suite.ctx, withdrawRequests)
+	suite.Len(withdrawRequests, 2, "2 requests should be within the rate limit without the network fee")
+
+	_, err = k.BuildBtcBatchWithdrawSigningRequest(suite.ctx, withdrawRequests, 10, suite.btcVault)
+	suite.ErrorIs(err, types.ErrWithdrawRateLimitExceeded, "should fail due to the network fee exceeding the global limit")
+	suite.True(k.GetGlobalOutflow(suite.ctx).IsZero(), "outflow should not be recorded")
+
+	signingRequest, err := k.BuildBtcBatchWithdrawSigningRequest(suite.ctx, withdrawRequests[:1], 10, suite.btcVault)
+	suite.NoError(err)
+
+	p, err := psbt.NewFromRawBytes(bytes.NewReader([]byte(signingRequest.Psbt)), true)
+	suite.NoError(err)
+
+	networkFee, err := p.GetTxFee()
+	suite.NoError(err)
+	suite.Equal(sdkmath.NewInt(400000+int64(networkFee)), k.GetGlobalOutflow(suite.ctx), "outflow should include the network fee")
+}
+
+func (suite *KeeperTestSuite) TestEmergencyPause() {
+	k := suite.app.BtcBridgeKeeper
+	msgServer := keeper.NewMsgServerImpl(k)
+
+	guardian := suite.sender
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	runesDenom := fmt.Sprintf("%s/%s", types.RunesProtocolName, "840000:3")
+
+	params := k.GetParams(suite.ctx)
+	params.Guardians = []string{guardian}
+	k.SetParams(suite.ctx, params)
+
+	suite.ctx = suite.ctx.WithBlockTime(time.Now())
+
+	_, err := msgServer.EmergencyPause(suite.ctx, types.NewMsgEmergencyPause(authority, types.PauseScope_PAUSE_SCOPE_WITHDRAW, "", time.Hour))
+	suite.ErrorIs(err, types.ErrUnauthorizedGuardian, "should fail due to unauthorized guardian")
+
+	_, err = msgServer.EmergencyPause(suite.ctx, types.NewMsgEmergencyPause(guardian, types.PauseScope_PAUSE_SCOPE_WITHDRAW, "", params.MaxPauseDuration+time.Second))
+	suite.ErrorIs(err, types.ErrInvalidEmergencyPause, "should fail due to too long duration")
+
+	res, err := msgServer.EmergencyPause(suite.ctx, types.NewMsgEmergencyPause(guardian, types.PauseScope_PAUSE_SCOPE_WITHDRAW, "", time.Hour))
+	suite.NoError(err)
+	suite.Equal(uint64(1), res.Id, "incorrect pause id")
+
+	_, err = msgServer.WithdrawToBitcoin(suite.ctx, &types.MsgWithdrawToBitcoin{Sender: suite.sender, Amount: "100000sat"})
+	suite.ErrorIs(err, types.ErrPaused, "should fail due to the paused withdrawal")
+
+	_, err = msgServer.EmergencyPause(suite.ctx, types.NewMsgEmergencyPause(guardian, types.PauseScope_PAUSE_SCOPE_ASSET, runesDenom, 2*time.Hour))
+	suite.NoError(err)
+
+	_, err = k.HandleWithdrawal(suite.ctx, suite.sender, sdk.NewInt64Coin(runesDenom, 100))
+	suite.ErrorIs(err, types.ErrPaused, "should fail due to the paused asset")
+
+	_, err = msgServer.EmergencyPause(suite.ctx, types.NewMsgEmergencyPause(guardian, types.PauseScope_PAUSE_SCOPE_SIGNING, "", time.Hour))
+	suite.NoError(err)
+
+	err = k.ConsolidateVaults(suite.ctx, 0, &types.BtcConsolidation{TargetThreshold: 10000, MaxNum: 10}, nil)
+	suite.ErrorIs(err, types.ErrPaused, "should fail due to the paused signing request creation")
+
+	suite.Len(k.GetActiveEmergencyPauses(suite.ctx), 3, "there should be 3 active pauses")
+
+	_, err = msgServer.LiftEmergencyPause(suite.ctx, &types.MsgLiftEmergencyPause{Authority: guardian, Id: 1})
+	suite.Error(err, "only governance can lift the pause")
+
+	_, err = msgServer.LiftEmergencyPause(suite.ctx, &types.MsgLiftEmergencyPause{Authority: authority, Id: 1})
+	suite.NoError(err)
+	suite.False(k.IsPaused(suite.ctx, types.PauseScope_PAUSE_SCOPE_WITHDRAW, ""), "withdrawal should not be paused")
+
+	_, err = msgServer.LiftEmergencyPause(suite.ctx, &types.MsgLiftEmergencyPause{Authority: authority, Id: 1})
+	suite.ErrorIs(err, types.ErrEmergencyPauseNotActive, "should fail due to the lifted pause")
+
+	// the signing pause expires
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(time.Hour))
+	k.PruneExpiredEmergencyPauses(suite.ctx)
+
+	suite.False(k.IsPaused(suite.ctx, types.PauseScope_PAUSE_SCOPE_SIGNING, ""), "signing request creation should not be paused")
+	suite.True(k.IsPaused(suite.ctx, types.PauseScope_PAUSE_SCOPE_ASSET, runesDenom), "asset should be paused")
+	suite.Len(k.GetActiveEmergencyPauses(suite.ctx), 1, "there should be 1 active pause")
+
+	pauses := k.GetEmergencyPauses(suite.ctx)
+	suite.Len(pauses, 3, "all pauses should be recorded")
+	suite.Equal(guardian, pauses[2].Guardian, "incorrect guardian")
+	suite.True(pauses[0].Lifted, "the first pause should be lifted")
+}
+
+func (suite *KeeperTestSuite) TestRegisterRunes() {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	metadata := &types.RuneMetadata{
+		Id:           "840000:3",
+		Name:         "UNCOMMON•GOODS",
+		Symbol:       "⧉",
+		Divisibility: 2,
+		EtchingTx:    chainhash.HashH([]byte("etching")).String(),
+	}
+
+	untrusted, _ := bech32.Encode(sdk.GetConfig().GetBtcChainCfg().Bech32HRPSegwit, segwit.GenPrivKey().PubKey().Address())
+
+	err := suite.app.BtcBridgeKeeper.RegisterRunes(suite.ctx, untrusted, []*types.RuneMetadata{metadata})
+	suite.ErrorIs(err, types.ErrUntrustedNonBtcRelayer)
+
+	err = suite.app.BtcBridgeKeeper.RegisterRunes(suite.ctx, suite.sender, []*types.RuneMetadata{metadata})
+	suite.NoError(err)
+
+	suite.Equal(metadata, suite.app.BtcBridgeKeeper.GetRuneMetadata(suite.ctx, metadata.Id))
+	suite.Len(suite.app.BtcBridgeKeeper.GetAllRuneMetadata(suite.ctx), 1)
+
+	bankMetadata, found := suite.app.BankKeeper.GetDenomMetaData(suite.ctx, "runes/840000:3")
+	suite.True(found, "bank metadata should be registered")
+	suite.Equal("runes/UNCOMMONGOODS", bankMetadata.Display)
+	suite.Equal(uint32(2), bankMetadata.DenomUnits[1].Exponent)
+	suite.Equal("⧉", bankMetadata.Symbol)
+
+	// the registered rune can only be updated by the authority
+	updated := *metadata
+	updated.Divisibility = 0
+
+	err = suite.app.BtcBridgeKeeper.RegisterRunes(suite.ctx, suite.sender, []*types.RuneMetadata{&updated})
+	suite.ErrorIs(err, types.ErrRuneAlreadyRegistered)
+
+	err = suite.app.BtcBridgeKeeper.RegisterRunes(suite.ctx, authority, []*types.RuneMetadata{&updated})
+	suite.NoError(err)
+
+	bankMetadata, _ = suite.app.BankKeeper.GetDenomMetaData(suite.ctx, "runes/840000:3")
+	suite.Equal("runes/840000:3", bankMetadata.Display)
+	suite.Len(bankMetadata.DenomUnits, 1)
+}
+
+func (suite *KeeperTestSuite) TestPriorityBtcWithdrawal() {
+	k := suite.app.BtcBridgeKeeper
+	msgServer := keeper.NewMsgServerImpl(k)
 
 	paymentUTXOs := []*types.UTXO{
 		{
 			Txid:         chainhash.HashH([]byte("payment")).String(),
 			Vout:         1,
 			Address:      suite.btcVault,
-			Amount:       100000,
+			Amount:       2000000,
 			PubKeyScript: suite.btcVaultPkScript,
 			IsLocked:     false,
 		},
 	}
 	suite.setupUTXOs(paymentUTXOs)
 
-	req, err := suite.app.BtcBridgeKeeper.NewRunesSigningRequest(suite.ctx, suite.sender, coin, int64(feeRate), suite.runesVault, suite.btcVault)
-	suite.NoError(err)
+	k.SetFeeRate(suite.ctx, 10)
 
-	suite.False(suite.app.BtcBridgeKeeper.HasUTXO(suite.ctx, runesUTXOs[0].Txid, runesUTXOs[0].Vout), "runes utxo should be spent")
-	suite.False(suite.app.BtcBridgeKeeper.HasUTXO(suite.ctx, paymentUTXOs[0].Txid, paymentUTXOs[0].Vout), "payment utxo should be spent")
+	msg := &types.MsgWithdrawToBitcoin{Sender: suite.sender, Amount: "1000000sat", FeeRate: 5}
+	suite.True(msg.IsPriority(), "should be priority withdrawal")
 
-	runesUTXOs = suite.app.BtcBridgeKeeper.GetUTXOsByAddr(suite.ctx, suite.runesVault)
-	suite.Len(runesUTXOs, 1, "there should be 1 runes utxo(s)")
+	cacheCtx, _ := suite.ctx.CacheContext()
+	_, err := msgServer.WithdrawToBitcoin(cacheCtx, msg)
+	suite.ErrorIs(err, types.ErrInvalidFeeRate, "should fail due to the fee rate less than the network fee rate")
 
-	suite.True(runesUTXOs[0].IsLocked, "the rune utxo should be locked")
-	suite.Len(runesUTXOs[0].Runes, 1, "there should be 1 rune in the runes utxo")
-	suite.Equal(runeId, runesUTXOs[0].Runes[0].Id, "incorrect rune id")
-	suite.Equal(uint64(runeAmount-amount), types.RuneAmountFromString(runesUTXOs[0].Runes[0].Amount).Big().Uint64(), "incorrect rune amount")
+	cacheCtx, _ = suite.ctx.CacheContext()
+	_, err = msgServer.WithdrawToBitcoin(cacheCtx, &types.MsgWithdrawToBitcoin{Sender: suite.sender, Amount: "1000000sat", MaxFee: 1})
+	suite.ErrorIs(err, types.ErrMaxNetworkFeeExceeded, "should fail due to the max fee exceeded")
 
-	p, err := psbt.NewFromRawBytes(bytes.NewReader([]byte(req.Psbt)), true)
+	cacheCtx, _ = suite.ctx.CacheContext()
+	_, err = k.HandlePriorityBtcWithdrawal(cacheCtx, suite.sender, sdk.NewInt64Coin(fmt.Sprintf("%s/%s", types.RunesProtocolName, "840000:3"), 100), 20, 0)
+	suite.ErrorIs(err, types.ErrAssetNotSupported, "should fail due to non-btc asset")
+
+	balanceBefore := suite.app.BankKeeper.GetBalance(suite.ctx, sdk.MustAccAddressFromBech32(suite.sender), types.DefaultBtcVoucherDenom)
+
+	_, err = msgServer.WithdrawToBitcoin(suite.ctx, &types.MsgWithdrawToBitcoin{Sender: suite.sender, Amount: "1000000sat", FeeRate: 20})
 	suite.NoError(err)
 
-	suite.Len(p.Inputs, 2, "there should be 2 inputs")
-	suite.Equal(suite.runesVaultPkScript, p.Inputs[0].WitnessUtxo.PkScript, "the first input should be runes vault")
-	suite.Equal(suite.btcVaultPkScript, p.Inputs[1].WitnessUtxo.PkScript, "the second input should be btc vault")
+	suite.Empty(k.GetPendingBtcWithdrawRequests(suite.ctx, 100), "priority withdrawal should not be queued")
 
-	expectedRunesScript, err := types.BuildEdictScript(runeId, uint128.From64(uint64(amount)), 2)
+	var withdrawEvent *types.EventWithdrawRequested
+	for _, e := range suite.ctx.EventManager().ABCIEvents() {
+		if msg, err := sdk.ParseTypedEvent(e); err == nil {
+			if event, ok := msg.(*types.EventWithdrawRequested); ok {
+				withdrawEvent = event
+			}
+		}
+	}
+
+	suite.NotNil(withdrawEvent, "typed withdrawal event should be emitted")
+	suite.Equal(suite.sender, withdrawEvent.Sender)
+	suite.NotEmpty(withdrawEvent.Txid, "priority withdrawal should be signed immediately")
+	suite.True(hasEventAttribute(suite.ctx.EventManager().Events(), "txid", withdrawEvent.Txid), "legacy event should be emitted for compatibility")
+	suite.False(k.HasUTXO(suite.ctx, paymentUTXOs[0].Txid, paymentUTXOs[0].Vout), "payment utxo should be spent")
+
+	withdrawRequests := k.GetWithdrawRequestsByAddress(suite.ctx, suite.sender)
+	suite.Len(withdrawRequests, 1, "there should be 1 withdrawal request")
+
+	signingRequest := k.GetSigningRequestByTxHash(suite.ctx, withdrawRequests[0].Txid)
+	suite.Equal(suite.sender, signingRequest.Address, "incorrect signing request address")
+	suite.Equal(types.AssetType_ASSET_TYPE_BTC, signingRequest.Type, "incorrect signing request type")
+
+	p, err := psbt.NewFromRawBytes(bytes.NewReader([]byte(signingRequest.Psbt)), true)
 	suite.NoError(err)
 
-	suite.Len(p.UnsignedTx.TxOut, 4, "there should be 4 outputs")
-	suite.Equal(expectedRunesScript, p.UnsignedTx.TxOut[0].PkScript, "incorrect runes script")
-	suite.Equal(suite.runesVaultPkScript, p.UnsignedTx.TxOut[1].PkScript, "the second output should be runes change output")
-	suite.Equal(suite.senderPkScript, p.UnsignedTx.TxOut[2].PkScript, "the third output should be sender output")
-	suite.Equal(suite.btcVaultPkScript, p.UnsignedTx.TxOut[3].PkScript, "the fouth output should be btc change output")
+	networkFee, err := p.GetTxFee()
+	suite.NoError(err)
+
+	protocolFee := k.GetProtocolWithdrawFee(suite.ctx, sdk.NewInt64Coin(types.DefaultBtcVoucherDenom, 1000000))
+	withdrawAmount := 1000000 - protocolFee.Amount.Int64()
+
+	suite.Equal(withdrawAmount, p.UnsignedTx.TxOut[0].Value, "incorrect withdrawal amount")
+	suite.Equal(suite.senderPkScript, p.UnsignedTx.TxOut[0].PkScript, "the first output should be sender output")
+
+	balanceAfter := suite.app.BankKeeper.GetBalance(suite.ctx, sdk.MustAccAddressFromBech32(suite.sender), types.DefaultBtcVoucherDenom)
+	suite.Equal(int64(1000000)+int64(networkFee), balanceBefore.Amount.Sub(balanceAfter.Amount).Int64(), "incorrect balance change")
+}
+
+func (suite *KeeperTestSuite) TestAutoConsolidateVaults() {
+	k := suite.app.BtcBridgeKeeper
+
+	utxos := []*types.UTXO{}
+	for i := 0; i < 4; i++ {
+		utxos = append(utxos, &types.UTXO{
+			Txid:         chainhash.HashH([]byte(fmt.Sprintf("consolidation%d", i))).String(),
+			Vout:         1,
+			Address:      suite.btcVault,
+			Amount:       20000,
+			PubKeyScript: suite.btcVaultPkScript,
+			IsLocked:     false,
+		})
+	}
+	suite.setupUTXOs(utxos)
+
+	params := k.GetParams(suite.ctx)
+	params.ConsolidationPolicy = types.ConsolidationPolicy{
+		UtxoNumTrigger:     3,
+		MaxFeeRate:         20,
+		BtcTargetThreshold: 50000,
+		MaxNum:             10,
+		Cooldown:           100,
+	}
+	k.SetParams(suite.ctx, params)
+
+	suite.ctx = suite.ctx.WithBlockHeight(1000).WithEventManager(sdk.NewEventManager())
+	k.SetFeeRate(suite.ctx, 50)
+
+	k.AutoConsolidateVaults(suite.ctx)
+	suite.True(k.HasUTXO(suite.ctx, utxos[0].Txid, utxos[0].Vout), "consolidation should be skipped")
+	suite.True(hasEventAttribute(suite.ctx.EventManager().Events(), "reason", types.ConsolidationSkipReasonFeeRateTooHigh), "skip event should be emitted")
+
+	k.SetFeeRate(suite.ctx, 10)
+
+	k.AutoConsolidateVaults(suite.ctx)
+	for _, utxo := range utxos {
+		suite.False(k.HasUTXO(suite.ctx, utxo.Txid, utxo.Vout), "utxo should be consolidated")
+	}
+	suite.Equal(int64(1000), k.GetLastConsolidationHeight(suite.ctx), "incorrect last consolidation height")
+	suite.Equal(uint64(1), k.GetSigningRequestSequence(suite.ctx), "there should be 1 signing request")
+
+	newUTXOs := []*types.UTXO{}
+	for i := 4; i < 8; i++ {
+		newUTXOs = append(newUTXOs, &types.UTXO{
+			Txid:         chainhash.HashH([]byte(fmt.Sprintf("consolidation%d", i))).String(),
+			Vout:         1,
+			Address:      suite.btcVault,
+			Amount:       20000,
+			PubKeyScript: suite.btcVaultPkScript,
+			IsLocked:     false,
+		})
+	}
+	suite.setupUTXOs(newUTXOs)
+
+	// within the cooldown
+	suite.ctx = suite.ctx.WithBlockHeight(1050)
+	k.SetFeeRate(suite.ctx, 10)
+
+	k.AutoConsolidateVaults(suite.ctx)
+	suite.True(k.HasUTXO(suite.ctx, newUTXOs[0].Txid, newUTXOs[0].Vout), "consolidation should not run during the cooldown")
+
+	suite.ctx = suite.ctx.WithBlockHeight(1100)
+	k.SetFeeRate(suite.ctx, 10)
+
+	k.AutoConsolidateVaults(suite.ctx)
+	suite.False(k.HasUTXO(suite.ctx, newUTXOs[0].Txid, newUTXOs[0].Vout), "utxo should be consolidated after the cooldown")
+}
+
+func (suite *KeeperTestSuite) TestAutoConsolidateVaultsWithDefaultMaxNum() {
+	k := suite.app.BtcBridgeKeeper
+
+	utxos := []*types.UTXO{}
+	for i := 0; i < 4; i++ {
+		utxos = append(utxos, &types.UTXO{
+			Txid:         chainhash.HashH([]byte(fmt.Sprintf("consolidation%d", i))).String(),
+			Vout:         1,
+			Address:      suite.btcVault,
+			Amount:       20000,
+			PubKeyScript: suite.btcVaultPkScript,
+		})
+	}
+	suite.setupUTXOs(utxos)
+
+	params := k.GetParams(suite.ctx)
+	params.WithdrawParams.MaxUtxoNum = 3
+	params.ConsolidationPolicy = types.ConsolidationPolicy{
+		UtxoNumTrigger:     3,
+		BtcTargetThreshold: 50000,
+	}
+	k.SetParams(suite.ctx, params)
+
+	k.SetFeeRate(suite.ctx, 10)
+
+	k.AutoConsolidateVaults(suite.ctx)
+	suite.Equal(uint64(1), k.GetSigningRequestSequence(suite.ctx), "there should be 1 signing request")
+
+	signingReq := k.GetSigningRequest(suite.ctx, 1)
+	p, err := psbt.NewFromRawBytes(bytes.NewReader([]byte(signingReq.Psbt)), true)
+	suite.NoError(err)
+	suite.Len(p.UnsignedTx.TxIn, 3, "max num of 0 should be resolved to the max utxo num of the withdrawal params")
+}
+
+func (suite *KeeperTestSuite) TestRecoverVault() {
+	k := suite.app.BtcBridgeKeeper
+
+	recoveryPrivKey, err := secp256k1.NewPrivateKey()
+	suite.NoError(err)
+
+	policy := types.VaultRecoveryPolicy{
+		PubKeys:   []string{hex.EncodeToString(schnorr.SerializePubKey(recoveryPrivKey.PubKey()))},
+		Threshold: 1,
+		Timelock:  100,
+	}
+
+	tree, err := policy.TaprootTree()
+	suite.NoError(err)
+
+	internalPrivKey, err := secp256k1.NewPrivateKey()
+	suite.NoError(err)
+
+	vaultAddress, err := tree.Address(internalPrivKey.PubKey())
+	suite.NoError(err)
+
+	params := k.GetParams(suite.ctx)
+	params.Vaults = append(params.Vaults, &types.Vault{
+		Address:     vaultAddress,
+		PubKey:      hex.EncodeToString(internalPrivKey.PubKey().SerializeCompressed()),
+		AssetType:   types.AssetType_ASSET_TYPE_BTC,
+		Version:     1,
+		TaprootTree: tree,
+	})
+	params.WithdrawParams.MaxUtxoNum = 1
+	k.SetParams(suite.ctx, params)
+
+	vaultPkScript := types.MustPkScriptFromAddress(vaultAddress)
+
+	utxos := []*types.UTXO{
+		{Txid: chainhash.HashH([]byte("recovery0")).String(), Vout: 0, Address: vaultAddress, Amount: 100000, PubKeyScript: vaultPkScript, Height: 100},
+		{Txid: chainhash.HashH([]byte("recovery1")).String(), Vout: 0, Address: vaultAddress, Amount: 100000, PubKeyScript: vaultPkScript, Height: 150},
+		{Txid: chainhash.HashH([]byte("recovery2")).String(), Vout: 0, Address: vaultAddress, Amount: 100000, PubKeyScript: vaultPkScript},
+		{Txid: chainhash.HashH([]byte("recovery3")).String(), Vout: 0, Address: vaultAddress, Amount: 100000, PubKeyScript: vaultPkScript, Height: 120},
+	}
+	suite.setupUTXOs(utxos)
+
+	k.SetBestBlockHeader(suite.ctx, &types.BlockHeader{Height: 199})
+	k.SetFeeRate(suite.ctx, 10)
+
+	_, err = k.RecoverVault(suite.ctx, suite.btcVault, suite.sender, "", 0)
+	suite.ErrorIs(err, types.ErrInvalidVaultRecovery, "vault without the taproot tree can not be recovered")
+
+	otherPrivKey, err := secp256k1.NewPrivateKey()
+	suite.NoError(err)
+
+	_, err = k.RecoverVault(suite.ctx, vaultAddress, suite.sender, hex.EncodeToString(schnorr.SerializePubKey(otherPrivKey.PubKey())), 0)
+	suite.ErrorIs(err, types.ErrInvalidVaultRecovery, "mismatched internal key should fail")
+
+	signingReq, err := k.RecoverVault(suite.ctx, vaultAddress, suite.sender, "", 0)
+	suite.NoError(err)
+
+	suite.NotEqual(k.HasUTXO(suite.ctx, utxos[0].Txid, utxos[0].Vout), k.HasUTXO(suite.ctx, utxos[3].Txid, utxos[3].Vout), "only one matured utxo should be spent by the max utxo num of the withdrawal params")
+	suite.True(k.HasUTXO(suite.ctx, utxos[1].Txid, utxos[1].Vout), "immature utxo should not be spent")
+	suite.True(k.HasUTXO(suite.ctx, utxos[2].Txid, utxos[2].Vout), "utxo without the confirmation height should not be spent")
+
+	p, err := psbt.NewFromRawBytes(bytes.NewReader([]byte(signingReq.Psbt)), true)
+	suite.NoError(err)
+	suite.Len(p.UnsignedTx.TxIn, 1)
+	suite.Equal(policy.Timelock, p.UnsignedTx.TxIn[0].Sequence)
+	suite.Len(p.Inputs[0].TaprootLeafScript, 1)
+}
+
+func (suite *KeeperTestSuite) TestMultipleActiveVaults() {
+	k := suite.app.BtcBridgeKeeper
+
+	chainCfg := sdk.GetConfig().GetBtcChainCfg()
+	secondBtcVault, _ := bech32.Encode(chainCfg.Bech32HRPSegwit, segwit.GenPrivKey().PubKey().Address())
+
+	params := k.GetParams(suite.ctx)
+	params.Vaults = append(params.Vaults, &types.Vault{
+		Address:   secondBtcVault,
+		AssetType: types.AssetType_ASSET_TYPE_BTC,
+	})
+	k.SetParams(suite.ctx, params)
+
+	suite.Len(k.GetActiveVaults(suite.ctx, types.AssetType_ASSET_TYPE_BTC), 2, "there should be 2 active btc vaults")
+
+	// round-robin
+	vault, err := k.AssignDepositVault(suite.ctx, types.AssetType_ASSET_TYPE_BTC)
+	suite.NoError(err)
+	suite.Equal(suite.btcVault, vault.Address)
+
+	k.IncrementDepositVaultCursor(suite.ctx, types.AssetType_ASSET_TYPE_BTC)
+
+	vault, err = k.AssignDepositVault(suite.ctx, types.AssetType_ASSET_TYPE_BTC)
+	suite.NoError(err)
+	suite.Equal(secondBtcVault, vault.Address)
+
+	k.IncrementDepositVaultCursor(suite.ctx, types.AssetType_ASSET_TYPE_BTC)
+
+	vault, err = k.AssignDepositVault(suite.ctx, types.AssetType_ASSET_TYPE_BTC)
+	suite.NoError(err)
+	suite.Equal(suite.btcVault, vault.Address, "round-robin should wrap around")
+
+	suite.setupUTXOs([]*types.UTXO{
+		{Txid: chainhash.HashH([]byte("multivault0")).String(), Vout: 0, Address: suite.btcVault, Amount: 60000, PubKeyScript: suite.btcVaultPkScript},
+		{Txid: chainhash.HashH([]byte("multivault1")).String(), Vout: 0, Address: suite.btcVault, Amount: 40000, PubKeyScript: suite.btcVaultPkScript},
+		{Txid: chainhash.HashH([]byte("multivault2")).String(), Vout: 0, Address: secondBtcVault, Amount: 80000, PubKeyScript: types.MustPkScriptFromAddress(secondBtcVault)},
+	})
+
+	// least utxo
+	params = k.GetParams(suite.ctx)
+	params.VaultAssignmentStrategy = types.VaultAssignmentStrategy_VAULT_ASSIGNMENT_STRATEGY_LEAST_UTXO
+	k.SetParams(suite.ctx, params)
+
+	vault, err = k.AssignDepositVault(suite.ctx, types.AssetType_ASSET_TYPE_BTC)
+	suite.NoError(err)
+	suite.Equal(secondBtcVault, vault.Address, "vault with the fewest utxos should be assigned")
+
+	// withdrawal assignment
+	suite.Equal(suite.btcVault, k.SelectWithdrawVault(suite.ctx, types.AssetType_ASSET_TYPE_BTC, "").Address, "vault with the largest balance should be selected")
+
+	withdrawRequests := []*types.WithdrawRequest{
+		{Address: suite.sender, Amount: "50000sat", Sequence: 1},
+		{Address: suite.sender, Amount: "40000sat", Sequence: 2},
+		{Address: suite.sender, Amount: "30000sat", Sequence: 3},
+	}
+
+	assignments := k.AssignBtcWithdrawRequests(suite.ctx, withdrawRequests)
+	suite.Len(assignments, 2, "requests should be assigned to 2 vaults")
+	suite.Equal(suite.btcVault, assignments[0].Vault)
+	suite.Equal([]*types.WithdrawRequest{withdrawRequests[0], withdrawRequests[2]}, assignments[0].Requests)
+	suite.Equal(secondBtcVault, assignments[1].Vault)
+	suite.Equal([]*types.WithdrawRequest{withdrawRequests[1]}, assignments[1].Requests)
+}
+
+func (suite *KeeperTestSuite) TestVaultTransfer() {
+	k := suite.app.BtcBridgeKeeper
+
+	chainCfg := sdk.GetConfig().GetBtcChainCfg()
+
+	destBtcVault, _ := bech32.Encode(chainCfg.Bech32HRPSegwit, segwit.GenPrivKey().PubKey().Address())
+	destRunesVault, _ := bech32.Encode(chainCfg.Bech32HRPSegwit, segwit.GenPrivKey().PubKey().Address())
+
+	params := k.GetParams(suite.ctx)
+	params.Vaults = append(params.Vaults,
+		&types.Vault{Address: destBtcVault, AssetType: types.AssetType_ASSET_TYPE_BTC, Version: 1},
+		&types.Vault{Address: destRunesVault, AssetType: types.AssetType_ASSET_TYPE_RUNES, Version: 1},
+	)
+	k.SetParams(suite.ctx, params)
+
+	utxos := []*types.UTXO{}
+	for i := 0; i < 2; i++ {
+		utxos = append(utxos, &types.UTXO{
+			Txid:         chainhash.HashH([]byte(fmt.Sprintf("transfer%d", i))).String(),
+			Vout:         1,
+			Address:      suite.btcVault,
+			Amount:       100000,
+			PubKeyScript: suite.btcVaultPkScript,
+		})
+	}
+	suite.setupUTXOs(utxos)
+
+	suite.ctx = suite.ctx.WithBlockHeight(100)
+	k.SetFeeRate(suite.ctx, 10)
+
+	transfer := k.StartVaultTransfer(suite.ctx, 1, 0, 1)
+	k.UpdateVaultTransferProgress(suite.ctx, transfer)
+
+	transfer = k.GetVaultTransfer(suite.ctx, 0)
+	suite.Equal(types.VaultTransferStatus_VAULT_TRANSFER_STATUS_IN_PROGRESS, transfer.Status)
+	suite.Equal(types.VaultTransferStatus_VAULT_TRANSFER_STATUS_COMPLETED, transfer.GetAssetTransfer(types.AssetType_ASSET_TYPE_RUNES).Status, "empty runes vault should be transferred")
+	suite.Equal(types.VaultTransferStatus_VAULT_TRANSFER_STATUS_PENDING, transfer.GetAssetTransfer(types.AssetType_ASSET_TYPE_BTC).Status)
+	suite.Equal(uint64(2), transfer.GetAssetTransfer(types.AssetType_ASSET_TYPE_BTC).RemainingUtxoNum)
+	suite.Equal(uint64(200000), transfer.GetAssetTransfer(types.AssetType_ASSET_TYPE_BTC).RemainingValue)
+
+	// failed step
+	k.RecordVaultTransferError(suite.ctx, 0, types.AssetType_ASSET_TYPE_BTC, types.ErrInvalidFeeRate)
+	suite.Equal(types.ErrInvalidFeeRate.Error(), k.GetVaultTransfer(suite.ctx, 0).GetAssetTransfer(types.AssetType_ASSET_TYPE_BTC).LastError)
+
+	// transfer one utxo per step
+	for i := 0; i < 2; i++ {
+		suite.NoError(k.TransferVault(suite.ctx, 0, 1, types.AssetType_ASSET_TYPE_BTC, nil, 1))
+
+		transfer = k.GetVaultTransfer(suite.ctx, 0)
+		k.UpdateVaultTransferProgress(suite.ctx, transfer)
+
+		btcTransfer := transfer.GetAssetTransfer(types.AssetType_ASSET_TYPE_BTC)
+		suite.Len(btcTransfer.SigningRequests, i+1, "signing request should be recorded")
+		suite.Equal(uint64(1-i), btcTransfer.RemainingUtxoNum)
+		suite.Empty(btcTransfer.LastError, "last error should be cleared")
+	}
+
+	transfer = k.GetVaultTransfer(suite.ctx, 0)
+	suite.Equal(types.VaultTransferStatus_VAULT_TRANSFER_STATUS_COMPLETED, transfer.Status)
+	suite.Equal(int64(100), transfer.CompletionHeight)
+
+	events := suite.ctx.EventManager().Events()
+	for _, eventType := range []string{"side.btcbridge.EventVaultTransferStarted", "side.btcbridge.EventVaultTransferStepFailed", "side.btcbridge.EventVaultTransferStepExecuted", "side.btcbridge.EventVaultTransferCompleted"} {
+		suite.True(slices.ContainsFunc(events, func(e sdk.Event) bool { return e.Type == eventType }), "%s should be emitted", eventType)
+	}
+}
+
+func (suite *KeeperTestSuite) TestVaultTransferProgress() {
+	k := suite.app.BtcBridgeKeeper
+
+	chainCfg := sdk.GetConfig().GetBtcChainCfg()
+
+	destBtcVault, _ := bech32.Encode(chainCfg.Bech32HRPSegwit, segwit.GenPrivKey().PubKey().Address())
+
+	params := k.GetParams(suite.ctx)
+	params.Vaults = append(params.Vaults, &types.Vault{Address: destBtcVault, AssetType: types.AssetType_ASSET_TYPE_BTC, Version: 1})
+	k.SetParams(suite.ctx, params)
+
+	suite.setupUTXOs([]*types.UTXO{
+		{Txid: chainhash.HashH([]byte("progress0")).String(), Vout: 0, Address: suite.btcVault, Amount: 100000, PubKeyScript: suite.btcVaultPkScript},
+		{Txid: chainhash.HashH([]byte("progress1")).String(), Vout: 0, Address: suite.btcVault, Amount: 100000, PubKeyScript: suite.btcVaultPkScript},
+	})
+
+	k.SetFeeRate(suite.ctx, 10)
+
+	transfer := k.StartVaultTransfer(suite.ctx, 1, 0, 1)
+	suite.Equal(uint64(2), transfer.GetAssetTransfer(types.AssetType_ASSET_TYPE_BTC).RemainingUtxoNum, "remaining assets should be counted on start")
+
+	suite.NoError(k.TransferVault(suite.ctx, 0, 1, types.AssetType_ASSET_TYPE_BTC, nil, 1))
+
+	btcTransfer := k.GetVaultTransfer(suite.ctx, 0).GetAssetTransfer(types.AssetType_ASSET_TYPE_BTC)
+	suite.Equal(uint64(1), btcTransfer.RemainingUtxoNum, "remaining assets should be updated by the transfer step")
+
+	// late deposit to the source vault
+	suite.setupUTXOs([]*types.UTXO{
+		{Txid: chainhash.HashH([]byte("progress2")).String(), Vout: 0, Address: suite.btcVault, Amount: 50000, PubKeyScript: suite.btcVaultPkScript},
+	})
+
+	k.UpdateVaultTransferProgress(suite.ctx, k.GetVaultTransfer(suite.ctx, 0))
+	suite.Equal(uint64(1), k.GetVaultTransfer(suite.ctx, 0).GetAssetTransfer(types.AssetType_ASSET_TYPE_BTC).RemainingUtxoNum, "source vault should not be rescanned per block")
+
+	k.UpdateVaultTransferProgressByTx(suite.ctx, btcTransfer.SigningRequests[0])
+
+	btcTransfer = k.GetVaultTransfer(suite.ctx, 0).GetAssetTransfer(types.AssetType_ASSET_TYPE_BTC)
+	suite.Equal(uint64(2), btcTransfer.RemainingUtxoNum, "remaining assets should be recounted on the transfer tx confirmation")
+	suite.Equal(uint64(150000), btcTransfer.RemainingValue)
+	suite.Equal(types.VaultTransferStatus_VAULT_TRANSFER_STATUS_IN_PROGRESS, btcTransfer.Status)
+}
+
+func (suite *KeeperTestSuite) TestVaultTransferMultipleVaults() {
+	k := suite.app.BtcBridgeKeeper
+
+	chainCfg := sdk.GetConfig().GetBtcChainCfg()
+
+	secondBtcVault, _ := bech32.Encode(chainCfg.Bech32HRPSegwit, segwit.GenPrivKey().PubKey().Address())
+	destBtcVaults := make([]string, 2)
+	for i := range destBtcVaults {
+		destBtcVaults[i], _ = bech32.Encode(chainCfg.Bech32HRPSegwit, segwit.GenPrivKey().PubKey().Address())
+	}
+
+	params := k.GetParams(suite.ctx)
+	params.Vaults = append(params.Vaults,
+		&types.Vault{Address: secondBtcVault, AssetType: types.AssetType_ASSET_TYPE_BTC, Version: 0},
+		&types.Vault{Address: destBtcVaults[0], AssetType: types.AssetType_ASSET_TYPE_BTC, Version: 1},
+		&types.Vault{Address: destBtcVaults[1], AssetType: types.AssetType_ASSET_TYPE_BTC, Version: 1},
+	)
+	k.SetParams(suite.ctx, params)
+
+	suite.setupUTXOs([]*types.UTXO{
+		{Txid: chainhash.HashH([]byte("multitransfer0")).String(), Vout: 0, Address: suite.btcVault, Amount: 100000, PubKeyScript: suite.btcVaultPkScript},
+		{Txid: chainhash.HashH([]byte("multitransfer1")).String(), Vout: 0, Address: secondBtcVault, Amount: 100000, PubKeyScript: types.MustPkScriptFromAddress(secondBtcVault)},
+	})
+
+	k.SetFeeRate(suite.ctx, 10)
+
+	transfer := k.StartVaultTransfer(suite.ctx, 1, 0, 1)
+	suite.Equal(suite.btcVault, transfer.Assets[0].SourceVault)
+	suite.Equal(destBtcVaults[0], transfer.Assets[0].DestVault)
+	suite.Equal(secondBtcVault, transfer.Assets[1].SourceVault)
+	suite.Equal(destBtcVaults[1], transfer.Assets[1].DestVault, "source vaults should be paired with destination vaults in order")
+
+	suite.False(k.VaultsTransferCompleted(suite.ctx, 0))
+
+	suite.NoError(k.TransferVault(suite.ctx, 0, 1, types.AssetType_ASSET_TYPE_BTC, nil, 10))
+	suite.True(k.VaultsTransferCompleted(suite.ctx, 0), "all source vaults should be transferred")
+
+	transfer = k.GetVaultTransfer(suite.ctx, 0)
+	for i, asset := range transfer.Assets {
+		suite.Len(asset.SigningRequests, 1, "signing request should be recorded for source vault %d", i)
+		suite.Len(k.GetUTXOsByAddr(suite.ctx, destBtcVaults[i]), 1, "utxo should be transferred to destination vault %d", i)
+	}
+}
+
+func (suite *KeeperTestSuite) TestVaultTransferByEndBlocker() {
+	k := suite.app.BtcBridgeKeeper
+
+	chainCfg := sdk.GetConfig().GetBtcChainCfg()
+
+	destBtcVault, _ := bech32.Encode(chainCfg.Bech32HRPSegwit, segwit.GenPrivKey().PubKey().Address())
+	destRunesVault, _ := bech32.Encode(chainCfg.Bech32HRPSegwit, segwit.GenPrivKey().PubKey().Address())
+
+	params := k.GetParams(suite.ctx)
+	params.Vaults = append(params.Vaults,
+		&types.Vault{Address: destBtcVault, AssetType: types.AssetType_ASSET_TYPE_BTC, Version: 1},
+		&types.Vault{Address: destRunesVault, AssetType: types.AssetType_ASSET_TYPE_RUNES, Version: 1},
+	)
+	k.SetParams(suite.ctx, params)
+	k.SetVaultVersion(suite.ctx, 1)
+
+	suite.setupUTXOs([]*types.UTXO{
+		{Txid: chainhash.HashH([]byte("autotransfer")).String(), Vout: 0, Address: suite.btcVault, Amount: 100000, PubKeyScript: suite.btcVaultPkScript},
+	})
+
+	k.SetFeeRate(suite.ctx, 10)
+
+	k.SetDKGRequest(suite.ctx, &types.DKGRequest{Id: 1, EnableTransfer: true, TargetUtxoNum: 10, Status: types.DKGRequestStatus_DKG_REQUEST_STATUS_COMPLETED})
+	k.SetDKGCompletionRequest(suite.ctx, &types.DKGCompletionRequest{Id: 1, ConsensusAddress: "validator", Vaults: []string{destBtcVault, destRunesVault}})
+
+	btcbridge.EndBlocker(suite.ctx, k)
+
+	suite.Len(k.GetUTXOsByAddr(suite.ctx, destBtcVault), 1, "utxo should be transferred automatically")
+	suite.True(slices.ContainsFunc(suite.ctx.EventManager().Events(), func(e sdk.Event) bool {
+		return e.Type == "side.btcbridge.EventVaultTransferred"
+	}), "typed vault transferred event should be emitted by the automatic transfer")
+}
+
+func (suite *KeeperTestSuite) TestTransferVaultPsbts() {
+	k := suite.app.BtcBridgeKeeper
+
+	chainCfg := sdk.GetConfig().GetBtcChainCfg()
+
+	// the destination btc vault is a p2wsh multisig vault
+	multisigPrivKey, err := secp256k1.NewPrivateKey()
+	suite.NoError(err)
+
+	descriptor := &types.VaultDescriptor{
+		ScriptType: types.VaultScriptType_VAULT_SCRIPT_TYPE_P2WSH_MULTISIG,
+		Threshold:  1,
+		PubKeys:    []string{hex.EncodeToString(multisigPrivKey.PubKey().SerializeCompressed())},
+	}
+
+	destBtcVault, err := descriptor.Address()
+	suite.NoError(err)
+
+	destRunesVault, _ := bech32.Encode(chainCfg.Bech32HRPSegwit, segwit.GenPrivKey().PubKey().Address())
+
+	destBtcPkScript := types.MustPkScriptFromAddress(destBtcVault)
+	destRunesPkScript := types.MustPkScriptFromAddress(destRunesVault)
+
+	params := k.GetParams(suite.ctx)
+	params.Vaults = append(params.Vaults,
+		&types.Vault{Address: destBtcVault, AssetType: types.AssetType_ASSET_TYPE_BTC, Version: 1, ScriptDescriptor: descriptor},
+		&types.Vault{Address: destRunesVault, AssetType: types.AssetType_ASSET_TYPE_RUNES, Version: 1},
+	)
+	k.SetParams(suite.ctx, params)
+
+	btcUtxo := &types.UTXO{
+		Txid:         chainhash.HashH([]byte("btc")).String(),
+		Vout:         0,
+		Address:      suite.btcVault,
+		Amount:       100000,
+		PubKeyScript: suite.btcVaultPkScript,
+	}
+	runesUtxo := &types.UTXO{
+		Txid:         chainhash.HashH([]byte("runes")).String(),
+		Vout:         1,
+		Address:      suite.runesVault,
+		Amount:       types.RunesOutValue,
+		PubKeyScript: suite.runesVaultPkScript,
+		Runes:        []*types.RuneBalance{{Id: "840000:3", Amount: "1000"}},
+	}
+	suite.setupUTXOs([]*types.UTXO{btcUtxo, runesUtxo})
+
+	suite.ctx = suite.ctx.WithBlockHeight(100)
+	k.SetFeeRate(suite.ctx, 10)
+
+	buildPsbt := func(utxos []*types.UTXO, outs []*wire.TxOut) string {
+		tx := wire.NewMsgTx(types.TxVersion)
+		for _, utxo := range utxos {
+			hash, err := chainhash.NewHashFromStr(utxo.Txid)
+			suite.NoError(err)
+
+			tx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(hash, uint32(utxo.Vout)), nil, nil))
+		}
+
+		for _, out := range outs {
+			tx.AddTxOut(out)
+		}
+
+		p, err := psbt.NewFromUnsignedTx(tx)
+		suite.NoError(err)
+
+		for i, utxo := range utxos {
+			p.Inputs[i].WitnessUtxo = wire.NewTxOut(int64(utxo.Amount), utxo.PubKeyScript)
+			p.Inputs[i].SighashType = types.DefaultSigHashType
+		}
+
+		psbtB64, err := p.B64Encode()
+		suite.NoError(err)
+
+		return psbtB64
+	}
+
+	runesScript := func(amount uint64, output uint32) []byte {
+		script, err := types.BuildEdictScript("840000:3", uint128.From64(amount), output)
+		suite.NoError(err)
+
+		return script
+	}
+
+	transferBtc := func(psbts ...string) error {
+		return k.TransferVault(suite.ctx, 0, 1, types.AssetType_ASSET_TYPE_BTC, psbts, 0)
+	}
+
+	transferRunes := func(psbts ...string) error {
+		return k.TransferVault(suite.ctx, 0, 1, types.AssetType_ASSET_TYPE_RUNES, psbts, 0)
+	}
+
+	suite.ErrorIs(transferBtc("invalid"), types.ErrInvalidPsbt, "malformed psbt should be rejected")
+
+	unknownUtxo := *btcUtxo
+	unknownUtxo.Txid = chainhash.HashH([]byte("unknown")).String()
+	suite.ErrorIs(transferBtc(buildPsbt([]*types.UTXO{&unknownUtxo}, []*wire.TxOut{wire.NewTxOut(99000, destBtcPkScript)})), types.ErrUTXODoesNotExist)
+
+	mismatchedUtxo := *btcUtxo
+	mismatchedUtxo.Amount = 200000
+	suite.ErrorIs(transferBtc(buildPsbt([]*types.UTXO{&mismatchedUtxo}, []*wire.TxOut{wire.NewTxOut(199000, destBtcPkScript)})), types.ErrInvalidPsbt, "mismatched witness utxo should be rejected")
+
+	suite.ErrorIs(transferBtc(buildPsbt([]*types.UTXO{runesUtxo}, []*wire.TxOut{wire.NewTxOut(300, destBtcPkScript)})), types.ErrInvalidVault, "input not from the source vault should be rejected")
+	suite.ErrorIs(transferBtc(buildPsbt([]*types.UTXO{btcUtxo}, []*wire.TxOut{wire.NewTxOut(99000, suite.senderPkScript)})), types.ErrInvalidVault, "output not to the destination vault should be rejected")
+	suite.ErrorIs(transferBtc(buildPsbt([]*types.UTXO{btcUtxo}, []*wire.TxOut{wire.NewTxOut(100000, destBtcPkScript)})), types.ErrInvalidPsbt, "zero fee should be rejected")
+	suite.ErrorIs(transferBtc(buildPsbt([]*types.UTXO{btcUtxo}, []*wire.TxOut{wire.NewTxOut(90000, destBtcPkScript)})), types.ErrMaxNetworkFeeExceeded, "excessive fee should be rejected")
+
+	// runes
+	suite.ErrorIs(transferRunes(buildPsbt([]*types.UTXO{runesUtxo, btcUtxo}, []*wire.TxOut{
+		wire.NewTxOut(0, runesScript(1000, 2)),
+		wire.NewTxOut(types.RunesOutValue, destRunesPkScript),
+		wire.NewTxOut(99000, destBtcPkScript),
+	})), types.ErrInvalidRunes, "runes allocated to the change output should be rejected")
+
+	suite.ErrorIs(transferRunes(buildPsbt([]*types.UTXO{runesUtxo, btcUtxo}, []*wire.TxOut{
+		wire.NewTxOut(0, runesScript(2000, 1)),
+		wire.NewTxOut(types.RunesOutValue, destRunesPkScript),
+		wire.NewTxOut(99000, destBtcPkScript),
+	})), types.ErrInvalidRunes, "runes exceeding the input balance should be rejected")
+
+	suite.ErrorIs(transferRunes(buildPsbt([]*types.UTXO{runesUtxo, btcUtxo}, []*wire.TxOut{
+		wire.NewTxOut(0, runesScript(1000, 1)),
+		wire.NewTxOut(types.RunesOutValue, destBtcPkScript),
+		wire.NewTxOut(99000, destRunesPkScript),
+	})), types.ErrInvalidVault, "runes not sent to the destination runes vault should be rejected")
+
+	suite.Equal(uint64(0), k.GetSigningRequestSequence(suite.ctx), "no signing request should be created for the rejected psbts")
+	suite.True(k.HasUTXO(suite.ctx, btcUtxo.Txid, btcUtxo.Vout) && !k.IsUTXOLocked(suite.ctx, btcUtxo.Txid, btcUtxo.Vout), "utxo should not be spent by the rejected psbts")
+
+	suite.NoError(transferRunes(buildPsbt([]*types.UTXO{runesUtxo, btcUtxo}, []*wire.TxOut{
+		wire.NewTxOut(0, runesScript(1000, 1)),
+		wire.NewTxOut(types.RunesOutValue, destRunesPkScript),
+		wire.NewTxOut(99000, destBtcPkScript),
+	})))
+
+	suite.False(k.HasUTXO(suite.ctx, runesUtxo.Txid, runesUtxo.Vout), "runes utxo should be spent")
+	suite.False(k.HasUTXO(suite.ctx, btcUtxo.Txid, btcUtxo.Vout), "btc utxo should be spent")
+
+	runesUtxos := k.GetUTXOsByAddr(suite.ctx, destRunesVault)
+	suite.Len(runesUtxos, 1)
+	suite.True(runesUtxos[0].IsLocked)
+	suite.Equal("1000", runesUtxos[0].Runes[0].Amount, "rune balances should be conserved")
+
+	btcUtxos := k.GetUTXOsByAddr(suite.ctx, destBtcVault)
+	suite.Len(btcUtxos, 1, "btc change should be locked in the destination vault")
+
+	witnessScript, err := descriptor.WitnessScript()
+	suite.NoError(err)
+	suite.Equal(witnessScript, btcUtxos[0].WitnessScript, "witness script of the multisig vault should be set")
+}
+
+func (suite *KeeperTestSuite) TestVaultLifecycle() {
+	k := suite.app.BtcBridgeKeeper
+
+	chainCfg := sdk.GetConfig().GetBtcChainCfg()
+
+	destBtcVault, _ := bech32.Encode(chainCfg.Bech32HRPSegwit, segwit.GenPrivKey().PubKey().Address())
+
+	params := k.GetParams(suite.ctx)
+	params.Vaults = append(params.Vaults, &types.Vault{Address: destBtcVault, AssetType: types.AssetType_ASSET_TYPE_BTC, Version: 1})
+	k.SetParams(suite.ctx, params)
+	k.SetVaultVersion(suite.ctx, 1)
+
+	k.ScheduleVaultsRetirement(suite.ctx, 1)
+
+	vault := types.SelectVaultByAddress(k.GetParams(suite.ctx).Vaults, suite.btcVault)
+	suite.Equal(types.VaultState_VAULT_STATE_DEPRECATED, vault.State, "old vault should be deprecated")
+	suite.Equal(types.VaultState_VAULT_STATE_ACTIVE, types.SelectVaultByAddress(k.GetParams(suite.ctx).Vaults, destBtcVault).State)
+
+	// late deposit to the deprecated vault
+	lateDeposit := &types.UTXO{
+		Txid:         chainhash.HashH([]byte("late deposit")).String(),
+		Vout:         0,
+		Address:      suite.btcVault,
+		Amount:       100000,
+		PubKeyScript: suite.btcVaultPkScript,
+	}
+	suite.setupUTXOs([]*types.UTXO{lateDeposit})
+
+	k.SetFeeRate(suite.ctx, 10)
+	k.SweepDeprecatedVaults(suite.ctx)
+
+	suite.False(k.HasUTXO(suite.ctx, lateDeposit.Txid, lateDeposit.Vout), "late deposit should be swept")
+	suite.Equal(uint64(1), k.GetSigningRequestSequence(suite.ctx), "sweep signing request should be created")
+	suite.Len(k.GetVaultTransfer(suite.ctx, 0).GetAssetTransfer(types.AssetType_ASSET_TYPE_BTC).SigningRequests, 1, "sweep should be recorded in the vault transfer")
+	suite.True(slices.ContainsFunc(suite.ctx.EventManager().Events(), func(e sdk.Event) bool {
+		return e.Type == "side.btcbridge.EventVaultTransferred"
+	}), "typed vault transferred event should be emitted by the sweep")
+
+	// retired after the transition period
+	k.RetireDeprecatedVaults(suite.ctx)
+	suite.Equal(types.VaultState_VAULT_STATE_DEPRECATED, types.SelectVaultByAddress(k.GetParams(suite.ctx).Vaults, suite.btcVault).State, "vault should not be retired within the transition period")
+
+	suite.ctx = suite.ctx.WithBlockTime(vault.RetirementTime.Add(time.Second))
+	k.RetireDeprecatedVaults(suite.ctx)
+
+	vault = types.SelectVaultByAddress(k.GetParams(suite.ctx).Vaults, suite.btcVault)
+	suite.Equal(types.VaultState_VAULT_STATE_RETIRED, vault.State)
+	suite.True(vault.IsRetired(suite.ctx.BlockTime()))
+
+	// governance sweep of the untracked output
+	tx := wire.NewMsgTx(types.TxVersion)
+	tx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&chainhash.Hash{2}, 0), nil, nil))
+	tx.AddTxOut(wire.NewTxOut(90000, types.MustPkScriptFromAddress(destBtcVault)))
+
+	p, err := psbt.NewFromUnsignedTx(tx)
+	suite.NoError(err)
+
+	p.Inputs[0].WitnessUtxo = wire.NewTxOut(100000, suite.btcVaultPkScript)
+	p.Inputs[0].SighashType = types.DefaultSigHashType
+
+	psbtB64, err := p.B64Encode()
+	suite.NoError(err)
+
+	_, err = k.SweepVault(suite.ctx, destBtcVault, psbtB64)
+	suite.ErrorIs(err, types.ErrInvalidVaultSweep, "active vault should not be swept")
+
+	signingReq, err := k.SweepVault(suite.ctx, suite.btcVault, psbtB64)
+	suite.NoError(err)
+	suite.Equal(tx.TxHash().String(), signingReq.Txid)
+	suite.Equal(types.SigningStatus_SIGNING_STATUS_PENDING, signingReq.Status)
+}
+
+func (suite *KeeperTestSuite) TestDKGRetry() {
+	k := suite.app.BtcBridgeKeeper
+
+	participants := suite.setupDKGParticipants(3)
+
+	vaultPubKeys := []string{}
+	for i := 0; i < 2; i++ {
+		privKey, err := secp256k1.NewPrivateKey()
+		suite.NoError(err)
+
+		vaultPubKeys = append(vaultPubKeys, hex.EncodeToString(privKey.PubKey().SerializeCompressed()))
+	}
+
+	params := k.GetParams(suite.ctx)
+	params.TssParams.DkgMaxRetries = 1
+	k.SetParams(suite.ctx, params)
+
+	req, err := k.InitiateDKG(suite.ctx, participants, 2, types.SupportedAssetTypes(), false, 0)
+	suite.NoError(err)
+
+	// one completion only
+	k.SetDKGCompletionRequest(suite.ctx, &types.DKGCompletionRequest{Id: req.Id, Vaults: []string{suite.btcVault, suite.runesVault}, PubKeys: vaultPubKeys, ConsensusAddress: types.MustGetConsensusAddr(participants[0].ConsensusPubkey)})
+
+	k.HandlePendingDKGRequest(suite.ctx, req)
+	suite.Equal(types.DKGRequestStatus_DKG_REQUEST_STATUS_PENDING, k.GetDKGRequest(suite.ctx, req.Id).Status, "dkg request should be pending before expiration")
+
+	// expired
+	suite.ctx = suite.ctx.WithBlockTime(req.Expiration.Add(time.Second)).WithEventManager(sdk.NewEventManager())
+
+	k.HandlePendingDKGRequest(suite.ctx, req)
+
+	req = k.GetDKGRequest(suite.ctx, req.Id)
+	suite.Equal(types.DKGRequestStatus_DKG_REQUEST_STATUS_TIMEDOUT, req.Status)
+	suite.Equal(participants[1:], req.NonResponders, "non-responders should be recorded")
+	suite.True(hasEventAttribute(suite.ctx.EventManager().Events(), "outcome", "retry_skipped"), "retry should be skipped due to insufficient participants")
+
+	// two agreeing completions out of three participants with one silent participant before expiration
+	req, err = k.InitiateDKG(suite.ctx, participants, 2, types.SupportedAssetTypes(), false, 0)
+	suite.NoError(err)
+
+	for _, p := range participants[:2] {
+		k.SetDKGCompletionRequest(suite.ctx, &types.DKGCompletionRequest{Id: req.Id, Vaults: []string{suite.btcVault, suite.runesVault}, PubKeys: vaultPubKeys, ConsensusAddress: types.MustGetConsensusAddr(p.ConsensusPubkey)})
+	}
+
+	suite.ctx = suite.ctx.WithEventManager(sdk.NewEventManager())
+	suite.True(suite.ctx.BlockTime().Before(*req.Expiration))
+
+	responderMissedDKGs := k.GetSignerPerformance(suite.ctx, participants[1].OperatorAddress).TotalMissedDkgs
+	missedDKGs := k.GetSignerPerformance(suite.ctx, participants[2].OperatorAddress).TotalMissedDkgs
+
+	k.HandlePendingDKGRequest(suite.ctx, req)
+
+	req = k.GetDKGRequest(suite.ctx, req.Id)
+	suite.Equal(types.DKGRequestStatus_DKG_REQUEST_STATUS_COMPLETED, req.Status, "dkg request should complete with threshold completions before expiration")
+	suite.Equal(missedDKGs, k.GetSignerPerformance(suite.ctx, participants[2].OperatorAddress).TotalMissedDkgs, "participant yet to respond should not be penalized on the early completion")
+	suite.Equal(responderMissedDKGs, k.GetSignerPerformance(suite.ctx, participants[1].OperatorAddress).TotalMissedDkgs, "responder should not miss the dkg")
+	suite.True(slices.ContainsFunc(suite.ctx.EventManager().Events(), func(e sdk.Event) bool { return e.Type == "side.btcbridge.EventDKGCompleted" }), "typed dkg event should be emitted")
+	suite.Empty(req.NonResponders, "no non-responders should be recorded before expiration")
+	suite.Equal(vaultPubKeys[0], k.GetVaultByAssetTypeAndVersion(suite.ctx, types.AssetType_ASSET_TYPE_BTC, k.GetLatestVaultVersion(suite.ctx)).PubKey, "vault public key should be stored")
+
+	// one completion and one conflicting completion; retry without the non-responder
+	req, err = k.InitiateDKG(suite.ctx, participants, 2, types.SupportedAssetTypes(), false, 0)
+	suite.NoError(err)
+
+	k.SetDKGCompletionRequest(suite.ctx, &types.DKGCompletionRequest{Id: req.Id, Vaults: []string{suite.btcVault, suite.runesVault}, PubKeys: vaultPubKeys, ConsensusAddress: types.MustGetConsensusAddr(participants[0].ConsensusPubkey)})
+	k.SetDKGCompletionRequest(suite.ctx, &types.DKGCompletionRequest{Id: req.Id, Vaults: []string{suite.runesVault, suite.btcVault}, PubKeys: vaultPubKeys, ConsensusAddress: types.MustGetConsensusAddr(participants[1].ConsensusPubkey)})
+
+	suite.ctx = suite.ctx.WithBlockTime(req.Expiration.Add(time.Second)).WithEventManager(sdk.NewEventManager())
+
+	k.HandlePendingDKGRequest(suite.ctx, req)
+	suite.True(hasEventAttribute(suite.ctx.EventManager().Events(), "outcome", "retried"), "dkg request should be retried")
+
+	retryReq := k.GetDKGRequest(suite.ctx, k.GetNextDKGRequestID(suite.ctx)-1)
+	suite.Equal(req.Id, retryReq.RetryOf)
+	suite.Equal(uint32(1), retryReq.RetryCount)
+	suite.Equal(participants[:2], retryReq.Participants, "non-responder should be excluded")
+
+	// retry budget exhausted
+	suite.ctx = suite.ctx.WithBlockTime(retryReq.Expiration.Add(time.Second)).WithEventManager(sdk.NewEventManager())
+
+	k.HandlePendingDKGRequest(suite.ctx, retryReq)
+	suite.Equal(types.DKGRequestStatus_DKG_REQUEST_STATUS_TIMEDOUT, k.GetDKGRequest(suite.ctx, retryReq.Id).Status)
+	suite.True(hasEventAttribute(suite.ctx.EventManager().Events(), "outcome", "retry_skipped"), "retry should be skipped when the budget is exhausted")
+}
+
+// setupDKGParticipants sets up the given number of bonded validators as the DKG participants
+func (suite *KeeperTestSuite) setupDKGParticipants(n int) []*types.DKGParticipant {
+	participants := []*types.DKGParticipant{}
+
+	for i := 0; i < n; i++ {
+		consPubKey := ed25519.GenPrivKey().PubKey()
+		valAddr := sdk.ValAddress(ed25519.GenPrivKey().PubKey().Address())
+
+		validator, err := stakingtypes.NewValidator(valAddr.String(), consPubKey, stakingtypes.Description{})
+		suite.NoError(err)
+
+		validator.Status = stakingtypes.Bonded
+		validator.Tokens = sdk.TokensFromConsensusPower(int64(1000000+n-i), sdk.DefaultPowerReduction)
+		validator.DelegatorShares = sdkmath.LegacyNewDecFromInt(validator.Tokens)
+		suite.NoError(suite.app.StakingKeeper.SetValidator(suite.ctx, validator))
+		suite.NoError(suite.app.StakingKeeper.SetValidatorByPowerIndex(suite.ctx, validator))
+		suite.NoError(suite.app.StakingKeeper.SetValidatorByConsAddr(suite.ctx, validator))
+
+		participants = append(participants, &types.DKGParticipant{
+			OperatorAddress: valAddr.String(),
+			ConsensusPubkey: base64.StdEncoding.EncodeToString(consPubKey.Bytes()),
+		})
+	}
+
+	return participants
+}
+
+func (suite *KeeperTestSuite) TestSignerLiveness() {
+	k := suite.app.BtcBridgeKeeper
+
+	participants := suite.setupDKGParticipants(3)
+
+	vaultPubKeys := []string{}
+	for i := 0; i < 2; i++ {
+		privKey, err := secp256k1.NewPrivateKey()
+		suite.NoError(err)
+
+		vaultPubKeys = append(vaultPubKeys, hex.EncodeToString(privKey.PubKey().SerializeCompressed()))
+	}
+
+	params := k.GetParams(suite.ctx)
+	params.TssParams.SignerLiveness.MaxMissedDkgs = 1
+	params.TssParams.SignerLiveness.SlashFractionBps = 100
+	params.TssParams.SignerLiveness.JailDuration = time.Hour
+	k.SetParams(suite.ctx, params)
+
+	// fund the bonded pool for slashing
+	bondDenom, err := suite.app.StakingKeeper.BondDenom(suite.ctx)
+	suite.NoError(err)
+
+	bondedCoins := sdk.NewCoins(sdk.NewCoin(bondDenom, sdk.TokensFromConsensusPower(10000000, sdk.DefaultPowerReduction)))
+	suite.NoError(suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, bondedCoins))
+	suite.NoError(suite.app.BankKeeper.SendCoinsFromModuleToModule(suite.ctx, types.ModuleName, stakingtypes.BondedPoolName, bondedCoins))
+
+	valAddr, err := sdk.ValAddressFromBech32(participants[2].OperatorAddress)
+	suite.NoError(err)
+
+	validator, err := suite.app.StakingKeeper.GetValidator(suite.ctx, valAddr)
+	suite.NoError(err)
+
+	consAddr, err := validator.GetConsAddr()
+	suite.NoError(err)
+
+	suite.NoError(suite.app.DistrKeeper.Hooks().AfterValidatorCreated(suite.ctx, valAddr))
+	suite.NoError(suite.app.SlashingKeeper.SetValidatorSigningInfo(suite.ctx, consAddr, slashingtypes.NewValidatorSigningInfo(consAddr, 0, 0, time.Unix(0, 0), false, 0)))
+
+	// the last participant misses the DKG completions
+	for i := 0; i < 2; i++ {
+		req, err := k.InitiateDKG(suite.ctx, participants, 2, types.SupportedAssetTypes(), false, 0)
+		suite.NoError(err)
+
+		for _, p := range participants[:2] {
+			k.SetDKGCompletionRequest(suite.ctx, &types.DKGCompletionRequest{Id: req.Id, Vaults: []string{suite.btcVault, suite.runesVault}, PubKeys: vaultPubKeys, ConsensusAddress: types.MustGetConsensusAddr(p.ConsensusPubkey)})
+		}
+
+		suite.ctx = suite.ctx.WithBlockTime(req.Expiration.Add(time.Second)).WithEventManager(sdk.NewEventManager())
+
+		k.HandlePendingDKGRequest(suite.ctx, req)
+		suite.Equal(types.DKGRequestStatus_DKG_REQUEST_STATUS_COMPLETED, k.GetDKGRequest(suite.ctx, req.Id).Status)
+
+		if i == 0 {
+			performance := k.GetSignerPerformance(suite.ctx, participants[2].OperatorAddress)
+			suite.Len(performance.MissedDkgHeights, 1)
+			suite.Zero(performance.Punishments, "signer should not be punished within the limit")
+		}
+	}
+
+	res, err := k.QuerySignerPerformance(suite.ctx, &types.QuerySignerPerformanceRequest{OperatorAddress: participants[2].OperatorAddress})
+	suite.NoError(err)
+	suite.Equal(uint64(2), res.Performance.TotalMissedDkgs)
+	suite.Equal(uint64(1), res.Performance.Punishments, "signer should be punished when exceeding the limit")
+	suite.Empty(res.Performance.MissedDkgHeights, "missed heights should be reset after punishment")
+	suite.True(hasEventAttribute(suite.ctx.EventManager().Events(), "signer_punished", participants[2].OperatorAddress))
+
+	punished, err := suite.app.StakingKeeper.GetValidator(suite.ctx, valAddr)
+	suite.NoError(err)
+	suite.True(punished.IsJailed(), "signer should be jailed")
+	suite.True(punished.Tokens.LT(validator.Tokens), "signer should be slashed")
+
+	signingInfo, err := suite.app.SlashingKeeper.GetValidatorSigningInfo(suite.ctx, consAddr)
+	suite.NoError(err)
+	suite.Equal(suite.ctx.BlockTime().Add(time.Hour), signingInfo.JailedUntil)
+
+	suite.Zero(k.GetSignerPerformance(suite.ctx, participants[0].OperatorAddress).TotalMissedDkgs, "responding signer should not miss")
+}
+
+func (suite *KeeperTestSuite) TestParticipantUpdate() {
+	k := suite.app.BtcBridgeKeeper
+
+	participants := suite.setupDKGParticipants(3)
+
+	params := k.GetParams(suite.ctx)
+	params.TssParams.AutoRotationEnabled = true
+	params.TssParams.MaxParticipants = 3
+	k.SetParams(suite.ctx, params)
+
+	req, err := k.InitiateDKG(suite.ctx, participants, 2, types.SupportedAssetTypes(), false, 0)
+	suite.NoError(err)
+
+	req.Status = types.DKGRequestStatus_DKG_REQUEST_STATUS_COMPLETED
+	k.SetDKGRequest(suite.ctx, req)
+
+	hooks := k.Hooks()
+	delAddr := sdk.MustAccAddressFromBech32(suite.sender)
+
+	valAddr, err := sdk.ValAddressFromBech32(participants[0].OperatorAddress)
+	suite.NoError(err)
+
+	// ordinary delegation does not change the top bonded validators
+	suite.NoError(hooks.AfterDelegationModified(suite.ctx, delAddr, valAddr))
+	suite.False(k.IsParticipantUpdatePending(suite.ctx), "ordinary delegation should not mark participant update")
+
+	k.HandleParticipantUpdate(suite.ctx)
+	suite.Empty(k.GetPendingDKGRequests(suite.ctx), "no dkg should be initiated by ordinary delegation")
+
+	// no participant update
+	k.MarkParticipantUpdatePending(suite.ctx)
+	k.HandleParticipantUpdate(suite.ctx)
+	suite.False(k.IsParticipantUpdatePending(suite.ctx), "pending participant update should be cleared")
+	suite.Empty(k.GetPendingDKGRequests(suite.ctx), "no dkg should be initiated without participant update")
+
+	// one participant leaves the bonded set
+	newParticipants := suite.setupDKGParticipants(1)
+
+	leftValAddr, err := sdk.ValAddressFromBech32(participants[2].OperatorAddress)
+	suite.NoError(err)
+
+	validator, err := suite.app.StakingKeeper.GetValidator(suite.ctx, leftValAddr)
+	suite.NoError(err)
+	suite.NoError(suite.app.StakingKeeper.DeleteValidatorByPowerIndex(suite.ctx, validator))
+
+	// delegation observing the changed top bonded validators
+	suite.NoError(hooks.AfterDelegationModified(suite.ctx, delAddr, valAddr))
+	suite.True(k.IsParticipantUpdatePending(suite.ctx), "participant update should be marked when the top bonded validators change")
+
+	k.HandleParticipantUpdate(suite.ctx)
+
+	pendingReqs := k.GetPendingDKGRequests(suite.ctx)
+	suite.Len(pendingReqs, 1, "dkg should be initiated when a participant leaves")
+	suite.True(pendingReqs[0].EnableTransfer, "vault transfer should be enabled")
+	suite.Equal(uint32(2), pendingReqs[0].Threshold)
+	suite.ElementsMatch([]string{newParticipants[0].ConsensusPubkey, participants[0].ConsensusPubkey, participants[1].ConsensusPubkey}, consensusPubKeys(pendingReqs[0].Participants))
+	suite.True(hasEventAttribute(suite.ctx.EventManager().Events(), "rotation_reason", keeper.RotationReasonParticipantLeft))
+
+	// ordinary delegations do not restart the dkg after the retry budget runs out
+	pendingReqs[0].Status = types.DKGRequestStatus_DKG_REQUEST_STATUS_TIMEDOUT
+	k.SetDKGRequest(suite.ctx, pendingReqs[0])
+
+	suite.NoError(hooks.AfterDelegationModified(suite.ctx, delAddr, valAddr))
+	suite.False(k.IsParticipantUpdatePending(suite.ctx), "ordinary delegation should not mark participant update again")
+
+	k.HandleParticipantUpdate(suite.ctx)
+	suite.Empty(k.GetPendingDKGRequests(suite.ctx), "no dkg should be restarted by ordinary delegation")
+
+	// retire the old vaults after the transition period
+	k.ScheduleVaultsRetirement(suite.ctx, 1)
+
+	for _, v := range k.GetParams(suite.ctx).Vaults {
+		suite.NotNil(v.RetirementTime)
+		suite.False(v.IsRetired(suite.ctx.BlockTime()))
+		suite.True(v.IsRetired(suite.ctx.BlockTime().Add(params.TssParams.ParticipantUpdateTransitionPeriod)))
+	}
+}
+
+func (suite *KeeperTestSuite) TestFrostSigning() {
+	k := suite.app.BtcBridgeKeeper
+
+	frostKey, err := frost.GenerateKey(3, 2)
+	suite.NoError(err)
+
+	vaultAddr, err := btcutil.NewAddressTaproot(schnorr.SerializePubKey(txscript.ComputeTaprootKeyNoScript(frostKey.GroupKey)), sdk.GetConfig().GetBtcChainCfg())
+	suite.NoError(err)
+
+	vaultPkScript, err := txscript.PayToAddrScript(vaultAddr)
+	suite.NoError(err)
+
+	params := k.GetParams(suite.ctx)
+	params.Vaults = append(params.Vaults, &types.Vault{Address: vaultAddr.EncodeAddress(), AssetType: types.AssetType_ASSET_TYPE_BTC, Version: 1})
+	k.SetParams(suite.ctx, params)
+
+	signers := make([]string, 3)
+	vaultKey := &types.FrostVaultKey{Vault: vaultAddr.EncodeAddress(), PubKey: hex.EncodeToString(frostKey.GroupKey.SerializeCompressed()), Threshold: 2}
+
+	for i := range signers {
+		signers[i] = sdk.ValAddress(ed25519.GenPrivKey().PubKey().Address()).String()
+		vaultKey.Signers = append(vaultKey.Signers, types.FrostSigner{OperatorAddress: signers[i], Index: uint32(i + 1), VerificationShare: frostKey.VerificationShares[i]})
+	}
+
+	k.SetFrostVaultKey(suite.ctx, vaultKey)
+
+	// signing request spending the vault utxo
+	tx := wire.NewMsgTx(types.TxVersion)
+	tx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&chainhash.Hash{1}, 0), nil, nil))
+	tx.AddTxOut(wire.NewTxOut(90000, suite.senderPkScript))
+
+	p, err := psbt.NewFromUnsignedTx(tx)
+	suite.NoError(err)
+
+	p.Inputs[0].WitnessUtxo = wire.NewTxOut(100000, vaultPkScript)
+
+	psbtB64, err := p.B64Encode()
+	suite.NoError(err)
+
+	txid := tx.TxHash().String()
+	k.SetSigningRequest(suite.ctx, &types.SigningRequest{Address: vaultAddr.EncodeAddress(), Sequence: 1, Txid: txid, Psbt: psbtB64, Status: types.SigningStatus_SIGNING_STATUS_PENDING})
+
+	prevOutputFetcher := txscript.NewCannedPrevOutputFetcher(vaultPkScript, 100000)
+	sigHash, err := txscript.CalcTaprootSignatureHash(txscript.NewTxSigHashes(tx, prevOutputFetcher), types.DefaultSigHashType, tx, 0, prevOutputFetcher)
+	suite.NoError(err)
+
+	input, err := types.NewFrostSigningInput(vaultKey, nil, sigHash)
+	suite.NoError(err)
+
+	// commit and sign by the given signers; the signer of the given index submits the invalid partial signature if any
+	sign := func(signerIndexes []uint32, misbehavingIndex uint32) *types.FrostSigningSession {
+		nonces := make([]*frost.Nonce, len(signerIndexes))
+		commitments := make([]*types.FrostSignerCommitment, len(signerIndexes))
+
+		for i, index := range signerIndexes {
+			nonce, commitment, err := frost.GenerateNonce()
+			suite.NoError(err)
+
+			_, err = k.SubmitNonceCommitments(suite.ctx, signers[index-1], txid, []types.FrostNonceCommitment{commitment})
+			suite.NoError(err)
+
+			hiding, _ := types.ParseFrostPoint(commitment.Hiding)
+			binding, _ := types.ParseFrostPoint(commitment.Binding)
+
+			nonces[i] = nonce
+			commitments[i] = &types.FrostSignerCommitment{Index: index, Hiding: hiding, Binding: binding}
+		}
+
+		var session *types.FrostSigningSession
+
+		for i, index := range signerIndexes {
+			partialSig, err := frostKey.PartialSign(input, commitments, index, nonces[i])
+			suite.NoError(err)
+
+			if index == misbehavingIndex {
+				partialSig.Add(new(secp256k1.ModNScalar).SetInt(1))
+			}
+
+			var sigBytes [32]byte
+			partialSig.PutBytes(&sigBytes)
+
+			session, err = k.SubmitPartialSignatures(suite.ctx, signers[index-1], txid, []string{hex.EncodeToString(sigBytes[:])})
+			suite.NoError(err)
+
+			if index == misbehavingIndex {
+				break
+			}
+		}
+
+		return session
+	}
+
+	_, commitment, err := frost.GenerateNonce()
+	suite.NoError(err)
+
+	_, err = k.SubmitNonceCommitments(suite.ctx, signers[2], txid, []types.FrostNonceCommitment{commitment, commitment})
+	suite.Error(err, "mismatched nonce commitments")
+
+	_, err = k.SubmitNonceCommitments(suite.ctx, sdk.ValAddress(ed25519.GenPrivKey().PubKey().Address()).String(), txid, []types.FrostNonceCommitment{commitment})
+	suite.Error(err, "non signer")
+
+	// misbehaving signer
+	session := sign([]uint32{1, 2}, 1)
+	suite.Equal(types.FrostSigningStatus_FROST_SIGNING_STATUS_COMMITMENT, session.Status, "session should restart")
+	suite.Equal(uint32(2), session.Round)
+	suite.Equal([]string{signers[0]}, session.MisbehavingSigners)
+	suite.True(hasEventAttribute(suite.ctx.EventManager().Events(), "misbehaving_signer", signers[0]))
+
+	_, err = k.SubmitNonceCommitments(suite.ctx, signers[0], txid, []types.FrostNonceCommitment{commitment})
+	suite.Error(err, "misbehaving signer should be excluded")
+	suite.Equal(uint64(1), k.GetSignerPerformance(suite.ctx, signers[0]).TotalMissedSignings, "misbehaving signer should miss the round")
+
+	// signing round timed out without partial signatures
+	for _, signer := range signers[1:] {
+		_, err = k.SubmitNonceCommitments(suite.ctx, signer, txid, []types.FrostNonceCommitment{commitment})
+		suite.NoError(err)
+	}
+
+	k.HandleFrostSigningTimeouts(suite.ctx)
+	suite.Equal(types.FrostSigningStatus_FROST_SIGNING_STATUS_SIGNING, k.GetFrostSigningSession(suite.ctx, txid).Status, "session should not time out before the timeout")
+
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(params.TssParams.SignerLiveness.SigningRoundTimeout))
+	k.HandleFrostSigningTimeouts(suite.ctx)
+
+	session = k.GetFrostSigningSession(suite.ctx, txid)
+	suite.Equal(types.FrostSigningStatus_FROST_SIGNING_STATUS_COMMITMENT, session.Status, "session should restart after timeout")
+	suite.Equal(uint32(3), session.Round)
+	suite.Equal(uint64(1), k.GetSignerPerformance(suite.ctx, signers[2]).TotalMissedSignings, "signer not submitting should miss the round")
+
+	// honest signers
+	session = sign([]uint32{2, 3}, 0)
+	suite.Equal(types.FrostSigningStatus_FROST_SIGNING_STATUS_COMPLETED, session.Status)
+
+	signingRequest := k.GetSigningRequestByTxHash(suite.ctx, txid)
+	suite.Equal(types.SigningStatus_SIGNING_STATUS_BROADCASTED, signingRequest.Status)
+
+	signedPsbt, err := psbt.NewFromRawBytes(bytes.NewReader([]byte(signingRequest.Psbt)), true)
+	suite.NoError(err)
+	suite.True(signedPsbt.IsComplete())
+	suite.True(types.VerifyPsbtSignatures(signedPsbt))
+	suite.Empty(k.GetActiveFrostSigningSessions(suite.ctx), "completed session should not be active")
+
+	history := k.GetSigningRequestHistory(suite.ctx, 1)
+	transitions := []types.SigningTransition{}
+	for _, entry := range history {
+		transitions = append(transitions, entry.Transition)
+	}
+
+	suite.Equal([]types.SigningTransition{
+		types.SigningTransition_SIGNING_TRANSITION_CREATED,
+		types.SigningTransition_SIGNING_TRANSITION_REPLACED,
+		types.SigningTransition_SIGNING_TRANSITION_REPLACED,
+		types.SigningTransition_SIGNING_TRANSITION_SIGNATURES_SUBMITTED,
+		types.SigningTransition_SIGNING_TRANSITION_SIGNATURES_SUBMITTED,
+		types.SigningTransition_SIGNING_TRANSITION_BROADCASTED,
+	}, transitions)
+	suite.Equal(signers[0], history[1].Actor, "misbehaving signer should be recorded")
+	suite.Empty(history[2].Actor, "timed out round should be replaced by the module")
+	suite.Equal(signers[1], history[3].Actor, "signer should be recorded")
+	suite.Equal(signers[2], history[4].Actor, "signer should be recorded")
+	suite.True(slices.ContainsFunc(suite.ctx.EventManager().Events(), func(e sdk.Event) bool { return e.Type == "side.btcbridge.EventSigningRequestReplaced" }), "typed replaced event should be emitted")
+
+	// the signing request fails once the threshold can no longer be reached
+	tx = wire.NewMsgTx(types.TxVersion)
+	tx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&chainhash.Hash{2}, 0), nil, nil))
+	tx.AddTxOut(wire.NewTxOut(90000, suite.senderPkScript))
+
+	p, err = psbt.NewFromUnsignedTx(tx)
+	suite.NoError(err)
+
+	p.Inputs[0].WitnessUtxo = wire.NewTxOut(100000, vaultPkScript)
+
+	psbtB64, err = p.B64Encode()
+	suite.NoError(err)
+
+	txid = tx.TxHash().String()
+	k.SetSigningRequest(suite.ctx, &types.SigningRequest{Address: vaultAddr.EncodeAddress(), Sequence: 2, Txid: txid, Psbt: psbtB64, Status: types.SigningStatus_SIGNING_STATUS_PENDING})
+
+	sigHash, err = txscript.CalcTaprootSignatureHash(txscript.NewTxSigHashes(tx, prevOutputFetcher), types.DefaultSigHashType, tx, 0, prevOutputFetcher)
+	suite.NoError(err)
+
+	input, err = types.NewFrostSigningInput(vaultKey, nil, sigHash)
+	suite.NoError(err)
+
+	session = sign([]uint32{1, 2}, 2)
+	suite.Equal(types.FrostSigningStatus_FROST_SIGNING_STATUS_COMMITMENT, session.Status, "session should restart while the threshold is reachable")
+
+	session = sign([]uint32{1, 3}, 3)
+	suite.Equal(types.FrostSigningStatus_FROST_SIGNING_STATUS_FAILED, session.Status, "session should fail once the threshold is unreachable")
+	suite.Equal(types.SigningStatus_SIGNING_STATUS_FAILED, k.GetSigningRequestByTxHash(suite.ctx, txid).Status)
+	suite.Empty(k.GetActiveFrostSigningSessions(suite.ctx), "failed session should not be active")
+
+	history = k.GetSigningRequestHistory(suite.ctx, 2)
+	suite.Len(history, 5)
+	suite.Equal(types.SigningTransition_SIGNING_TRANSITION_REPLACED, history[2].Transition, "restarted round should be replaced")
+	suite.Equal(signers[1], history[2].Actor, "misbehaving signer should be recorded")
+	suite.Equal(types.SigningTransition_SIGNING_TRANSITION_FAILED, history[4].Transition, "failed request should not be replaced")
+	suite.True(slices.ContainsFunc(suite.ctx.EventManager().Events(), func(e sdk.Event) bool { return e.Type == "side.btcbridge.EventSigningRequestFailed" }), "typed failed event should be emitted")
+}
+
+func (suite *KeeperTestSuite) TestSigningRequestHistory() {
+	k := suite.app.BtcBridgeKeeper
+
+	suite.ctx = suite.ctx.WithBlockHeight(100)
+
+	signingRequest := &types.SigningRequest{
+		Address:      suite.sender,
+		Sequence:     1,
+		Type:         types.AssetType_ASSET_TYPE_BTC,
+		Txid:         chainhash.HashH([]byte("signing")).String(),
+		CreationTime: suite.ctx.BlockTime(),
+		Status:       types.SigningStatus_SIGNING_STATUS_PENDING,
+	}
+	k.SetSigningRequest(suite.ctx, signingRequest)
+	k.SetSigningRequest(suite.ctx, signingRequest)
+
+	suite.Len(k.GetSigningRequestHistory(suite.ctx, 1), 1, "unchanged status should not be recorded")
+
+	suite.ctx = suite.ctx.WithBlockHeight(101)
+
+	signingRequest.Status = types.SigningStatus_SIGNING_STATUS_CONFIRMED
+	k.SetSigningRequest(suite.ctx, signingRequest)
+
+	res, err := k.QuerySigningRequest(suite.ctx, &types.QuerySigningRequestRequest{Sequence: 1})
+	suite.NoError(err)
+	suite.Len(res.History, 2)
+	suite.Equal(types.SigningTransition_SIGNING_TRANSITION_CREATED, res.History[0].Transition)
+	suite.Equal(int64(100), res.History[0].Height)
+	suite.Equal(types.SigningTransition_SIGNING_TRANSITION_CONFIRMED, res.History[1].Transition)
+	suite.Equal(int64(101), res.History[1].Height)
+
+	events := suite.ctx.EventManager().Events()
+	for _, eventType := range []string{"side.btcbridge.EventSigningRequestCreated", "side.btcbridge.EventSigningRequestConfirmed"} {
+		suite.True(slices.ContainsFunc(events, func(e sdk.Event) bool { return e.Type == eventType }), "%s should be emitted", eventType)
+	}
+}
+
+func consensusPubKeys(participants []*types.DKGParticipant) []string {
+	pubKeys := []string{}
+	for _, p := range participants {
+		pubKeys = append(pubKeys, p.ConsensusPubkey)
+	}
+
+	return pubKeys
+}
+
+func hasEventAttribute(events sdk.Events, key string, value string) bool {
+	for _, event := range events {
+		for _, attr := range event.Attributes {
+			if attr.Key == key && attr.Value == value {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func (suite *KeeperTestSuite) TestWithdrawRunes() {
+	runeId := "840000:3"
+	runeAmount := 500000000
+
+	runesUTXOs := []*types.UTXO{
+		{
+			Txid:         chainhash.HashH([]byte("runes")).String(),
+			Vout:         1,
+			Address:      suite.runesVault,
+			Amount:       types.RunesOutValue,
+			PubKeyScript: suite.runesVaultPkScript,
+			IsLocked:     false,
+			Runes: []*types.RuneBalance{
+				{
+					Id:     runeId,
+					Amount: fmt.Sprintf("%d", runeAmount),
+				},
+			},
+		},
+	}
+	suite.setupUTXOs(runesUTXOs)
+
+	feeRate := 100
+	amount := runeAmount + 1
+
+	denom := fmt.Sprintf("%s/%s", types.RunesProtocolName, runeId)
+	coin := sdk.NewInt64Coin(denom, int64(amount))
+
+	_, err := suite.app.BtcBridgeKeeper.NewRunesSigningRequest(suite.ctx, suite.sender, coin, int64(feeRate), suite.runesVault, suite.btcVault)
+	suite.ErrorIs(err, types.ErrInsufficientUTXOs, "should fail due to insufficient runes utxos")
+
+	amount = 100000000
+	coin = sdk.NewInt64Coin(denom, int64(amount))
+
+	_, err = suite.app.BtcBridgeKeeper.NewRunesSigningRequest(suite.ctx, suite.sender, coin, int64(feeRate), suite.runesVault, suite.btcVault)
+	suite.ErrorIs(err, types.ErrInsufficientUTXOs, "should fail due to insufficient payment utxos")
+
+	paymentUTXOs := []*types.UTXO{
+		{
+			Txid:         chainhash.HashH([]byte("payment")).String(),
+			Vout:         1,
+			Address:      suite.btcVault,
+			Amount:       100000,
+			PubKeyScript: suite.btcVaultPkScript,
+			IsLocked:     false,
+		},
+	}
+	suite.setupUTXOs(paymentUTXOs)
+
+	req, err := suite.app.BtcBridgeKeeper.NewRunesSigningRequest(suite.ctx, suite.sender, coin, int64(feeRate), suite.runesVault, suite.btcVault)
+	suite.NoError(err)
+
+	suite.False(suite.app.BtcBridgeKeeper.HasUTXO(suite.ctx, runesUTXOs[0].Txid, runesUTXOs[0].Vout), "runes utxo should be spent")
+	suite.False(suite.app.BtcBridgeKeeper.HasUTXO(suite.ctx, paymentUTXOs[0].Txid, paymentUTXOs[0].Vout), "payment utxo should be spent")
+
+	runesUTXOs = suite.app.BtcBridgeKeeper.GetUTXOsByAddr(suite.ctx, suite.runesVault)
+	suite.Len(runesUTXOs, 1, "there should be 1 runes utxo(s)")
+
+	suite.True(runesUTXOs[0].IsLocked, "the rune utxo should be locked")
+	suite.Len(runesUTXOs[0].Runes, 1, "there should be 1 rune in the runes utxo")
+	suite.Equal(runeId, runesUTXOs[0].Runes[0].Id, "incorrect rune id")
+	suite.Equal(uint64(runeAmount-amount), types.RuneAmountFromString(runesUTXOs[0].Runes[0].Amount).Big().Uint64(), "incorrect rune amount")
+
+	p, err := psbt.NewFromRawBytes(bytes.NewReader([]byte(req.Psbt)), true)
+	suite.NoError(err)
+
+	suite.Len(p.Inputs, 2, "there should be 2 inputs")
+	suite.Equal(suite.runesVaultPkScript, p.Inputs[0].WitnessUtxo.PkScript, "the first input should be runes vault")
+	suite.Equal(suite.btcVaultPkScript, p.Inputs[1].WitnessUtxo.PkScript, "the second input should be btc vault")
+
+	expectedRunesScript, err := types.BuildEdictScript(runeId, uint128.From64(uint64(amount)), 2)
+	suite.NoError(err)
+
+	suite.Len(p.UnsignedTx.TxOut, 4, "there should be 4 outputs")
+	suite.Equal(expectedRunesScript, p.UnsignedTx.TxOut[0].PkScript, "incorrect runes script")
+	suite.Equal(suite.runesVaultPkScript, p.UnsignedTx.TxOut[1].PkScript, "the second output should be runes change output")
+	suite.Equal(suite.senderPkScript, p.UnsignedTx.TxOut[2].PkScript, "the third output should be sender output")
+	suite.Equal(suite.btcVaultPkScript, p.UnsignedTx.TxOut[3].PkScript, "the fouth output should be btc change output")
+}
+
+func (suite *KeeperTestSuite) TestMintBrc20() {
+	transfer := types.NewBrc20Transfer("ordi", sdkmath.NewIntWithDecimal(1000, types.Brc20Decimals))
+
+	inscriptionScript, err := types.BuildBrc20TransferScript(make([]byte, 32), transfer)
+	suite.NoError(err)
+
+	// reveal tx which inscribes the brc20 transfer to the sender
+	revealTx := wire.NewMsgTx(types.TxVersion)
+	revealTx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&chainhash.Hash{}, 0), nil, wire.TxWitness{make([]byte, 64), inscriptionScript, make([]byte, txscript.ControlBlockBaseSize)}))
+	revealTx.AddTxOut(wire.NewTxOut(types.Brc20OutValue, suite.senderPkScript))
+
+	revealTxHash := revealTx.TxHash()
+
+	// deposit tx which sends the inscription to the brc20 vault
+	tx := wire.NewMsgTx(types.TxVersion)
+	tx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&revealTxHash, 0), nil, nil))
+	tx.AddTxOut(wire.NewTxOut(types.Brc20OutValue, suite.brc20VaultPkScript))
+	tx.AddTxOut(wire.NewTxOut(AttachedBtcAmount, suite.btcVaultPkScript))
+
+	denom := types.Brc20Denom("ordi")
+
+	cacheCtx, _ := suite.ctx.CacheContext()
+
+	assetType, recipient, err := suite.app.BtcBridgeKeeper.Mint(cacheCtx, suite.btcVault, btcutil.NewTx(tx), btcutil.NewTx(revealTx), 0)
+	suite.ErrorIs(err, types.ErrUntrustedNonBtcRelayer, "should fail due to untrusted non btc relayer")
+	suite.Equal(types.AssetType_ASSET_TYPE_BRC20, assetType)
+	suite.Nil(recipient)
+
+	cacheCtx, _ = suite.ctx.CacheContext()
+
+	_, _, err = suite.app.BtcBridgeKeeper.Mint(cacheCtx, suite.sender, btcutil.NewTx(tx), btcutil.NewTx(revealTx), 0)
+	suite.ErrorIs(err, types.ErrBrc20TickNotRegistered, "should fail due to unregistered ticker")
+
+	suite.NoError(suite.app.BtcBridgeKeeper.RegisterBrc20Tickers(suite.ctx, suite.sender, []*types.Brc20Metadata{{Tick: "ORDI", Decimals: 18}}))
+
+	assetType, recipient, err = suite.app.BtcBridgeKeeper.Mint(suite.ctx, suite.sender, btcutil.NewTx(tx), btcutil.NewTx(revealTx), 0)
+	suite.NoError(err)
+	suite.Equal(types.AssetType_ASSET_TYPE_BRC20, assetType)
+	suite.Equal(suite.sender, recipient.EncodeAddress(), "incorrect recipient")
+
+	protocolFee := suite.app.BtcBridgeKeeper.GetProtocolDepositFee(suite.ctx, sdk.NewCoin(denom, transfer.Amount()))
+	suite.True(protocolFee.IsPositive(), "the protocol fee should be charged in brc20")
+
+	balance := suite.app.BankKeeper.GetBalance(suite.ctx, sdk.MustAccAddressFromBech32(suite.sender), denom)
+	suite.Equal(transfer.Amount().Sub(protocolFee.Amount), balance.Amount, "incorrect %s balance after mint", denom)
+
+	utxos := suite.app.BtcBridgeKeeper.GetUTXOsByAddr(suite.ctx, suite.brc20Vault)
+	suite.Len(utxos, 1, "there should be 1 brc20 utxo(s)")
+	suite.Equal(uint64(0), utxos[0].Vout, "the inscription should be received by the first output")
+}
+
+func (suite *KeeperTestSuite) TestWithdrawBrc20() {
+	feeRate := int64(100)
+	amount := sdk.NewCoin(types.Brc20Denom("ordi"), sdkmath.NewIntWithDecimal(15, types.Brc20Decimals-1))
+
+	vault := types.SelectVaultByAssetType(suite.app.BtcBridgeKeeper.GetParams(suite.ctx).Vaults, types.AssetType_ASSET_TYPE_BRC20)
+
+	_, err := suite.app.BtcBridgeKeeper.NewBrc20SigningRequests(suite.ctx, suite.sender, amount, feeRate, vault, suite.btcVault)
+	suite.ErrorIs(err, types.ErrInsufficientUTXOs, "should fail due to insufficient payment utxos")
+
+	paymentUTXOs := []*types.UTXO{
+		{
+			Txid:         chainhash.HashH([]byte("payment")).String(),
+			Vout:         1,
+			Address:      suite.btcVault,
+			Amount:       100000,
+			PubKeyScript: suite.btcVaultPkScript,
+			IsLocked:     false,
+		},
+	}
+	suite.setupUTXOs(paymentUTXOs)
+
+	reqs, err := suite.app.BtcBridgeKeeper.NewBrc20SigningRequests(suite.ctx, suite.sender, amount, feeRate, vault, suite.btcVault)
+	suite.NoError(err)
+	suite.Len(reqs, 3, "there should be 3 signing requests")
+
+	suite.False(suite.app.BtcBridgeKeeper.HasUTXO(suite.ctx, paymentUTXOs[0].Txid, paymentUTXOs[0].Vout), "payment utxo should be spent")
+
+	psbts := make([]*psbt.Packet, len(reqs))
+	for i, req := range reqs {
+		suite.Equal(types.AssetType_ASSET_TYPE_BRC20, req.Type)
+
+		psbts[i], err = psbt.NewFromRawBytes(bytes.NewReader([]byte(req.Psbt)), true)
+		suite.NoError(err)
+	}
+
+	commit, reveal, transfer := psbts[0].UnsignedTx, psbts[1].UnsignedTx, psbts[2].UnsignedTx
+
+	suite.Len(commit.TxOut, 2, "there should be 2 commit outputs")
+	suite.True(txscript.IsPayToTaproot(commit.TxOut[0].PkScript), "the first commit output should be the inscription commitment")
+	suite.Equal(suite.btcVaultPkScript, commit.TxOut[1].PkScript, "the second commit output should be btc change output")
+
+	suite.Equal(commit.TxHash(), reveal.TxIn[0].PreviousOutPoint.Hash, "the reveal tx should spend the commit tx")
+	suite.Len(reveal.TxOut, 1, "there should be 1 reveal output")
+	suite.Equal(suite.brc20VaultPkScript, reveal.TxOut[0].PkScript, "the inscription should be revealed to the brc20 vault")
+
+	suite.Len(psbts[1].Inputs[0].TaprootLeafScript, 1, "the reveal input should be spent by the script path")
+
+	inscription, err := types.ParseInscription(psbts[1].Inputs[0].TaprootLeafScript[0].Script)
+	suite.NoError(err)
+	suite.JSONEq(`{"p":"brc-20","op":"transfer","tick":"ordi","amt":"1.5"}`, string(inscription.Body))
+
+	pkBytes, _ := hex.DecodeString(suite.brc20VaultPubKey)
+	pubKey, err := schnorr.ParsePubKey(pkBytes[1:])
+	suite.NoError(err)
+	suite.Equal(schnorr.SerializePubKey(pubKey), psbts[1].Inputs[0].TaprootLeafScript[0].Script[1:33], "the inscription should be signed by the brc20 vault")
+
+	suite.Equal(reveal.TxHash(), transfer.TxIn[0].PreviousOutPoint.Hash, "the transfer tx should spend the reveal tx")
+	suite.Len(transfer.TxOut, 1, "there should be 1 transfer output")
+	suite.Equal(suite.senderPkScript, transfer.TxOut[0].PkScript, "the inscription should be transferred to the sender")
+	suite.Equal(int64(types.Brc20OutValue), transfer.TxOut[0].Value)
+
+	// the amount is checked against the decimals of the ticker before burning
+	sequence := suite.app.BtcBridgeKeeper.GetSigningRequestSequence(suite.ctx)
+
+	_, err = suite.app.BtcBridgeKeeper.HandleBrc20Withdrawal(suite.ctx, suite.sender, amount)
+	suite.ErrorIs(err, types.ErrBrc20TickNotRegistered, "should fail due to unregistered ticker")
+
+	suite.NoError(suite.app.BtcBridgeKeeper.RegisterBrc20Tickers(suite.ctx, suite.sender, []*types.Brc20Metadata{{Tick: "ordi", Decimals: 0}}))
+	suite.ErrorIs(suite.app.BtcBridgeKeeper.RegisterBrc20Tickers(suite.ctx, suite.sender, []*types.Brc20Metadata{{Tick: "ordi", Decimals: 18}}), types.ErrBrc20TickAlreadyRegistered, "registered ticker should only be updated by the authority")
+
+	_, err = suite.app.BtcBridgeKeeper.HandleBrc20Withdrawal(suite.ctx, suite.sender, amount)
+	suite.ErrorIs(err, types.ErrInvalidBrc20Amount, "should fail due to the amount exceeding the precision of the ticker")
+	suite.Equal(sequence, suite.app.BtcBridgeKeeper.GetSigningRequestSequence(suite.ctx), "no signing request should be created")
 }
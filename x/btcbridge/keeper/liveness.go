@@ -0,0 +1,197 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sideprotocol/side/x/btcbridge/types"
+)
+
+// SetSignerPerformance sets the liveness performance of the TSS participant
+func (k Keeper) SetSignerPerformance(ctx sdk.Context, performance *types.SignerPerformance) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := k.cdc.MustMarshal(performance)
+	store.Set(types.SignerPerformanceKey(performance.OperatorAddress), bz)
+}
+
+// GetSignerPerformance gets the liveness performance of the given TSS participant
+// The missed heights out of the current window are excluded
+func (k Keeper) GetSignerPerformance(ctx sdk.Context, operatorAddress string) *types.SignerPerformance {
+	store := ctx.KVStore(k.storeKey)
+
+	performance := &types.SignerPerformance{
+		OperatorAddress: operatorAddress,
+	}
+
+	bz := store.Get(types.SignerPerformanceKey(operatorAddress))
+	if bz != nil {
+		k.cdc.MustUnmarshal(bz, performance)
+	}
+
+	k.pruneSignerPerformance(ctx, performance)
+
+	return performance
+}
+
+// GetAllSignerPerformances gets the liveness performances of all TSS participants
+func (k Keeper) GetAllSignerPerformances(ctx sdk.Context) []*types.SignerPerformance {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := storetypes.KVStorePrefixIterator(store, types.SignerPerformanceKeyPrefix)
+	defer iterator.Close()
+
+	performances := make([]*types.SignerPerformance, 0)
+
+	for ; iterator.Valid(); iterator.Next() {
+		var performance types.SignerPerformance
+		k.cdc.MustUnmarshal(iterator.Value(), &performance)
+
+		k.pruneSignerPerformance(ctx, &performance)
+
+		performances = append(performances, &performance)
+	}
+
+	return performances
+}
+
+// RecordMissedDKG records the missed DKG completion of the given TSS participant
+func (k Keeper) RecordMissedDKG(ctx sdk.Context, operatorAddress string) {
+	k.recordMissed(ctx, operatorAddress, true)
+}
+
+// RecordMissedSigning records the missed signing round of the given TSS participant
+func (k Keeper) RecordMissedSigning(ctx sdk.Context, operatorAddress string) {
+	k.recordMissed(ctx, operatorAddress, false)
+}
+
+// recordMissed records the missed DKG completion or signing round of the given TSS participant
+// The participant is punished once the missed number in the window exceeds the limit
+func (k Keeper) recordMissed(ctx sdk.Context, operatorAddress string, dkg bool) {
+	params := k.GetParams(ctx).TssParams.SignerLiveness
+	if params.Window == 0 {
+		return
+	}
+
+	performance := k.GetSignerPerformance(ctx, operatorAddress)
+
+	var missed int
+	var limit uint32
+
+	if dkg {
+		performance.MissedDkgHeights = append(performance.MissedDkgHeights, ctx.BlockHeight())
+		performance.TotalMissedDkgs++
+
+		missed, limit = len(performance.MissedDkgHeights), params.MaxMissedDkgs
+	} else {
+		performance.MissedSigningHeights = append(performance.MissedSigningHeights, ctx.BlockHeight())
+		performance.TotalMissedSignings++
+
+		missed, limit = len(performance.MissedSigningHeights), params.MaxMissedSignings
+	}
+
+	if limit != 0 && uint32(missed) > limit {
+		k.punishSigner(ctx, performance, &params)
+	}
+
+	k.SetSignerPerformance(ctx, performance)
+}
+
+// punishSigner slashes and jails the given TSS participant according to the liveness params
+// The missed heights are reset so that the participant is not punished repeatedly for the same misses
+func (k Keeper) punishSigner(ctx sdk.Context, performance *types.SignerPerformance, params *types.SignerLivenessParams) {
+	performance.MissedDkgHeights = nil
+	performance.MissedSigningHeights = nil
+
+	cacheCtx, write := ctx.CacheContext()
+
+	punished, err := k.slashAndJailSigner(cacheCtx, performance.OperatorAddress, params)
+	if err != nil {
+		k.Logger(ctx).Error("failed to punish signer", "operator", performance.OperatorAddress, "err", err)
+		return
+	}
+
+	if !punished {
+		return
+	}
+
+	write()
+
+	performance.Punishments++
+	performance.LastPunishmentHeight = ctx.BlockHeight()
+
+	k.EmitEvent(ctx, k.authority,
+		sdk.NewAttribute("signer_punished", performance.OperatorAddress),
+		sdk.NewAttribute("slash_fraction_bps", fmt.Sprintf("%d", params.SlashFractionBps)),
+		sdk.NewAttribute("jail_duration", params.JailDuration.String()),
+	)
+}
+
+// slashAndJailSigner slashes and jails the validator of the given operator address
+// The validator already jailed is not punished again
+func (k Keeper) slashAndJailSigner(ctx sdk.Context, operatorAddress string, params *types.SignerLivenessParams) (bool, error) {
+	valAddr, err := sdk.ValAddressFromBech32(operatorAddress)
+	if err != nil {
+		return false, err
+	}
+
+	validator, err := k.stakingKeeper.GetValidator(ctx, valAddr)
+	if err != nil {
+		return false, err
+	}
+
+	if validator.IsJailed() {
+		return false, nil
+	}
+
+	consAddr, err := validator.GetConsAddr()
+	if err != nil {
+		return false, err
+	}
+
+	if params.SlashFractionBps > 0 {
+		fraction := sdkmath.LegacyNewDec(int64(params.SlashFractionBps)).QuoInt64(types.MaxFeeBps)
+		power := validator.ConsensusPower(k.stakingKeeper.PowerReduction(ctx))
+
+		if err := k.slashingKeeper.Slash(ctx, consAddr, fraction, power, ctx.BlockHeight()); err != nil {
+			return false, err
+		}
+	}
+
+	if params.JailDuration > 0 {
+		if err := k.slashingKeeper.Jail(ctx, consAddr); err != nil {
+			return false, err
+		}
+
+		if err := k.slashingKeeper.JailUntil(ctx, consAddr, ctx.BlockTime().Add(params.JailDuration)); err != nil {
+			return false, err
+		}
+	}
+
+	return true, nil
+}
+
+// pruneSignerPerformance removes the missed heights out of the current window
+func (k Keeper) pruneSignerPerformance(ctx sdk.Context, performance *types.SignerPerformance) {
+	window := k.GetParams(ctx).TssParams.SignerLiveness.Window
+	startHeight := ctx.BlockHeight() - window + 1
+
+	performance.MissedDkgHeights = pruneHeights(performance.MissedDkgHeights, startHeight)
+	performance.MissedSigningHeights = pruneHeights(performance.MissedSigningHeights, startHeight)
+}
+
+// pruneHeights removes the heights before the given start height
+func pruneHeights(heights []int64, startHeight int64) []int64 {
+	pruned := make([]int64, 0, len(heights))
+
+	for _, h := range heights {
+		if h >= startHeight {
+			pruned = append(pruned, h)
+		}
+	}
+
+	return pruned
+}
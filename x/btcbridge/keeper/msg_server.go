@@ -94,6 +94,10 @@ func (m msgServer) SubmitDepositTransaction(goCtx context.Context, msg *types.Ms
 		return nil, types.ErrDepositNotEnabled
 	}
 
+	if err := m.CheckNotPaused(ctx, types.PauseScope_PAUSE_SCOPE_DEPOSIT, ""); err != nil {
+		return nil, err
+	}
+
 	txHash, recipient, err := m.ProcessBitcoinDepositTransaction(ctx, msg)
 	if err != nil {
 		return nil, err
@@ -106,6 +110,13 @@ func (m msgServer) SubmitDepositTransaction(goCtx context.Context, msg *types.Ms
 		sdk.NewAttribute("recipient", recipient.EncodeAddress()),
 	)
 
+	_ = ctx.EventManager().EmitTypedEvent(&types.EventDeposit{
+		Sender:    msg.Sender,
+		Blockhash: msg.Blockhash,
+		Txid:      txHash.String(),
+		Recipient: recipient.EncodeAddress(),
+	})
+
 	return &types.MsgSubmitDepositTransactionResponse{}, nil
 }
 
@@ -168,6 +179,10 @@ func (m msgServer) WithdrawToBitcoin(goCtx context.Context, msg *types.MsgWithdr
 		return nil, types.ErrWithdrawNotEnabled
 	}
 
+	if err := m.CheckNotPaused(ctx, types.PauseScope_PAUSE_SCOPE_WITHDRAW, ""); err != nil {
+		return nil, err
+	}
+
 	sender := sdk.MustAccAddressFromBech32(msg.Sender)
 
 	amount, err := sdk.ParseCoinNormalized(msg.Amount)
@@ -175,15 +190,19 @@ func (m msgServer) WithdrawToBitcoin(goCtx context.Context, msg *types.MsgWithdr
 		return nil, err
 	}
 
-	if m.ProtocolWithdrawFeeEnabled(ctx) {
-		// deduct the protocol fee and get the actual withdrawal amount
-		amount, err = m.handleWithdrawProtocolFee(ctx, sender, amount)
-		if err != nil {
-			return nil, err
-		}
+	// deduct the protocol fee and get the actual withdrawal amount
+	amount, err = m.handleWithdrawProtocolFee(ctx, sender, amount)
+	if err != nil {
+		return nil, err
+	}
+
+	var withdrawRequest *types.WithdrawRequest
+	if msg.IsPriority() {
+		withdrawRequest, err = m.HandlePriorityBtcWithdrawal(ctx, msg.Sender, amount, msg.FeeRate, msg.MaxFee)
+	} else {
+		withdrawRequest, err = m.HandleWithdrawal(ctx, msg.Sender, amount)
 	}
 
-	withdrawRequest, err := m.HandleWithdrawal(ctx, msg.Sender, amount)
 	if err != nil {
 		return nil, err
 	}
@@ -195,6 +214,13 @@ func (m msgServer) WithdrawToBitcoin(goCtx context.Context, msg *types.MsgWithdr
 		sdk.NewAttribute("txid", withdrawRequest.Txid),
 	)
 
+	_ = ctx.EventManager().EmitTypedEvent(&types.EventWithdrawRequested{
+		Sender:   msg.Sender,
+		Amount:   amount.String(),
+		Sequence: withdrawRequest.Sequence,
+		Txid:     withdrawRequest.Txid,
+	})
+
 	return &types.MsgWithdrawToBitcoinResponse{}, nil
 }
 
@@ -238,6 +264,8 @@ func (m msgServer) SubmitSignatures(goCtx context.Context, msg *types.MsgSubmitS
 		return nil, errorsmod.Wrap(types.ErrInvalidSignatures, "failed to verify")
 	}
 
+	m.RecordSigningRequestTransition(ctx, signingRequest, types.SigningTransition_SIGNING_TRANSITION_SIGNATURES_SUBMITTED, msg.Sender)
+
 	// set the signing request status to broadcasted
 	signingRequest.Psbt = msg.Psbt
 	signingRequest.Status = types.SigningStatus_SIGNING_STATUS_BROADCASTED
@@ -301,11 +329,14 @@ func (m msgServer) CompleteDKG(goCtx context.Context, msg *types.MsgCompleteDKG)
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
 	req := &types.DKGCompletionRequest{
-		Id:               msg.Id,
-		Sender:           msg.Sender,
-		Vaults:           msg.Vaults,
-		ConsensusAddress: msg.ConsensusAddress,
-		Signature:        msg.Signature,
+		Id:                 msg.Id,
+		Sender:             msg.Sender,
+		Vaults:             msg.Vaults,
+		ConsensusAddress:   msg.ConsensusAddress,
+		Signature:          msg.Signature,
+		PubKeys:            msg.PubKeys,
+		Proofs:             msg.Proofs,
+		VerificationShares: msg.VerificationShares,
 	}
 
 	if err := m.Keeper.CompleteDKG(ctx, req); err != nil {
@@ -346,6 +377,220 @@ func (m msgServer) TransferVault(goCtx context.Context, msg *types.MsgTransferVa
 	return &types.MsgTransferVaultResponse{}, nil
 }
 
+// RecoverVault recovers the vault through the timelocked recovery script path.
+func (m msgServer) RecoverVault(goCtx context.Context, msg *types.MsgRecoverVault) (*types.MsgRecoverVaultResponse, error) {
+	if m.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", m.authority, msg.Authority)
+	}
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	signingReq, err := m.Keeper.RecoverVault(ctx, msg.Vault, msg.Recipient, msg.InternalKey, msg.MaxUtxoNum)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRecoverVaultResponse{
+		Sequence: signingReq.Sequence,
+	}, nil
+}
+
+// SweepVault sweeps the outputs of the retired vault.
+func (m msgServer) SweepVault(goCtx context.Context, msg *types.MsgSweepVault) (*types.MsgSweepVaultResponse, error) {
+	if m.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", m.authority, msg.Authority)
+	}
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	signingReq, err := m.Keeper.SweepVault(ctx, msg.Vault, msg.Psbt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSweepVaultResponse{
+		Sequence: signingReq.Sequence,
+	}, nil
+}
+
+// SubmitNonceCommitments submits the FROST nonce commitments of the signing request.
+func (m msgServer) SubmitNonceCommitments(goCtx context.Context, msg *types.MsgSubmitNonceCommitments) (*types.MsgSubmitNonceCommitmentsResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, _ := sdk.AccAddressFromBech32(msg.Sender)
+
+	session, err := m.Keeper.SubmitNonceCommitments(ctx, sdk.ValAddress(sender).String(), msg.Txid, msg.Commitments)
+	if err != nil {
+		return nil, err
+	}
+
+	// Emit events
+	m.EmitEvent(ctx, msg.Sender,
+		sdk.NewAttribute("txid", msg.Txid),
+		sdk.NewAttribute("round", fmt.Sprintf("%d", session.Round)),
+		sdk.NewAttribute("status", session.Status.String()),
+	)
+
+	return &types.MsgSubmitNonceCommitmentsResponse{}, nil
+}
+
+// SubmitPartialSignatures submits the FROST partial signatures of the signing request.
+func (m msgServer) SubmitPartialSignatures(goCtx context.Context, msg *types.MsgSubmitPartialSignatures) (*types.MsgSubmitPartialSignaturesResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, _ := sdk.AccAddressFromBech32(msg.Sender)
+
+	session, err := m.Keeper.SubmitPartialSignatures(ctx, sdk.ValAddress(sender).String(), msg.Txid, msg.PartialSignatures)
+	if err != nil {
+		return nil, err
+	}
+
+	// Emit events
+	m.EmitEvent(ctx, msg.Sender,
+		sdk.NewAttribute("txid", msg.Txid),
+		sdk.NewAttribute("round", fmt.Sprintf("%d", session.Round)),
+		sdk.NewAttribute("status", session.Status.String()),
+	)
+
+	return &types.MsgSubmitPartialSignaturesResponse{}, nil
+}
+
+// RegisterRunes registers the metadata of the given runes.
+func (m msgServer) RegisterRunes(goCtx context.Context, msg *types.MsgRegisterRunes) (*types.MsgRegisterRunesResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := m.Keeper.RegisterRunes(ctx, msg.Sender, msg.Runes); err != nil {
+		return nil, err
+	}
+
+	// Emit events
+	for _, metadata := range msg.Runes {
+		m.EmitEvent(ctx, msg.Sender,
+			sdk.NewAttribute("rune_id", metadata.Id),
+			sdk.NewAttribute("rune_name", metadata.Name),
+		)
+	}
+
+	return &types.MsgRegisterRunesResponse{}, nil
+}
+
+// RegisterBrc20Tickers registers the metadata of the given brc20 tickers.
+func (m msgServer) RegisterBrc20Tickers(goCtx context.Context, msg *types.MsgRegisterBrc20Tickers) (*types.MsgRegisterBrc20TickersResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := m.Keeper.RegisterBrc20Tickers(ctx, msg.Sender, msg.Tickers); err != nil {
+		return nil, err
+	}
+
+	// Emit events
+	for _, metadata := range msg.Tickers {
+		m.EmitEvent(ctx, msg.Sender,
+			sdk.NewAttribute("brc20_tick", metadata.Tick),
+			sdk.NewAttribute("brc20_decimals", fmt.Sprintf("%d", metadata.Decimals)),
+		)
+	}
+
+	return &types.MsgRegisterBrc20TickersResponse{}, nil
+}
+
+// ResetCircuitBreaker resets the tripped withdrawal circuit breaker.
+func (m msgServer) ResetCircuitBreaker(goCtx context.Context, msg *types.MsgResetCircuitBreaker) (*types.MsgResetCircuitBreakerResponse, error) {
+	if m.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", m.authority, msg.Authority)
+	}
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := m.Keeper.ResetCircuitBreaker(ctx); err != nil {
+		return nil, err
+	}
+
+	// Emit events
+	m.EmitEvent(ctx, msg.Authority,
+		sdk.NewAttribute("circuit_breaker", "reset"),
+	)
+
+	return &types.MsgResetCircuitBreakerResponse{}, nil
+}
+
+// EmergencyPause pauses the bridge in the given scope until expiration.
+func (m msgServer) EmergencyPause(goCtx context.Context, msg *types.MsgEmergencyPause) (*types.MsgEmergencyPauseResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	pause, err := m.Keeper.EmergencyPause(ctx, msg.Guardian, msg.Scope, msg.Denom, msg.Duration)
+	if err != nil {
+		return nil, err
+	}
+
+	// Emit events
+	m.EmitEvent(ctx, msg.Guardian,
+		sdk.NewAttribute("emergency_pause", "paused"),
+		sdk.NewAttribute("id", fmt.Sprintf("%d", pause.Id)),
+		sdk.NewAttribute("scope", pause.Scope.String()),
+		sdk.NewAttribute("denom", pause.Denom),
+		sdk.NewAttribute("expiration", pause.Expiration.String()),
+	)
+
+	return &types.MsgEmergencyPauseResponse{Id: pause.Id}, nil
+}
+
+// LiftEmergencyPause lifts the given emergency pause before expiration.
+func (m msgServer) LiftEmergencyPause(goCtx context.Context, msg *types.MsgLiftEmergencyPause) (*types.MsgLiftEmergencyPauseResponse, error) {
+	if m.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", m.authority, msg.Authority)
+	}
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := m.Keeper.LiftEmergencyPause(ctx, msg.Id); err != nil {
+		return nil, err
+	}
+
+	// Emit events
+	m.EmitEvent(ctx, msg.Authority,
+		sdk.NewAttribute("emergency_pause", "lifted"),
+		sdk.NewAttribute("id", fmt.Sprintf("%d", msg.Id)),
+	)
+
+	return &types.MsgLiftEmergencyPauseResponse{}, nil
+}
+
 // UpdateParams updates the module params.
 func (m msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
 	if m.authority != msg.Authority {
@@ -359,6 +604,9 @@ func (m msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParam
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	m.SetParams(ctx, msg.Params)
 
+	// the btc voucher denom may be changed
+	m.SetBtcVoucherMetadata(ctx)
+
 	return &types.MsgUpdateParamsResponse{}, nil
 }
 
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/sideprotocol/side/x/btcbridge/types"
@@ -31,14 +32,61 @@ func (k Keeper) WithdrawEnabled(ctx sdk.Context) bool {
 	return k.GetParams(ctx).WithdrawEnabled
 }
 
-// ProtocolDepositFeeEnabled returns true if the protocol fee is required for deposit, false otherwise
-func (k Keeper) ProtocolDepositFeeEnabled(ctx sdk.Context) bool {
-	return k.GetParams(ctx).ProtocolFees.DepositFee > 0
+// GetRuneConfig gets the config of the given rune; nil returned if the rune is not configured
+func (k Keeper) GetRuneConfig(ctx sdk.Context, id string) *types.RuneConfig {
+	return types.SelectRuneConfig(k.GetParams(ctx).RuneConfigs, id)
 }
 
-// ProtocolWithdrawFeeEnabled returns true if the protocol fee is required for withdrawal, false otherwise
-func (k Keeper) ProtocolWithdrawFeeEnabled(ctx sdk.Context) bool {
-	return k.GetParams(ctx).ProtocolFees.WithdrawFee > 0
+// GetEnabledRuneConfig gets the config of the given rune
+// Error returned if the rune is not configured or not enabled
+func (k Keeper) GetEnabledRuneConfig(ctx sdk.Context, id string) (*types.RuneConfig, error) {
+	config := k.GetRuneConfig(ctx, id)
+	if config == nil || !config.Enabled {
+		return nil, errorsmod.Wrapf(types.ErrRuneNotEnabled, "rune %s", id)
+	}
+
+	return config, nil
+}
+
+// GetProtocolDepositFee gets the protocol fee for depositing the given amount
+// The fee is charged in the same denom as the asset
+func (k Keeper) GetProtocolDepositFee(ctx sdk.Context, amount sdk.Coin) sdk.Coin {
+	return sdk.NewCoin(amount.Denom, k.getProtocolFeeSchedule(ctx, amount.Denom, false).Compute(amount.Amount))
+}
+
+// GetProtocolWithdrawFee gets the protocol fee for withdrawing the given amount
+// The fee is charged in the same denom as the asset
+func (k Keeper) GetProtocolWithdrawFee(ctx sdk.Context, amount sdk.Coin) sdk.Coin {
+	return sdk.NewCoin(amount.Denom, k.getProtocolFeeSchedule(ctx, amount.Denom, true).Compute(amount.Amount))
+}
+
+// getProtocolFeeSchedule gets the protocol fee schedule for the given denom
+// The fee override of the rune takes precedence if any
+func (k Keeper) getProtocolFeeSchedule(ctx sdk.Context, denom string, withdrawal bool) *types.FeeSchedule {
+	params := k.GetParams(ctx)
+
+	schedule := &params.ProtocolFees.DepositFeeSchedule
+	if withdrawal {
+		schedule = &params.ProtocolFees.WithdrawFeeSchedule
+	}
+
+	switch types.AssetTypeFromDenom(denom, params) {
+	case types.AssetType_ASSET_TYPE_BTC:
+		return schedule
+
+	case types.AssetType_ASSET_TYPE_RUNES:
+		config := types.SelectRuneConfig(params.RuneConfigs, types.RuneIdFromDenom(denom))
+		if config != nil && config.FeeOverride != nil {
+			if withdrawal {
+				return &config.FeeOverride.WithdrawFeeSchedule
+			}
+
+			return &config.FeeOverride.DepositFeeSchedule
+		}
+	}
+
+	// the floor and cap in sat are not applicable to the non-btc assets
+	return schedule.WithoutBounds()
 }
 
 // ProtocolFeeCollector gets the protocol fee collector
@@ -89,6 +137,17 @@ func (k Keeper) IsTrustedFeeProvider(ctx sdk.Context, addr string) bool {
 	return false
 }
 
+// IsGuardian returns true if the given address is a guardian, false otherwise
+func (k Keeper) IsGuardian(ctx sdk.Context, addr string) bool {
+	for _, guardian := range k.GetParams(ctx).Guardians {
+		if guardian == addr {
+			return true
+		}
+	}
+
+	return false
+}
+
 // GetVaultByAssetTypeAndVersion gets the vault by the given asset type and version
 func (k Keeper) GetVaultByAssetTypeAndVersion(ctx sdk.Context, assetType types.AssetType, version uint64) *types.Vault {
 	for _, v := range k.GetParams(ctx).Vaults {
@@ -100,6 +159,27 @@ func (k Keeper) GetVaultByAssetTypeAndVersion(ctx sdk.Context, assetType types.A
 	return nil
 }
 
+// GetVaultsByAssetTypeAndVersion gets all the vaults by the given asset type and version
+func (k Keeper) GetVaultsByAssetTypeAndVersion(ctx sdk.Context, assetType types.AssetType, version uint64) []*types.Vault {
+	return types.SelectVaultsByAssetTypeAndVersion(k.GetParams(ctx).Vaults, assetType, version)
+}
+
+// GetVaultWitnessScript gets the witness script of the given vault
+// Nil is returned if the vault is not described by the script descriptor
+func (k Keeper) GetVaultWitnessScript(ctx sdk.Context, address string) []byte {
+	vault := types.SelectVaultByAddress(k.GetParams(ctx).Vaults, address)
+	if vault == nil || vault.ScriptDescriptor == nil {
+		return nil
+	}
+
+	witnessScript, err := vault.ScriptDescriptor.WitnessScript()
+	if err != nil {
+		return nil
+	}
+
+	return witnessScript
+}
+
 // GetVaultVersionByAddress gets the vault version of the given address
 func (k Keeper) GetVaultVersionByAddress(ctx sdk.Context, address string) (uint64, bool) {
 	for _, v := range k.GetParams(ctx).Vaults {
@@ -117,3 +197,10 @@ func (k Keeper) GetMaxUtxoNum(ctx sdk.Context) int {
 
 	return int(params.WithdrawParams.MaxUtxoNum)
 }
+
+// GetCoinSelector gets the coin selector for the signing request
+func (k Keeper) GetCoinSelector(ctx sdk.Context) types.CoinSelector {
+	params := k.GetParams(ctx)
+
+	return types.NewCoinSelector(params.WithdrawParams.CoinSelection)
+}
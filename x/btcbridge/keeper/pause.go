@@ -0,0 +1,219 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sideprotocol/side/x/btcbridge/types"
+)
+
+// GetNextEmergencyPauseID gets the next emergency pause ID
+func (k Keeper) GetNextEmergencyPauseID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.EmergencyPauseIDKey)
+	if bz == nil {
+		return 1
+	}
+
+	return sdk.BigEndianToUint64(bz) + 1
+}
+
+// SetEmergencyPauseID sets the current emergency pause ID
+func (k Keeper) SetEmergencyPauseID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Set(types.EmergencyPauseIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+// EmergencyPause pauses the bridge in the given scope for the given duration by the guardian
+func (k Keeper) EmergencyPause(ctx sdk.Context, guardian string, scope types.PauseScope, denom string, duration time.Duration) (*types.EmergencyPause, error) {
+	if !k.IsGuardian(ctx, guardian) {
+		return nil, types.ErrUnauthorizedGuardian
+	}
+
+	if maxDuration := k.GetParams(ctx).MaxPauseDuration; duration > maxDuration {
+		return nil, errorsmod.Wrapf(types.ErrInvalidEmergencyPause, "duration must not be greater than %s", maxDuration)
+	}
+
+	pause := &types.EmergencyPause{
+		Id:         k.GetNextEmergencyPauseID(ctx),
+		Guardian:   guardian,
+		Scope:      scope,
+		Denom:      denom,
+		StartTime:  ctx.BlockTime(),
+		Expiration: ctx.BlockTime().Add(duration),
+	}
+
+	k.SetEmergencyPause(ctx, pause)
+	k.SetEmergencyPauseID(ctx, pause.Id)
+
+	return pause, nil
+}
+
+// LiftEmergencyPause lifts the given emergency pause before expiration
+func (k Keeper) LiftEmergencyPause(ctx sdk.Context, id uint64) error {
+	if !k.HasEmergencyPause(ctx, id) {
+		return types.ErrEmergencyPauseDoesNotExist
+	}
+
+	pause := k.GetEmergencyPause(ctx, id)
+	if !pause.IsActive(ctx.BlockTime()) {
+		return types.ErrEmergencyPauseNotActive
+	}
+
+	pause.Lifted = true
+	k.SetEmergencyPause(ctx, pause)
+
+	return nil
+}
+
+// IsPaused returns true if the bridge is paused in the given scope, false otherwise
+// The asset is only checked for the asset scope
+func (k Keeper) IsPaused(ctx sdk.Context, scope types.PauseScope, denom string) bool {
+	paused := false
+
+	k.IterateActiveEmergencyPauses(ctx, func(pause *types.EmergencyPause) (stop bool) {
+		if pause.IsActive(ctx.BlockTime()) && pause.Covers(scope, denom) {
+			paused = true
+			return true
+		}
+
+		return false
+	})
+
+	return paused
+}
+
+// CheckNotPaused returns an error if the bridge is paused in the given scope
+func (k Keeper) CheckNotPaused(ctx sdk.Context, scope types.PauseScope, denom string) error {
+	if k.IsPaused(ctx, scope, denom) {
+		if scope == types.PauseScope_PAUSE_SCOPE_ASSET {
+			return errorsmod.Wrapf(types.ErrPaused, "asset %s", denom)
+		}
+
+		return errorsmod.Wrapf(types.ErrPaused, "scope %s", scope)
+	}
+
+	return nil
+}
+
+// CheckAssetsNotPaused returns an error if any of the given assets is paused
+func (k Keeper) CheckAssetsNotPaused(ctx sdk.Context, coins sdk.Coins) error {
+	for _, coin := range coins {
+		if err := k.CheckNotPaused(ctx, types.PauseScope_PAUSE_SCOPE_ASSET, coin.Denom); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// HasEmergencyPause returns true if the given emergency pause exists, false otherwise
+func (k Keeper) HasEmergencyPause(ctx sdk.Context, id uint64) bool {
+	store := ctx.KVStore(k.storeKey)
+
+	return store.Has(types.EmergencyPauseKey(id))
+}
+
+// GetEmergencyPause gets the emergency pause by the given id
+func (k Keeper) GetEmergencyPause(ctx sdk.Context, id uint64) *types.EmergencyPause {
+	store := ctx.KVStore(k.storeKey)
+
+	var pause types.EmergencyPause
+	bz := store.Get(types.EmergencyPauseKey(id))
+	k.cdc.MustUnmarshal(bz, &pause)
+
+	return &pause
+}
+
+// SetEmergencyPause sets the given emergency pause
+// The pause is indexed as active until lifted or pruned after expiration
+func (k Keeper) SetEmergencyPause(ctx sdk.Context, pause *types.EmergencyPause) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Set(types.EmergencyPauseKey(pause.Id), k.cdc.MustMarshal(pause))
+
+	if pause.Lifted {
+		store.Delete(types.ActiveEmergencyPauseKey(pause.Id))
+	} else {
+		store.Set(types.ActiveEmergencyPauseKey(pause.Id), []byte{})
+	}
+}
+
+// GetEmergencyPauses gets all the emergency pauses
+func (k Keeper) GetEmergencyPauses(ctx sdk.Context) []*types.EmergencyPause {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := storetypes.KVStorePrefixIterator(store, types.EmergencyPauseKeyPrefix)
+	defer iterator.Close()
+
+	pauses := make([]*types.EmergencyPause, 0)
+
+	for ; iterator.Valid(); iterator.Next() {
+		var pause types.EmergencyPause
+		k.cdc.MustUnmarshal(iterator.Value(), &pause)
+
+		pauses = append(pauses, &pause)
+	}
+
+	return pauses
+}
+
+// GetActiveEmergencyPauses gets the active emergency pauses
+func (k Keeper) GetActiveEmergencyPauses(ctx sdk.Context) []*types.EmergencyPause {
+	pauses := make([]*types.EmergencyPause, 0)
+
+	k.IterateActiveEmergencyPauses(ctx, func(pause *types.EmergencyPause) (stop bool) {
+		if pause.IsActive(ctx.BlockTime()) {
+			pauses = append(pauses, pause)
+		}
+
+		return false
+	})
+
+	return pauses
+}
+
+// IterateActiveEmergencyPauses iterates through the emergency pauses indexed as active
+func (k Keeper) IterateActiveEmergencyPauses(ctx sdk.Context, cb func(pause *types.EmergencyPause) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := prefix.NewStore(store, types.ActiveEmergencyPauseKeyPrefix).Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		if cb(k.GetEmergencyPause(ctx, sdk.BigEndianToUint64(iterator.Key()))) {
+			break
+		}
+	}
+}
+
+// PruneExpiredEmergencyPauses removes the expired emergency pauses from the active index
+func (k Keeper) PruneExpiredEmergencyPauses(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+
+	expired := make([]*types.EmergencyPause, 0)
+
+	k.IterateActiveEmergencyPauses(ctx, func(pause *types.EmergencyPause) (stop bool) {
+		if !pause.IsActive(ctx.BlockTime()) {
+			expired = append(expired, pause)
+		}
+
+		return false
+	})
+
+	for _, pause := range expired {
+		store.Delete(types.ActiveEmergencyPauseKey(pause.Id))
+
+		k.EmitEvent(ctx, pause.Guardian,
+			sdk.NewAttribute("emergency_pause", "expired"),
+			sdk.NewAttribute("id", fmt.Sprintf("%d", pause.Id)),
+		)
+	}
+}
@@ -165,8 +165,9 @@ func (k Keeper) QuerySigningRequest(goCtx context.Context, req *types.QuerySigni
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
 	request := k.GetSigningRequest(ctx, req.Sequence)
+	history := k.GetSigningRequestHistory(ctx, req.Sequence)
 
-	return &types.QuerySigningRequestResponse{Request: request}, nil
+	return &types.QuerySigningRequestResponse{Request: request, History: history}, nil
 }
 
 func (k Keeper) QuerySigningRequests(goCtx context.Context, req *types.QuerySigningRequestsRequest) (*types.QuerySigningRequestsResponse, error) {
@@ -310,3 +311,226 @@ func (k Keeper) QueryDKGCompletionRequests(goCtx context.Context, req *types.Que
 
 	return &types.QueryDKGCompletionRequestsResponse{Requests: requests}, nil
 }
+
+func (k Keeper) QueryRunes(goCtx context.Context, req *types.QueryRunesRequest) (*types.QueryRunesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	runes := k.GetAllRuneMetadata(ctx)
+
+	return &types.QueryRunesResponse{Runes: runes}, nil
+}
+
+func (k Keeper) QueryRune(goCtx context.Context, req *types.QueryRuneRequest) (*types.QueryRuneResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var id types.RuneId
+	if err := id.FromString(req.Id); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid rune id")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	metadata := k.GetRuneMetadata(ctx, req.Id)
+	if metadata == nil {
+		return nil, status.Error(codes.NotFound, "rune not registered")
+	}
+
+	return &types.QueryRuneResponse{Rune: metadata}, nil
+}
+
+func (k Keeper) QueryRuneConfigs(goCtx context.Context, req *types.QueryRuneConfigsRequest) (*types.QueryRuneConfigsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	configs := make([]*types.RuneConfigWithSupply, 0)
+
+	for _, config := range k.GetParams(ctx).RuneConfigs {
+		if len(req.Id) != 0 && config.Id != req.Id {
+			continue
+		}
+
+		configs = append(configs, &types.RuneConfigWithSupply{
+			Config: config,
+			Supply: k.bankKeeper.GetSupply(ctx, types.RunesDenomFromId(config.Id)).Amount.String(),
+		})
+	}
+
+	if len(req.Id) != 0 && len(configs) == 0 {
+		return nil, status.Error(codes.NotFound, "rune not configured")
+	}
+
+	return &types.QueryRuneConfigsResponse{Configs: configs}, nil
+}
+
+func (k Keeper) QueryProtocolFees(goCtx context.Context, req *types.QueryProtocolFeesRequest) (*types.QueryProtocolFeesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	amount, err := sdk.ParseCoinNormalized(req.Amount)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if types.AssetTypeFromDenom(amount.Denom, k.GetParams(ctx)) == types.AssetType_ASSET_TYPE_UNSPECIFIED {
+		return nil, status.Error(codes.InvalidArgument, "asset not supported")
+	}
+
+	return &types.QueryProtocolFeesResponse{
+		DepositFee:  k.GetProtocolDepositFee(ctx, amount).String(),
+		WithdrawFee: k.GetProtocolWithdrawFee(ctx, amount).String(),
+	}, nil
+}
+
+func (k Keeper) QueryFeeDistribution(goCtx context.Context, req *types.QueryFeeDistributionRequest) (*types.QueryFeeDistributionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QueryFeeDistributionResponse{
+		Buckets:              k.GetFeeBucketStats(ctx),
+		LastSettlementHeight: k.GetLastFeeSettlementHeight(ctx),
+	}, nil
+}
+
+func (k Keeper) QueryOutflowUsage(goCtx context.Context, req *types.QueryOutflowUsageRequest) (*types.QueryOutflowUsageResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if len(req.Denom) != 0 {
+		if err := sdk.ValidateDenom(req.Denom); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QueryOutflowUsageResponse{
+		Window:  k.GetParams(ctx).WithdrawRateLimit.Window,
+		Global:  k.GetGlobalOutflowUsage(ctx),
+		Assets:  k.GetOutflowUsages(ctx, req.Denom),
+		Breaker: k.GetCircuitBreaker(ctx),
+	}, nil
+}
+
+func (k Keeper) QueryEmergencyPauses(goCtx context.Context, req *types.QueryEmergencyPausesRequest) (*types.QueryEmergencyPausesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if req.ActiveOnly {
+		return &types.QueryEmergencyPausesResponse{Pauses: k.GetActiveEmergencyPauses(ctx)}, nil
+	}
+
+	return &types.QueryEmergencyPausesResponse{Pauses: k.GetEmergencyPauses(ctx)}, nil
+}
+
+func (k Keeper) QueryDepositAddress(goCtx context.Context, req *types.QueryDepositAddressRequest) (*types.QueryDepositAddressResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	vault, err := k.AssignDepositVault(ctx, req.AssetType)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &types.QueryDepositAddressResponse{Vault: vault, ActiveVaults: k.GetActiveVaults(ctx, req.AssetType)}, nil
+}
+
+func (k Keeper) QueryFrostSigningSession(goCtx context.Context, req *types.QueryFrostSigningSessionRequest) (*types.QueryFrostSigningSessionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	session := k.GetFrostSigningSession(ctx, req.Txid)
+	if session == nil {
+		return nil, status.Error(codes.NotFound, "frost signing session not found")
+	}
+
+	return &types.QueryFrostSigningSessionResponse{Session: session}, nil
+}
+
+func (k Keeper) QueryFrostVaultKey(goCtx context.Context, req *types.QueryFrostVaultKeyRequest) (*types.QueryFrostVaultKeyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	key := k.GetFrostVaultKey(ctx, req.Vault)
+	if key == nil {
+		return nil, status.Error(codes.NotFound, "frost vault key not found")
+	}
+
+	return &types.QueryFrostVaultKeyResponse{Key: key}, nil
+}
+
+func (k Keeper) QuerySignerPerformance(goCtx context.Context, req *types.QuerySignerPerformanceRequest) (*types.QuerySignerPerformanceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if _, err := sdk.ValAddressFromBech32(req.OperatorAddress); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid operator address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QuerySignerPerformanceResponse{Performance: k.GetSignerPerformance(ctx, req.OperatorAddress)}, nil
+}
+
+func (k Keeper) QuerySignerPerformances(goCtx context.Context, req *types.QuerySignerPerformancesRequest) (*types.QuerySignerPerformancesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QuerySignerPerformancesResponse{Performances: k.GetAllSignerPerformances(ctx)}, nil
+}
+
+func (k Keeper) QueryVaultTransfer(goCtx context.Context, req *types.QueryVaultTransferRequest) (*types.QueryVaultTransferResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	transfer := k.GetVaultTransfer(ctx, req.SourceVersion)
+	if transfer == nil {
+		return nil, status.Error(codes.NotFound, "vault transfer not found")
+	}
+
+	return &types.QueryVaultTransferResponse{Transfer: transfer}, nil
+}
+
+func (k Keeper) QueryVaultTransfers(goCtx context.Context, req *types.QueryVaultTransfersRequest) (*types.QueryVaultTransfersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QueryVaultTransfersResponse{Transfers: k.GetAllVaultTransfers(ctx)}, nil
+}
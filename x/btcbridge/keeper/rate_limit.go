@@ -0,0 +1,286 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sideprotocol/side/x/btcbridge/types"
+)
+
+// CheckOutflow checks if the given outflow is allowed in the current window
+// The btc amount is the total btc leaving the vaults which is limited by the global limit
+func (k Keeper) CheckOutflow(ctx sdk.Context, amount sdk.Coin, btcAmount int64) error {
+	if k.IsCircuitBreakerTripped(ctx) {
+		return types.ErrCircuitBreakerTripped
+	}
+
+	rateLimit := k.GetParams(ctx).WithdrawRateLimit
+	if !rateLimit.IsEnabled() {
+		return nil
+	}
+
+	if limit := rateLimit.AssetLimitAmount(amount.Denom); limit.IsPositive() {
+		used := k.GetOutflow(ctx, amount.Denom)
+		if used.Add(amount.Amount).GT(limit) {
+			return errorsmod.Wrapf(types.ErrWithdrawRateLimitExceeded, "asset outflow %s%s, limit %s%s", used, amount.Denom, limit, amount.Denom)
+		}
+	}
+
+	if limit := rateLimit.GlobalLimitAmount(); limit.IsPositive() {
+		used := k.GetGlobalOutflow(ctx)
+		if used.AddRaw(btcAmount).GT(limit) {
+			return errorsmod.Wrapf(types.ErrWithdrawRateLimitExceeded, "global outflow %s, limit %s", used, limit)
+		}
+	}
+
+	return nil
+}
+
+// RecordOutflow records the given outflow in the current block
+// The circuit breaker is tripped if the outflow in the current window reaches the high-water mark
+func (k Keeper) RecordOutflow(ctx sdk.Context, amount sdk.Coin, btcAmount int64) {
+	store := ctx.KVStore(k.storeKey)
+
+	height := ctx.BlockHeight()
+
+	if amount.IsPositive() {
+		outflow := k.getOutflowAtHeight(ctx, height, amount.Denom).Add(amount.Amount)
+		store.Set(types.OutflowKey(height, amount.Denom), k.mustMarshalInt(outflow))
+	}
+
+	if btcAmount > 0 {
+		outflow := k.getGlobalOutflowAtHeight(ctx, height).AddRaw(btcAmount)
+		store.Set(types.GlobalOutflowKey(height), k.mustMarshalInt(outflow))
+	}
+
+	rateLimit := k.GetParams(ctx).WithdrawRateLimit
+	if !rateLimit.IsEnabled() || rateLimit.BreakerThresholdBps == 0 || k.IsCircuitBreakerTripped(ctx) {
+		return
+	}
+
+	if mark := rateLimit.HighWaterMark(rateLimit.AssetLimitAmount(amount.Denom)); mark.IsPositive() && k.GetOutflow(ctx, amount.Denom).GTE(mark) {
+		k.TripCircuitBreaker(ctx, amount.Denom)
+		return
+	}
+
+	if mark := rateLimit.HighWaterMark(rateLimit.GlobalLimitAmount()); mark.IsPositive() && k.GetGlobalOutflow(ctx).GTE(mark) {
+		k.TripCircuitBreaker(ctx, "")
+	}
+}
+
+// GetOutflow gets the outflow of the given asset in the current window
+func (k Keeper) GetOutflow(ctx sdk.Context, denom string) sdkmath.Int {
+	outflow := sdkmath.ZeroInt()
+
+	k.iterateOutflowsInWindow(ctx, types.OutflowKeyPrefix, func(key []byte, amount sdkmath.Int) {
+		if string(key[8:]) == denom {
+			outflow = outflow.Add(amount)
+		}
+	})
+
+	return outflow
+}
+
+// GetGlobalOutflow gets the global btc outflow in the current window
+func (k Keeper) GetGlobalOutflow(ctx sdk.Context) sdkmath.Int {
+	outflow := sdkmath.ZeroInt()
+
+	k.iterateOutflowsInWindow(ctx, types.GlobalOutflowKeyPrefix, func(_ []byte, amount sdkmath.Int) {
+		outflow = outflow.Add(amount)
+	})
+
+	return outflow
+}
+
+// GetOutflowUsages gets the outflow usages of the limited assets in the current window
+// The given asset is included even if not limited
+func (k Keeper) GetOutflowUsages(ctx sdk.Context, denom string) []*types.OutflowUsage {
+	rateLimit := k.GetParams(ctx).WithdrawRateLimit
+
+	denoms := []string{}
+	for _, limit := range rateLimit.AssetLimits {
+		if len(denom) == 0 || limit.Denom == denom {
+			denoms = append(denoms, limit.Denom)
+		}
+	}
+
+	if len(denom) != 0 && len(denoms) == 0 {
+		denoms = append(denoms, denom)
+	}
+
+	usages := make([]*types.OutflowUsage, 0, len(denoms))
+
+	for _, d := range denoms {
+		usages = append(usages, &types.OutflowUsage{
+			Denom: d,
+			Used:  k.GetOutflow(ctx, d).String(),
+			Limit: rateLimit.AssetLimitAmount(d).String(),
+		})
+	}
+
+	return usages
+}
+
+// GetGlobalOutflowUsage gets the global btc outflow usage in the current window
+func (k Keeper) GetGlobalOutflowUsage(ctx sdk.Context) *types.OutflowUsage {
+	return &types.OutflowUsage{
+		Used:  k.GetGlobalOutflow(ctx).String(),
+		Limit: k.GetParams(ctx).WithdrawRateLimit.GlobalLimitAmount().String(),
+	}
+}
+
+// PruneOutflows removes the outflow records out of the current window
+func (k Keeper) PruneOutflows(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+
+	start := sdk.Uint64ToBigEndian(uint64(k.getWindowStartHeight(ctx)))
+
+	for _, keyPrefix := range [][]byte{types.OutflowKeyPrefix, types.GlobalOutflowKeyPrefix} {
+		prefixStore := prefix.NewStore(store, keyPrefix)
+
+		iterator := prefixStore.Iterator(nil, start)
+
+		keys := [][]byte{}
+		for ; iterator.Valid(); iterator.Next() {
+			keys = append(keys, iterator.Key())
+		}
+
+		iterator.Close()
+
+		for _, key := range keys {
+			prefixStore.Delete(key)
+		}
+	}
+}
+
+// GetCircuitBreaker gets the withdrawal circuit breaker
+func (k Keeper) GetCircuitBreaker(ctx sdk.Context) *types.CircuitBreaker {
+	store := ctx.KVStore(k.storeKey)
+
+	var breaker types.CircuitBreaker
+
+	bz := store.Get(types.CircuitBreakerKey)
+	if bz != nil {
+		k.cdc.MustUnmarshal(bz, &breaker)
+	}
+
+	return &breaker
+}
+
+// SetCircuitBreaker sets the withdrawal circuit breaker
+func (k Keeper) SetCircuitBreaker(ctx sdk.Context, breaker *types.CircuitBreaker) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Set(types.CircuitBreakerKey, k.cdc.MustMarshal(breaker))
+}
+
+// IsCircuitBreakerTripped returns true if the withdrawal circuit breaker is tripped, false otherwise
+func (k Keeper) IsCircuitBreakerTripped(ctx sdk.Context) bool {
+	return k.GetCircuitBreaker(ctx).Tripped
+}
+
+// TripCircuitBreaker trips the withdrawal circuit breaker due to the outflow of the given asset
+// Empty denom indicates the global outflow
+func (k Keeper) TripCircuitBreaker(ctx sdk.Context, denom string) {
+	k.SetCircuitBreaker(ctx, &types.CircuitBreaker{
+		Tripped: true,
+		Height:  ctx.BlockHeight(),
+		Denom:   denom,
+	})
+
+	k.EmitEvent(ctx, k.authority,
+		sdk.NewAttribute("circuit_breaker", "tripped"),
+		sdk.NewAttribute("denom", denom),
+		sdk.NewAttribute("height", fmt.Sprintf("%d", ctx.BlockHeight())),
+	)
+}
+
+// ResetCircuitBreaker resets the tripped withdrawal circuit breaker
+func (k Keeper) ResetCircuitBreaker(ctx sdk.Context) error {
+	if !k.IsCircuitBreakerTripped(ctx) {
+		return types.ErrCircuitBreakerNotTripped
+	}
+
+	k.SetCircuitBreaker(ctx, &types.CircuitBreaker{})
+
+	return nil
+}
+
+// handleOutflow checks and records the given outflow
+func (k Keeper) handleOutflow(ctx sdk.Context, amount sdk.Coin, btcAmount int64) error {
+	if err := k.CheckOutflow(ctx, amount, btcAmount); err != nil {
+		return err
+	}
+
+	k.RecordOutflow(ctx, amount, btcAmount)
+
+	return nil
+}
+
+// getWindowStartHeight gets the start block height of the current window
+func (k Keeper) getWindowStartHeight(ctx sdk.Context) int64 {
+	start := ctx.BlockHeight() - k.GetParams(ctx).WithdrawRateLimit.Window + 1
+	if start < 0 {
+		return 0
+	}
+
+	return start
+}
+
+// iterateOutflowsInWindow iterates through the outflow records with the given key prefix in the current window
+func (k Keeper) iterateOutflowsInWindow(ctx sdk.Context, keyPrefix []byte, cb func(key []byte, amount sdkmath.Int)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := prefix.NewStore(store, keyPrefix).Iterator(sdk.Uint64ToBigEndian(uint64(k.getWindowStartHeight(ctx))), nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		cb(iterator.Key(), k.mustUnmarshalInt(iterator.Value()))
+	}
+}
+
+// getOutflowAtHeight gets the outflow of the given asset at the given block height
+func (k Keeper) getOutflowAtHeight(ctx sdk.Context, height int64, denom string) sdkmath.Int {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.OutflowKey(height, denom))
+	if bz == nil {
+		return sdkmath.ZeroInt()
+	}
+
+	return k.mustUnmarshalInt(bz)
+}
+
+// getGlobalOutflowAtHeight gets the global btc outflow at the given block height
+func (k Keeper) getGlobalOutflowAtHeight(ctx sdk.Context, height int64) sdkmath.Int {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GlobalOutflowKey(height))
+	if bz == nil {
+		return sdkmath.ZeroInt()
+	}
+
+	return k.mustUnmarshalInt(bz)
+}
+
+func (k Keeper) mustMarshalInt(amount sdkmath.Int) []byte {
+	bz, err := amount.Marshal()
+	if err != nil {
+		panic(err)
+	}
+
+	return bz
+}
+
+func (k Keeper) mustUnmarshalInt(bz []byte) sdkmath.Int {
+	var amount sdkmath.Int
+	if err := amount.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+
+	return amount
+}
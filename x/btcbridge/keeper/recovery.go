@@ -0,0 +1,132 @@
+package keeper
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sideprotocol/side/x/btcbridge/types"
+)
+
+// RecoverVault transfers the matured utxos of the given vault to the recipient through the timelocked recovery script path
+// The internal key of the vault is used if the given internal key is empty
+func (k Keeper) RecoverVault(ctx sdk.Context, vaultAddress string, recipient string, internalKeyHex string, maxUtxoNum uint32) (*types.SigningRequest, error) {
+	if err := k.CheckNotPaused(ctx, types.PauseScope_PAUSE_SCOPE_SIGNING, ""); err != nil {
+		return nil, err
+	}
+
+	vault := types.SelectVaultByAddress(k.GetParams(ctx).Vaults, vaultAddress)
+	if vault == nil {
+		return nil, types.ErrVaultDoesNotExist
+	}
+
+	if vault.TaprootTree == nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidVaultRecovery, "vault does not commit to the recovery script")
+	}
+
+	if len(internalKeyHex) == 0 {
+		internalKeyHex = vault.PubKey
+	}
+
+	if len(internalKeyHex) == 0 {
+		return nil, errorsmod.Wrap(types.ErrInvalidVaultRecovery, "internal key required")
+	}
+
+	internalKey, err := (&types.Vault{PubKey: internalKeyHex}).InternalKey()
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidVaultRecovery, "invalid internal key")
+	}
+
+	address, err := vault.TaprootTree.Address(internalKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if address != vault.Address {
+		return nil, errorsmod.Wrap(types.ErrInvalidVaultRecovery, "internal key does not match the vault")
+	}
+
+	script, err := vault.TaprootTree.RecoveryScriptBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	recoveryScript, err := types.ParseRecoveryScript(script)
+	if err != nil {
+		return nil, err
+	}
+
+	if maxUtxoNum == 0 {
+		maxUtxoNum = uint32(k.GetMaxUtxoNum(ctx))
+	}
+
+	utxos := k.getMaturedRecoveryUTXOs(ctx, vault.Address, recoveryScript.Timelock, maxUtxoNum)
+	if len(utxos) == 0 {
+		return nil, types.ErrInsufficientUTXOs
+	}
+
+	feeRate := k.GetFeeRate(ctx)
+	if err := k.CheckFeeRate(ctx, feeRate); err != nil {
+		return nil, err
+	}
+
+	p, err := types.BuildVaultRecoveryPsbt(utxos, vault.TaprootTree, internalKey, recipient, feeRate.Value)
+	if err != nil {
+		return nil, err
+	}
+
+	psbtB64, err := p.B64Encode()
+	if err != nil {
+		return nil, types.ErrFailToSerializePsbt
+	}
+
+	// spend the involved utxos
+	_ = k.SpendUTXOs(ctx, utxos)
+
+	// set signing request
+	signingReq := &types.SigningRequest{
+		Address:      k.authority,
+		Sequence:     k.IncrementSigningRequestSequence(ctx),
+		Type:         vault.AssetType,
+		Txid:         p.UnsignedTx.TxHash().String(),
+		Psbt:         psbtB64,
+		CreationTime: ctx.BlockTime(),
+		Status:       types.SigningStatus_SIGNING_STATUS_PENDING,
+	}
+	k.SetSigningRequest(ctx, signingReq)
+
+	// Emit events
+	k.EmitEvent(ctx, k.authority,
+		sdk.NewAttribute("vault", vault.Address),
+		sdk.NewAttribute("recipient", recipient),
+		sdk.NewAttribute("internal_key", hex.EncodeToString(p.Inputs[0].TaprootInternalKey)),
+		sdk.NewAttribute("sequence", fmt.Sprintf("%d", signingReq.Sequence)),
+		sdk.NewAttribute("txid", signingReq.Txid),
+	)
+
+	return signingReq, nil
+}
+
+// getMaturedRecoveryUTXOs gets the unlocked utxos of the given vault which have been confirmed for the relative timelock
+func (k Keeper) getMaturedRecoveryUTXOs(ctx sdk.Context, vault string, timelock uint32, maxNum uint32) []*types.UTXO {
+	utxos := []*types.UTXO{}
+
+	bestBlockHeader := k.GetBestBlockHeader(ctx)
+	if bestBlockHeader == nil {
+		return utxos
+	}
+
+	k.IterateUnlockedUTXOsByAddr(ctx, vault, func(addr string, utxo *types.UTXO) (stop bool) {
+		// the utxo is spendable in the block at the height of utxo height + timelock
+		// the utxo without the confirmation height is not matured
+		if utxo.Height > 0 && utxo.Height+uint64(timelock) <= bestBlockHeader.Height+1 {
+			utxos = append(utxos, utxo)
+		}
+
+		return maxNum != 0 && len(utxos) >= int(maxNum)
+	})
+
+	return utxos
+}
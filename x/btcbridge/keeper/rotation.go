@@ -0,0 +1,234 @@
+package keeper
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"sort"
+	"strings"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sideprotocol/side/x/btcbridge/types"
+)
+
+const (
+	// reasons of the automatic vault rotation
+	RotationReasonParticipantLeft = "participant_left"
+	RotationReasonStakeShift      = "stake_shift"
+)
+
+// MarkParticipantUpdatePending marks that the TSS participant update is to be checked
+func (k Keeper) MarkParticipantUpdatePending(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Set(types.ParticipantUpdatePendingKey, []byte{1})
+}
+
+// IsParticipantUpdatePending returns true if the TSS participant update is to be checked, false otherwise
+func (k Keeper) IsParticipantUpdatePending(ctx sdk.Context) bool {
+	store := ctx.KVStore(k.storeKey)
+
+	return store.Has(types.ParticipantUpdatePendingKey)
+}
+
+// MarkParticipantUpdatePendingIfChanged marks that the TSS participant update is to be checked
+// only when the top bonded validators or the stake shift cross the rotation condition since the last observation
+// This avoids the repeated automatic DKG on every ordinary delegation, e.g. after the retry budget runs out
+func (k Keeper) MarkParticipantUpdatePendingIfChanged(ctx sdk.Context) {
+	params := k.GetParams(ctx).TssParams
+	if !params.AutoRotationEnabled {
+		return
+	}
+
+	currentReq := k.GetLatestCompletedDKGRequest(ctx)
+	if currentReq == nil {
+		return
+	}
+
+	participants, reason, err := k.getParticipantUpdate(ctx, &params, currentReq)
+	if err != nil {
+		k.Logger(ctx).Error("failed to check participant update", "err", err)
+		return
+	}
+
+	if k.setParticipantUpdateState(ctx, participants, reason) && len(reason) > 0 {
+		k.MarkParticipantUpdatePending(ctx)
+	}
+}
+
+// setParticipantUpdateState sets the observed state of the TSS participant update
+// Returns true if the state changes, false otherwise
+func (k Keeper) setParticipantUpdateState(ctx sdk.Context, participants []*types.DKGParticipant, reason string) bool {
+	store := ctx.KVStore(k.storeKey)
+
+	pubKeys := []string{reason}
+	for _, p := range participants {
+		pubKeys = append(pubKeys, p.ConsensusPubkey)
+	}
+
+	sort.Strings(pubKeys[1:])
+
+	state := []byte(strings.Join(pubKeys, ","))
+	if bytes.Equal(store.Get(types.ParticipantUpdateStateKey), state) {
+		return false
+	}
+
+	store.Set(types.ParticipantUpdateStateKey, state)
+
+	return true
+}
+
+// HandleParticipantUpdate initiates the new DKG with the current top bonded validators automatically
+// when any TSS participant leaves the bonded set or the stake shift reaches the threshold
+func (k Keeper) HandleParticipantUpdate(ctx sdk.Context) {
+	params := k.GetParams(ctx).TssParams
+	if !params.AutoRotationEnabled || !k.IsParticipantUpdatePending(ctx) {
+		return
+	}
+
+	// postpone the check until no DKG is in progress
+	if len(k.GetPendingDKGRequests(ctx)) > 0 {
+		return
+	}
+
+	ctx.KVStore(k.storeKey).Delete(types.ParticipantUpdatePendingKey)
+
+	currentReq := k.GetLatestCompletedDKGRequest(ctx)
+	if currentReq == nil {
+		return
+	}
+
+	participants, reason, err := k.getParticipantUpdate(ctx, &params, currentReq)
+	if err != nil {
+		k.Logger(ctx).Error("failed to get bonded validators", "err", err)
+		return
+	}
+
+	k.setParticipantUpdateState(ctx, participants, reason)
+
+	if len(reason) == 0 {
+		return
+	}
+
+	// keep the threshold ratio of the current participants
+	threshold := (currentReq.Threshold*uint32(len(participants)) + uint32(len(currentReq.Participants)) - 1) / uint32(len(currentReq.Participants))
+	if threshold == 0 {
+		threshold = 1
+	}
+
+	targetUtxoNum := currentReq.TargetUtxoNum
+	if targetUtxoNum == 0 {
+		targetUtxoNum = uint32(k.GetMaxUtxoNum(ctx))
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+
+	req, err := k.InitiateDKG(cacheCtx, participants, threshold, types.SupportedAssetTypes(), true, targetUtxoNum)
+	if err != nil {
+		k.Logger(ctx).Info("failed to initiate dkg for participant update", "err", err)
+		return
+	}
+
+	write()
+
+	k.EmitEvent(ctx, k.authority,
+		sdk.NewAttribute("dkg_id", fmt.Sprintf("%d", req.Id)),
+		sdk.NewAttribute("rotation_reason", reason),
+		sdk.NewAttribute("participants", fmt.Sprintf("%d", len(participants))),
+		sdk.NewAttribute("threshold", fmt.Sprintf("%d", threshold)),
+	)
+}
+
+// getParticipantUpdate gets the current top bonded validators as the TSS participants and the reason to rotate if any
+// The reason is empty if no rotation is required
+func (k Keeper) getParticipantUpdate(ctx sdk.Context, params *types.TSSParams, currentReq *types.DKGRequest) ([]*types.DKGParticipant, string, error) {
+	maxParticipants := params.MaxParticipants
+	if maxParticipants == 0 {
+		maxParticipants = uint32(len(currentReq.Participants))
+	}
+
+	validators, err := k.stakingKeeper.GetBondedValidatorsByPower(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if len(validators) > int(maxParticipants) {
+		validators = validators[:maxParticipants]
+	}
+
+	participants := make([]*types.DKGParticipant, 0, len(validators))
+	currentParticipants := make(map[string]bool)
+	for _, p := range currentReq.Participants {
+		currentParticipants[p.ConsensusPubkey] = true
+	}
+
+	totalPower := sdkmath.ZeroInt()
+	shiftedPower := sdkmath.ZeroInt()
+
+	for _, v := range validators {
+		pubKey, err := v.ConsPubKey()
+		if err != nil {
+			continue
+		}
+
+		consPubKey := base64.StdEncoding.EncodeToString(pubKey.Bytes())
+
+		participants = append(participants, &types.DKGParticipant{
+			Moniker:         v.GetMoniker(),
+			OperatorAddress: v.OperatorAddress,
+			ConsensusPubkey: consPubKey,
+		})
+
+		totalPower = totalPower.Add(v.GetTokens())
+		if !currentParticipants[consPubKey] {
+			shiftedPower = shiftedPower.Add(v.GetTokens())
+		}
+	}
+
+	if len(participants) == 0 {
+		return nil, "", nil
+	}
+
+	switch {
+	case len(types.ExcludeDKGParticipants(currentReq.Participants, participants)) > 0:
+		return participants, RotationReasonParticipantLeft, nil
+
+	case params.StakeShiftThresholdBps > 0 && totalPower.IsPositive() &&
+		shiftedPower.MulRaw(types.MaxFeeBps).GTE(totalPower.MulRaw(int64(params.StakeShiftThresholdBps))):
+		return participants, RotationReasonStakeShift, nil
+
+	default:
+		return participants, "", nil
+	}
+}
+
+// GetLatestCompletedDKGRequest gets the latest completed DKG request, i.e. the current TSS participants
+func (k Keeper) GetLatestCompletedDKGRequest(ctx sdk.Context) *types.DKGRequest {
+	var latestReq *types.DKGRequest
+
+	for _, req := range k.GetDKGRequests(ctx, types.DKGRequestStatus_DKG_REQUEST_STATUS_COMPLETED) {
+		if latestReq == nil || req.Id > latestReq.Id {
+			latestReq = req
+		}
+	}
+
+	return latestReq
+}
+
+// ScheduleVaultsRetirement deprecates the vaults before the given version and schedules the retirement after the transition period
+func (k Keeper) ScheduleVaultsRetirement(ctx sdk.Context, version uint64) {
+	params := k.GetParams(ctx)
+
+	retirementTime := ctx.BlockTime().Add(params.TssParams.ParticipantUpdateTransitionPeriod)
+
+	for _, v := range params.Vaults {
+		if v.Version < version && v.RetirementTime == nil {
+			v.RetirementTime = &retirementTime
+			v.State = types.VaultState_VAULT_STATE_DEPRECATED
+		}
+	}
+
+	k.SetParams(ctx, params)
+}
@@ -0,0 +1,103 @@
+package keeper
+
+import (
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sideprotocol/side/x/btcbridge/types"
+)
+
+// RegisterRunes registers the metadata of the given runes
+// The registered rune can only be updated by the authority
+func (k Keeper) RegisterRunes(ctx sdk.Context, sender string, runes []*types.RuneMetadata) error {
+	isAuthority := sender == k.authority
+
+	if !isAuthority && !k.IsTrustedNonBtcRelayer(ctx, sender) {
+		return types.ErrUntrustedNonBtcRelayer
+	}
+
+	for _, metadata := range runes {
+		if !isAuthority && k.HasRuneMetadata(ctx, metadata.Id) {
+			return types.ErrRuneAlreadyRegistered
+		}
+
+		if err := k.SetRuneMetadata(ctx, metadata); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// SetRuneMetadata sets the given rune metadata along with the bank denom metadata of the rune voucher token
+func (k Keeper) SetRuneMetadata(ctx sdk.Context, metadata *types.RuneMetadata) error {
+	bankMetadata := metadata.BankMetadata()
+	if err := bankMetadata.Validate(); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+
+	bz := k.cdc.MustMarshal(metadata)
+	store.Set(types.RuneMetadataKey(metadata.Id), bz)
+
+	k.bankKeeper.SetDenomMetaData(ctx, bankMetadata)
+
+	return nil
+}
+
+// HasRuneMetadata returns true if the given rune is registered, false otherwise
+func (k Keeper) HasRuneMetadata(ctx sdk.Context, id string) bool {
+	store := ctx.KVStore(k.storeKey)
+
+	return store.Has(types.RuneMetadataKey(id))
+}
+
+// GetRuneMetadata gets the metadata of the given rune
+func (k Keeper) GetRuneMetadata(ctx sdk.Context, id string) *types.RuneMetadata {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.RuneMetadataKey(id))
+	if bz == nil {
+		return nil
+	}
+
+	var metadata types.RuneMetadata
+	k.cdc.MustUnmarshal(bz, &metadata)
+
+	return &metadata
+}
+
+// GetAllRuneMetadata gets the metadata of all registered runes
+func (k Keeper) GetAllRuneMetadata(ctx sdk.Context) []*types.RuneMetadata {
+	runes := make([]*types.RuneMetadata, 0)
+
+	k.IterateRuneMetadata(ctx, func(metadata *types.RuneMetadata) (stop bool) {
+		runes = append(runes, metadata)
+		return false
+	})
+
+	return runes
+}
+
+// IterateRuneMetadata iterates through the metadata of all registered runes
+func (k Keeper) IterateRuneMetadata(ctx sdk.Context, cb func(metadata *types.RuneMetadata) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := storetypes.KVStorePrefixIterator(store, types.RuneMetadataKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var metadata types.RuneMetadata
+		k.cdc.MustUnmarshal(iterator.Value(), &metadata)
+
+		if cb(&metadata) {
+			break
+		}
+	}
+}
+
+// SetBtcVoucherMetadata sets the bank denom metadata of the btc voucher token
+func (k Keeper) SetBtcVoucherMetadata(ctx sdk.Context) {
+	k.bankKeeper.SetDenomMetaData(ctx, types.BtcVoucherMetadata(k.BtcDenom(ctx)))
+}
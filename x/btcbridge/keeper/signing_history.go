@@ -0,0 +1,161 @@
+package keeper
+
+import (
+	"github.com/cosmos/gogoproto/proto"
+
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sideprotocol/side/x/btcbridge/types"
+)
+
+// GetSigningRequestHistory gets the status history of the given signing request in order
+func (k Keeper) GetSigningRequestHistory(ctx sdk.Context, sequence uint64) []*types.SigningRequestHistoryEntry {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := storetypes.KVStorePrefixIterator(store, append(types.BtcSigningRequestHistoryKeyPrefix, sdk.Uint64ToBigEndian(sequence)...))
+	defer iterator.Close()
+
+	history := make([]*types.SigningRequestHistoryEntry, 0)
+
+	for ; iterator.Valid(); iterator.Next() {
+		var entry types.SigningRequestHistoryEntry
+		k.cdc.MustUnmarshal(iterator.Value(), &entry)
+
+		history = append(history, &entry)
+	}
+
+	return history
+}
+
+// SetSigningRequestHistory sets the status history of the given signing request
+func (k Keeper) SetSigningRequestHistory(ctx sdk.Context, sequence uint64, history []*types.SigningRequestHistoryEntry) {
+	store := ctx.KVStore(k.storeKey)
+
+	for i, entry := range history {
+		bz := k.cdc.MustMarshal(entry)
+		store.Set(types.BtcSigningRequestHistoryKey(sequence, uint64(i)), bz)
+	}
+
+	store.Set(types.BtcSigningRequestHistoryIndexKey(sequence), sdk.Uint64ToBigEndian(uint64(len(history))))
+}
+
+// GetAllSigningRequestHistories gets the status histories of all signing requests
+func (k Keeper) GetAllSigningRequestHistories(ctx sdk.Context) []*types.SigningRequestHistory {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := storetypes.KVStorePrefixIterator(store, types.BtcSigningRequestHistoryIndexPrefix)
+	defer iterator.Close()
+
+	histories := make([]*types.SigningRequestHistory, 0)
+
+	for ; iterator.Valid(); iterator.Next() {
+		sequence := sdk.BigEndianToUint64(iterator.Key()[len(types.BtcSigningRequestHistoryIndexPrefix):])
+
+		histories = append(histories, &types.SigningRequestHistory{
+			Sequence: sequence,
+			Entries:  k.GetSigningRequestHistory(ctx, sequence),
+		})
+	}
+
+	return histories
+}
+
+// getNextSigningRequestHistoryIndex gets the next history index of the given signing request and increases it
+func (k Keeper) getNextSigningRequestHistoryIndex(ctx sdk.Context, sequence uint64) uint64 {
+	store := ctx.KVStore(k.storeKey)
+
+	index := sdk.BigEndianToUint64(store.Get(types.BtcSigningRequestHistoryIndexKey(sequence)))
+	store.Set(types.BtcSigningRequestHistoryIndexKey(sequence), sdk.Uint64ToBigEndian(index+1))
+
+	return index
+}
+
+// RecordSigningRequestTransition appends the given transition to the history of the signing request and emits the corresponding event
+// The history is append-only
+func (k Keeper) RecordSigningRequestTransition(ctx sdk.Context, signingRequest *types.SigningRequest, transition types.SigningTransition, actor string) {
+	store := ctx.KVStore(k.storeKey)
+
+	entry := &types.SigningRequestHistoryEntry{
+		Transition: transition,
+		Actor:      actor,
+		Height:     ctx.BlockHeight(),
+		Time:       ctx.BlockTime(),
+	}
+
+	index := k.getNextSigningRequestHistoryIndex(ctx, signingRequest.Sequence)
+
+	bz := k.cdc.MustMarshal(entry)
+	store.Set(types.BtcSigningRequestHistoryKey(signingRequest.Sequence, index), bz)
+
+	if event := newSigningTransitionEvent(signingRequest, transition, actor); event != nil {
+		_ = ctx.EventManager().EmitTypedEvent(event)
+	}
+}
+
+// getSigningTransitionByStatus gets the transition to the given signing status
+func getSigningTransitionByStatus(status types.SigningStatus) types.SigningTransition {
+	switch status {
+	case types.SigningStatus_SIGNING_STATUS_BROADCASTED:
+		return types.SigningTransition_SIGNING_TRANSITION_BROADCASTED
+
+	case types.SigningStatus_SIGNING_STATUS_CONFIRMED:
+		return types.SigningTransition_SIGNING_TRANSITION_CONFIRMED
+
+	case types.SigningStatus_SIGNING_STATUS_FAILED:
+		return types.SigningTransition_SIGNING_TRANSITION_FAILED
+
+	case types.SigningStatus_SIGNING_STATUS_REPLACED:
+		return types.SigningTransition_SIGNING_TRANSITION_REPLACED
+
+	default:
+		return types.SigningTransition_SIGNING_TRANSITION_UNSPECIFIED
+	}
+}
+
+// newSigningTransitionEvent builds the typed event of the given transition
+func newSigningTransitionEvent(signingRequest *types.SigningRequest, transition types.SigningTransition, actor string) proto.Message {
+	switch transition {
+	case types.SigningTransition_SIGNING_TRANSITION_CREATED:
+		return &types.EventSigningRequestCreated{
+			Sequence:  signingRequest.Sequence,
+			Txid:      signingRequest.Txid,
+			AssetType: signingRequest.Type,
+			Address:   signingRequest.Address,
+		}
+
+	case types.SigningTransition_SIGNING_TRANSITION_SIGNATURES_SUBMITTED:
+		return &types.EventSigningRequestSignaturesSubmitted{
+			Sequence: signingRequest.Sequence,
+			Txid:     signingRequest.Txid,
+			Signer:   actor,
+		}
+
+	case types.SigningTransition_SIGNING_TRANSITION_BROADCASTED:
+		return &types.EventSigningRequestBroadcasted{
+			Sequence: signingRequest.Sequence,
+			Txid:     signingRequest.Txid,
+		}
+
+	case types.SigningTransition_SIGNING_TRANSITION_CONFIRMED:
+		return &types.EventSigningRequestConfirmed{
+			Sequence: signingRequest.Sequence,
+			Txid:     signingRequest.Txid,
+		}
+
+	case types.SigningTransition_SIGNING_TRANSITION_FAILED:
+		return &types.EventSigningRequestFailed{
+			Sequence: signingRequest.Sequence,
+			Txid:     signingRequest.Txid,
+		}
+
+	case types.SigningTransition_SIGNING_TRANSITION_REPLACED:
+		return &types.EventSigningRequestReplaced{
+			Sequence: signingRequest.Sequence,
+			Txid:     signingRequest.Txid,
+		}
+
+	default:
+		return nil
+	}
+}
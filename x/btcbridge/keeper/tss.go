@@ -3,6 +3,9 @@ package keeper
 import (
 	"bytes"
 	"encoding/base64"
+	"fmt"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/btcsuite/btcd/btcutil/psbt"
@@ -203,12 +206,152 @@ func (k Keeper) InitiateDKG(ctx sdk.Context, participants []*types.DKGParticipan
 		Status:         types.DKGRequestStatus_DKG_REQUEST_STATUS_PENDING,
 	}
 
+	// commit to the recovery script path if enabled
+	if policy := k.GetParams(ctx).VaultRecoveryPolicy; policy.IsEnabled() {
+		taprootTree, err := policy.TaprootTree()
+		if err != nil {
+			return nil, err
+		}
+
+		req.TaprootTree = taprootTree
+	}
+
 	k.SetDKGRequest(ctx, req)
 	k.SetDKGRequestID(ctx, req.Id)
 
 	return req, nil
 }
 
+// HandlePendingDKGRequest handles the given pending DKG request
+// The DKG request completes as soon as the threshold-or-more completion requests agree on the vaults.
+// Otherwise the DKG request fails when all participants submit the completion requests, or times out when the request expires.
+// The timed out DKG request is retried without the non-responders within the retry budget.
+func (k Keeper) HandlePendingDKGRequest(ctx sdk.Context, req *types.DKGRequest) {
+	completionRequests := k.GetDKGCompletionRequests(ctx, req.Id)
+
+	vaults, agreeing := types.SelectAgreedDKGCompletionVaults(completionRequests)
+	agreed := len(agreeing) > 0 && uint32(len(agreeing)) >= req.Threshold
+
+	expired := !ctx.BlockTime().Before(*req.Expiration)
+	if !agreed && !expired && len(completionRequests) != len(req.Participants) {
+		// agreement can still be reached
+		return
+	}
+
+	// the participants not responding are recorded only when the request expires
+	// i.e. the participants yet to respond on the early completion are not penalized
+	if expired {
+		req.NonResponders = types.GetDKGNonResponders(req.Participants, completionRequests)
+		for _, p := range req.NonResponders {
+			k.RecordMissedDKG(ctx, p.OperatorAddress)
+		}
+	}
+
+	if agreed {
+		// update vaults
+		k.UpdateVaults(ctx, vaults, agreeing[0].PubKeys, req.VaultTypes, req.TaprootTree)
+		k.SetFrostVaultKeys(ctx, req, agreeing[0])
+
+		// retire the previous vaults after the transition period once the assets are to be transferred
+		if req.EnableTransfer {
+			k.ScheduleVaultsRetirement(ctx, k.GetLatestVaultVersion(ctx))
+		}
+
+		// update status
+		req.Status = types.DKGRequestStatus_DKG_REQUEST_STATUS_COMPLETED
+		k.SetDKGRequest(ctx, req)
+
+		k.emitDKGEvent(ctx, req, "completed")
+
+		_ = ctx.EventManager().EmitTypedEvent(&types.EventDKGCompleted{
+			Id:            req.Id,
+			Vaults:        vaults,
+			NonResponders: getNonResponderConsAddrs(req),
+			RetryCount:    req.RetryCount,
+		})
+
+		return
+	}
+
+	if !expired {
+		// all participants submitted but not enough agreed
+		req.Status = types.DKGRequestStatus_DKG_REQUEST_STATUS_FAILED
+		k.SetDKGRequest(ctx, req)
+
+		k.emitDKGEvent(ctx, req, "failed")
+
+		return
+	}
+
+	req.Status = types.DKGRequestStatus_DKG_REQUEST_STATUS_TIMEDOUT
+	k.SetDKGRequest(ctx, req)
+
+	k.emitDKGEvent(ctx, req, "timedout")
+
+	cacheCtx, write := ctx.CacheContext()
+
+	retryReq, err := k.RetryDKG(cacheCtx, req)
+	if err != nil {
+		k.Logger(ctx).Info("failed to retry dkg", "id", req.Id, "err", err)
+		k.emitDKGEvent(ctx, req, "retry_skipped", sdk.NewAttribute("reason", err.Error()))
+
+		return
+	}
+
+	write()
+
+	k.emitDKGEvent(ctx, req, "retried", sdk.NewAttribute("retry_id", fmt.Sprintf("%d", retryReq.Id)))
+}
+
+// RetryDKG initiates the follow-up DKG request of the given timed out DKG request without the non-responders
+func (k Keeper) RetryDKG(ctx sdk.Context, req *types.DKGRequest) (*types.DKGRequest, error) {
+	if req.Status != types.DKGRequestStatus_DKG_REQUEST_STATUS_TIMEDOUT {
+		return nil, errorsmod.Wrap(types.ErrDKGRetryNotAllowed, "dkg request not timed out")
+	}
+
+	if req.RetryCount >= k.GetParams(ctx).TssParams.DkgMaxRetries {
+		return nil, errorsmod.Wrap(types.ErrDKGRetryNotAllowed, "retry budget exhausted")
+	}
+
+	participants := types.ExcludeDKGParticipants(req.Participants, req.NonResponders)
+	if len(participants) == 0 || uint32(len(participants)) < req.Threshold {
+		return nil, errorsmod.Wrap(types.ErrDKGRetryNotAllowed, "insufficient participants")
+	}
+
+	retryReq, err := k.InitiateDKG(ctx, participants, req.Threshold, req.VaultTypes, req.EnableTransfer, req.TargetUtxoNum)
+	if err != nil {
+		return nil, err
+	}
+
+	retryReq.RetryOf = req.Id
+	retryReq.RetryCount = req.RetryCount + 1
+	k.SetDKGRequest(ctx, retryReq)
+
+	return retryReq, nil
+}
+
+// emitDKGEvent emits the event of the given DKG request outcome
+func (k Keeper) emitDKGEvent(ctx sdk.Context, req *types.DKGRequest, outcome string, attrs ...sdk.Attribute) {
+	k.EmitEvent(ctx, k.authority,
+		append([]sdk.Attribute{
+			sdk.NewAttribute("dkg_id", fmt.Sprintf("%d", req.Id)),
+			sdk.NewAttribute("outcome", outcome),
+			sdk.NewAttribute("non_responders", strings.Join(getNonResponderConsAddrs(req), ",")),
+			sdk.NewAttribute("retry_count", fmt.Sprintf("%d", req.RetryCount)),
+		}, attrs...)...,
+	)
+}
+
+// getNonResponderConsAddrs gets the consensus addresses of the non-responders of the given DKG request
+func getNonResponderConsAddrs(req *types.DKGRequest) []string {
+	nonResponders := make([]string, 0, len(req.NonResponders))
+	for _, p := range req.NonResponders {
+		nonResponders = append(nonResponders, types.MustGetConsensusAddr(p.ConsensusPubkey))
+	}
+
+	return nonResponders
+}
+
 // CompleteDKG completes the DKG request by the DKG participant
 // The DKG request will be completed when all participants submit the valid completion request before timeout
 func (k Keeper) CompleteDKG(ctx sdk.Context, req *types.DKGCompletionRequest) error {
@@ -237,6 +380,14 @@ func (k Keeper) CompleteDKG(ctx sdk.Context, req *types.DKGCompletionRequest) er
 		return err
 	}
 
+	if err := types.VerifyDKGVaultKeys(req, dkgReq.TaprootTree); err != nil {
+		return err
+	}
+
+	if err := types.VerifyDKGVerificationShares(req, len(dkgReq.Participants), dkgReq.Threshold); err != nil {
+		return err
+	}
+
 	consAddress, _ := sdk.ConsAddressFromHex(req.ConsensusAddress)
 	validator, err := k.stakingKeeper.GetValidatorByConsAddr(ctx, consAddress)
 	if err != nil {
@@ -262,23 +413,31 @@ func (k Keeper) CompleteDKG(ctx sdk.Context, req *types.DKGCompletionRequest) er
 }
 
 // TransferVault performs the vault asset transfer from the source version to the destination version
+// All the vaults of the given asset type and source version are transferred
 func (k Keeper) TransferVault(ctx sdk.Context, sourceVersion uint64, destVersion uint64, assetType types.AssetType, psbts []string, targetUtxoNum uint32) error {
-	sourceVault := k.GetVaultByAssetTypeAndVersion(ctx, assetType, sourceVersion)
-	if sourceVault == nil {
+	if err := k.CheckNotPaused(ctx, types.PauseScope_PAUSE_SCOPE_SIGNING, ""); err != nil {
+		return err
+	}
+
+	sourceVaults := k.GetVaultsByAssetTypeAndVersion(ctx, assetType, sourceVersion)
+	if len(sourceVaults) == 0 {
 		return types.ErrVaultDoesNotExist
 	}
 
-	destVault := k.GetVaultByAssetTypeAndVersion(ctx, assetType, destVersion)
-	if destVault == nil {
+	if len(k.GetVaultsByAssetTypeAndVersion(ctx, assetType, destVersion)) == 0 {
 		return types.ErrVaultDoesNotExist
 	}
 
 	// handle pre-built psbts if any
 	if len(psbts) > 0 {
 		for i := range psbts {
-			p, _ := psbt.NewFromRawBytes(bytes.NewReader([]byte(psbts[i])), true)
+			p, err := psbt.NewFromRawBytes(bytes.NewReader([]byte(psbts[i])), true)
+			if err != nil {
+				return errorsmod.Wrapf(types.ErrInvalidPsbt, "psbt %d: %v", i, err)
+			}
 
-			if err := k.handleTransferVaultTx(ctx, p, sourceVault, destVault, assetType); err != nil {
+			spentVaults, err := k.handleTransferVaultTx(ctx, p, sourceVersion, destVersion, assetType)
+			if err != nil {
 				return err
 			}
 
@@ -293,8 +452,18 @@ func (k Keeper) TransferVault(ctx sdk.Context, sourceVersion uint64, destVersion
 			}
 
 			k.SetSigningRequest(ctx, signingReq)
+
+			for _, vault := range spentVaults {
+				k.RecordVaultTransferStep(ctx, sourceVersion, destVersion, assetType, vault, []string{signingReq.Txid})
+			}
 		}
 
+		_ = ctx.EventManager().EmitTypedEvent(&types.EventVaultTransferred{
+			SourceVersion: sourceVersion,
+			DestVersion:   destVersion,
+			AssetType:     assetType,
+		})
+
 		return nil
 	}
 
@@ -303,141 +472,299 @@ func (k Keeper) TransferVault(ctx sdk.Context, sourceVersion uint64, destVersion
 		return err
 	}
 
-	var err error
-	var signingReq *types.SigningRequest
+	transferred := false
 
-	switch assetType {
-	case types.AssetType_ASSET_TYPE_BTC:
-		signingReq, err = k.BuildTransferVaultBtcSigningRequest(ctx, sourceVault, destVault, targetUtxoNum, feeRate.Value)
-		if err != nil {
-			return err
+	for _, sourceVault := range sourceVaults {
+		if k.VaultTransferCompleted(ctx, sourceVault.Address) {
+			continue
 		}
 
-	case types.AssetType_ASSET_TYPE_RUNES:
-		signingReq, err = k.BuildTransferVaultRunesSigningRequest(ctx, sourceVault, destVault, targetUtxoNum, feeRate.Value)
-		if err != nil {
-			return err
+		destVault := types.SelectTransferDestVault(k.GetParams(ctx).Vaults, sourceVault, destVersion)
+
+		var err error
+		var signingReq *types.SigningRequest
+
+		switch assetType {
+		case types.AssetType_ASSET_TYPE_BTC:
+			signingReq, err = k.BuildTransferVaultBtcSigningRequest(ctx, sourceVault, destVault, targetUtxoNum, feeRate.Value)
+			if err != nil {
+				return err
+			}
+
+		case types.AssetType_ASSET_TYPE_RUNES:
+			signingReq, err = k.BuildTransferVaultRunesSigningRequest(ctx, sourceVault, destVault, targetUtxoNum, feeRate.Value)
+			if err != nil {
+				return err
+			}
 		}
+
+		k.SetSigningRequest(ctx, signingReq)
+
+		k.RecordVaultTransferStep(ctx, sourceVersion, destVersion, assetType, sourceVault.Address, []string{signingReq.Txid})
+
+		transferred = true
+	}
+
+	if !transferred {
+		return types.ErrInsufficientUTXOs
 	}
 
-	k.SetSigningRequest(ctx, signingReq)
+	_ = ctx.EventManager().EmitTypedEvent(&types.EventVaultTransferred{
+		SourceVersion: sourceVersion,
+		DestVersion:   destVersion,
+		AssetType:     assetType,
+	})
 
 	return nil
 }
 
 // handleTransferVaultTx handles the pre-built tx for the vault transfer
-func (k Keeper) handleTransferVaultTx(ctx sdk.Context, p *psbt.Packet, sourceVault, destVault *types.Vault, assetType types.AssetType) error {
-	if err := k.checkUtxoCount(ctx, len(p.UnsignedTx.TxIn)); err != nil {
-		return err
+// The tx is validated thoroughly before any state change
+// The source vaults of the given asset type spent by the tx are returned
+func (k Keeper) handleTransferVaultTx(ctx sdk.Context, p *psbt.Packet, sourceVersion uint64, destVersion uint64, assetType types.AssetType) ([]string, error) {
+	utxos, runeBalances, err := k.validateTransferVaultTx(ctx, p, sourceVersion, destVersion, assetType)
+	if err != nil {
+		return nil, err
 	}
 
 	txHash := p.UnsignedTx.TxHash().String()
 
+	// spend the involved utxos
+	_ = k.SpendUTXOs(ctx, utxos)
+
+	// lock the outputs to the destination vaults and mark minted
+	for i, out := range p.UnsignedTx.TxOut {
+		if types.IsOpReturnOutput(out) {
+			continue
+		}
+
+		utxo := &types.UTXO{
+			Txid:         txHash,
+			Vout:         uint64(i),
+			Address:      types.SelectVaultByPkScript(k.GetParams(ctx).Vaults, out.PkScript).Address,
+			Amount:       uint64(out.Value),
+			PubKeyScript: out.PkScript,
+		}
+
+		if assetType == types.AssetType_ASSET_TYPE_RUNES && i == 1 {
+			utxo.Runes = runeBalances
+		}
+
+		k.lockChangeUTXOs(ctx, txHash, utxo)
+	}
+
+	spentVaults := []string{}
+
+	for _, utxo := range utxos {
+		vault := types.SelectVaultByAddress(k.GetParams(ctx).Vaults, utxo.Address)
+		if vault.AssetType == assetType && !slices.Contains(spentVaults, vault.Address) {
+			spentVaults = append(spentVaults, vault.Address)
+		}
+	}
+
+	return spentVaults, nil
+}
+
+// validateTransferVaultTx validates the pre-built tx for the vault transfer
+// The following are required:
+// 1. every input is a known unlocked utxo of the source vaults; the source btc vaults are allowed for fees of the runes transfer
+// 2. the runes tx allocates all runes to a destination runes vault at output 1 following the runestone at output 0
+// 3. every other output goes to a destination vault; the destination btc vaults are allowed for the change of the runes transfer
+// 4. the network fee is bounded by the current fee rate
+// The source and destination vaults are all the vaults of the given asset type and versions
+// The spent utxos and the rune balances to be transferred are returned
+func (k Keeper) validateTransferVaultTx(ctx sdk.Context, p *psbt.Packet, sourceVersion uint64, destVersion uint64, assetType types.AssetType) ([]*types.UTXO, types.RuneBalances, error) {
+	tx := p.UnsignedTx
+
+	if len(tx.TxIn) == 0 || len(tx.TxOut) == 0 {
+		return nil, nil, errorsmod.Wrap(types.ErrInvalidPsbt, "no inputs or outputs")
+	}
+
+	if err := k.checkUtxoCount(ctx, len(tx.TxIn)); err != nil {
+		return nil, nil, err
+	}
+
+	vaults := k.GetParams(ctx).Vaults
+
 	if assetType == types.AssetType_ASSET_TYPE_RUNES {
-		if edicts, err := types.ParseRunes(p.UnsignedTx); err != nil || len(edicts) != types.RunesEdictNum {
-			return types.ErrInvalidRunes
+		if len(types.SelectVaultsByAssetTypeAndVersion(vaults, types.AssetType_ASSET_TYPE_BTC, sourceVersion)) == 0 ||
+			len(types.SelectVaultsByAssetTypeAndVersion(vaults, types.AssetType_ASSET_TYPE_BTC, destVersion)) == 0 {
+			return nil, nil, types.ErrVaultDoesNotExist
 		}
 	}
 
+	utxos := make([]*types.UTXO, 0, len(tx.TxIn))
 	runeBalances := make(types.RuneBalances, 0)
 
-	for i, ti := range p.UnsignedTx.TxIn {
+	var inputValue int64
+
+	for i, ti := range tx.TxIn {
 		hash := ti.PreviousOutPoint.Hash.String()
-		vout := ti.PreviousOutPoint.Index
+		vout := uint64(ti.PreviousOutPoint.Index)
 
-		if !k.HasUTXO(ctx, hash, uint64(vout)) {
-			return types.ErrUTXODoesNotExist
+		if !k.HasUTXO(ctx, hash, vout) {
+			return nil, nil, errorsmod.Wrapf(types.ErrUTXODoesNotExist, "input %d: %s:%d", i, hash, vout)
 		}
 
-		if k.IsUTXOLocked(ctx, hash, uint64(vout)) {
-			return types.ErrUTXOLocked
+		if k.IsUTXOLocked(ctx, hash, vout) {
+			return nil, nil, errorsmod.Wrapf(types.ErrUTXOLocked, "input %d: %s:%d", i, hash, vout)
 		}
 
-		utxo := k.GetUTXO(ctx, hash, uint64(vout))
-		if !bytes.Equal(utxo.PubKeyScript, p.Inputs[i].WitnessUtxo.PkScript) || utxo.Amount != uint64(p.Inputs[i].WitnessUtxo.Value) {
-			return types.ErrInvalidPsbt
+		for _, utxo := range utxos {
+			if utxo.Txid == hash && utxo.Vout == vout {
+				return nil, nil, errorsmod.Wrapf(types.ErrInvalidPsbt, "input %d: duplicate input", i)
+			}
 		}
 
-		vault := types.SelectVaultByAddress(k.GetParams(ctx).Vaults, utxo.Address)
-		if vault == nil {
-			return types.ErrVaultDoesNotExist
-		}
+		utxo := k.GetUTXO(ctx, hash, vout)
 
-		if vault.Version != sourceVault.Version {
-			return types.ErrInvalidVaultVersion
+		witnessUtxo := p.Inputs[i].WitnessUtxo
+		if witnessUtxo == nil || !bytes.Equal(utxo.PubKeyScript, witnessUtxo.PkScript) || utxo.Amount != uint64(witnessUtxo.Value) {
+			return nil, nil, errorsmod.Wrapf(types.ErrInvalidPsbt, "input %d: witness utxo mismatched", i)
 		}
 
-		if assetType == types.AssetType_ASSET_TYPE_BTC && vault.AssetType != sourceVault.AssetType {
-			return types.ErrInvalidVault
-		}
+		vault := types.SelectVaultByAddress(vaults, utxo.Address)
+
+		switch {
+		case vault == nil || vault.Version != sourceVersion:
+			return nil, nil, errorsmod.Wrapf(types.ErrInvalidVault, "input %d: not spent from the source vault", i)
+
+		case vault.AssetType == assetType:
+			if assetType == types.AssetType_ASSET_TYPE_RUNES {
+				runeBalances = runeBalances.Merge(utxo.Runes)
+			}
+
+		case vault.AssetType == types.AssetType_ASSET_TYPE_BTC:
+			// fee payment of the runes transfer
 
-		if assetType == types.AssetType_ASSET_TYPE_RUNES && vault.AssetType == types.AssetType_ASSET_TYPE_RUNES {
-			runeBalances = runeBalances.Merge(utxo.Runes)
+		default:
+			return nil, nil, errorsmod.Wrapf(types.ErrInvalidVault, "input %d: not spent from the source vault", i)
 		}
 
-		_ = k.SpendUTXO(ctx, hash, uint64(vout))
+		utxos = append(utxos, utxo)
+		inputValue += witnessUtxo.Value
 	}
 
-	for i, out := range p.UnsignedTx.TxOut {
-		if !txscript.IsNullData(out.PkScript) {
-			vault := types.SelectVaultByPkScript(k.GetParams(ctx).Vaults, out.PkScript)
-			if vault == nil {
-				return types.ErrVaultDoesNotExist
-			}
+	var outputValue int64
 
-			if vault.Version != destVault.Version {
-				return types.ErrInvalidVault
-			}
+	for i, out := range tx.TxOut {
+		outputValue += out.Value
 
-			if assetType == types.AssetType_ASSET_TYPE_BTC && vault.AssetType != destVault.AssetType {
-				return types.ErrInvalidVault
-			}
+		expectedAssetType := types.AssetType_ASSET_TYPE_BTC
 
-			if vault.AssetType == types.AssetType_ASSET_TYPE_RUNES && i != 1 {
-				return types.ErrInvalidRunes
-			}
+		if assetType == types.AssetType_ASSET_TYPE_RUNES {
+			switch i {
+			case 0:
+				// checked against the runestone
+				continue
 
-			if vault.AssetType == types.AssetType_ASSET_TYPE_BTC {
-				utxo := &types.UTXO{
-					Txid:         txHash,
-					Vout:         uint64(i),
-					Address:      vault.Address,
-					Amount:       uint64(out.Value),
-					PubKeyScript: out.PkScript,
-					IsLocked:     true,
-				}
-
-				k.SetUTXO(ctx, utxo)
+			case 1:
+				expectedAssetType = types.AssetType_ASSET_TYPE_RUNES
 			}
+		}
 
-			if vault.AssetType == types.AssetType_ASSET_TYPE_RUNES {
-				if len(runeBalances) == 0 {
-					return types.ErrInvalidRunes
-				}
-
-				utxo := &types.UTXO{
-					Txid:         txHash,
-					Vout:         uint64(i),
-					Address:      vault.Address,
-					Amount:       uint64(out.Value),
-					PubKeyScript: out.PkScript,
-					IsLocked:     true,
-					Runes:        runeBalances,
-				}
-
-				k.SetUTXO(ctx, utxo)
-			}
+		vault := types.SelectVaultByPkScript(vaults, out.PkScript)
+		if vault == nil || vault.AssetType != expectedAssetType || vault.Version != destVersion {
+			return nil, nil, errorsmod.Wrapf(types.ErrInvalidVault, "output %d: not sent to the destination vault", i)
+		}
+
+		if types.IsDustOut(out) {
+			return nil, nil, errorsmod.Wrapf(types.ErrDustOutput, "output %d", i)
+		}
+	}
+
+	if assetType == types.AssetType_ASSET_TYPE_RUNES {
+		if err := checkTransferVaultRunes(p, runeBalances); err != nil {
+			return nil, nil, err
 		}
 	}
 
-	// mark minted
-	k.addToMintHistory(ctx, p.UnsignedTx.TxHash().String())
+	if err := types.CheckTransactionWeight(tx, utxos); err != nil {
+		return nil, nil, err
+	}
+
+	feeRate := k.GetFeeRate(ctx)
+	if err := k.CheckFeeRate(ctx, feeRate); err != nil {
+		return nil, nil, err
+	}
+
+	fee := inputValue - outputValue
+	if fee <= 0 {
+		return nil, nil, errorsmod.Wrapf(types.ErrInvalidPsbt, "invalid network fee %d", fee)
+	}
+
+	maxFee := feeRate.Value * types.GetTxVirtualSize(tx, utxos)
+	if fee > maxFee {
+		return nil, nil, errorsmod.Wrapf(types.ErrMaxNetworkFeeExceeded, "network fee %d, max fee %d", fee, maxFee)
+	}
+
+	return utxos, runeBalances, nil
+}
+
+// checkTransferVaultRunes checks if the runes tx conserves the given rune balances by re-parsing the runestone
+// All runes are required to be allocated to output 1 which is the first non-OP_RETURN output following the runestone at output 0
+// Assume that output 1 has been checked to be the destination runes vault
+func checkTransferVaultRunes(p *psbt.Packet, runeBalances types.RuneBalances) error {
+	tx := p.UnsignedTx
+
+	if len(runeBalances) == 0 {
+		return errorsmod.Wrap(types.ErrInvalidRunes, "no runes spent from the source vault")
+	}
+
+	if len(tx.TxOut) < 2 {
+		return errorsmod.Wrap(types.ErrInvalidRunes, "missing runes outputs")
+	}
+
+	runestoneOut := tx.TxOut[0].PkScript
+	if len(runestoneOut) < 2 || runestoneOut[0] != txscript.OP_RETURN || runestoneOut[1] != types.MagicNumber {
+		return errorsmod.Wrap(types.ErrInvalidRunes, "output 0: runestone not found")
+	}
+
+	runestone, err := types.ParseRunestone(tx)
+	if err != nil {
+		return err
+	}
+
+	if runestone == nil {
+		return errorsmod.Wrap(types.ErrInvalidRunes, "runestone not found")
+	}
+
+	if runestone.Etching != nil || runestone.Mint != nil {
+		return errorsmod.Wrap(types.ErrInvalidRunes, "etching or mint not allowed")
+	}
+
+	if runestone.Pointer != nil && *runestone.Pointer != 1 {
+		return errorsmod.Wrapf(types.ErrInvalidRunes, "invalid pointer %d", *runestone.Pointer)
+	}
+
+	if len(runestone.Edicts) != types.RunesEdictNum {
+		return errorsmod.Wrapf(types.ErrInvalidRunes, "invalid edict number %d", len(runestone.Edicts))
+	}
+
+	for _, edict := range runestone.Edicts {
+		if edict.Output != 1 {
+			return errorsmod.Wrapf(types.ErrInvalidRunes, "edict output %d", edict.Output)
+		}
+
+		_, balance := runeBalances.GetBalance(edict.Id.ToString())
+		if balance.IsZero() {
+			return errorsmod.Wrapf(types.ErrInvalidRunes, "rune %s not spent", edict.Id.ToString())
+		}
+
+		if types.RuneAmountFromString(edict.Amount).Cmp(balance) > 0 {
+			return errorsmod.Wrapf(types.ErrInvalidRunes, "edict amount %s exceeds balance %s", edict.Amount, balance)
+		}
+	}
 
 	return nil
 }
 
 // BuildTransferVaultBtcSigningRequest builds the signing request to transfer btc of the given vault
 func (k Keeper) BuildTransferVaultBtcSigningRequest(ctx sdk.Context, sourceVault *types.Vault, destVault *types.Vault, targetUtxoNum uint32, feeRate int64) (*types.SigningRequest, error) {
+	if err := k.CheckNotPaused(ctx, types.PauseScope_PAUSE_SCOPE_SIGNING, ""); err != nil {
+		return nil, err
+	}
+
 	utxos := make([]*types.UTXO, 0)
 
 	maxUtxoNum := uint32(k.GetMaxUtxoNum(ctx))
@@ -488,6 +815,10 @@ func (k Keeper) BuildTransferVaultBtcSigningRequest(ctx sdk.Context, sourceVault
 
 // BuildTransferVaultRunesSigningRequest builds the signing request to transfer runes of the given vault
 func (k Keeper) BuildTransferVaultRunesSigningRequest(ctx sdk.Context, sourceVault *types.Vault, destVault *types.Vault, targetUtxoNum uint32, feeRate int64) (*types.SigningRequest, error) {
+	if err := k.CheckNotPaused(ctx, types.PauseScope_PAUSE_SCOPE_SIGNING, ""); err != nil {
+		return nil, err
+	}
+
 	runesUtxos := make([]*types.UTXO, 0)
 	runeBalances := make(types.RuneBalances, 0)
 
@@ -507,19 +838,19 @@ func (k Keeper) BuildTransferVaultRunesSigningRequest(ctx sdk.Context, sourceVau
 		return nil, types.ErrInsufficientUTXOs
 	}
 
-	sourceBtcVault := k.GetVaultByAssetTypeAndVersion(ctx, types.AssetType_ASSET_TYPE_BTC, sourceVault.Version)
+	sourceBtcVault := k.getTransferFeeVault(ctx, sourceVault.Version)
 	if sourceBtcVault == nil {
 		return nil, types.ErrVaultDoesNotExist
 	}
 
-	destBtcVault := k.GetVaultByAssetTypeAndVersion(ctx, types.AssetType_ASSET_TYPE_BTC, destVault.Version)
+	destBtcVault := types.SelectTransferDestVault(k.GetParams(ctx).Vaults, sourceBtcVault, destVault.Version)
 	if destBtcVault == nil {
 		return nil, types.ErrVaultDoesNotExist
 	}
 
 	btcUtxoIterator := k.GetUTXOIteratorByAddr(ctx, sourceBtcVault.Address)
 
-	p, selectedUtxos, changeUtxo, runesRecipientUtxo, err := types.BuildTransferAllRunesPsbt(runesUtxos, btcUtxoIterator, destVault.Address, runeBalances, feeRate, destBtcVault.Address, k.GetMaxUtxoNum(ctx))
+	p, selectedUtxos, changeUtxo, runesRecipientUtxo, err := types.BuildTransferAllRunesPsbt(runesUtxos, btcUtxoIterator, destVault.Address, runeBalances, feeRate, destBtcVault.Address, k.GetMaxUtxoNum(ctx), k.GetCoinSelector(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -551,6 +882,23 @@ func (k Keeper) BuildTransferVaultRunesSigningRequest(ctx sdk.Context, sourceVau
 	return signingReq, nil
 }
 
+// getTransferFeeVault gets the btc vault of the given version to pay the fees of the runes transfer
+// The first btc vault with unlocked utxos is preferred
+func (k Keeper) getTransferFeeVault(ctx sdk.Context, version uint64) *types.Vault {
+	btcVaults := k.GetVaultsByAssetTypeAndVersion(ctx, types.AssetType_ASSET_TYPE_BTC, version)
+	if len(btcVaults) == 0 {
+		return nil
+	}
+
+	for _, v := range btcVaults {
+		if !k.VaultTransferCompleted(ctx, v.Address) {
+			return v
+		}
+	}
+
+	return btcVaults[0]
+}
+
 // CheckVaults checks if the provided vaults are valid
 func (k Keeper) CheckVaults(ctx sdk.Context, vaults []string, vaultTypes []types.AssetType) error {
 	currentVaults := k.GetParams(ctx).Vaults
@@ -569,17 +917,20 @@ func (k Keeper) CheckVaults(ctx sdk.Context, vaults []string, vaultTypes []types
 }
 
 // UpdateVaults updates the asset vaults of the btc bridge
-// Assume that vaults are validated and match vault types
-func (k Keeper) UpdateVaults(ctx sdk.Context, newVaults []string, vaultTypes []types.AssetType) {
+// Assume that vaults are validated and match public keys and vault types
+// The taproot tree is committed to by the new vaults if any
+func (k Keeper) UpdateVaults(ctx sdk.Context, newVaults []string, pubKeys []string, vaultTypes []types.AssetType, taprootTree *types.VaultTaprootTree) {
 	params := k.GetParams(ctx)
 
 	version := k.IncreaseVaultVersion(ctx)
 
 	for i, v := range newVaults {
 		newVault := &types.Vault{
-			Address:   v,
-			AssetType: vaultTypes[i],
-			Version:   version,
+			Address:     v,
+			PubKey:      strings.ToLower(pubKeys[i]),
+			AssetType:   vaultTypes[i],
+			Version:     version,
+			TaprootTree: taprootTree,
 		}
 
 		params.Vaults = append(params.Vaults, newVault)
@@ -632,8 +983,22 @@ func (k Keeper) VaultTransferCompleted(ctx sdk.Context, vault string) bool {
 
 // VaultsTransferCompleted returns true if all asset transfer completed for the given vault version, false otherwise
 func (k Keeper) VaultsTransferCompleted(ctx sdk.Context, version uint64) bool {
-	btcVault := k.GetVaultByAssetTypeAndVersion(ctx, types.AssetType_ASSET_TYPE_BTC, version).Address
-	runesVault := k.GetVaultByAssetTypeAndVersion(ctx, types.AssetType_ASSET_TYPE_RUNES, version).Address
+	for _, assetType := range types.VaultTransferAssetTypes() {
+		if !k.AssetVaultsTransferCompleted(ctx, assetType, version) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// AssetVaultsTransferCompleted returns true if the asset transfer completed for all the vaults of the given asset type and version, false otherwise
+func (k Keeper) AssetVaultsTransferCompleted(ctx sdk.Context, assetType types.AssetType, version uint64) bool {
+	for _, v := range k.GetVaultsByAssetTypeAndVersion(ctx, assetType, version) {
+		if !k.VaultTransferCompleted(ctx, v.Address) {
+			return false
+		}
+	}
 
-	return k.VaultTransferCompleted(ctx, btcVault) && k.VaultTransferCompleted(ctx, runesVault)
+	return true
 }
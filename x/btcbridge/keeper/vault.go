@@ -0,0 +1,141 @@
+package keeper
+
+import (
+	"sort"
+
+	"lukechampine.com/uint128"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sideprotocol/side/x/btcbridge/types"
+)
+
+// GetActiveVaults gets all the active vaults of the given asset type
+func (k Keeper) GetActiveVaults(ctx sdk.Context, assetType types.AssetType) []*types.Vault {
+	return types.SelectActiveVaultsByAssetType(k.GetParams(ctx).Vaults, assetType)
+}
+
+// AssignDepositVault assigns the deposit vault among the active vaults of the given asset type by the assignment strategy
+func (k Keeper) AssignDepositVault(ctx sdk.Context, assetType types.AssetType) (*types.Vault, error) {
+	activeVaults := k.GetActiveVaults(ctx, assetType)
+	if len(activeVaults) == 0 {
+		return nil, types.ErrVaultDoesNotExist
+	}
+
+	switch k.GetParams(ctx).VaultAssignmentStrategy {
+	case types.VaultAssignmentStrategy_VAULT_ASSIGNMENT_STRATEGY_LEAST_UTXO:
+		var vault *types.Vault
+		minCount := uint32(0)
+
+		for _, v := range activeVaults {
+			count, _, _ := k.GetUnlockedUTXOCountAndBalancesByAddr(ctx, v.Address)
+			if vault == nil || count < minCount {
+				vault = v
+				minCount = count
+			}
+		}
+
+		return vault, nil
+
+	default:
+		cursor := k.GetDepositVaultCursor(ctx, assetType)
+
+		return activeVaults[cursor%uint64(len(activeVaults))], nil
+	}
+}
+
+// GetDepositVaultCursor gets the round-robin deposit vault cursor of the given asset type
+func (k Keeper) GetDepositVaultCursor(ctx sdk.Context, assetType types.AssetType) uint64 {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.DepositVaultCursorKey(assetType))
+	if bz == nil {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// IncrementDepositVaultCursor increments the round-robin deposit vault cursor of the given asset type
+// The cursor is moved forward once the deposit to the vault of the given asset type arrives
+func (k Keeper) IncrementDepositVaultCursor(ctx sdk.Context, assetType types.AssetType) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Set(types.DepositVaultCursorKey(assetType), sdk.Uint64ToBigEndian(k.GetDepositVaultCursor(ctx, assetType)+1))
+}
+
+// SelectWithdrawVault selects the active vault of the given asset type for withdrawal
+// The btc vault with the largest unlocked balance or the runes vault with the largest balance of the given rune is selected
+func (k Keeper) SelectWithdrawVault(ctx sdk.Context, assetType types.AssetType, runeId string) *types.Vault {
+	activeVaults := k.GetActiveVaults(ctx, assetType)
+	if len(activeVaults) <= 1 || (assetType != types.AssetType_ASSET_TYPE_BTC && assetType != types.AssetType_ASSET_TYPE_RUNES) {
+		return types.SelectVaultByAssetType(activeVaults, assetType)
+	}
+
+	var vault *types.Vault
+	maxBalance := uint128.Zero
+
+	for _, v := range activeVaults {
+		_, value, runeBalances := k.GetUnlockedUTXOCountAndBalancesByAddr(ctx, v.Address)
+
+		balance := uint128.From64(uint64(value))
+		if assetType == types.AssetType_ASSET_TYPE_RUNES {
+			_, balance = types.RuneBalances(runeBalances).GetBalance(runeId)
+		}
+
+		if vault == nil || balance.Cmp(maxBalance) > 0 {
+			vault = v
+			maxBalance = balance
+		}
+	}
+
+	return vault
+}
+
+// AssignBtcWithdrawRequests assigns the given btc withdrawal requests among the active btc vaults
+// Each request is assigned to the vault with the largest remaining balance, which results in one signing request per vault
+func (k Keeper) AssignBtcWithdrawRequests(ctx sdk.Context, withdrawRequests []*types.WithdrawRequest) []*types.VaultWithdrawRequests {
+	activeVaults := k.GetActiveVaults(ctx, types.AssetType_ASSET_TYPE_BTC)
+	if len(activeVaults) == 0 {
+		return nil
+	}
+
+	assignments := make([]*types.VaultWithdrawRequests, len(activeVaults))
+	balances := make([]int64, len(activeVaults))
+
+	for i, v := range activeVaults {
+		_, balances[i], _ = k.GetUnlockedUTXOCountAndBalancesByAddr(ctx, v.Address)
+		assignments[i] = &types.VaultWithdrawRequests{Vault: v.Address}
+	}
+
+	for _, req := range withdrawRequests {
+		amount, err := sdk.ParseCoinNormalized(req.Amount)
+		if err != nil {
+			continue
+		}
+
+		idx := 0
+		for i := range balances {
+			if balances[i] > balances[idx] {
+				idx = i
+			}
+		}
+
+		assignments[idx].Requests = append(assignments[idx].Requests, req)
+		balances[idx] -= amount.Amount.Int64()
+	}
+
+	result := []*types.VaultWithdrawRequests{}
+	for _, assignment := range assignments {
+		if len(assignment.Requests) > 0 {
+			result = append(result, assignment)
+		}
+	}
+
+	// keep the order of the first assigned request
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].Requests[0].Sequence < result[j].Requests[0].Sequence
+	})
+
+	return result
+}
@@ -0,0 +1,194 @@
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcutil/psbt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sideprotocol/side/x/btcbridge/types"
+)
+
+// RetireDeprecatedVaults retires the deprecated vaults of which the retirement time is reached
+func (k Keeper) RetireDeprecatedVaults(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+
+	retiredVaults := []string{}
+
+	for _, v := range params.Vaults {
+		if v.State == types.VaultState_VAULT_STATE_DEPRECATED && v.IsRetired(ctx.BlockTime()) {
+			v.State = types.VaultState_VAULT_STATE_RETIRED
+			retiredVaults = append(retiredVaults, v.Address)
+		}
+	}
+
+	if len(retiredVaults) == 0 {
+		return
+	}
+
+	k.SetParams(ctx, params)
+
+	for _, vault := range retiredVaults {
+		k.EmitEvent(ctx, k.authority,
+			sdk.NewAttribute("vault", vault),
+			sdk.NewAttribute("vault_state", types.VaultState_VAULT_STATE_RETIRED.String()),
+		)
+	}
+}
+
+// SweepDeprecatedVaults sweeps the late deposits to the deprecated runes and btc vaults to the vaults of the latest version
+// The runes vaults are swept before the btc vaults which pay the fees of the runes sweep
+// The sweep is deferred until the ongoing vault transfer from the deprecated vaults completes
+func (k Keeper) SweepDeprecatedVaults(ctx sdk.Context) {
+	latestVersion := k.GetLatestVaultVersion(ctx)
+	vaults := k.GetParams(ctx).Vaults
+
+	for _, assetType := range types.VaultTransferAssetTypes() {
+		for _, v := range vaults {
+			if v.AssetType != assetType || v.State != types.VaultState_VAULT_STATE_DEPRECATED || v.Version >= latestVersion {
+				continue
+			}
+
+			if transfer := k.GetVaultTransfer(ctx, v.Version); transfer != nil && transfer.Status != types.VaultTransferStatus_VAULT_TRANSFER_STATUS_COMPLETED {
+				continue
+			}
+
+			if k.VaultTransferCompleted(ctx, v.Address) {
+				continue
+			}
+
+			cacheCtx, write := ctx.CacheContext()
+
+			signingReq, err := k.sweepDeprecatedVault(cacheCtx, v, latestVersion)
+			if err != nil {
+				k.Logger(ctx).Info("failed to sweep deprecated vault", "vault", v.Address, "err", err)
+				k.RecordVaultTransferError(ctx, v.Version, v.AssetType, err)
+
+				continue
+			}
+
+			write()
+
+			k.UpdateVaultTransferProgress(ctx, k.GetVaultTransfer(ctx, v.Version))
+
+			k.EmitEvent(ctx, k.authority,
+				sdk.NewAttribute("vault", v.Address),
+				sdk.NewAttribute("vault_state", v.State.String()),
+				sdk.NewAttribute("sweep_txid", signingReq.Txid),
+			)
+		}
+	}
+}
+
+// sweepDeprecatedVault builds the signing request to sweep the unlocked utxos of the given deprecated vault to the vault of the given version
+func (k Keeper) sweepDeprecatedVault(ctx sdk.Context, vault *types.Vault, destVersion uint64) (*types.SigningRequest, error) {
+	destVault := types.SelectTransferDestVault(k.GetParams(ctx).Vaults, vault, destVersion)
+	if destVault == nil {
+		return nil, types.ErrVaultDoesNotExist
+	}
+
+	feeRate := k.GetFeeRate(ctx)
+	if err := k.CheckFeeRate(ctx, feeRate); err != nil {
+		return nil, err
+	}
+
+	var signingReq *types.SigningRequest
+	var err error
+
+	if vault.AssetType == types.AssetType_ASSET_TYPE_BTC {
+		signingReq, err = k.BuildTransferVaultBtcSigningRequest(ctx, vault, destVault, uint32(k.GetMaxUtxoNum(ctx)), feeRate.Value)
+	} else {
+		signingReq, err = k.BuildTransferVaultRunesSigningRequest(ctx, vault, destVault, uint32(k.GetMaxUtxoNum(ctx)), feeRate.Value)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	k.SetSigningRequest(ctx, signingReq)
+
+	k.RecordVaultTransferStep(ctx, vault.Version, destVersion, vault.AssetType, vault.Address, []string{signingReq.Txid})
+
+	_ = ctx.EventManager().EmitTypedEvent(&types.EventVaultTransferred{
+		SourceVersion: vault.Version,
+		DestVersion:   destVersion,
+		AssetType:     vault.AssetType,
+	})
+
+	return signingReq, nil
+}
+
+// SweepVault sweeps the outputs of the given retired vault by the pre-built psbt approved by governance
+// The outputs not tracked, i.e. the rejected deposits after retirement, can be swept as well
+func (k Keeper) SweepVault(ctx sdk.Context, vaultAddress string, psbtB64 string) (*types.SigningRequest, error) {
+	if err := k.CheckNotPaused(ctx, types.PauseScope_PAUSE_SCOPE_SIGNING, ""); err != nil {
+		return nil, err
+	}
+
+	vault := types.SelectVaultByAddress(k.GetParams(ctx).Vaults, vaultAddress)
+	if vault == nil {
+		return nil, types.ErrVaultDoesNotExist
+	}
+
+	if vault.State != types.VaultState_VAULT_STATE_RETIRED {
+		return nil, errorsmod.Wrap(types.ErrInvalidVaultSweep, "vault not retired")
+	}
+
+	p, err := psbt.NewFromRawBytes(bytes.NewReader([]byte(psbtB64)), true)
+	if err != nil {
+		return nil, types.ErrInvalidPsbt
+	}
+
+	if err := k.checkUtxoCount(ctx, len(p.UnsignedTx.TxIn)); err != nil {
+		return nil, err
+	}
+
+	vaultPkScript := types.MustPkScriptFromAddress(vault.Address)
+
+	for i, ti := range p.UnsignedTx.TxIn {
+		if p.Inputs[i].WitnessUtxo == nil || !bytes.Equal(p.Inputs[i].WitnessUtxo.PkScript, vaultPkScript) {
+			return nil, errorsmod.Wrap(types.ErrInvalidVaultSweep, "input not spent from the vault")
+		}
+
+		hash := ti.PreviousOutPoint.Hash.String()
+		vout := uint64(ti.PreviousOutPoint.Index)
+
+		if !k.HasUTXO(ctx, hash, vout) {
+			continue
+		}
+
+		if k.IsUTXOLocked(ctx, hash, vout) {
+			return nil, types.ErrUTXOLocked
+		}
+
+		if k.GetUTXO(ctx, hash, vout).Amount != uint64(p.Inputs[i].WitnessUtxo.Value) {
+			return nil, types.ErrInvalidPsbt
+		}
+
+		_ = k.SpendUTXO(ctx, hash, vout)
+	}
+
+	signingReq := &types.SigningRequest{
+		Address:      k.authority,
+		Sequence:     k.IncrementSigningRequestSequence(ctx),
+		Type:         vault.AssetType,
+		Txid:         p.UnsignedTx.TxHash().String(),
+		Psbt:         psbtB64,
+		CreationTime: ctx.BlockTime(),
+		Status:       types.SigningStatus_SIGNING_STATUS_PENDING,
+	}
+	k.SetSigningRequest(ctx, signingReq)
+
+	// Emit events
+	k.EmitEvent(ctx, k.authority,
+		sdk.NewAttribute("vault", vault.Address),
+		sdk.NewAttribute("vault_state", vault.State.String()),
+		sdk.NewAttribute("sequence", fmt.Sprintf("%d", signingReq.Sequence)),
+		sdk.NewAttribute("sweep_txid", signingReq.Txid),
+	)
+
+	return signingReq, nil
+}
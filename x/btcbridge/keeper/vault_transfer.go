@@ -0,0 +1,238 @@
+package keeper
+
+import (
+	"slices"
+
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sideprotocol/side/x/btcbridge/types"
+)
+
+// SetVaultTransfer sets the given vault transfer
+func (k Keeper) SetVaultTransfer(ctx sdk.Context, transfer *types.VaultTransfer) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := k.cdc.MustMarshal(transfer)
+	store.Set(types.VaultTransferKey(transfer.SourceVersion), bz)
+}
+
+// GetVaultTransfer gets the vault transfer from the given source version
+func (k Keeper) GetVaultTransfer(ctx sdk.Context, sourceVersion uint64) *types.VaultTransfer {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.VaultTransferKey(sourceVersion))
+	if bz == nil {
+		return nil
+	}
+
+	var transfer types.VaultTransfer
+	k.cdc.MustUnmarshal(bz, &transfer)
+
+	return &transfer
+}
+
+// GetAllVaultTransfers gets all vault transfers
+func (k Keeper) GetAllVaultTransfers(ctx sdk.Context) []*types.VaultTransfer {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := storetypes.KVStorePrefixIterator(store, types.VaultTransferKeyPrefix)
+	defer iterator.Close()
+
+	transfers := make([]*types.VaultTransfer, 0)
+
+	for ; iterator.Valid(); iterator.Next() {
+		var transfer types.VaultTransfer
+		k.cdc.MustUnmarshal(iterator.Value(), &transfer)
+
+		transfers = append(transfers, &transfer)
+	}
+
+	return transfers
+}
+
+// StartVaultTransfer starts tracking the vault transfer from the source version to the destination version
+// The existing vault transfer is returned if any
+func (k Keeper) StartVaultTransfer(ctx sdk.Context, dkgID uint64, sourceVersion uint64, destVersion uint64) *types.VaultTransfer {
+	if transfer := k.GetVaultTransfer(ctx, sourceVersion); transfer != nil {
+		return transfer
+	}
+
+	transfer := &types.VaultTransfer{
+		SourceVersion: sourceVersion,
+		DestVersion:   destVersion,
+		DkgId:         dkgID,
+		Status:        types.VaultTransferStatus_VAULT_TRANSFER_STATUS_IN_PROGRESS,
+		StartHeight:   ctx.BlockHeight(),
+	}
+
+	vaults := k.GetParams(ctx).Vaults
+
+	for _, assetType := range types.VaultTransferAssetTypes() {
+		for _, sourceVault := range types.SelectVaultsByAssetTypeAndVersion(vaults, assetType, sourceVersion) {
+			destVault := types.SelectTransferDestVault(vaults, sourceVault, destVersion)
+			if destVault == nil {
+				continue
+			}
+
+			asset := types.AssetTransfer{
+				AssetType:   assetType,
+				SourceVault: sourceVault.Address,
+				DestVault:   destVault.Address,
+				Status:      types.VaultTransferStatus_VAULT_TRANSFER_STATUS_PENDING,
+			}
+			k.updateAssetTransferRemaining(ctx, &asset)
+
+			transfer.Assets = append(transfer.Assets, asset)
+		}
+	}
+
+	k.SetVaultTransfer(ctx, transfer)
+
+	_ = ctx.EventManager().EmitTypedEvent(&types.EventVaultTransferStarted{
+		SourceVersion: sourceVersion,
+		DestVersion:   destVersion,
+		DkgId:         dkgID,
+	})
+
+	return transfer
+}
+
+// UpdateVaultTransferProgress updates the status of the given vault transfer by the remaining assets
+// The remaining assets are updated by the transfer steps and the confirmation of the transfer txs rather than per block
+// The vault transfer is completed once no assets remain in the source vaults
+func (k Keeper) UpdateVaultTransferProgress(ctx sdk.Context, transfer *types.VaultTransfer) {
+	if transfer.Status == types.VaultTransferStatus_VAULT_TRANSFER_STATUS_COMPLETED {
+		return
+	}
+
+	completed := true
+
+	for i := range transfer.Assets {
+		asset := &transfer.Assets[i]
+
+		switch {
+		case asset.RemainingUtxoNum == 0:
+			asset.Status = types.VaultTransferStatus_VAULT_TRANSFER_STATUS_COMPLETED
+
+		case len(asset.SigningRequests) > 0:
+			asset.Status = types.VaultTransferStatus_VAULT_TRANSFER_STATUS_IN_PROGRESS
+			completed = false
+
+		default:
+			asset.Status = types.VaultTransferStatus_VAULT_TRANSFER_STATUS_PENDING
+			completed = false
+		}
+	}
+
+	if completed {
+		transfer.Status = types.VaultTransferStatus_VAULT_TRANSFER_STATUS_COMPLETED
+		transfer.CompletionHeight = ctx.BlockHeight()
+
+		_ = ctx.EventManager().EmitTypedEvent(&types.EventVaultTransferCompleted{
+			SourceVersion: transfer.SourceVersion,
+			DestVersion:   transfer.DestVersion,
+		})
+	}
+
+	k.SetVaultTransfer(ctx, transfer)
+}
+
+// RecordVaultTransferStep records the signing requests created by the vault transfer step of the given source vault
+// The completed vault transfer is reopened by the step, e.g. sweeping the late deposits
+func (k Keeper) RecordVaultTransferStep(ctx sdk.Context, sourceVersion uint64, destVersion uint64, assetType types.AssetType, sourceVault string, txids []string) {
+	transfer := k.StartVaultTransfer(ctx, 0, sourceVersion, destVersion)
+
+	if transfer.Status == types.VaultTransferStatus_VAULT_TRANSFER_STATUS_COMPLETED {
+		transfer.Status = types.VaultTransferStatus_VAULT_TRANSFER_STATUS_IN_PROGRESS
+		transfer.CompletionHeight = 0
+	}
+
+	if asset := transfer.GetAssetTransferBySourceVault(sourceVault); asset != nil {
+		asset.SigningRequests = append(asset.SigningRequests, txids...)
+		asset.Status = types.VaultTransferStatus_VAULT_TRANSFER_STATUS_IN_PROGRESS
+		asset.LastError = ""
+
+		k.updateAssetTransferRemaining(ctx, asset)
+	}
+
+	k.SetVaultTransfer(ctx, transfer)
+
+	_ = ctx.EventManager().EmitTypedEvent(&types.EventVaultTransferStepExecuted{
+		SourceVersion: sourceVersion,
+		DestVersion:   destVersion,
+		AssetType:     assetType,
+		Txids:         txids,
+	})
+}
+
+// RecordVaultTransferError records the error of the failed vault transfer step of the given asset type
+// The event is only emitted when the error changes to avoid repeated events per block
+func (k Keeper) RecordVaultTransferError(ctx sdk.Context, sourceVersion uint64, assetType types.AssetType, err error) {
+	transfer := k.GetVaultTransfer(ctx, sourceVersion)
+	if transfer == nil {
+		return
+	}
+
+	updated := false
+
+	for i := range transfer.Assets {
+		asset := &transfer.Assets[i]
+
+		if asset.AssetType == assetType && asset.LastError != err.Error() {
+			asset.LastError = err.Error()
+			updated = true
+		}
+	}
+
+	if !updated {
+		return
+	}
+
+	k.SetVaultTransfer(ctx, transfer)
+
+	_ = ctx.EventManager().EmitTypedEvent(&types.EventVaultTransferStepFailed{
+		SourceVersion: sourceVersion,
+		DestVersion:   transfer.DestVersion,
+		AssetType:     assetType,
+		Error:         err.Error(),
+	})
+}
+
+// UpdateVaultTransferProgressByTx updates the remaining assets of the vault transfers involving the given tx
+// The remaining assets are recounted on confirmation to catch up with the source vault changes in the meantime, e.g. late deposits
+func (k Keeper) UpdateVaultTransferProgressByTx(ctx sdk.Context, txid string) {
+	for _, transfer := range k.GetAllVaultTransfers(ctx) {
+		if transfer.Status == types.VaultTransferStatus_VAULT_TRANSFER_STATUS_COMPLETED {
+			continue
+		}
+
+		updated := false
+
+		for i := range transfer.Assets {
+			asset := &transfer.Assets[i]
+
+			if slices.Contains(asset.SigningRequests, txid) {
+				k.updateAssetTransferRemaining(ctx, asset)
+				updated = true
+			}
+		}
+
+		if updated {
+			k.UpdateVaultTransferProgress(ctx, transfer)
+		}
+	}
+}
+
+// updateAssetTransferRemaining updates the utxos and value remaining in the source vault of the given asset transfer
+func (k Keeper) updateAssetTransferRemaining(ctx sdk.Context, asset *types.AssetTransfer) {
+	asset.RemainingUtxoNum = 0
+	asset.RemainingValue = 0
+
+	k.IterateUTXOsByAddr(ctx, asset.SourceVault, func(utxo *types.UTXO) (stop bool) {
+		asset.RemainingUtxoNum++
+		asset.RemainingValue += utxo.Amount
+
+		return false
+	})
+}
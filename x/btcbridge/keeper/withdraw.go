@@ -8,6 +8,8 @@ import (
 	"github.com/btcsuite/btcd/btcutil/psbt"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
 	"cosmossdk.io/store/prefix"
 	storetypes "cosmossdk.io/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -63,6 +65,10 @@ func (k Keeper) IncrementSigningRequestSequence(ctx sdk.Context) uint64 {
 
 // HandleWithdrawal handles the given withdrawal request
 func (k Keeper) HandleWithdrawal(ctx sdk.Context, sender string, amount sdk.Coin) (*types.WithdrawRequest, error) {
+	if err := k.checkWithdrawalAllowed(ctx, amount); err != nil {
+		return nil, err
+	}
+
 	switch types.AssetTypeFromDenom(amount.Denom, k.GetParams(ctx)) {
 	case types.AssetType_ASSET_TYPE_BTC:
 		return k.HandleBtcWithdrawal(ctx, sender, amount)
@@ -70,6 +76,9 @@ func (k Keeper) HandleWithdrawal(ctx sdk.Context, sender string, amount sdk.Coin
 	case types.AssetType_ASSET_TYPE_RUNES:
 		return k.HandleRunesWithdrawal(ctx, sender, amount)
 
+	case types.AssetType_ASSET_TYPE_BRC20:
+		return k.HandleBrc20Withdrawal(ctx, sender, amount)
+
 	default:
 		return nil, types.ErrAssetNotSupported
 	}
@@ -77,7 +86,15 @@ func (k Keeper) HandleWithdrawal(ctx sdk.Context, sender string, amount sdk.Coin
 
 // HandleBtcWithdrawal handles the given btc withdrawal request
 // Btc withdrawal request will be dispatched to the batch withdrawal queue which is handled periodically
+// The request stays in the queue until the rate limit window allows
 func (k Keeper) HandleBtcWithdrawal(ctx sdk.Context, sender string, amount sdk.Coin) (*types.WithdrawRequest, error) {
+	rateLimit := k.GetParams(ctx).WithdrawRateLimit
+
+	// reject the request which can never be batched within the rate limit
+	if err := rateLimit.CheckAmount(amount, amount.Amount.Int64()); err != nil {
+		return nil, err
+	}
+
 	// set the withdrawal request
 	withdrawRequest := k.NewWithdrawRequest(ctx, sender, amount.String())
 	k.SetWithdrawRequest(ctx, withdrawRequest)
@@ -96,6 +113,80 @@ func (k Keeper) HandleBtcWithdrawal(ctx sdk.Context, sender string, amount sdk.C
 		return nil, err
 	}
 
+	// the global outflow of the batch includes the network fee
+	if err := rateLimit.CheckAmount(amount, amount.Amount.Int64()+networkFee.Amount.Int64()); err != nil {
+		return nil, err
+	}
+
+	// burn asset
+	if err := k.BurnAsset(ctx, sender, amount); err != nil {
+		return nil, err
+	}
+
+	// burn btc network fee
+	if err := k.BurnAsset(ctx, sender, networkFee); err != nil {
+		return nil, err
+	}
+
+	return withdrawRequest, nil
+}
+
+// HandlePriorityBtcWithdrawal handles the given priority btc withdrawal request
+// Priority btc withdrawal will generate a standalone signing request immediately at the given fee rate
+func (k Keeper) HandlePriorityBtcWithdrawal(ctx sdk.Context, sender string, amount sdk.Coin, feeRate int64, maxFee int64) (*types.WithdrawRequest, error) {
+	if err := k.checkWithdrawalAllowed(ctx, amount); err != nil {
+		return nil, err
+	}
+
+	params := k.GetParams(ctx)
+
+	if types.AssetTypeFromDenom(amount.Denom, params) != types.AssetType_ASSET_TYPE_BTC {
+		return nil, errorsmod.Wrap(types.ErrAssetNotSupported, "priority withdrawal only supported for btc")
+	}
+
+	networkFeeRate := k.GetFeeRate(ctx)
+	if err := k.CheckFeeRate(ctx, networkFeeRate); err != nil {
+		return nil, err
+	}
+
+	if feeRate == 0 {
+		feeRate = networkFeeRate.Value
+	} else if feeRate < networkFeeRate.Value {
+		return nil, errorsmod.Wrapf(types.ErrInvalidFeeRate, "fee rate must not be less than the network fee rate %d", networkFeeRate.Value)
+	}
+
+	vault := k.SelectWithdrawVault(ctx, types.AssetType_ASSET_TYPE_BTC, "")
+	if vault == nil {
+		return nil, types.ErrVaultDoesNotExist
+	}
+
+	// build the withdrawal request
+	withdrawRequest := k.NewWithdrawRequest(ctx, sender, amount.String())
+
+	// build the signing request
+	signingRequest, err := k.NewBtcSigningRequest(ctx, sender, amount, feeRate, vault.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	networkFee, err := k.getBtcNetworkFee(ctx, signingRequest.Psbt)
+	if err != nil {
+		return nil, err
+	}
+
+	if maxFee > 0 && networkFee.Amount.Int64() > maxFee {
+		return nil, errorsmod.Wrapf(types.ErrMaxNetworkFeeExceeded, "network fee %s, max fee %d", networkFee, maxFee)
+	}
+
+	// check and record the outflow
+	if err := k.handleOutflow(ctx, amount, amount.Amount.Int64()+networkFee.Amount.Int64()); err != nil {
+		return nil, err
+	}
+
+	// set the withdrawal request
+	withdrawRequest.Txid = signingRequest.Txid
+	k.SetWithdrawRequest(ctx, withdrawRequest)
+
 	// burn asset
 	if err := k.BurnAsset(ctx, sender, amount); err != nil {
 		return nil, err
@@ -112,14 +203,27 @@ func (k Keeper) HandleBtcWithdrawal(ctx sdk.Context, sender string, amount sdk.C
 // HandleRunesWithdrawal handles the given runes withdrawal request
 // Runes withdrawal will generate a signing request immediately
 func (k Keeper) HandleRunesWithdrawal(ctx sdk.Context, sender string, amount sdk.Coin) (*types.WithdrawRequest, error) {
+	// check the rune config
+	config, err := k.GetEnabledRuneConfig(ctx, types.RuneIdFromDenom(amount.Denom))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := config.CheckWithdrawAmount(amount.Amount); err != nil {
+		return nil, err
+	}
+
 	// build the withdrawal request
 	withdrawRequest := k.NewWithdrawRequest(ctx, sender, amount.String())
 
 	// build the signing request
 
-	vaults := k.GetParams(ctx).Vaults
-	btcVault := types.SelectVaultByAssetType(vaults, types.AssetType_ASSET_TYPE_BTC)
-	runesVault := types.SelectVaultByAssetType(vaults, types.AssetType_ASSET_TYPE_RUNES)
+	btcVault := k.SelectWithdrawVault(ctx, types.AssetType_ASSET_TYPE_BTC, "")
+	runesVault := k.SelectWithdrawVault(ctx, types.AssetType_ASSET_TYPE_RUNES, config.Id)
+
+	if btcVault == nil || runesVault == nil {
+		return nil, types.ErrVaultDoesNotExist
+	}
 
 	feeRate := k.GetFeeRate(ctx)
 	if err := k.CheckFeeRate(ctx, feeRate); err != nil {
@@ -131,6 +235,16 @@ func (k Keeper) HandleRunesWithdrawal(ctx sdk.Context, sender string, amount sdk
 		return nil, err
 	}
 
+	networkFee, err := k.getBtcNetworkFee(ctx, signingRequest.Psbt)
+	if err != nil {
+		return nil, err
+	}
+
+	// check and record the outflow
+	if err := k.handleOutflow(ctx, amount, networkFee.Amount.Int64()+types.RunesOutValue); err != nil {
+		return nil, err
+	}
+
 	// set the withdrawal request
 	withdrawRequest.Txid = signingRequest.Txid
 	k.SetWithdrawRequest(ctx, withdrawRequest)
@@ -153,6 +267,75 @@ func (k Keeper) HandleRunesWithdrawal(ctx sdk.Context, sender string, amount sdk
 	return withdrawRequest, nil
 }
 
+// HandleBrc20Withdrawal handles the given brc20 withdrawal request
+// Brc20 withdrawal will generate the signing requests to inscribe and transfer the brc20 immediately
+func (k Keeper) HandleBrc20Withdrawal(ctx sdk.Context, sender string, amount sdk.Coin) (*types.WithdrawRequest, error) {
+	// the amount must be valid by the decimals of the ticker before burning
+	if err := k.CheckBrc20Amount(ctx, types.Brc20TickFromDenom(amount.Denom), amount.Amount); err != nil {
+		return nil, err
+	}
+
+	// build the withdrawal request
+	withdrawRequest := k.NewWithdrawRequest(ctx, sender, amount.String())
+
+	// build the signing requests
+
+	btcVault := k.SelectWithdrawVault(ctx, types.AssetType_ASSET_TYPE_BTC, "")
+	brc20Vault := k.SelectWithdrawVault(ctx, types.AssetType_ASSET_TYPE_BRC20, "")
+
+	if btcVault == nil || brc20Vault == nil {
+		return nil, types.ErrVaultDoesNotExist
+	}
+
+	feeRate := k.GetFeeRate(ctx)
+	if err := k.CheckFeeRate(ctx, feeRate); err != nil {
+		return nil, err
+	}
+
+	signingRequests, err := k.NewBrc20SigningRequests(ctx, sender, amount, feeRate.Value, brc20Vault, btcVault.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	btcOutflow := int64(types.Brc20OutValue)
+	for _, signingRequest := range signingRequests {
+		networkFee, err := k.getBtcNetworkFee(ctx, signingRequest.Psbt)
+		if err != nil {
+			return nil, err
+		}
+
+		btcOutflow += networkFee.Amount.Int64()
+	}
+
+	// check and record the outflow
+	if err := k.handleOutflow(ctx, amount, btcOutflow); err != nil {
+		return nil, err
+	}
+
+	// set the withdrawal request to the tx which transfers the inscription to the sender
+	withdrawRequest.Txid = signingRequests[len(signingRequests)-1].Txid
+	k.SetWithdrawRequest(ctx, withdrawRequest)
+
+	// burn asset
+	if err := k.BurnAsset(ctx, sender, amount); err != nil {
+		return nil, err
+	}
+
+	// burn btc network fee
+	for _, signingRequest := range signingRequests {
+		if err := k.BurnBtcNetworkFee(ctx, sender, signingRequest.Psbt); err != nil {
+			return nil, err
+		}
+	}
+
+	// burn the btc value attached to the inscription
+	if err := k.BurnAsset(ctx, sender, sdk.NewInt64Coin(k.BtcDenom(ctx), types.Brc20OutValue)); err != nil {
+		return nil, err
+	}
+
+	return withdrawRequest, nil
+}
+
 // NewWithdrawRequest builds a new withdrawal request
 func (k Keeper) NewWithdrawRequest(ctx sdk.Context, sender string, amount string) *types.WithdrawRequest {
 	return &types.WithdrawRequest{
@@ -162,8 +345,53 @@ func (k Keeper) NewWithdrawRequest(ctx sdk.Context, sender string, amount string
 	}
 }
 
+// NewBtcSigningRequest creates the standalone signing request for btc withdrawal
+func (k Keeper) NewBtcSigningRequest(ctx sdk.Context, sender string, amount sdk.Coin, feeRate int64, vault string) (*types.SigningRequest, error) {
+	if err := k.CheckNotPaused(ctx, types.PauseScope_PAUSE_SCOPE_SIGNING, ""); err != nil {
+		return nil, err
+	}
+
+	utxoIterator := k.GetUTXOIteratorByAddr(ctx, vault)
+
+	psbt, selectedUTXOs, changeUTXO, err := types.BuildPsbt(utxoIterator, sender, amount.Amount.Int64(), feeRate, vault, k.GetMaxUtxoNum(ctx), k.GetCoinSelector(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	psbtB64, err := psbt.B64Encode()
+	if err != nil {
+		return nil, types.ErrFailToSerializePsbt
+	}
+
+	txHash := psbt.UnsignedTx.TxHash().String()
+
+	// spend the selected utxos
+	_ = k.SpendUTXOs(ctx, selectedUTXOs)
+
+	// lock the change utxo
+	k.lockChangeUTXOs(ctx, txHash, changeUTXO)
+
+	signingRequest := &types.SigningRequest{
+		Address:      sender,
+		Sequence:     k.IncrementSigningRequestSequence(ctx),
+		Type:         types.AssetType_ASSET_TYPE_BTC,
+		Txid:         txHash,
+		Psbt:         psbtB64,
+		CreationTime: ctx.BlockTime(),
+		Status:       types.SigningStatus_SIGNING_STATUS_PENDING,
+	}
+
+	k.SetSigningRequest(ctx, signingRequest)
+
+	return signingRequest, nil
+}
+
 // NewRunesSigningRequest creates the signing request for runes withdrawal
 func (k Keeper) NewRunesSigningRequest(ctx sdk.Context, sender string, amount sdk.Coin, feeRate int64, vault string, btcVault string) (*types.SigningRequest, error) {
+	if err := k.CheckNotPaused(ctx, types.PauseScope_PAUSE_SCOPE_SIGNING, ""); err != nil {
+		return nil, err
+	}
+
 	var runeId types.RuneId
 	runeId.FromDenom(amount.Denom)
 
@@ -176,7 +404,7 @@ func (k Keeper) NewRunesSigningRequest(ctx sdk.Context, sender string, amount sd
 
 	paymentUTXOIterator := k.GetUTXOIteratorByAddr(ctx, btcVault)
 
-	psbt, selectedUTXOs, changeUTXO, runesChangeUTXO, err := types.BuildRunesPsbt(runesUTXOs, paymentUTXOIterator, sender, runeId.ToString(), runeAmount, feeRate, runeBalancesDelta, vault, btcVault, k.GetMaxUtxoNum(ctx))
+	psbt, selectedUTXOs, changeUTXO, runesChangeUTXO, err := types.BuildRunesPsbt(runesUTXOs, paymentUTXOIterator, sender, runeId.ToString(), runeAmount, feeRate, runeBalancesDelta, vault, btcVault, k.GetMaxUtxoNum(ctx), k.GetCoinSelector(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -210,15 +438,98 @@ func (k Keeper) NewRunesSigningRequest(ctx sdk.Context, sender string, amount sd
 	return signingRequest, nil
 }
 
+// NewBrc20SigningRequests creates the signing requests for brc20 withdrawal
+// The signing requests are returned in the order of commit, reveal and transfer
+func (k Keeper) NewBrc20SigningRequests(ctx sdk.Context, sender string, amount sdk.Coin, feeRate int64, vault *types.Vault, btcVault string) ([]*types.SigningRequest, error) {
+	if err := k.CheckNotPaused(ctx, types.PauseScope_PAUSE_SCOPE_SIGNING, ""); err != nil {
+		return nil, err
+	}
+
+	psbts, selectedUTXOs, changeUTXO, err := k.buildBrc20TransferPsbts(ctx, sender, amount, feeRate, vault, btcVault)
+	if err != nil {
+		return nil, err
+	}
+
+	// spend the involved utxos
+	_ = k.SpendUTXOs(ctx, selectedUTXOs)
+
+	// lock the change utxo
+	k.lockChangeUTXOs(ctx, psbts[0].UnsignedTx.TxHash().String(), changeUTXO)
+
+	signingRequests := make([]*types.SigningRequest, len(psbts))
+
+	for i, p := range psbts {
+		psbtB64, err := p.B64Encode()
+		if err != nil {
+			return nil, types.ErrFailToSerializePsbt
+		}
+
+		signingRequests[i] = &types.SigningRequest{
+			Address:      sender,
+			Sequence:     k.IncrementSigningRequestSequence(ctx),
+			Type:         types.AssetType_ASSET_TYPE_BRC20,
+			Txid:         p.UnsignedTx.TxHash().String(),
+			Psbt:         psbtB64,
+			CreationTime: ctx.BlockTime(),
+			Status:       types.SigningStatus_SIGNING_STATUS_PENDING,
+		}
+
+		k.SetSigningRequest(ctx, signingRequests[i])
+	}
+
+	return signingRequests, nil
+}
+
+// buildBrc20TransferPsbts builds the psbts to inscribe and transfer the brc20 to the given recipient
+func (k Keeper) buildBrc20TransferPsbts(ctx sdk.Context, recipient string, amount sdk.Coin, feeRate int64, vault *types.Vault, btcVault string) ([]*psbt.Packet, []*types.UTXO, *types.UTXO, error) {
+	vaultPubKey, err := types.ParseVaultPubKey(vault)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	transfer := types.NewBrc20Transfer(types.Brc20TickFromDenom(amount.Denom), amount.Amount)
+	if err := transfer.Validate(); err != nil {
+		return nil, nil, nil, err
+	}
+
+	paymentUTXOIterator := k.GetUTXOIteratorByAddr(ctx, btcVault)
+
+	return types.BuildBrc20TransferPsbts(paymentUTXOIterator, recipient, transfer, feeRate, vaultPubKey, vault.Address, btcVault, k.GetMaxUtxoNum(ctx), k.GetCoinSelector(ctx))
+}
+
 // BuildBtcBatchWithdrawSigningRequest builds the signing request for btc batch withdrawal
+// The outflow including the network fee is checked and recorded against the rate limit
 func (k Keeper) BuildBtcBatchWithdrawSigningRequest(ctx sdk.Context, withdrawRequests []*types.WithdrawRequest, feeRate int64, vault string) (*types.SigningRequest, error) {
+	if err := k.CheckNotPaused(ctx, types.PauseScope_PAUSE_SCOPE_SIGNING, ""); err != nil {
+		return nil, err
+	}
+
 	utxoIterator := k.GetUTXOIteratorByAddr(ctx, vault)
 
-	psbt, selectedUTXOs, changeUTXO, err := types.BuildBtcBatchWithdrawPsbt(utxoIterator, withdrawRequests, feeRate, vault, k.GetMaxUtxoNum(ctx))
+	psbt, selectedUTXOs, changeUTXO, err := types.BuildBtcBatchWithdrawPsbt(utxoIterator, withdrawRequests, feeRate, vault, k.GetMaxUtxoNum(ctx), k.GetCoinSelector(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	networkFee, err := psbt.GetTxFee()
 	if err != nil {
 		return nil, err
 	}
 
+	amount := sdk.NewInt64Coin(k.BtcDenom(ctx), 0)
+	for _, req := range withdrawRequests {
+		reqAmount, err := sdk.ParseCoinNormalized(req.Amount)
+		if err != nil {
+			return nil, err
+		}
+
+		amount = amount.Add(reqAmount)
+	}
+
+	if err := k.handleOutflow(ctx, amount, amount.Amount.Int64()+int64(networkFee)); err != nil {
+		return nil, err
+	}
+
 	psbtB64, err := psbt.B64Encode()
 	if err != nil {
 		return nil, types.ErrFailToSerializePsbt
@@ -250,14 +561,21 @@ func (k Keeper) BuildBtcBatchWithdrawSigningRequest(ctx sdk.Context, withdrawReq
 // BuildWithdrawTx builds the bitcoin tx for the given withdrawal
 func (k Keeper) BuildWithdrawTx(ctx sdk.Context, sender string, amount sdk.Coin, feeRate int64) (*psbt.Packet, error) {
 	p := k.GetParams(ctx)
-	btcVault := types.SelectVaultByAssetType(p.Vaults, types.AssetType_ASSET_TYPE_BTC)
+	btcVault := k.SelectWithdrawVault(ctx, types.AssetType_ASSET_TYPE_BTC, "")
+	if btcVault == nil {
+		return nil, types.ErrVaultDoesNotExist
+	}
 
 	switch types.AssetTypeFromDenom(amount.Denom, p) {
 	case types.AssetType_ASSET_TYPE_BTC:
 		return k.BuildWithdrawBtcTx(ctx, sender, amount, feeRate, btcVault.Address)
 
 	case types.AssetType_ASSET_TYPE_RUNES:
-		runesVault := types.SelectVaultByAssetType(p.Vaults, types.AssetType_ASSET_TYPE_RUNES)
+		runesVault := k.SelectWithdrawVault(ctx, types.AssetType_ASSET_TYPE_RUNES, types.RuneIdFromDenom(amount.Denom))
+		if runesVault == nil {
+			return nil, types.ErrVaultDoesNotExist
+		}
+
 		return k.BuildWithdrawRunesTx(ctx, sender, amount, feeRate, runesVault.Address, btcVault.Address)
 
 	default:
@@ -269,7 +587,7 @@ func (k Keeper) BuildWithdrawTx(ctx sdk.Context, sender string, amount sdk.Coin,
 func (k Keeper) BuildWithdrawBtcTx(ctx sdk.Context, sender string, amount sdk.Coin, feeRate int64, vault string) (*psbt.Packet, error) {
 	utxoIterator := k.GetUTXOIteratorByAddr(ctx, vault)
 
-	psbt, _, _, err := types.BuildPsbt(utxoIterator, sender, amount.Amount.Int64(), feeRate, vault, k.GetMaxUtxoNum(ctx))
+	psbt, _, _, err := types.BuildPsbt(utxoIterator, sender, amount.Amount.Int64(), feeRate, vault, k.GetMaxUtxoNum(ctx), k.GetCoinSelector(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -291,7 +609,7 @@ func (k Keeper) BuildWithdrawRunesTx(ctx sdk.Context, sender string, amount sdk.
 
 	paymentUTXOIterator := k.GetUTXOIteratorByAddr(ctx, btcVault)
 
-	psbt, _, _, _, err := types.BuildRunesPsbt(runesUTXOs, paymentUTXOIterator, sender, runeId.ToString(), runeAmount, feeRate, runeBalancesDelta, vault, btcVault, k.GetMaxUtxoNum(ctx))
+	psbt, _, _, _, err := types.BuildRunesPsbt(runesUTXOs, paymentUTXOIterator, sender, runeId.ToString(), runeAmount, feeRate, runeBalancesDelta, vault, btcVault, k.GetMaxUtxoNum(ctx), k.GetCoinSelector(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -301,6 +619,10 @@ func (k Keeper) BuildWithdrawRunesTx(ctx sdk.Context, sender string, amount sdk.
 
 // EstimateBtcNetworkFee estimates the btc network fee for the given withdrawal
 func (k Keeper) EstimateWithdrawalNetworkFee(ctx sdk.Context, address string, amount sdk.Coin, feeRate int64) (sdk.Coin, error) {
+	if types.AssetTypeFromDenom(amount.Denom, k.GetParams(ctx)) == types.AssetType_ASSET_TYPE_BRC20 {
+		return k.EstimateBrc20WithdrawalNetworkFee(ctx, address, amount, feeRate)
+	}
+
 	psbt, err := k.BuildWithdrawTx(ctx, address, amount, feeRate)
 	if err != nil {
 		return sdk.Coin{}, err
@@ -319,6 +641,35 @@ func (k Keeper) EstimateWithdrawalNetworkFee(ctx sdk.Context, address string, am
 	return networkFee, nil
 }
 
+// EstimateBrc20WithdrawalNetworkFee estimates the btc network fee for the given brc20 withdrawal
+// The network fee is the total fee of the commit, reveal and transfer txs
+func (k Keeper) EstimateBrc20WithdrawalNetworkFee(ctx sdk.Context, address string, amount sdk.Coin, feeRate int64) (sdk.Coin, error) {
+	btcVault := k.SelectWithdrawVault(ctx, types.AssetType_ASSET_TYPE_BTC, "")
+	brc20Vault := k.SelectWithdrawVault(ctx, types.AssetType_ASSET_TYPE_BRC20, "")
+
+	if btcVault == nil || brc20Vault == nil {
+		return sdk.Coin{}, types.ErrVaultDoesNotExist
+	}
+
+	psbts, _, _, err := k.buildBrc20TransferPsbts(ctx, address, amount, feeRate, brc20Vault, btcVault.Address)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	networkFee := sdk.NewInt64Coin(k.BtcDenom(ctx), 0)
+
+	for _, p := range psbts {
+		txFee, err := p.GetTxFee()
+		if err != nil {
+			return sdk.Coin{}, err
+		}
+
+		networkFee = networkFee.AddAmount(sdkmath.NewInt(int64(txFee)))
+	}
+
+	return networkFee, nil
+}
+
 // GetWithdrawRequest gets the withdrawal request by the given sequence
 func (k Keeper) GetWithdrawRequest(ctx sdk.Context, sequence uint64) *types.WithdrawRequest {
 	store := ctx.KVStore(k.storeKey)
@@ -361,6 +712,27 @@ func (k Keeper) GetPendingBtcWithdrawRequests(ctx sdk.Context, maxNum uint32) []
 	return requests
 }
 
+// GetBtcWithdrawRequestsWithinRateLimit gets the leading requests of the given pending btc withdrawal requests within the rate limit
+// The network fee is not known until the batch is built, in which the outflow including the network fee is checked
+func (k Keeper) GetBtcWithdrawRequestsWithinRateLimit(ctx sdk.Context, withdrawRequests []*types.WithdrawRequest) []*types.WithdrawRequest {
+	amount := sdk.NewInt64Coin(k.BtcDenom(ctx), 0)
+
+	for i, req := range withdrawRequests {
+		reqAmount, err := sdk.ParseCoinNormalized(req.Amount)
+		if err != nil {
+			return withdrawRequests[:i]
+		}
+
+		amount = amount.Add(reqAmount)
+
+		if err := k.CheckOutflow(ctx, amount, amount.Amount.Int64()); err != nil {
+			return withdrawRequests[:i]
+		}
+	}
+
+	return withdrawRequests
+}
+
 // GetWithdrawRequestsByTxHash gets the withdrawal requests by the given tx hash
 func (k Keeper) GetWithdrawRequestsByTxHash(ctx sdk.Context, txHash string) []*types.WithdrawRequest {
 	requests := make([]*types.WithdrawRequest, 0)
@@ -494,15 +866,29 @@ func (k Keeper) GetSigningRequestByTxHash(ctx sdk.Context, hash string) *types.S
 }
 
 // SetSigningRequest sets the signing request
+// The creation and the status change are recorded in the history of the signing request
 func (k Keeper) SetSigningRequest(ctx sdk.Context, signingRequest *types.SigningRequest) {
 	store := ctx.KVStore(k.storeKey)
 
+	transition := types.SigningTransition_SIGNING_TRANSITION_CREATED
+	if k.HasSigningRequest(ctx, signingRequest.Sequence) {
+		transition = types.SigningTransition_SIGNING_TRANSITION_UNSPECIFIED
+
+		if k.GetSigningRequest(ctx, signingRequest.Sequence).Status != signingRequest.Status {
+			transition = getSigningTransitionByStatus(signingRequest.Status)
+		}
+	}
+
 	bz := k.cdc.MustMarshal(signingRequest)
 
 	k.SetSigningRequestStatus(ctx, signingRequest.Sequence, signingRequest.Status)
 
 	store.Set(types.BtcSigningRequestKey(signingRequest.Sequence), bz)
 	store.Set(types.BtcSigningRequestByTxHashKey(signingRequest.Txid), sdk.Uint64ToBigEndian(signingRequest.Sequence))
+
+	if transition != types.SigningTransition_SIGNING_TRANSITION_UNSPECIFIED {
+		k.RecordSigningRequestTransition(ctx, signingRequest, transition, "")
+	}
 }
 
 // SetSigningRequestStatus sets the status store of the signing request
@@ -606,7 +992,10 @@ func (k Keeper) ProcessBitcoinWithdrawTransaction(ctx sdk.Context, msg *types.Ms
 	k.SetSigningRequest(ctx, signingRequest)
 
 	// unlock the change utxos
-	k.unlockChangeUTXOs(ctx, txHash.String())
+	k.unlockChangeUTXOs(ctx, txHash.String(), k.GetBlockHeader(ctx, msg.Blockhash).Height)
+
+	// update the vault transfer progress if the tx is a transfer step
+	k.UpdateVaultTransferProgressByTx(ctx, txHash.String())
 
 	// hook
 	if signingRequest.Type == types.AssetType_ASSET_TYPE_BTC {
@@ -626,22 +1015,34 @@ func (k Keeper) lockChangeUTXOs(ctx sdk.Context, txHash string, utxos ...*types.
 		}
 
 		utxo.IsLocked = true
+		utxo.WitnessScript = k.GetVaultWitnessScript(ctx, utxo.Address)
 		k.SetUTXO(ctx, utxo)
 
 		k.addToMintHistory(ctx, txHash)
 	}
 }
 
-// unlockChangeUTXOs unlocks the change utxos of the given tx
-func (k Keeper) unlockChangeUTXOs(ctx sdk.Context, txHash string) {
+// unlockChangeUTXOs unlocks the change utxos of the given tx confirmed at the given height
+// The confirmation height is required for the relative timelock, e.g. the vault recovery
+func (k Keeper) unlockChangeUTXOs(ctx sdk.Context, txHash string, height uint64) {
 	k.IterateUTXOsByTxHash(ctx, txHash, func(utxo *types.UTXO) (stop bool) {
 		utxo.IsLocked = false
+		utxo.Height = height
 		k.saveUTXO(ctx, utxo)
 
 		return false
 	})
 }
 
+// checkWithdrawalAllowed checks if the withdrawal of the given asset is allowed currently
+func (k Keeper) checkWithdrawalAllowed(ctx sdk.Context, amount sdk.Coin) error {
+	if k.IsCircuitBreakerTripped(ctx) {
+		return types.ErrCircuitBreakerTripped
+	}
+
+	return k.CheckNotPaused(ctx, types.PauseScope_PAUSE_SCOPE_ASSET, amount.Denom)
+}
+
 // BurnAsset burns the asset related to the withdrawal
 func (k Keeper) BurnAsset(ctx sdk.Context, address string, amount sdk.Coin) error {
 	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sdk.MustAccAddressFromBech32(address), types.ModuleName, sdk.NewCoins(amount)); err != nil {
@@ -666,24 +1067,27 @@ func (k Keeper) BurnBtcNetworkFee(ctx sdk.Context, sender string, packet string)
 }
 
 // handleWithdrawProtocolFee performs the protocol fee handling and returns the actual withdrawal amount
+// The protocol fee is charged in the same denom as the asset
 func (k Keeper) handleWithdrawProtocolFee(ctx sdk.Context, sender sdk.AccAddress, amount sdk.Coin) (sdk.Coin, error) {
 	params := k.GetParams(ctx)
 
-	protocolFee := sdk.NewInt64Coin(params.BtcVoucherDenom, params.ProtocolFees.WithdrawFee)
-	protocoFeeCollector := sdk.MustAccAddressFromBech32(params.ProtocolFees.Collector)
+	protocolFee := k.GetProtocolWithdrawFee(ctx, amount)
 
-	var err error
-	withdrawAmount := amount
+	withdrawAmount, err := amount.SafeSub(protocolFee)
+	if err != nil || !withdrawAmount.IsPositive() {
+		return sdk.Coin{}, types.ErrInvalidWithdrawAmount
+	}
 
 	if amount.Denom == params.BtcVoucherDenom {
-		withdrawAmount, err = amount.SafeSub(protocolFee)
-		if err != nil || withdrawAmount.Amount.Int64() < params.ProtocolLimits.BtcMinWithdraw || withdrawAmount.Amount.Int64() > params.ProtocolLimits.BtcMaxWithdraw {
+		if withdrawAmount.Amount.Int64() < params.ProtocolLimits.BtcMinWithdraw || withdrawAmount.Amount.Int64() > params.ProtocolLimits.BtcMaxWithdraw {
 			return sdk.Coin{}, types.ErrInvalidWithdrawAmount
 		}
 	}
 
-	if err := k.bankKeeper.SendCoins(ctx, sender, protocoFeeCollector, sdk.NewCoins(protocolFee)); err != nil {
-		return sdk.Coin{}, err
+	if protocolFee.IsPositive() {
+		if err := k.collectProtocolFeesFromAccount(ctx, sender, sdk.NewCoins(protocolFee)); err != nil {
+			return sdk.Coin{}, err
+		}
 	}
 
 	return withdrawAmount, nil
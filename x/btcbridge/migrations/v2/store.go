@@ -0,0 +1,179 @@
+package v2
+
+import (
+	"strconv"
+
+	"google.golang.org/protobuf/encoding/protowire"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sideprotocol/side/x/btcbridge/types"
+)
+
+const (
+	// field number of the protocol fees in the params
+	protocolFeesFieldNum = 15
+
+	// field numbers of the legacy flat protocol fees in the protocol fees
+	legacyDepositFeeFieldNum  = 1
+	legacyWithdrawFeeFieldNum = 2
+)
+
+// MigrateStore migrates the btc bridge module state from v1 to v2
+// The legacy flat protocol fees are converted to the fee schedules
+// The params introduced since v1 are seeded with the defaults
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	store := ctx.KVStore(storeKey)
+
+	bz := store.Get(types.ParamsStoreKey)
+	if bz == nil {
+		return nil
+	}
+
+	var params types.Params
+	if err := cdc.Unmarshal(bz, &params); err != nil {
+		return err
+	}
+
+	if err := migrateProtocolFees(bz, &params); err != nil {
+		return err
+	}
+
+	seedFeeDistribution(&params)
+	seedWithdrawRateLimit(&params)
+	seedMaxPauseDuration(&params)
+	seedDKGMaxRetries(&params)
+	seedSignerLiveness(&params)
+
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	store.Set(types.ParamsStoreKey, cdc.MustMarshal(&params))
+
+	return nil
+}
+
+// migrateProtocolFees converts the legacy flat protocol fees of the encoded params to the fee schedules
+func migrateProtocolFees(bz []byte, params *types.Params) error {
+	depositFee, withdrawFee, err := getLegacyProtocolFees(bz)
+	if err != nil {
+		return err
+	}
+
+	if depositFee > 0 {
+		params.ProtocolFees.DepositFeeSchedule = types.FeeSchedule{MinFee: strconv.FormatInt(depositFee, 10)}
+	}
+
+	if withdrawFee > 0 {
+		params.ProtocolFees.WithdrawFeeSchedule = types.FeeSchedule{MinFee: strconv.FormatInt(withdrawFee, 10)}
+	}
+
+	return nil
+}
+
+// seedFeeDistribution sets the default protocol fee distribution introduced since v1 if not set
+func seedFeeDistribution(params *types.Params) {
+	if params.ProtocolFees.Distribution.Epoch == 0 {
+		params.ProtocolFees.Distribution = types.DefaultParams().ProtocolFees.Distribution
+	}
+}
+
+// seedWithdrawRateLimit sets the default withdrawal rate limit introduced since v1 if not set
+func seedWithdrawRateLimit(params *types.Params) {
+	if params.WithdrawRateLimit.Window == 0 {
+		params.WithdrawRateLimit = types.DefaultParams().WithdrawRateLimit
+	}
+}
+
+// seedMaxPauseDuration sets the default maximum emergency pause duration introduced since v1 if not set
+func seedMaxPauseDuration(params *types.Params) {
+	if params.MaxPauseDuration == 0 {
+		params.MaxPauseDuration = types.DefaultParams().MaxPauseDuration
+	}
+}
+
+// seedDKGMaxRetries sets the default maximum DKG retries introduced since v1 if not set
+func seedDKGMaxRetries(params *types.Params) {
+	if params.TssParams.DkgMaxRetries == 0 {
+		params.TssParams.DkgMaxRetries = types.DefaultParams().TssParams.DkgMaxRetries
+	}
+}
+
+// seedSignerLiveness sets the default TSS signer liveness params introduced since v1 if not set
+func seedSignerLiveness(params *types.Params) {
+	if params.TssParams.SignerLiveness.Window == 0 {
+		params.TssParams.SignerLiveness = types.DefaultParams().TssParams.SignerLiveness
+	}
+}
+
+// getLegacyProtocolFees gets the legacy flat deposit and withdrawal fees from the encoded params
+func getLegacyProtocolFees(bz []byte) (int64, int64, error) {
+	var depositFee, withdrawFee int64
+
+	err := rangeFields(bz, func(num protowire.Number, typ protowire.Type, value []byte) error {
+		if num != protocolFeesFieldNum || typ != protowire.BytesType {
+			return nil
+		}
+
+		return rangeFields(value, func(num protowire.Number, typ protowire.Type, value []byte) error {
+			if typ != protowire.VarintType {
+				return nil
+			}
+
+			fee, n := protowire.ConsumeVarint(value)
+			if n < 0 {
+				return protowire.ParseError(n)
+			}
+
+			switch num {
+			case legacyDepositFeeFieldNum:
+				depositFee = int64(fee)
+
+			case legacyWithdrawFeeFieldNum:
+				withdrawFee = int64(fee)
+			}
+
+			return nil
+		})
+	})
+	if err != nil {
+		return 0, 0, errorsmod.Wrap(types.ErrInvalidParams, err.Error())
+	}
+
+	return depositFee, withdrawFee, nil
+}
+
+// rangeFields calls the given function for each field of the encoded message
+// The value is the payload of the length-delimited field or the raw encoding of the other fields
+func rangeFields(bz []byte, fn func(num protowire.Number, typ protowire.Type, value []byte) error) error {
+	for len(bz) > 0 {
+		num, typ, n := protowire.ConsumeTag(bz)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+
+		bz = bz[n:]
+
+		n = protowire.ConsumeFieldValue(num, typ, bz)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+
+		value := bz[:n]
+		if typ == protowire.BytesType {
+			value, _ = protowire.ConsumeBytes(value)
+		}
+
+		if err := fn(num, typ, value); err != nil {
+			return err
+		}
+
+		bz = bz[n:]
+	}
+
+	return nil
+}
@@ -1,6 +1,7 @@
 package btcbridge
 
 import (
+	"errors"
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -13,7 +14,15 @@ import (
 func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
 	handleBtcWithdrawRequests(ctx, k)
 	handleDKGRequests(ctx, k)
+	k.HandleParticipantUpdate(ctx)
+	k.HandleFrostSigningTimeouts(ctx)
 	handleVaultTransfer(ctx, k)
+	k.SweepDeprecatedVaults(ctx)
+	k.RetireDeprecatedVaults(ctx)
+	handleProtocolFeeSettlement(ctx, k)
+	k.AutoConsolidateVaults(ctx)
+	k.PruneOutflows(ctx)
+	k.PruneExpiredEmergencyPauses(ctx)
 }
 
 // handleBtcWithdrawRequests performs the batch btc withdrawal request handling
@@ -25,6 +34,11 @@ func handleBtcWithdrawRequests(ctx sdk.Context, k keeper.Keeper) {
 		return
 	}
 
+	// check if withdrawal is paused in emergency
+	if k.IsPaused(ctx, types.PauseScope_PAUSE_SCOPE_WITHDRAW, "") || k.IsPaused(ctx, types.PauseScope_PAUSE_SCOPE_ASSET, p.BtcVoucherDenom) {
+		return
+	}
+
 	// check block height
 	if ctx.BlockHeight()%p.WithdrawParams.BtcBatchWithdrawPeriod != 0 {
 		return
@@ -32,6 +46,9 @@ func handleBtcWithdrawRequests(ctx sdk.Context, k keeper.Keeper) {
 
 	// get the pending btc withdrawal request
 	pendingWithdrawRequests := k.GetPendingBtcWithdrawRequests(ctx, p.WithdrawParams.MaxBtcBatchWithdrawNum)
+
+	// the requests exceeding the rate limit stay in the queue
+	pendingWithdrawRequests = k.GetBtcWithdrawRequestsWithinRateLimit(ctx, pendingWithdrawRequests)
 	if len(pendingWithdrawRequests) == 0 {
 		return
 	}
@@ -42,67 +59,62 @@ func handleBtcWithdrawRequests(ctx sdk.Context, k keeper.Keeper) {
 		return
 	}
 
-	vault := types.SelectVaultByAssetType(p.Vaults, types.AssetType_ASSET_TYPE_BTC)
-	if vault == nil {
+	// the requests are distributed among the active btc vaults, one signing request per vault
+	assignments := k.AssignBtcWithdrawRequests(ctx, pendingWithdrawRequests)
+	if len(assignments) == 0 {
 		k.Logger(ctx).Info("btc vault does not exist")
 		return
 	}
 
-	signingRequest, err := k.BuildBtcBatchWithdrawSigningRequest(ctx, pendingWithdrawRequests, feeRate.Value, vault.Address)
-	if err != nil {
-		k.Logger(ctx).Info("failed to build signing request", "err", err)
-		return
-	}
+	for _, assignment := range assignments {
+		requests := assignment.Requests
 
-	for _, req := range pendingWithdrawRequests {
-		// update withdrawal request
-		req.Txid = signingRequest.Txid
-		k.SetWithdrawRequest(ctx, req)
+		signingRequest, err := k.BuildBtcBatchWithdrawSigningRequest(ctx, requests, feeRate.Value, assignment.Vault)
+		for errors.Is(err, types.ErrWithdrawRateLimitExceeded) && len(requests) > 1 {
+			// the trailing request stays in the queue if the outflow with the network fee exceeds the rate limit
+			requests = requests[:len(requests)-1]
+			signingRequest, err = k.BuildBtcBatchWithdrawSigningRequest(ctx, requests, feeRate.Value, assignment.Vault)
+		}
 
-		// remove from the pending queue
-		k.RemoveFromBtcWithdrawRequestQueue(ctx, req)
+		if err != nil {
+			// the requests stay in the queue
+			k.Logger(ctx).Info("failed to build signing request", "vault", assignment.Vault, "err", err)
+			continue
+		}
 
-		// emit event
-		k.EmitEvent(ctx, req.Address,
-			sdk.NewAttribute("sequence", fmt.Sprintf("%d", req.Sequence)),
-			sdk.NewAttribute("txid", req.Txid),
-		)
-	}
-}
+		sequences := make([]uint64, 0, len(requests))
 
-// handleDKGRequests performs the DKG request handling
-func handleDKGRequests(ctx sdk.Context, k keeper.Keeper) {
-	pendingDKGRequests := k.GetPendingDKGRequests(ctx)
+		for _, req := range requests {
+			// update withdrawal request
+			req.Txid = signingRequest.Txid
+			k.SetWithdrawRequest(ctx, req)
 
-	for _, req := range pendingDKGRequests {
-		// check if the DKG request expired
-		if !ctx.BlockTime().Before(*req.Expiration) {
-			req.Status = types.DKGRequestStatus_DKG_REQUEST_STATUS_TIMEDOUT
-			k.SetDKGRequest(ctx, req)
+			// remove from the pending queue
+			k.RemoveFromBtcWithdrawRequestQueue(ctx, req)
 
-			continue
-		}
+			// emit event
+			k.EmitEvent(ctx, req.Address,
+				sdk.NewAttribute("sequence", fmt.Sprintf("%d", req.Sequence)),
+				sdk.NewAttribute("txid", req.Txid),
+			)
 
-		// handle DKG completion requests
-		completionRequests := k.GetDKGCompletionRequests(ctx, req.Id)
-		if len(completionRequests) != len(req.Participants) {
-			continue
+			sequences = append(sequences, req.Sequence)
 		}
 
-		// check if the DKG completion requests are valid
-		if !types.CheckDKGCompletionRequests(completionRequests) {
-			req.Status = types.DKGRequestStatus_DKG_REQUEST_STATUS_FAILED
-			k.SetDKGRequest(ctx, req)
-
-			continue
-		}
+		_ = ctx.EventManager().EmitTypedEvent(&types.EventWithdrawBatched{
+			Vault:     assignment.Vault,
+			Txid:      signingRequest.Txid,
+			Sequences: sequences,
+		})
+	}
+}
 
-		// update vaults
-		k.UpdateVaults(ctx, completionRequests[0].Vaults, req.VaultTypes)
+// handleDKGRequests performs the DKG request handling
+func handleDKGRequests(ctx sdk.Context, k keeper.Keeper) {
+	pendingDKGRequests := k.GetPendingDKGRequests(ctx)
 
-		// update status
-		req.Status = types.DKGRequestStatus_DKG_REQUEST_STATUS_COMPLETED
-		k.SetDKGRequest(ctx, req)
+	for _, req := range pendingDKGRequests {
+		k.HandlePendingDKGRequest(ctx, req)
 	}
 }
 
@@ -112,38 +124,73 @@ func handleVaultTransfer(ctx sdk.Context, k keeper.Keeper) {
 
 	for _, req := range completedDKGRequests {
 		if req.EnableTransfer {
-			completions := k.GetDKGCompletionRequests(ctx, req.Id)
-			dkgVaultVersion, _ := k.GetVaultVersionByAddress(ctx, completions[0].Vaults[0])
+			vaults, _ := types.SelectAgreedDKGCompletionVaults(k.GetDKGCompletionRequests(ctx, req.Id))
+			dkgVaultVersion, _ := k.GetVaultVersionByAddress(ctx, vaults[0])
 
 			sourceVersion := dkgVaultVersion - 1
 			destVersion := k.GetLatestVaultVersion(ctx)
 
-			if k.VaultsTransferCompleted(ctx, sourceVersion) {
+			transfer := k.StartVaultTransfer(ctx, req.Id, sourceVersion, destVersion)
+			if transfer.Status == types.VaultTransferStatus_VAULT_TRANSFER_STATUS_COMPLETED {
 				continue
 			}
 
-			sourceBtcVault := k.GetVaultByAssetTypeAndVersion(ctx, types.AssetType_ASSET_TYPE_BTC, sourceVersion).Address
-			sourceRunesVault := k.GetVaultByAssetTypeAndVersion(ctx, types.AssetType_ASSET_TYPE_RUNES, sourceVersion).Address
-
-			// transfer runes
-			if !k.VaultTransferCompleted(ctx, sourceRunesVault) {
-				if err := k.TransferVault(ctx, sourceVersion, destVersion, types.AssetType_ASSET_TYPE_RUNES, nil, req.TargetUtxoNum); err != nil {
-					k.Logger(ctx).Info("failed to transfer vault", "source version", sourceVersion, "destination version", destVersion, "asset type", types.AssetType_ASSET_TYPE_RUNES, "target utxo num", req.TargetUtxoNum, "err", err)
-					continue
+			if !k.VaultsTransferCompleted(ctx, sourceVersion) {
+				// transfer runes
+				if !k.AssetVaultsTransferCompleted(ctx, types.AssetType_ASSET_TYPE_RUNES, sourceVersion) {
+					if err := transferVault(ctx, k, sourceVersion, destVersion, types.AssetType_ASSET_TYPE_RUNES, req.TargetUtxoNum); err != nil {
+						continue
+					}
 				}
-			}
 
-			// transfer btc only when runes transfer completed
-			if k.VaultTransferCompleted(ctx, sourceRunesVault) && !k.VaultTransferCompleted(ctx, sourceBtcVault) {
-				if err := k.TransferVault(ctx, sourceVersion, destVersion, types.AssetType_ASSET_TYPE_BTC, nil, req.TargetUtxoNum); err != nil {
-					k.Logger(ctx).Info("failed to transfer vault", "source version", sourceVersion, "destination version", destVersion, "asset type", types.AssetType_ASSET_TYPE_BTC, "target utxo num", req.TargetUtxoNum, "err", err)
-					continue
+				// transfer btc only when runes transfer completed
+				if k.AssetVaultsTransferCompleted(ctx, types.AssetType_ASSET_TYPE_RUNES, sourceVersion) && !k.AssetVaultsTransferCompleted(ctx, types.AssetType_ASSET_TYPE_BTC, sourceVersion) {
+					if err := transferVault(ctx, k, sourceVersion, destVersion, types.AssetType_ASSET_TYPE_BTC, req.TargetUtxoNum); err != nil {
+						continue
+					}
 				}
 			}
 
-			if k.VaultsTransferCompleted(ctx, sourceVersion) {
+			// reload the vault transfer updated by the transfer steps
+			transfer = k.GetVaultTransfer(ctx, sourceVersion)
+			k.UpdateVaultTransferProgress(ctx, transfer)
+
+			if transfer.Status == types.VaultTransferStatus_VAULT_TRANSFER_STATUS_COMPLETED {
 				k.Logger(ctx).Info("vaults transfer completed", "source version", sourceVersion, "destination version", destVersion)
 			}
 		}
 	}
 }
+
+// transferVault performs the vault transfer step of the given asset type
+// The error of the failed step is recorded in the vault transfer
+func transferVault(ctx sdk.Context, k keeper.Keeper, sourceVersion uint64, destVersion uint64, assetType types.AssetType, targetUtxoNum uint32) error {
+	cacheCtx, write := ctx.CacheContext()
+
+	if err := k.TransferVault(cacheCtx, sourceVersion, destVersion, assetType, nil, targetUtxoNum); err != nil {
+		k.Logger(ctx).Info("failed to transfer vault", "source version", sourceVersion, "destination version", destVersion, "asset type", assetType, "target utxo num", targetUtxoNum, "err", err)
+		k.RecordVaultTransferError(ctx, sourceVersion, assetType, err)
+
+		return err
+	}
+
+	write()
+
+	return nil
+}
+
+// handleProtocolFeeSettlement performs the protocol fee settlement per epoch
+func handleProtocolFeeSettlement(ctx sdk.Context, k keeper.Keeper) {
+	epoch := k.GetParams(ctx).ProtocolFees.Distribution.Epoch
+	if epoch <= 0 || ctx.BlockHeight()%epoch != 0 {
+		return
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+	if err := k.SettleProtocolFees(cacheCtx); err != nil {
+		k.Logger(ctx).Info("failed to settle protocol fees", "height", ctx.BlockHeight(), "err", err)
+		return
+	}
+
+	write()
+}
@@ -14,6 +14,9 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	// this line is used by starport scaffolding # genesis/module/init
 	k.SetParams(ctx, genState.Params)
 
+	// set the bank metadata of the btc voucher token
+	k.SetBtcVoucherMetadata(ctx)
+
 	// set the best block header
 	k.SetBestBlockHeader(ctx, genState.BestBlockHeader)
 	k.SetBlockHeader(ctx, genState.BestBlockHeader)
@@ -34,6 +37,33 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 		k.SetDKGRequestID(ctx, genState.DkgRequest.Id)
 	}
 
+	// set rune metadata
+	for _, metadata := range genState.Runes {
+		if err := k.SetRuneMetadata(ctx, metadata); err != nil {
+			panic(err)
+		}
+	}
+
+	// set brc20 metadata
+	for _, metadata := range genState.Brc20Tickers {
+		k.SetBrc20Metadata(ctx, metadata)
+	}
+
+	// set FROST vault keys
+	for _, key := range genState.FrostVaultKeys {
+		k.SetFrostVaultKey(ctx, key)
+	}
+
+	// set vault transfers
+	for _, transfer := range genState.VaultTransfers {
+		k.SetVaultTransfer(ctx, transfer)
+	}
+
+	// set signing request histories
+	for _, history := range genState.SigningRequestHistories {
+		k.SetSigningRequestHistory(ctx, history.Sequence, history.Entries)
+	}
+
 	// sort vaults and set the latest vault version
 	if len(genState.Params.Vaults) > 0 {
 		vaults := genState.Params.Vaults
@@ -50,6 +80,11 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis.BestBlockHeader = k.GetBestBlockHeader(ctx)
 	genesis.BlockHeaders = k.GetAllBlockHeaders(ctx)
 	genesis.Utxos = k.GetAllUTXOs(ctx)
+	genesis.Runes = k.GetAllRuneMetadata(ctx)
+	genesis.Brc20Tickers = k.GetAllBrc20Metadata(ctx)
+	genesis.FrostVaultKeys = k.GetAllFrostVaultKeys(ctx)
+	genesis.VaultTransfers = k.GetAllVaultTransfers(ctx)
+	genesis.SigningRequestHistories = k.GetAllSigningRequestHistories(ctx)
 
 	// this line is used by starport scaffolding # genesis/module/export
 
@@ -6,6 +6,7 @@ import (
 	"bytes"
 	"encoding/hex"
 	"testing"
+	"time"
 
 	"github.com/btcsuite/btcd/btcutil"
 	"github.com/btcsuite/btcd/wire"
@@ -21,12 +22,42 @@ func TestGenesis(t *testing.T) {
 	println(mnemonic)
 
 	genesisState := types.DefaultGenesis()
+	genesisState.SigningRequestHistories = []*types.SigningRequestHistory{
+		{
+			Sequence: 1,
+			Entries: []*types.SigningRequestHistoryEntry{
+				{Transition: types.SigningTransition_SIGNING_TRANSITION_CREATED, Height: 1, Time: time.Unix(1, 0).UTC()},
+				{Transition: types.SigningTransition_SIGNING_TRANSITION_BROADCASTED, Height: 2, Time: time.Unix(2, 0).UTC()},
+			},
+		},
+		{
+			Sequence: 2,
+			Entries: []*types.SigningRequestHistoryEntry{
+				{Transition: types.SigningTransition_SIGNING_TRANSITION_CREATED, Height: 3, Time: time.Unix(3, 0).UTC()},
+			},
+		},
+	}
+
+	genesisState.Brc20Tickers = []*types.Brc20Metadata{{Tick: "ordi", Decimals: 18}, {Tick: "sats", Decimals: 0}}
 
 	k, ctx := keepertest.BtcBridgeKeeper(t)
 	btcbridge.InitGenesis(ctx, *k, *genesisState)
 	got := btcbridge.ExportGenesis(ctx, *k)
 	require.NotNil(t, got)
 
+	// signing request histories round trip
+	require.Equal(t, genesisState.SigningRequestHistories, got.SigningRequestHistories)
+
+	// brc20 tickers round trip
+	require.Equal(t, genesisState.Brc20Tickers, got.Brc20Tickers)
+
+	// the history keeps appending after the imported entries
+	k.RecordSigningRequestTransition(ctx, &types.SigningRequest{Sequence: 2}, types.SigningTransition_SIGNING_TRANSITION_BROADCASTED, "")
+
+	history := k.GetSigningRequestHistory(ctx, 2)
+	require.Len(t, history, 2)
+	require.Equal(t, types.SigningTransition_SIGNING_TRANSITION_BROADCASTED, history[1].Transition)
+
 	nullify.Fill(&genesisState)
 	nullify.Fill(got)
 
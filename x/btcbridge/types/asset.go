@@ -15,6 +15,10 @@ func AssetTypeFromDenom(denom string, p Params) AssetType {
 		return AssetType_ASSET_TYPE_RUNES
 	}
 
+	if strings.HasPrefix(denom, fmt.Sprintf("%s/", Brc20ProtocolName)) {
+		return AssetType_ASSET_TYPE_BRC20
+	}
+
 	return AssetType_ASSET_TYPE_UNSPECIFIED
 }
 
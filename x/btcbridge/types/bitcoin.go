@@ -1,9 +1,13 @@
 package types
 
 import (
+	"sort"
+
 	"lukechampine.com/uint128"
 
 	"github.com/btcsuite/btcd/blockchain"
+	secp256k1 "github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
 	"github.com/btcsuite/btcd/btcutil"
 	"github.com/btcsuite/btcd/btcutil/psbt"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
@@ -26,11 +30,20 @@ const (
 
 	// default sig hash type
 	DefaultSigHashType = txscript.SigHashDefault
+
+	// sig hash type for the multisig inputs, as the ECDSA signature requires the explicit sig hash type
+	MultisigSigHashType = txscript.SigHashAll
+
+	// maximum number of candidate utxos collected for the coin selection
+	MaxCoinSelectionCandidates = 1000
+
+	// maximum number of tries for the branch and bound search
+	MaxBranchAndBoundTries = 100000
 )
 
 // BuildPsbt builds a bitcoin psbt from the given params.
 // Assume that the utxo script type is witness.
-func BuildPsbt(utxoIterator UTXOIterator, recipient string, amount int64, feeRate int64, change string, maxUTXONum int) (*psbt.Packet, []*UTXO, *UTXO, error) {
+func BuildPsbt(utxoIterator UTXOIterator, recipient string, amount int64, feeRate int64, change string, maxUTXONum int, selector CoinSelector) (*psbt.Packet, []*UTXO, *UTXO, error) {
 	chaincfg := sdk.GetConfig().GetBtcChainCfg()
 
 	recipientAddr, err := btcutil.DecodeAddress(recipient, chaincfg)
@@ -51,7 +64,7 @@ func BuildPsbt(utxoIterator UTXOIterator, recipient string, amount int64, feeRat
 	txOuts := make([]*wire.TxOut, 0)
 	txOuts = append(txOuts, wire.NewTxOut(amount, recipientPkScript))
 
-	unsignedTx, selectedUTXOs, changeUTXO, err := BuildUnsignedTransaction([]*UTXO{}, txOuts, utxoIterator, feeRate, changeAddr, maxUTXONum)
+	unsignedTx, selectedUTXOs, changeUTXO, err := BuildUnsignedTransaction([]*UTXO{}, txOuts, utxoIterator, feeRate, changeAddr, maxUTXONum, selector)
 	if err != nil {
 		return nil, nil, nil, err
 	}
@@ -64,6 +77,7 @@ func BuildPsbt(utxoIterator UTXOIterator, recipient string, amount int64, feeRat
 	for i, utxo := range selectedUTXOs {
 		p.Inputs[i].SighashType = DefaultSigHashType
 		p.Inputs[i].WitnessUtxo = wire.NewTxOut(int64(utxo.Amount), utxo.PubKeyScript)
+		PopulatePsbtInputScripts(&p.Inputs[i], utxo)
 	}
 
 	return p, selectedUTXOs, changeUTXO, nil
@@ -100,6 +114,7 @@ func BuildTransferAllBtcPsbt(utxos []*UTXO, recipient string, feeRate int64) (*p
 	for i, utxo := range utxos {
 		p.Inputs[i].SighashType = DefaultSigHashType
 		p.Inputs[i].WitnessUtxo = wire.NewTxOut(int64(utxo.Amount), utxo.PubKeyScript)
+		PopulatePsbtInputScripts(&p.Inputs[i], utxo)
 	}
 
 	recipientUTXO := GetChangeUTXO(unsignedTx, recipient)
@@ -108,7 +123,7 @@ func BuildTransferAllBtcPsbt(utxos []*UTXO, recipient string, feeRate int64) (*p
 }
 
 // BuildBtcBatchWithdrawPsbt builds the psbt to perform btc batch withdrawal
-func BuildBtcBatchWithdrawPsbt(utxoIterator UTXOIterator, withdrawRequests []*WithdrawRequest, feeRate int64, change string, maxUTXONum int) (*psbt.Packet, []*UTXO, *UTXO, error) {
+func BuildBtcBatchWithdrawPsbt(utxoIterator UTXOIterator, withdrawRequests []*WithdrawRequest, feeRate int64, change string, maxUTXONum int, selector CoinSelector) (*psbt.Packet, []*UTXO, *UTXO, error) {
 	chainCfg := sdk.GetConfig().GetBtcChainCfg()
 
 	txOuts := make([]*wire.TxOut, len(withdrawRequests))
@@ -134,7 +149,7 @@ func BuildBtcBatchWithdrawPsbt(utxoIterator UTXOIterator, withdrawRequests []*Wi
 		return nil, nil, nil, err
 	}
 
-	unsignedTx, selectedUTXOs, changeUTXO, err := BuildUnsignedTransaction([]*UTXO{}, txOuts, utxoIterator, feeRate, changeAddress, maxUTXONum)
+	unsignedTx, selectedUTXOs, changeUTXO, err := BuildUnsignedTransaction([]*UTXO{}, txOuts, utxoIterator, feeRate, changeAddress, maxUTXONum, selector)
 	if err != nil {
 		return nil, nil, nil, err
 	}
@@ -147,6 +162,7 @@ func BuildBtcBatchWithdrawPsbt(utxoIterator UTXOIterator, withdrawRequests []*Wi
 	for i, utxo := range selectedUTXOs {
 		p.Inputs[i].SighashType = DefaultSigHashType
 		p.Inputs[i].WitnessUtxo = wire.NewTxOut(int64(utxo.Amount), utxo.PubKeyScript)
+		PopulatePsbtInputScripts(&p.Inputs[i], utxo)
 	}
 
 	return p, selectedUTXOs, changeUTXO, nil
@@ -154,7 +170,7 @@ func BuildBtcBatchWithdrawPsbt(utxoIterator UTXOIterator, withdrawRequests []*Wi
 
 // BuildRunesPsbt builds a bitcoin psbt for runes edict from the given params.
 // Assume that the utxo script type is witness.
-func BuildRunesPsbt(utxos []*UTXO, paymentUTXOIterator UTXOIterator, recipient string, runeId string, amount uint128.Uint128, feeRate int64, runeBalancesDelta []*RuneBalance, runesChange string, change string, maxUTXONum int) (*psbt.Packet, []*UTXO, *UTXO, *UTXO, error) {
+func BuildRunesPsbt(utxos []*UTXO, paymentUTXOIterator UTXOIterator, recipient string, runeId string, amount uint128.Uint128, feeRate int64, runeBalancesDelta []*RuneBalance, runesChange string, change string, maxUTXONum int, selector CoinSelector) (*psbt.Packet, []*UTXO, *UTXO, *UTXO, error) {
 	chaincfg := sdk.GetConfig().GetBtcChainCfg()
 
 	recipientAddr, err := btcutil.DecodeAddress(recipient, chaincfg)
@@ -211,11 +227,16 @@ func BuildRunesPsbt(utxos []*UTXO, paymentUTXOIterator UTXOIterator, recipient s
 	// populate the runes protocol script
 	txOuts[0].PkScript = runesScript
 
-	unsignedTx, selectedUTXOs, changeUTXO, err := BuildUnsignedTransaction(utxos, txOuts, paymentUTXOIterator, feeRate, changeAddr, maxUTXONum)
+	unsignedTx, selectedUTXOs, changeUTXO, err := BuildUnsignedTransaction(utxos, txOuts, paymentUTXOIterator, feeRate, changeAddr, maxUTXONum, selector)
 	if err != nil {
 		return nil, nil, nil, nil, err
 	}
 
+	// the runes would be burned if the tx is a cenotaph
+	if IsCenotaph(unsignedTx) {
+		return nil, nil, nil, nil, ErrCenotaph
+	}
+
 	if runesChangeUTXO != nil {
 		runesChangeUTXO.Txid = unsignedTx.TxHash().String()
 	}
@@ -228,11 +249,13 @@ func BuildRunesPsbt(utxos []*UTXO, paymentUTXOIterator UTXOIterator, recipient s
 	for i, utxo := range utxos {
 		p.Inputs[i].SighashType = DefaultSigHashType
 		p.Inputs[i].WitnessUtxo = wire.NewTxOut(int64(utxo.Amount), utxo.PubKeyScript)
+		PopulatePsbtInputScripts(&p.Inputs[i], utxo)
 	}
 
 	for i, utxo := range selectedUTXOs {
 		p.Inputs[i+len(utxos)].SighashType = DefaultSigHashType
 		p.Inputs[i+len(utxos)].WitnessUtxo = wire.NewTxOut(int64(utxo.Amount), utxo.PubKeyScript)
+		PopulatePsbtInputScripts(&p.Inputs[i+len(utxos)], utxo)
 	}
 
 	return p, selectedUTXOs, changeUTXO, runesChangeUTXO, nil
@@ -240,7 +263,7 @@ func BuildRunesPsbt(utxos []*UTXO, paymentUTXOIterator UTXOIterator, recipient s
 
 // BuildTransferAllRunesPsbt builds a bitcoin psbt to transfer all specified runes.
 // Assume that the utxo script type is witness.
-func BuildTransferAllRunesPsbt(utxos []*UTXO, paymentUTXOIterator UTXOIterator, recipient string, runeBalancesDelta []*RuneBalance, feeRate int64, btcChange string, maxUTXONum int) (*psbt.Packet, []*UTXO, *UTXO, *UTXO, error) {
+func BuildTransferAllRunesPsbt(utxos []*UTXO, paymentUTXOIterator UTXOIterator, recipient string, runeBalancesDelta []*RuneBalance, feeRate int64, btcChange string, maxUTXONum int, selector CoinSelector) (*psbt.Packet, []*UTXO, *UTXO, *UTXO, error) {
 	chaincfg := sdk.GetConfig().GetBtcChainCfg()
 
 	recipientAddr, err := btcutil.DecodeAddress(recipient, chaincfg)
@@ -266,11 +289,16 @@ func BuildTransferAllRunesPsbt(utxos []*UTXO, paymentUTXOIterator UTXOIterator,
 	// allocate the remaining runes to the first non-OP_RETURN output by default
 	txOuts = append(txOuts, wire.NewTxOut(RunesOutValue, recipientPkScript))
 
-	unsignedTx, selectedUTXOs, changeUTXO, err := BuildUnsignedTransaction(utxos, txOuts, paymentUTXOIterator, feeRate, changeAddr, maxUTXONum)
+	unsignedTx, selectedUTXOs, changeUTXO, err := BuildUnsignedTransaction(utxos, txOuts, paymentUTXOIterator, feeRate, changeAddr, maxUTXONum, selector)
 	if err != nil {
 		return nil, nil, nil, nil, err
 	}
 
+	// the runes would be burned if the tx is a cenotaph
+	if IsCenotaph(unsignedTx) {
+		return nil, nil, nil, nil, ErrCenotaph
+	}
+
 	p, err := psbt.NewFromUnsignedTx(unsignedTx)
 	if err != nil {
 		return nil, nil, nil, nil, err
@@ -282,18 +310,145 @@ func BuildTransferAllRunesPsbt(utxos []*UTXO, paymentUTXOIterator UTXOIterator,
 	for i, utxo := range utxos {
 		p.Inputs[i].SighashType = DefaultSigHashType
 		p.Inputs[i].WitnessUtxo = wire.NewTxOut(int64(utxo.Amount), utxo.PubKeyScript)
+		PopulatePsbtInputScripts(&p.Inputs[i], utxo)
 	}
 
 	for i, utxo := range selectedUTXOs {
 		p.Inputs[i+len(utxos)].SighashType = DefaultSigHashType
 		p.Inputs[i+len(utxos)].WitnessUtxo = wire.NewTxOut(int64(utxo.Amount), utxo.PubKeyScript)
+		PopulatePsbtInputScripts(&p.Inputs[i+len(utxos)], utxo)
 	}
 
 	return p, selectedUTXOs, changeUTXO, runesRecipientUTXO, nil
 }
 
+// BuildBrc20TransferPsbts builds the bitcoin psbts to inscribe and transfer the brc20 transfer inscription to the recipient.
+// The following psbts are built in order:
+// 1. commit psbt which pays the inscription commitment output from the payment utxos
+// 2. reveal psbt which reveals the inscription to the brc20 vault by the script path
+// 3. transfer psbt which sends the inscription to the recipient
+// The network fees of the reveal and transfer txs are prepaid by the commit tx.
+// Assume that the utxo script type is witness.
+func BuildBrc20TransferPsbts(paymentUTXOIterator UTXOIterator, recipient string, transfer *Brc20Transfer, feeRate int64, vaultPubKey *secp256k1.PublicKey, vault string, change string, maxUTXONum int, selector CoinSelector) ([]*psbt.Packet, []*UTXO, *UTXO, error) {
+	chaincfg := sdk.GetConfig().GetBtcChainCfg()
+
+	recipientAddr, err := btcutil.DecodeAddress(recipient, chaincfg)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	recipientPkScript, err := txscript.PayToAddrScript(recipientAddr)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	vaultAddr, err := btcutil.DecodeAddress(vault, chaincfg)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	vaultPkScript, err := txscript.PayToAddrScript(vaultAddr)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	changeAddr, err := btcutil.DecodeAddress(change, chaincfg)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	// build the inscription commitment
+	inscriptionScript, err := BuildBrc20TransferScript(schnorr.SerializePubKey(vaultPubKey), transfer)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	tapLeaf := txscript.NewBaseTapLeaf(inscriptionScript)
+	tapScriptTree := txscript.AssembleTaprootScriptTree(tapLeaf)
+	tapScriptRoot := tapScriptTree.RootNode.TapHash()
+
+	commitmentPkScript, err := txscript.PayToTaprootScript(txscript.ComputeTaprootOutputKey(vaultPubKey, tapScriptRoot[:]))
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	controlBlock := tapScriptTree.LeafMerkleProofs[0].ToControlBlock(vaultPubKey)
+
+	controlBlockBytes, err := controlBlock.ToBytes()
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	// build the transfer tx with the placeholder input to estimate the network fee
+	transferTx := wire.NewMsgTx(TxVersion)
+	transferTx.AddTxIn(wire.NewTxIn(&wire.OutPoint{}, nil, nil))
+	transferTx.AddTxOut(wire.NewTxOut(Brc20OutValue, recipientPkScript))
+
+	transferTx.TxIn[0].Sequence = MagicSequence
+
+	transferFee := GetTxVirtualSize(transferTx, []*UTXO{{PubKeyScript: vaultPkScript}}) * feeRate
+
+	// build the reveal tx with the placeholder input to estimate the network fee
+	revealTx := wire.NewMsgTx(TxVersion)
+	revealTx.AddTxIn(wire.NewTxIn(&wire.OutPoint{}, nil, nil))
+	revealTx.AddTxOut(wire.NewTxOut(Brc20OutValue+transferFee, vaultPkScript))
+
+	revealTx.TxIn[0].Sequence = MagicSequence
+
+	revealFee := GetTapscriptTxVirtualSize(revealTx, inscriptionScript, controlBlockBytes) * feeRate
+
+	// build the commit tx
+	commitOut := wire.NewTxOut(Brc20OutValue+transferFee+revealFee, commitmentPkScript)
+
+	commitTx, selectedUTXOs, changeUTXO, err := BuildUnsignedTransaction([]*UTXO{}, []*wire.TxOut{commitOut}, paymentUTXOIterator, feeRate, changeAddr, maxUTXONum, selector)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	// chain the txs
+	revealTx.TxIn[0].PreviousOutPoint = *wire.NewOutPoint(GetTxHash(commitTx), 0)
+	transferTx.TxIn[0].PreviousOutPoint = *wire.NewOutPoint(GetTxHash(revealTx), 0)
+
+	commitPsbt, err := psbt.NewFromUnsignedTx(commitTx)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	for i, utxo := range selectedUTXOs {
+		commitPsbt.Inputs[i].SighashType = DefaultSigHashType
+		commitPsbt.Inputs[i].WitnessUtxo = wire.NewTxOut(int64(utxo.Amount), utxo.PubKeyScript)
+		PopulatePsbtInputScripts(&commitPsbt.Inputs[i], utxo)
+	}
+
+	revealPsbt, err := psbt.NewFromUnsignedTx(revealTx)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	revealPsbt.Inputs[0].SighashType = DefaultSigHashType
+	revealPsbt.Inputs[0].WitnessUtxo = commitOut
+	revealPsbt.Inputs[0].TaprootInternalKey = schnorr.SerializePubKey(vaultPubKey)
+	revealPsbt.Inputs[0].TaprootLeafScript = []*psbt.TaprootTapLeafScript{
+		{
+			ControlBlock: controlBlockBytes,
+			Script:       inscriptionScript,
+			LeafVersion:  txscript.BaseLeafVersion,
+		},
+	}
+
+	transferPsbt, err := psbt.NewFromUnsignedTx(transferTx)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	transferPsbt.Inputs[0].SighashType = DefaultSigHashType
+	transferPsbt.Inputs[0].WitnessUtxo = revealTx.TxOut[0]
+
+	return []*psbt.Packet{commitPsbt, revealPsbt, transferPsbt}, selectedUTXOs, changeUTXO, nil
+}
+
 // BuildUnsignedTransaction builds an unsigned tx from the given params.
-func BuildUnsignedTransaction(utxos []*UTXO, txOuts []*wire.TxOut, paymentUTXOIterator UTXOIterator, feeRate int64, change btcutil.Address, maxUTXONum int) (*wire.MsgTx, []*UTXO, *UTXO, error) {
+func BuildUnsignedTransaction(utxos []*UTXO, txOuts []*wire.TxOut, paymentUTXOIterator UTXOIterator, feeRate int64, change btcutil.Address, maxUTXONum int, selector CoinSelector) (*wire.MsgTx, []*UTXO, *UTXO, error) {
 	tx := wire.NewMsgTx(TxVersion)
 
 	inAmount := int64(0)
@@ -320,7 +475,7 @@ func BuildUnsignedTransaction(utxos []*UTXO, txOuts []*wire.TxOut, paymentUTXOIt
 
 	changeOut := wire.NewTxOut(0, changePkScript)
 
-	selectedUTXOs, err := AddPaymentUTXOsToTx(tx, utxos, inAmount-outAmount, paymentUTXOIterator, changeOut, feeRate, maxUTXONum)
+	selectedUTXOs, err := selector.SelectUTXOs(tx, utxos, inAmount-outAmount, paymentUTXOIterator, changeOut, feeRate, maxUTXONum)
 	if err != nil {
 		return nil, nil, nil, err
 	}
@@ -462,6 +617,274 @@ func AddPaymentUTXOToTx(tx *wire.MsgTx, utxos []*UTXO, utxo *UTXO, inOutDiff int
 	return false, nil
 }
 
+// CoinSelector defines the interface to select the payment utxos
+type CoinSelector interface {
+	// SelectUTXOs selects the payment utxos and adds them to the tx along with the change output if any
+	SelectUTXOs(tx *wire.MsgTx, utxos []*UTXO, inOutDiff int64, paymentUTXOIterator UTXOIterator, changeOut *wire.TxOut, feeRate int64, maxUTXONum int) ([]*UTXO, error)
+}
+
+// NewCoinSelector creates a coin selector by the given strategy
+func NewCoinSelector(strategy CoinSelectionStrategy) CoinSelector {
+	switch strategy {
+	case CoinSelectionStrategy_COIN_SELECTION_STRATEGY_LARGEST_FIRST:
+		return LargestFirstCoinSelector{}
+
+	case CoinSelectionStrategy_COIN_SELECTION_STRATEGY_BRANCH_AND_BOUND:
+		return BranchAndBoundCoinSelector{}
+
+	case CoinSelectionStrategy_COIN_SELECTION_STRATEGY_PRIVACY:
+		return PrivacyCoinSelector{}
+
+	default:
+		return DefaultCoinSelector{}
+	}
+}
+
+// DefaultCoinSelector selects the minimum utxo first and then the largest ones
+type DefaultCoinSelector struct{}
+
+// SelectUTXOs implements CoinSelector
+func (s DefaultCoinSelector) SelectUTXOs(tx *wire.MsgTx, utxos []*UTXO, inOutDiff int64, paymentUTXOIterator UTXOIterator, changeOut *wire.TxOut, feeRate int64, maxUTXONum int) ([]*UTXO, error) {
+	return AddPaymentUTXOsToTx(tx, utxos, inOutDiff, paymentUTXOIterator, changeOut, feeRate, maxUTXONum)
+}
+
+// LargestFirstCoinSelector selects the largest utxos first, which minimizes the number of inputs
+type LargestFirstCoinSelector struct{}
+
+// SelectUTXOs implements CoinSelector
+func (s LargestFirstCoinSelector) SelectUTXOs(tx *wire.MsgTx, utxos []*UTXO, inOutDiff int64, paymentUTXOIterator UTXOIterator, changeOut *wire.TxOut, feeRate int64, maxUTXONum int) ([]*UTXO, error) {
+	defer paymentUTXOIterator.Close()
+
+	selectedUTXOs := []*UTXO{}
+
+	addUTXO := func(utxo *UTXO) (bool, error) {
+		selectedUTXOs = append(selectedUTXOs, utxo)
+
+		ok, err := AddPaymentUTXOToTx(tx, utxos, utxo, inOutDiff, changeOut, feeRate, maxUTXONum)
+		if err != nil || ok {
+			return ok, err
+		}
+
+		utxos = append(utxos, utxo)
+		inOutDiff += int64(utxo.Amount)
+
+		return false, nil
+	}
+
+	for ; paymentUTXOIterator.Valid(); paymentUTXOIterator.Next() {
+		ok, err := addUTXO(paymentUTXOIterator.GetUTXO())
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			return selectedUTXOs, nil
+		}
+	}
+
+	// the minimum utxo is excluded by the iterator and taken last
+	if minUTXO := paymentUTXOIterator.GetMinimumUTXO(); minUTXO != nil {
+		ok, err := addUTXO(minUTXO)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			return selectedUTXOs, nil
+		}
+	}
+
+	return nil, ErrInsufficientUTXOs
+}
+
+// BranchAndBoundCoinSelector searches for the selection without the change output, of which the excess is less than the cost of the change
+// Fall back to the largest first if no such selection is found
+type BranchAndBoundCoinSelector struct{}
+
+// SelectUTXOs implements CoinSelector
+func (s BranchAndBoundCoinSelector) SelectUTXOs(tx *wire.MsgTx, utxos []*UTXO, inOutDiff int64, paymentUTXOIterator UTXOIterator, changeOut *wire.TxOut, feeRate int64, maxUTXONum int) ([]*UTXO, error) {
+	candidates := collectPaymentUTXOs(paymentUTXOIterator)
+	if len(candidates) == 0 {
+		return nil, ErrInsufficientUTXOs
+	}
+
+	if selectedUTXOs := selectChangelessUTXOs(tx, utxos, inOutDiff, candidates, changeOut, feeRate, maxUTXONum); selectedUTXOs != nil {
+		for _, utxo := range selectedUTXOs {
+			AddUTXOToTx(tx, utxo)
+		}
+
+		return selectedUTXOs, nil
+	}
+
+	return LargestFirstCoinSelector{}.SelectUTXOs(tx, utxos, inOutDiff, NewUTXOSliceIterator(candidates), changeOut, feeRate, maxUTXONum)
+}
+
+// PrivacyCoinSelector avoids the change output and merging utxos in a fee-aware manner
+// The changeless selection is preferred, then the smallest single utxo covering the outputs and fee, and finally the largest first
+type PrivacyCoinSelector struct{}
+
+// SelectUTXOs implements CoinSelector
+func (s PrivacyCoinSelector) SelectUTXOs(tx *wire.MsgTx, utxos []*UTXO, inOutDiff int64, paymentUTXOIterator UTXOIterator, changeOut *wire.TxOut, feeRate int64, maxUTXONum int) ([]*UTXO, error) {
+	candidates := collectPaymentUTXOs(paymentUTXOIterator)
+	if len(candidates) == 0 {
+		return nil, ErrInsufficientUTXOs
+	}
+
+	if selectedUTXOs := selectChangelessUTXOs(tx, utxos, inOutDiff, candidates, changeOut, feeRate, maxUTXONum); selectedUTXOs != nil {
+		for _, utxo := range selectedUTXOs {
+			AddUTXOToTx(tx, utxo)
+		}
+
+		return selectedUTXOs, nil
+	}
+
+	// candidates are in descending order
+	for i := len(candidates) - 1; i >= 0; i-- {
+		candidateTx := tx.Copy()
+
+		ok, err := AddPaymentUTXOToTx(candidateTx, utxos, candidates[i], inOutDiff, changeOut, feeRate, maxUTXONum)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			*tx = *candidateTx
+			return []*UTXO{candidates[i]}, nil
+		}
+	}
+
+	return LargestFirstCoinSelector{}.SelectUTXOs(tx, utxos, inOutDiff, NewUTXOSliceIterator(candidates), changeOut, feeRate, maxUTXONum)
+}
+
+// selectChangelessUTXOs searches for the payment utxos without the change output by branch and bound
+// Nil is returned if not found
+func selectChangelessUTXOs(tx *wire.MsgTx, utxos []*UTXO, inOutDiff int64, candidates []*UTXO, changeOut *wire.TxOut, feeRate int64, maxUTXONum int) []*UTXO {
+	maxNum := maxUTXONum - len(utxos)
+	if maxNum <= 0 {
+		return nil
+	}
+
+	baseVSize := GetTxVirtualSize(tx, utxos)
+	target := baseVSize*feeRate - inOutDiff
+
+	type effectiveUTXO struct {
+		utxo  *UTXO
+		value int64
+	}
+
+	effectiveUTXOs := make([]effectiveUTXO, 0, len(candidates))
+	minInputVSize := int64(0)
+
+	for _, utxo := range candidates {
+		candidateTx := tx.Copy()
+		AddUTXOToTx(candidateTx, utxo)
+
+		inputVSize := GetTxVirtualSize(candidateTx, append(append([]*UTXO{}, utxos...), utxo)) - baseVSize
+		if minInputVSize == 0 || inputVSize < minInputVSize {
+			minInputVSize = inputVSize
+		}
+
+		if value := int64(utxo.Amount) - inputVSize*feeRate; value > 0 {
+			effectiveUTXOs = append(effectiveUTXOs, effectiveUTXO{utxo, value})
+		}
+	}
+
+	sort.SliceStable(effectiveUTXOs, func(i, j int) bool {
+		return effectiveUTXOs[i].value > effectiveUTXOs[j].value
+	})
+
+	// cost to create and spend the change output
+	costOfChange := (int64(changeOut.SerializeSize()) + minInputVSize) * feeRate
+
+	remaining := make([]int64, len(effectiveUTXOs)+1)
+	for i := len(effectiveUTXOs) - 1; i >= 0; i-- {
+		remaining[i] = remaining[i+1] + effectiveUTXOs[i].value
+	}
+
+	var best []int
+	bestExcess := costOfChange + 1
+	tries := 0
+
+	var search func(index int, selected []int, value int64)
+	search = func(index int, selected []int, value int64) {
+		if tries >= MaxBranchAndBoundTries || bestExcess == 0 {
+			return
+		}
+
+		tries++
+
+		if len(selected) > 0 && value >= target {
+			if excess := value - target; excess < bestExcess {
+				best = append([]int{}, selected...)
+				bestExcess = excess
+			}
+
+			return
+		}
+
+		if index == len(effectiveUTXOs) || len(selected) == maxNum || value+remaining[index] < target {
+			return
+		}
+
+		// branch with the current utxo if the excess is bounded
+		if value+effectiveUTXOs[index].value <= target+costOfChange {
+			search(index+1, append(selected, index), value+effectiveUTXOs[index].value)
+		}
+
+		search(index+1, selected, value)
+	}
+
+	search(0, []int{}, 0)
+
+	if best == nil {
+		return nil
+	}
+
+	selectedUTXOs := make([]*UTXO, 0, len(best))
+	amount := int64(0)
+
+	candidateTx := tx.Copy()
+	for _, i := range best {
+		selectedUTXOs = append(selectedUTXOs, effectiveUTXOs[i].utxo)
+		amount += int64(effectiveUTXOs[i].utxo.Amount)
+
+		AddUTXOToTx(candidateTx, effectiveUTXOs[i].utxo)
+	}
+
+	// check against the actual fee
+	fee := GetTxVirtualSize(candidateTx, append(append([]*UTXO{}, utxos...), selectedUTXOs...)) * feeRate
+	if amount+inOutDiff < fee {
+		return nil
+	}
+
+	return selectedUTXOs
+}
+
+// collectPaymentUTXOs collects the candidate payment utxos from the given iterator in descending order by amount
+func collectPaymentUTXOs(paymentUTXOIterator UTXOIterator) []*UTXO {
+	defer paymentUTXOIterator.Close()
+
+	utxos := []*UTXO{}
+
+	for ; paymentUTXOIterator.Valid() && len(utxos) < MaxCoinSelectionCandidates-1; paymentUTXOIterator.Next() {
+		utxos = append(utxos, paymentUTXOIterator.GetUTXO())
+	}
+
+	if minUTXO := paymentUTXOIterator.GetMinimumUTXO(); minUTXO != nil && !containsUTXO(utxos, minUTXO) {
+		utxos = append(utxos, minUTXO)
+	}
+
+	return utxos
+}
+
+// containsUTXO returns true if the given utxo is included in the utxos, false otherwise
+func containsUTXO(utxos []*UTXO, utxo *UTXO) bool {
+	for _, u := range utxos {
+		if u.Txid == utxo.Txid && u.Vout == utxo.Vout {
+			return true
+		}
+	}
+
+	return false
+}
+
 // AddUTXOToTx adds the given utxo to the specified tx
 // Make sure the utxo is valid
 func AddUTXOToTx(tx *wire.MsgTx, utxo *UTXO) {
@@ -510,6 +933,27 @@ func GetTxVirtualSize(tx *wire.MsgTx, utxos []*UTXO) int64 {
 	return mempool.GetTxVirtualSize(btcutil.NewTx(newTx))
 }
 
+// GetTapscriptTxVirtualSize gets the virtual size of the given tx of which the inputs are spent by the given tapscript.
+func GetTapscriptTxVirtualSize(tx *wire.MsgTx, script []byte, controlBlock []byte) int64 {
+	newTx := tx.Copy()
+
+	for _, txIn := range newTx.TxIn {
+		// maximum size when the sig hash is not SigHashDefault
+		dummySig := make([]byte, 65)
+
+		txIn.Witness = wire.TxWitness{dummySig, script, controlBlock}
+	}
+
+	return mempool.GetTxVirtualSize(btcutil.NewTx(newTx))
+}
+
+// GetTxHash gets the hash of the given tx
+func GetTxHash(tx *wire.MsgTx) *chainhash.Hash {
+	hash := tx.TxHash()
+
+	return &hash
+}
+
 // CheckTransactionWeight checks if the weight of the given tx exceeds the allowed maximum weight
 func CheckTransactionWeight(tx *wire.MsgTx, utxos []*UTXO) error {
 	newTx := PopulateTxWithDummyWitness(tx, utxos)
@@ -533,6 +977,11 @@ func PopulateTxWithDummyWitness(tx *wire.MsgTx, utxos []*UTXO) *wire.MsgTx {
 	newTx := tx.Copy()
 
 	for i, txIn := range newTx.TxIn {
+		if IsScriptHashUTXO(utxos[i]) {
+			populateTxInWithDummyMultisigWitness(txIn, utxos[i])
+			continue
+		}
+
 		var dummyWitness []byte
 
 		switch txscript.GetScriptClass(utxos[i].PubKeyScript) {
@@ -552,6 +1001,28 @@ func PopulateTxWithDummyWitness(tx *wire.MsgTx, utxos []*UTXO) *wire.MsgTx {
 	return newTx
 }
 
+// populateTxInWithDummyMultisigWitness populates the given tx input with the dummy multisig witness of the script hash utxo
+// The signature script is populated with the redeem script for the P2SH-wrapped utxo
+func populateTxInWithDummyMultisigWitness(txIn *wire.TxIn, utxo *UTXO) {
+	_, numSigs, err := txscript.CalcMultiSigStats(utxo.WitnessScript)
+	if err != nil {
+		numSigs = 0
+	}
+
+	// the leading empty element is required by OP_CHECKMULTISIG
+	witness := wire.TxWitness{[]byte{}}
+	for i := 0; i < numSigs; i++ {
+		// maximum DER signature size with the sig hash type
+		witness = append(witness, make([]byte, 73))
+	}
+
+	txIn.Witness = append(witness, utxo.WitnessScript)
+
+	if txscript.IsPayToScriptHash(utxo.PubKeyScript) {
+		txIn.SignatureScript = append([]byte{txscript.OP_DATA_34}, NestedRedeemScript(utxo.WitnessScript)...)
+	}
+}
+
 // PopulateTxWithDummyTaprootWitness populates the given tx with the dummy taproot witness
 func PopulateTxWithDummyTaprootWitness(tx *wire.MsgTx) *wire.MsgTx {
 	newTx := tx.Copy()
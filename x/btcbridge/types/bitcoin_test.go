@@ -0,0 +1,168 @@
+package types_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sideprotocol/side/x/btcbridge/types"
+)
+
+var coinSelectionStrategies = []types.CoinSelectionStrategy{
+	types.CoinSelectionStrategy_COIN_SELECTION_STRATEGY_DEFAULT,
+	types.CoinSelectionStrategy_COIN_SELECTION_STRATEGY_LARGEST_FIRST,
+	types.CoinSelectionStrategy_COIN_SELECTION_STRATEGY_BRANCH_AND_BOUND,
+	types.CoinSelectionStrategy_COIN_SELECTION_STRATEGY_PRIVACY,
+}
+
+type coinSelectionScenario struct {
+	name    string
+	utxos   []int64
+	amount  int64
+	feeRate int64
+}
+
+var coinSelectionScenarios = []coinSelectionScenario{
+	{"changeless match", []int64{1000000, 500000, 200000, 50000}, 199850, 1},
+	{"no changeless match", []int64{1000000, 500000, 200000, 50000}, 300000, 10},
+	{"merge required", []int64{100000, 80000, 60000, 40000}, 150000, 5},
+}
+
+func TestCoinSelection(t *testing.T) {
+	type outcome struct {
+		inputs    []int64
+		hasChange bool
+	}
+
+	expected := map[string]map[types.CoinSelectionStrategy]outcome{
+		"changeless match": {
+			types.CoinSelectionStrategy_COIN_SELECTION_STRATEGY_DEFAULT:          {[]int64{50000, 1000000}, true},
+			types.CoinSelectionStrategy_COIN_SELECTION_STRATEGY_LARGEST_FIRST:    {[]int64{1000000}, true},
+			types.CoinSelectionStrategy_COIN_SELECTION_STRATEGY_BRANCH_AND_BOUND: {[]int64{200000}, false},
+			types.CoinSelectionStrategy_COIN_SELECTION_STRATEGY_PRIVACY:          {[]int64{200000}, false},
+		},
+		"no changeless match": {
+			types.CoinSelectionStrategy_COIN_SELECTION_STRATEGY_DEFAULT:          {[]int64{50000, 1000000}, true},
+			types.CoinSelectionStrategy_COIN_SELECTION_STRATEGY_LARGEST_FIRST:    {[]int64{1000000}, true},
+			types.CoinSelectionStrategy_COIN_SELECTION_STRATEGY_BRANCH_AND_BOUND: {[]int64{1000000}, true},
+			types.CoinSelectionStrategy_COIN_SELECTION_STRATEGY_PRIVACY:          {[]int64{500000}, true},
+		},
+		"merge required": {
+			types.CoinSelectionStrategy_COIN_SELECTION_STRATEGY_DEFAULT:          {[]int64{40000, 100000, 80000}, true},
+			types.CoinSelectionStrategy_COIN_SELECTION_STRATEGY_LARGEST_FIRST:    {[]int64{100000, 80000}, true},
+			types.CoinSelectionStrategy_COIN_SELECTION_STRATEGY_BRANCH_AND_BOUND: {[]int64{100000, 80000}, true},
+			types.CoinSelectionStrategy_COIN_SELECTION_STRATEGY_PRIVACY:          {[]int64{100000, 80000}, true},
+		},
+	}
+
+	for _, scenario := range coinSelectionScenarios {
+		fees := map[types.CoinSelectionStrategy]int64{}
+
+		for _, strategy := range coinSelectionStrategies {
+			t.Run(fmt.Sprintf("%s/%s", scenario.name, strategy), func(t *testing.T) {
+				fee, selectedUTXOs, changeUTXO, err := buildPsbtWithCoinSelection(scenario, strategy)
+				require.NoError(t, err)
+
+				inputs := []int64{}
+				for _, utxo := range selectedUTXOs {
+					inputs = append(inputs, int64(utxo.Amount))
+				}
+
+				require.Equal(t, expected[scenario.name][strategy].inputs, inputs)
+				require.Equal(t, expected[scenario.name][strategy].hasChange, changeUTXO != nil)
+				require.Positive(t, fee)
+
+				fees[strategy] = fee
+			})
+		}
+
+		// the strategies other than the default never pay more fee
+		for _, strategy := range coinSelectionStrategies[1:] {
+			require.LessOrEqual(t, fees[strategy], fees[types.CoinSelectionStrategy_COIN_SELECTION_STRATEGY_DEFAULT], scenario.name)
+		}
+	}
+}
+
+func TestCoinSelectionInsufficientUTXOs(t *testing.T) {
+	scenario := coinSelectionScenario{"insufficient", []int64{100000, 50000}, 200000, 1}
+
+	for _, strategy := range coinSelectionStrategies {
+		_, _, _, err := buildPsbtWithCoinSelection(scenario, strategy)
+		require.ErrorIs(t, err, types.ErrInsufficientUTXOs, strategy.String())
+	}
+}
+
+func BenchmarkCoinSelection(b *testing.B) {
+	for _, scenario := range coinSelectionScenarios {
+		for _, strategy := range coinSelectionStrategies {
+			b.Run(fmt.Sprintf("%s/%s", scenario.name, strategy), func(b *testing.B) {
+				var fee int64
+				var changeUTXO *types.UTXO
+
+				for i := 0; i < b.N; i++ {
+					var err error
+					fee, _, changeUTXO, err = buildPsbtWithCoinSelection(scenario, strategy)
+					require.NoError(b, err)
+				}
+
+				change := int64(0)
+				if changeUTXO != nil {
+					change = int64(changeUTXO.Amount)
+				}
+
+				b.ReportMetric(float64(fee), "fee-sat")
+				b.ReportMetric(float64(change), "change-sat")
+			})
+		}
+	}
+}
+
+// buildPsbtWithCoinSelection builds the psbt for the given scenario and returns the fee, selected utxos and change utxo
+func buildPsbtWithCoinSelection(scenario coinSelectionScenario, strategy types.CoinSelectionStrategy) (int64, []*types.UTXO, *types.UTXO, error) {
+	vault := testTaprootAddress(1)
+
+	utxos := make([]*types.UTXO, 0, len(scenario.utxos))
+	for i, amount := range scenario.utxos {
+		utxos = append(utxos, &types.UTXO{
+			Txid:         chainhash.DoubleHashH([]byte{byte(i)}).String(),
+			Vout:         uint64(i),
+			Address:      vault,
+			Amount:       uint64(amount),
+			PubKeyScript: types.MustPkScriptFromAddress(vault),
+		})
+	}
+
+	p, selectedUTXOs, changeUTXO, err := types.BuildPsbt(types.NewUTXOSliceIterator(utxos), testTaprootAddress(2), scenario.amount, scenario.feeRate, vault, 100, types.NewCoinSelector(strategy))
+	if err != nil {
+		return 0, nil, nil, err
+	}
+
+	fee := int64(0)
+	for _, utxo := range selectedUTXOs {
+		fee += int64(utxo.Amount)
+	}
+
+	for _, out := range p.UnsignedTx.TxOut {
+		fee -= out.Value
+	}
+
+	return fee, selectedUTXOs, changeUTXO, nil
+}
+
+func testTaprootAddress(seed byte) string {
+	key := make([]byte, 32)
+	key[31] = seed
+
+	address, err := btcutil.NewAddressTaproot(key, sdk.GetConfig().GetBtcChainCfg())
+	if err != nil {
+		panic(err)
+	}
+
+	return address.EncodeAddress()
+}
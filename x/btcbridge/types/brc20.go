@@ -0,0 +1,346 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// brc20 protocol name
+	Brc20ProtocolName = "brc20"
+
+	// brc20 protocol identifier in the inscription content
+	Brc20ProtocolId = "brc-20"
+
+	// brc20 transfer operation
+	Brc20OpTransfer = "transfer"
+
+	// decimals of the brc20 voucher token, i.e. the maximum decimals allowed by the brc20 protocol
+	Brc20Decimals = 18
+
+	// sats in the brc20 inscription output by default
+	Brc20OutValue = 546
+
+	// content type of the brc20 inscription built by the bridge
+	Brc20ContentType = "text/plain;charset=utf-8"
+
+	// ord protocol identifier in the inscription envelope
+	InscriptionProtocolId = "ord"
+
+	// tag indicating that the following is the content type
+	InscriptionTagContentType = 1
+)
+
+var (
+	// brc20 amount pattern, i.e. non-negative decimal without sign and exponent
+	brc20AmountPattern = regexp.MustCompile(`^[0-9]+(\.[0-9]+)?$`)
+)
+
+// Brc20Transfer defines the content of the brc20 transfer inscription
+type Brc20Transfer struct {
+	P    string `json:"p"`
+	Op   string `json:"op"`
+	Tick string `json:"tick"`
+	Amt  string `json:"amt"`
+}
+
+// NewBrc20Transfer creates a new brc20 transfer with the given ticker and amount
+func NewBrc20Transfer(tick string, amount sdkmath.Int) *Brc20Transfer {
+	return &Brc20Transfer{
+		P:    Brc20ProtocolId,
+		Op:   Brc20OpTransfer,
+		Tick: tick,
+		Amt:  FormatBrc20Amount(amount),
+	}
+}
+
+// Validate validates the brc20 transfer
+func (t *Brc20Transfer) Validate() error {
+	if t.P != Brc20ProtocolId || t.Op != Brc20OpTransfer {
+		return ErrInvalidBrc20
+	}
+
+	if err := ValidateBrc20Tick(t.Tick); err != nil {
+		return err
+	}
+
+	amount, err := ParseBrc20Amount(t.Amt)
+	if err != nil {
+		return err
+	}
+
+	if !amount.IsPositive() {
+		return ErrInvalidBrc20
+	}
+
+	return nil
+}
+
+// Denom returns the voucher denom of the brc20 transfer
+func (t *Brc20Transfer) Denom() string {
+	return Brc20Denom(t.Tick)
+}
+
+// Amount returns the voucher amount of the brc20 transfer
+// Assume that the brc20 transfer is valid
+func (t *Brc20Transfer) Amount() sdkmath.Int {
+	amount, _ := ParseBrc20Amount(t.Amt)
+
+	return amount
+}
+
+// Brc20Denom returns the corresponding denom for the brc20 voucher token
+// Note: the brc20 ticker is case insensitive
+func Brc20Denom(tick string) string {
+	return fmt.Sprintf("%s/%s", Brc20ProtocolName, strings.ToLower(tick))
+}
+
+// Brc20TickFromDenom returns the brc20 ticker from the given denom
+func Brc20TickFromDenom(denom string) string {
+	return strings.TrimPrefix(denom, fmt.Sprintf("%s/", Brc20ProtocolName))
+}
+
+// ValidateBrc20Tick validates the given brc20 ticker
+func ValidateBrc20Tick(tick string) error {
+	if len(tick) != 4 && len(tick) != 5 {
+		return ErrInvalidBrc20Tick
+	}
+
+	if err := sdk.ValidateDenom(Brc20Denom(tick)); err != nil {
+		return ErrInvalidBrc20Tick
+	}
+
+	return nil
+}
+
+// ParseBrc20Amount parses the given brc20 amount to the voucher amount with 18 decimals
+func ParseBrc20Amount(amt string) (sdkmath.Int, error) {
+	if !brc20AmountPattern.MatchString(amt) {
+		return sdkmath.Int{}, ErrInvalidBrc20
+	}
+
+	amount, err := sdkmath.LegacyNewDecFromStr(amt)
+	if err != nil {
+		return sdkmath.Int{}, ErrInvalidBrc20
+	}
+
+	return sdkmath.NewIntFromBigInt(amount.BigInt()), nil
+}
+
+// FormatBrc20Amount formats the given voucher amount to the brc20 amount
+func FormatBrc20Amount(amount sdkmath.Int) string {
+	amt := sdkmath.LegacyNewDecFromBigIntWithPrec(amount.BigInt(), Brc20Decimals).String()
+
+	return strings.TrimSuffix(strings.TrimRight(amt, "0"), ".")
+}
+
+// Validate validates the brc20 metadata
+func (m *Brc20Metadata) Validate() error {
+	if err := ValidateBrc20Tick(m.Tick); err != nil {
+		return err
+	}
+
+	if m.Decimals > Brc20Decimals {
+		return errorsmod.Wrapf(ErrInvalidBrc20Metadata, "decimals can not be greater than %d", Brc20Decimals)
+	}
+
+	return nil
+}
+
+// CheckAmount checks if the given voucher amount is allowed by the decimals of the brc20 ticker
+// The amount with more precision than the decimals is invalid by the brc20 protocol
+func (m *Brc20Metadata) CheckAmount(amount sdkmath.Int) error {
+	unit := sdkmath.NewIntWithDecimal(1, int(Brc20Decimals-m.Decimals))
+	if !amount.Mod(unit).IsZero() {
+		return errorsmod.Wrapf(ErrInvalidBrc20Amount, "amount %s exceeds the precision of %d decimals", FormatBrc20Amount(amount), m.Decimals)
+	}
+
+	return nil
+}
+
+// Inscription defines the inscription parsed from the envelope
+type Inscription struct {
+	ContentType string
+	Body        []byte
+}
+
+// ParseInscription parses the inscription envelope from the given tapscript
+// If no envelope found, no error returned
+func ParseInscription(script []byte) (*Inscription, error) {
+	tokenizer := txscript.MakeScriptTokenizer(0, script)
+
+	// seek to the envelope header, i.e. OP_FALSE OP_IF "ord"
+	for {
+		if !tokenizer.Next() {
+			return nil, nil
+		}
+
+		if tokenizer.Opcode() != txscript.OP_FALSE {
+			continue
+		}
+
+		if !tokenizer.Next() || tokenizer.Opcode() != txscript.OP_IF {
+			continue
+		}
+
+		if !tokenizer.Next() || string(tokenizer.Data()) != InscriptionProtocolId {
+			continue
+		}
+
+		break
+	}
+
+	inscription := &Inscription{}
+
+	// parse fields
+	for {
+		if !tokenizer.Next() {
+			return nil, ErrInvalidInscription
+		}
+
+		if tokenizer.Opcode() == txscript.OP_ENDIF {
+			return inscription, nil
+		}
+
+		// the empty push indicates that the following is the body
+		if tokenizer.Opcode() == txscript.OP_0 {
+			break
+		}
+
+		tag, ok := inscriptionTag(tokenizer)
+		if !ok || !tokenizer.Next() || tokenizer.Opcode() > txscript.OP_PUSHDATA4 {
+			return nil, ErrInvalidInscription
+		}
+
+		if tag == InscriptionTagContentType {
+			inscription.ContentType = string(tokenizer.Data())
+		}
+	}
+
+	// parse body
+	for tokenizer.Next() {
+		if tokenizer.Opcode() == txscript.OP_ENDIF {
+			return inscription, nil
+		}
+
+		if tokenizer.Opcode() > txscript.OP_PUSHDATA4 {
+			return nil, ErrInvalidInscription
+		}
+
+		inscription.Body = append(inscription.Body, tokenizer.Data()...)
+	}
+
+	return nil, ErrInvalidInscription
+}
+
+// inscriptionTag gets the tag of the current field from the given tokenizer
+func inscriptionTag(tokenizer txscript.ScriptTokenizer) (int, bool) {
+	if txscript.IsSmallInt(tokenizer.Opcode()) {
+		return txscript.AsSmallInt(tokenizer.Opcode()), true
+	}
+
+	if len(tokenizer.Data()) == 1 {
+		return int(tokenizer.Data()[0]), true
+	}
+
+	return 0, false
+}
+
+// ParseBrc20Transfer parses the brc20 transfer from the witness of the inscription reveal input
+func ParseBrc20Transfer(witness wire.TxWitness) (*Brc20Transfer, error) {
+	script := tapscriptFromWitness(witness)
+	if script == nil {
+		return nil, ErrInvalidInscription
+	}
+
+	inscription, err := ParseInscription(script)
+	if err != nil {
+		return nil, err
+	}
+
+	if inscription == nil {
+		return nil, ErrInvalidInscription
+	}
+
+	if !strings.HasPrefix(inscription.ContentType, "text/plain") && !strings.HasPrefix(inscription.ContentType, "application/json") {
+		return nil, ErrInvalidBrc20
+	}
+
+	var transfer Brc20Transfer
+	if err := json.Unmarshal(inscription.Body, &transfer); err != nil {
+		return nil, ErrInvalidBrc20
+	}
+
+	if err := transfer.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &transfer, nil
+}
+
+// tapscriptFromWitness gets the tapscript from the given script path witness
+func tapscriptFromWitness(witness wire.TxWitness) []byte {
+	// remove the annex if any
+	if len(witness) >= 2 && len(witness[len(witness)-1]) > 0 && witness[len(witness)-1][0] == txscript.TaprootAnnexTag {
+		witness = witness[:len(witness)-1]
+	}
+
+	if len(witness) < 2 {
+		return nil
+	}
+
+	return witness[len(witness)-2]
+}
+
+// BuildBrc20TransferScript builds the tapscript to inscribe the brc20 transfer by the given x-only public key
+func BuildBrc20TransferScript(xOnlyPubKey []byte, transfer *Brc20Transfer) ([]byte, error) {
+	body, err := json.Marshal(transfer)
+	if err != nil {
+		return nil, err
+	}
+
+	scriptBuilder := txscript.NewScriptBuilder()
+	scriptBuilder.AddData(xOnlyPubKey).AddOp(txscript.OP_CHECKSIG)
+	scriptBuilder.AddOp(txscript.OP_FALSE).AddOp(txscript.OP_IF)
+	scriptBuilder.AddData([]byte(InscriptionProtocolId))
+	scriptBuilder.AddData([]byte{InscriptionTagContentType}).AddData([]byte(Brc20ContentType))
+	scriptBuilder.AddOp(txscript.OP_0).AddData(body)
+	scriptBuilder.AddOp(txscript.OP_ENDIF)
+
+	return scriptBuilder.Script()
+}
+
+// CheckBrc20DepositTransaction checks if the given tx is valid brc20 deposit tx.
+// The brc20 deposit tx is required to send the transfer inscription revealed by the previous tx to the brc20 vault directly,
+// that is, the first input spends the first output of the previous tx and the first output goes to the brc20 vault.
+// If the first output does not go to the brc20 vault, no error returned.
+func CheckBrc20DepositTransaction(tx *wire.MsgTx, prevTx *wire.MsgTx, vaults []*Vault) (*Brc20Transfer, error) {
+	if len(tx.TxOut) == 0 {
+		return nil, nil
+	}
+
+	vault := SelectVaultByPkScript(vaults, tx.TxOut[0].PkScript)
+	if vault == nil || vault.AssetType != AssetType_ASSET_TYPE_BRC20 {
+		return nil, nil
+	}
+
+	if prevTx == nil || len(prevTx.TxIn) == 0 || tx.TxIn[0].PreviousOutPoint.Index != 0 {
+		return nil, ErrInvalidDepositTransaction
+	}
+
+	transfer, err := ParseBrc20Transfer(prevTx.TxIn[0].Witness)
+	if err != nil {
+		return nil, ErrInvalidDepositTransaction
+	}
+
+	return transfer, nil
+}
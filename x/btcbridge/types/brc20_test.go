@@ -0,0 +1,223 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	secp256k1 "github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/btcutil/psbt"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/sideprotocol/side/x/btcbridge/types"
+)
+
+func TestParseBrc20Transfer(t *testing.T) {
+	xOnlyPubKey := make([]byte, 32)
+
+	buildScript := func(contentType string, body string) []byte {
+		scriptBuilder := txscript.NewScriptBuilder()
+		scriptBuilder.AddData(xOnlyPubKey).AddOp(txscript.OP_CHECKSIG)
+		scriptBuilder.AddOp(txscript.OP_FALSE).AddOp(txscript.OP_IF)
+		scriptBuilder.AddData([]byte(types.InscriptionProtocolId))
+		scriptBuilder.AddData([]byte{types.InscriptionTagContentType}).AddData([]byte(contentType))
+		scriptBuilder.AddOp(txscript.OP_0).AddData([]byte(body))
+		scriptBuilder.AddOp(txscript.OP_ENDIF)
+
+		script, err := scriptBuilder.Script()
+		require.NoError(t, err)
+
+		return script
+	}
+
+	validScript, err := types.BuildBrc20TransferScript(xOnlyPubKey, types.NewBrc20Transfer("ordi", sdkmath.NewIntWithDecimal(15, 17)))
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name       string
+		script     []byte
+		transfer   *types.Brc20Transfer
+		expectPass bool
+	}{
+		{
+			name:       "valid brc20 transfer built by the bridge",
+			script:     validScript,
+			transfer:   &types.Brc20Transfer{P: "brc-20", Op: "transfer", Tick: "ordi", Amt: "1.5"},
+			expectPass: true,
+		},
+		{
+			name:       "valid brc20 transfer with json content type",
+			script:     buildScript("application/json", `{"p":"brc-20","op":"transfer","tick":"SATS","amt":"1000"}`),
+			transfer:   &types.Brc20Transfer{P: "brc-20", Op: "transfer", Tick: "SATS", Amt: "1000"},
+			expectPass: true,
+		},
+		{
+			name:       "valid 5-byte ticker",
+			script:     buildScript("text/plain;charset=utf-8", `{"p":"brc-20","op":"transfer","tick":"pizza","amt":"0.000000000000000001"}`),
+			transfer:   &types.Brc20Transfer{P: "brc-20", Op: "transfer", Tick: "pizza", Amt: "0.000000000000000001"},
+			expectPass: true,
+		},
+		{
+			name:       "mint operation",
+			script:     buildScript("text/plain;charset=utf-8", `{"p":"brc-20","op":"mint","tick":"ordi","amt":"1000"}`),
+			expectPass: false,
+		},
+		{
+			name:       "invalid protocol",
+			script:     buildScript("text/plain;charset=utf-8", `{"p":"brc20","op":"transfer","tick":"ordi","amt":"1000"}`),
+			expectPass: false,
+		},
+		{
+			name:       "invalid ticker length",
+			script:     buildScript("text/plain;charset=utf-8", `{"p":"brc-20","op":"transfer","tick":"ord","amt":"1000"}`),
+			expectPass: false,
+		},
+		{
+			name:       "too many decimals",
+			script:     buildScript("text/plain;charset=utf-8", `{"p":"brc-20","op":"transfer","tick":"ordi","amt":"0.0000000000000000001"}`),
+			expectPass: false,
+		},
+		{
+			name:       "negative amount",
+			script:     buildScript("text/plain;charset=utf-8", `{"p":"brc-20","op":"transfer","tick":"ordi","amt":"-1"}`),
+			expectPass: false,
+		},
+		{
+			name:       "zero amount",
+			script:     buildScript("text/plain;charset=utf-8", `{"p":"brc-20","op":"transfer","tick":"ordi","amt":"0"}`),
+			expectPass: false,
+		},
+		{
+			name:       "unsupported content type",
+			script:     buildScript("image/png", `{"p":"brc-20","op":"transfer","tick":"ordi","amt":"1000"}`),
+			expectPass: false,
+		},
+		{
+			name:       "no envelope",
+			script:     append(append([]byte{txscript.OP_DATA_32}, xOnlyPubKey...), txscript.OP_CHECKSIG),
+			expectPass: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			witness := wire.TxWitness{make([]byte, 64), tc.script, make([]byte, txscript.ControlBlockBaseSize)}
+
+			transfer, err := types.ParseBrc20Transfer(witness)
+			if tc.expectPass {
+				require.NoError(t, err)
+				require.Equal(t, tc.transfer, transfer)
+			} else {
+				require.NotNil(t, err)
+			}
+		})
+	}
+}
+
+func TestBrc20Amount(t *testing.T) {
+	testCases := []struct {
+		amt    string
+		amount sdkmath.Int
+	}{
+		{"1", sdkmath.NewIntWithDecimal(1, types.Brc20Decimals)},
+		{"1.5", sdkmath.NewIntWithDecimal(15, types.Brc20Decimals-1)},
+		{"0.000000000000000001", sdkmath.NewInt(1)},
+		{"21000000", sdkmath.NewIntWithDecimal(21000000, types.Brc20Decimals)},
+	}
+
+	for _, tc := range testCases {
+		amount, err := types.ParseBrc20Amount(tc.amt)
+		require.NoError(t, err)
+		require.Equal(t, tc.amount, amount)
+
+		require.Equal(t, tc.amt, types.FormatBrc20Amount(amount))
+	}
+}
+
+func TestVerifyTapscriptSignature(t *testing.T) {
+	privKey, err := secp256k1.NewPrivateKey()
+	require.NoError(t, err)
+
+	pubKey := privKey.PubKey()
+
+	inscriptionScript, err := types.BuildBrc20TransferScript(schnorr.SerializePubKey(pubKey), types.NewBrc20Transfer("ordi", sdkmath.NewIntWithDecimal(1, types.Brc20Decimals)))
+	require.NoError(t, err)
+
+	tapLeaf := txscript.NewBaseTapLeaf(inscriptionScript)
+	tapScriptTree := txscript.AssembleTaprootScriptTree(tapLeaf)
+	tapScriptRoot := tapScriptTree.RootNode.TapHash()
+
+	commitmentPkScript, err := txscript.PayToTaprootScript(txscript.ComputeTaprootOutputKey(pubKey, tapScriptRoot[:]))
+	require.NoError(t, err)
+
+	controlBlock := tapScriptTree.LeafMerkleProofs[0].ToControlBlock(pubKey)
+	controlBlockBytes, err := controlBlock.ToBytes()
+	require.NoError(t, err)
+
+	prevOut := wire.NewTxOut(10000, commitmentPkScript)
+
+	revealTx := wire.NewMsgTx(types.TxVersion)
+	revealTx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&chainhash.Hash{}, 0), nil, nil))
+	revealTx.AddTxOut(wire.NewTxOut(types.Brc20OutValue, commitmentPkScript))
+
+	p, err := psbt.NewFromUnsignedTx(revealTx)
+	require.NoError(t, err)
+
+	p.Inputs[0].WitnessUtxo = prevOut
+	p.Inputs[0].TaprootLeafScript = []*psbt.TaprootTapLeafScript{{ControlBlock: controlBlockBytes, Script: inscriptionScript, LeafVersion: txscript.BaseLeafVersion}}
+
+	prevOutFetcher := txscript.NewCannedPrevOutputFetcher(prevOut.PkScript, prevOut.Value)
+
+	sig, err := txscript.RawTxInTapscriptSignature(revealTx, txscript.NewTxSigHashes(revealTx, prevOutFetcher), 0, prevOut.Value, prevOut.PkScript, tapLeaf, types.DefaultSigHashType, privKey)
+	require.NoError(t, err)
+
+	leafHash := tapLeaf.TapHash()
+	p.Inputs[0].TaprootScriptSpendSig = []*psbt.TaprootScriptSpendSig{{XOnlyPubKey: schnorr.SerializePubKey(pubKey), LeafHash: leafHash[:], Signature: sig, SigHash: types.DefaultSigHashType}}
+
+	require.NoError(t, psbt.Finalize(p, 0))
+	require.True(t, types.VerifyPsbtSignatures(p), "tapscript signature should be valid")
+
+	// tamper with the signature
+	tampered := *p
+	tampered.Inputs = []psbt.PInput{p.Inputs[0]}
+	tampered.Inputs[0].FinalScriptWitness = append([]byte{}, p.Inputs[0].FinalScriptWitness...)
+	tampered.Inputs[0].FinalScriptWitness[2] ^= 0x01
+
+	require.False(t, types.VerifyPsbtSignatures(&tampered), "tampered tapscript signature should be invalid")
+}
+
+func TestBrc20MetadataCheckAmount(t *testing.T) {
+	testCases := []struct {
+		name      string
+		decimals  uint32
+		amount    sdkmath.Int
+		expectErr bool
+	}{
+		{"18 decimals", 18, sdkmath.NewInt(1), false},
+		{"integer with 0 decimals", 0, sdkmath.NewIntWithDecimal(15, types.Brc20Decimals), false},
+		{"fraction with 0 decimals", 0, sdkmath.NewIntWithDecimal(15, types.Brc20Decimals-1), true},
+		{"fraction within 2 decimals", 2, sdkmath.NewIntWithDecimal(123, types.Brc20Decimals-2), false},
+		{"fraction exceeding 2 decimals", 2, sdkmath.NewIntWithDecimal(1234, types.Brc20Decimals-3), true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			metadata := types.Brc20Metadata{Tick: "ordi", Decimals: tc.decimals}
+			require.NoError(t, metadata.Validate())
+
+			err := metadata.CheckAmount(tc.amount)
+			if tc.expectErr {
+				require.ErrorIs(t, err, types.ErrInvalidBrc20Amount)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	require.Error(t, (&types.Brc20Metadata{Tick: "ordi", Decimals: 19}).Validate(), "decimals greater than 18 should be invalid")
+}
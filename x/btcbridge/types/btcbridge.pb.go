@@ -7,7 +7,8 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/codec/types"
-	_ "github.com/cosmos/cosmos-sdk/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
@@ -44,6 +45,8 @@ const (
 	SigningStatus_SIGNING_STATUS_CONFIRMED SigningStatus = 3
 	// SIGNING_STATUS_FAILED - The signing request failed to be signed or broadcast due to unexpected exceptions
 	SigningStatus_SIGNING_STATUS_FAILED SigningStatus = 4
+	// SIGNING_STATUS_REPLACED - The signing request is replaced by another signing request
+	SigningStatus_SIGNING_STATUS_REPLACED SigningStatus = 5
 )
 
 var SigningStatus_name = map[int32]string{
@@ -52,6 +55,7 @@ var SigningStatus_name = map[int32]string{
 	2: "SIGNING_STATUS_BROADCASTED",
 	3: "SIGNING_STATUS_CONFIRMED",
 	4: "SIGNING_STATUS_FAILED",
+	5: "SIGNING_STATUS_REPLACED",
 }
 
 var SigningStatus_value = map[string]int32{
@@ -60,6 +64,7 @@ var SigningStatus_value = map[string]int32{
 	"SIGNING_STATUS_BROADCASTED": 2,
 	"SIGNING_STATUS_CONFIRMED":   3,
 	"SIGNING_STATUS_FAILED":      4,
+	"SIGNING_STATUS_REPLACED":    5,
 }
 
 func (x SigningStatus) String() string {
@@ -70,6 +75,54 @@ func (SigningStatus) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_9ff68b16012a2359, []int{0}
 }
 
+// SigningTransition enumerates the transitions of the signing request
+type SigningTransition int32
+
+const (
+	// SIGNING_TRANSITION_UNSPECIFIED - Default value, should not be used
+	SigningTransition_SIGNING_TRANSITION_UNSPECIFIED SigningTransition = 0
+	// SIGNING_TRANSITION_CREATED - The signing request is created
+	SigningTransition_SIGNING_TRANSITION_CREATED SigningTransition = 1
+	// SIGNING_TRANSITION_SIGNATURES_SUBMITTED - The signatures are submitted by the signer
+	SigningTransition_SIGNING_TRANSITION_SIGNATURES_SUBMITTED SigningTransition = 2
+	// SIGNING_TRANSITION_BROADCASTED - The signing request is broadcasted
+	SigningTransition_SIGNING_TRANSITION_BROADCASTED SigningTransition = 3
+	// SIGNING_TRANSITION_CONFIRMED - The signing request is confirmed
+	SigningTransition_SIGNING_TRANSITION_CONFIRMED SigningTransition = 4
+	// SIGNING_TRANSITION_FAILED - The signing request failed
+	SigningTransition_SIGNING_TRANSITION_FAILED SigningTransition = 5
+	// SIGNING_TRANSITION_REPLACED - The signing request is replaced
+	SigningTransition_SIGNING_TRANSITION_REPLACED SigningTransition = 6
+)
+
+var SigningTransition_name = map[int32]string{
+	0: "SIGNING_TRANSITION_UNSPECIFIED",
+	1: "SIGNING_TRANSITION_CREATED",
+	2: "SIGNING_TRANSITION_SIGNATURES_SUBMITTED",
+	3: "SIGNING_TRANSITION_BROADCASTED",
+	4: "SIGNING_TRANSITION_CONFIRMED",
+	5: "SIGNING_TRANSITION_FAILED",
+	6: "SIGNING_TRANSITION_REPLACED",
+}
+
+var SigningTransition_value = map[string]int32{
+	"SIGNING_TRANSITION_UNSPECIFIED":          0,
+	"SIGNING_TRANSITION_CREATED":              1,
+	"SIGNING_TRANSITION_SIGNATURES_SUBMITTED": 2,
+	"SIGNING_TRANSITION_BROADCASTED":          3,
+	"SIGNING_TRANSITION_CONFIRMED":            4,
+	"SIGNING_TRANSITION_FAILED":               5,
+	"SIGNING_TRANSITION_REPLACED":             6,
+}
+
+func (x SigningTransition) String() string {
+	return proto.EnumName(SigningTransition_name, int32(x))
+}
+
+func (SigningTransition) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_9ff68b16012a2359, []int{1}
+}
+
 type DKGRequestStatus int32
 
 const (
@@ -106,7 +159,167 @@ func (x DKGRequestStatus) String() string {
 }
 
 func (DKGRequestStatus) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_9ff68b16012a2359, []int{1}
+	return fileDescriptor_9ff68b16012a2359, []int{2}
+}
+
+// FrostSigningStatus defines the status of the FROST signing session
+type FrostSigningStatus int32
+
+const (
+	// FROST_SIGNING_STATUS_UNSPECIFIED defines the unknown status
+	FrostSigningStatus_FROST_SIGNING_STATUS_UNSPECIFIED FrostSigningStatus = 0
+	// FROST_SIGNING_STATUS_COMMITMENT defines the status in which the nonce commitments are collected
+	FrostSigningStatus_FROST_SIGNING_STATUS_COMMITMENT FrostSigningStatus = 1
+	// FROST_SIGNING_STATUS_SIGNING defines the status in which the partial signatures are collected
+	FrostSigningStatus_FROST_SIGNING_STATUS_SIGNING FrostSigningStatus = 2
+	// FROST_SIGNING_STATUS_COMPLETED defines the status in which the signatures are aggregated
+	FrostSigningStatus_FROST_SIGNING_STATUS_COMPLETED FrostSigningStatus = 3
+	// FROST_SIGNING_STATUS_FAILED defines the status in which the threshold can no longer be reached due to the misbehaving signers
+	FrostSigningStatus_FROST_SIGNING_STATUS_FAILED FrostSigningStatus = 4
+)
+
+var FrostSigningStatus_name = map[int32]string{
+	0: "FROST_SIGNING_STATUS_UNSPECIFIED",
+	1: "FROST_SIGNING_STATUS_COMMITMENT",
+	2: "FROST_SIGNING_STATUS_SIGNING",
+	3: "FROST_SIGNING_STATUS_COMPLETED",
+	4: "FROST_SIGNING_STATUS_FAILED",
+}
+
+var FrostSigningStatus_value = map[string]int32{
+	"FROST_SIGNING_STATUS_UNSPECIFIED": 0,
+	"FROST_SIGNING_STATUS_COMMITMENT":  1,
+	"FROST_SIGNING_STATUS_SIGNING":     2,
+	"FROST_SIGNING_STATUS_COMPLETED":   3,
+	"FROST_SIGNING_STATUS_FAILED":      4,
+}
+
+func (x FrostSigningStatus) String() string {
+	return proto.EnumName(FrostSigningStatus_name, int32(x))
+}
+
+func (FrostSigningStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_9ff68b16012a2359, []int{3}
+}
+
+// VaultTransferStatus defines the status of the vault asset transfer
+type VaultTransferStatus int32
+
+const (
+	// VAULT_TRANSFER_STATUS_UNSPECIFIED defines the unknown status
+	VaultTransferStatus_VAULT_TRANSFER_STATUS_UNSPECIFIED VaultTransferStatus = 0
+	// VAULT_TRANSFER_STATUS_PENDING defines the status of the transfer not started yet
+	VaultTransferStatus_VAULT_TRANSFER_STATUS_PENDING VaultTransferStatus = 1
+	// VAULT_TRANSFER_STATUS_IN_PROGRESS defines the status of the transfer with the assets remaining in the source vault
+	VaultTransferStatus_VAULT_TRANSFER_STATUS_IN_PROGRESS VaultTransferStatus = 2
+	// VAULT_TRANSFER_STATUS_COMPLETED defines the status of the transfer with no assets remaining in the source vault
+	VaultTransferStatus_VAULT_TRANSFER_STATUS_COMPLETED VaultTransferStatus = 3
+)
+
+var VaultTransferStatus_name = map[int32]string{
+	0: "VAULT_TRANSFER_STATUS_UNSPECIFIED",
+	1: "VAULT_TRANSFER_STATUS_PENDING",
+	2: "VAULT_TRANSFER_STATUS_IN_PROGRESS",
+	3: "VAULT_TRANSFER_STATUS_COMPLETED",
+}
+
+var VaultTransferStatus_value = map[string]int32{
+	"VAULT_TRANSFER_STATUS_UNSPECIFIED": 0,
+	"VAULT_TRANSFER_STATUS_PENDING":     1,
+	"VAULT_TRANSFER_STATUS_IN_PROGRESS": 2,
+	"VAULT_TRANSFER_STATUS_COMPLETED":   3,
+}
+
+func (x VaultTransferStatus) String() string {
+	return proto.EnumName(VaultTransferStatus_name, int32(x))
+}
+
+func (VaultTransferStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_9ff68b16012a2359, []int{4}
+}
+
+// FeeBucket defines the destination of the protocol fee distribution
+type FeeBucket int32
+
+const (
+	// FEE_BUCKET_UNSPECIFIED defines the unknown bucket
+	FeeBucket_FEE_BUCKET_UNSPECIFIED FeeBucket = 0
+	// FEE_BUCKET_COMMUNITY_POOL defines the community pool
+	FeeBucket_FEE_BUCKET_COMMUNITY_POOL FeeBucket = 1
+	// FEE_BUCKET_RELAYER_POOL defines the relayer reward pool paid out to the trusted relayers at the settlement
+	FeeBucket_FEE_BUCKET_RELAYER_POOL FeeBucket = 2
+	// FEE_BUCKET_SIGNER_POOL defines the signer pool paid out to the current TSS participants at the settlement
+	FeeBucket_FEE_BUCKET_SIGNER_POOL FeeBucket = 3
+	// FEE_BUCKET_INSURANCE defines the insurance reserve
+	FeeBucket_FEE_BUCKET_INSURANCE FeeBucket = 4
+	// FEE_BUCKET_COLLECTOR defines the protocol fee collector
+	FeeBucket_FEE_BUCKET_COLLECTOR FeeBucket = 5
+)
+
+var FeeBucket_name = map[int32]string{
+	0: "FEE_BUCKET_UNSPECIFIED",
+	1: "FEE_BUCKET_COMMUNITY_POOL",
+	2: "FEE_BUCKET_RELAYER_POOL",
+	3: "FEE_BUCKET_SIGNER_POOL",
+	4: "FEE_BUCKET_INSURANCE",
+	5: "FEE_BUCKET_COLLECTOR",
+}
+
+var FeeBucket_value = map[string]int32{
+	"FEE_BUCKET_UNSPECIFIED":    0,
+	"FEE_BUCKET_COMMUNITY_POOL": 1,
+	"FEE_BUCKET_RELAYER_POOL":   2,
+	"FEE_BUCKET_SIGNER_POOL":    3,
+	"FEE_BUCKET_INSURANCE":      4,
+	"FEE_BUCKET_COLLECTOR":      5,
+}
+
+func (x FeeBucket) String() string {
+	return proto.EnumName(FeeBucket_name, int32(x))
+}
+
+func (FeeBucket) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_9ff68b16012a2359, []int{5}
+}
+
+// PauseScope defines the scope of the emergency pause
+type PauseScope int32
+
+const (
+	// PAUSE_SCOPE_UNSPECIFIED defines the unknown scope
+	PauseScope_PAUSE_SCOPE_UNSPECIFIED PauseScope = 0
+	// PAUSE_SCOPE_DEPOSIT pauses all deposits
+	PauseScope_PAUSE_SCOPE_DEPOSIT PauseScope = 1
+	// PAUSE_SCOPE_WITHDRAW pauses all withdrawals
+	PauseScope_PAUSE_SCOPE_WITHDRAW PauseScope = 2
+	// PAUSE_SCOPE_SIGNING pauses the signing request creation
+	PauseScope_PAUSE_SCOPE_SIGNING PauseScope = 3
+	// PAUSE_SCOPE_ASSET pauses the deposits and withdrawals of the given asset
+	PauseScope_PAUSE_SCOPE_ASSET PauseScope = 4
+)
+
+var PauseScope_name = map[int32]string{
+	0: "PAUSE_SCOPE_UNSPECIFIED",
+	1: "PAUSE_SCOPE_DEPOSIT",
+	2: "PAUSE_SCOPE_WITHDRAW",
+	3: "PAUSE_SCOPE_SIGNING",
+	4: "PAUSE_SCOPE_ASSET",
+}
+
+var PauseScope_value = map[string]int32{
+	"PAUSE_SCOPE_UNSPECIFIED": 0,
+	"PAUSE_SCOPE_DEPOSIT":     1,
+	"PAUSE_SCOPE_WITHDRAW":    2,
+	"PAUSE_SCOPE_SIGNING":     3,
+	"PAUSE_SCOPE_ASSET":       4,
+}
+
+func (x PauseScope) String() string {
+	return proto.EnumName(PauseScope_name, int32(x))
+}
+
+func (PauseScope) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_9ff68b16012a2359, []int{6}
 }
 
 // Bitcoin Block Header
@@ -366,6 +579,130 @@ func (m *SigningRequest) GetStatus() SigningStatus {
 	return SigningStatus_SIGNING_STATUS_UNSPECIFIED
 }
 
+// Signing Request History Entry
+type SigningRequestHistoryEntry struct {
+	Transition SigningTransition `protobuf:"varint,1,opt,name=transition,proto3,enum=side.btcbridge.SigningTransition" json:"transition,omitempty"`
+	// the account which triggers the transition if any, e.g. the signer submitting signatures
+	Actor  string    `protobuf:"bytes,2,opt,name=actor,proto3" json:"actor,omitempty"`
+	Height int64     `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	Time   time.Time `protobuf:"bytes,4,opt,name=time,proto3,stdtime" json:"time"`
+}
+
+func (m *SigningRequestHistoryEntry) Reset()         { *m = SigningRequestHistoryEntry{} }
+func (m *SigningRequestHistoryEntry) String() string { return proto.CompactTextString(m) }
+func (*SigningRequestHistoryEntry) ProtoMessage()    {}
+func (*SigningRequestHistoryEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ff68b16012a2359, []int{3}
+}
+func (m *SigningRequestHistoryEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SigningRequestHistoryEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SigningRequestHistoryEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SigningRequestHistoryEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SigningRequestHistoryEntry.Merge(m, src)
+}
+func (m *SigningRequestHistoryEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *SigningRequestHistoryEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_SigningRequestHistoryEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SigningRequestHistoryEntry proto.InternalMessageInfo
+
+func (m *SigningRequestHistoryEntry) GetTransition() SigningTransition {
+	if m != nil {
+		return m.Transition
+	}
+	return SigningTransition_SIGNING_TRANSITION_UNSPECIFIED
+}
+
+func (m *SigningRequestHistoryEntry) GetActor() string {
+	if m != nil {
+		return m.Actor
+	}
+	return ""
+}
+
+func (m *SigningRequestHistoryEntry) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *SigningRequestHistoryEntry) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+// Signing Request History
+type SigningRequestHistory struct {
+	// the sequence of the signing request
+	Sequence uint64                        `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Entries  []*SigningRequestHistoryEntry `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries,omitempty"`
+}
+
+func (m *SigningRequestHistory) Reset()         { *m = SigningRequestHistory{} }
+func (m *SigningRequestHistory) String() string { return proto.CompactTextString(m) }
+func (*SigningRequestHistory) ProtoMessage()    {}
+func (*SigningRequestHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ff68b16012a2359, []int{4}
+}
+func (m *SigningRequestHistory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SigningRequestHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SigningRequestHistory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SigningRequestHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SigningRequestHistory.Merge(m, src)
+}
+func (m *SigningRequestHistory) XXX_Size() int {
+	return m.Size()
+}
+func (m *SigningRequestHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_SigningRequestHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SigningRequestHistory proto.InternalMessageInfo
+
+func (m *SigningRequestHistory) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *SigningRequestHistory) GetEntries() []*SigningRequestHistoryEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
 // Withdrawal Request
 type WithdrawRequest struct {
 	Address  string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
@@ -378,7 +715,7 @@ func (m *WithdrawRequest) Reset()         { *m = WithdrawRequest{} }
 func (m *WithdrawRequest) String() string { return proto.CompactTextString(m) }
 func (*WithdrawRequest) ProtoMessage()    {}
 func (*WithdrawRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9ff68b16012a2359, []int{3}
+	return fileDescriptor_9ff68b16012a2359, []int{5}
 }
 func (m *WithdrawRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -446,13 +783,15 @@ type UTXO struct {
 	IsLocked     bool   `protobuf:"varint,7,opt,name=is_locked,json=isLocked,proto3" json:"is_locked,omitempty"`
 	// rune balances associated with the UTXO
 	Runes []*RuneBalance `protobuf:"bytes,8,rep,name=runes,proto3" json:"runes,omitempty"`
+	// witness script of the script hash output, e.g. P2WSH multisig
+	WitnessScript []byte `protobuf:"bytes,9,opt,name=witness_script,json=witnessScript,proto3" json:"witness_script,omitempty"`
 }
 
 func (m *UTXO) Reset()         { *m = UTXO{} }
 func (m *UTXO) String() string { return proto.CompactTextString(m) }
 func (*UTXO) ProtoMessage()    {}
 func (*UTXO) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9ff68b16012a2359, []int{4}
+	return fileDescriptor_9ff68b16012a2359, []int{6}
 }
 func (m *UTXO) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -537,6 +876,13 @@ func (m *UTXO) GetRunes() []*RuneBalance {
 	return nil
 }
 
+func (m *UTXO) GetWitnessScript() []byte {
+	if m != nil {
+		return m.WitnessScript
+	}
+	return nil
+}
+
 // Rune Balance
 type RuneBalance struct {
 	// serialized rune id
@@ -549,7 +895,7 @@ func (m *RuneBalance) Reset()         { *m = RuneBalance{} }
 func (m *RuneBalance) String() string { return proto.CompactTextString(m) }
 func (*RuneBalance) ProtoMessage()    {}
 func (*RuneBalance) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9ff68b16012a2359, []int{5}
+	return fileDescriptor_9ff68b16012a2359, []int{7}
 }
 func (m *RuneBalance) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -604,7 +950,7 @@ func (m *RuneId) Reset()         { *m = RuneId{} }
 func (m *RuneId) String() string { return proto.CompactTextString(m) }
 func (*RuneId) ProtoMessage()    {}
 func (*RuneId) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9ff68b16012a2359, []int{6}
+	return fileDescriptor_9ff68b16012a2359, []int{8}
 }
 func (m *RuneId) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -658,7 +1004,7 @@ func (m *Edict) Reset()         { *m = Edict{} }
 func (m *Edict) String() string { return proto.CompactTextString(m) }
 func (*Edict) ProtoMessage()    {}
 func (*Edict) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9ff68b16012a2359, []int{7}
+	return fileDescriptor_9ff68b16012a2359, []int{9}
 }
 func (m *Edict) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -708,6 +1054,143 @@ func (m *Edict) GetOutput() uint32 {
 	return 0
 }
 
+// Rune Metadata
+type RuneMetadata struct {
+	// rune id
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// spaced rune name, e.g. UNCOMMON•GOODS
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// currency symbol
+	Symbol string `protobuf:"bytes,3,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	// divisibility
+	Divisibility uint32 `protobuf:"varint,4,opt,name=divisibility,proto3" json:"divisibility,omitempty"`
+	// hash of the etching tx
+	EtchingTx string `protobuf:"bytes,5,opt,name=etching_tx,json=etchingTx,proto3" json:"etching_tx,omitempty"`
+}
+
+func (m *RuneMetadata) Reset()         { *m = RuneMetadata{} }
+func (m *RuneMetadata) String() string { return proto.CompactTextString(m) }
+func (*RuneMetadata) ProtoMessage()    {}
+func (*RuneMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ff68b16012a2359, []int{10}
+}
+func (m *RuneMetadata) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RuneMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RuneMetadata.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RuneMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RuneMetadata.Merge(m, src)
+}
+func (m *RuneMetadata) XXX_Size() int {
+	return m.Size()
+}
+func (m *RuneMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_RuneMetadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RuneMetadata proto.InternalMessageInfo
+
+func (m *RuneMetadata) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *RuneMetadata) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *RuneMetadata) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *RuneMetadata) GetDivisibility() uint32 {
+	if m != nil {
+		return m.Divisibility
+	}
+	return 0
+}
+
+func (m *RuneMetadata) GetEtchingTx() string {
+	if m != nil {
+		return m.EtchingTx
+	}
+	return ""
+}
+
+// BRC20 Metadata
+type Brc20Metadata struct {
+	// brc20 ticker
+	Tick string `protobuf:"bytes,1,opt,name=tick,proto3" json:"tick,omitempty"`
+	// decimals of the ticker by the deploy inscription
+	Decimals uint32 `protobuf:"varint,2,opt,name=decimals,proto3" json:"decimals,omitempty"`
+}
+
+func (m *Brc20Metadata) Reset()         { *m = Brc20Metadata{} }
+func (m *Brc20Metadata) String() string { return proto.CompactTextString(m) }
+func (*Brc20Metadata) ProtoMessage()    {}
+func (*Brc20Metadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ff68b16012a2359, []int{11}
+}
+func (m *Brc20Metadata) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Brc20Metadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Brc20Metadata.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Brc20Metadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Brc20Metadata.Merge(m, src)
+}
+func (m *Brc20Metadata) XXX_Size() int {
+	return m.Size()
+}
+func (m *Brc20Metadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_Brc20Metadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Brc20Metadata proto.InternalMessageInfo
+
+func (m *Brc20Metadata) GetTick() string {
+	if m != nil {
+		return m.Tick
+	}
+	return ""
+}
+
+func (m *Brc20Metadata) GetDecimals() uint32 {
+	if m != nil {
+		return m.Decimals
+	}
+	return 0
+}
+
 // BTC UTXO Consolidation
 type BtcConsolidation struct {
 	// maximum threshold of the btc value
@@ -720,7 +1203,7 @@ func (m *BtcConsolidation) Reset()         { *m = BtcConsolidation{} }
 func (m *BtcConsolidation) String() string { return proto.CompactTextString(m) }
 func (*BtcConsolidation) ProtoMessage()    {}
 func (*BtcConsolidation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9ff68b16012a2359, []int{8}
+	return fileDescriptor_9ff68b16012a2359, []int{12}
 }
 func (m *BtcConsolidation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -777,7 +1260,7 @@ func (m *RunesConsolidation) Reset()         { *m = RunesConsolidation{} }
 func (m *RunesConsolidation) String() string { return proto.CompactTextString(m) }
 func (*RunesConsolidation) ProtoMessage()    {}
 func (*RunesConsolidation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9ff68b16012a2359, []int{9}
+	return fileDescriptor_9ff68b16012a2359, []int{13}
 }
 func (m *RunesConsolidation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -841,7 +1324,7 @@ func (m *DKGParticipant) Reset()         { *m = DKGParticipant{} }
 func (m *DKGParticipant) String() string { return proto.CompactTextString(m) }
 func (*DKGParticipant) ProtoMessage()    {}
 func (*DKGParticipant) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9ff68b16012a2359, []int{10}
+	return fileDescriptor_9ff68b16012a2359, []int{14}
 }
 func (m *DKGParticipant) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -909,13 +1392,21 @@ type DKGRequest struct {
 	Expiration *time.Time `protobuf:"bytes,7,opt,name=expiration,proto3,stdtime" json:"expiration,omitempty"`
 	// status
 	Status DKGRequestStatus `protobuf:"varint,8,opt,name=status,proto3,enum=side.btcbridge.DKGRequestStatus" json:"status,omitempty"`
+	// taproot script tree to be committed by the generated vaults; nil if no script path
+	TaprootTree *VaultTaprootTree `protobuf:"bytes,9,opt,name=taproot_tree,json=taprootTree,proto3" json:"taproot_tree,omitempty"`
+	// participants which did not submit the completion request before expiration
+	NonResponders []*DKGParticipant `protobuf:"bytes,10,rep,name=non_responders,json=nonResponders,proto3" json:"non_responders,omitempty"`
+	// id of the timed out DKG request retried by this request; 0 if not a retry
+	RetryOf uint64 `protobuf:"varint,11,opt,name=retry_of,json=retryOf,proto3" json:"retry_of,omitempty"`
+	// number of the retries so far
+	RetryCount uint32 `protobuf:"varint,12,opt,name=retry_count,json=retryCount,proto3" json:"retry_count,omitempty"`
 }
 
 func (m *DKGRequest) Reset()         { *m = DKGRequest{} }
 func (m *DKGRequest) String() string { return proto.CompactTextString(m) }
 func (*DKGRequest) ProtoMessage()    {}
 func (*DKGRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9ff68b16012a2359, []int{11}
+	return fileDescriptor_9ff68b16012a2359, []int{15}
 }
 func (m *DKGRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1000,25 +1491,59 @@ func (m *DKGRequest) GetStatus() DKGRequestStatus {
 	return DKGRequestStatus_DKG_REQUEST_STATUS_UNSPECIFIED
 }
 
-// DKG Completion Request
-type DKGCompletionRequest struct {
-	// request id
-	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	// sender
-	Sender string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
-	// new vaults generated by DKG
-	Vaults []string `protobuf:"bytes,3,rep,name=vaults,proto3" json:"vaults,omitempty"`
-	// consensus address of the corresponding validator
-	ConsensusAddress string `protobuf:"bytes,4,opt,name=consensus_address,json=consensusAddress,proto3" json:"consensus_address,omitempty"`
-	// hex encoded validator signature
-	Signature string `protobuf:"bytes,5,opt,name=signature,proto3" json:"signature,omitempty"`
-}
-
+func (m *DKGRequest) GetTaprootTree() *VaultTaprootTree {
+	if m != nil {
+		return m.TaprootTree
+	}
+	return nil
+}
+
+func (m *DKGRequest) GetNonResponders() []*DKGParticipant {
+	if m != nil {
+		return m.NonResponders
+	}
+	return nil
+}
+
+func (m *DKGRequest) GetRetryOf() uint64 {
+	if m != nil {
+		return m.RetryOf
+	}
+	return 0
+}
+
+func (m *DKGRequest) GetRetryCount() uint32 {
+	if m != nil {
+		return m.RetryCount
+	}
+	return 0
+}
+
+// DKG Completion Request
+type DKGCompletionRequest struct {
+	// request id
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// sender
+	Sender string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	// new vaults generated by DKG
+	Vaults []string `protobuf:"bytes,3,rep,name=vaults,proto3" json:"vaults,omitempty"`
+	// consensus address of the corresponding validator
+	ConsensusAddress string `protobuf:"bytes,4,opt,name=consensus_address,json=consensusAddress,proto3" json:"consensus_address,omitempty"`
+	// hex encoded validator signature over the id, vaults, public keys, proofs and verification shares
+	Signature string `protobuf:"bytes,5,opt,name=signature,proto3" json:"signature,omitempty"`
+	// hex encoded aggregate public keys of the new vaults
+	PubKeys []string `protobuf:"bytes,6,rep,name=pub_keys,json=pubKeys,proto3" json:"pub_keys,omitempty"`
+	// hex encoded schnorr signatures of the aggregate public keys as the proof of possession
+	Proofs []string `protobuf:"bytes,7,rep,name=proofs,proto3" json:"proofs,omitempty"`
+	// FROST verification shares of the new vaults; empty for the vaults not signed through the chain
+	VerificationShares []VerificationShares `protobuf:"bytes,8,rep,name=verification_shares,json=verificationShares,proto3" json:"verification_shares"`
+}
+
 func (m *DKGCompletionRequest) Reset()         { *m = DKGCompletionRequest{} }
 func (m *DKGCompletionRequest) String() string { return proto.CompactTextString(m) }
 func (*DKGCompletionRequest) ProtoMessage()    {}
 func (*DKGCompletionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9ff68b16012a2359, []int{12}
+	return fileDescriptor_9ff68b16012a2359, []int{16}
 }
 func (m *DKGCompletionRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1082,1153 +1607,6470 @@ func (m *DKGCompletionRequest) GetSignature() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterEnum("side.btcbridge.SigningStatus", SigningStatus_name, SigningStatus_value)
-	proto.RegisterEnum("side.btcbridge.DKGRequestStatus", DKGRequestStatus_name, DKGRequestStatus_value)
-	proto.RegisterType((*BlockHeader)(nil), "side.btcbridge.BlockHeader")
-	proto.RegisterType((*FeeRate)(nil), "side.btcbridge.FeeRate")
-	proto.RegisterType((*SigningRequest)(nil), "side.btcbridge.SigningRequest")
-	proto.RegisterType((*WithdrawRequest)(nil), "side.btcbridge.WithdrawRequest")
-	proto.RegisterType((*UTXO)(nil), "side.btcbridge.UTXO")
-	proto.RegisterType((*RuneBalance)(nil), "side.btcbridge.RuneBalance")
-	proto.RegisterType((*RuneId)(nil), "side.btcbridge.RuneId")
-	proto.RegisterType((*Edict)(nil), "side.btcbridge.Edict")
-	proto.RegisterType((*BtcConsolidation)(nil), "side.btcbridge.BtcConsolidation")
-	proto.RegisterType((*RunesConsolidation)(nil), "side.btcbridge.RunesConsolidation")
-	proto.RegisterType((*DKGParticipant)(nil), "side.btcbridge.DKGParticipant")
-	proto.RegisterType((*DKGRequest)(nil), "side.btcbridge.DKGRequest")
-	proto.RegisterType((*DKGCompletionRequest)(nil), "side.btcbridge.DKGCompletionRequest")
+func (m *DKGCompletionRequest) GetPubKeys() []string {
+	if m != nil {
+		return m.PubKeys
+	}
+	return nil
 }
 
-func init() { proto.RegisterFile("side/btcbridge/btcbridge.proto", fileDescriptor_9ff68b16012a2359) }
-
-var fileDescriptor_9ff68b16012a2359 = []byte{
-	// 1264 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x56, 0xcf, 0x6e, 0xdb, 0xc6,
-	0x13, 0x36, 0x25, 0x59, 0x96, 0x47, 0xb6, 0xac, 0xec, 0x2f, 0x3f, 0x47, 0x96, 0x1d, 0xd9, 0x10,
-	0x8a, 0x34, 0x49, 0x51, 0x09, 0x71, 0x11, 0xb4, 0xe8, 0xcd, 0x32, 0x65, 0x45, 0x70, 0x22, 0xbb,
-	0x94, 0xdc, 0x16, 0xbd, 0x10, 0x4b, 0x72, 0x23, 0x11, 0x16, 0xb9, 0x0c, 0x77, 0xe9, 0xca, 0xb7,
-	0xde, 0x7b, 0xc9, 0x1b, 0x14, 0x05, 0xfa, 0x10, 0x7d, 0x84, 0x1c, 0x73, 0xec, 0xa9, 0x2d, 0x92,
-	0x7b, 0x4f, 0x7d, 0x80, 0x62, 0xff, 0x50, 0xb2, 0x04, 0xc5, 0xb9, 0xcd, 0x7c, 0x33, 0xbb, 0x33,
-	0xfc, 0xf6, 0x9b, 0x91, 0xa0, 0xc6, 0x7c, 0x8f, 0x34, 0x1d, 0xee, 0x3a, 0xb1, 0xef, 0x0d, 0x6f,
-	0x58, 0x8d, 0x28, 0xa6, 0x9c, 0xa2, 0x92, 0x88, 0x37, 0xa6, 0x68, 0xf5, 0xee, 0x90, 0x0e, 0xa9,
-	0x0c, 0x35, 0x85, 0xa5, 0xb2, 0xaa, 0x3b, 0x43, 0x4a, 0x87, 0x63, 0xd2, 0x94, 0x9e, 0x93, 0xbc,
-	0x6c, 0xe2, 0xf0, 0x5a, 0x87, 0xf6, 0x17, 0x43, 0xdc, 0x0f, 0x08, 0xe3, 0x38, 0x88, 0x74, 0x42,
-	0xcd, 0xa5, 0x2c, 0xa0, 0xac, 0xe9, 0x60, 0x46, 0x9a, 0x57, 0x4f, 0x1c, 0xc2, 0xf1, 0x93, 0xa6,
-	0x4b, 0xfd, 0x30, 0xbd, 0x5b, 0xc5, 0x6d, 0x55, 0x54, 0x39, 0x3a, 0xb4, 0xbb, 0xd0, 0x7c, 0x84,
-	0x63, 0x1c, 0xe8, 0x60, 0xfd, 0x5f, 0x03, 0x8a, 0xad, 0x31, 0x75, 0x2f, 0x9f, 0x11, 0xec, 0x91,
-	0x18, 0x55, 0x60, 0xed, 0x8a, 0xc4, 0xcc, 0xa7, 0x61, 0xc5, 0x38, 0x30, 0x1e, 0xe6, 0xac, 0xd4,
-	0x45, 0x08, 0x72, 0x23, 0xcc, 0x46, 0x95, 0xcc, 0x81, 0xf1, 0x70, 0xdd, 0x92, 0x36, 0xda, 0x86,
-	0xfc, 0x88, 0xf8, 0xc3, 0x11, 0xaf, 0x64, 0x65, 0xb2, 0xf6, 0x50, 0x03, 0xfe, 0x17, 0xc5, 0xe4,
-	0xca, 0xa7, 0x09, 0xb3, 0x1d, 0x71, 0xbb, 0x2d, 0x8f, 0xe6, 0xe4, 0xd1, 0x3b, 0x69, 0x48, 0xd5,
-	0x15, 0xf7, 0xec, 0x43, 0x31, 0x20, 0xf1, 0xe5, 0x98, 0xd8, 0x31, 0xa5, 0xbc, 0xb2, 0x2a, 0xf3,
-	0x40, 0x41, 0x16, 0xa5, 0x1c, 0xdd, 0x85, 0xd5, 0x90, 0x86, 0x2e, 0xa9, 0xe4, 0x65, 0x1d, 0xe5,
-	0x88, 0x96, 0x1c, 0x9f, 0xb3, 0xca, 0x9a, 0x6a, 0x49, 0xd8, 0x02, 0x13, 0xdc, 0x55, 0x0a, 0x32,
-	0x51, 0xda, 0xa8, 0x0c, 0xd9, 0x90, 0x4f, 0x2a, 0xeb, 0x12, 0x12, 0x66, 0xfd, 0x4b, 0x58, 0x3b,
-	0x21, 0xc4, 0xc2, 0x9c, 0x88, 0xab, 0xaf, 0xf0, 0x38, 0x21, 0xf2, 0x7b, 0xb3, 0x96, 0x72, 0x6e,
-	0x7c, 0x59, 0x46, 0xc2, 0xda, 0xab, 0xff, 0x92, 0x81, 0x52, 0xdf, 0x1f, 0x86, 0x7e, 0x38, 0xb4,
-	0xc8, 0xab, 0x84, 0x30, 0x2e, 0x28, 0xc3, 0x9e, 0x17, 0x13, 0xc6, 0xe4, 0x15, 0xeb, 0x56, 0xea,
-	0xa2, 0x2a, 0x14, 0x98, 0x48, 0x12, 0x8d, 0x67, 0x64, 0xf1, 0xa9, 0x8f, 0x3e, 0x87, 0x1c, 0xbf,
-	0x8e, 0x88, 0x24, 0xae, 0x74, 0xb8, 0xd3, 0x98, 0x57, 0x50, 0xe3, 0x88, 0x31, 0xc2, 0x07, 0xd7,
-	0x11, 0xb1, 0x64, 0x9a, 0xfc, 0xac, 0x89, 0xef, 0x69, 0x0a, 0xa5, 0x2d, 0xb0, 0x88, 0x39, 0x29,
-	0x5d, 0xd2, 0x46, 0x5d, 0xd8, 0x74, 0x63, 0x82, 0xb9, 0x4f, 0x43, 0x5b, 0xf2, 0x20, 0x08, 0x2b,
-	0x1e, 0x56, 0x1b, 0x4a, 0x60, 0x8d, 0x54, 0x60, 0x8d, 0x41, 0x2a, 0xb0, 0x56, 0xe1, 0xcd, 0x9f,
-	0xfb, 0x2b, 0xaf, 0xff, 0xda, 0x37, 0xac, 0x8d, 0xf4, 0xa8, 0x08, 0xa2, 0xa7, 0x90, 0x67, 0x1c,
-	0xf3, 0x44, 0xf1, 0x5b, 0x3a, 0xbc, 0xbf, 0xd8, 0xa3, 0xe6, 0xa1, 0x2f, 0x93, 0x2c, 0x9d, 0x5c,
-	0x67, 0xb0, 0xf5, 0x9d, 0xcf, 0x47, 0x5e, 0x8c, 0x7f, 0xfc, 0x38, 0x43, 0xdb, 0x90, 0xc7, 0x01,
-	0x4d, 0x42, 0xae, 0x65, 0xa5, 0xbd, 0x39, 0xe6, 0xb2, 0x0b, 0xcc, 0x2d, 0xa1, 0xa2, 0xfe, 0x8f,
-	0x01, 0xb9, 0x8b, 0xc1, 0xf7, 0x67, 0xd3, 0xa0, 0x31, 0xcf, 0xd3, 0x15, 0x4d, 0xb8, 0x7e, 0x02,
-	0x69, 0xdf, 0x6c, 0x29, 0xfb, 0xa1, 0x96, 0x72, 0x4a, 0xd3, 0xba, 0xa5, 0x99, 0x22, 0x56, 0xe7,
-	0xb4, 0xfe, 0x09, 0x94, 0xa2, 0xc4, 0xb1, 0x2f, 0xc9, 0xb5, 0xcd, 0xdc, 0xd8, 0x8f, 0xb8, 0xa4,
-	0x7c, 0xc3, 0xda, 0x88, 0x12, 0xe7, 0x94, 0x5c, 0xf7, 0x25, 0x86, 0x76, 0x61, 0xdd, 0x67, 0xb6,
-	0x10, 0x3c, 0xf1, 0x24, 0x9f, 0x05, 0xab, 0xe0, 0xb3, 0xe7, 0xd2, 0x47, 0x4f, 0x60, 0x35, 0x4e,
-	0x42, 0xc2, 0x2a, 0x85, 0x83, 0xec, 0xc3, 0xe2, 0xe1, 0xee, 0x22, 0xd1, 0x56, 0x12, 0x92, 0x16,
-	0x1e, 0xe3, 0xd0, 0x25, 0x96, 0xca, 0xac, 0x3f, 0x85, 0xe2, 0x0d, 0x14, 0x95, 0x20, 0x33, 0xfd,
-	0xe8, 0x8c, 0xef, 0x7d, 0x88, 0xd7, 0x7a, 0x03, 0xf2, 0xe2, 0x58, 0xd7, 0x13, 0xb2, 0x97, 0x93,
-	0xa9, 0xc7, 0x5c, 0x39, 0xe2, 0x1e, 0x3e, 0x91, 0x67, 0x36, 0xad, 0x0c, 0x9f, 0xd4, 0x6d, 0x58,
-	0x6d, 0x7b, 0xbe, 0xcb, 0xd1, 0x83, 0x69, 0x81, 0xe2, 0xe1, 0xf6, 0xb2, 0xfe, 0xba, 0xde, 0x6d,
-	0x85, 0x05, 0x4e, 0x13, 0x1e, 0x25, 0x6a, 0x53, 0x6c, 0x5a, 0xda, 0xab, 0x7f, 0x0b, 0xe5, 0x16,
-	0x77, 0x8f, 0x69, 0xc8, 0xe8, 0xd8, 0xf7, 0xa4, 0xf8, 0xd0, 0x23, 0x28, 0x73, 0x1c, 0x0f, 0x09,
-	0xb7, 0xf9, 0x28, 0x26, 0x6c, 0x44, 0xc7, 0x9e, 0x1e, 0xce, 0x2d, 0x85, 0x0f, 0x52, 0x18, 0xdd,
-	0x83, 0xb5, 0x00, 0x4f, 0xec, 0x30, 0x09, 0x74, 0xd3, 0xf9, 0x00, 0x4f, 0x7a, 0x49, 0x50, 0x7f,
-	0x05, 0x48, 0x74, 0xc5, 0xe6, 0x6f, 0xbe, 0x07, 0x6b, 0x82, 0x3e, 0x7b, 0xca, 0x55, 0x3e, 0x56,
-	0x6c, 0x2c, 0x2b, 0xa9, 0x3e, 0xe0, 0xb6, 0x92, 0xd9, 0xb9, 0x92, 0x3f, 0x19, 0x50, 0x32, 0x4f,
-	0x3b, 0xe7, 0x38, 0xe6, 0xbe, 0xeb, 0x47, 0x38, 0x94, 0x2a, 0x0b, 0x68, 0xe8, 0x5f, 0x92, 0x38,
-	0x15, 0xbe, 0x76, 0x45, 0x41, 0x1a, 0x91, 0x18, 0x73, 0x1a, 0xdb, 0xa9, 0x10, 0x75, 0xc1, 0x14,
-	0x3f, 0xd2, 0x82, 0x7c, 0x04, 0x65, 0x97, 0x86, 0x8c, 0x84, 0x2c, 0x61, 0x76, 0x94, 0x38, 0x97,
-	0xe4, 0x5a, 0x6b, 0x76, 0x6b, 0x8a, 0x9f, 0x4b, 0xb8, 0xfe, 0x73, 0x16, 0xc0, 0x3c, 0xed, 0xa4,
-	0x73, 0x37, 0x53, 0x45, 0x4e, 0x3e, 0x4e, 0x0b, 0x36, 0xa2, 0x59, 0x77, 0xa2, 0xa0, 0x90, 0x5b,
-	0x6d, 0xf1, 0x39, 0xe7, 0x3f, 0xc2, 0x9a, 0x3b, 0x83, 0xf6, 0x60, 0x7d, 0x46, 0x91, 0x22, 0x60,
-	0x06, 0xa0, 0xaf, 0xa1, 0x78, 0x85, 0x93, 0x31, 0xb7, 0xc5, 0xd2, 0x62, 0x95, 0xdc, 0x41, 0xf6,
-	0xf6, 0xe5, 0x06, 0x32, 0x5b, 0x98, 0x0c, 0x7d, 0x0a, 0x5b, 0x24, 0xc4, 0xce, 0x98, 0xd8, 0x3c,
-	0xc6, 0x21, 0x7b, 0x49, 0x62, 0x39, 0x69, 0x05, 0xab, 0xa4, 0xe0, 0x81, 0x46, 0xd1, 0x03, 0xd0,
-	0x8f, 0x62, 0x27, 0x7c, 0x42, 0xe5, 0x4b, 0xe4, 0x65, 0x23, 0x9b, 0x0a, 0xbe, 0xe0, 0x13, 0xda,
-	0x4b, 0x02, 0x64, 0x02, 0x90, 0x49, 0xe4, 0xc7, 0xf2, 0xed, 0xe5, 0xd0, 0x7d, 0x7c, 0x11, 0x1a,
-	0x72, 0x11, 0xde, 0x38, 0x87, 0xbe, 0x9a, 0xae, 0xc1, 0x82, 0x5c, 0x83, 0x07, 0x4b, 0xe8, 0xd2,
-	0x84, 0x2f, 0x6c, 0xc2, 0x5f, 0x0d, 0xb8, 0x6b, 0x9e, 0x76, 0x8e, 0x69, 0x10, 0x8d, 0x89, 0xb8,
-	0xeb, 0x43, 0xef, 0xb2, 0x0d, 0x79, 0x46, 0x42, 0x8f, 0xc4, 0xe9, 0xd0, 0x28, 0x4f, 0xe0, 0x92,
-	0x1f, 0xb1, 0xa3, 0xb2, 0x02, 0x57, 0x1e, 0xfa, 0x0c, 0xee, 0xcc, 0x14, 0x91, 0xaa, 0x47, 0xad,
-	0xc3, 0x99, 0x54, 0x52, 0xf9, 0xec, 0xc1, 0x3a, 0xf3, 0x87, 0x21, 0xe6, 0x49, 0x4c, 0xf4, 0x4f,
-	0xc5, 0x0c, 0x78, 0xfc, 0x9b, 0x01, 0x9b, 0x73, 0x7b, 0x1c, 0xd5, 0xa0, 0xda, 0xef, 0x76, 0x7a,
-	0xdd, 0x5e, 0xc7, 0xee, 0x0f, 0x8e, 0x06, 0x17, 0x7d, 0xfb, 0xa2, 0xd7, 0x3f, 0x6f, 0x1f, 0x77,
-	0x4f, 0xba, 0x6d, 0xb3, 0xbc, 0x82, 0xaa, 0xb0, 0xbd, 0x10, 0x3f, 0x6f, 0xf7, 0xcc, 0x6e, 0xaf,
-	0x53, 0x36, 0x96, 0x9c, 0x6d, 0x59, 0x67, 0x47, 0xe6, 0xf1, 0x51, 0x7f, 0xd0, 0x36, 0xcb, 0x19,
-	0xb4, 0x07, 0x95, 0x85, 0xf8, 0xf1, 0x59, 0xef, 0xa4, 0x6b, 0xbd, 0x68, 0x9b, 0xe5, 0x2c, 0xda,
-	0x81, 0xff, 0x2f, 0x44, 0x4f, 0x8e, 0xba, 0xcf, 0xdb, 0x66, 0x39, 0xf7, 0xf8, 0x77, 0x03, 0xca,
-	0x8b, 0x3c, 0xa3, 0x3a, 0xd4, 0xcc, 0xd3, 0x8e, 0x6d, 0xb5, 0xbf, 0xb9, 0x68, 0xf7, 0x07, 0xcb,
-	0xbb, 0xad, 0x41, 0x75, 0x49, 0xce, 0xac, 0xe3, 0x03, 0xd8, 0x5b, 0x12, 0x3f, 0x3e, 0x7b, 0x71,
-	0xfe, 0xbc, 0xad, 0x7a, 0xbe, 0x0f, 0x3b, 0x4b, 0x32, 0x74, 0x67, 0x59, 0xb4, 0x0f, 0xbb, 0x4b,
-	0xc2, 0x83, 0xee, 0x8b, 0xb6, 0x79, 0x76, 0x31, 0x28, 0xe7, 0x5a, 0xcf, 0xde, 0xbc, 0xab, 0x19,
-	0x6f, 0xdf, 0xd5, 0x8c, 0xbf, 0xdf, 0xd5, 0x8c, 0xd7, 0xef, 0x6b, 0x2b, 0x6f, 0xdf, 0xd7, 0x56,
-	0xfe, 0x78, 0x5f, 0x5b, 0xf9, 0xa1, 0x31, 0xf4, 0xf9, 0x28, 0x71, 0x1a, 0x2e, 0x0d, 0x9a, 0x42,
-	0x53, 0x52, 0x92, 0x2e, 0x1d, 0x4b, 0xa7, 0x39, 0xb9, 0xf1, 0x97, 0x4d, 0x4e, 0x93, 0x93, 0x97,
-	0x09, 0x5f, 0xfc, 0x17, 0x00, 0x00, 0xff, 0xff, 0xdb, 0xa7, 0xa1, 0x3b, 0x8e, 0x0a, 0x00, 0x00,
+func (m *DKGCompletionRequest) GetProofs() []string {
+	if m != nil {
+		return m.Proofs
+	}
+	return nil
 }
 
-func (m *BlockHeader) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *DKGCompletionRequest) GetVerificationShares() []VerificationShares {
+	if m != nil {
+		return m.VerificationShares
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *BlockHeader) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// VerificationShares defines the FROST verification shares of the vault
+type VerificationShares struct {
+	// hex encoded compressed verification shares in the order of the DKG participants
+	Shares []string `protobuf:"bytes,1,rep,name=shares,proto3" json:"shares,omitempty"`
 }
 
-func (m *BlockHeader) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Ntx != 0 {
-		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Ntx))
-		i--
-		dAtA[i] = 0x48
-	}
-	if m.Time != 0 {
-		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Time))
-		i--
-		dAtA[i] = 0x40
-	}
-	if len(m.Bits) > 0 {
-		i -= len(m.Bits)
-		copy(dAtA[i:], m.Bits)
-		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.Bits)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if m.Nonce != 0 {
-		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Nonce))
-		i--
-		dAtA[i] = 0x30
-	}
-	if len(m.MerkleRoot) > 0 {
-		i -= len(m.MerkleRoot)
-		copy(dAtA[i:], m.MerkleRoot)
-		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.MerkleRoot)))
-		i--
-		dAtA[i] = 0x2a
+func (m *VerificationShares) Reset()         { *m = VerificationShares{} }
+func (m *VerificationShares) String() string { return proto.CompactTextString(m) }
+func (*VerificationShares) ProtoMessage()    {}
+func (*VerificationShares) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ff68b16012a2359, []int{17}
+}
+func (m *VerificationShares) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VerificationShares) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VerificationShares.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.PreviousBlockHash) > 0 {
-		i -= len(m.PreviousBlockHash)
-		copy(dAtA[i:], m.PreviousBlockHash)
-		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.PreviousBlockHash)))
-		i--
-		dAtA[i] = 0x22
+}
+func (m *VerificationShares) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerificationShares.Merge(m, src)
+}
+func (m *VerificationShares) XXX_Size() int {
+	return m.Size()
+}
+func (m *VerificationShares) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerificationShares.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VerificationShares proto.InternalMessageInfo
+
+func (m *VerificationShares) GetShares() []string {
+	if m != nil {
+		return m.Shares
 	}
-	if m.Height != 0 {
-		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x18
+	return nil
+}
+
+// FrostSigner defines the FROST signer of the vault
+type FrostSigner struct {
+	// operator address of the signer
+	OperatorAddress string `protobuf:"bytes,1,opt,name=operator_address,json=operatorAddress,proto3" json:"operator_address,omitempty"`
+	// signer identifier starting from 1
+	Index uint32 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	// hex encoded compressed verification share
+	VerificationShare string `protobuf:"bytes,3,opt,name=verification_share,json=verificationShare,proto3" json:"verification_share,omitempty"`
+}
+
+func (m *FrostSigner) Reset()         { *m = FrostSigner{} }
+func (m *FrostSigner) String() string { return proto.CompactTextString(m) }
+func (*FrostSigner) ProtoMessage()    {}
+func (*FrostSigner) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ff68b16012a2359, []int{18}
+}
+func (m *FrostSigner) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FrostSigner) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FrostSigner.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Hash) > 0 {
-		i -= len(m.Hash)
-		copy(dAtA[i:], m.Hash)
-		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.Hash)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *FrostSigner) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FrostSigner.Merge(m, src)
+}
+func (m *FrostSigner) XXX_Size() int {
+	return m.Size()
+}
+func (m *FrostSigner) XXX_DiscardUnknown() {
+	xxx_messageInfo_FrostSigner.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FrostSigner proto.InternalMessageInfo
+
+func (m *FrostSigner) GetOperatorAddress() string {
+	if m != nil {
+		return m.OperatorAddress
 	}
-	if m.Version != 0 {
-		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Version))
-		i--
-		dAtA[i] = 0x8
+	return ""
+}
+
+func (m *FrostSigner) GetIndex() uint32 {
+	if m != nil {
+		return m.Index
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *FeeRate) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *FrostSigner) GetVerificationShare() string {
+	if m != nil {
+		return m.VerificationShare
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *FeeRate) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// FrostVaultKey defines the FROST key of the vault generated by DKG
+type FrostVaultKey struct {
+	// vault address
+	Vault string `protobuf:"bytes,1,opt,name=vault,proto3" json:"vault,omitempty"`
+	// hex encoded compressed aggregate public key
+	PubKey string `protobuf:"bytes,2,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+	// signing threshold
+	Threshold uint32 `protobuf:"varint,3,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// signers
+	Signers []FrostSigner `protobuf:"bytes,4,rep,name=signers,proto3" json:"signers"`
 }
 
-func (m *FeeRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Height != 0 {
-		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.Value != 0 {
-		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Value))
-		i--
-		dAtA[i] = 0x8
+func (m *FrostVaultKey) Reset()         { *m = FrostVaultKey{} }
+func (m *FrostVaultKey) String() string { return proto.CompactTextString(m) }
+func (*FrostVaultKey) ProtoMessage()    {}
+func (*FrostVaultKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ff68b16012a2359, []int{19}
+}
+func (m *FrostVaultKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FrostVaultKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FrostVaultKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *FrostVaultKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FrostVaultKey.Merge(m, src)
+}
+func (m *FrostVaultKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *FrostVaultKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_FrostVaultKey.DiscardUnknown(m)
 }
 
-func (m *SigningRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_FrostVaultKey proto.InternalMessageInfo
+
+func (m *FrostVaultKey) GetVault() string {
+	if m != nil {
+		return m.Vault
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *SigningRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *FrostVaultKey) GetPubKey() string {
+	if m != nil {
+		return m.PubKey
+	}
+	return ""
 }
 
-func (m *SigningRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Status != 0 {
-		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x38
+func (m *FrostVaultKey) GetThreshold() uint32 {
+	if m != nil {
+		return m.Threshold
 	}
-	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CreationTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreationTime):])
-	if err1 != nil {
-		return 0, err1
+	return 0
+}
+
+func (m *FrostVaultKey) GetSigners() []FrostSigner {
+	if m != nil {
+		return m.Signers
 	}
-	i -= n1
-	i = encodeVarintBtcbridge(dAtA, i, uint64(n1))
-	i--
-	dAtA[i] = 0x32
-	if len(m.Psbt) > 0 {
-		i -= len(m.Psbt)
-		copy(dAtA[i:], m.Psbt)
-		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.Psbt)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Txid) > 0 {
-		i -= len(m.Txid)
-		copy(dAtA[i:], m.Txid)
-		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.Txid)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.Type != 0 {
-		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Type))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.Sequence != 0 {
-		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Sequence))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *WithdrawRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// FrostNonceCommitment defines the FROST nonce commitment for a signing request input
+type FrostNonceCommitment struct {
+	// hex encoded compressed hiding nonce commitment
+	Hiding string `protobuf:"bytes,1,opt,name=hiding,proto3" json:"hiding,omitempty"`
+	// hex encoded compressed binding nonce commitment
+	Binding string `protobuf:"bytes,2,opt,name=binding,proto3" json:"binding,omitempty"`
 }
 
-func (m *WithdrawRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *FrostNonceCommitment) Reset()         { *m = FrostNonceCommitment{} }
+func (m *FrostNonceCommitment) String() string { return proto.CompactTextString(m) }
+func (*FrostNonceCommitment) ProtoMessage()    {}
+func (*FrostNonceCommitment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ff68b16012a2359, []int{20}
 }
-
-func (m *WithdrawRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Txid) > 0 {
-		i -= len(m.Txid)
-		copy(dAtA[i:], m.Txid)
-		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.Txid)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.Sequence != 0 {
-		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Sequence))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Amount) > 0 {
-		i -= len(m.Amount)
-		copy(dAtA[i:], m.Amount)
-		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.Amount)))
-		i--
-		dAtA[i] = 0x12
+func (m *FrostNonceCommitment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FrostNonceCommitment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FrostNonceCommitment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *FrostNonceCommitment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FrostNonceCommitment.Merge(m, src)
+}
+func (m *FrostNonceCommitment) XXX_Size() int {
+	return m.Size()
+}
+func (m *FrostNonceCommitment) XXX_DiscardUnknown() {
+	xxx_messageInfo_FrostNonceCommitment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FrostNonceCommitment proto.InternalMessageInfo
+
+func (m *FrostNonceCommitment) GetHiding() string {
+	if m != nil {
+		return m.Hiding
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *UTXO) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *FrostNonceCommitment) GetBinding() string {
+	if m != nil {
+		return m.Binding
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *UTXO) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// FrostSignerRound defines the round data submitted by the FROST signer
+type FrostSignerRound struct {
+	// operator address of the signer
+	OperatorAddress string `protobuf:"bytes,1,opt,name=operator_address,json=operatorAddress,proto3" json:"operator_address,omitempty"`
+	// nonce commitments for each input
+	Commitments []FrostNonceCommitment `protobuf:"bytes,2,rep,name=commitments,proto3" json:"commitments"`
+	// hex encoded partial signatures for each input
+	PartialSignatures []string `protobuf:"bytes,3,rep,name=partial_signatures,json=partialSignatures,proto3" json:"partial_signatures,omitempty"`
 }
 
-func (m *UTXO) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Runes) > 0 {
-		for iNdEx := len(m.Runes) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Runes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintBtcbridge(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x42
-		}
-	}
-	if m.IsLocked {
-		i--
-		if m.IsLocked {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *FrostSignerRound) Reset()         { *m = FrostSignerRound{} }
+func (m *FrostSignerRound) String() string { return proto.CompactTextString(m) }
+func (*FrostSignerRound) ProtoMessage()    {}
+func (*FrostSignerRound) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ff68b16012a2359, []int{21}
+}
+func (m *FrostSignerRound) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FrostSignerRound) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FrostSignerRound.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x38
-	}
-	if len(m.PubKeyScript) > 0 {
-		i -= len(m.PubKeyScript)
-		copy(dAtA[i:], m.PubKeyScript)
-		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.PubKeyScript)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.Height != 0 {
-		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.Amount != 0 {
-		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Amount))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Vout != 0 {
-		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Vout))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Txid) > 0 {
-		i -= len(m.Txid)
-		copy(dAtA[i:], m.Txid)
-		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.Txid)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *FrostSignerRound) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FrostSignerRound.Merge(m, src)
+}
+func (m *FrostSignerRound) XXX_Size() int {
+	return m.Size()
+}
+func (m *FrostSignerRound) XXX_DiscardUnknown() {
+	xxx_messageInfo_FrostSignerRound.DiscardUnknown(m)
 }
 
-func (m *RuneBalance) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_FrostSignerRound proto.InternalMessageInfo
+
+func (m *FrostSignerRound) GetOperatorAddress() string {
+	if m != nil {
+		return m.OperatorAddress
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *RuneBalance) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *FrostSignerRound) GetCommitments() []FrostNonceCommitment {
+	if m != nil {
+		return m.Commitments
+	}
+	return nil
 }
 
-func (m *RuneBalance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Amount) > 0 {
-		i -= len(m.Amount)
-		copy(dAtA[i:], m.Amount)
-		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.Amount)))
-		i--
-		dAtA[i] = 0x12
+func (m *FrostSignerRound) GetPartialSignatures() []string {
+	if m != nil {
+		return m.PartialSignatures
 	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
+	return nil
+}
+
+// FrostSigningSession defines the FROST signing session of the signing request
+type FrostSigningSession struct {
+	// tx hash of the signing request
+	Txid string `protobuf:"bytes,1,opt,name=txid,proto3" json:"txid,omitempty"`
+	// status
+	Status FrostSigningStatus `protobuf:"varint,2,opt,name=status,proto3,enum=side.btcbridge.FrostSigningStatus" json:"status,omitempty"`
+	// signing round starting from 1, which is increased once any signer misbehaves
+	Round uint32 `protobuf:"varint,3,opt,name=round,proto3" json:"round,omitempty"`
+	// number of the signers required
+	Threshold uint32 `protobuf:"varint,4,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// signers of the current round in the order of submission
+	Signers []FrostSignerRound `protobuf:"bytes,5,rep,name=signers,proto3" json:"signers"`
+	// operator addresses of the misbehaving signers excluded from the session
+	MisbehavingSigners []string `protobuf:"bytes,6,rep,name=misbehaving_signers,json=misbehavingSigners,proto3" json:"misbehaving_signers,omitempty"`
+	// start time of the current phase of the round
+	RoundStartTime time.Time `protobuf:"bytes,7,opt,name=round_start_time,json=roundStartTime,proto3,stdtime" json:"round_start_time"`
+}
+
+func (m *FrostSigningSession) Reset()         { *m = FrostSigningSession{} }
+func (m *FrostSigningSession) String() string { return proto.CompactTextString(m) }
+func (*FrostSigningSession) ProtoMessage()    {}
+func (*FrostSigningSession) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ff68b16012a2359, []int{22}
+}
+func (m *FrostSigningSession) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FrostSigningSession) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FrostSigningSession.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *FrostSigningSession) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FrostSigningSession.Merge(m, src)
+}
+func (m *FrostSigningSession) XXX_Size() int {
+	return m.Size()
+}
+func (m *FrostSigningSession) XXX_DiscardUnknown() {
+	xxx_messageInfo_FrostSigningSession.DiscardUnknown(m)
 }
 
-func (m *RuneId) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_FrostSigningSession proto.InternalMessageInfo
+
+func (m *FrostSigningSession) GetTxid() string {
+	if m != nil {
+		return m.Txid
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *RuneId) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *FrostSigningSession) GetStatus() FrostSigningStatus {
+	if m != nil {
+		return m.Status
+	}
+	return FrostSigningStatus_FROST_SIGNING_STATUS_UNSPECIFIED
 }
 
-func (m *RuneId) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Tx != 0 {
-		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Tx))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.Block != 0 {
-		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Block))
-		i--
-		dAtA[i] = 0x8
+func (m *FrostSigningSession) GetRound() uint32 {
+	if m != nil {
+		return m.Round
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *Edict) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *FrostSigningSession) GetThreshold() uint32 {
+	if m != nil {
+		return m.Threshold
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Edict) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *FrostSigningSession) GetSigners() []FrostSignerRound {
+	if m != nil {
+		return m.Signers
+	}
+	return nil
 }
 
-func (m *Edict) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Output != 0 {
-		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Output))
-		i--
-		dAtA[i] = 0x18
+func (m *FrostSigningSession) GetMisbehavingSigners() []string {
+	if m != nil {
+		return m.MisbehavingSigners
 	}
-	if len(m.Amount) > 0 {
-		i -= len(m.Amount)
-		copy(dAtA[i:], m.Amount)
-		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.Amount)))
-		i--
-		dAtA[i] = 0x12
+	return nil
+}
+
+func (m *FrostSigningSession) GetRoundStartTime() time.Time {
+	if m != nil {
+		return m.RoundStartTime
 	}
-	if m.Id != nil {
-		{
-			size, err := m.Id.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintBtcbridge(dAtA, i, uint64(size))
+	return time.Time{}
+}
+
+// SignerPerformance defines the liveness performance of the TSS participant
+type SignerPerformance struct {
+	// operator address of the participant
+	OperatorAddress string `protobuf:"bytes,1,opt,name=operator_address,json=operatorAddress,proto3" json:"operator_address,omitempty"`
+	// block heights of the missed DKG completions in the current window
+	MissedDkgHeights []int64 `protobuf:"varint,2,rep,packed,name=missed_dkg_heights,json=missedDkgHeights,proto3" json:"missed_dkg_heights,omitempty"`
+	// block heights of the missed signing rounds in the current window
+	MissedSigningHeights []int64 `protobuf:"varint,3,rep,packed,name=missed_signing_heights,json=missedSigningHeights,proto3" json:"missed_signing_heights,omitempty"`
+	// total number of the missed DKG completions
+	TotalMissedDkgs uint64 `protobuf:"varint,4,opt,name=total_missed_dkgs,json=totalMissedDkgs,proto3" json:"total_missed_dkgs,omitempty"`
+	// total number of the missed signing rounds
+	TotalMissedSignings uint64 `protobuf:"varint,5,opt,name=total_missed_signings,json=totalMissedSignings,proto3" json:"total_missed_signings,omitempty"`
+	// number of times the participant has been punished
+	Punishments uint64 `protobuf:"varint,6,opt,name=punishments,proto3" json:"punishments,omitempty"`
+	// block height of the last punishment
+	LastPunishmentHeight int64 `protobuf:"varint,7,opt,name=last_punishment_height,json=lastPunishmentHeight,proto3" json:"last_punishment_height,omitempty"`
+}
+
+func (m *SignerPerformance) Reset()         { *m = SignerPerformance{} }
+func (m *SignerPerformance) String() string { return proto.CompactTextString(m) }
+func (*SignerPerformance) ProtoMessage()    {}
+func (*SignerPerformance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ff68b16012a2359, []int{23}
+}
+func (m *SignerPerformance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SignerPerformance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SignerPerformance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *SignerPerformance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignerPerformance.Merge(m, src)
+}
+func (m *SignerPerformance) XXX_Size() int {
+	return m.Size()
+}
+func (m *SignerPerformance) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignerPerformance.DiscardUnknown(m)
 }
 
-func (m *BtcConsolidation) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_SignerPerformance proto.InternalMessageInfo
+
+func (m *SignerPerformance) GetOperatorAddress() string {
+	if m != nil {
+		return m.OperatorAddress
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *BtcConsolidation) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *SignerPerformance) GetMissedDkgHeights() []int64 {
+	if m != nil {
+		return m.MissedDkgHeights
+	}
+	return nil
 }
 
-func (m *BtcConsolidation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.MaxNum != 0 {
-		i = encodeVarintBtcbridge(dAtA, i, uint64(m.MaxNum))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.TargetThreshold != 0 {
-		i = encodeVarintBtcbridge(dAtA, i, uint64(m.TargetThreshold))
-		i--
-		dAtA[i] = 0x8
+func (m *SignerPerformance) GetMissedSigningHeights() []int64 {
+	if m != nil {
+		return m.MissedSigningHeights
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *RunesConsolidation) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *SignerPerformance) GetTotalMissedDkgs() uint64 {
+	if m != nil {
+		return m.TotalMissedDkgs
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *RunesConsolidation) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *SignerPerformance) GetTotalMissedSignings() uint64 {
+	if m != nil {
+		return m.TotalMissedSignings
+	}
+	return 0
 }
 
-func (m *RunesConsolidation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.MaxNum != 0 {
-		i = encodeVarintBtcbridge(dAtA, i, uint64(m.MaxNum))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.TargetThreshold) > 0 {
-		i -= len(m.TargetThreshold)
-		copy(dAtA[i:], m.TargetThreshold)
-		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.TargetThreshold)))
-		i--
-		dAtA[i] = 0x12
+func (m *SignerPerformance) GetPunishments() uint64 {
+	if m != nil {
+		return m.Punishments
 	}
-	if len(m.RuneId) > 0 {
-		i -= len(m.RuneId)
-		copy(dAtA[i:], m.RuneId)
-		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.RuneId)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *SignerPerformance) GetLastPunishmentHeight() int64 {
+	if m != nil {
+		return m.LastPunishmentHeight
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *DKGParticipant) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+// AssetTransfer defines the transfer progress of the vault of the given asset type
+type AssetTransfer struct {
+	// asset type
+	AssetType AssetType `protobuf:"varint,1,opt,name=asset_type,json=assetType,proto3,enum=side.btcbridge.AssetType" json:"asset_type,omitempty"`
+	// source vault address
+	SourceVault string `protobuf:"bytes,2,opt,name=source_vault,json=sourceVault,proto3" json:"source_vault,omitempty"`
+	// destination vault address
+	DestVault string `protobuf:"bytes,3,opt,name=dest_vault,json=destVault,proto3" json:"dest_vault,omitempty"`
+	// status
+	Status VaultTransferStatus `protobuf:"varint,4,opt,name=status,proto3,enum=side.btcbridge.VaultTransferStatus" json:"status,omitempty"`
+	// tx hashes of the signing requests created for the transfer
+	SigningRequests []string `protobuf:"bytes,5,rep,name=signing_requests,json=signingRequests,proto3" json:"signing_requests,omitempty"`
+	// number of the utxos remaining in the source vault
+	RemainingUtxoNum uint64 `protobuf:"varint,6,opt,name=remaining_utxo_num,json=remainingUtxoNum,proto3" json:"remaining_utxo_num,omitempty"`
+	// total value in satoshis of the utxos remaining in the source vault
+	RemainingValue uint64 `protobuf:"varint,7,opt,name=remaining_value,json=remainingValue,proto3" json:"remaining_value,omitempty"`
+	// error of the last failed transfer step; cleared once a step succeeds
+	LastError string `protobuf:"bytes,8,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+}
+
+func (m *AssetTransfer) Reset()         { *m = AssetTransfer{} }
+func (m *AssetTransfer) String() string { return proto.CompactTextString(m) }
+func (*AssetTransfer) ProtoMessage()    {}
+func (*AssetTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ff68b16012a2359, []int{24}
+}
+func (m *AssetTransfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AssetTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AssetTransfer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *AssetTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AssetTransfer.Merge(m, src)
+}
+func (m *AssetTransfer) XXX_Size() int {
+	return m.Size()
+}
+func (m *AssetTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_AssetTransfer.DiscardUnknown(m)
 }
 
-func (m *DKGParticipant) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_AssetTransfer proto.InternalMessageInfo
+
+func (m *AssetTransfer) GetAssetType() AssetType {
+	if m != nil {
+		return m.AssetType
+	}
+	return AssetType_ASSET_TYPE_UNSPECIFIED
 }
 
-func (m *DKGParticipant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ConsensusPubkey) > 0 {
-		i -= len(m.ConsensusPubkey)
-		copy(dAtA[i:], m.ConsensusPubkey)
-		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.ConsensusPubkey)))
-		i--
-		dAtA[i] = 0x1a
+func (m *AssetTransfer) GetSourceVault() string {
+	if m != nil {
+		return m.SourceVault
 	}
-	if len(m.OperatorAddress) > 0 {
-		i -= len(m.OperatorAddress)
-		copy(dAtA[i:], m.OperatorAddress)
-		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.OperatorAddress)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *AssetTransfer) GetDestVault() string {
+	if m != nil {
+		return m.DestVault
 	}
-	if len(m.Moniker) > 0 {
-		i -= len(m.Moniker)
-		copy(dAtA[i:], m.Moniker)
-		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.Moniker)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *AssetTransfer) GetStatus() VaultTransferStatus {
+	if m != nil {
+		return m.Status
 	}
-	return len(dAtA) - i, nil
+	return VaultTransferStatus_VAULT_TRANSFER_STATUS_UNSPECIFIED
 }
 
-func (m *DKGRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *AssetTransfer) GetSigningRequests() []string {
+	if m != nil {
+		return m.SigningRequests
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *DKGRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *AssetTransfer) GetRemainingUtxoNum() uint64 {
+	if m != nil {
+		return m.RemainingUtxoNum
+	}
+	return 0
 }
 
-func (m *DKGRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Status != 0 {
-		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x40
+func (m *AssetTransfer) GetRemainingValue() uint64 {
+	if m != nil {
+		return m.RemainingValue
 	}
-	if m.Expiration != nil {
-		n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration):])
-		if err3 != nil {
-			return 0, err3
-		}
-		i -= n3
-		i = encodeVarintBtcbridge(dAtA, i, uint64(n3))
-		i--
-		dAtA[i] = 0x3a
+	return 0
+}
+
+func (m *AssetTransfer) GetLastError() string {
+	if m != nil {
+		return m.LastError
 	}
-	if m.TargetUtxoNum != 0 {
-		i = encodeVarintBtcbridge(dAtA, i, uint64(m.TargetUtxoNum))
-		i--
-		dAtA[i] = 0x30
-	}
-	if m.EnableTransfer {
-		i--
-		if m.EnableTransfer {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.VaultTypes) > 0 {
-		dAtA5 := make([]byte, len(m.VaultTypes)*10)
-		var j4 int
-		for _, num := range m.VaultTypes {
-			for num >= 1<<7 {
-				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j4++
-			}
-			dAtA5[j4] = uint8(num)
-			j4++
+	return ""
+}
+
+// VaultTransfer defines the asset transfer from the vaults of the source version to the destination version
+type VaultTransfer struct {
+	// source vault version
+	SourceVersion uint64 `protobuf:"varint,1,opt,name=source_version,json=sourceVersion,proto3" json:"source_version,omitempty"`
+	// destination vault version
+	DestVersion uint64 `protobuf:"varint,2,opt,name=dest_version,json=destVersion,proto3" json:"dest_version,omitempty"`
+	// id of the DKG request enabling the transfer; 0 means the transfer initiated by governance
+	DkgId uint64 `protobuf:"varint,3,opt,name=dkg_id,json=dkgId,proto3" json:"dkg_id,omitempty"`
+	// status
+	Status VaultTransferStatus `protobuf:"varint,4,opt,name=status,proto3,enum=side.btcbridge.VaultTransferStatus" json:"status,omitempty"`
+	// transfer progress per asset type
+	Assets []AssetTransfer `protobuf:"bytes,5,rep,name=assets,proto3" json:"assets"`
+	// block height at which the transfer started
+	StartHeight int64 `protobuf:"varint,6,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// block height at which the transfer completed
+	CompletionHeight int64 `protobuf:"varint,7,opt,name=completion_height,json=completionHeight,proto3" json:"completion_height,omitempty"`
+}
+
+func (m *VaultTransfer) Reset()         { *m = VaultTransfer{} }
+func (m *VaultTransfer) String() string { return proto.CompactTextString(m) }
+func (*VaultTransfer) ProtoMessage()    {}
+func (*VaultTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ff68b16012a2359, []int{25}
+}
+func (m *VaultTransfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VaultTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VaultTransfer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i -= j4
-		copy(dAtA[i:], dAtA5[:j4])
-		i = encodeVarintBtcbridge(dAtA, i, uint64(j4))
-		i--
-		dAtA[i] = 0x22
+		return b[:n], nil
 	}
-	if m.Threshold != 0 {
-		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Threshold))
-		i--
-		dAtA[i] = 0x18
+}
+func (m *VaultTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VaultTransfer.Merge(m, src)
+}
+func (m *VaultTransfer) XXX_Size() int {
+	return m.Size()
+}
+func (m *VaultTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_VaultTransfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VaultTransfer proto.InternalMessageInfo
+
+func (m *VaultTransfer) GetSourceVersion() uint64 {
+	if m != nil {
+		return m.SourceVersion
 	}
-	if len(m.Participants) > 0 {
-		for iNdEx := len(m.Participants) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Participants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintBtcbridge(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+	return 0
+}
+
+func (m *VaultTransfer) GetDestVersion() uint64 {
+	if m != nil {
+		return m.DestVersion
 	}
-	if m.Id != 0 {
-		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+	return 0
+}
+
+func (m *VaultTransfer) GetDkgId() uint64 {
+	if m != nil {
+		return m.DkgId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *DKGCompletionRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *VaultTransfer) GetStatus() VaultTransferStatus {
+	if m != nil {
+		return m.Status
 	}
-	return dAtA[:n], nil
+	return VaultTransferStatus_VAULT_TRANSFER_STATUS_UNSPECIFIED
 }
 
-func (m *DKGCompletionRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *VaultTransfer) GetAssets() []AssetTransfer {
+	if m != nil {
+		return m.Assets
+	}
+	return nil
 }
 
-func (m *DKGCompletionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signature) > 0 {
-		i -= len(m.Signature)
-		copy(dAtA[i:], m.Signature)
-		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.Signature)))
-		i--
-		dAtA[i] = 0x2a
+func (m *VaultTransfer) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
 	}
-	if len(m.ConsensusAddress) > 0 {
-		i -= len(m.ConsensusAddress)
-		copy(dAtA[i:], m.ConsensusAddress)
-		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.ConsensusAddress)))
-		i--
-		dAtA[i] = 0x22
+	return 0
+}
+
+func (m *VaultTransfer) GetCompletionHeight() int64 {
+	if m != nil {
+		return m.CompletionHeight
 	}
-	if len(m.Vaults) > 0 {
-		for iNdEx := len(m.Vaults) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Vaults[iNdEx])
-			copy(dAtA[i:], m.Vaults[iNdEx])
-			i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.Vaults[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
+	return 0
+}
+
+// FeeBucketStats defines the protocol fee statistics of the given bucket
+type FeeBucketStats struct {
+	// bucket
+	Bucket FeeBucket `protobuf:"varint,1,opt,name=bucket,proto3,enum=side.btcbridge.FeeBucket" json:"bucket,omitempty"`
+	// fees allocated to the bucket at the settlements in total
+	Accumulated github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=accumulated,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"accumulated"`
+	// fees paid out of the bucket to the recipients in total
+	Distributed github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=distributed,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"distributed"`
+}
+
+func (m *FeeBucketStats) Reset()         { *m = FeeBucketStats{} }
+func (m *FeeBucketStats) String() string { return proto.CompactTextString(m) }
+func (*FeeBucketStats) ProtoMessage()    {}
+func (*FeeBucketStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ff68b16012a2359, []int{26}
+}
+func (m *FeeBucketStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeBucketStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeBucketStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Id != 0 {
-		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+}
+func (m *FeeBucketStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeBucketStats.Merge(m, src)
+}
+func (m *FeeBucketStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeBucketStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeBucketStats.DiscardUnknown(m)
 }
 
-func encodeVarintBtcbridge(dAtA []byte, offset int, v uint64) int {
-	offset -= sovBtcbridge(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_FeeBucketStats proto.InternalMessageInfo
+
+func (m *FeeBucketStats) GetBucket() FeeBucket {
+	if m != nil {
+		return m.Bucket
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return FeeBucket_FEE_BUCKET_UNSPECIFIED
 }
-func (m *BlockHeader) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Version != 0 {
-		n += 1 + sovBtcbridge(uint64(m.Version))
-	}
-	l = len(m.Hash)
-	if l > 0 {
-		n += 1 + l + sovBtcbridge(uint64(l))
+
+func (m *FeeBucketStats) GetAccumulated() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Accumulated
 	}
-	if m.Height != 0 {
-		n += 1 + sovBtcbridge(uint64(m.Height))
+	return nil
+}
+
+func (m *FeeBucketStats) GetDistributed() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Distributed
 	}
-	l = len(m.PreviousBlockHash)
-	if l > 0 {
-		n += 1 + l + sovBtcbridge(uint64(l))
+	return nil
+}
+
+// CircuitBreaker defines the state of the withdrawal circuit breaker
+type CircuitBreaker struct {
+	// Indicates if the breaker is tripped, in which case the withdrawals are paused until reset by governance
+	Tripped bool `protobuf:"varint,1,opt,name=tripped,proto3" json:"tripped,omitempty"`
+	// block height at which the breaker is tripped
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// denom of the asset whose outflow tripped the breaker; empty for the global limit
+	Denom string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *CircuitBreaker) Reset()         { *m = CircuitBreaker{} }
+func (m *CircuitBreaker) String() string { return proto.CompactTextString(m) }
+func (*CircuitBreaker) ProtoMessage()    {}
+func (*CircuitBreaker) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ff68b16012a2359, []int{27}
+}
+func (m *CircuitBreaker) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CircuitBreaker) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CircuitBreaker.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.MerkleRoot)
-	if l > 0 {
-		n += 1 + l + sovBtcbridge(uint64(l))
+}
+func (m *CircuitBreaker) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CircuitBreaker.Merge(m, src)
+}
+func (m *CircuitBreaker) XXX_Size() int {
+	return m.Size()
+}
+func (m *CircuitBreaker) XXX_DiscardUnknown() {
+	xxx_messageInfo_CircuitBreaker.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CircuitBreaker proto.InternalMessageInfo
+
+func (m *CircuitBreaker) GetTripped() bool {
+	if m != nil {
+		return m.Tripped
 	}
-	if m.Nonce != 0 {
-		n += 1 + sovBtcbridge(uint64(m.Nonce))
+	return false
+}
+
+func (m *CircuitBreaker) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	l = len(m.Bits)
-	if l > 0 {
-		n += 1 + l + sovBtcbridge(uint64(l))
+	return 0
+}
+
+func (m *CircuitBreaker) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	if m.Time != 0 {
-		n += 1 + sovBtcbridge(uint64(m.Time))
+	return ""
+}
+
+// OutflowUsage defines the outflow of the current rate limit window
+type OutflowUsage struct {
+	// asset denom; empty for the global limit
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// outflow amount in the current window
+	Used string `protobuf:"bytes,2,opt,name=used,proto3" json:"used,omitempty"`
+	// outflow limit per window; zero means no limit
+	Limit string `protobuf:"bytes,3,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (m *OutflowUsage) Reset()         { *m = OutflowUsage{} }
+func (m *OutflowUsage) String() string { return proto.CompactTextString(m) }
+func (*OutflowUsage) ProtoMessage()    {}
+func (*OutflowUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ff68b16012a2359, []int{28}
+}
+func (m *OutflowUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OutflowUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OutflowUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.Ntx != 0 {
-		n += 1 + sovBtcbridge(uint64(m.Ntx))
+}
+func (m *OutflowUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OutflowUsage.Merge(m, src)
+}
+func (m *OutflowUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *OutflowUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_OutflowUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OutflowUsage proto.InternalMessageInfo
+
+func (m *OutflowUsage) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return n
+	return ""
 }
 
-func (m *FeeRate) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *OutflowUsage) GetUsed() string {
+	if m != nil {
+		return m.Used
 	}
-	var l int
-	_ = l
-	if m.Value != 0 {
-		n += 1 + sovBtcbridge(uint64(m.Value))
-	}
-	if m.Height != 0 {
-		n += 1 + sovBtcbridge(uint64(m.Height))
-	}
-	return n
+	return ""
 }
 
-func (m *SigningRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovBtcbridge(uint64(l))
-	}
-	if m.Sequence != 0 {
-		n += 1 + sovBtcbridge(uint64(m.Sequence))
-	}
-	if m.Type != 0 {
-		n += 1 + sovBtcbridge(uint64(m.Type))
+func (m *OutflowUsage) GetLimit() string {
+	if m != nil {
+		return m.Limit
 	}
-	l = len(m.Txid)
-	if l > 0 {
-		n += 1 + l + sovBtcbridge(uint64(l))
+	return ""
+}
+
+// EmergencyPause defines the emergency pause issued by the guardian
+type EmergencyPause struct {
+	// pause id
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// guardian who issued the pause
+	Guardian string `protobuf:"bytes,2,opt,name=guardian,proto3" json:"guardian,omitempty"`
+	// pause scope
+	Scope PauseScope `protobuf:"varint,3,opt,name=scope,proto3,enum=side.btcbridge.PauseScope" json:"scope,omitempty"`
+	// asset denom for the asset scope
+	Denom string `protobuf:"bytes,4,opt,name=denom,proto3" json:"denom,omitempty"`
+	// start time
+	StartTime time.Time `protobuf:"bytes,5,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	// expiration time
+	Expiration time.Time `protobuf:"bytes,6,opt,name=expiration,proto3,stdtime" json:"expiration"`
+	// Indicates if the pause is lifted by governance before expiration
+	Lifted bool `protobuf:"varint,7,opt,name=lifted,proto3" json:"lifted,omitempty"`
+}
+
+func (m *EmergencyPause) Reset()         { *m = EmergencyPause{} }
+func (m *EmergencyPause) String() string { return proto.CompactTextString(m) }
+func (*EmergencyPause) ProtoMessage()    {}
+func (*EmergencyPause) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ff68b16012a2359, []int{29}
+}
+func (m *EmergencyPause) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EmergencyPause) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EmergencyPause.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.Psbt)
-	if l > 0 {
-		n += 1 + l + sovBtcbridge(uint64(l))
+}
+func (m *EmergencyPause) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EmergencyPause.Merge(m, src)
+}
+func (m *EmergencyPause) XXX_Size() int {
+	return m.Size()
+}
+func (m *EmergencyPause) XXX_DiscardUnknown() {
+	xxx_messageInfo_EmergencyPause.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EmergencyPause proto.InternalMessageInfo
+
+func (m *EmergencyPause) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreationTime)
-	n += 1 + l + sovBtcbridge(uint64(l))
-	if m.Status != 0 {
-		n += 1 + sovBtcbridge(uint64(m.Status))
+	return 0
+}
+
+func (m *EmergencyPause) GetGuardian() string {
+	if m != nil {
+		return m.Guardian
 	}
-	return n
+	return ""
 }
 
-func (m *WithdrawRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EmergencyPause) GetScope() PauseScope {
+	if m != nil {
+		return m.Scope
 	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovBtcbridge(uint64(l))
+	return PauseScope_PAUSE_SCOPE_UNSPECIFIED
+}
+
+func (m *EmergencyPause) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	l = len(m.Amount)
-	if l > 0 {
-		n += 1 + l + sovBtcbridge(uint64(l))
+	return ""
+}
+
+func (m *EmergencyPause) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
 	}
-	if m.Sequence != 0 {
-		n += 1 + sovBtcbridge(uint64(m.Sequence))
+	return time.Time{}
+}
+
+func (m *EmergencyPause) GetExpiration() time.Time {
+	if m != nil {
+		return m.Expiration
 	}
-	l = len(m.Txid)
-	if l > 0 {
-		n += 1 + l + sovBtcbridge(uint64(l))
+	return time.Time{}
+}
+
+func (m *EmergencyPause) GetLifted() bool {
+	if m != nil {
+		return m.Lifted
 	}
-	return n
+	return false
 }
 
-func (m *UTXO) Size() (n int) {
-	if m == nil {
-		return 0
+func init() {
+	proto.RegisterEnum("side.btcbridge.SigningStatus", SigningStatus_name, SigningStatus_value)
+	proto.RegisterEnum("side.btcbridge.SigningTransition", SigningTransition_name, SigningTransition_value)
+	proto.RegisterEnum("side.btcbridge.DKGRequestStatus", DKGRequestStatus_name, DKGRequestStatus_value)
+	proto.RegisterEnum("side.btcbridge.FrostSigningStatus", FrostSigningStatus_name, FrostSigningStatus_value)
+	proto.RegisterEnum("side.btcbridge.VaultTransferStatus", VaultTransferStatus_name, VaultTransferStatus_value)
+	proto.RegisterEnum("side.btcbridge.FeeBucket", FeeBucket_name, FeeBucket_value)
+	proto.RegisterEnum("side.btcbridge.PauseScope", PauseScope_name, PauseScope_value)
+	proto.RegisterType((*BlockHeader)(nil), "side.btcbridge.BlockHeader")
+	proto.RegisterType((*FeeRate)(nil), "side.btcbridge.FeeRate")
+	proto.RegisterType((*SigningRequest)(nil), "side.btcbridge.SigningRequest")
+	proto.RegisterType((*SigningRequestHistoryEntry)(nil), "side.btcbridge.SigningRequestHistoryEntry")
+	proto.RegisterType((*SigningRequestHistory)(nil), "side.btcbridge.SigningRequestHistory")
+	proto.RegisterType((*WithdrawRequest)(nil), "side.btcbridge.WithdrawRequest")
+	proto.RegisterType((*UTXO)(nil), "side.btcbridge.UTXO")
+	proto.RegisterType((*RuneBalance)(nil), "side.btcbridge.RuneBalance")
+	proto.RegisterType((*RuneId)(nil), "side.btcbridge.RuneId")
+	proto.RegisterType((*Edict)(nil), "side.btcbridge.Edict")
+	proto.RegisterType((*RuneMetadata)(nil), "side.btcbridge.RuneMetadata")
+	proto.RegisterType((*Brc20Metadata)(nil), "side.btcbridge.Brc20Metadata")
+	proto.RegisterType((*BtcConsolidation)(nil), "side.btcbridge.BtcConsolidation")
+	proto.RegisterType((*RunesConsolidation)(nil), "side.btcbridge.RunesConsolidation")
+	proto.RegisterType((*DKGParticipant)(nil), "side.btcbridge.DKGParticipant")
+	proto.RegisterType((*DKGRequest)(nil), "side.btcbridge.DKGRequest")
+	proto.RegisterType((*DKGCompletionRequest)(nil), "side.btcbridge.DKGCompletionRequest")
+	proto.RegisterType((*VerificationShares)(nil), "side.btcbridge.VerificationShares")
+	proto.RegisterType((*FrostSigner)(nil), "side.btcbridge.FrostSigner")
+	proto.RegisterType((*FrostVaultKey)(nil), "side.btcbridge.FrostVaultKey")
+	proto.RegisterType((*FrostNonceCommitment)(nil), "side.btcbridge.FrostNonceCommitment")
+	proto.RegisterType((*FrostSignerRound)(nil), "side.btcbridge.FrostSignerRound")
+	proto.RegisterType((*FrostSigningSession)(nil), "side.btcbridge.FrostSigningSession")
+	proto.RegisterType((*SignerPerformance)(nil), "side.btcbridge.SignerPerformance")
+	proto.RegisterType((*AssetTransfer)(nil), "side.btcbridge.AssetTransfer")
+	proto.RegisterType((*VaultTransfer)(nil), "side.btcbridge.VaultTransfer")
+	proto.RegisterType((*FeeBucketStats)(nil), "side.btcbridge.FeeBucketStats")
+	proto.RegisterType((*CircuitBreaker)(nil), "side.btcbridge.CircuitBreaker")
+	proto.RegisterType((*OutflowUsage)(nil), "side.btcbridge.OutflowUsage")
+	proto.RegisterType((*EmergencyPause)(nil), "side.btcbridge.EmergencyPause")
+}
+
+func init() { proto.RegisterFile("side/btcbridge/btcbridge.proto", fileDescriptor_9ff68b16012a2359) }
+
+var fileDescriptor_9ff68b16012a2359 = []byte{
+	// 2856 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x19, 0x4d, 0x6f, 0x1b, 0xc7,
+	0xd5, 0x4b, 0x52, 0x94, 0xf8, 0x28, 0xd1, 0xf4, 0x58, 0xb6, 0x29, 0xd9, 0x96, 0xe5, 0xcd, 0x97,
+	0xa3, 0x24, 0x52, 0xac, 0x36, 0x68, 0x90, 0x1c, 0x5a, 0x8a, 0x5c, 0xc9, 0x84, 0x25, 0x52, 0x5d,
+	0x92, 0x4e, 0xd2, 0xcb, 0x62, 0xb9, 0x3b, 0xa2, 0x06, 0xe2, 0xee, 0x32, 0x3b, 0xb3, 0x8a, 0x78,
+	0x28, 0xd0, 0x73, 0xd1, 0x02, 0xb9, 0xf4, 0xd0, 0x53, 0xd1, 0x53, 0x81, 0xf6, 0xd0, 0x43, 0x2f,
+	0x45, 0x6e, 0xbd, 0xb4, 0x39, 0x06, 0xe8, 0xa5, 0x28, 0x8a, 0xa6, 0x48, 0x4e, 0xbd, 0xf7, 0x07,
+	0x14, 0xf3, 0xb1, 0xcb, 0x25, 0xb5, 0x72, 0x62, 0x14, 0x3d, 0x71, 0xde, 0xd7, 0xbc, 0x37, 0xef,
+	0xbd, 0x79, 0xef, 0xcd, 0x12, 0x36, 0x28, 0x71, 0xf1, 0xce, 0x80, 0x39, 0x83, 0x90, 0xb8, 0xc3,
+	0xd4, 0x6a, 0x7b, 0x1c, 0x06, 0x2c, 0x40, 0x15, 0x4e, 0xdf, 0x4e, 0xb0, 0xeb, 0xab, 0xc3, 0x60,
+	0x18, 0x08, 0xd2, 0x0e, 0x5f, 0x49, 0xae, 0xf5, 0xb5, 0x61, 0x10, 0x0c, 0x47, 0x78, 0x47, 0x40,
+	0x83, 0xe8, 0x64, 0xc7, 0xf6, 0x27, 0x8a, 0xf4, 0x60, 0x9e, 0xc4, 0x88, 0x87, 0x29, 0xb3, 0xbd,
+	0xb1, 0x62, 0xd8, 0x70, 0x02, 0xea, 0x05, 0x74, 0x67, 0x60, 0x53, 0xbc, 0x73, 0xfe, 0x78, 0x80,
+	0x99, 0xfd, 0x78, 0xc7, 0x09, 0x88, 0x1f, 0xef, 0x2d, 0xe9, 0x96, 0x54, 0x2a, 0x01, 0x45, 0xba,
+	0x3b, 0x67, 0xfc, 0xd8, 0x0e, 0x6d, 0x4f, 0x11, 0xf5, 0xff, 0x68, 0x50, 0xde, 0x1b, 0x05, 0xce,
+	0xd9, 0x13, 0x6c, 0xbb, 0x38, 0x44, 0x35, 0x58, 0x3c, 0xc7, 0x21, 0x25, 0x81, 0x5f, 0xd3, 0x36,
+	0xb5, 0x47, 0x05, 0x33, 0x06, 0x11, 0x82, 0xc2, 0xa9, 0x4d, 0x4f, 0x6b, 0xb9, 0x4d, 0xed, 0x51,
+	0xc9, 0x14, 0x6b, 0x74, 0x1b, 0x8a, 0xa7, 0x98, 0x0c, 0x4f, 0x59, 0x2d, 0x2f, 0x98, 0x15, 0x84,
+	0xb6, 0xe1, 0xe6, 0x38, 0xc4, 0xe7, 0x24, 0x88, 0xa8, 0x35, 0xe0, 0xbb, 0x5b, 0x42, 0xb4, 0x20,
+	0x44, 0x6f, 0xc4, 0x24, 0xa9, 0x97, 0xef, 0xf3, 0x00, 0xca, 0x1e, 0x0e, 0xcf, 0x46, 0xd8, 0x0a,
+	0x83, 0x80, 0xd5, 0x16, 0x04, 0x1f, 0x48, 0x94, 0x19, 0x04, 0x0c, 0xad, 0xc2, 0x82, 0x1f, 0xf8,
+	0x0e, 0xae, 0x15, 0x85, 0x1e, 0x09, 0x70, 0x93, 0x06, 0x84, 0xd1, 0xda, 0xa2, 0x34, 0x89, 0xaf,
+	0x39, 0x8e, 0xfb, 0xae, 0xb6, 0x24, 0x18, 0xc5, 0x1a, 0x55, 0x21, 0xef, 0xb3, 0x8b, 0x5a, 0x49,
+	0xa0, 0xf8, 0x52, 0xff, 0x1e, 0x2c, 0xee, 0x63, 0x6c, 0xda, 0x0c, 0xf3, 0xad, 0xcf, 0xed, 0x51,
+	0x84, 0xc5, 0x79, 0xf3, 0xa6, 0x04, 0x52, 0x27, 0xcb, 0x09, 0xb4, 0x82, 0xf4, 0x5f, 0xe5, 0xa0,
+	0xd2, 0x25, 0x43, 0x9f, 0xf8, 0x43, 0x13, 0x7f, 0x1c, 0x61, 0xca, 0xb8, 0xcb, 0x6c, 0xd7, 0x0d,
+	0x31, 0xa5, 0x62, 0x8b, 0x92, 0x19, 0x83, 0x68, 0x1d, 0x96, 0x28, 0x67, 0xe2, 0x86, 0xe7, 0x84,
+	0xf2, 0x04, 0x46, 0x6f, 0x41, 0x81, 0x4d, 0xc6, 0x58, 0x38, 0xae, 0xb2, 0xbb, 0xb6, 0x3d, 0x9b,
+	0x41, 0xdb, 0x75, 0x4a, 0x31, 0xeb, 0x4d, 0xc6, 0xd8, 0x14, 0x6c, 0xe2, 0x58, 0x17, 0xc4, 0x55,
+	0x2e, 0x14, 0x6b, 0x8e, 0x1b, 0xd3, 0x41, 0xec, 0x2e, 0xb1, 0x46, 0x2d, 0x58, 0x71, 0x42, 0x6c,
+	0x33, 0x12, 0xf8, 0x96, 0xf0, 0x03, 0x77, 0x58, 0x79, 0x77, 0x7d, 0x5b, 0x26, 0xd8, 0x76, 0x9c,
+	0x60, 0xdb, 0xbd, 0x38, 0xc1, 0xf6, 0x96, 0x3e, 0xff, 0xe7, 0x83, 0x6b, 0x9f, 0x7e, 0xf9, 0x40,
+	0x33, 0x97, 0x63, 0x51, 0x4e, 0x44, 0xef, 0x40, 0x91, 0x32, 0x9b, 0x45, 0xd2, 0xbf, 0x95, 0xdd,
+	0xfb, 0xf3, 0x36, 0x2a, 0x3f, 0x74, 0x05, 0x93, 0xa9, 0x98, 0xf5, 0xbf, 0x68, 0xb0, 0x3e, 0xeb,
+	0xa1, 0x27, 0x84, 0xb2, 0x20, 0x9c, 0x18, 0x3e, 0x0b, 0x27, 0xa8, 0x0e, 0xc0, 0x42, 0xdb, 0xa7,
+	0x84, 0xc5, 0x39, 0x56, 0xd9, 0x7d, 0x78, 0xc5, 0xce, 0xbd, 0x84, 0xd1, 0x4c, 0x09, 0xf1, 0x88,
+	0xd9, 0x0e, 0x0b, 0x42, 0x95, 0x8a, 0x12, 0x98, 0xcb, 0xc5, 0x24, 0x62, 0xe8, 0x5d, 0x95, 0x10,
+	0x85, 0x17, 0x70, 0x84, 0x90, 0xd0, 0x27, 0x70, 0x2b, 0xf3, 0x20, 0x33, 0x71, 0xd5, 0xe6, 0xe2,
+	0xda, 0x84, 0x45, 0xec, 0xb3, 0x90, 0x60, 0x5a, 0xcb, 0x6d, 0xe6, 0x1f, 0x95, 0x77, 0xb7, 0xae,
+	0x38, 0x5c, 0x86, 0x73, 0xcc, 0x58, 0x54, 0xa7, 0x70, 0xfd, 0x03, 0xc2, 0x4e, 0xdd, 0xd0, 0xfe,
+	0xe4, 0x9b, 0xd3, 0xec, 0x36, 0x14, 0x6d, 0x2f, 0x88, 0x7c, 0xa6, 0x1c, 0xa2, 0xa0, 0x19, 0x33,
+	0xf3, 0x73, 0x66, 0x66, 0xe4, 0x93, 0xfe, 0x8b, 0x1c, 0x14, 0xfa, 0xbd, 0x0f, 0x3b, 0x09, 0x51,
+	0x9b, 0x4d, 0xb6, 0xf3, 0x20, 0x62, 0x2a, 0x8f, 0xc5, 0x3a, 0x6d, 0x52, 0xfe, 0x2a, 0x93, 0x0a,
+	0xb2, 0x30, 0x28, 0x93, 0xa6, 0x41, 0x5a, 0x98, 0x29, 0x18, 0x2f, 0x43, 0x65, 0x1c, 0x0d, 0xac,
+	0x33, 0x3c, 0xb1, 0xa8, 0x13, 0x92, 0x31, 0x13, 0x79, 0xbb, 0x6c, 0x2e, 0x8f, 0xa3, 0xc1, 0x53,
+	0x3c, 0xe9, 0x0a, 0x1c, 0xba, 0x0b, 0x25, 0x42, 0x2d, 0x5e, 0x35, 0xb0, 0x2b, 0x92, 0x72, 0xc9,
+	0x5c, 0x22, 0xf4, 0x50, 0xc0, 0xe8, 0x31, 0x2c, 0x84, 0x91, 0x8f, 0x69, 0x6d, 0x49, 0xb8, 0xfd,
+	0xee, 0xbc, 0xdb, 0xcd, 0xc8, 0xc7, 0x7b, 0xf6, 0xc8, 0xf6, 0x1d, 0x6c, 0x4a, 0x4e, 0xf4, 0x0a,
+	0x54, 0x3e, 0x21, 0xcc, 0xc7, 0x94, 0xc6, 0x5a, 0x4b, 0x42, 0xeb, 0x8a, 0xc2, 0x4a, 0xb5, 0xfa,
+	0x3b, 0x50, 0x4e, 0x09, 0xa3, 0x0a, 0xe4, 0x12, 0xdf, 0xe4, 0x88, 0x7b, 0x95, 0xfb, 0xf5, 0x6d,
+	0x28, 0x72, 0xb1, 0x96, 0xcb, 0x13, 0x56, 0x54, 0x41, 0x95, 0x2c, 0x12, 0xe0, 0xfb, 0xb0, 0x0b,
+	0x21, 0xb3, 0x62, 0xe6, 0xd8, 0x85, 0x6e, 0xc1, 0x82, 0xe1, 0x12, 0x87, 0xa1, 0x57, 0x13, 0x05,
+	0xe5, 0xdd, 0xdb, 0x59, 0xc7, 0x68, 0xb9, 0xcf, 0x53, 0xcc, 0xf1, 0x41, 0xc4, 0xc6, 0x91, 0xbc,
+	0x09, 0x2b, 0xa6, 0x82, 0xf4, 0x9f, 0x6b, 0xb0, 0xcc, 0xc5, 0x8f, 0x30, 0xb3, 0x5d, 0x9b, 0xd9,
+	0x97, 0x4e, 0x82, 0xa0, 0xe0, 0xdb, 0x1e, 0x8e, 0x4b, 0x3c, 0x5f, 0xf3, 0xcd, 0xe8, 0xc4, 0x1b,
+	0x04, 0x23, 0x15, 0x62, 0x05, 0x21, 0x1d, 0x96, 0x5d, 0x72, 0x4e, 0x28, 0x19, 0x90, 0x11, 0x61,
+	0x13, 0x11, 0xe7, 0x15, 0x73, 0x06, 0x87, 0xee, 0x03, 0x60, 0xe6, 0x9c, 0x12, 0x7f, 0x68, 0xb1,
+	0x0b, 0x55, 0xa6, 0x4a, 0x0a, 0xd3, 0xbb, 0xd0, 0xbf, 0x0f, 0x2b, 0x7b, 0xa1, 0xb3, 0xfb, 0x76,
+	0x62, 0x8f, 0xa8, 0xdd, 0xce, 0x59, 0x92, 0x77, 0xc4, 0x39, 0xe3, 0x49, 0xec, 0x62, 0x87, 0x78,
+	0xf6, 0x88, 0x2a, 0x5f, 0x25, 0xb0, 0xfe, 0x0c, 0xaa, 0x7b, 0xcc, 0x69, 0x04, 0x3e, 0x0d, 0x46,
+	0xc4, 0x15, 0x95, 0x0b, 0xbd, 0x0e, 0x55, 0x66, 0x87, 0x43, 0xcc, 0x2c, 0x76, 0x1a, 0x62, 0x7a,
+	0x1a, 0x8c, 0x5c, 0x55, 0xd9, 0xaf, 0x4b, 0x7c, 0x2f, 0x46, 0xa3, 0x3b, 0xb0, 0xe8, 0xd9, 0x17,
+	0x96, 0x1f, 0x79, 0x6a, 0xe7, 0xa2, 0x67, 0x5f, 0xb4, 0x23, 0x4f, 0xff, 0x18, 0x10, 0xf7, 0x13,
+	0x9d, 0xdd, 0xf9, 0x0e, 0x2c, 0xf2, 0xb4, 0xb1, 0x12, 0x97, 0x15, 0x43, 0x19, 0xde, 0x2c, 0x95,
+	0xd2, 0x85, 0xcf, 0x53, 0x99, 0x9f, 0x51, 0xf9, 0x13, 0x0d, 0x2a, 0xcd, 0xa7, 0x07, 0xc7, 0x76,
+	0xc8, 0x88, 0x43, 0xc6, 0xb6, 0x2f, 0x6e, 0x97, 0x17, 0xf8, 0xe4, 0x0c, 0x87, 0xf1, 0x85, 0x57,
+	0x20, 0x57, 0x18, 0x8c, 0x71, 0x68, 0xb3, 0x20, 0xb4, 0xe2, 0x0b, 0xa8, 0x14, 0xc6, 0xf8, 0xba,
+	0x44, 0x73, 0x56, 0x27, 0xf0, 0x29, 0xf6, 0x69, 0x44, 0xad, 0x71, 0x34, 0x38, 0xc3, 0x13, 0x15,
+	0xc8, 0xeb, 0x09, 0xfe, 0x58, 0xa0, 0xf5, 0xbf, 0x16, 0x00, 0x9a, 0x4f, 0x0f, 0xe2, 0x7a, 0x33,
+	0x4d, 0x8e, 0x82, 0x48, 0x8e, 0x3d, 0x58, 0x1e, 0x4f, 0xad, 0x8b, 0xab, 0xdb, 0xc6, 0x7c, 0x7e,
+	0xce, 0x1e, 0xc2, 0x9c, 0x91, 0x41, 0xf7, 0xa0, 0x34, 0x75, 0x91, 0x74, 0xc0, 0x14, 0x81, 0xde,
+	0x83, 0xf2, 0xb9, 0x1d, 0x8d, 0x98, 0xc5, 0x3b, 0x1e, 0xad, 0x15, 0x36, 0xf3, 0xcf, 0xef, 0x8c,
+	0x20, 0xb8, 0xf9, 0x92, 0xa2, 0xd7, 0xe0, 0x3a, 0xf6, 0xed, 0xc1, 0x08, 0x5b, 0xa2, 0x51, 0x9c,
+	0xe0, 0x50, 0xe4, 0xdb, 0x92, 0x59, 0x91, 0xe8, 0x9e, 0xc2, 0xa2, 0x57, 0x41, 0x05, 0xc5, 0x8a,
+	0xd8, 0x45, 0x20, 0x22, 0x51, 0x14, 0x86, 0xac, 0x48, 0x74, 0x9f, 0x5d, 0x04, 0xed, 0xc8, 0x43,
+	0x4d, 0x00, 0x7c, 0x31, 0x26, 0xa1, 0x88, 0x7d, 0x6d, 0xf1, 0x5b, 0x35, 0x0f, 0x4d, 0x34, 0x8f,
+	0x94, 0x1c, 0x7a, 0x37, 0xe9, 0xa1, 0x4b, 0xa2, 0xd3, 0x6d, 0x66, 0xb8, 0x4b, 0x39, 0x7c, 0xb6,
+	0x8d, 0xa2, 0x06, 0x2c, 0x33, 0x7b, 0xcc, 0xc7, 0x21, 0x8b, 0x85, 0x18, 0x8b, 0xca, 0x54, 0xbe,
+	0x2c, 0xff, 0x4c, 0xb8, 0x40, 0x32, 0xf6, 0x42, 0x8c, 0xcd, 0x32, 0x9b, 0x02, 0xc8, 0x80, 0x8a,
+	0x1f, 0xf8, 0x56, 0x88, 0xe9, 0x38, 0xf0, 0x5d, 0x1c, 0xd2, 0x1a, 0x7c, 0xab, 0xa8, 0xad, 0xf8,
+	0x81, 0x6f, 0x26, 0x42, 0x68, 0x0d, 0x96, 0x42, 0xcc, 0xc2, 0x89, 0x15, 0x9c, 0xd4, 0xca, 0x72,
+	0x2a, 0x14, 0x70, 0xe7, 0x84, 0x4f, 0x6e, 0x92, 0xe4, 0x88, 0x42, 0xb4, 0x2c, 0x5c, 0x09, 0x02,
+	0xd5, 0x10, 0x55, 0xf0, 0xf7, 0x39, 0x58, 0x6d, 0x3e, 0x3d, 0x68, 0x04, 0xde, 0x78, 0x84, 0x45,
+	0x2b, 0xbf, 0x22, 0xbf, 0x78, 0xa1, 0xc1, 0x5c, 0x5f, 0x5c, 0xcd, 0x24, 0xc4, 0xf1, 0x22, 0xce,
+	0xbc, 0xc7, 0xe4, 0x39, 0x5e, 0x42, 0xe8, 0x0d, 0xb8, 0x31, 0xcd, 0xec, 0xf8, 0x16, 0xc8, 0x76,
+	0x36, 0x4d, 0xf9, 0xf8, 0x1a, 0xdc, 0x83, 0x12, 0x25, 0x43, 0xdf, 0x66, 0x51, 0x88, 0xe3, 0x42,
+	0x94, 0x20, 0xf8, 0xf9, 0x54, 0xf7, 0xa1, 0xb5, 0xa2, 0x50, 0xb2, 0x28, 0xfb, 0x8e, 0x68, 0x64,
+	0xdc, 0x9d, 0x27, 0x7c, 0x08, 0x12, 0xda, 0x25, 0x84, 0x3e, 0x82, 0x9b, 0xe7, 0x38, 0x24, 0x27,
+	0xc4, 0x91, 0xb3, 0x16, 0x3d, 0xb5, 0xc3, 0xa4, 0xf7, 0xe8, 0x97, 0xa2, 0x94, 0x62, 0xed, 0x0a,
+	0xce, 0xbd, 0x02, 0x1f, 0x36, 0x4c, 0x74, 0x7e, 0x89, 0xa2, 0xbf, 0x09, 0xe8, 0x32, 0xbf, 0x70,
+	0x8f, 0xd4, 0xa1, 0x49, 0x43, 0x24, 0xa4, 0xff, 0x18, 0xca, 0xfb, 0x61, 0x40, 0x19, 0x9f, 0x2a,
+	0xae, 0x28, 0x0d, 0x5a, 0x76, 0x69, 0x58, 0x85, 0x05, 0xe2, 0xbb, 0x38, 0x6e, 0x41, 0x12, 0x40,
+	0x6f, 0x01, 0xba, 0x7c, 0x30, 0x55, 0x32, 0x6e, 0x5c, 0xb2, 0x56, 0xff, 0xa5, 0x06, 0x2b, 0x42,
+	0xbf, 0x48, 0xc4, 0xa7, 0x78, 0x22, 0xe7, 0xe9, 0x68, 0xc4, 0x94, 0x5a, 0x09, 0xf0, 0xc2, 0xa7,
+	0x5c, 0x1c, 0x87, 0x57, 0x7a, 0xf8, 0x1b, 0x4a, 0xc2, 0xfb, 0xb0, 0x48, 0xc5, 0xc1, 0x64, 0x39,
+	0xc8, 0x68, 0xeb, 0xa9, 0xc3, 0x2b, 0x9f, 0xc6, 0x12, 0xfa, 0x13, 0x58, 0x15, 0xd4, 0x36, 0x7f,
+	0x2c, 0x34, 0x02, 0xcf, 0x23, 0xcc, 0xc3, 0x6a, 0x08, 0x21, 0x2e, 0xf1, 0x87, 0x71, 0x1d, 0x97,
+	0x10, 0x2f, 0xb8, 0x03, 0xe2, 0x0b, 0x82, 0xb4, 0x31, 0x06, 0xf5, 0xcf, 0x34, 0xa8, 0xa6, 0x14,
+	0x99, 0x41, 0xe4, 0xbb, 0x2f, 0xe2, 0xea, 0x43, 0x28, 0x3b, 0x89, 0xfe, 0xb8, 0x74, 0xbe, 0x9c,
+	0x79, 0x94, 0x39, 0x63, 0xd5, 0x99, 0xd2, 0xe2, 0x3c, 0x44, 0xa2, 0xaa, 0xda, 0x23, 0x2b, 0xc9,
+	0xe1, 0xf8, 0x76, 0xdc, 0x50, 0x94, 0x6e, 0x42, 0xd0, 0xff, 0x91, 0x83, 0x9b, 0x89, 0xf1, 0x7c,
+	0x5e, 0xc7, 0x34, 0x7e, 0xd0, 0x5d, 0x9a, 0xf2, 0xde, 0x4b, 0xea, 0x55, 0x4e, 0xd4, 0x2b, 0xfd,
+	0x4a, 0x77, 0x5f, 0x1a, 0xfc, 0x79, 0xe0, 0x43, 0xee, 0x18, 0x15, 0x45, 0x09, 0xcc, 0xc6, 0xb7,
+	0x30, 0x1f, 0xdf, 0x1f, 0x4c, 0xe3, 0xbb, 0xb0, 0x99, 0xcf, 0x2a, 0x70, 0xf3, 0x6e, 0x9f, 0x0b,
+	0x32, 0xda, 0x81, 0x9b, 0x1e, 0xa1, 0x03, 0x7c, 0x6a, 0x9f, 0xf3, 0x39, 0x23, 0xde, 0x4d, 0x5e,
+	0x63, 0x94, 0x22, 0x75, 0x95, 0x40, 0x1b, 0xaa, 0xc2, 0x32, 0x8b, 0x32, 0x3b, 0x64, 0xf2, 0x91,
+	0xb4, 0xf8, 0x02, 0x6f, 0x83, 0x8a, 0x90, 0xee, 0x72, 0x61, 0x4e, 0xd6, 0xff, 0x9e, 0x83, 0x1b,
+	0x72, 0xef, 0x63, 0x1c, 0x9e, 0x04, 0xa1, 0x27, 0x86, 0xc4, 0x17, 0x48, 0x8e, 0x37, 0x81, 0x9b,
+	0x49, 0xb1, 0x6b, 0xb9, 0x67, 0x43, 0x4b, 0x0e, 0xc4, 0x32, 0x47, 0xf2, 0x66, 0x55, 0x52, 0x9a,
+	0x67, 0xc3, 0x27, 0x12, 0x8f, 0xbe, 0x0b, 0xb7, 0x15, 0x37, 0x95, 0x51, 0x48, 0x24, 0xf2, 0x42,
+	0x62, 0x55, 0x52, 0x55, 0x88, 0x62, 0xa9, 0x2d, 0xb8, 0xc1, 0x02, 0x66, 0x8f, 0xac, 0xa9, 0x26,
+	0xaa, 0x46, 0xf3, 0xeb, 0x82, 0x70, 0x14, 0xeb, 0xa1, 0x68, 0x17, 0x6e, 0xcd, 0xf0, 0x2a, 0x3d,
+	0x54, 0x8d, 0xec, 0x37, 0x53, 0xfc, 0x4a, 0x0b, 0x45, 0x9b, 0x50, 0x1e, 0x47, 0x3e, 0xa1, 0xa7,
+	0x32, 0xc1, 0xe5, 0x2b, 0x3d, 0x8d, 0xe2, 0x76, 0x8f, 0x6c, 0xca, 0xac, 0x29, 0x4e, 0x19, 0x2e,
+	0x9c, 0x9f, 0x37, 0x57, 0x39, 0xf5, 0x38, 0x21, 0x4a, 0xc3, 0xf5, 0x7f, 0xe7, 0x60, 0x45, 0x36,
+	0xfc, 0xb8, 0x7f, 0xbf, 0x0b, 0x60, 0x73, 0x84, 0x18, 0x12, 0xd4, 0xfb, 0xf1, 0x39, 0x33, 0x42,
+	0xc9, 0x8e, 0x97, 0xe8, 0x21, 0x2c, 0xd3, 0x20, 0x0a, 0x1d, 0x6c, 0xc9, 0xfa, 0x24, 0xef, 0x78,
+	0x59, 0xe2, 0x44, 0xf9, 0xe2, 0x03, 0xab, 0x8b, 0x29, 0x53, 0x0c, 0xb2, 0xe8, 0x95, 0x38, 0x46,
+	0x92, 0xdf, 0x4f, 0x6e, 0x47, 0x41, 0xe8, 0x7d, 0x29, 0xbb, 0x1b, 0x2b, 0x53, 0xe7, 0xae, 0xc7,
+	0xeb, 0x50, 0x8d, 0x23, 0x16, 0xca, 0x16, 0x28, 0x73, 0xbe, 0x64, 0x5e, 0xa7, 0x33, 0x2f, 0x42,
+	0x91, 0x11, 0x21, 0xf6, 0x6c, 0x22, 0x98, 0x67, 0xc6, 0x94, 0x82, 0x59, 0x4d, 0x28, 0xf1, 0xa4,
+	0xf2, 0x1a, 0x5c, 0x9f, 0x72, 0xcb, 0x4f, 0x19, 0x8b, 0x82, 0xb5, 0x92, 0xa0, 0x9f, 0x71, 0x2c,
+	0x3f, 0x9d, 0x08, 0x01, 0x0e, 0xc3, 0x20, 0x14, 0x03, 0x49, 0xc9, 0x2c, 0x71, 0x8c, 0xc1, 0x11,
+	0xfa, 0x67, 0x39, 0x58, 0x99, 0x39, 0x00, 0x7f, 0x1f, 0xc5, 0x1e, 0x9b, 0xf9, 0x26, 0xb4, 0xa2,
+	0x7c, 0x26, 0x91, 0xdc, 0xb1, 0xd2, 0x6b, 0x8a, 0x49, 0x3e, 0x11, 0xcb, 0xc2, 0x6f, 0x8a, 0xe5,
+	0x16, 0x14, 0x79, 0x72, 0x13, 0x57, 0x3d, 0x44, 0x17, 0xdc, 0xb3, 0x61, 0xcb, 0xfd, 0xdf, 0x1c,
+	0xfa, 0x3e, 0x14, 0x45, 0x70, 0xe3, 0xd2, 0x71, 0x3f, 0x3b, 0x0b, 0x94, 0xb0, 0xaa, 0x1b, 0x4a,
+	0x44, 0x24, 0x83, 0xb8, 0xff, 0x2a, 0x09, 0x8b, 0x22, 0x09, 0xcb, 0x02, 0x27, 0x73, 0x4f, 0x0e,
+	0x18, 0xf1, 0xd4, 0x32, 0x9b, 0xac, 0xd5, 0x29, 0x41, 0x25, 0xea, 0xaf, 0x73, 0x50, 0xd9, 0xc7,
+	0x78, 0x2f, 0x72, 0xce, 0xb0, 0x18, 0xe5, 0x28, 0x7a, 0x0c, 0xc5, 0x81, 0x00, 0xaf, 0xca, 0xd2,
+	0x84, 0xdf, 0x54, 0x8c, 0xc8, 0x83, 0xb2, 0xed, 0x38, 0x91, 0x17, 0x8d, 0x6c, 0x86, 0x5d, 0xd5,
+	0x27, 0xd6, 0xb6, 0xd5, 0xe7, 0x3c, 0xfe, 0xed, 0x6f, 0x5b, 0x7d, 0xfb, 0xdb, 0x6e, 0x04, 0xc4,
+	0xdf, 0x7b, 0x9b, 0x9f, 0xe9, 0xb7, 0x5f, 0x3e, 0x78, 0x34, 0x24, 0xec, 0x34, 0x1a, 0x6c, 0x3b,
+	0x81, 0xa7, 0xbe, 0xfd, 0xa9, 0x9f, 0xb7, 0xa8, 0x7b, 0xb6, 0x23, 0xc6, 0x69, 0x21, 0x40, 0xcd,
+	0xf4, 0xfe, 0x5c, 0x9d, 0x4b, 0x28, 0x0b, 0xc9, 0x20, 0xe2, 0xea, 0xf2, 0xff, 0x07, 0x75, 0xa9,
+	0xfd, 0xf5, 0x0f, 0xa1, 0xd2, 0x20, 0xa1, 0x13, 0x11, 0xb6, 0x17, 0x62, 0xfb, 0x4c, 0x7e, 0x6d,
+	0x64, 0x21, 0x19, 0x8f, 0xb1, 0xec, 0x42, 0x4b, 0x66, 0x0c, 0x5e, 0xf5, 0xfd, 0x8d, 0x37, 0x19,
+	0x17, 0xfb, 0x81, 0xa7, 0x2e, 0xa7, 0x04, 0xf4, 0x36, 0x2c, 0x77, 0x22, 0x76, 0x32, 0x0a, 0x3e,
+	0xe9, 0x53, 0x7b, 0x88, 0xa7, 0x5c, 0x5a, 0x8a, 0x8b, 0x37, 0xbc, 0x88, 0xe2, 0xf8, 0x6d, 0x26,
+	0xd6, 0x9c, 0x73, 0x44, 0x3c, 0x12, 0x5f, 0x76, 0x09, 0xe8, 0xbf, 0xc9, 0x41, 0xc5, 0xf0, 0x70,
+	0x38, 0xc4, 0xbe, 0x33, 0x39, 0xb6, 0x23, 0x8a, 0x2f, 0x8d, 0xab, 0xeb, 0xb0, 0x34, 0x8c, 0xec,
+	0xd0, 0x25, 0xb6, 0xaf, 0x36, 0x4c, 0x60, 0xf4, 0x36, 0x2c, 0x50, 0x27, 0x48, 0x3e, 0xee, 0xad,
+	0xcf, 0x07, 0x5e, 0xec, 0xd8, 0xe5, 0x1c, 0xa6, 0x64, 0x9c, 0x1a, 0x5c, 0x48, 0x1b, 0xdc, 0x00,
+	0x48, 0x35, 0xa9, 0x85, 0x17, 0x68, 0x52, 0x25, 0x1a, 0xf7, 0xa7, 0xb9, 0x87, 0xcc, 0x8b, 0x7c,
+	0x0e, 0x4c, 0xc9, 0xf1, 0x78, 0x8c, 0xc8, 0x09, 0x4b, 0xbe, 0xbb, 0x28, 0x68, 0xeb, 0x4f, 0x1a,
+	0xac, 0xcc, 0x8c, 0x03, 0x68, 0x03, 0xd6, 0xbb, 0xad, 0x83, 0x76, 0xab, 0x7d, 0x60, 0x75, 0x7b,
+	0xf5, 0x5e, 0xbf, 0x6b, 0xf5, 0xdb, 0xdd, 0x63, 0xa3, 0xd1, 0xda, 0x6f, 0x19, 0xcd, 0xea, 0x35,
+	0xb4, 0x0e, 0xb7, 0xe7, 0xe8, 0xc7, 0x46, 0xbb, 0xd9, 0x6a, 0x1f, 0x54, 0xb5, 0x0c, 0xd9, 0x3d,
+	0xb3, 0x53, 0x6f, 0x36, 0xea, 0xdd, 0x9e, 0xd1, 0xac, 0xe6, 0xd0, 0x3d, 0xa8, 0xcd, 0xd1, 0x1b,
+	0x9d, 0xf6, 0x7e, 0xcb, 0x3c, 0x32, 0x9a, 0xd5, 0x3c, 0x5a, 0x83, 0x5b, 0x73, 0xd4, 0xfd, 0x7a,
+	0xeb, 0xd0, 0x68, 0x56, 0x0b, 0xe8, 0x2e, 0xdc, 0x99, 0x23, 0x99, 0xc6, 0xf1, 0x61, 0xbd, 0x61,
+	0x34, 0xab, 0x0b, 0x5b, 0x3f, 0x55, 0x1d, 0x7c, 0xe6, 0x8b, 0x23, 0xd2, 0x61, 0x23, 0x16, 0xe9,
+	0x99, 0xf5, 0x76, 0xb7, 0xd5, 0x6b, 0x75, 0xda, 0x73, 0x67, 0x49, 0xd9, 0x9b, 0xe2, 0x69, 0x98,
+	0x46, 0x9d, 0xdb, 0xab, 0xa1, 0x37, 0xe0, 0xb5, 0x0c, 0x3a, 0x47, 0xd5, 0x7b, 0x7d, 0xd3, 0xe8,
+	0x5a, 0xdd, 0xfe, 0xde, 0x51, 0xab, 0x27, 0x0f, 0x97, 0xad, 0x30, 0xed, 0x80, 0x3c, 0xda, 0x84,
+	0x7b, 0x59, 0x0a, 0x13, 0x27, 0x14, 0xd0, 0x7d, 0x58, 0xcb, 0xe0, 0x50, 0x8e, 0x58, 0x40, 0x0f,
+	0xe0, 0x6e, 0x06, 0x39, 0x71, 0x46, 0x71, 0xeb, 0x8f, 0x1a, 0x54, 0xe7, 0x1f, 0xa5, 0xdc, 0xb4,
+	0xe6, 0xd3, 0x03, 0xcb, 0x34, 0x7e, 0xd8, 0x37, 0xba, 0xbd, 0xec, 0xb8, 0x6e, 0xc0, 0x7a, 0x06,
+	0xcf, 0x34, 0xb6, 0x9b, 0x70, 0x2f, 0x83, 0xde, 0xe8, 0x1c, 0x1d, 0x1f, 0x1a, 0xd2, 0x01, 0xf7,
+	0x61, 0x2d, 0x83, 0x43, 0x99, 0x9e, 0xe7, 0xa6, 0x67, 0x90, 0x7b, 0xad, 0x23, 0xa3, 0xd9, 0xe9,
+	0xf7, 0xaa, 0x85, 0xad, 0x3f, 0x6b, 0x80, 0x2e, 0xcf, 0xa7, 0xe8, 0x65, 0xd8, 0xdc, 0x37, 0x3b,
+	0x5c, 0xe2, 0x79, 0x69, 0xf9, 0x12, 0x3c, 0xc8, 0xe4, 0x6a, 0x74, 0x8e, 0x8e, 0x5a, 0xbd, 0x23,
+	0xa3, 0xdd, 0x93, 0x67, 0xc8, 0x64, 0x52, 0xa0, 0x0c, 0xe2, 0x55, 0xdb, 0xa8, 0x73, 0x8a, 0x83,
+	0x64, 0xf2, 0xc4, 0xd9, 0xba, 0xf5, 0x3b, 0x0d, 0x6e, 0x66, 0x74, 0x3e, 0xf4, 0x0a, 0x3c, 0x7c,
+	0x56, 0xef, 0x1f, 0xf6, 0x64, 0xe8, 0xf6, 0x0d, 0x33, 0xfb, 0x28, 0x0f, 0xe1, 0x7e, 0x36, 0xdb,
+	0x34, 0x18, 0x57, 0xee, 0xd4, 0x6a, 0x5b, 0xc7, 0x66, 0xe7, 0xc0, 0x34, 0xba, 0xdd, 0x6a, 0x8e,
+	0x3b, 0x25, 0x9b, 0x2d, 0x75, 0x9c, 0xad, 0x3f, 0x68, 0x50, 0x4a, 0x5a, 0x19, 0xbf, 0xde, 0xfb,
+	0x86, 0x61, 0xed, 0xf5, 0x1b, 0x4f, 0x8d, 0xde, 0x9c, 0x61, 0xf7, 0x61, 0x2d, 0x45, 0xe3, 0x9e,
+	0xed, 0xb7, 0x5b, 0xbd, 0x8f, 0xac, 0xe3, 0x4e, 0xe7, 0xb0, 0xaa, 0xf1, 0x4b, 0x9a, 0x22, 0x9b,
+	0xc6, 0x61, 0xfd, 0x23, 0xc3, 0x94, 0xc4, 0xdc, 0xdc, 0xbe, 0xdc, 0x73, 0x31, 0x2d, 0x8f, 0x6a,
+	0xb0, 0x9a, 0xa2, 0xb5, 0xda, 0xdd, 0xbe, 0x59, 0x6f, 0x37, 0x8c, 0x6a, 0x61, 0x8e, 0xd2, 0xe8,
+	0x1c, 0x1e, 0x1a, 0x8d, 0x5e, 0xc7, 0xac, 0x2e, 0x6c, 0xfd, 0x4c, 0x03, 0x98, 0xd6, 0x61, 0xae,
+	0xfb, 0xb8, 0xde, 0xef, 0x1a, 0x56, 0xb7, 0xd1, 0x39, 0x36, 0xe6, 0xec, 0xbe, 0x03, 0x37, 0xd3,
+	0xc4, 0xa6, 0x71, 0xdc, 0xe9, 0xb6, 0x78, 0x3e, 0xd4, 0x60, 0x35, 0x4d, 0xf8, 0xa0, 0xd5, 0x7b,
+	0xd2, 0x34, 0xeb, 0x1f, 0x54, 0x73, 0xf3, 0x22, 0x71, 0x82, 0xe4, 0xd1, 0x2d, 0xb8, 0x91, 0x26,
+	0xd4, 0xbb, 0x5d, 0xa3, 0x57, 0x2d, 0xec, 0x3d, 0xf9, 0xfc, 0xab, 0x0d, 0xed, 0x8b, 0xaf, 0x36,
+	0xb4, 0x7f, 0x7d, 0xb5, 0xa1, 0x7d, 0xfa, 0xf5, 0xc6, 0xb5, 0x2f, 0xbe, 0xde, 0xb8, 0xf6, 0xb7,
+	0xaf, 0x37, 0xae, 0xfd, 0x68, 0x3b, 0xd5, 0x6c, 0x79, 0x1f, 0x11, 0x25, 0xdb, 0x09, 0x46, 0x02,
+	0xd8, 0xb9, 0x48, 0xfd, 0xb1, 0x27, 0x1a, 0xef, 0xa0, 0x28, 0x18, 0xbe, 0xf3, 0xdf, 0x01, 0x00,
+	0x42, 0xcb, 0x5f, 0xe7, 0xb4, 0x1c, 0x00, 0x00,
+}
+
+func (m *BlockHeader) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockHeader) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockHeader) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Txid)
-	if l > 0 {
-		n += 1 + l + sovBtcbridge(uint64(l))
+	if m.Ntx != 0 {
+		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Ntx))
+		i--
+		dAtA[i] = 0x48
 	}
-	if m.Vout != 0 {
-		n += 1 + sovBtcbridge(uint64(m.Vout))
+	if m.Time != 0 {
+		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Time))
+		i--
+		dAtA[i] = 0x40
 	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovBtcbridge(uint64(l))
+	if len(m.Bits) > 0 {
+		i -= len(m.Bits)
+		copy(dAtA[i:], m.Bits)
+		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.Bits)))
+		i--
+		dAtA[i] = 0x3a
 	}
-	if m.Amount != 0 {
-		n += 1 + sovBtcbridge(uint64(m.Amount))
+	if m.Nonce != 0 {
+		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x30
 	}
-	if m.Height != 0 {
-		n += 1 + sovBtcbridge(uint64(m.Height))
+	if len(m.MerkleRoot) > 0 {
+		i -= len(m.MerkleRoot)
+		copy(dAtA[i:], m.MerkleRoot)
+		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.MerkleRoot)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.PubKeyScript)
-	if l > 0 {
-		n += 1 + l + sovBtcbridge(uint64(l))
+	if len(m.PreviousBlockHash) > 0 {
+		i -= len(m.PreviousBlockHash)
+		copy(dAtA[i:], m.PreviousBlockHash)
+		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.PreviousBlockHash)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.IsLocked {
-		n += 2
+	if m.Height != 0 {
+		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
 	}
-	if len(m.Runes) > 0 {
-		for _, e := range m.Runes {
-			l = e.Size()
-			n += 1 + l + sovBtcbridge(uint64(l))
-		}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if m.Version != 0 {
+		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *RuneBalance) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *FeeRate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeRate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovBtcbridge(uint64(l))
+	if m.Height != 0 {
+		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.Amount)
-	if l > 0 {
-		n += 1 + l + sovBtcbridge(uint64(l))
+	if m.Value != 0 {
+		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Value))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *RuneId) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Block != 0 {
-		n += 1 + sovBtcbridge(uint64(m.Block))
-	}
-	if m.Tx != 0 {
-		n += 1 + sovBtcbridge(uint64(m.Tx))
+func (m *SigningRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *Edict) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *SigningRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SigningRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Id != nil {
-		l = m.Id.Size()
-		n += 1 + l + sovBtcbridge(uint64(l))
+	if m.Status != 0 {
+		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x38
 	}
-	l = len(m.Amount)
-	if l > 0 {
-		n += 1 + l + sovBtcbridge(uint64(l))
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CreationTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreationTime):])
+	if err1 != nil {
+		return 0, err1
 	}
-	if m.Output != 0 {
-		n += 1 + sovBtcbridge(uint64(m.Output))
+	i -= n1
+	i = encodeVarintBtcbridge(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x32
+	if len(m.Psbt) > 0 {
+		i -= len(m.Psbt)
+		copy(dAtA[i:], m.Psbt)
+		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.Psbt)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	return n
+	if len(m.Txid) > 0 {
+		i -= len(m.Txid)
+		copy(dAtA[i:], m.Txid)
+		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.Txid)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Type != 0 {
+		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *BtcConsolidation) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SigningRequestHistoryEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SigningRequestHistoryEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SigningRequestHistoryEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.TargetThreshold != 0 {
-		n += 1 + sovBtcbridge(uint64(m.TargetThreshold))
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err2 != nil {
+		return 0, err2
 	}
-	if m.MaxNum != 0 {
-		n += 1 + sovBtcbridge(uint64(m.MaxNum))
+	i -= n2
+	i = encodeVarintBtcbridge(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x22
+	if m.Height != 0 {
+		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
 	}
-	return n
+	if len(m.Actor) > 0 {
+		i -= len(m.Actor)
+		copy(dAtA[i:], m.Actor)
+		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.Actor)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Transition != 0 {
+		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Transition))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *RunesConsolidation) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SigningRequestHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SigningRequestHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SigningRequestHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.RuneId)
-	if l > 0 {
-		n += 1 + l + sovBtcbridge(uint64(l))
-	}
-	l = len(m.TargetThreshold)
-	if l > 0 {
-		n += 1 + l + sovBtcbridge(uint64(l))
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBtcbridge(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	if m.MaxNum != 0 {
-		n += 1 + sovBtcbridge(uint64(m.MaxNum))
+	if m.Sequence != 0 {
+		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *DKGParticipant) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *WithdrawRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *WithdrawRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WithdrawRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Moniker)
-	if l > 0 {
-		n += 1 + l + sovBtcbridge(uint64(l))
+	if len(m.Txid) > 0 {
+		i -= len(m.Txid)
+		copy(dAtA[i:], m.Txid)
+		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.Txid)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.OperatorAddress)
-	if l > 0 {
-		n += 1 + l + sovBtcbridge(uint64(l))
+	if m.Sequence != 0 {
+		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.ConsensusPubkey)
-	if l > 0 {
-		n += 1 + l + sovBtcbridge(uint64(l))
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *DKGRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *UTXO) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *UTXO) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UTXO) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Id != 0 {
-		n += 1 + sovBtcbridge(uint64(m.Id))
+	if len(m.WitnessScript) > 0 {
+		i -= len(m.WitnessScript)
+		copy(dAtA[i:], m.WitnessScript)
+		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.WitnessScript)))
+		i--
+		dAtA[i] = 0x4a
 	}
-	if len(m.Participants) > 0 {
-		for _, e := range m.Participants {
-			l = e.Size()
-			n += 1 + l + sovBtcbridge(uint64(l))
+	if len(m.Runes) > 0 {
+		for iNdEx := len(m.Runes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Runes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBtcbridge(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
 		}
 	}
-	if m.Threshold != 0 {
-		n += 1 + sovBtcbridge(uint64(m.Threshold))
-	}
-	if len(m.VaultTypes) > 0 {
-		l = 0
-		for _, e := range m.VaultTypes {
-			l += sovBtcbridge(uint64(e))
+	if m.IsLocked {
+		i--
+		if m.IsLocked {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.PubKeyScript) > 0 {
+		i -= len(m.PubKeyScript)
+		copy(dAtA[i:], m.PubKeyScript)
+		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.PubKeyScript)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Height != 0 {
+		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Amount != 0 {
+		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Vout != 0 {
+		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Vout))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Txid) > 0 {
+		i -= len(m.Txid)
+		copy(dAtA[i:], m.Txid)
+		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.Txid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RuneBalance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RuneBalance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RuneBalance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RuneId) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RuneId) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RuneId) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Tx != 0 {
+		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Tx))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Block != 0 {
+		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Block))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Edict) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Edict) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Edict) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Output != 0 {
+		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Output))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != nil {
+		{
+			size, err := m.Id.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBtcbridge(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RuneMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RuneMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RuneMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EtchingTx) > 0 {
+		i -= len(m.EtchingTx)
+		copy(dAtA[i:], m.EtchingTx)
+		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.EtchingTx)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Divisibility != 0 {
+		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Divisibility))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Brc20Metadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Brc20Metadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Brc20Metadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Decimals != 0 {
+		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Decimals))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Tick) > 0 {
+		i -= len(m.Tick)
+		copy(dAtA[i:], m.Tick)
+		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.Tick)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BtcConsolidation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BtcConsolidation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BtcConsolidation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxNum != 0 {
+		i = encodeVarintBtcbridge(dAtA, i, uint64(m.MaxNum))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TargetThreshold != 0 {
+		i = encodeVarintBtcbridge(dAtA, i, uint64(m.TargetThreshold))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RunesConsolidation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RunesConsolidation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RunesConsolidation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxNum != 0 {
+		i = encodeVarintBtcbridge(dAtA, i, uint64(m.MaxNum))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.TargetThreshold) > 0 {
+		i -= len(m.TargetThreshold)
+		copy(dAtA[i:], m.TargetThreshold)
+		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.TargetThreshold)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RuneId) > 0 {
+		i -= len(m.RuneId)
+		copy(dAtA[i:], m.RuneId)
+		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.RuneId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DKGParticipant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DKGParticipant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DKGParticipant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsensusPubkey) > 0 {
+		i -= len(m.ConsensusPubkey)
+		copy(dAtA[i:], m.ConsensusPubkey)
+		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.ConsensusPubkey)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.OperatorAddress) > 0 {
+		i -= len(m.OperatorAddress)
+		copy(dAtA[i:], m.OperatorAddress)
+		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.OperatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Moniker) > 0 {
+		i -= len(m.Moniker)
+		copy(dAtA[i:], m.Moniker)
+		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.Moniker)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DKGRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DKGRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DKGRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RetryCount != 0 {
+		i = encodeVarintBtcbridge(dAtA, i, uint64(m.RetryCount))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.RetryOf != 0 {
+		i = encodeVarintBtcbridge(dAtA, i, uint64(m.RetryOf))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.NonResponders) > 0 {
+		for iNdEx := len(m.NonResponders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NonResponders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBtcbridge(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.TaprootTree != nil {
+		{
+			size, err := m.TaprootTree.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBtcbridge(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.Status != 0 {
+		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Expiration != nil {
+		n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration):])
+		if err5 != nil {
+			return 0, err5
+		}
+		i -= n5
+		i = encodeVarintBtcbridge(dAtA, i, uint64(n5))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.TargetUtxoNum != 0 {
+		i = encodeVarintBtcbridge(dAtA, i, uint64(m.TargetUtxoNum))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.EnableTransfer {
+		i--
+		if m.EnableTransfer {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.VaultTypes) > 0 {
+		dAtA7 := make([]byte, len(m.VaultTypes)*10)
+		var j6 int
+		for _, num := range m.VaultTypes {
+			for num >= 1<<7 {
+				dAtA7[j6] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j6++
+			}
+			dAtA7[j6] = uint8(num)
+			j6++
+		}
+		i -= j6
+		copy(dAtA[i:], dAtA7[:j6])
+		i = encodeVarintBtcbridge(dAtA, i, uint64(j6))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Threshold != 0 {
+		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Threshold))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Participants) > 0 {
+		for iNdEx := len(m.Participants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Participants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBtcbridge(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Id != 0 {
+		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DKGCompletionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DKGCompletionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DKGCompletionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VerificationShares) > 0 {
+		for iNdEx := len(m.VerificationShares) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VerificationShares[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBtcbridge(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.Proofs) > 0 {
+		for iNdEx := len(m.Proofs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Proofs[iNdEx])
+			copy(dAtA[i:], m.Proofs[iNdEx])
+			i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.Proofs[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.PubKeys) > 0 {
+		for iNdEx := len(m.PubKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PubKeys[iNdEx])
+			copy(dAtA[i:], m.PubKeys[iNdEx])
+			i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.PubKeys[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ConsensusAddress) > 0 {
+		i -= len(m.ConsensusAddress)
+		copy(dAtA[i:], m.ConsensusAddress)
+		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.ConsensusAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Vaults) > 0 {
+		for iNdEx := len(m.Vaults) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Vaults[iNdEx])
+			copy(dAtA[i:], m.Vaults[iNdEx])
+			i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.Vaults[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VerificationShares) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VerificationShares) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VerificationShares) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Shares) > 0 {
+		for iNdEx := len(m.Shares) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Shares[iNdEx])
+			copy(dAtA[i:], m.Shares[iNdEx])
+			i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.Shares[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FrostSigner) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FrostSigner) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FrostSigner) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VerificationShare) > 0 {
+		i -= len(m.VerificationShare)
+		copy(dAtA[i:], m.VerificationShare)
+		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.VerificationShare)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Index != 0 {
+		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.OperatorAddress) > 0 {
+		i -= len(m.OperatorAddress)
+		copy(dAtA[i:], m.OperatorAddress)
+		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.OperatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FrostVaultKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FrostVaultKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FrostVaultKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signers) > 0 {
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Signers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBtcbridge(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Threshold != 0 {
+		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Threshold))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.PubKey) > 0 {
+		i -= len(m.PubKey)
+		copy(dAtA[i:], m.PubKey)
+		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.PubKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Vault) > 0 {
+		i -= len(m.Vault)
+		copy(dAtA[i:], m.Vault)
+		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.Vault)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FrostNonceCommitment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FrostNonceCommitment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FrostNonceCommitment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Binding) > 0 {
+		i -= len(m.Binding)
+		copy(dAtA[i:], m.Binding)
+		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.Binding)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Hiding) > 0 {
+		i -= len(m.Hiding)
+		copy(dAtA[i:], m.Hiding)
+		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.Hiding)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FrostSignerRound) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FrostSignerRound) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FrostSignerRound) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PartialSignatures) > 0 {
+		for iNdEx := len(m.PartialSignatures) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PartialSignatures[iNdEx])
+			copy(dAtA[i:], m.PartialSignatures[iNdEx])
+			i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.PartialSignatures[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Commitments) > 0 {
+		for iNdEx := len(m.Commitments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Commitments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBtcbridge(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.OperatorAddress) > 0 {
+		i -= len(m.OperatorAddress)
+		copy(dAtA[i:], m.OperatorAddress)
+		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.OperatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FrostSigningSession) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FrostSigningSession) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FrostSigningSession) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.RoundStartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.RoundStartTime):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintBtcbridge(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0x3a
+	if len(m.MisbehavingSigners) > 0 {
+		for iNdEx := len(m.MisbehavingSigners) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MisbehavingSigners[iNdEx])
+			copy(dAtA[i:], m.MisbehavingSigners[iNdEx])
+			i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.MisbehavingSigners[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Signers) > 0 {
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Signers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBtcbridge(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.Threshold != 0 {
+		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Threshold))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Round != 0 {
+		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Round))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Status != 0 {
+		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Txid) > 0 {
+		i -= len(m.Txid)
+		copy(dAtA[i:], m.Txid)
+		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.Txid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SignerPerformance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignerPerformance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignerPerformance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastPunishmentHeight != 0 {
+		i = encodeVarintBtcbridge(dAtA, i, uint64(m.LastPunishmentHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Punishments != 0 {
+		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Punishments))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.TotalMissedSignings != 0 {
+		i = encodeVarintBtcbridge(dAtA, i, uint64(m.TotalMissedSignings))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.TotalMissedDkgs != 0 {
+		i = encodeVarintBtcbridge(dAtA, i, uint64(m.TotalMissedDkgs))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.MissedSigningHeights) > 0 {
+		dAtA10 := make([]byte, len(m.MissedSigningHeights)*10)
+		var j9 int
+		for _, num1 := range m.MissedSigningHeights {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA10[j9] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j9++
+			}
+			dAtA10[j9] = uint8(num)
+			j9++
+		}
+		i -= j9
+		copy(dAtA[i:], dAtA10[:j9])
+		i = encodeVarintBtcbridge(dAtA, i, uint64(j9))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.MissedDkgHeights) > 0 {
+		dAtA12 := make([]byte, len(m.MissedDkgHeights)*10)
+		var j11 int
+		for _, num1 := range m.MissedDkgHeights {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA12[j11] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j11++
+			}
+			dAtA12[j11] = uint8(num)
+			j11++
+		}
+		i -= j11
+		copy(dAtA[i:], dAtA12[:j11])
+		i = encodeVarintBtcbridge(dAtA, i, uint64(j11))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OperatorAddress) > 0 {
+		i -= len(m.OperatorAddress)
+		copy(dAtA[i:], m.OperatorAddress)
+		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.OperatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AssetTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AssetTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AssetTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LastError) > 0 {
+		i -= len(m.LastError)
+		copy(dAtA[i:], m.LastError)
+		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.LastError)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.RemainingValue != 0 {
+		i = encodeVarintBtcbridge(dAtA, i, uint64(m.RemainingValue))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.RemainingUtxoNum != 0 {
+		i = encodeVarintBtcbridge(dAtA, i, uint64(m.RemainingUtxoNum))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.SigningRequests) > 0 {
+		for iNdEx := len(m.SigningRequests) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SigningRequests[iNdEx])
+			copy(dAtA[i:], m.SigningRequests[iNdEx])
+			i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.SigningRequests[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.Status != 0 {
+		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.DestVault) > 0 {
+		i -= len(m.DestVault)
+		copy(dAtA[i:], m.DestVault)
+		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.DestVault)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SourceVault) > 0 {
+		i -= len(m.SourceVault)
+		copy(dAtA[i:], m.SourceVault)
+		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.SourceVault)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.AssetType != 0 {
+		i = encodeVarintBtcbridge(dAtA, i, uint64(m.AssetType))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VaultTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VaultTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VaultTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CompletionHeight != 0 {
+		i = encodeVarintBtcbridge(dAtA, i, uint64(m.CompletionHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintBtcbridge(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Assets) > 0 {
+		for iNdEx := len(m.Assets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Assets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBtcbridge(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.Status != 0 {
+		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.DkgId != 0 {
+		i = encodeVarintBtcbridge(dAtA, i, uint64(m.DkgId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.DestVersion != 0 {
+		i = encodeVarintBtcbridge(dAtA, i, uint64(m.DestVersion))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SourceVersion != 0 {
+		i = encodeVarintBtcbridge(dAtA, i, uint64(m.SourceVersion))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeBucketStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeBucketStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeBucketStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Distributed) > 0 {
+		for iNdEx := len(m.Distributed) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Distributed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBtcbridge(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Accumulated) > 0 {
+		for iNdEx := len(m.Accumulated) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Accumulated[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBtcbridge(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Bucket != 0 {
+		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Bucket))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CircuitBreaker) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CircuitBreaker) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CircuitBreaker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Height != 0 {
+		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Tripped {
+		i--
+		if m.Tripped {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OutflowUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OutflowUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OutflowUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Limit) > 0 {
+		i -= len(m.Limit)
+		copy(dAtA[i:], m.Limit)
+		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.Limit)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Used) > 0 {
+		i -= len(m.Used)
+		copy(dAtA[i:], m.Used)
+		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.Used)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EmergencyPause) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EmergencyPause) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EmergencyPause) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Lifted {
+		i--
+		if m.Lifted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	n13, err13 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Expiration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Expiration):])
+	if err13 != nil {
+		return 0, err13
+	}
+	i -= n13
+	i = encodeVarintBtcbridge(dAtA, i, uint64(n13))
+	i--
+	dAtA[i] = 0x32
+	n14, err14 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err14 != nil {
+		return 0, err14
+	}
+	i -= n14
+	i = encodeVarintBtcbridge(dAtA, i, uint64(n14))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Scope != 0 {
+		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Scope))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Guardian) > 0 {
+		i -= len(m.Guardian)
+		copy(dAtA[i:], m.Guardian)
+		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.Guardian)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintBtcbridge(dAtA []byte, offset int, v uint64) int {
+	offset -= sovBtcbridge(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *BlockHeader) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovBtcbridge(uint64(m.Version))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovBtcbridge(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovBtcbridge(uint64(m.Height))
+	}
+	l = len(m.PreviousBlockHash)
+	if l > 0 {
+		n += 1 + l + sovBtcbridge(uint64(l))
+	}
+	l = len(m.MerkleRoot)
+	if l > 0 {
+		n += 1 + l + sovBtcbridge(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovBtcbridge(uint64(m.Nonce))
+	}
+	l = len(m.Bits)
+	if l > 0 {
+		n += 1 + l + sovBtcbridge(uint64(l))
+	}
+	if m.Time != 0 {
+		n += 1 + sovBtcbridge(uint64(m.Time))
+	}
+	if m.Ntx != 0 {
+		n += 1 + sovBtcbridge(uint64(m.Ntx))
+	}
+	return n
+}
+
+func (m *FeeRate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Value != 0 {
+		n += 1 + sovBtcbridge(uint64(m.Value))
+	}
+	if m.Height != 0 {
+		n += 1 + sovBtcbridge(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *SigningRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovBtcbridge(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovBtcbridge(uint64(m.Sequence))
+	}
+	if m.Type != 0 {
+		n += 1 + sovBtcbridge(uint64(m.Type))
+	}
+	l = len(m.Txid)
+	if l > 0 {
+		n += 1 + l + sovBtcbridge(uint64(l))
+	}
+	l = len(m.Psbt)
+	if l > 0 {
+		n += 1 + l + sovBtcbridge(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreationTime)
+	n += 1 + l + sovBtcbridge(uint64(l))
+	if m.Status != 0 {
+		n += 1 + sovBtcbridge(uint64(m.Status))
+	}
+	return n
+}
+
+func (m *SigningRequestHistoryEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Transition != 0 {
+		n += 1 + sovBtcbridge(uint64(m.Transition))
+	}
+	l = len(m.Actor)
+	if l > 0 {
+		n += 1 + l + sovBtcbridge(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovBtcbridge(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovBtcbridge(uint64(l))
+	return n
+}
+
+func (m *SigningRequestHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		n += 1 + sovBtcbridge(uint64(m.Sequence))
+	}
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovBtcbridge(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *WithdrawRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovBtcbridge(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovBtcbridge(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovBtcbridge(uint64(m.Sequence))
+	}
+	l = len(m.Txid)
+	if l > 0 {
+		n += 1 + l + sovBtcbridge(uint64(l))
+	}
+	return n
+}
+
+func (m *UTXO) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Txid)
+	if l > 0 {
+		n += 1 + l + sovBtcbridge(uint64(l))
+	}
+	if m.Vout != 0 {
+		n += 1 + sovBtcbridge(uint64(m.Vout))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovBtcbridge(uint64(l))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovBtcbridge(uint64(m.Amount))
+	}
+	if m.Height != 0 {
+		n += 1 + sovBtcbridge(uint64(m.Height))
+	}
+	l = len(m.PubKeyScript)
+	if l > 0 {
+		n += 1 + l + sovBtcbridge(uint64(l))
+	}
+	if m.IsLocked {
+		n += 2
+	}
+	if len(m.Runes) > 0 {
+		for _, e := range m.Runes {
+			l = e.Size()
+			n += 1 + l + sovBtcbridge(uint64(l))
+		}
+	}
+	l = len(m.WitnessScript)
+	if l > 0 {
+		n += 1 + l + sovBtcbridge(uint64(l))
+	}
+	return n
+}
+
+func (m *RuneBalance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovBtcbridge(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovBtcbridge(uint64(l))
+	}
+	return n
+}
+
+func (m *RuneId) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Block != 0 {
+		n += 1 + sovBtcbridge(uint64(m.Block))
+	}
+	if m.Tx != 0 {
+		n += 1 + sovBtcbridge(uint64(m.Tx))
+	}
+	return n
+}
+
+func (m *Edict) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != nil {
+		l = m.Id.Size()
+		n += 1 + l + sovBtcbridge(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovBtcbridge(uint64(l))
+	}
+	if m.Output != 0 {
+		n += 1 + sovBtcbridge(uint64(m.Output))
+	}
+	return n
+}
+
+func (m *RuneMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovBtcbridge(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovBtcbridge(uint64(l))
+	}
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovBtcbridge(uint64(l))
+	}
+	if m.Divisibility != 0 {
+		n += 1 + sovBtcbridge(uint64(m.Divisibility))
+	}
+	l = len(m.EtchingTx)
+	if l > 0 {
+		n += 1 + l + sovBtcbridge(uint64(l))
+	}
+	return n
+}
+
+func (m *Brc20Metadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Tick)
+	if l > 0 {
+		n += 1 + l + sovBtcbridge(uint64(l))
+	}
+	if m.Decimals != 0 {
+		n += 1 + sovBtcbridge(uint64(m.Decimals))
+	}
+	return n
+}
+
+func (m *BtcConsolidation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TargetThreshold != 0 {
+		n += 1 + sovBtcbridge(uint64(m.TargetThreshold))
+	}
+	if m.MaxNum != 0 {
+		n += 1 + sovBtcbridge(uint64(m.MaxNum))
+	}
+	return n
+}
+
+func (m *RunesConsolidation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RuneId)
+	if l > 0 {
+		n += 1 + l + sovBtcbridge(uint64(l))
+	}
+	l = len(m.TargetThreshold)
+	if l > 0 {
+		n += 1 + l + sovBtcbridge(uint64(l))
+	}
+	if m.MaxNum != 0 {
+		n += 1 + sovBtcbridge(uint64(m.MaxNum))
+	}
+	return n
+}
+
+func (m *DKGParticipant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Moniker)
+	if l > 0 {
+		n += 1 + l + sovBtcbridge(uint64(l))
+	}
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovBtcbridge(uint64(l))
+	}
+	l = len(m.ConsensusPubkey)
+	if l > 0 {
+		n += 1 + l + sovBtcbridge(uint64(l))
+	}
+	return n
+}
+
+func (m *DKGRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovBtcbridge(uint64(m.Id))
+	}
+	if len(m.Participants) > 0 {
+		for _, e := range m.Participants {
+			l = e.Size()
+			n += 1 + l + sovBtcbridge(uint64(l))
+		}
+	}
+	if m.Threshold != 0 {
+		n += 1 + sovBtcbridge(uint64(m.Threshold))
+	}
+	if len(m.VaultTypes) > 0 {
+		l = 0
+		for _, e := range m.VaultTypes {
+			l += sovBtcbridge(uint64(e))
+		}
+		n += 1 + sovBtcbridge(uint64(l)) + l
+	}
+	if m.EnableTransfer {
+		n += 2
+	}
+	if m.TargetUtxoNum != 0 {
+		n += 1 + sovBtcbridge(uint64(m.TargetUtxoNum))
+	}
+	if m.Expiration != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration)
+		n += 1 + l + sovBtcbridge(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovBtcbridge(uint64(m.Status))
+	}
+	if m.TaprootTree != nil {
+		l = m.TaprootTree.Size()
+		n += 1 + l + sovBtcbridge(uint64(l))
+	}
+	if len(m.NonResponders) > 0 {
+		for _, e := range m.NonResponders {
+			l = e.Size()
+			n += 1 + l + sovBtcbridge(uint64(l))
+		}
+	}
+	if m.RetryOf != 0 {
+		n += 1 + sovBtcbridge(uint64(m.RetryOf))
+	}
+	if m.RetryCount != 0 {
+		n += 1 + sovBtcbridge(uint64(m.RetryCount))
+	}
+	return n
+}
+
+func (m *DKGCompletionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovBtcbridge(uint64(m.Id))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovBtcbridge(uint64(l))
+	}
+	if len(m.Vaults) > 0 {
+		for _, s := range m.Vaults {
+			l = len(s)
+			n += 1 + l + sovBtcbridge(uint64(l))
+		}
+	}
+	l = len(m.ConsensusAddress)
+	if l > 0 {
+		n += 1 + l + sovBtcbridge(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovBtcbridge(uint64(l))
+	}
+	if len(m.PubKeys) > 0 {
+		for _, s := range m.PubKeys {
+			l = len(s)
+			n += 1 + l + sovBtcbridge(uint64(l))
+		}
+	}
+	if len(m.Proofs) > 0 {
+		for _, s := range m.Proofs {
+			l = len(s)
+			n += 1 + l + sovBtcbridge(uint64(l))
+		}
+	}
+	if len(m.VerificationShares) > 0 {
+		for _, e := range m.VerificationShares {
+			l = e.Size()
+			n += 1 + l + sovBtcbridge(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *VerificationShares) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Shares) > 0 {
+		for _, s := range m.Shares {
+			l = len(s)
+			n += 1 + l + sovBtcbridge(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FrostSigner) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovBtcbridge(uint64(l))
+	}
+	if m.Index != 0 {
+		n += 1 + sovBtcbridge(uint64(m.Index))
+	}
+	l = len(m.VerificationShare)
+	if l > 0 {
+		n += 1 + l + sovBtcbridge(uint64(l))
+	}
+	return n
+}
+
+func (m *FrostVaultKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Vault)
+	if l > 0 {
+		n += 1 + l + sovBtcbridge(uint64(l))
+	}
+	l = len(m.PubKey)
+	if l > 0 {
+		n += 1 + l + sovBtcbridge(uint64(l))
+	}
+	if m.Threshold != 0 {
+		n += 1 + sovBtcbridge(uint64(m.Threshold))
+	}
+	if len(m.Signers) > 0 {
+		for _, e := range m.Signers {
+			l = e.Size()
+			n += 1 + l + sovBtcbridge(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FrostNonceCommitment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hiding)
+	if l > 0 {
+		n += 1 + l + sovBtcbridge(uint64(l))
+	}
+	l = len(m.Binding)
+	if l > 0 {
+		n += 1 + l + sovBtcbridge(uint64(l))
+	}
+	return n
+}
+
+func (m *FrostSignerRound) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovBtcbridge(uint64(l))
+	}
+	if len(m.Commitments) > 0 {
+		for _, e := range m.Commitments {
+			l = e.Size()
+			n += 1 + l + sovBtcbridge(uint64(l))
+		}
+	}
+	if len(m.PartialSignatures) > 0 {
+		for _, s := range m.PartialSignatures {
+			l = len(s)
+			n += 1 + l + sovBtcbridge(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FrostSigningSession) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Txid)
+	if l > 0 {
+		n += 1 + l + sovBtcbridge(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovBtcbridge(uint64(m.Status))
+	}
+	if m.Round != 0 {
+		n += 1 + sovBtcbridge(uint64(m.Round))
+	}
+	if m.Threshold != 0 {
+		n += 1 + sovBtcbridge(uint64(m.Threshold))
+	}
+	if len(m.Signers) > 0 {
+		for _, e := range m.Signers {
+			l = e.Size()
+			n += 1 + l + sovBtcbridge(uint64(l))
+		}
+	}
+	if len(m.MisbehavingSigners) > 0 {
+		for _, s := range m.MisbehavingSigners {
+			l = len(s)
+			n += 1 + l + sovBtcbridge(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.RoundStartTime)
+	n += 1 + l + sovBtcbridge(uint64(l))
+	return n
+}
+
+func (m *SignerPerformance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovBtcbridge(uint64(l))
+	}
+	if len(m.MissedDkgHeights) > 0 {
+		l = 0
+		for _, e := range m.MissedDkgHeights {
+			l += sovBtcbridge(uint64(e))
+		}
+		n += 1 + sovBtcbridge(uint64(l)) + l
+	}
+	if len(m.MissedSigningHeights) > 0 {
+		l = 0
+		for _, e := range m.MissedSigningHeights {
+			l += sovBtcbridge(uint64(e))
+		}
+		n += 1 + sovBtcbridge(uint64(l)) + l
+	}
+	if m.TotalMissedDkgs != 0 {
+		n += 1 + sovBtcbridge(uint64(m.TotalMissedDkgs))
+	}
+	if m.TotalMissedSignings != 0 {
+		n += 1 + sovBtcbridge(uint64(m.TotalMissedSignings))
+	}
+	if m.Punishments != 0 {
+		n += 1 + sovBtcbridge(uint64(m.Punishments))
+	}
+	if m.LastPunishmentHeight != 0 {
+		n += 1 + sovBtcbridge(uint64(m.LastPunishmentHeight))
+	}
+	return n
+}
+
+func (m *AssetTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AssetType != 0 {
+		n += 1 + sovBtcbridge(uint64(m.AssetType))
+	}
+	l = len(m.SourceVault)
+	if l > 0 {
+		n += 1 + l + sovBtcbridge(uint64(l))
+	}
+	l = len(m.DestVault)
+	if l > 0 {
+		n += 1 + l + sovBtcbridge(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovBtcbridge(uint64(m.Status))
+	}
+	if len(m.SigningRequests) > 0 {
+		for _, s := range m.SigningRequests {
+			l = len(s)
+			n += 1 + l + sovBtcbridge(uint64(l))
+		}
+	}
+	if m.RemainingUtxoNum != 0 {
+		n += 1 + sovBtcbridge(uint64(m.RemainingUtxoNum))
+	}
+	if m.RemainingValue != 0 {
+		n += 1 + sovBtcbridge(uint64(m.RemainingValue))
+	}
+	l = len(m.LastError)
+	if l > 0 {
+		n += 1 + l + sovBtcbridge(uint64(l))
+	}
+	return n
+}
+
+func (m *VaultTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SourceVersion != 0 {
+		n += 1 + sovBtcbridge(uint64(m.SourceVersion))
+	}
+	if m.DestVersion != 0 {
+		n += 1 + sovBtcbridge(uint64(m.DestVersion))
+	}
+	if m.DkgId != 0 {
+		n += 1 + sovBtcbridge(uint64(m.DkgId))
+	}
+	if m.Status != 0 {
+		n += 1 + sovBtcbridge(uint64(m.Status))
+	}
+	if len(m.Assets) > 0 {
+		for _, e := range m.Assets {
+			l = e.Size()
+			n += 1 + l + sovBtcbridge(uint64(l))
+		}
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovBtcbridge(uint64(m.StartHeight))
+	}
+	if m.CompletionHeight != 0 {
+		n += 1 + sovBtcbridge(uint64(m.CompletionHeight))
+	}
+	return n
+}
+
+func (m *FeeBucketStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Bucket != 0 {
+		n += 1 + sovBtcbridge(uint64(m.Bucket))
+	}
+	if len(m.Accumulated) > 0 {
+		for _, e := range m.Accumulated {
+			l = e.Size()
+			n += 1 + l + sovBtcbridge(uint64(l))
+		}
+	}
+	if len(m.Distributed) > 0 {
+		for _, e := range m.Distributed {
+			l = e.Size()
+			n += 1 + l + sovBtcbridge(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CircuitBreaker) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Tripped {
+		n += 2
+	}
+	if m.Height != 0 {
+		n += 1 + sovBtcbridge(uint64(m.Height))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovBtcbridge(uint64(l))
+	}
+	return n
+}
+
+func (m *OutflowUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovBtcbridge(uint64(l))
+	}
+	l = len(m.Used)
+	if l > 0 {
+		n += 1 + l + sovBtcbridge(uint64(l))
+	}
+	l = len(m.Limit)
+	if l > 0 {
+		n += 1 + l + sovBtcbridge(uint64(l))
+	}
+	return n
+}
+
+func (m *EmergencyPause) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovBtcbridge(uint64(m.Id))
+	}
+	l = len(m.Guardian)
+	if l > 0 {
+		n += 1 + l + sovBtcbridge(uint64(l))
+	}
+	if m.Scope != 0 {
+		n += 1 + sovBtcbridge(uint64(m.Scope))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovBtcbridge(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovBtcbridge(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Expiration)
+	n += 1 + l + sovBtcbridge(uint64(l))
+	if m.Lifted {
+		n += 2
+	}
+	return n
+}
+
+func sovBtcbridge(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozBtcbridge(x uint64) (n int) {
+	return sovBtcbridge(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *BlockHeader) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBtcbridge
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockHeader: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockHeader: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousBlockHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousBlockHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MerkleRoot", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MerkleRoot = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bits", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bits = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			m.Time = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Time |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ntx", wireType)
+			}
+			m.Ntx = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Ntx |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBtcbridge(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeRate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBtcbridge
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeRate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeRate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			m.Value = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Value |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBtcbridge(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SigningRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBtcbridge
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SigningRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SigningRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= AssetType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Txid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Txid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Psbt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Psbt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CreationTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= SigningStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBtcbridge(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SigningRequestHistoryEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBtcbridge
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SigningRequestHistoryEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SigningRequestHistoryEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transition", wireType)
+			}
+			m.Transition = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Transition |= SigningTransition(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Actor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Actor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBtcbridge(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SigningRequestHistory) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBtcbridge
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SigningRequestHistory: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SigningRequestHistory: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, &SigningRequestHistoryEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBtcbridge(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WithdrawRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBtcbridge
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WithdrawRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WithdrawRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Txid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Txid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBtcbridge(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UTXO) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBtcbridge
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UTXO: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UTXO: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Txid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Txid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vout", wireType)
+			}
+			m.Vout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Vout |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKeyScript", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKeyScript = append(m.PubKeyScript[:0], dAtA[iNdEx:postIndex]...)
+			if m.PubKeyScript == nil {
+				m.PubKeyScript = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsLocked", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsLocked = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Runes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Runes = append(m.Runes, &RuneBalance{})
+			if err := m.Runes[len(m.Runes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WitnessScript", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WitnessScript = append(m.WitnessScript[:0], dAtA[iNdEx:postIndex]...)
+			if m.WitnessScript == nil {
+				m.WitnessScript = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBtcbridge(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RuneBalance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBtcbridge
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RuneBalance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RuneBalance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBtcbridge(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RuneId) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBtcbridge
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RuneId: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RuneId: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+			}
+			m.Block = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Block |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tx", wireType)
+			}
+			m.Tx = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Tx |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBtcbridge(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Edict) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBtcbridge
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Edict: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Edict: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Id == nil {
+				m.Id = &RuneId{}
+			}
+			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Output", wireType)
+			}
+			m.Output = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Output |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBtcbridge(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RuneMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBtcbridge
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RuneMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RuneMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Divisibility", wireType)
+			}
+			m.Divisibility = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Divisibility |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EtchingTx", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EtchingTx = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBtcbridge(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Brc20Metadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBtcbridge
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Brc20Metadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Brc20Metadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tick", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tick = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+			}
+			m.Decimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Decimals |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBtcbridge(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BtcConsolidation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBtcbridge
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BtcConsolidation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BtcConsolidation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetThreshold", wireType)
+			}
+			m.TargetThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetThreshold |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxNum", wireType)
+			}
+			m.MaxNum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxNum |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBtcbridge(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RunesConsolidation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBtcbridge
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RunesConsolidation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RunesConsolidation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RuneId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RuneId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetThreshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxNum", wireType)
+			}
+			m.MaxNum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxNum |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBtcbridge(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DKGParticipant) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBtcbridge
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DKGParticipant: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DKGParticipant: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Moniker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Moniker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusPubkey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsensusPubkey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBtcbridge(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DKGRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBtcbridge
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DKGRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DKGRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Participants", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Participants = append(m.Participants, &DKGParticipant{})
+			if err := m.Participants[len(m.Participants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			m.Threshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Threshold |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType == 0 {
+				var v AssetType
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowBtcbridge
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= AssetType(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.VaultTypes = append(m.VaultTypes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowBtcbridge
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthBtcbridge
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthBtcbridge
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.VaultTypes) == 0 {
+					m.VaultTypes = make([]AssetType, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v AssetType
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowBtcbridge
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= AssetType(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.VaultTypes = append(m.VaultTypes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field VaultTypes", wireType)
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableTransfer", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnableTransfer = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetUtxoNum", wireType)
+			}
+			m.TargetUtxoNum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetUtxoNum |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expiration == nil {
+				m.Expiration = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= DKGRequestStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaprootTree", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TaprootTree == nil {
+				m.TaprootTree = &VaultTaprootTree{}
+			}
+			if err := m.TaprootTree.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NonResponders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NonResponders = append(m.NonResponders, &DKGParticipant{})
+			if err := m.NonResponders[len(m.NonResponders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryOf", wireType)
+			}
+			m.RetryOf = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RetryOf |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryCount", wireType)
+			}
+			m.RetryCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RetryCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBtcbridge(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
-		n += 1 + sovBtcbridge(uint64(l)) + l
-	}
-	if m.EnableTransfer {
-		n += 2
-	}
-	if m.TargetUtxoNum != 0 {
-		n += 1 + sovBtcbridge(uint64(m.TargetUtxoNum))
 	}
-	if m.Expiration != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration)
-		n += 1 + l + sovBtcbridge(uint64(l))
-	}
-	if m.Status != 0 {
-		n += 1 + sovBtcbridge(uint64(m.Status))
-	}
-	return n
-}
 
-func (m *DKGCompletionRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovBtcbridge(uint64(m.Id))
-	}
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovBtcbridge(uint64(l))
-	}
-	if len(m.Vaults) > 0 {
-		for _, s := range m.Vaults {
-			l = len(s)
-			n += 1 + l + sovBtcbridge(uint64(l))
-		}
-	}
-	l = len(m.ConsensusAddress)
-	if l > 0 {
-		n += 1 + l + sovBtcbridge(uint64(l))
-	}
-	l = len(m.Signature)
-	if l > 0 {
-		n += 1 + l + sovBtcbridge(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovBtcbridge(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozBtcbridge(x uint64) (n int) {
-	return sovBtcbridge(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *BlockHeader) Unmarshal(dAtA []byte) error {
+func (m *DKGCompletionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2251,17 +8093,17 @@ func (m *BlockHeader) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BlockHeader: wiretype end group for non-group")
+			return fmt.Errorf("proto: DKGCompletionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BlockHeader: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DKGCompletionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			m.Version = 0
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBtcbridge
@@ -2271,14 +8113,14 @@ func (m *BlockHeader) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Version |= uint64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2306,13 +8148,13 @@ func (m *BlockHeader) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hash = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vaults", wireType)
 			}
-			m.Height = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBtcbridge
@@ -2322,14 +8164,27 @@ func (m *BlockHeader) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Vaults = append(m.Vaults, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreviousBlockHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2357,11 +8212,11 @@ func (m *BlockHeader) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PreviousBlockHash = string(dAtA[iNdEx:postIndex])
+			m.ConsensusAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MerkleRoot", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2389,13 +8244,13 @@ func (m *BlockHeader) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MerkleRoot = string(dAtA[iNdEx:postIndex])
+			m.Signature = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKeys", wireType)
 			}
-			m.Nonce = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBtcbridge
@@ -2405,14 +8260,27 @@ func (m *BlockHeader) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Nonce |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKeys = append(m.PubKeys, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bits", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proofs", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2440,13 +8308,13 @@ func (m *BlockHeader) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Bits = string(dAtA[iNdEx:postIndex])
+			m.Proofs = append(m.Proofs, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerificationShares", wireType)
 			}
-			m.Time = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBtcbridge
@@ -2456,30 +8324,26 @@ func (m *BlockHeader) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Time |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ntx", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthBtcbridge
 			}
-			m.Ntx = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBtcbridge
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Ntx |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.VerificationShares = append(m.VerificationShares, VerificationShares{})
+			if err := m.VerificationShares[len(m.VerificationShares)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBtcbridge(dAtA[iNdEx:])
@@ -2501,7 +8365,7 @@ func (m *BlockHeader) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FeeRate) Unmarshal(dAtA []byte) error {
+func (m *VerificationShares) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2524,17 +8388,17 @@ func (m *FeeRate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FeeRate: wiretype end group for non-group")
+			return fmt.Errorf("proto: VerificationShares: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FeeRate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VerificationShares: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
 			}
-			m.Value = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBtcbridge
@@ -2544,30 +8408,24 @@ func (m *FeeRate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Value |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtcbridge
 			}
-			m.Height = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBtcbridge
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Height |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcbridge
 			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Shares = append(m.Shares, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBtcbridge(dAtA[iNdEx:])
@@ -2589,7 +8447,7 @@ func (m *FeeRate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SigningRequest) Unmarshal(dAtA []byte) error {
+func (m *FrostSigner) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2612,15 +8470,15 @@ func (m *SigningRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SigningRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: FrostSigner: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SigningRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FrostSigner: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2648,13 +8506,13 @@ func (m *SigningRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
 			}
-			m.Sequence = 0
+			m.Index = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBtcbridge
@@ -2664,16 +8522,16 @@ func (m *SigningRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Sequence |= uint64(b&0x7F) << shift
+				m.Index |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerificationShare", wireType)
 			}
-			m.Type = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBtcbridge
@@ -2683,14 +8541,77 @@ func (m *SigningRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= AssetType(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VerificationShare = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBtcbridge(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FrostVaultKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBtcbridge
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FrostVaultKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FrostVaultKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Txid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Vault", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2718,11 +8639,11 @@ func (m *SigningRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Txid = string(dAtA[iNdEx:postIndex])
+			m.Vault = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Psbt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2750,11 +8671,30 @@ func (m *SigningRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Psbt = string(dAtA[iNdEx:postIndex])
+			m.PubKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			m.Threshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Threshold |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreationTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2781,29 +8721,11 @@ func (m *SigningRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CreationTime, dAtA[iNdEx:postIndex]); err != nil {
+			m.Signers = append(m.Signers, FrostSigner{})
+			if err := m.Signers[len(m.Signers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-			}
-			m.Status = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBtcbridge
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Status |= SigningStatus(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBtcbridge(dAtA[iNdEx:])
@@ -2825,7 +8747,7 @@ func (m *SigningRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WithdrawRequest) Unmarshal(dAtA []byte) error {
+func (m *FrostNonceCommitment) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2848,15 +8770,15 @@ func (m *WithdrawRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WithdrawRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: FrostNonceCommitment: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WithdrawRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FrostNonceCommitment: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hiding", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2884,11 +8806,11 @@ func (m *WithdrawRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Hiding = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Binding", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2916,13 +8838,95 @@ func (m *WithdrawRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = string(dAtA[iNdEx:postIndex])
+			m.Binding = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBtcbridge(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FrostSignerRound) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBtcbridge
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FrostSignerRound: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FrostSignerRound: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commitments", wireType)
 			}
-			m.Sequence = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBtcbridge
@@ -2932,14 +8936,29 @@ func (m *WithdrawRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Sequence |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+			if msglen < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commitments = append(m.Commitments, FrostNonceCommitment{})
+			if err := m.Commitments[len(m.Commitments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Txid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PartialSignatures", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2967,7 +8986,7 @@ func (m *WithdrawRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Txid = string(dAtA[iNdEx:postIndex])
+			m.PartialSignatures = append(m.PartialSignatures, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2990,7 +9009,7 @@ func (m *WithdrawRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UTXO) Unmarshal(dAtA []byte) error {
+func (m *FrostSigningSession) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3013,10 +9032,10 @@ func (m *UTXO) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UTXO: wiretype end group for non-group")
+			return fmt.Errorf("proto: FrostSigningSession: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UTXO: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FrostSigningSession: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3053,9 +9072,9 @@ func (m *UTXO) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Vout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			m.Vout = 0
+			m.Status = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBtcbridge
@@ -3065,16 +9084,16 @@ func (m *UTXO) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Vout |= uint64(b&0x7F) << shift
+				m.Status |= FrostSigningStatus(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
 			}
-			var stringLen uint64
+			m.Round = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBtcbridge
@@ -3084,29 +9103,16 @@ func (m *UTXO) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Round |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthBtcbridge
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBtcbridge
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
 			}
-			m.Amount = 0
+			m.Threshold = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBtcbridge
@@ -3116,16 +9122,16 @@ func (m *UTXO) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Amount |= uint64(b&0x7F) << shift
+				m.Threshold |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
 			}
-			m.Height = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBtcbridge
@@ -3135,16 +9141,31 @@ func (m *UTXO) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signers = append(m.Signers, FrostSignerRound{})
+			if err := m.Signers[len(m.Signers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PubKeyScript", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MisbehavingSigners", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBtcbridge
@@ -3154,49 +9175,27 @@ func (m *UTXO) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthBtcbridge
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthBtcbridge
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PubKeyScript = append(m.PubKeyScript[:0], dAtA[iNdEx:postIndex]...)
-			if m.PubKeyScript == nil {
-				m.PubKeyScript = []byte{}
-			}
+			m.MisbehavingSigners = append(m.MisbehavingSigners, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsLocked", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBtcbridge
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.IsLocked = bool(v != 0)
-		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Runes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RoundStartTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3223,8 +9222,7 @@ func (m *UTXO) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Runes = append(m.Runes, &RuneBalance{})
-			if err := m.Runes[len(m.Runes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.RoundStartTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3249,7 +9247,7 @@ func (m *UTXO) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RuneBalance) Unmarshal(dAtA []byte) error {
+func (m *SignerPerformance) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3272,15 +9270,15 @@ func (m *RuneBalance) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RuneBalance: wiretype end group for non-group")
+			return fmt.Errorf("proto: SignerPerformance: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RuneBalance: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SignerPerformance: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3308,13 +9306,184 @@ func (m *RuneBalance) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowBtcbridge
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.MissedDkgHeights = append(m.MissedDkgHeights, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowBtcbridge
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthBtcbridge
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthBtcbridge
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.MissedDkgHeights) == 0 {
+					m.MissedDkgHeights = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowBtcbridge
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.MissedDkgHeights = append(m.MissedDkgHeights, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedDkgHeights", wireType)
+			}
+		case 3:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowBtcbridge
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.MissedSigningHeights = append(m.MissedSigningHeights, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowBtcbridge
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthBtcbridge
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthBtcbridge
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.MissedSigningHeights) == 0 {
+					m.MissedSigningHeights = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowBtcbridge
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.MissedSigningHeights = append(m.MissedSigningHeights, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedSigningHeights", wireType)
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalMissedDkgs", wireType)
+			}
+			m.TotalMissedDkgs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalMissedDkgs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			var stringLen uint64
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalMissedSignings", wireType)
+			}
+			m.TotalMissedSignings = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBtcbridge
@@ -3324,79 +9493,16 @@ func (m *RuneBalance) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.TotalMissedSignings |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthBtcbridge
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBtcbridge
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Amount = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipBtcbridge(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthBtcbridge
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RuneId) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowBtcbridge
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RuneId: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RuneId: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Punishments", wireType)
 			}
-			m.Block = 0
+			m.Punishments = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBtcbridge
@@ -3406,16 +9512,16 @@ func (m *RuneId) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Block |= uint64(b&0x7F) << shift
+				m.Punishments |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tx", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastPunishmentHeight", wireType)
 			}
-			m.Tx = 0
+			m.LastPunishmentHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBtcbridge
@@ -3425,7 +9531,7 @@ func (m *RuneId) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Tx |= uint32(b&0x7F) << shift
+				m.LastPunishmentHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3451,7 +9557,7 @@ func (m *RuneId) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Edict) Unmarshal(dAtA []byte) error {
+func (m *AssetTransfer) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3474,17 +9580,17 @@ func (m *Edict) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Edict: wiretype end group for non-group")
+			return fmt.Errorf("proto: AssetTransfer: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Edict: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AssetTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetType", wireType)
 			}
-			var msglen int
+			m.AssetType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBtcbridge
@@ -3494,31 +9600,14 @@ func (m *Edict) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.AssetType |= AssetType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthBtcbridge
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthBtcbridge
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Id == nil {
-				m.Id = &RuneId{}
-			}
-			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceVault", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3546,13 +9635,13 @@ func (m *Edict) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = string(dAtA[iNdEx:postIndex])
+			m.SourceVault = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Output", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestVault", wireType)
 			}
-			m.Output = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBtcbridge
@@ -3562,66 +9651,29 @@ func (m *Edict) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Output |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipBtcbridge(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthBtcbridge
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *BtcConsolidation) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowBtcbridge
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcbridge
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: BtcConsolidation: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BtcConsolidation: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.DestVault = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetThreshold", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			m.TargetThreshold = 0
+			m.Status = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBtcbridge
@@ -3631,16 +9683,16 @@ func (m *BtcConsolidation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TargetThreshold |= int64(b&0x7F) << shift
+				m.Status |= VaultTransferStatus(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxNum", wireType)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigningRequests", wireType)
 			}
-			m.MaxNum = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBtcbridge
@@ -3650,66 +9702,29 @@ func (m *BtcConsolidation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxNum |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipBtcbridge(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthBtcbridge
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RunesConsolidation) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowBtcbridge
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcbridge
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RunesConsolidation: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RunesConsolidation: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RuneId", wireType)
+			m.SigningRequests = append(m.SigningRequests, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingUtxoNum", wireType)
 			}
-			var stringLen uint64
+			m.RemainingUtxoNum = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBtcbridge
@@ -3719,27 +9734,33 @@ func (m *RunesConsolidation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.RemainingUtxoNum |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthBtcbridge
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBtcbridge
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingValue", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.RemainingValue = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RemainingValue |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.RuneId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetThreshold", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastError", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3767,27 +9788,8 @@ func (m *RunesConsolidation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TargetThreshold = string(dAtA[iNdEx:postIndex])
+			m.LastError = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxNum", wireType)
-			}
-			m.MaxNum = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBtcbridge
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MaxNum |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBtcbridge(dAtA[iNdEx:])
@@ -3809,7 +9811,7 @@ func (m *RunesConsolidation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DKGParticipant) Unmarshal(dAtA []byte) error {
+func (m *VaultTransfer) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3832,17 +9834,17 @@ func (m *DKGParticipant) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DKGParticipant: wiretype end group for non-group")
+			return fmt.Errorf("proto: VaultTransfer: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DKGParticipant: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VaultTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Moniker", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceVersion", wireType)
 			}
-			var stringLen uint64
+			m.SourceVersion = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBtcbridge
@@ -3852,29 +9854,73 @@ func (m *DKGParticipant) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.SourceVersion |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthBtcbridge
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestVersion", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBtcbridge
+			m.DestVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DestVersion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DkgId", wireType)
 			}
-			m.Moniker = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+			m.DkgId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DkgId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= VaultTransferStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Assets", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBtcbridge
@@ -3884,29 +9930,31 @@ func (m *DKGParticipant) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthBtcbridge
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthBtcbridge
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			m.Assets = append(m.Assets, AssetTransfer{})
+			if err := m.Assets[len(m.Assets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusPubkey", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
 			}
-			var stringLen uint64
+			m.StartHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBtcbridge
@@ -3916,24 +9964,30 @@ func (m *DKGParticipant) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.StartHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthBtcbridge
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBtcbridge
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionHeight", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.CompletionHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CompletionHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.ConsensusPubkey = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBtcbridge(dAtA[iNdEx:])
@@ -3955,7 +10009,7 @@ func (m *DKGParticipant) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DKGRequest) Unmarshal(dAtA []byte) error {
+func (m *FeeBucketStats) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3978,17 +10032,17 @@ func (m *DKGRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DKGRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: FeeBucketStats: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DKGRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FeeBucketStats: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bucket", wireType)
 			}
-			m.Id = 0
+			m.Bucket = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBtcbridge
@@ -3998,14 +10052,14 @@ func (m *DKGRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				m.Bucket |= FeeBucket(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Participants", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Accumulated", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4032,16 +10086,16 @@ func (m *DKGRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Participants = append(m.Participants, &DKGParticipant{})
-			if err := m.Participants[len(m.Participants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Accumulated = append(m.Accumulated, types.Coin{})
+			if err := m.Accumulated[len(m.Accumulated)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Distributed", wireType)
 			}
-			m.Threshold = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBtcbridge
@@ -4051,85 +10105,101 @@ func (m *DKGRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Threshold |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType == 0 {
-				var v AssetType
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowBtcbridge
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= AssetType(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.VaultTypes = append(m.VaultTypes, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowBtcbridge
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthBtcbridge
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthBtcbridge
+			if msglen < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Distributed = append(m.Distributed, types.Coin{})
+			if err := m.Distributed[len(m.Distributed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBtcbridge(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CircuitBreaker) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBtcbridge
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CircuitBreaker: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CircuitBreaker: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tripped", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				if elementCount != 0 && len(m.VaultTypes) == 0 {
-					m.VaultTypes = make([]AssetType, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v AssetType
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowBtcbridge
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= AssetType(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.VaultTypes = append(m.VaultTypes, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field VaultTypes", wireType)
 			}
-		case 5:
+			m.Tripped = bool(v != 0)
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnableTransfer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var v int
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBtcbridge
@@ -4139,17 +10209,16 @@ func (m *DKGRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.EnableTransfer = bool(v != 0)
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetUtxoNum", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			m.TargetUtxoNum = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBtcbridge
@@ -4159,16 +10228,79 @@ func (m *DKGRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TargetUtxoNum |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBtcbridge(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OutflowUsage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBtcbridge
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OutflowUsage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OutflowUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBtcbridge
@@ -4178,33 +10310,61 @@ func (m *DKGRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthBtcbridge
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthBtcbridge
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Expiration == nil {
-				m.Expiration = new(time.Time)
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Used", wireType)
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcbridge
 			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Used = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
 			}
-			m.Status = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBtcbridge
@@ -4214,11 +10374,24 @@ func (m *DKGRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= DKGRequestStatus(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Limit = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBtcbridge(dAtA[iNdEx:])
@@ -4240,7 +10413,7 @@ func (m *DKGRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DKGCompletionRequest) Unmarshal(dAtA []byte) error {
+func (m *EmergencyPause) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4263,10 +10436,10 @@ func (m *DKGCompletionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DKGCompletionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: EmergencyPause: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DKGCompletionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EmergencyPause: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4290,7 +10463,7 @@ func (m *DKGCompletionRequest) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Guardian", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4318,11 +10491,30 @@ func (m *DKGCompletionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Guardian = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
+			}
+			m.Scope = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Scope |= PauseScope(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Vaults", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4350,13 +10542,13 @@ func (m *DKGCompletionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Vaults = append(m.Vaults, string(dAtA[iNdEx:postIndex]))
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBtcbridge
@@ -4366,29 +10558,30 @@ func (m *DKGCompletionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthBtcbridge
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthBtcbridge
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConsensusAddress = string(dAtA[iNdEx:postIndex])
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBtcbridge
@@ -4398,24 +10591,45 @@ func (m *DKGCompletionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthBtcbridge
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthBtcbridge
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signature = string(dAtA[iNdEx:postIndex])
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lifted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Lifted = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBtcbridge(dAtA[iNdEx:])
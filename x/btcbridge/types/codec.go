@@ -17,6 +17,13 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgWithdrawToBitcoin{}, "btcbridge/MsgWithdrawToBitcoin", nil)
 	cdc.RegisterConcrete(&MsgSubmitSignatures{}, "btcbridge/MsgSubmitSignatures", nil)
 	cdc.RegisterConcrete(&MsgCompleteDKG{}, "btcbridge/MsgCompleteDKG", nil)
+	cdc.RegisterConcrete(&MsgRegisterRunes{}, "btcbridge/MsgRegisterRunes", nil)
+	cdc.RegisterConcrete(&MsgRegisterBrc20Tickers{}, "btcbridge/MsgRegisterBrc20Tickers", nil)
+	cdc.RegisterConcrete(&MsgResetCircuitBreaker{}, "btcbridge/MsgResetCircuitBreaker", nil)
+	cdc.RegisterConcrete(&MsgEmergencyPause{}, "btcbridge/MsgEmergencyPause", nil)
+	cdc.RegisterConcrete(&MsgLiftEmergencyPause{}, "btcbridge/MsgLiftEmergencyPause", nil)
+	cdc.RegisterConcrete(&MsgSubmitNonceCommitments{}, "btcbridge/MsgSubmitNonceCommitments", nil)
+	cdc.RegisterConcrete(&MsgSubmitPartialSignatures{}, "btcbridge/MsgSubmitPartialSignatures", nil)
 	cdc.RegisterConcrete(&MsgUpdateParams{}, "btcbridge/MsgUpdateParams", nil)
 	// this line is used by starport scaffolding # 2
 }
@@ -31,6 +38,13 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgWithdrawToBitcoin{})
 	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgSubmitSignatures{})
 	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgCompleteDKG{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgRegisterRunes{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgRegisterBrc20Tickers{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgResetCircuitBreaker{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgEmergencyPause{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgLiftEmergencyPause{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgSubmitNonceCommitments{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgSubmitPartialSignatures{})
 	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgUpdateParams{})
 	// this line is used by starport scaffolding # 3
 
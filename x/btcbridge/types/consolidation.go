@@ -0,0 +1,91 @@
+package types
+
+import (
+	"lukechampine.com/uint128"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+const (
+	// the network fee rate is unavailable or outdated
+	ConsolidationSkipReasonFeeRateUnavailable = "fee_rate_unavailable"
+
+	// the network fee rate is higher than the policy ceiling
+	ConsolidationSkipReasonFeeRateTooHigh = "fee_rate_too_high"
+
+	// signing is paused in emergency
+	ConsolidationSkipReasonPaused = "paused"
+
+	// the consolidation failed, e.g. no utxos below the target threshold
+	ConsolidationSkipReasonFailed = "failed"
+)
+
+const (
+	// maximum number of the utxos to be consolidated per tx
+	MaxConsolidationUtxoNum = uint32(500)
+)
+
+// Validate validates the consolidation policy
+func (p ConsolidationPolicy) Validate() error {
+	if p.UtxoNumTrigger == 1 {
+		return errorsmod.Wrapf(ErrInvalidParams, "consolidation utxo number trigger must be greater than 1")
+	}
+
+	if p.MaxFeeRate < 0 {
+		return errorsmod.Wrapf(ErrInvalidParams, "consolidation max fee rate must not be negative")
+	}
+
+	if p.BtcTargetThreshold < 0 {
+		return errorsmod.Wrapf(ErrInvalidParams, "consolidation btc target threshold must not be negative")
+	}
+
+	if p.MaxNum > MaxConsolidationUtxoNum {
+		return errorsmod.Wrapf(ErrInvalidParams, "consolidation max num must not be greater than %d", MaxConsolidationUtxoNum)
+	}
+
+	if p.Cooldown < 0 {
+		return errorsmod.Wrapf(ErrInvalidParams, "consolidation cooldown must not be negative")
+	}
+
+	runeIds := make(map[string]bool)
+
+	for _, t := range p.RuneThresholds {
+		var id RuneId
+		if err := id.FromString(t.RuneId); err != nil {
+			return errorsmod.Wrapf(ErrInvalidParams, "invalid consolidation rune id %s", t.RuneId)
+		}
+
+		if runeIds[t.RuneId] {
+			return errorsmod.Wrapf(ErrInvalidParams, "duplicate consolidation rune id %s", t.RuneId)
+		}
+
+		threshold, err := uint128.FromString(t.TargetThreshold)
+		if err != nil || threshold.IsZero() {
+			return errorsmod.Wrapf(ErrInvalidParams, "invalid consolidation target threshold for rune %s", t.RuneId)
+		}
+
+		runeIds[t.RuneId] = true
+	}
+
+	return nil
+}
+
+// IsEnabled returns true if the automatic consolidation is enabled, false otherwise
+func (p ConsolidationPolicy) IsEnabled() bool {
+	return p.UtxoNumTrigger > 0 && (p.BtcTargetThreshold > 0 || len(p.RuneThresholds) > 0)
+}
+
+// GetConsolidationMaxNum gets the maximum number of the utxos to be consolidated per tx
+// 0 means the given maximum utxo number of the withdrawal params
+// The result is bounded by the withdrawal params and MaxConsolidationUtxoNum
+func GetConsolidationMaxNum(maxNum uint32, maxUtxoNum uint32) uint32 {
+	if maxNum == 0 || (maxUtxoNum > 0 && maxNum > maxUtxoNum) {
+		maxNum = maxUtxoNum
+	}
+
+	if maxNum == 0 || maxNum > MaxConsolidationUtxoNum {
+		maxNum = MaxConsolidationUtxoNum
+	}
+
+	return maxNum
+}
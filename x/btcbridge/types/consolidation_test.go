@@ -0,0 +1,51 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sideprotocol/side/x/btcbridge/types"
+)
+
+func TestConsolidationPolicyValidate(t *testing.T) {
+	testCases := []struct {
+		name      string
+		policy    types.ConsolidationPolicy
+		enabled   bool
+		expectErr bool
+	}{
+		{"disabled", types.ConsolidationPolicy{}, false, false},
+		{"btc", types.ConsolidationPolicy{UtxoNumTrigger: 100, MaxFeeRate: 10, BtcTargetThreshold: 100000, Cooldown: 1000}, true, false},
+		{"runes", types.ConsolidationPolicy{UtxoNumTrigger: 100, RuneThresholds: []types.RuneConsolidationThreshold{{RuneId: "840000:3", TargetThreshold: "1000"}}}, true, false},
+		{"no target", types.ConsolidationPolicy{UtxoNumTrigger: 100}, false, false},
+		{"trigger of 1", types.ConsolidationPolicy{UtxoNumTrigger: 1, BtcTargetThreshold: 100000}, false, true},
+		{"negative fee rate", types.ConsolidationPolicy{UtxoNumTrigger: 100, MaxFeeRate: -1}, false, true},
+		{"negative cooldown", types.ConsolidationPolicy{UtxoNumTrigger: 100, Cooldown: -1}, false, true},
+		{"max num exceeded", types.ConsolidationPolicy{UtxoNumTrigger: 100, MaxNum: types.MaxConsolidationUtxoNum + 1}, false, true},
+		{"invalid rune id", types.ConsolidationPolicy{RuneThresholds: []types.RuneConsolidationThreshold{{RuneId: "840000", TargetThreshold: "1000"}}}, false, true},
+		{"zero rune threshold", types.ConsolidationPolicy{RuneThresholds: []types.RuneConsolidationThreshold{{RuneId: "840000:3", TargetThreshold: "0"}}}, false, true},
+		{"duplicate rune", types.ConsolidationPolicy{RuneThresholds: []types.RuneConsolidationThreshold{{RuneId: "840000:3", TargetThreshold: "1"}, {RuneId: "840000:3", TargetThreshold: "2"}}}, false, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.policy.Validate()
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.enabled, tc.policy.IsEnabled())
+		})
+	}
+}
+
+func TestGetConsolidationMaxNum(t *testing.T) {
+	require.Equal(t, uint32(200), types.GetConsolidationMaxNum(0, 200), "0 should be resolved to the max utxo num")
+	require.Equal(t, uint32(50), types.GetConsolidationMaxNum(50, 200))
+	require.Equal(t, uint32(200), types.GetConsolidationMaxNum(300, 200), "max num should be bounded by the max utxo num")
+	require.Equal(t, types.MaxConsolidationUtxoNum, types.GetConsolidationMaxNum(0, 0), "max num should be bounded by the hard limit")
+	require.Equal(t, types.MaxConsolidationUtxoNum, types.GetConsolidationMaxNum(1000, 2000), "max num should be bounded by the hard limit")
+}
@@ -39,13 +39,30 @@ var (
 	ErrSigningRequestDoesNotExist   = errorsmod.Register(ModuleName, 3110, "signing request does not exist")
 	ErrSigningRequestConfirmed      = errorsmod.Register(ModuleName, 3111, "signing request has been confirmed")
 	ErrWithdrawNotEnabled           = errorsmod.Register(ModuleName, 3112, "withdrawal not enabled")
+	ErrWithdrawRateLimitExceeded    = errorsmod.Register(ModuleName, 3113, "withdrawal rate limit exceeded")
+	ErrCircuitBreakerTripped        = errorsmod.Register(ModuleName, 3114, "withdrawal circuit breaker tripped")
+	ErrCircuitBreakerNotTripped     = errorsmod.Register(ModuleName, 3115, "withdrawal circuit breaker not tripped")
+	ErrMaxNetworkFeeExceeded        = errorsmod.Register(ModuleName, 3116, "maximum network fee exceeded")
 
 	ErrUTXODoesNotExist = errorsmod.Register(ModuleName, 4100, "utxo does not exist")
 	ErrUTXOLocked       = errorsmod.Register(ModuleName, 4101, "utxo locked")
 	ErrUTXOUnlocked     = errorsmod.Register(ModuleName, 4102, "utxo unlocked")
 
-	ErrInvalidRunes  = errorsmod.Register(ModuleName, 5100, "invalid runes")
-	ErrInvalidRuneId = errorsmod.Register(ModuleName, 5101, "invalid rune id")
+	ErrInvalidRunes          = errorsmod.Register(ModuleName, 5100, "invalid runes")
+	ErrInvalidRuneId         = errorsmod.Register(ModuleName, 5101, "invalid rune id")
+	ErrCenotaph              = errorsmod.Register(ModuleName, 5102, "cenotaph")
+	ErrInvalidRuneMetadata   = errorsmod.Register(ModuleName, 5103, "invalid rune metadata")
+	ErrRuneAlreadyRegistered = errorsmod.Register(ModuleName, 5104, "rune already registered")
+	ErrRuneNotEnabled        = errorsmod.Register(ModuleName, 5105, "rune not enabled")
+	ErrRuneSupplyCapExceeded = errorsmod.Register(ModuleName, 5106, "rune supply cap exceeded")
+
+	ErrInvalidBrc20               = errorsmod.Register(ModuleName, 5200, "invalid brc20")
+	ErrInvalidBrc20Tick           = errorsmod.Register(ModuleName, 5201, "invalid brc20 ticker")
+	ErrInvalidInscription         = errorsmod.Register(ModuleName, 5202, "invalid inscription")
+	ErrInvalidBrc20Metadata       = errorsmod.Register(ModuleName, 5203, "invalid brc20 metadata")
+	ErrBrc20TickAlreadyRegistered = errorsmod.Register(ModuleName, 5204, "brc20 ticker already registered")
+	ErrBrc20TickNotRegistered     = errorsmod.Register(ModuleName, 5205, "brc20 ticker not registered")
+	ErrInvalidBrc20Amount         = errorsmod.Register(ModuleName, 5206, "invalid brc20 amount")
 
 	ErrInvalidParams       = errorsmod.Register(ModuleName, 6100, "invalid module params")
 	ErrInvalidRelayers     = errorsmod.Register(ModuleName, 6101, "invalid relayers")
@@ -60,6 +77,21 @@ var (
 	ErrInvalidVault                     = errorsmod.Register(ModuleName, 7106, "invalid vault")
 	ErrVaultDoesNotExist                = errorsmod.Register(ModuleName, 7107, "vault does not exist")
 	ErrInvalidPsbt                      = errorsmod.Register(ModuleName, 7108, "invalid psbt")
+	ErrInvalidVaultRecovery             = errorsmod.Register(ModuleName, 7109, "invalid vault recovery")
+	ErrInvalidRecoveryScript            = errorsmod.Register(ModuleName, 7110, "invalid recovery script")
+	ErrDKGRetryNotAllowed               = errorsmod.Register(ModuleName, 7111, "dkg retry not allowed")
+	ErrVaultRetired                     = errorsmod.Register(ModuleName, 7112, "vault retired")
+	ErrInvalidVaultKey                  = errorsmod.Register(ModuleName, 7113, "invalid vault key")
+	ErrInvalidVerificationShares        = errorsmod.Register(ModuleName, 7114, "invalid verification shares")
+	ErrInvalidFrostSigning              = errorsmod.Register(ModuleName, 7115, "invalid frost signing")
+	ErrFrostSigningSessionDoesNotExist  = errorsmod.Register(ModuleName, 7116, "frost signing session does not exist")
+	ErrInvalidVaultSweep                = errorsmod.Register(ModuleName, 7117, "invalid vault sweep")
 
 	ErrInvalidConsolidation = errorsmod.Register(ModuleName, 8100, "invalid consolidation")
+
+	ErrUnauthorizedGuardian       = errorsmod.Register(ModuleName, 9100, "unauthorized guardian")
+	ErrInvalidEmergencyPause      = errorsmod.Register(ModuleName, 9101, "invalid emergency pause")
+	ErrEmergencyPauseDoesNotExist = errorsmod.Register(ModuleName, 9102, "emergency pause does not exist")
+	ErrEmergencyPauseNotActive    = errorsmod.Register(ModuleName, 9103, "emergency pause not active")
+	ErrPaused                     = errorsmod.Register(ModuleName, 9104, "paused in emergency")
 )
@@ -0,0 +1,195 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// maximum fee rate in basis points, i.e. 100%
+	MaxFeeBps = 10000
+)
+
+// Validate validates the fee schedule
+func (s *FeeSchedule) Validate() error {
+	if s.Bps > MaxFeeBps {
+		return errorsmod.Wrapf(ErrInvalidParams, "fee rate can not be greater than %d bps", MaxFeeBps)
+	}
+
+	if err := validateFeeAmount(s.MinFee); err != nil {
+		return err
+	}
+
+	if err := validateFeeAmount(s.MaxFee); err != nil {
+		return err
+	}
+
+	maxFee := s.MaxFeeAmount()
+	if !maxFee.IsZero() && s.MinFeeAmount().GT(maxFee) {
+		return errorsmod.Wrap(ErrInvalidParams, "minimum fee must not be greater than maximum fee")
+	}
+
+	for i, tier := range s.Tiers {
+		if tier.Bps > MaxFeeBps {
+			return errorsmod.Wrapf(ErrInvalidParams, "fee rate can not be greater than %d bps", MaxFeeBps)
+		}
+
+		if err := validateFeeAmount(tier.MinAmount); err != nil {
+			return err
+		}
+
+		if i > 0 && !parseFeeAmount(tier.MinAmount).GT(parseFeeAmount(s.Tiers[i-1].MinAmount)) {
+			return errorsmod.Wrap(ErrInvalidParams, "fee tiers must be in strictly ascending order of the minimum amount")
+		}
+	}
+
+	return nil
+}
+
+// IsEnabled returns true if the fee schedule may charge any fee, false otherwise
+func (s *FeeSchedule) IsEnabled() bool {
+	if s.Bps > 0 || s.MinFeeAmount().IsPositive() {
+		return true
+	}
+
+	for _, tier := range s.Tiers {
+		if tier.Bps > 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// MinFeeAmount returns the minimum fee amount
+func (s *FeeSchedule) MinFeeAmount() sdkmath.Int {
+	return parseFeeAmount(s.MinFee)
+}
+
+// MaxFeeAmount returns the maximum fee amount; zero means no cap
+func (s *FeeSchedule) MaxFeeAmount() sdkmath.Int {
+	return parseFeeAmount(s.MaxFee)
+}
+
+// GetBpsByAmount returns the fee rate in basis points applied to the given amount
+func (s *FeeSchedule) GetBpsByAmount(amount sdkmath.Int) uint32 {
+	bps := s.Bps
+
+	for _, tier := range s.Tiers {
+		if amount.LT(parseFeeAmount(tier.MinAmount)) {
+			break
+		}
+
+		bps = tier.Bps
+	}
+
+	return bps
+}
+
+// Compute computes the fee for the given amount
+// The fee is rounded down and bounded by the floor and cap
+func (s *FeeSchedule) Compute(amount sdkmath.Int) sdkmath.Int {
+	fee := amount.MulRaw(int64(s.GetBpsByAmount(amount))).QuoRaw(MaxFeeBps)
+
+	fee = sdkmath.MaxInt(fee, s.MinFeeAmount())
+
+	maxFee := s.MaxFeeAmount()
+	if !maxFee.IsZero() {
+		fee = sdkmath.MinInt(fee, maxFee)
+	}
+
+	return fee
+}
+
+// WithoutBounds returns the fee schedule without the floor and cap
+func (s *FeeSchedule) WithoutBounds() *FeeSchedule {
+	return &FeeSchedule{
+		Bps:   s.Bps,
+		Tiers: s.Tiers,
+	}
+}
+
+// Validate validates the fee distribution
+func (d *FeeDistribution) Validate() error {
+	if d.Epoch <= 0 {
+		return errorsmod.Wrap(ErrInvalidParams, "fee distribution epoch must be greater than 0")
+	}
+
+	return nil
+}
+
+// TotalWeight returns the total weight of the buckets
+func (d *FeeDistribution) TotalWeight() uint64 {
+	return uint64(d.CommunityPoolWeight) + uint64(d.RelayerPoolWeight) + uint64(d.SignerPoolWeight) + uint64(d.InsuranceWeight)
+}
+
+// Split splits the given fees into the buckets by weight
+// The remainder due to rounding is left for the next settlement
+// All fees go to the collector if the weights are all zero
+func (d *FeeDistribution) Split(fees sdk.Coins) map[FeeBucket]sdk.Coins {
+	shares := make(map[FeeBucket]sdk.Coins)
+
+	totalWeight := d.TotalWeight()
+	if totalWeight == 0 {
+		shares[FeeBucket_FEE_BUCKET_COLLECTOR] = fees
+
+		return shares
+	}
+
+	weights := map[FeeBucket]uint32{
+		FeeBucket_FEE_BUCKET_COMMUNITY_POOL: d.CommunityPoolWeight,
+		FeeBucket_FEE_BUCKET_RELAYER_POOL:   d.RelayerPoolWeight,
+		FeeBucket_FEE_BUCKET_SIGNER_POOL:    d.SignerPoolWeight,
+		FeeBucket_FEE_BUCKET_INSURANCE:      d.InsuranceWeight,
+	}
+
+	for bucket, weight := range weights {
+		share := sdk.NewCoins()
+
+		for _, fee := range fees {
+			share = share.Add(sdk.NewCoin(fee.Denom, fee.Amount.MulRaw(int64(weight)).Quo(sdkmath.NewIntFromUint64(totalWeight))))
+		}
+
+		shares[bucket] = share
+	}
+
+	return shares
+}
+
+// FeeBuckets returns all the fee buckets in order
+func FeeBuckets() []FeeBucket {
+	return []FeeBucket{
+		FeeBucket_FEE_BUCKET_COMMUNITY_POOL,
+		FeeBucket_FEE_BUCKET_RELAYER_POOL,
+		FeeBucket_FEE_BUCKET_SIGNER_POOL,
+		FeeBucket_FEE_BUCKET_INSURANCE,
+		FeeBucket_FEE_BUCKET_COLLECTOR,
+	}
+}
+
+// validateFeeAmount validates the given fee amount
+func validateFeeAmount(amount string) error {
+	if len(amount) == 0 {
+		return nil
+	}
+
+	a, ok := sdkmath.NewIntFromString(amount)
+	if !ok || a.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidParams, "invalid fee amount %s", amount)
+	}
+
+	return nil
+}
+
+// parseFeeAmount parses the given fee amount
+// Assume that the amount is valid
+func parseFeeAmount(amount string) sdkmath.Int {
+	if len(amount) == 0 {
+		return sdkmath.ZeroInt()
+	}
+
+	a, _ := sdkmath.NewIntFromString(amount)
+
+	return a
+}
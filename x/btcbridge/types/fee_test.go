@@ -0,0 +1,105 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sideprotocol/side/x/btcbridge/types"
+)
+
+func TestFeeScheduleCompute(t *testing.T) {
+	schedule := types.FeeSchedule{
+		Bps:    30,
+		MinFee: "1000",
+		MaxFee: "1000000",
+		Tiers: []types.FeeTier{
+			{MinAmount: "10000000", Bps: 20},
+			{MinAmount: "100000000", Bps: 10},
+		},
+	}
+	require.NoError(t, schedule.Validate())
+
+	testCases := []struct {
+		name        string
+		amount      int64
+		expectedFee int64
+	}{
+		{"floor", 100000, 1000},
+		{"base rate", 1000000, 3000},
+		{"first tier", 10000000, 20000},
+		{"second tier", 300000000, 300000},
+		{"cap", 2000000000, 1000000},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expectedFee, schedule.Compute(sdkmath.NewInt(tc.amount)).Int64())
+		})
+	}
+
+	require.Equal(t, int64(300), schedule.WithoutBounds().Compute(sdkmath.NewInt(100000)).Int64(), "the floor should not apply")
+}
+
+func TestFeeScheduleValidate(t *testing.T) {
+	testCases := []struct {
+		name       string
+		schedule   types.FeeSchedule
+		expectPass bool
+	}{
+		{
+			name:       "empty schedule",
+			schedule:   types.FeeSchedule{},
+			expectPass: true,
+		},
+		{
+			name:       "rate too high",
+			schedule:   types.FeeSchedule{Bps: types.MaxFeeBps + 1},
+			expectPass: false,
+		},
+		{
+			name:       "floor greater than cap",
+			schedule:   types.FeeSchedule{MinFee: "2000", MaxFee: "1000"},
+			expectPass: false,
+		},
+		{
+			name:       "tiers not in ascending order",
+			schedule:   types.FeeSchedule{Tiers: []types.FeeTier{{MinAmount: "2000", Bps: 10}, {MinAmount: "1000", Bps: 5}}},
+			expectPass: false,
+		},
+		{
+			name:       "invalid tier amount",
+			schedule:   types.FeeSchedule{Tiers: []types.FeeTier{{MinAmount: "abc", Bps: 10}}},
+			expectPass: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.schedule.Validate()
+			if tc.expectPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestFeeDistributionSplit(t *testing.T) {
+	fees := sdk.NewCoins(sdk.NewInt64Coin("sat", 1000), sdk.NewInt64Coin("runes/840000:3", 10))
+
+	distribution := types.FeeDistribution{Epoch: 1, CommunityPoolWeight: 20, RelayerPoolWeight: 20, SignerPoolWeight: 50, InsuranceWeight: 10}
+	shares := distribution.Split(fees)
+
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("sat", 200), sdk.NewInt64Coin("runes/840000:3", 2)), shares[types.FeeBucket_FEE_BUCKET_COMMUNITY_POOL])
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("sat", 500), sdk.NewInt64Coin("runes/840000:3", 5)), shares[types.FeeBucket_FEE_BUCKET_SIGNER_POOL])
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("sat", 100), sdk.NewInt64Coin("runes/840000:3", 1)), shares[types.FeeBucket_FEE_BUCKET_INSURANCE])
+	require.True(t, shares[types.FeeBucket_FEE_BUCKET_COLLECTOR].IsZero())
+
+	distribution = types.FeeDistribution{Epoch: 1}
+	require.Equal(t, fees, distribution.Split(fees)[types.FeeBucket_FEE_BUCKET_COLLECTOR], "all fees should go to the collector")
+}
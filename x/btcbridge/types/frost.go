@@ -0,0 +1,408 @@
+package types
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"sort"
+
+	secp256k1 "github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+// The FROST signing is compatible with BIP340 and the taproot key path spending.
+//
+// For the signer i in the signing set S with the nonce commitments (D_i, E_i):
+//   - binding factor: rho_i = H_binding(Q.x || msg || (j || D_j || E_j) for j in S sorted by index || i)
+//   - group commitment: R = sum(D_i + rho_i * E_i)
+//   - challenge: c = H_BIP0340/challenge(R.x || Q.x || msg)
+//   - partial signature: z_i = g_R * (d_i + rho_i * e_i) + c * lambda_i * g_Q * g_Y * s_i
+//
+// where Y is the aggregate public key, Q is the taproot output key tweaked by t, lambda_i is the lagrange coefficient
+// and g_R, g_Q, g_Y are -1 if the y coordinate of R, Q, Y is odd respectively, otherwise 1.
+// The final signature is (R.x, sum(z_i) + c * g_Q * t).
+
+var (
+	// tag of the FROST binding factor hash
+	FrostBindingFactorTag = []byte("SIDE/frost/binding")
+)
+
+// FrostSignerCommitment defines the nonce commitment of the FROST signer for an input
+type FrostSignerCommitment struct {
+	Index   uint32
+	Hiding  *secp256k1.PublicKey
+	Binding *secp256k1.PublicKey
+}
+
+// FrostSigningInput defines the FROST signing context of the signing request input
+type FrostSigningInput struct {
+	Key       *FrostVaultKey
+	GroupKey  *secp256k1.PublicKey
+	OutputKey *secp256k1.PublicKey
+	Tweak     secp256k1.ModNScalar
+	SigHash   []byte
+}
+
+// NewFrostSigningInput creates the FROST signing context of the input spent by the given vault key
+func NewFrostSigningInput(key *FrostVaultKey, taprootTree *VaultTaprootTree, sigHash []byte) (*FrostSigningInput, error) {
+	groupKey, err := ParseFrostPoint(key.PubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	var rootHash []byte
+	if taprootTree != nil {
+		tree, _, err := taprootTree.assemble()
+		if err != nil {
+			return nil, err
+		}
+
+		hash := tree.RootNode.TapHash()
+		rootHash = hash[:]
+	}
+
+	tweakHash := chainhash.TaggedHash(chainhash.TagTapTweak, schnorr.SerializePubKey(groupKey), rootHash)
+
+	input := &FrostSigningInput{
+		Key:       key,
+		GroupKey:  groupKey,
+		OutputKey: txscript.ComputeTaprootOutputKey(groupKey, rootHash),
+		SigHash:   sigHash,
+	}
+
+	if overflow := input.Tweak.SetBytes((*[32]byte)(tweakHash)); overflow != 0 {
+		return nil, errorsmod.Wrap(ErrInvalidFrostSigning, "invalid taproot tweak")
+	}
+
+	return input, nil
+}
+
+// Signer returns the signer of the given operator address
+func (input *FrostSigningInput) Signer(operatorAddress string) *FrostSigner {
+	for i := range input.Key.Signers {
+		if input.Key.Signers[i].OperatorAddress == operatorAddress {
+			return &input.Key.Signers[i]
+		}
+	}
+
+	return nil
+}
+
+// BindingFactor computes the binding factor of the given signer
+func (input *FrostSigningInput) BindingFactor(commitments []*FrostSignerCommitment, index uint32) *secp256k1.ModNScalar {
+	sorted := make([]*FrostSignerCommitment, len(commitments))
+	copy(sorted, commitments)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Index < sorted[j].Index })
+
+	var buf bytes.Buffer
+
+	buf.Write(schnorr.SerializePubKey(input.OutputKey))
+	buf.Write(input.SigHash)
+
+	for _, c := range sorted {
+		buf.Write(binary.BigEndian.AppendUint32(nil, c.Index))
+		buf.Write(c.Hiding.SerializeCompressed())
+		buf.Write(c.Binding.SerializeCompressed())
+	}
+
+	buf.Write(binary.BigEndian.AppendUint32(nil, index))
+
+	hash := chainhash.TaggedHash(FrostBindingFactorTag, buf.Bytes())
+
+	var rho secp256k1.ModNScalar
+	rho.SetBytes((*[32]byte)(hash))
+
+	return &rho
+}
+
+// SignerCommitment computes the commitment share D_i + rho_i * E_i of the given signer
+func (input *FrostSigningInput) SignerCommitment(commitments []*FrostSignerCommitment, c *FrostSignerCommitment) secp256k1.JacobianPoint {
+	var hiding, binding, boundBinding, result secp256k1.JacobianPoint
+
+	c.Hiding.AsJacobian(&hiding)
+	c.Binding.AsJacobian(&binding)
+
+	secp256k1.ScalarMultNonConst(input.BindingFactor(commitments, c.Index), &binding, &boundBinding)
+	secp256k1.AddNonConst(&hiding, &boundBinding, &result)
+
+	return result
+}
+
+// GroupCommitment computes the group commitment R
+func (input *FrostSigningInput) GroupCommitment(commitments []*FrostSignerCommitment) (secp256k1.JacobianPoint, error) {
+	var result secp256k1.JacobianPoint
+
+	for _, c := range commitments {
+		commitment := input.SignerCommitment(commitments, c)
+
+		sum := result
+		secp256k1.AddNonConst(&sum, &commitment, &result)
+	}
+
+	if isInfinity(&result) {
+		return result, errorsmod.Wrap(ErrInvalidFrostSigning, "invalid group commitment")
+	}
+
+	result.ToAffine()
+
+	return result, nil
+}
+
+// Challenge computes the BIP340 challenge of the given group commitment
+func (input *FrostSigningInput) Challenge(groupCommitment *secp256k1.JacobianPoint) *secp256k1.ModNScalar {
+	r := secp256k1.NewPublicKey(&groupCommitment.X, &groupCommitment.Y)
+
+	hash := chainhash.TaggedHash(chainhash.TagBIP0340Challenge, schnorr.SerializePubKey(r), schnorr.SerializePubKey(input.OutputKey), input.SigHash)
+
+	var c secp256k1.ModNScalar
+	c.SetBytes((*[32]byte)(hash))
+
+	return &c
+}
+
+// VerifyPartialSignature verifies the partial signature of the given signer
+func (input *FrostSigningInput) VerifyPartialSignature(commitments []*FrostSignerCommitment, index uint32, partialSig *secp256k1.ModNScalar) error {
+	signer := input.signerByIndex(index)
+	if signer == nil {
+		return errorsmod.Wrap(ErrInvalidFrostSigning, "unknown signer")
+	}
+
+	share, err := ParseFrostPoint(signer.VerificationShare)
+	if err != nil {
+		return err
+	}
+
+	var signerCommitment *FrostSignerCommitment
+	indexes := make([]uint32, 0, len(commitments))
+
+	for _, c := range commitments {
+		if c.Index == index {
+			signerCommitment = c
+		}
+
+		indexes = append(indexes, c.Index)
+	}
+
+	if signerCommitment == nil {
+		return errorsmod.Wrap(ErrInvalidFrostSigning, "signer commitment not found")
+	}
+
+	groupCommitment, err := input.GroupCommitment(commitments)
+	if err != nil {
+		return err
+	}
+
+	// g_R * R_i
+	commitment := input.SignerCommitment(commitments, signerCommitment)
+	if isOddY(&groupCommitment) {
+		negatePoint(&commitment)
+	}
+
+	// c * lambda_i * g_Q * g_Y
+	e := new(secp256k1.ModNScalar).Set(input.Challenge(&groupCommitment))
+	e.Mul(LagrangeCoefficient(index, indexes, 0))
+
+	if input.outputKeyOdd() != input.groupKeyOdd() {
+		e.Negate()
+	}
+
+	var sharePoint, scaledShare, expected, actual secp256k1.JacobianPoint
+
+	share.AsJacobian(&sharePoint)
+	secp256k1.ScalarMultNonConst(e, &sharePoint, &scaledShare)
+	secp256k1.AddNonConst(&commitment, &scaledShare, &expected)
+
+	secp256k1.ScalarBaseMultNonConst(partialSig, &actual)
+
+	expected.ToAffine()
+	actual.ToAffine()
+
+	if !expected.X.Equals(&actual.X) || !expected.Y.Equals(&actual.Y) {
+		return errorsmod.Wrap(ErrInvalidFrostSigning, "invalid partial signature")
+	}
+
+	return nil
+}
+
+// AggregateSignatures aggregates the given partial signatures into the final schnorr signature
+func (input *FrostSigningInput) AggregateSignatures(commitments []*FrostSignerCommitment, partialSigs []*secp256k1.ModNScalar) (*schnorr.Signature, error) {
+	groupCommitment, err := input.GroupCommitment(commitments)
+	if err != nil {
+		return nil, err
+	}
+
+	// c * g_Q * t
+	z := new(secp256k1.ModNScalar).Set(input.Challenge(&groupCommitment))
+	z.Mul(&input.Tweak)
+
+	if input.outputKeyOdd() {
+		z.Negate()
+	}
+
+	for _, partialSig := range partialSigs {
+		z.Add(partialSig)
+	}
+
+	sig := schnorr.NewSignature(&groupCommitment.X, z)
+	if !sig.Verify(input.SigHash, input.OutputKey) {
+		return nil, errorsmod.Wrap(ErrInvalidFrostSigning, "invalid aggregate signature")
+	}
+
+	return sig, nil
+}
+
+// signerByIndex returns the signer of the given index
+func (input *FrostSigningInput) signerByIndex(index uint32) *FrostSigner {
+	for i := range input.Key.Signers {
+		if input.Key.Signers[i].Index == index {
+			return &input.Key.Signers[i]
+		}
+	}
+
+	return nil
+}
+
+// groupKeyOdd returns true if the y coordinate of the aggregate public key is odd, false otherwise
+func (input *FrostSigningInput) groupKeyOdd() bool {
+	return input.GroupKey.Y().Bit(0) == 1
+}
+
+// outputKeyOdd returns true if the y coordinate of the output key is odd, false otherwise
+func (input *FrostSigningInput) outputKeyOdd() bool {
+	return input.OutputKey.Y().Bit(0) == 1
+}
+
+// VerifyVerificationShares verifies that the given verification shares lie on the polynomial of the given threshold
+// whose constant term is the given aggregate public key
+// The aggregate public key with the actual y coordinate is returned
+func VerifyVerificationShares(pubKey *secp256k1.PublicKey, shares []string, threshold uint32) (*secp256k1.PublicKey, error) {
+	if threshold == 0 || len(shares) < int(threshold) {
+		return nil, errorsmod.Wrap(ErrInvalidVerificationShares, "insufficient verification shares")
+	}
+
+	points := make([]*secp256k1.PublicKey, len(shares))
+	for i, share := range shares {
+		point, err := ParseFrostPoint(share)
+		if err != nil {
+			return nil, errorsmod.Wrap(ErrInvalidVerificationShares, "invalid verification share")
+		}
+
+		points[i] = point
+	}
+
+	indexes := make([]uint32, threshold)
+	for i := range indexes {
+		indexes[i] = uint32(i + 1)
+	}
+
+	groupKey, err := interpolatePoint(indexes, points[:threshold], 0)
+	if err != nil {
+		return nil, err
+	}
+
+	if !bytes.Equal(schnorr.SerializePubKey(groupKey), schnorr.SerializePubKey(pubKey)) {
+		return nil, errorsmod.Wrap(ErrInvalidVerificationShares, "aggregate public key mismatch")
+	}
+
+	for i := int(threshold); i < len(points); i++ {
+		share, err := interpolatePoint(indexes, points[:threshold], uint32(i+1))
+		if err != nil {
+			return nil, err
+		}
+
+		if !share.IsEqual(points[i]) {
+			return nil, errorsmod.Wrap(ErrInvalidVerificationShares, "inconsistent verification shares")
+		}
+	}
+
+	return groupKey, nil
+}
+
+// LagrangeCoefficient computes the lagrange coefficient of the given index at x over the given indexes
+func LagrangeCoefficient(index uint32, indexes []uint32, x uint32) *secp256k1.ModNScalar {
+	num := new(secp256k1.ModNScalar).SetInt(1)
+	den := new(secp256k1.ModNScalar).SetInt(1)
+
+	for _, j := range indexes {
+		if j == index {
+			continue
+		}
+
+		// (x - j) / (index - j)
+		num.Mul(new(secp256k1.ModNScalar).SetInt(j).Negate().Add(new(secp256k1.ModNScalar).SetInt(x)))
+		den.Mul(new(secp256k1.ModNScalar).SetInt(j).Negate().Add(new(secp256k1.ModNScalar).SetInt(index)))
+	}
+
+	return num.Mul(den.InverseNonConst())
+}
+
+// ParseFrostPoint parses the given hex encoded compressed point
+func ParseFrostPoint(point string) (*secp256k1.PublicKey, error) {
+	pointBytes, err := hex.DecodeString(point)
+	if err != nil || len(pointBytes) != secp256k1.PubKeyBytesLenCompressed {
+		return nil, errorsmod.Wrap(ErrInvalidFrostSigning, "invalid point")
+	}
+
+	return secp256k1.ParsePubKey(pointBytes)
+}
+
+// ParseFrostScalar parses the given hex encoded 32-byte scalar
+func ParseFrostScalar(scalar string) (*secp256k1.ModNScalar, error) {
+	scalarBytes, err := hex.DecodeString(scalar)
+	if err != nil || len(scalarBytes) != 32 {
+		return nil, errorsmod.Wrap(ErrInvalidFrostSigning, "invalid scalar")
+	}
+
+	var s secp256k1.ModNScalar
+	if overflow := s.SetByteSlice(scalarBytes); overflow {
+		return nil, errorsmod.Wrap(ErrInvalidFrostSigning, "invalid scalar")
+	}
+
+	return &s, nil
+}
+
+// interpolatePoint interpolates the given points at x in the exponent
+func interpolatePoint(indexes []uint32, points []*secp256k1.PublicKey, x uint32) (*secp256k1.PublicKey, error) {
+	var result secp256k1.JacobianPoint
+
+	for i, index := range indexes {
+		var point, scaledPoint secp256k1.JacobianPoint
+
+		points[i].AsJacobian(&point)
+		secp256k1.ScalarMultNonConst(LagrangeCoefficient(index, indexes, x), &point, &scaledPoint)
+
+		sum := result
+		secp256k1.AddNonConst(&sum, &scaledPoint, &result)
+	}
+
+	if isInfinity(&result) {
+		return nil, errorsmod.Wrap(ErrInvalidVerificationShares, "point at infinity")
+	}
+
+	result.ToAffine()
+
+	return secp256k1.NewPublicKey(&result.X, &result.Y), nil
+}
+
+// isInfinity returns true if the given point is the point at infinity, false otherwise
+func isInfinity(p *secp256k1.JacobianPoint) bool {
+	return (p.X.IsZero() && p.Y.IsZero()) || p.Z.IsZero()
+}
+
+// isOddY returns true if the y coordinate of the given affine point is odd, false otherwise
+func isOddY(p *secp256k1.JacobianPoint) bool {
+	y := p.Y
+	y.Normalize()
+
+	return y.IsOdd()
+}
+
+// negatePoint negates the given point
+func negatePoint(p *secp256k1.JacobianPoint) {
+	p.ToAffine()
+	p.Y.Negate(1).Normalize()
+}
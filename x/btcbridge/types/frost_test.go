@@ -0,0 +1,115 @@
+package types_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	secp256k1 "github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+
+	"github.com/sideprotocol/side/testutil/frost"
+	"github.com/sideprotocol/side/x/btcbridge/types"
+)
+
+func TestVerifyVerificationShares(t *testing.T) {
+	key, err := frost.GenerateKey(5, 3)
+	require.NoError(t, err)
+
+	groupKey, err := types.VerifyVerificationShares(key.GroupKey, key.VerificationShares, 3)
+	require.NoError(t, err)
+	require.True(t, groupKey.IsEqual(key.GroupKey))
+
+	// x-only aggregate public key
+	xOnlyKey, err := schnorr.ParsePubKey(schnorr.SerializePubKey(key.GroupKey))
+	require.NoError(t, err)
+
+	groupKey, err = types.VerifyVerificationShares(xOnlyKey, key.VerificationShares, 3)
+	require.NoError(t, err)
+	require.True(t, groupKey.IsEqual(key.GroupKey), "actual aggregate public key should be recovered")
+
+	anotherKey, err := frost.GenerateKey(5, 3)
+	require.NoError(t, err)
+
+	_, err = types.VerifyVerificationShares(anotherKey.GroupKey, key.VerificationShares, 3)
+	require.Error(t, err, "aggregate public key mismatch")
+
+	inconsistentShares := append([]string{}, key.VerificationShares...)
+	inconsistentShares[4] = anotherKey.VerificationShares[4]
+
+	_, err = types.VerifyVerificationShares(key.GroupKey, inconsistentShares, 3)
+	require.Error(t, err, "inconsistent verification shares")
+
+	_, err = types.VerifyVerificationShares(key.GroupKey, key.VerificationShares, 6)
+	require.Error(t, err, "insufficient verification shares")
+}
+
+func TestFrostSigning(t *testing.T) {
+	_, recoveryPubKeys := generateRecoveryKeys(t, 1)
+	tree, err := types.VaultRecoveryPolicy{PubKeys: recoveryPubKeys, Threshold: 1, Timelock: 144}.TaprootTree()
+	require.NoError(t, err)
+
+	for _, taprootTree := range []*types.VaultTaprootTree{nil, tree} {
+		// repeat to cover the different parities
+		for i := 0; i < 8; i++ {
+			key, err := frost.GenerateKey(4, 3)
+			require.NoError(t, err)
+
+			vaultKey := &types.FrostVaultKey{
+				PubKey:    hex.EncodeToString(key.GroupKey.SerializeCompressed()),
+				Threshold: 3,
+			}
+
+			for j, share := range key.VerificationShares {
+				vaultKey.Signers = append(vaultKey.Signers, types.FrostSigner{Index: uint32(j + 1), VerificationShare: share})
+			}
+
+			sigHash := chainhash.HashB([]byte{byte(i)})
+
+			input, err := types.NewFrostSigningInput(vaultKey, taprootTree, sigHash)
+			require.NoError(t, err)
+
+			signers := []uint32{1, 3, 4}
+			nonces := make([]*frost.Nonce, len(signers))
+			commitments := make([]*types.FrostSignerCommitment, len(signers))
+
+			for j, index := range signers {
+				nonce, commitment, err := frost.GenerateNonce()
+				require.NoError(t, err)
+
+				hiding, err := types.ParseFrostPoint(commitment.Hiding)
+				require.NoError(t, err)
+
+				binding, err := types.ParseFrostPoint(commitment.Binding)
+				require.NoError(t, err)
+
+				nonces[j] = nonce
+				commitments[j] = &types.FrostSignerCommitment{Index: index, Hiding: hiding, Binding: binding}
+			}
+
+			partialSigs := make([]*secp256k1.ModNScalar, len(signers))
+
+			for j, index := range signers {
+				partialSig, err := key.PartialSign(input, commitments, index, nonces[j])
+				require.NoError(t, err)
+				require.NoError(t, input.VerifyPartialSignature(commitments, index, partialSig))
+
+				partialSigs[j] = partialSig
+			}
+
+			// invalid partial signature
+			invalidSig := new(secp256k1.ModNScalar).Set(partialSigs[0]).Add(new(secp256k1.ModNScalar).SetInt(1))
+			require.Error(t, input.VerifyPartialSignature(commitments, signers[0], invalidSig))
+			require.Error(t, input.VerifyPartialSignature(commitments, signers[1], partialSigs[0]), "partial signature of another signer")
+
+			sig, err := input.AggregateSignatures(commitments, partialSigs)
+			require.NoError(t, err)
+			require.True(t, sig.Verify(sigHash, input.OutputKey))
+
+			_, err = input.AggregateSignatures(commitments, []*secp256k1.ModNScalar{invalidSig, partialSigs[1], partialSigs[2]})
+			require.Error(t, err)
+		}
+	}
+}
@@ -74,6 +74,8 @@ func DefaultGenesis() *GenesisState {
 		BlockHeaders:    []*BlockHeader{},
 		Utxos:           []*UTXO{},
 		DkgRequest:      nil,
+		Runes:           []*RuneMetadata{},
+		Brc20Tickers:    []*Brc20Metadata{},
 	}
 }
 
@@ -97,6 +99,20 @@ func (gs GenesisState) Validate() error {
 		}
 	}
 
+	// validate rune metadata
+	for _, metadata := range gs.Runes {
+		if err := metadata.Validate(); err != nil {
+			return err
+		}
+	}
+
+	// validate brc20 metadata
+	for _, metadata := range gs.Brc20Tickers {
+		if err := metadata.Validate(); err != nil {
+			return err
+		}
+	}
+
 	// validate params
 	return gs.Params.Validate()
 }
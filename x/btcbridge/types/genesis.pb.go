@@ -27,10 +27,15 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type GenesisState struct {
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	// the chain tip of the bitcoin chain
-	BestBlockHeader *BlockHeader   `protobuf:"bytes,2,opt,name=best_block_header,json=bestBlockHeader,proto3" json:"best_block_header,omitempty"`
-	BlockHeaders    []*BlockHeader `protobuf:"bytes,3,rep,name=block_headers,json=blockHeaders,proto3" json:"block_headers,omitempty"`
-	Utxos           []*UTXO        `protobuf:"bytes,4,rep,name=utxos,proto3" json:"utxos,omitempty"`
-	DkgRequest      *DKGRequest    `protobuf:"bytes,5,opt,name=dkg_request,json=dkgRequest,proto3" json:"dkg_request,omitempty"`
+	BestBlockHeader         *BlockHeader             `protobuf:"bytes,2,opt,name=best_block_header,json=bestBlockHeader,proto3" json:"best_block_header,omitempty"`
+	BlockHeaders            []*BlockHeader           `protobuf:"bytes,3,rep,name=block_headers,json=blockHeaders,proto3" json:"block_headers,omitempty"`
+	Utxos                   []*UTXO                  `protobuf:"bytes,4,rep,name=utxos,proto3" json:"utxos,omitempty"`
+	DkgRequest              *DKGRequest              `protobuf:"bytes,5,opt,name=dkg_request,json=dkgRequest,proto3" json:"dkg_request,omitempty"`
+	Runes                   []*RuneMetadata          `protobuf:"bytes,6,rep,name=runes,proto3" json:"runes,omitempty"`
+	FrostVaultKeys          []*FrostVaultKey         `protobuf:"bytes,7,rep,name=frost_vault_keys,json=frostVaultKeys,proto3" json:"frost_vault_keys,omitempty"`
+	VaultTransfers          []*VaultTransfer         `protobuf:"bytes,8,rep,name=vault_transfers,json=vaultTransfers,proto3" json:"vault_transfers,omitempty"`
+	SigningRequestHistories []*SigningRequestHistory `protobuf:"bytes,9,rep,name=signing_request_histories,json=signingRequestHistories,proto3" json:"signing_request_histories,omitempty"`
+	Brc20Tickers            []*Brc20Metadata         `protobuf:"bytes,10,rep,name=brc20_tickers,json=brc20Tickers,proto3" json:"brc20_tickers,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -101,6 +106,41 @@ func (m *GenesisState) GetDkgRequest() *DKGRequest {
 	return nil
 }
 
+func (m *GenesisState) GetRunes() []*RuneMetadata {
+	if m != nil {
+		return m.Runes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFrostVaultKeys() []*FrostVaultKey {
+	if m != nil {
+		return m.FrostVaultKeys
+	}
+	return nil
+}
+
+func (m *GenesisState) GetVaultTransfers() []*VaultTransfer {
+	if m != nil {
+		return m.VaultTransfers
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSigningRequestHistories() []*SigningRequestHistory {
+	if m != nil {
+		return m.SigningRequestHistories
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBrc20Tickers() []*Brc20Metadata {
+	if m != nil {
+		return m.Brc20Tickers
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "side.btcbridge.GenesisState")
 }
@@ -108,27 +148,37 @@ func init() {
 func init() { proto.RegisterFile("side/btcbridge/genesis.proto", fileDescriptor_37c22954cf4a954b) }
 
 var fileDescriptor_37c22954cf4a954b = []byte{
-	// 318 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x29, 0xce, 0x4c, 0x49,
-	0xd5, 0x4f, 0x2a, 0x49, 0x4e, 0x2a, 0xca, 0x4c, 0x49, 0x4f, 0xd5, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d,
-	0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x03, 0xc9, 0xea, 0xc1, 0x65, 0xa5,
-	0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x52, 0xfa, 0x20, 0x16, 0x44, 0x95, 0x94, 0x34, 0x9a, 0x19,
-	0x05, 0x89, 0x45, 0x89, 0xb9, 0x50, 0x23, 0xa4, 0xe4, 0xd0, 0x24, 0xe1, 0x2c, 0x88, 0xbc, 0xd2,
-	0x0e, 0x26, 0x2e, 0x1e, 0x77, 0x88, 0xa5, 0xc1, 0x25, 0x89, 0x25, 0xa9, 0x42, 0x26, 0x5c, 0x6c,
-	0x10, 0x03, 0x24, 0x18, 0x15, 0x18, 0x35, 0xb8, 0x8d, 0xc4, 0xf4, 0x50, 0x1d, 0xa1, 0x17, 0x00,
-	0x96, 0x75, 0x62, 0x39, 0x71, 0x4f, 0x9e, 0x21, 0x08, 0xaa, 0x56, 0xc8, 0x9d, 0x4b, 0x30, 0x29,
-	0xb5, 0xb8, 0x24, 0x3e, 0x29, 0x27, 0x3f, 0x39, 0x3b, 0x3e, 0x23, 0x35, 0x31, 0x25, 0xb5, 0x48,
-	0x82, 0x09, 0x6c, 0x80, 0x34, 0xba, 0x01, 0x4e, 0x20, 0x35, 0x1e, 0x60, 0x25, 0x41, 0xfc, 0x20,
-	0x5d, 0x48, 0x02, 0x42, 0x0e, 0x5c, 0xbc, 0xc8, 0x66, 0x14, 0x4b, 0x30, 0x2b, 0x30, 0x13, 0x32,
-	0x84, 0x27, 0x09, 0xc1, 0x29, 0x16, 0xd2, 0xe2, 0x62, 0x2d, 0x2d, 0xa9, 0xc8, 0x2f, 0x96, 0x60,
-	0x01, 0xeb, 0x14, 0x41, 0xd7, 0x19, 0x1a, 0x12, 0xe1, 0x1f, 0x04, 0x51, 0x22, 0x64, 0xcd, 0xc5,
-	0x9d, 0x92, 0x9d, 0x1e, 0x5f, 0x94, 0x5a, 0x58, 0x9a, 0x5a, 0x5c, 0x22, 0xc1, 0x0a, 0x76, 0xb0,
-	0x14, 0xba, 0x0e, 0x17, 0x6f, 0xf7, 0x20, 0x88, 0x8a, 0x20, 0xae, 0x94, 0xec, 0x74, 0x28, 0xdb,
-	0xc9, 0xe3, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0,
-	0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5, 0x18, 0xa2, 0xf4, 0xd2, 0x33, 0x4b,
-	0x32, 0x4a, 0x93, 0xf4, 0x92, 0xf3, 0x73, 0xf5, 0x41, 0x66, 0x81, 0x83, 0x3a, 0x39, 0x3f, 0x07,
-	0xcc, 0xd1, 0xaf, 0x40, 0x8a, 0x8e, 0x92, 0xca, 0x82, 0xd4, 0xe2, 0x24, 0x36, 0xb0, 0x02, 0x63,
-	0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0xb4, 0x80, 0xfe, 0x23, 0x0e, 0x02, 0x00, 0x00,
+	// 467 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0x41, 0x6f, 0xd3, 0x30,
+	0x14, 0xc7, 0x1b, 0xb6, 0x16, 0xf0, 0xba, 0x0e, 0xac, 0x09, 0x42, 0x37, 0xc2, 0x84, 0x84, 0x84,
+	0x38, 0x24, 0xa8, 0x70, 0xe3, 0x82, 0x22, 0xb4, 0x56, 0x9a, 0x10, 0xc8, 0x2b, 0x08, 0x71, 0x89,
+	0x9c, 0xc4, 0x4d, 0xad, 0x74, 0x71, 0xf1, 0x73, 0xa6, 0xf5, 0x5b, 0xf0, 0x21, 0xf8, 0x30, 0x3b,
+	0xee, 0xc8, 0x09, 0xa1, 0xf6, 0x8b, 0x20, 0xdb, 0x51, 0x08, 0x51, 0x25, 0x6e, 0x7e, 0x7e, 0xbf,
+	0xff, 0xcf, 0x4f, 0x4f, 0x46, 0xc7, 0xc0, 0x53, 0x16, 0xc4, 0x2a, 0x89, 0x25, 0x4f, 0x33, 0x16,
+	0x64, 0xac, 0x60, 0xc0, 0xc1, 0x5f, 0x4a, 0xa1, 0x04, 0x1e, 0xe8, 0xae, 0x5f, 0x77, 0x87, 0x87,
+	0x99, 0xc8, 0x84, 0x69, 0x05, 0xfa, 0x64, 0xa9, 0xe1, 0x51, 0xcb, 0xb1, 0xa4, 0x92, 0x5e, 0x54,
+	0x8a, 0xa1, 0xd7, 0x6a, 0xd6, 0x27, 0xdb, 0x7f, 0xfa, 0xa3, 0x8b, 0xfa, 0x63, 0xfb, 0xe8, 0xb9,
+	0xa2, 0x8a, 0xe1, 0xd7, 0xa8, 0x67, 0x05, 0xae, 0x73, 0xe2, 0x3c, 0xdf, 0x1b, 0x3d, 0xf0, 0xff,
+	0x1d, 0xc2, 0xff, 0x68, 0xba, 0xe1, 0xee, 0xf5, 0xaf, 0x27, 0x1d, 0x52, 0xb1, 0x78, 0x8c, 0xee,
+	0xc7, 0x0c, 0x54, 0x14, 0x2f, 0x44, 0x92, 0x47, 0x73, 0x46, 0x53, 0x26, 0xdd, 0x5b, 0x46, 0x70,
+	0xd4, 0x16, 0x84, 0x9a, 0x99, 0x18, 0x84, 0x1c, 0xe8, 0x54, 0xe3, 0x02, 0xbf, 0x45, 0xfb, 0x4d,
+	0x07, 0xb8, 0x3b, 0x27, 0x3b, 0xff, 0x93, 0xf4, 0xe3, 0xbf, 0x05, 0xe0, 0x17, 0xa8, 0x5b, 0xaa,
+	0x2b, 0x01, 0xee, 0xae, 0x49, 0x1e, 0xb6, 0x93, 0x9f, 0xa6, 0x5f, 0x3e, 0x10, 0x8b, 0xe0, 0x37,
+	0x68, 0x2f, 0xcd, 0xb3, 0x48, 0xb2, 0x6f, 0x25, 0x03, 0xe5, 0x76, 0xcd, 0xc0, 0xc3, 0x76, 0xe2,
+	0xdd, 0xd9, 0x98, 0x58, 0x82, 0xa0, 0x34, 0xcf, 0xaa, 0x33, 0x1e, 0xa1, 0xae, 0x2c, 0x0b, 0x06,
+	0x6e, 0xcf, 0x3c, 0x74, 0xdc, 0x8e, 0x91, 0xb2, 0x60, 0xef, 0x99, 0xa2, 0x29, 0x55, 0x94, 0x58,
+	0x14, 0x8f, 0xd1, 0xbd, 0x99, 0x14, 0xa0, 0xa2, 0x4b, 0x5a, 0x2e, 0x54, 0x94, 0xb3, 0x15, 0xb8,
+	0xb7, 0x4d, 0xfc, 0x71, 0x3b, 0x7e, 0xaa, 0xb9, 0xcf, 0x1a, 0x3b, 0x63, 0x2b, 0x32, 0x98, 0x35,
+	0x4b, 0xc0, 0xa7, 0xe8, 0xc0, 0x2a, 0x94, 0xa4, 0x05, 0xcc, 0xf4, 0xa6, 0xee, 0x6c, 0xf7, 0x98,
+	0xcc, 0xb4, 0xa2, 0xc8, 0xe0, 0xb2, 0x59, 0x02, 0xa6, 0xe8, 0x11, 0xf0, 0xac, 0xe0, 0x45, 0xbd,
+	0x85, 0x68, 0xce, 0x41, 0x09, 0xc9, 0x19, 0xb8, 0x77, 0x8d, 0xf1, 0x59, 0xdb, 0x78, 0x6e, 0x03,
+	0xd5, 0x1e, 0x26, 0x06, 0x5f, 0x91, 0x87, 0xb0, 0xe5, 0x9a, 0x33, 0xc0, 0x21, 0xda, 0x8f, 0x65,
+	0x32, 0x7a, 0x19, 0x29, 0x9e, 0xe4, 0x7a, 0x50, 0xb4, 0x7d, 0xd0, 0x50, 0x43, 0xf5, 0xc2, 0xfa,
+	0x26, 0x33, 0xb5, 0x91, 0x70, 0x72, 0xbd, 0xf6, 0x9c, 0x9b, 0xb5, 0xe7, 0xfc, 0x5e, 0x7b, 0xce,
+	0xf7, 0x8d, 0xd7, 0xb9, 0xd9, 0x78, 0x9d, 0x9f, 0x1b, 0xaf, 0xf3, 0xd5, 0xcf, 0xb8, 0x9a, 0x97,
+	0xb1, 0x9f, 0x88, 0x8b, 0x40, 0x0b, 0xcd, 0xb7, 0x4e, 0xc4, 0xc2, 0x14, 0xc1, 0x55, 0xe3, 0xeb,
+	0xab, 0xd5, 0x92, 0x41, 0xdc, 0x33, 0xc0, 0xab, 0x3f, 0x03, 0x00, 0x7c, 0x7e, 0xc4, 0xc0, 0x7a,
+	0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -151,6 +201,76 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Brc20Tickers) > 0 {
+		for iNdEx := len(m.Brc20Tickers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Brc20Tickers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.SigningRequestHistories) > 0 {
+		for iNdEx := len(m.SigningRequestHistories) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SigningRequestHistories[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.VaultTransfers) > 0 {
+		for iNdEx := len(m.VaultTransfers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VaultTransfers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.FrostVaultKeys) > 0 {
+		for iNdEx := len(m.FrostVaultKeys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FrostVaultKeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Runes) > 0 {
+		for iNdEx := len(m.Runes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Runes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if m.DkgRequest != nil {
 		{
 			size, err := m.DkgRequest.MarshalToSizedBuffer(dAtA[:i])
@@ -255,6 +375,36 @@ func (m *GenesisState) Size() (n int) {
 		l = m.DkgRequest.Size()
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	if len(m.Runes) > 0 {
+		for _, e := range m.Runes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FrostVaultKeys) > 0 {
+		for _, e := range m.FrostVaultKeys {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.VaultTransfers) > 0 {
+		for _, e := range m.VaultTransfers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SigningRequestHistories) > 0 {
+		for _, e := range m.SigningRequestHistories {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Brc20Tickers) > 0 {
+		for _, e := range m.Brc20Tickers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -466,6 +616,176 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Runes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Runes = append(m.Runes, &RuneMetadata{})
+			if err := m.Runes[len(m.Runes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FrostVaultKeys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FrostVaultKeys = append(m.FrostVaultKeys, &FrostVaultKey{})
+			if err := m.FrostVaultKeys[len(m.FrostVaultKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VaultTransfers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VaultTransfers = append(m.VaultTransfers, &VaultTransfer{})
+			if err := m.VaultTransfers[len(m.VaultTransfers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigningRequestHistories", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SigningRequestHistories = append(m.SigningRequestHistories, &SigningRequestHistory{})
+			if err := m.SigningRequestHistories[len(m.SigningRequestHistories)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Brc20Tickers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Brc20Tickers = append(m.Brc20Tickers, &Brc20Metadata{})
+			if err := m.Brc20Tickers[len(m.Brc20Tickers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"strings"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -16,6 +18,18 @@ const (
 
 	// MemStoreKey defines the in-memory store key
 	MemStoreKey = "mem_btcbridge"
+
+	// ProtocolFeePoolName defines the module account name for the accrued protocol fees
+	ProtocolFeePoolName = "btcbridge_fee_pool"
+
+	// RelayerPoolName defines the module account name for the relayer rewards
+	RelayerPoolName = "btcbridge_relayer_pool"
+
+	// SignerPoolName defines the module account name for the TSS participant rewards
+	SignerPoolName = "btcbridge_signer_pool"
+
+	// InsurancePoolName defines the module account name for the insurance reserve
+	InsurancePoolName = "btcbridge_insurance"
 )
 
 var (
@@ -35,6 +49,8 @@ var (
 	BtcSigningRequestByTxHashPrefix     = []byte{0x26} // prefix for each key to a signing request from tx hash
 	BtcSigningRequestByStatusKeyPrefix  = []byte{0x27} // prefix for each key to a signing request by status
 	BtcMintedTxHashKeyPrefix            = []byte{0x28} // prefix for each key to a minted tx hash
+	BtcSigningRequestHistoryKeyPrefix   = []byte{0x29} // prefix for each key to a signing request history entry
+	BtcSigningRequestHistoryIndexPrefix = []byte{0x2a} // prefix for each key to the next history index of a signing request
 
 	BtcUtxoKeyPrefix              = []byte{0x30} // prefix for each key to a utxo
 	BtcOwnerUtxoKeyPrefix         = []byte{0x31} // prefix for each key to an owned utxo
@@ -45,6 +61,31 @@ var (
 	DKGRequestKeyPrefix           = []byte{0x41} // prefix for each key to a DKG request
 	DKGCompletionRequestKeyPrefix = []byte{0x42} // prefix for each key to a DKG completion request
 	VaultVersionKey               = []byte{0x43} // key for vault version increased by 1 once updated
+	ParticipantUpdatePendingKey   = []byte{0x44} // key for the pending check of the TSS participant update
+	FrostVaultKeyKeyPrefix        = []byte{0x45} // prefix for each key to a FROST vault key
+	FrostSigningSessionKeyPrefix  = []byte{0x46} // prefix for each key to a FROST signing session
+	SignerPerformanceKeyPrefix    = []byte{0x47} // prefix for each key to the liveness performance of a TSS participant
+	ActiveFrostSigningKeyPrefix   = []byte{0x48} // prefix for each key to an active FROST signing session
+	VaultTransferKeyPrefix        = []byte{0x49} // prefix for each key to a vault transfer
+	ParticipantUpdateStateKey     = []byte{0x4a} // key for the last observed state of the TSS participant update
+
+	RuneMetadataKeyPrefix  = []byte{0x50} // prefix for each key to a rune metadata
+	Brc20MetadataKeyPrefix = []byte{0x51} // prefix for each key to a brc20 ticker metadata
+
+	FeeBucketStatsKeyPrefix    = []byte{0x60} // prefix for each key to the protocol fee statistics of a bucket
+	LastFeeSettlementHeightKey = []byte{0x61} // key for the block height of the last protocol fee settlement
+
+	OutflowKeyPrefix       = []byte{0x70} // prefix for each key to the asset outflow at a block height
+	GlobalOutflowKeyPrefix = []byte{0x71} // prefix for each key to the global btc outflow at a block height
+	CircuitBreakerKey      = []byte{0x72} // key for the withdrawal circuit breaker
+
+	EmergencyPauseIDKey           = []byte{0x80} // key for the emergency pause id
+	EmergencyPauseKeyPrefix       = []byte{0x81} // prefix for each key to an emergency pause
+	ActiveEmergencyPauseKeyPrefix = []byte{0x82} // prefix for each key to an active emergency pause
+
+	LastConsolidationHeightKey = []byte{0x90} // key for the block height of the last automatic consolidation
+
+	DepositVaultCursorKeyPrefix = []byte{0x91} // prefix for each key to the round-robin deposit vault cursor of an asset type
 )
 
 func BtcBlockHeaderHashKey(hash string) []byte {
@@ -81,6 +122,16 @@ func BtcSigningRequestByStatusKey(status SigningStatus, sequence uint64) []byte
 	return append(key, sdk.Uint64ToBigEndian(sequence)...)
 }
 
+func BtcSigningRequestHistoryKey(sequence uint64, index uint64) []byte {
+	key := append(BtcSigningRequestHistoryKeyPrefix, sdk.Uint64ToBigEndian(sequence)...)
+
+	return append(key, sdk.Uint64ToBigEndian(index)...)
+}
+
+func BtcSigningRequestHistoryIndexKey(sequence uint64) []byte {
+	return append(BtcSigningRequestHistoryIndexPrefix, sdk.Uint64ToBigEndian(sequence)...)
+}
+
 func BtcMintedTxHashKey(hash string) []byte {
 	return append(BtcMintedTxHashKeyPrefix, []byte(hash)...)
 }
@@ -120,3 +171,55 @@ func DKGRequestKey(id uint64) []byte {
 func DKGCompletionRequestKey(id uint64, consAddress string) []byte {
 	return append(append(DKGCompletionRequestKeyPrefix, sdk.Uint64ToBigEndian(id)...), []byte(consAddress)...)
 }
+
+func FrostVaultKeyKey(vault string) []byte {
+	return append(FrostVaultKeyKeyPrefix, []byte(vault)...)
+}
+
+func FrostSigningSessionKey(txid string) []byte {
+	return append(FrostSigningSessionKeyPrefix, []byte(txid)...)
+}
+
+func SignerPerformanceKey(operatorAddress string) []byte {
+	return append(SignerPerformanceKeyPrefix, []byte(operatorAddress)...)
+}
+
+func ActiveFrostSigningKey(txid string) []byte {
+	return append(ActiveFrostSigningKeyPrefix, []byte(txid)...)
+}
+
+func VaultTransferKey(sourceVersion uint64) []byte {
+	return append(VaultTransferKeyPrefix, sdk.Uint64ToBigEndian(sourceVersion)...)
+}
+
+func RuneMetadataKey(id string) []byte {
+	return append(RuneMetadataKeyPrefix, MarshalRuneIdFromString(id)...)
+}
+
+func Brc20MetadataKey(tick string) []byte {
+	return append(Brc20MetadataKeyPrefix, []byte(strings.ToLower(tick))...)
+}
+
+func FeeBucketStatsKey(bucket FeeBucket) []byte {
+	return append(FeeBucketStatsKeyPrefix, sdk.Uint64ToBigEndian(uint64(bucket))...)
+}
+
+func OutflowKey(height int64, denom string) []byte {
+	return append(append(OutflowKeyPrefix, sdk.Uint64ToBigEndian(uint64(height))...), []byte(denom)...)
+}
+
+func GlobalOutflowKey(height int64) []byte {
+	return append(GlobalOutflowKeyPrefix, sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+func EmergencyPauseKey(id uint64) []byte {
+	return append(EmergencyPauseKeyPrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
+func ActiveEmergencyPauseKey(id uint64) []byte {
+	return append(ActiveEmergencyPauseKeyPrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
+func DepositVaultCursorKey(assetType AssetType) []byte {
+	return append(DepositVaultCursorKeyPrefix, sdk.Uint64ToBigEndian(uint64(assetType))...)
+}
@@ -3,6 +3,8 @@ package types
 import (
 	"encoding/hex"
 
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+
 	errorsmod "cosmossdk.io/errors"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -14,15 +16,21 @@ func NewMsgCompleteDKG(
 	sender string,
 	id uint64,
 	vaults []string,
+	pubKeys []string,
+	proofs []string,
+	verificationShares []VerificationShares,
 	consAddress string,
 	signature string,
 ) *MsgCompleteDKG {
 	return &MsgCompleteDKG{
-		Sender:           sender,
-		Id:               id,
-		Vaults:           vaults,
-		ConsensusAddress: consAddress,
-		Signature:        signature,
+		Sender:             sender,
+		Id:                 id,
+		Vaults:             vaults,
+		ConsensusAddress:   consAddress,
+		Signature:          signature,
+		PubKeys:            pubKeys,
+		Proofs:             proofs,
+		VerificationShares: verificationShares,
 	}
 }
 
@@ -46,6 +54,25 @@ func (m *MsgCompleteDKG) ValidateBasic() error {
 		vaults[v] = true
 	}
 
+	if len(m.PubKeys) != len(m.Vaults) || len(m.Proofs) != len(m.Vaults) {
+		return errorsmod.Wrap(ErrInvalidDKGCompletionRequest, "mismatched vault keys")
+	}
+
+	if len(m.VerificationShares) != 0 && len(m.VerificationShares) != len(m.Vaults) {
+		return errorsmod.Wrap(ErrInvalidVerificationShares, "mismatched verification shares")
+	}
+
+	for i, pk := range m.PubKeys {
+		if _, err := (&Vault{PubKey: pk}).InternalKey(); err != nil {
+			return errorsmod.Wrap(ErrInvalidVaultKey, "invalid public key")
+		}
+
+		proof, err := hex.DecodeString(m.Proofs[i])
+		if err != nil || len(proof) != schnorr.SignatureSize {
+			return errorsmod.Wrap(ErrInvalidVaultKey, "invalid proof")
+		}
+	}
+
 	if _, err := sdk.ConsAddressFromHex(m.ConsensusAddress); err != nil {
 		return ErrInvalidDKGCompletionRequest
 	}
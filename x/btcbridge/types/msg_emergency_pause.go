@@ -0,0 +1,49 @@
+package types
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ sdk.Msg = &MsgEmergencyPause{}
+
+func NewMsgEmergencyPause(
+	guardian string,
+	scope PauseScope,
+	denom string,
+	duration time.Duration,
+) *MsgEmergencyPause {
+	return &MsgEmergencyPause{
+		Guardian: guardian,
+		Scope:    scope,
+		Denom:    denom,
+		Duration: duration,
+	}
+}
+
+// ValidateBasic performs basic MsgEmergencyPause message validation.
+func (m *MsgEmergencyPause) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Guardian); err != nil {
+		return errorsmod.Wrap(err, "invalid guardian address")
+	}
+
+	if _, ok := PauseScope_name[int32(m.Scope)]; !ok || m.Scope == PauseScope_PAUSE_SCOPE_UNSPECIFIED {
+		return errorsmod.Wrap(ErrInvalidEmergencyPause, "invalid scope")
+	}
+
+	if m.Scope == PauseScope_PAUSE_SCOPE_ASSET {
+		if err := sdk.ValidateDenom(m.Denom); err != nil {
+			return errorsmod.Wrap(ErrInvalidEmergencyPause, "invalid denom")
+		}
+	} else if len(m.Denom) != 0 {
+		return errorsmod.Wrap(ErrInvalidEmergencyPause, "denom is only allowed for the asset scope")
+	}
+
+	if m.Duration <= 0 {
+		return errorsmod.Wrap(ErrInvalidEmergencyPause, "duration must be greater than 0")
+	}
+
+	return nil
+}
@@ -44,8 +44,17 @@ func (m *MsgInitiateDKG) ValidateBasic() error {
 		participants[p.ConsensusPubkey] = true
 	}
 
-	if !slices.Equal(m.VaultTypes, SupportedAssetTypes()) {
-		return errorsmod.Wrap(ErrInvalidDKGParams, "incorrect vault types")
+	// multiple vaults of the same asset type are allowed to be active concurrently
+	for _, t := range SupportedAssetTypes() {
+		if !slices.Contains(m.VaultTypes, t) {
+			return errorsmod.Wrap(ErrInvalidDKGParams, "incorrect vault types")
+		}
+	}
+
+	for _, t := range m.VaultTypes {
+		if !slices.Contains(SupportedAssetTypes(), t) {
+			return errorsmod.Wrap(ErrInvalidDKGParams, "incorrect vault types")
+		}
 	}
 
 	if m.EnableTransfer {
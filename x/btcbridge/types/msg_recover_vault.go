@@ -0,0 +1,36 @@
+package types
+
+import (
+	"encoding/hex"
+
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ sdk.Msg = &MsgRecoverVault{}
+
+// ValidateBasic performs basic MsgRecoverVault message validation.
+func (m *MsgRecoverVault) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Vault); err != nil {
+		return errorsmod.Wrap(err, "invalid vault address")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Recipient); err != nil {
+		return errorsmod.Wrap(err, "invalid recipient address")
+	}
+
+	if len(m.InternalKey) != 0 {
+		pkBytes, err := hex.DecodeString(m.InternalKey)
+		if err != nil || len(pkBytes) != schnorr.PubKeyBytesLen {
+			return errorsmod.Wrap(ErrInvalidVaultRecovery, "invalid internal key")
+		}
+	}
+
+	return nil
+}
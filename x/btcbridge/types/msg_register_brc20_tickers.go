@@ -0,0 +1,50 @@
+package types
+
+import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ sdk.Msg = &MsgRegisterBrc20Tickers{}
+
+func NewMsgRegisterBrc20Tickers(
+	sender string,
+	tickers []*Brc20Metadata,
+) *MsgRegisterBrc20Tickers {
+	return &MsgRegisterBrc20Tickers{
+		Sender:  sender,
+		Tickers: tickers,
+	}
+}
+
+func (msg *MsgRegisterBrc20Tickers) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(err, "invalid sender address (%s)", err)
+	}
+
+	if len(msg.Tickers) == 0 {
+		return errorsmod.Wrap(ErrInvalidBrc20Metadata, "tickers can not be empty")
+	}
+
+	ticks := make(map[string]bool)
+
+	for _, metadata := range msg.Tickers {
+		if err := metadata.Validate(); err != nil {
+			return err
+		}
+
+		// the brc20 ticker is case insensitive
+		tick := strings.ToLower(metadata.Tick)
+
+		if ticks[tick] {
+			return errorsmod.Wrapf(ErrInvalidBrc20Metadata, "duplicate metadata %s", metadata.Tick)
+		}
+
+		ticks[tick] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,45 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ sdk.Msg = &MsgRegisterRunes{}
+
+func NewMsgRegisterRunes(
+	sender string,
+	runes []*RuneMetadata,
+) *MsgRegisterRunes {
+	return &MsgRegisterRunes{
+		Sender: sender,
+		Runes:  runes,
+	}
+}
+
+func (msg *MsgRegisterRunes) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(err, "invalid sender address (%s)", err)
+	}
+
+	if len(msg.Runes) == 0 {
+		return errorsmod.Wrap(ErrInvalidRuneMetadata, "runes can not be empty")
+	}
+
+	ids := make(map[string]bool)
+
+	for _, metadata := range msg.Runes {
+		if err := metadata.Validate(); err != nil {
+			return err
+		}
+
+		if ids[metadata.Id] {
+			return errorsmod.Wrapf(ErrInvalidRuneMetadata, "duplicate metadata %s", metadata.Id)
+		}
+
+		ids[metadata.Id] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,47 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ sdk.Msg = &MsgSubmitNonceCommitments{}
+
+func NewMsgSubmitNonceCommitments(
+	sender string,
+	txid string,
+	commitments []FrostNonceCommitment,
+) *MsgSubmitNonceCommitments {
+	return &MsgSubmitNonceCommitments{
+		Sender:      sender,
+		Txid:        txid,
+		Commitments: commitments,
+	}
+}
+
+// ValidateBasic performs basic MsgSubmitNonceCommitments message validation.
+func (m *MsgSubmitNonceCommitments) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return errorsmod.Wrap(err, "invalid sender address")
+	}
+
+	if len(m.Txid) == 0 {
+		return errorsmod.Wrap(ErrInvalidFrostSigning, "txid cannot be empty")
+	}
+
+	if len(m.Commitments) == 0 {
+		return errorsmod.Wrap(ErrInvalidFrostSigning, "nonce commitments cannot be empty")
+	}
+
+	for _, c := range m.Commitments {
+		if _, err := ParseFrostPoint(c.Hiding); err != nil {
+			return errorsmod.Wrap(ErrInvalidFrostSigning, "invalid hiding nonce commitment")
+		}
+
+		if _, err := ParseFrostPoint(c.Binding); err != nil {
+			return errorsmod.Wrap(ErrInvalidFrostSigning, "invalid binding nonce commitment")
+		}
+	}
+
+	return nil
+}
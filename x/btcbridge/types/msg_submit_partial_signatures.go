@@ -0,0 +1,43 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ sdk.Msg = &MsgSubmitPartialSignatures{}
+
+func NewMsgSubmitPartialSignatures(
+	sender string,
+	txid string,
+	partialSigs []string,
+) *MsgSubmitPartialSignatures {
+	return &MsgSubmitPartialSignatures{
+		Sender:            sender,
+		Txid:              txid,
+		PartialSignatures: partialSigs,
+	}
+}
+
+// ValidateBasic performs basic MsgSubmitPartialSignatures message validation.
+func (m *MsgSubmitPartialSignatures) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return errorsmod.Wrap(err, "invalid sender address")
+	}
+
+	if len(m.Txid) == 0 {
+		return errorsmod.Wrap(ErrInvalidFrostSigning, "txid cannot be empty")
+	}
+
+	if len(m.PartialSignatures) == 0 {
+		return errorsmod.Wrap(ErrInvalidFrostSigning, "partial signatures cannot be empty")
+	}
+
+	for _, sig := range m.PartialSignatures {
+		if _, err := ParseFrostScalar(sig); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,46 @@
+package types
+
+import (
+	"bytes"
+
+	"github.com/btcsuite/btcd/btcutil/psbt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ sdk.Msg = &MsgSweepVault{}
+
+// ValidateBasic performs basic MsgSweepVault message validation.
+func (m *MsgSweepVault) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Vault); err != nil {
+		return errorsmod.Wrap(err, "invalid vault address")
+	}
+
+	packet, err := psbt.NewFromRawBytes(bytes.NewReader([]byte(m.Psbt)), true)
+	if err != nil {
+		return ErrInvalidPsbt
+	}
+
+	if err := CheckTransactionWeight(packet.UnsignedTx, nil); err != nil {
+		return err
+	}
+
+	for i := range packet.UnsignedTx.TxIn {
+		if !IsValidPsbtInputSigHashType(&packet.Inputs[i]) {
+			return ErrInvalidPsbt
+		}
+	}
+
+	for _, out := range packet.UnsignedTx.TxOut {
+		if IsDustOut(out) {
+			return ErrDustOutput
+		}
+	}
+
+	return nil
+}
@@ -41,7 +41,7 @@ func (m *MsgTransferVault) ValidateBasic() error {
 				return ErrInvalidPsbt
 			}
 
-			if packet.Inputs[i].SighashType != DefaultSigHashType {
+			if !IsValidPsbtInputSigHashType(&packet.Inputs[i]) {
 				return ErrInvalidPsbt
 			}
 		}
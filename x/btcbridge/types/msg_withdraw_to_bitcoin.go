@@ -32,5 +32,18 @@ func (msg *MsgWithdrawToBitcoin) ValidateBasic() error {
 		return errorsmod.Wrapf(err, "invalid withdrawal amount")
 	}
 
+	if msg.FeeRate < 0 {
+		return ErrInvalidFeeRate
+	}
+
+	if msg.MaxFee < 0 {
+		return errorsmod.Wrap(ErrInvalidWithdrawAmount, "max fee must not be negative")
+	}
+
 	return nil
 }
+
+// IsPriority returns true if the withdrawal is a priority withdrawal, false otherwise
+func (msg *MsgWithdrawToBitcoin) IsPriority() bool {
+	return msg.FeeRate > 0 || msg.MaxFee > 0
+}
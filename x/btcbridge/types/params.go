@@ -2,7 +2,7 @@ package types
 
 import (
 	"bytes"
-	"encoding/hex"
+	"slices"
 	"sort"
 	"time"
 
@@ -42,11 +42,29 @@ var (
 	// default maximum number of btc batch withdrawal per batch
 	DefaultMaxBtcBatchWithdrawNum = uint32(100)
 
+	// default protocol fee distribution epoch
+	DefaultFeeDistributionEpoch = int64(14400) // 14400 blocks
+
+	// default rolling window of the withdrawal rate limit
+	DefaultWithdrawRateLimitWindow = int64(14400) // 14400 blocks
+
+	// default maximum duration of the emergency pause by guardians
+	DefaultMaxPauseDuration = time.Duration(604800) * time.Second // 7 days
+
 	// default DKG timeout period
 	DefaultDKGTimeoutPeriod = time.Duration(86400) * time.Second // 1 day
 
-	// default TSS participant update transition period; not used for now
+	// default maximum number of the automatic DKG retries
+	DefaultDKGMaxRetries = uint32(2)
+
+	// default TSS participant update transition period
 	DefaultTSSParticipantUpdateTransitionPeriod = time.Duration(1209600) * time.Second // 14 days
+
+	// default sliding window of the TSS signer liveness tracking
+	DefaultSignerLivenessWindow = int64(14400) // 14400 blocks
+
+	// default timeout of the FROST signing round
+	DefaultSigningRoundTimeout = time.Duration(1800) * time.Second // 30 minutes
 )
 
 // NewParams creates a new Params instance
@@ -75,13 +93,46 @@ func NewParams() Params {
 			BtcMaxWithdraw: 500000000, // 5 BTC
 		},
 		ProtocolFees: ProtocolFees{
-			DepositFee:  8000,  // 0.00008 BTC
-			WithdrawFee: 12000, // 0.00012 BTC
-			Collector:   authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+			Collector: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+			DepositFeeSchedule: FeeSchedule{
+				Bps:    10,       // 0.1%
+				MinFee: "8000",   // 0.00008 BTC
+				MaxFee: "500000", // 0.005 BTC
+			},
+			WithdrawFeeSchedule: FeeSchedule{
+				Bps:    10,       // 0.1%
+				MinFee: "12000",  // 0.00012 BTC
+				MaxFee: "500000", // 0.005 BTC
+			},
+			Distribution: FeeDistribution{
+				Epoch:               DefaultFeeDistributionEpoch,
+				CommunityPoolWeight: 20,
+				RelayerPoolWeight:   20,
+				SignerPoolWeight:    50,
+				InsuranceWeight:     10,
+			},
 		},
 		TssParams: TSSParams{
 			DkgTimeoutPeriod:                  DefaultDKGTimeoutPeriod,
 			ParticipantUpdateTransitionPeriod: DefaultTSSParticipantUpdateTransitionPeriod,
+			DkgMaxRetries:                     DefaultDKGMaxRetries,
+			SignerLiveness: SignerLivenessParams{
+				Window:              DefaultSignerLivenessWindow,
+				SigningRoundTimeout: DefaultSigningRoundTimeout,
+			},
+		},
+		RuneConfigs: []RuneConfig{},
+		WithdrawRateLimit: WithdrawRateLimit{
+			Window:      DefaultWithdrawRateLimitWindow,
+			AssetLimits: sdk.Coins{},
+		},
+		Guardians:        []string{},
+		MaxPauseDuration: DefaultMaxPauseDuration,
+		ConsolidationPolicy: ConsolidationPolicy{
+			RuneThresholds: []RuneConsolidationThreshold{},
+		},
+		VaultRecoveryPolicy: VaultRecoveryPolicy{
+			PubKeys: []string{},
 		},
 	}
 }
@@ -129,7 +180,35 @@ func (p Params) Validate() error {
 		return err
 	}
 
-	return validateTSSParams(&p.TssParams)
+	if err := validateTSSParams(&p.TssParams); err != nil {
+		return err
+	}
+
+	if err := validateRuneConfigs(p.RuneConfigs); err != nil {
+		return err
+	}
+
+	if err := p.WithdrawRateLimit.Validate(); err != nil {
+		return err
+	}
+
+	if err := validateGuardianParams(p.Guardians, p.MaxPauseDuration); err != nil {
+		return err
+	}
+
+	if err := p.ConsolidationPolicy.Validate(); err != nil {
+		return err
+	}
+
+	if err := p.VaultRecoveryPolicy.Validate(); err != nil {
+		return err
+	}
+
+	if _, ok := VaultAssignmentStrategy_name[int32(p.VaultAssignmentStrategy)]; !ok {
+		return errorsmod.Wrapf(ErrInvalidParams, "invalid vault assignment strategy")
+	}
+
+	return nil
 }
 
 // SelectVaultByAddress returns the vault by the given address
@@ -169,6 +248,52 @@ func SelectVaultByAssetType(vaults []*Vault, assetType AssetType) *Vault {
 	return nil
 }
 
+// SelectActiveVaultsByAssetType returns all the vaults by the asset type of the highest version
+// The vaults of the same version are active concurrently
+func SelectActiveVaultsByAssetType(vaults []*Vault, assetType AssetType) []*Vault {
+	activeVaults := []*Vault{}
+
+	latestVault := SelectVaultByAssetType(vaults, assetType)
+	if latestVault == nil {
+		return activeVaults
+	}
+
+	for _, v := range vaults {
+		if v.AssetType == assetType && v.Version == latestVault.Version {
+			activeVaults = append(activeVaults, v)
+		}
+	}
+
+	return activeVaults
+}
+
+// SelectVaultsByAssetTypeAndVersion returns all the vaults by the given asset type and version
+func SelectVaultsByAssetTypeAndVersion(vaults []*Vault, assetType AssetType, version uint64) []*Vault {
+	selectedVaults := []*Vault{}
+
+	for _, v := range vaults {
+		if v.AssetType == assetType && v.Version == version {
+			selectedVaults = append(selectedVaults, v)
+		}
+	}
+
+	return selectedVaults
+}
+
+// SelectTransferDestVault returns the vault of the given destination version to which the given source vault is transferred
+// The source vaults of the same asset type and version are paired with the destination vaults in order
+func SelectTransferDestVault(vaults []*Vault, sourceVault *Vault, destVersion uint64) *Vault {
+	destVaults := SelectVaultsByAssetTypeAndVersion(vaults, sourceVault.AssetType, destVersion)
+	if len(destVaults) == 0 {
+		return nil
+	}
+
+	sourceVaults := SelectVaultsByAssetTypeAndVersion(vaults, sourceVault.AssetType, sourceVault.Version)
+	index := max(slices.IndexFunc(sourceVaults, func(v *Vault) bool { return v.Address == sourceVault.Address }), 0)
+
+	return destVaults[index%len(destVaults)]
+}
+
 // SelectVaultByPkScript returns the vault by the given pk script for convenience
 func SelectVaultByPkScript(vaults []*Vault, pkScript []byte) *Vault {
 	chainCfg := sdk.GetConfig().GetBtcChainCfg()
@@ -192,6 +317,21 @@ func SelectVaultByPkScript(vaults []*Vault, pkScript []byte) *Vault {
 	return nil
 }
 
+// ParseVaultPubKey parses the public key of the given vault
+// The public key is either compressed or x-only
+func ParseVaultPubKey(vault *Vault) (*secp256k1.PublicKey, error) {
+	if len(vault.PubKey) == 0 {
+		return nil, errorsmod.Wrap(ErrInvalidVault, "vault public key not set")
+	}
+
+	pubKey, err := vault.InternalKey()
+	if err != nil {
+		return nil, errorsmod.Wrap(ErrInvalidVault, "invalid vault public key")
+	}
+
+	return pubKey, nil
+}
+
 // validateConfirmationAndReorgParams validates the given confirmation and reorg params
 func validateConfirmationAndReorgParams(depositConfirmationDepth int32, withdrawConfirmationDepth int32, maxReorgDepth int32) error {
 	if depositConfirmationDepth <= 0 || withdrawConfirmationDepth <= 0 {
@@ -255,9 +395,15 @@ func validateVaults(vaults []*Vault) error {
 	vaultMap := make(map[string]bool)
 
 	for _, v := range vaults {
-		_, err := sdk.AccAddressFromBech32(v.Address)
-		if err != nil {
-			return err
+		if v.ScriptDescriptor != nil {
+			if err := validateVaultDescriptor(v); err != nil {
+				return err
+			}
+		} else {
+			_, err := sdk.AccAddressFromBech32(v.Address)
+			if err != nil {
+				return err
+			}
 		}
 
 		if vaultMap[v.Address] {
@@ -267,13 +413,13 @@ func validateVaults(vaults []*Vault) error {
 		vaultMap[v.Address] = true
 
 		if len(v.PubKey) != 0 {
-			pkBytes, err := hex.DecodeString(v.PubKey)
-			if err != nil {
+			if _, err := v.InternalKey(); err != nil {
 				return err
 			}
+		}
 
-			_, err = secp256k1.ParsePubKey(pkBytes)
-			if err != nil {
+		if v.TaprootTree != nil {
+			if err := validateVaultTaprootTree(v); err != nil {
 				return err
 			}
 		}
@@ -281,6 +427,60 @@ func validateVaults(vaults []*Vault) error {
 		if v.AssetType == AssetType_ASSET_TYPE_UNSPECIFIED {
 			return errorsmod.Wrapf(ErrInvalidParams, "invalid asset type")
 		}
+
+		if _, ok := VaultState_name[int32(v.State)]; !ok {
+			return errorsmod.Wrapf(ErrInvalidParams, "invalid vault state")
+		}
+	}
+
+	return nil
+}
+
+// validateVaultDescriptor validates the descriptor of the given vault
+func validateVaultDescriptor(vault *Vault) error {
+	if err := vault.ScriptDescriptor.Validate(); err != nil {
+		return err
+	}
+
+	address, err := vault.ScriptDescriptor.Address()
+	if err != nil {
+		return err
+	}
+
+	if address != vault.Address {
+		return errorsmod.Wrapf(ErrInvalidParams, "vault address %s does not match the descriptor address %s", vault.Address, address)
+	}
+
+	return nil
+}
+
+// validateVaultTaprootTree validates the taproot tree of the given vault
+func validateVaultTaprootTree(vault *Vault) error {
+	if vault.ScriptDescriptor != nil {
+		return errorsmod.Wrapf(ErrInvalidParams, "vault %s can not have both the script descriptor and taproot tree", vault.Address)
+	}
+
+	if err := vault.TaprootTree.Validate(); err != nil {
+		return err
+	}
+
+	// the vault address can only be verified when the internal key is known
+	if len(vault.PubKey) == 0 {
+		return nil
+	}
+
+	internalKey, err := vault.InternalKey()
+	if err != nil {
+		return err
+	}
+
+	address, err := vault.TaprootTree.Address(internalKey)
+	if err != nil {
+		return err
+	}
+
+	if address != vault.Address {
+		return errorsmod.Wrapf(ErrInvalidParams, "vault address %s does not match the taproot address %s", vault.Address, address)
 	}
 
 	return nil
@@ -292,6 +492,10 @@ func validateWithdrawParams(withdrawParams *WithdrawParams) error {
 		return errorsmod.Wrapf(ErrInvalidParams, "invalid withdrawal params")
 	}
 
+	if _, ok := CoinSelectionStrategy_name[int32(withdrawParams.CoinSelection)]; !ok {
+		return errorsmod.Wrapf(ErrInvalidParams, "invalid coin selection strategy")
+	}
+
 	return nil
 }
 
@@ -301,7 +505,19 @@ func validateProtocolParams(protocolLimits *ProtocolLimits, protocolFees *Protoc
 		return errorsmod.Wrapf(ErrInvalidParams, "minimum btc withdrawal amount must not be greater than maximum withdrawal amount")
 	}
 
-	if (protocolFees.DepositFee != 0 || protocolFees.WithdrawFee != 0) && len(protocolFees.Collector) == 0 {
+	if err := protocolFees.DepositFeeSchedule.Validate(); err != nil {
+		return err
+	}
+
+	if err := protocolFees.WithdrawFeeSchedule.Validate(); err != nil {
+		return err
+	}
+
+	if err := protocolFees.Distribution.Validate(); err != nil {
+		return err
+	}
+
+	if (protocolFees.DepositFeeSchedule.IsEnabled() || protocolFees.WithdrawFeeSchedule.IsEnabled()) && protocolFees.Distribution.TotalWeight() == 0 && len(protocolFees.Collector) == 0 {
 		return errorsmod.Wrapf(ErrInvalidParams, "invalid protocol fee params")
 	}
 
@@ -325,5 +541,76 @@ func validateTSSParams(params *TSSParams) error {
 		return errorsmod.Wrapf(ErrInvalidParams, "invalid participant update transition period")
 	}
 
+	if params.StakeShiftThresholdBps > MaxFeeBps {
+		return errorsmod.Wrapf(ErrInvalidParams, "stake shift threshold must not be greater than %d bps", MaxFeeBps)
+	}
+
+	return validateSignerLivenessParams(&params.SignerLiveness)
+}
+
+// validateSignerLivenessParams validates the given signer liveness params
+func validateSignerLivenessParams(params *SignerLivenessParams) error {
+	if params.Window < 0 {
+		return errorsmod.Wrapf(ErrInvalidParams, "signer liveness window must not be negative")
+	}
+
+	if params.Window == 0 && (params.MaxMissedDkgs != 0 || params.MaxMissedSignings != 0) {
+		return errorsmod.Wrapf(ErrInvalidParams, "signer liveness window required for the missed limits")
+	}
+
+	if params.SlashFractionBps > MaxFeeBps {
+		return errorsmod.Wrapf(ErrInvalidParams, "slash fraction must not be greater than %d bps", MaxFeeBps)
+	}
+
+	if params.JailDuration < 0 {
+		return errorsmod.Wrapf(ErrInvalidParams, "jail duration must not be negative")
+	}
+
+	if params.SigningRoundTimeout < 0 {
+		return errorsmod.Wrapf(ErrInvalidParams, "signing round timeout must not be negative")
+	}
+
+	return nil
+}
+
+// validateRuneConfigs validates the given rune configs
+func validateRuneConfigs(configs []RuneConfig) error {
+	configMap := make(map[string]bool)
+
+	for _, c := range configs {
+		if err := c.Validate(); err != nil {
+			return err
+		}
+
+		if configMap[c.Id] {
+			return errorsmod.Wrapf(ErrInvalidParams, "duplicate rune config")
+		}
+
+		configMap[c.Id] = true
+	}
+
+	return nil
+}
+
+// validateGuardianParams validates the given guardians and maximum pause duration
+func validateGuardianParams(guardians []string, maxPauseDuration time.Duration) error {
+	guardianMap := make(map[string]bool)
+
+	for _, guardian := range guardians {
+		if _, err := sdk.AccAddressFromBech32(guardian); err != nil {
+			return errorsmod.Wrapf(ErrInvalidParams, "invalid guardian")
+		}
+
+		if guardianMap[guardian] {
+			return errorsmod.Wrapf(ErrInvalidParams, "duplicate guardian")
+		}
+
+		guardianMap[guardian] = true
+	}
+
+	if maxPauseDuration <= 0 {
+		return errorsmod.Wrapf(ErrInvalidParams, "max pause duration must be greater than 0")
+	}
+
 	return nil
 }
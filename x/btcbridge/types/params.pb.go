@@ -5,11 +5,13 @@ package types
 
 import (
 	fmt "fmt"
-	_ "github.com/cosmos/cosmos-sdk/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -28,6 +30,34 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// VaultAssignmentStrategy defines the strategy to assign the deposit vault among the active vaults
+type VaultAssignmentStrategy int32
+
+const (
+	// Round-robin: the active vaults are assigned in turn as deposits arrive
+	VaultAssignmentStrategy_VAULT_ASSIGNMENT_STRATEGY_ROUND_ROBIN VaultAssignmentStrategy = 0
+	// Least utxo: the active vault with the fewest unlocked utxos is assigned
+	VaultAssignmentStrategy_VAULT_ASSIGNMENT_STRATEGY_LEAST_UTXO VaultAssignmentStrategy = 1
+)
+
+var VaultAssignmentStrategy_name = map[int32]string{
+	0: "VAULT_ASSIGNMENT_STRATEGY_ROUND_ROBIN",
+	1: "VAULT_ASSIGNMENT_STRATEGY_LEAST_UTXO",
+}
+
+var VaultAssignmentStrategy_value = map[string]int32{
+	"VAULT_ASSIGNMENT_STRATEGY_ROUND_ROBIN": 0,
+	"VAULT_ASSIGNMENT_STRATEGY_LEAST_UTXO":  1,
+}
+
+func (x VaultAssignmentStrategy) String() string {
+	return proto.EnumName(VaultAssignmentStrategy_name, int32(x))
+}
+
+func (VaultAssignmentStrategy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_f1d33573cda8a6d2, []int{0}
+}
+
 // AssetType defines the type of asset
 type AssetType int32
 
@@ -61,7 +91,107 @@ func (x AssetType) String() string {
 }
 
 func (AssetType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_f1d33573cda8a6d2, []int{0}
+	return fileDescriptor_f1d33573cda8a6d2, []int{1}
+}
+
+// VaultState defines the lifecycle state of the vault
+type VaultState int32
+
+const (
+	// VAULT_STATE_ACTIVE defines the vault in use; the default state
+	VaultState_VAULT_STATE_ACTIVE VaultState = 0
+	// VAULT_STATE_DEPRECATED defines the vault superseded by the new version, of which the deposits are accepted and swept to the latest version automatically
+	VaultState_VAULT_STATE_DEPRECATED VaultState = 1
+	// VAULT_STATE_RETIRED defines the vault of which the deposits are rejected and only swept by governance
+	VaultState_VAULT_STATE_RETIRED VaultState = 2
+)
+
+var VaultState_name = map[int32]string{
+	0: "VAULT_STATE_ACTIVE",
+	1: "VAULT_STATE_DEPRECATED",
+	2: "VAULT_STATE_RETIRED",
+}
+
+var VaultState_value = map[string]int32{
+	"VAULT_STATE_ACTIVE":     0,
+	"VAULT_STATE_DEPRECATED": 1,
+	"VAULT_STATE_RETIRED":    2,
+}
+
+func (x VaultState) String() string {
+	return proto.EnumName(VaultState_name, int32(x))
+}
+
+func (VaultState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_f1d33573cda8a6d2, []int{2}
+}
+
+// VaultScriptType defines the script type of the vault described by the descriptor
+type VaultScriptType int32
+
+const (
+	// Unspecified script type
+	VaultScriptType_VAULT_SCRIPT_TYPE_UNSPECIFIED VaultScriptType = 0
+	// P2WSH m-of-n OP_CHECKMULTISIG
+	VaultScriptType_VAULT_SCRIPT_TYPE_P2WSH_MULTISIG VaultScriptType = 1
+	// P2SH-wrapped P2WSH m-of-n OP_CHECKMULTISIG
+	VaultScriptType_VAULT_SCRIPT_TYPE_P2SH_P2WSH_MULTISIG VaultScriptType = 2
+)
+
+var VaultScriptType_name = map[int32]string{
+	0: "VAULT_SCRIPT_TYPE_UNSPECIFIED",
+	1: "VAULT_SCRIPT_TYPE_P2WSH_MULTISIG",
+	2: "VAULT_SCRIPT_TYPE_P2SH_P2WSH_MULTISIG",
+}
+
+var VaultScriptType_value = map[string]int32{
+	"VAULT_SCRIPT_TYPE_UNSPECIFIED":         0,
+	"VAULT_SCRIPT_TYPE_P2WSH_MULTISIG":      1,
+	"VAULT_SCRIPT_TYPE_P2SH_P2WSH_MULTISIG": 2,
+}
+
+func (x VaultScriptType) String() string {
+	return proto.EnumName(VaultScriptType_name, int32(x))
+}
+
+func (VaultScriptType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_f1d33573cda8a6d2, []int{3}
+}
+
+// CoinSelectionStrategy defines the strategy to select the payment utxos
+type CoinSelectionStrategy int32
+
+const (
+	// Default: the minimum utxo first and then the largest ones
+	CoinSelectionStrategy_COIN_SELECTION_STRATEGY_DEFAULT CoinSelectionStrategy = 0
+	// Largest first
+	CoinSelectionStrategy_COIN_SELECTION_STRATEGY_LARGEST_FIRST CoinSelectionStrategy = 1
+	// Branch and bound, preferring the changeless selection
+	CoinSelectionStrategy_COIN_SELECTION_STRATEGY_BRANCH_AND_BOUND CoinSelectionStrategy = 2
+	// Fee-aware privacy, avoiding the change output and merging utxos where possible
+	CoinSelectionStrategy_COIN_SELECTION_STRATEGY_PRIVACY CoinSelectionStrategy = 3
+)
+
+var CoinSelectionStrategy_name = map[int32]string{
+	0: "COIN_SELECTION_STRATEGY_DEFAULT",
+	1: "COIN_SELECTION_STRATEGY_LARGEST_FIRST",
+	2: "COIN_SELECTION_STRATEGY_BRANCH_AND_BOUND",
+	3: "COIN_SELECTION_STRATEGY_PRIVACY",
+}
+
+var CoinSelectionStrategy_value = map[string]int32{
+	"COIN_SELECTION_STRATEGY_DEFAULT":          0,
+	"COIN_SELECTION_STRATEGY_LARGEST_FIRST":    1,
+	"COIN_SELECTION_STRATEGY_BRANCH_AND_BOUND": 2,
+	"COIN_SELECTION_STRATEGY_PRIVACY":          3,
+}
+
+func (x CoinSelectionStrategy) String() string {
+	return proto.EnumName(CoinSelectionStrategy_name, int32(x))
+}
+
+func (CoinSelectionStrategy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_f1d33573cda8a6d2, []int{4}
 }
 
 // Params defines the parameters for the module.
@@ -98,6 +228,20 @@ type Params struct {
 	ProtocolFees ProtocolFees `protobuf:"bytes,15,opt,name=protocol_fees,json=protocolFees,proto3" json:"protocol_fees"`
 	// TSS params
 	TssParams TSSParams `protobuf:"bytes,16,opt,name=tss_params,json=tssParams,proto3" json:"tss_params"`
+	// Per-rune configurations; the runes not configured are not allowed
+	RuneConfigs []RuneConfig `protobuf:"bytes,17,rep,name=rune_configs,json=runeConfigs,proto3" json:"rune_configs"`
+	// Withdrawal rate limit
+	WithdrawRateLimit WithdrawRateLimit `protobuf:"bytes,18,opt,name=withdraw_rate_limit,json=withdrawRateLimit,proto3" json:"withdraw_rate_limit"`
+	// Guardians allowed to pause the bridge in emergency, e.g. multisig or group policy accounts
+	Guardians []string `protobuf:"bytes,19,rep,name=guardians,proto3" json:"guardians,omitempty"`
+	// Maximum duration of the emergency pause by guardians
+	MaxPauseDuration time.Duration `protobuf:"bytes,20,opt,name=max_pause_duration,json=maxPauseDuration,proto3,stdduration" json:"max_pause_duration"`
+	// Automatic vault utxo consolidation policy
+	ConsolidationPolicy ConsolidationPolicy `protobuf:"bytes,21,opt,name=consolidation_policy,json=consolidationPolicy,proto3" json:"consolidation_policy"`
+	// Recovery policy of the vaults generated by DKG
+	VaultRecoveryPolicy VaultRecoveryPolicy `protobuf:"bytes,22,opt,name=vault_recovery_policy,json=vaultRecoveryPolicy,proto3" json:"vault_recovery_policy"`
+	// Strategy to assign the deposit address among the active vaults of the same asset type
+	VaultAssignmentStrategy VaultAssignmentStrategy `protobuf:"varint,23,opt,name=vault_assignment_strategy,json=vaultAssignmentStrategy,proto3,enum=side.btcbridge.VaultAssignmentStrategy" json:"vault_assignment_strategy,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -245,6 +389,55 @@ func (m *Params) GetTssParams() TSSParams {
 	return TSSParams{}
 }
 
+func (m *Params) GetRuneConfigs() []RuneConfig {
+	if m != nil {
+		return m.RuneConfigs
+	}
+	return nil
+}
+
+func (m *Params) GetWithdrawRateLimit() WithdrawRateLimit {
+	if m != nil {
+		return m.WithdrawRateLimit
+	}
+	return WithdrawRateLimit{}
+}
+
+func (m *Params) GetGuardians() []string {
+	if m != nil {
+		return m.Guardians
+	}
+	return nil
+}
+
+func (m *Params) GetMaxPauseDuration() time.Duration {
+	if m != nil {
+		return m.MaxPauseDuration
+	}
+	return 0
+}
+
+func (m *Params) GetConsolidationPolicy() ConsolidationPolicy {
+	if m != nil {
+		return m.ConsolidationPolicy
+	}
+	return ConsolidationPolicy{}
+}
+
+func (m *Params) GetVaultRecoveryPolicy() VaultRecoveryPolicy {
+	if m != nil {
+		return m.VaultRecoveryPolicy
+	}
+	return VaultRecoveryPolicy{}
+}
+
+func (m *Params) GetVaultAssignmentStrategy() VaultAssignmentStrategy {
+	if m != nil {
+		return m.VaultAssignmentStrategy
+	}
+	return VaultAssignmentStrategy_VAULT_ASSIGNMENT_STRATEGY_ROUND_ROBIN
+}
+
 // Vault defines the asset vault
 type Vault struct {
 	// the vault address for deposit
@@ -255,6 +448,14 @@ type Vault struct {
 	AssetType AssetType `protobuf:"varint,3,opt,name=asset_type,json=assetType,proto3,enum=side.btcbridge.AssetType" json:"asset_type,omitempty"`
 	// version
 	Version uint64 `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`
+	// script descriptor of the vault; nil for the single key vault, i.e. P2WPKH or P2TR
+	ScriptDescriptor *VaultDescriptor `protobuf:"bytes,5,opt,name=script_descriptor,json=scriptDescriptor,proto3" json:"script_descriptor,omitempty"`
+	// taproot script tree committed by the vault output besides the TSS key path; nil if no script path
+	TaprootTree *VaultTaprootTree `protobuf:"bytes,6,opt,name=taproot_tree,json=taprootTree,proto3" json:"taproot_tree,omitempty"`
+	// time after which the vault is retired and deposits are rejected; nil if not scheduled
+	RetirementTime *time.Time `protobuf:"bytes,7,opt,name=retirement_time,json=retirementTime,proto3,stdtime" json:"retirement_time,omitempty"`
+	// lifecycle state
+	State VaultState `protobuf:"varint,8,opt,name=state,proto3,enum=side.btcbridge.VaultState" json:"state,omitempty"`
 }
 
 func (m *Vault) Reset()         { *m = Vault{} }
@@ -318,6 +519,208 @@ func (m *Vault) GetVersion() uint64 {
 	return 0
 }
 
+func (m *Vault) GetScriptDescriptor() *VaultDescriptor {
+	if m != nil {
+		return m.ScriptDescriptor
+	}
+	return nil
+}
+
+func (m *Vault) GetTaprootTree() *VaultTaprootTree {
+	if m != nil {
+		return m.TaprootTree
+	}
+	return nil
+}
+
+func (m *Vault) GetRetirementTime() *time.Time {
+	if m != nil {
+		return m.RetirementTime
+	}
+	return nil
+}
+
+func (m *Vault) GetState() VaultState {
+	if m != nil {
+		return m.State
+	}
+	return VaultState_VAULT_STATE_ACTIVE
+}
+
+// VaultTaprootTree defines the taproot script tree of the vault
+type VaultTaprootTree struct {
+	// hex encoded tapscript of the timelocked recovery leaf
+	RecoveryScript string `protobuf:"bytes,1,opt,name=recovery_script,json=recoveryScript,proto3" json:"recovery_script,omitempty"`
+}
+
+func (m *VaultTaprootTree) Reset()         { *m = VaultTaprootTree{} }
+func (m *VaultTaprootTree) String() string { return proto.CompactTextString(m) }
+func (*VaultTaprootTree) ProtoMessage()    {}
+func (*VaultTaprootTree) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1d33573cda8a6d2, []int{2}
+}
+func (m *VaultTaprootTree) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VaultTaprootTree) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VaultTaprootTree.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VaultTaprootTree) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VaultTaprootTree.Merge(m, src)
+}
+func (m *VaultTaprootTree) XXX_Size() int {
+	return m.Size()
+}
+func (m *VaultTaprootTree) XXX_DiscardUnknown() {
+	xxx_messageInfo_VaultTaprootTree.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VaultTaprootTree proto.InternalMessageInfo
+
+func (m *VaultTaprootTree) GetRecoveryScript() string {
+	if m != nil {
+		return m.RecoveryScript
+	}
+	return ""
+}
+
+// VaultRecoveryPolicy defines the timelocked recovery script path committed by the vaults generated by DKG
+type VaultRecoveryPolicy struct {
+	// hex encoded x-only public keys of the governance cold key or multisig; empty means no recovery script path
+	PubKeys []string `protobuf:"bytes,1,rep,name=pub_keys,json=pubKeys,proto3" json:"pub_keys,omitempty"`
+	// number of the signatures required
+	Threshold uint32 `protobuf:"varint,2,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// relative timelock in blocks enforced by OP_CHECKSEQUENCEVERIFY
+	Timelock uint32 `protobuf:"varint,3,opt,name=timelock,proto3" json:"timelock,omitempty"`
+}
+
+func (m *VaultRecoveryPolicy) Reset()         { *m = VaultRecoveryPolicy{} }
+func (m *VaultRecoveryPolicy) String() string { return proto.CompactTextString(m) }
+func (*VaultRecoveryPolicy) ProtoMessage()    {}
+func (*VaultRecoveryPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1d33573cda8a6d2, []int{3}
+}
+func (m *VaultRecoveryPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VaultRecoveryPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VaultRecoveryPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VaultRecoveryPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VaultRecoveryPolicy.Merge(m, src)
+}
+func (m *VaultRecoveryPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *VaultRecoveryPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_VaultRecoveryPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VaultRecoveryPolicy proto.InternalMessageInfo
+
+func (m *VaultRecoveryPolicy) GetPubKeys() []string {
+	if m != nil {
+		return m.PubKeys
+	}
+	return nil
+}
+
+func (m *VaultRecoveryPolicy) GetThreshold() uint32 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
+func (m *VaultRecoveryPolicy) GetTimelock() uint32 {
+	if m != nil {
+		return m.Timelock
+	}
+	return 0
+}
+
+// VaultDescriptor defines the script descriptor of the script hash vault
+type VaultDescriptor struct {
+	// script type
+	ScriptType VaultScriptType `protobuf:"varint,1,opt,name=script_type,json=scriptType,proto3,enum=side.btcbridge.VaultScriptType" json:"script_type,omitempty"`
+	// number of the signatures required
+	Threshold uint32 `protobuf:"varint,2,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// hex encoded compressed public keys in the script order
+	PubKeys []string `protobuf:"bytes,3,rep,name=pub_keys,json=pubKeys,proto3" json:"pub_keys,omitempty"`
+}
+
+func (m *VaultDescriptor) Reset()         { *m = VaultDescriptor{} }
+func (m *VaultDescriptor) String() string { return proto.CompactTextString(m) }
+func (*VaultDescriptor) ProtoMessage()    {}
+func (*VaultDescriptor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1d33573cda8a6d2, []int{4}
+}
+func (m *VaultDescriptor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VaultDescriptor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VaultDescriptor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VaultDescriptor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VaultDescriptor.Merge(m, src)
+}
+func (m *VaultDescriptor) XXX_Size() int {
+	return m.Size()
+}
+func (m *VaultDescriptor) XXX_DiscardUnknown() {
+	xxx_messageInfo_VaultDescriptor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VaultDescriptor proto.InternalMessageInfo
+
+func (m *VaultDescriptor) GetScriptType() VaultScriptType {
+	if m != nil {
+		return m.ScriptType
+	}
+	return VaultScriptType_VAULT_SCRIPT_TYPE_UNSPECIFIED
+}
+
+func (m *VaultDescriptor) GetThreshold() uint32 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
+func (m *VaultDescriptor) GetPubKeys() []string {
+	if m != nil {
+		return m.PubKeys
+	}
+	return nil
+}
+
 type WithdrawParams struct {
 	// Maximum number of utxos used to build the signing request; O means unlimited
 	MaxUtxoNum uint32 `protobuf:"varint,1,opt,name=max_utxo_num,json=maxUtxoNum,proto3" json:"max_utxo_num,omitempty"`
@@ -325,13 +728,15 @@ type WithdrawParams struct {
 	BtcBatchWithdrawPeriod int64 `protobuf:"varint,2,opt,name=btc_batch_withdraw_period,json=btcBatchWithdrawPeriod,proto3" json:"btc_batch_withdraw_period,omitempty"`
 	// Maximum number of btc withdrawal requests to be handled per batch
 	MaxBtcBatchWithdrawNum uint32 `protobuf:"varint,3,opt,name=max_btc_batch_withdraw_num,json=maxBtcBatchWithdrawNum,proto3" json:"max_btc_batch_withdraw_num,omitempty"`
+	// Coin selection strategy used to select the payment utxos
+	CoinSelection CoinSelectionStrategy `protobuf:"varint,4,opt,name=coin_selection,json=coinSelection,proto3,enum=side.btcbridge.CoinSelectionStrategy" json:"coin_selection,omitempty"`
 }
 
 func (m *WithdrawParams) Reset()         { *m = WithdrawParams{} }
 func (m *WithdrawParams) String() string { return proto.CompactTextString(m) }
 func (*WithdrawParams) ProtoMessage()    {}
 func (*WithdrawParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f1d33573cda8a6d2, []int{2}
+	return fileDescriptor_f1d33573cda8a6d2, []int{5}
 }
 func (m *WithdrawParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -381,6 +786,87 @@ func (m *WithdrawParams) GetMaxBtcBatchWithdrawNum() uint32 {
 	return 0
 }
 
+func (m *WithdrawParams) GetCoinSelection() CoinSelectionStrategy {
+	if m != nil {
+		return m.CoinSelection
+	}
+	return CoinSelectionStrategy_COIN_SELECTION_STRATEGY_DEFAULT
+}
+
+// WithdrawRateLimit defines the rolling window outflow limits for withdrawals
+// The btc withdrawals are limited when batched and the other assets when requested
+type WithdrawRateLimit struct {
+	// Rolling window in blocks; 0 means no rate limit
+	Window int64 `protobuf:"varint,1,opt,name=window,proto3" json:"window,omitempty"`
+	// Maximum btc in sat leaving the vaults per window, including the network fees and the btc attached to non-btc assets; 0 means no limit
+	GlobalLimit int64 `protobuf:"varint,2,opt,name=global_limit,json=globalLimit,proto3" json:"global_limit,omitempty"`
+	// Maximum withdrawal amount per window for each asset; the assets not listed are not limited
+	AssetLimits github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=asset_limits,json=assetLimits,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"asset_limits"`
+	// High-water mark in basis points of the limits, reaching which trips the circuit breaker; 0 means no circuit breaker
+	BreakerThresholdBps uint32 `protobuf:"varint,4,opt,name=breaker_threshold_bps,json=breakerThresholdBps,proto3" json:"breaker_threshold_bps,omitempty"`
+}
+
+func (m *WithdrawRateLimit) Reset()         { *m = WithdrawRateLimit{} }
+func (m *WithdrawRateLimit) String() string { return proto.CompactTextString(m) }
+func (*WithdrawRateLimit) ProtoMessage()    {}
+func (*WithdrawRateLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1d33573cda8a6d2, []int{6}
+}
+func (m *WithdrawRateLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WithdrawRateLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WithdrawRateLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WithdrawRateLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WithdrawRateLimit.Merge(m, src)
+}
+func (m *WithdrawRateLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *WithdrawRateLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_WithdrawRateLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WithdrawRateLimit proto.InternalMessageInfo
+
+func (m *WithdrawRateLimit) GetWindow() int64 {
+	if m != nil {
+		return m.Window
+	}
+	return 0
+}
+
+func (m *WithdrawRateLimit) GetGlobalLimit() int64 {
+	if m != nil {
+		return m.GlobalLimit
+	}
+	return 0
+}
+
+func (m *WithdrawRateLimit) GetAssetLimits() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.AssetLimits
+	}
+	return nil
+}
+
+func (m *WithdrawRateLimit) GetBreakerThresholdBps() uint32 {
+	if m != nil {
+		return m.BreakerThresholdBps
+	}
+	return 0
+}
+
 // ProtocolLimits defines the params related to the the protocol limitations
 type ProtocolLimits struct {
 	// The minimum deposit amount for btc in sat
@@ -395,7 +881,7 @@ func (m *ProtocolLimits) Reset()         { *m = ProtocolLimits{} }
 func (m *ProtocolLimits) String() string { return proto.CompactTextString(m) }
 func (*ProtocolLimits) ProtoMessage()    {}
 func (*ProtocolLimits) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f1d33573cda8a6d2, []int{3}
+	return fileDescriptor_f1d33573cda8a6d2, []int{7}
 }
 func (m *ProtocolLimits) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -446,20 +932,25 @@ func (m *ProtocolLimits) GetBtcMaxWithdraw() int64 {
 }
 
 // ProtocolFees defines the params related to the protocol fees
+// The protocol fees are charged in the denom of the asset being deposited or withdrawn
 type ProtocolFees struct {
-	// Protocol fee amount for deposit in sat
-	DepositFee int64 `protobuf:"varint,1,opt,name=deposit_fee,json=depositFee,proto3" json:"deposit_fee,omitempty"`
-	// Protocol fee amount for withdrawal in sat
-	WithdrawFee int64 `protobuf:"varint,2,opt,name=withdraw_fee,json=withdrawFee,proto3" json:"withdraw_fee,omitempty"`
-	// Protocol fee collector
+	// Protocol fee collector which receives all the fees if the distribution weights are all zero
 	Collector string `protobuf:"bytes,3,opt,name=collector,proto3" json:"collector,omitempty"`
+	// Protocol fee schedule for deposit
+	// The floor and cap are in sat and only apply to btc unless overridden by the asset
+	DepositFeeSchedule FeeSchedule `protobuf:"bytes,4,opt,name=deposit_fee_schedule,json=depositFeeSchedule,proto3" json:"deposit_fee_schedule"`
+	// Protocol fee schedule for withdrawal
+	// The floor and cap are in sat and only apply to btc unless overridden by the asset
+	WithdrawFeeSchedule FeeSchedule `protobuf:"bytes,5,opt,name=withdraw_fee_schedule,json=withdrawFeeSchedule,proto3" json:"withdraw_fee_schedule"`
+	// Protocol fee distribution
+	Distribution FeeDistribution `protobuf:"bytes,6,opt,name=distribution,proto3" json:"distribution"`
 }
 
 func (m *ProtocolFees) Reset()         { *m = ProtocolFees{} }
 func (m *ProtocolFees) String() string { return proto.CompactTextString(m) }
 func (*ProtocolFees) ProtoMessage()    {}
 func (*ProtocolFees) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f1d33573cda8a6d2, []int{4}
+	return fileDescriptor_f1d33573cda8a6d2, []int{8}
 }
 func (m *ProtocolFees) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -488,40 +979,427 @@ func (m *ProtocolFees) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ProtocolFees proto.InternalMessageInfo
 
-func (m *ProtocolFees) GetDepositFee() int64 {
+func (m *ProtocolFees) GetCollector() string {
 	if m != nil {
-		return m.DepositFee
+		return m.Collector
 	}
-	return 0
+	return ""
+}
+
+func (m *ProtocolFees) GetDepositFeeSchedule() FeeSchedule {
+	if m != nil {
+		return m.DepositFeeSchedule
+	}
+	return FeeSchedule{}
+}
+
+func (m *ProtocolFees) GetWithdrawFeeSchedule() FeeSchedule {
+	if m != nil {
+		return m.WithdrawFeeSchedule
+	}
+	return FeeSchedule{}
+}
+
+func (m *ProtocolFees) GetDistribution() FeeDistribution {
+	if m != nil {
+		return m.Distribution
+	}
+	return FeeDistribution{}
+}
+
+// FeeDistribution defines the distribution of the accrued protocol fees
+// The fees are all distributed to the collector if the weights are all zero
+type FeeDistribution struct {
+	// Settlement period in blocks
+	Epoch int64 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// Weight of the community pool
+	CommunityPoolWeight uint32 `protobuf:"varint,2,opt,name=community_pool_weight,json=communityPoolWeight,proto3" json:"community_pool_weight,omitempty"`
+	// Weight of the relayer reward pool
+	RelayerPoolWeight uint32 `protobuf:"varint,3,opt,name=relayer_pool_weight,json=relayerPoolWeight,proto3" json:"relayer_pool_weight,omitempty"`
+	// Weight of the signer pool for the TSS participants
+	SignerPoolWeight uint32 `protobuf:"varint,4,opt,name=signer_pool_weight,json=signerPoolWeight,proto3" json:"signer_pool_weight,omitempty"`
+	// Weight of the insurance reserve
+	InsuranceWeight uint32 `protobuf:"varint,5,opt,name=insurance_weight,json=insuranceWeight,proto3" json:"insurance_weight,omitempty"`
+}
+
+func (m *FeeDistribution) Reset()         { *m = FeeDistribution{} }
+func (m *FeeDistribution) String() string { return proto.CompactTextString(m) }
+func (*FeeDistribution) ProtoMessage()    {}
+func (*FeeDistribution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1d33573cda8a6d2, []int{9}
+}
+func (m *FeeDistribution) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeDistribution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeDistribution.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeDistribution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeDistribution.Merge(m, src)
+}
+func (m *FeeDistribution) XXX_Size() int {
+	return m.Size()
 }
+func (m *FeeDistribution) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeDistribution.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeDistribution proto.InternalMessageInfo
 
-func (m *ProtocolFees) GetWithdrawFee() int64 {
+func (m *FeeDistribution) GetEpoch() int64 {
 	if m != nil {
-		return m.WithdrawFee
+		return m.Epoch
 	}
 	return 0
 }
 
-func (m *ProtocolFees) GetCollector() string {
+func (m *FeeDistribution) GetCommunityPoolWeight() uint32 {
 	if m != nil {
-		return m.Collector
+		return m.CommunityPoolWeight
+	}
+	return 0
+}
+
+func (m *FeeDistribution) GetRelayerPoolWeight() uint32 {
+	if m != nil {
+		return m.RelayerPoolWeight
+	}
+	return 0
+}
+
+func (m *FeeDistribution) GetSignerPoolWeight() uint32 {
+	if m != nil {
+		return m.SignerPoolWeight
+	}
+	return 0
+}
+
+func (m *FeeDistribution) GetInsuranceWeight() uint32 {
+	if m != nil {
+		return m.InsuranceWeight
+	}
+	return 0
+}
+
+// FeeSchedule defines the percentage-based fee with the floor, cap and optional volume tiers
+// The amounts are in the smallest unit of the asset
+type FeeSchedule struct {
+	// Fee rate in basis points
+	Bps uint32 `protobuf:"varint,1,opt,name=bps,proto3" json:"bps,omitempty"`
+	// The minimum fee amount
+	MinFee string `protobuf:"bytes,2,opt,name=min_fee,json=minFee,proto3" json:"min_fee,omitempty"`
+	// The maximum fee amount; empty or zero means no cap
+	MaxFee string `protobuf:"bytes,3,opt,name=max_fee,json=maxFee,proto3" json:"max_fee,omitempty"`
+	// Volume tiers in ascending order of the minimum amount
+	// The fee rate of the highest tier reached by the amount takes precedence
+	Tiers []FeeTier `protobuf:"bytes,4,rep,name=tiers,proto3" json:"tiers"`
+}
+
+func (m *FeeSchedule) Reset()         { *m = FeeSchedule{} }
+func (m *FeeSchedule) String() string { return proto.CompactTextString(m) }
+func (*FeeSchedule) ProtoMessage()    {}
+func (*FeeSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1d33573cda8a6d2, []int{10}
+}
+func (m *FeeSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeSchedule.Merge(m, src)
+}
+func (m *FeeSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeSchedule proto.InternalMessageInfo
+
+func (m *FeeSchedule) GetBps() uint32 {
+	if m != nil {
+		return m.Bps
+	}
+	return 0
+}
+
+func (m *FeeSchedule) GetMinFee() string {
+	if m != nil {
+		return m.MinFee
+	}
+	return ""
+}
+
+func (m *FeeSchedule) GetMaxFee() string {
+	if m != nil {
+		return m.MaxFee
+	}
+	return ""
+}
+
+func (m *FeeSchedule) GetTiers() []FeeTier {
+	if m != nil {
+		return m.Tiers
+	}
+	return nil
+}
+
+// FeeTier defines the fee rate applied to the amount not less than the given minimum amount
+type FeeTier struct {
+	// The minimum amount to reach the tier
+	MinAmount string `protobuf:"bytes,1,opt,name=min_amount,json=minAmount,proto3" json:"min_amount,omitempty"`
+	// Fee rate in basis points
+	Bps uint32 `protobuf:"varint,2,opt,name=bps,proto3" json:"bps,omitempty"`
+}
+
+func (m *FeeTier) Reset()         { *m = FeeTier{} }
+func (m *FeeTier) String() string { return proto.CompactTextString(m) }
+func (*FeeTier) ProtoMessage()    {}
+func (*FeeTier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1d33573cda8a6d2, []int{11}
+}
+func (m *FeeTier) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeTier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeTier.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeTier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeTier.Merge(m, src)
+}
+func (m *FeeTier) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeTier) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeTier.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeTier proto.InternalMessageInfo
+
+func (m *FeeTier) GetMinAmount() string {
+	if m != nil {
+		return m.MinAmount
+	}
+	return ""
+}
+
+func (m *FeeTier) GetBps() uint32 {
+	if m != nil {
+		return m.Bps
+	}
+	return 0
+}
+
+// RuneConfig defines the governance controlled configuration for the given rune
+// The amounts are in the smallest unit of the rune; empty or zero means no limit
+type RuneConfig struct {
+	// rune id
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Indicates if deposit and withdrawal are enabled for the rune
+	Enabled bool `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// The minimum deposit amount
+	MinDeposit string `protobuf:"bytes,3,opt,name=min_deposit,json=minDeposit,proto3" json:"min_deposit,omitempty"`
+	// The minimum withdrawal amount
+	MinWithdraw string `protobuf:"bytes,4,opt,name=min_withdraw,json=minWithdraw,proto3" json:"min_withdraw,omitempty"`
+	// The maximum withdrawal amount
+	MaxWithdraw string `protobuf:"bytes,5,opt,name=max_withdraw,json=maxWithdraw,proto3" json:"max_withdraw,omitempty"`
+	// The maximum supply of the voucher token
+	SupplyCap string `protobuf:"bytes,6,opt,name=supply_cap,json=supplyCap,proto3" json:"supply_cap,omitempty"`
+	// Protocol fees overriding the default protocol fees; nil means no override
+	FeeOverride *RuneFees `protobuf:"bytes,7,opt,name=fee_override,json=feeOverride,proto3" json:"fee_override,omitempty"`
+}
+
+func (m *RuneConfig) Reset()         { *m = RuneConfig{} }
+func (m *RuneConfig) String() string { return proto.CompactTextString(m) }
+func (*RuneConfig) ProtoMessage()    {}
+func (*RuneConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1d33573cda8a6d2, []int{12}
+}
+func (m *RuneConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RuneConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RuneConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RuneConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RuneConfig.Merge(m, src)
+}
+func (m *RuneConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *RuneConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_RuneConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RuneConfig proto.InternalMessageInfo
+
+func (m *RuneConfig) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *RuneConfig) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *RuneConfig) GetMinDeposit() string {
+	if m != nil {
+		return m.MinDeposit
+	}
+	return ""
+}
+
+func (m *RuneConfig) GetMinWithdraw() string {
+	if m != nil {
+		return m.MinWithdraw
+	}
+	return ""
+}
+
+func (m *RuneConfig) GetMaxWithdraw() string {
+	if m != nil {
+		return m.MaxWithdraw
+	}
+	return ""
+}
+
+func (m *RuneConfig) GetSupplyCap() string {
+	if m != nil {
+		return m.SupplyCap
 	}
 	return ""
 }
 
+func (m *RuneConfig) GetFeeOverride() *RuneFees {
+	if m != nil {
+		return m.FeeOverride
+	}
+	return nil
+}
+
+// RuneFees defines the protocol fees for the given rune
+// The amounts of the fee schedules are in the smallest unit of the rune
+type RuneFees struct {
+	// Protocol fee schedule for deposit
+	DepositFeeSchedule FeeSchedule `protobuf:"bytes,1,opt,name=deposit_fee_schedule,json=depositFeeSchedule,proto3" json:"deposit_fee_schedule"`
+	// Protocol fee schedule for withdrawal
+	WithdrawFeeSchedule FeeSchedule `protobuf:"bytes,2,opt,name=withdraw_fee_schedule,json=withdrawFeeSchedule,proto3" json:"withdraw_fee_schedule"`
+}
+
+func (m *RuneFees) Reset()         { *m = RuneFees{} }
+func (m *RuneFees) String() string { return proto.CompactTextString(m) }
+func (*RuneFees) ProtoMessage()    {}
+func (*RuneFees) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1d33573cda8a6d2, []int{13}
+}
+func (m *RuneFees) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RuneFees) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RuneFees.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RuneFees) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RuneFees.Merge(m, src)
+}
+func (m *RuneFees) XXX_Size() int {
+	return m.Size()
+}
+func (m *RuneFees) XXX_DiscardUnknown() {
+	xxx_messageInfo_RuneFees.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RuneFees proto.InternalMessageInfo
+
+func (m *RuneFees) GetDepositFeeSchedule() FeeSchedule {
+	if m != nil {
+		return m.DepositFeeSchedule
+	}
+	return FeeSchedule{}
+}
+
+func (m *RuneFees) GetWithdrawFeeSchedule() FeeSchedule {
+	if m != nil {
+		return m.WithdrawFeeSchedule
+	}
+	return FeeSchedule{}
+}
+
 // TSSParams defines the params related to TSS
 type TSSParams struct {
 	// Timeout duration for DKG request
 	DkgTimeoutPeriod time.Duration `protobuf:"bytes,1,opt,name=dkg_timeout_period,json=dkgTimeoutPeriod,proto3,stdduration" json:"dkg_timeout_period"`
 	// Transition period after which TSS participants update process is completed
 	ParticipantUpdateTransitionPeriod time.Duration `protobuf:"bytes,2,opt,name=participant_update_transition_period,json=participantUpdateTransitionPeriod,proto3,stdduration" json:"participant_update_transition_period"`
+	// Maximum number of the automatic retries of the timed out DKG request without the non-responders; 0 means no retry
+	DkgMaxRetries uint32 `protobuf:"varint,3,opt,name=dkg_max_retries,json=dkgMaxRetries,proto3" json:"dkg_max_retries,omitempty"`
+	// Indicates if the new DKG is initiated automatically when the bonded validator set changes
+	AutoRotationEnabled bool `protobuf:"varint,4,opt,name=auto_rotation_enabled,json=autoRotationEnabled,proto3" json:"auto_rotation_enabled,omitempty"`
+	// Maximum number of the top bonded validators as the TSS participants; 0 means the number of the current participants
+	MaxParticipants uint32 `protobuf:"varint,5,opt,name=max_participants,json=maxParticipants,proto3" json:"max_participants,omitempty"`
+	// Stake shift in basis points of the top validators power which triggers the rotation; 0 means only the leaving participants trigger
+	StakeShiftThresholdBps uint32 `protobuf:"varint,6,opt,name=stake_shift_threshold_bps,json=stakeShiftThresholdBps,proto3" json:"stake_shift_threshold_bps,omitempty"`
+	// Liveness params of the TSS participants
+	SignerLiveness SignerLivenessParams `protobuf:"bytes,7,opt,name=signer_liveness,json=signerLiveness,proto3" json:"signer_liveness"`
 }
 
 func (m *TSSParams) Reset()         { *m = TSSParams{} }
 func (m *TSSParams) String() string { return proto.CompactTextString(m) }
 func (*TSSParams) ProtoMessage()    {}
 func (*TSSParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f1d33573cda8a6d2, []int{5}
+	return fileDescriptor_f1d33573cda8a6d2, []int{14}
 }
 func (m *TSSParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -564,118 +1442,582 @@ func (m *TSSParams) GetParticipantUpdateTransitionPeriod() time.Duration {
 	return 0
 }
 
-func init() {
-	proto.RegisterEnum("side.btcbridge.AssetType", AssetType_name, AssetType_value)
-	proto.RegisterType((*Params)(nil), "side.btcbridge.Params")
-	proto.RegisterType((*Vault)(nil), "side.btcbridge.Vault")
-	proto.RegisterType((*WithdrawParams)(nil), "side.btcbridge.WithdrawParams")
-	proto.RegisterType((*ProtocolLimits)(nil), "side.btcbridge.ProtocolLimits")
-	proto.RegisterType((*ProtocolFees)(nil), "side.btcbridge.ProtocolFees")
-	proto.RegisterType((*TSSParams)(nil), "side.btcbridge.TSSParams")
+func (m *TSSParams) GetDkgMaxRetries() uint32 {
+	if m != nil {
+		return m.DkgMaxRetries
+	}
+	return 0
 }
 
-func init() { proto.RegisterFile("side/btcbridge/params.proto", fileDescriptor_f1d33573cda8a6d2) }
-
-var fileDescriptor_f1d33573cda8a6d2 = []byte{
-	// 1027 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0xcf, 0x6e, 0xe3, 0x44,
-	0x18, 0xaf, 0x37, 0xfd, 0xe7, 0x69, 0x9b, 0x86, 0xa1, 0xed, 0xba, 0xdd, 0x55, 0x9a, 0x8d, 0x10,
-	0x84, 0x95, 0x48, 0x76, 0xcb, 0x81, 0xbf, 0x5a, 0xa9, 0x69, 0x53, 0x58, 0xc1, 0x56, 0xc1, 0x49,
-	0x8b, 0xe0, 0x32, 0x1a, 0xdb, 0x5f, 0xd2, 0x51, 0x63, 0x8f, 0xe5, 0x19, 0xa7, 0xc9, 0x3b, 0x20,
-	0xc4, 0x11, 0x1e, 0x81, 0x37, 0xd9, 0xe3, 0x1e, 0x38, 0x70, 0x02, 0xd4, 0xbe, 0x08, 0x9a, 0xb1,
-	0xc7, 0x4d, 0xba, 0x45, 0xe2, 0x66, 0xff, 0xfe, 0x7d, 0x63, 0xcf, 0xf7, 0xcd, 0xa0, 0x47, 0x82,
-	0x05, 0xd0, 0xf2, 0xa4, 0xef, 0x25, 0x2c, 0x18, 0x42, 0x2b, 0xa6, 0x09, 0x0d, 0x45, 0x33, 0x4e,
-	0xb8, 0xe4, 0xb8, 0xac, 0xc8, 0x66, 0x41, 0xee, 0x6d, 0x0d, 0xf9, 0x90, 0x6b, 0xaa, 0xa5, 0x9e,
-	0x32, 0xd5, 0x5e, 0x75, 0xc8, 0xf9, 0x70, 0x04, 0x2d, 0xfd, 0xe6, 0xa5, 0x83, 0x56, 0x90, 0x26,
-	0x54, 0x32, 0x1e, 0x19, 0xde, 0xe7, 0x22, 0xe4, 0xa2, 0xe5, 0x51, 0x01, 0xad, 0xf1, 0x73, 0x0f,
-	0x24, 0x7d, 0xde, 0xf2, 0x39, 0xcb, 0xf9, 0xfa, 0x6f, 0x2b, 0x68, 0xb9, 0xab, 0xcb, 0xe2, 0x2f,
-	0xd1, 0x5e, 0x00, 0x31, 0x17, 0x4c, 0x12, 0x9f, 0x47, 0x03, 0x96, 0x84, 0x3a, 0x88, 0x04, 0x10,
-	0xcb, 0x0b, 0xc7, 0xaa, 0x59, 0x8d, 0x25, 0xd7, 0xc9, 0x15, 0x47, 0x33, 0x82, 0x63, 0xc5, 0xe3,
-	0x17, 0xe8, 0xd1, 0x15, 0x93, 0x17, 0x41, 0x42, 0xaf, 0xee, 0xb3, 0x3f, 0xd0, 0xf6, 0x5d, 0x23,
-	0x79, 0xdb, 0xff, 0x3e, 0xda, 0x0c, 0xe9, 0x84, 0x24, 0xc0, 0x93, 0x61, 0xee, 0x29, 0x69, 0xcf,
-	0x46, 0x48, 0x27, 0xae, 0x42, 0x33, 0xdd, 0x17, 0x68, 0x4f, 0xe9, 0xa8, 0xef, 0x43, 0x2c, 0xa9,
-	0x37, 0x02, 0xe2, 0x8d, 0xb8, 0x7f, 0x99, 0x5b, 0x16, 0x6b, 0x56, 0x63, 0xd1, 0x7d, 0x18, 0xd2,
-	0xc9, 0x61, 0x21, 0x68, 0x2b, 0x3e, 0x33, 0x3f, 0x45, 0xef, 0x78, 0xd2, 0x27, 0x63, 0x9e, 0xfa,
-	0x17, 0x90, 0x90, 0x00, 0x22, 0x1e, 0x3a, 0x4b, 0x35, 0xab, 0x61, 0xbb, 0x9b, 0x9e, 0xf4, 0xcf,
-	0x33, 0xfc, 0x58, 0xc1, 0xf8, 0x03, 0xb4, 0x69, 0x7e, 0x07, 0x44, 0x2a, 0x27, 0x70, 0x96, 0x6b,
-	0x56, 0x63, 0xd5, 0x2d, 0xe7, 0x70, 0x27, 0x43, 0xf1, 0x87, 0xa8, 0x52, 0x7c, 0xb9, 0x51, 0xae,
-	0x68, 0xe5, 0xa6, 0xc1, 0x8d, 0xf4, 0x19, 0xda, 0x92, 0x49, 0x2a, 0x24, 0x04, 0x44, 0xad, 0x23,
-	0x81, 0x11, 0x9d, 0x42, 0x22, 0x9c, 0xd5, 0x5a, 0xa9, 0x61, 0xbb, 0x38, 0xe7, 0xda, 0xd2, 0x77,
-	0x73, 0x06, 0x7f, 0x82, 0x1c, 0xe3, 0x88, 0x78, 0x34, 0xef, 0xb2, 0xb5, 0x6b, 0x3b, 0xe7, 0x4f,
-	0x79, 0x34, 0x6b, 0x3c, 0x40, 0x86, 0x20, 0x03, 0x00, 0x12, 0x27, 0x7c, 0xcc, 0x02, 0xe5, 0x42,
-	0xda, 0xf5, 0x6e, 0x4e, 0x9e, 0x00, 0x74, 0x0d, 0xa5, 0x8a, 0x29, 0x6d, 0x42, 0x25, 0x90, 0x31,
-	0x1d, 0xb1, 0x80, 0xc9, 0x29, 0x89, 0x21, 0x61, 0x3c, 0x70, 0xd6, 0x6a, 0x56, 0xa3, 0xe4, 0x6e,
-	0x0f, 0x00, 0x5c, 0x2a, 0xe1, 0x3c, 0x67, 0xbb, 0x9a, 0xc4, 0x1f, 0xa1, 0xe5, 0x31, 0x4d, 0x47,
-	0x52, 0x38, 0xeb, 0xb5, 0x52, 0x63, 0xed, 0x60, 0xbb, 0x39, 0xdf, 0xbc, 0xcd, 0x73, 0xc5, 0xba,
-	0xb9, 0x08, 0xbf, 0x42, 0xc5, 0x9f, 0x21, 0x59, 0xcf, 0x3b, 0x1b, 0x35, 0xab, 0xb1, 0x76, 0x50,
-	0xbd, 0xeb, 0xfb, 0x3e, 0x97, 0x65, 0x2d, 0xda, 0x5e, 0x7c, 0xfd, 0xd7, 0xfe, 0x82, 0x5b, 0xbe,
-	0x9a, 0x43, 0x55, 0x9c, 0x6e, 0x66, 0x9f, 0x8f, 0xc8, 0x88, 0x85, 0x4c, 0x0a, 0xa7, 0x7c, 0x7f,
-	0x5c, 0x37, 0x97, 0x7d, 0xab, 0x55, 0x26, 0x2e, 0x9e, 0x43, 0xf1, 0x57, 0x68, 0xa3, 0x88, 0x1b,
-	0x00, 0x08, 0x67, 0x53, 0x87, 0x3d, 0xfe, 0xaf, 0xb0, 0x13, 0x00, 0x13, 0xb5, 0x1e, 0xcf, 0x60,
-	0xf8, 0x05, 0x42, 0x52, 0x08, 0xf3, 0x85, 0x15, 0x9d, 0xb2, 0x7b, 0x37, 0xa5, 0xdf, 0xeb, 0xcd,
-	0x7d, 0x9c, 0x2d, 0x85, 0xc8, 0x80, 0xfa, 0xcf, 0x16, 0x5a, 0xd2, 0x3f, 0x0e, 0x3b, 0x68, 0x85,
-	0x06, 0x41, 0x02, 0x42, 0xe8, 0x39, 0xb4, 0x5d, 0xf3, 0x8a, 0x1f, 0xa2, 0x95, 0x38, 0xf5, 0xc8,
-	0x25, 0x4c, 0xf5, 0x88, 0xd9, 0xee, 0x72, 0x9c, 0x7a, 0xdf, 0xc0, 0x14, 0x7f, 0x8a, 0x10, 0x15,
-	0x02, 0x24, 0x91, 0xd3, 0x18, 0xf4, 0x28, 0x95, 0xdf, 0x2e, 0x7e, 0xa8, 0x14, 0xfd, 0x69, 0x0c,
-	0xae, 0x4d, 0xcd, 0xa3, 0x2a, 0x36, 0x86, 0x44, 0x30, 0x1e, 0xe5, 0xe3, 0x64, 0x5e, 0xeb, 0xbf,
-	0x5b, 0xa8, 0x3c, 0xbf, 0x23, 0xb8, 0x86, 0xd6, 0xd5, 0x38, 0xa6, 0x72, 0xc2, 0x49, 0x94, 0x86,
-	0x7a, 0x79, 0x1b, 0x2e, 0x0a, 0xe9, 0xe4, 0x4c, 0x4e, 0xf8, 0x69, 0x1a, 0xe2, 0xcf, 0xd0, 0xae,
-	0xea, 0x5a, 0x8f, 0x4a, 0xff, 0x82, 0xdc, 0x6e, 0x7b, 0xd6, 0x55, 0x0f, 0x74, 0x57, 0xed, 0x78,
-	0xd2, 0x6f, 0x2b, 0xbe, 0x08, 0xcf, 0xda, 0xea, 0xf3, 0x6c, 0xd6, 0xef, 0xb1, 0xab, 0x52, 0x25,
-	0x5d, 0x6a, 0x27, 0xa4, 0x93, 0xf6, 0x1d, 0xfb, 0x69, 0x1a, 0xd6, 0x7f, 0xb2, 0x50, 0x79, 0x7e,
-	0xbb, 0xd5, 0x11, 0xa3, 0xa2, 0x42, 0xa6, 0x0f, 0x25, 0x35, 0xc2, 0x7a, 0xb9, 0x25, 0x77, 0xc3,
-	0x93, 0xfe, 0x2b, 0xa6, 0x0e, 0x22, 0x05, 0xe2, 0x06, 0xaa, 0x18, 0x9d, 0x29, 0x98, 0x2f, 0xb4,
-	0x9c, 0x09, 0x4d, 0x9d, 0x42, 0x49, 0x27, 0xb7, 0xca, 0xd2, 0xad, 0x92, 0x4e, 0x8c, 0xb2, 0x1e,
-	0xa3, 0xf5, 0xd9, 0x7e, 0xc1, 0xfb, 0x68, 0xcd, 0x9c, 0x2e, 0x03, 0x80, 0x7c, 0x1d, 0x28, 0x87,
-	0x4e, 0x00, 0xf0, 0x13, 0xb4, 0x5e, 0x7c, 0xad, 0x52, 0x64, 0x0b, 0x58, 0x33, 0x98, 0x92, 0x3c,
-	0x46, 0xb6, 0xcf, 0x47, 0x23, 0xf0, 0x25, 0x4f, 0x74, 0x59, 0xdb, 0xbd, 0x05, 0xea, 0x7f, 0x58,
-	0xc8, 0x2e, 0x9a, 0x0b, 0x7f, 0x87, 0x70, 0x70, 0x39, 0x24, 0x92, 0x85, 0xc0, 0x53, 0x69, 0x7e,
-	0xbf, 0x95, 0xf7, 0x64, 0x76, 0x89, 0x34, 0xcd, 0x25, 0xd2, 0x3c, 0xce, 0x2f, 0x91, 0xf6, 0xaa,
-	0xea, 0xc9, 0x5f, 0xff, 0xde, 0xb7, 0xdc, 0x4a, 0x70, 0x39, 0xec, 0x67, 0xee, 0x7c, 0x77, 0x24,
-	0x7a, 0x2f, 0xa6, 0x89, 0x64, 0x3e, 0x8b, 0x69, 0x24, 0x49, 0x1a, 0x07, 0xea, 0xdc, 0x90, 0x09,
-	0x8d, 0x04, 0xd3, 0x27, 0xff, 0xcc, 0x1e, 0xff, 0xcf, 0x22, 0x4f, 0x66, 0x02, 0xcf, 0x74, 0x5e,
-	0xbf, 0x88, 0xcb, 0xaa, 0x3e, 0x1d, 0x22, 0xbb, 0xe8, 0x5a, 0xbc, 0x87, 0x76, 0x0e, 0x7b, 0xbd,
-	0x4e, 0x9f, 0xf4, 0x7f, 0xe8, 0x76, 0xc8, 0xd9, 0x69, 0xaf, 0xdb, 0x39, 0x7a, 0x79, 0xf2, 0xb2,
-	0x73, 0x5c, 0x59, 0xc0, 0x18, 0x95, 0x67, 0xb8, 0x76, 0xff, 0xa8, 0x62, 0xe1, 0x2d, 0x54, 0x99,
-	0xc5, 0xdc, 0xa3, 0x83, 0x67, 0x95, 0x07, 0x77, 0x50, 0xf7, 0xec, 0xb4, 0xd3, 0xab, 0x94, 0xda,
-	0x5f, 0xbf, 0xbe, 0xae, 0x5a, 0x6f, 0xae, 0xab, 0xd6, 0x3f, 0xd7, 0x55, 0xeb, 0x97, 0x9b, 0xea,
-	0xc2, 0x9b, 0x9b, 0xea, 0xc2, 0x9f, 0x37, 0xd5, 0x85, 0x1f, 0x9b, 0x43, 0x26, 0x2f, 0x52, 0xaf,
-	0xe9, 0xf3, 0xb0, 0xa5, 0x06, 0xca, 0x0c, 0xbd, 0x7e, 0x69, 0x4d, 0x66, 0x2e, 0x74, 0x35, 0x7b,
-	0xc2, 0x5b, 0xd6, 0x82, 0x8f, 0xff, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x1a, 0xcb, 0xe3, 0x5e, 0xef,
-	0x07, 0x00, 0x00,
+func (m *TSSParams) GetAutoRotationEnabled() bool {
+	if m != nil {
+		return m.AutoRotationEnabled
+	}
+	return false
 }
 
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *TSSParams) GetMaxParticipants() uint32 {
+	if m != nil {
+		return m.MaxParticipants
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *TSSParams) GetStakeShiftThresholdBps() uint32 {
+	if m != nil {
+		return m.StakeShiftThresholdBps
+	}
+	return 0
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.TssParams.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintParams(dAtA, i, uint64(size))
+func (m *TSSParams) GetSignerLiveness() SignerLivenessParams {
+	if m != nil {
+		return m.SignerLiveness
 	}
+	return SignerLivenessParams{}
+}
+
+// SignerLivenessParams defines the params for the liveness tracking of the TSS participants
+type SignerLivenessParams struct {
+	// Sliding window in blocks in which the missed DKG completions and signing rounds are counted; 0 means disabled
+	Window int64 `protobuf:"varint,1,opt,name=window,proto3" json:"window,omitempty"`
+	// Maximum number of the missed DKG completions in the window; 0 means no limit
+	MaxMissedDkgs uint32 `protobuf:"varint,2,opt,name=max_missed_dkgs,json=maxMissedDkgs,proto3" json:"max_missed_dkgs,omitempty"`
+	// Maximum number of the missed signing rounds in the window; 0 means no limit
+	MaxMissedSignings uint32 `protobuf:"varint,3,opt,name=max_missed_signings,json=maxMissedSignings,proto3" json:"max_missed_signings,omitempty"`
+	// Fraction in basis points of the stake slashed when the participant exceeds the limits; 0 means no slashing
+	SlashFractionBps uint32 `protobuf:"varint,4,opt,name=slash_fraction_bps,json=slashFractionBps,proto3" json:"slash_fraction_bps,omitempty"`
+	// Jail duration of the participant exceeding the limits; 0 means no jailing
+	JailDuration time.Duration `protobuf:"bytes,5,opt,name=jail_duration,json=jailDuration,proto3,stdduration" json:"jail_duration"`
+	// Timeout of the signing round after which the non-responding signers are considered missed; 0 means no timeout
+	SigningRoundTimeout time.Duration `protobuf:"bytes,6,opt,name=signing_round_timeout,json=signingRoundTimeout,proto3,stdduration" json:"signing_round_timeout"`
+}
+
+func (m *SignerLivenessParams) Reset()         { *m = SignerLivenessParams{} }
+func (m *SignerLivenessParams) String() string { return proto.CompactTextString(m) }
+func (*SignerLivenessParams) ProtoMessage()    {}
+func (*SignerLivenessParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1d33573cda8a6d2, []int{15}
+}
+func (m *SignerLivenessParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SignerLivenessParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SignerLivenessParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SignerLivenessParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignerLivenessParams.Merge(m, src)
+}
+func (m *SignerLivenessParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *SignerLivenessParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignerLivenessParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignerLivenessParams proto.InternalMessageInfo
+
+func (m *SignerLivenessParams) GetWindow() int64 {
+	if m != nil {
+		return m.Window
+	}
+	return 0
+}
+
+func (m *SignerLivenessParams) GetMaxMissedDkgs() uint32 {
+	if m != nil {
+		return m.MaxMissedDkgs
+	}
+	return 0
+}
+
+func (m *SignerLivenessParams) GetMaxMissedSignings() uint32 {
+	if m != nil {
+		return m.MaxMissedSignings
+	}
+	return 0
+}
+
+func (m *SignerLivenessParams) GetSlashFractionBps() uint32 {
+	if m != nil {
+		return m.SlashFractionBps
+	}
+	return 0
+}
+
+func (m *SignerLivenessParams) GetJailDuration() time.Duration {
+	if m != nil {
+		return m.JailDuration
+	}
+	return 0
+}
+
+func (m *SignerLivenessParams) GetSigningRoundTimeout() time.Duration {
+	if m != nil {
+		return m.SigningRoundTimeout
+	}
+	return 0
+}
+
+// ConsolidationPolicy defines the policy for the automatic vault utxo consolidation in EndBlocker
+// The policy applies to the vaults of the latest version
+type ConsolidationPolicy struct {
+	// Number of the unlocked vault utxos to trigger the consolidation; 0 means disabled
+	UtxoNumTrigger uint32 `protobuf:"varint,1,opt,name=utxo_num_trigger,json=utxoNumTrigger,proto3" json:"utxo_num_trigger,omitempty"`
+	// Maximum network fee rate in sat/vbyte allowed for the consolidation; 0 means no ceiling
+	MaxFeeRate int64 `protobuf:"varint,2,opt,name=max_fee_rate,json=maxFeeRate,proto3" json:"max_fee_rate,omitempty"`
+	// Maximum threshold of the btc value of the utxos to be consolidated; 0 means the btc vault is not consolidated
+	BtcTargetThreshold int64 `protobuf:"varint,3,opt,name=btc_target_threshold,json=btcTargetThreshold,proto3" json:"btc_target_threshold,omitempty"`
+	// Runes to be consolidated with the maximum threshold of the rune balance
+	RuneThresholds []RuneConsolidationThreshold `protobuf:"bytes,4,rep,name=rune_thresholds,json=runeThresholds,proto3" json:"rune_thresholds"`
+	// Maximum number of the utxos to be consolidated per run; 0 means the maximum utxo number of the withdrawal params
+	MaxNum uint32 `protobuf:"varint,5,opt,name=max_num,json=maxNum,proto3" json:"max_num,omitempty"`
+	// Minimum number of blocks between two runs
+	Cooldown int64 `protobuf:"varint,6,opt,name=cooldown,proto3" json:"cooldown,omitempty"`
+}
+
+func (m *ConsolidationPolicy) Reset()         { *m = ConsolidationPolicy{} }
+func (m *ConsolidationPolicy) String() string { return proto.CompactTextString(m) }
+func (*ConsolidationPolicy) ProtoMessage()    {}
+func (*ConsolidationPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1d33573cda8a6d2, []int{16}
+}
+func (m *ConsolidationPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsolidationPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsolidationPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConsolidationPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsolidationPolicy.Merge(m, src)
+}
+func (m *ConsolidationPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsolidationPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsolidationPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConsolidationPolicy proto.InternalMessageInfo
+
+func (m *ConsolidationPolicy) GetUtxoNumTrigger() uint32 {
+	if m != nil {
+		return m.UtxoNumTrigger
+	}
+	return 0
+}
+
+func (m *ConsolidationPolicy) GetMaxFeeRate() int64 {
+	if m != nil {
+		return m.MaxFeeRate
+	}
+	return 0
+}
+
+func (m *ConsolidationPolicy) GetBtcTargetThreshold() int64 {
+	if m != nil {
+		return m.BtcTargetThreshold
+	}
+	return 0
+}
+
+func (m *ConsolidationPolicy) GetRuneThresholds() []RuneConsolidationThreshold {
+	if m != nil {
+		return m.RuneThresholds
+	}
+	return nil
+}
+
+func (m *ConsolidationPolicy) GetMaxNum() uint32 {
+	if m != nil {
+		return m.MaxNum
+	}
+	return 0
+}
+
+func (m *ConsolidationPolicy) GetCooldown() int64 {
+	if m != nil {
+		return m.Cooldown
+	}
+	return 0
+}
+
+// RuneConsolidationThreshold defines the target threshold for the automatic runes consolidation
+type RuneConsolidationThreshold struct {
+	// rune id
+	RuneId string `protobuf:"bytes,1,opt,name=rune_id,json=runeId,proto3" json:"rune_id,omitempty"`
+	// maximum threshold of the corresponding rune balance
+	TargetThreshold string `protobuf:"bytes,2,opt,name=target_threshold,json=targetThreshold,proto3" json:"target_threshold,omitempty"`
+}
+
+func (m *RuneConsolidationThreshold) Reset()         { *m = RuneConsolidationThreshold{} }
+func (m *RuneConsolidationThreshold) String() string { return proto.CompactTextString(m) }
+func (*RuneConsolidationThreshold) ProtoMessage()    {}
+func (*RuneConsolidationThreshold) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1d33573cda8a6d2, []int{17}
+}
+func (m *RuneConsolidationThreshold) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RuneConsolidationThreshold) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RuneConsolidationThreshold.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RuneConsolidationThreshold) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RuneConsolidationThreshold.Merge(m, src)
+}
+func (m *RuneConsolidationThreshold) XXX_Size() int {
+	return m.Size()
+}
+func (m *RuneConsolidationThreshold) XXX_DiscardUnknown() {
+	xxx_messageInfo_RuneConsolidationThreshold.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RuneConsolidationThreshold proto.InternalMessageInfo
+
+func (m *RuneConsolidationThreshold) GetRuneId() string {
+	if m != nil {
+		return m.RuneId
+	}
+	return ""
+}
+
+func (m *RuneConsolidationThreshold) GetTargetThreshold() string {
+	if m != nil {
+		return m.TargetThreshold
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("side.btcbridge.VaultAssignmentStrategy", VaultAssignmentStrategy_name, VaultAssignmentStrategy_value)
+	proto.RegisterEnum("side.btcbridge.AssetType", AssetType_name, AssetType_value)
+	proto.RegisterEnum("side.btcbridge.VaultState", VaultState_name, VaultState_value)
+	proto.RegisterEnum("side.btcbridge.VaultScriptType", VaultScriptType_name, VaultScriptType_value)
+	proto.RegisterEnum("side.btcbridge.CoinSelectionStrategy", CoinSelectionStrategy_name, CoinSelectionStrategy_value)
+	proto.RegisterType((*Params)(nil), "side.btcbridge.Params")
+	proto.RegisterType((*Vault)(nil), "side.btcbridge.Vault")
+	proto.RegisterType((*VaultTaprootTree)(nil), "side.btcbridge.VaultTaprootTree")
+	proto.RegisterType((*VaultRecoveryPolicy)(nil), "side.btcbridge.VaultRecoveryPolicy")
+	proto.RegisterType((*VaultDescriptor)(nil), "side.btcbridge.VaultDescriptor")
+	proto.RegisterType((*WithdrawParams)(nil), "side.btcbridge.WithdrawParams")
+	proto.RegisterType((*WithdrawRateLimit)(nil), "side.btcbridge.WithdrawRateLimit")
+	proto.RegisterType((*ProtocolLimits)(nil), "side.btcbridge.ProtocolLimits")
+	proto.RegisterType((*ProtocolFees)(nil), "side.btcbridge.ProtocolFees")
+	proto.RegisterType((*FeeDistribution)(nil), "side.btcbridge.FeeDistribution")
+	proto.RegisterType((*FeeSchedule)(nil), "side.btcbridge.FeeSchedule")
+	proto.RegisterType((*FeeTier)(nil), "side.btcbridge.FeeTier")
+	proto.RegisterType((*RuneConfig)(nil), "side.btcbridge.RuneConfig")
+	proto.RegisterType((*RuneFees)(nil), "side.btcbridge.RuneFees")
+	proto.RegisterType((*TSSParams)(nil), "side.btcbridge.TSSParams")
+	proto.RegisterType((*SignerLivenessParams)(nil), "side.btcbridge.SignerLivenessParams")
+	proto.RegisterType((*ConsolidationPolicy)(nil), "side.btcbridge.ConsolidationPolicy")
+	proto.RegisterType((*RuneConsolidationThreshold)(nil), "side.btcbridge.RuneConsolidationThreshold")
+}
+
+func init() { proto.RegisterFile("side/btcbridge/params.proto", fileDescriptor_f1d33573cda8a6d2) }
+
+var fileDescriptor_f1d33573cda8a6d2 = []byte{
+	// 2547 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x4b, 0x73, 0x23, 0x57,
+	0x15, 0x76, 0x4b, 0xb6, 0xc7, 0x3a, 0xb2, 0xe5, 0x9e, 0x6b, 0x7b, 0xac, 0x71, 0x12, 0xdb, 0xa3,
+	0x4c, 0x12, 0xc5, 0x95, 0xd8, 0x13, 0x67, 0x01, 0x24, 0x54, 0x0a, 0xbd, 0x3c, 0xa3, 0xe0, 0xf1,
+	0x88, 0xee, 0xf6, 0x0c, 0x43, 0x41, 0x35, 0xad, 0xee, 0x6b, 0xb9, 0x63, 0xf5, 0xa3, 0xfa, 0xde,
+	0xb6, 0xe5, 0x1f, 0x00, 0x2b, 0x16, 0x59, 0xb2, 0x86, 0x1d, 0x3f, 0x80, 0x05, 0xbf, 0x20, 0x95,
+	0x55, 0x76, 0xb0, 0x22, 0x54, 0xc2, 0x8e, 0x1d, 0x1b, 0x8a, 0x1d, 0x75, 0x5f, 0xad, 0x96, 0x2c,
+	0xc3, 0x50, 0x14, 0x2b, 0xe9, 0x9e, 0xef, 0x3b, 0xe7, 0xbe, 0xce, 0xeb, 0x36, 0xbc, 0x46, 0x7c,
+	0x0f, 0x1f, 0xf4, 0xa9, 0xdb, 0x4f, 0x7c, 0x6f, 0x80, 0x0f, 0x62, 0x27, 0x71, 0x02, 0xb2, 0x1f,
+	0x27, 0x11, 0x8d, 0x50, 0x85, 0x81, 0xfb, 0x19, 0xb8, 0xb5, 0x3e, 0x88, 0x06, 0x11, 0x87, 0x0e,
+	0xd8, 0x3f, 0xc1, 0xda, 0xda, 0x1e, 0x44, 0xd1, 0x60, 0x88, 0x0f, 0xf8, 0xa8, 0x9f, 0x9e, 0x1d,
+	0x78, 0x69, 0xe2, 0x50, 0x3f, 0x0a, 0x25, 0xbe, 0x33, 0x8d, 0x53, 0x3f, 0xc0, 0x84, 0x3a, 0x41,
+	0xac, 0x0c, 0xb8, 0x11, 0x09, 0x22, 0x72, 0xd0, 0x77, 0x08, 0x3e, 0xb8, 0xfc, 0xa0, 0x8f, 0xa9,
+	0xf3, 0xc1, 0x81, 0x1b, 0xf9, 0xd2, 0x40, 0xed, 0xcb, 0x32, 0x2c, 0xf6, 0xf8, 0xba, 0xd0, 0xf7,
+	0x61, 0xcb, 0xc3, 0x71, 0x44, 0x7c, 0x6a, 0xbb, 0x51, 0x78, 0xe6, 0x27, 0x01, 0x9f, 0xc9, 0xf6,
+	0x70, 0x4c, 0xcf, 0xab, 0xda, 0xae, 0x56, 0x5f, 0x30, 0xaa, 0x92, 0xd1, 0xca, 0x11, 0xda, 0x0c,
+	0x47, 0x9f, 0xc0, 0x6b, 0x57, 0x3e, 0x3d, 0xf7, 0x12, 0xe7, 0x6a, 0x96, 0x7a, 0x81, 0xab, 0xdf,
+	0x57, 0x94, 0x9b, 0xfa, 0x6f, 0xc3, 0x6a, 0xe0, 0x8c, 0xec, 0x04, 0x47, 0xc9, 0x40, 0xea, 0x14,
+	0xb9, 0xce, 0x4a, 0xe0, 0x8c, 0x0c, 0x26, 0x15, 0xbc, 0x8f, 0x61, 0x8b, 0xf1, 0x1c, 0xd7, 0xc5,
+	0x31, 0x75, 0xfa, 0x43, 0x6c, 0xf7, 0x87, 0x91, 0x7b, 0x21, 0x55, 0xe6, 0x77, 0xb5, 0xfa, 0xbc,
+	0xb1, 0x19, 0x38, 0xa3, 0x46, 0x46, 0x68, 0x32, 0x5c, 0x28, 0xef, 0xc1, 0xdd, 0x3e, 0x75, 0xed,
+	0xcb, 0x28, 0x75, 0xcf, 0x71, 0x62, 0x7b, 0x38, 0x8c, 0x82, 0xea, 0xc2, 0xae, 0x56, 0x2f, 0x19,
+	0xab, 0x7d, 0xea, 0x3e, 0x17, 0xf2, 0x36, 0x13, 0xa3, 0x77, 0x60, 0x55, 0x1d, 0x07, 0x0e, 0x99,
+	0x1d, 0xaf, 0xba, 0xb8, 0xab, 0xd5, 0x97, 0x8c, 0x8a, 0x14, 0x77, 0x84, 0x14, 0xbd, 0x0b, 0x7a,
+	0xb6, 0x73, 0xc5, 0xbc, 0xc3, 0x99, 0xab, 0x4a, 0xae, 0xa8, 0x8f, 0x60, 0x9d, 0x26, 0x29, 0xa1,
+	0xd8, 0xb3, 0xd9, 0x3a, 0x12, 0x3c, 0x74, 0xae, 0x71, 0x42, 0xaa, 0x4b, 0xbb, 0xc5, 0x7a, 0xc9,
+	0x40, 0x12, 0x6b, 0x52, 0xd7, 0x90, 0x08, 0xfa, 0x0e, 0x54, 0x95, 0x46, 0x18, 0x85, 0x93, 0x5a,
+	0x25, 0xae, 0xb5, 0x21, 0xf1, 0x93, 0x28, 0xcc, 0x2b, 0x1e, 0x82, 0x02, 0xec, 0x33, 0x8c, 0xed,
+	0x38, 0x89, 0x2e, 0x7d, 0x8f, 0x69, 0x01, 0xd7, 0x5a, 0x93, 0xe0, 0x11, 0xc6, 0x3d, 0x05, 0xb1,
+	0xc9, 0x18, 0x37, 0x71, 0x28, 0xb6, 0x2f, 0x9d, 0xa1, 0xef, 0xf9, 0xf4, 0xda, 0x8e, 0x71, 0xe2,
+	0x47, 0x5e, 0xb5, 0xbc, 0xab, 0xd5, 0x8b, 0xc6, 0xc6, 0x19, 0xc6, 0x86, 0x43, 0xf1, 0x73, 0x89,
+	0xf6, 0x38, 0x88, 0xde, 0x87, 0xc5, 0x4b, 0x27, 0x1d, 0x52, 0x52, 0x5d, 0xde, 0x2d, 0xd6, 0xcb,
+	0x87, 0x1b, 0xfb, 0x93, 0xde, 0xbd, 0xff, 0x9c, 0xa1, 0x86, 0x24, 0xa1, 0xa7, 0x90, 0x9d, 0x8c,
+	0x2d, 0x82, 0xa2, 0xba, 0xb2, 0xab, 0xd5, 0xcb, 0x87, 0xdb, 0xd3, 0x7a, 0x2f, 0x24, 0x4d, 0xb8,
+	0x68, 0x73, 0xfe, 0x8b, 0x3f, 0xef, 0xcc, 0x19, 0x95, 0xab, 0x09, 0x29, 0x33, 0xc7, 0x9d, 0xd9,
+	0x8d, 0x86, 0xf6, 0xd0, 0x0f, 0x7c, 0x4a, 0xaa, 0x95, 0xd9, 0xe6, 0x7a, 0x92, 0x76, 0xcc, 0x59,
+	0xca, 0x5c, 0x3c, 0x21, 0x45, 0x8f, 0x61, 0x25, 0x33, 0x77, 0x86, 0x31, 0xa9, 0xae, 0x72, 0x63,
+	0xaf, 0xdf, 0x66, 0xec, 0x08, 0x63, 0x65, 0x6a, 0x39, 0xce, 0xc9, 0xd0, 0x27, 0x00, 0x94, 0x10,
+	0xb5, 0x43, 0x9d, 0x5b, 0xb9, 0x3f, 0x6d, 0xc5, 0x32, 0xcd, 0x89, 0xcd, 0x95, 0x28, 0x21, 0x72,
+	0x5f, 0x2d, 0x58, 0x4e, 0xd2, 0x10, 0x8b, 0x70, 0x1a, 0x90, 0xea, 0x5d, 0x7e, 0xb6, 0x5b, 0xd3,
+	0x16, 0x8c, 0x34, 0xc4, 0x3c, 0x9e, 0x06, 0xd2, 0x44, 0x39, 0xc9, 0x24, 0x04, 0xbd, 0x80, 0xb5,
+	0xec, 0xac, 0xf9, 0xc5, 0xf2, 0x13, 0xaa, 0x22, 0xbe, 0x9a, 0x07, 0xb7, 0x9d, 0x37, 0xbb, 0x63,
+	0x7e, 0x1c, 0xd2, 0xe4, 0xdd, 0xab, 0x69, 0x00, 0xbd, 0x0e, 0xa5, 0x41, 0xea, 0x24, 0x9e, 0xef,
+	0x84, 0xa4, 0xba, 0xc6, 0x9d, 0x6a, 0x2c, 0x40, 0x3f, 0x02, 0xc4, 0xc2, 0x34, 0x76, 0x52, 0x82,
+	0x6d, 0x95, 0xb4, 0xaa, 0xeb, 0xf2, 0x0c, 0x44, 0xd6, 0xda, 0x57, 0x59, 0x6b, 0xbf, 0x2d, 0x09,
+	0xcd, 0x25, 0x36, 0xdb, 0xaf, 0xbf, 0xde, 0xd1, 0x0c, 0x3d, 0x70, 0x46, 0x3d, 0xa6, 0xad, 0x30,
+	0xf4, 0x53, 0x58, 0x77, 0xa3, 0x90, 0x44, 0x43, 0xdf, 0xe3, 0x02, 0x3b, 0x8e, 0x86, 0xbe, 0x7b,
+	0x5d, 0xdd, 0xe0, 0x46, 0xdf, 0x9c, 0xde, 0x4a, 0x2b, 0xcf, 0xed, 0x71, 0xaa, 0xdc, 0xcc, 0x9a,
+	0x7b, 0x13, 0x42, 0x3f, 0x83, 0x0d, 0xee, 0x9d, 0x76, 0x82, 0xdd, 0xe8, 0x12, 0x27, 0xd7, 0xca,
+	0xfc, 0xbd, 0xd9, 0xe6, 0x85, 0x47, 0x4b, 0xee, 0xa4, 0xf9, 0xcb, 0x9b, 0x10, 0x72, 0xe1, 0xbe,
+	0x30, 0xef, 0x10, 0xe2, 0x0f, 0xc2, 0x00, 0x87, 0xd4, 0x26, 0x94, 0x5d, 0xc8, 0xe0, 0xba, 0xba,
+	0xb9, 0xab, 0xd5, 0x2b, 0x87, 0xef, 0xcc, 0x9c, 0xa2, 0x91, 0xf1, 0x4d, 0x49, 0x37, 0x36, 0x2f,
+	0x67, 0x03, 0xb5, 0xdf, 0x14, 0x61, 0x81, 0x2b, 0xa1, 0x2a, 0xdc, 0x71, 0x3c, 0x2f, 0xc1, 0x84,
+	0xf0, 0xc4, 0x5d, 0x32, 0xd4, 0x10, 0x6d, 0xc2, 0x9d, 0x38, 0xed, 0xdb, 0x17, 0xf8, 0x9a, 0xe7,
+	0xe4, 0x92, 0xb1, 0x18, 0xa7, 0xfd, 0x1f, 0xe2, 0x6b, 0xf4, 0x5d, 0x00, 0x87, 0x10, 0x4c, 0x6d,
+	0x7a, 0x1d, 0x63, 0x9e, 0x7b, 0x2b, 0x37, 0xbd, 0xb5, 0xc1, 0x18, 0xd6, 0x75, 0x8c, 0x8d, 0x92,
+	0xa3, 0xfe, 0xb2, 0xc9, 0x2e, 0x71, 0x42, 0xd8, 0x05, 0x8b, 0xfc, 0xab, 0x86, 0xe8, 0x18, 0xee,
+	0x12, 0x37, 0xf1, 0x63, 0x6a, 0x7b, 0x58, 0xfc, 0x89, 0x12, 0x9e, 0x6f, 0xcb, 0x87, 0x3b, 0x33,
+	0x77, 0xdb, 0xce, 0x68, 0x86, 0x2e, 0xfe, 0x8d, 0x25, 0x2c, 0x1e, 0xa8, 0x13, 0x27, 0x51, 0x44,
+	0x6d, 0x9a, 0x60, 0xcc, 0xd3, 0x71, 0xf9, 0x70, 0x77, 0xa6, 0x21, 0x4b, 0x10, 0xad, 0x04, 0x63,
+	0xa3, 0x4c, 0xc7, 0x03, 0x96, 0x2c, 0x12, 0x4c, 0xfd, 0x04, 0xf3, 0x2b, 0x60, 0xe5, 0x92, 0x27,
+	0x6b, 0x16, 0x57, 0xd3, 0x5e, 0x69, 0xa9, 0x5a, 0xca, 0xdd, 0x52, 0xfb, 0x9c, 0xb9, 0x65, 0x65,
+	0xac, 0xcc, 0x60, 0xf4, 0x08, 0x16, 0x08, 0x75, 0x28, 0xae, 0x2e, 0xf1, 0x03, 0xdb, 0x9a, 0xb9,
+	0x18, 0x93, 0x31, 0x0c, 0x41, 0xac, 0x7d, 0x0c, 0xfa, 0xf4, 0x0a, 0x59, 0xad, 0xc9, 0xdc, 0x4e,
+	0x6c, 0x57, 0x5e, 0x5b, 0x45, 0x89, 0x4d, 0x2e, 0xad, 0x7d, 0x06, 0x6b, 0x33, 0x1c, 0x0f, 0xdd,
+	0x87, 0x25, 0x79, 0xa9, 0xec, 0xbe, 0x59, 0x28, 0xde, 0x11, 0xb7, 0x4a, 0x58, 0x98, 0xd2, 0xf3,
+	0x04, 0x93, 0xf3, 0x68, 0xe8, 0xf1, 0x1b, 0x5f, 0x31, 0xc6, 0x02, 0xb4, 0x05, 0x4b, 0xec, 0x08,
+	0x58, 0x85, 0xe4, 0x57, 0xbe, 0x62, 0x64, 0xe3, 0xda, 0xaf, 0x34, 0x58, 0x9d, 0xba, 0x14, 0xf4,
+	0x03, 0x28, 0x8b, 0xff, 0xc2, 0x4b, 0x34, 0xbe, 0xe9, 0xd9, 0x57, 0x29, 0x56, 0xcc, 0x7d, 0x05,
+	0x48, 0xf6, 0xff, 0x3f, 0xac, 0x27, 0xbf, 0x91, 0xe2, 0xc4, 0x46, 0x6a, 0xff, 0xd0, 0xa0, 0x32,
+	0x59, 0x0e, 0xd0, 0x2e, 0x2c, 0xb3, 0x24, 0x93, 0xd2, 0x51, 0x64, 0x87, 0x69, 0xc0, 0x97, 0xb3,
+	0x62, 0x40, 0xe0, 0x8c, 0x4e, 0xe9, 0x28, 0x3a, 0x49, 0x03, 0xf4, 0x3d, 0xb8, 0xcf, 0x4a, 0x66,
+	0xdf, 0xa1, 0xee, 0xb9, 0x3d, 0xae, 0x39, 0xa2, 0xa4, 0x15, 0x78, 0x49, 0xbb, 0xd7, 0xa7, 0x6e,
+	0x93, 0xe1, 0x99, 0x71, 0x8e, 0xa2, 0x8f, 0x44, 0xa3, 0x31, 0x43, 0x9d, 0x4d, 0x25, 0x0e, 0xeb,
+	0x5e, 0xe0, 0x8c, 0x9a, 0x53, 0xea, 0x6c, 0xda, 0x63, 0xa8, 0xb0, 0x1e, 0xcb, 0x26, 0x78, 0x88,
+	0x5d, 0xaa, 0x02, 0xa3, 0x72, 0xf8, 0xd6, 0xcd, 0x24, 0xe5, 0x87, 0xa6, 0x22, 0x65, 0x01, 0xbe,
+	0xe2, 0xe6, 0xc5, 0xb5, 0xbf, 0x6b, 0x70, 0xf7, 0x46, 0x62, 0x46, 0xf7, 0x60, 0xf1, 0xca, 0x0f,
+	0xbd, 0xe8, 0x8a, 0x6f, 0xbb, 0x68, 0xc8, 0x11, 0x7a, 0x00, 0xcb, 0x83, 0x61, 0xd4, 0x77, 0x64,
+	0x2d, 0x94, 0xbb, 0x2c, 0x0b, 0x99, 0x50, 0x0d, 0x61, 0x59, 0x84, 0xba, 0xac, 0x96, 0x45, 0x5e,
+	0x58, 0xee, 0xef, 0x8b, 0x5e, 0x71, 0x9f, 0xf5, 0x8a, 0xfb, 0xb2, 0x57, 0xe4, 0x2b, 0x6c, 0x3e,
+	0x62, 0x89, 0xed, 0x77, 0x5f, 0xef, 0xd4, 0x07, 0x3e, 0x3d, 0x4f, 0xfb, 0xfb, 0x6e, 0x14, 0x1c,
+	0xc8, 0xc6, 0x52, 0xfc, 0xbc, 0x4f, 0xbc, 0x8b, 0x03, 0xe6, 0x12, 0x84, 0x2b, 0x10, 0xa3, 0xcc,
+	0x27, 0x90, 0x15, 0xf5, 0x10, 0x36, 0xfa, 0x09, 0x76, 0x2e, 0x70, 0x62, 0x67, 0x57, 0x6d, 0xf7,
+	0x63, 0xc2, 0x4f, 0x65, 0xc5, 0x58, 0x93, 0xa0, 0xa5, 0xb0, 0x66, 0x4c, 0x98, 0xf7, 0x55, 0x26,
+	0xcb, 0x35, 0x6b, 0x11, 0xd9, 0x6d, 0x04, 0x7e, 0x68, 0xcb, 0x16, 0x4c, 0x6e, 0x7d, 0xa5, 0x4f,
+	0xdd, 0xa7, 0x7e, 0xd8, 0x16, 0x42, 0x54, 0x07, 0x5d, 0xf1, 0xd4, 0x9d, 0xc9, 0x53, 0xa8, 0x08,
+	0xa2, 0x3a, 0xcc, 0x8c, 0xe9, 0x8c, 0xc6, 0xcc, 0xe2, 0x98, 0xe9, 0x8c, 0x14, 0xb3, 0xf6, 0x65,
+	0x01, 0x96, 0xf3, 0x05, 0x9f, 0xf9, 0xb1, 0x1b, 0x0d, 0xd9, 0x15, 0x45, 0x09, 0xd7, 0x29, 0x19,
+	0x63, 0x01, 0x32, 0x61, 0x5d, 0x35, 0x8f, 0xac, 0xa3, 0x22, 0xee, 0x39, 0xf6, 0xd2, 0x21, 0xe6,
+	0x1b, 0x2e, 0x1f, 0xbe, 0x36, 0xed, 0x06, 0x47, 0x18, 0x9b, 0x92, 0x22, 0x8b, 0x08, 0x92, 0xea,
+	0x39, 0x04, 0x9d, 0xc2, 0x46, 0xe6, 0x83, 0x13, 0x56, 0x17, 0x5e, 0xd5, 0x6a, 0xd6, 0x0a, 0xe4,
+	0xcd, 0x76, 0x61, 0xd9, 0xf3, 0x09, 0x4d, 0xfc, 0x7e, 0xca, 0x5d, 0x75, 0x71, 0x76, 0x7e, 0x3e,
+	0xc2, 0xb8, 0x9d, 0xa3, 0xa9, 0x8e, 0x27, 0xaf, 0xfa, 0xe9, 0xfc, 0x92, 0xa6, 0x17, 0x3e, 0x9d,
+	0x5f, 0x2a, 0xe8, 0x45, 0xa3, 0x9c, 0x3b, 0x00, 0x63, 0x39, 0xbf, 0xf0, 0xda, 0x5f, 0x35, 0x58,
+	0x9d, 0x32, 0x87, 0xd6, 0x61, 0x01, 0xc7, 0x91, 0x7b, 0x2e, 0xaf, 0x54, 0x0c, 0x98, 0xe7, 0xb8,
+	0x51, 0x10, 0xa4, 0x21, 0xef, 0x44, 0xa3, 0x68, 0x68, 0x5f, 0x61, 0x7f, 0x70, 0x4e, 0x65, 0xe6,
+	0x58, 0xcb, 0xc0, 0x5e, 0x14, 0x0d, 0x5f, 0x70, 0x08, 0xed, 0xc3, 0x9a, 0x6c, 0x91, 0x27, 0x34,
+	0x44, 0xc4, 0xde, 0x95, 0x50, 0x8e, 0xff, 0x1e, 0x20, 0x56, 0x49, 0xa7, 0xe8, 0xc2, 0x35, 0x75,
+	0x81, 0xe4, 0xd8, 0xef, 0x82, 0xee, 0x87, 0x24, 0x4d, 0x9c, 0xd0, 0xc5, 0x8a, 0xbb, 0xc0, 0xb9,
+	0xab, 0x99, 0x5c, 0x50, 0x6b, 0xbf, 0xd4, 0xa0, 0x9c, 0x3f, 0x68, 0x1d, 0x8a, 0xcc, 0xe9, 0x45,
+	0x96, 0x62, 0x7f, 0x59, 0x31, 0x66, 0x5e, 0x7a, 0x86, 0xb1, 0x2a, 0xc6, 0x81, 0x1f, 0x1e, 0x61,
+	0xcc, 0x01, 0x67, 0xc4, 0x81, 0xa2, 0x04, 0x9c, 0x11, 0x03, 0x3e, 0x84, 0x05, 0xea, 0xb3, 0x36,
+	0x7e, 0x9e, 0xc7, 0xec, 0xe6, 0x8c, 0x5b, 0xb2, 0x7c, 0x9c, 0xc8, 0xdb, 0x11, 0xdc, 0xda, 0x47,
+	0x70, 0x47, 0xca, 0xd1, 0x1b, 0x00, 0x6c, 0x46, 0x27, 0x88, 0xd2, 0x50, 0x15, 0x99, 0x52, 0xe0,
+	0x87, 0x0d, 0x2e, 0x50, 0x4b, 0x2c, 0x64, 0x4b, 0xac, 0xfd, 0x53, 0x03, 0x18, 0x77, 0x98, 0xa8,
+	0x02, 0x05, 0xdf, 0x93, 0x7a, 0x05, 0xdf, 0x63, 0xb5, 0x5f, 0xbd, 0x79, 0x0a, 0xfc, 0xcd, 0xa3,
+	0x86, 0x68, 0x07, 0xca, 0xf9, 0x48, 0x15, 0xdb, 0x80, 0x60, 0x1c, 0xa6, 0x0f, 0x60, 0x79, 0x22,
+	0x44, 0xe7, 0x39, 0x83, 0x29, 0x65, 0xf1, 0xf9, 0x40, 0x24, 0xf8, 0x8c, 0xb2, 0x20, 0x29, 0xe3,
+	0xc0, 0x64, 0x1b, 0x22, 0x69, 0x1c, 0x0f, 0xaf, 0x6d, 0xd7, 0x89, 0xb9, 0xef, 0x96, 0x8c, 0x92,
+	0x90, 0xb4, 0x9c, 0x18, 0x7d, 0x0c, 0xcb, 0x2c, 0x54, 0x58, 0xb1, 0x4c, 0x7c, 0x4f, 0xd5, 0xfa,
+	0xea, 0xac, 0x1e, 0x9a, 0x85, 0xb5, 0x51, 0x3e, 0xc3, 0xf8, 0x99, 0x24, 0xd7, 0x7e, 0xaf, 0xc1,
+	0x92, 0x42, 0x6e, 0x0d, 0x69, 0xed, 0xff, 0x12, 0xd2, 0x85, 0xff, 0x25, 0xa4, 0x6b, 0x7f, 0x2b,
+	0x42, 0x29, 0x7b, 0x58, 0xb0, 0x5e, 0xdc, 0xbb, 0x18, 0xf0, 0x5e, 0x27, 0x4a, 0xa9, 0xaa, 0x7e,
+	0xda, 0x7f, 0xd1, 0x8b, 0x7b, 0x17, 0x03, 0x4b, 0x68, 0xcb, 0xe2, 0x48, 0xe1, 0x61, 0xec, 0x24,
+	0xd4, 0x77, 0xfd, 0xd8, 0x09, 0xa9, 0x9d, 0xc6, 0x1e, 0x7b, 0x5a, 0xd0, 0xc4, 0x09, 0x89, 0x2f,
+	0x7a, 0xf3, 0x71, 0x89, 0x7d, 0xc5, 0x49, 0x1e, 0xe4, 0x0c, 0x9e, 0x72, 0x7b, 0x56, 0x66, 0x4e,
+	0xce, 0xfa, 0x36, 0xac, 0xb2, 0x8d, 0x88, 0xef, 0x04, 0x34, 0xf1, 0x31, 0x91, 0x51, 0xbd, 0xe2,
+	0x5d, 0x0c, 0x9e, 0xb2, 0xcf, 0x04, 0x5c, 0xc8, 0xb2, 0x86, 0x93, 0xd2, 0xc8, 0x4e, 0x22, 0xca,
+	0x67, 0xc9, 0x9e, 0xe5, 0xf3, 0xdc, 0x45, 0xd7, 0x18, 0x68, 0x48, 0x2c, 0xf7, 0x8a, 0x17, 0x0f,
+	0x96, 0x6c, 0x11, 0x44, 0xc5, 0x35, 0x7f, 0x89, 0x8c, 0xc5, 0xac, 0xa9, 0x20, 0xd4, 0xb9, 0xc0,
+	0x36, 0x39, 0xf7, 0xcf, 0xe8, 0x54, 0x49, 0x5b, 0x14, 0x8d, 0x01, 0x27, 0x98, 0x0c, 0xcf, 0x57,
+	0x35, 0x64, 0xc2, 0xaa, 0xcc, 0x35, 0x43, 0xff, 0x12, 0x87, 0xac, 0x3f, 0x17, 0x1e, 0xf9, 0x70,
+	0xfa, 0xa6, 0x4d, 0x4e, 0x3b, 0x96, 0xac, 0xc9, 0xf7, 0x2f, 0x99, 0xc0, 0x6a, 0x7f, 0x2c, 0xc0,
+	0xfa, 0x2c, 0xfa, 0xad, 0x2d, 0x82, 0xfc, 0xd6, 0x12, 0xf8, 0x84, 0x60, 0xcf, 0xf6, 0x2e, 0x06,
+	0x2a, 0xe2, 0xd9, 0xb7, 0x96, 0xa7, 0x5c, 0xda, 0xbe, 0x18, 0x10, 0x96, 0x49, 0x73, 0x3c, 0x36,
+	0xab, 0x1f, 0x0e, 0xd4, 0x99, 0xdf, 0xcd, 0xb8, 0xa6, 0x04, 0x78, 0x26, 0x1d, 0x3a, 0xe4, 0xdc,
+	0x3e, 0x4b, 0x1c, 0xde, 0xba, 0xe4, 0x8a, 0xbc, 0xce, 0x91, 0x23, 0x09, 0xb0, 0xb3, 0x78, 0x02,
+	0x2b, 0x9f, 0x39, 0xfe, 0x70, 0xfc, 0x3a, 0x5c, 0x78, 0x75, 0x67, 0x59, 0x66, 0x9a, 0x4a, 0x8e,
+	0x5e, 0xc0, 0x86, 0x5c, 0x9c, 0x9d, 0x44, 0x69, 0xe8, 0x29, 0x57, 0xaf, 0x2e, 0xbe, 0xba, 0xc5,
+	0x35, 0x69, 0xc1, 0x60, 0x06, 0xa4, 0xb3, 0xd7, 0x7e, 0x5b, 0x80, 0xb5, 0x19, 0xef, 0x48, 0xd6,
+	0x37, 0xa8, 0xa6, 0xd3, 0xa6, 0x89, 0x3f, 0x18, 0xe0, 0x44, 0xa6, 0xf5, 0x4a, 0x2a, 0x3a, 0x4f,
+	0x4b, 0x48, 0x55, 0x8b, 0xaa, 0x3e, 0xab, 0xc8, 0x3e, 0x04, 0x44, 0x36, 0x67, 0xcd, 0x1c, 0xfb,
+	0x26, 0xc4, 0x7a, 0x10, 0xea, 0x24, 0x03, 0x9c, 0x73, 0x26, 0xd9, 0x87, 0xa0, 0x3e, 0x75, 0x2d,
+	0x0e, 0x65, 0x7e, 0x84, 0x5e, 0xc2, 0x2a, 0xff, 0x2e, 0x90, 0x71, 0x55, 0x35, 0xd8, 0xbb, 0xe5,
+	0xd3, 0xc0, 0x78, 0xfd, 0x63, 0x67, 0x94, 0xae, 0xc4, 0x0c, 0x65, 0x42, 0xa2, 0xea, 0x0e, 0xeb,
+	0x70, 0x85, 0xf3, 0xb3, 0xba, 0xc3, 0x3a, 0xda, 0x2d, 0x58, 0x72, 0xa3, 0x68, 0xe8, 0x45, 0x57,
+	0xa2, 0x41, 0x28, 0x1a, 0xd9, 0xb8, 0xf6, 0x73, 0xd8, 0xba, 0x7d, 0x22, 0x66, 0x92, 0xaf, 0x36,
+	0x2b, 0x1b, 0x8b, 0x6c, 0xd8, 0xe5, 0x11, 0x77, 0x63, 0xd3, 0xa2, 0x0a, 0xae, 0xd2, 0xc9, 0x1d,
+	0xef, 0x85, 0xb0, 0x79, 0xcb, 0x63, 0x18, 0xbd, 0x0b, 0x6f, 0x3d, 0x6f, 0x9c, 0x1e, 0x5b, 0x76,
+	0xc3, 0x34, 0xbb, 0x8f, 0x4f, 0x9e, 0x76, 0x4e, 0x2c, 0xdb, 0xb4, 0x8c, 0x86, 0xd5, 0x79, 0xfc,
+	0xd2, 0x36, 0x9e, 0x9d, 0x9e, 0xb4, 0x6d, 0xe3, 0x59, 0xb3, 0x7b, 0xa2, 0xcf, 0xa1, 0x3a, 0x3c,
+	0xbc, 0x9d, 0x7a, 0xdc, 0x69, 0x98, 0x96, 0x7d, 0x6a, 0xfd, 0xf8, 0x99, 0xae, 0xed, 0x0d, 0xa0,
+	0x94, 0xbd, 0x74, 0xd1, 0x16, 0xdc, 0x6b, 0x98, 0x66, 0xc7, 0xb2, 0xad, 0x97, 0xbd, 0x8e, 0x7d,
+	0x7a, 0x62, 0xf6, 0x3a, 0xad, 0xee, 0x51, 0xb7, 0xd3, 0xd6, 0xe7, 0x10, 0x82, 0x4a, 0x0e, 0x6b,
+	0x5a, 0x2d, 0x5d, 0x43, 0xeb, 0xa0, 0xe7, 0x65, 0x46, 0xeb, 0xf0, 0x91, 0x5e, 0x98, 0x92, 0x1a,
+	0xa7, 0x27, 0x1d, 0x53, 0x2f, 0xee, 0xbd, 0x04, 0x18, 0xbf, 0x10, 0xd1, 0x3d, 0x40, 0x62, 0x81,
+	0xa6, 0xd5, 0xb0, 0x3a, 0x76, 0xa3, 0x65, 0x75, 0x9f, 0x77, 0xf4, 0x39, 0xb6, 0x82, 0xbc, 0xbc,
+	0xdd, 0xe9, 0x19, 0x9d, 0x56, 0xc3, 0xea, 0xb4, 0x75, 0x0d, 0x6d, 0xc2, 0x5a, 0x1e, 0x33, 0x3a,
+	0x56, 0xd7, 0xe8, 0xb4, 0xf5, 0xc2, 0xde, 0x2f, 0xd4, 0xf3, 0x6d, 0xfc, 0x10, 0x43, 0x0f, 0xe0,
+	0x0d, 0x49, 0x6e, 0x19, 0xdd, 0xde, 0xcc, 0x1d, 0x3d, 0x84, 0xdd, 0x9b, 0x94, 0xde, 0xe1, 0x0b,
+	0xf3, 0x89, 0xfd, 0xf4, 0xf4, 0xd8, 0xea, 0x9a, 0xdd, 0xc7, 0xba, 0x36, 0x3e, 0xf5, 0x49, 0x96,
+	0xf9, 0x64, 0x9a, 0x5a, 0xd8, 0xfb, 0x83, 0x06, 0x1b, 0x33, 0x9f, 0x39, 0xe8, 0x4d, 0xd8, 0x69,
+	0x3d, 0xeb, 0x9e, 0xd8, 0x66, 0xe7, 0xb8, 0xd3, 0xb2, 0xba, 0xcf, 0x4e, 0xc6, 0xb7, 0xd1, 0xee,
+	0x1c, 0x31, 0xf3, 0xfa, 0x1c, 0x9b, 0xe9, 0x36, 0xd2, 0x71, 0xc3, 0x78, 0xdc, 0x31, 0x2d, 0xfb,
+	0xa8, 0x6b, 0x98, 0x96, 0xae, 0xa1, 0xf7, 0xa0, 0x7e, 0x1b, 0xb5, 0x69, 0x34, 0x4e, 0x5a, 0x4f,
+	0xec, 0xc6, 0x49, 0xdb, 0x6e, 0x32, 0x9f, 0xd0, 0x0b, 0xff, 0x6e, 0xf6, 0x9e, 0xd1, 0x7d, 0xde,
+	0x68, 0xbd, 0xd4, 0x8b, 0xcd, 0x27, 0x5f, 0x7c, 0xb3, 0xad, 0x7d, 0xf5, 0xcd, 0xb6, 0xf6, 0x97,
+	0x6f, 0xb6, 0xb5, 0xcf, 0xbf, 0xdd, 0x9e, 0xfb, 0xea, 0xdb, 0xed, 0xb9, 0x3f, 0x7d, 0xbb, 0x3d,
+	0xf7, 0x93, 0xfd, 0xdc, 0x53, 0x88, 0x45, 0x9d, 0xfa, 0xf2, 0xc7, 0x07, 0x07, 0xa3, 0xdc, 0x67,
+	0x7f, 0xfe, 0x2c, 0xea, 0x2f, 0x72, 0xc2, 0x87, 0xff, 0x1a, 0x00, 0xd6, 0x51, 0x33, 0xae, 0x15,
+	0x18, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.VaultAssignmentStrategy != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.VaultAssignmentStrategy))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb8
+	}
+	{
+		size, err := m.VaultRecoveryPolicy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xb2
+	{
+		size, err := m.ConsolidationPolicy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xaa
+	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MaxPauseDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxPauseDuration):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintParams(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xa2
+	if len(m.Guardians) > 0 {
+		for iNdEx := len(m.Guardians) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Guardians[iNdEx])
+			copy(dAtA[i:], m.Guardians[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.Guardians[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	{
+		size, err := m.WithdrawRateLimit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x92
+	if len(m.RuneConfigs) > 0 {
+		for iNdEx := len(m.RuneConfigs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RuneConfigs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	{
+		size, err := m.TssParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
 	i--
-	dAtA[i] = 0x1
-	i--
 	dAtA[i] = 0x82
 	{
 		size, err := m.ProtocolFees.MarshalToSizedBuffer(dAtA[:i])
@@ -823,6 +2165,45 @@ func (m *Vault) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.State != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.RetirementTime != nil {
+		n9, err9 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.RetirementTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.RetirementTime):])
+		if err9 != nil {
+			return 0, err9
+		}
+		i -= n9
+		i = encodeVarintParams(dAtA, i, uint64(n9))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.TaprootTree != nil {
+		{
+			size, err := m.TaprootTree.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintParams(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.ScriptDescriptor != nil {
+		{
+			size, err := m.ScriptDescriptor.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintParams(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.Version != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.Version))
 		i--
@@ -850,7 +2231,7 @@ func (m *Vault) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *WithdrawParams) Marshal() (dAtA []byte, err error) {
+func (m *VaultTaprootTree) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -860,22 +2241,141 @@ func (m *WithdrawParams) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *WithdrawParams) MarshalTo(dAtA []byte) (int, error) {
+func (m *VaultTaprootTree) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *WithdrawParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *VaultTaprootTree) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.MaxBtcBatchWithdrawNum != 0 {
-		i = encodeVarintParams(dAtA, i, uint64(m.MaxBtcBatchWithdrawNum))
+	if len(m.RecoveryScript) > 0 {
+		i -= len(m.RecoveryScript)
+		copy(dAtA[i:], m.RecoveryScript)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.RecoveryScript)))
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0xa
 	}
-	if m.BtcBatchWithdrawPeriod != 0 {
+	return len(dAtA) - i, nil
+}
+
+func (m *VaultRecoveryPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VaultRecoveryPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VaultRecoveryPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Timelock != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.Timelock))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Threshold != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.Threshold))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.PubKeys) > 0 {
+		for iNdEx := len(m.PubKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PubKeys[iNdEx])
+			copy(dAtA[i:], m.PubKeys[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.PubKeys[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VaultDescriptor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VaultDescriptor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VaultDescriptor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PubKeys) > 0 {
+		for iNdEx := len(m.PubKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PubKeys[iNdEx])
+			copy(dAtA[i:], m.PubKeys[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.PubKeys[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Threshold != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.Threshold))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ScriptType != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ScriptType))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WithdrawParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WithdrawParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WithdrawParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CoinSelection != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.CoinSelection))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaxBtcBatchWithdrawNum != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxBtcBatchWithdrawNum))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.BtcBatchWithdrawPeriod != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.BtcBatchWithdrawPeriod))
 		i--
 		dAtA[i] = 0x10
@@ -888,6 +2388,58 @@ func (m *WithdrawParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *WithdrawRateLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WithdrawRateLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WithdrawRateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BreakerThresholdBps != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.BreakerThresholdBps))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.AssetLimits) > 0 {
+		for iNdEx := len(m.AssetLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AssetLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.GlobalLimit != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.GlobalLimit))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Window != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.Window))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ProtocolLimits) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -946,6 +2498,36 @@ func (m *ProtocolFees) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.Distribution.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.WithdrawFeeSchedule.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.DepositFeeSchedule.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
 	if len(m.Collector) > 0 {
 		i -= len(m.Collector)
 		copy(dAtA[i:], m.Collector)
@@ -953,20 +2535,58 @@ func (m *ProtocolFees) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x1a
 	}
-	if m.WithdrawFee != 0 {
-		i = encodeVarintParams(dAtA, i, uint64(m.WithdrawFee))
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeDistribution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeDistribution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeDistribution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.InsuranceWeight != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.InsuranceWeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.SignerPoolWeight != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.SignerPoolWeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.RelayerPoolWeight != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.RelayerPoolWeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.CommunityPoolWeight != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.CommunityPoolWeight))
 		i--
 		dAtA[i] = 0x10
 	}
-	if m.DepositFee != 0 {
-		i = encodeVarintParams(dAtA, i, uint64(m.DepositFee))
+	if m.Epoch != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.Epoch))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *TSSParams) Marshal() (dAtA []byte, err error) {
+func (m *FeeSchedule) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -976,210 +2596,2935 @@ func (m *TSSParams) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TSSParams) MarshalTo(dAtA []byte) (int, error) {
+func (m *FeeSchedule) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TSSParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *FeeSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	n5, err5 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.ParticipantUpdateTransitionPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ParticipantUpdateTransitionPeriod):])
-	if err5 != nil {
-		return 0, err5
+	if len(m.Tiers) > 0 {
+		for iNdEx := len(m.Tiers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tiers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	i -= n5
-	i = encodeVarintParams(dAtA, i, uint64(n5))
-	i--
-	dAtA[i] = 0x12
-	n6, err6 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.DkgTimeoutPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DkgTimeoutPeriod):])
-	if err6 != nil {
-		return 0, err6
+	if len(m.MaxFee) > 0 {
+		i -= len(m.MaxFee)
+		copy(dAtA[i:], m.MaxFee)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.MaxFee)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.MinFee) > 0 {
+		i -= len(m.MinFee)
+		copy(dAtA[i:], m.MinFee)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.MinFee)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Bps != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.Bps))
+		i--
+		dAtA[i] = 0x8
 	}
-	i -= n6
-	i = encodeVarintParams(dAtA, i, uint64(n6))
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
-	offset -= sovParams(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *FeeTier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *FeeTier) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeTier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.DepositConfirmationDepth != 0 {
-		n += 1 + sovParams(uint64(m.DepositConfirmationDepth))
-	}
-	if m.WithdrawConfirmationDepth != 0 {
-		n += 1 + sovParams(uint64(m.WithdrawConfirmationDepth))
-	}
-	if m.MaxReorgDepth != 0 {
-		n += 1 + sovParams(uint64(m.MaxReorgDepth))
+	if m.Bps != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.Bps))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.MaxAcceptableBlockDepth != 0 {
-		n += 1 + sovParams(uint64(m.MaxAcceptableBlockDepth))
+	if len(m.MinAmount) > 0 {
+		i -= len(m.MinAmount)
+		copy(dAtA[i:], m.MinAmount)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.MinAmount)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = len(m.BtcVoucherDenom)
-	if l > 0 {
-		n += 1 + l + sovParams(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *RuneConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.DepositEnabled {
-		n += 2
-	}
-	if m.WithdrawEnabled {
-		n += 2
-	}
-	if len(m.TrustedBtcRelayers) > 0 {
-		for _, s := range m.TrustedBtcRelayers {
-			l = len(s)
-			n += 1 + l + sovParams(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *RuneConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RuneConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FeeOverride != nil {
+		{
+			size, err := m.FeeOverride.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintParams(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x3a
 	}
-	if len(m.TrustedNonBtcRelayers) > 0 {
-		for _, s := range m.TrustedNonBtcRelayers {
-			l = len(s)
-			n += 1 + l + sovParams(uint64(l))
-		}
+	if len(m.SupplyCap) > 0 {
+		i -= len(m.SupplyCap)
+		copy(dAtA[i:], m.SupplyCap)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.SupplyCap)))
+		i--
+		dAtA[i] = 0x32
 	}
-	if len(m.TrustedFeeProviders) > 0 {
-		for _, s := range m.TrustedFeeProviders {
-			l = len(s)
-			n += 1 + l + sovParams(uint64(l))
-		}
+	if len(m.MaxWithdraw) > 0 {
+		i -= len(m.MaxWithdraw)
+		copy(dAtA[i:], m.MaxWithdraw)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.MaxWithdraw)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	if m.FeeRateValidityPeriod != 0 {
-		n += 1 + sovParams(uint64(m.FeeRateValidityPeriod))
+	if len(m.MinWithdraw) > 0 {
+		i -= len(m.MinWithdraw)
+		copy(dAtA[i:], m.MinWithdraw)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.MinWithdraw)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if len(m.Vaults) > 0 {
-		for _, e := range m.Vaults {
-			l = e.Size()
-			n += 1 + l + sovParams(uint64(l))
+	if len(m.MinDeposit) > 0 {
+		i -= len(m.MinDeposit)
+		copy(dAtA[i:], m.MinDeposit)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.MinDeposit)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x10
 	}
-	l = m.WithdrawParams.Size()
-	n += 1 + l + sovParams(uint64(l))
-	l = m.ProtocolLimits.Size()
-	n += 1 + l + sovParams(uint64(l))
-	l = m.ProtocolFees.Size()
-	n += 1 + l + sovParams(uint64(l))
-	l = m.TssParams.Size()
-	n += 2 + l + sovParams(uint64(l))
-	return n
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *Vault) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RuneFees) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RuneFees) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RuneFees) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovParams(uint64(l))
-	}
-	l = len(m.PubKey)
-	if l > 0 {
-		n += 1 + l + sovParams(uint64(l))
-	}
-	if m.AssetType != 0 {
-		n += 1 + sovParams(uint64(m.AssetType))
+	{
+		size, err := m.WithdrawFeeSchedule.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
 	}
-	if m.Version != 0 {
-		n += 1 + sovParams(uint64(m.Version))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.DepositFeeSchedule.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *WithdrawParams) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TSSParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *TSSParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TSSParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.MaxUtxoNum != 0 {
-		n += 1 + sovParams(uint64(m.MaxUtxoNum))
+	{
+		size, err := m.SignerLiveness.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
 	}
-	if m.BtcBatchWithdrawPeriod != 0 {
-		n += 1 + sovParams(uint64(m.BtcBatchWithdrawPeriod))
+	i--
+	dAtA[i] = 0x3a
+	if m.StakeShiftThresholdBps != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.StakeShiftThresholdBps))
+		i--
+		dAtA[i] = 0x30
 	}
-	if m.MaxBtcBatchWithdrawNum != 0 {
-		n += 1 + sovParams(uint64(m.MaxBtcBatchWithdrawNum))
+	if m.MaxParticipants != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxParticipants))
+		i--
+		dAtA[i] = 0x28
 	}
-	return n
+	if m.AutoRotationEnabled {
+		i--
+		if m.AutoRotationEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.DkgMaxRetries != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.DkgMaxRetries))
+		i--
+		dAtA[i] = 0x18
+	}
+	n19, err19 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.ParticipantUpdateTransitionPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ParticipantUpdateTransitionPeriod):])
+	if err19 != nil {
+		return 0, err19
+	}
+	i -= n19
+	i = encodeVarintParams(dAtA, i, uint64(n19))
+	i--
+	dAtA[i] = 0x12
+	n20, err20 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.DkgTimeoutPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DkgTimeoutPeriod):])
+	if err20 != nil {
+		return 0, err20
+	}
+	i -= n20
+	i = encodeVarintParams(dAtA, i, uint64(n20))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *ProtocolLimits) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SignerLivenessParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SignerLivenessParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignerLivenessParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.BtcMinDeposit != 0 {
-		n += 1 + sovParams(uint64(m.BtcMinDeposit))
+	n21, err21 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.SigningRoundTimeout, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.SigningRoundTimeout):])
+	if err21 != nil {
+		return 0, err21
 	}
-	if m.BtcMinWithdraw != 0 {
-		n += 1 + sovParams(uint64(m.BtcMinWithdraw))
+	i -= n21
+	i = encodeVarintParams(dAtA, i, uint64(n21))
+	i--
+	dAtA[i] = 0x32
+	n22, err22 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.JailDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.JailDuration):])
+	if err22 != nil {
+		return 0, err22
 	}
-	if m.BtcMaxWithdraw != 0 {
-		n += 1 + sovParams(uint64(m.BtcMaxWithdraw))
+	i -= n22
+	i = encodeVarintParams(dAtA, i, uint64(n22))
+	i--
+	dAtA[i] = 0x2a
+	if m.SlashFractionBps != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.SlashFractionBps))
+		i--
+		dAtA[i] = 0x20
 	}
-	return n
+	if m.MaxMissedSignings != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxMissedSignings))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxMissedDkgs != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxMissedDkgs))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Window != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.Window))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ProtocolFees) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ConsolidationPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsolidationPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsolidationPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.DepositFee != 0 {
-		n += 1 + sovParams(uint64(m.DepositFee))
+	if m.Cooldown != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.Cooldown))
+		i--
+		dAtA[i] = 0x30
 	}
-	if m.WithdrawFee != 0 {
-		n += 1 + sovParams(uint64(m.WithdrawFee))
+	if m.MaxNum != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxNum))
+		i--
+		dAtA[i] = 0x28
 	}
-	l = len(m.Collector)
-	if l > 0 {
-		n += 1 + l + sovParams(uint64(l))
+	if len(m.RuneThresholds) > 0 {
+		for iNdEx := len(m.RuneThresholds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RuneThresholds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.BtcTargetThreshold != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.BtcTargetThreshold))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxFeeRate != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxFeeRate))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.UtxoNumTrigger != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.UtxoNumTrigger))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RuneConsolidationThreshold) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RuneConsolidationThreshold) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RuneConsolidationThreshold) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TargetThreshold) > 0 {
+		i -= len(m.TargetThreshold)
+		copy(dAtA[i:], m.TargetThreshold)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.TargetThreshold)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RuneId) > 0 {
+		i -= len(m.RuneId)
+		copy(dAtA[i:], m.RuneId)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.RuneId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DepositConfirmationDepth != 0 {
+		n += 1 + sovParams(uint64(m.DepositConfirmationDepth))
+	}
+	if m.WithdrawConfirmationDepth != 0 {
+		n += 1 + sovParams(uint64(m.WithdrawConfirmationDepth))
+	}
+	if m.MaxReorgDepth != 0 {
+		n += 1 + sovParams(uint64(m.MaxReorgDepth))
+	}
+	if m.MaxAcceptableBlockDepth != 0 {
+		n += 1 + sovParams(uint64(m.MaxAcceptableBlockDepth))
+	}
+	l = len(m.BtcVoucherDenom)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.DepositEnabled {
+		n += 2
+	}
+	if m.WithdrawEnabled {
+		n += 2
+	}
+	if len(m.TrustedBtcRelayers) > 0 {
+		for _, s := range m.TrustedBtcRelayers {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.TrustedNonBtcRelayers) > 0 {
+		for _, s := range m.TrustedNonBtcRelayers {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.TrustedFeeProviders) > 0 {
+		for _, s := range m.TrustedFeeProviders {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.FeeRateValidityPeriod != 0 {
+		n += 1 + sovParams(uint64(m.FeeRateValidityPeriod))
+	}
+	if len(m.Vaults) > 0 {
+		for _, e := range m.Vaults {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	l = m.WithdrawParams.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.ProtocolLimits.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.ProtocolFees.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.TssParams.Size()
+	n += 2 + l + sovParams(uint64(l))
+	if len(m.RuneConfigs) > 0 {
+		for _, e := range m.RuneConfigs {
+			l = e.Size()
+			n += 2 + l + sovParams(uint64(l))
+		}
+	}
+	l = m.WithdrawRateLimit.Size()
+	n += 2 + l + sovParams(uint64(l))
+	if len(m.Guardians) > 0 {
+		for _, s := range m.Guardians {
+			l = len(s)
+			n += 2 + l + sovParams(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxPauseDuration)
+	n += 2 + l + sovParams(uint64(l))
+	l = m.ConsolidationPolicy.Size()
+	n += 2 + l + sovParams(uint64(l))
+	l = m.VaultRecoveryPolicy.Size()
+	n += 2 + l + sovParams(uint64(l))
+	if m.VaultAssignmentStrategy != 0 {
+		n += 2 + sovParams(uint64(m.VaultAssignmentStrategy))
+	}
+	return n
+}
+
+func (m *Vault) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = len(m.PubKey)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.AssetType != 0 {
+		n += 1 + sovParams(uint64(m.AssetType))
+	}
+	if m.Version != 0 {
+		n += 1 + sovParams(uint64(m.Version))
+	}
+	if m.ScriptDescriptor != nil {
+		l = m.ScriptDescriptor.Size()
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.TaprootTree != nil {
+		l = m.TaprootTree.Size()
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.RetirementTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.RetirementTime)
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.State != 0 {
+		n += 1 + sovParams(uint64(m.State))
+	}
+	return n
+}
+
+func (m *VaultTaprootTree) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RecoveryScript)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	return n
+}
+
+func (m *VaultRecoveryPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PubKeys) > 0 {
+		for _, s := range m.PubKeys {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.Threshold != 0 {
+		n += 1 + sovParams(uint64(m.Threshold))
+	}
+	if m.Timelock != 0 {
+		n += 1 + sovParams(uint64(m.Timelock))
+	}
+	return n
+}
+
+func (m *VaultDescriptor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ScriptType != 0 {
+		n += 1 + sovParams(uint64(m.ScriptType))
+	}
+	if m.Threshold != 0 {
+		n += 1 + sovParams(uint64(m.Threshold))
+	}
+	if len(m.PubKeys) > 0 {
+		for _, s := range m.PubKeys {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *WithdrawParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxUtxoNum != 0 {
+		n += 1 + sovParams(uint64(m.MaxUtxoNum))
+	}
+	if m.BtcBatchWithdrawPeriod != 0 {
+		n += 1 + sovParams(uint64(m.BtcBatchWithdrawPeriod))
+	}
+	if m.MaxBtcBatchWithdrawNum != 0 {
+		n += 1 + sovParams(uint64(m.MaxBtcBatchWithdrawNum))
+	}
+	if m.CoinSelection != 0 {
+		n += 1 + sovParams(uint64(m.CoinSelection))
+	}
+	return n
+}
+
+func (m *WithdrawRateLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Window != 0 {
+		n += 1 + sovParams(uint64(m.Window))
+	}
+	if m.GlobalLimit != 0 {
+		n += 1 + sovParams(uint64(m.GlobalLimit))
+	}
+	if len(m.AssetLimits) > 0 {
+		for _, e := range m.AssetLimits {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.BreakerThresholdBps != 0 {
+		n += 1 + sovParams(uint64(m.BreakerThresholdBps))
+	}
+	return n
+}
+
+func (m *ProtocolLimits) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BtcMinDeposit != 0 {
+		n += 1 + sovParams(uint64(m.BtcMinDeposit))
+	}
+	if m.BtcMinWithdraw != 0 {
+		n += 1 + sovParams(uint64(m.BtcMinWithdraw))
+	}
+	if m.BtcMaxWithdraw != 0 {
+		n += 1 + sovParams(uint64(m.BtcMaxWithdraw))
+	}
+	return n
+}
+
+func (m *ProtocolFees) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Collector)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = m.DepositFeeSchedule.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.WithdrawFeeSchedule.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.Distribution.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func (m *FeeDistribution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovParams(uint64(m.Epoch))
+	}
+	if m.CommunityPoolWeight != 0 {
+		n += 1 + sovParams(uint64(m.CommunityPoolWeight))
+	}
+	if m.RelayerPoolWeight != 0 {
+		n += 1 + sovParams(uint64(m.RelayerPoolWeight))
+	}
+	if m.SignerPoolWeight != 0 {
+		n += 1 + sovParams(uint64(m.SignerPoolWeight))
+	}
+	if m.InsuranceWeight != 0 {
+		n += 1 + sovParams(uint64(m.InsuranceWeight))
+	}
+	return n
+}
+
+func (m *FeeSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Bps != 0 {
+		n += 1 + sovParams(uint64(m.Bps))
+	}
+	l = len(m.MinFee)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = len(m.MaxFee)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if len(m.Tiers) > 0 {
+		for _, e := range m.Tiers {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FeeTier) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MinAmount)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.Bps != 0 {
+		n += 1 + sovParams(uint64(m.Bps))
+	}
+	return n
+}
+
+func (m *RuneConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	l = len(m.MinDeposit)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = len(m.MinWithdraw)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = len(m.MaxWithdraw)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = len(m.SupplyCap)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.FeeOverride != nil {
+		l = m.FeeOverride.Size()
+		n += 1 + l + sovParams(uint64(l))
+	}
+	return n
+}
+
+func (m *RuneFees) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.DepositFeeSchedule.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.WithdrawFeeSchedule.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func (m *TSSParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DkgTimeoutPeriod)
+	n += 1 + l + sovParams(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ParticipantUpdateTransitionPeriod)
+	n += 1 + l + sovParams(uint64(l))
+	if m.DkgMaxRetries != 0 {
+		n += 1 + sovParams(uint64(m.DkgMaxRetries))
+	}
+	if m.AutoRotationEnabled {
+		n += 2
+	}
+	if m.MaxParticipants != 0 {
+		n += 1 + sovParams(uint64(m.MaxParticipants))
+	}
+	if m.StakeShiftThresholdBps != 0 {
+		n += 1 + sovParams(uint64(m.StakeShiftThresholdBps))
+	}
+	l = m.SignerLiveness.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func (m *SignerLivenessParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Window != 0 {
+		n += 1 + sovParams(uint64(m.Window))
+	}
+	if m.MaxMissedDkgs != 0 {
+		n += 1 + sovParams(uint64(m.MaxMissedDkgs))
+	}
+	if m.MaxMissedSignings != 0 {
+		n += 1 + sovParams(uint64(m.MaxMissedSignings))
+	}
+	if m.SlashFractionBps != 0 {
+		n += 1 + sovParams(uint64(m.SlashFractionBps))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.JailDuration)
+	n += 1 + l + sovParams(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.SigningRoundTimeout)
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func (m *ConsolidationPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UtxoNumTrigger != 0 {
+		n += 1 + sovParams(uint64(m.UtxoNumTrigger))
+	}
+	if m.MaxFeeRate != 0 {
+		n += 1 + sovParams(uint64(m.MaxFeeRate))
+	}
+	if m.BtcTargetThreshold != 0 {
+		n += 1 + sovParams(uint64(m.BtcTargetThreshold))
+	}
+	if len(m.RuneThresholds) > 0 {
+		for _, e := range m.RuneThresholds {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.MaxNum != 0 {
+		n += 1 + sovParams(uint64(m.MaxNum))
+	}
+	if m.Cooldown != 0 {
+		n += 1 + sovParams(uint64(m.Cooldown))
+	}
+	return n
+}
+
+func (m *RuneConsolidationThreshold) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RuneId)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = len(m.TargetThreshold)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositConfirmationDepth", wireType)
+			}
+			m.DepositConfirmationDepth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DepositConfirmationDepth |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawConfirmationDepth", wireType)
+			}
+			m.WithdrawConfirmationDepth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WithdrawConfirmationDepth |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxReorgDepth", wireType)
+			}
+			m.MaxReorgDepth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxReorgDepth |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAcceptableBlockDepth", wireType)
+			}
+			m.MaxAcceptableBlockDepth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAcceptableBlockDepth |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcVoucherDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BtcVoucherDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DepositEnabled = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.WithdrawEnabled = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrustedBtcRelayers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TrustedBtcRelayers = append(m.TrustedBtcRelayers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrustedNonBtcRelayers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TrustedNonBtcRelayers = append(m.TrustedNonBtcRelayers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrustedFeeProviders", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TrustedFeeProviders = append(m.TrustedFeeProviders, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeRateValidityPeriod", wireType)
+			}
+			m.FeeRateValidityPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FeeRateValidityPeriod |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vaults", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Vaults = append(m.Vaults, &Vault{})
+			if err := m.Vaults[len(m.Vaults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.WithdrawParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProtocolLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProtocolFees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TssParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TssParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RuneConfigs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RuneConfigs = append(m.RuneConfigs, RuneConfig{})
+			if err := m.RuneConfigs[len(m.RuneConfigs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawRateLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.WithdrawRateLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Guardians", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Guardians = append(m.Guardians, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPauseDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.MaxPauseDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsolidationPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ConsolidationPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VaultRecoveryPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VaultRecoveryPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VaultAssignmentStrategy", wireType)
+			}
+			m.VaultAssignmentStrategy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VaultAssignmentStrategy |= VaultAssignmentStrategy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Vault) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Vault: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Vault: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetType", wireType)
+			}
+			m.AssetType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AssetType |= AssetType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScriptDescriptor", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ScriptDescriptor == nil {
+				m.ScriptDescriptor = &VaultDescriptor{}
+			}
+			if err := m.ScriptDescriptor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaprootTree", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TaprootTree == nil {
+				m.TaprootTree = &VaultTaprootTree{}
+			}
+			if err := m.TaprootTree.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetirementTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RetirementTime == nil {
+				m.RetirementTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.RetirementTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= VaultState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VaultTaprootTree) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VaultTaprootTree: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VaultTaprootTree: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecoveryScript", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecoveryScript = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VaultRecoveryPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VaultRecoveryPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VaultRecoveryPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKeys", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKeys = append(m.PubKeys, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			m.Threshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Threshold |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timelock", wireType)
+			}
+			m.Timelock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timelock |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VaultDescriptor) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VaultDescriptor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VaultDescriptor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScriptType", wireType)
+			}
+			m.ScriptType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScriptType |= VaultScriptType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			m.Threshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Threshold |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKeys", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKeys = append(m.PubKeys, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WithdrawParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WithdrawParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WithdrawParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxUtxoNum", wireType)
+			}
+			m.MaxUtxoNum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxUtxoNum |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcBatchWithdrawPeriod", wireType)
+			}
+			m.BtcBatchWithdrawPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BtcBatchWithdrawPeriod |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBtcBatchWithdrawNum", wireType)
+			}
+			m.MaxBtcBatchWithdrawNum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBtcBatchWithdrawNum |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoinSelection", wireType)
+			}
+			m.CoinSelection = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CoinSelection |= CoinSelectionStrategy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WithdrawRateLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WithdrawRateLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WithdrawRateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			m.Window = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Window |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalLimit", wireType)
+			}
+			m.GlobalLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GlobalLimit |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetLimits = append(m.AssetLimits, types.Coin{})
+			if err := m.AssetLimits[len(m.AssetLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BreakerThresholdBps", wireType)
+			}
+			m.BreakerThresholdBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BreakerThresholdBps |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProtocolLimits) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProtocolLimits: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProtocolLimits: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcMinDeposit", wireType)
+			}
+			m.BtcMinDeposit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BtcMinDeposit |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcMinWithdraw", wireType)
+			}
+			m.BtcMinWithdraw = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BtcMinWithdraw |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcMaxWithdraw", wireType)
+			}
+			m.BtcMaxWithdraw = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BtcMaxWithdraw |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProtocolFees) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProtocolFees: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProtocolFees: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Collector", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Collector = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositFeeSchedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DepositFeeSchedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawFeeSchedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.WithdrawFeeSchedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Distribution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Distribution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeDistribution) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeDistribution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeDistribution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPoolWeight", wireType)
+			}
+			m.CommunityPoolWeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommunityPoolWeight |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayerPoolWeight", wireType)
+			}
+			m.RelayerPoolWeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RelayerPoolWeight |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignerPoolWeight", wireType)
+			}
+			m.SignerPoolWeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignerPoolWeight |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InsuranceWeight", wireType)
+			}
+			m.InsuranceWeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InsuranceWeight |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *TSSParams) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DkgTimeoutPeriod)
-	n += 1 + l + sovParams(uint64(l))
-	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ParticipantUpdateTransitionPeriod)
-	n += 1 + l + sovParams(uint64(l))
-	return n
-}
-
-func sovParams(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozParams(x uint64) (n int) {
-	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *FeeSchedule) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1202,17 +5547,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: FeeSchedule: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FeeSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DepositConfirmationDepth", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bps", wireType)
 			}
-			m.DepositConfirmationDepth = 0
+			m.Bps = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -1222,16 +5567,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DepositConfirmationDepth |= int32(b&0x7F) << shift
+				m.Bps |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawConfirmationDepth", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinFee", wireType)
 			}
-			m.WithdrawConfirmationDepth = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -1241,16 +5586,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.WithdrawConfirmationDepth |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinFee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxReorgDepth", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFee", wireType)
 			}
-			m.MaxReorgDepth = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -1260,16 +5618,145 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxReorgDepth |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxFee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tiers = append(m.Tiers, FeeTier{})
+			if err := m.Tiers[len(m.Tiers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeTier) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeTier: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeTier: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinAmount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxAcceptableBlockDepth", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bps", wireType)
 			}
-			m.MaxAcceptableBlockDepth = 0
+			m.Bps = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -1279,14 +5766,64 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxAcceptableBlockDepth |= uint64(b&0x7F) << shift
+				m.Bps |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RuneConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RuneConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RuneConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BtcVoucherDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1314,31 +5851,11 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BtcVoucherDenom = string(dAtA[iNdEx:postIndex])
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DepositEnabled", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowParams
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.DepositEnabled = bool(v != 0)
-		case 7:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawEnabled", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -1355,10 +5872,10 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.WithdrawEnabled = bool(v != 0)
-		case 8:
+			m.Enabled = bool(v != 0)
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TrustedBtcRelayers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDeposit", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1386,11 +5903,11 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TrustedBtcRelayers = append(m.TrustedBtcRelayers, string(dAtA[iNdEx:postIndex]))
+			m.MinDeposit = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TrustedNonBtcRelayers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinWithdraw", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1418,11 +5935,11 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TrustedNonBtcRelayers = append(m.TrustedNonBtcRelayers, string(dAtA[iNdEx:postIndex]))
+			m.MinWithdraw = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TrustedFeeProviders", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxWithdraw", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1450,32 +5967,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TrustedFeeProviders = append(m.TrustedFeeProviders, string(dAtA[iNdEx:postIndex]))
+			m.MaxWithdraw = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeeRateValidityPeriod", wireType)
-			}
-			m.FeeRateValidityPeriod = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowParams
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.FeeRateValidityPeriod |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 12:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Vaults", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SupplyCap", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -1485,29 +5983,27 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthParams
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthParams
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Vaults = append(m.Vaults, &Vault{})
-			if err := m.Vaults[len(m.Vaults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SupplyCap = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 13:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawParams", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeOverride", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1534,46 +6030,66 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.WithdrawParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.FeeOverride == nil {
+				m.FeeOverride = &RuneFees{}
+			}
+			if err := m.FeeOverride.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 14:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolLimits", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowParams
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthParams
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthParams
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RuneFees) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ProtocolLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 15:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RuneFees: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RuneFees: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolFees", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositFeeSchedule", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1600,13 +6116,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ProtocolFees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DepositFeeSchedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 16:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TssParams", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawFeeSchedule", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1633,7 +6149,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TssParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.WithdrawFeeSchedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1658,7 +6174,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Vault) Unmarshal(dAtA []byte) error {
+func (m *TSSParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1681,17 +6197,17 @@ func (m *Vault) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Vault: wiretype end group for non-group")
+			return fmt.Errorf("proto: TSSParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Vault: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TSSParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DkgTimeoutPeriod", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -1701,29 +6217,102 @@ func (m *Vault) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthParams
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.DkgTimeoutPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParticipantUpdateTransitionPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthParams
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.ParticipantUpdateTransitionPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DkgMaxRetries", wireType)
+			}
+			m.DkgMaxRetries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DkgMaxRetries |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoRotationEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoRotationEnabled = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxParticipants", wireType)
 			}
-			var stringLen uint64
+			m.MaxParticipants = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -1733,29 +6322,16 @@ func (m *Vault) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MaxParticipants |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthParams
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthParams
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PubKey = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssetType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakeShiftThresholdBps", wireType)
 			}
-			m.AssetType = 0
+			m.StakeShiftThresholdBps = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -1765,16 +6341,16 @@ func (m *Vault) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AssetType |= AssetType(b&0x7F) << shift
+				m.StakeShiftThresholdBps |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignerLiveness", wireType)
 			}
-			m.Version = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -1784,11 +6360,25 @@ func (m *Vault) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Version |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SignerLiveness.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -1810,7 +6400,7 @@ func (m *Vault) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WithdrawParams) Unmarshal(dAtA []byte) error {
+func (m *SignerLivenessParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1833,17 +6423,17 @@ func (m *WithdrawParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WithdrawParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: SignerLivenessParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WithdrawParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SignerLivenessParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxUtxoNum", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
 			}
-			m.MaxUtxoNum = 0
+			m.Window = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -1853,16 +6443,16 @@ func (m *WithdrawParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxUtxoNum |= uint32(b&0x7F) << shift
+				m.Window |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BtcBatchWithdrawPeriod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMissedDkgs", wireType)
 			}
-			m.BtcBatchWithdrawPeriod = 0
+			m.MaxMissedDkgs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -1872,16 +6462,16 @@ func (m *WithdrawParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BtcBatchWithdrawPeriod |= int64(b&0x7F) << shift
+				m.MaxMissedDkgs |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxBtcBatchWithdrawNum", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMissedSignings", wireType)
 			}
-			m.MaxBtcBatchWithdrawNum = 0
+			m.MaxMissedSignings = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -1891,66 +6481,16 @@ func (m *WithdrawParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxBtcBatchWithdrawNum |= uint32(b&0x7F) << shift
+				m.MaxMissedSignings |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipParams(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthParams
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ProtocolLimits) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowParams
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ProtocolLimits: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProtocolLimits: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BtcMinDeposit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionBps", wireType)
 			}
-			m.BtcMinDeposit = 0
+			m.SlashFractionBps = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -1960,16 +6500,16 @@ func (m *ProtocolLimits) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BtcMinDeposit |= int64(b&0x7F) << shift
+				m.SlashFractionBps |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BtcMinWithdraw", wireType)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailDuration", wireType)
 			}
-			m.BtcMinWithdraw = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -1979,16 +6519,30 @@ func (m *ProtocolLimits) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BtcMinWithdraw |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BtcMaxWithdraw", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthParams
 			}
-			m.BtcMaxWithdraw = 0
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.JailDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigningRoundTimeout", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -1998,11 +6552,25 @@ func (m *ProtocolLimits) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BtcMaxWithdraw |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.SigningRoundTimeout, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -2024,7 +6592,7 @@ func (m *ProtocolLimits) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ProtocolFees) Unmarshal(dAtA []byte) error {
+func (m *ConsolidationPolicy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2047,17 +6615,17 @@ func (m *ProtocolFees) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ProtocolFees: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConsolidationPolicy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProtocolFees: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConsolidationPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DepositFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UtxoNumTrigger", wireType)
 			}
-			m.DepositFee = 0
+			m.UtxoNumTrigger = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -2067,16 +6635,16 @@ func (m *ProtocolFees) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DepositFee |= int64(b&0x7F) << shift
+				m.UtxoNumTrigger |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFeeRate", wireType)
 			}
-			m.WithdrawFee = 0
+			m.MaxFeeRate = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -2086,16 +6654,35 @@ func (m *ProtocolFees) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.WithdrawFee |= int64(b&0x7F) << shift
+				m.MaxFeeRate |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcTargetThreshold", wireType)
+			}
+			m.BtcTargetThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BtcTargetThreshold |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Collector", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RuneThresholds", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -2105,24 +6692,64 @@ func (m *ProtocolFees) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthParams
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthParams
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Collector = string(dAtA[iNdEx:postIndex])
+			m.RuneThresholds = append(m.RuneThresholds, RuneConsolidationThreshold{})
+			if err := m.RuneThresholds[len(m.RuneThresholds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxNum", wireType)
+			}
+			m.MaxNum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxNum |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cooldown", wireType)
+			}
+			m.Cooldown = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Cooldown |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -2144,7 +6771,7 @@ func (m *ProtocolFees) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TSSParams) Unmarshal(dAtA []byte) error {
+func (m *RuneConsolidationThreshold) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2167,17 +6794,17 @@ func (m *TSSParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TSSParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: RuneConsolidationThreshold: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TSSParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RuneConsolidationThreshold: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DkgTimeoutPeriod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RuneId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -2187,30 +6814,29 @@ func (m *TSSParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthParams
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthParams
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.DkgTimeoutPeriod, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.RuneId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParticipantUpdateTransitionPeriod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetThreshold", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -2220,24 +6846,23 @@ func (m *TSSParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthParams
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthParams
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.ParticipantUpdateTransitionPeriod, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TargetThreshold = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -0,0 +1,20 @@
+package types
+
+import (
+	"time"
+)
+
+// IsActive returns true if the emergency pause is active at the given time, false otherwise
+func (p *EmergencyPause) IsActive(now time.Time) bool {
+	return !p.Lifted && now.Before(p.Expiration)
+}
+
+// Covers returns true if the emergency pause covers the given scope and asset, false otherwise
+// The asset is only checked for the asset scope
+func (p *EmergencyPause) Covers(scope PauseScope, denom string) bool {
+	if p.Scope != scope {
+		return false
+	}
+
+	return scope != PauseScope_PAUSE_SCOPE_ASSET || p.Denom == denom
+}
@@ -5,6 +5,8 @@ import (
 	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
+
+	errorsmod "cosmossdk.io/errors"
 )
 
 const (
@@ -14,7 +16,7 @@ const (
 	// maximum allowed number of the non-vault outputs for the runes deposit transaction
 	RunesMaxNonVaultOutNum = 3
 
-	// allowed number of edicts in the runes payload for the runes deposit transaction
+	// allowed number of edicts in the runes payload for the runes transaction built by the bridge
 	RunesEdictNum = 1
 
 	// transaction input sequence intended to identify the txs built by the bridge (for relayers' convenience)
@@ -22,8 +24,8 @@ const (
 )
 
 // ExtractRecipientAddr extracts the recipient address for minting voucher token by the type of the asset to be deposited
-func ExtractRecipientAddr(tx *wire.MsgTx, prevTx *wire.MsgTx, vaults []*Vault, isRunes bool, chainCfg *chaincfg.Params) (btcutil.Address, error) {
-	if isRunes {
+func ExtractRecipientAddr(tx *wire.MsgTx, prevTx *wire.MsgTx, vaults []*Vault, assetType AssetType, chainCfg *chaincfg.Params) (btcutil.Address, error) {
+	if assetType == AssetType_ASSET_TYPE_RUNES {
 		return ExtractRunesRecipientAddr(tx, prevTx, vaults, chainCfg)
 	}
 
@@ -125,30 +127,88 @@ func ExtractRunesRecipientAddr(tx *wire.MsgTx, prevTx *wire.MsgTx, vaults []*Vau
 	return pkScript.Address(chainCfg)
 }
 
-// CheckRunesDepositTransaction checks if the given tx is valid runes deposit tx
-func CheckRunesDepositTransaction(tx *wire.MsgTx, vaults []*Vault) (*Edict, error) {
-	edicts, err := ParseRunes(tx)
+// CheckRunesDepositTransaction checks if the given tx is valid runes deposit tx.
+// The runestone can carry multiple edicts for multiple runes, but all the edicts to the vault are required to
+// go to the same runes vault output with the explicit non-zero amount, so that the deposited runes can be determined.
+// The unallocated runes are not allowed to be transferred to the vault by the pointer or default output.
+// Returns the edicts to the runes vault with the same rune id merged.
+func CheckRunesDepositTransaction(tx *wire.MsgTx, vaults []*Vault) ([]*Edict, error) {
+	// the runes are burned if the tx is a cenotaph
+	runestone, err := ParseRunestone(tx)
 	if err != nil {
-		return nil, ErrInvalidDepositTransaction
+		return nil, errorsmod.Wrap(ErrInvalidDepositTransaction, err.Error())
 	}
 
-	if len(edicts) == 0 {
+	if runestone == nil || len(runestone.Edicts) == 0 {
 		return nil, nil
 	}
 
-	if len(edicts) != RunesEdictNum {
+	// the unallocated runes can not go to the vault
+	if output, ok := runestone.DefaultOutput(tx); ok && SelectVaultByPkScript(vaults, tx.TxOut[output].PkScript) != nil {
 		return nil, ErrInvalidDepositTransaction
 	}
 
-	// even split is not supported
-	if edicts[0].Output == uint32(len(tx.TxOut)) {
-		return nil, ErrInvalidDepositTransaction
+	var vaultOutput *uint32
+	var balances RuneBalances
+
+	for _, edict := range runestone.Edicts {
+		// even split is not supported
+		if edict.Output == uint32(len(tx.TxOut)) {
+			return nil, ErrInvalidDepositTransaction
+		}
+
+		vault := SelectVaultByPkScript(vaults, tx.TxOut[edict.Output].PkScript)
+		if vault == nil {
+			continue
+		}
+
+		// the rune etched by the tx is not supported
+		if vault.AssetType != AssetType_ASSET_TYPE_RUNES || edict.Id.Block == 0 {
+			return nil, ErrInvalidDepositTransaction
+		}
+
+		if vaultOutput != nil && *vaultOutput != edict.Output {
+			return nil, ErrInvalidDepositTransaction
+		}
+
+		// zero amount indicates all the remaining runes, which can not be determined
+		amount := RuneAmountFromString(edict.Amount)
+		if amount.IsZero() {
+			return nil, ErrInvalidDepositTransaction
+		}
+
+		output := edict.Output
+		vaultOutput = &output
+
+		i, balance := balances.GetBalance(edict.Id.ToString())
+		if i < 0 {
+			balances = append(balances, &RuneBalance{Id: edict.Id.ToString(), Amount: edict.Amount})
+			continue
+		}
+
+		// check overflow
+		if amount.AddWrap(balance).Cmp(balance) < 0 {
+			return nil, ErrInvalidDepositTransaction
+		}
+
+		balances[i].Amount = balance.Add(amount).String()
 	}
 
-	vault := SelectVaultByPkScript(vaults, tx.TxOut[edicts[0].Output].PkScript)
-	if vault == nil || vault.AssetType != AssetType_ASSET_TYPE_RUNES {
+	if vaultOutput == nil {
 		return nil, ErrInvalidDepositTransaction
 	}
 
-	return edicts[0], nil
+	edicts := make([]*Edict, 0, len(balances))
+	for _, balance := range balances {
+		var id RuneId
+		id.MustUnmarshalFromString(balance.Id)
+
+		edicts = append(edicts, &Edict{
+			Id:     &id,
+			Amount: balance.Amount,
+			Output: *vaultOutput,
+		})
+	}
+
+	return edicts, nil
 }
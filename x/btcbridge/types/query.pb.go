@@ -377,7 +377,8 @@ func (m *QuerySigningRequestRequest) GetSequence() uint64 {
 
 // QuerySigningRequestResponse is response type for the Query/SigningRequest RPC method.
 type QuerySigningRequestResponse struct {
-	Request *SigningRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	Request *SigningRequest               `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	History []*SigningRequestHistoryEntry `protobuf:"bytes,2,rep,name=history,proto3" json:"history,omitempty"`
 }
 
 func (m *QuerySigningRequestResponse) Reset()         { *m = QuerySigningRequestResponse{} }
@@ -420,6 +421,13 @@ func (m *QuerySigningRequestResponse) GetRequest() *SigningRequest {
 	return nil
 }
 
+func (m *QuerySigningRequestResponse) GetHistory() []*SigningRequestHistoryEntry {
+	if m != nil {
+		return m.History
+	}
+	return nil
+}
+
 // QuerySigningRequestsRequest is request type for the Query/SigningRequests RPC method.
 type QuerySigningRequestsRequest struct {
 	Status     SigningStatus      `protobuf:"varint,1,opt,name=status,proto3,enum=side.btcbridge.SigningStatus" json:"status,omitempty"`
@@ -1909,2332 +1917,3011 @@ func (m *QueryDKGCompletionRequestsResponse) GetRequests() []*DKGCompletionReque
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryWithdrawRequestsByAddressRequest)(nil), "side.btcbridge.QueryWithdrawRequestsByAddressRequest")
-	proto.RegisterType((*QueryWithdrawRequestsByAddressResponse)(nil), "side.btcbridge.QueryWithdrawRequestsByAddressResponse")
-	proto.RegisterType((*QueryWithdrawRequestsByTxHashRequest)(nil), "side.btcbridge.QueryWithdrawRequestsByTxHashRequest")
-	proto.RegisterType((*QueryWithdrawRequestsByTxHashResponse)(nil), "side.btcbridge.QueryWithdrawRequestsByTxHashResponse")
-	proto.RegisterType((*QueryPendingBtcWithdrawRequestsRequest)(nil), "side.btcbridge.QueryPendingBtcWithdrawRequestsRequest")
-	proto.RegisterType((*QueryPendingBtcWithdrawRequestsResponse)(nil), "side.btcbridge.QueryPendingBtcWithdrawRequestsResponse")
-	proto.RegisterType((*QuerySigningRequestRequest)(nil), "side.btcbridge.QuerySigningRequestRequest")
-	proto.RegisterType((*QuerySigningRequestResponse)(nil), "side.btcbridge.QuerySigningRequestResponse")
-	proto.RegisterType((*QuerySigningRequestsRequest)(nil), "side.btcbridge.QuerySigningRequestsRequest")
-	proto.RegisterType((*QuerySigningRequestsResponse)(nil), "side.btcbridge.QuerySigningRequestsResponse")
-	proto.RegisterType((*QuerySigningRequestsByAddressRequest)(nil), "side.btcbridge.QuerySigningRequestsByAddressRequest")
-	proto.RegisterType((*QuerySigningRequestsByAddressResponse)(nil), "side.btcbridge.QuerySigningRequestsByAddressResponse")
-	proto.RegisterType((*QuerySigningRequestByTxHashRequest)(nil), "side.btcbridge.QuerySigningRequestByTxHashRequest")
-	proto.RegisterType((*QuerySigningRequestByTxHashResponse)(nil), "side.btcbridge.QuerySigningRequestByTxHashResponse")
-	proto.RegisterType((*QueryFeeRateRequest)(nil), "side.btcbridge.QueryFeeRateRequest")
-	proto.RegisterType((*QueryFeeRateResponse)(nil), "side.btcbridge.QueryFeeRateResponse")
-	proto.RegisterType((*QueryWithdrawalNetworkFeeRequest)(nil), "side.btcbridge.QueryWithdrawalNetworkFeeRequest")
-	proto.RegisterType((*QueryWithdrawalNetworkFeeResponse)(nil), "side.btcbridge.QueryWithdrawalNetworkFeeResponse")
-	proto.RegisterType((*QueryParamsRequest)(nil), "side.btcbridge.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "side.btcbridge.QueryParamsResponse")
-	proto.RegisterType((*QueryChainTipRequest)(nil), "side.btcbridge.QueryChainTipRequest")
-	proto.RegisterType((*QueryChainTipResponse)(nil), "side.btcbridge.QueryChainTipResponse")
-	proto.RegisterType((*QueryBlockHeaderByHeightRequest)(nil), "side.btcbridge.QueryBlockHeaderByHeightRequest")
-	proto.RegisterType((*QueryBlockHeaderByHeightResponse)(nil), "side.btcbridge.QueryBlockHeaderByHeightResponse")
-	proto.RegisterType((*QueryBlockHeaderByHashRequest)(nil), "side.btcbridge.QueryBlockHeaderByHashRequest")
-	proto.RegisterType((*QueryBlockHeaderByHashResponse)(nil), "side.btcbridge.QueryBlockHeaderByHashResponse")
-	proto.RegisterType((*QueryUTXOsRequest)(nil), "side.btcbridge.QueryUTXOsRequest")
-	proto.RegisterType((*QueryUTXOsResponse)(nil), "side.btcbridge.QueryUTXOsResponse")
-	proto.RegisterType((*QueryUTXOsByAddressRequest)(nil), "side.btcbridge.QueryUTXOsByAddressRequest")
-	proto.RegisterType((*QueryUTXOsByAddressResponse)(nil), "side.btcbridge.QueryUTXOsByAddressResponse")
-	proto.RegisterType((*QueryUTXOCountAndBalancesByAddressRequest)(nil), "side.btcbridge.QueryUTXOCountAndBalancesByAddressRequest")
-	proto.RegisterType((*QueryUTXOCountAndBalancesByAddressResponse)(nil), "side.btcbridge.QueryUTXOCountAndBalancesByAddressResponse")
-	proto.RegisterType((*QueryDKGRequestRequest)(nil), "side.btcbridge.QueryDKGRequestRequest")
-	proto.RegisterType((*QueryDKGRequestResponse)(nil), "side.btcbridge.QueryDKGRequestResponse")
-	proto.RegisterType((*QueryDKGRequestsRequest)(nil), "side.btcbridge.QueryDKGRequestsRequest")
-	proto.RegisterType((*QueryDKGRequestsResponse)(nil), "side.btcbridge.QueryDKGRequestsResponse")
-	proto.RegisterType((*QueryAllDKGRequestsRequest)(nil), "side.btcbridge.QueryAllDKGRequestsRequest")
-	proto.RegisterType((*QueryAllDKGRequestsResponse)(nil), "side.btcbridge.QueryAllDKGRequestsResponse")
-	proto.RegisterType((*QueryDKGCompletionRequestsRequest)(nil), "side.btcbridge.QueryDKGCompletionRequestsRequest")
-	proto.RegisterType((*QueryDKGCompletionRequestsResponse)(nil), "side.btcbridge.QueryDKGCompletionRequestsResponse")
+// QueryRunesRequest is the request type for the Query/Runes RPC method.
+type QueryRunesRequest struct {
 }
 
-func init() { proto.RegisterFile("side/btcbridge/query.proto", fileDescriptor_fb547edb49d5502d) }
-
-var fileDescriptor_fb547edb49d5502d = []byte{
-	// 1676 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0xcf, 0x6f, 0xd4, 0xc6,
-	0x17, 0xcf, 0x24, 0x21, 0xc0, 0x04, 0xf8, 0xf2, 0x7d, 0xe4, 0xa7, 0x93, 0x6c, 0x16, 0x13, 0xc8,
-	0x12, 0xc8, 0xba, 0xd9, 0x10, 0x08, 0xa9, 0xda, 0xc2, 0x86, 0x42, 0x5a, 0xa4, 0x42, 0x0d, 0x88,
-	0xaa, 0x17, 0xea, 0xdd, 0x9d, 0xec, 0x5a, 0x6c, 0xec, 0x65, 0xed, 0x85, 0xa4, 0x51, 0x2e, 0x3d,
-	0xb5, 0x55, 0x0f, 0x95, 0x5a, 0xa9, 0xaa, 0xca, 0x0d, 0xa9, 0xa7, 0xaa, 0x3d, 0xf4, 0x52, 0xf5,
-	0xda, 0x0b, 0x6a, 0xa5, 0x0a, 0xa9, 0x97, 0x9e, 0xaa, 0x0a, 0x7a, 0xee, 0xdf, 0x50, 0x79, 0xfc,
-	0xec, 0x5d, 0x8f, 0xed, 0xf5, 0x2e, 0x50, 0x95, 0x4b, 0x62, 0x7b, 0xde, 0x7b, 0x9f, 0xcf, 0x7b,
-	0x33, 0x6f, 0xe6, 0xbd, 0x59, 0x2a, 0x59, 0x7a, 0x89, 0x29, 0x05, 0xbb, 0x58, 0xa8, 0xeb, 0xa5,
-	0x32, 0x53, 0xee, 0x34, 0x58, 0x7d, 0x2b, 0x5b, 0xab, 0x9b, 0xb6, 0x09, 0x07, 0x9c, 0xb1, 0xac,
-	0x3f, 0x26, 0x0d, 0x95, 0xcd, 0xb2, 0xc9, 0x87, 0x14, 0xe7, 0xc9, 0x95, 0x92, 0x26, 0xcb, 0xa6,
-	0x59, 0xae, 0x32, 0x45, 0xab, 0xe9, 0x8a, 0x66, 0x18, 0xa6, 0xad, 0xd9, 0xba, 0x69, 0x58, 0x38,
-	0x3a, 0x57, 0x34, 0xad, 0x0d, 0xd3, 0x52, 0x0a, 0x9a, 0x85, 0xc6, 0x95, 0xbb, 0x0b, 0x05, 0x66,
-	0x6b, 0x0b, 0x4a, 0x4d, 0x2b, 0xeb, 0x06, 0x17, 0x46, 0xd9, 0x09, 0x81, 0x4b, 0x4d, 0xab, 0x6b,
-	0x1b, 0x9e, 0xa1, 0x94, 0x30, 0xe8, 0x3f, 0xb9, 0xe3, 0xf2, 0x47, 0x84, 0x1e, 0x7d, 0xdb, 0xb1,
-	0x7f, 0x53, 0xb7, 0x2b, 0xa5, 0xba, 0x76, 0x4f, 0x65, 0x77, 0x1a, 0xcc, 0xb2, 0xad, 0xfc, 0xd6,
-	0xf9, 0x52, 0xa9, 0xce, 0x2c, 0x0b, 0x3f, 0xc0, 0x18, 0xdd, 0xad, 0xb9, 0x5f, 0xc6, 0x48, 0x9a,
-	0x64, 0xf6, 0xaa, 0xde, 0x2b, 0x5c, 0xa4, 0xb4, 0x49, 0x6a, 0xac, 0x37, 0x4d, 0x32, 0x83, 0xb9,
-	0x63, 0x59, 0xd7, 0x83, 0xac, 0xe3, 0x41, 0xd6, 0x0d, 0x0f, 0x7a, 0x90, 0xbd, 0xaa, 0x95, 0x19,
-	0x5a, 0x55, 0x5b, 0x34, 0xe5, 0x6f, 0x09, 0x3d, 0x96, 0xc4, 0xc5, 0xaa, 0x99, 0x86, 0xc5, 0xe0,
-	0x65, 0xba, 0xa7, 0x8e, 0x83, 0x63, 0x24, 0xdd, 0x97, 0x19, 0xcc, 0x4d, 0x67, 0x83, 0x61, 0xcf,
-	0x0a, 0x46, 0x54, 0x5f, 0x01, 0x2e, 0x45, 0xf0, 0x9d, 0x4d, 0xe4, 0xeb, 0x22, 0x07, 0x08, 0xaf,
-	0xd0, 0x99, 0x18, 0xbe, 0xd7, 0x37, 0xd7, 0x34, 0xab, 0xe2, 0x85, 0x0e, 0x68, 0xbf, 0xbd, 0xa9,
-	0x97, 0x30, 0x6e, 0xfc, 0x59, 0x2e, 0xc5, 0xc6, 0xdd, 0xd3, 0x7d, 0x0e, 0xae, 0xca, 0x35, 0x8c,
-	0xe8, 0x55, 0x66, 0x94, 0x74, 0xa3, 0x9c, 0xb7, 0x8b, 0x22, 0x9e, 0xc7, 0x31, 0x38, 0x89, 0xe4,
-	0xa9, 0x27, 0xf1, 0x3b, 0x42, 0x67, 0x13, 0x21, 0x5f, 0xa8, 0x59, 0x5c, 0xa6, 0x12, 0x27, 0x7c,
-	0x4d, 0x2f, 0x1b, 0xba, 0x51, 0xf6, 0x90, 0x30, 0x2e, 0x12, 0xdd, 0x63, 0x39, 0x8f, 0x46, 0x91,
-	0xf1, 0xa8, 0xf4, 0xab, 0xfe, 0xbb, 0x7c, 0x93, 0x4e, 0x44, 0x6a, 0xa2, 0x7b, 0xcb, 0x74, 0x37,
-	0xb2, 0xc5, 0x78, 0xa6, 0x44, 0xef, 0x04, 0x45, 0x4f, 0x5c, 0xbe, 0x4f, 0x22, 0x2d, 0xfb, 0x93,
-	0xb5, 0x44, 0x07, 0x2c, 0x5b, 0xb3, 0x1b, 0x6e, 0x2a, 0x1e, 0xc8, 0x4d, 0xc5, 0x18, 0xbe, 0xc6,
-	0x85, 0x54, 0x14, 0x7e, 0x6e, 0x89, 0xfa, 0x80, 0xd0, 0xc9, 0x68, 0x7a, 0xe8, 0xf9, 0x4a, 0x68,
-	0x62, 0x93, 0x5c, 0xff, 0x17, 0xe6, 0xf5, 0x43, 0x82, 0xe9, 0x29, 0xb0, 0xfc, 0x0f, 0x76, 0xb6,
-	0x6f, 0xbc, 0x5d, 0x36, 0x9e, 0xca, 0x8b, 0x14, 0xb9, 0x65, 0x2a, 0x47, 0xb0, 0xed, 0x64, 0x57,
-	0xbb, 0x45, 0x8f, 0xb4, 0xd5, 0x7c, 0xe6, 0xcc, 0x18, 0xa6, 0x87, 0x38, 0xc0, 0x45, 0xc6, 0x54,
-	0xcd, 0xf6, 0x82, 0x2d, 0xbf, 0x49, 0x87, 0x82, 0x9f, 0x11, 0x28, 0x47, 0xf7, 0xac, 0x33, 0x76,
-	0xab, 0xae, 0xd9, 0x0c, 0x91, 0x46, 0x45, 0x24, 0x4f, 0x65, 0xf7, 0xba, 0xfb, 0x20, 0x9b, 0x34,
-	0x1d, 0xd8, 0x99, 0xb5, 0xea, 0x5b, 0xcc, 0xbe, 0x67, 0xd6, 0x6f, 0x3b, 0x92, 0x89, 0x4b, 0x66,
-	0x84, 0x0e, 0x68, 0x1b, 0x66, 0xc3, 0xb0, 0xf9, 0x04, 0xec, 0x55, 0xf1, 0x0d, 0xc6, 0x5b, 0x98,
-	0xf4, 0xa5, 0x49, 0xa6, 0xaf, 0x09, 0x78, 0x95, 0x1e, 0x6e, 0x03, 0x88, 0x9e, 0x8c, 0x0b, 0x9e,
-	0x34, 0xf5, 0xe1, 0x20, 0xed, 0x5b, 0x67, 0x0c, 0xf1, 0x9c, 0x47, 0x79, 0x88, 0x82, 0xbb, 0x07,
-	0xf3, 0x52, 0xc0, 0x0b, 0xd2, 0x65, 0x8c, 0x9d, 0xf7, 0x15, 0x2d, 0x9f, 0xa2, 0x03, 0x6e, 0xc9,
-	0x80, 0x11, 0x1a, 0x11, 0x23, 0xe4, 0xca, 0xe7, 0xfb, 0x1f, 0xfe, 0x31, 0xdd, 0xa3, 0xa2, 0xac,
-	0x3c, 0x82, 0x11, 0x5f, 0xad, 0x68, 0xba, 0x71, 0x5d, 0xaf, 0x79, 0x20, 0xab, 0x74, 0x58, 0xf8,
-	0x8e, 0x30, 0x40, 0xfb, 0x2b, 0x9a, 0x55, 0xf1, 0x96, 0x8b, 0xf3, 0xec, 0x04, 0xab, 0xc2, 0xf4,
-	0x72, 0xc5, 0x0d, 0x56, 0xbf, 0x8a, 0x6f, 0xf2, 0x59, 0x3a, 0xcd, 0x8d, 0xe4, 0xab, 0x66, 0xf1,
-	0xf6, 0x1a, 0xd3, 0x4a, 0xac, 0x9e, 0xdf, 0x5a, 0xe3, 0x63, 0xde, 0x0c, 0x34, 0x55, 0x49, 0x40,
-	0xb5, 0x80, 0xb3, 0x17, 0xa9, 0x8a, 0x54, 0x5e, 0xa5, 0xfb, 0x0a, 0xce, 0xf0, 0xad, 0x0a, 0x1f,
-	0x47, 0xbf, 0x27, 0x44, 0xbf, 0x5b, 0x4c, 0xa8, 0x83, 0x85, 0xe6, 0x8b, 0xbc, 0x48, 0xa7, 0x22,
-	0x30, 0x82, 0xa9, 0x21, 0xfa, 0x2a, 0xbf, 0x47, 0x53, 0x71, 0x4a, 0xcf, 0x89, 0xd6, 0x21, 0xfa,
-	0x7f, 0x8e, 0x70, 0xe3, 0xfa, 0x3b, 0x57, 0xfc, 0x49, 0x3f, 0x87, 0x4b, 0x01, 0x3f, 0x22, 0xd4,
-	0x1c, 0xdd, 0xd5, 0xb0, 0x37, 0x4d, 0x6f, 0x8f, 0x19, 0x12, 0x31, 0x1c, 0x69, 0xd5, 0x15, 0x91,
-	0x4f, 0xe3, 0xf9, 0xc8, 0x2d, 0x74, 0xbe, 0x79, 0xca, 0x6f, 0xe0, 0x19, 0x26, 0xea, 0x3d, 0x05,
-	0x85, 0xd7, 0xe9, 0x71, 0xdf, 0xd4, 0xaa, 0x93, 0x4e, 0xe7, 0x8d, 0x52, 0x5e, 0xab, 0x6a, 0x46,
-	0x91, 0x75, 0xc3, 0xe8, 0x4b, 0x42, 0xe7, 0x3a, 0xb1, 0x83, 0x0c, 0x87, 0xe8, 0xae, 0x22, 0xcf,
-	0x64, 0xc7, 0xcc, 0x7e, 0xd5, 0x7d, 0x71, 0xbe, 0xde, 0xd5, 0xaa, 0x0d, 0x37, 0xdf, 0xfa, 0x54,
-	0xf7, 0x05, 0x5e, 0xa3, 0xfb, 0xea, 0x0d, 0x83, 0x79, 0xc6, 0xc6, 0xfa, 0xb8, 0x53, 0xa1, 0xb9,
-	0x53, 0x9b, 0x32, 0x6a, 0x40, 0x41, 0xce, 0xd0, 0x11, 0x4e, 0xed, 0xc2, 0xe5, 0x4b, 0x42, 0x05,
-	0x72, 0x80, 0xf6, 0xe2, 0x2e, 0xdb, 0xaf, 0xf6, 0xea, 0x25, 0xf9, 0x0a, 0x1d, 0x0d, 0x49, 0xfa,
-	0xa9, 0x2c, 0xec, 0xab, 0x92, 0x48, 0xa0, 0x45, 0xc9, 0xdf, 0x53, 0xaf, 0x85, 0x0c, 0xfa, 0xb1,
-	0x5c, 0x16, 0x0a, 0x8d, 0x74, 0xbc, 0xbd, 0x60, 0xad, 0x21, 0xab, 0x74, 0x2c, 0x6c, 0x14, 0x69,
-	0x9e, 0x0e, 0x1d, 0x72, 0xed, 0x78, 0x36, 0xab, 0xd9, 0x49, 0x5c, 0x89, 0xe7, 0xab, 0xd5, 0x30,
-	0x57, 0xf9, 0x06, 0xae, 0x37, 0x71, 0xf4, 0x19, 0x41, 0x17, 0x71, 0x77, 0xbe, 0x70, 0xf9, 0xd2,
-	0xaa, 0xb9, 0x51, 0xab, 0x32, 0xe7, 0x88, 0x14, 0xe3, 0x24, 0xce, 0xd1, 0x3a, 0x9e, 0xa0, 0x31,
-	0x4a, 0x48, 0xe9, 0x5c, 0x88, 0xd2, 0x4c, 0x04, 0xa5, 0x90, 0x81, 0x26, 0xb9, 0xdc, 0xdf, 0xe3,
-	0x74, 0x17, 0x07, 0x82, 0xbb, 0x74, 0xb0, 0x65, 0x73, 0x07, 0x59, 0x34, 0x14, 0x3e, 0x0f, 0xa4,
-	0x23, 0x6d, 0x65, 0x5c, 0x8e, 0x72, 0xea, 0x83, 0xdf, 0xfe, 0xfa, 0xac, 0x77, 0x0c, 0x46, 0x94,
-	0xc8, 0x36, 0x13, 0xb6, 0xe8, 0xfe, 0xc0, 0x7e, 0x0f, 0x33, 0x91, 0x56, 0x85, 0x63, 0x42, 0x3a,
-	0x9a, 0x20, 0x85, 0xe8, 0x13, 0x1c, 0x7d, 0x18, 0x0e, 0x89, 0xe8, 0xb6, 0x5e, 0x83, 0xaf, 0x09,
-	0xae, 0xb1, 0x88, 0xbd, 0x1e, 0x94, 0x48, 0x80, 0xf8, 0x03, 0x45, 0x7a, 0xa9, 0x73, 0x05, 0x24,
-	0x37, 0xcb, 0xc9, 0x1d, 0x86, 0x69, 0x91, 0x9c, 0x7b, 0x14, 0x29, 0xdb, 0xee, 0xff, 0x1d, 0xb8,
-	0x4f, 0x30, 0xb9, 0x43, 0x7b, 0x3f, 0xcc, 0x77, 0x80, 0xda, 0x3c, 0x58, 0xa4, 0x6c, 0xa7, 0xe2,
-	0x48, 0xf1, 0x08, 0xa7, 0x38, 0x05, 0x13, 0x21, 0x8a, 0x9a, 0x55, 0x51, 0xb6, 0x9d, 0xbf, 0x3b,
-	0xf0, 0x3e, 0xdd, 0xd7, 0x5a, 0x3c, 0x41, 0xf4, 0xba, 0x08, 0x56, 0x5c, 0xd2, 0x4c, 0x7b, 0x21,
-	0xc4, 0x9f, 0xe6, 0xf8, 0xe3, 0x30, 0x2a, 0xe2, 0xaf, 0x33, 0xe6, 0x94, 0x32, 0xf0, 0x3d, 0xa1,
-	0xe3, 0xb1, 0xc5, 0x0f, 0x44, 0xcf, 0x49, 0x9b, 0xc2, 0x4c, 0x5a, 0xe8, 0x42, 0x03, 0x39, 0x2e,
-	0x70, 0x8e, 0x27, 0xe0, 0xb8, 0xc8, 0xf1, 0x9e, 0xaf, 0xe5, 0xd0, 0x55, 0x98, 0x65, 0xeb, 0x1b,
-	0xbc, 0x40, 0x86, 0x5f, 0x09, 0x1e, 0xe6, 0xb1, 0x37, 0x15, 0xb0, 0xd4, 0x96, 0x48, 0x5c, 0x2f,
-	0x22, 0x9d, 0xee, 0x56, 0x0d, 0x9d, 0x78, 0x85, 0x3b, 0x71, 0x06, 0x96, 0xda, 0x38, 0xe1, 0xed,
-	0x1a, 0x0a, 0x9e, 0x87, 0xca, 0x36, 0x3e, 0xec, 0xc0, 0x4f, 0x04, 0x4b, 0x9a, 0xb8, 0xeb, 0x08,
-	0x38, 0xd5, 0x21, 0xb1, 0x40, 0x8f, 0x20, 0x2d, 0x75, 0xa9, 0x85, 0xde, 0x2c, 0x71, 0x6f, 0x14,
-	0x98, 0xef, 0xc4, 0x1b, 0x7b, 0x53, 0xd9, 0x76, 0x9a, 0x8f, 0x1d, 0xf8, 0x85, 0x60, 0xdd, 0x18,
-	0x7f, 0xf7, 0x00, 0xd1, 0x01, 0x4e, 0xbc, 0x1f, 0x91, 0xce, 0x74, 0xad, 0x87, 0xbe, 0x9c, 0xe1,
-	0xbe, 0x2c, 0x80, 0xd2, 0x89, 0x2f, 0x05, 0xbb, 0xa8, 0xd4, 0x5c, 0x9b, 0xf0, 0x80, 0x60, 0xbd,
-	0x1e, 0xec, 0x85, 0x60, 0x2e, 0x92, 0x49, 0xe4, 0xed, 0x85, 0x74, 0xa2, 0x23, 0x59, 0x64, 0xba,
-	0xc8, 0x99, 0xce, 0xc3, 0x09, 0x91, 0xa9, 0xe5, 0xca, 0x37, 0x69, 0x6e, 0x7b, 0x57, 0x20, 0x3b,
-	0xf0, 0x15, 0xc1, 0x46, 0x40, 0x68, 0x6d, 0xa1, 0x13, 0x68, 0x3f, 0xba, 0x27, 0x3b, 0x13, 0x46,
-	0xa2, 0x19, 0x4e, 0x54, 0x86, 0x74, 0x12, 0x51, 0x78, 0xe8, 0xad, 0xeb, 0xb8, 0xc6, 0x3b, 0x66,
-	0x5d, 0x27, 0x5c, 0x19, 0xc4, 0xac, 0xeb, 0xa4, 0xee, 0x5e, 0x5e, 0xe1, 0xc4, 0x4f, 0x41, 0x2e,
-	0x31, 0xc2, 0xe1, 0x14, 0xfd, 0x31, 0xfa, 0x4e, 0xc8, 0x4f, 0xd0, 0x5c, 0x07, 0x94, 0xc4, 0xf4,
-	0x5c, 0xec, 0x4a, 0x07, 0x9d, 0xc8, 0x71, 0x27, 0x4e, 0xc2, 0x5c, 0xa2, 0x13, 0xcd, 0xcc, 0xac,
-	0x53, 0xda, 0xec, 0x05, 0xe0, 0x70, 0x24, 0x6c, 0x6b, 0xdb, 0x22, 0xc9, 0xed, 0x44, 0x90, 0xc8,
-	0x14, 0x27, 0x32, 0x0a, 0xc3, 0x22, 0x11, 0xde, 0x34, 0xc0, 0x17, 0x5e, 0xfe, 0x04, 0x1b, 0x90,
-	0x98, 0xfc, 0x89, 0xec, 0x6e, 0x62, 0xf2, 0x27, 0xba, 0xa3, 0x89, 0xaf, 0x07, 0x38, 0x9f, 0x96,
-	0xa9, 0xfc, 0x99, 0x60, 0x79, 0xd8, 0xb6, 0x0f, 0x81, 0xb3, 0xb1, 0xe0, 0x49, 0x3d, 0x90, 0xb4,
-	0xf2, 0x34, 0xaa, 0xe8, 0xc6, 0x3c, 0x77, 0x63, 0x16, 0x8e, 0x26, 0xb8, 0xa1, 0x38, 0x95, 0xbe,
-	0x05, 0x9f, 0x10, 0xfa, 0x3f, 0xa1, 0xd2, 0x87, 0x63, 0x91, 0xf0, 0xa1, 0xd6, 0x46, 0x9a, 0x4d,
-	0x94, 0x4b, 0xca, 0xf8, 0xd2, 0x6d, 0x7f, 0xbd, 0x29, 0xdb, 0xce, 0x4a, 0xfb, 0x98, 0xd0, 0x83,
-	0x62, 0xe3, 0x01, 0x49, 0x38, 0x7e, 0xdc, 0x32, 0xc9, 0x82, 0x49, 0x95, 0x55, 0x0b, 0x23, 0xf8,
-	0xdc, 0x5b, 0x82, 0xc1, 0x9e, 0x24, 0x66, 0x09, 0x46, 0xb6, 0x35, 0x31, 0x4b, 0x30, 0xba, 0xc9,
-	0x91, 0x67, 0x38, 0xab, 0x14, 0x4c, 0xb6, 0x61, 0x65, 0xc1, 0x0f, 0x04, 0x1b, 0xa9, 0xc8, 0xf6,
-	0x04, 0x16, 0xe2, 0x82, 0x10, 0xdb, 0xff, 0x48, 0xb9, 0x6e, 0x54, 0x92, 0x8e, 0x1b, 0x87, 0x6b,
-	0xd1, 0xd7, 0x0b, 0x4c, 0x6f, 0x7e, 0xed, 0xe1, 0xe3, 0x14, 0x79, 0xf4, 0x38, 0x45, 0xfe, 0x7c,
-	0x9c, 0x22, 0x9f, 0x3e, 0x49, 0xf5, 0x3c, 0x7a, 0x92, 0xea, 0xf9, 0xfd, 0x49, 0xaa, 0xe7, 0xdd,
-	0x6c, 0x59, 0xb7, 0x2b, 0x8d, 0x42, 0xb6, 0x68, 0x6e, 0x70, 0x83, 0xfc, 0xf7, 0xad, 0xa2, 0x59,
-	0x75, 0xad, 0x6f, 0xb6, 0xf6, 0x0e, 0x5b, 0x35, 0x66, 0x15, 0x06, 0xb8, 0xc0, 0xe2, 0x3f, 0x01,
-	0x00, 0x00, 0xff, 0xff, 0xe0, 0x7e, 0xc3, 0x84, 0xcb, 0x1b, 0x00, 0x00,
+func (m *QueryRunesRequest) Reset()         { *m = QueryRunesRequest{} }
+func (m *QueryRunesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRunesRequest) ProtoMessage()    {}
+func (*QueryRunesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb547edb49d5502d, []int{40}
+}
+func (m *QueryRunesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRunesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRunesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRunesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRunesRequest.Merge(m, src)
+}
+func (m *QueryRunesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRunesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRunesRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryRunesRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Parameters queries the parameters of the module.
-	QueryParams(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// ChainTip queries the chain tip of the module.
-	QueryChainTip(ctx context.Context, in *QueryChainTipRequest, opts ...grpc.CallOption) (*QueryChainTipResponse, error)
-	// BlockHeaderByHeight queries the block header by height.
-	QueryBlockHeaderByHeight(ctx context.Context, in *QueryBlockHeaderByHeightRequest, opts ...grpc.CallOption) (*QueryBlockHeaderByHeightResponse, error)
-	// BlockHeaderByHash queries the block header by hash.
-	QueryBlockHeaderByHash(ctx context.Context, in *QueryBlockHeaderByHashRequest, opts ...grpc.CallOption) (*QueryBlockHeaderByHashResponse, error)
-	// QueryFeeRate queries the bitcoin network fee rate on the side chain.
-	QueryFeeRate(ctx context.Context, in *QueryFeeRateRequest, opts ...grpc.CallOption) (*QueryFeeRateResponse, error)
-	// QueryWithdrawalNetworkFee queries the estimated btc network fee for the given withdrawal.
-	QueryWithdrawalNetworkFee(ctx context.Context, in *QueryWithdrawalNetworkFeeRequest, opts ...grpc.CallOption) (*QueryWithdrawalNetworkFeeResponse, error)
-	// QueryWithdrawRequestsByAddress queries the withdrawal requests by the given address.
-	QueryWithdrawRequestsByAddress(ctx context.Context, in *QueryWithdrawRequestsByAddressRequest, opts ...grpc.CallOption) (*QueryWithdrawRequestsByAddressResponse, error)
-	// QueryWithdrawRequestsByTxHash queries the withdrawal requests by the given tx hash.
-	QueryWithdrawRequestsByTxHash(ctx context.Context, in *QueryWithdrawRequestsByTxHashRequest, opts ...grpc.CallOption) (*QueryWithdrawRequestsByTxHashResponse, error)
-	// QueryPendingBtcWithdrawRequests queries the pending btc withdrawal requests.
-	QueryPendingBtcWithdrawRequests(ctx context.Context, in *QueryPendingBtcWithdrawRequestsRequest, opts ...grpc.CallOption) (*QueryPendingBtcWithdrawRequestsResponse, error)
-	// QuerySigningRequest queries the signing requests by sequence.
-	QuerySigningRequest(ctx context.Context, in *QuerySigningRequestRequest, opts ...grpc.CallOption) (*QuerySigningRequestResponse, error)
-	// QuerySigningRequests queries the signing requests by the given status.
-	QuerySigningRequests(ctx context.Context, in *QuerySigningRequestsRequest, opts ...grpc.CallOption) (*QuerySigningRequestsResponse, error)
-	// QuerySigningRequestsByAddress queries the signing requests by the given address.
-	QuerySigningRequestsByAddress(ctx context.Context, in *QuerySigningRequestsByAddressRequest, opts ...grpc.CallOption) (*QuerySigningRequestsByAddressResponse, error)
-	// QuerySigningRequestByTxHash queries the signing request by the given tx hash.
-	QuerySigningRequestByTxHash(ctx context.Context, in *QuerySigningRequestByTxHashRequest, opts ...grpc.CallOption) (*QuerySigningRequestByTxHashResponse, error)
-	// QueryUTXOs queries all utxos.
-	QueryUTXOs(ctx context.Context, in *QueryUTXOsRequest, opts ...grpc.CallOption) (*QueryUTXOsResponse, error)
-	// QueryUTXOsByAddress queries the utxos of the given address.
-	QueryUTXOsByAddress(ctx context.Context, in *QueryUTXOsByAddressRequest, opts ...grpc.CallOption) (*QueryUTXOsByAddressResponse, error)
-	// QueryUTXOCountAndBalancesByAddress queries the total count and balances of the unlocked utxos by the given address.
-	QueryUTXOCountAndBalancesByAddress(ctx context.Context, in *QueryUTXOCountAndBalancesByAddressRequest, opts ...grpc.CallOption) (*QueryUTXOCountAndBalancesByAddressResponse, error)
-	// QueryDKGRequest queries the DKG request by the given id.
-	QueryDKGRequest(ctx context.Context, in *QueryDKGRequestRequest, opts ...grpc.CallOption) (*QueryDKGRequestResponse, error)
-	// QueryDKGRequests queries the DKG requests by the given status
-	QueryDKGRequests(ctx context.Context, in *QueryDKGRequestsRequest, opts ...grpc.CallOption) (*QueryDKGRequestsResponse, error)
-	// QueryAllDKGRequests queries all DKG requests.
-	QueryAllDKGRequests(ctx context.Context, in *QueryAllDKGRequestsRequest, opts ...grpc.CallOption) (*QueryAllDKGRequestsResponse, error)
-	// QueryDKGCompletionRequests queries DKG completion requests by the given id.
-	QueryDKGCompletionRequests(ctx context.Context, in *QueryDKGCompletionRequestsRequest, opts ...grpc.CallOption) (*QueryDKGCompletionRequestsResponse, error)
+// QueryRunesResponse is the response type for the Query/Runes RPC method.
+type QueryRunesResponse struct {
+	Runes []*RuneMetadata `protobuf:"bytes,1,rep,name=runes,proto3" json:"runes,omitempty"`
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryRunesResponse) Reset()         { *m = QueryRunesResponse{} }
+func (m *QueryRunesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRunesResponse) ProtoMessage()    {}
+func (*QueryRunesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb547edb49d5502d, []int{41}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryRunesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) QueryParams(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/side.btcbridge.Query/QueryParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryRunesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRunesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *queryClient) QueryChainTip(ctx context.Context, in *QueryChainTipRequest, opts ...grpc.CallOption) (*QueryChainTipResponse, error) {
-	out := new(QueryChainTipResponse)
-	err := c.cc.Invoke(ctx, "/side.btcbridge.Query/QueryChainTip", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryRunesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRunesResponse.Merge(m, src)
 }
-
-func (c *queryClient) QueryBlockHeaderByHeight(ctx context.Context, in *QueryBlockHeaderByHeightRequest, opts ...grpc.CallOption) (*QueryBlockHeaderByHeightResponse, error) {
-	out := new(QueryBlockHeaderByHeightResponse)
-	err := c.cc.Invoke(ctx, "/side.btcbridge.Query/QueryBlockHeaderByHeight", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryRunesResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (c *queryClient) QueryBlockHeaderByHash(ctx context.Context, in *QueryBlockHeaderByHashRequest, opts ...grpc.CallOption) (*QueryBlockHeaderByHashResponse, error) {
-	out := new(QueryBlockHeaderByHashResponse)
-	err := c.cc.Invoke(ctx, "/side.btcbridge.Query/QueryBlockHeaderByHash", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryRunesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRunesResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) QueryFeeRate(ctx context.Context, in *QueryFeeRateRequest, opts ...grpc.CallOption) (*QueryFeeRateResponse, error) {
-	out := new(QueryFeeRateResponse)
-	err := c.cc.Invoke(ctx, "/side.btcbridge.Query/QueryFeeRate", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryRunesResponse proto.InternalMessageInfo
+
+func (m *QueryRunesResponse) GetRunes() []*RuneMetadata {
+	if m != nil {
+		return m.Runes
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) QueryWithdrawalNetworkFee(ctx context.Context, in *QueryWithdrawalNetworkFeeRequest, opts ...grpc.CallOption) (*QueryWithdrawalNetworkFeeResponse, error) {
-	out := new(QueryWithdrawalNetworkFeeResponse)
-	err := c.cc.Invoke(ctx, "/side.btcbridge.Query/QueryWithdrawalNetworkFee", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryRuneRequest is the request type for the Query/Rune RPC method.
+type QueryRuneRequest struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (c *queryClient) QueryWithdrawRequestsByAddress(ctx context.Context, in *QueryWithdrawRequestsByAddressRequest, opts ...grpc.CallOption) (*QueryWithdrawRequestsByAddressResponse, error) {
-	out := new(QueryWithdrawRequestsByAddressResponse)
-	err := c.cc.Invoke(ctx, "/side.btcbridge.Query/QueryWithdrawRequestsByAddress", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryRuneRequest) Reset()         { *m = QueryRuneRequest{} }
+func (m *QueryRuneRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRuneRequest) ProtoMessage()    {}
+func (*QueryRuneRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb547edb49d5502d, []int{42}
+}
+func (m *QueryRuneRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRuneRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRuneRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryRuneRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRuneRequest.Merge(m, src)
+}
+func (m *QueryRuneRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRuneRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRuneRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) QueryWithdrawRequestsByTxHash(ctx context.Context, in *QueryWithdrawRequestsByTxHashRequest, opts ...grpc.CallOption) (*QueryWithdrawRequestsByTxHashResponse, error) {
-	out := new(QueryWithdrawRequestsByTxHashResponse)
-	err := c.cc.Invoke(ctx, "/side.btcbridge.Query/QueryWithdrawRequestsByTxHash", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryRuneRequest proto.InternalMessageInfo
+
+func (m *QueryRuneRequest) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) QueryPendingBtcWithdrawRequests(ctx context.Context, in *QueryPendingBtcWithdrawRequestsRequest, opts ...grpc.CallOption) (*QueryPendingBtcWithdrawRequestsResponse, error) {
-	out := new(QueryPendingBtcWithdrawRequestsResponse)
-	err := c.cc.Invoke(ctx, "/side.btcbridge.Query/QueryPendingBtcWithdrawRequests", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryRuneResponse is the response type for the Query/Rune RPC method.
+type QueryRuneResponse struct {
+	Rune *RuneMetadata `protobuf:"bytes,1,opt,name=rune,proto3" json:"rune,omitempty"`
 }
 
-func (c *queryClient) QuerySigningRequest(ctx context.Context, in *QuerySigningRequestRequest, opts ...grpc.CallOption) (*QuerySigningRequestResponse, error) {
-	out := new(QuerySigningRequestResponse)
-	err := c.cc.Invoke(ctx, "/side.btcbridge.Query/QuerySigningRequest", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryRuneResponse) Reset()         { *m = QueryRuneResponse{} }
+func (m *QueryRuneResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRuneResponse) ProtoMessage()    {}
+func (*QueryRuneResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb547edb49d5502d, []int{43}
+}
+func (m *QueryRuneResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRuneResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRuneResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryRuneResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRuneResponse.Merge(m, src)
+}
+func (m *QueryRuneResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRuneResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRuneResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) QuerySigningRequests(ctx context.Context, in *QuerySigningRequestsRequest, opts ...grpc.CallOption) (*QuerySigningRequestsResponse, error) {
-	out := new(QuerySigningRequestsResponse)
-	err := c.cc.Invoke(ctx, "/side.btcbridge.Query/QuerySigningRequests", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryRuneResponse proto.InternalMessageInfo
+
+func (m *QueryRuneResponse) GetRune() *RuneMetadata {
+	if m != nil {
+		return m.Rune
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) QuerySigningRequestsByAddress(ctx context.Context, in *QuerySigningRequestsByAddressRequest, opts ...grpc.CallOption) (*QuerySigningRequestsByAddressResponse, error) {
-	out := new(QuerySigningRequestsByAddressResponse)
-	err := c.cc.Invoke(ctx, "/side.btcbridge.Query/QuerySigningRequestsByAddress", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryRuneConfigsRequest is the request type for the Query/RuneConfigs RPC method.
+type QueryRuneConfigsRequest struct {
+	// optional rune id
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (c *queryClient) QuerySigningRequestByTxHash(ctx context.Context, in *QuerySigningRequestByTxHashRequest, opts ...grpc.CallOption) (*QuerySigningRequestByTxHashResponse, error) {
-	out := new(QuerySigningRequestByTxHashResponse)
-	err := c.cc.Invoke(ctx, "/side.btcbridge.Query/QuerySigningRequestByTxHash", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryRuneConfigsRequest) Reset()         { *m = QueryRuneConfigsRequest{} }
+func (m *QueryRuneConfigsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRuneConfigsRequest) ProtoMessage()    {}
+func (*QueryRuneConfigsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb547edb49d5502d, []int{44}
+}
+func (m *QueryRuneConfigsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRuneConfigsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRuneConfigsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryRuneConfigsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRuneConfigsRequest.Merge(m, src)
+}
+func (m *QueryRuneConfigsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRuneConfigsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRuneConfigsRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) QueryUTXOs(ctx context.Context, in *QueryUTXOsRequest, opts ...grpc.CallOption) (*QueryUTXOsResponse, error) {
-	out := new(QueryUTXOsResponse)
-	err := c.cc.Invoke(ctx, "/side.btcbridge.Query/QueryUTXOs", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryRuneConfigsRequest proto.InternalMessageInfo
+
+func (m *QueryRuneConfigsRequest) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) QueryUTXOsByAddress(ctx context.Context, in *QueryUTXOsByAddressRequest, opts ...grpc.CallOption) (*QueryUTXOsByAddressResponse, error) {
-	out := new(QueryUTXOsByAddressResponse)
-	err := c.cc.Invoke(ctx, "/side.btcbridge.Query/QueryUTXOsByAddress", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryRuneConfigsResponse is the response type for the Query/RuneConfigs RPC method.
+type QueryRuneConfigsResponse struct {
+	Configs []*RuneConfigWithSupply `protobuf:"bytes,1,rep,name=configs,proto3" json:"configs,omitempty"`
 }
 
-func (c *queryClient) QueryUTXOCountAndBalancesByAddress(ctx context.Context, in *QueryUTXOCountAndBalancesByAddressRequest, opts ...grpc.CallOption) (*QueryUTXOCountAndBalancesByAddressResponse, error) {
-	out := new(QueryUTXOCountAndBalancesByAddressResponse)
-	err := c.cc.Invoke(ctx, "/side.btcbridge.Query/QueryUTXOCountAndBalancesByAddress", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryRuneConfigsResponse) Reset()         { *m = QueryRuneConfigsResponse{} }
+func (m *QueryRuneConfigsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRuneConfigsResponse) ProtoMessage()    {}
+func (*QueryRuneConfigsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb547edb49d5502d, []int{45}
+}
+func (m *QueryRuneConfigsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRuneConfigsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRuneConfigsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryRuneConfigsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRuneConfigsResponse.Merge(m, src)
+}
+func (m *QueryRuneConfigsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRuneConfigsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRuneConfigsResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) QueryDKGRequest(ctx context.Context, in *QueryDKGRequestRequest, opts ...grpc.CallOption) (*QueryDKGRequestResponse, error) {
-	out := new(QueryDKGRequestResponse)
-	err := c.cc.Invoke(ctx, "/side.btcbridge.Query/QueryDKGRequest", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryRuneConfigsResponse proto.InternalMessageInfo
+
+func (m *QueryRuneConfigsResponse) GetConfigs() []*RuneConfigWithSupply {
+	if m != nil {
+		return m.Configs
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) QueryDKGRequests(ctx context.Context, in *QueryDKGRequestsRequest, opts ...grpc.CallOption) (*QueryDKGRequestsResponse, error) {
-	out := new(QueryDKGRequestsResponse)
-	err := c.cc.Invoke(ctx, "/side.btcbridge.Query/QueryDKGRequests", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// RuneConfigWithSupply defines the rune configuration along with the current voucher supply
+type RuneConfigWithSupply struct {
+	Config RuneConfig `protobuf:"bytes,1,opt,name=config,proto3" json:"config"`
+	// current supply of the voucher token
+	Supply string `protobuf:"bytes,2,opt,name=supply,proto3" json:"supply,omitempty"`
 }
 
-func (c *queryClient) QueryAllDKGRequests(ctx context.Context, in *QueryAllDKGRequestsRequest, opts ...grpc.CallOption) (*QueryAllDKGRequestsResponse, error) {
-	out := new(QueryAllDKGRequestsResponse)
-	err := c.cc.Invoke(ctx, "/side.btcbridge.Query/QueryAllDKGRequests", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *RuneConfigWithSupply) Reset()         { *m = RuneConfigWithSupply{} }
+func (m *RuneConfigWithSupply) String() string { return proto.CompactTextString(m) }
+func (*RuneConfigWithSupply) ProtoMessage()    {}
+func (*RuneConfigWithSupply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb547edb49d5502d, []int{46}
+}
+func (m *RuneConfigWithSupply) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RuneConfigWithSupply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RuneConfigWithSupply.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *RuneConfigWithSupply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RuneConfigWithSupply.Merge(m, src)
+}
+func (m *RuneConfigWithSupply) XXX_Size() int {
+	return m.Size()
+}
+func (m *RuneConfigWithSupply) XXX_DiscardUnknown() {
+	xxx_messageInfo_RuneConfigWithSupply.DiscardUnknown(m)
 }
 
-func (c *queryClient) QueryDKGCompletionRequests(ctx context.Context, in *QueryDKGCompletionRequestsRequest, opts ...grpc.CallOption) (*QueryDKGCompletionRequestsResponse, error) {
-	out := new(QueryDKGCompletionRequestsResponse)
-	err := c.cc.Invoke(ctx, "/side.btcbridge.Query/QueryDKGCompletionRequests", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_RuneConfigWithSupply proto.InternalMessageInfo
+
+func (m *RuneConfigWithSupply) GetConfig() RuneConfig {
+	if m != nil {
+		return m.Config
 	}
-	return out, nil
+	return RuneConfig{}
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Parameters queries the parameters of the module.
-	QueryParams(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// ChainTip queries the chain tip of the module.
-	QueryChainTip(context.Context, *QueryChainTipRequest) (*QueryChainTipResponse, error)
-	// BlockHeaderByHeight queries the block header by height.
-	QueryBlockHeaderByHeight(context.Context, *QueryBlockHeaderByHeightRequest) (*QueryBlockHeaderByHeightResponse, error)
-	// BlockHeaderByHash queries the block header by hash.
-	QueryBlockHeaderByHash(context.Context, *QueryBlockHeaderByHashRequest) (*QueryBlockHeaderByHashResponse, error)
-	// QueryFeeRate queries the bitcoin network fee rate on the side chain.
-	QueryFeeRate(context.Context, *QueryFeeRateRequest) (*QueryFeeRateResponse, error)
-	// QueryWithdrawalNetworkFee queries the estimated btc network fee for the given withdrawal.
-	QueryWithdrawalNetworkFee(context.Context, *QueryWithdrawalNetworkFeeRequest) (*QueryWithdrawalNetworkFeeResponse, error)
-	// QueryWithdrawRequestsByAddress queries the withdrawal requests by the given address.
-	QueryWithdrawRequestsByAddress(context.Context, *QueryWithdrawRequestsByAddressRequest) (*QueryWithdrawRequestsByAddressResponse, error)
-	// QueryWithdrawRequestsByTxHash queries the withdrawal requests by the given tx hash.
-	QueryWithdrawRequestsByTxHash(context.Context, *QueryWithdrawRequestsByTxHashRequest) (*QueryWithdrawRequestsByTxHashResponse, error)
-	// QueryPendingBtcWithdrawRequests queries the pending btc withdrawal requests.
-	QueryPendingBtcWithdrawRequests(context.Context, *QueryPendingBtcWithdrawRequestsRequest) (*QueryPendingBtcWithdrawRequestsResponse, error)
-	// QuerySigningRequest queries the signing requests by sequence.
-	QuerySigningRequest(context.Context, *QuerySigningRequestRequest) (*QuerySigningRequestResponse, error)
-	// QuerySigningRequests queries the signing requests by the given status.
-	QuerySigningRequests(context.Context, *QuerySigningRequestsRequest) (*QuerySigningRequestsResponse, error)
-	// QuerySigningRequestsByAddress queries the signing requests by the given address.
-	QuerySigningRequestsByAddress(context.Context, *QuerySigningRequestsByAddressRequest) (*QuerySigningRequestsByAddressResponse, error)
-	// QuerySigningRequestByTxHash queries the signing request by the given tx hash.
-	QuerySigningRequestByTxHash(context.Context, *QuerySigningRequestByTxHashRequest) (*QuerySigningRequestByTxHashResponse, error)
-	// QueryUTXOs queries all utxos.
-	QueryUTXOs(context.Context, *QueryUTXOsRequest) (*QueryUTXOsResponse, error)
-	// QueryUTXOsByAddress queries the utxos of the given address.
-	QueryUTXOsByAddress(context.Context, *QueryUTXOsByAddressRequest) (*QueryUTXOsByAddressResponse, error)
-	// QueryUTXOCountAndBalancesByAddress queries the total count and balances of the unlocked utxos by the given address.
-	QueryUTXOCountAndBalancesByAddress(context.Context, *QueryUTXOCountAndBalancesByAddressRequest) (*QueryUTXOCountAndBalancesByAddressResponse, error)
-	// QueryDKGRequest queries the DKG request by the given id.
-	QueryDKGRequest(context.Context, *QueryDKGRequestRequest) (*QueryDKGRequestResponse, error)
-	// QueryDKGRequests queries the DKG requests by the given status
-	QueryDKGRequests(context.Context, *QueryDKGRequestsRequest) (*QueryDKGRequestsResponse, error)
-	// QueryAllDKGRequests queries all DKG requests.
-	QueryAllDKGRequests(context.Context, *QueryAllDKGRequestsRequest) (*QueryAllDKGRequestsResponse, error)
-	// QueryDKGCompletionRequests queries DKG completion requests by the given id.
-	QueryDKGCompletionRequests(context.Context, *QueryDKGCompletionRequestsRequest) (*QueryDKGCompletionRequestsResponse, error)
+func (m *RuneConfigWithSupply) GetSupply() string {
+	if m != nil {
+		return m.Supply
+	}
+	return ""
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+// QueryProtocolFeesRequest is the request type for the Query/ProtocolFees RPC method.
+type QueryProtocolFeesRequest struct {
+	// amount to be deposited or withdrawn, e.g. 100000sat
+	Amount string `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
 }
 
-func (*UnimplementedQueryServer) QueryParams(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryParams not implemented")
+func (m *QueryProtocolFeesRequest) Reset()         { *m = QueryProtocolFeesRequest{} }
+func (m *QueryProtocolFeesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProtocolFeesRequest) ProtoMessage()    {}
+func (*QueryProtocolFeesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb547edb49d5502d, []int{47}
 }
-func (*UnimplementedQueryServer) QueryChainTip(ctx context.Context, req *QueryChainTipRequest) (*QueryChainTipResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryChainTip not implemented")
+func (m *QueryProtocolFeesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) QueryBlockHeaderByHeight(ctx context.Context, req *QueryBlockHeaderByHeightRequest) (*QueryBlockHeaderByHeightResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryBlockHeaderByHeight not implemented")
+func (m *QueryProtocolFeesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProtocolFeesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) QueryBlockHeaderByHash(ctx context.Context, req *QueryBlockHeaderByHashRequest) (*QueryBlockHeaderByHashResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryBlockHeaderByHash not implemented")
+func (m *QueryProtocolFeesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProtocolFeesRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) QueryFeeRate(ctx context.Context, req *QueryFeeRateRequest) (*QueryFeeRateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryFeeRate not implemented")
+func (m *QueryProtocolFeesRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) QueryWithdrawalNetworkFee(ctx context.Context, req *QueryWithdrawalNetworkFeeRequest) (*QueryWithdrawalNetworkFeeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryWithdrawalNetworkFee not implemented")
+func (m *QueryProtocolFeesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProtocolFeesRequest.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) QueryWithdrawRequestsByAddress(ctx context.Context, req *QueryWithdrawRequestsByAddressRequest) (*QueryWithdrawRequestsByAddressResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryWithdrawRequestsByAddress not implemented")
+
+var xxx_messageInfo_QueryProtocolFeesRequest proto.InternalMessageInfo
+
+func (m *QueryProtocolFeesRequest) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
 }
-func (*UnimplementedQueryServer) QueryWithdrawRequestsByTxHash(ctx context.Context, req *QueryWithdrawRequestsByTxHashRequest) (*QueryWithdrawRequestsByTxHashResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryWithdrawRequestsByTxHash not implemented")
+
+// QueryProtocolFeesResponse is the response type for the Query/ProtocolFees RPC method.
+type QueryProtocolFeesResponse struct {
+	// protocol fee for deposit
+	DepositFee string `protobuf:"bytes,1,opt,name=deposit_fee,json=depositFee,proto3" json:"deposit_fee,omitempty"`
+	// protocol fee for withdrawal
+	WithdrawFee string `protobuf:"bytes,2,opt,name=withdraw_fee,json=withdrawFee,proto3" json:"withdraw_fee,omitempty"`
 }
-func (*UnimplementedQueryServer) QueryPendingBtcWithdrawRequests(ctx context.Context, req *QueryPendingBtcWithdrawRequestsRequest) (*QueryPendingBtcWithdrawRequestsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryPendingBtcWithdrawRequests not implemented")
+
+func (m *QueryProtocolFeesResponse) Reset()         { *m = QueryProtocolFeesResponse{} }
+func (m *QueryProtocolFeesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProtocolFeesResponse) ProtoMessage()    {}
+func (*QueryProtocolFeesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb547edb49d5502d, []int{48}
 }
-func (*UnimplementedQueryServer) QuerySigningRequest(ctx context.Context, req *QuerySigningRequestRequest) (*QuerySigningRequestResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QuerySigningRequest not implemented")
+func (m *QueryProtocolFeesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) QuerySigningRequests(ctx context.Context, req *QuerySigningRequestsRequest) (*QuerySigningRequestsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QuerySigningRequests not implemented")
+func (m *QueryProtocolFeesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProtocolFeesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) QuerySigningRequestsByAddress(ctx context.Context, req *QuerySigningRequestsByAddressRequest) (*QuerySigningRequestsByAddressResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QuerySigningRequestsByAddress not implemented")
+func (m *QueryProtocolFeesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProtocolFeesResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) QuerySigningRequestByTxHash(ctx context.Context, req *QuerySigningRequestByTxHashRequest) (*QuerySigningRequestByTxHashResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QuerySigningRequestByTxHash not implemented")
+func (m *QueryProtocolFeesResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) QueryUTXOs(ctx context.Context, req *QueryUTXOsRequest) (*QueryUTXOsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryUTXOs not implemented")
+func (m *QueryProtocolFeesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProtocolFeesResponse.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) QueryUTXOsByAddress(ctx context.Context, req *QueryUTXOsByAddressRequest) (*QueryUTXOsByAddressResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryUTXOsByAddress not implemented")
+
+var xxx_messageInfo_QueryProtocolFeesResponse proto.InternalMessageInfo
+
+func (m *QueryProtocolFeesResponse) GetDepositFee() string {
+	if m != nil {
+		return m.DepositFee
+	}
+	return ""
 }
-func (*UnimplementedQueryServer) QueryUTXOCountAndBalancesByAddress(ctx context.Context, req *QueryUTXOCountAndBalancesByAddressRequest) (*QueryUTXOCountAndBalancesByAddressResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryUTXOCountAndBalancesByAddress not implemented")
+
+func (m *QueryProtocolFeesResponse) GetWithdrawFee() string {
+	if m != nil {
+		return m.WithdrawFee
+	}
+	return ""
 }
-func (*UnimplementedQueryServer) QueryDKGRequest(ctx context.Context, req *QueryDKGRequestRequest) (*QueryDKGRequestResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryDKGRequest not implemented")
+
+// QueryFeeDistributionRequest is the request type for the Query/FeeDistribution RPC method.
+type QueryFeeDistributionRequest struct {
 }
-func (*UnimplementedQueryServer) QueryDKGRequests(ctx context.Context, req *QueryDKGRequestsRequest) (*QueryDKGRequestsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryDKGRequests not implemented")
+
+func (m *QueryFeeDistributionRequest) Reset()         { *m = QueryFeeDistributionRequest{} }
+func (m *QueryFeeDistributionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeDistributionRequest) ProtoMessage()    {}
+func (*QueryFeeDistributionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb547edb49d5502d, []int{49}
 }
-func (*UnimplementedQueryServer) QueryAllDKGRequests(ctx context.Context, req *QueryAllDKGRequestsRequest) (*QueryAllDKGRequestsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryAllDKGRequests not implemented")
+func (m *QueryFeeDistributionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) QueryDKGCompletionRequests(ctx context.Context, req *QueryDKGCompletionRequestsRequest) (*QueryDKGCompletionRequestsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryDKGCompletionRequests not implemented")
+func (m *QueryFeeDistributionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeDistributionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeDistributionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeDistributionRequest.Merge(m, src)
+}
+func (m *QueryFeeDistributionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeDistributionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeDistributionRequest.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+var xxx_messageInfo_QueryFeeDistributionRequest proto.InternalMessageInfo
+
+// QueryFeeDistributionResponse is the response type for the Query/FeeDistribution RPC method.
+type QueryFeeDistributionResponse struct {
+	Buckets []*FeeBucketStats `protobuf:"bytes,1,rep,name=buckets,proto3" json:"buckets,omitempty"`
+	// block height of the last settlement
+	LastSettlementHeight int64 `protobuf:"varint,2,opt,name=last_settlement_height,json=lastSettlementHeight,proto3" json:"last_settlement_height,omitempty"`
 }
 
-func _Query_QueryParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/side.btcbridge.Query/QueryParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryParams(ctx, req.(*QueryParamsRequest))
+func (m *QueryFeeDistributionResponse) Reset()         { *m = QueryFeeDistributionResponse{} }
+func (m *QueryFeeDistributionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeDistributionResponse) ProtoMessage()    {}
+func (*QueryFeeDistributionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb547edb49d5502d, []int{50}
+}
+func (m *QueryFeeDistributionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeDistributionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeDistributionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryFeeDistributionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeDistributionResponse.Merge(m, src)
+}
+func (m *QueryFeeDistributionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeDistributionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeDistributionResponse.DiscardUnknown(m)
 }
 
-func _Query_QueryChainTip_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryChainTipRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryChainTip(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/side.btcbridge.Query/QueryChainTip",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryChainTip(ctx, req.(*QueryChainTipRequest))
+var xxx_messageInfo_QueryFeeDistributionResponse proto.InternalMessageInfo
+
+func (m *QueryFeeDistributionResponse) GetBuckets() []*FeeBucketStats {
+	if m != nil {
+		return m.Buckets
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_QueryBlockHeaderByHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryBlockHeaderByHeightRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryBlockHeaderByHeight(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/side.btcbridge.Query/QueryBlockHeaderByHeight",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryBlockHeaderByHeight(ctx, req.(*QueryBlockHeaderByHeightRequest))
+func (m *QueryFeeDistributionResponse) GetLastSettlementHeight() int64 {
+	if m != nil {
+		return m.LastSettlementHeight
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_QueryBlockHeaderByHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryBlockHeaderByHashRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryBlockHeaderByHash(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/side.btcbridge.Query/QueryBlockHeaderByHash",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryBlockHeaderByHash(ctx, req.(*QueryBlockHeaderByHashRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryOutflowUsageRequest is the request type for the Query/OutflowUsage RPC method.
+type QueryOutflowUsageRequest struct {
+	// optional asset denom
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
-func _Query_QueryFeeRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryFeeRateRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryFeeRate(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/side.btcbridge.Query/QueryFeeRate",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryFeeRate(ctx, req.(*QueryFeeRateRequest))
+func (m *QueryOutflowUsageRequest) Reset()         { *m = QueryOutflowUsageRequest{} }
+func (m *QueryOutflowUsageRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryOutflowUsageRequest) ProtoMessage()    {}
+func (*QueryOutflowUsageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb547edb49d5502d, []int{51}
+}
+func (m *QueryOutflowUsageRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOutflowUsageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOutflowUsageRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryOutflowUsageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOutflowUsageRequest.Merge(m, src)
+}
+func (m *QueryOutflowUsageRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOutflowUsageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOutflowUsageRequest.DiscardUnknown(m)
 }
 
-func _Query_QueryWithdrawalNetworkFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryWithdrawalNetworkFeeRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryWithdrawalNetworkFee(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/side.btcbridge.Query/QueryWithdrawalNetworkFee",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryWithdrawalNetworkFee(ctx, req.(*QueryWithdrawalNetworkFeeRequest))
+var xxx_messageInfo_QueryOutflowUsageRequest proto.InternalMessageInfo
+
+func (m *QueryOutflowUsageRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_QueryWithdrawRequestsByAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryWithdrawRequestsByAddressRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryWithdrawRequestsByAddress(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/side.btcbridge.Query/QueryWithdrawRequestsByAddress",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryWithdrawRequestsByAddress(ctx, req.(*QueryWithdrawRequestsByAddressRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryOutflowUsageResponse is the response type for the Query/OutflowUsage RPC method.
+type QueryOutflowUsageResponse struct {
+	// rolling window in blocks
+	Window int64 `protobuf:"varint,1,opt,name=window,proto3" json:"window,omitempty"`
+	// global btc outflow in sat
+	Global *OutflowUsage `protobuf:"bytes,2,opt,name=global,proto3" json:"global,omitempty"`
+	// outflow of the assets
+	Assets []*OutflowUsage `protobuf:"bytes,3,rep,name=assets,proto3" json:"assets,omitempty"`
+	// circuit breaker state
+	Breaker *CircuitBreaker `protobuf:"bytes,4,opt,name=breaker,proto3" json:"breaker,omitempty"`
 }
 
-func _Query_QueryWithdrawRequestsByTxHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryWithdrawRequestsByTxHashRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryWithdrawRequestsByTxHash(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/side.btcbridge.Query/QueryWithdrawRequestsByTxHash",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryWithdrawRequestsByTxHash(ctx, req.(*QueryWithdrawRequestsByTxHashRequest))
+func (m *QueryOutflowUsageResponse) Reset()         { *m = QueryOutflowUsageResponse{} }
+func (m *QueryOutflowUsageResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryOutflowUsageResponse) ProtoMessage()    {}
+func (*QueryOutflowUsageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb547edb49d5502d, []int{52}
+}
+func (m *QueryOutflowUsageResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOutflowUsageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOutflowUsageResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryOutflowUsageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOutflowUsageResponse.Merge(m, src)
+}
+func (m *QueryOutflowUsageResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOutflowUsageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOutflowUsageResponse.DiscardUnknown(m)
 }
 
-func _Query_QueryPendingBtcWithdrawRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPendingBtcWithdrawRequestsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryPendingBtcWithdrawRequests(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/side.btcbridge.Query/QueryPendingBtcWithdrawRequests",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryPendingBtcWithdrawRequests(ctx, req.(*QueryPendingBtcWithdrawRequestsRequest))
+var xxx_messageInfo_QueryOutflowUsageResponse proto.InternalMessageInfo
+
+func (m *QueryOutflowUsageResponse) GetWindow() int64 {
+	if m != nil {
+		return m.Window
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_QuerySigningRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QuerySigningRequestRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QuerySigningRequest(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/side.btcbridge.Query/QuerySigningRequest",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QuerySigningRequest(ctx, req.(*QuerySigningRequestRequest))
+func (m *QueryOutflowUsageResponse) GetGlobal() *OutflowUsage {
+	if m != nil {
+		return m.Global
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_QuerySigningRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QuerySigningRequestsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QuerySigningRequests(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/side.btcbridge.Query/QuerySigningRequests",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QuerySigningRequests(ctx, req.(*QuerySigningRequestsRequest))
+func (m *QueryOutflowUsageResponse) GetAssets() []*OutflowUsage {
+	if m != nil {
+		return m.Assets
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_QuerySigningRequestsByAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QuerySigningRequestsByAddressRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QuerySigningRequestsByAddress(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/side.btcbridge.Query/QuerySigningRequestsByAddress",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QuerySigningRequestsByAddress(ctx, req.(*QuerySigningRequestsByAddressRequest))
+func (m *QueryOutflowUsageResponse) GetBreaker() *CircuitBreaker {
+	if m != nil {
+		return m.Breaker
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_QuerySigningRequestByTxHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QuerySigningRequestByTxHashRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QuerySigningRequestByTxHash(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/side.btcbridge.Query/QuerySigningRequestByTxHash",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QuerySigningRequestByTxHash(ctx, req.(*QuerySigningRequestByTxHashRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryEmergencyPausesRequest is the request type for the Query/EmergencyPauses RPC method.
+type QueryEmergencyPausesRequest struct {
+	// Indicates if only the active pauses are queried
+	ActiveOnly bool `protobuf:"varint,1,opt,name=active_only,json=activeOnly,proto3" json:"active_only,omitempty"`
 }
 
-func _Query_QueryUTXOs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryUTXOsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryUTXOs(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/side.btcbridge.Query/QueryUTXOs",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryUTXOs(ctx, req.(*QueryUTXOsRequest))
+func (m *QueryEmergencyPausesRequest) Reset()         { *m = QueryEmergencyPausesRequest{} }
+func (m *QueryEmergencyPausesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEmergencyPausesRequest) ProtoMessage()    {}
+func (*QueryEmergencyPausesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb547edb49d5502d, []int{53}
+}
+func (m *QueryEmergencyPausesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEmergencyPausesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEmergencyPausesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryEmergencyPausesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEmergencyPausesRequest.Merge(m, src)
+}
+func (m *QueryEmergencyPausesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEmergencyPausesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEmergencyPausesRequest.DiscardUnknown(m)
 }
 
-func _Query_QueryUTXOsByAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryUTXOsByAddressRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryUTXOsByAddress(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/side.btcbridge.Query/QueryUTXOsByAddress",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryUTXOsByAddress(ctx, req.(*QueryUTXOsByAddressRequest))
+var xxx_messageInfo_QueryEmergencyPausesRequest proto.InternalMessageInfo
+
+func (m *QueryEmergencyPausesRequest) GetActiveOnly() bool {
+	if m != nil {
+		return m.ActiveOnly
 	}
-	return interceptor(ctx, in, info, handler)
+	return false
 }
 
-func _Query_QueryUTXOCountAndBalancesByAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryUTXOCountAndBalancesByAddressRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryUTXOCountAndBalancesByAddress(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/side.btcbridge.Query/QueryUTXOCountAndBalancesByAddress",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryUTXOCountAndBalancesByAddress(ctx, req.(*QueryUTXOCountAndBalancesByAddressRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryEmergencyPausesResponse is the response type for the Query/EmergencyPauses RPC method.
+type QueryEmergencyPausesResponse struct {
+	Pauses []*EmergencyPause `protobuf:"bytes,1,rep,name=pauses,proto3" json:"pauses,omitempty"`
 }
 
-func _Query_QueryDKGRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDKGRequestRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryDKGRequest(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/side.btcbridge.Query/QueryDKGRequest",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryDKGRequest(ctx, req.(*QueryDKGRequestRequest))
+func (m *QueryEmergencyPausesResponse) Reset()         { *m = QueryEmergencyPausesResponse{} }
+func (m *QueryEmergencyPausesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEmergencyPausesResponse) ProtoMessage()    {}
+func (*QueryEmergencyPausesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb547edb49d5502d, []int{54}
+}
+func (m *QueryEmergencyPausesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEmergencyPausesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEmergencyPausesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryEmergencyPausesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEmergencyPausesResponse.Merge(m, src)
+}
+func (m *QueryEmergencyPausesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEmergencyPausesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEmergencyPausesResponse.DiscardUnknown(m)
 }
 
-func _Query_QueryDKGRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDKGRequestsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_QueryEmergencyPausesResponse proto.InternalMessageInfo
+
+func (m *QueryEmergencyPausesResponse) GetPauses() []*EmergencyPause {
+	if m != nil {
+		return m.Pauses
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryDKGRequests(ctx, in)
+	return nil
+}
+
+// QueryDepositAddressRequest is the request type for the Query/DepositAddress RPC method.
+type QueryDepositAddressRequest struct {
+	AssetType AssetType `protobuf:"varint,1,opt,name=asset_type,json=assetType,proto3,enum=side.btcbridge.AssetType" json:"asset_type,omitempty"`
+}
+
+func (m *QueryDepositAddressRequest) Reset()         { *m = QueryDepositAddressRequest{} }
+func (m *QueryDepositAddressRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDepositAddressRequest) ProtoMessage()    {}
+func (*QueryDepositAddressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb547edb49d5502d, []int{55}
+}
+func (m *QueryDepositAddressRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDepositAddressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDepositAddressRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/side.btcbridge.Query/QueryDKGRequests",
+}
+func (m *QueryDepositAddressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDepositAddressRequest.Merge(m, src)
+}
+func (m *QueryDepositAddressRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDepositAddressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDepositAddressRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDepositAddressRequest proto.InternalMessageInfo
+
+func (m *QueryDepositAddressRequest) GetAssetType() AssetType {
+	if m != nil {
+		return m.AssetType
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryDKGRequests(ctx, req.(*QueryDKGRequestsRequest))
+	return AssetType_ASSET_TYPE_UNSPECIFIED
+}
+
+// QueryDepositAddressResponse is the response type for the Query/DepositAddress RPC method.
+type QueryDepositAddressResponse struct {
+	// the assigned vault
+	Vault *Vault `protobuf:"bytes,1,opt,name=vault,proto3" json:"vault,omitempty"`
+	// all active vaults of the asset type
+	ActiveVaults []*Vault `protobuf:"bytes,2,rep,name=active_vaults,json=activeVaults,proto3" json:"active_vaults,omitempty"`
+}
+
+func (m *QueryDepositAddressResponse) Reset()         { *m = QueryDepositAddressResponse{} }
+func (m *QueryDepositAddressResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDepositAddressResponse) ProtoMessage()    {}
+func (*QueryDepositAddressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb547edb49d5502d, []int{56}
+}
+func (m *QueryDepositAddressResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDepositAddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDepositAddressResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryDepositAddressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDepositAddressResponse.Merge(m, src)
+}
+func (m *QueryDepositAddressResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDepositAddressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDepositAddressResponse.DiscardUnknown(m)
 }
 
-func _Query_QueryAllDKGRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllDKGRequestsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_QueryDepositAddressResponse proto.InternalMessageInfo
+
+func (m *QueryDepositAddressResponse) GetVault() *Vault {
+	if m != nil {
+		return m.Vault
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryAllDKGRequests(ctx, in)
+	return nil
+}
+
+func (m *QueryDepositAddressResponse) GetActiveVaults() []*Vault {
+	if m != nil {
+		return m.ActiveVaults
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/side.btcbridge.Query/QueryAllDKGRequests",
+	return nil
+}
+
+// QueryFrostSigningSessionRequest is the request type for the Query/FrostSigningSession RPC method.
+type QueryFrostSigningSessionRequest struct {
+	Txid string `protobuf:"bytes,1,opt,name=txid,proto3" json:"txid,omitempty"`
+}
+
+func (m *QueryFrostSigningSessionRequest) Reset()         { *m = QueryFrostSigningSessionRequest{} }
+func (m *QueryFrostSigningSessionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFrostSigningSessionRequest) ProtoMessage()    {}
+func (*QueryFrostSigningSessionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb547edb49d5502d, []int{57}
+}
+func (m *QueryFrostSigningSessionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFrostSigningSessionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFrostSigningSessionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryAllDKGRequests(ctx, req.(*QueryAllDKGRequestsRequest))
+}
+func (m *QueryFrostSigningSessionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFrostSigningSessionRequest.Merge(m, src)
+}
+func (m *QueryFrostSigningSessionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFrostSigningSessionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFrostSigningSessionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFrostSigningSessionRequest proto.InternalMessageInfo
+
+func (m *QueryFrostSigningSessionRequest) GetTxid() string {
+	if m != nil {
+		return m.Txid
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_QueryDKGCompletionRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDKGCompletionRequestsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+// QueryFrostSigningSessionResponse is the response type for the Query/FrostSigningSession RPC method.
+type QueryFrostSigningSessionResponse struct {
+	Session *FrostSigningSession `protobuf:"bytes,1,opt,name=session,proto3" json:"session,omitempty"`
+}
+
+func (m *QueryFrostSigningSessionResponse) Reset()         { *m = QueryFrostSigningSessionResponse{} }
+func (m *QueryFrostSigningSessionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFrostSigningSessionResponse) ProtoMessage()    {}
+func (*QueryFrostSigningSessionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb547edb49d5502d, []int{58}
+}
+func (m *QueryFrostSigningSessionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFrostSigningSessionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFrostSigningSessionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryDKGCompletionRequests(ctx, in)
+}
+func (m *QueryFrostSigningSessionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFrostSigningSessionResponse.Merge(m, src)
+}
+func (m *QueryFrostSigningSessionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFrostSigningSessionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFrostSigningSessionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFrostSigningSessionResponse proto.InternalMessageInfo
+
+func (m *QueryFrostSigningSessionResponse) GetSession() *FrostSigningSession {
+	if m != nil {
+		return m.Session
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/side.btcbridge.Query/QueryDKGCompletionRequests",
+	return nil
+}
+
+// QueryFrostVaultKeyRequest is the request type for the Query/FrostVaultKey RPC method.
+type QueryFrostVaultKeyRequest struct {
+	Vault string `protobuf:"bytes,1,opt,name=vault,proto3" json:"vault,omitempty"`
+}
+
+func (m *QueryFrostVaultKeyRequest) Reset()         { *m = QueryFrostVaultKeyRequest{} }
+func (m *QueryFrostVaultKeyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFrostVaultKeyRequest) ProtoMessage()    {}
+func (*QueryFrostVaultKeyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb547edb49d5502d, []int{59}
+}
+func (m *QueryFrostVaultKeyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFrostVaultKeyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFrostVaultKeyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryDKGCompletionRequests(ctx, req.(*QueryDKGCompletionRequestsRequest))
+}
+func (m *QueryFrostVaultKeyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFrostVaultKeyRequest.Merge(m, src)
+}
+func (m *QueryFrostVaultKeyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFrostVaultKeyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFrostVaultKeyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFrostVaultKeyRequest proto.InternalMessageInfo
+
+func (m *QueryFrostVaultKeyRequest) GetVault() string {
+	if m != nil {
+		return m.Vault
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var Query_serviceDesc = _Query_serviceDesc
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "side.btcbridge.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "QueryParams",
-			Handler:    _Query_QueryParams_Handler,
-		},
-		{
-			MethodName: "QueryChainTip",
-			Handler:    _Query_QueryChainTip_Handler,
-		},
-		{
-			MethodName: "QueryBlockHeaderByHeight",
-			Handler:    _Query_QueryBlockHeaderByHeight_Handler,
-		},
-		{
-			MethodName: "QueryBlockHeaderByHash",
-			Handler:    _Query_QueryBlockHeaderByHash_Handler,
-		},
-		{
-			MethodName: "QueryFeeRate",
-			Handler:    _Query_QueryFeeRate_Handler,
-		},
-		{
-			MethodName: "QueryWithdrawalNetworkFee",
-			Handler:    _Query_QueryWithdrawalNetworkFee_Handler,
-		},
-		{
-			MethodName: "QueryWithdrawRequestsByAddress",
-			Handler:    _Query_QueryWithdrawRequestsByAddress_Handler,
-		},
-		{
-			MethodName: "QueryWithdrawRequestsByTxHash",
-			Handler:    _Query_QueryWithdrawRequestsByTxHash_Handler,
-		},
-		{
-			MethodName: "QueryPendingBtcWithdrawRequests",
-			Handler:    _Query_QueryPendingBtcWithdrawRequests_Handler,
-		},
-		{
-			MethodName: "QuerySigningRequest",
-			Handler:    _Query_QuerySigningRequest_Handler,
-		},
-		{
-			MethodName: "QuerySigningRequests",
-			Handler:    _Query_QuerySigningRequests_Handler,
-		},
-		{
-			MethodName: "QuerySigningRequestsByAddress",
-			Handler:    _Query_QuerySigningRequestsByAddress_Handler,
-		},
-		{
-			MethodName: "QuerySigningRequestByTxHash",
-			Handler:    _Query_QuerySigningRequestByTxHash_Handler,
-		},
-		{
-			MethodName: "QueryUTXOs",
-			Handler:    _Query_QueryUTXOs_Handler,
-		},
-		{
-			MethodName: "QueryUTXOsByAddress",
-			Handler:    _Query_QueryUTXOsByAddress_Handler,
-		},
-		{
-			MethodName: "QueryUTXOCountAndBalancesByAddress",
-			Handler:    _Query_QueryUTXOCountAndBalancesByAddress_Handler,
-		},
-		{
-			MethodName: "QueryDKGRequest",
-			Handler:    _Query_QueryDKGRequest_Handler,
-		},
-		{
-			MethodName: "QueryDKGRequests",
-			Handler:    _Query_QueryDKGRequests_Handler,
-		},
-		{
-			MethodName: "QueryAllDKGRequests",
-			Handler:    _Query_QueryAllDKGRequests_Handler,
-		},
-		{
-			MethodName: "QueryDKGCompletionRequests",
-			Handler:    _Query_QueryDKGCompletionRequests_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "side/btcbridge/query.proto",
+// QueryFrostVaultKeyResponse is the response type for the Query/FrostVaultKey RPC method.
+type QueryFrostVaultKeyResponse struct {
+	Key *FrostVaultKey `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
 }
 
-func (m *QueryWithdrawRequestsByAddressRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryFrostVaultKeyResponse) Reset()         { *m = QueryFrostVaultKeyResponse{} }
+func (m *QueryFrostVaultKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFrostVaultKeyResponse) ProtoMessage()    {}
+func (*QueryFrostVaultKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb547edb49d5502d, []int{60}
 }
-
-func (m *QueryWithdrawRequestsByAddressRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryFrostVaultKeyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *QueryWithdrawRequestsByAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryFrostVaultKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFrostVaultKeyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryFrostVaultKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFrostVaultKeyResponse.Merge(m, src)
+}
+func (m *QueryFrostVaultKeyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFrostVaultKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFrostVaultKeyResponse.DiscardUnknown(m)
 }
 
-func (m *QueryWithdrawRequestsByAddressResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryFrostVaultKeyResponse proto.InternalMessageInfo
+
+func (m *QueryFrostVaultKeyResponse) GetKey() *FrostVaultKey {
+	if m != nil {
+		return m.Key
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryWithdrawRequestsByAddressResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QuerySignerPerformanceRequest is the request type for the Query/SignerPerformance RPC method.
+type QuerySignerPerformanceRequest struct {
+	OperatorAddress string `protobuf:"bytes,1,opt,name=operator_address,json=operatorAddress,proto3" json:"operator_address,omitempty"`
 }
 
-func (m *QueryWithdrawRequestsByAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Requests) > 0 {
-		for iNdEx := len(m.Requests) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Requests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QuerySignerPerformanceRequest) Reset()         { *m = QuerySignerPerformanceRequest{} }
+func (m *QuerySignerPerformanceRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySignerPerformanceRequest) ProtoMessage()    {}
+func (*QuerySignerPerformanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb547edb49d5502d, []int{61}
+}
+func (m *QuerySignerPerformanceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySignerPerformanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySignerPerformanceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QuerySignerPerformanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySignerPerformanceRequest.Merge(m, src)
+}
+func (m *QuerySignerPerformanceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySignerPerformanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySignerPerformanceRequest.DiscardUnknown(m)
 }
 
-func (m *QueryWithdrawRequestsByTxHashRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QuerySignerPerformanceRequest proto.InternalMessageInfo
+
+func (m *QuerySignerPerformanceRequest) GetOperatorAddress() string {
+	if m != nil {
+		return m.OperatorAddress
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryWithdrawRequestsByTxHashRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QuerySignerPerformanceResponse is the response type for the Query/SignerPerformance RPC method.
+type QuerySignerPerformanceResponse struct {
+	Performance *SignerPerformance `protobuf:"bytes,1,opt,name=performance,proto3" json:"performance,omitempty"`
 }
 
-func (m *QueryWithdrawRequestsByTxHashRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Txid) > 0 {
-		i -= len(m.Txid)
-		copy(dAtA[i:], m.Txid)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Txid)))
-		i--
-		dAtA[i] = 0xa
+func (m *QuerySignerPerformanceResponse) Reset()         { *m = QuerySignerPerformanceResponse{} }
+func (m *QuerySignerPerformanceResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySignerPerformanceResponse) ProtoMessage()    {}
+func (*QuerySignerPerformanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb547edb49d5502d, []int{62}
+}
+func (m *QuerySignerPerformanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySignerPerformanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySignerPerformanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QuerySignerPerformanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySignerPerformanceResponse.Merge(m, src)
+}
+func (m *QuerySignerPerformanceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySignerPerformanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySignerPerformanceResponse.DiscardUnknown(m)
 }
 
-func (m *QueryWithdrawRequestsByTxHashResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QuerySignerPerformanceResponse proto.InternalMessageInfo
+
+func (m *QuerySignerPerformanceResponse) GetPerformance() *SignerPerformance {
+	if m != nil {
+		return m.Performance
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryWithdrawRequestsByTxHashResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QuerySignerPerformancesRequest is the request type for the Query/SignerPerformances RPC method.
+type QuerySignerPerformancesRequest struct {
 }
 
-func (m *QueryWithdrawRequestsByTxHashResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Requests) > 0 {
-		for iNdEx := len(m.Requests) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Requests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+func (m *QuerySignerPerformancesRequest) Reset()         { *m = QuerySignerPerformancesRequest{} }
+func (m *QuerySignerPerformancesRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySignerPerformancesRequest) ProtoMessage()    {}
+func (*QuerySignerPerformancesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb547edb49d5502d, []int{63}
 }
-
-func (m *QueryPendingBtcWithdrawRequestsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QuerySignerPerformancesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySignerPerformancesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySignerPerformancesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QuerySignerPerformancesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySignerPerformancesRequest.Merge(m, src)
+}
+func (m *QuerySignerPerformancesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySignerPerformancesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySignerPerformancesRequest.DiscardUnknown(m)
 }
 
-func (m *QueryPendingBtcWithdrawRequestsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QuerySignerPerformancesRequest proto.InternalMessageInfo
+
+// QuerySignerPerformancesResponse is the response type for the Query/SignerPerformances RPC method.
+type QuerySignerPerformancesResponse struct {
+	Performances []*SignerPerformance `protobuf:"bytes,1,rep,name=performances,proto3" json:"performances,omitempty"`
 }
 
-func (m *QueryPendingBtcWithdrawRequestsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QuerySignerPerformancesResponse) Reset()         { *m = QuerySignerPerformancesResponse{} }
+func (m *QuerySignerPerformancesResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySignerPerformancesResponse) ProtoMessage()    {}
+func (*QuerySignerPerformancesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb547edb49d5502d, []int{64}
+}
+func (m *QuerySignerPerformancesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySignerPerformancesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySignerPerformancesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QuerySignerPerformancesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySignerPerformancesResponse.Merge(m, src)
+}
+func (m *QuerySignerPerformancesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySignerPerformancesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySignerPerformancesResponse.DiscardUnknown(m)
 }
 
-func (m *QueryPendingBtcWithdrawRequestsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QuerySignerPerformancesResponse proto.InternalMessageInfo
+
+func (m *QuerySignerPerformancesResponse) GetPerformances() []*SignerPerformance {
+	if m != nil {
+		return m.Performances
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryPendingBtcWithdrawRequestsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryVaultTransferRequest is the request type for the Query/VaultTransfer RPC method.
+type QueryVaultTransferRequest struct {
+	SourceVersion uint64 `protobuf:"varint,1,opt,name=source_version,json=sourceVersion,proto3" json:"source_version,omitempty"`
 }
 
-func (m *QueryPendingBtcWithdrawRequestsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Requests) > 0 {
-		for iNdEx := len(m.Requests) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Requests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryVaultTransferRequest) Reset()         { *m = QueryVaultTransferRequest{} }
+func (m *QueryVaultTransferRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVaultTransferRequest) ProtoMessage()    {}
+func (*QueryVaultTransferRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb547edb49d5502d, []int{65}
+}
+func (m *QueryVaultTransferRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVaultTransferRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVaultTransferRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryVaultTransferRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVaultTransferRequest.Merge(m, src)
+}
+func (m *QueryVaultTransferRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVaultTransferRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVaultTransferRequest.DiscardUnknown(m)
 }
 
-func (m *QuerySigningRequestRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryVaultTransferRequest proto.InternalMessageInfo
+
+func (m *QueryVaultTransferRequest) GetSourceVersion() uint64 {
+	if m != nil {
+		return m.SourceVersion
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QuerySigningRequestRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryVaultTransferResponse is the response type for the Query/VaultTransfer RPC method.
+type QueryVaultTransferResponse struct {
+	Transfer *VaultTransfer `protobuf:"bytes,1,opt,name=transfer,proto3" json:"transfer,omitempty"`
 }
 
-func (m *QuerySigningRequestRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Sequence != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Sequence))
-		i--
-		dAtA[i] = 0x8
+func (m *QueryVaultTransferResponse) Reset()         { *m = QueryVaultTransferResponse{} }
+func (m *QueryVaultTransferResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVaultTransferResponse) ProtoMessage()    {}
+func (*QueryVaultTransferResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb547edb49d5502d, []int{66}
+}
+func (m *QueryVaultTransferResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVaultTransferResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVaultTransferResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryVaultTransferResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVaultTransferResponse.Merge(m, src)
+}
+func (m *QueryVaultTransferResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVaultTransferResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVaultTransferResponse.DiscardUnknown(m)
 }
 
-func (m *QuerySigningRequestResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryVaultTransferResponse proto.InternalMessageInfo
+
+func (m *QueryVaultTransferResponse) GetTransfer() *VaultTransfer {
+	if m != nil {
+		return m.Transfer
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QuerySigningRequestResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryVaultTransfersRequest is the request type for the Query/VaultTransfers RPC method.
+type QueryVaultTransfersRequest struct {
 }
 
-func (m *QuerySigningRequestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Request != nil {
-		{
-			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryVaultTransfersRequest) Reset()         { *m = QueryVaultTransfersRequest{} }
+func (m *QueryVaultTransfersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVaultTransfersRequest) ProtoMessage()    {}
+func (*QueryVaultTransfersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb547edb49d5502d, []int{67}
+}
+func (m *QueryVaultTransfersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVaultTransfersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVaultTransfersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QuerySigningRequestsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryVaultTransfersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVaultTransfersRequest.Merge(m, src)
+}
+func (m *QueryVaultTransfersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVaultTransfersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVaultTransfersRequest.DiscardUnknown(m)
 }
 
-func (m *QuerySigningRequestsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryVaultTransfersRequest proto.InternalMessageInfo
+
+// QueryVaultTransfersResponse is the response type for the Query/VaultTransfers RPC method.
+type QueryVaultTransfersResponse struct {
+	Transfers []*VaultTransfer `protobuf:"bytes,1,rep,name=transfers,proto3" json:"transfers,omitempty"`
 }
 
-func (m *QuerySigningRequestsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryVaultTransfersResponse) Reset()         { *m = QueryVaultTransfersResponse{} }
+func (m *QueryVaultTransfersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVaultTransfersResponse) ProtoMessage()    {}
+func (*QueryVaultTransfersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb547edb49d5502d, []int{68}
+}
+func (m *QueryVaultTransfersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVaultTransfersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVaultTransfersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Status != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryVaultTransfersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVaultTransfersResponse.Merge(m, src)
+}
+func (m *QueryVaultTransfersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVaultTransfersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVaultTransfersResponse.DiscardUnknown(m)
 }
 
-func (m *QuerySigningRequestsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryVaultTransfersResponse proto.InternalMessageInfo
+
+func (m *QueryVaultTransfersResponse) GetTransfers() []*VaultTransfer {
+	if m != nil {
+		return m.Transfers
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QuerySigningRequestsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterType((*QueryWithdrawRequestsByAddressRequest)(nil), "side.btcbridge.QueryWithdrawRequestsByAddressRequest")
+	proto.RegisterType((*QueryWithdrawRequestsByAddressResponse)(nil), "side.btcbridge.QueryWithdrawRequestsByAddressResponse")
+	proto.RegisterType((*QueryWithdrawRequestsByTxHashRequest)(nil), "side.btcbridge.QueryWithdrawRequestsByTxHashRequest")
+	proto.RegisterType((*QueryWithdrawRequestsByTxHashResponse)(nil), "side.btcbridge.QueryWithdrawRequestsByTxHashResponse")
+	proto.RegisterType((*QueryPendingBtcWithdrawRequestsRequest)(nil), "side.btcbridge.QueryPendingBtcWithdrawRequestsRequest")
+	proto.RegisterType((*QueryPendingBtcWithdrawRequestsResponse)(nil), "side.btcbridge.QueryPendingBtcWithdrawRequestsResponse")
+	proto.RegisterType((*QuerySigningRequestRequest)(nil), "side.btcbridge.QuerySigningRequestRequest")
+	proto.RegisterType((*QuerySigningRequestResponse)(nil), "side.btcbridge.QuerySigningRequestResponse")
+	proto.RegisterType((*QuerySigningRequestsRequest)(nil), "side.btcbridge.QuerySigningRequestsRequest")
+	proto.RegisterType((*QuerySigningRequestsResponse)(nil), "side.btcbridge.QuerySigningRequestsResponse")
+	proto.RegisterType((*QuerySigningRequestsByAddressRequest)(nil), "side.btcbridge.QuerySigningRequestsByAddressRequest")
+	proto.RegisterType((*QuerySigningRequestsByAddressResponse)(nil), "side.btcbridge.QuerySigningRequestsByAddressResponse")
+	proto.RegisterType((*QuerySigningRequestByTxHashRequest)(nil), "side.btcbridge.QuerySigningRequestByTxHashRequest")
+	proto.RegisterType((*QuerySigningRequestByTxHashResponse)(nil), "side.btcbridge.QuerySigningRequestByTxHashResponse")
+	proto.RegisterType((*QueryFeeRateRequest)(nil), "side.btcbridge.QueryFeeRateRequest")
+	proto.RegisterType((*QueryFeeRateResponse)(nil), "side.btcbridge.QueryFeeRateResponse")
+	proto.RegisterType((*QueryWithdrawalNetworkFeeRequest)(nil), "side.btcbridge.QueryWithdrawalNetworkFeeRequest")
+	proto.RegisterType((*QueryWithdrawalNetworkFeeResponse)(nil), "side.btcbridge.QueryWithdrawalNetworkFeeResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "side.btcbridge.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "side.btcbridge.QueryParamsResponse")
+	proto.RegisterType((*QueryChainTipRequest)(nil), "side.btcbridge.QueryChainTipRequest")
+	proto.RegisterType((*QueryChainTipResponse)(nil), "side.btcbridge.QueryChainTipResponse")
+	proto.RegisterType((*QueryBlockHeaderByHeightRequest)(nil), "side.btcbridge.QueryBlockHeaderByHeightRequest")
+	proto.RegisterType((*QueryBlockHeaderByHeightResponse)(nil), "side.btcbridge.QueryBlockHeaderByHeightResponse")
+	proto.RegisterType((*QueryBlockHeaderByHashRequest)(nil), "side.btcbridge.QueryBlockHeaderByHashRequest")
+	proto.RegisterType((*QueryBlockHeaderByHashResponse)(nil), "side.btcbridge.QueryBlockHeaderByHashResponse")
+	proto.RegisterType((*QueryUTXOsRequest)(nil), "side.btcbridge.QueryUTXOsRequest")
+	proto.RegisterType((*QueryUTXOsResponse)(nil), "side.btcbridge.QueryUTXOsResponse")
+	proto.RegisterType((*QueryUTXOsByAddressRequest)(nil), "side.btcbridge.QueryUTXOsByAddressRequest")
+	proto.RegisterType((*QueryUTXOsByAddressResponse)(nil), "side.btcbridge.QueryUTXOsByAddressResponse")
+	proto.RegisterType((*QueryUTXOCountAndBalancesByAddressRequest)(nil), "side.btcbridge.QueryUTXOCountAndBalancesByAddressRequest")
+	proto.RegisterType((*QueryUTXOCountAndBalancesByAddressResponse)(nil), "side.btcbridge.QueryUTXOCountAndBalancesByAddressResponse")
+	proto.RegisterType((*QueryDKGRequestRequest)(nil), "side.btcbridge.QueryDKGRequestRequest")
+	proto.RegisterType((*QueryDKGRequestResponse)(nil), "side.btcbridge.QueryDKGRequestResponse")
+	proto.RegisterType((*QueryDKGRequestsRequest)(nil), "side.btcbridge.QueryDKGRequestsRequest")
+	proto.RegisterType((*QueryDKGRequestsResponse)(nil), "side.btcbridge.QueryDKGRequestsResponse")
+	proto.RegisterType((*QueryAllDKGRequestsRequest)(nil), "side.btcbridge.QueryAllDKGRequestsRequest")
+	proto.RegisterType((*QueryAllDKGRequestsResponse)(nil), "side.btcbridge.QueryAllDKGRequestsResponse")
+	proto.RegisterType((*QueryDKGCompletionRequestsRequest)(nil), "side.btcbridge.QueryDKGCompletionRequestsRequest")
+	proto.RegisterType((*QueryDKGCompletionRequestsResponse)(nil), "side.btcbridge.QueryDKGCompletionRequestsResponse")
+	proto.RegisterType((*QueryRunesRequest)(nil), "side.btcbridge.QueryRunesRequest")
+	proto.RegisterType((*QueryRunesResponse)(nil), "side.btcbridge.QueryRunesResponse")
+	proto.RegisterType((*QueryRuneRequest)(nil), "side.btcbridge.QueryRuneRequest")
+	proto.RegisterType((*QueryRuneResponse)(nil), "side.btcbridge.QueryRuneResponse")
+	proto.RegisterType((*QueryRuneConfigsRequest)(nil), "side.btcbridge.QueryRuneConfigsRequest")
+	proto.RegisterType((*QueryRuneConfigsResponse)(nil), "side.btcbridge.QueryRuneConfigsResponse")
+	proto.RegisterType((*RuneConfigWithSupply)(nil), "side.btcbridge.RuneConfigWithSupply")
+	proto.RegisterType((*QueryProtocolFeesRequest)(nil), "side.btcbridge.QueryProtocolFeesRequest")
+	proto.RegisterType((*QueryProtocolFeesResponse)(nil), "side.btcbridge.QueryProtocolFeesResponse")
+	proto.RegisterType((*QueryFeeDistributionRequest)(nil), "side.btcbridge.QueryFeeDistributionRequest")
+	proto.RegisterType((*QueryFeeDistributionResponse)(nil), "side.btcbridge.QueryFeeDistributionResponse")
+	proto.RegisterType((*QueryOutflowUsageRequest)(nil), "side.btcbridge.QueryOutflowUsageRequest")
+	proto.RegisterType((*QueryOutflowUsageResponse)(nil), "side.btcbridge.QueryOutflowUsageResponse")
+	proto.RegisterType((*QueryEmergencyPausesRequest)(nil), "side.btcbridge.QueryEmergencyPausesRequest")
+	proto.RegisterType((*QueryEmergencyPausesResponse)(nil), "side.btcbridge.QueryEmergencyPausesResponse")
+	proto.RegisterType((*QueryDepositAddressRequest)(nil), "side.btcbridge.QueryDepositAddressRequest")
+	proto.RegisterType((*QueryDepositAddressResponse)(nil), "side.btcbridge.QueryDepositAddressResponse")
+	proto.RegisterType((*QueryFrostSigningSessionRequest)(nil), "side.btcbridge.QueryFrostSigningSessionRequest")
+	proto.RegisterType((*QueryFrostSigningSessionResponse)(nil), "side.btcbridge.QueryFrostSigningSessionResponse")
+	proto.RegisterType((*QueryFrostVaultKeyRequest)(nil), "side.btcbridge.QueryFrostVaultKeyRequest")
+	proto.RegisterType((*QueryFrostVaultKeyResponse)(nil), "side.btcbridge.QueryFrostVaultKeyResponse")
+	proto.RegisterType((*QuerySignerPerformanceRequest)(nil), "side.btcbridge.QuerySignerPerformanceRequest")
+	proto.RegisterType((*QuerySignerPerformanceResponse)(nil), "side.btcbridge.QuerySignerPerformanceResponse")
+	proto.RegisterType((*QuerySignerPerformancesRequest)(nil), "side.btcbridge.QuerySignerPerformancesRequest")
+	proto.RegisterType((*QuerySignerPerformancesResponse)(nil), "side.btcbridge.QuerySignerPerformancesResponse")
+	proto.RegisterType((*QueryVaultTransferRequest)(nil), "side.btcbridge.QueryVaultTransferRequest")
+	proto.RegisterType((*QueryVaultTransferResponse)(nil), "side.btcbridge.QueryVaultTransferResponse")
+	proto.RegisterType((*QueryVaultTransfersRequest)(nil), "side.btcbridge.QueryVaultTransfersRequest")
+	proto.RegisterType((*QueryVaultTransfersResponse)(nil), "side.btcbridge.QueryVaultTransfersResponse")
 }
 
-func (m *QuerySigningRequestsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Requests) > 0 {
-		for iNdEx := len(m.Requests) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Requests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+func init() { proto.RegisterFile("side/btcbridge/query.proto", fileDescriptor_fb547edb49d5502d) }
+
+var fileDescriptor_fb547edb49d5502d = []byte{
+	// 2760 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0xcf, 0x6f, 0x1b, 0xc7,
+	0xf5, 0xf7, 0x5a, 0xf2, 0x0f, 0x3d, 0xd9, 0x8e, 0x33, 0x96, 0x65, 0x79, 0x25, 0x51, 0xf4, 0xd8,
+	0x8e, 0xf5, 0xc3, 0xe6, 0x5a, 0xb2, 0x95, 0x38, 0xfe, 0x22, 0xf9, 0xc6, 0x92, 0xad, 0xb8, 0x31,
+	0x52, 0xbb, 0x6b, 0xc7, 0x29, 0x72, 0x61, 0x97, 0xe4, 0x88, 0x5c, 0x88, 0xe2, 0x32, 0x3b, 0x4b,
+	0xcb, 0xac, 0xa0, 0x4b, 0x0f, 0x45, 0xd3, 0x16, 0x41, 0x8b, 0x16, 0x28, 0x8a, 0xa6, 0xbd, 0x24,
+	0xe8, 0xa9, 0x68, 0x0f, 0xb9, 0x14, 0xbd, 0xb6, 0x87, 0xa0, 0x05, 0x8a, 0x00, 0xbd, 0xb4, 0x97,
+	0xa2, 0x48, 0xfa, 0x87, 0x14, 0x3b, 0xfb, 0x66, 0x7f, 0x71, 0x96, 0xbb, 0x4a, 0x5c, 0x34, 0x17,
+	0x9b, 0x3b, 0xf3, 0x7e, 0x7c, 0xde, 0x9b, 0x79, 0x33, 0x6f, 0x3e, 0x02, 0x9d, 0xdb, 0x0d, 0x66,
+	0xd4, 0xbc, 0x7a, 0xcd, 0xb5, 0x1b, 0x4d, 0x66, 0xbc, 0xdb, 0x63, 0x6e, 0xbf, 0xd2, 0x75, 0x1d,
+	0xcf, 0x21, 0x27, 0xfc, 0xb9, 0x4a, 0x38, 0xa7, 0x4f, 0x34, 0x9d, 0xa6, 0x23, 0xa6, 0x0c, 0xff,
+	0x57, 0x20, 0xa5, 0xcf, 0x34, 0x1d, 0xa7, 0xd9, 0x66, 0x86, 0xd5, 0xb5, 0x0d, 0xab, 0xd3, 0x71,
+	0x3c, 0xcb, 0xb3, 0x9d, 0x0e, 0xc7, 0xd9, 0xc5, 0xba, 0xc3, 0xb7, 0x1d, 0x6e, 0xd4, 0x2c, 0x8e,
+	0xc6, 0x8d, 0x27, 0xcb, 0x35, 0xe6, 0x59, 0xcb, 0x46, 0xd7, 0x6a, 0xda, 0x1d, 0x21, 0x8c, 0xb2,
+	0xd3, 0x29, 0x2c, 0x5d, 0xcb, 0xb5, 0xb6, 0xa5, 0xa1, 0x52, 0x6a, 0x32, 0xfc, 0x15, 0xcc, 0xd3,
+	0xf7, 0x34, 0xb8, 0xf8, 0x0d, 0xdf, 0xfe, 0xdb, 0xb6, 0xd7, 0x6a, 0xb8, 0xd6, 0x8e, 0xc9, 0xde,
+	0xed, 0x31, 0xee, 0xf1, 0xb5, 0xfe, 0xad, 0x46, 0xc3, 0x65, 0x9c, 0xe3, 0x00, 0x99, 0x82, 0x23,
+	0x56, 0x30, 0x32, 0xa5, 0x95, 0xb5, 0xf9, 0x31, 0x53, 0x7e, 0x92, 0x0d, 0x80, 0x08, 0xd4, 0xd4,
+	0xc1, 0xb2, 0x36, 0x3f, 0xbe, 0xf2, 0x42, 0x25, 0x88, 0xa0, 0xe2, 0x47, 0x50, 0x09, 0xd2, 0x83,
+	0x11, 0x54, 0x1e, 0x58, 0x4d, 0x86, 0x56, 0xcd, 0x98, 0x26, 0xfd, 0xad, 0x06, 0x2f, 0xe4, 0x61,
+	0xe1, 0x5d, 0xa7, 0xc3, 0x19, 0xf9, 0x3f, 0x38, 0xea, 0xe2, 0xe4, 0x94, 0x56, 0x1e, 0x99, 0x1f,
+	0x5f, 0x99, 0xab, 0x24, 0xd3, 0x5e, 0x49, 0x19, 0x31, 0x43, 0x05, 0xf2, 0xba, 0x02, 0xef, 0xa5,
+	0x5c, 0xbc, 0x81, 0xe7, 0x04, 0xe0, 0x9b, 0x70, 0x21, 0x03, 0xef, 0xa3, 0xa7, 0x77, 0x2d, 0xde,
+	0x92, 0xa9, 0x23, 0x30, 0xea, 0x3d, 0xb5, 0x1b, 0x98, 0x37, 0xf1, 0x9b, 0x36, 0xe0, 0x62, 0x8e,
+	0xee, 0x33, 0x08, 0x95, 0x76, 0x31, 0xa3, 0x0f, 0x58, 0xa7, 0x61, 0x77, 0x9a, 0x6b, 0x5e, 0x3d,
+	0xed, 0x4f, 0x62, 0x4c, 0x2e, 0xa2, 0xf6, 0x85, 0x17, 0xf1, 0x77, 0x1a, 0x5c, 0xca, 0x75, 0xf9,
+	0x95, 0x5a, 0xc5, 0x1b, 0xa0, 0x0b, 0xc0, 0x0f, 0xed, 0x66, 0xc7, 0xee, 0x34, 0xa5, 0xa7, 0xe0,
+	0x3f, 0xa2, 0xc3, 0x51, 0xee, 0xff, 0xec, 0xd4, 0x99, 0xc8, 0xca, 0xa8, 0x19, 0x7e, 0xd3, 0x5f,
+	0x6a, 0x30, 0xad, 0x54, 0xc5, 0xf8, 0x6e, 0xc0, 0x11, 0x84, 0x8b, 0x09, 0x2d, 0xa5, 0xc3, 0x4b,
+	0x29, 0x4a, 0x71, 0x72, 0x1b, 0x8e, 0xb4, 0x6c, 0xee, 0x39, 0x6e, 0x7f, 0xea, 0xa0, 0x48, 0xcc,
+	0xe2, 0x70, 0xcd, 0xbb, 0x81, 0xf0, 0x9d, 0x8e, 0xe7, 0xf6, 0x4d, 0xa9, 0x4a, 0x3f, 0x50, 0xe3,
+	0x0b, 0xd7, 0x7c, 0x15, 0x0e, 0x73, 0xcf, 0xf2, 0x7a, 0x41, 0x45, 0x9f, 0x58, 0x99, 0xcd, 0x70,
+	0xf2, 0x50, 0x08, 0x99, 0x28, 0xfc, 0xcc, 0xea, 0xfd, 0x43, 0x0d, 0x66, 0xd4, 0xf0, 0x30, 0x7f,
+	0x37, 0x07, 0xf6, 0x47, 0x5e, 0x02, 0xff, 0x0b, 0xdb, 0xe3, 0x7b, 0x1a, 0x56, 0x79, 0x0a, 0xe5,
+	0xff, 0xe0, 0x80, 0xfc, 0x8d, 0x3c, 0xac, 0xb3, 0xa1, 0x7c, 0x95, 0x32, 0x77, 0x03, 0xa8, 0x02,
+	0x6d, 0x91, 0xc3, 0xb1, 0x0a, 0xe7, 0x87, 0x6a, 0x7e, 0xd9, 0xfa, 0xa2, 0xa7, 0xe1, 0x94, 0x70,
+	0xb0, 0xc1, 0x98, 0x69, 0x79, 0x32, 0xd9, 0xf4, 0x0d, 0x98, 0x48, 0x0e, 0xa3, 0xa3, 0x15, 0x38,
+	0xba, 0xc9, 0x58, 0xd5, 0xb5, 0x3c, 0x86, 0x9e, 0xce, 0xa4, 0x3d, 0x49, 0x95, 0x23, 0x9b, 0xc1,
+	0x0f, 0xea, 0x40, 0x39, 0x71, 0xc0, 0x5b, 0xed, 0xaf, 0x33, 0x6f, 0xc7, 0x71, 0xb7, 0x36, 0x98,
+	0xf4, 0x37, 0x64, 0xcb, 0x4c, 0xc2, 0x61, 0x6b, 0xdb, 0xe9, 0x75, 0x3c, 0xb1, 0x00, 0x63, 0x26,
+	0x7e, 0x91, 0xb3, 0x31, 0x24, 0x23, 0x65, 0x6d, 0x7e, 0x24, 0x72, 0xf8, 0x00, 0xce, 0x0d, 0x71,
+	0x88, 0x91, 0x9c, 0x4d, 0x45, 0x12, 0xe9, 0x93, 0x93, 0x30, 0xb2, 0xc9, 0x18, 0xfa, 0xf3, 0x7f,
+	0xd2, 0x09, 0x20, 0xc1, 0x51, 0x2e, 0x3a, 0x0a, 0x99, 0xa4, 0x7b, 0x70, 0x2a, 0x31, 0x8a, 0x96,
+	0xaf, 0xc3, 0xe1, 0xa0, 0xf3, 0xc0, 0x0c, 0x4d, 0xa6, 0x33, 0x14, 0xc8, 0xaf, 0x8d, 0x7e, 0xf2,
+	0xcf, 0xb9, 0x03, 0x26, 0xca, 0xd2, 0x49, 0xcc, 0xf8, 0x7a, 0xcb, 0xb2, 0x3b, 0x8f, 0xec, 0xae,
+	0x74, 0xb2, 0x0e, 0xa7, 0x53, 0xe3, 0xe8, 0x86, 0xc0, 0x68, 0xcb, 0xe2, 0x2d, 0xb9, 0x5d, 0xfc,
+	0xdf, 0x7e, 0xb2, 0x5a, 0xcc, 0x6e, 0xb6, 0x82, 0x64, 0x8d, 0x9a, 0xf8, 0x45, 0x5f, 0x86, 0x39,
+	0x61, 0x64, 0xad, 0xed, 0xd4, 0xb7, 0xee, 0x32, 0xab, 0xc1, 0xdc, 0xb5, 0xfe, 0x5d, 0x31, 0x27,
+	0x57, 0x20, 0x52, 0xd5, 0x12, 0xaa, 0x35, 0x28, 0x67, 0xab, 0x22, 0x94, 0x57, 0xe1, 0x58, 0xcd,
+	0x9f, 0xae, 0xb6, 0xc4, 0x3c, 0xc6, 0x3d, 0x9d, 0x8e, 0x3b, 0x66, 0xc2, 0x1c, 0xaf, 0x45, 0x1f,
+	0xf4, 0x1a, 0xcc, 0x2a, 0x7c, 0x24, 0x4b, 0x23, 0x1d, 0x2b, 0xfd, 0x16, 0x94, 0xb2, 0x94, 0x9e,
+	0x11, 0xac, 0x53, 0xf0, 0xbc, 0xf0, 0xf0, 0xd6, 0xa3, 0x6f, 0xde, 0x0f, 0x17, 0xfd, 0x35, 0x20,
+	0xf1, 0x41, 0x74, 0xb5, 0x08, 0x87, 0x7a, 0xde, 0x53, 0x47, 0x9e, 0x31, 0x13, 0x69, 0x1f, 0xbe,
+	0xb4, 0x19, 0x88, 0xd0, 0x17, 0xf1, 0x9a, 0x15, 0x16, 0x8a, 0x1f, 0x9e, 0xf4, 0x6b, 0x30, 0xad,
+	0xd4, 0xfb, 0x02, 0x10, 0xee, 0xc0, 0x42, 0x68, 0x6a, 0xdd, 0x2f, 0xa7, 0x5b, 0x9d, 0xc6, 0x9a,
+	0xd5, 0xb6, 0x3a, 0x75, 0xb6, 0x1f, 0x44, 0x3f, 0xd7, 0x60, 0xb1, 0x88, 0x1d, 0x44, 0x38, 0x01,
+	0x87, 0xea, 0xa2, 0x92, 0x7d, 0x33, 0xc7, 0xcd, 0xe0, 0xc3, 0x1f, 0x7d, 0x62, 0xb5, 0x7b, 0x41,
+	0xbd, 0x8d, 0x98, 0xc1, 0x07, 0xf9, 0x7f, 0x38, 0xe6, 0xf6, 0x3a, 0x4c, 0x1a, 0x9b, 0x1a, 0x29,
+	0x8f, 0xa8, 0xd6, 0xce, 0x8c, 0x64, 0xcc, 0x84, 0x02, 0x9d, 0x87, 0x49, 0x01, 0xed, 0xf6, 0xbd,
+	0xd7, 0x53, 0x8d, 0xcc, 0x09, 0x38, 0x88, 0xa7, 0xec, 0xa8, 0x79, 0xd0, 0x6e, 0xd0, 0xfb, 0x70,
+	0x66, 0x40, 0x32, 0x2c, 0xe5, 0xd4, 0xb9, 0xaa, 0xa7, 0x01, 0xc4, 0x94, 0xc2, 0x33, 0xf5, 0xe1,
+	0x80, 0xc1, 0x30, 0x97, 0x37, 0x52, 0x8d, 0x46, 0x39, 0xdb, 0x5e, 0xb2, 0xd7, 0xa0, 0x26, 0x4c,
+	0x0d, 0x1a, 0x45, 0x98, 0x2f, 0x0e, 0x5c, 0x72, 0xc3, 0x70, 0x46, 0x4d, 0xf1, 0x0c, 0xee, 0xc4,
+	0x5b, 0xed, 0xf6, 0x20, 0x56, 0xfa, 0x16, 0x4c, 0x2b, 0x67, 0xbf, 0xa4, 0xd3, 0x6b, 0x78, 0x3a,
+	0xdf, 0xbe, 0xf7, 0xfa, 0xba, 0xb3, 0xdd, 0x6d, 0x33, 0xff, 0x8a, 0x4c, 0xe7, 0x29, 0xbd, 0x46,
+	0x9b, 0x40, 0x87, 0x29, 0x21, 0xa4, 0xd7, 0x06, 0x20, 0x5d, 0x50, 0x40, 0x1a, 0x30, 0x10, 0x03,
+	0x27, 0x4b, 0xde, 0xdf, 0x57, 0x61, 0x22, 0xee, 0x02, 0x89, 0x0f, 0x86, 0x57, 0xe1, 0x21, 0x7f,
+	0xc3, 0x49, 0x4f, 0x33, 0xaa, 0xad, 0xf9, 0x26, 0xf3, 0xac, 0x86, 0xe5, 0x59, 0x66, 0x20, 0x4a,
+	0x29, 0x9c, 0x0c, 0x2d, 0x0d, 0x86, 0x3a, 0x26, 0x42, 0xbd, 0x13, 0x83, 0x10, 0x3a, 0xbb, 0x0a,
+	0xa3, 0xbe, 0x05, 0xdc, 0x85, 0xc3, 0x7d, 0x09, 0x49, 0xba, 0x80, 0x9b, 0xd0, 0x9f, 0x5a, 0x77,
+	0x3a, 0x9b, 0x76, 0x93, 0x67, 0x79, 0x7c, 0x07, 0xa6, 0x06, 0x45, 0xc3, 0x33, 0xf4, 0x48, 0x3d,
+	0x18, 0xca, 0xca, 0x68, 0xa4, 0xe5, 0xdf, 0xb7, 0x0f, 0x7b, 0xdd, 0x6e, 0xbb, 0x6f, 0x4a, 0x25,
+	0xda, 0x82, 0x09, 0x95, 0x80, 0x5f, 0x08, 0x81, 0x48, 0x56, 0x61, 0x45, 0x5a, 0xf2, 0xa2, 0x0c,
+	0xe4, 0xfd, 0x8b, 0x8a, 0x0b, 0x1b, 0xb2, 0x21, 0x08, 0xbe, 0xe8, 0x0a, 0x46, 0xf1, 0xc0, 0x7f,
+	0xce, 0xd7, 0x9d, 0xf6, 0x06, 0x0b, 0x57, 0x30, 0xd6, 0x44, 0x68, 0xf1, 0x26, 0x82, 0x56, 0xe1,
+	0xac, 0x42, 0x07, 0x43, 0x9f, 0x83, 0xf1, 0x06, 0xeb, 0x3a, 0xdc, 0xf6, 0xaa, 0x7e, 0x3b, 0x10,
+	0x68, 0x02, 0x0e, 0x6d, 0x30, 0x46, 0xce, 0xc1, 0xb1, 0x1d, 0x6c, 0x31, 0xaa, 0x51, 0xc3, 0x30,
+	0x2e, 0xc7, 0x36, 0x18, 0xa3, 0xb3, 0x58, 0x43, 0x1b, 0x8c, 0xdd, 0xb6, 0xb9, 0xe7, 0xda, 0xb5,
+	0x5e, 0x6c, 0xe3, 0xd1, 0xf7, 0x65, 0xe3, 0x3f, 0x30, 0x1f, 0x35, 0x76, 0xb5, 0x5e, 0x7d, 0x8b,
+	0x65, 0x77, 0xaf, 0x1b, 0x8c, 0xad, 0x09, 0x09, 0xff, 0xbc, 0xe0, 0xa6, 0x14, 0x27, 0xd7, 0x61,
+	0xb2, 0x6d, 0x71, 0xaf, 0xca, 0x99, 0xe7, 0xb5, 0xd9, 0x36, 0xeb, 0x78, 0xd5, 0x58, 0x6b, 0x30,
+	0x62, 0x4e, 0xf8, 0xb3, 0x0f, 0xc3, 0xc9, 0xe0, 0x46, 0xa7, 0x57, 0x31, 0x89, 0xf7, 0x7b, 0xde,
+	0x66, 0xdb, 0xd9, 0x79, 0x8b, 0x47, 0x0d, 0xb8, 0x7f, 0x50, 0x37, 0x58, 0xc7, 0xd9, 0xc6, 0x4c,
+	0x04, 0x1f, 0xf4, 0x1f, 0x1a, 0x9c, 0x55, 0xa8, 0x20, 0xfe, 0x49, 0x38, 0xbc, 0x63, 0x77, 0x1a,
+	0xce, 0x0e, 0xf6, 0x58, 0xf8, 0xe5, 0xf7, 0x48, 0xcd, 0xb6, 0x53, 0xb3, 0xda, 0x53, 0x07, 0xd5,
+	0x3b, 0x3a, 0x61, 0x0d, 0x65, 0x7d, 0x2d, 0x8b, 0x73, 0xe6, 0xc9, 0xeb, 0x20, 0x47, 0x2b, 0x90,
+	0x15, 0x39, 0x74, 0x99, 0xb5, 0xc5, 0xdc, 0xa9, 0x51, 0x75, 0x73, 0xbc, 0x6e, 0xbb, 0xf5, 0x9e,
+	0xed, 0xad, 0x05, 0x52, 0xa6, 0x14, 0xa7, 0xaf, 0xe2, 0xea, 0xdd, 0xd9, 0x66, 0x6e, 0x93, 0x75,
+	0xea, 0xfd, 0x07, 0x56, 0x8f, 0x47, 0xbb, 0x6a, 0x0e, 0xc6, 0xad, 0xba, 0x67, 0x3f, 0x61, 0x55,
+	0xa7, 0xd3, 0xee, 0x8b, 0x08, 0x8f, 0x9a, 0x10, 0x0c, 0xdd, 0xef, 0xb4, 0xfb, 0xf4, 0x31, 0xcc,
+	0xa8, 0xf5, 0xc3, 0x23, 0xf4, 0x70, 0x57, 0x8c, 0x64, 0x2d, 0x6e, 0x52, 0xd1, 0x44, 0x69, 0xfa,
+	0x18, 0xcf, 0xed, 0xdb, 0xc1, 0x5e, 0x4c, 0xdd, 0xd7, 0x37, 0x00, 0x44, 0xe4, 0x55, 0xaf, 0xdf,
+	0x65, 0x78, 0xcf, 0x9c, 0x4d, 0x5b, 0xbe, 0xe5, 0x4b, 0x3c, 0xea, 0x77, 0x99, 0x39, 0x66, 0xc9,
+	0x9f, 0xf4, 0xbb, 0xf2, 0x99, 0x9c, 0x36, 0x8c, 0x78, 0x97, 0xfc, 0xab, 0xba, 0xd7, 0x96, 0x97,
+	0xe1, 0xe9, 0xb4, 0xd1, 0xc7, 0xfe, 0xa4, 0x19, 0xc8, 0x90, 0x9b, 0x70, 0x1c, 0xb3, 0x23, 0xbe,
+	0x39, 0xbe, 0xdf, 0x33, 0x94, 0x8e, 0x05, 0xb2, 0xe2, 0x83, 0xd3, 0x55, 0xec, 0x57, 0x37, 0x5c,
+	0x87, 0x7b, 0xf2, 0xed, 0xcd, 0x38, 0x8f, 0x4a, 0x47, 0xf9, 0x5a, 0xb2, 0xa0, 0x9c, 0xad, 0x86,
+	0x31, 0xbc, 0x02, 0x47, 0x78, 0x30, 0x84, 0x51, 0x9c, 0x1f, 0xa8, 0x28, 0x85, 0xb6, 0xd4, 0xa1,
+	0xcb, 0xb8, 0xdb, 0x85, 0x90, 0x40, 0x7b, 0x8f, 0xf5, 0x63, 0x15, 0x12, 0xe5, 0x67, 0x0c, 0x13,
+	0x41, 0xdf, 0x04, 0x5d, 0xa5, 0x82, 0x78, 0x0c, 0x18, 0xd9, 0x62, 0x7d, 0xc4, 0x32, 0xab, 0xc4,
+	0x12, 0xea, 0xf8, 0x92, 0xf4, 0x0d, 0x6c, 0x96, 0x7d, 0x84, 0xcc, 0x7d, 0xc0, 0xdc, 0x4d, 0xc7,
+	0xdd, 0x16, 0x0d, 0x10, 0xa2, 0x58, 0x80, 0x93, 0x4e, 0x97, 0xb9, 0x96, 0xe7, 0xb8, 0xd5, 0x64,
+	0xe3, 0xf6, 0x9c, 0x1c, 0xc7, 0x85, 0xa5, 0x0c, 0x4a, 0x59, 0xb6, 0x10, 0xde, 0x3a, 0x8c, 0x77,
+	0xa3, 0x61, 0x84, 0x79, 0x4e, 0xf5, 0xba, 0x4c, 0xea, 0xc7, 0xb5, 0x68, 0x39, 0xcb, 0x4d, 0x78,
+	0xc5, 0xb6, 0x60, 0x2e, 0x53, 0x02, 0x91, 0xdc, 0x81, 0x63, 0x31, 0x9b, 0xb2, 0x64, 0x0a, 0x40,
+	0x49, 0xa8, 0xd1, 0x35, 0x5c, 0x40, 0x91, 0xd4, 0x47, 0xae, 0xd5, 0xe1, 0x9b, 0xcc, 0x95, 0xa9,
+	0xbb, 0x08, 0x27, 0xb8, 0xd3, 0x73, 0xeb, 0xac, 0xfa, 0x84, 0xb9, 0xe1, 0x1e, 0x19, 0x35, 0x8f,
+	0x07, 0xa3, 0x8f, 0x83, 0x41, 0xfa, 0x36, 0xe8, 0x2a, 0x1b, 0x08, 0xf4, 0x65, 0x38, 0xea, 0xe1,
+	0x58, 0xd6, 0xb2, 0x26, 0x15, 0x43, 0xf1, 0xb0, 0x21, 0x4b, 0xcc, 0x87, 0x49, 0x7a, 0x07, 0xa6,
+	0x95, 0xb3, 0x21, 0x89, 0x38, 0x26, 0x0d, 0xc9, 0xec, 0xe4, 0x38, 0x8e, 0xe4, 0x57, 0xfe, 0xb4,
+	0x08, 0x87, 0x84, 0x71, 0xf2, 0x04, 0xc6, 0x63, 0xaf, 0x5a, 0x42, 0xd3, 0x26, 0x06, 0x1f, 0xc2,
+	0xfa, 0xf9, 0xa1, 0x32, 0x01, 0x3c, 0x5a, 0xfa, 0xce, 0xdf, 0xfe, 0xfd, 0x93, 0x83, 0x53, 0x64,
+	0xd2, 0x50, 0xd2, 0xf4, 0xa4, 0x0f, 0xc7, 0x13, 0x0f, 0x5d, 0x72, 0x41, 0x69, 0x35, 0xf5, 0x3e,
+	0xd6, 0x2f, 0xe6, 0x48, 0xa1, 0xf7, 0x69, 0xe1, 0xfd, 0x34, 0x39, 0x95, 0xf6, 0xee, 0xd9, 0x5d,
+	0xf2, 0x6b, 0x0d, 0xaf, 0x3d, 0xc5, 0x23, 0x97, 0x18, 0x4a, 0x07, 0xd9, 0x2f, 0x69, 0xfd, 0x6a,
+	0x71, 0x05, 0x04, 0x77, 0x49, 0x80, 0x3b, 0x47, 0xe6, 0xd2, 0xe0, 0x82, 0x1b, 0xdb, 0xd8, 0x0d,
+	0xfe, 0xdf, 0x23, 0x1f, 0x68, 0x30, 0xa9, 0xb0, 0xe6, 0x3f, 0xfd, 0xaf, 0x14, 0xf0, 0x1a, 0xbd,
+	0xa8, 0xf5, 0x4a, 0x51, 0x71, 0x84, 0x78, 0x5e, 0x40, 0x9c, 0x25, 0xd3, 0x03, 0x10, 0x2d, 0xde,
+	0x32, 0x76, 0xfd, 0x7f, 0xf7, 0xc8, 0xb7, 0xe1, 0x58, 0x9c, 0x35, 0x22, 0xea, 0x7d, 0x91, 0xa4,
+	0x9a, 0xf4, 0x0b, 0xc3, 0x85, 0xd0, 0xff, 0x9c, 0xf0, 0x7f, 0x96, 0x9c, 0x49, 0xfb, 0xdf, 0x64,
+	0xcc, 0xf5, 0x7d, 0x7d, 0x2c, 0xfb, 0x10, 0x15, 0xeb, 0x43, 0xd4, 0x6b, 0x32, 0x84, 0x91, 0xd2,
+	0x97, 0xf7, 0xa1, 0x81, 0x18, 0x97, 0x05, 0xc6, 0x25, 0xb2, 0x90, 0xc6, 0xb8, 0x13, 0x6a, 0xf9,
+	0x70, 0x0d, 0xc6, 0x3d, 0x7b, 0x5b, 0x30, 0x83, 0xe4, 0xaf, 0x1a, 0x94, 0x12, 0x86, 0x07, 0x98,
+	0x4c, 0xb2, 0x3a, 0x14, 0x48, 0x16, 0x09, 0xab, 0xbf, 0xb8, 0x5f, 0x35, 0x0c, 0xe2, 0x15, 0x11,
+	0xc4, 0x4b, 0x64, 0x75, 0x48, 0x10, 0xf2, 0xb9, 0x64, 0xe0, 0xf5, 0x62, 0xec, 0xe2, 0x8f, 0x3d,
+	0xf2, 0x47, 0x0d, 0x66, 0x33, 0x3c, 0x05, 0x9c, 0x25, 0xb9, 0x5e, 0x10, 0x58, 0x82, 0x1c, 0xd5,
+	0x57, 0xf7, 0xa9, 0x85, 0xd1, 0xac, 0x8a, 0x68, 0x0c, 0x72, 0xa5, 0x48, 0x34, 0xde, 0x53, 0x63,
+	0xd7, 0xef, 0x23, 0xf6, 0xc8, 0x5f, 0x34, 0x98, 0xcb, 0xf9, 0xdb, 0x0d, 0x51, 0x27, 0x38, 0xf7,
+	0xef, 0x4b, 0xfa, 0x4b, 0xfb, 0xd6, 0xc3, 0x58, 0x5e, 0x12, 0xb1, 0x2c, 0x13, 0xa3, 0x48, 0x2c,
+	0x35, 0xaf, 0x6e, 0x74, 0x03, 0x9b, 0xe4, 0x43, 0x0d, 0x89, 0xca, 0x24, 0x09, 0x4c, 0x16, 0x95,
+	0x48, 0x94, 0x7f, 0xfd, 0xd1, 0x97, 0x0a, 0xc9, 0x22, 0xd2, 0x6b, 0x02, 0xe9, 0x15, 0xb2, 0x94,
+	0x46, 0xca, 0x03, 0xf9, 0x08, 0xe6, 0xae, 0xfc, 0x13, 0xd2, 0x1e, 0xf9, 0x85, 0x06, 0x13, 0x0a,
+	0xa3, 0x9c, 0x14, 0x71, 0x1d, 0x66, 0xf7, 0x72, 0x31, 0x61, 0x04, 0x3a, 0x2f, 0x80, 0x52, 0x52,
+	0xce, 0x03, 0x4a, 0x3e, 0xd1, 0x60, 0x56, 0x65, 0x2a, 0xaa, 0xd3, 0xeb, 0x45, 0x3c, 0x0f, 0x94,
+	0xe9, 0xea, 0x3e, 0xb5, 0x10, 0xf8, 0x4d, 0x01, 0xfc, 0x3a, 0x59, 0xc9, 0xcd, 0xf0, 0x60, 0x89,
+	0xfe, 0x41, 0xfd, 0xc7, 0xb0, 0xb0, 0x40, 0x57, 0x0a, 0x40, 0x4a, 0x97, 0xe7, 0xb5, 0x7d, 0xe9,
+	0x60, 0x10, 0x2b, 0x22, 0x88, 0xcb, 0x64, 0x31, 0x37, 0x88, 0xa8, 0x32, 0x5d, 0x80, 0x88, 0x04,
+	0x25, 0xe7, 0x94, 0x6e, 0xe3, 0x7c, 0xad, 0x4e, 0x87, 0x89, 0x20, 0x90, 0x59, 0x01, 0xe4, 0x0c,
+	0x39, 0x9d, 0x06, 0x22, 0xd8, 0x52, 0xf2, 0x33, 0x59, 0x3f, 0x49, 0xe6, 0x35, 0xa3, 0x7e, 0x94,
+	0xb4, 0xae, 0xbe, 0x54, 0x48, 0x36, 0xaf, 0x1f, 0x10, 0x78, 0x62, 0x4b, 0xf9, 0x67, 0x0d, 0x68,
+	0x68, 0x28, 0x93, 0x80, 0x25, 0x2f, 0x67, 0x3a, 0xcf, 0x23, 0x7f, 0xf5, 0x9b, 0x5f, 0x44, 0x15,
+	0xc3, 0xb8, 0x22, 0xc2, 0xb8, 0x44, 0x2e, 0xe6, 0x84, 0x61, 0x70, 0xcf, 0xf2, 0x38, 0xf9, 0xa1,
+	0x06, 0xcf, 0xa5, 0x28, 0x4e, 0xf2, 0x82, 0xd2, 0xfd, 0x00, 0xa7, 0xab, 0x5f, 0xca, 0x95, 0xcb,
+	0xab, 0xf8, 0xc6, 0x56, 0xb8, 0xdf, 0x8c, 0x5d, 0x7f, 0xa7, 0x7d, 0x5f, 0x83, 0x93, 0x29, 0x2b,
+	0x9c, 0xe4, 0xf9, 0x09, 0xf3, 0x36, 0x9f, 0x2f, 0x98, 0xd7, 0x59, 0xc5, 0x10, 0x91, 0x9f, 0xca,
+	0x2d, 0x98, 0x24, 0x63, 0x33, 0xb6, 0xa0, 0x92, 0xcf, 0xd5, 0x97, 0x0a, 0xc9, 0x22, 0xaa, 0x0b,
+	0x02, 0x55, 0x89, 0xcc, 0x0c, 0x41, 0xc5, 0xc9, 0xef, 0x35, 0xc9, 0x44, 0xa8, 0x78, 0x59, 0xb2,
+	0x9c, 0x95, 0x84, 0x4c, 0xe2, 0x57, 0x5f, 0xd9, 0x8f, 0x4a, 0xde, 0x75, 0xe3, 0x63, 0xad, 0x87,
+	0x7a, 0xc9, 0xe5, 0x95, 0x07, 0x89, 0x20, 0x75, 0x33, 0x0e, 0x92, 0x38, 0x0b, 0xac, 0xd3, 0x61,
+	0x22, 0x79, 0x07, 0x89, 0xa0, 0x7f, 0x89, 0x07, 0x63, 0xa1, 0x12, 0x29, 0x67, 0xda, 0x93, 0x1e,
+	0xcf, 0x0d, 0x91, 0x40, 0x87, 0x54, 0x38, 0x9c, 0x21, 0xba, 0xd2, 0x61, 0x10, 0xe9, 0xfb, 0x1a,
+	0x3c, 0x3f, 0xc0, 0x72, 0x12, 0xf5, 0x06, 0x55, 0x90, 0xa7, 0xfa, 0x42, 0x01, 0xc9, 0xbc, 0x5d,
+	0xe3, 0xb7, 0xbd, 0x5d, 0xd4, 0x88, 0x6e, 0xfa, 0x14, 0xeb, 0x99, 0x71, 0xd3, 0xab, 0xb9, 0x53,
+	0xfd, 0x72, 0x31, 0xe1, 0xbc, 0xba, 0xf7, 0x91, 0x35, 0xe2, 0x20, 0x7e, 0xa0, 0xc5, 0x48, 0x7a,
+	0xa4, 0xc3, 0x33, 0xea, 0x7e, 0x90, 0x5b, 0xd7, 0xe7, 0xf3, 0x05, 0xf3, 0x72, 0xe5, 0x2f, 0x5d,
+	0x15, 0xf9, 0x73, 0xf2, 0x9e, 0x5c, 0xbc, 0x38, 0xb5, 0x99, 0xb1, 0x78, 0x0a, 0xd2, 0x56, 0x5f,
+	0x28, 0x20, 0x99, 0xf7, 0xc4, 0x72, 0x02, 0x69, 0xf2, 0x63, 0xb9, 0x6e, 0x29, 0x3e, 0x33, 0x63,
+	0xdd, 0xd4, 0xac, 0xa9, 0x7e, 0xb9, 0x98, 0x70, 0x3e, 0x6b, 0x20, 0x5c, 0x87, 0x77, 0x73, 0x92,
+	0xb2, 0xcc, 0x38, 0x18, 0x95, 0x84, 0xa9, 0xbe, 0x54, 0x48, 0x36, 0xef, 0x6e, 0xc6, 0x3f, 0x0c,
+	0xc8, 0x86, 0x8b, 0x7c, 0x2c, 0x49, 0x05, 0x05, 0x9f, 0x98, 0x41, 0x2a, 0x64, 0xd3, 0x9d, 0xfa,
+	0xd5, 0xe2, 0x0a, 0x08, 0xf4, 0xba, 0x00, 0x5a, 0x21, 0x97, 0xb3, 0xba, 0xab, 0x4d, 0x5f, 0xd9,
+	0x40, 0x62, 0x53, 0xf6, 0x57, 0xbf, 0xd2, 0x80, 0x44, 0xa6, 0x25, 0xf3, 0x48, 0x16, 0xb2, 0xdd,
+	0xa7, 0x48, 0x50, 0x7d, 0xb1, 0x88, 0x68, 0xde, 0x8b, 0x39, 0x89, 0x71, 0x8b, 0xf5, 0x8d, 0x5d,
+	0x41, 0xa6, 0xee, 0x91, 0x8f, 0x25, 0x05, 0x32, 0x40, 0xf4, 0x65, 0x50, 0x20, 0x59, 0x3c, 0xa9,
+	0x5e, 0x29, 0x2a, 0x9e, 0xd7, 0x73, 0x7b, 0x9c, 0x1b, 0x31, 0x8e, 0xd1, 0xd8, 0x4d, 0xd3, 0xaf,
+	0x7b, 0xe4, 0x23, 0x0d, 0xce, 0xa8, 0xcd, 0x73, 0x52, 0x10, 0x47, 0xb8, 0x5d, 0x8d, 0xc2, 0xf2,
+	0x79, 0x67, 0x5f, 0x0a, 0x38, 0x27, 0x1f, 0xc9, 0xd5, 0x4f, 0xf0, 0x84, 0x19, 0xab, 0xaf, 0x62,
+	0x50, 0xf5, 0xc5, 0x22, 0xa2, 0x79, 0x0f, 0x5a, 0xb1, 0xd4, 0x86, 0x24, 0x27, 0x8d, 0xdd, 0x24,
+	0x27, 0xbb, 0x17, 0x15, 0x7d, 0xc2, 0x6e, 0x56, 0xd1, 0x2b, 0xc9, 0x54, 0x7d, 0xa9, 0x90, 0x6c,
+	0x5e, 0xd1, 0x27, 0x91, 0xf2, 0xb5, 0xbb, 0x9f, 0x7c, 0x56, 0xd2, 0x3e, 0xfd, 0xac, 0xa4, 0xfd,
+	0xeb, 0xb3, 0x92, 0xf6, 0xa3, 0xcf, 0x4b, 0x07, 0x3e, 0xfd, 0xbc, 0x74, 0xe0, 0xef, 0x9f, 0x97,
+	0x0e, 0xbc, 0x53, 0x69, 0xda, 0x5e, 0xab, 0x57, 0xab, 0xd4, 0x9d, 0x6d, 0x61, 0x44, 0xde, 0x86,
+	0x81, 0xc5, 0xa7, 0xf1, 0x55, 0xe9, 0x77, 0x19, 0xaf, 0x1d, 0x16, 0x02, 0xd7, 0xfe, 0x33, 0x00,
+	0x94, 0xdc, 0xce, 0x9a, 0x61, 0x2d, 0x00, 0x00,
 }
 
-func (m *QuerySigningRequestsByAddressRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	QueryParams(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// ChainTip queries the chain tip of the module.
+	QueryChainTip(ctx context.Context, in *QueryChainTipRequest, opts ...grpc.CallOption) (*QueryChainTipResponse, error)
+	// BlockHeaderByHeight queries the block header by height.
+	QueryBlockHeaderByHeight(ctx context.Context, in *QueryBlockHeaderByHeightRequest, opts ...grpc.CallOption) (*QueryBlockHeaderByHeightResponse, error)
+	// BlockHeaderByHash queries the block header by hash.
+	QueryBlockHeaderByHash(ctx context.Context, in *QueryBlockHeaderByHashRequest, opts ...grpc.CallOption) (*QueryBlockHeaderByHashResponse, error)
+	// QueryFeeRate queries the bitcoin network fee rate on the side chain.
+	QueryFeeRate(ctx context.Context, in *QueryFeeRateRequest, opts ...grpc.CallOption) (*QueryFeeRateResponse, error)
+	// QueryWithdrawalNetworkFee queries the estimated btc network fee for the given withdrawal.
+	QueryWithdrawalNetworkFee(ctx context.Context, in *QueryWithdrawalNetworkFeeRequest, opts ...grpc.CallOption) (*QueryWithdrawalNetworkFeeResponse, error)
+	// QueryWithdrawRequestsByAddress queries the withdrawal requests by the given address.
+	QueryWithdrawRequestsByAddress(ctx context.Context, in *QueryWithdrawRequestsByAddressRequest, opts ...grpc.CallOption) (*QueryWithdrawRequestsByAddressResponse, error)
+	// QueryWithdrawRequestsByTxHash queries the withdrawal requests by the given tx hash.
+	QueryWithdrawRequestsByTxHash(ctx context.Context, in *QueryWithdrawRequestsByTxHashRequest, opts ...grpc.CallOption) (*QueryWithdrawRequestsByTxHashResponse, error)
+	// QueryPendingBtcWithdrawRequests queries the pending btc withdrawal requests.
+	QueryPendingBtcWithdrawRequests(ctx context.Context, in *QueryPendingBtcWithdrawRequestsRequest, opts ...grpc.CallOption) (*QueryPendingBtcWithdrawRequestsResponse, error)
+	// QuerySigningRequest queries the signing requests by sequence.
+	QuerySigningRequest(ctx context.Context, in *QuerySigningRequestRequest, opts ...grpc.CallOption) (*QuerySigningRequestResponse, error)
+	// QuerySigningRequests queries the signing requests by the given status.
+	QuerySigningRequests(ctx context.Context, in *QuerySigningRequestsRequest, opts ...grpc.CallOption) (*QuerySigningRequestsResponse, error)
+	// QuerySigningRequestsByAddress queries the signing requests by the given address.
+	QuerySigningRequestsByAddress(ctx context.Context, in *QuerySigningRequestsByAddressRequest, opts ...grpc.CallOption) (*QuerySigningRequestsByAddressResponse, error)
+	// QuerySigningRequestByTxHash queries the signing request by the given tx hash.
+	QuerySigningRequestByTxHash(ctx context.Context, in *QuerySigningRequestByTxHashRequest, opts ...grpc.CallOption) (*QuerySigningRequestByTxHashResponse, error)
+	// QueryUTXOs queries all utxos.
+	QueryUTXOs(ctx context.Context, in *QueryUTXOsRequest, opts ...grpc.CallOption) (*QueryUTXOsResponse, error)
+	// QueryUTXOsByAddress queries the utxos of the given address.
+	QueryUTXOsByAddress(ctx context.Context, in *QueryUTXOsByAddressRequest, opts ...grpc.CallOption) (*QueryUTXOsByAddressResponse, error)
+	// QueryUTXOCountAndBalancesByAddress queries the total count and balances of the unlocked utxos by the given address.
+	QueryUTXOCountAndBalancesByAddress(ctx context.Context, in *QueryUTXOCountAndBalancesByAddressRequest, opts ...grpc.CallOption) (*QueryUTXOCountAndBalancesByAddressResponse, error)
+	// QueryDKGRequest queries the DKG request by the given id.
+	QueryDKGRequest(ctx context.Context, in *QueryDKGRequestRequest, opts ...grpc.CallOption) (*QueryDKGRequestResponse, error)
+	// QueryDKGRequests queries the DKG requests by the given status
+	QueryDKGRequests(ctx context.Context, in *QueryDKGRequestsRequest, opts ...grpc.CallOption) (*QueryDKGRequestsResponse, error)
+	// QueryAllDKGRequests queries all DKG requests.
+	QueryAllDKGRequests(ctx context.Context, in *QueryAllDKGRequestsRequest, opts ...grpc.CallOption) (*QueryAllDKGRequestsResponse, error)
+	// QueryDKGCompletionRequests queries DKG completion requests by the given id.
+	QueryDKGCompletionRequests(ctx context.Context, in *QueryDKGCompletionRequestsRequest, opts ...grpc.CallOption) (*QueryDKGCompletionRequestsResponse, error)
+	// QueryRunes queries all registered runes.
+	QueryRunes(ctx context.Context, in *QueryRunesRequest, opts ...grpc.CallOption) (*QueryRunesResponse, error)
+	// QueryRune queries the registered rune by the given id.
+	QueryRune(ctx context.Context, in *QueryRuneRequest, opts ...grpc.CallOption) (*QueryRuneResponse, error)
+	// QueryProtocolFees queries the protocol fees quoted for the given amount.
+	QueryProtocolFees(ctx context.Context, in *QueryProtocolFeesRequest, opts ...grpc.CallOption) (*QueryProtocolFeesResponse, error)
+	// QueryFeeDistribution queries the accumulated and distributed protocol fees of each bucket.
+	QueryFeeDistribution(ctx context.Context, in *QueryFeeDistributionRequest, opts ...grpc.CallOption) (*QueryFeeDistributionResponse, error)
+	// QueryRuneConfigs queries the configurations and voucher supplies of the configured runes.
+	QueryRuneConfigs(ctx context.Context, in *QueryRuneConfigsRequest, opts ...grpc.CallOption) (*QueryRuneConfigsResponse, error)
+	// QueryOutflowUsage queries the withdrawal outflow of the current rate limit window.
+	QueryOutflowUsage(ctx context.Context, in *QueryOutflowUsageRequest, opts ...grpc.CallOption) (*QueryOutflowUsageResponse, error)
+	// QueryEmergencyPauses queries the emergency pauses.
+	QueryEmergencyPauses(ctx context.Context, in *QueryEmergencyPausesRequest, opts ...grpc.CallOption) (*QueryEmergencyPausesResponse, error)
+	// QueryDepositAddress queries the deposit address assigned among the active vaults of the given asset type.
+	QueryDepositAddress(ctx context.Context, in *QueryDepositAddressRequest, opts ...grpc.CallOption) (*QueryDepositAddressResponse, error)
+	// QueryFrostSigningSession queries the FROST signing session of the given signing request.
+	QueryFrostSigningSession(ctx context.Context, in *QueryFrostSigningSessionRequest, opts ...grpc.CallOption) (*QueryFrostSigningSessionResponse, error)
+	// QueryFrostVaultKey queries the FROST key of the given vault.
+	QueryFrostVaultKey(ctx context.Context, in *QueryFrostVaultKeyRequest, opts ...grpc.CallOption) (*QueryFrostVaultKeyResponse, error)
+	// QuerySignerPerformance queries the liveness performance of the given TSS participant.
+	QuerySignerPerformance(ctx context.Context, in *QuerySignerPerformanceRequest, opts ...grpc.CallOption) (*QuerySignerPerformanceResponse, error)
+	// QuerySignerPerformances queries the liveness performances of all the TSS participants.
+	QuerySignerPerformances(ctx context.Context, in *QuerySignerPerformancesRequest, opts ...grpc.CallOption) (*QuerySignerPerformancesResponse, error)
+	// QueryVaultTransfer queries the vault transfer from the given source version.
+	QueryVaultTransfer(ctx context.Context, in *QueryVaultTransferRequest, opts ...grpc.CallOption) (*QueryVaultTransferResponse, error)
+	// QueryVaultTransfers queries all vault transfers.
+	QueryVaultTransfers(ctx context.Context, in *QueryVaultTransfersRequest, opts ...grpc.CallOption) (*QueryVaultTransfersResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) QueryParams(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/side.btcbridge.Query/QueryParams", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QuerySigningRequestsByAddressRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) QueryChainTip(ctx context.Context, in *QueryChainTipRequest, opts ...grpc.CallOption) (*QueryChainTipResponse, error) {
+	out := new(QueryChainTipResponse)
+	err := c.cc.Invoke(ctx, "/side.btcbridge.Query/QueryChainTip", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QuerySigningRequestsByAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) QueryBlockHeaderByHeight(ctx context.Context, in *QueryBlockHeaderByHeightRequest, opts ...grpc.CallOption) (*QueryBlockHeaderByHeightResponse, error) {
+	out := new(QueryBlockHeaderByHeightResponse)
+	err := c.cc.Invoke(ctx, "/side.btcbridge.Query/QueryBlockHeaderByHeight", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QuerySigningRequestsByAddressResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) QueryBlockHeaderByHash(ctx context.Context, in *QueryBlockHeaderByHashRequest, opts ...grpc.CallOption) (*QueryBlockHeaderByHashResponse, error) {
+	out := new(QueryBlockHeaderByHashResponse)
+	err := c.cc.Invoke(ctx, "/side.btcbridge.Query/QueryBlockHeaderByHash", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QuerySigningRequestsByAddressResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) QueryFeeRate(ctx context.Context, in *QueryFeeRateRequest, opts ...grpc.CallOption) (*QueryFeeRateResponse, error) {
+	out := new(QueryFeeRateResponse)
+	err := c.cc.Invoke(ctx, "/side.btcbridge.Query/QueryFeeRate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QuerySigningRequestsByAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Requests) > 0 {
-		for iNdEx := len(m.Requests) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Requests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) QueryWithdrawalNetworkFee(ctx context.Context, in *QueryWithdrawalNetworkFeeRequest, opts ...grpc.CallOption) (*QueryWithdrawalNetworkFeeResponse, error) {
+	out := new(QueryWithdrawalNetworkFeeResponse)
+	err := c.cc.Invoke(ctx, "/side.btcbridge.Query/QueryWithdrawalNetworkFee", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QuerySigningRequestByTxHashRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) QueryWithdrawRequestsByAddress(ctx context.Context, in *QueryWithdrawRequestsByAddressRequest, opts ...grpc.CallOption) (*QueryWithdrawRequestsByAddressResponse, error) {
+	out := new(QueryWithdrawRequestsByAddressResponse)
+	err := c.cc.Invoke(ctx, "/side.btcbridge.Query/QueryWithdrawRequestsByAddress", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QuerySigningRequestByTxHashRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QuerySigningRequestByTxHashRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Txid) > 0 {
-		i -= len(m.Txid)
-		copy(dAtA[i:], m.Txid)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Txid)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) QueryWithdrawRequestsByTxHash(ctx context.Context, in *QueryWithdrawRequestsByTxHashRequest, opts ...grpc.CallOption) (*QueryWithdrawRequestsByTxHashResponse, error) {
+	out := new(QueryWithdrawRequestsByTxHashResponse)
+	err := c.cc.Invoke(ctx, "/side.btcbridge.Query/QueryWithdrawRequestsByTxHash", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QuerySigningRequestByTxHashResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) QueryPendingBtcWithdrawRequests(ctx context.Context, in *QueryPendingBtcWithdrawRequestsRequest, opts ...grpc.CallOption) (*QueryPendingBtcWithdrawRequestsResponse, error) {
+	out := new(QueryPendingBtcWithdrawRequestsResponse)
+	err := c.cc.Invoke(ctx, "/side.btcbridge.Query/QueryPendingBtcWithdrawRequests", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QuerySigningRequestByTxHashResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) QuerySigningRequest(ctx context.Context, in *QuerySigningRequestRequest, opts ...grpc.CallOption) (*QuerySigningRequestResponse, error) {
+	out := new(QuerySigningRequestResponse)
+	err := c.cc.Invoke(ctx, "/side.btcbridge.Query/QuerySigningRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QuerySigningRequestByTxHashResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Request != nil {
-		{
-			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) QuerySigningRequests(ctx context.Context, in *QuerySigningRequestsRequest, opts ...grpc.CallOption) (*QuerySigningRequestsResponse, error) {
+	out := new(QuerySigningRequestsResponse)
+	err := c.cc.Invoke(ctx, "/side.btcbridge.Query/QuerySigningRequests", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryFeeRateRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) QuerySigningRequestsByAddress(ctx context.Context, in *QuerySigningRequestsByAddressRequest, opts ...grpc.CallOption) (*QuerySigningRequestsByAddressResponse, error) {
+	out := new(QuerySigningRequestsByAddressResponse)
+	err := c.cc.Invoke(ctx, "/side.btcbridge.Query/QuerySigningRequestsByAddress", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryFeeRateRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) QuerySigningRequestByTxHash(ctx context.Context, in *QuerySigningRequestByTxHashRequest, opts ...grpc.CallOption) (*QuerySigningRequestByTxHashResponse, error) {
+	out := new(QuerySigningRequestByTxHashResponse)
+	err := c.cc.Invoke(ctx, "/side.btcbridge.Query/QuerySigningRequestByTxHash", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryFeeRateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *queryClient) QueryUTXOs(ctx context.Context, in *QueryUTXOsRequest, opts ...grpc.CallOption) (*QueryUTXOsResponse, error) {
+	out := new(QueryUTXOsResponse)
+	err := c.cc.Invoke(ctx, "/side.btcbridge.Query/QueryUTXOs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryFeeRateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) QueryUTXOsByAddress(ctx context.Context, in *QueryUTXOsByAddressRequest, opts ...grpc.CallOption) (*QueryUTXOsByAddressResponse, error) {
+	out := new(QueryUTXOsByAddressResponse)
+	err := c.cc.Invoke(ctx, "/side.btcbridge.Query/QueryUTXOsByAddress", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryFeeRateResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) QueryUTXOCountAndBalancesByAddress(ctx context.Context, in *QueryUTXOCountAndBalancesByAddressRequest, opts ...grpc.CallOption) (*QueryUTXOCountAndBalancesByAddressResponse, error) {
+	out := new(QueryUTXOCountAndBalancesByAddressResponse)
+	err := c.cc.Invoke(ctx, "/side.btcbridge.Query/QueryUTXOCountAndBalancesByAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryFeeRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.FeeRate != nil {
-		{
-			size, err := m.FeeRate.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) QueryDKGRequest(ctx context.Context, in *QueryDKGRequestRequest, opts ...grpc.CallOption) (*QueryDKGRequestResponse, error) {
+	out := new(QueryDKGRequestResponse)
+	err := c.cc.Invoke(ctx, "/side.btcbridge.Query/QueryDKGRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryWithdrawalNetworkFeeRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) QueryDKGRequests(ctx context.Context, in *QueryDKGRequestsRequest, opts ...grpc.CallOption) (*QueryDKGRequestsResponse, error) {
+	out := new(QueryDKGRequestsResponse)
+	err := c.cc.Invoke(ctx, "/side.btcbridge.Query/QueryDKGRequests", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryWithdrawalNetworkFeeRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) QueryAllDKGRequests(ctx context.Context, in *QueryAllDKGRequestsRequest, opts ...grpc.CallOption) (*QueryAllDKGRequestsResponse, error) {
+	out := new(QueryAllDKGRequestsResponse)
+	err := c.cc.Invoke(ctx, "/side.btcbridge.Query/QueryAllDKGRequests", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryWithdrawalNetworkFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.FeeRate != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.FeeRate))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Amount) > 0 {
-		i -= len(m.Amount)
-		copy(dAtA[i:], m.Amount)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Amount)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) QueryDKGCompletionRequests(ctx context.Context, in *QueryDKGCompletionRequestsRequest, opts ...grpc.CallOption) (*QueryDKGCompletionRequestsResponse, error) {
+	out := new(QueryDKGCompletionRequestsResponse)
+	err := c.cc.Invoke(ctx, "/side.btcbridge.Query/QueryDKGCompletionRequests", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryWithdrawalNetworkFeeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) QueryRunes(ctx context.Context, in *QueryRunesRequest, opts ...grpc.CallOption) (*QueryRunesResponse, error) {
+	out := new(QueryRunesResponse)
+	err := c.cc.Invoke(ctx, "/side.btcbridge.Query/QueryRunes", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryWithdrawalNetworkFeeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) QueryRune(ctx context.Context, in *QueryRuneRequest, opts ...grpc.CallOption) (*QueryRuneResponse, error) {
+	out := new(QueryRuneResponse)
+	err := c.cc.Invoke(ctx, "/side.btcbridge.Query/QueryRune", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryWithdrawalNetworkFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Fee) > 0 {
-		i -= len(m.Fee)
-		copy(dAtA[i:], m.Fee)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Fee)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.FeeRate != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.FeeRate))
-		i--
-		dAtA[i] = 0x8
+func (c *queryClient) QueryProtocolFees(ctx context.Context, in *QueryProtocolFeesRequest, opts ...grpc.CallOption) (*QueryProtocolFeesResponse, error) {
+	out := new(QueryProtocolFeesResponse)
+	err := c.cc.Invoke(ctx, "/side.btcbridge.Query/QueryProtocolFees", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) QueryFeeDistribution(ctx context.Context, in *QueryFeeDistributionRequest, opts ...grpc.CallOption) (*QueryFeeDistributionResponse, error) {
+	out := new(QueryFeeDistributionResponse)
+	err := c.cc.Invoke(ctx, "/side.btcbridge.Query/QueryFeeDistribution", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) QueryRuneConfigs(ctx context.Context, in *QueryRuneConfigsRequest, opts ...grpc.CallOption) (*QueryRuneConfigsResponse, error) {
+	out := new(QueryRuneConfigsResponse)
+	err := c.cc.Invoke(ctx, "/side.btcbridge.Query/QueryRuneConfigs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *queryClient) QueryOutflowUsage(ctx context.Context, in *QueryOutflowUsageRequest, opts ...grpc.CallOption) (*QueryOutflowUsageResponse, error) {
+	out := new(QueryOutflowUsageResponse)
+	err := c.cc.Invoke(ctx, "/side.btcbridge.Query/QueryOutflowUsage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) QueryEmergencyPauses(ctx context.Context, in *QueryEmergencyPausesRequest, opts ...grpc.CallOption) (*QueryEmergencyPausesResponse, error) {
+	out := new(QueryEmergencyPausesResponse)
+	err := c.cc.Invoke(ctx, "/side.btcbridge.Query/QueryEmergencyPauses", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) QueryDepositAddress(ctx context.Context, in *QueryDepositAddressRequest, opts ...grpc.CallOption) (*QueryDepositAddressResponse, error) {
+	out := new(QueryDepositAddressResponse)
+	err := c.cc.Invoke(ctx, "/side.btcbridge.Query/QueryDepositAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *QueryChainTipRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) QueryFrostSigningSession(ctx context.Context, in *QueryFrostSigningSessionRequest, opts ...grpc.CallOption) (*QueryFrostSigningSessionResponse, error) {
+	out := new(QueryFrostSigningSessionResponse)
+	err := c.cc.Invoke(ctx, "/side.btcbridge.Query/QueryFrostSigningSession", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryChainTipRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryChainTipRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryChainTipResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) QueryFrostVaultKey(ctx context.Context, in *QueryFrostVaultKeyRequest, opts ...grpc.CallOption) (*QueryFrostVaultKeyResponse, error) {
+	out := new(QueryFrostVaultKeyResponse)
+	err := c.cc.Invoke(ctx, "/side.btcbridge.Query/QueryFrostVaultKey", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryChainTipResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryChainTipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Height != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Hash) > 0 {
-		i -= len(m.Hash)
-		copy(dAtA[i:], m.Hash)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Hash)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) QuerySignerPerformance(ctx context.Context, in *QuerySignerPerformanceRequest, opts ...grpc.CallOption) (*QuerySignerPerformanceResponse, error) {
+	out := new(QuerySignerPerformanceResponse)
+	err := c.cc.Invoke(ctx, "/side.btcbridge.Query/QuerySignerPerformance", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryBlockHeaderByHeightRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) QuerySignerPerformances(ctx context.Context, in *QuerySignerPerformancesRequest, opts ...grpc.CallOption) (*QuerySignerPerformancesResponse, error) {
+	out := new(QuerySignerPerformancesResponse)
+	err := c.cc.Invoke(ctx, "/side.btcbridge.Query/QuerySignerPerformances", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryBlockHeaderByHeightRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryBlockHeaderByHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Height != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x8
+func (c *queryClient) QueryVaultTransfer(ctx context.Context, in *QueryVaultTransferRequest, opts ...grpc.CallOption) (*QueryVaultTransferResponse, error) {
+	out := new(QueryVaultTransferResponse)
+	err := c.cc.Invoke(ctx, "/side.btcbridge.Query/QueryVaultTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryBlockHeaderByHeightResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) QueryVaultTransfers(ctx context.Context, in *QueryVaultTransfersRequest, opts ...grpc.CallOption) (*QueryVaultTransfersResponse, error) {
+	out := new(QueryVaultTransfersResponse)
+	err := c.cc.Invoke(ctx, "/side.btcbridge.Query/QueryVaultTransfers", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryBlockHeaderByHeightResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	QueryParams(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// ChainTip queries the chain tip of the module.
+	QueryChainTip(context.Context, *QueryChainTipRequest) (*QueryChainTipResponse, error)
+	// BlockHeaderByHeight queries the block header by height.
+	QueryBlockHeaderByHeight(context.Context, *QueryBlockHeaderByHeightRequest) (*QueryBlockHeaderByHeightResponse, error)
+	// BlockHeaderByHash queries the block header by hash.
+	QueryBlockHeaderByHash(context.Context, *QueryBlockHeaderByHashRequest) (*QueryBlockHeaderByHashResponse, error)
+	// QueryFeeRate queries the bitcoin network fee rate on the side chain.
+	QueryFeeRate(context.Context, *QueryFeeRateRequest) (*QueryFeeRateResponse, error)
+	// QueryWithdrawalNetworkFee queries the estimated btc network fee for the given withdrawal.
+	QueryWithdrawalNetworkFee(context.Context, *QueryWithdrawalNetworkFeeRequest) (*QueryWithdrawalNetworkFeeResponse, error)
+	// QueryWithdrawRequestsByAddress queries the withdrawal requests by the given address.
+	QueryWithdrawRequestsByAddress(context.Context, *QueryWithdrawRequestsByAddressRequest) (*QueryWithdrawRequestsByAddressResponse, error)
+	// QueryWithdrawRequestsByTxHash queries the withdrawal requests by the given tx hash.
+	QueryWithdrawRequestsByTxHash(context.Context, *QueryWithdrawRequestsByTxHashRequest) (*QueryWithdrawRequestsByTxHashResponse, error)
+	// QueryPendingBtcWithdrawRequests queries the pending btc withdrawal requests.
+	QueryPendingBtcWithdrawRequests(context.Context, *QueryPendingBtcWithdrawRequestsRequest) (*QueryPendingBtcWithdrawRequestsResponse, error)
+	// QuerySigningRequest queries the signing requests by sequence.
+	QuerySigningRequest(context.Context, *QuerySigningRequestRequest) (*QuerySigningRequestResponse, error)
+	// QuerySigningRequests queries the signing requests by the given status.
+	QuerySigningRequests(context.Context, *QuerySigningRequestsRequest) (*QuerySigningRequestsResponse, error)
+	// QuerySigningRequestsByAddress queries the signing requests by the given address.
+	QuerySigningRequestsByAddress(context.Context, *QuerySigningRequestsByAddressRequest) (*QuerySigningRequestsByAddressResponse, error)
+	// QuerySigningRequestByTxHash queries the signing request by the given tx hash.
+	QuerySigningRequestByTxHash(context.Context, *QuerySigningRequestByTxHashRequest) (*QuerySigningRequestByTxHashResponse, error)
+	// QueryUTXOs queries all utxos.
+	QueryUTXOs(context.Context, *QueryUTXOsRequest) (*QueryUTXOsResponse, error)
+	// QueryUTXOsByAddress queries the utxos of the given address.
+	QueryUTXOsByAddress(context.Context, *QueryUTXOsByAddressRequest) (*QueryUTXOsByAddressResponse, error)
+	// QueryUTXOCountAndBalancesByAddress queries the total count and balances of the unlocked utxos by the given address.
+	QueryUTXOCountAndBalancesByAddress(context.Context, *QueryUTXOCountAndBalancesByAddressRequest) (*QueryUTXOCountAndBalancesByAddressResponse, error)
+	// QueryDKGRequest queries the DKG request by the given id.
+	QueryDKGRequest(context.Context, *QueryDKGRequestRequest) (*QueryDKGRequestResponse, error)
+	// QueryDKGRequests queries the DKG requests by the given status
+	QueryDKGRequests(context.Context, *QueryDKGRequestsRequest) (*QueryDKGRequestsResponse, error)
+	// QueryAllDKGRequests queries all DKG requests.
+	QueryAllDKGRequests(context.Context, *QueryAllDKGRequestsRequest) (*QueryAllDKGRequestsResponse, error)
+	// QueryDKGCompletionRequests queries DKG completion requests by the given id.
+	QueryDKGCompletionRequests(context.Context, *QueryDKGCompletionRequestsRequest) (*QueryDKGCompletionRequestsResponse, error)
+	// QueryRunes queries all registered runes.
+	QueryRunes(context.Context, *QueryRunesRequest) (*QueryRunesResponse, error)
+	// QueryRune queries the registered rune by the given id.
+	QueryRune(context.Context, *QueryRuneRequest) (*QueryRuneResponse, error)
+	// QueryProtocolFees queries the protocol fees quoted for the given amount.
+	QueryProtocolFees(context.Context, *QueryProtocolFeesRequest) (*QueryProtocolFeesResponse, error)
+	// QueryFeeDistribution queries the accumulated and distributed protocol fees of each bucket.
+	QueryFeeDistribution(context.Context, *QueryFeeDistributionRequest) (*QueryFeeDistributionResponse, error)
+	// QueryRuneConfigs queries the configurations and voucher supplies of the configured runes.
+	QueryRuneConfigs(context.Context, *QueryRuneConfigsRequest) (*QueryRuneConfigsResponse, error)
+	// QueryOutflowUsage queries the withdrawal outflow of the current rate limit window.
+	QueryOutflowUsage(context.Context, *QueryOutflowUsageRequest) (*QueryOutflowUsageResponse, error)
+	// QueryEmergencyPauses queries the emergency pauses.
+	QueryEmergencyPauses(context.Context, *QueryEmergencyPausesRequest) (*QueryEmergencyPausesResponse, error)
+	// QueryDepositAddress queries the deposit address assigned among the active vaults of the given asset type.
+	QueryDepositAddress(context.Context, *QueryDepositAddressRequest) (*QueryDepositAddressResponse, error)
+	// QueryFrostSigningSession queries the FROST signing session of the given signing request.
+	QueryFrostSigningSession(context.Context, *QueryFrostSigningSessionRequest) (*QueryFrostSigningSessionResponse, error)
+	// QueryFrostVaultKey queries the FROST key of the given vault.
+	QueryFrostVaultKey(context.Context, *QueryFrostVaultKeyRequest) (*QueryFrostVaultKeyResponse, error)
+	// QuerySignerPerformance queries the liveness performance of the given TSS participant.
+	QuerySignerPerformance(context.Context, *QuerySignerPerformanceRequest) (*QuerySignerPerformanceResponse, error)
+	// QuerySignerPerformances queries the liveness performances of all the TSS participants.
+	QuerySignerPerformances(context.Context, *QuerySignerPerformancesRequest) (*QuerySignerPerformancesResponse, error)
+	// QueryVaultTransfer queries the vault transfer from the given source version.
+	QueryVaultTransfer(context.Context, *QueryVaultTransferRequest) (*QueryVaultTransferResponse, error)
+	// QueryVaultTransfers queries all vault transfers.
+	QueryVaultTransfers(context.Context, *QueryVaultTransfersRequest) (*QueryVaultTransfersResponse, error)
 }
 
-func (m *QueryBlockHeaderByHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.BlockHeader != nil {
-		{
-			size, err := m.BlockHeader.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
-func (m *QueryBlockHeaderByHashRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) QueryParams(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryParams not implemented")
 }
-
-func (m *QueryBlockHeaderByHashRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) QueryChainTip(ctx context.Context, req *QueryChainTipRequest) (*QueryChainTipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryChainTip not implemented")
 }
-
-func (m *QueryBlockHeaderByHashRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Hash) > 0 {
-		i -= len(m.Hash)
-		copy(dAtA[i:], m.Hash)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Hash)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedQueryServer) QueryBlockHeaderByHeight(ctx context.Context, req *QueryBlockHeaderByHeightRequest) (*QueryBlockHeaderByHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryBlockHeaderByHeight not implemented")
 }
-
-func (m *QueryBlockHeaderByHashResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) QueryBlockHeaderByHash(ctx context.Context, req *QueryBlockHeaderByHashRequest) (*QueryBlockHeaderByHashResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryBlockHeaderByHash not implemented")
 }
-
-func (m *QueryBlockHeaderByHashResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) QueryFeeRate(ctx context.Context, req *QueryFeeRateRequest) (*QueryFeeRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryFeeRate not implemented")
 }
-
-func (m *QueryBlockHeaderByHashResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.BlockHeader != nil {
-		{
-			size, err := m.BlockHeader.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedQueryServer) QueryWithdrawalNetworkFee(ctx context.Context, req *QueryWithdrawalNetworkFeeRequest) (*QueryWithdrawalNetworkFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryWithdrawalNetworkFee not implemented")
 }
-
-func (m *QueryUTXOsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) QueryWithdrawRequestsByAddress(ctx context.Context, req *QueryWithdrawRequestsByAddressRequest) (*QueryWithdrawRequestsByAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryWithdrawRequestsByAddress not implemented")
 }
-
-func (m *QueryUTXOsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) QueryWithdrawRequestsByTxHash(ctx context.Context, req *QueryWithdrawRequestsByTxHashRequest) (*QueryWithdrawRequestsByTxHashResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryWithdrawRequestsByTxHash not implemented")
 }
-
-func (m *QueryUTXOsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (*UnimplementedQueryServer) QueryPendingBtcWithdrawRequests(ctx context.Context, req *QueryPendingBtcWithdrawRequestsRequest) (*QueryPendingBtcWithdrawRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryPendingBtcWithdrawRequests not implemented")
 }
-
-func (m *QueryUTXOsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) QuerySigningRequest(ctx context.Context, req *QuerySigningRequestRequest) (*QuerySigningRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuerySigningRequest not implemented")
+}
+func (*UnimplementedQueryServer) QuerySigningRequests(ctx context.Context, req *QuerySigningRequestsRequest) (*QuerySigningRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuerySigningRequests not implemented")
+}
+func (*UnimplementedQueryServer) QuerySigningRequestsByAddress(ctx context.Context, req *QuerySigningRequestsByAddressRequest) (*QuerySigningRequestsByAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuerySigningRequestsByAddress not implemented")
+}
+func (*UnimplementedQueryServer) QuerySigningRequestByTxHash(ctx context.Context, req *QuerySigningRequestByTxHashRequest) (*QuerySigningRequestByTxHashResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuerySigningRequestByTxHash not implemented")
+}
+func (*UnimplementedQueryServer) QueryUTXOs(ctx context.Context, req *QueryUTXOsRequest) (*QueryUTXOsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryUTXOs not implemented")
+}
+func (*UnimplementedQueryServer) QueryUTXOsByAddress(ctx context.Context, req *QueryUTXOsByAddressRequest) (*QueryUTXOsByAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryUTXOsByAddress not implemented")
+}
+func (*UnimplementedQueryServer) QueryUTXOCountAndBalancesByAddress(ctx context.Context, req *QueryUTXOCountAndBalancesByAddressRequest) (*QueryUTXOCountAndBalancesByAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryUTXOCountAndBalancesByAddress not implemented")
+}
+func (*UnimplementedQueryServer) QueryDKGRequest(ctx context.Context, req *QueryDKGRequestRequest) (*QueryDKGRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryDKGRequest not implemented")
+}
+func (*UnimplementedQueryServer) QueryDKGRequests(ctx context.Context, req *QueryDKGRequestsRequest) (*QueryDKGRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryDKGRequests not implemented")
+}
+func (*UnimplementedQueryServer) QueryAllDKGRequests(ctx context.Context, req *QueryAllDKGRequestsRequest) (*QueryAllDKGRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryAllDKGRequests not implemented")
+}
+func (*UnimplementedQueryServer) QueryDKGCompletionRequests(ctx context.Context, req *QueryDKGCompletionRequestsRequest) (*QueryDKGCompletionRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryDKGCompletionRequests not implemented")
+}
+func (*UnimplementedQueryServer) QueryRunes(ctx context.Context, req *QueryRunesRequest) (*QueryRunesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryRunes not implemented")
+}
+func (*UnimplementedQueryServer) QueryRune(ctx context.Context, req *QueryRuneRequest) (*QueryRuneResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryRune not implemented")
+}
+func (*UnimplementedQueryServer) QueryProtocolFees(ctx context.Context, req *QueryProtocolFeesRequest) (*QueryProtocolFeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryProtocolFees not implemented")
+}
+func (*UnimplementedQueryServer) QueryFeeDistribution(ctx context.Context, req *QueryFeeDistributionRequest) (*QueryFeeDistributionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryFeeDistribution not implemented")
+}
+func (*UnimplementedQueryServer) QueryRuneConfigs(ctx context.Context, req *QueryRuneConfigsRequest) (*QueryRuneConfigsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryRuneConfigs not implemented")
+}
+func (*UnimplementedQueryServer) QueryOutflowUsage(ctx context.Context, req *QueryOutflowUsageRequest) (*QueryOutflowUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryOutflowUsage not implemented")
+}
+func (*UnimplementedQueryServer) QueryEmergencyPauses(ctx context.Context, req *QueryEmergencyPausesRequest) (*QueryEmergencyPausesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryEmergencyPauses not implemented")
+}
+func (*UnimplementedQueryServer) QueryDepositAddress(ctx context.Context, req *QueryDepositAddressRequest) (*QueryDepositAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryDepositAddress not implemented")
+}
+func (*UnimplementedQueryServer) QueryFrostSigningSession(ctx context.Context, req *QueryFrostSigningSessionRequest) (*QueryFrostSigningSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryFrostSigningSession not implemented")
+}
+func (*UnimplementedQueryServer) QueryFrostVaultKey(ctx context.Context, req *QueryFrostVaultKeyRequest) (*QueryFrostVaultKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryFrostVaultKey not implemented")
+}
+func (*UnimplementedQueryServer) QuerySignerPerformance(ctx context.Context, req *QuerySignerPerformanceRequest) (*QuerySignerPerformanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuerySignerPerformance not implemented")
+}
+func (*UnimplementedQueryServer) QuerySignerPerformances(ctx context.Context, req *QuerySignerPerformancesRequest) (*QuerySignerPerformancesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuerySignerPerformances not implemented")
+}
+func (*UnimplementedQueryServer) QueryVaultTransfer(ctx context.Context, req *QueryVaultTransferRequest) (*QueryVaultTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryVaultTransfer not implemented")
+}
+func (*UnimplementedQueryServer) QueryVaultTransfers(ctx context.Context, req *QueryVaultTransfersRequest) (*QueryVaultTransfersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryVaultTransfers not implemented")
 }
 
-func (m *QueryUTXOsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
 }
 
-func (m *QueryUTXOsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Utxos) > 0 {
-		for iNdEx := len(m.Utxos) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Utxos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func _Query_QueryParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).QueryParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/side.btcbridge.Query/QueryParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryParams(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryUTXOsByAddressRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_QueryChainTip_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryChainTipRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).QueryChainTip(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/side.btcbridge.Query/QueryChainTip",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryChainTip(ctx, req.(*QueryChainTipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryUTXOsByAddressRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_QueryBlockHeaderByHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBlockHeaderByHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryBlockHeaderByHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/side.btcbridge.Query/QueryBlockHeaderByHeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryBlockHeaderByHeight(ctx, req.(*QueryBlockHeaderByHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryUTXOsByAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+func _Query_QueryBlockHeaderByHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBlockHeaderByHashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).QueryBlockHeaderByHash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/side.btcbridge.Query/QueryBlockHeaderByHash",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryBlockHeaderByHash(ctx, req.(*QueryBlockHeaderByHashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryUTXOsByAddressResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_QueryFeeRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeRateRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).QueryFeeRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/side.btcbridge.Query/QueryFeeRate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryFeeRate(ctx, req.(*QueryFeeRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryUTXOsByAddressResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_QueryWithdrawalNetworkFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryWithdrawalNetworkFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryWithdrawalNetworkFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/side.btcbridge.Query/QueryWithdrawalNetworkFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryWithdrawalNetworkFee(ctx, req.(*QueryWithdrawalNetworkFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryUTXOsByAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Utxos) > 0 {
-		for iNdEx := len(m.Utxos) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Utxos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func _Query_QueryWithdrawRequestsByAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryWithdrawRequestsByAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).QueryWithdrawRequestsByAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/side.btcbridge.Query/QueryWithdrawRequestsByAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryWithdrawRequestsByAddress(ctx, req.(*QueryWithdrawRequestsByAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryUTXOCountAndBalancesByAddressRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_QueryWithdrawRequestsByTxHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryWithdrawRequestsByTxHashRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).QueryWithdrawRequestsByTxHash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/side.btcbridge.Query/QueryWithdrawRequestsByTxHash",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryWithdrawRequestsByTxHash(ctx, req.(*QueryWithdrawRequestsByTxHashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryUTXOCountAndBalancesByAddressRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryUTXOCountAndBalancesByAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+func _Query_QueryPendingBtcWithdrawRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingBtcWithdrawRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).QueryPendingBtcWithdrawRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/side.btcbridge.Query/QueryPendingBtcWithdrawRequests",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryPendingBtcWithdrawRequests(ctx, req.(*QueryPendingBtcWithdrawRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryUTXOCountAndBalancesByAddressResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_QuerySigningRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySigningRequestRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryUTXOCountAndBalancesByAddressResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryUTXOCountAndBalancesByAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.RuneBalances) > 0 {
-		for iNdEx := len(m.RuneBalances) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.RuneBalances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).QuerySigningRequest(ctx, in)
 	}
-	if m.Value != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Value))
-		i--
-		dAtA[i] = 0x10
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/side.btcbridge.Query/QuerySigningRequest",
 	}
-	if m.Count != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Count))
-		i--
-		dAtA[i] = 0x8
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QuerySigningRequest(ctx, req.(*QuerySigningRequestRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryDKGRequestRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_QuerySigningRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySigningRequestsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).QuerySigningRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/side.btcbridge.Query/QuerySigningRequests",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QuerySigningRequests(ctx, req.(*QuerySigningRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryDKGRequestRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_QuerySigningRequestsByAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySigningRequestsByAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QuerySigningRequestsByAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/side.btcbridge.Query/QuerySigningRequestsByAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QuerySigningRequestsByAddress(ctx, req.(*QuerySigningRequestsByAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryDKGRequestRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+func _Query_QuerySigningRequestByTxHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySigningRequestByTxHashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).QuerySigningRequestByTxHash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/side.btcbridge.Query/QuerySigningRequestByTxHash",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QuerySigningRequestByTxHash(ctx, req.(*QuerySigningRequestByTxHashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryDKGRequestResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_QueryUTXOs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUTXOsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).QueryUTXOs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/side.btcbridge.Query/QueryUTXOs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryUTXOs(ctx, req.(*QueryUTXOsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryDKGRequestResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_QueryUTXOsByAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUTXOsByAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryUTXOsByAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/side.btcbridge.Query/QueryUTXOsByAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryUTXOsByAddress(ctx, req.(*QueryUTXOsByAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryDKGRequestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Request != nil {
-		{
-			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func _Query_QueryUTXOCountAndBalancesByAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUTXOCountAndBalancesByAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).QueryUTXOCountAndBalancesByAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/side.btcbridge.Query/QueryUTXOCountAndBalancesByAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryUTXOCountAndBalancesByAddress(ctx, req.(*QueryUTXOCountAndBalancesByAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryDKGRequestsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_QueryDKGRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDKGRequestRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).QueryDKGRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/side.btcbridge.Query/QueryDKGRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryDKGRequest(ctx, req.(*QueryDKGRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryDKGRequestsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_QueryDKGRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDKGRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryDKGRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/side.btcbridge.Query/QueryDKGRequests",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryDKGRequests(ctx, req.(*QueryDKGRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryDKGRequestsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Status != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x8
+func _Query_QueryAllDKGRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllDKGRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).QueryAllDKGRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/side.btcbridge.Query/QueryAllDKGRequests",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryAllDKGRequests(ctx, req.(*QueryAllDKGRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryDKGRequestsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_QueryDKGCompletionRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDKGCompletionRequestsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).QueryDKGCompletionRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/side.btcbridge.Query/QueryDKGCompletionRequests",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryDKGCompletionRequests(ctx, req.(*QueryDKGCompletionRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryDKGRequestsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_QueryRunes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRunesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryRunes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/side.btcbridge.Query/QueryRunes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryRunes(ctx, req.(*QueryRunesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryDKGRequestsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Requests) > 0 {
-		for iNdEx := len(m.Requests) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Requests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func _Query_QueryRune_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRuneRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).QueryRune(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/side.btcbridge.Query/QueryRune",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryRune(ctx, req.(*QueryRuneRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllDKGRequestsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_QueryProtocolFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProtocolFeesRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).QueryProtocolFees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/side.btcbridge.Query/QueryProtocolFees",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryProtocolFees(ctx, req.(*QueryProtocolFeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllDKGRequestsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_QueryFeeDistribution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeDistributionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryFeeDistribution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/side.btcbridge.Query/QueryFeeDistribution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryFeeDistribution(ctx, req.(*QueryFeeDistributionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllDKGRequestsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Query_QueryRuneConfigs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRuneConfigsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryRuneConfigs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/side.btcbridge.Query/QueryRuneConfigs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryRuneConfigs(ctx, req.(*QueryRuneConfigsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllDKGRequestsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_QueryOutflowUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOutflowUsageRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).QueryOutflowUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/side.btcbridge.Query/QueryOutflowUsage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryOutflowUsage(ctx, req.(*QueryOutflowUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllDKGRequestsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_QueryEmergencyPauses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEmergencyPausesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryEmergencyPauses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/side.btcbridge.Query/QueryEmergencyPauses",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryEmergencyPauses(ctx, req.(*QueryEmergencyPausesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllDKGRequestsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Requests) > 0 {
-		for iNdEx := len(m.Requests) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Requests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func _Query_QueryDepositAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDepositAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).QueryDepositAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/side.btcbridge.Query/QueryDepositAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryDepositAddress(ctx, req.(*QueryDepositAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryDKGCompletionRequestsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_QueryFrostSigningSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFrostSigningSessionRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).QueryFrostSigningSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/side.btcbridge.Query/QueryFrostSigningSession",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryFrostSigningSession(ctx, req.(*QueryFrostSigningSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryDKGCompletionRequestsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_QueryFrostVaultKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFrostVaultKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryFrostVaultKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/side.btcbridge.Query/QueryFrostVaultKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryFrostVaultKey(ctx, req.(*QueryFrostVaultKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryDKGCompletionRequestsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+func _Query_QuerySignerPerformance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySignerPerformanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).QuerySignerPerformance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/side.btcbridge.Query/QuerySignerPerformance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QuerySignerPerformance(ctx, req.(*QuerySignerPerformanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryDKGCompletionRequestsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_QuerySignerPerformances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySignerPerformancesRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).QuerySignerPerformances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/side.btcbridge.Query/QuerySignerPerformances",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QuerySignerPerformances(ctx, req.(*QuerySignerPerformancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryDKGCompletionRequestsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
+func _Query_QueryVaultTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVaultTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryVaultTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/side.btcbridge.Query/QueryVaultTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryVaultTransfer(ctx, req.(*QueryVaultTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryVaultTransfers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVaultTransfersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryVaultTransfers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/side.btcbridge.Query/QueryVaultTransfers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryVaultTransfers(ctx, req.(*QueryVaultTransfersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "side.btcbridge.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "QueryParams",
+			Handler:    _Query_QueryParams_Handler,
+		},
+		{
+			MethodName: "QueryChainTip",
+			Handler:    _Query_QueryChainTip_Handler,
+		},
+		{
+			MethodName: "QueryBlockHeaderByHeight",
+			Handler:    _Query_QueryBlockHeaderByHeight_Handler,
+		},
+		{
+			MethodName: "QueryBlockHeaderByHash",
+			Handler:    _Query_QueryBlockHeaderByHash_Handler,
+		},
+		{
+			MethodName: "QueryFeeRate",
+			Handler:    _Query_QueryFeeRate_Handler,
+		},
+		{
+			MethodName: "QueryWithdrawalNetworkFee",
+			Handler:    _Query_QueryWithdrawalNetworkFee_Handler,
+		},
+		{
+			MethodName: "QueryWithdrawRequestsByAddress",
+			Handler:    _Query_QueryWithdrawRequestsByAddress_Handler,
+		},
+		{
+			MethodName: "QueryWithdrawRequestsByTxHash",
+			Handler:    _Query_QueryWithdrawRequestsByTxHash_Handler,
+		},
+		{
+			MethodName: "QueryPendingBtcWithdrawRequests",
+			Handler:    _Query_QueryPendingBtcWithdrawRequests_Handler,
+		},
+		{
+			MethodName: "QuerySigningRequest",
+			Handler:    _Query_QuerySigningRequest_Handler,
+		},
+		{
+			MethodName: "QuerySigningRequests",
+			Handler:    _Query_QuerySigningRequests_Handler,
+		},
+		{
+			MethodName: "QuerySigningRequestsByAddress",
+			Handler:    _Query_QuerySigningRequestsByAddress_Handler,
+		},
+		{
+			MethodName: "QuerySigningRequestByTxHash",
+			Handler:    _Query_QuerySigningRequestByTxHash_Handler,
+		},
+		{
+			MethodName: "QueryUTXOs",
+			Handler:    _Query_QueryUTXOs_Handler,
+		},
+		{
+			MethodName: "QueryUTXOsByAddress",
+			Handler:    _Query_QueryUTXOsByAddress_Handler,
+		},
+		{
+			MethodName: "QueryUTXOCountAndBalancesByAddress",
+			Handler:    _Query_QueryUTXOCountAndBalancesByAddress_Handler,
+		},
+		{
+			MethodName: "QueryDKGRequest",
+			Handler:    _Query_QueryDKGRequest_Handler,
+		},
+		{
+			MethodName: "QueryDKGRequests",
+			Handler:    _Query_QueryDKGRequests_Handler,
+		},
+		{
+			MethodName: "QueryAllDKGRequests",
+			Handler:    _Query_QueryAllDKGRequests_Handler,
+		},
+		{
+			MethodName: "QueryDKGCompletionRequests",
+			Handler:    _Query_QueryDKGCompletionRequests_Handler,
+		},
+		{
+			MethodName: "QueryRunes",
+			Handler:    _Query_QueryRunes_Handler,
+		},
+		{
+			MethodName: "QueryRune",
+			Handler:    _Query_QueryRune_Handler,
+		},
+		{
+			MethodName: "QueryProtocolFees",
+			Handler:    _Query_QueryProtocolFees_Handler,
+		},
+		{
+			MethodName: "QueryFeeDistribution",
+			Handler:    _Query_QueryFeeDistribution_Handler,
+		},
+		{
+			MethodName: "QueryRuneConfigs",
+			Handler:    _Query_QueryRuneConfigs_Handler,
+		},
+		{
+			MethodName: "QueryOutflowUsage",
+			Handler:    _Query_QueryOutflowUsage_Handler,
+		},
+		{
+			MethodName: "QueryEmergencyPauses",
+			Handler:    _Query_QueryEmergencyPauses_Handler,
+		},
+		{
+			MethodName: "QueryDepositAddress",
+			Handler:    _Query_QueryDepositAddress_Handler,
+		},
+		{
+			MethodName: "QueryFrostSigningSession",
+			Handler:    _Query_QueryFrostSigningSession_Handler,
+		},
+		{
+			MethodName: "QueryFrostVaultKey",
+			Handler:    _Query_QueryFrostVaultKey_Handler,
+		},
+		{
+			MethodName: "QuerySignerPerformance",
+			Handler:    _Query_QuerySignerPerformance_Handler,
+		},
+		{
+			MethodName: "QuerySignerPerformances",
+			Handler:    _Query_QuerySignerPerformances_Handler,
+		},
+		{
+			MethodName: "QueryVaultTransfer",
+			Handler:    _Query_QueryVaultTransfer_Handler,
+		},
+		{
+			MethodName: "QueryVaultTransfers",
+			Handler:    _Query_QueryVaultTransfers_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "side/btcbridge/query.proto",
+}
+
+func (m *QueryWithdrawRequestsByAddressRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWithdrawRequestsByAddressRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryDKGCompletionRequestsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryWithdrawRequestsByAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWithdrawRequestsByAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWithdrawRequestsByAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWithdrawRequestsByAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.Requests) > 0 {
 		for iNdEx := len(m.Requests) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -4252,585 +4939,5434 @@ func (m *QueryDKGCompletionRequestsResponse) MarshalToSizedBuffer(dAtA []byte) (
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryWithdrawRequestsByTxHashRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryWithdrawRequestsByAddressRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *QueryWithdrawRequestsByTxHashRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWithdrawRequestsByTxHashRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Txid) > 0 {
+		i -= len(m.Txid)
+		copy(dAtA[i:], m.Txid)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Txid)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryWithdrawRequestsByAddressResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Requests) > 0 {
-		for _, e := range m.Requests {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryWithdrawRequestsByTxHashResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryWithdrawRequestsByTxHashRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Txid)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryWithdrawRequestsByTxHashResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryWithdrawRequestsByTxHashResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryWithdrawRequestsByTxHashResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if len(m.Requests) > 0 {
-		for _, e := range m.Requests {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+		for iNdEx := len(m.Requests) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Requests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryPendingBtcWithdrawRequestsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryPendingBtcWithdrawRequestsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingBtcWithdrawRequestsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingBtcWithdrawRequestsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryPendingBtcWithdrawRequestsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryPendingBtcWithdrawRequestsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingBtcWithdrawRequestsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingBtcWithdrawRequestsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Requests) > 0 {
-		for _, e := range m.Requests {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Requests) > 0 {
+		for iNdEx := len(m.Requests) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Requests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QuerySigningRequestRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QuerySigningRequestRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySigningRequestRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySigningRequestRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.Sequence != 0 {
-		n += 1 + sovQuery(uint64(m.Sequence))
+		i = encodeVarintQuery(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QuerySigningRequestResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Request != nil {
-		l = m.Request.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QuerySigningRequestResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QuerySigningRequestsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Status != 0 {
-		n += 1 + sovQuery(uint64(m.Status))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QuerySigningRequestResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QuerySigningRequestsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QuerySigningRequestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Requests) > 0 {
-		for _, e := range m.Requests {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.History) > 0 {
+		for iNdEx := len(m.History) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.History[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QuerySigningRequestsByAddressRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QuerySigningRequestsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QuerySigningRequestsByAddressResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QuerySigningRequestsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySigningRequestsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Requests) > 0 {
-		for _, e := range m.Requests {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QuerySigningRequestByTxHashRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Txid)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QuerySigningRequestsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QuerySigningRequestByTxHashResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QuerySigningRequestsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySigningRequestsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Request != nil {
-		l = m.Request.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Requests) > 0 {
+		for iNdEx := len(m.Requests) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Requests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryFeeRateRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QuerySigningRequestsByAddressRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryFeeRateResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.FeeRate != nil {
-		l = m.FeeRate.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QuerySigningRequestsByAddressRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryWithdrawalNetworkFeeRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QuerySigningRequestsByAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Amount)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.FeeRate != 0 {
-		n += 1 + sovQuery(uint64(m.FeeRate))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryWithdrawalNetworkFeeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QuerySigningRequestsByAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySigningRequestsByAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySigningRequestsByAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.FeeRate != 0 {
-		n += 1 + sovQuery(uint64(m.FeeRate))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Fee)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Requests) > 0 {
+		for iNdEx := len(m.Requests) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Requests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QuerySigningRequestByTxHashRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QuerySigningRequestByTxHashRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryChainTipRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QuerySigningRequestByTxHashRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	if len(m.Txid) > 0 {
+		i -= len(m.Txid)
+		copy(dAtA[i:], m.Txid)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Txid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryChainTipResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Hash)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Height != 0 {
-		n += 1 + sovQuery(uint64(m.Height))
+func (m *QuerySigningRequestByTxHashResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryBlockHeaderByHeightRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Height != 0 {
-		n += 1 + sovQuery(uint64(m.Height))
-	}
-	return n
+func (m *QuerySigningRequestByTxHashResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryBlockHeaderByHeightResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QuerySigningRequestByTxHashResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.BlockHeader != nil {
-		l = m.BlockHeader.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryBlockHeaderByHashRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Hash)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryFeeRateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryBlockHeaderByHashResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.BlockHeader != nil {
-		l = m.BlockHeader.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryFeeRateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryUTXOsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryFeeRateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryUTXOsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Utxos) > 0 {
-		for _, e := range m.Utxos {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+func (m *QueryFeeRateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryUTXOsByAddressRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryFeeRateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryUTXOsByAddressResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryFeeRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Utxos) > 0 {
-		for _, e := range m.Utxos {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.FeeRate != nil {
+		{
+			size, err := m.FeeRate.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryUTXOCountAndBalancesByAddressRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryWithdrawalNetworkFeeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryUTXOCountAndBalancesByAddressResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryWithdrawalNetworkFeeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWithdrawalNetworkFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Count != 0 {
-		n += 1 + sovQuery(uint64(m.Count))
+	if m.FeeRate != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FeeRate))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.Value != 0 {
-		n += 1 + sovQuery(uint64(m.Value))
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.RuneBalances) > 0 {
-		for _, e := range m.RuneBalances {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryDKGRequestRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovQuery(uint64(m.Id))
+func (m *QueryWithdrawalNetworkFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryDKGRequestResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Request != nil {
-		l = m.Request.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryWithdrawalNetworkFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryDKGRequestsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryWithdrawalNetworkFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Status != 0 {
-		n += 1 + sovQuery(uint64(m.Status))
+	if len(m.Fee) > 0 {
+		i -= len(m.Fee)
+		copy(dAtA[i:], m.Fee)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Fee)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
-}
-
-func (m *QueryDKGRequestsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.FeeRate != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FeeRate))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Requests) > 0 {
-		for _, e := range m.Requests {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllDKGRequestsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryChainTipRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryChainTipRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryChainTipRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllDKGRequestsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryChainTipResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryChainTipResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryChainTipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Requests) > 0 {
-		for _, e := range m.Requests {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlockHeaderByHeightRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlockHeaderByHeightRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlockHeaderByHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlockHeaderByHeightResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlockHeaderByHeightResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlockHeaderByHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockHeader != nil {
+		{
+			size, err := m.BlockHeader.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryDKGCompletionRequestsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryBlockHeaderByHashRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlockHeaderByHashRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlockHeaderByHashRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Id != 0 {
-		n += 1 + sovQuery(uint64(m.Id))
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryDKGCompletionRequestsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryBlockHeaderByHashResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlockHeaderByHashResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlockHeaderByHashResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Requests) > 0 {
-		for _, e := range m.Requests {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.BlockHeader != nil {
+		{
+			size, err := m.BlockHeader.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUTXOsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUTXOsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUTXOsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUTXOsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUTXOsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUTXOsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Utxos) > 0 {
+		for iNdEx := len(m.Utxos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Utxos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUTXOsByAddressRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUTXOsByAddressRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUTXOsByAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUTXOsByAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUTXOsByAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUTXOsByAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Utxos) > 0 {
+		for iNdEx := len(m.Utxos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Utxos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUTXOCountAndBalancesByAddressRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUTXOCountAndBalancesByAddressRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUTXOCountAndBalancesByAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUTXOCountAndBalancesByAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUTXOCountAndBalancesByAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUTXOCountAndBalancesByAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RuneBalances) > 0 {
+		for iNdEx := len(m.RuneBalances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RuneBalances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Value != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Value))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Count != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDKGRequestRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDKGRequestRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDKGRequestRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDKGRequestResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDKGRequestResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDKGRequestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDKGRequestsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDKGRequestsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDKGRequestsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDKGRequestsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDKGRequestsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDKGRequestsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Requests) > 0 {
+		for iNdEx := len(m.Requests) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Requests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllDKGRequestsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllDKGRequestsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllDKGRequestsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllDKGRequestsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllDKGRequestsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllDKGRequestsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Requests) > 0 {
+		for iNdEx := len(m.Requests) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Requests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDKGCompletionRequestsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDKGCompletionRequestsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDKGCompletionRequestsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDKGCompletionRequestsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDKGCompletionRequestsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDKGCompletionRequestsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Requests) > 0 {
+		for iNdEx := len(m.Requests) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Requests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRunesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRunesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRunesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRunesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRunesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRunesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Runes) > 0 {
+		for iNdEx := len(m.Runes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Runes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRuneRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRuneRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRuneRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRuneResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRuneResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRuneResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Rune != nil {
+		{
+			size, err := m.Rune.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRuneConfigsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRuneConfigsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRuneConfigsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRuneConfigsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRuneConfigsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRuneConfigsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Configs) > 0 {
+		for iNdEx := len(m.Configs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Configs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RuneConfigWithSupply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RuneConfigWithSupply) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RuneConfigWithSupply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Supply) > 0 {
+		i -= len(m.Supply)
+		copy(dAtA[i:], m.Supply)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Supply)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Config.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProtocolFeesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProtocolFeesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProtocolFeesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProtocolFeesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProtocolFeesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProtocolFeesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.WithdrawFee) > 0 {
+		i -= len(m.WithdrawFee)
+		copy(dAtA[i:], m.WithdrawFee)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.WithdrawFee)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DepositFee) > 0 {
+		i -= len(m.DepositFee)
+		copy(dAtA[i:], m.DepositFee)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DepositFee)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeDistributionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeDistributionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeDistributionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeDistributionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeDistributionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeDistributionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastSettlementHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LastSettlementHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Buckets) > 0 {
+		for iNdEx := len(m.Buckets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Buckets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOutflowUsageRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOutflowUsageRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOutflowUsageRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOutflowUsageResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOutflowUsageResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOutflowUsageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Breaker != nil {
+		{
+			size, err := m.Breaker.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Assets) > 0 {
+		for iNdEx := len(m.Assets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Assets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Global != nil {
+		{
+			size, err := m.Global.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Window != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Window))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEmergencyPausesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEmergencyPausesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEmergencyPausesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ActiveOnly {
+		i--
+		if m.ActiveOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEmergencyPausesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEmergencyPausesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEmergencyPausesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Pauses) > 0 {
+		for iNdEx := len(m.Pauses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pauses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDepositAddressRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDepositAddressRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDepositAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AssetType != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AssetType))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDepositAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDepositAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDepositAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ActiveVaults) > 0 {
+		for iNdEx := len(m.ActiveVaults) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ActiveVaults[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Vault != nil {
+		{
+			size, err := m.Vault.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFrostSigningSessionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFrostSigningSessionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFrostSigningSessionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Txid) > 0 {
+		i -= len(m.Txid)
+		copy(dAtA[i:], m.Txid)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Txid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFrostSigningSessionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFrostSigningSessionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFrostSigningSessionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Session != nil {
+		{
+			size, err := m.Session.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFrostVaultKeyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFrostVaultKeyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFrostVaultKeyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Vault) > 0 {
+		i -= len(m.Vault)
+		copy(dAtA[i:], m.Vault)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Vault)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFrostVaultKeyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFrostVaultKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFrostVaultKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Key != nil {
+		{
+			size, err := m.Key.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySignerPerformanceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySignerPerformanceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySignerPerformanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OperatorAddress) > 0 {
+		i -= len(m.OperatorAddress)
+		copy(dAtA[i:], m.OperatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OperatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySignerPerformanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySignerPerformanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySignerPerformanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Performance != nil {
+		{
+			size, err := m.Performance.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySignerPerformancesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySignerPerformancesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySignerPerformancesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySignerPerformancesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySignerPerformancesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySignerPerformancesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Performances) > 0 {
+		for iNdEx := len(m.Performances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Performances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVaultTransferRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVaultTransferRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVaultTransferRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SourceVersion != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SourceVersion))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVaultTransferResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVaultTransferResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVaultTransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Transfer != nil {
+		{
+			size, err := m.Transfer.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVaultTransfersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVaultTransfersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVaultTransfersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVaultTransfersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVaultTransfersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVaultTransfersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Transfers) > 0 {
+		for iNdEx := len(m.Transfers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Transfers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryWithdrawRequestsByAddressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryWithdrawRequestsByAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Requests) > 0 {
+		for _, e := range m.Requests {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryWithdrawRequestsByTxHashRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Txid)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryWithdrawRequestsByTxHashResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Requests) > 0 {
+		for _, e := range m.Requests {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPendingBtcWithdrawRequestsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingBtcWithdrawRequestsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Requests) > 0 {
+		for _, e := range m.Requests {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySigningRequestRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		n += 1 + sovQuery(uint64(m.Sequence))
+	}
+	return n
+}
+
+func (m *QuerySigningRequestResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.History) > 0 {
+		for _, e := range m.History {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QuerySigningRequestsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySigningRequestsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Requests) > 0 {
+		for _, e := range m.Requests {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySigningRequestsByAddressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySigningRequestsByAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Requests) > 0 {
+		for _, e := range m.Requests {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySigningRequestByTxHashRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Txid)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySigningRequestByTxHashResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFeeRateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryFeeRateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FeeRate != nil {
+		l = m.FeeRate.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryWithdrawalNetworkFeeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.FeeRate != 0 {
+		n += 1 + sovQuery(uint64(m.FeeRate))
+	}
+	return n
+}
+
+func (m *QueryWithdrawalNetworkFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FeeRate != 0 {
+		n += 1 + sovQuery(uint64(m.FeeRate))
+	}
+	l = len(m.Fee)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryChainTipRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryChainTipResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QueryBlockHeaderByHeightRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QueryBlockHeaderByHeightResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockHeader != nil {
+		l = m.BlockHeader.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBlockHeaderByHashRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBlockHeaderByHashResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockHeader != nil {
+		l = m.BlockHeader.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUTXOsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryUTXOsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Utxos) > 0 {
+		for _, e := range m.Utxos {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryUTXOsByAddressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUTXOsByAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Utxos) > 0 {
+		for _, e := range m.Utxos {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryUTXOCountAndBalancesByAddressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUTXOCountAndBalancesByAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Count != 0 {
+		n += 1 + sovQuery(uint64(m.Count))
+	}
+	if m.Value != 0 {
+		n += 1 + sovQuery(uint64(m.Value))
+	}
+	if len(m.RuneBalances) > 0 {
+		for _, e := range m.RuneBalances {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryDKGRequestRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryDKGRequestResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDKGRequestsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	return n
+}
+
+func (m *QueryDKGRequestsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Requests) > 0 {
+		for _, e := range m.Requests {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAllDKGRequestsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAllDKGRequestsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Requests) > 0 {
+		for _, e := range m.Requests {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryDKGCompletionRequestsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryDKGCompletionRequestsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Requests) > 0 {
+		for _, e := range m.Requests {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryRunesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryRunesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Runes) > 0 {
+		for _, e := range m.Runes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryRuneRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRuneResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Rune != nil {
+		l = m.Rune.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRuneConfigsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRuneConfigsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Configs) > 0 {
+		for _, e := range m.Configs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RuneConfigWithSupply) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Config.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.Supply)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProtocolFeesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProtocolFeesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DepositFee)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.WithdrawFee)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFeeDistributionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryFeeDistributionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Buckets) > 0 {
+		for _, e := range m.Buckets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.LastSettlementHeight != 0 {
+		n += 1 + sovQuery(uint64(m.LastSettlementHeight))
+	}
+	return n
+}
+
+func (m *QueryOutflowUsageRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOutflowUsageResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Window != 0 {
+		n += 1 + sovQuery(uint64(m.Window))
+	}
+	if m.Global != nil {
+		l = m.Global.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Assets) > 0 {
+		for _, e := range m.Assets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Breaker != nil {
+		l = m.Breaker.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEmergencyPausesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ActiveOnly {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryEmergencyPausesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pauses) > 0 {
+		for _, e := range m.Pauses {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryDepositAddressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AssetType != 0 {
+		n += 1 + sovQuery(uint64(m.AssetType))
+	}
+	return n
+}
+
+func (m *QueryDepositAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Vault != nil {
+		l = m.Vault.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.ActiveVaults) > 0 {
+		for _, e := range m.ActiveVaults {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryFrostSigningSessionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Txid)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFrostSigningSessionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Session != nil {
+		l = m.Session.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFrostVaultKeyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Vault)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFrostVaultKeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Key != nil {
+		l = m.Key.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySignerPerformanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySignerPerformanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Performance != nil {
+		l = m.Performance.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySignerPerformancesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QuerySignerPerformancesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Performances) > 0 {
+		for _, e := range m.Performances {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryVaultTransferRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SourceVersion != 0 {
+		n += 1 + sovQuery(uint64(m.SourceVersion))
+	}
+	return n
+}
+
+func (m *QueryVaultTransferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Transfer != nil {
+		l = m.Transfer.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVaultTransfersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryVaultTransfersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Transfers) > 0 {
+		for _, e := range m.Transfers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryWithdrawRequestsByAddressRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWithdrawRequestsByAddressRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWithdrawRequestsByAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryWithdrawRequestsByAddressResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWithdrawRequestsByAddressResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWithdrawRequestsByAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requests", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Requests = append(m.Requests, &WithdrawRequest{})
+			if err := m.Requests[len(m.Requests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryWithdrawRequestsByTxHashRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWithdrawRequestsByTxHashRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWithdrawRequestsByTxHashRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Txid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Txid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryWithdrawRequestsByTxHashResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWithdrawRequestsByTxHashResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWithdrawRequestsByTxHashResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requests", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Requests = append(m.Requests, &WithdrawRequest{})
+			if err := m.Requests[len(m.Requests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingBtcWithdrawRequestsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingBtcWithdrawRequestsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingBtcWithdrawRequestsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingBtcWithdrawRequestsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingBtcWithdrawRequestsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingBtcWithdrawRequestsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requests", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Requests = append(m.Requests, &WithdrawRequest{})
+			if err := m.Requests[len(m.Requests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySigningRequestRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySigningRequestRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySigningRequestRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySigningRequestResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySigningRequestResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySigningRequestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Request == nil {
+				m.Request = &SigningRequest{}
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.History = append(m.History, &SigningRequestHistoryEntry{})
+			if err := m.History[len(m.History)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySigningRequestsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySigningRequestsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySigningRequestsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= SigningStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySigningRequestsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySigningRequestsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySigningRequestsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requests", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Requests = append(m.Requests, &SigningRequest{})
+			if err := m.Requests[len(m.Requests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySigningRequestsByAddressRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySigningRequestsByAddressRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySigningRequestsByAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySigningRequestsByAddressResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySigningRequestsByAddressResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySigningRequestsByAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requests", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Requests = append(m.Requests, &SigningRequest{})
+			if err := m.Requests[len(m.Requests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySigningRequestByTxHashRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySigningRequestByTxHashRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySigningRequestByTxHashRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Txid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Txid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySigningRequestByTxHashResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySigningRequestByTxHashResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySigningRequestByTxHashResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Request == nil {
+				m.Request = &SigningRequest{}
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeeRateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeRateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeRateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeeRateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeRateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeRate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FeeRate == nil {
+				m.FeeRate = &FeeRate{}
+			}
+			if err := m.FeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryWithdrawalNetworkFeeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWithdrawalNetworkFeeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWithdrawalNetworkFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeRate", wireType)
+			}
+			m.FeeRate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FeeRate |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryWithdrawalNetworkFeeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWithdrawalNetworkFeeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWithdrawalNetworkFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeRate", wireType)
+			}
+			m.FeeRate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FeeRate |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryChainTipRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryChainTipRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryChainTipRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryChainTipResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryChainTipResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryChainTipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBlockHeaderByHeightRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlockHeaderByHeightRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlockHeaderByHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryWithdrawRequestsByAddressRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBlockHeaderByHeightResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4853,17 +10389,17 @@ func (m *QueryWithdrawRequestsByAddressRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryWithdrawRequestsByAddressRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBlockHeaderByHeightResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryWithdrawRequestsByAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBlockHeaderByHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeader", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4873,29 +10409,83 @@ func (m *QueryWithdrawRequestsByAddressRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			if m.BlockHeader == nil {
+				m.BlockHeader = &BlockHeader{}
+			}
+			if err := m.BlockHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBlockHeaderByHashRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlockHeaderByHashRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlockHeaderByHashRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4905,27 +10495,23 @@ func (m *QueryWithdrawRequestsByAddressRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4948,7 +10534,7 @@ func (m *QueryWithdrawRequestsByAddressRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryWithdrawRequestsByAddressResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBlockHeaderByHashResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4971,15 +10557,15 @@ func (m *QueryWithdrawRequestsByAddressResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryWithdrawRequestsByAddressResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBlockHeaderByHashResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryWithdrawRequestsByAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBlockHeaderByHashResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Requests", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeader", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5006,14 +10592,116 @@ func (m *QueryWithdrawRequestsByAddressResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Requests = append(m.Requests, &WithdrawRequest{})
-			if err := m.Requests[len(m.Requests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.BlockHeader == nil {
+				m.BlockHeader = &BlockHeader{}
+			}
+			if err := m.BlockHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUTXOsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUTXOsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUTXOsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUTXOsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUTXOsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUTXOsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Utxos", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5040,10 +10728,8 @@ func (m *QueryWithdrawRequestsByAddressResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Utxos = append(m.Utxos, &UTXO{})
+			if err := m.Utxos[len(m.Utxos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5068,7 +10754,7 @@ func (m *QueryWithdrawRequestsByAddressResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryWithdrawRequestsByTxHashRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryUTXOsByAddressRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5091,15 +10777,15 @@ func (m *QueryWithdrawRequestsByTxHashRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryWithdrawRequestsByTxHashRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryUTXOsByAddressRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryWithdrawRequestsByTxHashRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryUTXOsByAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Txid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5127,7 +10813,7 @@ func (m *QueryWithdrawRequestsByTxHashRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Txid = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5150,7 +10836,7 @@ func (m *QueryWithdrawRequestsByTxHashRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryWithdrawRequestsByTxHashResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryUTXOsByAddressResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5173,15 +10859,15 @@ func (m *QueryWithdrawRequestsByTxHashResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryWithdrawRequestsByTxHashResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryUTXOsByAddressResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryWithdrawRequestsByTxHashResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryUTXOsByAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Requests", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Utxos", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5208,8 +10894,8 @@ func (m *QueryWithdrawRequestsByTxHashResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Requests = append(m.Requests, &WithdrawRequest{})
-			if err := m.Requests[len(m.Requests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Utxos = append(m.Utxos, &UTXO{})
+			if err := m.Utxos[len(m.Utxos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5234,7 +10920,7 @@ func (m *QueryWithdrawRequestsByTxHashResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPendingBtcWithdrawRequestsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryUTXOCountAndBalancesByAddressRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5257,17 +10943,17 @@ func (m *QueryPendingBtcWithdrawRequestsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPendingBtcWithdrawRequestsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryUTXOCountAndBalancesByAddressRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPendingBtcWithdrawRequestsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryUTXOCountAndBalancesByAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5277,27 +10963,23 @@ func (m *QueryPendingBtcWithdrawRequestsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5320,7 +11002,7 @@ func (m *QueryPendingBtcWithdrawRequestsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPendingBtcWithdrawRequestsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryUTXOCountAndBalancesByAddressResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5343,17 +11025,17 @@ func (m *QueryPendingBtcWithdrawRequestsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPendingBtcWithdrawRequestsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryUTXOCountAndBalancesByAddressResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPendingBtcWithdrawRequestsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryUTXOCountAndBalancesByAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Requests", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
 			}
-			var msglen int
+			m.Count = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5363,29 +11045,33 @@ func (m *QueryPendingBtcWithdrawRequestsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Count |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			m.Requests = append(m.Requests, &WithdrawRequest{})
-			if err := m.Requests[len(m.Requests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Value = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Value |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RuneBalances", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5412,10 +11098,8 @@ func (m *QueryPendingBtcWithdrawRequestsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RuneBalances = append(m.RuneBalances, &RuneBalance{})
+			if err := m.RuneBalances[len(m.RuneBalances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5440,7 +11124,7 @@ func (m *QueryPendingBtcWithdrawRequestsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySigningRequestRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDKGRequestRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5463,17 +11147,17 @@ func (m *QuerySigningRequestRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySigningRequestRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDKGRequestRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySigningRequestRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDKGRequestRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			m.Sequence = 0
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5483,7 +11167,7 @@ func (m *QuerySigningRequestRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Sequence |= uint64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -5509,7 +11193,7 @@ func (m *QuerySigningRequestRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySigningRequestResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDKGRequestResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5532,10 +11216,10 @@ func (m *QuerySigningRequestResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySigningRequestResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDKGRequestResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySigningRequestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDKGRequestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5568,7 +11252,7 @@ func (m *QuerySigningRequestResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Request == nil {
-				m.Request = &SigningRequest{}
+				m.Request = &DKGRequest{}
 			}
 			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -5595,7 +11279,7 @@ func (m *QuerySigningRequestResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySigningRequestsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDKGRequestsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5618,10 +11302,10 @@ func (m *QuerySigningRequestsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySigningRequestsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDKGRequestsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySigningRequestsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDKGRequestsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5638,47 +11322,11 @@ func (m *QuerySigningRequestsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= SigningStatus(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Status |= DKGRequestStatus(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5700,7 +11348,7 @@ func (m *QuerySigningRequestsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySigningRequestsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDKGRequestsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5723,10 +11371,10 @@ func (m *QuerySigningRequestsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySigningRequestsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDKGRequestsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySigningRequestsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDKGRequestsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5758,47 +11406,11 @@ func (m *QuerySigningRequestsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Requests = append(m.Requests, &SigningRequest{})
+			m.Requests = append(m.Requests, &DKGRequest{})
 			if err := m.Requests[len(m.Requests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5820,7 +11432,7 @@ func (m *QuerySigningRequestsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySigningRequestsByAddressRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllDKGRequestsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5843,47 +11455,65 @@ func (m *QuerySigningRequestsByAddressRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySigningRequestsByAddressRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllDKGRequestsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySigningRequestsByAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllDKGRequestsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllDKGRequestsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllDKGRequestsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllDKGRequestsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Requests", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5910,10 +11540,8 @@ func (m *QuerySigningRequestsByAddressRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Requests = append(m.Requests, &DKGRequest{})
+			if err := m.Requests[len(m.Requests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5938,7 +11566,7 @@ func (m *QuerySigningRequestsByAddressRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySigningRequestsByAddressResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDKGCompletionRequestsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5961,17 +11589,17 @@ func (m *QuerySigningRequestsByAddressResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySigningRequestsByAddressResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDKGCompletionRequestsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySigningRequestsByAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDKGCompletionRequestsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Requests", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var msglen int
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5981,29 +11609,64 @@ func (m *QuerySigningRequestsByAddressResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Requests = append(m.Requests, &SigningRequest{})
-			if err := m.Requests[len(m.Requests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDKGCompletionRequestsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
-			iNdEx = postIndex
-		case 2:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDKGCompletionRequestsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDKGCompletionRequestsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Requests", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6030,10 +11693,8 @@ func (m *QuerySigningRequestsByAddressResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Requests = append(m.Requests, &DKGCompletionRequest{})
+			if err := m.Requests[len(m.Requests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6058,7 +11719,7 @@ func (m *QuerySigningRequestsByAddressResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySigningRequestByTxHashRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRunesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6081,44 +11742,12 @@ func (m *QuerySigningRequestByTxHashRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySigningRequestByTxHashRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRunesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySigningRequestByTxHashRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRunesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Txid", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Txid = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6140,7 +11769,7 @@ func (m *QuerySigningRequestByTxHashRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySigningRequestByTxHashResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRunesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6163,15 +11792,15 @@ func (m *QuerySigningRequestByTxHashResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySigningRequestByTxHashResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRunesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySigningRequestByTxHashResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRunesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Runes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6198,10 +11827,8 @@ func (m *QuerySigningRequestByTxHashResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Request == nil {
-				m.Request = &SigningRequest{}
-			}
-			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Runes = append(m.Runes, &RuneMetadata{})
+			if err := m.Runes[len(m.Runes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6226,7 +11853,7 @@ func (m *QuerySigningRequestByTxHashResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFeeRateRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRuneRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6249,12 +11876,44 @@ func (m *QueryFeeRateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFeeRateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRuneRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFeeRateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRuneRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6276,7 +11935,7 @@ func (m *QueryFeeRateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFeeRateResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRuneResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6299,15 +11958,15 @@ func (m *QueryFeeRateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFeeRateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRuneResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFeeRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRuneResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeeRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rune", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6334,10 +11993,10 @@ func (m *QueryFeeRateResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.FeeRate == nil {
-				m.FeeRate = &FeeRate{}
+			if m.Rune == nil {
+				m.Rune = &RuneMetadata{}
 			}
-			if err := m.FeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Rune.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6362,7 +12021,7 @@ func (m *QueryFeeRateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryWithdrawalNetworkFeeRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRuneConfigsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6385,15 +12044,15 @@ func (m *QueryWithdrawalNetworkFeeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryWithdrawalNetworkFeeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRuneConfigsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryWithdrawalNetworkFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRuneConfigsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6421,45 +12080,63 @@ func (m *QueryWithdrawalNetworkFeeRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRuneConfigsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeeRate", wireType)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRuneConfigsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRuneConfigsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Configs", wireType)
 			}
-			m.FeeRate = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6469,11 +12146,26 @@ func (m *QueryWithdrawalNetworkFeeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.FeeRate |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Configs = append(m.Configs, &RuneConfigWithSupply{})
+			if err := m.Configs[len(m.Configs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6495,7 +12187,7 @@ func (m *QueryWithdrawalNetworkFeeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryWithdrawalNetworkFeeResponse) Unmarshal(dAtA []byte) error {
+func (m *RuneConfigWithSupply) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6518,17 +12210,17 @@ func (m *QueryWithdrawalNetworkFeeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryWithdrawalNetworkFeeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RuneConfigWithSupply: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryWithdrawalNetworkFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RuneConfigWithSupply: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeeRate", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
 			}
-			m.FeeRate = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6538,14 +12230,28 @@ func (m *QueryWithdrawalNetworkFeeResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.FeeRate |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Supply", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6573,7 +12279,7 @@ func (m *QueryWithdrawalNetworkFeeResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Fee = string(dAtA[iNdEx:postIndex])
+			m.Supply = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6596,7 +12302,7 @@ func (m *QueryWithdrawalNetworkFeeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryProtocolFeesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6619,12 +12325,44 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProtocolFeesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProtocolFeesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6646,7 +12384,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryProtocolFeesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6669,17 +12407,17 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProtocolFeesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProtocolFeesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositFee", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6689,24 +12427,55 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.DepositFee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.WithdrawFee = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6729,7 +12498,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryChainTipRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFeeDistributionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6752,10 +12521,10 @@ func (m *QueryChainTipRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryChainTipRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFeeDistributionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryChainTipRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFeeDistributionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -6779,7 +12548,7 @@ func (m *QueryChainTipRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryChainTipResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFeeDistributionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6802,17 +12571,17 @@ func (m *QueryChainTipResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryChainTipResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFeeDistributionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryChainTipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFeeDistributionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Buckets", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6822,29 +12591,31 @@ func (m *QueryChainTipResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hash = string(dAtA[iNdEx:postIndex])
+			m.Buckets = append(m.Buckets, &FeeBucketStats{})
+			if err := m.Buckets[len(m.Buckets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSettlementHeight", wireType)
 			}
-			m.Height = 0
+			m.LastSettlementHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6854,7 +12625,7 @@ func (m *QueryChainTipResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
+				m.LastSettlementHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -6880,7 +12651,7 @@ func (m *QueryChainTipResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBlockHeaderByHeightRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryOutflowUsageRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6903,17 +12674,17 @@ func (m *QueryBlockHeaderByHeightRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBlockHeaderByHeightRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryOutflowUsageRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBlockHeaderByHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryOutflowUsageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			m.Height = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6923,11 +12694,24 @@ func (m *QueryBlockHeaderByHeightRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6949,7 +12733,7 @@ func (m *QueryBlockHeaderByHeightRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBlockHeaderByHeightResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryOutflowUsageResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6972,15 +12756,34 @@ func (m *QueryBlockHeaderByHeightResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBlockHeaderByHeightResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryOutflowUsageResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBlockHeaderByHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryOutflowUsageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			m.Window = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Window |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeader", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Global", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7007,10 +12810,80 @@ func (m *QueryBlockHeaderByHeightResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BlockHeader == nil {
-				m.BlockHeader = &BlockHeader{}
+			if m.Global == nil {
+				m.Global = &OutflowUsage{}
 			}
-			if err := m.BlockHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Global.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Assets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Assets = append(m.Assets, &OutflowUsage{})
+			if err := m.Assets[len(m.Assets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Breaker", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Breaker == nil {
+				m.Breaker = &CircuitBreaker{}
+			}
+			if err := m.Breaker.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7035,7 +12908,7 @@ func (m *QueryBlockHeaderByHeightResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBlockHeaderByHashRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryEmergencyPausesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7058,17 +12931,17 @@ func (m *QueryBlockHeaderByHashRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBlockHeaderByHashRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEmergencyPausesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBlockHeaderByHashRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEmergencyPausesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveOnly", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7078,24 +12951,12 @@ func (m *QueryBlockHeaderByHashRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Hash = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.ActiveOnly = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7117,7 +12978,7 @@ func (m *QueryBlockHeaderByHashRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBlockHeaderByHashResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryEmergencyPausesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7140,15 +13001,15 @@ func (m *QueryBlockHeaderByHashResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBlockHeaderByHashResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEmergencyPausesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBlockHeaderByHashResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEmergencyPausesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeader", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pauses", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7175,10 +13036,8 @@ func (m *QueryBlockHeaderByHashResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BlockHeader == nil {
-				m.BlockHeader = &BlockHeader{}
-			}
-			if err := m.BlockHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Pauses = append(m.Pauses, &EmergencyPause{})
+			if err := m.Pauses[len(m.Pauses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7203,7 +13062,7 @@ func (m *QueryBlockHeaderByHashResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryUTXOsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDepositAddressRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7226,12 +13085,31 @@ func (m *QueryUTXOsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryUTXOsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDepositAddressRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryUTXOsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDepositAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetType", wireType)
+			}
+			m.AssetType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AssetType |= AssetType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7253,7 +13131,7 @@ func (m *QueryUTXOsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryUTXOsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDepositAddressResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7276,15 +13154,15 @@ func (m *QueryUTXOsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryUTXOsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDepositAddressResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryUTXOsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDepositAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Utxos", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Vault", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7311,8 +13189,44 @@ func (m *QueryUTXOsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Utxos = append(m.Utxos, &UTXO{})
-			if err := m.Utxos[len(m.Utxos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Vault == nil {
+				m.Vault = &Vault{}
+			}
+			if err := m.Vault.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveVaults", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActiveVaults = append(m.ActiveVaults, &Vault{})
+			if err := m.ActiveVaults[len(m.ActiveVaults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7337,7 +13251,7 @@ func (m *QueryUTXOsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryUTXOsByAddressRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFrostSigningSessionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7360,15 +13274,15 @@ func (m *QueryUTXOsByAddressRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryUTXOsByAddressRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFrostSigningSessionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryUTXOsByAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFrostSigningSessionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Txid", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7396,7 +13310,7 @@ func (m *QueryUTXOsByAddressRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Txid = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7419,7 +13333,7 @@ func (m *QueryUTXOsByAddressRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryUTXOsByAddressResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFrostSigningSessionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7442,15 +13356,15 @@ func (m *QueryUTXOsByAddressResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryUTXOsByAddressResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFrostSigningSessionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryUTXOsByAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFrostSigningSessionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Utxos", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Session", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7477,8 +13391,10 @@ func (m *QueryUTXOsByAddressResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Utxos = append(m.Utxos, &UTXO{})
-			if err := m.Utxos[len(m.Utxos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Session == nil {
+				m.Session = &FrostSigningSession{}
+			}
+			if err := m.Session.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7503,7 +13419,7 @@ func (m *QueryUTXOsByAddressResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryUTXOCountAndBalancesByAddressRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFrostVaultKeyRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7526,15 +13442,15 @@ func (m *QueryUTXOCountAndBalancesByAddressRequest) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryUTXOCountAndBalancesByAddressRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFrostVaultKeyRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryUTXOCountAndBalancesByAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFrostVaultKeyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Vault", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7562,7 +13478,7 @@ func (m *QueryUTXOCountAndBalancesByAddressRequest) Unmarshal(dAtA []byte) error
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Vault = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7585,7 +13501,7 @@ func (m *QueryUTXOCountAndBalancesByAddressRequest) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryUTXOCountAndBalancesByAddressResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFrostVaultKeyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7608,53 +13524,15 @@ func (m *QueryUTXOCountAndBalancesByAddressResponse) Unmarshal(dAtA []byte) erro
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryUTXOCountAndBalancesByAddressResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFrostVaultKeyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryUTXOCountAndBalancesByAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFrostVaultKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
-			}
-			m.Count = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Count |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
-			}
-			m.Value = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Value |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RuneBalances", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7681,8 +13559,10 @@ func (m *QueryUTXOCountAndBalancesByAddressResponse) Unmarshal(dAtA []byte) erro
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RuneBalances = append(m.RuneBalances, &RuneBalance{})
-			if err := m.RuneBalances[len(m.RuneBalances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Key == nil {
+				m.Key = &FrostVaultKey{}
+			}
+			if err := m.Key.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7707,7 +13587,7 @@ func (m *QueryUTXOCountAndBalancesByAddressResponse) Unmarshal(dAtA []byte) erro
 	}
 	return nil
 }
-func (m *QueryDKGRequestRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySignerPerformanceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7730,17 +13610,17 @@ func (m *QueryDKGRequestRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDKGRequestRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySignerPerformanceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDKGRequestRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySignerPerformanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
 			}
-			m.Id = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7750,11 +13630,24 @@ func (m *QueryDKGRequestRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7776,7 +13669,7 @@ func (m *QueryDKGRequestRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDKGRequestResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySignerPerformanceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7799,15 +13692,15 @@ func (m *QueryDKGRequestResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDKGRequestResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySignerPerformanceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDKGRequestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySignerPerformanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Performance", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7834,10 +13727,10 @@ func (m *QueryDKGRequestResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Request == nil {
-				m.Request = &DKGRequest{}
+			if m.Performance == nil {
+				m.Performance = &SignerPerformance{}
 			}
-			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Performance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7862,7 +13755,7 @@ func (m *QueryDKGRequestResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDKGRequestsRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySignerPerformancesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7885,31 +13778,12 @@ func (m *QueryDKGRequestsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDKGRequestsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySignerPerformancesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDKGRequestsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySignerPerformancesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-			}
-			m.Status = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Status |= DKGRequestStatus(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7931,7 +13805,7 @@ func (m *QueryDKGRequestsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDKGRequestsResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySignerPerformancesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7954,15 +13828,15 @@ func (m *QueryDKGRequestsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDKGRequestsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySignerPerformancesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDKGRequestsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySignerPerformancesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Requests", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Performances", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7989,8 +13863,8 @@ func (m *QueryDKGRequestsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Requests = append(m.Requests, &DKGRequest{})
-			if err := m.Requests[len(m.Requests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Performances = append(m.Performances, &SignerPerformance{})
+			if err := m.Performances[len(m.Performances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8015,7 +13889,7 @@ func (m *QueryDKGRequestsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllDKGRequestsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryVaultTransferRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8038,12 +13912,31 @@ func (m *QueryAllDKGRequestsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllDKGRequestsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVaultTransferRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllDKGRequestsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVaultTransferRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceVersion", wireType)
+			}
+			m.SourceVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SourceVersion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -8065,7 +13958,7 @@ func (m *QueryAllDKGRequestsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllDKGRequestsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryVaultTransferResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8088,15 +13981,15 @@ func (m *QueryAllDKGRequestsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllDKGRequestsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVaultTransferResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllDKGRequestsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVaultTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Requests", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Transfer", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8123,8 +14016,10 @@ func (m *QueryAllDKGRequestsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Requests = append(m.Requests, &DKGRequest{})
-			if err := m.Requests[len(m.Requests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Transfer == nil {
+				m.Transfer = &VaultTransfer{}
+			}
+			if err := m.Transfer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8149,7 +14044,7 @@ func (m *QueryAllDKGRequestsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDKGCompletionRequestsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryVaultTransfersRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8172,31 +14067,12 @@ func (m *QueryDKGCompletionRequestsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDKGCompletionRequestsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVaultTransfersRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDKGCompletionRequestsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVaultTransfersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			m.Id = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -8218,7 +14094,7 @@ func (m *QueryDKGCompletionRequestsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDKGCompletionRequestsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryVaultTransfersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8241,15 +14117,15 @@ func (m *QueryDKGCompletionRequestsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDKGCompletionRequestsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVaultTransfersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDKGCompletionRequestsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVaultTransfersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Requests", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Transfers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8276,8 +14152,8 @@ func (m *QueryDKGCompletionRequestsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Requests = append(m.Requests, &DKGCompletionRequest{})
-			if err := m.Requests[len(m.Requests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Transfers = append(m.Transfers, &VaultTransfer{})
+			if err := m.Transfers[len(m.Transfers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -0,0 +1,70 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Validate validates the withdrawal rate limit
+func (r WithdrawRateLimit) Validate() error {
+	if r.Window < 0 {
+		return errorsmod.Wrapf(ErrInvalidParams, "rate limit window must not be negative")
+	}
+
+	if r.GlobalLimit < 0 {
+		return errorsmod.Wrapf(ErrInvalidParams, "global rate limit must not be negative")
+	}
+
+	if err := r.AssetLimits.Validate(); err != nil {
+		return errorsmod.Wrapf(ErrInvalidParams, "invalid asset rate limits: %v", err)
+	}
+
+	if r.BreakerThresholdBps > MaxFeeBps {
+		return errorsmod.Wrapf(ErrInvalidParams, "breaker threshold must not be greater than %d bps", MaxFeeBps)
+	}
+
+	if r.Window == 0 && (r.GlobalLimit != 0 || !r.AssetLimits.Empty()) {
+		return errorsmod.Wrapf(ErrInvalidParams, "rate limit window must be set for the limits")
+	}
+
+	return nil
+}
+
+// IsEnabled returns true if the rate limit is enabled, false otherwise
+func (r WithdrawRateLimit) IsEnabled() bool {
+	return r.Window > 0
+}
+
+// GlobalLimitAmount returns the global limit; zero means no limit
+func (r WithdrawRateLimit) GlobalLimitAmount() sdkmath.Int {
+	return sdkmath.NewInt(r.GlobalLimit)
+}
+
+// AssetLimitAmount returns the limit of the given asset; zero means no limit
+func (r WithdrawRateLimit) AssetLimitAmount(denom string) sdkmath.Int {
+	return r.AssetLimits.AmountOf(denom)
+}
+
+// HighWaterMark returns the high-water mark of the given limit
+// Zero is returned if the circuit breaker is disabled or the limit is zero
+func (r WithdrawRateLimit) HighWaterMark(limit sdkmath.Int) sdkmath.Int {
+	return limit.MulRaw(int64(r.BreakerThresholdBps)).QuoRaw(MaxFeeBps)
+}
+
+// CheckAmount checks if the given asset amount and btc amount fit in a whole window
+func (r WithdrawRateLimit) CheckAmount(amount sdk.Coin, btcAmount int64) error {
+	if !r.IsEnabled() {
+		return nil
+	}
+
+	if limit := r.AssetLimitAmount(amount.Denom); limit.IsPositive() && amount.Amount.GT(limit) {
+		return errorsmod.Wrapf(ErrWithdrawRateLimitExceeded, "amount %s exceeds the asset limit %s", amount, limit)
+	}
+
+	if r.GlobalLimit > 0 && btcAmount > r.GlobalLimit {
+		return errorsmod.Wrapf(ErrWithdrawRateLimitExceeded, "btc amount %d exceeds the global limit %d", btcAmount, r.GlobalLimit)
+	}
+
+	return nil
+}
@@ -0,0 +1,53 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sideprotocol/side/x/btcbridge/types"
+)
+
+func TestWithdrawRateLimitValidate(t *testing.T) {
+	testCases := []struct {
+		name      string
+		rateLimit types.WithdrawRateLimit
+		expectErr bool
+	}{
+		{"disabled", types.WithdrawRateLimit{}, false},
+		{"valid", types.WithdrawRateLimit{Window: 100, GlobalLimit: 100000000, AssetLimits: sdk.NewCoins(sdk.NewInt64Coin("sat", 100000000)), BreakerThresholdBps: 8000}, false},
+		{"negative window", types.WithdrawRateLimit{Window: -1}, true},
+		{"negative global limit", types.WithdrawRateLimit{Window: 100, GlobalLimit: -1}, true},
+		{"limits without window", types.WithdrawRateLimit{GlobalLimit: 100000000}, true},
+		{"invalid asset limits", types.WithdrawRateLimit{Window: 100, AssetLimits: sdk.Coins{sdk.Coin{Denom: "sat", Amount: sdkmath.ZeroInt()}}}, true},
+		{"threshold too high", types.WithdrawRateLimit{Window: 100, BreakerThresholdBps: 10001}, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.rateLimit.Validate()
+			if tc.expectErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestWithdrawRateLimitCheckAmount(t *testing.T) {
+	rateLimit := types.WithdrawRateLimit{
+		Window:      100,
+		GlobalLimit: 1000000,
+		AssetLimits: sdk.NewCoins(sdk.NewInt64Coin("runes/840000:3", 500)),
+	}
+
+	require.NoError(t, rateLimit.CheckAmount(sdk.NewInt64Coin("sat", 1000000), 1000000))
+	require.ErrorIs(t, rateLimit.CheckAmount(sdk.NewInt64Coin("sat", 1000001), 1000001), types.ErrWithdrawRateLimitExceeded)
+	require.NoError(t, rateLimit.CheckAmount(sdk.NewInt64Coin("runes/840000:3", 500), 2000))
+	require.ErrorIs(t, rateLimit.CheckAmount(sdk.NewInt64Coin("runes/840000:3", 501), 2000), types.ErrWithdrawRateLimitExceeded)
+	require.NoError(t, rateLimit.CheckAmount(sdk.NewInt64Coin("runes/840000:5", 100000), 2000), "asset not limited")
+}
@@ -0,0 +1,127 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+)
+
+// Validate validates the rune config
+func (c *RuneConfig) Validate() error {
+	var id RuneId
+	if err := id.FromString(c.Id); err != nil || id.Block == 0 || id.ToString() != c.Id {
+		return errorsmod.Wrap(ErrInvalidParams, "invalid rune id in rune config")
+	}
+
+	for _, amount := range []string{c.MinDeposit, c.MinWithdraw, c.MaxWithdraw, c.SupplyCap} {
+		if err := validateRuneLimit(amount); err != nil {
+			return err
+		}
+	}
+
+	maxWithdraw := c.MaxWithdrawAmount()
+	if !maxWithdraw.IsZero() && c.MinWithdrawAmount().GT(maxWithdraw) {
+		return errorsmod.Wrap(ErrInvalidParams, "minimum rune withdrawal amount must not be greater than maximum withdrawal amount")
+	}
+
+	if c.FeeOverride != nil {
+		if err := c.FeeOverride.DepositFeeSchedule.Validate(); err != nil {
+			return err
+		}
+
+		if err := c.FeeOverride.WithdrawFeeSchedule.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MinDepositAmount returns the minimum deposit amount; zero means no limit
+func (c *RuneConfig) MinDepositAmount() sdkmath.Int {
+	return parseRuneLimit(c.MinDeposit)
+}
+
+// MinWithdrawAmount returns the minimum withdrawal amount; zero means no limit
+func (c *RuneConfig) MinWithdrawAmount() sdkmath.Int {
+	return parseRuneLimit(c.MinWithdraw)
+}
+
+// MaxWithdrawAmount returns the maximum withdrawal amount; zero means no limit
+func (c *RuneConfig) MaxWithdrawAmount() sdkmath.Int {
+	return parseRuneLimit(c.MaxWithdraw)
+}
+
+// SupplyCapAmount returns the supply cap of the voucher token; zero means no limit
+func (c *RuneConfig) SupplyCapAmount() sdkmath.Int {
+	return parseRuneLimit(c.SupplyCap)
+}
+
+// CheckDepositAmount checks if the given deposit amount is allowed by the config
+func (c *RuneConfig) CheckDepositAmount(amount sdkmath.Int) error {
+	if amount.LT(c.MinDepositAmount()) {
+		return errorsmod.Wrapf(ErrInvalidDepositAmount, "rune %s deposit amount %s less than the minimum %s", c.Id, amount, c.MinDeposit)
+	}
+
+	return nil
+}
+
+// CheckWithdrawAmount checks if the given withdrawal amount is allowed by the config
+func (c *RuneConfig) CheckWithdrawAmount(amount sdkmath.Int) error {
+	if amount.LT(c.MinWithdrawAmount()) {
+		return errorsmod.Wrapf(ErrInvalidWithdrawAmount, "rune %s withdrawal amount %s less than the minimum %s", c.Id, amount, c.MinWithdraw)
+	}
+
+	maxWithdraw := c.MaxWithdrawAmount()
+	if !maxWithdraw.IsZero() && amount.GT(maxWithdraw) {
+		return errorsmod.Wrapf(ErrInvalidWithdrawAmount, "rune %s withdrawal amount %s greater than the maximum %s", c.Id, amount, c.MaxWithdraw)
+	}
+
+	return nil
+}
+
+// CheckSupply checks if the given voucher supply is within the supply cap
+func (c *RuneConfig) CheckSupply(supply sdkmath.Int) error {
+	supplyCap := c.SupplyCapAmount()
+	if !supplyCap.IsZero() && supply.GT(supplyCap) {
+		return errorsmod.Wrapf(ErrRuneSupplyCapExceeded, "rune %s supply %s exceeds the cap %s", c.Id, supply, c.SupplyCap)
+	}
+
+	return nil
+}
+
+// SelectRuneConfig returns the config of the given rune
+func SelectRuneConfig(configs []RuneConfig, id string) *RuneConfig {
+	for i := range configs {
+		if configs[i].Id == id {
+			return &configs[i]
+		}
+	}
+
+	return nil
+}
+
+// validateRuneLimit validates the given rune amount limit
+func validateRuneLimit(amount string) error {
+	if len(amount) == 0 {
+		return nil
+	}
+
+	a, ok := sdkmath.NewIntFromString(amount)
+	if !ok || a.IsNegative() || a.BigInt().BitLen() > 128 {
+		return errorsmod.Wrapf(ErrInvalidParams, "invalid rune amount limit %s", amount)
+	}
+
+	return nil
+}
+
+// parseRuneLimit parses the given rune amount limit
+// Assume that the limit is valid
+func parseRuneLimit(amount string) sdkmath.Int {
+	if len(amount) == 0 {
+		return sdkmath.ZeroInt()
+	}
+
+	a, _ := sdkmath.NewIntFromString(amount)
+
+	return a
+}
@@ -0,0 +1,79 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/sideprotocol/side/x/btcbridge/types"
+)
+
+func TestRuneConfigValidate(t *testing.T) {
+	testCases := []struct {
+		name       string
+		config     types.RuneConfig
+		expectPass bool
+	}{
+		{
+			name:       "valid config without limits",
+			config:     types.RuneConfig{Id: "840000:3", Enabled: true},
+			expectPass: true,
+		},
+		{
+			name:       "valid config with limits",
+			config:     types.RuneConfig{Id: "840000:3", MinDeposit: "100", MinWithdraw: "10", MaxWithdraw: "500", SupplyCap: "340282366920938463463374607431768211455"},
+			expectPass: true,
+		},
+		{
+			name:       "non canonical id",
+			config:     types.RuneConfig{Id: "840000:03"},
+			expectPass: false,
+		},
+		{
+			name:       "invalid amount",
+			config:     types.RuneConfig{Id: "840000:3", MinDeposit: "-1"},
+			expectPass: false,
+		},
+		{
+			name:       "amount overflow",
+			config:     types.RuneConfig{Id: "840000:3", SupplyCap: "340282366920938463463374607431768211456"},
+			expectPass: false,
+		},
+		{
+			name:       "min withdrawal greater than max withdrawal",
+			config:     types.RuneConfig{Id: "840000:3", MinWithdraw: "600", MaxWithdraw: "500"},
+			expectPass: false,
+		},
+		{
+			name:       "invalid fee override",
+			config:     types.RuneConfig{Id: "840000:3", FeeOverride: &types.RuneFees{WithdrawFeeSchedule: types.FeeSchedule{Bps: 10001}}},
+			expectPass: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.config.Validate()
+			if tc.expectPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestRuneConfigLimits(t *testing.T) {
+	config := types.RuneConfig{Id: "840000:3", MinWithdraw: "10", SupplyCap: "1000"}
+
+	require.NoError(t, config.CheckWithdrawAmount(sdkmath.NewInt(10)))
+	require.NoError(t, config.CheckWithdrawAmount(sdkmath.NewInt(1000000)), "no maximum withdrawal amount limit")
+	require.ErrorIs(t, config.CheckWithdrawAmount(sdkmath.NewInt(9)), types.ErrInvalidWithdrawAmount)
+
+	require.NoError(t, config.CheckDepositAmount(sdkmath.NewInt(1)), "no minimum deposit amount limit")
+
+	require.NoError(t, config.CheckSupply(sdkmath.NewInt(1000)))
+	require.ErrorIs(t, config.CheckSupply(sdkmath.NewInt(1001)), types.ErrRuneSupplyCapExceeded)
+}
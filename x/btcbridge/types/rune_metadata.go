@@ -0,0 +1,135 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+
+	errorsmod "cosmossdk.io/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+const (
+	// rune name spacer
+	RuneSpacer = "•"
+
+	// maximum length of the rune name without spacers
+	MaxRuneNameLen = 28
+
+	// default rune symbol
+	DefaultRuneSymbol = "¤"
+
+	// display denom of the btc voucher token
+	BtcDisplayDenom = "btc"
+
+	// decimals of btc
+	BtcDecimals = 8
+)
+
+// Validate validates the rune metadata
+func (m *RuneMetadata) Validate() error {
+	var id RuneId
+	if err := id.FromString(m.Id); err != nil || id.Block == 0 {
+		return errorsmod.Wrap(ErrInvalidRuneMetadata, "invalid rune id")
+	}
+
+	if err := ValidateRuneName(m.Name); err != nil {
+		return err
+	}
+
+	if len(m.Symbol) > 0 && utf8.RuneCountInString(m.Symbol) != 1 {
+		return errorsmod.Wrap(ErrInvalidRuneMetadata, "symbol must be a single character")
+	}
+
+	if m.Divisibility > MaxDivisibility {
+		return errorsmod.Wrapf(ErrInvalidRuneMetadata, "divisibility can not be greater than %d", MaxDivisibility)
+	}
+
+	if _, err := chainhash.NewHashFromStr(m.EtchingTx); err != nil || len(m.EtchingTx) != chainhash.MaxHashStringSize {
+		return errorsmod.Wrap(ErrInvalidRuneMetadata, "invalid etching tx hash")
+	}
+
+	return nil
+}
+
+// GetSymbolOrDefault returns the rune symbol; the default symbol returned if not specified
+func (m *RuneMetadata) GetSymbolOrDefault() string {
+	if len(m.Symbol) == 0 {
+		return DefaultRuneSymbol
+	}
+
+	return m.Symbol
+}
+
+// BankMetadata returns the bank denom metadata of the rune voucher token
+func (m *RuneMetadata) BankMetadata() banktypes.Metadata {
+	var id RuneId
+	id.MustUnmarshalFromString(m.Id)
+
+	base := id.Denom()
+	display := fmt.Sprintf("%s/%s", RunesProtocolName, strings.ReplaceAll(m.Name, RuneSpacer, ""))
+
+	denomUnits := []*banktypes.DenomUnit{{Denom: base, Exponent: 0}}
+	if m.Divisibility > 0 {
+		denomUnits = append(denomUnits, &banktypes.DenomUnit{Denom: display, Exponent: m.Divisibility})
+	} else {
+		display = base
+	}
+
+	return banktypes.Metadata{
+		Description: fmt.Sprintf("Bridged rune %s (%s)", m.Name, m.Id),
+		DenomUnits:  denomUnits,
+		Base:        base,
+		Display:     display,
+		Name:        m.Name,
+		Symbol:      m.GetSymbolOrDefault(),
+	}
+}
+
+// ValidateRuneName validates the given spaced rune name
+// The name consists of A-Z and the spacers are only allowed between letters
+func ValidateRuneName(name string) error {
+	letters := 0
+	spacer := false
+
+	for i, r := range name {
+		if string(r) == RuneSpacer {
+			if i == 0 || spacer {
+				return errorsmod.Wrap(ErrInvalidRuneMetadata, "invalid spacer in rune name")
+			}
+
+			spacer = true
+			continue
+		}
+
+		if r < 'A' || r > 'Z' {
+			return errorsmod.Wrap(ErrInvalidRuneMetadata, "rune name can only contain A-Z and spacers")
+		}
+
+		letters++
+		spacer = false
+	}
+
+	if letters == 0 || letters > MaxRuneNameLen || spacer {
+		return errorsmod.Wrap(ErrInvalidRuneMetadata, "invalid rune name")
+	}
+
+	return nil
+}
+
+// BtcVoucherMetadata returns the bank denom metadata of the btc voucher token
+func BtcVoucherMetadata(denom string) banktypes.Metadata {
+	return banktypes.Metadata{
+		Description: "Bridged bitcoin",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: denom, Exponent: 0},
+			{Denom: BtcDisplayDenom, Exponent: BtcDecimals},
+		},
+		Base:    denom,
+		Display: BtcDisplayDenom,
+		Name:    "Bitcoin",
+		Symbol:  "BTC",
+	}
+}
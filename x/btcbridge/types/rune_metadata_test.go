@@ -0,0 +1,93 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+
+	"github.com/sideprotocol/side/x/btcbridge/types"
+)
+
+func TestRuneMetadataValidate(t *testing.T) {
+	etchingTx := chainhash.HashH([]byte("etching")).String()
+
+	testCases := []struct {
+		name       string
+		metadata   types.RuneMetadata
+		expectPass bool
+	}{
+		{
+			name:       "valid metadata",
+			metadata:   types.RuneMetadata{Id: "840000:3", Name: "UNCOMMON•GOODS", Symbol: "⧉", Divisibility: 0, EtchingTx: etchingTx},
+			expectPass: true,
+		},
+		{
+			name:       "valid metadata without symbol",
+			metadata:   types.RuneMetadata{Id: "840000:3", Name: "DOGGOTOTHEMOON", Divisibility: 5, EtchingTx: etchingTx},
+			expectPass: true,
+		},
+		{
+			name:       "invalid rune id",
+			metadata:   types.RuneMetadata{Id: "0:0", Name: "UNCOMMONGOODS", EtchingTx: etchingTx},
+			expectPass: false,
+		},
+		{
+			name:       "lower case name",
+			metadata:   types.RuneMetadata{Id: "840000:3", Name: "uncommon", EtchingTx: etchingTx},
+			expectPass: false,
+		},
+		{
+			name:       "leading spacer",
+			metadata:   types.RuneMetadata{Id: "840000:3", Name: "•UNCOMMON", EtchingTx: etchingTx},
+			expectPass: false,
+		},
+		{
+			name:       "trailing spacer",
+			metadata:   types.RuneMetadata{Id: "840000:3", Name: "UNCOMMON•", EtchingTx: etchingTx},
+			expectPass: false,
+		},
+		{
+			name:       "consecutive spacers",
+			metadata:   types.RuneMetadata{Id: "840000:3", Name: "UNCOMMON••GOODS", EtchingTx: etchingTx},
+			expectPass: false,
+		},
+		{
+			name:       "name too long",
+			metadata:   types.RuneMetadata{Id: "840000:3", Name: "AAAAAAAAAAAAAAAAAAAAAAAAAAAAA", EtchingTx: etchingTx},
+			expectPass: false,
+		},
+		{
+			name:       "multi-character symbol",
+			metadata:   types.RuneMetadata{Id: "840000:3", Name: "UNCOMMONGOODS", Symbol: "UG", EtchingTx: etchingTx},
+			expectPass: false,
+		},
+		{
+			name:       "divisibility too large",
+			metadata:   types.RuneMetadata{Id: "840000:3", Name: "UNCOMMONGOODS", Divisibility: types.MaxDivisibility + 1, EtchingTx: etchingTx},
+			expectPass: false,
+		},
+		{
+			name:       "invalid etching tx",
+			metadata:   types.RuneMetadata{Id: "840000:3", Name: "UNCOMMONGOODS", EtchingTx: "abc"},
+			expectPass: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.metadata.Validate()
+			if tc.expectPass {
+				require.NoError(t, err)
+
+				bankMetadata := tc.metadata.BankMetadata()
+				require.NoError(t, bankMetadata.Validate())
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+
+	require.NoError(t, types.BtcVoucherMetadata(types.DefaultBtcVoucherDenom).Validate())
+}
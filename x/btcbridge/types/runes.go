@@ -3,6 +3,7 @@ package types
 import (
 	"encoding/binary"
 	"fmt"
+	"math"
 	"strconv"
 	"strings"
 
@@ -19,126 +20,79 @@ const (
 	// runes magic number
 	MagicNumber = txscript.OP_13
 
-	// tag indicating that the following are edicts
-	TagBody = 0
-
-	// the number of components of each edict
-	EdictLen = 4
-
 	// sats in the runes output by default
 	RunesOutValue = 546
 )
 
 // ParseRunes parses the potential runes protocol from the given tx;
 // If no OP_RETURN found, no error returned
-// Only support edicts for now
 func ParseRunes(tx *wire.MsgTx) ([]*Edict, error) {
-	for _, out := range tx.TxOut {
-		tokenizer := txscript.MakeScriptTokenizer(0, out.PkScript)
-		if !tokenizer.Next() || tokenizer.Err() != nil || tokenizer.Opcode() != txscript.OP_RETURN {
-			continue
-		}
-
-		if !tokenizer.Next() || tokenizer.Err() != nil || tokenizer.Opcode() != MagicNumber {
-			continue
-		}
-
-		var payload []byte
-
-		for tokenizer.Next() {
-			if txscript.IsSmallInt(tokenizer.Opcode()) || tokenizer.Opcode() <= txscript.OP_PUSHDATA4 {
-				payload = append(payload, tokenizer.Data()...)
-			} else {
-				return nil, ErrInvalidRunes
-			}
-		}
-
-		if tokenizer.Err() != nil {
-			return nil, ErrInvalidRunes
-		}
-
-		return ParseEdicts(tx, payload)
+	runestone, err := ParseRunestone(tx)
+	if err != nil || runestone == nil {
+		return nil, err
 	}
 
-	return nil, nil
+	return runestone.Edicts, nil
 }
 
-// ParseEdicts parses the given payload to a set of edicts
-func ParseEdicts(tx *wire.MsgTx, payload []byte) ([]*Edict, error) {
-	integers, err := DecodeVec(payload)
-	if err != nil {
-		return nil, err
-	}
+// BuildEdictScript builds the edict script
+func BuildEdictScript(runeId string, amount uint128.Uint128, output uint32) ([]byte, error) {
+	var id RuneId
+	id.MustUnmarshalFromString(runeId)
 
-	if len(integers) < EdictLen+1 || len(integers[1:])%EdictLen != 0 || !integers[0].Equals(uint128.From64(TagBody)) {
-		return nil, ErrInvalidRunes
+	runestone := Runestone{
+		Edicts: []*Edict{
+			{
+				Id:     &id,
+				Amount: amount.String(),
+				Output: output,
+			},
+		},
 	}
 
-	integers = integers[1:]
+	return runestone.Encipher()
+}
 
-	edicts := make([]*Edict, 0)
+// Next returns the next rune id by the given delta
+func (id *RuneId) Next(blockDelta uint128.Uint128, txDelta uint128.Uint128) (*RuneId, error) {
+	if !isUint64(blockDelta) || !isUint64(txDelta) || txDelta.Lo > math.MaxUint32 {
+		return nil, ErrInvalidRuneId
+	}
 
-	for i := 0; i < len(integers); i = i + 4 {
-		output := uint32(integers[i+3].Big().Uint64())
-		if output > uint32(len(tx.TxOut)) {
-			return nil, ErrInvalidRunes
+	if blockDelta.IsZero() {
+		if uint64(id.Tx)+txDelta.Lo > math.MaxUint32 {
+			return nil, ErrInvalidRuneId
 		}
 
-		// actually we only support one edict for now, so delta is unnecessary
-		edict := Edict{
-			Id: &RuneId{
-				Block: integers[i].Big().Uint64(),
-				Tx:    uint32(integers[i+1].Big().Uint64()),
-			},
-			Amount: integers[i+2].String(),
-			Output: output,
-		}
+		return NewRuneId(id.Block, id.Tx+uint32(txDelta.Lo))
+	}
 
-		edicts = append(edicts, &edict)
+	if id.Block+blockDelta.Lo < id.Block {
+		return nil, ErrInvalidRuneId
 	}
 
-	return edicts, nil
+	return NewRuneId(id.Block+blockDelta.Lo, uint32(txDelta.Lo))
 }
 
-// ParseEdict parses the given payload to edict
-func ParseEdict(payload []byte) (*Edict, error) {
-	integers, err := DecodeVec(payload)
-	if err != nil {
-		return nil, err
-	}
-
-	if len(integers) != EdictLen+1 && !integers[0].Equals(uint128.From64(TagBody)) {
-		return nil, ErrInvalidRunes
+// NewRuneId creates a new rune id
+// The rune id with the zero block and non-zero tx is invalid
+func NewRuneId(block uint64, tx uint32) (*RuneId, error) {
+	if block == 0 && tx > 0 {
+		return nil, ErrInvalidRuneId
 	}
 
-	return &Edict{
-		Id: &RuneId{
-			Block: integers[1].Big().Uint64(),
-			Tx:    uint32(integers[2].Big().Uint64()),
-		},
-		Amount: integers[3].String(),
-		Output: uint32(integers[4].Big().Uint64()),
-	}, nil
+	return &RuneId{Block: block, Tx: tx}, nil
 }
 
-// BuildEdictScript builds the edict script
-func BuildEdictScript(runeId string, amount uint128.Uint128, output uint32) ([]byte, error) {
-	var id RuneId
-	id.MustUnmarshalFromString(runeId)
-
-	edict := Edict{
-		Id:     &id,
-		Amount: amount.String(),
-		Output: output,
+// Delta returns the delta from the current rune id to the given next rune id
+// Assume that the next rune id is not less than the current one
+func (id *RuneId) Delta(next *RuneId) (uint64, uint32) {
+	blockDelta := next.Block - id.Block
+	if blockDelta == 0 {
+		return 0, next.Tx - id.Tx
 	}
 
-	payload := []byte{TagBody}
-	payload = append(payload, edict.MustMarshalLEB128()...)
-
-	scriptBuilder := txscript.NewScriptBuilder()
-	scriptBuilder.AddOp(txscript.OP_RETURN).AddOp(MagicNumber).AddData(payload)
-
-	return scriptBuilder.Script()
+	return blockDelta, next.Tx
 }
 
 func (id *RuneId) ToString() string {
@@ -195,9 +149,17 @@ func (id *RuneId) Denom() string {
 
 // FromDenom converts the denom to the rune id
 func (id *RuneId) FromDenom(denom string) {
-	idStr := strings.TrimPrefix(denom, fmt.Sprintf("%s/", RunesProtocolName))
+	id.MustUnmarshalFromString(RuneIdFromDenom(denom))
+}
+
+// RunesDenomFromId returns the runes voucher denom of the given rune id string
+func RunesDenomFromId(id string) string {
+	return fmt.Sprintf("%s/%s", RunesProtocolName, id)
+}
 
-	id.MustUnmarshalFromString(idStr)
+// RuneIdFromDenom returns the rune id string of the given runes voucher denom
+func RuneIdFromDenom(denom string) string {
+	return strings.TrimPrefix(denom, fmt.Sprintf("%s/", RunesProtocolName))
 }
 
 // MarshalRuneIdFromString marshals the given id string
@@ -309,3 +271,8 @@ func MarshalRuneAmountFromString(s string) []byte {
 func UnmarshalRuneAmount(bz []byte) uint128.Uint128 {
 	return uint128.FromBytes(bz)
 }
+
+// isUint64 returns true if the given uint128 fits in uint64
+func isUint64(n uint128.Uint128) bool {
+	return n.Hi == 0
+}
@@ -30,6 +30,61 @@ func TestParseRunes(t *testing.T) {
 			},
 			expectPass: true,
 		},
+		{
+			name:        "multiple edicts with delta encoded rune ids",
+			pkScriptHex: "6a5d0c00c0a2330364000002c80100",
+			edicts: []*types.Edict{
+				{
+					Id:     &types.RuneId{Block: 840000, Tx: 3},
+					Amount: "100",
+					Output: 0,
+				},
+				{
+					Id:     &types.RuneId{Block: 840000, Tx: 5},
+					Amount: "200",
+					Output: 0,
+				},
+			},
+			expectPass: true,
+		},
+		{
+			name:        "valid pointer",
+			pkScriptHex: "6a5d0e160000c0a2330364000002c80100",
+			edicts: []*types.Edict{
+				{
+					Id:     &types.RuneId{Block: 840000, Tx: 3},
+					Amount: "100",
+					Output: 0,
+				},
+				{
+					Id:     &types.RuneId{Block: 840000, Tx: 5},
+					Amount: "200",
+					Output: 0,
+				},
+			},
+			expectPass: true,
+		},
+		{
+			name:        "pointer is out of range",
+			pkScriptHex: "6a5d0e160100c0a2330364000002c80100",
+			expectPass:  false,
+		},
+		{
+			name:        "unrecognized odd tag",
+			pkScriptHex: "6a5d020101",
+			expectPass:  true,
+			edicts:      nil,
+		},
+		{
+			name:        "unrecognized even tag",
+			pkScriptHex: "6a5d037e0100",
+			expectPass:  false,
+		},
+		{
+			name:        "zero block of rune id",
+			pkScriptHex: "6a5d050000036400",
+			expectPass:  false,
+		},
 		{
 			name:        "output index is out of range",
 			pkScriptHex: "6a5d0b00c0a2330380cab5ee0102",
@@ -82,3 +137,45 @@ func TestParseRunes(t *testing.T) {
 		})
 	}
 }
+
+func TestRunestoneEncipher(t *testing.T) {
+	pointer := uint32(1)
+
+	runestone := &types.Runestone{
+		Edicts: []*types.Edict{
+			{
+				Id:     &types.RuneId{Block: 840001, Tx: 1},
+				Amount: "300",
+				Output: 2,
+			},
+			{
+				Id:     &types.RuneId{Block: 840000, Tx: 5},
+				Amount: "200",
+				Output: 2,
+			},
+			{
+				Id:     &types.RuneId{Block: 840000, Tx: 3},
+				Amount: "100",
+				Output: 2,
+			},
+		},
+		Pointer: &pointer,
+	}
+
+	script, err := runestone.Encipher()
+	require.NoError(t, err)
+
+	tx := wire.NewMsgTx(types.TxVersion)
+	tx.AddTxOut(wire.NewTxOut(0, script))
+	tx.AddTxOut(wire.NewTxOut(types.RunesOutValue, []byte{}))
+	tx.AddTxOut(wire.NewTxOut(types.RunesOutValue, []byte{}))
+
+	decoded, err := types.ParseRunestone(tx)
+	require.NoError(t, err)
+	require.Equal(t, pointer, *decoded.Pointer)
+	require.EqualValues(t, []*types.Edict{runestone.Edicts[2], runestone.Edicts[1], runestone.Edicts[0]}, decoded.Edicts)
+
+	output, ok := decoded.DefaultOutput(tx)
+	require.True(t, ok)
+	require.Equal(t, pointer, output)
+}
@@ -0,0 +1,627 @@
+package types
+
+import (
+	"math"
+	"math/big"
+	"sort"
+	"unicode/utf8"
+
+	"lukechampine.com/uint128"
+
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+// runestone tags
+// The even tags are required to be recognized, otherwise the runestone is a cenotaph
+const (
+	TagBody         = 0
+	TagDivisibility = 1
+	TagFlags        = 2
+	TagSpacers      = 3
+	TagRune         = 4
+	TagSymbol       = 5
+	TagPremine      = 6
+	TagCap          = 8
+	TagAmount       = 10
+	TagHeightStart  = 12
+	TagHeightEnd    = 14
+	TagOffsetStart  = 16
+	TagOffsetEnd    = 18
+	TagMint         = 20
+	TagPointer      = 22
+	TagCenotaph     = 126
+	TagNop          = 127
+)
+
+// runestone flags
+const (
+	FlagEtching  = 0
+	FlagTerms    = 1
+	FlagTurbo    = 2
+	FlagCenotaph = 127
+)
+
+const (
+	// the number of components of each edict
+	EdictLen = 4
+
+	// maximum divisibility of the rune
+	MaxDivisibility = 38
+
+	// maximum spacers of the rune
+	MaxSpacers = 0b00000111_11111111_11111111_11111111
+)
+
+// Flaw defines the reason why the runestone is a cenotaph
+type Flaw uint32
+
+const (
+	FlawNone Flaw = iota
+	FlawEdictOutput
+	FlawEdictRuneId
+	FlawInvalidScript
+	FlawOpcode
+	FlawSupplyOverflow
+	FlawTrailingIntegers
+	FlawTruncatedField
+	FlawUnrecognizedEvenTag
+	FlawUnrecognizedFlag
+	FlawVarint
+)
+
+var flawMessages = map[Flaw]string{
+	FlawEdictOutput:         "edict output greater than transaction output count",
+	FlawEdictRuneId:         "invalid rune ID in edict",
+	FlawInvalidScript:       "invalid script in OP_RETURN",
+	FlawOpcode:              "non-pushdata opcode in OP_RETURN",
+	FlawSupplyOverflow:      "supply overflows u128",
+	FlawTrailingIntegers:    "trailing integers in body",
+	FlawTruncatedField:      "field with missing value",
+	FlawUnrecognizedEvenTag: "unrecognized even tag",
+	FlawUnrecognizedFlag:    "unrecognized field",
+	FlawVarint:              "invalid varint",
+}
+
+// String implements the fmt.Stringer interface
+func (f Flaw) String() string {
+	return flawMessages[f]
+}
+
+// Runestone defines the runestone of the runes protocol
+type Runestone struct {
+	Edicts  []*Edict
+	Etching *Etching
+	Mint    *RuneId
+	Pointer *uint32
+}
+
+// Etching defines the etching of the runestone
+type Etching struct {
+	Divisibility *uint8
+	Premine      *uint128.Uint128
+	Rune         *uint128.Uint128
+	Spacers      *uint32
+	Symbol       *rune
+	Terms        *Terms
+	Turbo        bool
+}
+
+// Terms defines the open mint terms of the etching
+type Terms struct {
+	Amount      *uint128.Uint128
+	Cap         *uint128.Uint128
+	HeightStart *uint64
+	HeightEnd   *uint64
+	OffsetStart *uint64
+	OffsetEnd   *uint64
+}
+
+// Cenotaph defines the malformed runestone
+// All runes input to the tx containing the cenotaph are burned
+type Cenotaph struct {
+	Flaw    Flaw
+	Etching *uint128.Uint128
+	Mint    *RuneId
+}
+
+// ParseRunestone parses the runestone from the given tx
+// If no runestone found, no error returned
+// If the runestone is a cenotaph, ErrCenotaph returned
+func ParseRunestone(tx *wire.MsgTx) (*Runestone, error) {
+	runestone, cenotaph := Decipher(tx)
+	if cenotaph != nil {
+		return nil, errorsmod.Wrap(ErrCenotaph, cenotaph.Flaw.String())
+	}
+
+	return runestone, nil
+}
+
+// IsCenotaph returns true if the given tx contains the cenotaph
+func IsCenotaph(tx *wire.MsgTx) bool {
+	_, cenotaph := Decipher(tx)
+
+	return cenotaph != nil
+}
+
+// Decipher deciphers the runestone from the given tx according to the ord reference implementation
+// Either the runestone or the cenotaph returned if any; both are nil if no runestone found
+func Decipher(tx *wire.MsgTx) (*Runestone, *Cenotaph) {
+	payload, flaw, found := runestonePayload(tx)
+	if !found {
+		return nil, nil
+	}
+
+	if flaw != FlawNone {
+		return nil, &Cenotaph{Flaw: flaw}
+	}
+
+	integers, err := DecodeVec(payload)
+	if err != nil {
+		return nil, &Cenotaph{Flaw: FlawVarint}
+	}
+
+	edicts, fields, flaw := parseMessage(tx, integers)
+
+	var flags uint128.Uint128
+	takeField(fields, TagFlags, 1, func(values []uint128.Uint128) bool {
+		flags = values[0]
+		return true
+	})
+
+	var etching *Etching
+	if takeFlag(&flags, FlagEtching) {
+		etching = parseEtching(fields, &flags)
+	}
+
+	var mint *RuneId
+	takeField(fields, TagMint, 2, func(values []uint128.Uint128) bool {
+		if !isUint64(values[0]) || !isUint64(values[1]) || values[1].Lo > math.MaxUint32 {
+			return false
+		}
+
+		id, err := NewRuneId(values[0].Lo, uint32(values[1].Lo))
+		if err != nil {
+			return false
+		}
+
+		mint = id
+		return true
+	})
+
+	var pointer *uint32
+	takeField(fields, TagPointer, 1, func(values []uint128.Uint128) bool {
+		if !isUint64(values[0]) || values[0].Lo >= uint64(len(tx.TxOut)) {
+			return false
+		}
+
+		output := uint32(values[0].Lo)
+		pointer = &output
+		return true
+	})
+
+	if flaw == FlawNone && etching != nil && etching.Supply() == nil {
+		flaw = FlawSupplyOverflow
+	}
+
+	if flaw == FlawNone && !flags.IsZero() {
+		flaw = FlawUnrecognizedFlag
+	}
+
+	if flaw == FlawNone {
+		for tag := range fields {
+			if tag.Lo%2 == 0 {
+				flaw = FlawUnrecognizedEvenTag
+				break
+			}
+		}
+	}
+
+	if flaw != FlawNone {
+		cenotaph := &Cenotaph{Flaw: flaw, Mint: mint}
+		if etching != nil {
+			cenotaph.Etching = etching.Rune
+		}
+
+		return nil, cenotaph
+	}
+
+	return &Runestone{
+		Edicts:  edicts,
+		Etching: etching,
+		Mint:    mint,
+		Pointer: pointer,
+	}, nil
+}
+
+// runestonePayload extracts the runestone payload from the first output which starts with OP_RETURN OP_13
+// Only data pushes are allowed following the magic number
+func runestonePayload(tx *wire.MsgTx) ([]byte, Flaw, bool) {
+	for _, out := range tx.TxOut {
+		tokenizer := txscript.MakeScriptTokenizer(0, out.PkScript)
+		if !tokenizer.Next() || tokenizer.Opcode() != txscript.OP_RETURN {
+			continue
+		}
+
+		if !tokenizer.Next() || tokenizer.Opcode() != MagicNumber {
+			continue
+		}
+
+		payload := make([]byte, 0)
+
+		for tokenizer.Next() {
+			if tokenizer.Opcode() > txscript.OP_PUSHDATA4 {
+				return nil, FlawOpcode, true
+			}
+
+			payload = append(payload, tokenizer.Data()...)
+		}
+
+		if tokenizer.Err() != nil {
+			return nil, FlawInvalidScript, true
+		}
+
+		return payload, FlawNone, true
+	}
+
+	return nil, FlawNone, false
+}
+
+// parseMessage parses the given integers to the edicts and fields
+func parseMessage(tx *wire.MsgTx, integers []uint128.Uint128) ([]*Edict, map[uint128.Uint128][]uint128.Uint128, Flaw) {
+	var edicts []*Edict
+	fields := make(map[uint128.Uint128][]uint128.Uint128)
+	flaw := FlawNone
+
+	for i := 0; i < len(integers); i += 2 {
+		tag := integers[i]
+
+		if tag.Equals64(TagBody) {
+			edicts, flaw = parseEdicts(tx, integers[i+1:])
+			break
+		}
+
+		if i+1 >= len(integers) {
+			flaw = FlawTruncatedField
+			break
+		}
+
+		fields[tag] = append(fields[tag], integers[i+1])
+	}
+
+	return edicts, fields, flaw
+}
+
+// parseEdicts parses the given integers to a set of edicts
+// The rune ids of the edicts are delta encoded
+// The edicts parsed before the flaw encountered are returned
+func parseEdicts(tx *wire.MsgTx, integers []uint128.Uint128) ([]*Edict, Flaw) {
+	edicts := make([]*Edict, 0)
+
+	var id RuneId
+
+	for i := 0; i < len(integers); i += EdictLen {
+		if i+EdictLen > len(integers) {
+			return edicts, FlawTrailingIntegers
+		}
+
+		next, err := id.Next(integers[i], integers[i+1])
+		if err != nil {
+			return edicts, FlawEdictRuneId
+		}
+
+		output := integers[i+3]
+		if !isUint64(output) || output.Lo > math.MaxUint32 || output.Lo > uint64(len(tx.TxOut)) {
+			return edicts, FlawEdictOutput
+		}
+
+		edicts = append(edicts, &Edict{
+			Id:     next,
+			Amount: integers[i+2].String(),
+			Output: uint32(output.Lo),
+		})
+
+		id = *next
+	}
+
+	return edicts, FlawNone
+}
+
+// parseEtching parses the etching from the given fields and flags
+func parseEtching(fields map[uint128.Uint128][]uint128.Uint128, flags *uint128.Uint128) *Etching {
+	etching := &Etching{}
+
+	takeField(fields, TagDivisibility, 1, func(values []uint128.Uint128) bool {
+		if !isUint64(values[0]) || values[0].Lo > MaxDivisibility {
+			return false
+		}
+
+		divisibility := uint8(values[0].Lo)
+		etching.Divisibility = &divisibility
+		return true
+	})
+
+	etching.Premine = takeUint128Field(fields, TagPremine)
+	etching.Rune = takeUint128Field(fields, TagRune)
+
+	takeField(fields, TagSpacers, 1, func(values []uint128.Uint128) bool {
+		if !isUint64(values[0]) || values[0].Lo > MaxSpacers {
+			return false
+		}
+
+		spacers := uint32(values[0].Lo)
+		etching.Spacers = &spacers
+		return true
+	})
+
+	takeField(fields, TagSymbol, 1, func(values []uint128.Uint128) bool {
+		if !isUint64(values[0]) || values[0].Lo > math.MaxInt32 || !utf8.ValidRune(rune(values[0].Lo)) {
+			return false
+		}
+
+		symbol := rune(values[0].Lo)
+		etching.Symbol = &symbol
+		return true
+	})
+
+	if takeFlag(flags, FlagTerms) {
+		etching.Terms = &Terms{
+			Cap:         takeUint128Field(fields, TagCap),
+			HeightStart: takeUint64Field(fields, TagHeightStart),
+			HeightEnd:   takeUint64Field(fields, TagHeightEnd),
+			Amount:      takeUint128Field(fields, TagAmount),
+			OffsetStart: takeUint64Field(fields, TagOffsetStart),
+			OffsetEnd:   takeUint64Field(fields, TagOffsetEnd),
+		}
+	}
+
+	etching.Turbo = takeFlag(flags, FlagTurbo)
+
+	return etching
+}
+
+// Supply returns the total supply of the etching
+// Nil returned if overflow
+func (e *Etching) Supply() *uint128.Uint128 {
+	premine := new(big.Int)
+	if e.Premine != nil {
+		premine = e.Premine.Big()
+	}
+
+	mintCap := new(big.Int)
+	amount := new(big.Int)
+
+	if e.Terms != nil {
+		if e.Terms.Cap != nil {
+			mintCap = e.Terms.Cap.Big()
+		}
+
+		if e.Terms.Amount != nil {
+			amount = e.Terms.Amount.Big()
+		}
+	}
+
+	supply := new(big.Int).Add(premine, new(big.Int).Mul(mintCap, amount))
+	if supply.BitLen() > 128 {
+		return nil
+	}
+
+	result := uint128.FromBig(supply)
+
+	return &result
+}
+
+// takeField takes the first n values of the given tag from the fields if valid
+// The field is kept if the values are invalid, which results in a cenotaph for the even tag
+func takeField(fields map[uint128.Uint128][]uint128.Uint128, tag uint64, n int, with func([]uint128.Uint128) bool) bool {
+	key := uint128.From64(tag)
+
+	values, ok := fields[key]
+	if !ok || len(values) < n {
+		return false
+	}
+
+	if !with(values[:n]) {
+		return false
+	}
+
+	if len(values) == n {
+		delete(fields, key)
+	} else {
+		fields[key] = values[n:]
+	}
+
+	return true
+}
+
+// takeUint128Field takes the uint128 value of the given tag from the fields
+func takeUint128Field(fields map[uint128.Uint128][]uint128.Uint128, tag uint64) *uint128.Uint128 {
+	var result *uint128.Uint128
+
+	takeField(fields, tag, 1, func(values []uint128.Uint128) bool {
+		value := values[0]
+		result = &value
+		return true
+	})
+
+	return result
+}
+
+// takeUint64Field takes the uint64 value of the given tag from the fields
+func takeUint64Field(fields map[uint128.Uint128][]uint128.Uint128, tag uint64) *uint64 {
+	var result *uint64
+
+	takeField(fields, tag, 1, func(values []uint128.Uint128) bool {
+		if !isUint64(values[0]) {
+			return false
+		}
+
+		value := values[0].Lo
+		result = &value
+		return true
+	})
+
+	return result
+}
+
+// takeFlag takes the given flag from the flags
+func takeFlag(flags *uint128.Uint128, flag uint) bool {
+	mask := uint128.From64(1).Lsh(flag)
+	set := !flags.And(mask).IsZero()
+
+	*flags = flags.And(mask.Xor(uint128.Max))
+
+	return set
+}
+
+// DefaultOutput returns the output to which the unallocated runes are transferred.
+// The pointer output takes precedence over the first non-OP_RETURN output
+// False returned if the unallocated runes are burned
+func (r *Runestone) DefaultOutput(tx *wire.MsgTx) (uint32, bool) {
+	if r.Pointer != nil {
+		return *r.Pointer, !IsOpReturnOutput(tx.TxOut[*r.Pointer])
+	}
+
+	for i, out := range tx.TxOut {
+		if !IsOpReturnOutput(out) {
+			return uint32(i), true
+		}
+	}
+
+	return 0, false
+}
+
+// Encipher encodes the runestone to the runes protocol script
+// Edicts are sorted by rune id in order to be delta encoded
+func (r *Runestone) Encipher() ([]byte, error) {
+	payload := make([]byte, 0)
+
+	if r.Etching != nil {
+		flags := uint128.From64(1 << FlagEtching)
+
+		if r.Etching.Terms != nil {
+			flags = flags.Or64(1 << FlagTerms)
+		}
+
+		if r.Etching.Turbo {
+			flags = flags.Or64(1 << FlagTurbo)
+		}
+
+		payload = encodeField(payload, TagFlags, flags)
+		payload = encodeOptionalField(payload, TagRune, r.Etching.Rune)
+
+		if r.Etching.Divisibility != nil {
+			payload = encodeField(payload, TagDivisibility, uint128.From64(uint64(*r.Etching.Divisibility)))
+		}
+
+		if r.Etching.Spacers != nil {
+			payload = encodeField(payload, TagSpacers, uint128.From64(uint64(*r.Etching.Spacers)))
+		}
+
+		if r.Etching.Symbol != nil {
+			payload = encodeField(payload, TagSymbol, uint128.From64(uint64(*r.Etching.Symbol)))
+		}
+
+		payload = encodeOptionalField(payload, TagPremine, r.Etching.Premine)
+
+		if terms := r.Etching.Terms; terms != nil {
+			payload = encodeOptionalField(payload, TagAmount, terms.Amount)
+			payload = encodeOptionalField(payload, TagCap, terms.Cap)
+
+			for _, field := range []struct {
+				tag   uint64
+				value *uint64
+			}{
+				{TagHeightStart, terms.HeightStart},
+				{TagHeightEnd, terms.HeightEnd},
+				{TagOffsetStart, terms.OffsetStart},
+				{TagOffsetEnd, terms.OffsetEnd},
+			} {
+				if field.value != nil {
+					payload = encodeField(payload, field.tag, uint128.From64(*field.value))
+				}
+			}
+		}
+	}
+
+	if r.Mint != nil {
+		payload = encodeField(payload, TagMint, uint128.From64(r.Mint.Block))
+		payload = encodeField(payload, TagMint, uint128.From64(uint64(r.Mint.Tx)))
+	}
+
+	if r.Pointer != nil {
+		payload = encodeField(payload, TagPointer, uint128.From64(uint64(*r.Pointer)))
+	}
+
+	if len(r.Edicts) > 0 {
+		payload = append(payload, TagBody)
+
+		edicts := make([]*Edict, len(r.Edicts))
+		copy(edicts, r.Edicts)
+
+		sort.SliceStable(edicts, func(i, j int) bool {
+			return edicts[i].Id.Block < edicts[j].Id.Block || edicts[i].Id.Block == edicts[j].Id.Block && edicts[i].Id.Tx < edicts[j].Id.Tx
+		})
+
+		var previous RuneId
+
+		for _, edict := range edicts {
+			blockDelta, txDelta := previous.Delta(edict.Id)
+
+			amount := RuneAmountFromString(edict.Amount)
+
+			payload = append(payload, EncodeUint64(blockDelta)...)
+			payload = append(payload, EncodeUint32(txDelta)...)
+			payload = append(payload, EncodeUint128(&amount)...)
+			payload = append(payload, EncodeUint32(edict.Output)...)
+
+			previous = *edict.Id
+		}
+	}
+
+	script := []byte{txscript.OP_RETURN, MagicNumber}
+
+	// push the payload in chunks without the small integer opcodes, which make the runestone a cenotaph
+	for len(payload) > 0 {
+		chunk := payload[:min(len(payload), txscript.MaxScriptElementSize)]
+		payload = payload[len(chunk):]
+
+		script = append(script, pushDataOpcodes(len(chunk))...)
+		script = append(script, chunk...)
+	}
+
+	return script, nil
+}
+
+// encodeField encodes the given tag and value to the payload
+func encodeField(payload []byte, tag uint64, value uint128.Uint128) []byte {
+	payload = append(payload, EncodeUint64(tag)...)
+
+	return append(payload, EncodeUint128(&value)...)
+}
+
+// encodeOptionalField encodes the given tag and value to the payload if the value is not nil
+func encodeOptionalField(payload []byte, tag uint64, value *uint128.Uint128) []byte {
+	if value == nil {
+		return payload
+	}
+
+	return encodeField(payload, tag, *value)
+}
+
+// pushDataOpcodes returns the canonical opcodes to push the data with the given length
+// Assume that the length is not zero and not greater than MaxScriptElementSize
+func pushDataOpcodes(length int) []byte {
+	switch {
+	case length <= txscript.OP_DATA_75:
+		return []byte{byte(length)}
+
+	case length <= math.MaxUint8:
+		return []byte{txscript.OP_PUSHDATA1, byte(length)}
+
+	default:
+		return []byte{txscript.OP_PUSHDATA2, byte(length), byte(length >> 8)}
+	}
+}
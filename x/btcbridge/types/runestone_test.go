@@ -0,0 +1,321 @@
+package types_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"lukechampine.com/uint128"
+
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+
+	"github.com/sideprotocol/side/x/btcbridge/types"
+)
+
+// test vectors are derived from the runestone test suite of the ord reference implementation
+func TestDecipher(t *testing.T) {
+	payload := func(integers ...uint128.Uint128) []byte {
+		bz := make([]byte, 0)
+		for _, n := range integers {
+			bz = append(bz, types.EncodeUint128(&n)...)
+		}
+
+		return bz
+	}
+
+	script := func(ops ...[]byte) []byte {
+		bz := []byte{txscript.OP_RETURN, types.MagicNumber}
+		for _, op := range ops {
+			bz = append(bz, op...)
+		}
+
+		return bz
+	}
+
+	push := func(data []byte) []byte {
+		return append([]byte{byte(len(data))}, data...)
+	}
+
+	u := uint128.From64
+	maxUint64 := uint128.From64(math.MaxUint64)
+
+	ptrUint128 := func(n uint64) *uint128.Uint128 {
+		v := uint128.From64(n)
+		return &v
+	}
+
+	ptrUint8 := func(n uint8) *uint8 { return &n }
+
+	edicts := []*types.Edict{{Id: &types.RuneId{Block: 1, Tx: 1}, Amount: "2", Output: 0}}
+
+	testCases := []struct {
+		name      string
+		pkScripts [][]byte
+		runestone *types.Runestone
+		flaw      types.Flaw
+	}{
+		{
+			name:      "no outputs",
+			pkScripts: nil,
+		},
+		{
+			name:      "non OP_RETURN output",
+			pkScripts: [][]byte{push([]byte{})},
+		},
+		{
+			name:      "bare OP_RETURN",
+			pkScripts: [][]byte{{txscript.OP_RETURN}},
+		},
+		{
+			name:      "non matching OP_RETURN",
+			pkScripts: [][]byte{append([]byte{txscript.OP_RETURN}, push([]byte("FOOO"))...)},
+		},
+		{
+			name:      "malformed first opcode",
+			pkScripts: [][]byte{{txscript.OP_DATA_4}},
+		},
+		{
+			name:      "empty runestone",
+			pkScripts: [][]byte{script()},
+			runestone: &types.Runestone{},
+		},
+		{
+			name:      "invalid script postfix",
+			pkScripts: [][]byte{script([]byte{txscript.OP_DATA_4})},
+			flaw:      types.FlawInvalidScript,
+		},
+		{
+			name:      "non pushdata opcode",
+			pkScripts: [][]byte{script([]byte{txscript.OP_VERIFY}, push(payload(u(types.TagBody), u(1), u(1), u(2), u(0))))},
+			flaw:      types.FlawOpcode,
+		},
+		{
+			name:      "pushnum opcode",
+			pkScripts: [][]byte{script([]byte{txscript.OP_1})},
+			flaw:      types.FlawOpcode,
+		},
+		{
+			name:      "truncated varint",
+			pkScripts: [][]byte{script(push([]byte{128}))},
+			flaw:      types.FlawVarint,
+		},
+		{
+			name:      "runestone split across multiple pushes",
+			pkScripts: [][]byte{script(push(payload(u(types.TagBody), u(1))), push(payload(u(1), u(2), u(0))))},
+			runestone: &types.Runestone{Edicts: edicts},
+		},
+		{
+			name:      "only the first runestone output is deciphered",
+			pkScripts: [][]byte{script(push(payload(u(types.TagBody), u(1), u(1), u(2), u(0)))), script(push([]byte{128}))},
+			runestone: &types.Runestone{Edicts: edicts},
+		},
+		{
+			name:      "non empty runestone",
+			pkScripts: [][]byte{script(push(payload(u(types.TagBody), u(1), u(1), u(2), u(0))))},
+			runestone: &types.Runestone{Edicts: edicts},
+		},
+		{
+			name:      "etching",
+			pkScripts: [][]byte{script(push(payload(u(types.TagFlags), u(1<<types.FlagEtching), u(types.TagBody), u(1), u(1), u(2), u(0))))},
+			runestone: &types.Runestone{Etching: &types.Etching{}, Edicts: edicts},
+		},
+		{
+			name:      "etching with rune and divisibility",
+			pkScripts: [][]byte{script(push(payload(u(types.TagFlags), u(1<<types.FlagEtching), u(types.TagRune), u(4), u(types.TagDivisibility), u(5), u(types.TagBody), u(1), u(1), u(2), u(0))))},
+			runestone: &types.Runestone{Etching: &types.Etching{Rune: ptrUint128(4), Divisibility: ptrUint8(5)}, Edicts: edicts},
+		},
+		{
+			name:      "divisibility above max is ignored",
+			pkScripts: [][]byte{script(push(payload(u(types.TagFlags), u(1<<types.FlagEtching), u(types.TagRune), u(4), u(types.TagDivisibility), u(types.MaxDivisibility+1))))},
+			runestone: &types.Runestone{Etching: &types.Etching{Rune: ptrUint128(4)}},
+		},
+		{
+			name:      "symbol above max is ignored",
+			pkScripts: [][]byte{script(push(payload(u(types.TagFlags), u(1<<types.FlagEtching), u(types.TagSymbol), u(0x10FFFF+1))))},
+			runestone: &types.Runestone{Etching: &types.Etching{}},
+		},
+		{
+			name:      "duplicate odd tags are ignored",
+			pkScripts: [][]byte{script(push(payload(u(types.TagFlags), u(1<<types.FlagEtching), u(types.TagDivisibility), u(4), u(types.TagDivisibility), u(5))))},
+			runestone: &types.Runestone{Etching: &types.Etching{Divisibility: ptrUint8(4)}},
+		},
+		{
+			name:      "duplicate even tags produce cenotaph",
+			pkScripts: [][]byte{script(push(payload(u(types.TagFlags), u(1<<types.FlagEtching), u(types.TagRune), u(4), u(types.TagRune), u(5))))},
+			flaw:      types.FlawUnrecognizedEvenTag,
+		},
+		{
+			name:      "unrecognized odd tag is ignored",
+			pkScripts: [][]byte{script(push(payload(u(types.TagNop), u(100), u(types.TagBody), u(1), u(1), u(2), u(0))))},
+			runestone: &types.Runestone{Edicts: edicts},
+		},
+		{
+			name:      "unrecognized even tag",
+			pkScripts: [][]byte{script(push(payload(u(types.TagCenotaph), u(0), u(types.TagBody), u(1), u(1), u(2), u(0))))},
+			flaw:      types.FlawUnrecognizedEvenTag,
+		},
+		{
+			name:      "unrecognized flag",
+			pkScripts: [][]byte{script(push(payload(u(types.TagFlags), uint128.From64(1).Lsh(types.FlagCenotaph), u(types.TagBody), u(1), u(1), u(2), u(0))))},
+			flaw:      types.FlawUnrecognizedFlag,
+		},
+		{
+			name:      "terms flag without etching flag",
+			pkScripts: [][]byte{script(push(payload(u(types.TagFlags), u(1<<types.FlagTerms))))},
+			flaw:      types.FlawUnrecognizedFlag,
+		},
+		{
+			name:      "edict id with zero block and non zero tx",
+			pkScripts: [][]byte{script(push(payload(u(types.TagBody), u(0), u(1), u(2), u(0))))},
+			flaw:      types.FlawEdictRuneId,
+		},
+		{
+			name:      "overflowing edict block delta",
+			pkScripts: [][]byte{script(push(payload(u(types.TagBody), u(1), u(0), u(0), u(0), maxUint64, u(0), u(0), u(0))))},
+			flaw:      types.FlawEdictRuneId,
+		},
+		{
+			name:      "overflowing edict tx delta",
+			pkScripts: [][]byte{script(push(payload(u(types.TagBody), u(1), u(1), u(0), u(0), u(0), u(math.MaxUint32), u(0), u(0))))},
+			flaw:      types.FlawEdictRuneId,
+		},
+		{
+			name:      "edict output greater than output count",
+			pkScripts: [][]byte{script(push(payload(u(types.TagBody), u(1), u(1), u(2), u(2))))},
+			flaw:      types.FlawEdictOutput,
+		},
+		{
+			name:      "edict output equal to output count",
+			pkScripts: [][]byte{script(push(payload(u(types.TagBody), u(1), u(1), u(2), u(1))))},
+			runestone: &types.Runestone{Edicts: []*types.Edict{{Id: &types.RuneId{Block: 1, Tx: 1}, Amount: "2", Output: 1}}},
+		},
+		{
+			name:      "tag with no value",
+			pkScripts: [][]byte{script(push(payload(u(types.TagFlags), u(1), u(types.TagFlags))))},
+			flaw:      types.FlawTruncatedField,
+		},
+		{
+			name:      "trailing integers in body",
+			pkScripts: [][]byte{script(push(payload(u(types.TagBody), u(1), u(1), u(2), u(0), u(1))))},
+			flaw:      types.FlawTrailingIntegers,
+		},
+		{
+			name:      "supply overflow",
+			pkScripts: [][]byte{script(push(payload(u(types.TagFlags), u(1<<types.FlagEtching|1<<types.FlagTerms), u(types.TagPremine), u(1), u(types.TagCap), uint128.Max, u(types.TagAmount), u(1))))},
+			flaw:      types.FlawSupplyOverflow,
+		},
+		{
+			name:      "mint",
+			pkScripts: [][]byte{script(push(payload(u(types.TagMint), u(1), u(types.TagMint), u(2))))},
+			runestone: &types.Runestone{Mint: &types.RuneId{Block: 1, Tx: 2}},
+		},
+		{
+			name:      "invalid mint id",
+			pkScripts: [][]byte{script(push(payload(u(types.TagMint), u(0), u(types.TagMint), u(1))))},
+			flaw:      types.FlawUnrecognizedEvenTag,
+		},
+		{
+			name:      "pointer out of range",
+			pkScripts: [][]byte{script(push(payload(u(types.TagPointer), u(1))))},
+			flaw:      types.FlawUnrecognizedEvenTag,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tx := wire.NewMsgTx(types.TxVersion)
+			for _, pkScript := range tc.pkScripts {
+				tx.AddTxOut(wire.NewTxOut(0, pkScript))
+			}
+
+			runestone, cenotaph := types.Decipher(tx)
+
+			if tc.flaw != types.FlawNone {
+				require.Nil(t, runestone)
+				require.NotNil(t, cenotaph)
+				require.Equal(t, tc.flaw, cenotaph.Flaw)
+
+				_, err := types.ParseRunestone(tx)
+				require.ErrorIs(t, err, types.ErrCenotaph)
+
+				return
+			}
+
+			require.Nil(t, cenotaph)
+
+			if tc.runestone == nil {
+				require.Nil(t, runestone)
+				return
+			}
+
+			if tc.runestone.Edicts == nil && runestone.Edicts != nil {
+				require.Empty(t, runestone.Edicts)
+				runestone.Edicts = nil
+			}
+
+			require.Equal(t, tc.runestone, runestone)
+		})
+	}
+}
+
+func TestEncipherEtching(t *testing.T) {
+	divisibility := uint8(2)
+	spacers := uint32(5)
+	symbol := 'ᚠ'
+	premine := uint128.From64(1000)
+	runeName := uint128.From64(99246114928149462)
+	amount := uint128.From64(100)
+	mintCap := uint128.From64(10)
+	heightStart := uint64(840000)
+	offsetEnd := uint64(1000)
+	pointer := uint32(0)
+
+	runestone := &types.Runestone{
+		Etching: &types.Etching{
+			Divisibility: &divisibility,
+			Premine:      &premine,
+			Rune:         &runeName,
+			Spacers:      &spacers,
+			Symbol:       &symbol,
+			Terms: &types.Terms{
+				Amount:      &amount,
+				Cap:         &mintCap,
+				HeightStart: &heightStart,
+				OffsetEnd:   &offsetEnd,
+			},
+			Turbo: true,
+		},
+		Mint:    &types.RuneId{Block: 840000, Tx: 3},
+		Pointer: &pointer,
+		Edicts: []*types.Edict{
+			{Id: &types.RuneId{Block: 840000, Tx: 3}, Amount: "1", Output: 1},
+		},
+	}
+
+	script, err := runestone.Encipher()
+	require.NoError(t, err)
+
+	tx := wire.NewMsgTx(types.TxVersion)
+	tx.AddTxOut(wire.NewTxOut(0, script))
+
+	decoded, cenotaph := types.Decipher(tx)
+	require.Nil(t, cenotaph)
+	require.Equal(t, runestone, decoded)
+	require.Equal(t, uint128.From64(2000), *decoded.Etching.Supply())
+
+	// the large payload is pushed in chunks
+	runestone = &types.Runestone{}
+	for i := uint32(0); i < 200; i++ {
+		runestone.Edicts = append(runestone.Edicts, &types.Edict{Id: &types.RuneId{Block: 840000, Tx: i}, Amount: "340282366920938463463374607431768211455", Output: 0})
+	}
+
+	script, err = runestone.Encipher()
+	require.NoError(t, err)
+
+	tx.TxOut[0].PkScript = script
+
+	decoded, cenotaph = types.Decipher(tx)
+	require.Nil(t, cenotaph)
+	require.Equal(t, runestone.Edicts, decoded.Edicts)
+}
@@ -1,12 +1,23 @@
 package types
 
 import (
+	"bytes"
+	"crypto/sha256"
+
 	secp256k1 "github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcec/v2/ecdsa"
 	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/btcutil"
 	"github.com/btcsuite/btcd/btcutil/psbt"
 	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// witness length of the tapscript input signed by the single key, i.e. signature, script and control block
+	TapscriptWitnessLen = 3
 )
 
 // VerifyPsbtSignatures verifies the signatures of the given psbt
@@ -41,11 +52,26 @@ func VerifyPsbtSignatures(p *psbt.Packet) bool {
 				return false
 			}
 
+		case txscript.IsPayToTaproot(prevOutput.PkScript) && isRecoveryWitness(signedTx.TxIn[i].Witness):
+			if !verifyRecoveryTapscriptSignatures(signedTx, i, prevOutput, prevOutputFetcher, hashType) {
+				return false
+			}
+
+		case txscript.IsPayToTaproot(prevOutput.PkScript) && len(signedTx.TxIn[i].Witness) == TapscriptWitnessLen:
+			if !verifyTapscriptSignature(signedTx, i, prevOutput, prevOutputFetcher, hashType) {
+				return false
+			}
+
 		case txscript.IsPayToTaproot(prevOutput.PkScript):
 			if !verifyTaprootSignature(signedTx, i, prevOutput, prevOutputFetcher, hashType) {
 				return false
 			}
 
+		case txscript.IsPayToWitnessScriptHash(prevOutput.PkScript), txscript.IsPayToScriptHash(prevOutput.PkScript):
+			if !verifyMultisigSignatures(signedTx, i, prevOutput, prevOutputFetcher, MultisigSigHashType) {
+				return false
+			}
+
 		default:
 			return false
 		}
@@ -87,6 +113,80 @@ func verifyWitnessSignature(tx *wire.MsgTx, idx int, prevOutput *wire.TxOut, pre
 	return sig.Verify(sigHash, pk)
 }
 
+// verifyMultisigSignatures verifies the signatures of the P2WSH or P2SH-wrapped P2WSH multisig input
+// The signatures are expected in the order of the public keys as OP_CHECKMULTISIG requires
+func verifyMultisigSignatures(tx *wire.MsgTx, idx int, prevOutput *wire.TxOut, prevOutputFetcher txscript.PrevOutputFetcher, hashType txscript.SigHashType) bool {
+	witness := tx.TxIn[idx].Witness
+	if len(witness) < 3 || len(witness[0]) != 0 {
+		return false
+	}
+
+	witnessScript := witness[len(witness)-1]
+
+	if txscript.IsPayToScriptHash(prevOutput.PkScript) {
+		redeemScript := NestedRedeemScript(witnessScript)
+
+		if !bytes.Equal(tx.TxIn[idx].SignatureScript, append([]byte{txscript.OP_DATA_34}, redeemScript...)) {
+			return false
+		}
+
+		if !bytes.Equal(btcutil.Hash160(redeemScript), prevOutput.PkScript[2:22]) {
+			return false
+		}
+	} else {
+		scriptHash := sha256.Sum256(witnessScript)
+		if !bytes.Equal(scriptHash[:], prevOutput.PkScript[2:34]) {
+			return false
+		}
+	}
+
+	class, addresses, numSigs, err := txscript.ExtractPkScriptAddrs(witnessScript, sdk.GetConfig().GetBtcChainCfg())
+	if err != nil || class != txscript.MultiSigTy {
+		return false
+	}
+
+	sigs := witness[1 : len(witness)-1]
+	if len(sigs) != numSigs {
+		return false
+	}
+
+	sigHash, err := txscript.CalcWitnessSigHash(witnessScript, txscript.NewTxSigHashes(tx, prevOutputFetcher),
+		hashType, tx, idx, prevOutput.Value)
+	if err != nil {
+		return false
+	}
+
+	keyIdx := 0
+
+	for _, sigBytes := range sigs {
+		if len(sigBytes) == 0 || sigBytes[len(sigBytes)-1] != byte(hashType) {
+			return false
+		}
+
+		sig, err := ecdsa.ParseDERSignature(sigBytes[0 : len(sigBytes)-1])
+		if err != nil {
+			return false
+		}
+
+		verified := false
+
+		for ; keyIdx < len(addresses) && !verified; keyIdx++ {
+			pk, ok := addresses[keyIdx].(*btcutil.AddressPubKey)
+			if !ok {
+				return false
+			}
+
+			verified = sig.Verify(sigHash, pk.PubKey())
+		}
+
+		if !verified {
+			return false
+		}
+	}
+
+	return true
+}
+
 // verifyTaprootSignature verifies the signature of the taproot input
 func verifyTaprootSignature(tx *wire.MsgTx, idx int, prevOutput *wire.TxOut, prevOutputFetcher txscript.PrevOutputFetcher, hashType txscript.SigHashType) bool {
 	witness := tx.TxIn[idx].Witness
@@ -122,3 +222,144 @@ func verifyTaprootSignature(tx *wire.MsgTx, idx int, prevOutput *wire.TxOut, pre
 
 	return sig.Verify(sigHash, pk)
 }
+
+// verifyTapscriptSignature verifies the signature of the taproot input spent by the script path
+// Only the tapscript which starts with <x-only public key> OP_CHECKSIG is supported
+func verifyTapscriptSignature(tx *wire.MsgTx, idx int, prevOutput *wire.TxOut, prevOutputFetcher txscript.PrevOutputFetcher, hashType txscript.SigHashType) bool {
+	witness := tx.TxIn[idx].Witness
+	if len(witness) != TapscriptWitnessLen || len(witness[0]) == 0 {
+		return false
+	}
+
+	sigBytes := witness[0]
+	script := witness[1]
+
+	if len(script) < 34 || script[0] != txscript.OP_DATA_32 || script[33] != txscript.OP_CHECKSIG {
+		return false
+	}
+
+	controlBlock, err := txscript.ParseControlBlock(witness[2])
+	if err != nil {
+		return false
+	}
+
+	if err := txscript.VerifyTaprootLeafCommitment(controlBlock, prevOutput.PkScript[2:34], script); err != nil {
+		return false
+	}
+
+	if hashType != txscript.SigHashDefault {
+		if sigBytes[len(sigBytes)-1] != byte(hashType) {
+			return false
+		}
+
+		sigBytes = sigBytes[0 : len(sigBytes)-1]
+	}
+
+	sig, err := schnorr.ParseSignature(sigBytes)
+	if err != nil {
+		return false
+	}
+
+	pk, err := schnorr.ParsePubKey(script[1:33])
+	if err != nil {
+		return false
+	}
+
+	// the internal key is expected to be the same as the signing key
+	if !bytes.Equal(schnorr.SerializePubKey(controlBlock.InternalKey), script[1:33]) {
+		return false
+	}
+
+	sigHash, err := txscript.CalcTapscriptSignaturehash(txscript.NewTxSigHashes(tx, prevOutputFetcher),
+		hashType, tx, idx, prevOutputFetcher, txscript.NewBaseTapLeaf(script))
+	if err != nil {
+		return false
+	}
+
+	return sig.Verify(sigHash, pk)
+}
+
+// isRecoveryWitness returns true if the given witness spends the timelocked recovery leaf, false otherwise
+func isRecoveryWitness(witness wire.TxWitness) bool {
+	if len(witness) < TapscriptWitnessLen {
+		return false
+	}
+
+	_, err := ParseRecoveryScript(witness[len(witness)-2])
+
+	return err == nil
+}
+
+// verifyRecoveryTapscriptSignatures verifies the signatures of the taproot input spent by the timelocked recovery leaf
+// The signatures are expected in the reverse order of the public keys and the empty signature is allowed for the absent key
+func verifyRecoveryTapscriptSignatures(tx *wire.MsgTx, idx int, prevOutput *wire.TxOut, prevOutputFetcher txscript.PrevOutputFetcher, hashType txscript.SigHashType) bool {
+	witness := tx.TxIn[idx].Witness
+	script := witness[len(witness)-2]
+
+	recoveryScript, err := ParseRecoveryScript(script)
+	if err != nil {
+		return false
+	}
+
+	sigs := witness[0 : len(witness)-2]
+	if len(sigs) != len(recoveryScript.PubKeys) {
+		return false
+	}
+
+	controlBlock, err := txscript.ParseControlBlock(witness[len(witness)-1])
+	if err != nil {
+		return false
+	}
+
+	if err := txscript.VerifyTaprootLeafCommitment(controlBlock, prevOutput.PkScript[2:34], script); err != nil {
+		return false
+	}
+
+	// check the relative timelock by BIP68
+	sequence := tx.TxIn[idx].Sequence
+	if tx.Version < 2 || sequence&wire.SequenceLockTimeDisabled != 0 || sequence&wire.SequenceLockTimeIsSeconds != 0 ||
+		sequence&wire.SequenceLockTimeMask < recoveryScript.Timelock {
+		return false
+	}
+
+	sigHash, err := txscript.CalcTapscriptSignaturehash(txscript.NewTxSigHashes(tx, prevOutputFetcher),
+		hashType, tx, idx, prevOutputFetcher, txscript.NewBaseTapLeaf(script))
+	if err != nil {
+		return false
+	}
+
+	validSigs := uint32(0)
+
+	for i, pkBytes := range recoveryScript.PubKeys {
+		sigBytes := sigs[len(sigs)-1-i]
+		if len(sigBytes) == 0 {
+			continue
+		}
+
+		if hashType != txscript.SigHashDefault {
+			if sigBytes[len(sigBytes)-1] != byte(hashType) {
+				return false
+			}
+
+			sigBytes = sigBytes[0 : len(sigBytes)-1]
+		}
+
+		sig, err := schnorr.ParseSignature(sigBytes)
+		if err != nil {
+			return false
+		}
+
+		pk, err := schnorr.ParsePubKey(pkBytes)
+		if err != nil {
+			return false
+		}
+
+		if !sig.Verify(sigHash, pk) {
+			return false
+		}
+
+		validSigs++
+	}
+
+	return validSigs == recoveryScript.Threshold
+}
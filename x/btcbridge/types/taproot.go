@@ -0,0 +1,361 @@
+package types
+
+import (
+	"bytes"
+	"encoding/hex"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/btcsuite/btcd/blockchain"
+	secp256k1 "github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/btcutil/psbt"
+	"github.com/btcsuite/btcd/mempool"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// maximum number of the public keys of the recovery script
+	MaxRecoveryPubKeys = 20
+
+	// maximum relative timelock in blocks by BIP68
+	MaxRecoveryTimelock = wire.SequenceLockTimeMask
+)
+
+// RecoveryScript defines the parsed timelocked recovery tapscript
+type RecoveryScript struct {
+	Timelock  uint32
+	PubKeys   [][]byte
+	Threshold uint32
+}
+
+// Validate validates the vault recovery policy
+func (p VaultRecoveryPolicy) Validate() error {
+	if !p.IsEnabled() {
+		if p.Threshold != 0 || p.Timelock != 0 {
+			return errorsmod.Wrap(ErrInvalidParams, "recovery public keys must be set for the threshold and timelock")
+		}
+
+		return nil
+	}
+
+	if len(p.PubKeys) > MaxRecoveryPubKeys {
+		return errorsmod.Wrapf(ErrInvalidParams, "number of recovery public keys must not be greater than %d", MaxRecoveryPubKeys)
+	}
+
+	if p.Threshold == 0 || p.Threshold > uint32(len(p.PubKeys)) {
+		return errorsmod.Wrap(ErrInvalidParams, "recovery threshold must be between 1 and the number of public keys")
+	}
+
+	if p.Timelock == 0 || p.Timelock > MaxRecoveryTimelock {
+		return errorsmod.Wrapf(ErrInvalidParams, "recovery timelock must be between 1 and %d", MaxRecoveryTimelock)
+	}
+
+	pubKeys := make(map[string]bool)
+
+	for _, pk := range p.PubKeys {
+		pkBytes, err := hex.DecodeString(pk)
+		if err != nil {
+			return errorsmod.Wrapf(ErrInvalidParams, "invalid recovery public key %s", pk)
+		}
+
+		if _, err := schnorr.ParsePubKey(pkBytes); err != nil {
+			return errorsmod.Wrapf(ErrInvalidParams, "invalid recovery public key %s", pk)
+		}
+
+		if pubKeys[pk] {
+			return errorsmod.Wrap(ErrInvalidParams, "duplicate recovery public key")
+		}
+
+		pubKeys[pk] = true
+	}
+
+	return nil
+}
+
+// IsEnabled returns true if the recovery script path is enabled, false otherwise
+func (p VaultRecoveryPolicy) IsEnabled() bool {
+	return len(p.PubKeys) > 0
+}
+
+// TaprootTree builds the vault taproot script tree from the recovery policy
+// Make sure that the policy is valid and enabled
+func (p VaultRecoveryPolicy) TaprootTree() (*VaultTaprootTree, error) {
+	pubKeys := make([][]byte, 0, len(p.PubKeys))
+
+	for _, pk := range p.PubKeys {
+		pkBytes, err := hex.DecodeString(pk)
+		if err != nil {
+			return nil, err
+		}
+
+		pubKeys = append(pubKeys, pkBytes)
+	}
+
+	script, err := BuildRecoveryScript(pubKeys, p.Threshold, p.Timelock)
+	if err != nil {
+		return nil, err
+	}
+
+	return &VaultTaprootTree{
+		RecoveryScript: hex.EncodeToString(script),
+	}, nil
+}
+
+// BuildRecoveryScript builds the timelocked recovery tapscript
+// Single key: <timelock> OP_CHECKSEQUENCEVERIFY OP_DROP <pk> OP_CHECKSIG
+// Multiple keys: <timelock> OP_CHECKSEQUENCEVERIFY OP_DROP <pk1> OP_CHECKSIG <pk2> OP_CHECKSIGADD ... <m> OP_NUMEQUAL
+func BuildRecoveryScript(pubKeys [][]byte, threshold uint32, timelock uint32) ([]byte, error) {
+	builder := txscript.NewScriptBuilder()
+
+	builder.AddInt64(int64(timelock))
+	builder.AddOp(txscript.OP_CHECKSEQUENCEVERIFY)
+	builder.AddOp(txscript.OP_DROP)
+
+	for i, pk := range pubKeys {
+		builder.AddData(pk)
+
+		if i == 0 {
+			builder.AddOp(txscript.OP_CHECKSIG)
+		} else {
+			builder.AddOp(txscript.OP_CHECKSIGADD)
+		}
+	}
+
+	if len(pubKeys) > 1 {
+		builder.AddInt64(int64(threshold))
+		builder.AddOp(txscript.OP_NUMEQUAL)
+	}
+
+	return builder.Script()
+}
+
+// ParseRecoveryScript parses the given timelocked recovery tapscript
+// Only the canonical script built by BuildRecoveryScript is accepted
+func ParseRecoveryScript(script []byte) (*RecoveryScript, error) {
+	tokenizer := txscript.MakeScriptTokenizer(0, script)
+
+	if !tokenizer.Next() {
+		return nil, ErrInvalidRecoveryScript
+	}
+
+	timelock, ok := parseScriptInt(tokenizer.Opcode(), tokenizer.Data())
+	if !ok || timelock == 0 || timelock > MaxRecoveryTimelock {
+		return nil, ErrInvalidRecoveryScript
+	}
+
+	pubKeys := [][]byte{}
+	threshold := uint32(1)
+
+	for tokenizer.Next() {
+		data := tokenizer.Data()
+		if len(data) == schnorr.PubKeyBytesLen {
+			pubKeys = append(pubKeys, data)
+			continue
+		}
+
+		// the last but one token is the threshold for multiple keys
+		if len(pubKeys) > 1 {
+			if value, ok := parseScriptInt(tokenizer.Opcode(), data); ok {
+				threshold = value
+			}
+		}
+	}
+
+	if tokenizer.Err() != nil || len(pubKeys) == 0 || len(pubKeys) > MaxRecoveryPubKeys || threshold == 0 || threshold > uint32(len(pubKeys)) {
+		return nil, ErrInvalidRecoveryScript
+	}
+
+	expectedScript, err := BuildRecoveryScript(pubKeys, threshold, timelock)
+	if err != nil || !bytes.Equal(expectedScript, script) {
+		return nil, ErrInvalidRecoveryScript
+	}
+
+	return &RecoveryScript{
+		Timelock:  timelock,
+		PubKeys:   pubKeys,
+		Threshold: threshold,
+	}, nil
+}
+
+// InternalKey returns the taproot internal key of the vault
+func (v *Vault) InternalKey() (*secp256k1.PublicKey, error) {
+	pkBytes, err := hex.DecodeString(v.PubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(pkBytes) == schnorr.PubKeyBytesLen {
+		return schnorr.ParsePubKey(pkBytes)
+	}
+
+	return secp256k1.ParsePubKey(pkBytes)
+}
+
+// RecoveryScriptBytes returns the recovery tapscript of the taproot tree
+func (t *VaultTaprootTree) RecoveryScriptBytes() ([]byte, error) {
+	return hex.DecodeString(t.RecoveryScript)
+}
+
+// Validate validates the taproot tree
+func (t *VaultTaprootTree) Validate() error {
+	script, err := t.RecoveryScriptBytes()
+	if err != nil {
+		return ErrInvalidRecoveryScript
+	}
+
+	_, err = ParseRecoveryScript(script)
+
+	return err
+}
+
+// Address derives the vault address from the given internal key and the taproot tree
+func (t *VaultTaprootTree) Address(internalKey *secp256k1.PublicKey) (string, error) {
+	tree, _, err := t.assemble()
+	if err != nil {
+		return "", err
+	}
+
+	rootHash := tree.RootNode.TapHash()
+	outputKey := txscript.ComputeTaprootOutputKey(internalKey, rootHash[:])
+
+	address, err := btcutil.NewAddressTaproot(schnorr.SerializePubKey(outputKey), sdk.GetConfig().GetBtcChainCfg())
+	if err != nil {
+		return "", err
+	}
+
+	return address.EncodeAddress(), nil
+}
+
+// ControlBlock returns the serialized control block of the recovery leaf for the given internal key
+func (t *VaultTaprootTree) ControlBlock(internalKey *secp256k1.PublicKey) ([]byte, error) {
+	tree, _, err := t.assemble()
+	if err != nil {
+		return nil, err
+	}
+
+	controlBlock := tree.LeafMerkleProofs[0].ToControlBlock(internalKey)
+
+	return controlBlock.ToBytes()
+}
+
+// assemble assembles the taproot script tree
+func (t *VaultTaprootTree) assemble() (*txscript.IndexedTapScriptTree, []byte, error) {
+	script, err := t.RecoveryScriptBytes()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return txscript.AssembleTaprootScriptTree(txscript.NewBaseTapLeaf(script)), script, nil
+}
+
+// BuildVaultRecoveryPsbt builds the psbt to transfer all the given vault utxos to the recipient through the recovery script path
+// The input sequence is set to the relative timelock of the recovery script
+func BuildVaultRecoveryPsbt(utxos []*UTXO, tree *VaultTaprootTree, internalKey *secp256k1.PublicKey, recipient string, feeRate int64) (*psbt.Packet, error) {
+	script, err := tree.RecoveryScriptBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	recoveryScript, err := ParseRecoveryScript(script)
+	if err != nil {
+		return nil, err
+	}
+
+	controlBlock, err := tree.ControlBlock(internalKey)
+	if err != nil {
+		return nil, err
+	}
+
+	recipientAddr, err := btcutil.DecodeAddress(recipient, sdk.GetConfig().GetBtcChainCfg())
+	if err != nil {
+		return nil, err
+	}
+
+	recipientPkScript, err := txscript.PayToAddrScript(recipientAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	tx := wire.NewMsgTx(TxVersion)
+
+	amount := int64(0)
+	for _, utxo := range utxos {
+		AddUTXOToTx(tx, utxo)
+		tx.TxIn[len(tx.TxIn)-1].Sequence = recoveryScript.Timelock
+
+		amount += int64(utxo.Amount)
+	}
+
+	tx.AddTxOut(wire.NewTxOut(amount, recipientPkScript))
+
+	dummyTx := populateTxWithDummyRecoveryWitness(tx, script, controlBlock, len(recoveryScript.PubKeys))
+
+	if blockchain.GetTransactionWeight(btcutil.NewTx(dummyTx)) > MaxTransactionWeight {
+		return nil, ErrMaxTransactionWeightExceeded
+	}
+
+	tx.TxOut[0].Value = amount - mempool.GetTxVirtualSize(btcutil.NewTx(dummyTx))*feeRate
+	if tx.TxOut[0].Value <= 0 || IsDustOut(tx.TxOut[0]) {
+		return nil, ErrInsufficientUTXOs
+	}
+
+	p, err := psbt.NewFromUnsignedTx(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, utxo := range utxos {
+		p.Inputs[i].SighashType = DefaultSigHashType
+		p.Inputs[i].WitnessUtxo = wire.NewTxOut(int64(utxo.Amount), utxo.PubKeyScript)
+		p.Inputs[i].TaprootInternalKey = schnorr.SerializePubKey(internalKey)
+		p.Inputs[i].TaprootLeafScript = []*psbt.TaprootTapLeafScript{
+			{
+				ControlBlock: controlBlock,
+				Script:       script,
+				LeafVersion:  txscript.BaseLeafVersion,
+			},
+		}
+	}
+
+	return p, nil
+}
+
+// populateTxWithDummyRecoveryWitness populates the given tx with the dummy witness of the recovery script path
+// The signature slot is reserved for each public key
+func populateTxWithDummyRecoveryWitness(tx *wire.MsgTx, script []byte, controlBlock []byte, pubKeyNum int) *wire.MsgTx {
+	newTx := tx.Copy()
+
+	for _, txIn := range newTx.TxIn {
+		witness := wire.TxWitness{}
+		for i := 0; i < pubKeyNum; i++ {
+			// maximum size when the sig hash is not SigHashDefault
+			witness = append(witness, make([]byte, 65))
+		}
+
+		txIn.Witness = append(witness, script, controlBlock)
+	}
+
+	return newTx
+}
+
+// parseScriptInt parses the small non-negative script integer from the given opcode and data
+func parseScriptInt(opcode byte, data []byte) (uint32, bool) {
+	if opcode >= txscript.OP_1 && opcode <= txscript.OP_16 {
+		return uint32(opcode - (txscript.OP_1 - 1)), true
+	}
+
+	if len(data) == 0 || len(data) > 4 || data[len(data)-1]&0x80 != 0 {
+		return 0, false
+	}
+
+	value := uint32(0)
+	for i, b := range data {
+		value |= uint32(b) << (8 * i)
+	}
+
+	return value, true
+}
@@ -0,0 +1,206 @@
+package types_test
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	secp256k1 "github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/btcutil/psbt"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sideprotocol/side/x/btcbridge/types"
+)
+
+func TestVaultRecoveryPolicyValidate(t *testing.T) {
+	_, pubKeys := generateRecoveryKeys(t, 3)
+
+	testCases := []struct {
+		name      string
+		policy    types.VaultRecoveryPolicy
+		expectErr bool
+	}{
+		{"disabled", types.VaultRecoveryPolicy{}, false},
+		{"valid single key", types.VaultRecoveryPolicy{PubKeys: pubKeys[:1], Threshold: 1, Timelock: 144}, false},
+		{"valid multisig", types.VaultRecoveryPolicy{PubKeys: pubKeys, Threshold: 2, Timelock: 4320}, false},
+		{"timelock without keys", types.VaultRecoveryPolicy{Timelock: 144}, true},
+		{"zero threshold", types.VaultRecoveryPolicy{PubKeys: pubKeys, Timelock: 144}, true},
+		{"threshold greater than keys", types.VaultRecoveryPolicy{PubKeys: pubKeys, Threshold: 4, Timelock: 144}, true},
+		{"zero timelock", types.VaultRecoveryPolicy{PubKeys: pubKeys, Threshold: 2}, true},
+		{"timelock too large", types.VaultRecoveryPolicy{PubKeys: pubKeys, Threshold: 2, Timelock: 0x10000}, true},
+		{"duplicate keys", types.VaultRecoveryPolicy{PubKeys: []string{pubKeys[0], pubKeys[0]}, Threshold: 1, Timelock: 144}, true},
+		{"compressed key", types.VaultRecoveryPolicy{PubKeys: []string{"02" + pubKeys[0]}, Threshold: 1, Timelock: 144}, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.policy.Validate()
+			if tc.expectErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestParseRecoveryScript(t *testing.T) {
+	_, pubKeys := generateRecoveryKeys(t, 3)
+
+	for _, policy := range []types.VaultRecoveryPolicy{
+		{PubKeys: pubKeys[:1], Threshold: 1, Timelock: 1},
+		{PubKeys: pubKeys[:1], Threshold: 1, Timelock: 0xFFFF},
+		{PubKeys: pubKeys, Threshold: 2, Timelock: 144},
+		{PubKeys: pubKeys, Threshold: 3, Timelock: 0x8000},
+	} {
+		tree, err := policy.TaprootTree()
+		require.NoError(t, err)
+		require.NoError(t, tree.Validate())
+
+		script, err := tree.RecoveryScriptBytes()
+		require.NoError(t, err)
+
+		recoveryScript, err := types.ParseRecoveryScript(script)
+		require.NoError(t, err)
+		require.Equal(t, policy.Timelock, recoveryScript.Timelock)
+		require.Equal(t, policy.Threshold, recoveryScript.Threshold)
+		require.Len(t, recoveryScript.PubKeys, len(policy.PubKeys))
+	}
+
+	// non-canonical script
+	pk, _ := hex.DecodeString(pubKeys[0])
+	script, err := txscript.NewScriptBuilder().AddData(pk).AddOp(txscript.OP_CHECKSIG).Script()
+	require.NoError(t, err)
+
+	_, err = types.ParseRecoveryScript(script)
+	require.ErrorIs(t, err, types.ErrInvalidRecoveryScript)
+}
+
+func TestVerifyRecoveryPsbtSignatures(t *testing.T) {
+	privKeys, pubKeys := generateRecoveryKeys(t, 3)
+
+	policy := types.VaultRecoveryPolicy{PubKeys: pubKeys, Threshold: 2, Timelock: 144}
+
+	tree, err := policy.TaprootTree()
+	require.NoError(t, err)
+
+	internalPrivKey, err := secp256k1.NewPrivateKey()
+	require.NoError(t, err)
+
+	internalKey := internalPrivKey.PubKey()
+
+	vaultAddress, err := tree.Address(internalKey)
+	require.NoError(t, err)
+
+	vault := &types.Vault{Address: vaultAddress, PubKey: hex.EncodeToString(internalKey.SerializeCompressed()), AssetType: types.AssetType_ASSET_TYPE_BTC, TaprootTree: tree}
+
+	params := types.NewParams()
+	params.Vaults = []*types.Vault{vault}
+	require.NoError(t, params.Validate(), "vault address should match the taproot tree")
+
+	vaultAddr, err := btcutil.DecodeAddress(vaultAddress, sdk.GetConfig().GetBtcChainCfg())
+	require.NoError(t, err)
+
+	vaultPkScript, err := txscript.PayToAddrScript(vaultAddr)
+	require.NoError(t, err)
+
+	utxos := []*types.UTXO{
+		{Txid: chainhash.Hash{1}.String(), Vout: 0, Address: vaultAddress, Amount: 100000, PubKeyScript: vaultPkScript},
+		{Txid: chainhash.Hash{2}.String(), Vout: 1, Address: vaultAddress, Amount: 200000, PubKeyScript: vaultPkScript},
+	}
+
+	p, err := types.BuildVaultRecoveryPsbt(utxos, tree, internalKey, testTaprootAddress(1), 10)
+	require.NoError(t, err)
+
+	for _, txIn := range p.UnsignedTx.TxIn {
+		require.Equal(t, policy.Timelock, txIn.Sequence)
+	}
+
+	signRecoveryPsbt := func(p *psbt.Packet, signers []*secp256k1.PrivateKey) *psbt.Packet {
+		p = clonePsbt(t, p)
+
+		prevOutFetcher := txscript.NewMultiPrevOutFetcher(nil)
+		for i, txIn := range p.UnsignedTx.TxIn {
+			prevOutFetcher.AddPrevOut(txIn.PreviousOutPoint, p.Inputs[i].WitnessUtxo)
+		}
+
+		for i := range p.Inputs {
+			leafScript := p.Inputs[i].TaprootLeafScript[0]
+			tapLeaf := txscript.NewBaseTapLeaf(leafScript.Script)
+
+			witness := wire.TxWitness{}
+			for j := len(privKeys) - 1; j >= 0; j-- {
+				sig := []byte{}
+
+				for _, signer := range signers {
+					if signer == privKeys[j] {
+						sig, err = txscript.RawTxInTapscriptSignature(p.UnsignedTx, txscript.NewTxSigHashes(p.UnsignedTx, prevOutFetcher), i,
+							p.Inputs[i].WitnessUtxo.Value, p.Inputs[i].WitnessUtxo.PkScript, tapLeaf, types.DefaultSigHashType, signer)
+						require.NoError(t, err)
+					}
+				}
+
+				witness = append(witness, sig)
+			}
+
+			witness = append(witness, leafScript.Script, leafScript.ControlBlock)
+
+			var buf bytes.Buffer
+			require.NoError(t, psbt.WriteTxWitness(&buf, witness))
+
+			p.Inputs[i].FinalScriptWitness = buf.Bytes()
+		}
+
+		return p
+	}
+
+	require.True(t, types.VerifyPsbtSignatures(signRecoveryPsbt(p, privKeys[:2])), "2-of-3 recovery signatures should be valid")
+	require.True(t, types.VerifyPsbtSignatures(signRecoveryPsbt(p, []*secp256k1.PrivateKey{privKeys[0], privKeys[2]})), "2-of-3 recovery signatures should be valid")
+	require.False(t, types.VerifyPsbtSignatures(signRecoveryPsbt(p, privKeys[:1])), "recovery signatures below the threshold should be invalid")
+
+	// the relative timelock is not satisfied
+	immature := clonePsbt(t, p)
+	immature.UnsignedTx.TxIn[0].Sequence = policy.Timelock - 1
+	require.False(t, types.VerifyPsbtSignatures(signRecoveryPsbt(immature, privKeys[:2])), "recovery spend before the timelock should be invalid")
+
+	// the internal key does not match the vault
+	otherPrivKey, err := secp256k1.NewPrivateKey()
+	require.NoError(t, err)
+
+	mismatched, err := types.BuildVaultRecoveryPsbt(utxos, tree, otherPrivKey.PubKey(), testTaprootAddress(1), 10)
+	require.NoError(t, err)
+	require.False(t, types.VerifyPsbtSignatures(signRecoveryPsbt(mismatched, privKeys[:2])), "recovery spend with the mismatched internal key should be invalid")
+}
+
+func generateRecoveryKeys(t *testing.T, n int) ([]*secp256k1.PrivateKey, []string) {
+	privKeys := make([]*secp256k1.PrivateKey, n)
+	pubKeys := make([]string, n)
+
+	for i := 0; i < n; i++ {
+		privKey, err := secp256k1.NewPrivateKey()
+		require.NoError(t, err)
+
+		privKeys[i] = privKey
+		pubKeys[i] = hex.EncodeToString(schnorr.SerializePubKey(privKey.PubKey()))
+	}
+
+	return privKeys, pubKeys
+}
+
+func clonePsbt(t *testing.T, p *psbt.Packet) *psbt.Packet {
+	var buf bytes.Buffer
+	require.NoError(t, p.Serialize(&buf))
+
+	cloned, err := psbt.NewFromRawBytes(&buf, false)
+	require.NoError(t, err)
+
+	return cloned
+}
@@ -6,10 +6,18 @@ import (
 	"encoding/binary"
 	"encoding/hex"
 	"reflect"
+	"slices"
 	"strings"
 
+	secp256k1 "github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/txscript"
 	"github.com/cometbft/cometbft/crypto"
 	"github.com/cometbft/cometbft/crypto/tmhash"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // MustGetConsensusAddr gets the hex-encoded consensus address from the given consensus public key
@@ -34,16 +42,14 @@ func ParticipantExists(participants []*DKGParticipant, consAddress string) bool
 	return false
 }
 
-// CheckDKGCompletionRequests checks if the vaults of all the DKG completion requests are same
+// CheckDKGCompletionRequests checks if the vaults and public keys of all the DKG completion requests are same
 func CheckDKGCompletionRequests(requests []*DKGCompletionRequest) bool {
 	if len(requests) == 0 {
 		return false
 	}
 
-	vaults := requests[0].Vaults
-
 	for _, req := range requests[1:] {
-		if !reflect.DeepEqual(req.Vaults, vaults) {
+		if !dkgCompletionRequestsAgree(req, requests[0]) {
 			return false
 		}
 	}
@@ -51,6 +57,68 @@ func CheckDKGCompletionRequests(requests []*DKGCompletionRequest) bool {
 	return true
 }
 
+// SelectAgreedDKGCompletionVaults selects the vaults and public keys agreed by the most DKG completion requests
+// The agreeing completion requests are returned as well
+func SelectAgreedDKGCompletionVaults(requests []*DKGCompletionRequest) ([]string, []*DKGCompletionRequest) {
+	var vaults []string
+	var agreeing []*DKGCompletionRequest
+
+	for _, req := range requests {
+		candidates := []*DKGCompletionRequest{}
+
+		for _, r := range requests {
+			if dkgCompletionRequestsAgree(r, req) {
+				candidates = append(candidates, r)
+			}
+		}
+
+		// the first one wins if tied
+		if len(candidates) > len(agreeing) {
+			vaults = req.Vaults
+			agreeing = candidates
+		}
+	}
+
+	return vaults, agreeing
+}
+
+// dkgCompletionRequestsAgree returns true if the given DKG completion requests agree on the vaults, public keys and verification shares, false otherwise
+// The proofs are not required to be same
+func dkgCompletionRequestsAgree(a *DKGCompletionRequest, b *DKGCompletionRequest) bool {
+	return reflect.DeepEqual(a.Vaults, b.Vaults) && reflect.DeepEqual(a.PubKeys, b.PubKeys) &&
+		reflect.DeepEqual(a.VerificationShares, b.VerificationShares)
+}
+
+// GetDKGNonResponders gets the participants which did not submit the DKG completion requests
+func GetDKGNonResponders(participants []*DKGParticipant, requests []*DKGCompletionRequest) []*DKGParticipant {
+	responders := make(map[string]bool)
+	for _, req := range requests {
+		responders[strings.ToLower(req.ConsensusAddress)] = true
+	}
+
+	nonResponders := []*DKGParticipant{}
+	for _, p := range participants {
+		if !responders[MustGetConsensusAddr(p.ConsensusPubkey)] {
+			nonResponders = append(nonResponders, p)
+		}
+	}
+
+	return nonResponders
+}
+
+// ExcludeDKGParticipants returns the participants excluding the given ones
+func ExcludeDKGParticipants(participants []*DKGParticipant, excluded []*DKGParticipant) []*DKGParticipant {
+	result := []*DKGParticipant{}
+
+	for _, p := range participants {
+		if !slices.ContainsFunc(excluded, func(e *DKGParticipant) bool { return e.ConsensusPubkey == p.ConsensusPubkey }) {
+			result = append(result, p)
+		}
+	}
+
+	return result
+}
+
 // VerifySignature verifies the given signature against the given DKG completion request
 func VerifySignature(signature string, pubKey []byte, req *DKGCompletionRequest) bool {
 	sig, err := hex.DecodeString(signature)
@@ -64,6 +132,7 @@ func VerifySignature(signature string, pubKey []byte, req *DKGCompletionRequest)
 }
 
 // GetSigMsgFromDKGCompletionReq gets the msg to be signed from the given DKG completion request
+// The msg commits to the vaults, public keys, proofs and verification shares
 func GetSigMsgFromDKGCompletionReq(req *DKGCompletionRequest) []byte {
 	rawMsg := make([]byte, 8)
 	binary.BigEndian.PutUint64(rawMsg, req.Id)
@@ -72,5 +141,164 @@ func GetSigMsgFromDKGCompletionReq(req *DKGCompletionRequest) []byte {
 		rawMsg = append(rawMsg, []byte(v)...)
 	}
 
+	for _, pk := range req.PubKeys {
+		rawMsg = append(rawMsg, []byte(pk)...)
+	}
+
+	for _, proof := range req.Proofs {
+		rawMsg = append(rawMsg, []byte(proof)...)
+	}
+
+	// the verification shares are length prefixed per vault to avoid ambiguity
+	for _, vs := range req.VerificationShares {
+		rawMsg = binary.BigEndian.AppendUint32(rawMsg, uint32(len(vs.Shares)))
+
+		for _, share := range vs.Shares {
+			rawMsg = append(rawMsg, []byte(share)...)
+		}
+	}
+
+	return crypto.Sha256(rawMsg)
+}
+
+// VerifyDKGVaultKeys verifies the aggregate public keys and proofs of the given DKG completion request
+// The taproot vaults commit to the given taproot tree if any
+func VerifyDKGVaultKeys(req *DKGCompletionRequest, taprootTree *VaultTaprootTree) error {
+	if len(req.PubKeys) != len(req.Vaults) || len(req.Proofs) != len(req.Vaults) {
+		return errorsmod.Wrap(ErrInvalidVaultKey, "mismatched vault keys")
+	}
+
+	for i, v := range req.Vaults {
+		if err := VerifyVaultKey(req.Id, v, req.PubKeys[i], req.Proofs[i], taprootTree); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// VerifyDKGVerificationShares verifies the FROST verification shares of the given DKG completion request
+// The verification shares are optional and only supported for the taproot vaults
+func VerifyDKGVerificationShares(req *DKGCompletionRequest, participantNum int, threshold uint32) error {
+	if len(req.VerificationShares) == 0 {
+		return nil
+	}
+
+	if len(req.VerificationShares) != len(req.Vaults) || len(req.PubKeys) != len(req.Vaults) {
+		return errorsmod.Wrap(ErrInvalidVerificationShares, "mismatched verification shares")
+	}
+
+	for i, v := range req.Vaults {
+		shares := req.VerificationShares[i].Shares
+		if len(shares) == 0 {
+			continue
+		}
+
+		if len(shares) != participantNum {
+			return errorsmod.Wrap(ErrInvalidVerificationShares, "mismatched participants")
+		}
+
+		addr, err := btcutil.DecodeAddress(v, sdk.GetConfig().GetBtcChainCfg())
+		if err != nil {
+			return errorsmod.Wrap(ErrInvalidVerificationShares, "invalid vault address")
+		}
+
+		if _, ok := addr.(*btcutil.AddressTaproot); !ok {
+			return errorsmod.Wrap(ErrInvalidVerificationShares, "non taproot vault")
+		}
+
+		pubKey, err := (&Vault{PubKey: req.PubKeys[i]}).InternalKey()
+		if err != nil {
+			return errorsmod.Wrap(ErrInvalidVerificationShares, "invalid public key")
+		}
+
+		if _, err := VerifyVerificationShares(pubKey, shares, threshold); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// VerifyVaultKey verifies that the given vault address is derived from the given aggregate public key
+// and the proof is the valid schnorr signature by the aggregate public key
+func VerifyVaultKey(id uint64, vault string, pubKey string, proof string, taprootTree *VaultTaprootTree) error {
+	key, err := (&Vault{PubKey: pubKey}).InternalKey()
+	if err != nil {
+		return errorsmod.Wrap(ErrInvalidVaultKey, "invalid public key")
+	}
+
+	address, err := DeriveVaultAddress(vault, key, taprootTree)
+	if err != nil {
+		return err
+	}
+
+	if address != vault {
+		return errorsmod.Wrapf(ErrInvalidVaultKey, "address mismatch; expected %s, derived %s", vault, address)
+	}
+
+	sigBytes, err := hex.DecodeString(proof)
+	if err != nil {
+		return errorsmod.Wrap(ErrInvalidVaultKey, "invalid proof")
+	}
+
+	sig, err := schnorr.ParseSignature(sigBytes)
+	if err != nil {
+		return errorsmod.Wrap(ErrInvalidVaultKey, "invalid proof")
+	}
+
+	if !sig.Verify(GetVaultKeyProofMsg(id, vault, pubKey), key) {
+		return errorsmod.Wrap(ErrInvalidVaultKey, "invalid proof signature")
+	}
+
+	return nil
+}
+
+// DeriveVaultAddress derives the address of the same type as the given vault from the given public key
+// Only P2TR and P2WPKH vaults can be derived from the aggregate public key
+func DeriveVaultAddress(vault string, pubKey *secp256k1.PublicKey, taprootTree *VaultTaprootTree) (string, error) {
+	chainCfg := sdk.GetConfig().GetBtcChainCfg()
+
+	addr, err := btcutil.DecodeAddress(vault, chainCfg)
+	if err != nil {
+		return "", errorsmod.Wrap(ErrInvalidVaultKey, "invalid vault address")
+	}
+
+	switch addr.(type) {
+	case *btcutil.AddressTaproot:
+		if taprootTree != nil {
+			return taprootTree.Address(pubKey)
+		}
+
+		outputKey := txscript.ComputeTaprootKeyNoScript(pubKey)
+
+		address, err := btcutil.NewAddressTaproot(schnorr.SerializePubKey(outputKey), chainCfg)
+		if err != nil {
+			return "", err
+		}
+
+		return address.EncodeAddress(), nil
+
+	case *btcutil.AddressWitnessPubKeyHash:
+		address, err := btcutil.NewAddressWitnessPubKeyHash(btcutil.Hash160(pubKey.SerializeCompressed()), chainCfg)
+		if err != nil {
+			return "", err
+		}
+
+		return address.EncodeAddress(), nil
+
+	default:
+		return "", errorsmod.Wrap(ErrInvalidVaultKey, "unsupported vault address type")
+	}
+}
+
+// GetVaultKeyProofMsg gets the msg to be signed by the aggregate public key as the proof of possession
+func GetVaultKeyProofMsg(id uint64, vault string, pubKey string) []byte {
+	rawMsg := make([]byte, 8)
+	binary.BigEndian.PutUint64(rawMsg, id)
+
+	rawMsg = append(rawMsg, []byte(vault)...)
+	rawMsg = append(rawMsg, []byte(strings.ToLower(pubKey))...)
+
 	return crypto.Sha256(rawMsg)
 }
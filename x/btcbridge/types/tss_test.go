@@ -0,0 +1,103 @@
+package types_test
+
+import (
+	"crypto/ed25519"
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	secp256k1 "github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/txscript"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sideprotocol/side/x/btcbridge/types"
+)
+
+func TestVerifyVaultKey(t *testing.T) {
+	chainCfg := sdk.GetConfig().GetBtcChainCfg()
+
+	privKeys, _ := generateRecoveryKeys(t, 2)
+	privKey := privKeys[0]
+
+	_, recoveryPubKeys := generateRecoveryKeys(t, 1)
+	tree, err := types.VaultRecoveryPolicy{PubKeys: recoveryPubKeys, Threshold: 1, Timelock: 144}.TaprootTree()
+	require.NoError(t, err)
+
+	xOnlyPubKey := hex.EncodeToString(schnorr.SerializePubKey(privKey.PubKey()))
+	compressedPubKey := hex.EncodeToString(privKey.PubKey().SerializeCompressed())
+
+	p2trAddr, err := btcutil.NewAddressTaproot(schnorr.SerializePubKey(txscript.ComputeTaprootKeyNoScript(privKey.PubKey())), chainCfg)
+	require.NoError(t, err)
+
+	p2trWithTreeAddr, err := tree.Address(privKey.PubKey())
+	require.NoError(t, err)
+
+	p2wpkhAddr, err := btcutil.NewAddressWitnessPubKeyHash(btcutil.Hash160(privKey.PubKey().SerializeCompressed()), chainCfg)
+	require.NoError(t, err)
+
+	p2pkhAddr, err := btcutil.NewAddressPubKeyHash(btcutil.Hash160(privKey.PubKey().SerializeCompressed()), chainCfg)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name        string
+		vault       string
+		pubKey      string
+		signer      *secp256k1.PrivateKey
+		id          uint64
+		taprootTree *types.VaultTaprootTree
+		expectErr   bool
+	}{
+		{"p2tr", p2trAddr.EncodeAddress(), xOnlyPubKey, privKey, 1, nil, false},
+		{"p2tr with compressed key", p2trAddr.EncodeAddress(), compressedPubKey, privKey, 1, nil, false},
+		{"p2tr with taproot tree", p2trWithTreeAddr, xOnlyPubKey, privKey, 1, tree, false},
+		{"p2tr without committed taproot tree", p2trAddr.EncodeAddress(), xOnlyPubKey, privKey, 1, tree, true},
+		{"p2wpkh", p2wpkhAddr.EncodeAddress(), compressedPubKey, privKey, 1, nil, false},
+		{"unsupported address type", p2pkhAddr.EncodeAddress(), compressedPubKey, privKey, 1, nil, true},
+		{"invalid public key", p2trAddr.EncodeAddress(), "02", privKey, 1, nil, true},
+		{"mismatched public key", p2trAddr.EncodeAddress(), hex.EncodeToString(schnorr.SerializePubKey(privKeys[1].PubKey())), privKeys[1], 1, nil, true},
+		{"proof by another key", p2trAddr.EncodeAddress(), xOnlyPubKey, privKeys[1], 1, nil, true},
+		{"proof for another request", p2trAddr.EncodeAddress(), xOnlyPubKey, privKey, 2, nil, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			proof, err := schnorr.Sign(tc.signer, types.GetVaultKeyProofMsg(tc.id, tc.vault, tc.pubKey))
+			require.NoError(t, err)
+
+			err = types.VerifyVaultKey(1, tc.vault, tc.pubKey, hex.EncodeToString(proof.Serialize()), tc.taprootTree)
+			if tc.expectErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestVerifySignature(t *testing.T) {
+	pubKey, privKey, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+
+	req := &types.DKGCompletionRequest{
+		Id:                 1,
+		Vaults:             []string{"vault"},
+		PubKeys:            []string{"pubkey"},
+		Proofs:             []string{"proof"},
+		VerificationShares: []types.VerificationShares{{Shares: []string{"share1", "share2"}}},
+	}
+
+	req.Signature = hex.EncodeToString(ed25519.Sign(privKey, types.GetSigMsgFromDKGCompletionReq(req)))
+	require.True(t, types.VerifySignature(req.Signature, pubKey, req))
+
+	forgedShares := *req
+	forgedShares.VerificationShares = []types.VerificationShares{{Shares: []string{"share1", "forged"}}}
+	require.False(t, types.VerifySignature(req.Signature, pubKey, &forgedShares), "forged verification shares should be rejected")
+
+	forgedProofs := *req
+	forgedProofs.Proofs = []string{"forged"}
+	require.False(t, types.VerifySignature(req.Signature, pubKey, &forgedProofs), "forged proofs should be rejected")
+}
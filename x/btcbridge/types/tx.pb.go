@@ -10,18 +10,22 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -622,6 +626,11 @@ type MsgWithdrawToBitcoin struct {
 	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
 	// withdraw amount in satoshi, etc: 100000000sat = 1btc
 	Amount string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	// Fee rate in sat/vbyte for the priority btc withdrawal which is signed standalone immediately instead of batched
+	// Must not be less than the current network fee rate; 0 means the current network fee rate
+	FeeRate int64 `protobuf:"varint,3,opt,name=fee_rate,json=feeRate,proto3" json:"fee_rate,omitempty"`
+	// Maximum network fee in sat for the priority btc withdrawal; 0 means no limit
+	MaxFee int64 `protobuf:"varint,4,opt,name=max_fee,json=maxFee,proto3" json:"max_fee,omitempty"`
 }
 
 func (m *MsgWithdrawToBitcoin) Reset()         { *m = MsgWithdrawToBitcoin{} }
@@ -671,6 +680,20 @@ func (m *MsgWithdrawToBitcoin) GetAmount() string {
 	return ""
 }
 
+func (m *MsgWithdrawToBitcoin) GetFeeRate() int64 {
+	if m != nil {
+		return m.FeeRate
+	}
+	return 0
+}
+
+func (m *MsgWithdrawToBitcoin) GetMaxFee() int64 {
+	if m != nil {
+		return m.MaxFee
+	}
+	return 0
+}
+
 // MsgWithdrawToBitcoinResponse defines the Msg/WithdrawToBitcoin response type.
 type MsgWithdrawToBitcoinResponse struct {
 }
@@ -1054,8 +1077,14 @@ type MsgCompleteDKG struct {
 	Vaults []string `protobuf:"bytes,3,rep,name=vaults,proto3" json:"vaults,omitempty"`
 	// consensus address of the corresponding validator
 	ConsensusAddress string `protobuf:"bytes,4,opt,name=consensus_address,json=consensusAddress,proto3" json:"consensus_address,omitempty"`
-	// hex encoded validator signature
+	// hex encoded validator signature over the id, vaults, public keys, proofs and verification shares
 	Signature string `protobuf:"bytes,5,opt,name=signature,proto3" json:"signature,omitempty"`
+	// hex encoded aggregate public keys of the new vaults
+	PubKeys []string `protobuf:"bytes,6,rep,name=pub_keys,json=pubKeys,proto3" json:"pub_keys,omitempty"`
+	// hex encoded schnorr signatures of the aggregate public keys as the proof of possession
+	Proofs []string `protobuf:"bytes,7,rep,name=proofs,proto3" json:"proofs,omitempty"`
+	// FROST verification shares of the new vaults; empty for the vaults not signed through the chain
+	VerificationShares []VerificationShares `protobuf:"bytes,8,rep,name=verification_shares,json=verificationShares,proto3" json:"verification_shares"`
 }
 
 func (m *MsgCompleteDKG) Reset()         { *m = MsgCompleteDKG{} }
@@ -1126,6 +1155,27 @@ func (m *MsgCompleteDKG) GetSignature() string {
 	return ""
 }
 
+func (m *MsgCompleteDKG) GetPubKeys() []string {
+	if m != nil {
+		return m.PubKeys
+	}
+	return nil
+}
+
+func (m *MsgCompleteDKG) GetProofs() []string {
+	if m != nil {
+		return m.Proofs
+	}
+	return nil
+}
+
+func (m *MsgCompleteDKG) GetVerificationShares() []VerificationShares {
+	if m != nil {
+		return m.VerificationShares
+	}
+	return nil
+}
+
 // MsgCompleteDKGResponse defines the Msg/CompleteDKG response type.
 type MsgCompleteDKGResponse struct {
 }
@@ -1291,30 +1341,26 @@ func (m *MsgTransferVaultResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgTransferVaultResponse proto.InternalMessageInfo
 
-// MsgUpdateParams is the Msg/UpdateParams request type.
-//
-// Since: cosmos-sdk 0.47
-type MsgUpdateParams struct {
-	// authority is the address that controls the module (defaults to x/gov unless overwritten).
-	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
-	// params defines the x/btcbridge parameters to be updated.
-	//
-	// NOTE: All parameters must be supplied.
-	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+// MsgRegisterRunes defines the Msg/RegisterRunes request type.
+type MsgRegisterRunes struct {
+	// the sender is required to be the trusted non-btc relayer or the gov authority
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// rune metadata to be registered
+	Runes []*RuneMetadata `protobuf:"bytes,2,rep,name=runes,proto3" json:"runes,omitempty"`
 }
 
-func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
-func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateParams) ProtoMessage()    {}
-func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+func (m *MsgRegisterRunes) Reset()         { *m = MsgRegisterRunes{} }
+func (m *MsgRegisterRunes) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterRunes) ProtoMessage()    {}
+func (*MsgRegisterRunes) Descriptor() ([]byte, []int) {
 	return fileDescriptor_785ca8e1e4227068, []int{24}
 }
-func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+func (m *MsgRegisterRunes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgRegisterRunes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgRegisterRunes.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1324,50 +1370,48 @@ func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+func (m *MsgRegisterRunes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterRunes.Merge(m, src)
 }
-func (m *MsgUpdateParams) XXX_Size() int {
+func (m *MsgRegisterRunes) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateParams) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+func (m *MsgRegisterRunes) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterRunes.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+var xxx_messageInfo_MsgRegisterRunes proto.InternalMessageInfo
 
-func (m *MsgUpdateParams) GetAuthority() string {
+func (m *MsgRegisterRunes) GetSender() string {
 	if m != nil {
-		return m.Authority
+		return m.Sender
 	}
 	return ""
 }
 
-func (m *MsgUpdateParams) GetParams() Params {
+func (m *MsgRegisterRunes) GetRunes() []*RuneMetadata {
 	if m != nil {
-		return m.Params
+		return m.Runes
 	}
-	return Params{}
+	return nil
 }
 
-// MsgUpdateParamsResponse defines the Msg/UpdateParams response type.
-//
-// Since: cosmos-sdk 0.47
-type MsgUpdateParamsResponse struct {
+// MsgRegisterRunesResponse defines the Msg/RegisterRunes response type.
+type MsgRegisterRunesResponse struct {
 }
 
-func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
-func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateParamsResponse) ProtoMessage()    {}
-func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+func (m *MsgRegisterRunesResponse) Reset()         { *m = MsgRegisterRunesResponse{} }
+func (m *MsgRegisterRunesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterRunesResponse) ProtoMessage()    {}
+func (*MsgRegisterRunesResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_785ca8e1e4227068, []int{25}
 }
-func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgRegisterRunesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgRegisterRunesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgRegisterRunesResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1377,2070 +1421,6111 @@ func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+func (m *MsgRegisterRunesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterRunesResponse.Merge(m, src)
 }
-func (m *MsgUpdateParamsResponse) XXX_Size() int {
+func (m *MsgRegisterRunesResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+func (m *MsgRegisterRunesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterRunesResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgRegisterRunesResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgSubmitBlockHeaders)(nil), "side.btcbridge.MsgSubmitBlockHeaders")
-	proto.RegisterType((*MsgSubmitBlockHeadersResponse)(nil), "side.btcbridge.MsgSubmitBlockHeadersResponse")
-	proto.RegisterType((*MsgSubmitDepositTransaction)(nil), "side.btcbridge.MsgSubmitDepositTransaction")
-	proto.RegisterType((*MsgSubmitDepositTransactionResponse)(nil), "side.btcbridge.MsgSubmitDepositTransactionResponse")
-	proto.RegisterType((*MsgSubmitWithdrawTransaction)(nil), "side.btcbridge.MsgSubmitWithdrawTransaction")
-	proto.RegisterType((*MsgSubmitWithdrawTransactionResponse)(nil), "side.btcbridge.MsgSubmitWithdrawTransactionResponse")
-	proto.RegisterType((*MsgSubmitFeeRate)(nil), "side.btcbridge.MsgSubmitFeeRate")
-	proto.RegisterType((*MsgSubmitFeeRateResponse)(nil), "side.btcbridge.MsgSubmitFeeRateResponse")
-	proto.RegisterType((*MsgUpdateTrustedNonBtcRelayers)(nil), "side.btcbridge.MsgUpdateTrustedNonBtcRelayers")
-	proto.RegisterType((*MsgUpdateTrustedNonBtcRelayersResponse)(nil), "side.btcbridge.MsgUpdateTrustedNonBtcRelayersResponse")
-	proto.RegisterType((*MsgUpdateTrustedFeeProviders)(nil), "side.btcbridge.MsgUpdateTrustedFeeProviders")
-	proto.RegisterType((*MsgUpdateTrustedFeeProvidersResponse)(nil), "side.btcbridge.MsgUpdateTrustedFeeProvidersResponse")
-	proto.RegisterType((*MsgWithdrawToBitcoin)(nil), "side.btcbridge.MsgWithdrawToBitcoin")
-	proto.RegisterType((*MsgWithdrawToBitcoinResponse)(nil), "side.btcbridge.MsgWithdrawToBitcoinResponse")
-	proto.RegisterType((*MsgSubmitSignatures)(nil), "side.btcbridge.MsgSubmitSignatures")
-	proto.RegisterType((*MsgSubmitSignaturesResponse)(nil), "side.btcbridge.MsgSubmitSignaturesResponse")
-	proto.RegisterType((*MsgConsolidateVaults)(nil), "side.btcbridge.MsgConsolidateVaults")
-	proto.RegisterType((*MsgConsolidateVaultsResponse)(nil), "side.btcbridge.MsgConsolidateVaultsResponse")
-	proto.RegisterType((*MsgInitiateDKG)(nil), "side.btcbridge.MsgInitiateDKG")
-	proto.RegisterType((*MsgInitiateDKGResponse)(nil), "side.btcbridge.MsgInitiateDKGResponse")
-	proto.RegisterType((*MsgCompleteDKG)(nil), "side.btcbridge.MsgCompleteDKG")
-	proto.RegisterType((*MsgCompleteDKGResponse)(nil), "side.btcbridge.MsgCompleteDKGResponse")
-	proto.RegisterType((*MsgTransferVault)(nil), "side.btcbridge.MsgTransferVault")
-	proto.RegisterType((*MsgTransferVaultResponse)(nil), "side.btcbridge.MsgTransferVaultResponse")
-	proto.RegisterType((*MsgUpdateParams)(nil), "side.btcbridge.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "side.btcbridge.MsgUpdateParamsResponse")
+// MsgRegisterBrc20Tickers defines the Msg/RegisterBrc20Tickers request type.
+type MsgRegisterBrc20Tickers struct {
+	// the sender is required to be the trusted non-btc relayer or the gov authority
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// brc20 ticker metadata to be registered
+	Tickers []*Brc20Metadata `protobuf:"bytes,2,rep,name=tickers,proto3" json:"tickers,omitempty"`
 }
 
-func init() { proto.RegisterFile("side/btcbridge/tx.proto", fileDescriptor_785ca8e1e4227068) }
-
-var fileDescriptor_785ca8e1e4227068 = []byte{
-	// 1281 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x57, 0xcf, 0x6f, 0x13, 0xc7,
-	0x17, 0x8f, 0xed, 0x24, 0x24, 0xcf, 0xb1, 0x09, 0x4b, 0xbe, 0xc1, 0xd9, 0xc0, 0x92, 0x6f, 0x80,
-	0x10, 0x01, 0xb5, 0x55, 0x83, 0xaa, 0x8a, 0x53, 0x31, 0x08, 0xa8, 0x90, 0x11, 0x5a, 0x12, 0x5a,
-	0xb5, 0x07, 0x6b, 0x76, 0x77, 0xb2, 0xde, 0xd6, 0xde, 0x59, 0xcd, 0xcc, 0xa6, 0x8e, 0x54, 0xb5,
-	0x15, 0x52, 0xcf, 0xad, 0xfa, 0x57, 0xf4, 0x52, 0x89, 0x4b, 0xff, 0x07, 0x8e, 0x1c, 0x7b, 0xaa,
-	0x2a, 0x38, 0xf0, 0x3f, 0xf4, 0x54, 0xcd, 0xec, 0x7a, 0xbc, 0xeb, 0xdd, 0xb5, 0xa1, 0x3d, 0x79,
-	0xe6, 0xbd, 0xcf, 0x7e, 0xde, 0xe7, 0xbd, 0xf9, 0xf5, 0x0c, 0xe7, 0x98, 0xe7, 0xe0, 0x96, 0xc5,
-	0x6d, 0x8b, 0x7a, 0x8e, 0x8b, 0x5b, 0x7c, 0xd4, 0x0c, 0x28, 0xe1, 0x44, 0xab, 0x0b, 0x47, 0x53,
-	0x39, 0xf4, 0x73, 0x36, 0x61, 0x43, 0xc2, 0x5a, 0x43, 0xe6, 0xb6, 0x8e, 0x3f, 0x14, 0x3f, 0x11,
-	0x50, 0xdf, 0x70, 0x89, 0x4b, 0xe4, 0xb0, 0x25, 0x46, 0xb1, 0x75, 0x7b, 0x8a, 0x37, 0x40, 0x14,
-	0x0d, 0x59, 0xec, 0x34, 0xa6, 0x9c, 0x6a, 0x14, 0xf9, 0x77, 0xbf, 0x83, 0xff, 0x75, 0x99, 0xfb,
-	0x34, 0xb4, 0x86, 0x1e, 0xef, 0x0c, 0x88, 0xfd, 0xf5, 0x43, 0x8c, 0x1c, 0x4c, 0x99, 0xb6, 0x09,
-	0xcb, 0x0c, 0xfb, 0x0e, 0xa6, 0x8d, 0xd2, 0x4e, 0x69, 0x7f, 0xd5, 0x8c, 0x67, 0xda, 0x27, 0x50,
-	0xb3, 0x04, 0xae, 0xd7, 0x8f, 0x80, 0x8d, 0xf2, 0x4e, 0x65, 0xbf, 0xda, 0xde, 0x6e, 0xa6, 0x93,
-	0x68, 0x26, 0xc8, 0xcc, 0x35, 0x2b, 0xc1, 0x7c, 0xbb, 0xfa, 0xfc, 0xed, 0x8b, 0x6b, 0x31, 0xdd,
-	0xee, 0x45, 0xb8, 0x90, 0x1b, 0xdf, 0xc4, 0x2c, 0x20, 0x3e, 0xc3, 0xbb, 0xbf, 0x97, 0x60, 0x5b,
-	0x21, 0xee, 0xe1, 0x80, 0x30, 0x8f, 0x1f, 0x50, 0xe4, 0x33, 0x64, 0x73, 0x8f, 0xf8, 0x85, 0x3a,
-	0xcf, 0xc3, 0xaa, 0x8c, 0xda, 0x47, 0xac, 0xdf, 0x28, 0x4b, 0xd7, 0xc4, 0xa0, 0xed, 0x42, 0x2d,
-	0xa0, 0xf8, 0xb8, 0xc7, 0x47, 0x3d, 0xeb, 0x84, 0x63, 0xd6, 0xa8, 0x48, 0x44, 0x55, 0x18, 0x0f,
-	0x46, 0x1d, 0x61, 0xd2, 0xb6, 0x60, 0x45, 0xb9, 0x17, 0xa5, 0xfb, 0x14, 0x8f, 0x5d, 0x1b, 0xb0,
-	0x14, 0x50, 0x42, 0x8e, 0x1a, 0x4b, 0x3b, 0x95, 0xfd, 0x55, 0x33, 0x9a, 0xa4, 0x13, 0xbb, 0x02,
-	0x97, 0x66, 0xc8, 0x56, 0xe9, 0xfd, 0x52, 0x82, 0xf3, 0x0a, 0xf7, 0x99, 0xc7, 0xfb, 0x0e, 0x45,
-	0xdf, 0xfc, 0xf7, 0xfc, 0x92, 0xda, 0x2b, 0x05, 0xda, 0x17, 0x0b, 0xb5, 0xef, 0xc1, 0xe5, 0x59,
-	0x9a, 0x94, 0x78, 0x13, 0xd6, 0x15, 0xee, 0x3e, 0xc6, 0x26, 0xe2, 0xb8, 0x50, 0xef, 0x16, 0xac,
-	0x1c, 0x61, 0xdc, 0xa3, 0x88, 0x63, 0x29, 0xb7, 0x62, 0x9e, 0x3a, 0x8a, 0x3e, 0x49, 0xc7, 0xd6,
-	0xa1, 0x31, 0xcd, 0xa9, 0xe2, 0x21, 0x30, 0xba, 0xcc, 0x3d, 0x0c, 0x1c, 0xc4, 0xf1, 0x01, 0x0d,
-	0x19, 0xc7, 0xce, 0x63, 0xe2, 0x77, 0xb8, 0x6d, 0xe2, 0x01, 0x3a, 0x99, 0xb5, 0x6b, 0x75, 0x58,
-	0xa1, 0x31, 0x46, 0x6e, 0xd8, 0x55, 0x53, 0xcd, 0xd3, 0xe1, 0xf7, 0x61, 0x6f, 0x76, 0x08, 0x25,
-	0xc6, 0x95, 0x0b, 0x97, 0x42, 0xde, 0xc7, 0xf8, 0x09, 0x25, 0xc7, 0xde, 0xcc, 0x03, 0xb4, 0x0b,
-	0x6b, 0x49, 0x5c, 0x2c, 0x27, 0x65, 0xcb, 0x5b, 0x8d, 0xc2, 0x40, 0x4a, 0xd0, 0x53, 0xd8, 0xe8,
-	0x32, 0x57, 0xad, 0x17, 0xe9, 0x78, 0xdc, 0x26, 0x5e, 0xf1, 0x0e, 0xda, 0x84, 0x65, 0x34, 0x24,
-	0xa1, 0xcf, 0xe3, 0xed, 0x13, 0xcf, 0xd2, 0xc1, 0x0d, 0x99, 0x65, 0x86, 0x54, 0x05, 0xb5, 0xe0,
-	0xac, 0x5a, 0xae, 0xa7, 0x9e, 0xeb, 0x23, 0x1e, 0x52, 0x5c, 0x9c, 0xbc, 0x06, 0x8b, 0x7c, 0xe4,
-	0x39, 0x71, 0x44, 0x39, 0x16, 0xb6, 0x80, 0x59, 0x3c, 0xde, 0xa7, 0x72, 0x9c, 0xd6, 0x70, 0x21,
-	0x71, 0x03, 0x4c, 0x62, 0x28, 0x09, 0x3f, 0x95, 0x65, 0xe2, 0x77, 0x89, 0xcf, 0xc8, 0xc0, 0x13,
-	0x55, 0x7a, 0x86, 0xc2, 0x01, 0x67, 0xe2, 0x88, 0xa0, 0x90, 0xf7, 0x09, 0xf5, 0xf8, 0x49, 0xac,
-	0x63, 0x62, 0xd0, 0x2e, 0x41, 0xed, 0x58, 0xe0, 0x7a, 0xc7, 0x98, 0x32, 0x8f, 0xf8, 0x52, 0xd3,
-	0xa2, 0xb9, 0x26, 0x8d, 0xcf, 0x22, 0x9b, 0xd6, 0x85, 0x33, 0x16, 0xb7, 0x7b, 0xb6, 0xe2, 0x16,
-	0x40, 0x21, 0xb4, 0xda, 0xde, 0xc9, 0xdc, 0x78, 0xdc, 0xbe, 0x9b, 0xc4, 0x99, 0xeb, 0xd6, 0x94,
-	0x45, 0x3b, 0x84, 0x0d, 0x1a, 0xfa, 0x98, 0xa5, 0x09, 0x99, 0x3c, 0x8a, 0xd5, 0xf6, 0xee, 0x34,
-	0xa3, 0x29, 0xb0, 0x69, 0xce, 0xb3, 0x34, 0x63, 0x63, 0xb7, 0xeb, 0xa2, 0x5a, 0x93, 0xd4, 0xe2,
-	0x45, 0xcb, 0x14, 0x44, 0x55, 0xec, 0xb7, 0x32, 0xd4, 0xbb, 0xcc, 0xfd, 0xd4, 0xf7, 0xb8, 0x87,
-	0x38, 0xbe, 0xf7, 0xe8, 0xc1, 0x9c, 0x5a, 0x75, 0x60, 0x2d, 0x40, 0x94, 0x7b, 0xb6, 0x17, 0x20,
-	0x9f, 0x8f, 0xef, 0x7c, 0x63, 0x5a, 0xef, 0xbd, 0x47, 0x0f, 0x9e, 0x4c, 0x60, 0x66, 0xea, 0x1b,
-	0x11, 0x81, 0xf7, 0x29, 0x66, 0x7d, 0x32, 0x70, 0x64, 0x09, 0x6b, 0xe6, 0xc4, 0xa0, 0xdd, 0x86,
-	0x6a, 0xb4, 0x1a, 0xfc, 0x24, 0xc0, 0x51, 0x41, 0xea, 0xed, 0xad, 0xe9, 0x00, 0x77, 0x18, 0xc3,
-	0xfc, 0xe0, 0x24, 0xc0, 0x26, 0x48, 0xb4, 0x18, 0x32, 0xed, 0x2a, 0x9c, 0xc6, 0x3e, 0xb2, 0x06,
-	0xb8, 0xc7, 0xc5, 0x25, 0x75, 0x84, 0x69, 0x63, 0x69, 0xa7, 0xb4, 0xbf, 0x62, 0xd6, 0x23, 0xf3,
-	0x41, 0x6c, 0xd5, 0xf6, 0xe0, 0x34, 0x47, 0xd4, 0xc5, 0xbc, 0x17, 0xf2, 0x11, 0xe9, 0xf9, 0xe1,
-	0xb0, 0xb1, 0x2c, 0x85, 0xd4, 0x22, 0xf3, 0x21, 0x1f, 0x91, 0xc7, 0xe1, 0x30, 0x53, 0xcf, 0x06,
-	0x6c, 0xa6, 0xcb, 0xa5, 0x2a, 0xf9, 0x6b, 0x49, 0x56, 0xf2, 0x2e, 0x19, 0x06, 0x03, 0x1c, 0x55,
-	0xb2, 0x68, 0xeb, 0xd7, 0xa1, 0x1c, 0x6f, 0xfc, 0x45, 0xb3, 0xec, 0x39, 0x02, 0x27, 0x73, 0x10,
-	0x17, 0xb4, 0xb8, 0x01, 0xe2, 0x99, 0x76, 0x1d, 0xce, 0x88, 0xdd, 0x81, 0x7d, 0x16, 0xb2, 0x1e,
-	0x72, 0x1c, 0x8a, 0xd9, 0xf8, 0xfd, 0x59, 0x57, 0x8e, 0x3b, 0x91, 0x5d, 0x14, 0x95, 0x8d, 0x4f,
-	0x84, 0x4c, 0x7a, 0xd5, 0x9c, 0x18, 0xd2, 0xa7, 0x28, 0x4a, 0x22, 0xa1, 0x54, 0x25, 0xf1, 0xbc,
-	0x2c, 0xef, 0xf1, 0x71, 0x99, 0xe4, 0x66, 0x99, 0xb3, 0x21, 0xae, 0x40, 0x9d, 0x91, 0x90, 0xda,
-	0x78, 0xea, 0xf4, 0xd4, 0x22, 0xeb, 0xf8, 0xf8, 0xfc, 0x1f, 0xd6, 0x1c, 0xcc, 0x26, 0x47, 0xac,
-	0x22, 0x41, 0x55, 0x61, 0x1b, 0x43, 0x3e, 0x06, 0x40, 0x62, 0x55, 0xe5, 0xc2, 0xcb, 0x3c, 0x67,
-	0xae, 0xfb, 0x2a, 0x1a, 0x0f, 0xe5, 0x43, 0xc6, 0x2c, 0xce, 0xd4, 0x23, 0x2c, 0x26, 0xff, 0x7a,
-	0x8d, 0xa3, 0x77, 0x27, 0x55, 0x03, 0x55, 0xa0, 0x10, 0x4e, 0xab, 0x1b, 0xf8, 0x89, 0xec, 0xae,
-	0xe6, 0x94, 0xe7, 0x16, 0x2c, 0x47, 0x5d, 0x98, 0x2c, 0x4b, 0xb5, 0xbd, 0x39, 0x9d, 0x50, 0xc4,
-	0xd2, 0x59, 0x7c, 0xf9, 0xe7, 0xc5, 0x05, 0x33, 0xc6, 0x66, 0x24, 0x6d, 0xc1, 0xb9, 0xa9, 0xb0,
-	0x63, 0x45, 0xed, 0xbf, 0x01, 0x2a, 0x5d, 0xe6, 0x6a, 0x5f, 0x81, 0x96, 0xd3, 0xbb, 0x5d, 0x99,
-	0x0e, 0x97, 0xdb, 0x62, 0xe9, 0x1f, 0xbc, 0x13, 0x6c, 0x1c, 0x53, 0xfb, 0x16, 0x1a, 0x85, 0x5d,
-	0xd8, 0xf5, 0x42, 0xaa, 0x2c, 0x58, 0xbf, 0xf9, 0x1e, 0x60, 0x15, 0xfd, 0x7b, 0xd8, 0x2a, 0x6e,
-	0x92, 0x6e, 0x14, 0x32, 0xe6, 0xa0, 0xf5, 0x5b, 0xef, 0x83, 0x56, 0x02, 0xbe, 0x84, 0x5a, 0xba,
-	0xd3, 0xd9, 0x29, 0xa4, 0x89, 0x11, 0xfa, 0xfe, 0x3c, 0x84, 0x22, 0xff, 0xb1, 0x04, 0xdb, 0xb3,
-	0xfa, 0x9a, 0x66, 0x0e, 0xd3, 0x0c, 0xbc, 0xfe, 0xd1, 0xfb, 0xe1, 0x93, 0x55, 0x2e, 0xee, 0x68,
-	0x6e, 0xcc, 0x23, 0x4d, 0xa2, 0x73, 0xab, 0x3c, 0xb7, 0x89, 0xd1, 0x5c, 0x38, 0x93, 0xed, 0x60,
-	0x2e, 0xe7, 0x50, 0x65, 0x50, 0xfa, 0x8d, 0x77, 0x41, 0xa9, 0x40, 0x0e, 0xac, 0x67, 0xba, 0x96,
-	0x4b, 0x85, 0xeb, 0x35, 0x01, 0xe9, 0xd7, 0xdf, 0x01, 0x94, 0x4c, 0x27, 0xdb, 0x97, 0xe4, 0xa5,
-	0x93, 0x41, 0xe5, 0xa6, 0x53, 0xf8, 0xa4, 0x6b, 0x87, 0x50, 0x4d, 0x3e, 0xe7, 0x46, 0xce, 0xc7,
-	0x09, 0xbf, 0xbe, 0x37, 0xdb, 0x9f, 0xa4, 0x4d, 0xbe, 0x6d, 0x46, 0xae, 0x26, 0xe5, 0xcf, 0xa5,
-	0xcd, 0x79, 0x71, 0xc4, 0x59, 0x4a, 0xbf, 0x36, 0x79, 0x67, 0x29, 0x85, 0xc8, 0x3d, 0x4b, 0xb9,
-	0xb7, 0xb5, 0xf6, 0x39, 0xac, 0xa5, 0xae, 0xea, 0x8b, 0x85, 0x1b, 0x31, 0x02, 0xe8, 0x57, 0xe7,
-	0x00, 0xc6, 0xcc, 0xfa, 0xd2, 0x0f, 0x6f, 0x5f, 0x5c, 0x2b, 0x75, 0x1e, 0xbe, 0x7c, 0x6d, 0x94,
-	0x5e, 0xbd, 0x36, 0x4a, 0x7f, 0xbd, 0x36, 0x4a, 0x3f, 0xbf, 0x31, 0x16, 0x5e, 0xbd, 0x31, 0x16,
-	0xfe, 0x78, 0x63, 0x2c, 0x7c, 0xd1, 0x74, 0x3d, 0xde, 0x0f, 0xad, 0xa6, 0x4d, 0x86, 0x2d, 0xc1,
-	0x29, 0xff, 0x63, 0xdb, 0x64, 0x20, 0x27, 0xad, 0x51, 0xf2, 0xcf, 0xbf, 0xe8, 0x5c, 0xac, 0x65,
-	0x09, 0xb8, 0xf9, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x8c, 0x20, 0x04, 0x9e, 0x1b, 0x10, 0x00,
-	0x00,
+func (m *MsgRegisterBrc20Tickers) Reset()         { *m = MsgRegisterBrc20Tickers{} }
+func (m *MsgRegisterBrc20Tickers) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterBrc20Tickers) ProtoMessage()    {}
+func (*MsgRegisterBrc20Tickers) Descriptor() ([]byte, []int) {
+	return fileDescriptor_785ca8e1e4227068, []int{26}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// SubmitBlockHeaders submits bitcoin block headers to the side chain.
-	SubmitBlockHeaders(ctx context.Context, in *MsgSubmitBlockHeaders, opts ...grpc.CallOption) (*MsgSubmitBlockHeadersResponse, error)
-	// SubmitDepositTransaction submits the bitcoin deposit transaction to the side chain.
-	SubmitDepositTransaction(ctx context.Context, in *MsgSubmitDepositTransaction, opts ...grpc.CallOption) (*MsgSubmitDepositTransactionResponse, error)
-	// SubmitWithdrawalTransaction submits the bitcoin withdrawal transaction to the side chain.
-	SubmitWithdrawTransaction(ctx context.Context, in *MsgSubmitWithdrawTransaction, opts ...grpc.CallOption) (*MsgSubmitWithdrawTransactionResponse, error)
-	// SubmitFeeRate submits the bitcoin network fee rate to the side chain.
-	SubmitFeeRate(ctx context.Context, in *MsgSubmitFeeRate, opts ...grpc.CallOption) (*MsgSubmitFeeRateResponse, error)
-	// UpdateTrustedNonBtcRelayers updates the trusted non-btc asset relayers.
-	UpdateTrustedNonBtcRelayers(ctx context.Context, in *MsgUpdateTrustedNonBtcRelayers, opts ...grpc.CallOption) (*MsgUpdateTrustedNonBtcRelayersResponse, error)
-	// UpdateTrustedFeeProviders updates the trusted fee providers.
-	UpdateTrustedFeeProviders(ctx context.Context, in *MsgUpdateTrustedFeeProviders, opts ...grpc.CallOption) (*MsgUpdateTrustedFeeProvidersResponse, error)
-	// WithdrawToBitcoin withdraws the asset to bitcoin.
-	WithdrawToBitcoin(ctx context.Context, in *MsgWithdrawToBitcoin, opts ...grpc.CallOption) (*MsgWithdrawToBitcoinResponse, error)
-	// SubmitSignatures submits the signatures of the signing request to the side chain.
-	SubmitSignatures(ctx context.Context, in *MsgSubmitSignatures, opts ...grpc.CallOption) (*MsgSubmitSignaturesResponse, error)
-	// ConsolidateVaults performs the utxo consolidation for the given vaults.
-	ConsolidateVaults(ctx context.Context, in *MsgConsolidateVaults, opts ...grpc.CallOption) (*MsgConsolidateVaultsResponse, error)
-	// InitiateDKG initiates the DKG request.
-	InitiateDKG(ctx context.Context, in *MsgInitiateDKG, opts ...grpc.CallOption) (*MsgInitiateDKGResponse, error)
-	// CompleteDKG completes the given DKG request.
-	CompleteDKG(ctx context.Context, in *MsgCompleteDKG, opts ...grpc.CallOption) (*MsgCompleteDKGResponse, error)
-	// TransferVault transfers the vault asset from the source version to the destination version.
-	TransferVault(ctx context.Context, in *MsgTransferVault, opts ...grpc.CallOption) (*MsgTransferVaultResponse, error)
-	// UpdateParams defines a governance operation for updating the x/btcbridge module
-	// parameters. The authority defaults to the x/gov module account.
-	//
-	// Since: cosmos-sdk 0.47
-	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+func (m *MsgRegisterBrc20Tickers) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgRegisterBrc20Tickers) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterBrc20Tickers.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgRegisterBrc20Tickers) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterBrc20Tickers.Merge(m, src)
+}
+func (m *MsgRegisterBrc20Tickers) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterBrc20Tickers) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterBrc20Tickers.DiscardUnknown(m)
 }
 
-func (c *msgClient) SubmitBlockHeaders(ctx context.Context, in *MsgSubmitBlockHeaders, opts ...grpc.CallOption) (*MsgSubmitBlockHeadersResponse, error) {
-	out := new(MsgSubmitBlockHeadersResponse)
-	err := c.cc.Invoke(ctx, "/side.btcbridge.Msg/SubmitBlockHeaders", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgRegisterBrc20Tickers proto.InternalMessageInfo
+
+func (m *MsgRegisterBrc20Tickers) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) SubmitDepositTransaction(ctx context.Context, in *MsgSubmitDepositTransaction, opts ...grpc.CallOption) (*MsgSubmitDepositTransactionResponse, error) {
-	out := new(MsgSubmitDepositTransactionResponse)
-	err := c.cc.Invoke(ctx, "/side.btcbridge.Msg/SubmitDepositTransaction", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRegisterBrc20Tickers) GetTickers() []*Brc20Metadata {
+	if m != nil {
+		return m.Tickers
 	}
-	return out, nil
+	return nil
 }
 
-func (c *msgClient) SubmitWithdrawTransaction(ctx context.Context, in *MsgSubmitWithdrawTransaction, opts ...grpc.CallOption) (*MsgSubmitWithdrawTransactionResponse, error) {
-	out := new(MsgSubmitWithdrawTransactionResponse)
-	err := c.cc.Invoke(ctx, "/side.btcbridge.Msg/SubmitWithdrawTransaction", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// MsgRegisterBrc20TickersResponse defines the Msg/RegisterBrc20Tickers response type.
+type MsgRegisterBrc20TickersResponse struct {
 }
 
-func (c *msgClient) SubmitFeeRate(ctx context.Context, in *MsgSubmitFeeRate, opts ...grpc.CallOption) (*MsgSubmitFeeRateResponse, error) {
-	out := new(MsgSubmitFeeRateResponse)
-	err := c.cc.Invoke(ctx, "/side.btcbridge.Msg/SubmitFeeRate", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRegisterBrc20TickersResponse) Reset()         { *m = MsgRegisterBrc20TickersResponse{} }
+func (m *MsgRegisterBrc20TickersResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterBrc20TickersResponse) ProtoMessage()    {}
+func (*MsgRegisterBrc20TickersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_785ca8e1e4227068, []int{27}
+}
+func (m *MsgRegisterBrc20TickersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterBrc20TickersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterBrc20TickersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgRegisterBrc20TickersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterBrc20TickersResponse.Merge(m, src)
+}
+func (m *MsgRegisterBrc20TickersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterBrc20TickersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterBrc20TickersResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) UpdateTrustedNonBtcRelayers(ctx context.Context, in *MsgUpdateTrustedNonBtcRelayers, opts ...grpc.CallOption) (*MsgUpdateTrustedNonBtcRelayersResponse, error) {
-	out := new(MsgUpdateTrustedNonBtcRelayersResponse)
-	err := c.cc.Invoke(ctx, "/side.btcbridge.Msg/UpdateTrustedNonBtcRelayers", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_MsgRegisterBrc20TickersResponse proto.InternalMessageInfo
+
+// MsgResetCircuitBreaker is the Msg/ResetCircuitBreaker request type.
+type MsgResetCircuitBreaker struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
 }
 
-func (c *msgClient) UpdateTrustedFeeProviders(ctx context.Context, in *MsgUpdateTrustedFeeProviders, opts ...grpc.CallOption) (*MsgUpdateTrustedFeeProvidersResponse, error) {
-	out := new(MsgUpdateTrustedFeeProvidersResponse)
-	err := c.cc.Invoke(ctx, "/side.btcbridge.Msg/UpdateTrustedFeeProviders", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgResetCircuitBreaker) Reset()         { *m = MsgResetCircuitBreaker{} }
+func (m *MsgResetCircuitBreaker) String() string { return proto.CompactTextString(m) }
+func (*MsgResetCircuitBreaker) ProtoMessage()    {}
+func (*MsgResetCircuitBreaker) Descriptor() ([]byte, []int) {
+	return fileDescriptor_785ca8e1e4227068, []int{28}
+}
+func (m *MsgResetCircuitBreaker) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResetCircuitBreaker) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResetCircuitBreaker.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgResetCircuitBreaker) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResetCircuitBreaker.Merge(m, src)
+}
+func (m *MsgResetCircuitBreaker) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResetCircuitBreaker) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResetCircuitBreaker.DiscardUnknown(m)
 }
 
-func (c *msgClient) WithdrawToBitcoin(ctx context.Context, in *MsgWithdrawToBitcoin, opts ...grpc.CallOption) (*MsgWithdrawToBitcoinResponse, error) {
-	out := new(MsgWithdrawToBitcoinResponse)
-	err := c.cc.Invoke(ctx, "/side.btcbridge.Msg/WithdrawToBitcoin", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgResetCircuitBreaker proto.InternalMessageInfo
+
+func (m *MsgResetCircuitBreaker) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) SubmitSignatures(ctx context.Context, in *MsgSubmitSignatures, opts ...grpc.CallOption) (*MsgSubmitSignaturesResponse, error) {
-	out := new(MsgSubmitSignaturesResponse)
-	err := c.cc.Invoke(ctx, "/side.btcbridge.Msg/SubmitSignatures", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// MsgResetCircuitBreakerResponse defines the Msg/ResetCircuitBreaker response type.
+type MsgResetCircuitBreakerResponse struct {
 }
 
-func (c *msgClient) ConsolidateVaults(ctx context.Context, in *MsgConsolidateVaults, opts ...grpc.CallOption) (*MsgConsolidateVaultsResponse, error) {
-	out := new(MsgConsolidateVaultsResponse)
-	err := c.cc.Invoke(ctx, "/side.btcbridge.Msg/ConsolidateVaults", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgResetCircuitBreakerResponse) Reset()         { *m = MsgResetCircuitBreakerResponse{} }
+func (m *MsgResetCircuitBreakerResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgResetCircuitBreakerResponse) ProtoMessage()    {}
+func (*MsgResetCircuitBreakerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_785ca8e1e4227068, []int{29}
+}
+func (m *MsgResetCircuitBreakerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResetCircuitBreakerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResetCircuitBreakerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgResetCircuitBreakerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResetCircuitBreakerResponse.Merge(m, src)
+}
+func (m *MsgResetCircuitBreakerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResetCircuitBreakerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResetCircuitBreakerResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) InitiateDKG(ctx context.Context, in *MsgInitiateDKG, opts ...grpc.CallOption) (*MsgInitiateDKGResponse, error) {
-	out := new(MsgInitiateDKGResponse)
-	err := c.cc.Invoke(ctx, "/side.btcbridge.Msg/InitiateDKG", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgResetCircuitBreakerResponse proto.InternalMessageInfo
+
+// MsgEmergencyPause is the Msg/EmergencyPause request type.
+type MsgEmergencyPause struct {
+	// guardian address
+	Guardian string `protobuf:"bytes,1,opt,name=guardian,proto3" json:"guardian,omitempty"`
+	// pause scope
+	Scope PauseScope `protobuf:"varint,2,opt,name=scope,proto3,enum=side.btcbridge.PauseScope" json:"scope,omitempty"`
+	// asset denom; required for the asset scope
+	Denom string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+	// pause duration; must not be greater than the maximum pause duration
+	Duration time.Duration `protobuf:"bytes,4,opt,name=duration,proto3,stdduration" json:"duration"`
+}
+
+func (m *MsgEmergencyPause) Reset()         { *m = MsgEmergencyPause{} }
+func (m *MsgEmergencyPause) String() string { return proto.CompactTextString(m) }
+func (*MsgEmergencyPause) ProtoMessage()    {}
+func (*MsgEmergencyPause) Descriptor() ([]byte, []int) {
+	return fileDescriptor_785ca8e1e4227068, []int{30}
+}
+func (m *MsgEmergencyPause) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgEmergencyPause) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgEmergencyPause.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgEmergencyPause) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgEmergencyPause.Merge(m, src)
+}
+func (m *MsgEmergencyPause) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgEmergencyPause) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgEmergencyPause.DiscardUnknown(m)
 }
 
-func (c *msgClient) CompleteDKG(ctx context.Context, in *MsgCompleteDKG, opts ...grpc.CallOption) (*MsgCompleteDKGResponse, error) {
-	out := new(MsgCompleteDKGResponse)
-	err := c.cc.Invoke(ctx, "/side.btcbridge.Msg/CompleteDKG", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgEmergencyPause proto.InternalMessageInfo
+
+func (m *MsgEmergencyPause) GetGuardian() string {
+	if m != nil {
+		return m.Guardian
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) TransferVault(ctx context.Context, in *MsgTransferVault, opts ...grpc.CallOption) (*MsgTransferVaultResponse, error) {
-	out := new(MsgTransferVaultResponse)
-	err := c.cc.Invoke(ctx, "/side.btcbridge.Msg/TransferVault", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgEmergencyPause) GetScope() PauseScope {
+	if m != nil {
+		return m.Scope
 	}
-	return out, nil
+	return PauseScope_PAUSE_SCOPE_UNSPECIFIED
 }
 
-func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/side.btcbridge.Msg/UpdateParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgEmergencyPause) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return out, nil
+	return ""
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// SubmitBlockHeaders submits bitcoin block headers to the side chain.
-	SubmitBlockHeaders(context.Context, *MsgSubmitBlockHeaders) (*MsgSubmitBlockHeadersResponse, error)
-	// SubmitDepositTransaction submits the bitcoin deposit transaction to the side chain.
-	SubmitDepositTransaction(context.Context, *MsgSubmitDepositTransaction) (*MsgSubmitDepositTransactionResponse, error)
-	// SubmitWithdrawalTransaction submits the bitcoin withdrawal transaction to the side chain.
-	SubmitWithdrawTransaction(context.Context, *MsgSubmitWithdrawTransaction) (*MsgSubmitWithdrawTransactionResponse, error)
-	// SubmitFeeRate submits the bitcoin network fee rate to the side chain.
-	SubmitFeeRate(context.Context, *MsgSubmitFeeRate) (*MsgSubmitFeeRateResponse, error)
-	// UpdateTrustedNonBtcRelayers updates the trusted non-btc asset relayers.
-	UpdateTrustedNonBtcRelayers(context.Context, *MsgUpdateTrustedNonBtcRelayers) (*MsgUpdateTrustedNonBtcRelayersResponse, error)
-	// UpdateTrustedFeeProviders updates the trusted fee providers.
-	UpdateTrustedFeeProviders(context.Context, *MsgUpdateTrustedFeeProviders) (*MsgUpdateTrustedFeeProvidersResponse, error)
-	// WithdrawToBitcoin withdraws the asset to bitcoin.
-	WithdrawToBitcoin(context.Context, *MsgWithdrawToBitcoin) (*MsgWithdrawToBitcoinResponse, error)
-	// SubmitSignatures submits the signatures of the signing request to the side chain.
-	SubmitSignatures(context.Context, *MsgSubmitSignatures) (*MsgSubmitSignaturesResponse, error)
-	// ConsolidateVaults performs the utxo consolidation for the given vaults.
-	ConsolidateVaults(context.Context, *MsgConsolidateVaults) (*MsgConsolidateVaultsResponse, error)
-	// InitiateDKG initiates the DKG request.
-	InitiateDKG(context.Context, *MsgInitiateDKG) (*MsgInitiateDKGResponse, error)
-	// CompleteDKG completes the given DKG request.
-	CompleteDKG(context.Context, *MsgCompleteDKG) (*MsgCompleteDKGResponse, error)
-	// TransferVault transfers the vault asset from the source version to the destination version.
-	TransferVault(context.Context, *MsgTransferVault) (*MsgTransferVaultResponse, error)
-	// UpdateParams defines a governance operation for updating the x/btcbridge module
-	// parameters. The authority defaults to the x/gov module account.
-	//
-	// Since: cosmos-sdk 0.47
-	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+func (m *MsgEmergencyPause) GetDuration() time.Duration {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+// MsgEmergencyPauseResponse defines the Msg/EmergencyPause response type.
+type MsgEmergencyPauseResponse struct {
+	// pause id
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (*UnimplementedMsgServer) SubmitBlockHeaders(ctx context.Context, req *MsgSubmitBlockHeaders) (*MsgSubmitBlockHeadersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SubmitBlockHeaders not implemented")
+func (m *MsgEmergencyPauseResponse) Reset()         { *m = MsgEmergencyPauseResponse{} }
+func (m *MsgEmergencyPauseResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgEmergencyPauseResponse) ProtoMessage()    {}
+func (*MsgEmergencyPauseResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_785ca8e1e4227068, []int{31}
 }
-func (*UnimplementedMsgServer) SubmitDepositTransaction(ctx context.Context, req *MsgSubmitDepositTransaction) (*MsgSubmitDepositTransactionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SubmitDepositTransaction not implemented")
+func (m *MsgEmergencyPauseResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) SubmitWithdrawTransaction(ctx context.Context, req *MsgSubmitWithdrawTransaction) (*MsgSubmitWithdrawTransactionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SubmitWithdrawTransaction not implemented")
+func (m *MsgEmergencyPauseResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgEmergencyPauseResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) SubmitFeeRate(ctx context.Context, req *MsgSubmitFeeRate) (*MsgSubmitFeeRateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SubmitFeeRate not implemented")
+func (m *MsgEmergencyPauseResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgEmergencyPauseResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) UpdateTrustedNonBtcRelayers(ctx context.Context, req *MsgUpdateTrustedNonBtcRelayers) (*MsgUpdateTrustedNonBtcRelayersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateTrustedNonBtcRelayers not implemented")
+func (m *MsgEmergencyPauseResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) UpdateTrustedFeeProviders(ctx context.Context, req *MsgUpdateTrustedFeeProviders) (*MsgUpdateTrustedFeeProvidersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateTrustedFeeProviders not implemented")
+func (m *MsgEmergencyPauseResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgEmergencyPauseResponse.DiscardUnknown(m)
 }
-func (*UnimplementedMsgServer) WithdrawToBitcoin(ctx context.Context, req *MsgWithdrawToBitcoin) (*MsgWithdrawToBitcoinResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WithdrawToBitcoin not implemented")
+
+var xxx_messageInfo_MsgEmergencyPauseResponse proto.InternalMessageInfo
+
+func (m *MsgEmergencyPauseResponse) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
 }
-func (*UnimplementedMsgServer) SubmitSignatures(ctx context.Context, req *MsgSubmitSignatures) (*MsgSubmitSignaturesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SubmitSignatures not implemented")
+
+// MsgLiftEmergencyPause is the Msg/LiftEmergencyPause request type.
+type MsgLiftEmergencyPause struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// pause id
+	Id uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
 }
-func (*UnimplementedMsgServer) ConsolidateVaults(ctx context.Context, req *MsgConsolidateVaults) (*MsgConsolidateVaultsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ConsolidateVaults not implemented")
+
+func (m *MsgLiftEmergencyPause) Reset()         { *m = MsgLiftEmergencyPause{} }
+func (m *MsgLiftEmergencyPause) String() string { return proto.CompactTextString(m) }
+func (*MsgLiftEmergencyPause) ProtoMessage()    {}
+func (*MsgLiftEmergencyPause) Descriptor() ([]byte, []int) {
+	return fileDescriptor_785ca8e1e4227068, []int{32}
 }
-func (*UnimplementedMsgServer) InitiateDKG(ctx context.Context, req *MsgInitiateDKG) (*MsgInitiateDKGResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method InitiateDKG not implemented")
+func (m *MsgLiftEmergencyPause) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) CompleteDKG(ctx context.Context, req *MsgCompleteDKG) (*MsgCompleteDKGResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CompleteDKG not implemented")
+func (m *MsgLiftEmergencyPause) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgLiftEmergencyPause.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) TransferVault(ctx context.Context, req *MsgTransferVault) (*MsgTransferVaultResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TransferVault not implemented")
+func (m *MsgLiftEmergencyPause) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgLiftEmergencyPause.Merge(m, src)
 }
-func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+func (m *MsgLiftEmergencyPause) XXX_Size() int {
+	return m.Size()
 }
-
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+func (m *MsgLiftEmergencyPause) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgLiftEmergencyPause.DiscardUnknown(m)
 }
 
-func _Msg_SubmitBlockHeaders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSubmitBlockHeaders)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SubmitBlockHeaders(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/side.btcbridge.Msg/SubmitBlockHeaders",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SubmitBlockHeaders(ctx, req.(*MsgSubmitBlockHeaders))
+var xxx_messageInfo_MsgLiftEmergencyPause proto.InternalMessageInfo
+
+func (m *MsgLiftEmergencyPause) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_SubmitDepositTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSubmitDepositTransaction)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SubmitDepositTransaction(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/side.btcbridge.Msg/SubmitDepositTransaction",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SubmitDepositTransaction(ctx, req.(*MsgSubmitDepositTransaction))
+func (m *MsgLiftEmergencyPause) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_SubmitWithdrawTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSubmitWithdrawTransaction)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SubmitWithdrawTransaction(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/side.btcbridge.Msg/SubmitWithdrawTransaction",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SubmitWithdrawTransaction(ctx, req.(*MsgSubmitWithdrawTransaction))
-	}
-	return interceptor(ctx, in, info, handler)
+// MsgLiftEmergencyPauseResponse defines the Msg/LiftEmergencyPause response type.
+type MsgLiftEmergencyPauseResponse struct {
 }
 
-func _Msg_SubmitFeeRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSubmitFeeRate)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SubmitFeeRate(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/side.btcbridge.Msg/SubmitFeeRate",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SubmitFeeRate(ctx, req.(*MsgSubmitFeeRate))
+func (m *MsgLiftEmergencyPauseResponse) Reset()         { *m = MsgLiftEmergencyPauseResponse{} }
+func (m *MsgLiftEmergencyPauseResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgLiftEmergencyPauseResponse) ProtoMessage()    {}
+func (*MsgLiftEmergencyPauseResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_785ca8e1e4227068, []int{33}
+}
+func (m *MsgLiftEmergencyPauseResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgLiftEmergencyPauseResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgLiftEmergencyPauseResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgLiftEmergencyPauseResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgLiftEmergencyPauseResponse.Merge(m, src)
+}
+func (m *MsgLiftEmergencyPauseResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgLiftEmergencyPauseResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgLiftEmergencyPauseResponse.DiscardUnknown(m)
 }
 
-func _Msg_UpdateTrustedNonBtcRelayers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateTrustedNonBtcRelayers)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateTrustedNonBtcRelayers(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/side.btcbridge.Msg/UpdateTrustedNonBtcRelayers",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateTrustedNonBtcRelayers(ctx, req.(*MsgUpdateTrustedNonBtcRelayers))
+var xxx_messageInfo_MsgLiftEmergencyPauseResponse proto.InternalMessageInfo
+
+// MsgRecoverVault is the Msg/RecoverVault request type.
+type MsgRecoverVault struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// vault address to be recovered
+	Vault string `protobuf:"bytes,2,opt,name=vault,proto3" json:"vault,omitempty"`
+	// recipient address of the recovered asset
+	Recipient string `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// hex encoded x-only internal key of the vault; optional if the public key of the vault is known
+	InternalKey string `protobuf:"bytes,4,opt,name=internal_key,json=internalKey,proto3" json:"internal_key,omitempty"`
+	// maximum number of the UTXOs to be recovered; 0 means the maximum utxo number of the withdrawal params
+	MaxUtxoNum uint32 `protobuf:"varint,5,opt,name=max_utxo_num,json=maxUtxoNum,proto3" json:"max_utxo_num,omitempty"`
+}
+
+func (m *MsgRecoverVault) Reset()         { *m = MsgRecoverVault{} }
+func (m *MsgRecoverVault) String() string { return proto.CompactTextString(m) }
+func (*MsgRecoverVault) ProtoMessage()    {}
+func (*MsgRecoverVault) Descriptor() ([]byte, []int) {
+	return fileDescriptor_785ca8e1e4227068, []int{34}
+}
+func (m *MsgRecoverVault) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRecoverVault) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRecoverVault.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgRecoverVault) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRecoverVault.Merge(m, src)
+}
+func (m *MsgRecoverVault) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRecoverVault) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRecoverVault.DiscardUnknown(m)
 }
 
-func _Msg_UpdateTrustedFeeProviders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateTrustedFeeProviders)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateTrustedFeeProviders(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/side.btcbridge.Msg/UpdateTrustedFeeProviders",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateTrustedFeeProviders(ctx, req.(*MsgUpdateTrustedFeeProviders))
+var xxx_messageInfo_MsgRecoverVault proto.InternalMessageInfo
+
+func (m *MsgRecoverVault) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_WithdrawToBitcoin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgWithdrawToBitcoin)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).WithdrawToBitcoin(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/side.btcbridge.Msg/WithdrawToBitcoin",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).WithdrawToBitcoin(ctx, req.(*MsgWithdrawToBitcoin))
+func (m *MsgRecoverVault) GetVault() string {
+	if m != nil {
+		return m.Vault
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_SubmitSignatures_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSubmitSignatures)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SubmitSignatures(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/side.btcbridge.Msg/SubmitSignatures",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SubmitSignatures(ctx, req.(*MsgSubmitSignatures))
+func (m *MsgRecoverVault) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_ConsolidateVaults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgConsolidateVaults)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ConsolidateVaults(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/side.btcbridge.Msg/ConsolidateVaults",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ConsolidateVaults(ctx, req.(*MsgConsolidateVaults))
+func (m *MsgRecoverVault) GetInternalKey() string {
+	if m != nil {
+		return m.InternalKey
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_InitiateDKG_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgInitiateDKG)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *MsgRecoverVault) GetMaxUtxoNum() uint32 {
+	if m != nil {
+		return m.MaxUtxoNum
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).InitiateDKG(ctx, in)
+	return 0
+}
+
+// MsgRecoverVaultResponse defines the Msg/RecoverVault response type.
+type MsgRecoverVaultResponse struct {
+	// sequence of the signing request
+	Sequence uint64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+}
+
+func (m *MsgRecoverVaultResponse) Reset()         { *m = MsgRecoverVaultResponse{} }
+func (m *MsgRecoverVaultResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRecoverVaultResponse) ProtoMessage()    {}
+func (*MsgRecoverVaultResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_785ca8e1e4227068, []int{35}
+}
+func (m *MsgRecoverVaultResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRecoverVaultResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRecoverVaultResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/side.btcbridge.Msg/InitiateDKG",
+}
+func (m *MsgRecoverVaultResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRecoverVaultResponse.Merge(m, src)
+}
+func (m *MsgRecoverVaultResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRecoverVaultResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRecoverVaultResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRecoverVaultResponse proto.InternalMessageInfo
+
+func (m *MsgRecoverVaultResponse) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).InitiateDKG(ctx, req.(*MsgInitiateDKG))
+	return 0
+}
+
+// MsgSweepVault is the Msg/SweepVault request type.
+type MsgSweepVault struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// retired vault address to be swept
+	Vault string `protobuf:"bytes,2,opt,name=vault,proto3" json:"vault,omitempty"`
+	// base64 encoded psbt spending the outputs of the vault
+	Psbt string `protobuf:"bytes,3,opt,name=psbt,proto3" json:"psbt,omitempty"`
+}
+
+func (m *MsgSweepVault) Reset()         { *m = MsgSweepVault{} }
+func (m *MsgSweepVault) String() string { return proto.CompactTextString(m) }
+func (*MsgSweepVault) ProtoMessage()    {}
+func (*MsgSweepVault) Descriptor() ([]byte, []int) {
+	return fileDescriptor_785ca8e1e4227068, []int{36}
+}
+func (m *MsgSweepVault) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSweepVault) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSweepVault.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgSweepVault) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSweepVault.Merge(m, src)
+}
+func (m *MsgSweepVault) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSweepVault) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSweepVault.DiscardUnknown(m)
 }
 
-func _Msg_CompleteDKG_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCompleteDKG)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSweepVault proto.InternalMessageInfo
+
+func (m *MsgSweepVault) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).CompleteDKG(ctx, in)
+	return ""
+}
+
+func (m *MsgSweepVault) GetVault() string {
+	if m != nil {
+		return m.Vault
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/side.btcbridge.Msg/CompleteDKG",
+	return ""
+}
+
+func (m *MsgSweepVault) GetPsbt() string {
+	if m != nil {
+		return m.Psbt
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CompleteDKG(ctx, req.(*MsgCompleteDKG))
+	return ""
+}
+
+// MsgSweepVaultResponse defines the Msg/SweepVault response type.
+type MsgSweepVaultResponse struct {
+	// sequence of the signing request
+	Sequence uint64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+}
+
+func (m *MsgSweepVaultResponse) Reset()         { *m = MsgSweepVaultResponse{} }
+func (m *MsgSweepVaultResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSweepVaultResponse) ProtoMessage()    {}
+func (*MsgSweepVaultResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_785ca8e1e4227068, []int{37}
+}
+func (m *MsgSweepVaultResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSweepVaultResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSweepVaultResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgSweepVaultResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSweepVaultResponse.Merge(m, src)
+}
+func (m *MsgSweepVaultResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSweepVaultResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSweepVaultResponse.DiscardUnknown(m)
 }
 
-func _Msg_TransferVault_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgTransferVault)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSweepVaultResponse proto.InternalMessageInfo
+
+func (m *MsgSweepVaultResponse) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).TransferVault(ctx, in)
+	return 0
+}
+
+// MsgSubmitNonceCommitments is the Msg/SubmitNonceCommitments request type.
+type MsgSubmitNonceCommitments struct {
+	// the sender, i.e. the account of the signer operator
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// tx hash of the signing request
+	Txid string `protobuf:"bytes,2,opt,name=txid,proto3" json:"txid,omitempty"`
+	// nonce commitments for each input
+	Commitments []FrostNonceCommitment `protobuf:"bytes,3,rep,name=commitments,proto3" json:"commitments"`
+}
+
+func (m *MsgSubmitNonceCommitments) Reset()         { *m = MsgSubmitNonceCommitments{} }
+func (m *MsgSubmitNonceCommitments) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitNonceCommitments) ProtoMessage()    {}
+func (*MsgSubmitNonceCommitments) Descriptor() ([]byte, []int) {
+	return fileDescriptor_785ca8e1e4227068, []int{38}
+}
+func (m *MsgSubmitNonceCommitments) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitNonceCommitments) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitNonceCommitments.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/side.btcbridge.Msg/TransferVault",
+}
+func (m *MsgSubmitNonceCommitments) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitNonceCommitments.Merge(m, src)
+}
+func (m *MsgSubmitNonceCommitments) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitNonceCommitments) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitNonceCommitments.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitNonceCommitments proto.InternalMessageInfo
+
+func (m *MsgSubmitNonceCommitments) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).TransferVault(ctx, req.(*MsgTransferVault))
+	return ""
+}
+
+func (m *MsgSubmitNonceCommitments) GetTxid() string {
+	if m != nil {
+		return m.Txid
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateParams)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *MsgSubmitNonceCommitments) GetCommitments() []FrostNonceCommitment {
+	if m != nil {
+		return m.Commitments
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/side.btcbridge.Msg/UpdateParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
-	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var Msg_serviceDesc = _Msg_serviceDesc
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "side.btcbridge.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "SubmitBlockHeaders",
-			Handler:    _Msg_SubmitBlockHeaders_Handler,
-		},
-		{
-			MethodName: "SubmitDepositTransaction",
-			Handler:    _Msg_SubmitDepositTransaction_Handler,
-		},
-		{
-			MethodName: "SubmitWithdrawTransaction",
-			Handler:    _Msg_SubmitWithdrawTransaction_Handler,
-		},
-		{
-			MethodName: "SubmitFeeRate",
-			Handler:    _Msg_SubmitFeeRate_Handler,
-		},
-		{
-			MethodName: "UpdateTrustedNonBtcRelayers",
-			Handler:    _Msg_UpdateTrustedNonBtcRelayers_Handler,
-		},
-		{
-			MethodName: "UpdateTrustedFeeProviders",
-			Handler:    _Msg_UpdateTrustedFeeProviders_Handler,
-		},
-		{
-			MethodName: "WithdrawToBitcoin",
-			Handler:    _Msg_WithdrawToBitcoin_Handler,
-		},
-		{
-			MethodName: "SubmitSignatures",
-			Handler:    _Msg_SubmitSignatures_Handler,
-		},
-		{
-			MethodName: "ConsolidateVaults",
-			Handler:    _Msg_ConsolidateVaults_Handler,
-		},
-		{
-			MethodName: "InitiateDKG",
-			Handler:    _Msg_InitiateDKG_Handler,
-		},
-		{
-			MethodName: "CompleteDKG",
-			Handler:    _Msg_CompleteDKG_Handler,
-		},
-		{
-			MethodName: "TransferVault",
-			Handler:    _Msg_TransferVault_Handler,
-		},
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "side/btcbridge/tx.proto",
+// MsgSubmitNonceCommitmentsResponse defines the Msg/SubmitNonceCommitments response type.
+type MsgSubmitNonceCommitmentsResponse struct {
 }
 
-func (m *MsgSubmitBlockHeaders) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgSubmitNonceCommitmentsResponse) Reset()         { *m = MsgSubmitNonceCommitmentsResponse{} }
+func (m *MsgSubmitNonceCommitmentsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitNonceCommitmentsResponse) ProtoMessage()    {}
+func (*MsgSubmitNonceCommitmentsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_785ca8e1e4227068, []int{39}
 }
-
-func (m *MsgSubmitBlockHeaders) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSubmitNonceCommitmentsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *MsgSubmitBlockHeaders) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.BlockHeaders) > 0 {
-		for iNdEx := len(m.BlockHeaders) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.BlockHeaders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+func (m *MsgSubmitNonceCommitmentsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitNonceCommitmentsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgSubmitBlockHeadersResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgSubmitNonceCommitmentsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitNonceCommitmentsResponse.Merge(m, src)
 }
-
-func (m *MsgSubmitBlockHeadersResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSubmitNonceCommitmentsResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *MsgSubmitBlockHeadersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgSubmitNonceCommitmentsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitNonceCommitmentsResponse.DiscardUnknown(m)
 }
 
-func (m *MsgSubmitDepositTransaction) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
+var xxx_messageInfo_MsgSubmitNonceCommitmentsResponse proto.InternalMessageInfo
 
-func (m *MsgSubmitDepositTransaction) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgSubmitPartialSignatures is the Msg/SubmitPartialSignatures request type.
+type MsgSubmitPartialSignatures struct {
+	// the sender, i.e. the account of the signer operator
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// tx hash of the signing request
+	Txid string `protobuf:"bytes,2,opt,name=txid,proto3" json:"txid,omitempty"`
+	// hex encoded partial signatures for each input
+	PartialSignatures []string `protobuf:"bytes,3,rep,name=partial_signatures,json=partialSignatures,proto3" json:"partial_signatures,omitempty"`
 }
 
-func (m *MsgSubmitDepositTransaction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Proof) > 0 {
-		for iNdEx := len(m.Proof) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Proof[iNdEx])
-			copy(dAtA[i:], m.Proof[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Proof[iNdEx])))
-			i--
-			dAtA[i] = 0x2a
+func (m *MsgSubmitPartialSignatures) Reset()         { *m = MsgSubmitPartialSignatures{} }
+func (m *MsgSubmitPartialSignatures) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitPartialSignatures) ProtoMessage()    {}
+func (*MsgSubmitPartialSignatures) Descriptor() ([]byte, []int) {
+	return fileDescriptor_785ca8e1e4227068, []int{40}
+}
+func (m *MsgSubmitPartialSignatures) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitPartialSignatures) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitPartialSignatures.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.TxBytes) > 0 {
-		i -= len(m.TxBytes)
-		copy(dAtA[i:], m.TxBytes)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TxBytes)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.PrevTxBytes) > 0 {
-		i -= len(m.PrevTxBytes)
-		copy(dAtA[i:], m.PrevTxBytes)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.PrevTxBytes)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Blockhash) > 0 {
-		i -= len(m.Blockhash)
-		copy(dAtA[i:], m.Blockhash)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Blockhash)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgSubmitPartialSignatures) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitPartialSignatures.Merge(m, src)
+}
+func (m *MsgSubmitPartialSignatures) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitPartialSignatures) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitPartialSignatures.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitPartialSignatures proto.InternalMessageInfo
+
+func (m *MsgSubmitPartialSignatures) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgSubmitDepositTransactionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSubmitPartialSignatures) GetTxid() string {
+	if m != nil {
+		return m.Txid
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgSubmitDepositTransactionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSubmitPartialSignatures) GetPartialSignatures() []string {
+	if m != nil {
+		return m.PartialSignatures
+	}
+	return nil
 }
 
-func (m *MsgSubmitDepositTransactionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgSubmitPartialSignaturesResponse defines the Msg/SubmitPartialSignatures response type.
+type MsgSubmitPartialSignaturesResponse struct {
 }
 
-func (m *MsgSubmitWithdrawTransaction) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSubmitPartialSignaturesResponse) Reset()         { *m = MsgSubmitPartialSignaturesResponse{} }
+func (m *MsgSubmitPartialSignaturesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitPartialSignaturesResponse) ProtoMessage()    {}
+func (*MsgSubmitPartialSignaturesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_785ca8e1e4227068, []int{41}
+}
+func (m *MsgSubmitPartialSignaturesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitPartialSignaturesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitPartialSignaturesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgSubmitWithdrawTransaction) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSubmitPartialSignaturesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitPartialSignaturesResponse.Merge(m, src)
 }
-
-func (m *MsgSubmitWithdrawTransaction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Proof) > 0 {
-		for iNdEx := len(m.Proof) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Proof[iNdEx])
-			copy(dAtA[i:], m.Proof[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Proof[iNdEx])))
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if len(m.TxBytes) > 0 {
-		i -= len(m.TxBytes)
-		copy(dAtA[i:], m.TxBytes)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TxBytes)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Blockhash) > 0 {
-		i -= len(m.Blockhash)
-		copy(dAtA[i:], m.Blockhash)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Blockhash)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgSubmitWithdrawTransactionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgSubmitPartialSignaturesResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *MsgSubmitWithdrawTransactionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSubmitPartialSignaturesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitPartialSignaturesResponse.DiscardUnknown(m)
 }
 
-func (m *MsgSubmitWithdrawTransactionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
+var xxx_messageInfo_MsgSubmitPartialSignaturesResponse proto.InternalMessageInfo
 
-func (m *MsgSubmitFeeRate) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// MsgUpdateParams is the Msg/UpdateParams request type.
+//
+// Since: cosmos-sdk 0.47
+type MsgUpdateParams struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// params defines the x/btcbridge parameters to be updated.
+	//
+	// NOTE: All parameters must be supplied.
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
 }
 
-func (m *MsgSubmitFeeRate) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_785ca8e1e4227068, []int{42}
 }
-
-func (m *MsgSubmitFeeRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.FeeRate != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.FeeRate))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *MsgSubmitFeeRateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgSubmitFeeRateResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
 }
-
-func (m *MsgSubmitFeeRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *MsgUpdateTrustedNonBtcRelayers) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateTrustedNonBtcRelayers) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
 
-func (m *MsgUpdateTrustedNonBtcRelayers) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Relayers) > 0 {
-		for iNdEx := len(m.Relayers) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Relayers[iNdEx])
-			copy(dAtA[i:], m.Relayers[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Relayers[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgUpdateParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgUpdateTrustedNonBtcRelayersResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateParams) GetParams() Params {
+	if m != nil {
+		return m.Params
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgUpdateTrustedNonBtcRelayersResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return Params{}
 }
 
-func (m *MsgUpdateTrustedNonBtcRelayersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgUpdateParamsResponse defines the Msg/UpdateParams response type.
+//
+// Since: cosmos-sdk 0.47
+type MsgUpdateParamsResponse struct {
 }
 
-func (m *MsgUpdateTrustedFeeProviders) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_785ca8e1e4227068, []int{43}
 }
-
-func (m *MsgUpdateTrustedFeeProviders) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *MsgUpdateTrustedFeeProviders) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.FeeProviders) > 0 {
-		for iNdEx := len(m.FeeProviders) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.FeeProviders[iNdEx])
-			copy(dAtA[i:], m.FeeProviders[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.FeeProviders[iNdEx])))
-			i--
-			dAtA[i] = 0x12
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgUpdateTrustedFeeProvidersResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateTrustedFeeProvidersResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgSubmitBlockHeaders)(nil), "side.btcbridge.MsgSubmitBlockHeaders")
+	proto.RegisterType((*MsgSubmitBlockHeadersResponse)(nil), "side.btcbridge.MsgSubmitBlockHeadersResponse")
+	proto.RegisterType((*MsgSubmitDepositTransaction)(nil), "side.btcbridge.MsgSubmitDepositTransaction")
+	proto.RegisterType((*MsgSubmitDepositTransactionResponse)(nil), "side.btcbridge.MsgSubmitDepositTransactionResponse")
+	proto.RegisterType((*MsgSubmitWithdrawTransaction)(nil), "side.btcbridge.MsgSubmitWithdrawTransaction")
+	proto.RegisterType((*MsgSubmitWithdrawTransactionResponse)(nil), "side.btcbridge.MsgSubmitWithdrawTransactionResponse")
+	proto.RegisterType((*MsgSubmitFeeRate)(nil), "side.btcbridge.MsgSubmitFeeRate")
+	proto.RegisterType((*MsgSubmitFeeRateResponse)(nil), "side.btcbridge.MsgSubmitFeeRateResponse")
+	proto.RegisterType((*MsgUpdateTrustedNonBtcRelayers)(nil), "side.btcbridge.MsgUpdateTrustedNonBtcRelayers")
+	proto.RegisterType((*MsgUpdateTrustedNonBtcRelayersResponse)(nil), "side.btcbridge.MsgUpdateTrustedNonBtcRelayersResponse")
+	proto.RegisterType((*MsgUpdateTrustedFeeProviders)(nil), "side.btcbridge.MsgUpdateTrustedFeeProviders")
+	proto.RegisterType((*MsgUpdateTrustedFeeProvidersResponse)(nil), "side.btcbridge.MsgUpdateTrustedFeeProvidersResponse")
+	proto.RegisterType((*MsgWithdrawToBitcoin)(nil), "side.btcbridge.MsgWithdrawToBitcoin")
+	proto.RegisterType((*MsgWithdrawToBitcoinResponse)(nil), "side.btcbridge.MsgWithdrawToBitcoinResponse")
+	proto.RegisterType((*MsgSubmitSignatures)(nil), "side.btcbridge.MsgSubmitSignatures")
+	proto.RegisterType((*MsgSubmitSignaturesResponse)(nil), "side.btcbridge.MsgSubmitSignaturesResponse")
+	proto.RegisterType((*MsgConsolidateVaults)(nil), "side.btcbridge.MsgConsolidateVaults")
+	proto.RegisterType((*MsgConsolidateVaultsResponse)(nil), "side.btcbridge.MsgConsolidateVaultsResponse")
+	proto.RegisterType((*MsgInitiateDKG)(nil), "side.btcbridge.MsgInitiateDKG")
+	proto.RegisterType((*MsgInitiateDKGResponse)(nil), "side.btcbridge.MsgInitiateDKGResponse")
+	proto.RegisterType((*MsgCompleteDKG)(nil), "side.btcbridge.MsgCompleteDKG")
+	proto.RegisterType((*MsgCompleteDKGResponse)(nil), "side.btcbridge.MsgCompleteDKGResponse")
+	proto.RegisterType((*MsgTransferVault)(nil), "side.btcbridge.MsgTransferVault")
+	proto.RegisterType((*MsgTransferVaultResponse)(nil), "side.btcbridge.MsgTransferVaultResponse")
+	proto.RegisterType((*MsgRegisterRunes)(nil), "side.btcbridge.MsgRegisterRunes")
+	proto.RegisterType((*MsgRegisterRunesResponse)(nil), "side.btcbridge.MsgRegisterRunesResponse")
+	proto.RegisterType((*MsgRegisterBrc20Tickers)(nil), "side.btcbridge.MsgRegisterBrc20Tickers")
+	proto.RegisterType((*MsgRegisterBrc20TickersResponse)(nil), "side.btcbridge.MsgRegisterBrc20TickersResponse")
+	proto.RegisterType((*MsgResetCircuitBreaker)(nil), "side.btcbridge.MsgResetCircuitBreaker")
+	proto.RegisterType((*MsgResetCircuitBreakerResponse)(nil), "side.btcbridge.MsgResetCircuitBreakerResponse")
+	proto.RegisterType((*MsgEmergencyPause)(nil), "side.btcbridge.MsgEmergencyPause")
+	proto.RegisterType((*MsgEmergencyPauseResponse)(nil), "side.btcbridge.MsgEmergencyPauseResponse")
+	proto.RegisterType((*MsgLiftEmergencyPause)(nil), "side.btcbridge.MsgLiftEmergencyPause")
+	proto.RegisterType((*MsgLiftEmergencyPauseResponse)(nil), "side.btcbridge.MsgLiftEmergencyPauseResponse")
+	proto.RegisterType((*MsgRecoverVault)(nil), "side.btcbridge.MsgRecoverVault")
+	proto.RegisterType((*MsgRecoverVaultResponse)(nil), "side.btcbridge.MsgRecoverVaultResponse")
+	proto.RegisterType((*MsgSweepVault)(nil), "side.btcbridge.MsgSweepVault")
+	proto.RegisterType((*MsgSweepVaultResponse)(nil), "side.btcbridge.MsgSweepVaultResponse")
+	proto.RegisterType((*MsgSubmitNonceCommitments)(nil), "side.btcbridge.MsgSubmitNonceCommitments")
+	proto.RegisterType((*MsgSubmitNonceCommitmentsResponse)(nil), "side.btcbridge.MsgSubmitNonceCommitmentsResponse")
+	proto.RegisterType((*MsgSubmitPartialSignatures)(nil), "side.btcbridge.MsgSubmitPartialSignatures")
+	proto.RegisterType((*MsgSubmitPartialSignaturesResponse)(nil), "side.btcbridge.MsgSubmitPartialSignaturesResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "side.btcbridge.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "side.btcbridge.MsgUpdateParamsResponse")
 }
 
-func (m *MsgUpdateTrustedFeeProvidersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func init() { proto.RegisterFile("side/btcbridge/tx.proto", fileDescriptor_785ca8e1e4227068) }
+
+var fileDescriptor_785ca8e1e4227068 = []byte{
+	// 1973 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x59, 0x4b, 0x6f, 0x1b, 0xc9,
+	0x11, 0x36, 0xf5, 0x56, 0x51, 0x92, 0xed, 0xb1, 0x56, 0xa2, 0x46, 0x32, 0x25, 0xd3, 0xb6, 0xac,
+	0xf5, 0x83, 0xdc, 0xa5, 0x9d, 0x07, 0x7c, 0x49, 0x96, 0x72, 0xb4, 0x1b, 0x78, 0x65, 0x18, 0x63,
+	0xc9, 0x79, 0x01, 0x21, 0x9a, 0xc3, 0xd6, 0x68, 0x56, 0x9c, 0xe9, 0x49, 0x77, 0x0f, 0x4d, 0x02,
+	0x41, 0x12, 0x2c, 0x12, 0xe4, 0x98, 0x20, 0xa7, 0x9c, 0x03, 0xe4, 0x18, 0x60, 0x2f, 0x09, 0xf2,
+	0x0f, 0xb2, 0xc7, 0x3d, 0xe6, 0x94, 0x04, 0xf6, 0x61, 0xff, 0x46, 0xd0, 0x3d, 0x33, 0xcd, 0x79,
+	0x92, 0xb4, 0xf7, 0xa4, 0xe9, 0xea, 0xaf, 0xab, 0xbe, 0xae, 0xae, 0xaa, 0xae, 0xa6, 0x60, 0x93,
+	0xd9, 0x5d, 0xdc, 0xe8, 0x70, 0xb3, 0x43, 0xed, 0xae, 0x85, 0x1b, 0x7c, 0x50, 0xf7, 0x28, 0xe1,
+	0x44, 0x5b, 0x13, 0x13, 0x75, 0x35, 0xa1, 0x6f, 0x9a, 0x84, 0x39, 0x84, 0x35, 0x1c, 0x66, 0x35,
+	0xfa, 0x1f, 0x8a, 0x3f, 0x01, 0x50, 0x5f, 0xb7, 0x88, 0x45, 0xe4, 0x67, 0x43, 0x7c, 0x85, 0xd2,
+	0xaa, 0x45, 0x88, 0xd5, 0xc3, 0x0d, 0x39, 0xea, 0xf8, 0x67, 0x8d, 0xae, 0x4f, 0x11, 0xb7, 0x89,
+	0x1b, 0xce, 0x6f, 0xa7, 0xec, 0x7a, 0x88, 0x22, 0x87, 0x45, 0x8b, 0x53, 0x93, 0xea, 0x2b, 0x98,
+	0xaf, 0xfd, 0x0a, 0xde, 0x3b, 0x66, 0xd6, 0x0b, 0xbf, 0xe3, 0xd8, 0xbc, 0xd5, 0x23, 0xe6, 0xc5,
+	0x27, 0x18, 0x75, 0x31, 0x65, 0xda, 0x06, 0x2c, 0x30, 0xec, 0x76, 0x31, 0xad, 0x94, 0xf6, 0x4a,
+	0x07, 0xcb, 0x46, 0x38, 0xd2, 0xbe, 0x0f, 0xab, 0x1d, 0x81, 0x6b, 0x9f, 0x07, 0xc0, 0xca, 0xcc,
+	0xde, 0xec, 0x41, 0xb9, 0xb9, 0x5d, 0x4f, 0x6e, 0xb2, 0x1e, 0x53, 0x66, 0xac, 0x74, 0x46, 0x03,
+	0xf6, 0xb8, 0xfc, 0xf9, 0xd7, 0x5f, 0xdc, 0x0d, 0xd5, 0xd5, 0x76, 0xe1, 0x7a, 0xae, 0x7d, 0x03,
+	0x33, 0x8f, 0xb8, 0x0c, 0xd7, 0xfe, 0x5e, 0x82, 0x6d, 0x85, 0x78, 0x82, 0x3d, 0xc2, 0x6c, 0x7e,
+	0x42, 0x91, 0xcb, 0x90, 0x29, 0x7c, 0x50, 0xc8, 0x73, 0x07, 0x96, 0xa5, 0xd5, 0x73, 0xc4, 0xce,
+	0x2b, 0x33, 0x72, 0x6a, 0x24, 0xd0, 0x6a, 0xb0, 0xea, 0x51, 0xdc, 0x6f, 0xf3, 0x41, 0xbb, 0x33,
+	0xe4, 0x98, 0x55, 0x66, 0x25, 0xa2, 0x2c, 0x84, 0x27, 0x83, 0x96, 0x10, 0x69, 0x5b, 0xb0, 0xa4,
+	0xa6, 0xe7, 0xe4, 0xf4, 0x22, 0x0f, 0xa7, 0xd6, 0x61, 0xde, 0xa3, 0x84, 0x9c, 0x55, 0xe6, 0xf7,
+	0x66, 0x0f, 0x96, 0x8d, 0x60, 0x90, 0xdc, 0xd8, 0x6d, 0xb8, 0x39, 0x86, 0xb6, 0xda, 0xde, 0x9f,
+	0x4a, 0xb0, 0xa3, 0x70, 0x3f, 0xb2, 0xf9, 0x79, 0x97, 0xa2, 0x57, 0xdf, 0x7c, 0x7f, 0x71, 0xee,
+	0xb3, 0x05, 0xdc, 0xe7, 0x0a, 0xb9, 0xef, 0xc3, 0xad, 0x71, 0x9c, 0x14, 0x79, 0x03, 0xae, 0x28,
+	0xdc, 0x11, 0xc6, 0x06, 0xe2, 0xb8, 0x90, 0xef, 0x16, 0x2c, 0x9d, 0x61, 0xdc, 0xa6, 0x88, 0x63,
+	0x49, 0x77, 0xd6, 0x58, 0x3c, 0x0b, 0x96, 0x24, 0x6d, 0xeb, 0x50, 0x49, 0xeb, 0x54, 0xf6, 0x10,
+	0x54, 0x8f, 0x99, 0x75, 0xea, 0x75, 0x11, 0xc7, 0x27, 0xd4, 0x67, 0x1c, 0x77, 0x9f, 0x11, 0xb7,
+	0xc5, 0x4d, 0x03, 0xf7, 0xd0, 0x70, 0x5c, 0xd4, 0xea, 0xb0, 0x44, 0x43, 0x8c, 0x0c, 0xd8, 0x65,
+	0x43, 0x8d, 0x93, 0xe6, 0x0f, 0x60, 0x7f, 0xbc, 0x09, 0x45, 0xc6, 0x82, 0x9d, 0x34, 0xf2, 0x08,
+	0xe3, 0xe7, 0x94, 0xf4, 0xed, 0xb1, 0x09, 0x54, 0x83, 0x95, 0x38, 0x2e, 0xa4, 0x93, 0x90, 0xe5,
+	0x9d, 0x46, 0xa1, 0x21, 0x45, 0xe8, 0xf7, 0x25, 0x58, 0x3f, 0x66, 0x96, 0x3a, 0x30, 0xd2, 0xb2,
+	0xb9, 0x49, 0xec, 0xe2, 0x10, 0xda, 0x80, 0x05, 0xe4, 0x10, 0xdf, 0xe5, 0x61, 0xfc, 0x84, 0xa3,
+	0xc4, 0x51, 0xcd, 0x26, 0x8e, 0x4a, 0xdb, 0x84, 0x45, 0x07, 0x0d, 0xda, 0x67, 0x18, 0xcb, 0x94,
+	0x98, 0x35, 0x16, 0x1c, 0x34, 0x38, 0xc2, 0xa9, 0x33, 0xac, 0xc2, 0x4e, 0x1e, 0x11, 0xc5, 0xb4,
+	0x03, 0xd7, 0xd4, 0x19, 0xbf, 0xb0, 0x2d, 0x17, 0x71, 0x9f, 0xe2, 0x62, 0x8f, 0x69, 0x30, 0xc7,
+	0x07, 0x76, 0x37, 0x64, 0x29, 0xbf, 0x85, 0xcc, 0x63, 0x1d, 0x1e, 0x06, 0xb7, 0xfc, 0x4e, 0x72,
+	0xb8, 0x0e, 0xdb, 0x39, 0x36, 0x14, 0x85, 0x3f, 0xcc, 0x48, 0x67, 0x1d, 0x12, 0x97, 0x91, 0x9e,
+	0x2d, 0x5c, 0xfb, 0x12, 0xf9, 0x3d, 0xce, 0x44, 0x5e, 0x21, 0x9f, 0x9f, 0x13, 0x6a, 0xf3, 0x61,
+	0xc8, 0x63, 0x24, 0xd0, 0x6e, 0xc2, 0x6a, 0x5f, 0xe0, 0xda, 0x7d, 0x4c, 0x99, 0x4d, 0x5c, 0xc9,
+	0x69, 0xce, 0x58, 0x91, 0xc2, 0x97, 0x81, 0x4c, 0x3b, 0x86, 0xab, 0x1d, 0x6e, 0xb6, 0x4d, 0xa5,
+	0x5b, 0x00, 0x05, 0xd1, 0x72, 0x73, 0x2f, 0x53, 0x26, 0xb9, 0x79, 0x18, 0xc7, 0x19, 0x57, 0x3a,
+	0x29, 0x89, 0x76, 0x0a, 0xeb, 0xd4, 0x77, 0x31, 0x4b, 0x2a, 0x64, 0x32, 0x7f, 0xcb, 0xcd, 0x5a,
+	0x5a, 0xa3, 0x21, 0xb0, 0x49, 0x9d, 0xd7, 0x68, 0x46, 0xc6, 0x1e, 0xaf, 0x09, 0x6f, 0x8d, 0xb6,
+	0x16, 0x1e, 0x5a, 0xc6, 0x21, 0xca, 0x63, 0x7f, 0x9b, 0x81, 0xb5, 0x63, 0x66, 0xfd, 0xd0, 0xb5,
+	0xb9, 0x8d, 0x38, 0x7e, 0xf2, 0xf4, 0xe3, 0x09, 0xbe, 0x6a, 0xc1, 0x8a, 0x87, 0x28, 0xb7, 0x4d,
+	0xdb, 0x43, 0x2e, 0x8f, 0x2e, 0x8a, 0x6a, 0x9a, 0xef, 0x93, 0xa7, 0x1f, 0x3f, 0x1f, 0xc1, 0x8c,
+	0xc4, 0x1a, 0x61, 0x81, 0x9f, 0x53, 0xcc, 0xce, 0x49, 0xaf, 0x2b, 0x5d, 0xb8, 0x6a, 0x8c, 0x04,
+	0xda, 0x63, 0x28, 0x07, 0xa7, 0xc1, 0x87, 0x1e, 0x0e, 0x1c, 0xb2, 0xd6, 0xdc, 0x4a, 0x1b, 0xf8,
+	0x88, 0x31, 0xcc, 0x4f, 0x86, 0x1e, 0x36, 0x40, 0xa2, 0xc5, 0x27, 0xd3, 0xee, 0xc0, 0x65, 0xec,
+	0xa2, 0x4e, 0x0f, 0xb7, 0xb9, 0xa8, 0x6c, 0x67, 0x98, 0x56, 0xe6, 0xf7, 0x4a, 0x07, 0x4b, 0xc6,
+	0x5a, 0x20, 0x3e, 0x09, 0xa5, 0xda, 0x3e, 0x5c, 0xe6, 0x88, 0x5a, 0x98, 0xb7, 0x7d, 0x3e, 0x20,
+	0x6d, 0xd7, 0x77, 0x2a, 0x0b, 0x92, 0xc8, 0x6a, 0x20, 0x3e, 0xe5, 0x03, 0xf2, 0xcc, 0x77, 0x32,
+	0xfe, 0xac, 0xc0, 0x46, 0xd2, 0x5d, 0xca, 0x93, 0xff, 0x08, 0x3c, 0x79, 0x48, 0x1c, 0xaf, 0x87,
+	0x03, 0x4f, 0x16, 0x85, 0xfe, 0x1a, 0xcc, 0x84, 0x81, 0x3f, 0x67, 0xcc, 0xd8, 0x5d, 0x81, 0x93,
+	0x7b, 0x10, 0x55, 0x5d, 0x94, 0x8d, 0x70, 0xa4, 0xdd, 0x83, 0xab, 0x22, 0x3a, 0xb0, 0xcb, 0x7c,
+	0xd6, 0x46, 0xdd, 0x2e, 0xc5, 0x2c, 0xba, 0xb4, 0xae, 0xa8, 0x89, 0x8f, 0x02, 0xb9, 0x70, 0x2a,
+	0x8b, 0x32, 0x42, 0x6e, 0x7a, 0xd9, 0x18, 0x09, 0x44, 0xf6, 0x7b, 0x7e, 0xa7, 0x7d, 0x81, 0x87,
+	0xac, 0xb2, 0x20, 0x8d, 0x2c, 0x7a, 0x7e, 0xe7, 0x29, 0x1e, 0xca, 0x04, 0x95, 0xb7, 0x05, 0xab,
+	0x2c, 0x06, 0xd6, 0x83, 0x91, 0xf6, 0x13, 0xb8, 0xd6, 0xc7, 0xd4, 0x3e, 0xb3, 0x4d, 0x19, 0x5b,
+	0x6d, 0x76, 0x8e, 0x28, 0x66, 0x95, 0xa5, 0xfc, 0x00, 0x7d, 0x19, 0x83, 0xbe, 0x90, 0xc8, 0xd6,
+	0xdc, 0x97, 0xff, 0xd9, 0xbd, 0x64, 0x68, 0xfd, 0xcc, 0x4c, 0x32, 0xa7, 0x03, 0x97, 0xc6, 0xfc,
+	0xa6, 0x5c, 0xfa, 0xf9, 0x8c, 0xbc, 0x8a, 0xa2, 0x43, 0x93, 0xa1, 0x3b, 0x21, 0x3c, 0x6f, 0xc3,
+	0x1a, 0x23, 0x3e, 0x35, 0x71, 0x2a, 0x97, 0x57, 0x03, 0x69, 0x94, 0xcc, 0x37, 0x60, 0xa5, 0x8b,
+	0xd9, 0x28, 0xe1, 0x67, 0x25, 0xa8, 0x2c, 0x64, 0x11, 0xe4, 0xbb, 0x00, 0x48, 0xc4, 0x98, 0x0c,
+	0x43, 0xe9, 0xf5, 0xb1, 0x51, 0xb8, 0x8c, 0xa2, 0x4f, 0x79, 0x17, 0xb3, 0x0e, 0x67, 0xaa, 0x8f,
+	0x10, 0x83, 0x77, 0x8e, 0xb8, 0xe0, 0xea, 0x4c, 0xf8, 0x40, 0x39, 0xe8, 0x42, 0xfa, 0xc7, 0xc0,
+	0x96, 0xcd, 0x38, 0xa6, 0xb2, 0x46, 0x14, 0x06, 0x5d, 0x13, 0xe6, 0x65, 0xc1, 0x08, 0x33, 0x76,
+	0x27, 0xaf, 0xc2, 0x1c, 0x63, 0x8e, 0xba, 0x88, 0x23, 0x23, 0x80, 0xe6, 0xdd, 0xe1, 0x09, 0x63,
+	0x8a, 0xc8, 0x2b, 0xd8, 0x8c, 0xcd, 0xb5, 0xa8, 0xd9, 0xfc, 0xe0, 0xc4, 0x36, 0x2f, 0xc6, 0xdd,
+	0x98, 0xdf, 0x81, 0x45, 0x1e, 0x40, 0x42, 0x46, 0xd7, 0x33, 0x55, 0x54, 0xa8, 0x51, 0x94, 0x22,
+	0x74, 0x92, 0xd4, 0x0d, 0xd8, 0x2d, 0x30, 0xac, 0xb8, 0x1d, 0xc9, 0xf8, 0x32, 0x30, 0xc3, 0xfc,
+	0xd0, 0xa6, 0xa6, 0x6f, 0xf3, 0x16, 0xc5, 0xe8, 0x22, 0xe8, 0xb6, 0x8a, 0x43, 0x29, 0x73, 0x10,
+	0x7b, 0x50, 0xcd, 0xd7, 0xa3, 0x2c, 0xfd, 0xab, 0x04, 0x57, 0x8f, 0x99, 0xf5, 0x03, 0x07, 0x53,
+	0x0b, 0xbb, 0xe6, 0xf0, 0x39, 0xf2, 0x19, 0x16, 0x5d, 0x8a, 0xe5, 0x23, 0xda, 0xb5, 0x91, 0x1b,
+	0x1a, 0x51, 0x63, 0xed, 0x03, 0x98, 0x67, 0x26, 0xf1, 0x82, 0xe6, 0x69, 0xad, 0xa9, 0xa7, 0x5d,
+	0x20, 0x35, 0xbc, 0x10, 0x08, 0x23, 0x00, 0x8a, 0xe0, 0xea, 0x62, 0x97, 0x38, 0xe1, 0x1d, 0x19,
+	0x0c, 0xb4, 0xef, 0xc1, 0x52, 0xf4, 0x7e, 0x90, 0xa1, 0x5a, 0x6e, 0x6e, 0xd5, 0x83, 0x07, 0x46,
+	0x3d, 0x7a, 0x60, 0xd4, 0x9f, 0x84, 0x80, 0xd6, 0x92, 0xc8, 0xcb, 0x3f, 0xff, 0x77, 0xb7, 0x64,
+	0xa8, 0x45, 0x8f, 0x57, 0xc5, 0x66, 0x15, 0xaf, 0xda, 0x3d, 0xd8, 0xca, 0x6c, 0x24, 0xda, 0x66,
+	0x58, 0xbe, 0x4a, 0x51, 0xf9, 0xaa, 0x9d, 0xca, 0xd7, 0xc6, 0xa7, 0xf6, 0x19, 0x4f, 0xed, 0x7c,
+	0x7c, 0xaa, 0xa6, 0xaa, 0x60, 0xc6, 0xdf, 0xc1, 0x23, 0x22, 0xab, 0x56, 0xb9, 0xfb, 0x9f, 0x25,
+	0xb8, 0x2c, 0x4f, 0xc4, 0x24, 0xfd, 0xe9, 0xaa, 0xc3, 0x3a, 0xcc, 0xcb, 0xd2, 0x1a, 0x36, 0x1d,
+	0xf3, 0xfd, 0x68, 0x0d, 0xc5, 0xa6, 0xed, 0xd9, 0xd8, 0x8d, 0x5a, 0x8f, 0x91, 0x40, 0x94, 0x0a,
+	0xdb, 0xe5, 0x98, 0xba, 0xa8, 0x27, 0xca, 0x67, 0x58, 0x7f, 0xcb, 0x91, 0xec, 0x29, 0x1e, 0x6a,
+	0x7b, 0xb0, 0x22, 0xfa, 0x27, 0x95, 0xd7, 0xf3, 0x32, 0xaf, 0xc1, 0x41, 0x83, 0xa2, 0xa4, 0xfe,
+	0x16, 0x6c, 0xa6, 0x98, 0x2b, 0xef, 0xea, 0xb0, 0xc4, 0xf0, 0x2f, 0x7c, 0xec, 0x9a, 0x38, 0xf4,
+	0xb1, 0x1a, 0xd7, 0x2c, 0x58, 0x15, 0xed, 0xcf, 0x2b, 0x8c, 0xbd, 0x77, 0xdf, 0x6e, 0x5e, 0x93,
+	0x95, 0xe6, 0xf7, 0x10, 0xde, 0x4b, 0x18, 0x9a, 0x8a, 0xdd, 0x5f, 0x4a, 0xb0, 0xa5, 0xba, 0xb3,
+	0x67, 0xc4, 0x35, 0xf1, 0x21, 0x71, 0x1c, 0x9b, 0x3b, 0xd8, 0xe5, 0xc5, 0x75, 0x20, 0xaf, 0x0f,
+	0xfc, 0x14, 0xca, 0xe6, 0x68, 0xa9, 0xbc, 0x15, 0xcb, 0xcd, 0x5b, 0xe9, 0xe4, 0x38, 0xa2, 0x84,
+	0xa5, 0xed, 0x84, 0x97, 0x4e, 0x7c, 0x79, 0xb2, 0x60, 0xdc, 0x84, 0x1b, 0x85, 0x1c, 0x55, 0x64,
+	0xfd, 0xb6, 0x04, 0xba, 0x42, 0xc9, 0x3e, 0x06, 0xf5, 0xde, 0xb1, 0xa5, 0x7d, 0x00, 0x9a, 0x17,
+	0x28, 0x68, 0xab, 0xdb, 0x38, 0xba, 0xe7, 0xaf, 0x7a, 0x69, 0xd5, 0x49, 0xae, 0xb7, 0xa0, 0x56,
+	0xcc, 0x42, 0x91, 0xf5, 0xe1, 0xb2, 0x7a, 0x49, 0x3c, 0x97, 0xbf, 0x12, 0x4c, 0x08, 0x8b, 0x47,
+	0xb0, 0x10, 0xfc, 0x9a, 0x20, 0x89, 0x96, 0x9b, 0x1b, 0xd9, 0xaa, 0x23, 0x66, 0x43, 0x57, 0x86,
+	0xd8, 0x4c, 0x88, 0x6c, 0xc1, 0x66, 0xca, 0x6c, 0xc4, 0xa8, 0xf9, 0x57, 0x0d, 0x66, 0x8f, 0x99,
+	0xa5, 0x7d, 0x06, 0x5a, 0xce, 0x6f, 0x10, 0xb7, 0xd3, 0xe6, 0x72, 0x7f, 0x2a, 0xd0, 0x1f, 0x4c,
+	0x05, 0x53, 0x81, 0xf9, 0x4b, 0xa8, 0x14, 0xfe, 0x9a, 0x70, 0xaf, 0x50, 0x55, 0x16, 0xac, 0x3f,
+	0x7c, 0x0b, 0xb0, 0xb2, 0xfe, 0x6b, 0xd8, 0x2a, 0x7e, 0xec, 0xdf, 0x2f, 0xd4, 0x98, 0x83, 0xd6,
+	0x1f, 0xbd, 0x0d, 0x5a, 0x11, 0xf8, 0x19, 0xac, 0x26, 0x5f, 0xec, 0x7b, 0x85, 0x6a, 0x42, 0x84,
+	0x7e, 0x30, 0x09, 0xa1, 0x94, 0xff, 0xae, 0x04, 0xdb, 0xe3, 0xde, 0xe7, 0xf5, 0x1c, 0x4d, 0x63,
+	0xf0, 0xfa, 0xb7, 0xdf, 0x0e, 0x1f, 0xf7, 0x72, 0xf1, 0xcb, 0xfc, 0xfe, 0x24, 0xa5, 0x71, 0xb4,
+	0xfe, 0xe8, 0x6d, 0xd0, 0x8a, 0x80, 0x05, 0x57, 0xb3, 0x0f, 0xf1, 0x5b, 0x39, 0xaa, 0x32, 0x28,
+	0xfd, 0xfe, 0x34, 0x28, 0x65, 0xa8, 0x0b, 0x57, 0x32, 0x0f, 0xe9, 0x9b, 0x85, 0xe7, 0x35, 0x02,
+	0xe9, 0xf7, 0xa6, 0x00, 0xc5, 0xb7, 0x93, 0x7d, 0x2a, 0xe7, 0x6d, 0x27, 0x83, 0xd2, 0xef, 0x4f,
+	0x83, 0x52, 0x86, 0x4e, 0xa1, 0x1c, 0x7f, 0x61, 0x56, 0x73, 0x16, 0xc7, 0xe6, 0xf5, 0xfd, 0xf1,
+	0xf3, 0x71, 0xb5, 0xf1, 0xe7, 0x56, 0x35, 0x97, 0x93, 0x9a, 0xd7, 0xf7, 0xc7, 0xcf, 0xc7, 0x73,
+	0x29, 0xf9, 0xe4, 0xc8, 0xcb, 0xa5, 0x04, 0x42, 0x3f, 0x98, 0x84, 0x88, 0x2b, 0x4f, 0xf6, 0xeb,
+	0x79, 0xca, 0x13, 0x08, 0xfd, 0x60, 0x12, 0x42, 0x29, 0xf7, 0x60, 0x3d, 0xb7, 0x07, 0xbf, 0x33,
+	0x46, 0x43, 0x1c, 0xa8, 0x37, 0xa6, 0x04, 0x2a, 0x8b, 0x0e, 0x5c, 0xcb, 0xeb, 0xac, 0xf7, 0x73,
+	0xf5, 0x64, 0x70, 0x7a, 0x7d, 0x3a, 0x9c, 0x32, 0xf7, 0x73, 0x58, 0x4b, 0xf5, 0x98, 0x37, 0x72,
+	0x34, 0x24, 0x21, 0xfa, 0xfb, 0x13, 0x21, 0x4a, 0xff, 0x67, 0xa0, 0xe5, 0xf4, 0xb1, 0x79, 0x37,
+	0x56, 0x16, 0xa6, 0x3f, 0x98, 0x0a, 0xa6, 0x6c, 0xfd, 0x18, 0x56, 0x12, 0xad, 0xeb, 0x6e, 0xae,
+	0x2f, 0x46, 0x00, 0xfd, 0xce, 0x04, 0x80, 0xd2, 0x6c, 0x00, 0xc4, 0x7a, 0xc4, 0xeb, 0x79, 0x25,
+	0x41, 0x4d, 0xeb, 0xb7, 0xc7, 0x4e, 0x2b, 0x9d, 0x7d, 0xd8, 0x28, 0x68, 0xec, 0xde, 0x2f, 0x2c,
+	0x39, 0x69, 0xa8, 0xfe, 0xe1, 0xd4, 0x50, 0x65, 0x77, 0x08, 0x9b, 0x45, 0x6d, 0xd8, 0xdd, 0x42,
+	0x6d, 0x19, 0xac, 0xde, 0x9c, 0x1e, 0x1b, 0x3f, 0xa0, 0x44, 0x57, 0xb5, 0x5b, 0x78, 0x67, 0x04,
+	0x00, 0xfd, 0xce, 0x04, 0x40, 0xa4, 0x59, 0x9f, 0xff, 0xcd, 0xd7, 0x5f, 0xdc, 0x2d, 0xb5, 0x3e,
+	0xf9, 0xf2, 0x75, 0xb5, 0xf4, 0xd5, 0xeb, 0x6a, 0xe9, 0x7f, 0xaf, 0xab, 0xa5, 0x3f, 0xbe, 0xa9,
+	0x5e, 0xfa, 0xea, 0x4d, 0xf5, 0xd2, 0xbf, 0xdf, 0x54, 0x2f, 0xfd, 0xb4, 0x6e, 0xd9, 0xfc, 0xdc,
+	0xef, 0xd4, 0x4d, 0xe2, 0x34, 0x84, 0x4e, 0xf9, 0x88, 0x33, 0x49, 0x4f, 0x0e, 0x1a, 0x83, 0xf8,
+	0xff, 0xa3, 0xc4, 0xef, 0x5e, 0x9d, 0x05, 0x09, 0x78, 0xf8, 0xff, 0x01, 0x00, 0x47, 0x79, 0xbe,
+	0x31, 0xae, 0x1a, 0x00, 0x00,
 }
 
-func (m *MsgWithdrawToBitcoin) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// SubmitBlockHeaders submits bitcoin block headers to the side chain.
+	SubmitBlockHeaders(ctx context.Context, in *MsgSubmitBlockHeaders, opts ...grpc.CallOption) (*MsgSubmitBlockHeadersResponse, error)
+	// SubmitDepositTransaction submits the bitcoin deposit transaction to the side chain.
+	SubmitDepositTransaction(ctx context.Context, in *MsgSubmitDepositTransaction, opts ...grpc.CallOption) (*MsgSubmitDepositTransactionResponse, error)
+	// SubmitWithdrawalTransaction submits the bitcoin withdrawal transaction to the side chain.
+	SubmitWithdrawTransaction(ctx context.Context, in *MsgSubmitWithdrawTransaction, opts ...grpc.CallOption) (*MsgSubmitWithdrawTransactionResponse, error)
+	// SubmitFeeRate submits the bitcoin network fee rate to the side chain.
+	SubmitFeeRate(ctx context.Context, in *MsgSubmitFeeRate, opts ...grpc.CallOption) (*MsgSubmitFeeRateResponse, error)
+	// UpdateTrustedNonBtcRelayers updates the trusted non-btc asset relayers.
+	UpdateTrustedNonBtcRelayers(ctx context.Context, in *MsgUpdateTrustedNonBtcRelayers, opts ...grpc.CallOption) (*MsgUpdateTrustedNonBtcRelayersResponse, error)
+	// UpdateTrustedFeeProviders updates the trusted fee providers.
+	UpdateTrustedFeeProviders(ctx context.Context, in *MsgUpdateTrustedFeeProviders, opts ...grpc.CallOption) (*MsgUpdateTrustedFeeProvidersResponse, error)
+	// WithdrawToBitcoin withdraws the asset to bitcoin.
+	WithdrawToBitcoin(ctx context.Context, in *MsgWithdrawToBitcoin, opts ...grpc.CallOption) (*MsgWithdrawToBitcoinResponse, error)
+	// SubmitSignatures submits the signatures of the signing request to the side chain.
+	SubmitSignatures(ctx context.Context, in *MsgSubmitSignatures, opts ...grpc.CallOption) (*MsgSubmitSignaturesResponse, error)
+	// ConsolidateVaults performs the utxo consolidation for the given vaults.
+	ConsolidateVaults(ctx context.Context, in *MsgConsolidateVaults, opts ...grpc.CallOption) (*MsgConsolidateVaultsResponse, error)
+	// InitiateDKG initiates the DKG request.
+	InitiateDKG(ctx context.Context, in *MsgInitiateDKG, opts ...grpc.CallOption) (*MsgInitiateDKGResponse, error)
+	// CompleteDKG completes the given DKG request.
+	CompleteDKG(ctx context.Context, in *MsgCompleteDKG, opts ...grpc.CallOption) (*MsgCompleteDKGResponse, error)
+	// TransferVault transfers the vault asset from the source version to the destination version.
+	TransferVault(ctx context.Context, in *MsgTransferVault, opts ...grpc.CallOption) (*MsgTransferVaultResponse, error)
+	// RegisterRunes registers the metadata of the given runes.
+	RegisterRunes(ctx context.Context, in *MsgRegisterRunes, opts ...grpc.CallOption) (*MsgRegisterRunesResponse, error)
+	// RegisterBrc20Tickers registers the metadata of the given brc20 tickers.
+	RegisterBrc20Tickers(ctx context.Context, in *MsgRegisterBrc20Tickers, opts ...grpc.CallOption) (*MsgRegisterBrc20TickersResponse, error)
+	// ResetCircuitBreaker resets the tripped withdrawal circuit breaker.
+	ResetCircuitBreaker(ctx context.Context, in *MsgResetCircuitBreaker, opts ...grpc.CallOption) (*MsgResetCircuitBreakerResponse, error)
+	// EmergencyPause pauses the bridge in the given scope until expiration.
+	EmergencyPause(ctx context.Context, in *MsgEmergencyPause, opts ...grpc.CallOption) (*MsgEmergencyPauseResponse, error)
+	// LiftEmergencyPause lifts the given emergency pause before expiration.
+	LiftEmergencyPause(ctx context.Context, in *MsgLiftEmergencyPause, opts ...grpc.CallOption) (*MsgLiftEmergencyPauseResponse, error)
+	// RecoverVault recovers the vault asset through the timelocked recovery script path.
+	RecoverVault(ctx context.Context, in *MsgRecoverVault, opts ...grpc.CallOption) (*MsgRecoverVaultResponse, error)
+	// SweepVault sweeps the outputs of the retired vault.
+	SweepVault(ctx context.Context, in *MsgSweepVault, opts ...grpc.CallOption) (*MsgSweepVaultResponse, error)
+	// SubmitNonceCommitments submits the FROST nonce commitments of the signing request.
+	SubmitNonceCommitments(ctx context.Context, in *MsgSubmitNonceCommitments, opts ...grpc.CallOption) (*MsgSubmitNonceCommitmentsResponse, error)
+	// SubmitPartialSignatures submits the FROST partial signatures of the signing request.
+	SubmitPartialSignatures(ctx context.Context, in *MsgSubmitPartialSignatures, opts ...grpc.CallOption) (*MsgSubmitPartialSignaturesResponse, error)
+	// UpdateParams defines a governance operation for updating the x/btcbridge module
+	// parameters. The authority defaults to the x/gov module account.
+	//
+	// Since: cosmos-sdk 0.47
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 }
 
-func (m *MsgWithdrawToBitcoin) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type msgClient struct {
+	cc grpc1.ClientConn
 }
 
-func (m *MsgWithdrawToBitcoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Amount) > 0 {
-		i -= len(m.Amount)
-		copy(dAtA[i:], m.Amount)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Amount)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
 }
 
-func (m *MsgWithdrawToBitcoinResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SubmitBlockHeaders(ctx context.Context, in *MsgSubmitBlockHeaders, opts ...grpc.CallOption) (*MsgSubmitBlockHeadersResponse, error) {
+	out := new(MsgSubmitBlockHeadersResponse)
+	err := c.cc.Invoke(ctx, "/side.btcbridge.Msg/SubmitBlockHeaders", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgWithdrawToBitcoinResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SubmitDepositTransaction(ctx context.Context, in *MsgSubmitDepositTransaction, opts ...grpc.CallOption) (*MsgSubmitDepositTransactionResponse, error) {
+	out := new(MsgSubmitDepositTransactionResponse)
+	err := c.cc.Invoke(ctx, "/side.btcbridge.Msg/SubmitDepositTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgWithdrawToBitcoinResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) SubmitWithdrawTransaction(ctx context.Context, in *MsgSubmitWithdrawTransaction, opts ...grpc.CallOption) (*MsgSubmitWithdrawTransactionResponse, error) {
+	out := new(MsgSubmitWithdrawTransactionResponse)
+	err := c.cc.Invoke(ctx, "/side.btcbridge.Msg/SubmitWithdrawTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgSubmitSignatures) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SubmitFeeRate(ctx context.Context, in *MsgSubmitFeeRate, opts ...grpc.CallOption) (*MsgSubmitFeeRateResponse, error) {
+	out := new(MsgSubmitFeeRateResponse)
+	err := c.cc.Invoke(ctx, "/side.btcbridge.Msg/SubmitFeeRate", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgSubmitSignatures) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpdateTrustedNonBtcRelayers(ctx context.Context, in *MsgUpdateTrustedNonBtcRelayers, opts ...grpc.CallOption) (*MsgUpdateTrustedNonBtcRelayersResponse, error) {
+	out := new(MsgUpdateTrustedNonBtcRelayersResponse)
+	err := c.cc.Invoke(ctx, "/side.btcbridge.Msg/UpdateTrustedNonBtcRelayers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgSubmitSignatures) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Psbt) > 0 {
-		i -= len(m.Psbt)
-		copy(dAtA[i:], m.Psbt)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Psbt)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Txid) > 0 {
-		i -= len(m.Txid)
-		copy(dAtA[i:], m.Txid)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Txid)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) UpdateTrustedFeeProviders(ctx context.Context, in *MsgUpdateTrustedFeeProviders, opts ...grpc.CallOption) (*MsgUpdateTrustedFeeProvidersResponse, error) {
+	out := new(MsgUpdateTrustedFeeProvidersResponse)
+	err := c.cc.Invoke(ctx, "/side.btcbridge.Msg/UpdateTrustedFeeProviders", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgSubmitSignaturesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) WithdrawToBitcoin(ctx context.Context, in *MsgWithdrawToBitcoin, opts ...grpc.CallOption) (*MsgWithdrawToBitcoinResponse, error) {
+	out := new(MsgWithdrawToBitcoinResponse)
+	err := c.cc.Invoke(ctx, "/side.btcbridge.Msg/WithdrawToBitcoin", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgSubmitSignaturesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SubmitSignatures(ctx context.Context, in *MsgSubmitSignatures, opts ...grpc.CallOption) (*MsgSubmitSignaturesResponse, error) {
+	out := new(MsgSubmitSignaturesResponse)
+	err := c.cc.Invoke(ctx, "/side.btcbridge.Msg/SubmitSignatures", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgSubmitSignaturesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) ConsolidateVaults(ctx context.Context, in *MsgConsolidateVaults, opts ...grpc.CallOption) (*MsgConsolidateVaultsResponse, error) {
+	out := new(MsgConsolidateVaultsResponse)
+	err := c.cc.Invoke(ctx, "/side.btcbridge.Msg/ConsolidateVaults", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgConsolidateVaults) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) InitiateDKG(ctx context.Context, in *MsgInitiateDKG, opts ...grpc.CallOption) (*MsgInitiateDKGResponse, error) {
+	out := new(MsgInitiateDKGResponse)
+	err := c.cc.Invoke(ctx, "/side.btcbridge.Msg/InitiateDKG", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgConsolidateVaults) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgConsolidateVaults) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.RunesConsolidations) > 0 {
-		for iNdEx := len(m.RunesConsolidations) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.RunesConsolidations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if m.BtcConsolidation != nil {
-		{
-			size, err := m.BtcConsolidation.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.VaultVersion != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.VaultVersion))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) CompleteDKG(ctx context.Context, in *MsgCompleteDKG, opts ...grpc.CallOption) (*MsgCompleteDKGResponse, error) {
+	out := new(MsgCompleteDKGResponse)
+	err := c.cc.Invoke(ctx, "/side.btcbridge.Msg/CompleteDKG", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgConsolidateVaultsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) TransferVault(ctx context.Context, in *MsgTransferVault, opts ...grpc.CallOption) (*MsgTransferVaultResponse, error) {
+	out := new(MsgTransferVaultResponse)
+	err := c.cc.Invoke(ctx, "/side.btcbridge.Msg/TransferVault", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgConsolidateVaultsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgConsolidateVaultsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgInitiateDKG) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) RegisterRunes(ctx context.Context, in *MsgRegisterRunes, opts ...grpc.CallOption) (*MsgRegisterRunesResponse, error) {
+	out := new(MsgRegisterRunesResponse)
+	err := c.cc.Invoke(ctx, "/side.btcbridge.Msg/RegisterRunes", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgInitiateDKG) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *MsgInitiateDKG) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.TargetUtxoNum != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.TargetUtxoNum))
-		i--
-		dAtA[i] = 0x30
-	}
-	if m.EnableTransfer {
-		i--
-		if m.EnableTransfer {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.VaultTypes) > 0 {
-		dAtA3 := make([]byte, len(m.VaultTypes)*10)
-		var j2 int
-		for _, num := range m.VaultTypes {
-			for num >= 1<<7 {
-				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j2++
-			}
-			dAtA3[j2] = uint8(num)
-			j2++
-		}
-		i -= j2
-		copy(dAtA[i:], dAtA3[:j2])
-		i = encodeVarintTx(dAtA, i, uint64(j2))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.Threshold != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Threshold))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Participants) > 0 {
-		for iNdEx := len(m.Participants) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Participants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) RegisterBrc20Tickers(ctx context.Context, in *MsgRegisterBrc20Tickers, opts ...grpc.CallOption) (*MsgRegisterBrc20TickersResponse, error) {
+	out := new(MsgRegisterBrc20TickersResponse)
+	err := c.cc.Invoke(ctx, "/side.btcbridge.Msg/RegisterBrc20Tickers", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgInitiateDKGResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) ResetCircuitBreaker(ctx context.Context, in *MsgResetCircuitBreaker, opts ...grpc.CallOption) (*MsgResetCircuitBreakerResponse, error) {
+	out := new(MsgResetCircuitBreakerResponse)
+	err := c.cc.Invoke(ctx, "/side.btcbridge.Msg/ResetCircuitBreaker", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgInitiateDKGResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgInitiateDKGResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgCompleteDKG) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) EmergencyPause(ctx context.Context, in *MsgEmergencyPause, opts ...grpc.CallOption) (*MsgEmergencyPauseResponse, error) {
+	out := new(MsgEmergencyPauseResponse)
+	err := c.cc.Invoke(ctx, "/side.btcbridge.Msg/EmergencyPause", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgCompleteDKG) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *MsgCompleteDKG) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signature) > 0 {
-		i -= len(m.Signature)
-		copy(dAtA[i:], m.Signature)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signature)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.ConsensusAddress) > 0 {
-		i -= len(m.ConsensusAddress)
-		copy(dAtA[i:], m.ConsensusAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ConsensusAddress)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Vaults) > 0 {
-		for iNdEx := len(m.Vaults) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Vaults[iNdEx])
-			copy(dAtA[i:], m.Vaults[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Vaults[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if m.Id != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) LiftEmergencyPause(ctx context.Context, in *MsgLiftEmergencyPause, opts ...grpc.CallOption) (*MsgLiftEmergencyPauseResponse, error) {
+	out := new(MsgLiftEmergencyPauseResponse)
+	err := c.cc.Invoke(ctx, "/side.btcbridge.Msg/LiftEmergencyPause", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgCompleteDKGResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) RecoverVault(ctx context.Context, in *MsgRecoverVault, opts ...grpc.CallOption) (*MsgRecoverVaultResponse, error) {
+	out := new(MsgRecoverVaultResponse)
+	err := c.cc.Invoke(ctx, "/side.btcbridge.Msg/RecoverVault", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgCompleteDKGResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SweepVault(ctx context.Context, in *MsgSweepVault, opts ...grpc.CallOption) (*MsgSweepVaultResponse, error) {
+	out := new(MsgSweepVaultResponse)
+	err := c.cc.Invoke(ctx, "/side.btcbridge.Msg/SweepVault", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgCompleteDKGResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) SubmitNonceCommitments(ctx context.Context, in *MsgSubmitNonceCommitments, opts ...grpc.CallOption) (*MsgSubmitNonceCommitmentsResponse, error) {
+	out := new(MsgSubmitNonceCommitmentsResponse)
+	err := c.cc.Invoke(ctx, "/side.btcbridge.Msg/SubmitNonceCommitments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgTransferVault) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SubmitPartialSignatures(ctx context.Context, in *MsgSubmitPartialSignatures, opts ...grpc.CallOption) (*MsgSubmitPartialSignaturesResponse, error) {
+	out := new(MsgSubmitPartialSignaturesResponse)
+	err := c.cc.Invoke(ctx, "/side.btcbridge.Msg/SubmitPartialSignatures", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgTransferVault) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgTransferVault) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.TargetUtxoNum != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.TargetUtxoNum))
-		i--
-		dAtA[i] = 0x30
-	}
-	if len(m.Psbts) > 0 {
-		for iNdEx := len(m.Psbts) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Psbts[iNdEx])
-			copy(dAtA[i:], m.Psbts[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Psbts[iNdEx])))
-			i--
-			dAtA[i] = 0x2a
-		}
-	}
-	if m.AssetType != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.AssetType))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.DestVersion != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.DestVersion))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.SourceVersion != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.SourceVersion))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgTransferVaultResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/side.btcbridge.Msg/UpdateParams", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgTransferVaultResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// SubmitBlockHeaders submits bitcoin block headers to the side chain.
+	SubmitBlockHeaders(context.Context, *MsgSubmitBlockHeaders) (*MsgSubmitBlockHeadersResponse, error)
+	// SubmitDepositTransaction submits the bitcoin deposit transaction to the side chain.
+	SubmitDepositTransaction(context.Context, *MsgSubmitDepositTransaction) (*MsgSubmitDepositTransactionResponse, error)
+	// SubmitWithdrawalTransaction submits the bitcoin withdrawal transaction to the side chain.
+	SubmitWithdrawTransaction(context.Context, *MsgSubmitWithdrawTransaction) (*MsgSubmitWithdrawTransactionResponse, error)
+	// SubmitFeeRate submits the bitcoin network fee rate to the side chain.
+	SubmitFeeRate(context.Context, *MsgSubmitFeeRate) (*MsgSubmitFeeRateResponse, error)
+	// UpdateTrustedNonBtcRelayers updates the trusted non-btc asset relayers.
+	UpdateTrustedNonBtcRelayers(context.Context, *MsgUpdateTrustedNonBtcRelayers) (*MsgUpdateTrustedNonBtcRelayersResponse, error)
+	// UpdateTrustedFeeProviders updates the trusted fee providers.
+	UpdateTrustedFeeProviders(context.Context, *MsgUpdateTrustedFeeProviders) (*MsgUpdateTrustedFeeProvidersResponse, error)
+	// WithdrawToBitcoin withdraws the asset to bitcoin.
+	WithdrawToBitcoin(context.Context, *MsgWithdrawToBitcoin) (*MsgWithdrawToBitcoinResponse, error)
+	// SubmitSignatures submits the signatures of the signing request to the side chain.
+	SubmitSignatures(context.Context, *MsgSubmitSignatures) (*MsgSubmitSignaturesResponse, error)
+	// ConsolidateVaults performs the utxo consolidation for the given vaults.
+	ConsolidateVaults(context.Context, *MsgConsolidateVaults) (*MsgConsolidateVaultsResponse, error)
+	// InitiateDKG initiates the DKG request.
+	InitiateDKG(context.Context, *MsgInitiateDKG) (*MsgInitiateDKGResponse, error)
+	// CompleteDKG completes the given DKG request.
+	CompleteDKG(context.Context, *MsgCompleteDKG) (*MsgCompleteDKGResponse, error)
+	// TransferVault transfers the vault asset from the source version to the destination version.
+	TransferVault(context.Context, *MsgTransferVault) (*MsgTransferVaultResponse, error)
+	// RegisterRunes registers the metadata of the given runes.
+	RegisterRunes(context.Context, *MsgRegisterRunes) (*MsgRegisterRunesResponse, error)
+	// RegisterBrc20Tickers registers the metadata of the given brc20 tickers.
+	RegisterBrc20Tickers(context.Context, *MsgRegisterBrc20Tickers) (*MsgRegisterBrc20TickersResponse, error)
+	// ResetCircuitBreaker resets the tripped withdrawal circuit breaker.
+	ResetCircuitBreaker(context.Context, *MsgResetCircuitBreaker) (*MsgResetCircuitBreakerResponse, error)
+	// EmergencyPause pauses the bridge in the given scope until expiration.
+	EmergencyPause(context.Context, *MsgEmergencyPause) (*MsgEmergencyPauseResponse, error)
+	// LiftEmergencyPause lifts the given emergency pause before expiration.
+	LiftEmergencyPause(context.Context, *MsgLiftEmergencyPause) (*MsgLiftEmergencyPauseResponse, error)
+	// RecoverVault recovers the vault asset through the timelocked recovery script path.
+	RecoverVault(context.Context, *MsgRecoverVault) (*MsgRecoverVaultResponse, error)
+	// SweepVault sweeps the outputs of the retired vault.
+	SweepVault(context.Context, *MsgSweepVault) (*MsgSweepVaultResponse, error)
+	// SubmitNonceCommitments submits the FROST nonce commitments of the signing request.
+	SubmitNonceCommitments(context.Context, *MsgSubmitNonceCommitments) (*MsgSubmitNonceCommitmentsResponse, error)
+	// SubmitPartialSignatures submits the FROST partial signatures of the signing request.
+	SubmitPartialSignatures(context.Context, *MsgSubmitPartialSignatures) (*MsgSubmitPartialSignaturesResponse, error)
+	// UpdateParams defines a governance operation for updating the x/btcbridge module
+	// parameters. The authority defaults to the x/gov module account.
+	//
+	// Since: cosmos-sdk 0.47
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 }
 
-func (m *MsgTransferVaultResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedMsgServer) SubmitBlockHeaders(ctx context.Context, req *MsgSubmitBlockHeaders) (*MsgSubmitBlockHeadersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitBlockHeaders not implemented")
 }
-
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedMsgServer) SubmitDepositTransaction(ctx context.Context, req *MsgSubmitDepositTransaction) (*MsgSubmitDepositTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitDepositTransaction not implemented")
 }
-
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedMsgServer) SubmitWithdrawTransaction(ctx context.Context, req *MsgSubmitWithdrawTransaction) (*MsgSubmitWithdrawTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitWithdrawTransaction not implemented")
 }
-
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedMsgServer) SubmitFeeRate(ctx context.Context, req *MsgSubmitFeeRate) (*MsgSubmitFeeRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitFeeRate not implemented")
 }
-
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedMsgServer) UpdateTrustedNonBtcRelayers(ctx context.Context, req *MsgUpdateTrustedNonBtcRelayers) (*MsgUpdateTrustedNonBtcRelayersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTrustedNonBtcRelayers not implemented")
+}
+func (*UnimplementedMsgServer) UpdateTrustedFeeProviders(ctx context.Context, req *MsgUpdateTrustedFeeProviders) (*MsgUpdateTrustedFeeProvidersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTrustedFeeProviders not implemented")
+}
+func (*UnimplementedMsgServer) WithdrawToBitcoin(ctx context.Context, req *MsgWithdrawToBitcoin) (*MsgWithdrawToBitcoinResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawToBitcoin not implemented")
+}
+func (*UnimplementedMsgServer) SubmitSignatures(ctx context.Context, req *MsgSubmitSignatures) (*MsgSubmitSignaturesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitSignatures not implemented")
+}
+func (*UnimplementedMsgServer) ConsolidateVaults(ctx context.Context, req *MsgConsolidateVaults) (*MsgConsolidateVaultsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConsolidateVaults not implemented")
+}
+func (*UnimplementedMsgServer) InitiateDKG(ctx context.Context, req *MsgInitiateDKG) (*MsgInitiateDKGResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InitiateDKG not implemented")
+}
+func (*UnimplementedMsgServer) CompleteDKG(ctx context.Context, req *MsgCompleteDKG) (*MsgCompleteDKGResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompleteDKG not implemented")
+}
+func (*UnimplementedMsgServer) TransferVault(ctx context.Context, req *MsgTransferVault) (*MsgTransferVaultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferVault not implemented")
+}
+func (*UnimplementedMsgServer) RegisterRunes(ctx context.Context, req *MsgRegisterRunes) (*MsgRegisterRunesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterRunes not implemented")
+}
+func (*UnimplementedMsgServer) RegisterBrc20Tickers(ctx context.Context, req *MsgRegisterBrc20Tickers) (*MsgRegisterBrc20TickersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterBrc20Tickers not implemented")
+}
+func (*UnimplementedMsgServer) ResetCircuitBreaker(ctx context.Context, req *MsgResetCircuitBreaker) (*MsgResetCircuitBreakerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetCircuitBreaker not implemented")
+}
+func (*UnimplementedMsgServer) EmergencyPause(ctx context.Context, req *MsgEmergencyPause) (*MsgEmergencyPauseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EmergencyPause not implemented")
+}
+func (*UnimplementedMsgServer) LiftEmergencyPause(ctx context.Context, req *MsgLiftEmergencyPause) (*MsgLiftEmergencyPauseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LiftEmergencyPause not implemented")
+}
+func (*UnimplementedMsgServer) RecoverVault(ctx context.Context, req *MsgRecoverVault) (*MsgRecoverVaultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecoverVault not implemented")
+}
+func (*UnimplementedMsgServer) SweepVault(ctx context.Context, req *MsgSweepVault) (*MsgSweepVaultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SweepVault not implemented")
+}
+func (*UnimplementedMsgServer) SubmitNonceCommitments(ctx context.Context, req *MsgSubmitNonceCommitments) (*MsgSubmitNonceCommitmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitNonceCommitments not implemented")
+}
+func (*UnimplementedMsgServer) SubmitPartialSignatures(ctx context.Context, req *MsgSubmitPartialSignatures) (*MsgSubmitPartialSignaturesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitPartialSignatures not implemented")
+}
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func _Msg_SubmitBlockHeaders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitBlockHeaders)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *MsgSubmitBlockHeaders) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitBlockHeaders(ctx, in)
 	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/side.btcbridge.Msg/SubmitBlockHeaders",
 	}
-	if len(m.BlockHeaders) > 0 {
-		for _, e := range m.BlockHeaders {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitBlockHeaders(ctx, req.(*MsgSubmitBlockHeaders))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSubmitBlockHeadersResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_SubmitDepositTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitDepositTransaction)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitDepositTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/side.btcbridge.Msg/SubmitDepositTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitDepositTransaction(ctx, req.(*MsgSubmitDepositTransaction))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSubmitDepositTransaction) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Blockhash)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.PrevTxBytes)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func _Msg_SubmitWithdrawTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitWithdrawTransaction)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.TxBytes)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitWithdrawTransaction(ctx, in)
 	}
-	if len(m.Proof) > 0 {
-		for _, s := range m.Proof {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/side.btcbridge.Msg/SubmitWithdrawTransaction",
 	}
-	return n
-}
-
-func (m *MsgSubmitDepositTransactionResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitWithdrawTransaction(ctx, req.(*MsgSubmitWithdrawTransaction))
 	}
-	var l int
-	_ = l
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSubmitWithdrawTransaction) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func _Msg_SubmitFeeRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitFeeRate)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.Blockhash)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitFeeRate(ctx, in)
 	}
-	l = len(m.TxBytes)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/side.btcbridge.Msg/SubmitFeeRate",
 	}
-	if len(m.Proof) > 0 {
-		for _, s := range m.Proof {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitFeeRate(ctx, req.(*MsgSubmitFeeRate))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSubmitWithdrawTransactionResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_UpdateTrustedNonBtcRelayers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateTrustedNonBtcRelayers)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgSubmitFeeRate) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateTrustedNonBtcRelayers(ctx, in)
 	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/side.btcbridge.Msg/UpdateTrustedNonBtcRelayers",
 	}
-	if m.FeeRate != 0 {
-		n += 1 + sovTx(uint64(m.FeeRate))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateTrustedNonBtcRelayers(ctx, req.(*MsgUpdateTrustedNonBtcRelayers))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSubmitFeeRateResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_UpdateTrustedFeeProviders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateTrustedFeeProviders)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgUpdateTrustedNonBtcRelayers) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateTrustedFeeProviders(ctx, in)
 	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/side.btcbridge.Msg/UpdateTrustedFeeProviders",
 	}
-	if len(m.Relayers) > 0 {
-		for _, s := range m.Relayers {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateTrustedFeeProviders(ctx, req.(*MsgUpdateTrustedFeeProviders))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateTrustedNonBtcRelayersResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_WithdrawToBitcoin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawToBitcoin)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgUpdateTrustedFeeProviders) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawToBitcoin(ctx, in)
 	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/side.btcbridge.Msg/WithdrawToBitcoin",
 	}
-	if len(m.FeeProviders) > 0 {
-		for _, s := range m.FeeProviders {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawToBitcoin(ctx, req.(*MsgWithdrawToBitcoin))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateTrustedFeeProvidersResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_SubmitSignatures_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitSignatures)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgWithdrawToBitcoin) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitSignatures(ctx, in)
 	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/side.btcbridge.Msg/SubmitSignatures",
 	}
-	l = len(m.Amount)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitSignatures(ctx, req.(*MsgSubmitSignatures))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgWithdrawToBitcoinResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_ConsolidateVaults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgConsolidateVaults)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).ConsolidateVaults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/side.btcbridge.Msg/ConsolidateVaults",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ConsolidateVaults(ctx, req.(*MsgConsolidateVaults))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSubmitSignatures) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_InitiateDKG_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgInitiateDKG)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).InitiateDKG(ctx, in)
 	}
-	l = len(m.Txid)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/side.btcbridge.Msg/InitiateDKG",
 	}
-	l = len(m.Psbt)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).InitiateDKG(ctx, req.(*MsgInitiateDKG))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSubmitSignaturesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_CompleteDKG_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCompleteDKG)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).CompleteDKG(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/side.btcbridge.Msg/CompleteDKG",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CompleteDKG(ctx, req.(*MsgCompleteDKG))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgConsolidateVaults) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_TransferVault_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferVault)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).TransferVault(ctx, in)
 	}
-	if m.VaultVersion != 0 {
-		n += 1 + sovTx(uint64(m.VaultVersion))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/side.btcbridge.Msg/TransferVault",
 	}
-	if m.BtcConsolidation != nil {
-		l = m.BtcConsolidation.Size()
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TransferVault(ctx, req.(*MsgTransferVault))
 	}
-	if len(m.RunesConsolidations) > 0 {
-		for _, e := range m.RunesConsolidations {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RegisterRunes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterRunes)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterRunes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/side.btcbridge.Msg/RegisterRunes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterRunes(ctx, req.(*MsgRegisterRunes))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgConsolidateVaultsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_RegisterBrc20Tickers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterBrc20Tickers)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterBrc20Tickers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/side.btcbridge.Msg/RegisterBrc20Tickers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterBrc20Tickers(ctx, req.(*MsgRegisterBrc20Tickers))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgInitiateDKG) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_ResetCircuitBreaker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgResetCircuitBreaker)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).ResetCircuitBreaker(ctx, in)
 	}
-	if len(m.Participants) > 0 {
-		for _, e := range m.Participants {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/side.btcbridge.Msg/ResetCircuitBreaker",
 	}
-	if m.Threshold != 0 {
-		n += 1 + sovTx(uint64(m.Threshold))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ResetCircuitBreaker(ctx, req.(*MsgResetCircuitBreaker))
 	}
-	if len(m.VaultTypes) > 0 {
-		l = 0
-		for _, e := range m.VaultTypes {
-			l += sovTx(uint64(e))
-		}
-		n += 1 + sovTx(uint64(l)) + l
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_EmergencyPause_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgEmergencyPause)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.EnableTransfer {
-		n += 2
+	if interceptor == nil {
+		return srv.(MsgServer).EmergencyPause(ctx, in)
 	}
-	if m.TargetUtxoNum != 0 {
-		n += 1 + sovTx(uint64(m.TargetUtxoNum))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/side.btcbridge.Msg/EmergencyPause",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).EmergencyPause(ctx, req.(*MsgEmergencyPause))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgInitiateDKGResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_LiftEmergencyPause_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgLiftEmergencyPause)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).LiftEmergencyPause(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/side.btcbridge.Msg/LiftEmergencyPause",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).LiftEmergencyPause(ctx, req.(*MsgLiftEmergencyPause))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCompleteDKG) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_RecoverVault_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRecoverVault)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).RecoverVault(ctx, in)
 	}
-	if m.Id != 0 {
-		n += 1 + sovTx(uint64(m.Id))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/side.btcbridge.Msg/RecoverVault",
 	}
-	if len(m.Vaults) > 0 {
-		for _, s := range m.Vaults {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RecoverVault(ctx, req.(*MsgRecoverVault))
 	}
-	l = len(m.ConsensusAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SweepVault_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSweepVault)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.Signature)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).SweepVault(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/side.btcbridge.Msg/SweepVault",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SweepVault(ctx, req.(*MsgSweepVault))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCompleteDKGResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_SubmitNonceCommitments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitNonceCommitments)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitNonceCommitments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/side.btcbridge.Msg/SubmitNonceCommitments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitNonceCommitments(ctx, req.(*MsgSubmitNonceCommitments))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgTransferVault) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_SubmitPartialSignatures_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitPartialSignatures)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitPartialSignatures(ctx, in)
 	}
-	if m.SourceVersion != 0 {
-		n += 1 + sovTx(uint64(m.SourceVersion))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/side.btcbridge.Msg/SubmitPartialSignatures",
 	}
-	if m.DestVersion != 0 {
-		n += 1 + sovTx(uint64(m.DestVersion))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitPartialSignatures(ctx, req.(*MsgSubmitPartialSignatures))
 	}
-	if m.AssetType != 0 {
-		n += 1 + sovTx(uint64(m.AssetType))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Psbts) > 0 {
-		for _, s := range m.Psbts {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/side.btcbridge.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "side.btcbridge.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SubmitBlockHeaders",
+			Handler:    _Msg_SubmitBlockHeaders_Handler,
+		},
+		{
+			MethodName: "SubmitDepositTransaction",
+			Handler:    _Msg_SubmitDepositTransaction_Handler,
+		},
+		{
+			MethodName: "SubmitWithdrawTransaction",
+			Handler:    _Msg_SubmitWithdrawTransaction_Handler,
+		},
+		{
+			MethodName: "SubmitFeeRate",
+			Handler:    _Msg_SubmitFeeRate_Handler,
+		},
+		{
+			MethodName: "UpdateTrustedNonBtcRelayers",
+			Handler:    _Msg_UpdateTrustedNonBtcRelayers_Handler,
+		},
+		{
+			MethodName: "UpdateTrustedFeeProviders",
+			Handler:    _Msg_UpdateTrustedFeeProviders_Handler,
+		},
+		{
+			MethodName: "WithdrawToBitcoin",
+			Handler:    _Msg_WithdrawToBitcoin_Handler,
+		},
+		{
+			MethodName: "SubmitSignatures",
+			Handler:    _Msg_SubmitSignatures_Handler,
+		},
+		{
+			MethodName: "ConsolidateVaults",
+			Handler:    _Msg_ConsolidateVaults_Handler,
+		},
+		{
+			MethodName: "InitiateDKG",
+			Handler:    _Msg_InitiateDKG_Handler,
+		},
+		{
+			MethodName: "CompleteDKG",
+			Handler:    _Msg_CompleteDKG_Handler,
+		},
+		{
+			MethodName: "TransferVault",
+			Handler:    _Msg_TransferVault_Handler,
+		},
+		{
+			MethodName: "RegisterRunes",
+			Handler:    _Msg_RegisterRunes_Handler,
+		},
+		{
+			MethodName: "RegisterBrc20Tickers",
+			Handler:    _Msg_RegisterBrc20Tickers_Handler,
+		},
+		{
+			MethodName: "ResetCircuitBreaker",
+			Handler:    _Msg_ResetCircuitBreaker_Handler,
+		},
+		{
+			MethodName: "EmergencyPause",
+			Handler:    _Msg_EmergencyPause_Handler,
+		},
+		{
+			MethodName: "LiftEmergencyPause",
+			Handler:    _Msg_LiftEmergencyPause_Handler,
+		},
+		{
+			MethodName: "RecoverVault",
+			Handler:    _Msg_RecoverVault_Handler,
+		},
+		{
+			MethodName: "SweepVault",
+			Handler:    _Msg_SweepVault_Handler,
+		},
+		{
+			MethodName: "SubmitNonceCommitments",
+			Handler:    _Msg_SubmitNonceCommitments_Handler,
+		},
+		{
+			MethodName: "SubmitPartialSignatures",
+			Handler:    _Msg_SubmitPartialSignatures_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "side/btcbridge/tx.proto",
+}
+
+func (m *MsgSubmitBlockHeaders) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitBlockHeaders) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitBlockHeaders) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BlockHeaders) > 0 {
+		for iNdEx := len(m.BlockHeaders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BlockHeaders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitBlockHeadersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitBlockHeadersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitBlockHeadersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitDepositTransaction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitDepositTransaction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitDepositTransaction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Proof) > 0 {
+		for iNdEx := len(m.Proof) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Proof[iNdEx])
+			copy(dAtA[i:], m.Proof[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Proof[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.TxBytes) > 0 {
+		i -= len(m.TxBytes)
+		copy(dAtA[i:], m.TxBytes)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TxBytes)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.PrevTxBytes) > 0 {
+		i -= len(m.PrevTxBytes)
+		copy(dAtA[i:], m.PrevTxBytes)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PrevTxBytes)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Blockhash) > 0 {
+		i -= len(m.Blockhash)
+		copy(dAtA[i:], m.Blockhash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Blockhash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitDepositTransactionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitDepositTransactionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitDepositTransactionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitWithdrawTransaction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitWithdrawTransaction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitWithdrawTransaction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Proof) > 0 {
+		for iNdEx := len(m.Proof) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Proof[iNdEx])
+			copy(dAtA[i:], m.Proof[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Proof[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.TxBytes) > 0 {
+		i -= len(m.TxBytes)
+		copy(dAtA[i:], m.TxBytes)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TxBytes)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Blockhash) > 0 {
+		i -= len(m.Blockhash)
+		copy(dAtA[i:], m.Blockhash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Blockhash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitWithdrawTransactionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitWithdrawTransactionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitWithdrawTransactionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitFeeRate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitFeeRate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitFeeRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FeeRate != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.FeeRate))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitFeeRateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitFeeRateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitFeeRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateTrustedNonBtcRelayers) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateTrustedNonBtcRelayers) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateTrustedNonBtcRelayers) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Relayers) > 0 {
+		for iNdEx := len(m.Relayers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Relayers[iNdEx])
+			copy(dAtA[i:], m.Relayers[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Relayers[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateTrustedNonBtcRelayersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateTrustedNonBtcRelayersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateTrustedNonBtcRelayersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateTrustedFeeProviders) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateTrustedFeeProviders) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateTrustedFeeProviders) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FeeProviders) > 0 {
+		for iNdEx := len(m.FeeProviders) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.FeeProviders[iNdEx])
+			copy(dAtA[i:], m.FeeProviders[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.FeeProviders[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateTrustedFeeProvidersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateTrustedFeeProvidersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateTrustedFeeProvidersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawToBitcoin) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawToBitcoin) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawToBitcoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxFee != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MaxFee))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.FeeRate != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.FeeRate))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawToBitcoinResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawToBitcoinResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawToBitcoinResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitSignatures) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitSignatures) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitSignatures) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Psbt) > 0 {
+		i -= len(m.Psbt)
+		copy(dAtA[i:], m.Psbt)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Psbt)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Txid) > 0 {
+		i -= len(m.Txid)
+		copy(dAtA[i:], m.Txid)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Txid)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitSignaturesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitSignaturesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitSignaturesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgConsolidateVaults) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConsolidateVaults) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConsolidateVaults) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RunesConsolidations) > 0 {
+		for iNdEx := len(m.RunesConsolidations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RunesConsolidations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.BtcConsolidation != nil {
+		{
+			size, err := m.BtcConsolidation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.VaultVersion != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.VaultVersion))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgConsolidateVaultsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConsolidateVaultsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConsolidateVaultsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgInitiateDKG) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgInitiateDKG) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgInitiateDKG) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TargetUtxoNum != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TargetUtxoNum))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.EnableTransfer {
+		i--
+		if m.EnableTransfer {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.VaultTypes) > 0 {
+		dAtA3 := make([]byte, len(m.VaultTypes)*10)
+		var j2 int
+		for _, num := range m.VaultTypes {
+			for num >= 1<<7 {
+				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j2++
+			}
+			dAtA3[j2] = uint8(num)
+			j2++
+		}
+		i -= j2
+		copy(dAtA[i:], dAtA3[:j2])
+		i = encodeVarintTx(dAtA, i, uint64(j2))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Threshold != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Threshold))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Participants) > 0 {
+		for iNdEx := len(m.Participants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Participants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgInitiateDKGResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgInitiateDKGResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgInitiateDKGResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCompleteDKG) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCompleteDKG) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCompleteDKG) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VerificationShares) > 0 {
+		for iNdEx := len(m.VerificationShares) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VerificationShares[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.Proofs) > 0 {
+		for iNdEx := len(m.Proofs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Proofs[iNdEx])
+			copy(dAtA[i:], m.Proofs[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Proofs[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.PubKeys) > 0 {
+		for iNdEx := len(m.PubKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PubKeys[iNdEx])
+			copy(dAtA[i:], m.PubKeys[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.PubKeys[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ConsensusAddress) > 0 {
+		i -= len(m.ConsensusAddress)
+		copy(dAtA[i:], m.ConsensusAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ConsensusAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Vaults) > 0 {
+		for iNdEx := len(m.Vaults) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Vaults[iNdEx])
+			copy(dAtA[i:], m.Vaults[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Vaults[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCompleteDKGResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCompleteDKGResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCompleteDKGResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferVault) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferVault) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferVault) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TargetUtxoNum != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TargetUtxoNum))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Psbts) > 0 {
+		for iNdEx := len(m.Psbts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Psbts[iNdEx])
+			copy(dAtA[i:], m.Psbts[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Psbts[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.AssetType != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AssetType))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.DestVersion != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DestVersion))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SourceVersion != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SourceVersion))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferVaultResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferVaultResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferVaultResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterRunes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterRunes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterRunes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Runes) > 0 {
+		for iNdEx := len(m.Runes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Runes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterRunesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterRunesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterRunesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterBrc20Tickers) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterBrc20Tickers) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterBrc20Tickers) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Tickers) > 0 {
+		for iNdEx := len(m.Tickers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tickers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterBrc20TickersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterBrc20TickersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterBrc20TickersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgResetCircuitBreaker) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResetCircuitBreaker) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResetCircuitBreaker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgResetCircuitBreakerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResetCircuitBreakerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResetCircuitBreakerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgEmergencyPause) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgEmergencyPause) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgEmergencyPause) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n4, err4 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintTx(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Scope != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Scope))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Guardian) > 0 {
+		i -= len(m.Guardian)
+		copy(dAtA[i:], m.Guardian)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Guardian)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgEmergencyPauseResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgEmergencyPauseResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgEmergencyPauseResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgLiftEmergencyPause) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLiftEmergencyPause) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLiftEmergencyPause) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgLiftEmergencyPauseResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLiftEmergencyPauseResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLiftEmergencyPauseResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRecoverVault) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRecoverVault) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRecoverVault) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxUtxoNum != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MaxUtxoNum))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.InternalKey) > 0 {
+		i -= len(m.InternalKey)
+		copy(dAtA[i:], m.InternalKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.InternalKey)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Vault) > 0 {
+		i -= len(m.Vault)
+		copy(dAtA[i:], m.Vault)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Vault)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRecoverVaultResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRecoverVaultResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRecoverVaultResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSweepVault) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSweepVault) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSweepVault) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Psbt) > 0 {
+		i -= len(m.Psbt)
+		copy(dAtA[i:], m.Psbt)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Psbt)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Vault) > 0 {
+		i -= len(m.Vault)
+		copy(dAtA[i:], m.Vault)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Vault)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSweepVaultResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSweepVaultResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSweepVaultResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitNonceCommitments) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitNonceCommitments) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitNonceCommitments) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Commitments) > 0 {
+		for iNdEx := len(m.Commitments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Commitments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Txid) > 0 {
+		i -= len(m.Txid)
+		copy(dAtA[i:], m.Txid)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Txid)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitNonceCommitmentsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitNonceCommitmentsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitNonceCommitmentsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitPartialSignatures) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitPartialSignatures) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitPartialSignatures) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PartialSignatures) > 0 {
+		for iNdEx := len(m.PartialSignatures) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PartialSignatures[iNdEx])
+			copy(dAtA[i:], m.PartialSignatures[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.PartialSignatures[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Txid) > 0 {
+		i -= len(m.Txid)
+		copy(dAtA[i:], m.Txid)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Txid)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitPartialSignaturesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitPartialSignaturesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitPartialSignaturesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgSubmitBlockHeaders) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.BlockHeaders) > 0 {
+		for _, e := range m.BlockHeaders {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSubmitBlockHeadersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSubmitDepositTransaction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Blockhash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PrevTxBytes)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TxBytes)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Proof) > 0 {
+		for _, s := range m.Proof {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSubmitDepositTransactionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSubmitWithdrawTransaction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Blockhash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TxBytes)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Proof) > 0 {
+		for _, s := range m.Proof {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSubmitWithdrawTransactionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSubmitFeeRate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.FeeRate != 0 {
+		n += 1 + sovTx(uint64(m.FeeRate))
+	}
+	return n
+}
+
+func (m *MsgSubmitFeeRateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateTrustedNonBtcRelayers) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Relayers) > 0 {
+		for _, s := range m.Relayers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdateTrustedNonBtcRelayersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateTrustedFeeProviders) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.FeeProviders) > 0 {
+		for _, s := range m.FeeProviders {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdateTrustedFeeProvidersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWithdrawToBitcoin) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.FeeRate != 0 {
+		n += 1 + sovTx(uint64(m.FeeRate))
+	}
+	if m.MaxFee != 0 {
+		n += 1 + sovTx(uint64(m.MaxFee))
+	}
+	return n
+}
+
+func (m *MsgWithdrawToBitcoinResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSubmitSignatures) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Txid)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Psbt)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSubmitSignaturesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgConsolidateVaults) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.VaultVersion != 0 {
+		n += 1 + sovTx(uint64(m.VaultVersion))
+	}
+	if m.BtcConsolidation != nil {
+		l = m.BtcConsolidation.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.RunesConsolidations) > 0 {
+		for _, e := range m.RunesConsolidations {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgConsolidateVaultsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgInitiateDKG) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Participants) > 0 {
+		for _, e := range m.Participants {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.Threshold != 0 {
+		n += 1 + sovTx(uint64(m.Threshold))
+	}
+	if len(m.VaultTypes) > 0 {
+		l = 0
+		for _, e := range m.VaultTypes {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	if m.EnableTransfer {
+		n += 2
+	}
+	if m.TargetUtxoNum != 0 {
+		n += 1 + sovTx(uint64(m.TargetUtxoNum))
+	}
+	return n
+}
+
+func (m *MsgInitiateDKGResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCompleteDKG) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	if len(m.Vaults) > 0 {
+		for _, s := range m.Vaults {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.ConsensusAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.PubKeys) > 0 {
+		for _, s := range m.PubKeys {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Proofs) > 0 {
+		for _, s := range m.Proofs {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.VerificationShares) > 0 {
+		for _, e := range m.VerificationShares {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgCompleteDKGResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgTransferVault) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.SourceVersion != 0 {
+		n += 1 + sovTx(uint64(m.SourceVersion))
+	}
+	if m.DestVersion != 0 {
+		n += 1 + sovTx(uint64(m.DestVersion))
+	}
+	if m.AssetType != 0 {
+		n += 1 + sovTx(uint64(m.AssetType))
+	}
+	if len(m.Psbts) > 0 {
+		for _, s := range m.Psbts {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.TargetUtxoNum != 0 {
+		n += 1 + sovTx(uint64(m.TargetUtxoNum))
+	}
+	return n
+}
+
+func (m *MsgTransferVaultResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRegisterRunes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Runes) > 0 {
+		for _, e := range m.Runes {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgRegisterRunesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRegisterBrc20Tickers) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Tickers) > 0 {
+		for _, e := range m.Tickers {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgRegisterBrc20TickersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgResetCircuitBreaker) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgResetCircuitBreakerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgEmergencyPause) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Guardian)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Scope != 0 {
+		n += 1 + sovTx(uint64(m.Scope))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgEmergencyPauseResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgLiftEmergencyPause) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgLiftEmergencyPauseResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRecoverVault) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Vault)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.InternalKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MaxUtxoNum != 0 {
+		n += 1 + sovTx(uint64(m.MaxUtxoNum))
+	}
+	return n
+}
+
+func (m *MsgRecoverVaultResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		n += 1 + sovTx(uint64(m.Sequence))
+	}
+	return n
+}
+
+func (m *MsgSweepVault) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Vault)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Psbt)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSweepVaultResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		n += 1 + sovTx(uint64(m.Sequence))
+	}
+	return n
+}
+
+func (m *MsgSubmitNonceCommitments) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Txid)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Commitments) > 0 {
+		for _, e := range m.Commitments {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSubmitNonceCommitmentsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSubmitPartialSignatures) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Txid)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.PartialSignatures) > 0 {
+		for _, s := range m.PartialSignatures {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSubmitPartialSignaturesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgSubmitBlockHeaders) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitBlockHeaders: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitBlockHeaders: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeaders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockHeaders = append(m.BlockHeaders, &BlockHeader{})
+			if err := m.BlockHeaders[len(m.BlockHeaders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitBlockHeadersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitBlockHeadersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitBlockHeadersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitDepositTransaction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitDepositTransaction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitDepositTransaction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blockhash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Blockhash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrevTxBytes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PrevTxBytes = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxBytes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxBytes = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proof = append(m.Proof, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitDepositTransactionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitDepositTransactionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitDepositTransactionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitWithdrawTransaction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitWithdrawTransaction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitWithdrawTransaction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blockhash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Blockhash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxBytes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxBytes = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proof = append(m.Proof, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitWithdrawTransactionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitWithdrawTransactionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitWithdrawTransactionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitFeeRate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitFeeRate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitFeeRate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeRate", wireType)
+			}
+			m.FeeRate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FeeRate |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitFeeRateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitFeeRateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitFeeRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateTrustedNonBtcRelayers) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateTrustedNonBtcRelayers: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateTrustedNonBtcRelayers: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Relayers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Relayers = append(m.Relayers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateTrustedNonBtcRelayersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateTrustedNonBtcRelayersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateTrustedNonBtcRelayersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateTrustedFeeProviders) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateTrustedFeeProviders: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateTrustedFeeProviders: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeProviders", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeProviders = append(m.FeeProviders, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateTrustedFeeProvidersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateTrustedFeeProvidersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateTrustedFeeProvidersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawToBitcoin) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawToBitcoin: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawToBitcoin: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeRate", wireType)
+			}
+			m.FeeRate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FeeRate |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFee", wireType)
+			}
+			m.MaxFee = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxFee |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawToBitcoinResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawToBitcoinResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawToBitcoinResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitSignatures) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitSignatures: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitSignatures: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Txid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Txid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Psbt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Psbt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitSignaturesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitSignaturesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitSignaturesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgConsolidateVaults) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConsolidateVaults: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConsolidateVaults: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VaultVersion", wireType)
+			}
+			m.VaultVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VaultVersion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcConsolidation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BtcConsolidation == nil {
+				m.BtcConsolidation = &BtcConsolidation{}
+			}
+			if err := m.BtcConsolidation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RunesConsolidations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RunesConsolidations = append(m.RunesConsolidations, &RunesConsolidation{})
+			if err := m.RunesConsolidations[len(m.RunesConsolidations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.TargetUtxoNum != 0 {
-		n += 1 + sovTx(uint64(m.TargetUtxoNum))
-	}
-	return n
-}
 
-func (m *MsgTransferVaultResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgConsolidateVaultsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConsolidateVaultsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConsolidateVaultsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgSubmitBlockHeaders) Unmarshal(dAtA []byte) error {
+func (m *MsgInitiateDKG) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3459,21 +7544,175 @@ func (m *MsgSubmitBlockHeaders) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitBlockHeaders: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitBlockHeaders: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgInitiateDKG: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgInitiateDKG: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Participants", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Participants = append(m.Participants, &DKGParticipant{})
+			if err := m.Participants[len(m.Participants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			m.Threshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Threshold |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType == 0 {
+				var v AssetType
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= AssetType(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.VaultTypes = append(m.VaultTypes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.VaultTypes) == 0 {
+					m.VaultTypes = make([]AssetType, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v AssetType
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= AssetType(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.VaultTypes = append(m.VaultTypes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field VaultTypes", wireType)
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableTransfer", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3483,29 +7722,17 @@ func (m *MsgSubmitBlockHeaders) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeaders", wireType)
+			m.EnableTransfer = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetUtxoNum", wireType)
 			}
-			var msglen int
+			m.TargetUtxoNum = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3515,26 +7742,11 @@ func (m *MsgSubmitBlockHeaders) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.TargetUtxoNum |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.BlockHeaders = append(m.BlockHeaders, &BlockHeader{})
-			if err := m.BlockHeaders[len(m.BlockHeaders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3556,7 +7768,7 @@ func (m *MsgSubmitBlockHeaders) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitBlockHeadersResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgInitiateDKGResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3579,10 +7791,10 @@ func (m *MsgSubmitBlockHeadersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitBlockHeadersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgInitiateDKGResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitBlockHeadersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgInitiateDKGResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3606,7 +7818,7 @@ func (m *MsgSubmitBlockHeadersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitDepositTransaction) Unmarshal(dAtA []byte) error {
+func (m *MsgCompleteDKG) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3629,10 +7841,10 @@ func (m *MsgSubmitDepositTransaction) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitDepositTransaction: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCompleteDKG: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitDepositTransaction: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCompleteDKG: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3668,8 +7880,27 @@ func (m *MsgSubmitDepositTransaction) Unmarshal(dAtA []byte) error {
 			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Blockhash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Vaults", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3697,11 +7928,11 @@ func (m *MsgSubmitDepositTransaction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Blockhash = string(dAtA[iNdEx:postIndex])
+			m.Vaults = append(m.Vaults, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrevTxBytes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3729,11 +7960,11 @@ func (m *MsgSubmitDepositTransaction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PrevTxBytes = string(dAtA[iNdEx:postIndex])
+			m.ConsensusAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxBytes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3761,11 +7992,11 @@ func (m *MsgSubmitDepositTransaction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TxBytes = string(dAtA[iNdEx:postIndex])
+			m.Signature = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKeys", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3793,7 +8024,73 @@ func (m *MsgSubmitDepositTransaction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Proof = append(m.Proof, string(dAtA[iNdEx:postIndex]))
+			m.PubKeys = append(m.PubKeys, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proofs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proofs = append(m.Proofs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerificationShares", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VerificationShares = append(m.VerificationShares, VerificationShares{})
+			if err := m.VerificationShares[len(m.VerificationShares)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3816,7 +8113,7 @@ func (m *MsgSubmitDepositTransaction) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitDepositTransactionResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCompleteDKGResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3839,10 +8136,10 @@ func (m *MsgSubmitDepositTransactionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitDepositTransactionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCompleteDKGResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitDepositTransactionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCompleteDKGResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3866,7 +8163,7 @@ func (m *MsgSubmitDepositTransactionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitWithdrawTransaction) Unmarshal(dAtA []byte) error {
+func (m *MsgTransferVault) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3889,15 +8186,15 @@ func (m *MsgSubmitWithdrawTransaction) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitWithdrawTransaction: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTransferVault: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitWithdrawTransaction: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTransferVault: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3925,13 +8222,13 @@ func (m *MsgSubmitWithdrawTransaction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Blockhash", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceVersion", wireType)
 			}
-			var stringLen uint64
+			m.SourceVersion = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3941,27 +8238,52 @@ func (m *MsgSubmitWithdrawTransaction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.SourceVersion |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestVersion", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			m.DestVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DestVersion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetType", wireType)
+			}
+			m.AssetType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AssetType |= AssetType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Blockhash = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxBytes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Psbts", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3989,13 +8311,13 @@ func (m *MsgSubmitWithdrawTransaction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TxBytes = string(dAtA[iNdEx:postIndex])
+			m.Psbts = append(m.Psbts, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetUtxoNum", wireType)
 			}
-			var stringLen uint64
+			m.TargetUtxoNum = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4005,24 +8327,11 @@ func (m *MsgSubmitWithdrawTransaction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.TargetUtxoNum |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Proof = append(m.Proof, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4044,7 +8353,7 @@ func (m *MsgSubmitWithdrawTransaction) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitWithdrawTransactionResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgTransferVaultResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4067,10 +8376,10 @@ func (m *MsgSubmitWithdrawTransactionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitWithdrawTransactionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTransferVaultResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitWithdrawTransactionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTransferVaultResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4094,7 +8403,7 @@ func (m *MsgSubmitWithdrawTransactionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitFeeRate) Unmarshal(dAtA []byte) error {
+func (m *MsgRegisterRunes) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4117,10 +8426,10 @@ func (m *MsgSubmitFeeRate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitFeeRate: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRegisterRunes: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitFeeRate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRegisterRunes: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4156,10 +8465,10 @@ func (m *MsgSubmitFeeRate) Unmarshal(dAtA []byte) error {
 			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeeRate", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Runes", wireType)
 			}
-			m.FeeRate = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4169,11 +8478,26 @@ func (m *MsgSubmitFeeRate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.FeeRate |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Runes = append(m.Runes, &RuneMetadata{})
+			if err := m.Runes[len(m.Runes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4195,7 +8519,7 @@ func (m *MsgSubmitFeeRate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitFeeRateResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRegisterRunesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4218,10 +8542,10 @@ func (m *MsgSubmitFeeRateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitFeeRateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRegisterRunesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitFeeRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRegisterRunesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4245,7 +8569,7 @@ func (m *MsgSubmitFeeRateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateTrustedNonBtcRelayers) Unmarshal(dAtA []byte) error {
+func (m *MsgRegisterBrc20Tickers) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4268,10 +8592,10 @@ func (m *MsgUpdateTrustedNonBtcRelayers) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateTrustedNonBtcRelayers: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRegisterBrc20Tickers: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateTrustedNonBtcRelayers: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRegisterBrc20Tickers: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4308,9 +8632,9 @@ func (m *MsgUpdateTrustedNonBtcRelayers) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Relayers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tickers", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4320,23 +8644,25 @@ func (m *MsgUpdateTrustedNonBtcRelayers) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Relayers = append(m.Relayers, string(dAtA[iNdEx:postIndex]))
+			m.Tickers = append(m.Tickers, &Brc20Metadata{})
+			if err := m.Tickers[len(m.Tickers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4359,7 +8685,7 @@ func (m *MsgUpdateTrustedNonBtcRelayers) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateTrustedNonBtcRelayersResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRegisterBrc20TickersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4382,10 +8708,10 @@ func (m *MsgUpdateTrustedNonBtcRelayersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateTrustedNonBtcRelayersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRegisterBrc20TickersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateTrustedNonBtcRelayersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRegisterBrc20TickersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4409,7 +8735,7 @@ func (m *MsgUpdateTrustedNonBtcRelayersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateTrustedFeeProviders) Unmarshal(dAtA []byte) error {
+func (m *MsgResetCircuitBreaker) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4432,47 +8758,15 @@ func (m *MsgUpdateTrustedFeeProviders) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateTrustedFeeProviders: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgResetCircuitBreaker: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateTrustedFeeProviders: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgResetCircuitBreaker: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeeProviders", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4500,7 +8794,7 @@ func (m *MsgUpdateTrustedFeeProviders) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FeeProviders = append(m.FeeProviders, string(dAtA[iNdEx:postIndex]))
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4523,7 +8817,7 @@ func (m *MsgUpdateTrustedFeeProviders) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateTrustedFeeProvidersResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgResetCircuitBreakerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4546,10 +8840,10 @@ func (m *MsgUpdateTrustedFeeProvidersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateTrustedFeeProvidersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgResetCircuitBreakerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateTrustedFeeProvidersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgResetCircuitBreakerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4573,7 +8867,7 @@ func (m *MsgUpdateTrustedFeeProvidersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawToBitcoin) Unmarshal(dAtA []byte) error {
+func (m *MsgEmergencyPause) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4596,15 +8890,15 @@ func (m *MsgWithdrawToBitcoin) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawToBitcoin: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgEmergencyPause: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawToBitcoin: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgEmergencyPause: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Guardian", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4632,11 +8926,30 @@ func (m *MsgWithdrawToBitcoin) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Guardian = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
+			}
+			m.Scope = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Scope |= PauseScope(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4664,7 +8977,40 @@ func (m *MsgWithdrawToBitcoin) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4687,7 +9033,7 @@ func (m *MsgWithdrawToBitcoin) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawToBitcoinResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgEmergencyPauseResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4710,12 +9056,31 @@ func (m *MsgWithdrawToBitcoinResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawToBitcoinResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgEmergencyPauseResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawToBitcoinResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgEmergencyPauseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4737,7 +9102,7 @@ func (m *MsgWithdrawToBitcoinResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitSignatures) Unmarshal(dAtA []byte) error {
+func (m *MsgLiftEmergencyPause) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4760,15 +9125,15 @@ func (m *MsgSubmitSignatures) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitSignatures: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgLiftEmergencyPause: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitSignatures: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgLiftEmergencyPause: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4796,45 +9161,13 @@ func (m *MsgSubmitSignatures) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Txid", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Txid = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Psbt", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var stringLen uint64
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4844,24 +9177,11 @@ func (m *MsgSubmitSignatures) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Psbt = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4883,7 +9203,7 @@ func (m *MsgSubmitSignatures) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitSignaturesResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgLiftEmergencyPauseResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4906,10 +9226,10 @@ func (m *MsgSubmitSignaturesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitSignaturesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgLiftEmergencyPauseResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitSignaturesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgLiftEmergencyPauseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4933,7 +9253,7 @@ func (m *MsgSubmitSignaturesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgConsolidateVaults) Unmarshal(dAtA []byte) error {
+func (m *MsgRecoverVault) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4956,10 +9276,10 @@ func (m *MsgConsolidateVaults) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgConsolidateVaults: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRecoverVault: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgConsolidateVaults: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRecoverVault: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4995,10 +9315,10 @@ func (m *MsgConsolidateVaults) Unmarshal(dAtA []byte) error {
 			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VaultVersion", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vault", wireType)
 			}
-			m.VaultVersion = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5008,16 +9328,29 @@ func (m *MsgConsolidateVaults) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.VaultVersion |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Vault = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BtcConsolidation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5027,33 +9360,29 @@ func (m *MsgConsolidateVaults) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BtcConsolidation == nil {
-				m.BtcConsolidation = &BtcConsolidation{}
-			}
-			if err := m.BtcConsolidation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RunesConsolidations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InternalKey", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5063,26 +9392,43 @@ func (m *MsgConsolidateVaults) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RunesConsolidations = append(m.RunesConsolidations, &RunesConsolidation{})
-			if err := m.RunesConsolidations[len(m.RunesConsolidations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.InternalKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxUtxoNum", wireType)
+			}
+			m.MaxUtxoNum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxUtxoNum |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -5104,7 +9450,7 @@ func (m *MsgConsolidateVaults) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgConsolidateVaultsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRecoverVaultResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5127,12 +9473,31 @@ func (m *MsgConsolidateVaultsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgConsolidateVaultsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRecoverVaultResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgConsolidateVaultsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRecoverVaultResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -5154,7 +9519,7 @@ func (m *MsgConsolidateVaultsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgInitiateDKG) Unmarshal(dAtA []byte) error {
+func (m *MsgSweepVault) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5177,171 +9542,49 @@ func (m *MsgInitiateDKG) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgInitiateDKG: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSweepVault: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgInitiateDKG: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSweepVault: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Participants", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Participants = append(m.Participants, &DKGParticipant{})
-			if err := m.Participants[len(m.Participants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
-			}
-			m.Threshold = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Threshold |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType == 0 {
-				var v AssetType
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= AssetType(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.VaultTypes = append(m.VaultTypes, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTx
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTx
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				if elementCount != 0 && len(m.VaultTypes) == 0 {
-					m.VaultTypes = make([]AssetType, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v AssetType
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= AssetType(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.VaultTypes = append(m.VaultTypes, v)
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field VaultTypes", wireType)
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnableTransfer", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
 			}
-			var v int
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vault", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5351,17 +9594,29 @@ func (m *MsgInitiateDKG) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.EnableTransfer = bool(v != 0)
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetUtxoNum", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
 			}
-			m.TargetUtxoNum = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Vault = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Psbt", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5371,11 +9626,24 @@ func (m *MsgInitiateDKG) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TargetUtxoNum |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Psbt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -5397,7 +9665,7 @@ func (m *MsgInitiateDKG) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgInitiateDKGResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSweepVaultResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5420,12 +9688,31 @@ func (m *MsgInitiateDKGResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgInitiateDKGResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSweepVaultResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgInitiateDKGResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSweepVaultResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -5447,7 +9734,7 @@ func (m *MsgInitiateDKGResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCompleteDKG) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitNonceCommitments) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5470,10 +9757,10 @@ func (m *MsgCompleteDKG) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCompleteDKG: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitNonceCommitments: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCompleteDKG: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitNonceCommitments: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5509,27 +9796,8 @@ func (m *MsgCompleteDKG) Unmarshal(dAtA []byte) error {
 			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			m.Id = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Vaults", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Txid", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5557,13 +9825,13 @@ func (m *MsgCompleteDKG) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Vaults = append(m.Vaults, string(dAtA[iNdEx:postIndex]))
+			m.Txid = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commitments", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5573,55 +9841,25 @@ func (m *MsgCompleteDKG) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConsensusAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Commitments = append(m.Commitments, FrostNonceCommitment{})
+			if err := m.Commitments[len(m.Commitments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Signature = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5644,7 +9882,7 @@ func (m *MsgCompleteDKG) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCompleteDKGResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitNonceCommitmentsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5667,10 +9905,10 @@ func (m *MsgCompleteDKGResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCompleteDKGResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitNonceCommitmentsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCompleteDKGResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitNonceCommitmentsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -5694,7 +9932,7 @@ func (m *MsgCompleteDKGResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgTransferVault) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitPartialSignatures) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5717,15 +9955,15 @@ func (m *MsgTransferVault) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgTransferVault: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitPartialSignatures: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgTransferVault: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitPartialSignatures: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5753,13 +9991,13 @@ func (m *MsgTransferVault) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceVersion", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Txid", wireType)
 			}
-			m.SourceVersion = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5769,52 +10007,27 @@ func (m *MsgTransferVault) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SourceVersion |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DestVersion", wireType)
-			}
-			m.DestVersion = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DestVersion |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssetType", wireType)
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
-			m.AssetType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.AssetType |= AssetType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		case 5:
+			m.Txid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Psbts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PartialSignatures", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5842,27 +10055,8 @@ func (m *MsgTransferVault) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Psbts = append(m.Psbts, string(dAtA[iNdEx:postIndex]))
+			m.PartialSignatures = append(m.PartialSignatures, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetUtxoNum", wireType)
-			}
-			m.TargetUtxoNum = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TargetUtxoNum |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -5884,7 +10078,7 @@ func (m *MsgTransferVault) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgTransferVaultResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitPartialSignaturesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5907,10 +10101,10 @@ func (m *MsgTransferVaultResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgTransferVaultResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitPartialSignaturesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgTransferVaultResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitPartialSignaturesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -9,3 +9,39 @@ type UTXOIterator interface {
 	GetUTXO() *UTXO
 	GetMinimumUTXO() *UTXO
 }
+
+// UTXOSliceIterator implements UTXOIterator over the utxos in descending order by amount
+// The last utxo is taken as the minimum one and excluded from the iteration
+type UTXOSliceIterator struct {
+	utxos []*UTXO
+	index int
+}
+
+// NewUTXOSliceIterator creates a UTXOSliceIterator from the given utxos in descending order by amount
+func NewUTXOSliceIterator(utxos []*UTXO) *UTXOSliceIterator {
+	return &UTXOSliceIterator{utxos: utxos}
+}
+
+func (i *UTXOSliceIterator) Valid() bool {
+	return i.index < len(i.utxos)-1
+}
+
+func (i *UTXOSliceIterator) Next() {
+	i.index++
+}
+
+func (i *UTXOSliceIterator) Close() error {
+	return nil
+}
+
+func (i *UTXOSliceIterator) GetUTXO() *UTXO {
+	return i.utxos[i.index]
+}
+
+func (i *UTXOSliceIterator) GetMinimumUTXO() *UTXO {
+	if len(i.utxos) == 0 {
+		return nil
+	}
+
+	return i.utxos[len(i.utxos)-1]
+}
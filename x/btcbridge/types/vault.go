@@ -0,0 +1,152 @@
+package types
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	secp256k1 "github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/btcutil/psbt"
+	"github.com/btcsuite/btcd/txscript"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// maximum number of public keys of the P2WSH multisig by the standardness policy
+	MaxMultisigPubKeys = 20
+)
+
+// IsRetired returns true if the vault is retired or due to retire at the given time, false otherwise
+func (v *Vault) IsRetired(t time.Time) bool {
+	return v.State == VaultState_VAULT_STATE_RETIRED || (v.RetirementTime != nil && !t.Before(*v.RetirementTime))
+}
+
+// VaultWithdrawRequests defines the withdrawal requests assigned to the vault
+type VaultWithdrawRequests struct {
+	Vault    string
+	Requests []*WithdrawRequest
+}
+
+// Validate validates the vault descriptor
+func (d *VaultDescriptor) Validate() error {
+	if _, ok := VaultScriptType_name[int32(d.ScriptType)]; !ok || d.ScriptType == VaultScriptType_VAULT_SCRIPT_TYPE_UNSPECIFIED {
+		return errorsmod.Wrap(ErrInvalidVault, "invalid script type")
+	}
+
+	if len(d.PubKeys) == 0 || len(d.PubKeys) > MaxMultisigPubKeys {
+		return errorsmod.Wrapf(ErrInvalidVault, "number of public keys must be between 1 and %d", MaxMultisigPubKeys)
+	}
+
+	if d.Threshold == 0 || d.Threshold > uint32(len(d.PubKeys)) {
+		return errorsmod.Wrap(ErrInvalidVault, "threshold must be between 1 and the number of public keys")
+	}
+
+	pubKeys := make(map[string]bool)
+
+	for _, pk := range d.PubKeys {
+		pkBytes, err := hex.DecodeString(pk)
+		if err != nil || len(pkBytes) != secp256k1.PubKeyBytesLenCompressed {
+			return errorsmod.Wrap(ErrInvalidVault, "public key must be compressed")
+		}
+
+		if _, err := secp256k1.ParsePubKey(pkBytes); err != nil {
+			return errorsmod.Wrapf(ErrInvalidVault, "invalid public key: %v", err)
+		}
+
+		if pubKeys[pk] {
+			return errorsmod.Wrap(ErrInvalidVault, "duplicate public key")
+		}
+
+		pubKeys[pk] = true
+	}
+
+	return nil
+}
+
+// WitnessScript builds the witness script of the vault descriptor
+// Make sure that the descriptor is valid
+func (d *VaultDescriptor) WitnessScript() ([]byte, error) {
+	builder := txscript.NewScriptBuilder().AddInt64(int64(d.Threshold))
+
+	for _, pk := range d.PubKeys {
+		pkBytes, err := hex.DecodeString(pk)
+		if err != nil {
+			return nil, err
+		}
+
+		builder.AddData(pkBytes)
+	}
+
+	return builder.AddInt64(int64(len(d.PubKeys))).AddOp(txscript.OP_CHECKMULTISIG).Script()
+}
+
+// Address derives the vault address from the vault descriptor
+func (d *VaultDescriptor) Address() (string, error) {
+	witnessScript, err := d.WitnessScript()
+	if err != nil {
+		return "", err
+	}
+
+	scriptHash := sha256.Sum256(witnessScript)
+	chainCfg := sdk.GetConfig().GetBtcChainCfg()
+
+	switch d.ScriptType {
+	case VaultScriptType_VAULT_SCRIPT_TYPE_P2WSH_MULTISIG:
+		address, err := btcutil.NewAddressWitnessScriptHash(scriptHash[:], chainCfg)
+		if err != nil {
+			return "", err
+		}
+
+		return address.EncodeAddress(), nil
+
+	case VaultScriptType_VAULT_SCRIPT_TYPE_P2SH_P2WSH_MULTISIG:
+		address, err := btcutil.NewAddressScriptHash(NestedRedeemScript(witnessScript), chainCfg)
+		if err != nil {
+			return "", err
+		}
+
+		return address.EncodeAddress(), nil
+
+	default:
+		return "", errorsmod.Wrap(ErrInvalidVault, "invalid script type")
+	}
+}
+
+// NestedRedeemScript returns the P2SH redeem script wrapping the P2WSH output of the given witness script
+func NestedRedeemScript(witnessScript []byte) []byte {
+	scriptHash := sha256.Sum256(witnessScript)
+
+	return append([]byte{txscript.OP_0, txscript.OP_DATA_32}, scriptHash[:]...)
+}
+
+// IsScriptHashUTXO returns true if the given utxo is the script hash output with the witness script, false otherwise
+func IsScriptHashUTXO(utxo *UTXO) bool {
+	return len(utxo.WitnessScript) != 0 && (txscript.IsPayToWitnessScriptHash(utxo.PubKeyScript) || txscript.IsPayToScriptHash(utxo.PubKeyScript))
+}
+
+// PopulatePsbtInputScripts populates the given psbt input with the witness script and redeem script of the utxo if any
+// The sig hash type is overridden for the multisig input
+func PopulatePsbtInputScripts(input *psbt.PInput, utxo *UTXO) {
+	if !IsScriptHashUTXO(utxo) {
+		return
+	}
+
+	input.SighashType = MultisigSigHashType
+	input.WitnessScript = utxo.WitnessScript
+
+	if txscript.IsPayToScriptHash(utxo.PubKeyScript) {
+		input.RedeemScript = NestedRedeemScript(utxo.WitnessScript)
+	}
+}
+
+// IsValidPsbtInputSigHashType returns true if the sig hash type of the given psbt input is expected, false otherwise
+func IsValidPsbtInputSigHashType(input *psbt.PInput) bool {
+	if len(input.WitnessScript) != 0 {
+		return input.SighashType == MultisigSigHashType
+	}
+
+	return input.SighashType == DefaultSigHashType
+}
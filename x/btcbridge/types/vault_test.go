@@ -0,0 +1,126 @@
+package types_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	secp256k1 "github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/btcutil/psbt"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/mempool"
+	"github.com/btcsuite/btcd/txscript"
+
+	"github.com/sideprotocol/side/x/btcbridge/types"
+)
+
+func TestVaultDescriptorValidate(t *testing.T) {
+	_, pubKeys := generateMultisigKeys(t, 3)
+
+	testCases := []struct {
+		name       string
+		descriptor types.VaultDescriptor
+		expectErr  bool
+	}{
+		{"valid p2wsh", types.VaultDescriptor{ScriptType: types.VaultScriptType_VAULT_SCRIPT_TYPE_P2WSH_MULTISIG, Threshold: 2, PubKeys: pubKeys}, false},
+		{"valid p2sh-p2wsh", types.VaultDescriptor{ScriptType: types.VaultScriptType_VAULT_SCRIPT_TYPE_P2SH_P2WSH_MULTISIG, Threshold: 3, PubKeys: pubKeys}, false},
+		{"unspecified script type", types.VaultDescriptor{Threshold: 2, PubKeys: pubKeys}, true},
+		{"zero threshold", types.VaultDescriptor{ScriptType: types.VaultScriptType_VAULT_SCRIPT_TYPE_P2WSH_MULTISIG, PubKeys: pubKeys}, true},
+		{"threshold greater than keys", types.VaultDescriptor{ScriptType: types.VaultScriptType_VAULT_SCRIPT_TYPE_P2WSH_MULTISIG, Threshold: 4, PubKeys: pubKeys}, true},
+		{"duplicate keys", types.VaultDescriptor{ScriptType: types.VaultScriptType_VAULT_SCRIPT_TYPE_P2WSH_MULTISIG, Threshold: 2, PubKeys: []string{pubKeys[0], pubKeys[0]}}, true},
+		{"uncompressed key", types.VaultDescriptor{ScriptType: types.VaultScriptType_VAULT_SCRIPT_TYPE_P2WSH_MULTISIG, Threshold: 1, PubKeys: []string{"04" + pubKeys[0][2:]}}, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.descriptor.Validate()
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+
+			address, err := tc.descriptor.Address()
+			require.NoError(t, err)
+			require.True(t, types.IsValidBtcAddress(address))
+		})
+	}
+}
+
+func TestVerifyMultisigPsbtSignatures(t *testing.T) {
+	for _, scriptType := range []types.VaultScriptType{types.VaultScriptType_VAULT_SCRIPT_TYPE_P2WSH_MULTISIG, types.VaultScriptType_VAULT_SCRIPT_TYPE_P2SH_P2WSH_MULTISIG} {
+		t.Run(scriptType.String(), func(t *testing.T) {
+			privKeys, pubKeys := generateMultisigKeys(t, 3)
+
+			descriptor := types.VaultDescriptor{ScriptType: scriptType, Threshold: 2, PubKeys: pubKeys}
+
+			vault, err := descriptor.Address()
+			require.NoError(t, err)
+
+			witnessScript, err := descriptor.WitnessScript()
+			require.NoError(t, err)
+
+			utxo := &types.UTXO{
+				Txid:          chainhash.HashH([]byte("multisig")).String(),
+				Vout:          0,
+				Address:       vault,
+				Amount:        1000000,
+				PubKeyScript:  types.MustPkScriptFromAddress(vault),
+				WitnessScript: witnessScript,
+			}
+
+			p, _, _, err := types.BuildPsbt(types.NewUTXOSliceIterator([]*types.UTXO{utxo}), testTaprootAddress(2), 500000, 10, vault, 10, types.DefaultCoinSelector{})
+			require.NoError(t, err)
+			require.Equal(t, witnessScript, p.Inputs[0].WitnessScript)
+
+			// sign by the first and the third keys
+			prevOutputFetcher := txscript.NewCannedPrevOutputFetcher(utxo.PubKeyScript, int64(utxo.Amount))
+			sigHashes := txscript.NewTxSigHashes(p.UnsignedTx, prevOutputFetcher)
+
+			updater, err := psbt.NewUpdater(p)
+			require.NoError(t, err)
+
+			for _, i := range []int{0, 2} {
+				sig, err := txscript.RawTxInWitnessSignature(p.UnsignedTx, sigHashes, 0, int64(utxo.Amount), witnessScript, types.MultisigSigHashType, privKeys[i])
+				require.NoError(t, err)
+
+				_, err = updater.Sign(0, sig, privKeys[i].PubKey().SerializeCompressed(), p.Inputs[0].RedeemScript, witnessScript)
+				require.NoError(t, err)
+			}
+
+			require.NoError(t, psbt.MaybeFinalizeAll(p))
+			require.True(t, types.VerifyPsbtSignatures(p), "signatures should be valid")
+
+			// the fee estimation should cover the actual size
+			signedTx, err := psbt.Extract(p)
+			require.NoError(t, err)
+
+			estimatedSize := types.GetTxVirtualSize(p.UnsignedTx, []*types.UTXO{utxo})
+			actualSize := mempool.GetTxVirtualSize(btcutil.NewTx(signedTx))
+			require.GreaterOrEqual(t, estimatedSize, actualSize, "the estimated size should cover the actual size")
+			require.LessOrEqual(t, estimatedSize, actualSize+2, "the estimated size should be tight")
+
+			// tamper the signature
+			p.Inputs[0].FinalScriptWitness[2] ^= 0x01
+			require.False(t, types.VerifyPsbtSignatures(p), "signatures should be invalid")
+		})
+	}
+}
+
+func generateMultisigKeys(t *testing.T, n int) ([]*secp256k1.PrivateKey, []string) {
+	privKeys := make([]*secp256k1.PrivateKey, 0, n)
+	pubKeys := make([]string, 0, n)
+
+	for i := 0; i < n; i++ {
+		privKey, err := secp256k1.NewPrivateKey()
+		require.NoError(t, err)
+
+		privKeys = append(privKeys, privKey)
+		pubKeys = append(pubKeys, hex.EncodeToString(privKey.PubKey().SerializeCompressed()))
+	}
+
+	return privKeys, pubKeys
+}
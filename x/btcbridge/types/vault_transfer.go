@@ -0,0 +1,29 @@
+package types
+
+// VaultTransferAssetTypes returns the asset types of the vaults to be transferred in order
+// The btc vault is transferred after the runes vault
+func VaultTransferAssetTypes() []AssetType {
+	return []AssetType{AssetType_ASSET_TYPE_RUNES, AssetType_ASSET_TYPE_BTC}
+}
+
+// GetAssetTransfer gets the transfer progress of the given asset type
+func (t *VaultTransfer) GetAssetTransfer(assetType AssetType) *AssetTransfer {
+	for i := range t.Assets {
+		if t.Assets[i].AssetType == assetType {
+			return &t.Assets[i]
+		}
+	}
+
+	return nil
+}
+
+// GetAssetTransferBySourceVault gets the transfer progress of the given source vault
+func (t *VaultTransfer) GetAssetTransferBySourceVault(sourceVault string) *AssetTransfer {
+	for i := range t.Assets {
+		if t.Assets[i].SourceVault == sourceVault {
+			return &t.Assets[i]
+		}
+	}
+
+	return nil
+}
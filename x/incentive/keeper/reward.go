@@ -123,6 +123,12 @@ func (k Keeper) DistributeDepositReward(ctx sdk.Context, address string) error {
 	k.AddDepositReward(ctx, address, rewardAmount)
 	k.UpdateRewardStats(ctx, address, rewardAmount)
 
+	_ = ctx.EventManager().EmitTypedEvent(&types.EventRewardDistributed{
+		Address:    address,
+		RewardType: types.RewardTypeDeposit,
+		Reward:     rewardAmount,
+	})
+
 	return nil
 }
 
@@ -141,5 +147,11 @@ func (k Keeper) DistributeWithdrawReward(ctx sdk.Context, address string) error
 	k.AddWithdrawReward(ctx, address, rewardAmount)
 	k.UpdateRewardStats(ctx, address, rewardAmount)
 
+	_ = ctx.EventManager().EmitTypedEvent(&types.EventRewardDistributed{
+		Address:    address,
+		RewardType: types.RewardTypeWithdraw,
+		Reward:     rewardAmount,
+	})
+
 	return nil
 }
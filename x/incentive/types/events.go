@@ -0,0 +1,7 @@
+package types
+
+const (
+	// reward types of the distributed rewards
+	RewardTypeDeposit  = "deposit"
+	RewardTypeWithdraw = "withdraw"
+)
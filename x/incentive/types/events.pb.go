@@ -0,0 +1,429 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: side/incentive/events.proto
+
+package types
+
+import (
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// EventRewardDistributed is emitted when the reward is distributed
+type EventRewardDistributed struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// reward type, i.e. deposit or withdraw
+	RewardType string     `protobuf:"bytes,2,opt,name=reward_type,json=rewardType,proto3" json:"reward_type,omitempty"`
+	Reward     types.Coin `protobuf:"bytes,3,opt,name=reward,proto3" json:"reward"`
+}
+
+func (m *EventRewardDistributed) Reset()         { *m = EventRewardDistributed{} }
+func (m *EventRewardDistributed) String() string { return proto.CompactTextString(m) }
+func (*EventRewardDistributed) ProtoMessage()    {}
+func (*EventRewardDistributed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_338b67b8e70b8e9d, []int{0}
+}
+func (m *EventRewardDistributed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventRewardDistributed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventRewardDistributed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventRewardDistributed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRewardDistributed.Merge(m, src)
+}
+func (m *EventRewardDistributed) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventRewardDistributed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRewardDistributed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventRewardDistributed proto.InternalMessageInfo
+
+func (m *EventRewardDistributed) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *EventRewardDistributed) GetRewardType() string {
+	if m != nil {
+		return m.RewardType
+	}
+	return ""
+}
+
+func (m *EventRewardDistributed) GetReward() types.Coin {
+	if m != nil {
+		return m.Reward
+	}
+	return types.Coin{}
+}
+
+func init() {
+	proto.RegisterType((*EventRewardDistributed)(nil), "side.incentive.EventRewardDistributed")
+}
+
+func init() { proto.RegisterFile("side/incentive/events.proto", fileDescriptor_338b67b8e70b8e9d) }
+
+var fileDescriptor_338b67b8e70b8e9d = []byte{
+	// 260 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x90, 0x31, 0x4b, 0x03, 0x31,
+	0x14, 0xc7, 0x2f, 0x2a, 0x15, 0x53, 0x70, 0x38, 0x44, 0xce, 0x0a, 0x69, 0x71, 0xea, 0x94, 0x50,
+	0x1d, 0xdc, 0xab, 0x82, 0x73, 0x71, 0x72, 0x91, 0xbb, 0xe4, 0x71, 0x06, 0x6c, 0xde, 0x91, 0xa4,
+	0xa7, 0xfd, 0x0e, 0x0e, 0x7e, 0xac, 0x8e, 0x1d, 0x9d, 0x44, 0xee, 0xbe, 0x88, 0x24, 0xa9, 0xe2,
+	0x96, 0x7f, 0x7e, 0x7f, 0x1e, 0xbf, 0xf7, 0xe8, 0xb9, 0xd3, 0x0a, 0x84, 0x36, 0x12, 0x8c, 0xd7,
+	0x2d, 0x08, 0x68, 0xc1, 0x78, 0xc7, 0x1b, 0x8b, 0x1e, 0xf3, 0xe3, 0x00, 0xf9, 0x1f, 0x1c, 0x9d,
+	0xd4, 0x58, 0x63, 0x44, 0x22, 0xbc, 0x52, 0x6b, 0xc4, 0x24, 0xba, 0x25, 0x3a, 0x51, 0x95, 0x0e,
+	0x44, 0x3b, 0xab, 0xc0, 0x97, 0x33, 0x21, 0x51, 0x9b, 0xc4, 0x2f, 0xde, 0x09, 0x3d, 0xbd, 0x0b,
+	0x63, 0x17, 0xf0, 0x5a, 0x5a, 0x75, 0xab, 0x9d, 0xb7, 0xba, 0x5a, 0x79, 0x50, 0x79, 0x41, 0x0f,
+	0x4b, 0xa5, 0x2c, 0x38, 0x57, 0x90, 0x09, 0x99, 0x1e, 0x2d, 0x7e, 0x63, 0x3e, 0xa6, 0x43, 0x1b,
+	0xeb, 0x4f, 0x7e, 0xdd, 0x40, 0xb1, 0x17, 0x29, 0x4d, 0x5f, 0x0f, 0xeb, 0x06, 0xf2, 0x6b, 0x3a,
+	0x48, 0xa9, 0xd8, 0x9f, 0x90, 0xe9, 0xf0, 0xf2, 0x8c, 0x27, 0x0d, 0x1e, 0x34, 0xf8, 0x4e, 0x83,
+	0xdf, 0xa0, 0x36, 0xf3, 0x83, 0xcd, 0xd7, 0x38, 0x5b, 0xec, 0xea, 0xf3, 0xfb, 0x4d, 0xc7, 0xc8,
+	0xb6, 0x63, 0xe4, 0xbb, 0x63, 0xe4, 0xa3, 0x67, 0xd9, 0xb6, 0x67, 0xd9, 0x67, 0xcf, 0xb2, 0x47,
+	0x5e, 0x6b, 0xff, 0xbc, 0xaa, 0xb8, 0xc4, 0xa5, 0x08, 0x9b, 0x47, 0x7d, 0x89, 0x2f, 0x31, 0x88,
+	0xb7, 0x7f, 0x57, 0x0a, 0x4e, 0xae, 0x1a, 0xc4, 0xc2, 0xd5, 0xcf, 0x00, 0xb4, 0x6a, 0x85, 0xc2,
+	0x44, 0x01, 0x00, 0x00,
+}
+
+func (m *EventRewardDistributed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventRewardDistributed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRewardDistributed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Reward.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.RewardType) > 0 {
+		i -= len(m.RewardType)
+		copy(dAtA[i:], m.RewardType)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.RewardType)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventRewardDistributed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.RewardType)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Reward.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventRewardDistributed) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventRewardDistributed: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventRewardDistributed: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reward", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Reward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipEvents(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthEvents
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupEvents
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthEvents
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthEvents        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowEvents          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupEvents = fmt.Errorf("proto: unexpected end of group")
+)